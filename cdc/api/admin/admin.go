@@ -0,0 +1,536 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/capture"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/owner"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
+	adminProto "github.com/pingcap/tiflow/proto/admin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// forwardFromCaptureKey is the gRPC metadata key to mark a request that
+	// has been forwarded, every request can only be forwarded once.
+	forwardFromCaptureKey = "ticdc-forward-from-capture"
+	// forwardedForKey is the gRPC metadata key of the IP of the original
+	// caller of a forwarded request.
+	forwardedForKey = "ticdc-forwarded-for"
+
+	defaultWatchInterval = time.Second
+)
+
+// Server implements the gRPC admin API of a capture. Requests are handled
+// by the owner, a non-owner capture forwards them to the owner.
+type Server struct {
+	capture capture.Capture
+}
+
+// NewServer creates a Server.
+func NewServer(capture capture.Capture) *Server {
+	return &Server{capture: capture}
+}
+
+// ListCaptures implements adminProto.CDCAdminServer.
+func (s *Server) ListCaptures(
+	ctx context.Context, req *adminProto.ListCapturesRequest,
+) (*adminProto.ListCapturesResponse, error) {
+	return forward(ctx, s, req, s.listCaptures, adminProto.CDCAdminClient.ListCaptures)
+}
+
+// ListChangefeeds implements adminProto.CDCAdminServer.
+func (s *Server) ListChangefeeds(
+	ctx context.Context, req *adminProto.ListChangefeedsRequest,
+) (*adminProto.ListChangefeedsResponse, error) {
+	return forward(ctx, s, req, s.listChangefeeds, adminProto.CDCAdminClient.ListChangefeeds)
+}
+
+// GetChangefeed implements adminProto.CDCAdminServer.
+func (s *Server) GetChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.Changefeed, error) {
+	return forward(ctx, s, req, s.getChangefeed, adminProto.CDCAdminClient.GetChangefeed)
+}
+
+// PauseChangefeed implements adminProto.CDCAdminServer.
+func (s *Server) PauseChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	return forward(ctx, s, req, s.pauseChangefeed, adminProto.CDCAdminClient.PauseChangefeed)
+}
+
+// ResumeChangefeed implements adminProto.CDCAdminServer.
+func (s *Server) ResumeChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	return forward(ctx, s, req, s.resumeChangefeed, adminProto.CDCAdminClient.ResumeChangefeed)
+}
+
+// RemoveChangefeed implements adminProto.CDCAdminServer.
+func (s *Server) RemoveChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	return forward(ctx, s, req, s.removeChangefeed, adminProto.CDCAdminClient.RemoveChangefeed)
+}
+
+// ListProcessors implements adminProto.CDCAdminServer.
+func (s *Server) ListProcessors(
+	ctx context.Context, req *adminProto.ListProcessorsRequest,
+) (*adminProto.ListProcessorsResponse, error) {
+	return forward(ctx, s, req, s.listProcessors, adminProto.CDCAdminClient.ListProcessors)
+}
+
+// WatchChangefeed implements adminProto.CDCAdminServer.
+func (s *Server) WatchChangefeed(
+	req *adminProto.WatchChangefeedRequest, stream adminProto.CDCAdmin_WatchChangefeedServer,
+) error {
+	ctx := stream.Context()
+	if !s.capture.IsOwner() {
+		return toGRPCError(s.forwardWatchChangefeed(ctx, req, stream))
+	}
+
+	interval := defaultWatchInterval
+	if req.IntervalMs > 0 {
+		interval = time.Duration(req.IntervalMs) * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	cfReq := &adminProto.ChangefeedRequest{Namespace: req.Namespace, Id: req.Id}
+	for {
+		cf, err := s.getChangefeed(ctx, cfReq)
+		if err != nil {
+			if cerror.ErrChangeFeedNotExists.Equal(err) {
+				return nil
+			}
+			return toGRPCError(err)
+		}
+		if err := stream.Send(cf); err != nil {
+			return errors.Trace(err)
+		}
+		select {
+		case <-ctx.Done():
+			return toGRPCError(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) forwardWatchChangefeed(
+	ctx context.Context, req *adminProto.WatchChangefeedRequest,
+	stream adminProto.CDCAdmin_WatchChangefeedServer,
+) error {
+	ctx, conn, err := s.dialOwner(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer conn.Close()
+
+	client, err := adminProto.NewCDCAdminClient(conn).WatchChangefeed(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		cf, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := stream.Send(cf); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+func (s *Server) listCaptures(
+	ctx context.Context, _ *adminProto.ListCapturesRequest,
+) (*adminProto.ListCapturesResponse, error) {
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	captureInfos, err := provider.GetCaptures(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	info, err := s.capture.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	clusterID := s.capture.GetEtcdClient().GetClusterID()
+
+	resp := &adminProto.ListCapturesResponse{
+		Captures: make([]*adminProto.Capture, 0, len(captureInfos)),
+	}
+	for _, c := range captureInfos {
+		resp.Captures = append(resp.Captures, &adminProto.Capture{
+			Id:        c.ID,
+			IsOwner:   c.ID == info.ID,
+			Address:   c.AdvertiseAddr,
+			ClusterId: clusterID,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) listChangefeeds(
+	ctx context.Context, req *adminProto.ListChangefeedsRequest,
+) (*adminProto.ListChangefeedsResponse, error) {
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := provider.GetAllChangeFeedStatuses(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	infos, err := provider.GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	changefeeds := make([]model.ChangeFeedID, 0, len(infos))
+	for cfID := range infos {
+		if req.Namespace != "" && cfID.Namespace != req.Namespace {
+			continue
+		}
+		changefeeds = append(changefeeds, cfID)
+	}
+	sort.Slice(changefeeds, func(i, j int) bool {
+		if changefeeds[i].Namespace == changefeeds[j].Namespace {
+			return changefeeds[i].ID < changefeeds[j].ID
+		}
+		return changefeeds[i].Namespace < changefeeds[j].Namespace
+	})
+
+	resp := &adminProto.ListChangefeedsResponse{
+		Changefeeds: make([]*adminProto.Changefeed, 0, len(changefeeds)),
+	}
+	for _, cfID := range changefeeds {
+		resp.Changefeeds = append(resp.Changefeeds,
+			toChangefeed(cfID, infos[cfID], statuses[cfID]))
+	}
+	return resp, nil
+}
+
+func (s *Server) getChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.Changefeed, error) {
+	changefeedID, err := toChangefeedID(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	info, err := provider.GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cfStatus, err := provider.GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return toChangefeed(changefeedID, info, cfStatus), nil
+}
+
+func (s *Server) pauseChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	changefeedID, err := toChangefeedID(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	// check if the changefeed exists
+	if _, err := provider.GetChangeFeedStatus(ctx, changefeedID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminStop,
+	}
+	if err := api.HandleOwnerJob(ctx, s.capture, job); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &adminProto.OperateChangefeedResponse{}, nil
+}
+
+func (s *Server) resumeChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	changefeedID, err := toChangefeedID(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := provider.GetChangeFeedInfo(ctx, changefeedID); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Overwriting the checkpoint ts is not supported, so there is no need to
+	// ensure the GC safepoint as the OpenAPI does.
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminResume,
+	}
+	if err := api.HandleOwnerJob(ctx, s.capture, job); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &adminProto.OperateChangefeedResponse{}, nil
+}
+
+func (s *Server) removeChangefeed(
+	ctx context.Context, req *adminProto.ChangefeedRequest,
+) (*adminProto.OperateChangefeedResponse, error) {
+	changefeedID, err := toChangefeedID(req.Namespace, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := provider.GetChangeFeedStatus(ctx, changefeedID); err != nil {
+		if cerror.ErrChangeFeedNotExists.Equal(err) {
+			return &adminProto.OperateChangefeedResponse{}, nil
+		}
+		return nil, errors.Trace(err)
+	}
+
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminRemove,
+	}
+	if err := api.HandleOwnerJob(ctx, s.capture, job); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Owner needs at least two ticks to remove a changefeed,
+	// we need to wait for it.
+	err = retry.Do(ctx, func() error {
+		_, err := provider.GetChangeFeedStatus(ctx, changefeedID)
+		if err != nil {
+			if strings.Contains(err.Error(), "ErrChangeFeedNotExists") {
+				return nil
+			}
+			return err
+		}
+		return cerror.ErrChangeFeedDeletionUnfinished.GenWithStackByArgs(changefeedID)
+	},
+		retry.WithMaxTries(100),         // max retry duration is 1 minute
+		retry.WithBackoffBaseDelay(600), // default owner tick interval is 200ms
+		retry.WithIsRetryableErr(cerror.IsRetryableError))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &adminProto.OperateChangefeedResponse{}, nil
+}
+
+func (s *Server) listProcessors(
+	ctx context.Context, _ *adminProto.ListProcessorsRequest,
+) (*adminProto.ListProcessorsResponse, error) {
+	provider, err := s.statusProvider()
+	if err != nil {
+		return nil, err
+	}
+	infos, err := provider.GetProcessors(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resp := &adminProto.ListProcessorsResponse{
+		Processors: make([]*adminProto.Processor, 0, len(infos)),
+	}
+	for _, info := range infos {
+		resp.Processors = append(resp.Processors, &adminProto.Processor{
+			Namespace:    info.CfID.Namespace,
+			ChangefeedId: info.CfID.ID,
+			CaptureId:    info.CaptureID,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) statusProvider() (owner.StatusProvider, error) {
+	provider := s.capture.StatusProvider()
+	if provider == nil {
+		return nil, cerror.ErrNotOwner.GenWithStackByArgs()
+	}
+	return provider, nil
+}
+
+// dialOwner connects to the owner, and returns a context that marks the
+// request as forwarded. The caller must close the returned connection.
+func (s *Server) dialOwner(ctx context.Context) (context.Context, *grpc.ClientConn, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok &&
+		len(md.Get(forwardFromCaptureKey)) != 0 {
+		return nil, nil, cerror.ErrRequestForwardErr.FastGenByArgs()
+	}
+	info, err := s.capture.Info()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	ownerInfo, err := s.capture.GetOwnerCaptureInfo(ctx)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	dialOption, err := config.GetGlobalServerConfig().Security.ToGRPCDialOption()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	conn, err := grpc.DialContext(ctx, ownerInfo.AdvertiseAddr, dialOption)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	log.Debug("forward admin request to owner",
+		zap.String("captureID", info.ID),
+		zap.String("ownerAddr", ownerInfo.AdvertiseAddr))
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		forwardFromCaptureKey, info.ID, forwardedForKey, peerIP(ctx))
+	// The owner checks the credentials of the caller again.
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get(authorizationKey) {
+			ctx = metadata.AppendToOutgoingContext(ctx, authorizationKey, v)
+		}
+		for _, v := range md.Get(forwardedIdentityKey) {
+			ctx = metadata.AppendToOutgoingContext(ctx, forwardedIdentityKey, v)
+		}
+	}
+	return ctx, conn, nil
+}
+
+// forward handles the request by local if the capture is the owner,
+// otherwise the request is forwarded to the owner by remote.
+func forward[Req, Resp any](
+	ctx context.Context, s *Server, req Req,
+	local func(context.Context, Req) (Resp, error),
+	remote func(adminProto.CDCAdminClient, context.Context, Req, ...grpc.CallOption) (Resp, error),
+) (Resp, error) {
+	if s.capture.IsOwner() {
+		resp, err := local(ctx, req)
+		return resp, toGRPCError(err)
+	}
+	ctx, conn, err := s.dialOwner(ctx)
+	if err != nil {
+		var resp Resp
+		return resp, toGRPCError(err)
+	}
+	defer conn.Close()
+	markForwarded(ctx)
+	return remote(adminProto.NewCDCAdminClient(conn), ctx, req)
+}
+
+type forwardedFlagKey struct{}
+
+// withForwardedFlag returns a context carrying a flag, which is set if the
+// call is forwarded to the owner.
+func withForwardedFlag(ctx context.Context) (context.Context, *bool) {
+	forwarded := new(bool)
+	return context.WithValue(ctx, forwardedFlagKey{}, forwarded), forwarded
+}
+
+func markForwarded(ctx context.Context) {
+	if forwarded, ok := ctx.Value(forwardedFlagKey{}).(*bool); ok {
+		*forwarded = true
+	}
+}
+
+func toChangefeedID(namespace, id string) (model.ChangeFeedID, error) {
+	if namespace == "" {
+		namespace = model.DefaultNamespace
+	}
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return model.ChangeFeedID{}, cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid namespace: %s", namespace)
+	}
+	if err := model.ValidateChangefeedID(id); err != nil {
+		return model.ChangeFeedID{}, cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid changefeed_id: %s", id)
+	}
+	return model.ChangeFeedID{Namespace: namespace, ID: id}, nil
+}
+
+func toChangefeed(
+	id model.ChangeFeedID, info *model.ChangeFeedInfo, cfStatus *model.ChangeFeedStatus,
+) *adminProto.Changefeed {
+	cf := &adminProto.Changefeed{
+		Namespace:  id.Namespace,
+		Id:         id.ID,
+		UpstreamId: info.UpstreamID,
+		State:      string(info.State),
+	}
+	// if the state is normal, we shall not return the error info
+	// because changefeed will is retrying. errors will confuse the users
+	if info.State != model.StateNormal && info.Error != nil {
+		cf.Error = info.Error.Message
+	}
+	if cfStatus != nil {
+		cf.CheckpointTs = cfStatus.CheckpointTs
+		cf.ResolvedTs = cfStatus.ResolvedTs
+	}
+	return cf
+}
+
+// toGRPCError converts an error to a gRPC status error, so that clients can
+// tell errors apart by status codes.
+func toGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := cerror.GRPCStatusCode(err)
+	switch {
+	case cerror.ErrChangeFeedNotExists.Equal(err):
+		code = codes.NotFound
+	case cerror.ErrAPIInvalidParam.Equal(err):
+		code = codes.InvalidArgument
+	case cerror.ErrNotOwner.Equal(err):
+		code = codes.Unavailable
+	case cerror.ErrUnauthorized.Equal(err):
+		code = codes.Unauthenticated
+	case cerror.ErrPermissionDenied.Equal(err):
+		code = codes.PermissionDenied
+	case cerror.ErrAPIRateLimited.Equal(err):
+		code = codes.ResourceExhausted
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	adminProto "github.com/pingcap/tiflow/proto/admin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newOwnerServer(t *testing.T) (*Server, *mock_owner.MockStatusProvider) {
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	provider := mock_owner.NewMockStatusProvider(ctrl)
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "owner"}, nil).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient.EXPECT().GetClusterID().Return("cluster").AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	return NewServer(cp), provider
+}
+
+func TestListCaptures(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	provider.EXPECT().GetCaptures(gomock.Any()).Return([]*model.CaptureInfo{
+		{ID: "owner", AdvertiseAddr: "127.0.0.1:8300"},
+		{ID: "other", AdvertiseAddr: "127.0.0.1:8301"},
+	}, nil)
+	resp, err := s.ListCaptures(context.Background(), &adminProto.ListCapturesRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*adminProto.Capture{
+		{Id: "owner", IsOwner: true, Address: "127.0.0.1:8300", ClusterId: "cluster"},
+		{Id: "other", Address: "127.0.0.1:8301", ClusterId: "cluster"},
+	}, resp.Captures)
+}
+
+func TestListChangefeeds(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	cf1 := model.ChangeFeedID{Namespace: "ns1", ID: "cf1"}
+	cf2 := model.ChangeFeedID{Namespace: "ns1", ID: "cf2"}
+	cf3 := model.ChangeFeedID{Namespace: "ns2", ID: "cf3"}
+	provider.EXPECT().GetAllChangeFeedStatuses(gomock.Any()).Return(
+		map[model.ChangeFeedID]*model.ChangeFeedStatus{
+			cf1: {CheckpointTs: 1, ResolvedTs: 2},
+			cf3: {CheckpointTs: 3, ResolvedTs: 4},
+		}, nil).Times(2)
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).Return(
+		map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			cf1: {UpstreamID: 1, State: model.StateNormal},
+			cf2: {
+				UpstreamID: 1, State: model.StateFailed,
+				Error: &model.RunningError{Message: "fake"},
+			},
+			cf3: {UpstreamID: 2, State: model.StateStopped},
+		}, nil).Times(2)
+
+	resp, err := s.ListChangefeeds(context.Background(), &adminProto.ListChangefeedsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*adminProto.Changefeed{
+		{Namespace: "ns1", Id: "cf1", UpstreamId: 1, State: "normal", CheckpointTs: 1, ResolvedTs: 2},
+		{Namespace: "ns1", Id: "cf2", UpstreamId: 1, State: "failed", Error: "fake"},
+		{Namespace: "ns2", Id: "cf3", UpstreamId: 2, State: "stopped", CheckpointTs: 3, ResolvedTs: 4},
+	}, resp.Changefeeds)
+
+	resp, err = s.ListChangefeeds(context.Background(),
+		&adminProto.ListChangefeedsRequest{Namespace: "ns2"})
+	require.Nil(t, err)
+	require.Len(t, resp.Changefeeds, 1)
+	require.Equal(t, "cf3", resp.Changefeeds[0].Id)
+}
+
+func TestGetChangefeedErrors(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	_, err := s.GetChangefeed(context.Background(),
+		&adminProto.ChangefeedRequest{Id: "invalid/id"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	provider.EXPECT().GetChangeFeedInfo(gomock.Any(), model.DefaultChangeFeedID("cf")).
+		Return(nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs("cf"))
+	_, err = s.GetChangefeed(context.Background(),
+		&adminProto.ChangefeedRequest{Id: "cf"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListProcessors(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	provider.EXPECT().GetProcessors(gomock.Any()).Return([]*model.ProcInfoSnap{
+		{CfID: model.DefaultChangeFeedID("cf"), CaptureID: "owner"},
+	}, nil)
+	resp, err := s.ListProcessors(context.Background(), &adminProto.ListProcessorsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, []*adminProto.Processor{
+		{Namespace: model.DefaultNamespace, ChangefeedId: "cf", CaptureId: "owner"},
+	}, resp.Processors)
+}
+
+type mockWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*adminProto.Changefeed
+}
+
+func (m *mockWatchStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchStream) Send(cf *adminProto.Changefeed) error {
+	m.sent = append(m.sent, cf)
+	return nil
+}
+
+func TestWatchChangefeed(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	cfID := model.DefaultChangeFeedID("cf")
+	gomock.InOrder(
+		provider.EXPECT().GetChangeFeedInfo(gomock.Any(), cfID).
+			Return(&model.ChangeFeedInfo{State: model.StateNormal}, nil),
+		provider.EXPECT().GetChangeFeedStatus(gomock.Any(), cfID).
+			Return(&model.ChangeFeedStatus{CheckpointTs: 1}, nil),
+		provider.EXPECT().GetChangeFeedInfo(gomock.Any(), cfID).
+			Return(&model.ChangeFeedInfo{State: model.StateNormal}, nil),
+		provider.EXPECT().GetChangeFeedStatus(gomock.Any(), cfID).
+			Return(&model.ChangeFeedStatus{CheckpointTs: 2}, nil),
+		// The stream ends once the changefeed is removed.
+		provider.EXPECT().GetChangeFeedInfo(gomock.Any(), cfID).
+			Return(nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs("cf")),
+	)
+
+	stream := &mockWatchStream{ctx: context.Background()}
+	err := s.WatchChangefeed(&adminProto.WatchChangefeedRequest{Id: "cf", IntervalMs: 10}, stream)
+	require.Nil(t, err)
+	require.Len(t, stream.sent, 2)
+	require.Equal(t, uint64(1), stream.sent[0].CheckpointTs)
+	require.Equal(t, uint64(2), stream.sent[1].CheckpointTs)
+}
+
+func TestForwardOnlyOnce(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsOwner().Return(false).AnyTimes()
+	s := NewServer(cp)
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(forwardFromCaptureKey, "capture"))
+	_, err := s.ListCaptures(ctx, &adminProto.ListCapturesRequest{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ErrRequestForwardErr")
+}
@@ -0,0 +1,321 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/audit"
+	"github.com/pingcap/tiflow/pkg/auth"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	adminProto "github.com/pingcap/tiflow/proto/admin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	servicePrefix = "/admin.CDCAdmin/"
+	// authorizationKey is the gRPC metadata key of the credentials, which
+	// has the same format as the Authorization header of the HTTP API.
+	authorizationKey = "authorization"
+	// forwardedIdentityKey is the gRPC metadata key of the identity of the
+	// original caller of a forwarded request, which has the same format as
+	// the forwarded identity header of the HTTP API.
+	forwardedIdentityKey = "ticdc-forwarded-identity"
+)
+
+// methodPolicy is the role required to call a method of the admin service.
+type methodPolicy struct {
+	role auth.Role
+	// namespaced means the role is required in the namespace of the request,
+	// otherwise it's required in all namespaces.
+	namespaced bool
+}
+
+// methodPolicies are the same as the policies of the equivalent HTTP APIs,
+// the methods not listed are denied.
+var methodPolicies = map[string]methodPolicy{
+	servicePrefix + "ListCaptures":     {role: auth.RoleViewer},
+	servicePrefix + "ListProcessors":   {role: auth.RoleViewer},
+	servicePrefix + "ListChangefeeds":  {role: auth.RoleViewer, namespaced: true},
+	servicePrefix + "GetChangefeed":    {role: auth.RoleViewer, namespaced: true},
+	servicePrefix + "WatchChangefeed":  {role: auth.RoleViewer, namespaced: true},
+	servicePrefix + "PauseChangefeed":  {role: auth.RoleOperator, namespaced: true},
+	servicePrefix + "ResumeChangefeed": {role: auth.RoleOperator, namespaced: true},
+	servicePrefix + "RemoveChangefeed": {role: auth.RoleOperator, namespaced: true},
+}
+
+// auditedMethods are the mutating methods recorded to the audit log.
+var auditedMethods = map[string]struct{}{
+	servicePrefix + "PauseChangefeed":  {},
+	servicePrefix + "ResumeChangefeed": {},
+	servicePrefix + "RemoveChangefeed": {},
+}
+
+// Guard applies the access control, rate limits and audit log of the HTTP
+// API to the admin service, the methods of other services are not affected.
+type Guard struct {
+	authenticator *auth.Authenticator
+	limiter       *middleware.RateLimiter
+	logger        *audit.Logger
+}
+
+// NewGuard creates a Guard. All methods are allowed if the authenticator is
+// nil, nothing is limited if the limiter is nil, and nothing is recorded if
+// the logger is nil.
+func NewGuard(
+	authenticator *auth.Authenticator,
+	limiter *middleware.RateLimiter,
+	logger *audit.Logger,
+) *Guard {
+	return &Guard{authenticator: authenticator, limiter: limiter, logger: logger}
+}
+
+// UnaryServerInterceptor returns the interceptor of unary methods.
+func (g *Guard) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		identity, err := g.check(ctx, info.FullMethod, req)
+		if err != nil {
+			err = toGRPCError(err)
+			g.record(ctx, info.FullMethod, identity, start, err)
+			return nil, err
+		}
+		ctx, forwarded := withForwardedFlag(withIdentity(ctx, identity))
+		resp, err := handler(ctx, req)
+		// The owner records the forwarded call.
+		if !*forwarded {
+			g.record(ctx, info.FullMethod, identity, start, err)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor of streaming methods. The
+// request of a server streaming method is checked once it's received.
+func (g *Guard) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &guardedStream{ServerStream: ss, guard: g, method: info.FullMethod})
+	}
+}
+
+// guardedStream checks the first request received from a stream.
+type guardedStream struct {
+	grpc.ServerStream
+	guard  *Guard
+	method string
+	// ctx is the context returned by the check, it's nil before the first
+	// request is checked.
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *guardedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+// RecvMsg implements grpc.ServerStream.
+func (s *guardedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.ctx == nil {
+		ctx := s.ServerStream.Context()
+		identity, err := s.guard.check(ctx, s.method, m)
+		if err != nil {
+			return toGRPCError(err)
+		}
+		s.ctx = withIdentity(ctx, identity)
+	}
+	return nil
+}
+
+// check authenticates the caller, applies the rate limits and checks if the
+// caller has the role required by the method. It returns the identity of
+// the caller, which is nil if the access control is disabled or the caller
+// fails to be authenticated.
+func (g *Guard) check(
+	ctx context.Context, method string, req interface{},
+) (*auth.Identity, error) {
+	if g.authenticator == nil {
+		_, clientIP := forwardedFrom(ctx)
+		if scope := g.limiter.Allow("ip:" + clientIP); scope != "" {
+			return nil, cerror.ErrAPIRateLimited.GenWithStackByArgs(scope + " rate limit exceeded")
+		}
+		return nil, nil
+	}
+
+	identity, err := g.authenticator.Authenticate(ctx, toHTTPRequest(ctx))
+	if err != nil {
+		log.Warn("unauthorized admin request",
+			zap.String("method", method),
+			zap.String("ip", peerIP(ctx)),
+			zap.Error(err))
+		return nil, err
+	}
+	if scope := g.limiter.Allow("identity:" + identity.Name); scope != "" {
+		return identity, cerror.ErrAPIRateLimited.GenWithStackByArgs(scope + " rate limit exceeded")
+	}
+
+	policy, ok := methodPolicies[method]
+	if !ok {
+		return identity, cerror.ErrPermissionDenied.GenWithStackByArgs(method + " is not allowed")
+	}
+	namespace := auth.AllNamespaces
+	if policy.namespaced {
+		namespace = requestNamespace(req)
+	}
+	if !identity.Allows(policy.role, namespace) {
+		err := cerror.ErrPermissionDenied.GenWithStackByArgs(
+			identity.Name + " is not " + string(policy.role) + " of namespace " + namespace)
+		log.Warn("forbidden admin request",
+			zap.String("method", method),
+			zap.String("ip", peerIP(ctx)),
+			zap.Error(err))
+		return identity, err
+	}
+	return identity, nil
+}
+
+// record records a call of an audited method to the audit log.
+func (g *Guard) record(
+	ctx context.Context, method string,
+	identity *auth.Identity, start time.Time, err error,
+) {
+	if g.logger == nil {
+		return
+	}
+	if _, ok := auditedMethods[method]; !ok {
+		return
+	}
+	forwardedBy, sourceIP := forwardedFrom(ctx)
+	entry := audit.Entry{
+		Time:        start,
+		SourceIP:    sourceIP,
+		ForwardedBy: forwardedBy,
+		Method:      "GRPC",
+		Path:        method,
+		Status:      int(status.Code(err)),
+		Latency:     time.Since(start),
+	}
+	if identity != nil {
+		entry.Identity = identity.Name
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	g.logger.Record(entry)
+}
+
+// withIdentity returns a context whose metadata carries the identity of the
+// caller, which is forwarded to the owner if the capture is not the owner.
+// The identity sent by the caller is always overwritten.
+func withIdentity(ctx context.Context, identity *auth.Identity) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Delete(forwardedIdentityKey)
+	if identity != nil {
+		md.Set(forwardedIdentityKey, identity.Encode())
+	}
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// forwardedFrom returns the ID of the capture forwarding the call to the
+// owner, and the IP of the original caller. The capture ID is empty if the
+// call isn't forwarded.
+func forwardedFrom(ctx context.Context) (captureID string, clientIP string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get(forwardFromCaptureKey); len(v) != 0 {
+		captureID = v[0]
+		if ips := md.Get(forwardedForKey); len(ips) != 0 {
+			return captureID, ips[0]
+		}
+	}
+	return captureID, peerIP(ctx)
+}
+
+// requestNamespace returns the namespace a request operates in.
+func requestNamespace(req interface{}) string {
+	switch r := req.(type) {
+	case *adminProto.ListChangefeedsRequest:
+		// Listing the changefeeds of all namespaces is a cluster level call.
+		if r.Namespace == "" {
+			return auth.AllNamespaces
+		}
+		return r.Namespace
+	case interface{ GetNamespace() string }:
+		if namespace := r.GetNamespace(); namespace != "" {
+			return namespace
+		}
+	}
+	return model.DefaultNamespace
+}
+
+// toHTTPRequest converts the credentials of a gRPC call to an HTTP request,
+// so the caller is authenticated in the same way as the HTTP API.
+func toHTTPRequest(ctx context.Context) *http.Request {
+	r := &http.Request{Header: http.Header{}}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get(authorizationKey) {
+			r.Header.Add("Authorization", v)
+		}
+		for _, v := range md.Get(forwardedIdentityKey) {
+			r.Header.Add(auth.ForwardedIdentityHeader, v)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			r.TLS = &tlsInfo.State
+		}
+	}
+	return r
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
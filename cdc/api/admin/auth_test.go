@@ -0,0 +1,131 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/phayes/freeport"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/audit"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	adminProto "github.com/pingcap/tiflow/proto/admin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testTokenStore struct {
+	auth.TokenStore
+	tokens map[string]*auth.Token
+}
+
+func (s *testTokenStore) Verify(_ context.Context, secret string) (*auth.Token, error) {
+	if token, ok := s.tokens[secret]; ok {
+		return token, nil
+	}
+	return nil, cerror.ErrUnauthorized.GenWithStackByArgs("invalid token")
+}
+
+func TestGuard(t *testing.T) {
+	t.Parallel()
+
+	s, provider := newOwnerServer(t)
+	provider.EXPECT().GetCaptures(gomock.Any()).Return([]*model.CaptureInfo{}, nil)
+
+	store := &testTokenStore{tokens: map[string]*auth.Token{
+		"viewer.secret": {
+			Name:    "viewer",
+			Binding: auth.Binding{Role: auth.RoleViewer, Namespace: auth.AllNamespaces},
+		},
+	}}
+	auditConfig := config.NewDefaultAuditConfig()
+	auditConfig.Enable = true
+	auditConfig.Filename = t.TempDir() + "/audit.log"
+	logger, err := audit.NewLogger(auditConfig)
+	require.NoError(t, err)
+	defer logger.Close()
+	guard := NewGuard(
+		auth.NewAuthenticator(config.NewDefaultAuthConfig(), nil, store), nil, logger)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(guard.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(guard.StreamServerInterceptor()))
+	adminProto.RegisterCDCAdminServer(grpcServer, s)
+
+	addr := fmt.Sprintf("127.0.0.1:%d", freeport.GetPort())
+	lis, err := net.Listen("tcp", addr)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = grpcServer.Serve(lis)
+	}()
+	defer wg.Wait()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	client := adminProto.NewCDCAdminClient(conn)
+	ctx := context.Background()
+	viewerCtx := metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer viewer.secret")
+
+	// An unauthenticated call is rejected.
+	_, err = client.ListCaptures(ctx, &adminProto.ListCapturesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.ListCaptures(
+		metadata.AppendToOutgoingContext(ctx, authorizationKey, "Bearer bad.secret"),
+		&adminProto.ListCapturesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	stream, err := client.WatchChangefeed(ctx, &adminProto.WatchChangefeedRequest{Id: "cf"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A viewer can read but not operate changefeeds.
+	_, err = client.ListCaptures(viewerCtx, &adminProto.ListCapturesRequest{})
+	require.NoError(t, err)
+	_, err = client.PauseChangefeed(viewerCtx, &adminProto.ChangefeedRequest{Id: "cf"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Only the mutating calls are recorded.
+	entries := logger.Recent(0)
+	require.Len(t, entries, 1)
+	require.Equal(t, "token:viewer", entries[0].Identity)
+	require.Equal(t, servicePrefix+"PauseChangefeed", entries[0].Path)
+	require.Equal(t, int(codes.PermissionDenied), entries[0].Status)
+}
+
+func TestRequestNamespace(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, auth.AllNamespaces,
+		requestNamespace(&adminProto.ListChangefeedsRequest{}))
+	require.Equal(t, "ns1",
+		requestNamespace(&adminProto.ListChangefeedsRequest{Namespace: "ns1"}))
+	require.Equal(t, model.DefaultNamespace,
+		requestNamespace(&adminProto.ChangefeedRequest{Id: "cf"}))
+	require.Equal(t, "ns1",
+		requestNamespace(&adminProto.WatchChangefeedRequest{Namespace: "ns1", Id: "cf"}))
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/audit"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// maxAuditedBodySize is the max size of the body of an audited request,
+// the larger ones are rejected.
+const maxAuditedBodySize = 16 << 20
+
+// auditedReads are the routes recorded to the audit log although they're
+// read-only, since they return sensitive data.
+var auditedReads = map[string]struct{}{
+	// The exported specs contain the credentials in the sink URIs.
+	"/api/v2/changefeeds/export": {},
+}
+
+// AuditMiddleware records every mutating API call and sensitive read to the
+// audit log, including the ones rejected by the access control. Nothing is
+// recorded if the logger is nil, which means the audit log is disabled.
+//
+// A call forwarded to the owner is recorded by the owner only, with the IP
+// of its original caller, if the owner verifies the forwarding capture by
+// ForwardedMiddleware. Otherwise, it's recorded by both of them with the IP
+// of their callers.
+//
+// It must be used after ForwardedMiddleware and before AuthMiddleware, so the rejected calls are recorded
+// as well.
+func AuditMiddleware(logger *audit.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if logger == nil || !shouldAudit(c) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		forwardedBy, sourceIP := api.ForwardedFrom(c)
+		entry := audit.Entry{
+			Time:        start,
+			SourceIP:    sourceIP,
+			ForwardedBy: forwardedBy,
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.RequestURI(),
+		}
+		digest := sha256.New()
+		if c.Request.Body != nil {
+			body, err := io.ReadAll(
+				http.MaxBytesReader(c.Writer, c.Request.Body, maxAuditedBodySize))
+			_ = c.Request.Body.Close()
+			if err != nil {
+				err = errors.ErrAPIInvalidParam.GenWithStack(
+					"failed to read request body: %s", err.Error())
+				entry.Status = http.StatusRequestEntityTooLarge
+				entry.Error = err.Error()
+				entry.Latency = time.Since(start)
+				logger.Record(entry)
+				c.IndentedJSON(http.StatusRequestEntityTooLarge, model.NewHTTPError(err))
+				c.Abort()
+				return
+			}
+			digest.Write(body)
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		c.Next()
+
+		// The owner records the forwarded call since it verifies this capture.
+		if c.GetBool(api.ForwardedKey) {
+			return
+		}
+		entry.BodyDigest = hex.EncodeToString(digest.Sum(nil))
+		entry.Status = c.Writer.Status()
+		entry.Latency = time.Since(start)
+		if identity, ok := c.Get(IdentityKey); ok {
+			entry.Identity = identity.(*auth.Identity).Name
+		}
+		if err := c.Errors.Last(); err != nil {
+			entry.Error = err.Error()
+		}
+		logger.Record(entry)
+	}
+}
+
+func shouldAudit(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		_, ok := auditedReads[c.FullPath()]
+		return ok
+	default:
+		return true
+	}
+}
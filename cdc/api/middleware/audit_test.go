@@ -0,0 +1,145 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/pkg/audit"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultAuditConfig()
+	cfg.Enable = true
+	cfg.Filename = t.TempDir() + "/audit.log"
+	logger, err := audit.NewLogger(cfg)
+	require.NoError(t, err)
+	defer logger.Close()
+
+	router := gin.New()
+	router.Use(ForwardedMiddleware([]string{"cluster"}))
+	router.Use(AuditMiddleware(logger))
+	router.GET("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/test", func(c *gin.Context) {
+		// the body can still be read by the handler
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"a":1}`, string(body))
+		c.Status(http.StatusBadRequest)
+	})
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/test", nil)
+	require.Nil(t, err)
+	router.ServeHTTP(w, req)
+	require.Empty(t, logger.Recent(0))
+
+	w = httptest.NewRecorder()
+	req, err = http.NewRequestWithContext(context.Background(),
+		http.MethodPost, "/test?x=1", strings.NewReader(`{"a":1}`))
+	require.Nil(t, err)
+	router.ServeHTTP(w, req)
+	entries := logger.Recent(0)
+	require.Len(t, entries, 1)
+	digest := sha256.Sum256([]byte(`{"a":1}`))
+	require.Equal(t, hex.EncodeToString(digest[:]), entries[0].BodyDigest)
+	require.Equal(t, http.MethodPost, entries[0].Method)
+	require.Equal(t, "/test?x=1", entries[0].Path)
+	require.Equal(t, http.StatusBadRequest, entries[0].Status)
+
+	// the call received from the forwarding capture is recorded with the IP
+	// of the original caller.
+	w = httptest.NewRecorder()
+	req, err = http.NewRequestWithContext(context.Background(),
+		http.MethodPost, "/test", strings.NewReader(`{"a":1}`))
+	require.Nil(t, err)
+	req.Header.Set("TiCDC-ForwardFromCapture", "capture-1")
+	req.Header.Set(api.ForwardedForHeader, "10.0.0.1")
+	req.TLS = newClusterTLSState("cluster")
+	router.ServeHTTP(w, req)
+	entries = logger.Recent(1)
+	require.Equal(t, "capture-1", entries[0].ForwardedBy)
+	require.Equal(t, "10.0.0.1", entries[0].SourceIP)
+
+	// the forged forwarding headers are ignored.
+	for _, state := range []*tls.ConnectionState{nil, newClusterTLSState("other")} {
+		w = httptest.NewRecorder()
+		req, err = http.NewRequestWithContext(context.Background(),
+			http.MethodPost, "/test", strings.NewReader(`{"a":1}`))
+		require.Nil(t, err)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("TiCDC-ForwardFromCapture", "capture-1")
+		req.Header.Set(api.ForwardedForHeader, "10.0.0.1")
+		req.TLS = state
+		router.ServeHTTP(w, req)
+		entries = logger.Recent(1)
+		require.Empty(t, entries[0].ForwardedBy)
+		require.Equal(t, "192.0.2.1", entries[0].SourceIP)
+	}
+}
+
+func TestAuditMiddlewareSkipped(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultAuditConfig()
+	cfg.Enable = true
+	cfg.Filename = t.TempDir() + "/audit.log"
+	logger, err := audit.NewLogger(cfg)
+	require.NoError(t, err)
+	defer logger.Close()
+
+	router := gin.New()
+	router.Use(AuditMiddleware(logger))
+	router.GET("/api/v2/changefeeds/export", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/forward", func(c *gin.Context) {
+		c.Set(api.ForwardedKey, true)
+		c.Status(http.StatusOK)
+	})
+	router.POST("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+	do := func(method, path string, body io.Reader) int {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(), method, path, body)
+		require.Nil(t, err)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// the sensitive read is recorded.
+	require.Equal(t, http.StatusOK, do(http.MethodGet, "/api/v2/changefeeds/export", nil))
+	require.Len(t, logger.Recent(0), 1)
+	// the forwarded call is recorded by the owner only.
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/forward", nil))
+	require.Len(t, logger.Recent(0), 1)
+	// the oversized body is rejected.
+	body := strings.NewReader(strings.Repeat("a", maxAuditedBodySize+1))
+	require.Equal(t, http.StatusRequestEntityTooLarge, do(http.MethodPost, "/test", body))
+	entries := logger.Recent(0)
+	require.Len(t, entries, 2)
+	require.Equal(t, http.StatusRequestEntityTooLarge, entries[0].Status)
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdentityKey is the key of the authenticated identity in a gin.Context
+	IdentityKey = "identity"
+	// requiredRoleKey is the key of the role required by the route in a
+	// gin.Context
+	requiredRoleKey = "requiredRole"
+)
+
+// routePolicy is the role required to access the routes with the prefix.
+type routePolicy struct {
+	prefix string
+	// role is the required role, the role is decided by the http method if
+	// it's empty, the viewer role for GET and the operator role for others.
+	role auth.Role
+	// namespaced means the role is required in the namespace of the request,
+	// otherwise it's required in all namespaces.
+	namespaced bool
+	public     bool
+}
+
+// routePolicies are matched in order, the routes not matched are denied.
+var routePolicies = []routePolicy{
+	{prefix: "/status", public: true},
+	{prefix: "/metrics", public: true},
+	{prefix: "/swagger", public: true},
+	{prefix: "/api/v1/status", public: true},
+	{prefix: "/api/v1/health", public: true},
+	// The health report contains the lags and the errors of the changefeeds.
+	{prefix: "/api/v2/status/health", role: auth.RoleViewer},
+	{prefix: "/api/v2/status", public: true},
+	{prefix: "/api/v2/health", public: true},
+
+	{prefix: "/api/v1/log", role: auth.RoleAdmin},
+	{prefix: "/api/v1/owner", role: auth.RoleAdmin},
+	{prefix: "/api/v1/captures/drain", role: auth.RoleAdmin},
+	{prefix: "/api/v1/changefeeds", namespaced: true},
+	{prefix: "/api/v1"},
+
+	{prefix: "/api/v2/log", role: auth.RoleAdmin},
+	{prefix: "/api/v2/owner", role: auth.RoleAdmin},
+	{prefix: "/api/v2/unsafe", role: auth.RoleAdmin},
+	{prefix: "/api/v2/auth", role: auth.RoleAdmin},
+	{prefix: "/api/v2/audit", role: auth.RoleAdmin},
+	{prefix: "/api/v2/captures/:capture_id/drain", role: auth.RoleAdmin},
+	{prefix: "/api/v2/batch", role: auth.RoleOperator},
+	// The exported specs contain the credentials in the sink URIs.
+	{prefix: "/api/v2/changefeeds/export", role: auth.RoleAdmin},
+	{prefix: "/api/v2/changefeeds", namespaced: true},
+	{prefix: "/api/v2"},
+
+	{prefix: "/capture/owner", role: auth.RoleAdmin},
+	{prefix: "/admin", role: auth.RoleAdmin},
+	{prefix: "/debug", role: auth.RoleAdmin},
+}
+
+// AuthMiddleware authenticates the caller of an API and checks if it has
+// the role required by the API. All APIs are allowed if the authenticator
+// is nil, which means the access control is disabled.
+func AuthMiddleware(authenticator *auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authenticator == nil {
+			c.Next()
+			return
+		}
+		// The path is not found if it matches no route.
+		if c.FullPath() == "" {
+			c.Next()
+			return
+		}
+		policy, ok := matchRoutePolicy(c.FullPath())
+		if !ok {
+			err := errors.ErrPermissionDenied.GenWithStackByArgs(
+				c.FullPath() + " is not allowed")
+			c.IndentedJSON(http.StatusForbidden, model.NewHTTPError(err))
+			c.Abort()
+			return
+		}
+		if policy.public {
+			c.Next()
+			return
+		}
+
+		identity, err := authenticator.Authenticate(c.Request.Context(), c.Request)
+		if err != nil {
+			log.Warn("unauthorized api request",
+				zap.String("path", c.Request.URL.Path),
+				zap.String("ip", c.ClientIP()),
+				zap.Error(err))
+			c.IndentedJSON(http.StatusUnauthorized, model.NewHTTPError(err))
+			c.Abort()
+			return
+		}
+
+		role := policy.role
+		if role == "" {
+			role = auth.RoleOperator
+			if c.Request.Method == http.MethodGet {
+				role = auth.RoleViewer
+			}
+		}
+		namespace := auth.AllNamespaces
+		if policy.namespaced {
+			namespace = RequestNamespace(c)
+		}
+		c.Set(IdentityKey, identity)
+		c.Set(requiredRoleKey, role)
+		if !AuthorizeNamespace(c, namespace) {
+			return
+		}
+		// The owner authenticates a forwarded request as the original
+		// caller, the header sent by the caller is always overwritten.
+		c.Request.Header.Set(auth.ForwardedIdentityHeader, identity.Encode())
+		c.Next()
+	}
+}
+
+// RequestNamespace returns the namespace a request operates in, which is
+// checked by AuthMiddleware for the namespaced routes. The handlers of the
+// routes must build the changefeed IDs by it.
+func RequestNamespace(c *gin.Context) string {
+	if namespace := c.Query("namespace"); namespace != "" {
+		return namespace
+	}
+	return model.DefaultNamespace
+}
+
+// AuthorizeNamespace checks if the caller has the role required by the
+// route in another namespace, such as AllNamespaces for listing the
+// changefeeds of all namespaces. It aborts the request and returns false
+// if the caller doesn't.
+func AuthorizeNamespace(c *gin.Context, namespace string) bool {
+	v, ok := c.Get(IdentityKey)
+	if !ok {
+		// The access control is disabled.
+		return true
+	}
+	identity := v.(*auth.Identity)
+	role := c.MustGet(requiredRoleKey).(auth.Role)
+	if identity.Allows(role, namespace) {
+		return true
+	}
+	err := errors.ErrPermissionDenied.GenWithStackByArgs(
+		identity.Name + " is not " + string(role) + " of namespace " + namespace)
+	log.Warn("forbidden api request",
+		zap.String("path", c.Request.URL.Path),
+		zap.String("ip", c.ClientIP()),
+		zap.Error(err))
+	c.IndentedJSON(http.StatusForbidden, model.NewHTTPError(err))
+	c.Abort()
+	return false
+}
+
+func matchRoutePolicy(path string) (routePolicy, bool) {
+	for _, p := range routePolicies {
+		if strings.HasPrefix(path, p.prefix) {
+			return p, true
+		}
+	}
+	return routePolicy{}, false
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testTokenStore struct {
+	auth.TokenStore
+	tokens map[string]*auth.Token
+}
+
+func (s *testTokenStore) Verify(_ context.Context, secret string) (*auth.Token, error) {
+	if token, ok := s.tokens[secret]; ok {
+		return token, nil
+	}
+	return nil, cerror.ErrUnauthorized.GenWithStackByArgs("invalid token")
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	store := &testTokenStore{tokens: map[string]*auth.Token{
+		"viewer.secret": {
+			Name:    "viewer",
+			Binding: auth.Binding{Role: auth.RoleViewer, Namespace: auth.AllNamespaces},
+		},
+		"operator.secret": {
+			Name:    "operator",
+			Binding: auth.Binding{Role: auth.RoleOperator, Namespace: "ns1"},
+		},
+	}}
+	router := gin.New()
+	router.Use(AuthMiddleware(auth.NewAuthenticator(config.NewDefaultAuthConfig(), nil, store)))
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v2/health", handler)
+	router.GET("/api/v2/status", handler)
+	router.GET("/api/v2/status/health", handler)
+	router.GET("/api/v2/changefeeds", handler)
+	router.POST("/api/v2/changefeeds/:changefeed_id/pause", handler)
+	router.POST("/api/v2/owner/resign", handler)
+	router.GET("/unknown", handler)
+
+	cases := []struct {
+		method string
+		path   string
+		token  string
+		code   int
+	}{
+		{http.MethodGet, "/api/v2/health", "", http.StatusOK},
+		{http.MethodGet, "/api/v2/status", "", http.StatusOK},
+		// the health report of the changefeeds isn't public.
+		{http.MethodGet, "/api/v2/status/health", "", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v2/status/health", "viewer.secret", http.StatusOK},
+		{http.MethodGet, "/api/v2/changefeeds", "", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v2/changefeeds", "bad.secret", http.StatusUnauthorized},
+		{http.MethodGet, "/api/v2/changefeeds", "viewer.secret", http.StatusOK},
+		{http.MethodPost, "/api/v2/changefeeds/cf/pause", "viewer.secret", http.StatusForbidden},
+		{http.MethodPost, "/api/v2/changefeeds/cf/pause", "operator.secret", http.StatusForbidden},
+		{http.MethodPost, "/api/v2/changefeeds/cf/pause?namespace=ns1", "operator.secret", http.StatusOK},
+		{http.MethodPost, "/api/v2/owner/resign", "operator.secret", http.StatusForbidden},
+		// the routes without a policy are denied.
+		{http.MethodGet, "/unknown", "viewer.secret", http.StatusForbidden},
+		{http.MethodGet, "/not/found", "", http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req, err := http.NewRequestWithContext(ctx, tc.method, tc.path, nil)
+		require.Nil(t, err)
+		if tc.token != "" {
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+		}
+		router.ServeHTTP(w, req)
+		cancel()
+		require.Equal(t, tc.code, w.Code, "%s %s %s", tc.method, tc.path, tc.token)
+	}
+}
+
+func TestAuthMiddlewareDisabled(t *testing.T) {
+	t.Parallel()
+
+	router := gin.New()
+	router.Use(AuthMiddleware(nil))
+	router.POST("/api/v2/owner/resign", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(context.Background(),
+		http.MethodPost, "/api/v2/owner/resign", nil)
+	require.Nil(t, err)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuthMiddlewareNamespace(t *testing.T) {
+	t.Parallel()
+
+	store := &testTokenStore{tokens: map[string]*auth.Token{
+		"operator.secret": {
+			Name:    "operator",
+			Binding: auth.Binding{Role: auth.RoleOperator, Namespace: "ns1"},
+		},
+	}}
+	router := gin.New()
+	router.Use(AuthMiddleware(auth.NewAuthenticator(config.NewDefaultAuthConfig(), nil, store)))
+	// The handler operates in the namespace checked by the middleware, and
+	// the identity forwarded to the owner is the authenticated one.
+	router.POST("/api/v2/changefeeds/:changefeed_id/pause", func(c *gin.Context) {
+		identity, err := auth.DecodeIdentity(c.GetHeader(auth.ForwardedIdentityHeader))
+		require.NoError(t, err)
+		require.Equal(t, "token:operator", identity.Name)
+		c.String(http.StatusOK, RequestNamespace(c))
+	})
+	router.GET("/api/v2/changefeeds", func(c *gin.Context) {
+		if AuthorizeNamespace(c, auth.AllNamespaces) {
+			c.Status(http.StatusOK)
+		}
+	})
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(), method, path, nil)
+		require.Nil(t, err)
+		req.Header.Set("Authorization", "Bearer operator.secret")
+		req.Header.Set(auth.ForwardedIdentityHeader, "forged")
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request(http.MethodPost, "/api/v2/changefeeds/cf/pause?namespace=ns1")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "ns1", w.Body.String())
+	w = request(http.MethodPost, "/api/v2/changefeeds/cf/pause")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	// listing the changefeeds of all namespaces requires the role in all
+	// namespaces.
+	w = request(http.MethodGet, "/api/v2/changefeeds?namespace=ns1")
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
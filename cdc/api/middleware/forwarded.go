@@ -0,0 +1,49 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+)
+
+// ForwardedMiddleware verifies the forwarding headers of the requests
+// forwarded to the owner, they're trusted only if the request is sent with
+// a certificate whose common name is one of clusterCNs. The forwarded
+// requests can't be verified if clusterCNs is empty.
+//
+// It must be used before the other middlewares, which get the source of a
+// request by api.ForwardedFrom.
+func ForwardedMiddleware(clusterCNs []string) gin.HandlerFunc {
+	cns := make(map[string]struct{}, len(clusterCNs))
+	for _, cn := range clusterCNs {
+		cns[cn] = struct{}{}
+	}
+	return func(c *gin.Context) {
+		api.VerifyForwarded(c, isClusterRequest(c.Request, cns), len(cns) > 0)
+		c.Next()
+	}
+}
+
+// isClusterRequest returns true if the request is sent with a verified
+// certificate of the cluster.
+func isClusterRequest(r *http.Request, clusterCNs map[string]struct{}) bool {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return false
+	}
+	_, ok := clusterCNs[r.TLS.VerifiedChains[0][0].Subject.CommonName]
+	return ok
+}
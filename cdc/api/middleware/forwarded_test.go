@@ -0,0 +1,85 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/stretchr/testify/require"
+)
+
+func newClusterTLSState(commonName string) *tls.ConnectionState {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+}
+
+func TestForwardedMiddleware(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		captureID string
+		clientIP  string
+		header    string
+	}
+	serve := func(clusterCNs []string, state *tls.ConnectionState, headers map[string]string) result {
+		var res result
+		router := gin.New()
+		router.Use(ForwardedMiddleware(clusterCNs))
+		router.GET("/test", func(c *gin.Context) {
+			res.captureID, res.clientIP = api.ForwardedFrom(c)
+			res.header = c.GetHeader("TiCDC-ForwardFromCapture") + c.GetHeader(api.ForwardedForHeader)
+			c.Status(http.StatusOK)
+		})
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", nil)
+		require.Nil(t, err)
+		req.RemoteAddr = "192.0.2.1:1234"
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		req.TLS = state
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		return res
+	}
+	forwarded := map[string]string{
+		"TiCDC-ForwardFromCapture": "capture-1",
+		api.ForwardedForHeader:     "10.0.0.1",
+	}
+
+	// not forwarded
+	require.Equal(t, result{clientIP: "192.0.2.1"},
+		serve([]string{"cluster"}, newClusterTLSState("cluster"), nil))
+	// forwarded by a verified capture
+	require.Equal(t, result{captureID: "capture-1", clientIP: "10.0.0.1"},
+		serve([]string{"cluster"}, newClusterTLSState("cluster"), forwarded))
+	// the headers are removed if the caller isn't verified.
+	require.Equal(t, result{clientIP: "192.0.2.1"},
+		serve([]string{"cluster"}, newClusterTLSState("other"), forwarded))
+	require.Equal(t, result{clientIP: "192.0.2.1"},
+		serve([]string{"cluster"}, nil, forwarded))
+	require.Equal(t, result{clientIP: "192.0.2.1"},
+		serve(nil, newClusterTLSState("cluster"), forwarded))
+	require.Equal(t, result{clientIP: "192.0.2.1"},
+		serve([]string{"cluster"}, newClusterTLSState("cluster"),
+			map[string]string{api.ForwardedForHeader: "10.0.0.1"}))
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitScopeGlobal = "global"
+	rateLimitScopeClient = "client"
+)
+
+// RateLimiter limits the requests of all clients and of each client.
+type RateLimiter struct {
+	global *rate.Limiter
+	cfg    *config.RateLimitConfig
+
+	mu sync.Mutex
+	// clients is the limiters of the clients, keyed by clientKey.
+	clients *lru.Cache
+}
+
+// NewRateLimiter creates a RateLimiter, or returns nil if the config is nil
+// or disabled.
+func NewRateLimiter(cfg *config.RateLimitConfig) *RateLimiter {
+	if cfg == nil || !cfg.Enable {
+		return nil
+	}
+	l := &RateLimiter{cfg: cfg}
+	if cfg.GlobalQPS > 0 {
+		l.global = rate.NewLimiter(rate.Limit(cfg.GlobalQPS), cfg.GlobalBurst)
+	}
+	if cfg.ClientQPS > 0 {
+		// The error is returned only if the size is not positive, which is
+		// ensured by ValidateAndAdjust.
+		l.clients, _ = lru.New(cfg.MaxClients)
+	}
+	return l
+}
+
+// Allow returns the scope of the exceeded limit, or an empty string if the
+// request is allowed. A rejected request consumes no quota of any limit.
+// A nil RateLimiter allows everything.
+func (l *RateLimiter) Allow(client string) string {
+	if l == nil {
+		return ""
+	}
+	now := time.Now()
+	var reservation *rate.Reservation
+	if l.clients != nil {
+		l.mu.Lock()
+		var limiter *rate.Limiter
+		if v, ok := l.clients.Get(client); ok {
+			limiter = v.(*rate.Limiter)
+		} else {
+			limiter = rate.NewLimiter(rate.Limit(l.cfg.ClientQPS), l.cfg.ClientBurst)
+			l.clients.Add(client, limiter)
+		}
+		l.mu.Unlock()
+		if reservation = reserve(limiter, now); reservation == nil {
+			rateLimitedRequestCounter.WithLabelValues(rateLimitScopeClient).Inc()
+			return rateLimitScopeClient
+		}
+	}
+	if l.global != nil && reserve(l.global, now) == nil {
+		// Give the quota back to the client, since the request is rejected.
+		if reservation != nil {
+			reservation.CancelAt(now)
+		}
+		rateLimitedRequestCounter.WithLabelValues(rateLimitScopeGlobal).Inc()
+		return rateLimitScopeGlobal
+	}
+	return ""
+}
+
+// reserve reserves a token of the limiter if it's available now, otherwise
+// it returns nil and nothing is reserved.
+func reserve(limiter *rate.Limiter, now time.Time) *rate.Reservation {
+	r := limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return nil
+	}
+	if r.DelayFrom(now) > 0 {
+		r.CancelAt(now)
+		return nil
+	}
+	return r
+}
+
+// RateLimitMiddleware rejects the requests exceeding the rate limits with
+// 429 Too Many Requests. Nothing is limited if the config is nil or
+// disabled.
+//
+// It's used after AuthMiddleware, so a client is limited by its verified
+// identity rather than by the credentials it claims.
+func RateLimitMiddleware(cfg *config.RateLimitConfig) gin.HandlerFunc {
+	limiter := NewRateLimiter(cfg)
+	if limiter == nil {
+		return func(c *gin.Context) { c.Next() }
+	}
+	return func(c *gin.Context) {
+		// Health checks are never limited, otherwise the server might be
+		// killed by its orchestrator under heavy load.
+		if strings.HasSuffix(c.FullPath(), "/health") {
+			c.Next()
+			return
+		}
+		// A forwarded request has been limited by the forwarding capture.
+		if captureID, _ := api.ForwardedFrom(c); captureID != "" {
+			c.Next()
+			return
+		}
+		client := clientKey(c)
+		if scope := limiter.Allow(client); scope != "" {
+			err := errors.ErrAPIRateLimited.GenWithStackByArgs(
+				scope + " rate limit exceeded")
+			log.Debug("api request is rate limited",
+				zap.String("client", client),
+				zap.String("path", c.Request.URL.Path),
+				zap.Error(err))
+			c.Header("Retry-After", "1")
+			c.IndentedJSON(http.StatusTooManyRequests, model.NewHTTPError(err))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// clientKey identifies the client of a request by the identity verified by
+// AuthMiddleware, or by its IP address if the access control is disabled.
+func clientKey(c *gin.Context) string {
+	if identity, ok := c.Get(IdentityKey); ok {
+		return "identity:" + identity.(*auth.Identity).Name
+	}
+	return "ip:" + c.ClientIP()
+}
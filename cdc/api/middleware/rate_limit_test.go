@@ -0,0 +1,151 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.GlobalQPS = 0.001
+	cfg.GlobalBurst = 3
+	cfg.ClientQPS = 0.001
+	cfg.ClientBurst = 2
+	require.Nil(t, cfg.ValidateAndAdjust())
+
+	router := gin.New()
+	router.Use(testIdentityMiddleware)
+	router.Use(RateLimitMiddleware(cfg))
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v2/health", handler)
+	router.GET("/api/v2/changefeeds", handler)
+
+	request := func(path, identity string) int {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(),
+			http.MethodGet, path, nil)
+		require.Nil(t, err)
+		req.Header.Set(testIdentityHeader, identity)
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// the per-client limit
+	require.Equal(t, http.StatusOK, request("/api/v2/changefeeds", "a"))
+	require.Equal(t, http.StatusOK, request("/api/v2/changefeeds", "a"))
+	require.Equal(t, http.StatusTooManyRequests, request("/api/v2/changefeeds", "a"))
+	// the global limit
+	require.Equal(t, http.StatusOK, request("/api/v2/changefeeds", "b"))
+	require.Equal(t, http.StatusTooManyRequests, request("/api/v2/changefeeds", "c"))
+	// health checks are never limited
+	require.Equal(t, http.StatusOK, request("/api/v2/health", ""))
+}
+
+// testIdentityHeader is the header of the identity set by
+// testIdentityMiddleware, which stands for AuthMiddleware in the tests.
+const testIdentityHeader = "Test-Identity"
+
+func testIdentityMiddleware(c *gin.Context) {
+	if name := c.GetHeader(testIdentityHeader); name != "" {
+		c.Set(IdentityKey, &auth.Identity{Name: name})
+	}
+	c.Next()
+}
+
+func TestRateLimiterRejectedConsumesNothing(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.GlobalQPS = 0.001
+	cfg.GlobalBurst = 1
+	cfg.ClientQPS = 0.001
+	cfg.ClientBurst = 1
+	require.Nil(t, cfg.ValidateAndAdjust())
+	limiter := NewRateLimiter(cfg)
+
+	require.Empty(t, limiter.Allow("a"))
+	// the quota of b is given back since the global limit is exceeded.
+	require.Equal(t, rateLimitScopeGlobal, limiter.Allow("b"))
+	require.Equal(t, rateLimitScopeGlobal, limiter.Allow("b"))
+	require.Equal(t, rateLimitScopeClient, limiter.Allow("a"))
+}
+
+func TestRateLimitForwardedRequest(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.ClientQPS = 0.001
+	cfg.ClientBurst = 1
+	require.Nil(t, cfg.ValidateAndAdjust())
+
+	router := gin.New()
+	router.Use(ForwardedMiddleware([]string{"cluster"}))
+	router.Use(RateLimitMiddleware(cfg))
+	router.GET("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+	request := func(clientIP string, state *tls.ConnectionState) int {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(),
+			http.MethodGet, "/test", nil)
+		require.Nil(t, err)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("TiCDC-ForwardFromCapture", "capture-1")
+		req.Header.Set(api.ForwardedForHeader, clientIP)
+		req.TLS = state
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// the requests forwarded by a verified capture have been limited by the
+	// capture.
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, request("10.0.0.1", newClusterTLSState("cluster")))
+	}
+	// the forged forwarding headers don't give the caller a fresh quota.
+	require.Equal(t, http.StatusOK, request("10.0.0.1", nil))
+	require.Equal(t, http.StatusTooManyRequests, request("10.0.0.2", nil))
+	require.Equal(t, http.StatusTooManyRequests,
+		request("10.0.0.3", newClusterTLSState("other")))
+}
+
+func TestRateLimitMiddlewareDisabled(t *testing.T) {
+	t.Parallel()
+
+	router := gin.New()
+	router.Use(RateLimitMiddleware(config.NewDefaultRateLimitConfig()))
+	router.GET("/test", func(c *gin.Context) { c.Status(http.StatusOK) })
+	for i := 0; i < 500; i++ {
+		w := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(),
+			http.MethodGet, "/test", nil)
+		require.Nil(t, err)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+}
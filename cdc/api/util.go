@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 
@@ -38,13 +39,69 @@ var httpBadRequestError = []*errors.Error{
 	cerror.ErrChangeFeedNotExists, cerror.ErrTargetTsBeforeStartTs, cerror.ErrTableIneligible,
 	cerror.ErrFilterRuleInvalid, cerror.ErrChangefeedUpdateRefused, cerror.ErrMySQLConnectionError,
 	cerror.ErrMySQLInvalidConfig, cerror.ErrCaptureNotExist, cerror.ErrSchedulerRequestFailed,
+	cerror.ErrAuthTokenNotFound, cerror.ErrAuditLogDisabled, cerror.ErrTableNotReplicated,
+	cerror.ErrTableNotPaused, cerror.ErrPauseTableNotSupported, cerror.ErrInvalidErrorHandle,
 }
 
 const (
 	// forwardFromCapture is a header to be set when forwarding requests to owner
 	forwardFromCapture = "TiCDC-ForwardFromCapture"
+	// ForwardedForHeader is the header carrying the IP of the original
+	// caller of a request forwarded to the owner.
+	ForwardedForHeader = "TiCDC-Forwarded-For"
+	// ForwardedKey is the key in a gin.Context marking a request which has
+	// been forwarded to the owner, and is verified and handled as a
+	// forwarded request by the owner.
+	ForwardedKey = "forwarded"
+	// forwardedByKey and forwardedForKey are the keys in a gin.Context of
+	// the capture forwarding the request and the IP of its original caller,
+	// they're set only if the forwarding capture is verified.
+	forwardedByKey  = "forwardedBy"
+	forwardedForKey = "forwardedFor"
+	// forwardHopKey is the key in a gin.Context marking a request carrying
+	// the forwarding headers, verified or not, which is never forwarded.
+	forwardHopKey = "forwardHop"
+	// forwardVerifiableKey is the key in a gin.Context marking that the
+	// requests forwarded by this capture are verified by the owner.
+	forwardVerifiableKey = "forwardVerifiable"
 )
 
+// VerifyForwarded handles the forwarding headers of a request. They're
+// trusted only if verified is true, which means the request is sent with a
+// certificate of the cluster, otherwise any caller could forge the source
+// of its requests. The headers are removed from the request either way.
+// verifiable means the requests forwarded by this capture are verified by
+// the owner in the same way.
+func VerifyForwarded(c *gin.Context, verified bool, verifiable bool) {
+	c.Set(forwardVerifiableKey, verifiable)
+	captureID := c.GetHeader(forwardFromCapture)
+	clientIP := c.GetHeader(ForwardedForHeader)
+	if captureID == "" && clientIP == "" {
+		return
+	}
+	c.Request.Header.Del(forwardFromCapture)
+	c.Request.Header.Del(ForwardedForHeader)
+	c.Set(forwardHopKey, true)
+	if !verified || captureID == "" {
+		return
+	}
+	if clientIP == "" {
+		clientIP = c.ClientIP()
+	}
+	c.Set(forwardedByKey, captureID)
+	c.Set(forwardedForKey, clientIP)
+}
+
+// ForwardedFrom returns the ID of the capture forwarding the request to
+// the owner, and the IP of the original caller of the request. The capture
+// ID is empty if the request isn't forwarded by a verified capture.
+func ForwardedFrom(c *gin.Context) (captureID string, clientIP string) {
+	if captureID = c.GetString(forwardedByKey); captureID == "" {
+		return "", c.ClientIP()
+	}
+	return captureID, c.GetString(forwardedForKey)
+}
+
 // IsHTTPBadRequestError check if a error is a http bad request error
 func IsHTTPBadRequestError(err error) bool {
 	if err == nil {
@@ -150,11 +207,35 @@ func HandleOwnerScheduleTable(
 	}
 }
 
+// HandleOwnerPauseTables pauses or resumes the tables of a changefeed
+func HandleOwnerPauseTables(
+	ctx context.Context, capture capture.Capture,
+	changefeedID model.ChangeFeedID, tableIDs []model.TableID, resume bool,
+) error {
+	// Use buffered channel to prevent blocking owner.
+	done := make(chan error, 1)
+	o, err := capture.GetOwner()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if resume {
+		o.ResumeTables(changefeedID, tableIDs, done)
+	} else {
+		o.PauseTables(changefeedID, tableIDs, done)
+	}
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case err := <-done:
+		return errors.Trace(err)
+	}
+}
+
 // ForwardToOwner forwards an request to the owner
 func ForwardToOwner(c *gin.Context, p capture.Capture) {
 	ctx := c.Request.Context()
 	// every request can only forward to owner one time
-	if len(c.GetHeader(forwardFromCapture)) != 0 {
+	if c.GetBool(forwardHopKey) || len(c.GetHeader(forwardFromCapture)) != 0 {
 		_ = c.Error(cerror.ErrRequestForwardErr.FastGenByArgs())
 		return
 	}
@@ -199,6 +280,8 @@ func ForwardToOwner(c *gin.Context, p capture.Capture) {
 			req.Header.Add(k, vv)
 		}
 	}
+	req.Header.Set(forwardFromCapture, info.ID)
+	req.Header.Set(ForwardedForHeader, c.ClientIP())
 
 	// forward to owner
 	cli, err := httputil.NewClient(security)
@@ -211,6 +294,10 @@ func ForwardToOwner(c *gin.Context, p capture.Capture) {
 		_ = c.Error(err)
 		return
 	}
+	// The owner records the forwarded request if it verifies this capture.
+	if c.GetBool(forwardVerifiableKey) {
+		c.Set(ForwardedKey, true)
+	}
 
 	// write header
 	for k, values := range resp.Header {
@@ -224,6 +311,11 @@ func ForwardToOwner(c *gin.Context, p capture.Capture) {
 
 	// write response body
 	defer resp.Body.Close()
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		// flush every event of an event stream instead of buffering them
+		flushWriteTo(c, resp.Body)
+		return
+	}
 	_, err = bufio.NewReader(resp.Body).WriteTo(c.Writer)
 	if err != nil {
 		_ = c.Error(err)
@@ -231,6 +323,26 @@ func ForwardToOwner(c *gin.Context, p capture.Capture) {
 	}
 }
 
+func flushWriteTo(c *gin.Context, r io.Reader) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if _, err := c.Writer.Write(buf[:n]); err != nil {
+				_ = c.Error(err)
+				return
+			}
+			c.Writer.Flush()
+		}
+		if err != nil {
+			if err != io.EOF {
+				_ = c.Error(err)
+			}
+			return
+		}
+	}
+}
+
 // HandleOwnerDrainCapture schedule drain the target capture
 func HandleOwnerDrainCapture(
 	ctx context.Context, capture capture.Capture, captureID string,
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/owner"
+	"github.com/pingcap/tiflow/pkg/auth"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/logutil"
 	"github.com/pingcap/tiflow/pkg/retry"
@@ -45,6 +46,15 @@ const (
 	apiOpVarCaptureID = "capture_id"
 )
 
+// getChangefeedID returns the ID of the changefeed a request operates on,
+// whose namespace is the one checked by the access control.
+func getChangefeedID(c *gin.Context) model.ChangeFeedID {
+	return model.ChangeFeedID{
+		Namespace: middleware.RequestNamespace(c),
+		ID:        c.Param(apiOpVarChangefeedID),
+	}
+}
+
 // OpenAPI provides capture APIs.
 type OpenAPI struct {
 	capture capture.Capture
@@ -126,6 +136,10 @@ func RegisterOpenAPIRoutes(router *gin.Engine, api OpenAPI) {
 func (h *OpenAPI) ListChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 	state := c.Query(apiOpVarChangefeedState)
+	// The changefeeds of all namespaces are listed.
+	if !middleware.AuthorizeNamespace(c, auth.AllNamespaces) {
+		return
+	}
 	// get all changefeed status
 	statuses, err := h.statusProvider().GetAllChangeFeedStatuses(ctx)
 	if err != nil {
@@ -199,7 +213,7 @@ func (h *OpenAPI) ListChangefeed(c *gin.Context) {
 // @Router /api/v1/changefeeds/{changefeed_id} [get]
 func (h *OpenAPI) GetChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -282,6 +296,13 @@ func (h *OpenAPI) CreateChangefeed(c *gin.Context) {
 		_ = c.Error(cerror.ErrAPIInvalidParam.Wrap(err))
 		return
 	}
+	// The changefeeds are always created in the default namespace by the
+	// v1 API.
+	if namespace := middleware.RequestNamespace(c); namespace != model.DefaultNamespace {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"namespace %s is not supported by the v1 API", namespace))
+		return
+	}
 
 	upManager, err := h.capture.GetUpstreamManager()
 	if err != nil {
@@ -339,7 +360,7 @@ func (h *OpenAPI) CreateChangefeed(c *gin.Context) {
 func (h *OpenAPI) PauseChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -376,7 +397,7 @@ func (h *OpenAPI) PauseChangefeed(c *gin.Context) {
 // @Router	/api/v1/changefeeds/{changefeed_id}/resume [post]
 func (h *OpenAPI) ResumeChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -414,7 +435,7 @@ func (h *OpenAPI) ResumeChangefeed(c *gin.Context) {
 // @Router /api/v1/changefeeds/{changefeed_id} [put]
 func (h *OpenAPI) UpdateChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
@@ -476,7 +497,7 @@ func (h *OpenAPI) UpdateChangefeed(c *gin.Context) {
 // @Router	/api/v1/changefeeds/{changefeed_id} [delete]
 func (h *OpenAPI) RemoveChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -535,7 +556,7 @@ func (h *OpenAPI) RemoveChangefeed(c *gin.Context) {
 // @Router /api/v1/changefeeds/{changefeed_id}/tables/rebalance_table [post]
 func (h *OpenAPI) RebalanceTables(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
@@ -569,7 +590,7 @@ func (h *OpenAPI) RebalanceTables(c *gin.Context) {
 // @Router /api/v1/changefeeds/{changefeed_id}/tables/move_table [post]
 func (h *OpenAPI) MoveTable(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -635,7 +656,7 @@ func (h *OpenAPI) ResignOwner(c *gin.Context) {
 func (h *OpenAPI) GetProcessor(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
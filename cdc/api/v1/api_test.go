@@ -79,6 +79,26 @@ func (p *mockStatusProvider) GetAllTaskStatuses(ctx context.Context, changefeedI
 	return args.Get(0).(map[model.CaptureID]*model.TaskStatus), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetTableProgresses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableProgress, error) {
+	args := p.Called(ctx)
+	return args.Get(0).([]*model.TableProgress), args.Error(1)
+}
+
+func (p *mockStatusProvider) GetLagSLAStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.LagSLAStatus, error) {
+	args := p.Called(ctx)
+	return args.Get(0).(*model.LagSLAStatus), args.Error(1)
+}
+
+func (p *mockStatusProvider) GetEffectiveChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error) {
+	args := p.Called(ctx)
+	return args.Get(0).(*model.ChangeFeedInfo), args.Error(1)
+}
+
+func (p *mockStatusProvider) GetCaptureTableStats(ctx context.Context) (map[model.CaptureID]*model.CaptureTableStats, error) {
+	args := p.Called(ctx)
+	return args.Get(0).(map[model.CaptureID]*model.CaptureTableStats), args.Error(1)
+}
+
 func (p *mockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	args := p.Called(ctx)
 	return args.Get(0).([]*model.ProcInfoSnap), args.Error(1)
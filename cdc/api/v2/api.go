@@ -45,6 +45,7 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 
 	v2.GET("health", api.health)
 	v2.GET("status", api.serverStatus)
+	v2.GET("status/health", api.healthReport)
 	v2.POST("log", api.setLogLevel)
 
 	// changefeed apis
@@ -52,6 +53,9 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
 	changefeedGroup.GET("/:changefeed_id", api.getChangeFeed)
 	changefeedGroup.POST("", api.createChangefeed)
+	changefeedGroup.POST("/dry_run", api.dryRunChangefeed)
+	changefeedGroup.GET("/export", api.exportChangefeeds)
+	changefeedGroup.POST("/import", api.importChangefeeds)
 	changefeedGroup.GET("", api.listChangeFeeds)
 	changefeedGroup.PUT("/:changefeed_id", api.updateChangefeed)
 	changefeedGroup.DELETE("/:changefeed_id", api.deleteChangefeed)
@@ -59,6 +63,24 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.POST("/:changefeed_id/resume", api.resumeChangefeed)
 	changefeedGroup.POST("/:changefeed_id/pause", api.pauseChangefeed)
 	changefeedGroup.GET("/:changefeed_id/status", api.status)
+	changefeedGroup.GET("/:changefeed_id/status/stream", api.streamStatus)
+	changefeedGroup.GET("/:changefeed_id/tables", api.listTableProgresses)
+	changefeedGroup.GET("/:changefeed_id/ineligible_tables", api.listIneligibleTables)
+	changefeedGroup.POST("/:changefeed_id/tables/pause", api.pauseTables)
+	changefeedGroup.POST("/:changefeed_id/tables/resume", api.resumeTables)
+	changefeedGroup.GET("/:changefeed_id/lag_sla", api.getLagSLAStatus)
+	changefeedGroup.PATCH("/:changefeed_id/labels", api.updateChangefeedLabels)
+	changefeedGroup.POST("/:changefeed_id/handle_error", api.handleChangefeedError)
+	changefeedGroup.GET("/:changefeed_id/errors", api.listChangefeedErrors)
+	changefeedGroup.GET("/:changefeed_id/drift", api.getChangefeedDrift)
+	changefeedGroup.GET("/:changefeed_id/diagnosis", api.diagnoseChangefeed)
+
+	// batch changefeed apis
+	batchGroup := v2.Group("/batch/changefeeds")
+	batchGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
+	batchGroup.POST("/pause", api.batchPauseChangefeeds)
+	batchGroup.POST("/resume", api.batchResumeChangefeeds)
+	batchGroup.POST("/update", api.batchUpdateChangefeeds)
 
 	// capture apis
 	captureGroup := v2.Group("/captures")
@@ -72,6 +94,28 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	processorGroup.GET("/:changefeed_id/:capture_id", api.getProcessor)
 	processorGroup.GET("", api.listProcessors)
 
+	// resolved ts stall apis, which are served by the capture receiving the
+	// request instead of the owner, because the stall is only known by the
+	// capture replicating the table.
+	stallGroup := v2.Group("/resolved_ts_stall")
+	stallGroup.GET("/:changefeed_id/:table_id", api.getResolvedTsStall)
+
+	// resource usage api, which is served by the capture receiving the
+	// request, the owner collects it from all captures to list captures.
+	v2.GET("/resource_usage", api.getResourceUsage)
+
+	// sorter metrics apis, which are served by the capture receiving the
+	// request for the same reason as the resolved ts stall apis.
+	sorterGroup := v2.Group("/sorter_metrics")
+	sorterGroup.GET("/:changefeed_id/:table_id", api.getSorterMetrics)
+
+	// debug bundle apis, the capture bundle is served by the capture
+	// receiving the request, the owner collects it from all captures.
+	debugGroup := v2.Group("/debug")
+	debugGroup.GET("/capture_bundle", api.getCaptureBundle)
+	debugGroup.GET("/bundle",
+		middleware.ForwardToOwnerMiddleware(api.capture), api.getDebugBundle)
+
 	verifyTableGroup := v2.Group("/verify_table")
 	verifyTableGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
 	verifyTableGroup.POST("", api.verifyTable)
@@ -88,6 +132,13 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	unsafeGroup.Use(middleware.ForwardToOwnerMiddleware(api.capture))
 	ownerGroup.POST("/resign", api.resignOwner)
 
+	// auth apis
+	authGroup := v2.Group("/auth/tokens")
+	authGroup.GET("", api.listTokens)
+	authGroup.POST("", api.issueToken)
+	authGroup.POST("/:token_id/rotate", api.rotateToken)
+	authGroup.DELETE("/:token_id", api.revokeToken)
+
 	// common APIs
 	v2.POST("/tso", api.QueryTso)
 }
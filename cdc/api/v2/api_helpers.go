@@ -16,6 +16,7 @@ package v2
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/url"
 	"strings"
 	"time"
@@ -33,6 +34,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/version"
@@ -66,6 +68,18 @@ type APIV2Helpers interface {
 		kvStorage tidbkv.Storage,
 	) (*model.ChangeFeedInfo, error)
 
+	// dryRunChangefeedConfig verifies the changefeedConfig like
+	// verifyCreateChangefeedConfig without any side effect, and yield the
+	// effective changefeedInfo with the tables to replicate and warnings or error
+	dryRunChangefeedConfig(
+		ctx context.Context,
+		cfg *ChangefeedConfig,
+		pdClient pd.Client,
+		statusProvider owner.StatusProvider,
+		gcServiceID string,
+		kvStorage tidbkv.Storage,
+	) (*model.ChangeFeedInfo, []model.TableName, []string, error)
+
 	// verifyUpdateChangefeedConfig verifies the changefeed update config,
 	// and returns a pair of valid changefeedInfo & upstreamInfo
 	verifyUpdateChangefeedConfig(
@@ -117,6 +131,10 @@ type APIV2Helpers interface {
 		storage tidbkv.Storage, startTs uint64) (ineligibleTables,
 		eligibleTables []model.TableName, err error,
 	)
+
+	// probeSink wraps validator.Validate to increase testability
+	probeSink(ctx context.Context, sinkURI string,
+		replicaConfig *config.ReplicaConfig) error
 }
 
 // APIV2HelpersImpl is an implementation of AVIV2Helpers interface
@@ -132,9 +150,42 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 	ensureGCServiceID string,
 	kvStorage tidbkv.Storage,
 ) (*model.ChangeFeedInfo, error) {
+	info, _, _, err := verifyChangefeedConfig(ctx, cfg, pdClient, statusProvider,
+		ensureGCServiceID, kvStorage, false)
+	return info, err
+}
+
+// dryRunChangefeedConfig verifies ChangefeedConfig without setting the
+// service GC safepoint, and returns the changefeedInfo that would be created
+// with the tables to replicate and warnings.
+func (APIV2HelpersImpl) dryRunChangefeedConfig(
+	ctx context.Context,
+	cfg *ChangefeedConfig,
+	pdClient pd.Client,
+	statusProvider owner.StatusProvider,
+	gcServiceID string,
+	kvStorage tidbkv.Storage,
+) (*model.ChangeFeedInfo, []model.TableName, []string, error) {
+	return verifyChangefeedConfig(ctx, cfg, pdClient, statusProvider,
+		gcServiceID, kvStorage, true)
+}
+
+// verifyChangefeedConfig verifies ChangefeedConfig and returns a
+// changefeedInfo with the tables to replicate and warnings. The start ts is protected by a service GC
+// safepoint unless it's a dry run.
+func verifyChangefeedConfig(
+	ctx context.Context,
+	cfg *ChangefeedConfig,
+	pdClient pd.Client,
+	statusProvider owner.StatusProvider,
+	gcServiceID string,
+	kvStorage tidbkv.Storage,
+	dryRun bool,
+) (*model.ChangeFeedInfo, []model.TableName, []string, error) {
+	var warnings []string
 	// verify sinkURI
 	if cfg.SinkURI == "" {
-		return nil, cerror.ErrSinkURIInvalid.GenWithStackByArgs(
+		return nil, nil, nil, cerror.ErrSinkURIInvalid.GenWithStackByArgs(
 			"sink_uri is empty, cannot create a changefeed without sink_uri")
 	}
 
@@ -143,7 +194,7 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 		cfg.ID = uuid.New().String()
 	}
 	if err := model.ValidateChangefeedID(cfg.ID); err != nil {
-		return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+		return nil, nil, nil, cerror.ErrAPIInvalidParam.GenWithStack(
 			"invalid changefeed_id: %s", cfg.ID)
 	}
 	if cfg.Namespace == "" {
@@ -151,46 +202,56 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 	}
 
 	if err := model.ValidateNamespace(cfg.Namespace); err != nil {
-		return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+		return nil, nil, nil, cerror.ErrAPIInvalidParam.GenWithStack(
 			"invalid namespace: %s", cfg.Namespace)
 	}
 
 	cfStatus, err := statusProvider.GetChangeFeedStatus(ctx,
 		model.DefaultChangeFeedID(cfg.ID))
 	if err != nil && cerror.ErrChangeFeedNotExists.NotEqual(err) {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	if cfStatus != nil {
-		return nil, cerror.ErrChangeFeedAlreadyExists.GenWithStackByArgs(cfg.ID)
+		return nil, nil, nil, cerror.ErrChangeFeedAlreadyExists.GenWithStackByArgs(cfg.ID)
 	}
 
 	// verify start ts
 	if cfg.StartTs == 0 {
 		ts, logical, err := pdClient.GetTS(ctx)
 		if err != nil {
-			return nil, cerror.ErrPDEtcdAPIError.GenWithStackByArgs(
+			return nil, nil, nil, cerror.ErrPDEtcdAPIError.GenWithStackByArgs(
 				"fail to get ts from pd client")
 		}
 		cfg.StartTs = oracle.ComposeTS(ts, logical)
 	}
 
-	// Ensure the start ts is valid in the next 3600 seconds, aka 1 hour
-	const ensureTTL = 60 * 60
-	if err := gc.EnsureChangefeedStartTsSafety(
-		ctx,
-		pdClient,
-		ensureGCServiceID,
-		model.DefaultChangeFeedID(cfg.ID),
-		ensureTTL, cfg.StartTs); err != nil {
+	if dryRun {
+		err = gc.CheckChangefeedStartTsSafety(
+			ctx,
+			pdClient,
+			gcServiceID,
+			model.DefaultChangeFeedID(cfg.ID),
+			cfg.StartTs)
+	} else {
+		// Ensure the start ts is valid in the next 3600 seconds, aka 1 hour
+		const ensureTTL = 60 * 60
+		err = gc.EnsureChangefeedStartTsSafety(
+			ctx,
+			pdClient,
+			gcServiceID,
+			model.DefaultChangeFeedID(cfg.ID),
+			ensureTTL, cfg.StartTs)
+	}
+	if err != nil {
 		if !cerror.ErrStartTsBeforeGC.Equal(err) {
-			return nil, cerror.ErrPDEtcdAPIError.Wrap(err)
+			return nil, nil, nil, cerror.ErrPDEtcdAPIError.Wrap(err)
 		}
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// verify target ts
 	if cfg.TargetTs > 0 && cfg.TargetTs <= cfg.StartTs {
-		return nil, cerror.ErrTargetTsBeforeStartTs.GenWithStackByArgs(
+		return nil, nil, nil, cerror.ErrTargetTsBeforeStartTs.GenWithStackByArgs(
 			cfg.TargetTs, cfg.StartTs)
 	}
 
@@ -200,16 +261,16 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 	// verify replicaConfig
 	sinkURIParsed, err := url.Parse(cfg.SinkURI)
 	if err != nil {
-		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+		return nil, nil, nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
 	}
 	err = replicaCfg.ValidateAndAdjust(sinkURIParsed)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	if !replicaCfg.EnableOldValue {
 		sinkURIParsed, err := url.Parse(cfg.SinkURI)
 		if err != nil {
-			return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+			return nil, nil, nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
 		}
 
 		protocol := sinkURIParsed.Query().Get(config.ProtocolKey)
@@ -227,39 +288,67 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 					),
 				)
 				replicaCfg.EnableOldValue = true
+				warnings = append(warnings, fmt.Sprintf(
+					"old value is enabled as it is required by the protocol %s",
+					util.GetOrZero(replicaCfg.Sink.Protocol)))
 				break
 			}
 		}
 
 		if replicaCfg.ForceReplicate {
-			return nil, cerror.ErrOldValueNotEnabled.GenWithStackByArgs(
+			return nil, nil, nil, cerror.ErrOldValueNotEnabled.GenWithStackByArgs(
 				"if use force replicate, old value feature must be enabled")
 		}
+		if replicaCfg.IneligibleTablePolicy == config.IneligibleTablePolicyAppendOnly {
+			return nil, nil, nil, cerror.ErrOldValueNotEnabled.GenWithStackByArgs(
+				"if use the append-only ineligible table policy, " +
+					"old value feature must be enabled")
+		}
 	}
 	f, err := filter.NewFilter(replicaCfg, "")
 	if err != nil {
-		return nil, errors.Cause(err)
+		return nil, nil, nil, errors.Cause(err)
 	}
-	tableInfos, ineligibleTables, _, err := entry.VerifyTables(f, kvStorage, cfg.StartTs)
+	tableInfos, ineligibleTables, eligibleTables, err := entry.VerifyTables(f, kvStorage, cfg.StartTs)
 	if err != nil {
-		return nil, errors.Cause(err)
+		return nil, nil, nil, errors.Cause(err)
 	}
 	err = f.Verify(tableInfos)
 	if err != nil {
-		return nil, errors.Cause(err)
+		return nil, nil, nil, errors.Cause(err)
 	}
-	if !replicaCfg.ForceReplicate && !cfg.ReplicaConfig.IgnoreIneligibleTable {
-		if err != nil {
-			return nil, err
-		}
-		if len(ineligibleTables) != 0 {
-			return nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
-		}
+	transformer, err := transform.NewTransformer(replicaCfg.Transform)
+	if err != nil {
+		return nil, nil, nil, errors.Cause(err)
+	}
+	err = transformer.Verify(tableInfos)
+	if err != nil {
+		return nil, nil, nil, errors.Cause(err)
+	}
+	switch {
+	case len(ineligibleTables) == 0:
+	case replicaCfg.IneligibleTablePolicy == config.IneligibleTablePolicyFail,
+		replicaCfg.IneligibleTablePolicy == "" &&
+			!replicaCfg.ForceReplicate && !cfg.ReplicaConfig.IgnoreIneligibleTable:
+		return nil, nil, nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
+	case replicaCfg.IneligibleTablePolicy == config.IneligibleTablePolicyAppendOnly:
+		warnings = append(warnings, fmt.Sprintf(
+			"only the inserted rows of %d ineligible tables without a valid "+
+				"primary key or unique key will be replicated: %v",
+			len(ineligibleTables), ineligibleTables))
+	case !replicaCfg.ForceReplicate:
+		warnings = append(warnings, fmt.Sprintf(
+			"%d ineligible tables without a valid primary key or unique key "+
+				"will be ignored: %v", len(ineligibleTables), ineligibleTables))
+	}
+	tables := eligibleTables
+	if replicaCfg.ReplicateIneligibleTables() {
+		tables = append(tables, ineligibleTables...)
 	}
 
 	// verify sink
 	if err := validator.Validate(ctx, cfg.SinkURI, replicaCfg); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	return &model.ChangeFeedInfo{
@@ -274,7 +363,7 @@ func (APIV2HelpersImpl) verifyCreateChangefeedConfig(
 		State:          model.StateNormal,
 		CreatorVersion: version.ReleaseVersion,
 		Epoch:          owner.GenerateChangefeedEpoch(ctx, pdClient),
-	}, nil
+	}, tables, warnings, nil
 }
 
 // verifyUpstream verifies the upstream config before updating a changefeed
@@ -355,6 +444,16 @@ func (APIV2HelpersImpl) verifyUpdateChangefeedConfig(
 		return nil, nil, cerror.ErrChangefeedUpdateRefused.
 			GenWithStackByArgs(errors.Cause(err).Error())
 	}
+	transformer, err := transform.NewTransformer(newInfo.Config.Transform)
+	if err != nil {
+		return nil, nil, cerror.ErrChangefeedUpdateRefused.
+			GenWithStackByArgs(errors.Cause(err).Error())
+	}
+	err = transformer.Verify(tableInfos)
+	if err != nil {
+		return nil, nil, cerror.ErrChangefeedUpdateRefused.
+			GenWithStackByArgs(errors.Cause(err).Error())
+	}
 
 	if configUpdated || sinkURIUpdated {
 		log.Info("config or sink uri updated, check the compatibility",
@@ -530,3 +629,9 @@ func (h APIV2HelpersImpl) getVerfiedTables(replicaConfig *config.ReplicaConfig,
 		VerifyTables(f, storage, startTs)
 	return
 }
+
+func (h APIV2HelpersImpl) probeSink(ctx context.Context, sinkURI string,
+	replicaConfig *config.ReplicaConfig,
+) error {
+	return validator.Validate(ctx, sinkURI, replicaConfig)
+}
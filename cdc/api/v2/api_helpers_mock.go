@@ -57,6 +57,23 @@ func (mr *MockAPIV2HelpersMockRecorder) createTiStore(pdAddrs, credential interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createTiStore", reflect.TypeOf((*MockAPIV2Helpers)(nil).createTiStore), pdAddrs, credential)
 }
 
+// dryRunChangefeedConfig mocks base method.
+func (m *MockAPIV2Helpers) dryRunChangefeedConfig(ctx context.Context, cfg *ChangefeedConfig, pdClient client.Client, statusProvider owner.StatusProvider, gcServiceID string, kvStorage kv.Storage) (*model.ChangeFeedInfo, []model.TableName, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "dryRunChangefeedConfig", ctx, cfg, pdClient, statusProvider, gcServiceID, kvStorage)
+	ret0, _ := ret[0].(*model.ChangeFeedInfo)
+	ret1, _ := ret[1].([]model.TableName)
+	ret2, _ := ret[2].([]string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// dryRunChangefeedConfig indicates an expected call of dryRunChangefeedConfig.
+func (mr *MockAPIV2HelpersMockRecorder) dryRunChangefeedConfig(ctx, cfg, pdClient, statusProvider, gcServiceID, kvStorage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "dryRunChangefeedConfig", reflect.TypeOf((*MockAPIV2Helpers)(nil).dryRunChangefeedConfig), ctx, cfg, pdClient, statusProvider, gcServiceID, kvStorage)
+}
+
 // getEtcdClient mocks base method.
 func (m *MockAPIV2Helpers) getEtcdClient(pdAddrs []string, tlsConfig *tls.Config) (*v3.Client, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +120,20 @@ func (mr *MockAPIV2HelpersMockRecorder) getVerfiedTables(replicaConfig, storage,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getVerfiedTables", reflect.TypeOf((*MockAPIV2Helpers)(nil).getVerfiedTables), replicaConfig, storage, startTs)
 }
 
+// probeSink mocks base method.
+func (m *MockAPIV2Helpers) probeSink(ctx context.Context, sinkURI string, replicaConfig *config.ReplicaConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "probeSink", ctx, sinkURI, replicaConfig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// probeSink indicates an expected call of probeSink.
+func (mr *MockAPIV2HelpersMockRecorder) probeSink(ctx, sinkURI, replicaConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "probeSink", reflect.TypeOf((*MockAPIV2Helpers)(nil).probeSink), ctx, sinkURI, replicaConfig)
+}
+
 // verifyCreateChangefeedConfig mocks base method.
 func (m *MockAPIV2Helpers) verifyCreateChangefeedConfig(ctx context.Context, cfg *ChangefeedConfig, pdClient client.Client, statusProvider owner.StatusProvider, ensureGCServiceID string, kvStorage kv.Storage) (*model.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
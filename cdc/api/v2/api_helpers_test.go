@@ -91,6 +91,33 @@ func TestVerifyCreateChangefeedConfig(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestDryRunChangefeedConfig(t *testing.T) {
+	ctx := context.Background()
+	pdClient := &mockPDClient{}
+	helper := entry.NewSchemaTestHelper(t)
+	helper.Tk().MustExec("use test;")
+	helper.Tk().MustExec("create table t_no_pk (id int)")
+	storage := helper.Storage()
+	provider := &mockStatusProvider{}
+	h := &APIV2HelpersImpl{}
+
+	cfg := &ChangefeedConfig{
+		SinkURI:       "blackhole://",
+		ReplicaConfig: GetDefaultReplicaConfig(),
+	}
+	// ineligible tables are not allowed by default
+	_, _, _, err := h.dryRunChangefeedConfig(ctx, cfg, pdClient, provider, "en", storage)
+	require.True(t, cerror.ErrTableIneligible.Equal(err))
+
+	cfg.ReplicaConfig.IgnoreIneligibleTable = true
+	cfInfo, tables, warnings, err := h.dryRunChangefeedConfig(ctx, cfg, pdClient, provider, "en", storage)
+	require.Nil(t, err)
+	require.NotNil(t, cfInfo)
+	require.NotContains(t, tables, model.TableName{Schema: "test", Table: "t_no_pk"})
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "t_no_pk")
+}
+
 func TestVerifyUpdateChangefeedConfig(t *testing.T) {
 	ctx := context.Background()
 	cfg := &ChangefeedConfig{}
@@ -68,6 +68,9 @@ type mockStatusProvider struct {
 	taskStatus         map[model.CaptureID]*model.TaskStatus
 	changefeedInfos    map[model.ChangeFeedID]*model.ChangeFeedInfo
 	changefeedStatuses map[model.ChangeFeedID]*model.ChangeFeedStatus
+	tableProgresses    []*model.TableProgress
+	lagSLAStatus       *model.LagSLAStatus
+	effectiveInfo      *model.ChangeFeedInfo
 	err                error
 }
 
@@ -104,6 +107,30 @@ func (m *mockStatusProvider) GetAllTaskStatuses(
 	return m.taskStatus, m.err
 }
 
+// GetTableProgresses returns a list of mock table progresses.
+func (m *mockStatusProvider) GetTableProgresses(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) ([]*model.TableProgress, error) {
+	return m.tableProgresses, m.err
+}
+
+// GetLagSLAStatus returns a mock lag SLA status.
+func (m *mockStatusProvider) GetLagSLAStatus(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.LagSLAStatus, error) {
+	return m.lagSLAStatus, m.err
+}
+
+// GetEffectiveChangeFeedInfo returns a mock effective changefeed info.
+func (m *mockStatusProvider) GetEffectiveChangeFeedInfo(
+	ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangeFeedInfo, error) {
+	return m.effectiveInfo, m.err
+}
+
 // GetAllChangeFeedInfo returns a list of mock changefeed info.
 func (m *mockStatusProvider) GetAllChangeFeedInfo(_ context.Context) (
 	map[model.ChangeFeedID]*model.ChangeFeedInfo,
@@ -0,0 +1,67 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
+	"github.com/pingcap/tiflow/pkg/audit"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// RegisterAuditRoutes registers the routes of the audit log API, the
+// logger is nil if the audit log is disabled.
+func RegisterAuditRoutes(router *gin.Engine, logger *audit.Logger) {
+	auditGroup := router.Group("/api/v2/audit")
+	auditGroup.Use(middleware.LogMiddleware())
+	auditGroup.Use(middleware.ErrorHandleMiddleware())
+	auditGroup.GET("", listAuditEntries(logger))
+}
+
+// listAuditEntries lists the recent audit entries
+// @Summary List audit entries
+// @Description list the recent mutating API calls kept in memory, the newest
+// @Description first. The complete history is in the audit log file.
+// @Tags audit,v2
+// @Produce json
+// @Param limit query integer false "max number of entries to return"
+// @Success 200 {array} audit.Entry
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/audit [get]
+func listAuditEntries(logger *audit.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if logger == nil {
+			_ = c.Error(cerror.ErrAuditLogDisabled.GenWithStackByArgs())
+			return
+		}
+		limit := 0
+		if limitStr := c.Query(apiOpVarLimit); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 0 {
+				_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+					"invalid limit: %s", limitStr))
+				return
+			}
+		}
+		entries := logger.Recent(limit)
+		c.JSON(http.StatusOK, &ListResponse[audit.Entry]{
+			Total: len(entries),
+			Items: entries,
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const apiOpVarTokenID = "token_id"
+
+// tokenStore returns the store of the API tokens of the cluster.
+func (h *OpenAPIV2) tokenStore() auth.TokenStore {
+	etcdClient := h.capture.GetEtcdClient()
+	return auth.NewEtcdTokenStore(
+		etcdClient.GetEtcdClient().Unwrap(), etcdClient.GetClusterID())
+}
+
+// issueToken issues a new API token
+// @Summary Issue an API token
+// @Description issue an API token with a role, the secret of the token is
+// @Description only returned once.
+// @Tags auth,v2
+// @Accept json
+// @Produce json
+// @Param token body AuthTokenConfig true "token config"
+// @Success 200 {object} IssuedAuthToken
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/auth/tokens [post]
+func (h *OpenAPIV2) issueToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &AuthTokenConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if cfg.Name == "" {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("token name is empty"))
+		return
+	}
+	role, err := auth.ParseRole(cfg.Role)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	binding := auth.Binding{Role: role, Namespace: cfg.Namespace}
+	if binding.Namespace == "" {
+		binding.Namespace = auth.AllNamespaces
+	}
+
+	secret, token, err := h.tokenStore().Issue(ctx, cfg.Name, binding, tokenTTL(cfg))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("api token issued",
+		zap.String("id", token.ID),
+		zap.String("name", token.Name),
+		zap.Stringer("binding", token.Binding))
+	c.JSON(http.StatusOK, &IssuedAuthToken{AuthToken: toAPIToken(token), Secret: secret})
+}
+
+// rotateToken rotates the secret of an API token
+// @Summary Rotate an API token
+// @Description replace the secret of an API token, the old secret is
+// @Description rejected afterwards.
+// @Tags auth,v2
+// @Accept json
+// @Produce json
+// @Param token_id path string true "token ID"
+// @Param token body AuthTokenConfig false "token config, only the ttl is used"
+// @Success 200 {object} IssuedAuthToken
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/auth/tokens/{token_id}/rotate [post]
+func (h *OpenAPIV2) rotateToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &AuthTokenConfig{}
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(cfg); err != nil {
+			_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+			return
+		}
+	}
+	secret, token, err := h.tokenStore().Rotate(ctx, c.Param(apiOpVarTokenID), tokenTTL(cfg))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("api token rotated",
+		zap.String("id", token.ID),
+		zap.String("name", token.Name))
+	c.JSON(http.StatusOK, &IssuedAuthToken{AuthToken: toAPIToken(token), Secret: secret})
+}
+
+// revokeToken revokes an API token
+// @Summary Revoke an API token
+// @Description revoke an API token
+// @Tags auth,v2
+// @Produce json
+// @Param token_id path string true "token ID"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/auth/tokens/{token_id} [delete]
+func (h *OpenAPIV2) revokeToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param(apiOpVarTokenID)
+	if err := h.tokenStore().Revoke(ctx, id); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("api token revoked", zap.String("id", id))
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
+
+// listTokens lists all API tokens
+// @Summary List API tokens
+// @Description list all API tokens without their secrets
+// @Tags auth,v2
+// @Produce json
+// @Success 200 {array} AuthToken
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/auth/tokens [get]
+func (h *OpenAPIV2) listTokens(c *gin.Context) {
+	tokens, err := h.tokenStore().List(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	items := make([]AuthToken, 0, len(tokens))
+	for _, token := range tokens {
+		items = append(items, toAPIToken(token))
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].CreateTime.Before(items[j].CreateTime)
+	})
+	c.JSON(http.StatusOK, &ListResponse[AuthToken]{
+		Total: len(items),
+		Items: items,
+	})
+}
+
+// tokenTTL returns the ttl of a token, the default one in the server config
+// is used if it's not specified.
+func tokenTTL(cfg *AuthTokenConfig) time.Duration {
+	if cfg.TTL.duration > 0 {
+		return cfg.TTL.duration
+	}
+	if auth := config.GetGlobalServerConfig().Auth; auth != nil {
+		return time.Duration(auth.TokenTTL)
+	}
+	return 0
+}
+
+func toAPIToken(token *auth.Token) AuthToken {
+	res := AuthToken{
+		ID:         token.ID,
+		Name:       token.Name,
+		Role:       string(token.Binding.Role),
+		Namespace:  token.Binding.Namespace,
+		CreateTime: token.CreateTime,
+	}
+	if !token.ExpireTime.IsZero() {
+		expireTime := token.ExpireTime
+		res.ExpireTime = &expireTime
+	}
+	return res
+}
@@ -15,13 +15,18 @@ package v2
 
 import (
 	"net/http"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 )
 
-const apiOpVarCaptureID = "capture_id"
+const (
+	apiOpVarCaptureID = "capture_id"
+	apiOpVarDetail    = "detail"
+)
 
 // drainCapture remove all tables at the given capture.
 func (h *OpenAPIV2) drainCapture(c *gin.Context) {
@@ -85,11 +90,19 @@ func (h *OpenAPIV2) drainCapture(c *gin.Context) {
 // @Description list all captures in cdc cluster
 // @Tags capture,v2
 // @Produce json
+// @Param limit query integer false "max number of captures, unlimited by default"
+// @Param offset query integer false "number of captures to skip"
+// @Param detail query boolean false "whether to return the table and resource statistics"
 // @Success 200 {array} Capture
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/captures [get]
 func (h *OpenAPIV2) listCaptures(c *gin.Context) {
 	ctx := c.Request.Context()
+	page, err := parsePagination(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 	captureInfos, err := h.capture.StatusProvider().GetCaptures(ctx)
 	if err != nil {
 		_ = c.Error(err)
@@ -102,6 +115,23 @@ func (h *OpenAPIV2) listCaptures(c *gin.Context) {
 	}
 	ownerID := info.ID
 
+	// Paginate before collecting the statistics, so that only the captures
+	// in the page are asked for their resource usage.
+	sort.Slice(captureInfos, func(i, j int) bool {
+		return captureInfos[i].ID < captureInfos[j].ID
+	})
+	total := len(captureInfos)
+	captureInfos = paginate(captureInfos, page)
+
+	var stats map[model.CaptureID]*CaptureStats
+	if c.Query(apiOpVarDetail) == "true" {
+		stats, err = h.getCaptureStats(ctx, captureInfos, c.Request.Header)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
 	etcdClient := h.capture.GetEtcdClient()
 
 	captures := make([]Capture, 0, len(captureInfos))
@@ -113,10 +143,14 @@ func (h *OpenAPIV2) listCaptures(c *gin.Context) {
 				IsOwner:       isOwner,
 				AdvertiseAddr: c.AdvertiseAddr,
 				ClusterID:     etcdClient.GetClusterID(),
+				Version:       c.Version,
+				GitHash:       c.GitHash,
+				Labels:        c.Labels,
+				Stats:         stats[c.ID],
 			})
 	}
 	resp := &ListResponse[Capture]{
-		Total: len(captureInfos),
+		Total: total,
 		Items: captures,
 	}
 	c.JSON(http.StatusOK, resp)
@@ -18,7 +18,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
@@ -141,3 +145,143 @@ func TestListCaptures(t *testing.T) {
 		}
 	}
 }
+
+func TestListCapturesWithDetail(t *testing.T) {
+	t.Parallel()
+
+	usage := &ResourceUsage{MemoryUsage: 1024, Goroutines: 10, SortDirUsage: 512}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, resourceUsagePath, r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(usage)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	statusProvider.EXPECT().GetCaptures(gomock.Any()).Return([]*model.CaptureInfo{
+		{
+			ID:            "owner-id",
+			AdvertiseAddr: strings.TrimPrefix(server.URL, "http://"),
+			Version:       "v7.1.0",
+			Labels:        map[string]string{"zone": "z1"},
+		},
+		{
+			// unreachable
+			ID:            "capture-id",
+			AdvertiseAddr: "127.0.0.1:1",
+		},
+	}, nil)
+	statusProvider.EXPECT().GetCaptureTableStats(gomock.Any()).Return(
+		map[model.CaptureID]*model.CaptureTableStats{
+			"owner-id": {TableCount: 3, RegionCount: 10},
+		}, nil)
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "owner-id"}, nil)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient.EXPECT().GetClusterID().AnyTimes().Return("cdc-cluster-id")
+	cp.EXPECT().GetEtcdClient().AnyTimes().Return(etcdClient)
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/captures?detail=true", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	respCaptures := &ListResponse[Capture]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respCaptures))
+	require.Len(t, respCaptures.Items, 2)
+
+	// the items are sorted by ID
+	unreachable, owner := respCaptures.Items[0], respCaptures.Items[1]
+	require.Equal(t, "v7.1.0", owner.Version)
+	require.Equal(t, map[string]string{"zone": "z1"}, owner.Labels)
+	require.Equal(t, 3, owner.Stats.TableCount)
+	require.Equal(t, uint64(10), owner.Stats.RegionCount)
+	require.Equal(t, usage, owner.Stats.Resource)
+	require.Equal(t, 0, unreachable.Stats.TableCount)
+	require.Nil(t, unreachable.Stats.Resource)
+	require.NotEmpty(t, unreachable.Stats.ResourceError)
+}
+
+func TestListCapturesWithDetailPaginated(t *testing.T) {
+	t.Parallel()
+
+	usage := &ResourceUsage{MemoryUsage: 1024, Goroutines: 10}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(usage)
+	}))
+	defer server.Close()
+	// the captures out of the page must not be asked for the resource usage
+	outOfPage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request to a capture out of the page")
+	}))
+	defer outOfPage.Close()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	statusProvider.EXPECT().GetCaptures(gomock.Any()).Return([]*model.CaptureInfo{
+		{ID: "c", AdvertiseAddr: strings.TrimPrefix(outOfPage.URL, "http://")},
+		{ID: "b", AdvertiseAddr: strings.TrimPrefix(server.URL, "http://")},
+		{ID: "a", AdvertiseAddr: strings.TrimPrefix(outOfPage.URL, "http://")},
+	}, nil)
+	statusProvider.EXPECT().GetCaptureTableStats(gomock.Any()).Return(
+		map[model.CaptureID]*model.CaptureTableStats{}, nil)
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "a"}, nil)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient.EXPECT().GetClusterID().AnyTimes().Return("cdc-cluster-id")
+	cp.EXPECT().GetEtcdClient().AnyTimes().Return(etcdClient)
+
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/captures?detail=true&limit=1&offset=1", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	respCaptures := &ListResponse[Capture]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respCaptures))
+	require.Equal(t, 3, respCaptures.Total)
+	require.Len(t, respCaptures.Items, 1)
+	require.Equal(t, "b", respCaptures.Items[0].ID)
+	require.Equal(t, usage, respCaptures.Items[0].Stats.Resource)
+}
+
+func TestCollectResourceUsage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 100), 0o600))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 28), 0o600))
+	usage, err := collectResourceUsage(dir)
+	require.Nil(t, err)
+	require.Equal(t, uint64(128), usage.SortDirUsage)
+	require.Equal(t, dir, usage.SortDir)
+	require.Greater(t, usage.MemoryUsage, uint64(0))
+	require.Greater(t, usage.Goroutines, 0)
+
+	// the sort dir usage is cached
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "c"), make([]byte, 72), 0o600))
+	now := time.Now()
+	diskUsage, err := getSortDirUsage(dir, now)
+	require.Nil(t, err)
+	require.Equal(t, uint64(128), diskUsage)
+	diskUsage, err = getSortDirUsage(dir, now.Add(sortDirUsageTTL))
+	require.Nil(t, err)
+	require.Equal(t, uint64(200), diskUsage)
+
+	// the sort dir doesn't exist yet
+	usage, err = collectResourceUsage(filepath.Join(dir, "not-exist"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), usage.SortDirUsage)
+}
@@ -15,8 +15,11 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,14 +27,20 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher"
+	sinkutil "github.com/pingcap/tiflow/cdc/sink/util"
+	"github.com/pingcap/tiflow/pkg/auth"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/r3labs/diff"
 	"github.com/tikv/client-go/v2/oracle"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
@@ -42,8 +51,45 @@ const (
 	apiOpVarChangefeedState = "state"
 	// apiOpVarChangefeedID is the key of changefeed ID in HTTP API
 	apiOpVarChangefeedID = "changefeed_id"
+	// apiOpVarStatusStreamInterval is the key of the push interval of
+	// the changefeed status stream in HTTP API
+	apiOpVarStatusStreamInterval = "interval"
+	// apiOpVarUpsert is the key of the flag to create the changefeed if it
+	// doesn't exist when updating it in HTTP API
+	apiOpVarUpsert = "upsert"
+	// apiOpVarLevel is the key of the level of the errors of a changefeed
+	// in HTTP API
+	apiOpVarLevel = "level"
 )
 
+const (
+	defaultStatusStreamInterval = time.Second
+)
+
+// resolveNamespace fills the namespace in the body of a request with the
+// namespace checked by the access control, and rejects a different one.
+func resolveNamespace(c *gin.Context, namespace *string) error {
+	requested := middleware.RequestNamespace(c)
+	if *namespace == "" {
+		*namespace = requested
+		return nil
+	}
+	if *namespace != requested {
+		return cerror.ErrAPIInvalidParam.GenWithStack(
+			"namespace %s in the body doesn't match namespace %s", *namespace, requested)
+	}
+	return nil
+}
+
+// getChangefeedID returns the ID of the changefeed a request operates on,
+// whose namespace is the one checked by the access control.
+func getChangefeedID(c *gin.Context) model.ChangeFeedID {
+	return model.ChangeFeedID{
+		Namespace: middleware.RequestNamespace(c),
+		ID:        c.Param(apiOpVarChangefeedID),
+	}
+}
+
 // createChangefeed handles create changefeed request,
 // it returns the changefeed's changefeedInfo that it just created
 // CreateChangefeed creates a changefeed
@@ -64,11 +110,28 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	if err := resolveNamespace(c, &cfg.Namespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	info, err := h.doCreateChangefeed(ctx, cfg, false)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, info)
+}
+
+// doCreateChangefeed verifies the config and creates a changefeed, the
+// changefeed is created paused if paused is true, so that it never runs
+// before it's resumed.
+func (h *OpenAPIV2) doCreateChangefeed(
+	ctx context.Context, cfg *ChangefeedConfig, paused bool,
+) (*ChangeFeedInfo, error) {
 	if len(cfg.PDAddrs) == 0 {
 		up, err := getCaptureDefaultUpstream(h.capture)
 		if err != nil {
-			_ = c.Error(err)
-			return
+			return nil, err
 		}
 		cfg.PDConfig = getUpstreamPDConfig(up)
 	}
@@ -78,16 +141,14 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	defer cancel()
 	pdClient, err := h.helpers.getPDClient(timeoutCtx, cfg.PDAddrs, credential)
 	if err != nil {
-		_ = c.Error(cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err))
-		return
+		return nil, cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err)
 	}
 	defer pdClient.Close()
 
 	// verify tables todo: del kvstore
 	kvStorage, err := h.helpers.createTiStore(cfg.PDAddrs, credential)
 	if err != nil {
-		_ = c.Error(cerror.WrapError(cerror.ErrNewStore, err))
-		return
+		return nil, cerror.WrapError(cerror.ErrNewStore, err)
 	}
 	// We should not close kvStorage since all kvStorage in cdc is the same one.
 	// defer kvStorage.Close()
@@ -100,8 +161,11 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating),
 		kvStorage)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
+	}
+	if paused {
+		info.State = model.StateStopped
+		info.AdminJobType = model.AdminStop
 	}
 	needRemoveGCSafePoint := false
 	defer func() {
@@ -115,8 +179,8 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 			model.DefaultChangeFeedID(cfg.ID),
 		)
 		if err != nil {
-			_ = c.Error(err)
-			return
+			log.Warn("failed to undo ensuring changefeed start ts safety",
+				zap.String("id", cfg.ID), zap.Error(err))
 		}
 	}()
 	upstreamInfo := &model.UpstreamInfo{
@@ -130,30 +194,24 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	infoStr, err := info.Marshal()
 	if err != nil {
 		needRemoveGCSafePoint = true
-		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
-		return
+		return nil, cerror.WrapError(cerror.ErrAPIInvalidParam, err)
 	}
 
 	// cannot create changefeed if there are running lightning/restore tasks
 	tlsCfg, err := credential.ToTLSConfig()
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	cli, err := h.helpers.getEtcdClient(cfg.PDAddrs, tlsCfg)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 	err = hasRunningImport(ctx, cli)
 	if err != nil {
 		log.Error("failed to create changefeed", zap.Error(err))
-		_ = c.Error(
-			cerror.ErrUpstreamHasRunningImport.Wrap(err).
-				FastGenByArgs(info.UpstreamID),
-		)
-		return
+		return nil, cerror.ErrUpstreamHasRunningImport.Wrap(err).
+			FastGenByArgs(info.UpstreamID)
 	}
 
 	err = h.capture.GetEtcdClient().CreateChangefeedInfo(ctx,
@@ -162,16 +220,141 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		model.DefaultChangeFeedID(info.ID))
 	if err != nil {
 		needRemoveGCSafePoint = true
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	log.Info("Create changefeed successfully!",
 		zap.String("id", info.ID),
 		zap.String("changefeed", infoStr))
-	c.JSON(http.StatusOK, toAPIModel(info,
+	return toAPIModel(info,
 		info.StartTs, info.StartTs,
-		nil, true))
+		nil, true), nil
+}
+
+// dryRunChangefeed handles dry run changefeed request, it verifies the
+// changefeed config like creating a changefeed but creates nothing.
+// DryRunChangefeed verifies a changefeed config
+// @Summary Dry run changefeed
+// @Description verify a changefeed config and return the effective changefeed with warnings, nothing is created
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed body ChangefeedConfig true "changefeed config"
+// @Success 200 {object} ChangefeedDryRunResult
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/changefeeds/dry_run [post]
+func (h *OpenAPIV2) dryRunChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &ChangefeedConfig{ReplicaConfig: GetDefaultReplicaConfig()}
+
+	if err := c.BindJSON(&cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.PDAddrs) == 0 {
+		up, err := getCaptureDefaultUpstream(h.capture)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		cfg.PDConfig = getUpstreamPDConfig(up)
+	}
+	credential := cfg.PDConfig.toCredential()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	pdClient, err := h.helpers.getPDClient(timeoutCtx, cfg.PDAddrs, credential)
+	if err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err))
+		return
+	}
+	defer pdClient.Close()
+
+	kvStorage, err := h.helpers.createTiStore(cfg.PDAddrs, credential)
+	if err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrNewStore, err))
+		return
+	}
+	info, tables, warnings, err := h.helpers.dryRunChangefeedConfig(
+		ctx,
+		cfg,
+		pdClient,
+		h.capture.StatusProvider(),
+		h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating),
+		kvStorage)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	dryRunTables, err := toDryRunTables(info, tables)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tlsCfg, err := credential.ToTLSConfig()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	cli, err := h.helpers.getEtcdClient(cfg.PDAddrs, tlsCfg)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err := hasRunningImport(ctx, cli); err != nil {
+		warnings = append(warnings, fmt.Sprintf(
+			"the changefeed cannot be created now: %s", err.Error()))
+	}
+
+	c.JSON(http.StatusOK, &ChangefeedDryRunResult{
+		Info:     toAPIModel(info, info.StartTs, info.StartTs, nil, true),
+		Tables:   dryRunTables,
+		Warnings: warnings,
+	})
+}
+
+// toDryRunTables sorts the tables the changefeed would replicate, and
+// fills their dispatch targets if the sink is a MQ sink.
+func toDryRunTables(
+	info *model.ChangeFeedInfo, tables []model.TableName,
+) ([]DryRunTable, error) {
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Table < tables[j].Table
+	})
+
+	var router *dispatcher.EventRouter
+	sinkURI, err := url.Parse(info.SinkURI)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	}
+	if sink.IsMQScheme(sinkURI.Scheme) {
+		topic, err := sinkutil.GetTopic(sinkURI)
+		if err != nil {
+			return nil, err
+		}
+		router, err = dispatcher.NewEventRouter(info.Config, topic)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]DryRunTable, 0, len(tables))
+	for _, table := range tables {
+		t := DryRunTable{
+			Schema:  table.Schema,
+			Table:   table.Table,
+			TableID: table.TableID,
+		}
+		if router != nil {
+			t.Topic, t.PartitionRule = router.GetTargetForTable(table.Schema, table.Table)
+		}
+		result = append(result, t)
+	}
+	return result, nil
 }
 
 // hasRunningImport checks if there is running import tasks on the
@@ -216,12 +399,33 @@ func hasRunningImport(ctx context.Context, cli *clientv3.Client) error {
 // @Accept json
 // @Produce json
 // @Param state query string false "state"
+// @Param namespace query string false "namespace"
+// @Param keyword query string false "keyword in changefeed ID"
+// @Param sort_by query string false "id, checkpoint or lag, id by default"
+// @Param order query string false "asc or desc, asc by default"
+// @Param label_selector query string false "label selector, such as env=prod,team!=foo"
+// @Param limit query integer false "max number of changefeeds, unlimited by default"
+// @Param offset query integer false "number of changefeeds to skip"
 // @Success 200 {array} ChangefeedCommonInfo
-// @Failure 500 {object} model.HTTPError
+// @Failure 500,400 {object} model.HTTPError
 // @Router /api/v2/changefeeds [get]
 func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 	ctx := c.Request.Context()
 	state := c.Query(apiOpVarChangefeedState)
+	opts, err := parseChangefeedListOptions(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// The changefeeds of all namespaces are listed if no namespace is given.
+	if opts.namespace == "" && !middleware.AuthorizeNamespace(c, auth.AllNamespaces) {
+		return
+	}
+	page, err := parsePagination(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 	statuses, err := h.capture.StatusProvider().GetAllChangeFeedStatuses(ctx)
 	if err != nil {
 		_ = c.Error(err)
@@ -240,13 +444,6 @@ func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 	for cfID := range statuses {
 		changefeeds = append(changefeeds, cfID)
 	}
-	sort.Slice(changefeeds, func(i, j int) bool {
-		if changefeeds[i].Namespace == changefeeds[j].Namespace {
-			return changefeeds[i].ID < changefeeds[j].ID
-		}
-
-		return changefeeds[i].Namespace < changefeeds[j].Namespace
-	})
 
 	for _, cfID := range changefeeds {
 		cfInfo, exist := infos[cfID]
@@ -269,6 +466,9 @@ func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 			FeedState:    cfInfo.State,
 			RunningError: cfInfo.Error,
 		}
+		if cfInfo.Config != nil {
+			commonInfo.Labels = cfInfo.Config.Labels
+		}
 		// if the state is normal, we shall not return the error info
 		// because changefeed will is retrying. errors will confuse the users
 		if commonInfo.FeedState == model.StateNormal {
@@ -280,12 +480,16 @@ func (h *OpenAPIV2) listChangeFeeds(c *gin.Context) {
 			tm := oracle.GetTimeFromTS(cfStatus.CheckpointTs)
 			commonInfo.CheckpointTime = model.JSONTime(tm)
 		}
+		if !opts.match(commonInfo) {
+			continue
+		}
 
 		commonInfos = append(commonInfos, *commonInfo)
 	}
+	opts.sort(commonInfos)
 	resp := &ListResponse[ChangefeedCommonInfo]{
 		Total: len(commonInfos),
-		Items: commonInfos,
+		Items: paginate(commonInfos, page),
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -346,46 +550,74 @@ func (h *OpenAPIV2) verifyTable(c *gin.Context) {
 // SyncPointEnabled, SyncPointInterval
 // UpdateChangefeed updates a changefeed
 // @Summary Update a changefeed
-// @Description Update a changefeed
+// @Description Update a stopped or failed changefeed. The memory quota and the
+// @Description concurrency and batching parameters of the sink can also be
+// @Description updated when the changefeed is running, which are applied on the fly.
 // @Tags changefeed,v2
 // @Accept json
 // @Produce json
 // @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param upsert query boolean false "apply the config as the desired spec, see applyChangefeed"
 // @Param changefeedConfig body ChangefeedConfig true "changefeed config"
 // @Success 200 {object} ChangeFeedInfo
 // @Failure 500,400 {object} model.HTTPError
 // @Router /api/v2/changefeeds/{changefeed_id} [put]
 func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
+	if c.Query(apiOpVarUpsert) == "true" {
+		h.applyChangefeed(c)
+		return
+	}
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
 		return
 	}
 
-	oldCfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	info, err := h.doUpdateChangefeed(ctx, changefeedID,
+		func(*model.ChangeFeedInfo) (*ChangefeedConfig, error) {
+			updateCfConfig := &ChangefeedConfig{}
+			if err := c.BindJSON(updateCfConfig); err != nil {
+				return nil, cerror.WrapError(cerror.ErrAPIInvalidParam, err)
+			}
+			return updateCfConfig, nil
+		})
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+	c.JSON(http.StatusOK, info)
+}
+
+// doUpdateChangefeed updates the config of a stopped or failed changefeed,
+// or the runtime-mutable parameters of a running changefeed, which are
+// applied by the processors on the fly. The new config is got by getConfig
+// from the old info after the changefeed is checked.
+func (h *OpenAPIV2) doUpdateChangefeed(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+	getConfig func(oldInfo *model.ChangeFeedInfo) (*ChangefeedConfig, error),
+) (*ChangeFeedInfo, error) {
+	oldCfInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		return nil, err
+	}
 
+	running := false
 	switch oldCfInfo.State {
 	case model.StateStopped, model.StateFailed:
+	case model.StateNormal, model.StateError:
+		running = true
 	default:
-		_ = c.Error(
-			cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
-				"can only update changefeed config when it is stopped or failed",
-			),
+		return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can only update changefeed config when it is stopped or failed",
 		)
-		return
 	}
 
 	cfStatus, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	oldCfInfo.Namespace = changefeedID.Namespace
@@ -393,19 +625,16 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 	OldUpInfo, err := h.capture.GetEtcdClient().GetUpstreamInfo(ctx, oldCfInfo.UpstreamID,
 		oldCfInfo.Namespace)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
-	updateCfConfig := &ChangefeedConfig{}
-	if err = c.BindJSON(updateCfConfig); err != nil {
-		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
-		return
+	updateCfConfig, err := getConfig(oldCfInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	if err = h.helpers.verifyUpstream(ctx, updateCfConfig, oldCfInfo); err != nil {
-		_ = c.Error(errors.Trace(err))
-		return
+		return nil, errors.Trace(err)
 	}
 
 	log.Info("Old ChangeFeed and Upstream Info",
@@ -430,27 +659,68 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 
 	storage, err := h.helpers.createTiStore(pdAddrs, credentials)
 	if err != nil {
-		_ = c.Error(errors.Trace(err))
+		return nil, errors.Trace(err)
 	}
 	newCfInfo, newUpInfo, err := h.helpers.verifyUpdateChangefeedConfig(ctx,
 		updateCfConfig, oldCfInfo, OldUpInfo, storage, cfStatus.CheckpointTs)
 	if err != nil {
-		_ = c.Error(errors.Trace(err))
-		return
+		return nil, errors.Trace(err)
 	}
 
 	log.Info("New ChangeFeed and Upstream Info",
 		zap.String("changefeedInfo", newCfInfo.String()),
 		zap.Any("upstreamInfo", newUpInfo))
 
+	if running {
+		newCfInfo, err = h.updateRunningChangefeed(ctx, changefeedID,
+			oldCfInfo, newCfInfo, OldUpInfo, newUpInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return toAPIModel(newCfInfo,
+			cfStatus.ResolvedTs, cfStatus.CheckpointTs, nil, true), nil
+	}
+
 	err = h.capture.GetEtcdClient().
 		UpdateChangefeedAndUpstream(ctx, newUpInfo, newCfInfo, changefeedID)
 	if err != nil {
-		_ = c.Error(errors.Trace(err))
-		return
+		return nil, errors.Trace(err)
+	}
+	return toAPIModel(newCfInfo,
+		cfStatus.ResolvedTs, cfStatus.CheckpointTs, nil, true), nil
+}
+
+// updateRunningChangefeed updates the sink URI and the replica config of a
+// running changefeed, only the runtime-mutable parameters can be changed.
+// The other fields of the info are kept, since they can be modified by the
+// owner at the same time.
+func (h *OpenAPIV2) updateRunningChangefeed(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+	oldCfInfo, newCfInfo *model.ChangeFeedInfo,
+	oldUpInfo, newUpInfo *model.UpstreamInfo,
+) (*model.ChangeFeedInfo, error) {
+	ok, err := oldCfInfo.IsRuntimeMutableUpdate(newCfInfo)
+	if err != nil {
+		return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
+	}
+	if !ok || diff.Changed(oldUpInfo, newUpInfo) {
+		return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can only update the memory quota and the concurrency and batching " +
+				"parameters of the sink when the changefeed is running, " +
+				"pause the changefeed to update the others")
+	}
+	info, err := h.capture.GetEtcdClient().UpdateChangeFeedInfo(ctx, changefeedID,
+		func(info *model.ChangeFeedInfo) error {
+			info.SinkURI = newCfInfo.SinkURI
+			info.Config = newCfInfo.Config
+			return nil
+		})
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
-	c.JSON(http.StatusOK, toAPIModel(newCfInfo,
-		cfStatus.ResolvedTs, cfStatus.CheckpointTs, nil, true))
+	info.Namespace = changefeedID.Namespace
+	info.ID = changefeedID.ID
+	return info, nil
 }
 
 // getChangefeed get detailed info of a changefeed
@@ -465,7 +735,7 @@ func (h *OpenAPIV2) updateChangefeed(c *gin.Context) {
 // @Router /api/v2/changefeeds/{changefeed_id} [get]
 func (h *OpenAPIV2) getChangeFeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(
 			cerror.ErrAPIInvalidParam.GenWithStack(
@@ -532,7 +802,7 @@ func (h *OpenAPIV2) getChangeFeed(c *gin.Context) {
 // @Router	/api/v2/changefeeds/{changefeed_id} [delete]
 func (h *OpenAPIV2) deleteChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -586,7 +856,7 @@ func (h *OpenAPIV2) deleteChangefeed(c *gin.Context) {
 func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
@@ -645,7 +915,7 @@ func (h *OpenAPIV2) getChangeFeedMetaInfo(c *gin.Context) {
 // @Router	/api/v2/changefeeds/{changefeed_id}/resume [post]
 func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	err := model.ValidateChangefeedID(changefeedID.ID)
 	if err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
@@ -740,52 +1010,136 @@ func (h *OpenAPIV2) resumeChangefeed(c *gin.Context) {
 func (h *OpenAPIV2) pauseChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
 		return
 	}
+	if err := h.doPauseChangefeed(ctx, changefeedID); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
+
+func (h *OpenAPIV2) doPauseChangefeed(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) error {
 	// check if the changefeed exists
 	_, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return err
 	}
 
 	job := model.AdminJob{
 		CfID: changefeedID,
 		Type: model.AdminStop,
 	}
+	return api.HandleOwnerJob(ctx, h.capture, job)
+}
 
-	if err := api.HandleOwnerJob(ctx, h.capture, job); err != nil {
+func (h *OpenAPIV2) status(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	status, err := h.getChangefeedStatus(ctx, changefeedID)
+	if err != nil {
 		_ = c.Error(err)
 		return
 	}
-	c.JSON(http.StatusOK, &EmptyResponse{})
+	c.JSON(http.StatusOK, status)
 }
 
-func (h *OpenAPIV2) status(c *gin.Context) {
+// streamStatus handles the changefeed status stream request
+// StreamChangefeedStatus pushes the status of a changefeed as server-sent events
+// @Summary Stream the status of a changefeed
+// @Description Push the state, checkpoint ts and lag of a changefeed as `status`
+// @Description events periodically, and the last error of the changefeed as an
+// @Description `error` event once it occurs. A `close` event is pushed before the
+// @Description stream ends, e.g. the changefeed is removed.
+// @Tags changefeed,v2
+// @Produce text/event-stream
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param interval query integer false "push interval in milliseconds, default 1000"
+// @Success 200 {object} ChangefeedStatusEvent
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/status/stream [get]
+func (h *OpenAPIV2) streamStatus(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
 			changefeedID.ID))
 		return
 	}
-	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	interval := defaultStatusStreamInterval
+	if v := c.Query(apiOpVarStatusStreamInterval); v != "" {
+		ms, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || ms == 0 {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid interval: %s", v))
+			return
+		}
+		interval = time.Duration(ms) * time.Millisecond
+	}
+	// Return an error response instead of an empty stream if the changefeed
+	// does not exist.
+	status, err := h.getChangefeedStatus(ctx, changefeedID)
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastErrorTime time.Time
+	for {
+		c.SSEvent(StatusStreamEventStatus, &ChangefeedStatusEvent{
+			ChangefeedStatus: *status,
+			CheckpointLag: JSONDuration{
+				time.Since(oracle.GetTimeFromTS(status.CheckpointTs)),
+			},
+		})
+		if status.LastError != nil && status.LastError.Time.After(lastErrorTime) {
+			lastErrorTime = *status.LastError.Time
+			c.SSEvent(StatusStreamEventError, status.LastError)
+		}
+		c.Writer.Flush()
+
+		select {
+		case <-ctx.Done():
+			// The client is gone.
+			return
+		case <-ticker.C:
+		}
+		status, err = h.getChangefeedStatus(ctx, changefeedID)
+		if err != nil {
+			c.SSEvent(StatusStreamEventClose, model.NewHTTPError(err))
+			c.Writer.Flush()
+			return
+		}
+	}
+}
+
+func (h *OpenAPIV2) getChangefeedStatus(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) (*ChangefeedStatus, error) {
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	status, err := h.capture.StatusProvider().GetChangeFeedStatus(
 		ctx,
 		changefeedID,
 	)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, errors.Trace(err)
 	}
 	var lastError *RunningError
 	if info.Error != nil &&
@@ -808,13 +1162,13 @@ func (h *OpenAPIV2) status(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusOK, &ChangefeedStatus{
+	return &ChangefeedStatus{
 		State:        string(info.State),
 		CheckpointTs: status.CheckpointTs,
 		ResolvedTs:   status.ResolvedTs,
 		LastError:    lastError,
 		LastWarning:  lastWarning,
-	})
+	}, nil
 }
 
 func toAPIModel(
@@ -0,0 +1,325 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+)
+
+const (
+	// applyStopTimeout is the max time to wait for a running changefeed to
+	// be stopped before updating it.
+	applyStopTimeout      = 30 * time.Second
+	applyStopPollInterval = 200 * time.Millisecond
+)
+
+// applyChangefeed creates or updates a changefeed to match the submitted
+// spec. Only the fields differing from the spec are updated, and a running
+// changefeed is paused while it's updated and resumed afterwards. The
+// start_ts in the spec is only used when the changefeed is created.
+// ApplyChangefeed creates or updates a changefeed
+// @Summary Apply a changefeed spec
+// @Description Create or update a changefeed to match the spec, and report the changed fields
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param upsert query boolean true "must be true"
+// @Param changefeedConfig body ChangefeedConfig true "changefeed spec"
+// @Success 200 {object} ChangefeedApplyResult
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id} [put]
+func (h *OpenAPIV2) applyChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	spec := &ChangefeedConfig{ReplicaConfig: GetDefaultReplicaConfig()}
+	if err := c.BindJSON(spec); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if spec.ID == "" {
+		spec.ID = changefeedID.ID
+	} else if spec.ID != changefeedID.ID {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"changefeed_id %s in the spec doesn't match %s", spec.ID, changefeedID.ID))
+		return
+	}
+	if err := resolveNamespace(c, &spec.Namespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	oldInfo, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		if !cerror.ErrChangeFeedNotExists.Equal(err) {
+			_ = c.Error(err)
+			return
+		}
+		info, err := h.doCreateChangefeed(ctx, spec, false)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusOK, &ChangefeedApplyResult{
+			Action:     ChangefeedApplyCreated,
+			Changefeed: info,
+		})
+		return
+	}
+
+	oldUpInfo, err := h.capture.GetEtcdClient().GetUpstreamInfo(ctx,
+		oldInfo.UpstreamID, changefeedID.Namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	diff, update, err := diffChangefeedConfig(oldInfo, oldUpInfo, spec)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if len(diff) == 0 {
+		cfStatus, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusOK, &ChangefeedApplyResult{
+			Action: ChangefeedApplyUnchanged,
+			Changefeed: toAPIModel(oldInfo,
+				cfStatus.ResolvedTs, cfStatus.CheckpointTs, nil, true),
+		})
+		return
+	}
+
+	info, err := h.doApplyChangefeed(ctx, changefeedID, oldInfo.State, update)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("changefeed spec applied",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Any("diff", diff))
+	c.JSON(http.StatusOK, &ChangefeedApplyResult{
+		Action:     ChangefeedApplyUpdated,
+		Diff:       diff,
+		Changefeed: info,
+	})
+}
+
+// doApplyChangefeed updates a changefeed, a running changefeed is paused
+// before it's updated and resumed afterwards.
+func (h *OpenAPIV2) doApplyChangefeed(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+	state model.FeedState, update *ChangefeedConfig,
+) (*ChangeFeedInfo, error) {
+	needResume := false
+	switch state {
+	case model.StateStopped, model.StateFailed:
+	case model.StateNormal, model.StateError:
+		if err := h.doPauseChangefeed(ctx, changefeedID); err != nil {
+			return nil, err
+		}
+		if err := h.waitChangefeedStopped(ctx, changefeedID); err != nil {
+			return nil, err
+		}
+		needResume = true
+	default:
+		return nil, cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can not apply spec to a " + string(state) + " changefeed")
+	}
+
+	info, err := h.doUpdateChangefeed(ctx, changefeedID,
+		func(*model.ChangeFeedInfo) (*ChangefeedConfig, error) { return update, nil })
+	if needResume {
+		// Resume the changefeed even if the update fails, so it's not left
+		// paused by a failed apply.
+		if resumeErr := h.doResumeChangefeed(ctx, changefeedID); resumeErr != nil {
+			if err == nil {
+				err = resumeErr
+			}
+			log.Warn("failed to resume changefeed after applying spec",
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID),
+				zap.Error(resumeErr))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if needResume {
+		info.State = model.StateNormal
+	}
+	return info, nil
+}
+
+func (h *OpenAPIV2) waitChangefeedStopped(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, applyStopTimeout)
+	defer cancel()
+	ticker := time.NewTicker(applyStopPollInterval)
+	defer ticker.Stop()
+	for {
+		info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+		if err != nil {
+			return err
+		}
+		if info.State == model.StateStopped {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+				"timeout waiting for the changefeed to be stopped")
+		case <-ticker.C:
+		}
+	}
+}
+
+// diffChangefeedConfig compares a changefeed with the spec, and returns the
+// changed fields and the config to update the changefeed with, which only
+// contains the changed fields.
+func diffChangefeedConfig(
+	oldInfo *model.ChangeFeedInfo, oldUpInfo *model.UpstreamInfo,
+	spec *ChangefeedConfig,
+) ([]ChangefeedConfigDiff, *ChangefeedConfig, error) {
+	var diff []ChangefeedConfigDiff
+	update := &ChangefeedConfig{ID: spec.ID, Namespace: spec.Namespace}
+
+	if spec.SinkURI != "" && spec.SinkURI != oldInfo.SinkURI {
+		oldSinkURI, _ := util.MaskSinkURI(oldInfo.SinkURI)
+		newSinkURI, _ := util.MaskSinkURI(spec.SinkURI)
+		diff = append(diff, ChangefeedConfigDiff{
+			Field: "sink_uri", Old: oldSinkURI, New: newSinkURI,
+		})
+		update.SinkURI = spec.SinkURI
+	}
+	if spec.TargetTs != 0 && spec.TargetTs != oldInfo.TargetTs {
+		diff = append(diff, ChangefeedConfigDiff{
+			Field: "target_ts", Old: oldInfo.TargetTs, New: spec.TargetTs,
+		})
+		update.TargetTs = spec.TargetTs
+	}
+	if len(spec.PDAddrs) != 0 && oldUpInfo != nil &&
+		strings.Join(spec.PDAddrs, ",") != oldUpInfo.PDEndpoints {
+		diff = append(diff, ChangefeedConfigDiff{
+			Field: "pd_addrs",
+			Old:   strings.Split(oldUpInfo.PDEndpoints, ","),
+			New:   spec.PDAddrs,
+		})
+		update.PDConfig = spec.PDConfig
+	}
+
+	if spec.ReplicaConfig != nil {
+		// Adjust the spec like creating a changefeed, otherwise the fields
+		// filled in by the adjustment are always reported as changed.
+		sinkURI := spec.SinkURI
+		if sinkURI == "" {
+			sinkURI = oldInfo.SinkURI
+		}
+		sinkURIParsed, err := url.Parse(sinkURI)
+		if err != nil {
+			return nil, nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+		}
+		newCfg := spec.ReplicaConfig.ToInternalReplicaConfig()
+		if err := newCfg.ValidateAndAdjust(sinkURIParsed); err != nil {
+			return nil, nil, err
+		}
+		replicaDiff, err := diffJSON("replica_config",
+			ToAPIReplicaConfig(oldInfo.Config), ToAPIReplicaConfig(newCfg))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(replicaDiff) > 0 {
+			diff = append(diff, replicaDiff...)
+			update.ReplicaConfig = spec.ReplicaConfig
+		}
+	}
+	return diff, update, nil
+}
+
+// diffJSON returns the leaf fields differing between the json encodings of
+// two objects, arrays are compared as a whole.
+func diffJSON(prefix string, oldObj, newObj interface{}) ([]ChangefeedConfigDiff, error) {
+	oldFields := make(map[string]interface{})
+	newFields := make(map[string]interface{})
+	for _, item := range []struct {
+		obj    interface{}
+		fields map[string]interface{}
+	}{{oldObj, oldFields}, {newObj, newFields}} {
+		data, err := json.Marshal(item.obj)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+		}
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+		}
+		flattenJSON(prefix, v, item.fields)
+	}
+
+	var diff []ChangefeedConfigDiff
+	for field, newValue := range newFields {
+		oldValue := oldFields[field]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diff = append(diff, ChangefeedConfigDiff{
+				Field: field, Old: oldValue, New: newValue,
+			})
+		}
+	}
+	for field, oldValue := range oldFields {
+		if _, ok := newFields[field]; !ok {
+			diff = append(diff, ChangefeedConfigDiff{Field: field, Old: oldValue})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Field < diff[j].Field })
+	return diff, nil
+}
+
+func flattenJSON(prefix string, v interface{}, fields map[string]interface{}) {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		fields[prefix] = v
+		return
+	}
+	for k, child := range obj {
+		field := k
+		if prefix != "" {
+			field = prefix + "." + k
+		}
+		flattenJSON(field, child, fields)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAppliedChangefeedInfo(t *testing.T) *model.ChangeFeedInfo {
+	replicaConfig := config.GetDefaultReplicaConfig()
+	sinkURI, err := url.Parse(blackholeSink)
+	require.NoError(t, err)
+	require.NoError(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	return &model.ChangeFeedInfo{
+		Namespace:  changeFeedID.Namespace,
+		ID:         changeFeedID.ID,
+		UpstreamID: 1,
+		SinkURI:    blackholeSink,
+		State:      model.StateStopped,
+		Config:     replicaConfig,
+	}
+}
+
+func TestDiffChangefeedConfig(t *testing.T) {
+	t.Parallel()
+
+	oldInfo := newAppliedChangefeedInfo(t)
+	upInfo := &model.UpstreamInfo{PDEndpoints: "http://127.0.0.1:2379"}
+	spec := &ChangefeedConfig{
+		ID:            changeFeedID.ID,
+		SinkURI:       blackholeSink,
+		ReplicaConfig: GetDefaultReplicaConfig(),
+	}
+
+	// applying the same spec again changes nothing
+	diff, _, err := diffChangefeedConfig(oldInfo, upInfo, spec)
+	require.NoError(t, err)
+	require.Empty(t, diff)
+
+	spec.TargetTs = 100
+	spec.ReplicaConfig.ForceReplicate = true
+	spec.PDAddrs = []string{"http://127.0.0.1:2379"}
+	diff, update, err := diffChangefeedConfig(oldInfo, upInfo, spec)
+	require.NoError(t, err)
+	require.Equal(t, []ChangefeedConfigDiff{
+		{Field: "target_ts", Old: uint64(0), New: uint64(100)},
+		{Field: "replica_config.force_replicate", Old: false, New: true},
+	}, diff)
+	// only the changed fields are updated
+	require.Equal(t, "", update.SinkURI)
+	require.Equal(t, uint64(100), update.TargetTs)
+	require.Nil(t, update.PDAddrs)
+	require.True(t, update.ReplicaConfig.ForceReplicate)
+}
+
+func TestApplyChangefeed(t *testing.T) {
+	t.Parallel()
+	apply := testCase{url: "/api/v2/changefeeds/%s?upsert=true", method: "PUT"}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	statusProvider := &mockStatusProvider{
+		changefeedInfo:   newAppliedChangefeedInfo(t),
+		changefeedStatus: &model.ChangeFeedStatus{CheckpointTs: 1},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	etcdClient.EXPECT().GetUpstreamInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&model.UpstreamInfo{}, nil).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+
+	// case 1: the changefeed ID in the spec doesn't match the path
+	spec := &ChangefeedConfig{ID: "other", SinkURI: blackholeSink}
+	body, err := json.Marshal(spec)
+	require.Nil(t, err)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), apply.method,
+		fmt.Sprintf(apply.url, changeFeedID.ID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: unchanged
+	spec.ID = ""
+	body, err = json.Marshal(spec)
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), apply.method,
+		fmt.Sprintf(apply.url, changeFeedID.ID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ChangefeedApplyResult{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, ChangefeedApplyUnchanged, resp.Action)
+	require.Empty(t, resp.Diff)
+	require.Equal(t, changeFeedID.ID, resp.Changefeed.ID)
+}
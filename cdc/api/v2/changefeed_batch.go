@@ -0,0 +1,279 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// batchOperationConcurrency is the max number of changefeeds operated
+// concurrently in a batch.
+const batchOperationConcurrency = 16
+
+// batchPauseChangefeeds pauses changefeeds in a batch
+// @Summary Pause changefeeds in a batch
+// @Description Pause the changefeeds selected by IDs, namespace or labels
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param selector body ChangefeedSelector true "changefeed selector"
+// @Success 200 {object} ListResponse[ChangefeedOperationResult]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/batch/changefeeds/pause [post]
+func (h *OpenAPIV2) batchPauseChangefeeds(c *gin.Context) {
+	h.batchOperateChangefeeds(c, h.doPauseChangefeed)
+}
+
+// batchResumeChangefeeds resumes changefeeds in a batch
+// @Summary Resume changefeeds in a batch
+// @Description Resume the changefeeds selected by IDs, namespace or labels
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param selector body ChangefeedSelector true "changefeed selector"
+// @Success 200 {object} ListResponse[ChangefeedOperationResult]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/batch/changefeeds/resume [post]
+func (h *OpenAPIV2) batchResumeChangefeeds(c *gin.Context) {
+	h.batchOperateChangefeeds(c, h.doResumeChangefeed)
+}
+
+// batchUpdateChangefeeds updates changefeeds in a batch
+// @Summary Update changefeeds in a batch
+// @Description Merge a patch into the config of the changefeeds selected by IDs,
+// @Description namespace or labels, the changefeeds must be stopped or failed
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param config body BatchUpdateChangefeedConfig true "selector and config patch"
+// @Success 200 {object} ListResponse[ChangefeedOperationResult]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/batch/changefeeds/update [post]
+func (h *OpenAPIV2) batchUpdateChangefeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Unknown fields are rejected, so the settings of a single changefeed,
+	// such as its sink URI, can't be written to all the changefeeds.
+	cfg := &BatchUpdateChangefeedConfig{}
+	if err := decodeStrictJSON(c.Request.Body, cfg); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cfg.Patch == nil || (cfg.Patch.TargetTs == 0 && len(cfg.Patch.ReplicaConfig) == 0) {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("patch is required"))
+		return
+	}
+	if len(cfg.Patch.ReplicaConfig) != 0 {
+		if err := decodeStrictJSON(
+			bytes.NewReader(cfg.Patch.ReplicaConfig), &ReplicaConfig{}); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+	changefeeds, err := h.selectChangefeeds(ctx, &cfg.Selector)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	results := runBatch(ctx, changefeeds,
+		func(ctx context.Context, changefeedID model.ChangeFeedID) error {
+			_, err := h.doUpdateChangefeed(ctx, changefeedID, cfg.Patch.apply)
+			return err
+		})
+	c.JSON(http.StatusOK, results)
+}
+
+// apply merges the patch into the config of a changefeed, and returns the
+// config to update the changefeed with.
+func (p *ChangefeedConfigPatch) apply(info *model.ChangeFeedInfo) (*ChangefeedConfig, error) {
+	cfg := &ChangefeedConfig{TargetTs: p.TargetTs}
+	if len(p.ReplicaConfig) != 0 {
+		replicaConfig := GetDefaultReplicaConfig()
+		if info.Config != nil {
+			replicaConfig = ToAPIReplicaConfig(info.Config)
+		}
+		if err := decodeStrictJSON(bytes.NewReader(p.ReplicaConfig), replicaConfig); err != nil {
+			return nil, err
+		}
+		cfg.ReplicaConfig = replicaConfig
+	}
+	return cfg, nil
+}
+
+// decodeStrictJSON decodes the JSON into v, unknown fields are rejected.
+func decodeStrictJSON(r io.Reader, v interface{}) error {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return cerror.WrapError(cerror.ErrAPIInvalidParam, err)
+	}
+	return nil
+}
+
+func (h *OpenAPIV2) batchOperateChangefeeds(
+	c *gin.Context,
+	operate func(ctx context.Context, changefeedID model.ChangeFeedID) error,
+) {
+	ctx := c.Request.Context()
+
+	selector := &ChangefeedSelector{}
+	if err := c.BindJSON(selector); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	changefeeds, err := h.selectChangefeeds(ctx, selector)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, runBatch(ctx, changefeeds, operate))
+}
+
+// doResumeChangefeed resumes a changefeed from its checkpoint, overwriting
+// the checkpoint ts is not supported in batches.
+func (h *OpenAPIV2) doResumeChangefeed(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) error {
+	_, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		return err
+	}
+
+	job := model.AdminJob{
+		CfID: changefeedID,
+		Type: model.AdminResume,
+	}
+	return api.HandleOwnerJob(ctx, h.capture, job)
+}
+
+// selectChangefeeds returns the changefeeds selected by the selector. The
+// changefeeds selected by IDs are returned even if they don't exist, so that
+// they can be reported in the results.
+func (h *OpenAPIV2) selectChangefeeds(
+	ctx context.Context, selector *ChangefeedSelector,
+) ([]model.ChangeFeedID, error) {
+	if len(selector.IDs) == 0 && selector.Namespace == "" && selector.LabelSelector == "" {
+		return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+			"at least one of ids, namespace and label_selector is required")
+	}
+	labelSelector, err := parseLabelSelector(selector.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	if selector.Namespace != "" {
+		if err := model.ValidateNamespace(selector.Namespace); err != nil {
+			return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid namespace: %s", selector.Namespace)
+		}
+	}
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	matchLabels := func(info *model.ChangeFeedInfo) bool {
+		var labels map[string]string
+		if info.Config != nil {
+			labels = info.Config.Labels
+		}
+		return labelSelector.matches(labels)
+	}
+
+	var changefeeds []model.ChangeFeedID
+	if len(selector.IDs) > 0 {
+		namespace := selector.Namespace
+		if namespace == "" {
+			namespace = model.DefaultNamespace
+		}
+		selected := make(map[model.ChangeFeedID]struct{}, len(selector.IDs))
+		for _, id := range selector.IDs {
+			if err := model.ValidateChangefeedID(id); err != nil {
+				return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+					"invalid changefeed_id: %s", id)
+			}
+			changefeedID := model.ChangeFeedID{Namespace: namespace, ID: id}
+			if _, ok := selected[changefeedID]; ok {
+				continue
+			}
+			selected[changefeedID] = struct{}{}
+			if info, ok := infos[changefeedID]; ok && !matchLabels(info) {
+				continue
+			}
+			changefeeds = append(changefeeds, changefeedID)
+		}
+		return changefeeds, nil
+	}
+
+	for changefeedID, info := range infos {
+		if selector.Namespace != "" && changefeedID.Namespace != selector.Namespace {
+			continue
+		}
+		if !matchLabels(info) {
+			continue
+		}
+		changefeeds = append(changefeeds, changefeedID)
+	}
+	sort.Slice(changefeeds, func(i, j int) bool {
+		if changefeeds[i].Namespace == changefeeds[j].Namespace {
+			return changefeeds[i].ID < changefeeds[j].ID
+		}
+		return changefeeds[i].Namespace < changefeeds[j].Namespace
+	})
+	return changefeeds, nil
+}
+
+// runBatch runs the operation on the changefeeds concurrently, and returns the
+// results in the same order as the changefeeds.
+func runBatch(
+	ctx context.Context, changefeeds []model.ChangeFeedID,
+	operate func(ctx context.Context, changefeedID model.ChangeFeedID) error,
+) *ListResponse[ChangefeedOperationResult] {
+	results := make([]ChangefeedOperationResult, len(changefeeds))
+	sem := make(chan struct{}, batchOperationConcurrency)
+	var wg sync.WaitGroup
+	for i, changefeedID := range changefeeds {
+		results[i] = ChangefeedOperationResult{
+			Namespace: changefeedID.Namespace,
+			ID:        changefeedID.ID,
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(result *ChangefeedOperationResult, changefeedID model.ChangeFeedID) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := operate(ctx, changefeedID); err != nil {
+				httpErr := model.NewHTTPError(err)
+				result.Error = &httpErr
+			}
+		}(&results[i], changefeedID)
+	}
+	wg.Wait()
+	return &ListResponse[ChangefeedOperationResult]{
+		Total: len(results),
+		Items: results,
+	}
+}
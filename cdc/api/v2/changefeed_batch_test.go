@@ -0,0 +1,234 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchPauseChangefeeds(t *testing.T) {
+	t.Parallel()
+
+	pause := testCase{url: "/api/v2/batch/changefeeds/pause", method: "POST"}
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	owner := mock_owner.NewMockOwner(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(owner, nil).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	newInfo := func(env string) *model.ChangeFeedInfo {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.Labels = map[string]string{"env": env}
+		return &model.ChangeFeedInfo{Config: cfg}
+	}
+	cf1 := model.DefaultChangeFeedID("cf1")
+	cf2 := model.DefaultChangeFeedID("cf2")
+	cf3 := model.ChangeFeedID{Namespace: "ns", ID: "cf3"}
+	statusProvider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).Return(
+		map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			cf1: newInfo("prod"),
+			cf2: newInfo("dev"),
+			cf3: newInfo("prod"),
+		}, nil).AnyTimes()
+
+	doRequest := func(selector *ChangefeedSelector) *httptest.ResponseRecorder {
+		body, err := json.Marshal(selector)
+		require.Nil(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			pause.method, pause.url, bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: no condition
+	w := doRequest(&ChangefeedSelector{})
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: select by labels, one of the changefeeds fails to be paused
+	statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), cf1).
+		Return(&model.ChangeFeedStatus{}, nil)
+	statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), cf3).
+		Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(cf3))
+	owner.EXPECT().EnqueueJob(gomock.Any(), gomock.Any()).
+		Do(func(adminJob model.AdminJob, done chan<- error) {
+			require.Equal(t, cf1, adminJob.CfID)
+			require.Equal(t, model.AdminStop, adminJob.Type)
+			close(done)
+		})
+	w = doRequest(&ChangefeedSelector{LabelSelector: "env=prod"})
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ListResponse[ChangefeedOperationResult]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, "default", resp.Items[0].Namespace)
+	require.Equal(t, "cf1", resp.Items[0].ID)
+	require.Nil(t, resp.Items[0].Error)
+	require.Equal(t, "ns", resp.Items[1].Namespace)
+	require.Equal(t, "cf3", resp.Items[1].ID)
+	require.Contains(t, resp.Items[1].Error.Code, "ErrChangeFeedNotExists")
+
+	// case 3: select by IDs, the changefeeds not matching labels are skipped
+	statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), cf1).
+		Return(&model.ChangeFeedStatus{}, nil)
+	owner.EXPECT().EnqueueJob(gomock.Any(), gomock.Any()).
+		Do(func(adminJob model.AdminJob, done chan<- error) {
+			require.Equal(t, cf1, adminJob.CfID)
+			close(done)
+		})
+	w = doRequest(&ChangefeedSelector{
+		IDs: []string{"cf1", "cf2", "cf1"}, LabelSelector: "env=prod",
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = &ListResponse[ChangefeedOperationResult]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, "cf1", resp.Items[0].ID)
+	require.Nil(t, resp.Items[0].Error)
+}
+
+func TestBatchUpdateChangefeeds(t *testing.T) {
+	t.Parallel()
+
+	update := testCase{url: "/api/v2/batch/changefeeds/update", method: "POST"}
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	newInfo := func(env, sinkURI string) *model.ChangeFeedInfo {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.Labels = map[string]string{"env": env}
+		return &model.ChangeFeedInfo{
+			SinkURI: sinkURI, Config: cfg, State: model.StateStopped,
+		}
+	}
+	cf1 := model.DefaultChangeFeedID("cf1")
+	cf2 := model.DefaultChangeFeedID("cf2")
+	infos := map[model.ChangeFeedID]*model.ChangeFeedInfo{
+		cf1: newInfo("prod", "mysql://127.0.0.1:3306/"),
+		cf2: newInfo("dev", "kafka://127.0.0.1:9092/topic"),
+	}
+	statusProvider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).Return(infos, nil).AnyTimes()
+
+	doRequest := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			update.method, update.url, bytes.NewReader([]byte(body)))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: the settings of a single changefeed can't be updated in batches
+	for _, body := range []string{
+		`{"selector": {"ids": ["cf1", "cf2"]}}`,
+		`{"selector": {"ids": ["cf1", "cf2"]}, "config": {"sink_uri": "blackhole://"}}`,
+		`{"selector": {"ids": ["cf1", "cf2"]}, "patch": {"sink_uri": "blackhole://"}}`,
+		`{"selector": {"ids": ["cf1", "cf2"]}, "patch": {"replica_config": {"unknown": 1}}}`,
+	} {
+		w := doRequest(body)
+		respErr := model.HTTPError{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+		require.Contains(t, respErr.Code, "ErrAPIInvalidParam", body)
+		require.Equal(t, http.StatusBadRequest, w.Code, body)
+	}
+
+	// case 2: the patch is merged into the config of each changefeed
+	for id, info := range infos {
+		statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), id).Return(info, nil)
+		statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), id).
+			Return(&model.ChangeFeedStatus{CheckpointTs: 1}, nil)
+	}
+	etcdClient.EXPECT().GetUpstreamInfo(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&model.UpstreamInfo{}, nil).Times(2)
+	helpers.EXPECT().verifyUpstream(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(2)
+	helpers.EXPECT().createTiStore(gomock.Any(), gomock.Any()).
+		Return(nil, nil).Times(2)
+	helpers.EXPECT().verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, cfg *ChangefeedConfig, oldInfo *model.ChangeFeedInfo,
+			oldUpInfo *model.UpstreamInfo, _ interface{}, _ uint64,
+		) (*model.ChangeFeedInfo, *model.UpstreamInfo, error) {
+			require.Empty(t, cfg.SinkURI)
+			require.Empty(t, cfg.PDAddrs)
+			newInfo, err := oldInfo.Clone()
+			require.Nil(t, err)
+			newInfo.Config = cfg.ReplicaConfig.ToInternalReplicaConfig()
+			return newInfo, oldUpInfo, nil
+		}).Times(2)
+	updated := make(map[model.ChangeFeedID]*model.ChangeFeedInfo)
+	var mu sync.Mutex
+	etcdClient.EXPECT().UpdateChangefeedAndUpstream(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ *model.UpstreamInfo,
+			info *model.ChangeFeedInfo, id model.ChangeFeedID,
+		) error {
+			mu.Lock()
+			defer mu.Unlock()
+			updated[id] = info
+			return nil
+		}).Times(2)
+
+	w := doRequest(`{"selector": {"ids": ["cf1", "cf2"]},
+		"patch": {"replica_config": {"memory_quota": 1024, "labels": {"team": "a"}}}}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ListResponse[ChangefeedOperationResult]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 2, resp.Total)
+	for _, item := range resp.Items {
+		require.Nil(t, item.Error, item.ID)
+	}
+	require.Len(t, updated, 2)
+	require.Equal(t, "mysql://127.0.0.1:3306/", updated[cf1].SinkURI)
+	require.Equal(t, map[string]string{"env": "prod", "team": "a"}, updated[cf1].Config.Labels)
+	require.Equal(t, uint64(1024), updated[cf1].Config.MemoryQuota)
+	require.Equal(t, "kafka://127.0.0.1:9092/topic", updated[cf2].SinkURI)
+	require.Equal(t, map[string]string{"env": "dev", "team": "a"}, updated[cf2].Config.Labels)
+	require.Equal(t, uint64(1024), updated[cf2].Config.MemoryQuota)
+	// The existing configs are not modified in place.
+	require.Equal(t, map[string]string{"env": "prod"}, infos[cf1].Config.Labels)
+}
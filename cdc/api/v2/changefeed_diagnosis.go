@@ -0,0 +1,358 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// Names of the checks of a changefeed diagnosis.
+const (
+	diagnosisCheckState       = "state"
+	diagnosisCheckGCSafepoint = "gc_safepoint"
+	diagnosisCheckSink        = "sink"
+	diagnosisCheckTables      = "tables"
+	diagnosisCheckCaptures    = "captures"
+)
+
+const (
+	// diagnosisGCTTLWarningPercentage is the percentage of the gc-ttl, above
+	// which the checkpoint lag of a failed changefeed is warned, since the
+	// service GC safepoint is not kept for it once the lag exceeds the gc-ttl.
+	diagnosisGCTTLWarningPercentage = 80
+	// diagnosisMaxListedTables is the max number of tables listed in a finding.
+	diagnosisMaxListedTables = 10
+)
+
+// diagnoseChangefeed diagnoses a changefeed
+// @Summary Diagnose a changefeed
+// @Description check a changefeed end to end, including its state, the
+// @Description checkpoint ts against the GC safepoint, the connectivity of
+// @Description the sink, the tables matched by the filter rules and the
+// @Description captures, and return the findings with the suggested actions.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Success 200 {object} ChangefeedDiagnosis
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/changefeeds/{changefeed_id}/diagnosis [get]
+func (h *OpenAPIV2) diagnoseChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	up, ok := upManager.Get(info.UpstreamID)
+	if !ok {
+		_ = c.Error(cerror.ErrUpstreamNotFound.GenWithStackByArgs(info.UpstreamID))
+		return
+	}
+
+	diagnosis := &ChangefeedDiagnosis{
+		Namespace: changefeedID.Namespace,
+		ID:        changefeedID.ID,
+		Status:    HealthStatusOK,
+	}
+	addFinding := func(finding DiagnosisFinding) {
+		diagnosis.Findings = append(diagnosis.Findings, finding)
+		diagnosis.Status = worseHealthStatus(diagnosis.Status, finding.Status)
+	}
+	addFinding(diagnoseChangefeedState(info))
+	addFinding(h.diagnoseGCSafepoint(ctx, up, changefeedID, info, status.CheckpointTs))
+	addFinding(h.diagnoseSink(ctx, info))
+	addFinding(h.diagnoseTables(ctx, up, info))
+	addFinding(h.diagnoseCaptures(ctx, changefeedID, info))
+	c.JSON(http.StatusOK, diagnosis)
+}
+
+// diagnoseChangefeedState checks whether the changefeed is running normally.
+func diagnoseChangefeedState(info *model.ChangeFeedInfo) DiagnosisFinding {
+	finding := DiagnosisFinding{Check: diagnosisCheckState, Status: HealthStatusOK}
+	switch info.State {
+	case model.StateError:
+		finding.Status = HealthStatusFail
+		finding.Message = "the changefeed is in error state"
+		finding.Suggestion = "Fix the cause of the error, the changefeed is retried " +
+			"automatically, or resume it by `cdc cli changefeed resume`"
+	case model.StateFailed:
+		finding.Status = HealthStatusFail
+		finding.Message = "the changefeed is failed"
+		finding.Suggestion = "Fix the cause of the error and resume the changefeed " +
+			"by `cdc cli changefeed resume`"
+	case model.StateStopped:
+		finding.Status = HealthStatusDegraded
+		finding.Message = "the changefeed is paused"
+		finding.Suggestion = "Resume the changefeed by `cdc cli changefeed resume` " +
+			"if it's not paused on purpose"
+	case model.StateNormal:
+		finding.Message = "the changefeed is normal"
+		if info.Warning != nil {
+			finding.Status = HealthStatusDegraded
+			finding.Message = "the changefeed is retrying after a warning"
+			finding.Suggestion = "Check the warning, the changefeed recovers " +
+				"automatically once its cause is fixed"
+		}
+	default:
+		finding.Message = fmt.Sprintf("the changefeed is %s", info.State)
+	}
+	if finding.Status != HealthStatusOK {
+		runningErr := info.Error
+		if runningErr == nil {
+			runningErr = info.Warning
+		}
+		if runningErr != nil {
+			finding.Message += fmt.Sprintf(": [%s] %s", runningErr.Code, runningErr.Message)
+		}
+	}
+	return finding
+}
+
+// diagnoseGCSafepoint checks whether the checkpoint ts of the changefeed is
+// after the service GC safepoint of the upstream, and whether it's going to
+// be garbage collected.
+func (h *OpenAPIV2) diagnoseGCSafepoint(
+	ctx context.Context, up *upstream.Upstream,
+	changefeedID model.ChangeFeedID, info *model.ChangeFeedInfo, checkpointTs uint64,
+) DiagnosisFinding {
+	finding := DiagnosisFinding{Check: diagnosisCheckGCSafepoint, Status: HealthStatusOK}
+	checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	err := gc.CheckChangefeedStartTsSafety(checkCtx, up.PDClient,
+		h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceDiagnosing),
+		changefeedID, checkpointTs)
+	if err != nil {
+		if cerror.ErrStartTsBeforeGC.Equal(err) {
+			finding.Status = HealthStatusFail
+			finding.Message = fmt.Sprintf("the checkpoint ts %d is before the service "+
+				"GC safepoint, the data to replicate has been garbage collected", checkpointTs)
+			finding.Suggestion = "Recreate the changefeed with a start ts after the GC " +
+				"safepoint, and recover the data changed in between by other means"
+			return finding
+		}
+		finding.Status = HealthStatusDegraded
+		finding.Message = fmt.Sprintf("failed to get the service GC safepoint: %s", err)
+		finding.Suggestion = "Check whether the PD of the upstream is reachable"
+		return finding
+	}
+
+	now := time.Now()
+	if up.PDClock != nil {
+		if t, err := up.PDClock.CurrentTime(); err == nil {
+			now = t
+		}
+	}
+	lag := now.Sub(oracle.GetTimeFromTS(checkpointTs))
+	gcTTL := time.Duration(config.GetGlobalServerConfig().GcTTL) * time.Second
+	finding.Message = fmt.Sprintf("the checkpoint ts %d is after the service GC safepoint",
+		checkpointTs)
+	if info.State == model.StateFailed &&
+		lag > gcTTL*diagnosisGCTTLWarningPercentage/100 {
+		finding.Status = HealthStatusDegraded
+		finding.Message += fmt.Sprintf(", but the checkpoint lag %s is close to the "+
+			"gc-ttl %s, the GC safepoint is not kept for a failed changefeed once "+
+			"the lag exceeds the gc-ttl", lag.Round(time.Second), gcTTL)
+		finding.Suggestion = "Resume the changefeed before its checkpoint lag exceeds the gc-ttl"
+	}
+	return finding
+}
+
+// diagnoseSink checks whether the sink of the changefeed is reachable.
+func (h *OpenAPIV2) diagnoseSink(
+	ctx context.Context, info *model.ChangeFeedInfo,
+) DiagnosisFinding {
+	finding := DiagnosisFinding{Check: diagnosisCheckSink, Status: HealthStatusOK}
+	probeCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if err := h.helpers.probeSink(probeCtx, info.SinkURI, info.Config); err != nil {
+		finding.Status = HealthStatusFail
+		finding.Message = fmt.Sprintf("the sink is unreachable: %s", err)
+		finding.Suggestion = "Check the network connectivity, the credentials and " +
+			"the privileges of the sink"
+		return finding
+	}
+	finding.Message = "the sink is reachable"
+	return finding
+}
+
+// diagnoseTables checks whether the filter rules of the changefeed match any
+// table, and whether the tables matched can be replicated.
+func (h *OpenAPIV2) diagnoseTables(
+	ctx context.Context, up *upstream.Upstream, info *model.ChangeFeedInfo,
+) DiagnosisFinding {
+	finding := DiagnosisFinding{Check: diagnosisCheckTables, Status: HealthStatusOK}
+	tsCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	// The tables are verified at the current ts, since the snapshot of the
+	// checkpoint ts may have been garbage collected.
+	physical, logical, err := up.PDClient.GetTS(tsCtx)
+	if err == nil {
+		var ineligibleTables, eligibleTables []model.TableName
+		ineligibleTables, eligibleTables, err = h.helpers.getVerfiedTables(
+			info.Config, up.KVStorage, oracle.ComposeTS(physical, logical))
+		if err == nil {
+			return diagnoseTableNames(finding, info, ineligibleTables, eligibleTables)
+		}
+	}
+	finding.Status = HealthStatusDegraded
+	finding.Message = fmt.Sprintf("failed to verify the tables: %s", err)
+	finding.Suggestion = "Check whether the upstream is reachable"
+	return finding
+}
+
+func diagnoseTableNames(
+	finding DiagnosisFinding, info *model.ChangeFeedInfo,
+	ineligibleTables, eligibleTables []model.TableName,
+) DiagnosisFinding {
+	if len(ineligibleTables)+len(eligibleTables) == 0 {
+		finding.Status = HealthStatusFail
+		finding.Message = "the filter rules match no tables"
+		finding.Suggestion = "Check the filter rules of the changefeed, e.g. the " +
+			"schema names and the case sensitivity"
+		return finding
+	}
+	finding.Message = fmt.Sprintf("%d tables are matched by the filter rules",
+		len(ineligibleTables)+len(eligibleTables))
+	if len(ineligibleTables) == 0 {
+		return finding
+	}
+
+	names := make([]string, 0, len(ineligibleTables))
+	for _, table := range ineligibleTables {
+		names = append(names, table.String())
+	}
+	sort.Strings(names)
+	listed := strings.Join(names, ", ")
+	if len(names) > diagnosisMaxListedTables {
+		listed = strings.Join(names[:diagnosisMaxListedTables], ", ") +
+			fmt.Sprintf(" and %d more", len(names)-diagnosisMaxListedTables)
+	}
+	if info.Config != nil && info.Config.ForceReplicate {
+		finding.Message += fmt.Sprintf(", %d tables without a valid index are "+
+			"replicated by force-replicate, the rows of them may be duplicated: %s",
+			len(names), listed)
+		return finding
+	}
+	if info.Config != nil &&
+		info.Config.IneligibleTablePolicy == config.IneligibleTablePolicyAppendOnly {
+		finding.Message += fmt.Sprintf(", only the inserted rows of %d tables "+
+			"without a valid index are replicated by the append-only policy: %s",
+			len(names), listed)
+		return finding
+	}
+	finding.Status = HealthStatusDegraded
+	finding.Message += fmt.Sprintf(", %d tables without a valid index are not "+
+		"replicated: %s", len(names), listed)
+	finding.Suggestion = "Add a primary key or a not null unique key to the tables, " +
+		"or set the ineligible-table-policy to append-only to replicate their " +
+		"inserted rows"
+	return finding
+}
+
+// diagnoseCaptures checks whether the captures are healthy and all tables of
+// the changefeed are scheduled to the captures.
+func (h *OpenAPIV2) diagnoseCaptures(
+	ctx context.Context, changefeedID model.ChangeFeedID, info *model.ChangeFeedInfo,
+) DiagnosisFinding {
+	finding := DiagnosisFinding{Check: diagnosisCheckCaptures, Status: HealthStatusOK}
+	checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	_, captures, err := h.capture.GetEtcdClient().GetCaptures(checkCtx)
+	if err != nil {
+		finding.Status = HealthStatusFail
+		finding.Message = fmt.Sprintf("failed to get the captures: %s", err)
+		finding.Suggestion = "Check whether the PD of the TiCDC cluster is reachable"
+		return finding
+	}
+	if _, err := h.capture.GetOwnerCaptureInfo(checkCtx); err != nil {
+		finding.Status = HealthStatusFail
+		finding.Message = fmt.Sprintf("failed to get the owner: %s", err)
+		finding.Suggestion = "Check the logs of the captures for the owner election"
+		return finding
+	}
+
+	messages := []string{fmt.Sprintf("%d captures are alive", len(captures))}
+	var suggestions []string
+	versions := make(map[string]int)
+	for _, capture := range captures {
+		versions[capture.Version]++
+	}
+	if len(versions) > 1 {
+		counts := make([]string, 0, len(versions))
+		for version, count := range versions {
+			counts = append(counts, fmt.Sprintf("%s (%d)", version, count))
+		}
+		sort.Strings(counts)
+		finding.Status = HealthStatusDegraded
+		messages = append(messages, fmt.Sprintf("the captures run different versions: %s",
+			strings.Join(counts, ", ")))
+		suggestions = append(suggestions, "Finish the rolling upgrade of the captures")
+	}
+
+	// The tables are only scheduled for a running changefeed.
+	if info.State == model.StateNormal {
+		progresses, err := h.capture.StatusProvider().GetTableProgresses(checkCtx, changefeedID)
+		if err != nil {
+			finding.Status = HealthStatusDegraded
+			messages = append(messages, fmt.Sprintf("failed to get the tables: %s", err))
+		} else {
+			absent := 0
+			for _, p := range progresses {
+				if p.Phase == model.TablePhaseAbsent {
+					absent++
+				}
+			}
+			if absent > 0 {
+				finding.Status = HealthStatusDegraded
+				messages = append(messages, fmt.Sprintf(
+					"%d tables are not scheduled to any capture", absent))
+				suggestions = append(suggestions, "Check the logs of the owner for "+
+					"the scheduling errors, and whether the captures are overloaded")
+			}
+		}
+	}
+	finding.Message = strings.Join(messages, ", ")
+	finding.Suggestion = strings.Join(suggestions, "; ")
+	return finding
+}
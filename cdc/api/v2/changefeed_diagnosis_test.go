@@ -0,0 +1,217 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestDiagnoseChangefeed(t *testing.T) {
+	t.Parallel()
+
+	diagnose := testCase{url: "/api/v2/changefeeds/%s/diagnosis", method: "GET"}
+	ctrl := gomock.NewController(t)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	checkpointTs := oracle.GoTimeToTS(time.Now().Add(-time.Minute))
+	minServiceGCTs := checkpointTs - 100
+	pdClient := &gc.MockPDClient{
+		UpdateServiceGCSafePointFunc: func(
+			ctx context.Context, serviceID string, ttl int64, safePoint uint64,
+		) (uint64, error) {
+			return minServiceGCTs, nil
+		},
+	}
+	captures := []*model.CaptureInfo{
+		{ID: "capture-1", Version: "v7.1.0"},
+		{ID: "capture-2", Version: "v7.1.0"},
+	}
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().
+		Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().GetOwnerCaptureInfo(gomock.Any()).
+		Return(&model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+	etcdClient.EXPECT().GetEnsureGCServiceID(gc.EnsureGCServiceDiagnosing).
+		Return("ticdc-default-diagnosing-").AnyTimes()
+	etcdClient.EXPECT().GetCaptures(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (int64, []*model.CaptureInfo, error) {
+			return 0, captures, nil
+		}).AnyTimes()
+	info := &model.ChangeFeedInfo{
+		SinkURI: "blackhole://",
+		State:   model.StateNormal,
+		Config:  config.GetDefaultReplicaConfig(),
+	}
+	statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(info, nil).Times(2)
+	statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedStatus{CheckpointTs: checkpointTs}, nil).Times(2)
+
+	getDiagnosis := func() *ChangefeedDiagnosis {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), diagnose.method,
+			fmt.Sprintf(diagnose.url, changeFeedID.ID), nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &ChangefeedDiagnosis{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+		return resp
+	}
+	findings := func(diagnosis *ChangefeedDiagnosis) map[string]DiagnosisFinding {
+		res := make(map[string]DiagnosisFinding)
+		for _, finding := range diagnosis.Findings {
+			res[finding.Check] = finding
+		}
+		return res
+	}
+
+	// case 1: healthy
+	helpers.EXPECT().probeSink(gomock.Any(), info.SinkURI, gomock.Any()).Return(nil)
+	helpers.EXPECT().getVerfiedTables(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, []model.TableName{{Schema: "test", Table: "t1"}}, nil)
+	statusProvider.EXPECT().GetTableProgresses(gomock.Any(), changeFeedID).
+		Return([]*model.TableProgress{{TableID: 1, Phase: model.TablePhaseReplicating}}, nil)
+	diagnosis := getDiagnosis()
+	require.Equal(t, HealthStatusOK, diagnosis.Status)
+	require.Equal(t, changeFeedID.ID, diagnosis.ID)
+	require.Len(t, diagnosis.Findings, 5)
+	for _, finding := range diagnosis.Findings {
+		require.Equal(t, HealthStatusOK, finding.Status, finding.Check)
+		require.Empty(t, finding.Suggestion, finding.Check)
+	}
+
+	// case 2: the data is garbage collected, the sink is unreachable, some
+	// tables have no valid index and are not scheduled.
+	minServiceGCTs = checkpointTs + 100
+	captures = append(captures, &model.CaptureInfo{ID: "capture-3", Version: "v7.2.0"})
+	helpers.EXPECT().probeSink(gomock.Any(), info.SinkURI, gomock.Any()).
+		Return(errors.New("connection refused"))
+	helpers.EXPECT().getVerfiedTables(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]model.TableName{{Schema: "test", Table: "t2"}},
+			[]model.TableName{{Schema: "test", Table: "t1"}}, nil)
+	statusProvider.EXPECT().GetTableProgresses(gomock.Any(), changeFeedID).
+		Return([]*model.TableProgress{{TableID: 1, Phase: model.TablePhaseAbsent}}, nil)
+	diagnosis = getDiagnosis()
+	require.Equal(t, HealthStatusFail, diagnosis.Status)
+	res := findings(diagnosis)
+	require.Equal(t, HealthStatusOK, res[diagnosisCheckState].Status)
+	require.Equal(t, HealthStatusFail, res[diagnosisCheckGCSafepoint].Status)
+	require.Contains(t, res[diagnosisCheckGCSafepoint].Message, "garbage collected")
+	require.Equal(t, HealthStatusFail, res[diagnosisCheckSink].Status)
+	require.Contains(t, res[diagnosisCheckSink].Message, "connection refused")
+	require.Equal(t, HealthStatusDegraded, res[diagnosisCheckTables].Status)
+	require.Contains(t, res[diagnosisCheckTables].Message, "test.t2")
+	require.Equal(t, HealthStatusDegraded, res[diagnosisCheckCaptures].Status)
+	require.Contains(t, res[diagnosisCheckCaptures].Message, "v7.1.0 (2), v7.2.0 (1)")
+	require.Contains(t, res[diagnosisCheckCaptures].Message, "1 tables are not scheduled")
+	for _, finding := range diagnosis.Findings[1:] {
+		require.NotEmpty(t, finding.Suggestion, finding.Check)
+	}
+
+	// case 3: changefeed not exists
+	statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), diagnose.method,
+		fmt.Sprintf(diagnose.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+}
+
+func TestDiagnoseChangefeedState(t *testing.T) {
+	t.Parallel()
+
+	finding := diagnoseChangefeedState(&model.ChangeFeedInfo{State: model.StateNormal})
+	require.Equal(t, HealthStatusOK, finding.Status)
+
+	finding = diagnoseChangefeedState(&model.ChangeFeedInfo{
+		State:   model.StateNormal,
+		Warning: &model.RunningError{Code: "CDC:ErrSinkFailed", Message: "fake warning"},
+	})
+	require.Equal(t, HealthStatusDegraded, finding.Status)
+	require.Contains(t, finding.Message, "[CDC:ErrSinkFailed] fake warning")
+
+	finding = diagnoseChangefeedState(&model.ChangeFeedInfo{
+		State: model.StateFailed,
+		Error: &model.RunningError{Code: "CDC:ErrGCTTLExceeded", Message: "fake error"},
+	})
+	require.Equal(t, HealthStatusFail, finding.Status)
+	require.Contains(t, finding.Message, "[CDC:ErrGCTTLExceeded] fake error")
+	require.Contains(t, finding.Suggestion, "cdc cli changefeed resume")
+
+	finding = diagnoseChangefeedState(&model.ChangeFeedInfo{State: model.StateStopped})
+	require.Equal(t, HealthStatusDegraded, finding.Status)
+}
+
+func TestDiagnoseTableNames(t *testing.T) {
+	t.Parallel()
+
+	info := &model.ChangeFeedInfo{Config: config.GetDefaultReplicaConfig()}
+	finding := diagnoseTableNames(DiagnosisFinding{Status: HealthStatusOK}, info, nil, nil)
+	require.Equal(t, HealthStatusFail, finding.Status)
+	require.Contains(t, finding.Message, "match no tables")
+
+	var ineligibleTables []model.TableName
+	for i := 0; i < diagnosisMaxListedTables+2; i++ {
+		ineligibleTables = append(ineligibleTables,
+			model.TableName{Schema: "test", Table: fmt.Sprintf("t%02d", i)})
+	}
+	finding = diagnoseTableNames(DiagnosisFinding{Status: HealthStatusOK}, info,
+		ineligibleTables, nil)
+	require.Equal(t, HealthStatusDegraded, finding.Status)
+	require.Contains(t, finding.Message, "test.t09 and 2 more")
+	require.NotContains(t, finding.Message, "t10")
+
+	info.Config.ForceReplicate = true
+	finding = diagnoseTableNames(DiagnosisFinding{Status: HealthStatusOK}, info,
+		ineligibleTables, nil)
+	require.Equal(t, HealthStatusOK, finding.Status)
+	require.Contains(t, finding.Message, "replicated by force-replicate")
+
+	info.Config.ForceReplicate = false
+	info.Config.IneligibleTablePolicy = config.IneligibleTablePolicyAppendOnly
+	finding = diagnoseTableNames(DiagnosisFinding{Status: HealthStatusOK}, info,
+		ineligibleTables, nil)
+	require.Equal(t, HealthStatusOK, finding.Status)
+	require.Contains(t, finding.Message, "by the append-only policy")
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+)
+
+// driftIgnoredFields are the fields of a changefeed info recording its
+// runtime state instead of its spec, which are not compared.
+var driftIgnoredFields = []string{
+	"admin-job-type", "state", "error", "warning", "epoch",
+}
+
+// getChangefeedDrift gets the drift of the config of a changefeed
+// @Summary Get the drift of the config of a changefeed
+// @Description compare the changefeed info stored in etcd against the info
+// @Description currently in effect, after the defaults, migrations and the
+// @Description adjustments of the owner are applied, and return the fields
+// @Description differing between them.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Success 200 {object} ChangefeedDrift
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/drift [get]
+func (h *OpenAPIV2) getChangefeedDrift(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	stored, err := h.capture.GetEtcdClient().GetChangeFeedInfoRaw(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	effective, err := h.capture.StatusProvider().GetEffectiveChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	diff, err := diffChangefeedDrift(stored, effective)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &ChangefeedDrift{State: string(effective.State), Diff: diff})
+}
+
+// diffChangefeedDrift compares the raw changefeed info stored in etcd against
+// the effective one, the sink URIs are masked before comparing.
+func diffChangefeedDrift(
+	stored []byte, effective *model.ChangeFeedInfo,
+) ([]ChangefeedConfigDiff, error) {
+	storedFields := make(map[string]interface{})
+	if err := json.Unmarshal(stored, &storedFields); err != nil {
+		return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+	}
+	data, err := json.Marshal(effective)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+	effectiveFields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &effectiveFields); err != nil {
+		return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+	}
+
+	for _, fields := range []map[string]interface{}{storedFields, effectiveFields} {
+		for _, field := range driftIgnoredFields {
+			delete(fields, field)
+		}
+		maskDriftURI(fields, "sink-uri")
+		if cfg, ok := fields["config"].(map[string]interface{}); ok {
+			if consistent, ok := cfg["consistent"].(map[string]interface{}); ok {
+				maskDriftURI(consistent, "storage")
+			}
+		}
+	}
+	diff, err := diffJSON("", storedFields, effectiveFields)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil {
+		diff = []ChangefeedConfigDiff{}
+	}
+	return diff, nil
+}
+
+func maskDriftURI(fields map[string]interface{}, key string) {
+	uri, ok := fields[key].(string)
+	if !ok || uri == "" {
+		return
+	}
+	masked, err := util.MaskSinkURI(uri)
+	if err != nil {
+		// Do not expose the URI if it can't be parsed.
+		masked = "xxxxx"
+	}
+	fields[key] = masked
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// listChangefeedErrors lists the recent errors and warnings of a changefeed
+// @Summary List the recent errors and warnings of a changefeed
+// @Description list the recent errors and warnings reported by the captures
+// @Description of a changefeed, the latest one comes first. The same error
+// @Description happening in a row is merged into one record.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param level query string false "error or warning, all levels are listed if empty"
+// @Success 200 {object} ListResponse[ChangefeedErrorRecord]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/errors [get]
+func (h *OpenAPIV2) listChangefeedErrors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	level := model.ErrorLevel(c.Query(apiOpVarLevel))
+	if level != "" && level != model.ErrorLevelError && level != model.ErrorLevelWarning {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid level: %s", level))
+		return
+	}
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	items := make([]ChangefeedErrorRecord, 0, len(info.ErrorHistory))
+	for i := len(info.ErrorHistory) - 1; i >= 0; i-- {
+		r := info.ErrorHistory[i]
+		if level != "" && r.Level != level {
+			continue
+		}
+		items = append(items, ChangefeedErrorRecord{
+			Level:     string(r.Level),
+			Time:      r.Time,
+			FirstTime: r.FirstTime,
+			Count:     r.Count,
+			Capture:   r.Addr,
+			Code:      r.Code,
+			Message:   r.Message,
+		})
+	}
+	c.JSON(http.StatusOK, &ListResponse[ChangefeedErrorRecord]{
+		Total: len(items),
+		Items: items,
+	})
+}
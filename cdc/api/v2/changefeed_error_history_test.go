@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListChangefeedErrors(t *testing.T) {
+	t.Parallel()
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	now := time.Now()
+	info := &model.ChangeFeedInfo{ID: changeFeedID.ID}
+	info.RecordError(model.ErrorLevelWarning, &model.RunningError{
+		Time: now, Addr: "capture-1", Code: "CDC:ErrKafkaSendMessage", Message: "timeout",
+	})
+	info.RecordError(model.ErrorLevelError, &model.RunningError{
+		Time: now.Add(time.Second), Addr: "capture-2", Code: "CDC:ErrMySQLTxnError", Message: "denied",
+	})
+	statusProvider := &mockStatusProvider{changefeedInfo: info}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	list := func(query string) (int, *ListResponse[ChangefeedErrorRecord]) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet,
+			"/api/v2/changefeeds/"+changeFeedID.ID+"/errors"+query, nil)
+		router.ServeHTTP(w, req)
+		resp := &ListResponse[ChangefeedErrorRecord]{}
+		if w.Code == http.StatusOK {
+			require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+		}
+		return w.Code, resp
+	}
+
+	code, resp := list("")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, resp.Total)
+	// the latest error comes first
+	require.Equal(t, "error", resp.Items[0].Level)
+	require.Equal(t, "capture-2", resp.Items[0].Capture)
+	require.Equal(t, "CDC:ErrMySQLTxnError", resp.Items[0].Code)
+	require.Equal(t, "warning", resp.Items[1].Level)
+	require.Equal(t, 1, resp.Items[1].Count)
+
+	code, resp = list("?level=warning")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, "timeout", resp.Items[0].Message)
+
+	code, _ = list("?level=fatal")
+	require.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// apiOpVarStartTsStrategy is the key of the start ts strategy of importing
+// changefeeds in HTTP API
+const apiOpVarStartTsStrategy = "start_ts_strategy"
+
+// exportChangefeeds exports the specs of all changefeeds in a namespace
+// @Summary Export changefeeds
+// @Description export the specs of all changefeeds in a namespace as a
+// @Description versioned document, which can be imported into another cluster
+// @Tags changefeed,v2
+// @Produce json
+// @Param namespace query string false "namespace, default by default"
+// @Success 200 {object} ChangefeedExportDocument
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/export [get]
+func (h *OpenAPIV2) exportChangefeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	namespace := c.Query(apiOpVarNamespace)
+	if namespace == "" {
+		namespace = model.DefaultNamespace
+	}
+	if err := model.ValidateNamespace(namespace); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid namespace: %s", namespace))
+		return
+	}
+	doc, err := h.doExportChangefeeds(ctx, namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, doc)
+}
+
+func (h *OpenAPIV2) doExportChangefeeds(
+	ctx context.Context, namespace string,
+) (*ChangefeedExportDocument, error) {
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	statuses, err := h.capture.StatusProvider().GetAllChangeFeedStatuses(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	doc := &ChangefeedExportDocument{
+		Version:     ChangefeedExportVersion,
+		Namespace:   namespace,
+		ExportTime:  time.Now(),
+		Changefeeds: []ChangefeedSpec{},
+	}
+	for changefeedID, info := range infos {
+		if changefeedID.Namespace != namespace {
+			continue
+		}
+		spec := ChangefeedSpec{
+			ID:            changefeedID.ID,
+			SinkURI:       info.SinkURI,
+			StartTs:       info.StartTs,
+			TargetTs:      info.TargetTs,
+			CheckpointTs:  info.StartTs,
+			State:         info.State,
+			ReplicaConfig: ToAPIReplicaConfig(info.Config),
+		}
+		if status, ok := statuses[changefeedID]; ok && status != nil {
+			spec.CheckpointTs = status.CheckpointTs
+		}
+		doc.Changefeeds = append(doc.Changefeeds, spec)
+	}
+	sort.Slice(doc.Changefeeds, func(i, j int) bool {
+		return doc.Changefeeds[i].ID < doc.Changefeeds[j].ID
+	})
+	return doc, nil
+}
+
+// importChangefeeds imports the changefeeds in an export document
+// @Summary Import changefeeds
+// @Description create the changefeeds in an export document, the changefeeds
+// @Description replicate from the default upstream of this cluster. The
+// @Description stopped and failed changefeeds are created paused
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param start_ts_strategy query string false "checkpoint, original or now, checkpoint by default"
+// @Param document body ChangefeedExportDocument true "export document"
+// @Success 200 {object} ListResponse[ChangefeedOperationResult]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/import [post]
+func (h *OpenAPIV2) importChangefeeds(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	strategy := c.Query(apiOpVarStartTsStrategy)
+	switch strategy {
+	case "":
+		strategy = StartTsStrategyCheckpoint
+	case StartTsStrategyCheckpoint, StartTsStrategyOriginal, StartTsStrategyNow:
+	default:
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid start_ts_strategy: %s", strategy))
+		return
+	}
+	doc := &ChangefeedExportDocument{}
+	if err := c.BindJSON(doc); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if doc.Version != ChangefeedExportVersion {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"unsupported export document version: %d", doc.Version))
+		return
+	}
+
+	if err := resolveNamespace(c, &doc.Namespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	namespace := doc.Namespace
+	specs := make(map[model.ChangeFeedID]ChangefeedSpec, len(doc.Changefeeds))
+	changefeeds := make([]model.ChangeFeedID, 0, len(doc.Changefeeds))
+	for _, spec := range doc.Changefeeds {
+		changefeedID := model.ChangeFeedID{Namespace: namespace, ID: spec.ID}
+		if _, ok := specs[changefeedID]; ok {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+				"duplicated changefeed_id: %s", spec.ID))
+			return
+		}
+		specs[changefeedID] = spec
+		changefeeds = append(changefeeds, changefeedID)
+	}
+
+	results := runBatch(ctx, changefeeds,
+		func(ctx context.Context, changefeedID model.ChangeFeedID) error {
+			spec := specs[changefeedID]
+			cfg := &ChangefeedConfig{
+				Namespace:     changefeedID.Namespace,
+				ID:            spec.ID,
+				SinkURI:       spec.SinkURI,
+				TargetTs:      spec.TargetTs,
+				ReplicaConfig: spec.ReplicaConfig,
+			}
+			if cfg.ReplicaConfig == nil {
+				cfg.ReplicaConfig = GetDefaultReplicaConfig()
+			}
+			switch strategy {
+			case StartTsStrategyCheckpoint:
+				cfg.StartTs = spec.CheckpointTs
+			case StartTsStrategyOriginal:
+				cfg.StartTs = spec.StartTs
+			}
+			_, err := h.doCreateChangefeed(ctx, cfg, isPausedState(spec.State))
+			return err
+		})
+	log.Info("changefeeds imported",
+		zap.String("namespace", namespace),
+		zap.String("startTsStrategy", strategy),
+		zap.Int("count", len(changefeeds)))
+	c.JSON(http.StatusOK, results)
+}
+
+// isPausedState returns true if a changefeed in the exported state must be
+// imported paused. The changefeeds in the error state are retried by the
+// owner, so they're imported running.
+func isPausedState(state model.FeedState) bool {
+	return state == model.StateStopped || state == model.StateFailed
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tidbkv "github.com/pingcap/tidb/kv"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/owner"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	pd "github.com/tikv/pd/client"
+	"go.etcd.io/etcd/tests/v3/integration"
+)
+
+func TestExportChangefeeds(t *testing.T) {
+	t.Parallel()
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	cf1 := model.DefaultChangeFeedID("cf-1")
+	cf2 := model.DefaultChangeFeedID("cf-2")
+	other := model.ChangeFeedID{Namespace: "other", ID: "cf-3"}
+	statusProvider := &mockStatusProvider{
+		changefeedInfos: map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			cf2: {
+				SinkURI: blackholeSink, StartTs: 10, State: model.StateStopped,
+				Config: config.GetDefaultReplicaConfig(),
+			},
+			cf1: {
+				SinkURI: blackholeSink, StartTs: 20, State: model.StateNormal,
+				Config: config.GetDefaultReplicaConfig(),
+			},
+			other: {
+				SinkURI: blackholeSink, StartTs: 30, State: model.StateNormal,
+				Config: config.GetDefaultReplicaConfig(),
+			},
+		},
+		changefeedStatuses: map[model.ChangeFeedID]*model.ChangeFeedStatus{
+			cf1: {CheckpointTs: 25},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/changefeeds/export", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	doc := &ChangefeedExportDocument{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(doc))
+	require.Equal(t, ChangefeedExportVersion, doc.Version)
+	require.Equal(t, model.DefaultNamespace, doc.Namespace)
+	require.Len(t, doc.Changefeeds, 2)
+	require.Equal(t, "cf-1", doc.Changefeeds[0].ID)
+	require.Equal(t, uint64(20), doc.Changefeeds[0].StartTs)
+	require.Equal(t, uint64(25), doc.Changefeeds[0].CheckpointTs)
+	require.Equal(t, "cf-2", doc.Changefeeds[1].ID)
+	// the checkpoint falls back to the start ts if there is no status
+	require.Equal(t, uint64(10), doc.Changefeeds[1].CheckpointTs)
+	require.Equal(t, model.StateStopped, doc.Changefeeds[1].State)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/changefeeds/export?namespace=other", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	doc = &ChangefeedExportDocument{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(doc))
+	require.Len(t, doc.Changefeeds, 1)
+	require.Equal(t, "cf-3", doc.Changefeeds[0].ID)
+}
+
+func TestImportChangefeedsInvalidRequest(t *testing.T) {
+	t.Parallel()
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	doImport := func(url string, doc *ChangefeedExportDocument) *httptest.ResponseRecorder {
+		body, err := json.Marshal(doc)
+		require.Nil(t, err)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			http.MethodPost, url, bytes.NewReader(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	doc := &ChangefeedExportDocument{Version: ChangefeedExportVersion}
+	w := doImport("/api/v2/changefeeds/import?start_ts_strategy=latest", doc)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	doc.Version = ChangefeedExportVersion + 1
+	w = doImport("/api/v2/changefeeds/import", doc)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	doc.Version = ChangefeedExportVersion
+	doc.Changefeeds = []ChangefeedSpec{{ID: "cf-1"}, {ID: "cf-1"}}
+	w = doImport("/api/v2/changefeeds/import", doc)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// the namespace of the document must be the one checked by the access
+	// control
+	doc.Changefeeds = nil
+	doc.Namespace = "ns1"
+	w = doImport("/api/v2/changefeeds/import", doc)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	doc.Namespace = ""
+
+	// nothing to import
+	w = doImport("/api/v2/changefeeds/import?start_ts_strategy=now", doc)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ListResponse[ChangefeedOperationResult]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 0, resp.Total)
+}
+
+func TestImportChangefeedsState(t *testing.T) {
+	t.Parallel()
+	pdClient := &mockPDClient{}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+	integration.BeforeTestExternal(t)
+	testEtcdCluster := integration.NewClusterV3(
+		t, &integration.ClusterConfig{Size: 1},
+	)
+	defer testEtcdCluster.Terminate(t)
+
+	etcdClient.EXPECT().GetEnsureGCServiceID(gomock.Any()).
+		Return(etcd.GcServiceIDForTest()).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(&mockStatusProvider{}).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().
+		Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	helpers.EXPECT().getPDClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(pdClient, nil).AnyTimes()
+	helpers.EXPECT().createTiStore(gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+	helpers.EXPECT().getEtcdClient(gomock.Any(), gomock.Any()).
+		Return(testEtcdCluster.RandClient(), nil).AnyTimes()
+	helpers.EXPECT().
+		verifyCreateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context,
+			cfg *ChangefeedConfig,
+			pdClient pd.Client,
+			statusProvider owner.StatusProvider,
+			ensureGCServiceID string,
+			kvStorage tidbkv.Storage,
+		) (*model.ChangeFeedInfo, error) {
+			return &model.ChangeFeedInfo{
+				UpstreamID: 1,
+				ID:         cfg.ID,
+				SinkURI:    cfg.SinkURI,
+				State:      model.StateNormal,
+			}, nil
+		}).AnyTimes()
+	// the changefeeds are imported concurrently.
+	var mu sync.Mutex
+	created := make(map[string]*model.ChangeFeedInfo)
+	etcdClient.EXPECT().
+		CreateChangefeedInfo(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, upstreamInfo *model.UpstreamInfo,
+			info *model.ChangeFeedInfo, changefeedID model.ChangeFeedID,
+		) error {
+			mu.Lock()
+			defer mu.Unlock()
+			created[info.ID] = info
+			return nil
+		}).Times(4)
+
+	doc := &ChangefeedExportDocument{
+		Version: ChangefeedExportVersion,
+		Changefeeds: []ChangefeedSpec{
+			{ID: "normal", SinkURI: blackholeSink, State: model.StateNormal},
+			{ID: "stopped", SinkURI: blackholeSink, State: model.StateStopped},
+			{ID: "failed", SinkURI: blackholeSink, State: model.StateFailed},
+			{ID: "error", SinkURI: blackholeSink, State: model.StateError},
+		},
+	}
+	body, err := json.Marshal(doc)
+	require.Nil(t, err)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		http.MethodPost, "/api/v2/changefeeds/import", bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// the stopped and failed changefeeds are created paused, the ones in the
+	// error state are retried by the owner.
+	require.Len(t, created, 4)
+	for id, state := range map[string]model.FeedState{
+		"normal":  model.StateNormal,
+		"stopped": model.StateStopped,
+		"failed":  model.StateStopped,
+		"error":   model.StateNormal,
+	} {
+		require.Equal(t, state, created[id].State, id)
+	}
+	require.Equal(t, model.AdminStop, created["stopped"].AdminJobType)
+	require.Equal(t, model.AdminNone, created["normal"].AdminJobType)
+}
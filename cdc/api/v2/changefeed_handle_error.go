@@ -0,0 +1,105 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// handleChangefeedError skips or replaces an event of a changefeed
+// @Summary Skip or replace an event of a changefeed
+// @Description skip an event which can't be applied to the downstream, or
+// @Description replace a DDL event with another statement, the event is
+// @Description identified by its commit ts and table. The changefeed must be
+// @Description stopped, failed or in error state, the handle takes effect when
+// @Description the changefeed is resumed or retried. Skipping DML events drops
+// @Description every row change of the table committed at the commit ts, that
+// @Description is the whole part of the transaction on the table.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param handle body HandleErrorConfig true "the event to handle"
+// @Success 200 {object} ErrorHandles
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/handle_error [post]
+func (h *OpenAPIV2) handleChangefeedError(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	cfg := &HandleErrorConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	handle := cfg.ToErrorHandle()
+	if err := handle.Validate(); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	status, err := h.capture.StatusProvider().GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if handle.CommitTs <= status.CheckpointTs {
+		_ = c.Error(cerror.ErrInvalidErrorHandle.GenWithStackByArgs(
+			"the event has been replicated before the checkpoint ts"))
+		return
+	}
+
+	info, err := h.capture.GetEtcdClient().UpdateChangeFeedInfo(ctx, changefeedID,
+		func(info *model.ChangeFeedInfo) error {
+			switch info.State {
+			case model.StateStopped, model.StateFailed, model.StateError:
+			default:
+				return cerror.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+					"can only handle the error of a changefeed when it is stopped, failed or in error state")
+			}
+			info.AddErrorHandle(handle, status.CheckpointTs)
+			return nil
+		})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("changefeed error handle added",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Any("handle", handle))
+
+	resp := &ErrorHandles{Handles: make([]HandleErrorConfig, 0, len(info.ErrorHandles))}
+	for _, h := range info.ErrorHandles {
+		resp.Handles = append(resp.Handles, HandleErrorConfig{
+			CommitTs:  h.CommitTs,
+			Schema:    h.Schema,
+			Table:     h.Table,
+			Action:    string(h.Action),
+			Statement: h.Statement,
+		})
+	}
+	c.JSON(http.StatusOK, resp)
+}
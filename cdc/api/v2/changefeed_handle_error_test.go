@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleChangefeedError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	doRequest := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+			"/api/v2/changefeeds/"+changeFeedID.ID+"/handle_error", bytes.NewBufferString(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid handles
+	w := doRequest(`{"commit_ts":20,"schema":"test","action":"ignore"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = doRequest(`{"commit_ts":20,"schema":"test","action":"replace"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the event has been replicated
+	statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedStatus{CheckpointTs: 20}, nil).AnyTimes()
+	w = doRequest(`{"commit_ts":20,"schema":"test","table":"t","action":"skip"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	info := &model.ChangeFeedInfo{State: model.StateNormal}
+	etcdClient.EXPECT().UpdateChangeFeedInfo(gomock.Any(), changeFeedID, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ model.ChangeFeedID,
+			update func(*model.ChangeFeedInfo) error,
+		) (*model.ChangeFeedInfo, error) {
+			if err := update(info); err != nil {
+				return nil, err
+			}
+			return info, nil
+		}).Times(2)
+
+	// case 3: the changefeed is running
+	w = doRequest(`{"commit_ts":21,"schema":"test","table":"t","action":"skip"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Empty(t, info.ErrorHandles)
+
+	// case 4: the handle is added
+	info.State = model.StateFailed
+	w = doRequest(`{"commit_ts":21,"schema":"test","table":"t",` +
+		`"action":"replace","statement":"ALTER TABLE t ADD COLUMN c INT"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ErrorHandles{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	expected := HandleErrorConfig{
+		CommitTs: 21, Schema: "test", Table: "t",
+		Action: "replace", Statement: "ALTER TABLE t ADD COLUMN c INT",
+	}
+	require.Equal(t, []HandleErrorConfig{expected}, resp.Handles)
+	require.Equal(t, []*model.ErrorHandle{expected.ToErrorHandle()}, info.ErrorHandles)
+}
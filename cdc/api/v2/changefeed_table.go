@@ -0,0 +1,213 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// listIneligibleTables lists the tables without a valid index of a changefeed
+// @Summary List the ineligible tables of a changefeed
+// @Description list the tables matched by the filter rules of a changefeed
+// @Description at the current ts, which have neither a primary key nor a not
+// @Description null unique key. They are handled by the ineligible table
+// @Description policy of the changefeed.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Success 200 {object} IneligibleTables
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/ineligible_tables [get]
+func (h *OpenAPIV2) listIneligibleTables(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	info, err := h.capture.StatusProvider().GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	up, ok := upManager.Get(info.UpstreamID)
+	if !ok {
+		_ = c.Error(cerror.ErrUpstreamNotFound.GenWithStackByArgs(info.UpstreamID))
+		return
+	}
+	// The tables are verified at the current ts, since the snapshot of the
+	// checkpoint ts may have been garbage collected.
+	physical, logical, err := up.PDClient.GetTS(ctx)
+	if err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrPDEtcdAPIError, err))
+		return
+	}
+	ineligibleTables, _, err := h.helpers.getVerfiedTables(
+		info.Config, up.KVStorage, oracle.ComposeTS(physical, logical))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	tables := make([]TableName, 0, len(ineligibleTables))
+	for _, table := range ineligibleTables {
+		tables = append(tables, TableName{
+			Schema:      table.Schema,
+			Table:       table.Table,
+			TableID:     table.TableID,
+			IsPartition: table.IsPartition,
+		})
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Table < tables[j].Table
+	})
+	res := &IneligibleTables{Tables: tables}
+	if info.Config != nil {
+		res.Policy = info.Config.IneligibleTablePolicy
+		res.ForceReplicate = info.Config.ForceReplicate
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// listTableProgresses lists the replication progresses of the tables of a changefeed
+// @Summary List the replication progresses of tables
+// @Description list the checkpoint ts, resolved ts, phase and the captures of
+// @Description each table of a changefeed, the slowest table comes first, which
+// @Description is holding back the checkpoint ts of the changefeed.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Success 200 {object} ListResponse[TableProgress]
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables [get]
+func (h *OpenAPIV2) listTableProgresses(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	progresses, err := h.capture.StatusProvider().GetTableProgresses(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	now := time.Now()
+	items := make([]TableProgress, 0, len(progresses))
+	for _, p := range progresses {
+		checkpointTime := oracle.GetTimeFromTS(p.CheckpointTs)
+		items = append(items, TableProgress{
+			TableID:        p.TableID,
+			Captures:       p.Captures,
+			Phase:          string(p.Phase),
+			CheckpointTs:   p.CheckpointTs,
+			ResolvedTs:     p.ResolvedTs,
+			CheckpointTime: model.JSONTime(checkpointTime),
+			CheckpointLag:  JSONDuration{now.Sub(checkpointTime)},
+			SpanCount:      p.SpanCount,
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].CheckpointTs != items[j].CheckpointTs {
+			return items[i].CheckpointTs < items[j].CheckpointTs
+		}
+		return items[i].TableID < items[j].TableID
+	})
+	c.JSON(http.StatusOK, &ListResponse[TableProgress]{
+		Total: len(items),
+		Items: items,
+	})
+}
+
+// pauseTables pauses replicating tables of a changefeed
+// @Summary Pause tables of a changefeed
+// @Description pause replicating the specified tables of a changefeed, the
+// @Description paused tables are excluded from the checkpoint calculation of
+// @Description the changefeed, so they don't hold back the other tables. A DDL
+// @Description of the paused tables blocks the changefeed at its commit ts until
+// @Description the tables are resumed and catch up with it.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param tables body PauseTablesConfig true "the tables to pause"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables/pause [post]
+func (h *OpenAPIV2) pauseTables(c *gin.Context) {
+	h.handlePauseTables(c, false)
+}
+
+// resumeTables resumes replicating the paused tables of a changefeed
+// @Summary Resume paused tables of a changefeed
+// @Description resume replicating the paused tables of a changefeed, the
+// @Description tables catch up from the checkpoint ts they were paused at.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param tables body PauseTablesConfig true "the tables to resume"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/tables/resume [post]
+func (h *OpenAPIV2) resumeTables(c *gin.Context) {
+	h.handlePauseTables(c, true)
+}
+
+func (h *OpenAPIV2) handlePauseTables(c *gin.Context, resume bool) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	cfg := &PauseTablesConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.TableIDs) == 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("table_ids is empty"))
+		return
+	}
+	err := api.HandleOwnerPauseTables(ctx, h.capture, changefeedID, cfg.TableIDs, resume)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
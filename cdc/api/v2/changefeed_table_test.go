@@ -0,0 +1,175 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTableProgresses(t *testing.T) {
+	t.Parallel()
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	statusProvider := &mockStatusProvider{
+		tableProgresses: []*model.TableProgress{{
+			TableID:      1,
+			Captures:     []model.CaptureID{"a"},
+			Phase:        model.TablePhaseReplicating,
+			CheckpointTs: 20,
+			ResolvedTs:   30,
+			SpanCount:    1,
+		}, {
+			TableID:      2,
+			Phase:        model.TablePhaseScanning,
+			CheckpointTs: 10,
+			ResolvedTs:   10,
+			SpanCount:    1,
+		}},
+	}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/changefeeds/"+changeFeedID.ID+"/tables", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ListResponse[TableProgress]{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, 2, resp.Total)
+	// the slowest table comes first
+	require.Equal(t, int64(2), resp.Items[0].TableID)
+	require.Equal(t, "scanning", resp.Items[0].Phase)
+	require.Equal(t, int64(1), resp.Items[1].TableID)
+	require.Equal(t, []string{"a"}, resp.Items[1].Captures)
+	require.Equal(t, uint64(30), resp.Items[1].ResolvedTs)
+
+	statusProvider.err = cerror.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID.ID)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/changefeeds/"+changeFeedID.ID+"/tables", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestListIneligibleTables(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().
+		Return(upstream.NewManager4Test(&gc.MockPDClient{}), nil).AnyTimes()
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.IneligibleTablePolicy = config.IneligibleTablePolicyAppendOnly
+	statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedInfo{Config: cfg}, nil)
+	helpers.EXPECT().getVerfiedTables(cfg, gomock.Any(), gomock.Any()).
+		Return([]model.TableName{
+			{Schema: "test", Table: "t2", TableID: 2},
+			{Schema: "test", Table: "t1", TableID: 1},
+		}, []model.TableName{{Schema: "test", Table: "t3", TableID: 3}}, nil)
+
+	url := "/api/v2/changefeeds/" + changeFeedID.ID + "/ineligible_tables"
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &IneligibleTables{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, config.IneligibleTablePolicyAppendOnly, resp.Policy)
+	require.False(t, resp.ForceReplicate)
+	require.Equal(t, []TableName{
+		{Schema: "test", Table: "t1", TableID: 1},
+		{Schema: "test", Table: "t2", TableID: 2},
+	}, resp.Tables)
+
+	statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID.ID))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestPauseAndResumeTables(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	owner := mock_owner.NewMockOwner(ctrl)
+	apiV2 := NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(ctrl))
+	router := newRouter(apiV2)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(owner, nil).AnyTimes()
+
+	owner.EXPECT().PauseTables(changeFeedID, []model.TableID{1, 2}, gomock.Any()).
+		Do(func(_ model.ChangeFeedID, _ []model.TableID, done chan<- error) {
+			close(done)
+		})
+	body, _ := json.Marshal(&PauseTablesConfig{TableIDs: []int64{1, 2}})
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/changefeeds/"+changeFeedID.ID+"/tables/pause", bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	owner.EXPECT().ResumeTables(changeFeedID, []model.TableID{1}, gomock.Any()).
+		Do(func(_ model.ChangeFeedID, _ []model.TableID, done chan<- error) {
+			done <- cerror.ErrTableNotPaused.GenWithStackByArgs(1, changeFeedID)
+			close(done)
+		})
+	body, _ = json.Marshal(&PauseTablesConfig{TableIDs: []int64{1}})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/changefeeds/"+changeFeedID.ID+"/tables/resume", bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrTableNotPaused")
+
+	// no table is specified
+	body, _ = json.Marshal(&PauseTablesConfig{})
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"/api/v2/changefeeds/"+changeFeedID.ID+"/tables/pause", bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
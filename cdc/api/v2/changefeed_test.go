@@ -22,7 +22,9 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	tidbkv "github.com/pingcap/tidb/kv"
@@ -233,6 +235,133 @@ func TestCreateChangefeed(t *testing.T) {
 	require.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestDryRunChangefeed(t *testing.T) {
+	t.Parallel()
+	dryRun := testCase{url: "/api/v2/changefeeds/dry_run", method: "POST"}
+
+	pdClient := &mockPDClient{}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+	integration.BeforeTestExternal(t)
+	testEtcdCluster := integration.NewClusterV3(
+		t, &integration.ClusterConfig{Size: 1},
+	)
+	defer testEtcdCluster.Terminate(t)
+
+	statusProvider := &mockStatusProvider{}
+	etcdClient.EXPECT().
+		GetEnsureGCServiceID(gomock.Any()).
+		Return(etcd.GcServiceIDForTest()).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	helpers.EXPECT().
+		getPDClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(pdClient, nil).AnyTimes()
+	helpers.EXPECT().
+		createTiStore(gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+
+	cfConfig := &ChangefeedConfig{
+		ID:       changeFeedID.ID,
+		SinkURI:  mysqlSink,
+		PDConfig: PDConfig{PDAddrs: []string{"http://127.0.0.1:2379"}},
+	}
+	body, err := json.Marshal(cfConfig)
+	require.Nil(t, err)
+
+	// case 1: invalid config
+	helpers.EXPECT().
+		dryRunChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil, nil, cerrors.ErrStartTsBeforeGC.GenWithStackByArgs(1, 2)).
+		Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), dryRun.method,
+		dryRun.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrStartTsBeforeGC")
+
+	// case 2: success with warnings, nothing is created
+	helpers.EXPECT().
+		getEtcdClient(gomock.Any(), gomock.Any()).
+		Return(testEtcdCluster.RandClient(), nil)
+	helpers.EXPECT().
+		dryRunChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&model.ChangeFeedInfo{
+			UpstreamID: 1,
+			ID:         changeFeedID.ID,
+			SinkURI:    mysqlSink,
+			StartTs:    10,
+			Config:     config.GetDefaultReplicaConfig(),
+		}, []model.TableName{
+			{Schema: "test", Table: "t2", TableID: 2},
+			{Schema: "test", Table: "t1", TableID: 1},
+		}, []string{"warning"}, nil).
+		Times(1)
+	etcdClient.EXPECT().
+		CreateChangefeedInfo(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), dryRun.method,
+		dryRun.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedDryRunResult{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, changeFeedID.ID, resp.Info.ID)
+	require.Equal(t, uint64(10), resp.Info.StartTs)
+	require.NotNil(t, resp.Info.Config)
+	require.Equal(t, []DryRunTable{
+		{Schema: "test", Table: "t1", TableID: 1},
+		{Schema: "test", Table: "t2", TableID: 2},
+	}, resp.Tables)
+	require.Equal(t, []string{"warning"}, resp.Warnings)
+}
+
+func TestToDryRunTables(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"test.t1"}, PartitionRule: "ts", TopicRule: "{schema}_{table}"},
+	}
+	info := &model.ChangeFeedInfo{
+		SinkURI: "kafka://127.0.0.1:9092/default-topic",
+		Config:  replicaConfig,
+	}
+	tables := []model.TableName{
+		{Schema: "test", Table: "t2", TableID: 2},
+		{Schema: "test", Table: "t1", TableID: 1},
+	}
+	result, err := toDryRunTables(info, tables)
+	require.Nil(t, err)
+	require.Equal(t, []DryRunTable{
+		{Schema: "test", Table: "t1", TableID: 1, Topic: "test_t1", PartitionRule: "ts"},
+		{Schema: "test", Table: "t2", TableID: 2, Topic: "default-topic", PartitionRule: "default"},
+	}, result)
+
+	// the dispatch targets are not set for non-MQ sinks
+	info.SinkURI = "mysql://127.0.0.1:3306/"
+	result, err = toDryRunTables(info, tables)
+	require.Nil(t, err)
+	require.Equal(t, "", result[0].Topic)
+	require.Equal(t, "", result[0].PartitionRule)
+
+	info.SinkURI = "kafka://127.0.0.1:9092/"
+	_, err = toDryRunTables(info, tables)
+	require.NotNil(t, err)
+}
+
 func TestGetChangeFeed(t *testing.T) {
 	t.Parallel()
 
@@ -356,10 +485,10 @@ func TestUpdateChangefeed(t *testing.T) {
 	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
 	require.Equal(t, http.StatusBadRequest, w.Code)
 
-	// case 3: changefeed not stopped
+	// case 3: changefeed finished
 	oldCfInfo := &model.ChangeFeedInfo{
 		ID:         validID,
-		State:      "normal",
+		State:      "finished",
 		UpstreamID: 1,
 		Namespace:  model.DefaultNamespace,
 		Config:     &config.ReplicaConfig{},
@@ -504,6 +633,59 @@ func TestUpdateChangefeed(t *testing.T) {
 		fmt.Sprintf(update.url, validID), bytes.NewReader(body))
 	router.ServeHTTP(w, req)
 	require.Equal(t, http.StatusOK, w.Code)
+
+	// case 10: only the runtime-mutable parameters of a running changefeed
+	// can be updated
+	oldCfInfo.State = "normal"
+	oldCfInfo.SinkURI = "mysql://127.0.0.1:3306/?worker-count=16"
+	newCfInfo := *oldCfInfo
+	newCfInfo.TargetTs = 100
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&newCfInfo, nil, nil).
+		Times(1)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), update.method,
+		fmt.Sprintf(update.url, validID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangefeedUpdateRefused")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 11: success with the runtime-mutable parameters of a running
+	// changefeed updated
+	newCfInfo = *oldCfInfo
+	newCfInfo.SinkURI = "mysql://127.0.0.1:3306/?worker-count=32"
+	newCfInfo.Config = &config.ReplicaConfig{MemoryQuota: 1024}
+	helpers.EXPECT().
+		verifyUpdateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&newCfInfo, nil, nil).
+		Times(1)
+	etcdClient.EXPECT().
+		UpdateChangeFeedInfo(gomock.Any(), changeFeedID, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ model.ChangeFeedID,
+			update func(*model.ChangeFeedInfo) error,
+		) (*model.ChangeFeedInfo, error) {
+			info := &model.ChangeFeedInfo{State: model.StateNormal, Epoch: 10}
+			if err := update(info); err != nil {
+				return nil, err
+			}
+			return info, nil
+		}).Times(1)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), update.method,
+		fmt.Sprintf(update.url, validID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ChangeFeedInfo{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	require.Equal(t, newCfInfo.SinkURI, resp.SinkURI)
+	require.Equal(t, uint64(1024), resp.Config.MemoryQuota)
 }
 
 func TestListChangeFeeds(t *testing.T) {
@@ -584,6 +766,96 @@ func TestListChangeFeeds(t *testing.T) {
 	require.Equal(t, true, sorted(resp2.Items))
 }
 
+func TestListChangeFeedsWithOptions(t *testing.T) {
+	t.Parallel()
+
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	provider := &mockStatusProvider{
+		changefeedInfos: map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			model.DefaultChangeFeedID("cf-a1"): {
+				State: model.StateNormal,
+				Config: &config.ReplicaConfig{
+					Labels: map[string]string{"team": "foo", "env": "prod"},
+				},
+			},
+			model.DefaultChangeFeedID("cf-a2"): {State: model.StateNormal},
+			model.DefaultChangeFeedID("cf-b1"): {
+				State:  model.StateNormal,
+				Config: &config.ReplicaConfig{Labels: map[string]string{"team": "foo"}},
+			},
+			model.ChangeFeedID{Namespace: "ns", ID: "cf-a3"}: {State: model.StateNormal},
+			model.DefaultChangeFeedID("finished"):            {State: model.StateFinished},
+		},
+		changefeedStatuses: map[model.ChangeFeedID]*model.ChangeFeedStatus{
+			model.DefaultChangeFeedID("cf-a1"):               {CheckpointTs: 3},
+			model.DefaultChangeFeedID("cf-a2"):               {CheckpointTs: 1},
+			model.DefaultChangeFeedID("cf-b1"):               {CheckpointTs: 2},
+			model.ChangeFeedID{Namespace: "ns", ID: "cf-a3"}: {CheckpointTs: 4},
+			model.DefaultChangeFeedID("finished"):            {CheckpointTs: 5},
+		},
+	}
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+
+	list := func(query string) (int, ListResponse[ChangefeedCommonInfo]) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			"GET", "/api/v2/changefeeds?"+query, nil)
+		router.ServeHTTP(w, req)
+		resp := ListResponse[ChangefeedCommonInfo]{}
+		if w.Code == http.StatusOK {
+			require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+		}
+		return w.Code, resp
+	}
+	ids := func(items []ChangefeedCommonInfo) []string {
+		res := make([]string, 0, len(items))
+		for _, item := range items {
+			res = append(res, item.ID)
+		}
+		return res
+	}
+
+	code, resp := list("namespace=default&keyword=a")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, resp.Total)
+	require.Equal(t, []string{"cf-a1", "cf-a2"}, ids(resp.Items))
+
+	code, resp = list("sort_by=checkpoint&order=desc")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []string{"cf-a3", "cf-a1", "cf-b1", "cf-a2"}, ids(resp.Items))
+
+	code, resp = list("sort_by=lag&limit=2&offset=1")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 4, resp.Total)
+	require.Equal(t, []string{"cf-a1", "cf-b1"}, ids(resp.Items))
+
+	code, resp = list("state=all&offset=10")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 5, resp.Total)
+	require.Empty(t, resp.Items)
+
+	code, resp = list("label_selector=team=foo")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []string{"cf-a1", "cf-b1"}, ids(resp.Items))
+	require.Equal(t, map[string]string{"team": "foo", "env": "prod"}, resp.Items[0].Labels)
+
+	code, resp = list("label_selector=team=foo,env!=prod")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []string{"cf-b1"}, ids(resp.Items))
+
+	code, _ = list("sort_by=name")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = list("limit=-1")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = list("label_selector=team")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
 func TestVerifyTable(t *testing.T) {
 	t.Parallel()
 
@@ -959,3 +1231,67 @@ func TestHasRunningImport(t *testing.T) {
 		t, hasImport.Error(), "There are lightning/restore tasks running",
 	)
 }
+
+func TestStreamChangefeedStatus(t *testing.T) {
+	t.Parallel()
+
+	stream := testCase{url: "/api/v2/changefeeds/%s/status/stream", method: "GET"}
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	statusProvider := mock_owner.NewMockStatusProvider(ctrl)
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	// case 1: invalid interval
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), stream.method,
+		fmt.Sprintf(stream.url, changeFeedID.ID)+"?interval=0", nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: changefeed not exists
+	statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), stream.method,
+		fmt.Sprintf(stream.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+	require.Contains(t, respErr.Code, "ErrChangeFeedNotExists")
+
+	// case 3: push status and error events until the changefeed is removed
+	gomock.InOrder(
+		statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+			Return(&model.ChangeFeedInfo{State: model.StateNormal}, nil),
+		statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), changeFeedID).
+			Return(&model.ChangeFeedStatus{CheckpointTs: 1}, nil),
+		statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+			Return(&model.ChangeFeedInfo{
+				State: model.StateError,
+				Error: &model.RunningError{Time: time.Now(), Message: "fake error"},
+			}, nil),
+		statusProvider.EXPECT().GetChangeFeedStatus(gomock.Any(), changeFeedID).
+			Return(&model.ChangeFeedStatus{CheckpointTs: 2}, nil),
+		statusProvider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+			Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(changeFeedID)),
+	)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), stream.method,
+		fmt.Sprintf(stream.url, changeFeedID.ID)+"?interval=10", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	require.Equal(t, 2, strings.Count(body, "event:status\n"))
+	require.Equal(t, 1, strings.Count(body, "event:error\n"))
+	require.Contains(t, body, "fake error")
+	require.Equal(t, 1, strings.Count(body, "event:close\n"))
+	require.Contains(t, body, "ErrChangeFeedNotExists")
+}
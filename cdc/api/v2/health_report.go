@@ -0,0 +1,362 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/fsutil"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/tikv/client-go/v2/oracle"
+	pd "github.com/tikv/pd/client"
+)
+
+const (
+	// healthCheckTimeout is the timeout of each check of a health report.
+	healthCheckTimeout = 5 * time.Second
+	// sorterDiskDegradedPercentage and sorterDiskFailPercentage are the
+	// available percentages of the sorter disk below which the sorter is
+	// degraded and failed.
+	sorterDiskDegradedPercentage = 10
+	sorterDiskFailPercentage     = 1
+)
+
+// Names of the checks of a health report.
+const (
+	healthCheckEtcd        = "etcd"
+	healthCheckOwner       = "owner"
+	healthCheckUpstream    = "upstream"
+	healthCheckChangefeeds = "changefeeds"
+	healthCheckSorterDisk  = "sorter_disk"
+	healthCheckSinks       = "sinks"
+)
+
+var healthStatusRank = map[string]int{
+	HealthStatusOK:       0,
+	HealthStatusDegraded: 1,
+	HealthStatusFail:     2,
+}
+
+// worseHealthStatus returns the worse one of two health statuses.
+func worseHealthStatus(a, b string) string {
+	if healthStatusRank[b] > healthStatusRank[a] {
+		return b
+	}
+	return a
+}
+
+// healthReport gets the health report of a TiCDC node
+// @Summary Get the health report of a TiCDC node
+// @Description get the health of a TiCDC node and the subsystems it depends
+// @Description on, including etcd, the owner, the upstream PD and TiKV, the
+// @Description lag of changefeeds and the sorter disk, the sinks of running
+// @Description changefeeds are probed if probe_sinks is true. The status code
+// @Description is 503 if the status of the report is FAIL.
+// @Tags common,v2
+// @Produce json
+// @Param probe_sinks query bool false "whether to probe the sinks of running changefeeds"
+// @Success 200,503 {object} HealthReport
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/status/health [get]
+func (h *OpenAPIV2) healthReport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	probeSinks := false
+	if s := c.Query("probe_sinks"); s != "" {
+		var err error
+		probeSinks, err = strconv.ParseBool(s)
+		if err != nil {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid probe_sinks: %s", s))
+			return
+		}
+	}
+	info, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	report := &HealthReport{
+		Status:    HealthStatusOK,
+		ID:        info.ID,
+		IsOwner:   h.capture.IsOwner(),
+		CheckTime: time.Now(),
+	}
+	addCheck := func(check HealthCheck) {
+		report.Checks = append(report.Checks, check)
+		report.Status = worseHealthStatus(report.Status, check.Status)
+	}
+
+	addCheck(h.checkEtcdHealth(ctx))
+	addCheck(h.checkOwnerHealth(ctx))
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		addCheck(HealthCheck{
+			Name: healthCheckUpstream, Status: HealthStatusFail, Message: err.Error(),
+		})
+	} else {
+		addCheck(checkUpstreamHealth(ctx, upManager))
+	}
+	changefeedsCheck, infos, changefeeds := h.checkChangefeedsHealth(ctx, upManager)
+	addCheck(changefeedsCheck)
+	addCheck(checkSorterDiskHealth(config.GetGlobalServerConfig().Sorter.SortDir))
+	if probeSinks {
+		addCheck(h.probeSinks(ctx, infos, changefeeds))
+	}
+	report.Changefeeds = changefeeds
+
+	code := http.StatusOK
+	if report.Status == HealthStatusFail {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, report)
+}
+
+func (h *OpenAPIV2) checkEtcdHealth(ctx context.Context) HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	_, captures, err := h.capture.GetEtcdClient().GetCaptures(ctx)
+	if err != nil {
+		return HealthCheck{
+			Name: healthCheckEtcd, Status: HealthStatusFail, Message: err.Error(),
+		}
+	}
+	return HealthCheck{
+		Name:    healthCheckEtcd,
+		Status:  HealthStatusOK,
+		Message: fmt.Sprintf("%d captures alive", len(captures)),
+	}
+}
+
+func (h *OpenAPIV2) checkOwnerHealth(ctx context.Context) HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	owner, err := h.capture.GetOwnerCaptureInfo(ctx)
+	if err != nil {
+		return HealthCheck{
+			Name: healthCheckOwner, Status: HealthStatusFail, Message: err.Error(),
+		}
+	}
+	return HealthCheck{
+		Name:    healthCheckOwner,
+		Status:  HealthStatusOK,
+		Message: fmt.Sprintf("owner is %s at %s", owner.ID, owner.AdvertiseAddr),
+	}
+}
+
+// checkUpstreamHealth checks whether the PD of each upstream is reachable,
+// and all TiKV stores are up.
+func checkUpstreamHealth(ctx context.Context, upManager *upstream.Manager) HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	check := HealthCheck{Name: healthCheckUpstream, Status: HealthStatusOK}
+	var messages []string
+	_ = upManager.Visit(func(up *upstream.Upstream) error {
+		if err := up.Error(); err != nil {
+			check.Status = worseHealthStatus(check.Status, HealthStatusFail)
+			messages = append(messages, fmt.Sprintf("upstream %d: %s", up.ID, err))
+			return nil
+		}
+		if !up.IsNormal() {
+			check.Status = worseHealthStatus(check.Status, HealthStatusDegraded)
+			messages = append(messages, fmt.Sprintf("upstream %d is not ready", up.ID))
+			return nil
+		}
+		if _, _, err := up.PDClient.GetTS(ctx); err != nil {
+			check.Status = worseHealthStatus(check.Status, HealthStatusFail)
+			messages = append(messages, fmt.Sprintf("upstream %d: PD is unreachable: %s", up.ID, err))
+			return nil
+		}
+		stores, err := up.PDClient.GetAllStores(ctx, pd.WithExcludeTombstone())
+		if err != nil {
+			check.Status = worseHealthStatus(check.Status, HealthStatusFail)
+			messages = append(messages, fmt.Sprintf("upstream %d: failed to get stores: %s", up.ID, err))
+			return nil
+		}
+		down := 0
+		for _, store := range stores {
+			if store.GetState() != metapb.StoreState_Up {
+				down++
+			}
+		}
+		if down > 0 {
+			check.Status = worseHealthStatus(check.Status, HealthStatusDegraded)
+		}
+		messages = append(messages, fmt.Sprintf("upstream %d: %d of %d stores are up",
+			up.ID, len(stores)-down, len(stores)))
+		return nil
+	})
+	check.Message = strings.Join(messages, "; ")
+	return check
+}
+
+// checkChangefeedsHealth gets the checkpoint lag of all changefeeds, the
+// changefeeds are degraded if they are in error or failed state, or their lag
+// breaches the lag SLA.
+func (h *OpenAPIV2) checkChangefeedsHealth(
+	ctx context.Context, upManager *upstream.Manager,
+) (HealthCheck, map[model.ChangeFeedID]*model.ChangeFeedInfo, []ChangefeedHealth) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	etcdClient := h.capture.GetEtcdClient()
+	infos, err := etcdClient.GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		return HealthCheck{
+			Name: healthCheckChangefeeds, Status: HealthStatusFail, Message: err.Error(),
+		}, nil, nil
+	}
+	statuses := make(map[model.ChangeFeedID]*model.ChangeFeedStatus, len(infos))
+	for id := range infos {
+		status, _, err := etcdClient.GetChangeFeedStatus(ctx, id)
+		if err != nil && !cerror.ErrChangeFeedNotExists.Equal(err) {
+			return HealthCheck{
+				Name: healthCheckChangefeeds, Status: HealthStatusFail, Message: err.Error(),
+			}, nil, nil
+		}
+		statuses[id] = status
+	}
+	now := func(upstreamID uint64) time.Time {
+		if upManager != nil {
+			if up, ok := upManager.Get(upstreamID); ok && up.PDClock != nil {
+				if t, err := up.PDClock.CurrentTime(); err == nil {
+					return t
+				}
+			}
+		}
+		return time.Now()
+	}
+	check, changefeeds := checkChangefeedLags(infos, statuses, now)
+	return check, infos, changefeeds
+}
+
+func checkChangefeedLags(
+	infos map[model.ChangeFeedID]*model.ChangeFeedInfo,
+	statuses map[model.ChangeFeedID]*model.ChangeFeedStatus,
+	now func(upstreamID uint64) time.Time,
+) (HealthCheck, []ChangefeedHealth) {
+	check := HealthCheck{Name: healthCheckChangefeeds, Status: HealthStatusOK}
+	changefeeds := make([]ChangefeedHealth, 0, len(infos))
+	var messages []string
+	for id, info := range infos {
+		checkpointTs := info.StartTs
+		if status := statuses[id]; status != nil {
+			checkpointTs = status.CheckpointTs
+		}
+		lag := now(info.UpstreamID).Sub(oracle.GetTimeFromTS(checkpointTs))
+		if lag < 0 {
+			lag = 0
+		}
+		changefeeds = append(changefeeds, ChangefeedHealth{
+			Namespace:     id.Namespace,
+			ID:            id.ID,
+			State:         string(info.State),
+			CheckpointLag: JSONDuration{lag},
+		})
+
+		switch info.State {
+		case model.StateError, model.StateFailed:
+			check.Status = worseHealthStatus(check.Status, HealthStatusDegraded)
+			messages = append(messages, fmt.Sprintf("changefeed %s/%s is %s",
+				id.Namespace, id.ID, info.State))
+		case model.StateNormal:
+			if info.Config != nil && info.Config.LagSLA != nil &&
+				info.Config.LagSLA.MaxLag() > 0 && lag > info.Config.LagSLA.MaxLag() {
+				check.Status = worseHealthStatus(check.Status, HealthStatusDegraded)
+				messages = append(messages, fmt.Sprintf(
+					"changefeed %s/%s breaches its lag SLA", id.Namespace, id.ID))
+			}
+		}
+	}
+	sort.Slice(changefeeds, func(i, j int) bool {
+		if changefeeds[i].CheckpointLag.duration != changefeeds[j].CheckpointLag.duration {
+			return changefeeds[i].CheckpointLag.duration > changefeeds[j].CheckpointLag.duration
+		}
+		if changefeeds[i].Namespace != changefeeds[j].Namespace {
+			return changefeeds[i].Namespace < changefeeds[j].Namespace
+		}
+		return changefeeds[i].ID < changefeeds[j].ID
+	})
+	sort.Strings(messages)
+	if len(changefeeds) > 0 {
+		worst := changefeeds[0]
+		messages = append(messages, fmt.Sprintf("the worst checkpoint lag is %s of %s/%s",
+			worst.CheckpointLag.duration, worst.Namespace, worst.ID))
+	}
+	check.Message = strings.Join(messages, "; ")
+	return check, changefeeds
+}
+
+// checkSorterDiskHealth checks the available space of the disk of the sort dir.
+func checkSorterDiskHealth(sortDir string) HealthCheck {
+	check := HealthCheck{Name: healthCheckSorterDisk, Status: HealthStatusOK}
+	info, err := fsutil.GetDiskInfo(sortDir)
+	if err != nil {
+		check.Status = HealthStatusFail
+		check.Message = err.Error()
+		return check
+	}
+	switch {
+	case info.AvailPercentage < sorterDiskFailPercentage:
+		check.Status = HealthStatusFail
+	case info.AvailPercentage < sorterDiskDegradedPercentage:
+		check.Status = HealthStatusDegraded
+	}
+	check.Message = fmt.Sprintf("%dGB of %dGB (%.2f%%) available in %s",
+		info.Avail, info.All, info.AvailPercentage, sortDir)
+	return check
+}
+
+// probeSinks probes the sinks of the running changefeeds, a changefeed is
+// degraded if its sink is unreachable.
+func (h *OpenAPIV2) probeSinks(
+	ctx context.Context,
+	infos map[model.ChangeFeedID]*model.ChangeFeedInfo,
+	changefeeds []ChangefeedHealth,
+) HealthCheck {
+	check := HealthCheck{Name: healthCheckSinks, Status: HealthStatusOK}
+	var messages []string
+	probed := 0
+	for i := range changefeeds {
+		cf := &changefeeds[i]
+		if cf.State != string(model.StateNormal) {
+			continue
+		}
+		info := infos[model.ChangeFeedID{Namespace: cf.Namespace, ID: cf.ID}]
+		probeCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := h.helpers.probeSink(probeCtx, info.SinkURI, info.Config)
+		cancel()
+		probed++
+		if err != nil {
+			cf.SinkError = err.Error()
+			check.Status = worseHealthStatus(check.Status, HealthStatusDegraded)
+			messages = append(messages, fmt.Sprintf("the sink of changefeed %s/%s is unreachable",
+				cf.Namespace, cf.ID))
+		}
+	}
+	messages = append(messages, fmt.Sprintf("%d sinks probed", probed))
+	check.Message = strings.Join(messages, "; ")
+	return check
+}
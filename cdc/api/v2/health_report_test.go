@@ -0,0 +1,209 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+	pd "github.com/tikv/pd/client"
+)
+
+func TestHealthReport(t *testing.T) {
+	serverCfg := config.GetGlobalServerConfig()
+	cfg := serverCfg.Clone()
+	cfg.Sorter.SortDir = t.TempDir()
+	config.StoreGlobalServerConfig(cfg)
+	defer config.StoreGlobalServerConfig(serverCfg)
+
+	ctrl := gomock.NewController(t)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	cp := mock_capture.NewMockCapture(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	stores := []*metapb.Store{
+		{Id: 1, State: metapb.StoreState_Up},
+		{Id: 2, State: metapb.StoreState_Up},
+	}
+	pdClient := &gc.MockPDClient{
+		GetAllStoresFunc: func(
+			ctx context.Context, opts ...pd.GetStoreOption,
+		) ([]*metapb.Store, error) {
+			return stores, nil
+		},
+	}
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().
+		Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().GetOwnerCaptureInfo(gomock.Any()).
+		Return(&model.CaptureInfo{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"}, nil).
+		AnyTimes()
+	etcdClient.EXPECT().GetCaptures(gomock.Any()).
+		Return(int64(0), []*model.CaptureInfo{{ID: "capture-1"}}, nil).AnyTimes()
+
+	cfID := model.DefaultChangeFeedID("test")
+	info := &model.ChangeFeedInfo{
+		SinkURI: "blackhole://",
+		State:   model.StateNormal,
+		Config:  config.GetDefaultReplicaConfig(),
+	}
+	etcdClient.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{cfID: info}, nil).AnyTimes()
+	etcdClient.EXPECT().GetChangeFeedStatus(gomock.Any(), cfID).
+		Return(&model.ChangeFeedStatus{
+			CheckpointTs: oracle.GoTimeToTS(time.Now().Add(-time.Minute)),
+		}, int64(0), nil).AnyTimes()
+
+	getReport := func(url string, code int) *HealthReport {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			http.MethodGet, url, nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, code, w.Code)
+		resp := &HealthReport{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+		return resp
+	}
+	checkStatus := func(report *HealthReport, name string) string {
+		for _, check := range report.Checks {
+			if check.Name == name {
+				return check.Status
+			}
+		}
+		return ""
+	}
+
+	// case 1: healthy
+	report := getReport("/api/v2/status/health", http.StatusOK)
+	for _, name := range []string{
+		healthCheckEtcd, healthCheckOwner, healthCheckUpstream, healthCheckChangefeeds,
+	} {
+		require.Equal(t, HealthStatusOK, checkStatus(report, name), name)
+	}
+	require.Equal(t, "capture-1", report.ID)
+	require.True(t, report.IsOwner)
+	require.Len(t, report.Checks, 5)
+	require.Equal(t, "", checkStatus(report, healthCheckSinks))
+	require.Len(t, report.Changefeeds, 1)
+	require.Equal(t, "test", report.Changefeeds[0].ID)
+	require.GreaterOrEqual(t, report.Changefeeds[0].CheckpointLag.duration, time.Minute)
+
+	// case 2: a store is down and the sink is unreachable
+	stores = append(stores, &metapb.Store{Id: 3, State: metapb.StoreState_Offline})
+	helpers.EXPECT().probeSink(gomock.Any(), info.SinkURI, gomock.Any()).
+		Return(errors.New("unreachable"))
+	report = getReport("/api/v2/status/health?probe_sinks=true", http.StatusOK)
+	require.Equal(t, HealthStatusDegraded, report.Status)
+	require.Equal(t, HealthStatusDegraded, checkStatus(report, healthCheckUpstream))
+	require.Equal(t, HealthStatusDegraded, checkStatus(report, healthCheckSinks))
+	require.Equal(t, "unreachable", report.Changefeeds[0].SinkError)
+
+	// case 3: the sort dir is unavailable
+	cfg = cfg.Clone()
+	cfg.Sorter.SortDir = filepath.Join(t.TempDir(), "not-exist")
+	config.StoreGlobalServerConfig(cfg)
+	report = getReport("/api/v2/status/health", http.StatusServiceUnavailable)
+	require.Equal(t, HealthStatusFail, report.Status)
+	require.Equal(t, HealthStatusFail, checkStatus(report, healthCheckSorterDisk))
+
+	// case 4: invalid parameter
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		http.MethodGet, "/api/v2/status/health?probe_sinks=xx", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestCheckChangefeedLags(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.LagSLA = &config.LagSLAConfig{MaxLagSeconds: 60}
+	infos := map[model.ChangeFeedID]*model.ChangeFeedInfo{
+		model.DefaultChangeFeedID("a"): {State: model.StateNormal, Config: cfg},
+		model.DefaultChangeFeedID("b"): {State: model.StateNormal, Config: cfg},
+		model.DefaultChangeFeedID("c"): {
+			State: model.StateStopped, Config: cfg,
+			StartTs: oracle.GoTimeToTS(now.Add(-time.Hour)),
+		},
+	}
+	statuses := map[model.ChangeFeedID]*model.ChangeFeedStatus{
+		model.DefaultChangeFeedID("a"): {
+			CheckpointTs: oracle.GoTimeToTS(now.Add(-10 * time.Second)),
+		},
+		model.DefaultChangeFeedID("b"): {
+			CheckpointTs: oracle.GoTimeToTS(now.Add(-20 * time.Second)),
+		},
+	}
+	nowFn := func(uint64) time.Time { return now }
+
+	check, changefeeds := checkChangefeedLags(infos, statuses, nowFn)
+	require.Equal(t, HealthStatusOK, check.Status)
+	require.Len(t, changefeeds, 3)
+	// sorted by lag, the stopped changefeed has the largest lag.
+	require.Equal(t, "c", changefeeds[0].ID)
+	require.Equal(t, "b", changefeeds[1].ID)
+	require.Equal(t, "a", changefeeds[2].ID)
+	require.Contains(t, check.Message, "default/c")
+
+	// breaches the lag SLA
+	statuses[model.DefaultChangeFeedID("b")].CheckpointTs = oracle.GoTimeToTS(
+		now.Add(-2 * time.Minute))
+	check, _ = checkChangefeedLags(infos, statuses, nowFn)
+	require.Equal(t, HealthStatusDegraded, check.Status)
+	require.Contains(t, check.Message, "default/b breaches its lag SLA")
+
+	// in error state
+	statuses[model.DefaultChangeFeedID("b")].CheckpointTs = oracle.GoTimeToTS(now)
+	infos[model.DefaultChangeFeedID("a")].State = model.StateError
+	check, _ = checkChangefeedLags(infos, statuses, nowFn)
+	require.Equal(t, HealthStatusDegraded, check.Status)
+	require.Contains(t, check.Message, "default/a is error")
+}
+
+func TestCheckSorterDiskHealth(t *testing.T) {
+	t.Parallel()
+
+	check := checkSorterDiskHealth(t.TempDir())
+	require.NotEqual(t, HealthStatusFail, check.Status)
+	require.Contains(t, check.Message, "available")
+
+	check = checkSorterDiskHealth(filepath.Join(t.TempDir(), "not-exist"))
+	require.Equal(t, HealthStatusFail, check.Status)
+
+	require.Equal(t, HealthStatusFail, worseHealthStatus(HealthStatusDegraded, HealthStatusFail))
+	require.Equal(t, HealthStatusDegraded, worseHealthStatus(HealthStatusDegraded, HealthStatusOK))
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// labelRequirement requires the label with the key to have the value, or
+// not to have the value if notEqual is true.
+type labelRequirement struct {
+	key      string
+	value    string
+	notEqual bool
+}
+
+// labelSelector selects the changefeeds whose labels meet all requirements.
+type labelSelector []labelRequirement
+
+// parseLabelSelector parses a label selector like "env=prod,team!=foo". A
+// "key!=value" requirement is met if the label is absent.
+func parseLabelSelector(selector string) (labelSelector, error) {
+	var res labelSelector
+	if selector == "" {
+		return res, nil
+	}
+	for _, item := range strings.Split(selector, ",") {
+		item = strings.TrimSpace(item)
+		var req labelRequirement
+		if kv := strings.SplitN(item, "!=", 2); len(kv) == 2 {
+			req = labelRequirement{key: kv[0], value: kv[1], notEqual: true}
+		} else if kv := strings.SplitN(item, "=", 2); len(kv) == 2 {
+			req = labelRequirement{key: kv[0], value: kv[1]}
+		} else {
+			return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid label selector: %s", selector)
+		}
+		if req.key == "" {
+			return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid label selector: %s", selector)
+		}
+		res = append(res, req)
+	}
+	return res, nil
+}
+
+// matches returns true if the labels meet all requirements of the selector.
+func (s labelSelector) matches(labels map[string]string) bool {
+	for _, req := range s {
+		if (labels[req.key] == req.value) == req.notEqual {
+			return false
+		}
+	}
+	return true
+}
+
+// updateChangefeedLabels updates the labels of a changefeed
+// @Summary Update the labels of a changefeed
+// @Description set and remove labels of a changefeed, the changefeed can be
+// @Description in any state, since labels do not affect the replication.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param labels body ChangefeedLabelsPatch true "labels to set and remove"
+// @Success 200 {object} ChangefeedLabels
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/labels [patch]
+func (h *OpenAPIV2) updateChangefeedLabels(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	patch := &ChangefeedLabelsPatch{}
+	if err := c.BindJSON(patch); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if err := config.ValidateLabels(patch.Set); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+
+	info, err := h.capture.GetEtcdClient().UpdateChangeFeedInfo(ctx, changefeedID,
+		func(info *model.ChangeFeedInfo) error {
+			if info.Config == nil {
+				info.Config = config.GetDefaultReplicaConfig()
+			}
+			info.Config.Labels = patch.apply(info.Config.Labels)
+			return nil
+		})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("changefeed labels updated",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Any("labels", info.Config.Labels))
+	c.JSON(http.StatusOK, &ChangefeedLabels{Labels: info.Config.Labels})
+}
+
+// apply returns the labels after the patch is applied, the labels are
+// removed before set.
+func (p *ChangefeedLabelsPatch) apply(labels map[string]string) map[string]string {
+	res := make(map[string]string, len(labels)+len(p.Set))
+	for k, v := range labels {
+		res[k] = v
+	}
+	for _, k := range p.Remove {
+		delete(res, k)
+	}
+	for k, v := range p.Set {
+		res[k] = v
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
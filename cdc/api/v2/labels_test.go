@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	selector, err := parseLabelSelector("")
+	require.Nil(t, err)
+	require.Empty(t, selector)
+	require.True(t, selector.matches(nil))
+
+	selector, err = parseLabelSelector("env=prod, team=, app!=foo")
+	require.Nil(t, err)
+	require.Equal(t, labelSelector{
+		{key: "env", value: "prod"},
+		{key: "team", value: ""},
+		{key: "app", value: "foo", notEqual: true},
+	}, selector)
+	require.True(t, selector.matches(map[string]string{"env": "prod"}))
+	require.True(t, selector.matches(map[string]string{"env": "prod", "app": "bar"}))
+	require.False(t, selector.matches(map[string]string{"env": "prod", "app": "foo"}))
+	require.False(t, selector.matches(map[string]string{"env": "prod", "team": "bar"}))
+	require.False(t, selector.matches(nil))
+
+	for _, selector := range []string{"env", "=prod", "!=prod", "env=prod,"} {
+		_, err = parseLabelSelector(selector)
+		require.True(t, cerrors.ErrAPIInvalidParam.Equal(err), selector)
+	}
+}
+
+func TestUpdateChangefeedLabels(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	apiV2 := NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{})
+	router := newRouter(apiV2)
+
+	doRequest := func(id string, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "PATCH",
+			"/api/v2/changefeeds/"+id+"/labels", bytes.NewBufferString(body))
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid labels
+	w := doRequest("cf1", `{"set":{"team":"foo,bar"}}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = doRequest("cf1", `{"set":`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: changefeed not exists
+	cf2 := model.DefaultChangeFeedID("cf2")
+	etcdClient.EXPECT().UpdateChangeFeedInfo(gomock.Any(), cf2, gomock.Any()).
+		Return(nil, cerrors.ErrChangeFeedNotExists.GenWithStackByArgs(cf2))
+	w = doRequest("cf2", `{"set":{"team":"foo"}}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 3: labels are set and removed
+	info := &model.ChangeFeedInfo{
+		State: model.StateNormal,
+		Config: &config.ReplicaConfig{
+			Labels: map[string]string{"team": "foo", "env": "test"},
+		},
+	}
+	etcdClient.EXPECT().
+		UpdateChangeFeedInfo(gomock.Any(), model.DefaultChangeFeedID("cf1"), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ model.ChangeFeedID,
+			update func(*model.ChangeFeedInfo) error,
+		) (*model.ChangeFeedInfo, error) {
+			if err := update(info); err != nil {
+				return nil, err
+			}
+			return info, nil
+		})
+	w = doRequest("cf1", `{"set":{"env":"prod","app":"order"},"remove":["team"]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := &ChangefeedLabels{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+	expected := map[string]string{"env": "prod", "app": "order"}
+	require.Equal(t, expected, resp.Labels)
+	require.Equal(t, expected, info.Config.Labels)
+	require.Equal(t, model.StateNormal, info.State)
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// getLagSLAStatus gets the compliance of a changefeed with its lag SLA
+// @Summary Get the lag SLA status of a changefeed
+// @Description get whether the checkpoint lag of a changefeed breaches the
+// @Description lag SLA in its replica config, and since when.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Success 200 {object} LagSLAStatus
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/lag_sla [get]
+func (h *OpenAPIV2) getLagSLAStatus(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	status, err := h.capture.StatusProvider().GetLagSLAStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, toAPILagSLAStatus(status))
+}
+
+func toAPILagSLAStatus(status *model.LagSLAStatus) *LagSLAStatus {
+	if status == nil {
+		return &LagSLAStatus{}
+	}
+	res := &LagSLAStatus{
+		Enabled:       true,
+		MaxLagSeconds: int64(status.MaxLag.Seconds()),
+		CheckpointLag: JSONDuration{status.CheckpointLag},
+		Breached:      status.Breached,
+		BreachCount:   status.BreachCount,
+	}
+	if !status.BreachedSince.IsZero() {
+		breachedSince := status.BreachedSince
+		res.BreachedSince = &breachedSince
+	}
+	if !status.CheckTime.IsZero() {
+		checkTime := status.CheckTime
+		res.CheckTime = &checkTime
+	}
+	return res
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLagSLAStatus(t *testing.T) {
+	t.Parallel()
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	apiV2 := NewOpenAPIV2ForTest(cp, helpers)
+	router := newRouter(apiV2)
+
+	statusProvider := &mockStatusProvider{}
+	cp.EXPECT().StatusProvider().Return(statusProvider).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+
+	getStatus := func() *LagSLAStatus {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(),
+			http.MethodGet, "/api/v2/changefeeds/"+changeFeedID.ID+"/lag_sla", nil)
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &LagSLAStatus{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(resp))
+		return resp
+	}
+
+	// no lag SLA
+	resp := getStatus()
+	require.False(t, resp.Enabled)
+
+	breachedSince := time.Now().Add(-time.Minute)
+	statusProvider.lagSLAStatus = &model.LagSLAStatus{
+		MaxLag:        30 * time.Second,
+		CheckpointLag: 90 * time.Second,
+		Breached:      true,
+		BreachedSince: breachedSince,
+		BreachCount:   2,
+		CheckTime:     time.Now(),
+	}
+	resp = getStatus()
+	require.True(t, resp.Enabled)
+	require.True(t, resp.Breached)
+	require.Equal(t, int64(30), resp.MaxLagSeconds)
+	require.Equal(t, 90*time.Second, resp.CheckpointLag.duration)
+	require.Equal(t, 2, resp.BreachCount)
+	require.True(t, breachedSince.Equal(*resp.BreachedSince))
+}
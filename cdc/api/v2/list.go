@@ -0,0 +1,170 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// apiOpVarOffset is the key of the number of items to skip in list APIs
+	apiOpVarOffset = "offset"
+	// apiOpVarNamespace is the key of the namespace filter in list APIs
+	apiOpVarNamespace = "namespace"
+	// apiOpVarKeyword is the key of the keyword filter in list APIs, an item
+	// is returned only if its ID contains the keyword
+	apiOpVarKeyword = "keyword"
+	// apiOpVarSortBy is the key of the sort field in list APIs
+	apiOpVarSortBy = "sort_by"
+	// apiOpVarOrder is the key of the sort order in list APIs
+	apiOpVarOrder = "order"
+	// apiOpVarLabelSelector is the key of the label selector in list APIs
+	apiOpVarLabelSelector = "label_selector"
+)
+
+const (
+	sortByID         = "id"
+	sortByCheckpoint = "checkpoint"
+	sortByLag        = "lag"
+
+	orderAsc  = "asc"
+	orderDesc = "desc"
+)
+
+// pagination is the limit and offset of a list API. A zero limit means
+// returning all items after the offset.
+type pagination struct {
+	limit  int
+	offset int
+}
+
+// parsePagination parses the limit and offset of a list API from the query.
+func parsePagination(c *gin.Context) (pagination, error) {
+	var p pagination
+	if v := c.Query(apiOpVarLimit); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return p, cerror.ErrAPIInvalidParam.GenWithStack("invalid limit: %s", v)
+		}
+		p.limit = limit
+	}
+	if v := c.Query(apiOpVarOffset); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return p, cerror.ErrAPIInvalidParam.GenWithStack("invalid offset: %s", v)
+		}
+		p.offset = offset
+	}
+	return p, nil
+}
+
+// paginate returns the page of items selected by p.
+func paginate[T any](items []T, p pagination) []T {
+	if p.offset >= len(items) {
+		return items[:0]
+	}
+	items = items[p.offset:]
+	if p.limit > 0 && p.limit < len(items) {
+		items = items[:p.limit]
+	}
+	return items
+}
+
+// changefeedListOptions are the filter and sort options of listing
+// changefeeds.
+type changefeedListOptions struct {
+	namespace string
+	keyword   string
+	labels    labelSelector
+	sortBy    string
+	desc      bool
+}
+
+// parseChangefeedListOptions parses the filter and sort options of listing
+// changefeeds from the query.
+func parseChangefeedListOptions(c *gin.Context) (*changefeedListOptions, error) {
+	opts := &changefeedListOptions{
+		namespace: c.Query(apiOpVarNamespace),
+		keyword:   c.Query(apiOpVarKeyword),
+		sortBy:    sortByID,
+	}
+	labels, err := parseLabelSelector(c.Query(apiOpVarLabelSelector))
+	if err != nil {
+		return nil, err
+	}
+	opts.labels = labels
+	if v := c.Query(apiOpVarSortBy); v != "" {
+		switch v {
+		case sortByID, sortByCheckpoint, sortByLag:
+			opts.sortBy = v
+		default:
+			return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid sort_by: %s, it must be one of %s, %s and %s",
+				v, sortByID, sortByCheckpoint, sortByLag)
+		}
+	}
+	switch v := c.Query(apiOpVarOrder); v {
+	case "", orderAsc:
+	case orderDesc:
+		opts.desc = true
+	default:
+		return nil, cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid order: %s, it must be %s or %s", v, orderAsc, orderDesc)
+	}
+	return opts, nil
+}
+
+// match returns true if the changefeed is selected by the filter options.
+func (opts *changefeedListOptions) match(info *ChangefeedCommonInfo) bool {
+	if opts.namespace != "" && info.Namespace != opts.namespace {
+		return false
+	}
+	return strings.Contains(info.ID, opts.keyword) && opts.labels.matches(info.Labels)
+}
+
+// sort sorts the changefeeds by the sort options. The lag of a changefeed
+// only depends on its checkpoint, so sorting by lag is the reverse of
+// sorting by checkpoint.
+func (opts *changefeedListOptions) sort(infos []ChangefeedCommonInfo) {
+	less := func(i, j int) bool {
+		if infos[i].Namespace == infos[j].Namespace {
+			return infos[i].ID < infos[j].ID
+		}
+		return infos[i].Namespace < infos[j].Namespace
+	}
+	desc := opts.desc
+	switch opts.sortBy {
+	case sortByLag:
+		desc = !desc
+		fallthrough
+	case sortByCheckpoint:
+		byID := less
+		less = func(i, j int) bool {
+			if infos[i].CheckpointTSO == infos[j].CheckpointTSO {
+				return byID(i, j)
+			}
+			return infos[i].CheckpointTSO < infos[j].CheckpointTSO
+		}
+	}
+	if desc {
+		asc := less
+		less = func(i, j int) bool { return asc(j, i) }
+	}
+	sort.SliceStable(infos, less)
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	items := []int{1, 2, 3, 4, 5}
+	require.Equal(t, items, paginate(items, pagination{}))
+	require.Equal(t, []int{1, 2}, paginate(items, pagination{limit: 2}))
+	require.Equal(t, []int{4, 5}, paginate(items, pagination{limit: 3, offset: 3}))
+	require.Equal(t, []int{3, 4, 5}, paginate(items, pagination{offset: 2}))
+	require.Empty(t, paginate(items, pagination{offset: 5}))
+	require.Empty(t, paginate([]int{}, pagination{limit: 1}))
+}
+
+func TestSortChangefeeds(t *testing.T) {
+	t.Parallel()
+
+	newInfos := func() []ChangefeedCommonInfo {
+		return []ChangefeedCommonInfo{
+			{Namespace: "ns", ID: "a", CheckpointTSO: 1},
+			{Namespace: "default", ID: "c", CheckpointTSO: 2},
+			{Namespace: "default", ID: "b", CheckpointTSO: 2},
+		}
+	}
+	ids := func(infos []ChangefeedCommonInfo) []string {
+		res := make([]string, 0, len(infos))
+		for _, info := range infos {
+			res = append(res, info.ID)
+		}
+		return res
+	}
+
+	infos := newInfos()
+	(&changefeedListOptions{sortBy: sortByID}).sort(infos)
+	require.Equal(t, []string{"b", "c", "a"}, ids(infos))
+
+	infos = newInfos()
+	(&changefeedListOptions{sortBy: sortByID, desc: true}).sort(infos)
+	require.Equal(t, []string{"a", "c", "b"}, ids(infos))
+
+	infos = newInfos()
+	(&changefeedListOptions{sortBy: sortByCheckpoint}).sort(infos)
+	require.Equal(t, []string{"a", "b", "c"}, ids(infos))
+
+	infos = newInfos()
+	(&changefeedListOptions{sortBy: sortByLag}).sort(infos)
+	require.Equal(t, []string{"c", "b", "a"}, ids(infos))
+}
@@ -62,6 +62,15 @@ type TableName struct {
 	IsPartition bool   `json:"is_partition"`
 }
 
+// IneligibleTables lists the tables without a valid index of a changefeed and
+// how they are handled. The tables are skipped if neither the policy nor
+// force-replicate is set.
+type IneligibleTables struct {
+	Policy         string      `json:"policy,omitempty"`
+	ForceReplicate bool        `json:"force_replicate"`
+	Tables         []TableName `json:"tables"`
+}
+
 // VerifyTableConfig use to verify tables.
 // Only use by Open API v2.
 type VerifyTableConfig struct {
@@ -100,6 +109,7 @@ type ChangefeedCommonInfo struct {
 	CheckpointTSO  uint64              `json:"checkpoint_tso"`
 	CheckpointTime model.JSONTime      `json:"checkpoint_time"`
 	RunningError   *model.RunningError `json:"error"`
+	Labels         map[string]string   `json:"labels,omitempty"`
 }
 
 // ChangefeedConfig use by create changefeed api
@@ -125,6 +135,11 @@ type JSONDuration struct {
 	duration time.Duration
 }
 
+// Duration returns the wrapped duration
+func (d JSONDuration) Duration() time.Duration {
+	return d.duration
+}
+
 // MarshalJSON marshal duration to string
 func (d JSONDuration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.duration.Nanoseconds())
@@ -155,14 +170,18 @@ func (d *JSONDuration) UnmarshalJSON(b []byte) error {
 // ReplicaConfig is a duplicate of  config.ReplicaConfig
 type ReplicaConfig struct {
 	MemoryQuota           uint64 `json:"memory_quota"`
+	MemoryQuotaPriority   int    `json:"memory_quota_priority,omitempty"`
 	CaseSensitive         bool   `json:"case_sensitive"`
 	EnableOldValue        bool   `json:"enable_old_value"`
 	ForceReplicate        bool   `json:"force_replicate"`
 	IgnoreIneligibleTable bool   `json:"ignore_ineligible_table"`
+	IneligibleTablePolicy string `json:"ineligible_table_policy,omitempty"`
 	CheckGCSafePoint      bool   `json:"check_gc_safe_point"`
 	EnableSyncPoint       *bool  `json:"enable_sync_point,omitempty"`
 	BDRMode               *bool  `json:"bdr_mode,omitempty"`
 
+	Labels map[string]string `json:"labels,omitempty"`
+
 	SyncPointInterval  *JSONDuration `json:"sync_point_interval,omitempty" swaggertype:"string"`
 	SyncPointRetention *JSONDuration `json:"sync_point_retention,omitempty" swaggertype:"string"`
 
@@ -172,6 +191,8 @@ type ReplicaConfig struct {
 	Consistent *ConsistentConfig          `json:"consistent,omitempty"`
 	Scheduler  *ChangefeedSchedulerConfig `json:"scheduler"`
 	Integrity  *IntegrityConfig           `json:"integrity"`
+	LagSLA     *LagSLAConfig              `json:"lag_sla,omitempty"`
+	Transform  *TransformConfig           `json:"transform,omitempty"`
 }
 
 // ToInternalReplicaConfig coverts *v2.ReplicaConfig into *config.ReplicaConfig
@@ -184,12 +205,15 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 	res *config.ReplicaConfig,
 ) *config.ReplicaConfig {
 	res.MemoryQuota = c.MemoryQuota
+	res.MemoryQuotaPriority = c.MemoryQuotaPriority
+	res.Labels = c.Labels
 	res.CaseSensitive = c.CaseSensitive
 	res.EnableOldValue = c.EnableOldValue
 	res.ForceReplicate = c.ForceReplicate
 	res.CheckGCSafePoint = c.CheckGCSafePoint
 	res.EnableSyncPoint = c.EnableSyncPoint
 	res.IgnoreIneligibleTable = c.IgnoreIneligibleTable
+	res.IneligibleTablePolicy = c.IneligibleTablePolicy
 	if c.SyncPointInterval != nil {
 		res.SyncPointInterval = &c.SyncPointInterval.duration
 	}
@@ -230,20 +254,40 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				efs[i] = ef.ToInternalEventFilterRule()
 			}
 		}
+		var cfs []*config.ColumnFilterRule
+		for _, cf := range c.Filter.ColumnFilters {
+			cfs = append(cfs, &config.ColumnFilterRule{
+				Matcher:             cf.Matcher,
+				IgnoreColumns:       cf.IgnoreColumns,
+				IgnoreColumnRegexps: cf.IgnoreColumnRegexps,
+			})
+		}
+		var srs []*config.SampleRule
+		for _, sr := range c.Filter.SampleRules {
+			srs = append(srs, &config.SampleRule{
+				Matcher: sr.Matcher,
+				Percent: sr.Percent,
+			})
+		}
 		res.Filter = &config.FilterConfig{
 			Rules:                 c.Filter.Rules,
 			MySQLReplicationRules: mySQLReplicationRules,
 			IgnoreTxnStartTs:      c.Filter.IgnoreTxnStartTs,
 			EventFilters:          efs,
+			ColumnFilters:         cfs,
+			SampleRules:           srs,
 		}
 	}
 	if c.Consistent != nil {
 		res.Consistent = &config.ConsistentConfig{
-			Level:             c.Consistent.Level,
-			MaxLogSize:        c.Consistent.MaxLogSize,
-			FlushIntervalInMs: c.Consistent.FlushIntervalInMs,
-			Storage:           c.Consistent.Storage,
-			UseFileBackend:    c.Consistent.UseFileBackend,
+			Level:                c.Consistent.Level,
+			MaxLogSize:           c.Consistent.MaxLogSize,
+			FlushIntervalInMs:    c.Consistent.FlushIntervalInMs,
+			MaxFlushIntervalInMs: c.Consistent.MaxFlushIntervalInMs,
+			Storage:              c.Consistent.Storage,
+			UseFileBackend:       c.Consistent.UseFileBackend,
+			EncryptionKeyFile:    c.Consistent.EncryptionKeyFile,
+			LogRetentionInMs:     c.Consistent.LogRetentionInMs,
 		}
 	}
 	if c.Sink != nil {
@@ -254,6 +298,8 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				DispatcherRule: "",
 				PartitionRule:  rule.PartitionRule,
 				TopicRule:      rule.TopicRule,
+				ProtocolRule:   rule.ProtocolRule,
+				KeyRule:        rule.KeyRule,
 			})
 		}
 		var columnSelectors []*config.ColumnSelector
@@ -263,6 +309,21 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Columns: selector.Columns,
 			})
 		}
+		var splitUpdateRules []*config.SplitUpdateRule
+		for _, rule := range c.Sink.SplitUpdateRules {
+			splitUpdateRules = append(splitUpdateRules, &config.SplitUpdateRule{
+				Matcher: rule.Matcher,
+				Mode:    rule.Mode,
+			})
+		}
+		var routeRules []*config.RouteRule
+		for _, rule := range c.Sink.RouteRules {
+			routeRules = append(routeRules, &config.RouteRule{
+				Pattern:      rule.Pattern,
+				TargetSchema: rule.TargetSchema,
+				TargetTable:  rule.TargetTable,
+			})
+		}
 		var csvConfig *config.CSVConfig
 		if c.Sink.CSVConfig != nil {
 			csvConfig = &config.CSVConfig{
@@ -270,6 +331,19 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Quote:           c.Sink.CSVConfig.Quote,
 				NullString:      c.Sink.CSVConfig.NullString,
 				IncludeCommitTs: c.Sink.CSVConfig.IncludeCommitTs,
+
+				Escape:               c.Sink.CSVConfig.Escape,
+				OutputFieldHeader:    c.Sink.CSVConfig.OutputFieldHeader,
+				BinaryEncodingMethod: c.Sink.CSVConfig.BinaryEncodingMethod,
+			}
+		}
+		var canalJSONConfig *config.CanalJSONConfig
+		if c.Sink.CanalJSONConfig != nil {
+			canalJSONConfig = &config.CanalJSONConfig{
+				IncludeCommitTs:        c.Sink.CanalJSONConfig.IncludeCommitTs,
+				IncludeChangefeedID:    c.Sink.CanalJSONConfig.IncludeChangefeedID,
+				IncludePhysicalTableID: c.Sink.CanalJSONConfig.IncludePhysicalTableID,
+				Labels:                 c.Sink.CanalJSONConfig.Labels,
 			}
 		}
 		var kafkaConfig *config.KafkaConfig
@@ -283,6 +357,16 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 					AvroEnableWatermark:            oldConfig.AvroEnableWatermark,
 					AvroDecimalHandlingMode:        oldConfig.AvroDecimalHandlingMode,
 					AvroBigintUnsignedHandlingMode: oldConfig.AvroBigintUnsignedHandlingMode,
+					AvroTimePrecisionMode:          oldConfig.AvroTimePrecisionMode,
+					AvroEnumHandlingMode:           oldConfig.AvroEnumHandlingMode,
+					AvroSetHandlingMode:            oldConfig.AvroSetHandlingMode,
+					AvroNullableUnionOrder:         oldConfig.AvroNullableUnionOrder,
+					AvroEnableSchemaChangeEvent:    oldConfig.AvroEnableSchemaChangeEvent,
+					OpenProtocolVersion:            oldConfig.OpenProtocolVersion,
+					EnableMessageChunking:          oldConfig.EnableMessageChunking,
+					EnableEncodingChecksum:         oldConfig.EnableEncodingChecksum,
+					EncoderPlugin:                  oldConfig.EncoderPlugin,
+					EncoderPluginParams:            oldConfig.EncoderPluginParams,
 				}
 			}
 			kafkaConfig = &config.KafkaConfig{
@@ -355,6 +439,7 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			DispatchRules:            dispatchRules,
 			Protocol:                 c.Sink.Protocol,
 			CSVConfig:                csvConfig,
+			CanalJSONConfig:          canalJSONConfig,
 			ColumnSelectors:          columnSelectors,
 			SchemaRegistry:           c.Sink.SchemaRegistry,
 			EncoderConcurrency:       c.Sink.EncoderConcurrency,
@@ -364,6 +449,10 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			FileIndexWidth:           c.Sink.FileIndexWidth,
 			EnableKafkaSinkV2:        c.Sink.EnableKafkaSinkV2,
 			OnlyOutputUpdatedColumns: c.Sink.OnlyOutputUpdatedColumns,
+			SplitUpdateRules:         splitUpdateRules,
+			RouteRules:               routeRules,
+			MetadataColumns:          c.Sink.MetadataColumns,
+			LargeTxnThresholdInMB:    c.Sink.LargeTxnThresholdInMB,
 			KafkaConfig:              kafkaConfig,
 			MySQLConfig:              mysqlConfig,
 			CloudStorageConfig:       cloudStorageConfig,
@@ -393,6 +482,35 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			CorruptionHandleLevel: c.Integrity.CorruptionHandleLevel,
 		}
 	}
+	if c.LagSLA != nil {
+		res.LagSLA = &config.LagSLAConfig{
+			MaxLagSeconds: c.LagSLA.MaxLagSeconds,
+			WebhookURL:    c.LagSLA.WebhookURL,
+		}
+	}
+	if c.Transform != nil {
+		res.Transform = &config.TransformConfig{}
+		for _, rule := range c.Transform.MaskRules {
+			res.Transform.MaskRules = append(res.Transform.MaskRules, &config.MaskRule{
+				Matcher:  rule.Matcher,
+				Columns:  rule.Columns,
+				Function: rule.Function,
+				Value:    rule.Value,
+			})
+		}
+		for _, column := range c.Transform.ComputedColumns {
+			res.Transform.ComputedColumns = append(res.Transform.ComputedColumns,
+				&config.ComputedColumn{
+					Matcher:    column.Matcher,
+					Name:       column.Name,
+					Function:   column.Function,
+					Columns:    column.Columns,
+					Separator:  column.Separator,
+					BucketSize: column.BucketSize,
+					Format:     column.Format,
+				})
+		}
+	}
 	return res
 }
 
@@ -402,10 +520,13 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 
 	res := &ReplicaConfig{
 		MemoryQuota:           cloned.MemoryQuota,
+		MemoryQuotaPriority:   cloned.MemoryQuotaPriority,
+		Labels:                cloned.Labels,
 		CaseSensitive:         cloned.CaseSensitive,
 		EnableOldValue:        cloned.EnableOldValue,
 		ForceReplicate:        cloned.ForceReplicate,
 		IgnoreIneligibleTable: cloned.IgnoreIneligibleTable,
+		IneligibleTablePolicy: cloned.IneligibleTablePolicy,
 		CheckGCSafePoint:      cloned.CheckGCSafePoint,
 		EnableSyncPoint:       cloned.EnableSyncPoint,
 		BDRMode:               cloned.BDRMode,
@@ -453,11 +574,30 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			}
 		}
 
+		var cfs []ColumnFilterRule
+		for _, cf := range cloned.Filter.ColumnFilters {
+			cfs = append(cfs, ColumnFilterRule{
+				Matcher:             cf.Matcher,
+				IgnoreColumns:       cf.IgnoreColumns,
+				IgnoreColumnRegexps: cf.IgnoreColumnRegexps,
+			})
+		}
+
+		var srs []SampleRule
+		for _, sr := range cloned.Filter.SampleRules {
+			srs = append(srs, SampleRule{
+				Matcher: sr.Matcher,
+				Percent: sr.Percent,
+			})
+		}
+
 		res.Filter = &FilterConfig{
 			MySQLReplicationRules: mySQLReplicationRules,
 			Rules:                 cloned.Filter.Rules,
 			IgnoreTxnStartTs:      cloned.Filter.IgnoreTxnStartTs,
 			EventFilters:          efs,
+			ColumnFilters:         cfs,
+			SampleRules:           srs,
 		}
 	}
 	if cloned.Sink != nil {
@@ -467,6 +607,8 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Matcher:       rule.Matcher,
 				PartitionRule: rule.PartitionRule,
 				TopicRule:     rule.TopicRule,
+				ProtocolRule:  rule.ProtocolRule,
+				KeyRule:       rule.KeyRule,
 			})
 		}
 		var columnSelectors []*ColumnSelector
@@ -476,6 +618,21 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var splitUpdateRules []*SplitUpdateRule
+		for _, rule := range cloned.Sink.SplitUpdateRules {
+			splitUpdateRules = append(splitUpdateRules, &SplitUpdateRule{
+				Matcher: rule.Matcher,
+				Mode:    rule.Mode,
+			})
+		}
+		var routeRules []*RouteRule
+		for _, rule := range cloned.Sink.RouteRules {
+			routeRules = append(routeRules, &RouteRule{
+				Pattern:      rule.Pattern,
+				TargetSchema: rule.TargetSchema,
+				TargetTable:  rule.TargetTable,
+			})
+		}
 		var csvConfig *CSVConfig
 		if cloned.Sink.CSVConfig != nil {
 			csvConfig = &CSVConfig{
@@ -483,6 +640,19 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Quote:           cloned.Sink.CSVConfig.Quote,
 				NullString:      cloned.Sink.CSVConfig.NullString,
 				IncludeCommitTs: cloned.Sink.CSVConfig.IncludeCommitTs,
+
+				Escape:               cloned.Sink.CSVConfig.Escape,
+				OutputFieldHeader:    cloned.Sink.CSVConfig.OutputFieldHeader,
+				BinaryEncodingMethod: cloned.Sink.CSVConfig.BinaryEncodingMethod,
+			}
+		}
+		var canalJSONConfig *CanalJSONConfig
+		if cloned.Sink.CanalJSONConfig != nil {
+			canalJSONConfig = &CanalJSONConfig{
+				IncludeCommitTs:        cloned.Sink.CanalJSONConfig.IncludeCommitTs,
+				IncludeChangefeedID:    cloned.Sink.CanalJSONConfig.IncludeChangefeedID,
+				IncludePhysicalTableID: cloned.Sink.CanalJSONConfig.IncludePhysicalTableID,
+				Labels:                 cloned.Sink.CanalJSONConfig.Labels,
 			}
 		}
 		var kafkaConfig *KafkaConfig
@@ -496,6 +666,16 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 					AvroEnableWatermark:            oldConfig.AvroEnableWatermark,
 					AvroDecimalHandlingMode:        oldConfig.AvroDecimalHandlingMode,
 					AvroBigintUnsignedHandlingMode: oldConfig.AvroBigintUnsignedHandlingMode,
+					AvroTimePrecisionMode:          oldConfig.AvroTimePrecisionMode,
+					AvroEnumHandlingMode:           oldConfig.AvroEnumHandlingMode,
+					AvroSetHandlingMode:            oldConfig.AvroSetHandlingMode,
+					AvroNullableUnionOrder:         oldConfig.AvroNullableUnionOrder,
+					AvroEnableSchemaChangeEvent:    oldConfig.AvroEnableSchemaChangeEvent,
+					OpenProtocolVersion:            oldConfig.OpenProtocolVersion,
+					EnableMessageChunking:          oldConfig.EnableMessageChunking,
+					EnableEncodingChecksum:         oldConfig.EnableEncodingChecksum,
+					EncoderPlugin:                  oldConfig.EncoderPlugin,
+					EncoderPluginParams:            oldConfig.EncoderPluginParams,
 				}
 			}
 			kafkaConfig = &KafkaConfig{
@@ -569,6 +749,7 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			SchemaRegistry:           cloned.Sink.SchemaRegistry,
 			DispatchRules:            dispatchRules,
 			CSVConfig:                csvConfig,
+			CanalJSONConfig:          canalJSONConfig,
 			ColumnSelectors:          columnSelectors,
 			EncoderConcurrency:       cloned.Sink.EncoderConcurrency,
 			Terminator:               cloned.Sink.Terminator,
@@ -577,6 +758,10 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			FileIndexWidth:           cloned.Sink.FileIndexWidth,
 			EnableKafkaSinkV2:        cloned.Sink.EnableKafkaSinkV2,
 			OnlyOutputUpdatedColumns: cloned.Sink.OnlyOutputUpdatedColumns,
+			SplitUpdateRules:         splitUpdateRules,
+			RouteRules:               routeRules,
+			MetadataColumns:          cloned.Sink.MetadataColumns,
+			LargeTxnThresholdInMB:    cloned.Sink.LargeTxnThresholdInMB,
 			KafkaConfig:              kafkaConfig,
 			MySQLConfig:              mysqlConfig,
 			CloudStorageConfig:       cloudStorageConfig,
@@ -589,11 +774,14 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 	}
 	if cloned.Consistent != nil {
 		res.Consistent = &ConsistentConfig{
-			Level:             cloned.Consistent.Level,
-			MaxLogSize:        cloned.Consistent.MaxLogSize,
-			FlushIntervalInMs: cloned.Consistent.FlushIntervalInMs,
-			Storage:           cloned.Consistent.Storage,
-			UseFileBackend:    cloned.Consistent.UseFileBackend,
+			Level:                cloned.Consistent.Level,
+			MaxLogSize:           cloned.Consistent.MaxLogSize,
+			FlushIntervalInMs:    cloned.Consistent.FlushIntervalInMs,
+			MaxFlushIntervalInMs: cloned.Consistent.MaxFlushIntervalInMs,
+			Storage:              cloned.Consistent.Storage,
+			UseFileBackend:       cloned.Consistent.UseFileBackend,
+			EncryptionKeyFile:    cloned.Consistent.EncryptionKeyFile,
+			LogRetentionInMs:     cloned.Consistent.LogRetentionInMs,
 		}
 	}
 	if cloned.Mounter != nil {
@@ -615,6 +803,35 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			CorruptionHandleLevel: cloned.Integrity.CorruptionHandleLevel,
 		}
 	}
+	if cloned.LagSLA != nil {
+		res.LagSLA = &LagSLAConfig{
+			MaxLagSeconds: cloned.LagSLA.MaxLagSeconds,
+			WebhookURL:    cloned.LagSLA.WebhookURL,
+		}
+	}
+	if cloned.Transform != nil {
+		res.Transform = &TransformConfig{}
+		for _, rule := range cloned.Transform.MaskRules {
+			res.Transform.MaskRules = append(res.Transform.MaskRules, &MaskRule{
+				Matcher:  rule.Matcher,
+				Columns:  rule.Columns,
+				Function: rule.Function,
+				Value:    rule.Value,
+			})
+		}
+		for _, column := range cloned.Transform.ComputedColumns {
+			res.Transform.ComputedColumns = append(res.Transform.ComputedColumns,
+				&ComputedColumn{
+					Matcher:    column.Matcher,
+					Name:       column.Name,
+					Function:   column.Function,
+					Columns:    column.Columns,
+					Separator:  column.Separator,
+					BucketSize: column.BucketSize,
+					Format:     column.Format,
+				})
+		}
+	}
 
 	return res
 }
@@ -628,9 +845,26 @@ func GetDefaultReplicaConfig() *ReplicaConfig {
 // This is a duplicate of config.FilterConfig
 type FilterConfig struct {
 	*MySQLReplicationRules
-	Rules            []string          `json:"rules,omitempty"`
-	IgnoreTxnStartTs []uint64          `json:"ignore_txn_start_ts,omitempty"`
-	EventFilters     []EventFilterRule `json:"event_filters,omitempty"`
+	Rules            []string           `json:"rules,omitempty"`
+	IgnoreTxnStartTs []uint64           `json:"ignore_txn_start_ts,omitempty"`
+	EventFilters     []EventFilterRule  `json:"event_filters,omitempty"`
+	ColumnFilters    []ColumnFilterRule `json:"column_filters,omitempty"`
+	SampleRules      []SampleRule       `json:"sample_rules,omitempty"`
+}
+
+// SampleRule only replicates a percentage of the rows of the matched tables
+// This is a duplicate of config.SampleRule
+type SampleRule struct {
+	Matcher []string `json:"matcher"`
+	Percent float64  `json:"percent"`
+}
+
+// ColumnFilterRule ignores the columns of the matched tables
+// This is a duplicate of config.ColumnFilterRule
+type ColumnFilterRule struct {
+	Matcher             []string `json:"matcher"`
+	IgnoreColumns       []string `json:"ignore_columns,omitempty"`
+	IgnoreColumnRegexps []string `json:"ignore_column_regexps,omitempty"`
 }
 
 // MounterConfig represents mounter config for a changefeed
@@ -645,10 +879,22 @@ type EventFilterRule struct {
 	// regular expression
 	IgnoreSQL []string `toml:"ignore_sql" json:"ignore_sql"`
 	// sql expression
-	IgnoreInsertValueExpr    string `json:"ignore_insert_value_expr"`
-	IgnoreUpdateNewValueExpr string `json:"ignore_update_new_value_expr"`
-	IgnoreUpdateOldValueExpr string `json:"ignore_update_old_value_expr"`
-	IgnoreDeleteValueExpr    string `json:"ignore_delete_value_expr"`
+	IgnoreInsertValueExpr    string        `json:"ignore_insert_value_expr"`
+	IgnoreUpdateNewValueExpr string        `json:"ignore_update_new_value_expr"`
+	IgnoreUpdateOldValueExpr string        `json:"ignore_update_old_value_expr"`
+	IgnoreDeleteValueExpr    string        `json:"ignore_delete_value_expr"`
+	RowFilterExpr            string        `json:"row_filter_expr,omitempty"`
+	AllowDDL                 []string      `json:"allow_ddl,omitempty"`
+	BlockDDL                 []string      `json:"block_ddl,omitempty"`
+	BlockedDDLAction         string        `json:"blocked_ddl_action,omitempty"`
+	IgnoreValueRegexps       []ValueRegexp `json:"ignore_value_regexps,omitempty"`
+}
+
+// ValueRegexp matches the values of a column by a regular expression
+// This is a duplicate of config.ValueRegexp
+type ValueRegexp struct {
+	Column string `json:"column"`
+	Regexp string `json:"regexp"`
 }
 
 // ToInternalEventFilterRule converts EventFilterRule to *config.EventFilterRule
@@ -660,6 +906,16 @@ func (e EventFilterRule) ToInternalEventFilterRule() *config.EventFilterRule {
 		IgnoreUpdateNewValueExpr: e.IgnoreUpdateNewValueExpr,
 		IgnoreUpdateOldValueExpr: e.IgnoreUpdateOldValueExpr,
 		IgnoreDeleteValueExpr:    e.IgnoreDeleteValueExpr,
+		RowFilterExpr:            e.RowFilterExpr,
+		AllowDDL:                 e.AllowDDL,
+		BlockDDL:                 e.BlockDDL,
+		BlockedDDLAction:         e.BlockedDDLAction,
+	}
+	for _, vr := range e.IgnoreValueRegexps {
+		res.IgnoreValueRegexps = append(res.IgnoreValueRegexps, &config.ValueRegexp{
+			Column: vr.Column,
+			Regexp: vr.Regexp,
+		})
 	}
 	if len(e.IgnoreEvent) != 0 {
 		res.IgnoreEvent = make([]bf.EventType, len(e.IgnoreEvent))
@@ -677,6 +933,22 @@ func ToAPIEventFilterRule(er *config.EventFilterRule) EventFilterRule {
 		IgnoreUpdateNewValueExpr: er.IgnoreUpdateNewValueExpr,
 		IgnoreUpdateOldValueExpr: er.IgnoreUpdateOldValueExpr,
 		IgnoreDeleteValueExpr:    er.IgnoreDeleteValueExpr,
+		RowFilterExpr:            er.RowFilterExpr,
+		BlockedDDLAction:         er.BlockedDDLAction,
+	}
+	for _, vr := range er.IgnoreValueRegexps {
+		res.IgnoreValueRegexps = append(res.IgnoreValueRegexps, ValueRegexp{
+			Column: vr.Column,
+			Regexp: vr.Regexp,
+		})
+	}
+	if len(er.AllowDDL) != 0 {
+		res.AllowDDL = make([]string, len(er.AllowDDL))
+		copy(res.AllowDDL, er.AllowDDL)
+	}
+	if len(er.BlockDDL) != 0 {
+		res.BlockDDL = make([]string, len(er.BlockDDL))
+		copy(res.BlockDDL, er.BlockDDL)
 	}
 	if len(er.Matcher) != 0 {
 		res.Matcher = make([]string, len(er.Matcher))
@@ -722,6 +994,7 @@ type SinkConfig struct {
 	Protocol                 *string             `json:"protocol,omitempty"`
 	SchemaRegistry           *string             `json:"schema_registry,omitempty"`
 	CSVConfig                *CSVConfig          `json:"csv,omitempty"`
+	CanalJSONConfig          *CanalJSONConfig    `json:"canal_json,omitempty"`
 	DispatchRules            []*DispatchRule     `json:"dispatchers,omitempty"`
 	ColumnSelectors          []*ColumnSelector   `json:"column_selectors,omitempty"`
 	TxnAtomicity             *string             `json:"transaction_atomicity,omitempty"`
@@ -732,6 +1005,10 @@ type SinkConfig struct {
 	FileIndexWidth           *int                `json:"file_index_width,omitempty"`
 	EnableKafkaSinkV2        *bool               `json:"enable_kafka_sink_v2,omitempty"`
 	OnlyOutputUpdatedColumns *bool               `json:"only_output_updated_columns,omitempty"`
+	SplitUpdateRules         []*SplitUpdateRule  `json:"split_update_rules,omitempty"`
+	RouteRules               []*RouteRule        `json:"route_rules,omitempty"`
+	MetadataColumns          []string            `json:"metadata_columns,omitempty"`
+	LargeTxnThresholdInMB    *uint64             `json:"large_txn_threshold_in_mb,omitempty"`
 	SafeMode                 *bool               `json:"safe_mode,omitempty"`
 	KafkaConfig              *KafkaConfig        `json:"kafka_config,omitempty"`
 	MySQLConfig              *MySQLConfig        `json:"mysql_config,omitempty"`
@@ -745,6 +1022,19 @@ type CSVConfig struct {
 	Quote           string `json:"quote"`
 	NullString      string `json:"null"`
 	IncludeCommitTs bool   `json:"include_commit_ts"`
+
+	Escape               string `json:"escape,omitempty"`
+	OutputFieldHeader    bool   `json:"output_field_header,omitempty"`
+	BinaryEncodingMethod string `json:"binary_encoding_method,omitempty"`
+}
+
+// CanalJSONConfig denotes the canal-json config
+// This is the same as config.CanalJSONConfig
+type CanalJSONConfig struct {
+	IncludeCommitTs        *bool             `json:"include_commit_ts,omitempty"`
+	IncludeChangefeedID    bool              `json:"include_changefeed_id,omitempty"`
+	IncludePhysicalTableID bool              `json:"include_physical_table_id,omitempty"`
+	Labels                 map[string]string `json:"labels,omitempty"`
 }
 
 // DispatchRule represents partition rule for a table
@@ -753,6 +1043,8 @@ type DispatchRule struct {
 	Matcher       []string `json:"matcher,omitempty"`
 	PartitionRule string   `json:"partition"`
 	TopicRule     string   `json:"topic"`
+	ProtocolRule  string   `json:"protocol,omitempty"`
+	KeyRule       string   `json:"key,omitempty"`
 }
 
 // ColumnSelector represents a column selector for a table.
@@ -762,14 +1054,33 @@ type ColumnSelector struct {
 	Columns []string `json:"columns,omitempty"`
 }
 
+// SplitUpdateRule decides whether the updates of the matched tables are
+// emitted as delete and insert pairs.
+// This is a duplicate of config.SplitUpdateRule
+type SplitUpdateRule struct {
+	Matcher []string `json:"matcher"`
+	Mode    string   `json:"mode"`
+}
+
+// RouteRule renames the schema and table of the tables matching a pattern.
+// This is a duplicate of config.RouteRule
+type RouteRule struct {
+	Pattern      string `json:"pattern"`
+	TargetSchema string `json:"target_schema"`
+	TargetTable  string `json:"target_table"`
+}
+
 // ConsistentConfig represents replication consistency config for a changefeed
 // This is a duplicate of config.ConsistentConfig
 type ConsistentConfig struct {
-	Level             string `json:"level,omitempty"`
-	MaxLogSize        int64  `json:"max_log_size"`
-	FlushIntervalInMs int64  `json:"flush_interval"`
-	Storage           string `json:"storage,omitempty"`
-	UseFileBackend    bool   `json:"use_file_backend"`
+	Level                string `json:"level,omitempty"`
+	MaxLogSize           int64  `json:"max_log_size"`
+	FlushIntervalInMs    int64  `json:"flush_interval"`
+	MaxFlushIntervalInMs int64  `json:"max_flush_interval,omitempty"`
+	Storage              string `json:"storage,omitempty"`
+	UseFileBackend       bool   `json:"use_file_backend"`
+	EncryptionKeyFile    string `json:"encryption_key_file,omitempty"`
+	LogRetentionInMs     int64  `json:"log_retention,omitempty"`
 }
 
 // ChangefeedSchedulerConfig is per changefeed scheduler settings.
@@ -791,6 +1102,42 @@ type IntegrityConfig struct {
 	CorruptionHandleLevel string `json:"corruption_handle_level"`
 }
 
+// LagSLAConfig is the objective of the checkpoint lag of a changefeed
+// This is a duplicate of config.LagSLAConfig
+type LagSLAConfig struct {
+	MaxLagSeconds int64  `json:"max_lag_seconds"`
+	WebhookURL    string `json:"webhook_url,omitempty"`
+}
+
+// TransformConfig represents the transformations applied to the row changes
+// This is a duplicate of config.TransformConfig
+type TransformConfig struct {
+	MaskRules       []*MaskRule       `json:"mask_rules,omitempty"`
+	ComputedColumns []*ComputedColumn `json:"computed_columns,omitempty"`
+}
+
+// ComputedColumn appends a computed column to the row changes of the matched
+// tables
+// This is a duplicate of config.ComputedColumn
+type ComputedColumn struct {
+	Matcher    []string `json:"matcher"`
+	Name       string   `json:"name"`
+	Function   string   `json:"function"`
+	Columns    []string `json:"columns,omitempty"`
+	Separator  string   `json:"separator,omitempty"`
+	BucketSize int64    `json:"bucket_size,omitempty"`
+	Format     string   `json:"format,omitempty"`
+}
+
+// MaskRule masks the values of the matched columns of the matched tables
+// This is a duplicate of config.MaskRule
+type MaskRule struct {
+	Matcher  []string `json:"matcher"`
+	Columns  []string `json:"columns"`
+	Function string   `json:"function"`
+	Value    string   `json:"value,omitempty"`
+}
+
 // EtcdData contains key/value pair of etcd data
 type EtcdData struct {
 	Key   string `json:"key,omitempty"`
@@ -888,6 +1235,62 @@ type ProcessorDetail struct {
 	Tables []int64 `json:"table_ids"`
 }
 
+// TableStall holds the regions which hold back the resolved ts of a table,
+// sorted by their resolved ts.
+type TableStall struct {
+	CaptureID string        `json:"capture_id"`
+	Regions   []RegionStall `json:"regions"`
+}
+
+// RegionStall holds the info of a region which holds back the resolved ts of
+// a table.
+type RegionStall struct {
+	RegionID   uint64 `json:"region_id"`
+	StoreID    uint64 `json:"store_id"`
+	StoreAddr  string `json:"store_addr"`
+	StartKey   string `json:"start_key"`
+	EndKey     string `json:"end_key"`
+	ResolvedTs uint64 `json:"resolved_ts"`
+	// Initialized is false if the incremental scan of the region is not
+	// finished yet.
+	Initialized bool `json:"initialized"`
+	// Lag is the duration between the resolved ts of the region and now.
+	Lag   JSONDuration `json:"lag"`
+	Locks []RegionLock `json:"locks"`
+}
+
+// RegionLock holds the info of a lock in a region.
+type RegionLock struct {
+	Key      string `json:"key"`
+	Primary  string `json:"primary"`
+	StartTs  uint64 `json:"start_ts"`
+	TTL      uint64 `json:"ttl"`
+	TxnSize  uint64 `json:"txn_size"`
+	LockType string `json:"lock_type"`
+}
+
+// TableSorterMetrics is a snapshot of the sorter metrics of a table.
+type TableSorterMetrics struct {
+	CaptureID       string       `json:"capture_id"`
+	IterSeekLatency LatencyStats `json:"iter_seek_latency"`
+	IterNextLatency LatencyStats `json:"iter_next_latency"`
+	// ResolvedTsDelay is the duration from the latest resolved ts is added
+	// into the sorter to it's available for fetching.
+	ResolvedTsDelay JSONDuration `json:"resolved_ts_delay"`
+	OnDiskBytes     uint64       `json:"on_disk_bytes"`
+	// PendingCompactionBytes is shared by all tables in the same db.
+	PendingCompactionBytes uint64 `json:"pending_compaction_bytes"`
+}
+
+// LatencyStats holds the percentiles of the recent latency samples.
+type LatencyStats struct {
+	Count uint64       `json:"count"`
+	P50   JSONDuration `json:"p50"`
+	P90   JSONDuration `json:"p90"`
+	P99   JSONDuration `json:"p99"`
+	Max   JSONDuration `json:"max"`
+}
+
 // Liveness is the liveness status of a capture.
 // Liveness can only be changed from alive to stopping, and no way back.
 type Liveness int32
@@ -905,10 +1308,36 @@ type ServerStatus struct {
 
 // Capture holds common information of a capture in cdc
 type Capture struct {
-	ID            string `json:"id"`
-	IsOwner       bool   `json:"is_owner"`
-	AdvertiseAddr string `json:"address"`
-	ClusterID     string `json:"cluster_id"`
+	ID            string            `json:"id"`
+	IsOwner       bool              `json:"is_owner"`
+	AdvertiseAddr string            `json:"address"`
+	ClusterID     string            `json:"cluster_id"`
+	Version       string            `json:"version,omitempty"`
+	GitHash       string            `json:"git_hash,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	// Stats is only returned if the details of captures are requested.
+	Stats *CaptureStats `json:"stats,omitempty"`
+}
+
+// CaptureStats is the statistics of the tables and resources of a capture
+type CaptureStats struct {
+	TableCount  int            `json:"table_count"`
+	RegionCount uint64         `json:"region_count"`
+	Resource    *ResourceUsage `json:"resource,omitempty"`
+	// ResourceError is the error of collecting the resource usage of the
+	// capture, e.g. the capture is unreachable.
+	ResourceError string `json:"resource_error,omitempty"`
+}
+
+// ResourceUsage is the resource usage of a capture
+type ResourceUsage struct {
+	// MemoryUsage is the memory obtained from the OS by the capture in bytes.
+	MemoryUsage uint64 `json:"memory_usage"`
+	HeapInuse   uint64 `json:"heap_inuse"`
+	Goroutines  int    `json:"goroutines"`
+	SortDir     string `json:"sort_dir"`
+	// SortDirUsage is the total size of the files in the sort dir in bytes.
+	SortDirUsage uint64 `json:"sort_dir_usage"`
 }
 
 // CodecConfig represents a MQ codec configuration
@@ -918,6 +1347,17 @@ type CodecConfig struct {
 	AvroEnableWatermark            *bool   `json:"avro_enable_watermark"`
 	AvroDecimalHandlingMode        *string `json:"avro_decimal_handling_mode,omitempty"`
 	AvroBigintUnsignedHandlingMode *string `json:"avro_bigint_unsigned_handling_mode,omitempty"`
+	AvroTimePrecisionMode          *string `json:"avro_time_precision_mode,omitempty"`
+	AvroEnumHandlingMode           *string `json:"avro_enum_handling_mode,omitempty"`
+	AvroSetHandlingMode            *string `json:"avro_set_handling_mode,omitempty"`
+	AvroNullableUnionOrder         *string `json:"avro_nullable_union_order,omitempty"`
+	AvroEnableSchemaChangeEvent    *bool   `json:"avro_enable_schema_change_event,omitempty"`
+	OpenProtocolVersion            *uint64 `json:"open_protocol_version,omitempty"`
+	EnableMessageChunking          *bool   `json:"enable_message_chunking,omitempty"`
+	EnableEncodingChecksum         *bool   `json:"enable_encoding_checksum,omitempty"`
+
+	EncoderPlugin       *string           `json:"encoder_plugin,omitempty"`
+	EncoderPluginParams map[string]string `json:"encoder_plugin_params,omitempty"`
 }
 
 // KafkaConfig represents a kafka sink configuration
@@ -992,3 +1432,344 @@ type ChangefeedStatus struct {
 	LastError    *RunningError `json:"last_error,omitempty"`
 	LastWarning  *RunningError `json:"last_warning,omitempty"`
 }
+
+// ChangefeedSelector selects changefeeds for a batch operation, a changefeed
+// is selected if it matches all the non-empty conditions.
+type ChangefeedSelector struct {
+	// IDs are the IDs of the selected changefeeds.
+	IDs []string `json:"ids,omitempty"`
+	// Namespace is the namespace of the selected changefeeds, the default
+	// namespace is used to find changefeeds by IDs if it's empty.
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector is a comma separated list of key=value and key!=value
+	// requirements, such as "env=prod,team!=foo", which must all be met by
+	// the labels of the selected changefeeds.
+	LabelSelector string `json:"label_selector,omitempty"`
+}
+
+// ChangefeedLabelsPatch is the request body of updating the labels of a
+// changefeed.
+type ChangefeedLabelsPatch struct {
+	// Set are the labels to add or overwrite.
+	Set map[string]string `json:"set,omitempty"`
+	// Remove are the keys of the labels to remove.
+	Remove []string `json:"remove,omitempty"`
+}
+
+// ChangefeedLabels are the labels of a changefeed.
+type ChangefeedLabels struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// BatchUpdateChangefeedConfig is the request body of updating changefeeds in
+// a batch, the patch is merged into the config of every selected changefeed.
+type BatchUpdateChangefeedConfig struct {
+	Selector ChangefeedSelector     `json:"selector"`
+	Patch    *ChangefeedConfigPatch `json:"patch"`
+}
+
+// ChangefeedConfigPatch is the change of the configs of changefeeds updated in
+// a batch. The settings of each changefeed not present in the patch, such as
+// its sink URI and labels, are kept.
+type ChangefeedConfigPatch struct {
+	TargetTs uint64 `json:"target_ts,omitempty"`
+	// ReplicaConfig is merged into the replica config of each changefeed,
+	// only the fields present in it are changed and the maps in it, such
+	// as labels, are merged key by key.
+	ReplicaConfig json.RawMessage `json:"replica_config,omitempty"`
+}
+
+// ChangefeedOperationResult is the result of the operation on a changefeed
+// in a batch.
+type ChangefeedOperationResult struct {
+	Namespace string           `json:"namespace"`
+	ID        string           `json:"id"`
+	Error     *model.HTTPError `json:"error,omitempty"`
+}
+
+const (
+	// StatusStreamEventStatus is the name of the events pushed by the
+	// changefeed status stream with a ChangefeedStatusEvent payload.
+	StatusStreamEventStatus = "status"
+	// StatusStreamEventError is the name of the events pushed by the
+	// changefeed status stream with a RunningError payload when a new error
+	// occurs.
+	StatusStreamEventError = "error"
+	// StatusStreamEventClose is the name of the last event pushed by the
+	// changefeed status stream with a model.HTTPError payload, e.g. the
+	// changefeed is removed.
+	StatusStreamEventClose = "close"
+)
+
+// ChangefeedStatusEvent is the payload of a status event pushed by the
+// changefeed status stream.
+type ChangefeedStatusEvent struct {
+	ChangefeedStatus
+	// CheckpointLag is the lag of the checkpoint ts in nanoseconds.
+	CheckpointLag JSONDuration `json:"checkpoint_lag" swaggertype:"integer"`
+}
+
+// ChangefeedDryRunResult is the result of verifying a changefeed config
+// without creating the changefeed.
+type ChangefeedDryRunResult struct {
+	// Info is the changefeed that would be created with the config, its
+	// replica config is merged with the default one.
+	Info *ChangeFeedInfo `json:"info"`
+	// Tables are the tables the changefeed would replicate.
+	Tables []DryRunTable `json:"tables,omitempty"`
+	// Warnings are the adjustments made to the config and the potential
+	// problems found while verifying it.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// DryRunTable is a table the changefeed would replicate and its dispatch
+// target, the target is only set for MQ sinks.
+type DryRunTable struct {
+	Schema        string `json:"schema"`
+	Table         string `json:"table"`
+	TableID       int64  `json:"table_id"`
+	Topic         string `json:"topic,omitempty"`
+	PartitionRule string `json:"partition_rule,omitempty"`
+}
+
+// AuthTokenConfig is the request body of issuing or rotating an API token
+type AuthTokenConfig struct {
+	Name string `json:"name"`
+	// Role is one of viewer, operator and admin.
+	Role string `json:"role"`
+	// Namespace is the namespace the role is granted in, the role is
+	// granted in all namespaces if it's empty or "*".
+	Namespace string `json:"namespace,omitempty"`
+	// TTL is the ttl of the token, the auth.token-ttl of the server is used
+	// if it's 0.
+	TTL JSONDuration `json:"ttl" swaggertype:"integer"`
+}
+
+// AuthToken is an API token without its secret
+type AuthToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Role       string     `json:"role"`
+	Namespace  string     `json:"namespace"`
+	CreateTime time.Time  `json:"create_time"`
+	ExpireTime *time.Time `json:"expire_time,omitempty"`
+}
+
+// IssuedAuthToken is an API token with its secret, which is only returned
+// once when the token is issued or rotated.
+type IssuedAuthToken struct {
+	AuthToken
+	Secret string `json:"secret"`
+}
+
+// Actions taken to apply a changefeed spec.
+const (
+	ChangefeedApplyCreated   = "created"
+	ChangefeedApplyUpdated   = "updated"
+	ChangefeedApplyUnchanged = "unchanged"
+)
+
+// ChangefeedApplyResult is the result of applying a changefeed spec
+type ChangefeedApplyResult struct {
+	// Action is one of created, updated and unchanged.
+	Action string `json:"action"`
+	// Diff is the fields changed by the spec, it's empty if the changefeed
+	// is created or unchanged.
+	Diff       []ChangefeedConfigDiff `json:"diff,omitempty"`
+	Changefeed *ChangeFeedInfo        `json:"changefeed"`
+}
+
+// ChangefeedConfigDiff is a field of a changefeed changed by a spec, the
+// nested fields of the replica config are joined by dots.
+type ChangefeedConfigDiff struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// ChangefeedExportVersion is the version of the changefeed export document
+const ChangefeedExportVersion = 1
+
+// Start ts strategies of importing changefeeds.
+const (
+	// StartTsStrategyCheckpoint starts the imported changefeeds from their
+	// exported checkpoint ts, which is used to rebuild a cluster replicating
+	// the same upstream.
+	StartTsStrategyCheckpoint = "checkpoint"
+	// StartTsStrategyOriginal starts the imported changefeeds from their
+	// original start ts.
+	StartTsStrategyOriginal = "original"
+	// StartTsStrategyNow starts the imported changefeeds from the current ts
+	// of the upstream.
+	StartTsStrategyNow = "now"
+)
+
+// ChangefeedExportDocument holds the specs of all changefeeds in a namespace
+type ChangefeedExportDocument struct {
+	Version     int              `json:"version"`
+	Namespace   string           `json:"namespace"`
+	ExportTime  time.Time        `json:"export_time"`
+	Changefeeds []ChangefeedSpec `json:"changefeeds"`
+}
+
+// ChangefeedSpec is the exported spec of a changefeed. The upstream of the
+// changefeed is not exported, since it's specific to the cluster.
+type ChangefeedSpec struct {
+	ID            string          `json:"changefeed_id"`
+	SinkURI       string          `json:"sink_uri"`
+	StartTs       uint64          `json:"start_ts"`
+	TargetTs      uint64          `json:"target_ts"`
+	CheckpointTs  uint64          `json:"checkpoint_ts"`
+	State         model.FeedState `json:"state"`
+	ReplicaConfig *ReplicaConfig  `json:"replica_config"`
+}
+
+// PauseTablesConfig is the request body of pausing or resuming tables of a
+// changefeed.
+type PauseTablesConfig struct {
+	TableIDs []int64 `json:"table_ids"`
+}
+
+// HandleErrorConfig is the request body of handling an event which can't be
+// applied to the downstream, the event is identified by its commit ts and
+// table.
+type HandleErrorConfig struct {
+	CommitTs uint64 `json:"commit_ts"`
+	Schema   string `json:"schema"`
+	// Table is empty for the DDL events of a schema.
+	Table string `json:"table"`
+	// Action is one of skip and replace. Skip drops every row change of the
+	// table committed at the commit ts, not a single row.
+	Action string `json:"action"`
+	// Statement replaces the DDL event if the action is replace.
+	Statement string `json:"statement,omitempty"`
+}
+
+// ToErrorHandle converts the config to an error handle of a changefeed.
+func (c *HandleErrorConfig) ToErrorHandle() *model.ErrorHandle {
+	return &model.ErrorHandle{
+		CommitTs:  c.CommitTs,
+		Schema:    c.Schema,
+		Table:     c.Table,
+		Action:    model.ErrorHandleAction(c.Action),
+		Statement: c.Statement,
+	}
+}
+
+// ErrorHandles are the error handles of a changefeed.
+type ErrorHandles struct {
+	Handles []HandleErrorConfig `json:"handles"`
+}
+
+// ChangefeedErrorRecord is a record in the error history of a changefeed.
+type ChangefeedErrorRecord struct {
+	// Level is one of error and warning.
+	Level string `json:"level"`
+	// Time is the last time the error happened, FirstTime is the first
+	// time it happened in a row.
+	Time      time.Time `json:"time"`
+	FirstTime time.Time `json:"first_time"`
+	Count     int       `json:"count"`
+	// Capture is the address of the capture reporting the error.
+	Capture string `json:"capture"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// TableProgress is the replication progress of a table in a changefeed.
+type TableProgress struct {
+	TableID int64 `json:"table_id"`
+	// Captures are the captures replicating the spans of the table.
+	Captures []string `json:"captures"`
+	// Phase is one of absent, scanning, replicating, removing, excluded
+	// and catching-up.
+	Phase          string         `json:"phase"`
+	CheckpointTs   uint64         `json:"checkpoint_ts"`
+	ResolvedTs     uint64         `json:"resolved_ts"`
+	CheckpointTime model.JSONTime `json:"checkpoint_time"`
+	// CheckpointLag is the lag of the checkpoint ts in nanoseconds.
+	CheckpointLag JSONDuration `json:"checkpoint_lag" swaggertype:"integer"`
+	SpanCount     int          `json:"span_count"`
+}
+
+// LagSLAStatus is the compliance of a changefeed with its lag SLA.
+type LagSLAStatus struct {
+	// Enabled is false if the changefeed has no lag SLA, or it's not
+	// tracked by the owner, e.g. the changefeed is stopped.
+	Enabled       bool         `json:"enabled"`
+	MaxLagSeconds int64        `json:"max_lag_seconds,omitempty"`
+	CheckpointLag JSONDuration `json:"checkpoint_lag" swaggertype:"integer"`
+	Breached      bool         `json:"breached"`
+	BreachedSince *time.Time   `json:"breached_since,omitempty"`
+	BreachCount   int          `json:"breach_count"`
+	CheckTime     *time.Time   `json:"check_time,omitempty"`
+}
+
+// ChangefeedDrift is the difference between the changefeed info stored in
+// etcd and the info currently in effect, after the defaults, migrations and
+// adjustments of the owner are applied.
+type ChangefeedDrift struct {
+	State string `json:"state"`
+	// Diff is the fields differing between the infos, Old is the stored
+	// value and New is the effective value. The fields are in the format
+	// stored in etcd, and the nested fields are joined by dots.
+	Diff []ChangefeedConfigDiff `json:"diff"`
+}
+
+// Statuses of a health report or a health check.
+const (
+	HealthStatusOK       = "OK"
+	HealthStatusDegraded = "DEGRADED"
+	HealthStatusFail     = "FAIL"
+)
+
+// HealthReport is the health of a TiCDC node and the subsystems it depends
+// on, Status is the worst status of the checks.
+type HealthReport struct {
+	Status    string        `json:"status"`
+	ID        string        `json:"id"`
+	IsOwner   bool          `json:"is_owner"`
+	CheckTime time.Time     `json:"check_time"`
+	Checks    []HealthCheck `json:"checks"`
+	// Changefeeds are sorted by the checkpoint lag in descending order.
+	Changefeeds []ChangefeedHealth `json:"changefeeds,omitempty"`
+}
+
+// HealthCheck is the health of a subsystem.
+type HealthCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ChangefeedHealth is the health of a changefeed.
+type ChangefeedHealth struct {
+	Namespace string `json:"namespace"`
+	ID        string `json:"id"`
+	State     string `json:"state"`
+	// CheckpointLag is the lag of the checkpoint ts in nanoseconds.
+	CheckpointLag JSONDuration `json:"checkpoint_lag" swaggertype:"integer"`
+	// SinkError is the error of probing the sink, it's only set if the sink
+	// is probed.
+	SinkError string `json:"sink_error,omitempty"`
+}
+
+// ChangefeedDiagnosis is the result of diagnosing a changefeed end to end,
+// Status is the worst status of the findings.
+type ChangefeedDiagnosis struct {
+	Namespace string             `json:"namespace"`
+	ID        string             `json:"id"`
+	Status    string             `json:"status"`
+	Findings  []DiagnosisFinding `json:"findings"`
+}
+
+// DiagnosisFinding is the result of a check of a changefeed diagnosis, the
+// status is one of the health statuses.
+type DiagnosisFinding struct {
+	Check   string `json:"check"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	// Suggestion is the action to fix the problems found, it's empty if the
+	// status is OK.
+	Suggestion string `json:"suggestion,omitempty"`
+}
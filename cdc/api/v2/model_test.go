@@ -111,6 +111,13 @@ func TestToAPIReplicaConfig(t *testing.T) {
 		},
 		SchemaRegistry: util.AddressOf("bbb"),
 		TxnAtomicity:   util.AddressOf(config.AtomicityLevel("aa")),
+		SplitUpdateRules: []*config.SplitUpdateRule{
+			{Matcher: []string{"test.*"}, Mode: config.SplitUpdateModeAll},
+		},
+		RouteRules: []*config.RouteRule{
+			{Pattern: `db_(\d+)\.orders`, TargetSchema: "db_all"},
+		},
+		MetadataColumns: []string{config.MetadataColumnCommitTs, config.MetadataColumnOp},
 	}
 	cfg.Consistent = &config.ConsistentConfig{
 		Level:             "1",
@@ -143,9 +150,39 @@ func TestToAPIReplicaConfig(t *testing.T) {
 			IgnoreUpdateNewValueExpr: "age <= 55",
 			IgnoreUpdateOldValueExpr: "age >= 84",
 			IgnoreDeleteValueExpr:    "age > 20",
+			RowFilterExpr:            "region = 'eu'",
+			IgnoreValueRegexps: []*config.ValueRegexp{
+				{Column: "email", Regexp: "@pingcap[.]com$"},
+			},
+		}},
+		ColumnFilters: []*config.ColumnFilterRule{{
+			Matcher:             []string{"test.t1"},
+			IgnoreColumns:       []string{"avatar"},
+			IgnoreColumnRegexps: []string{"^audit_"},
+		}},
+		SampleRules: []*config.SampleRule{{
+			Matcher: []string{"test.t1"},
+			Percent: 12.5,
 		}},
 	}
 	cfg.Mounter = &config.MounterConfig{WorkerNum: 11}
+	cfg.Transform = &config.TransformConfig{
+		MaskRules: []*config.MaskRule{{
+			Matcher:  []string{"test.t1"},
+			Columns:  []string{"phone"},
+			Function: config.MaskFunctionFixed,
+			Value:    "x",
+		}},
+		ComputedColumns: []*config.ComputedColumn{{
+			Matcher:    []string{"test.t1"},
+			Name:       "id_bucket",
+			Function:   config.ComputeFunctionBucket,
+			Columns:    []string{"id"},
+			BucketSize: 100,
+		}},
+	}
+	cfg.Labels = map[string]string{"env": "prod"}
+	cfg.IneligibleTablePolicy = config.IneligibleTablePolicySkip
 	cfg.Scheduler = &config.ChangefeedSchedulerConfig{
 		EnableTableAcrossNodes: true, RegionThreshold: 10001, WriteKeyThreshold: 10001,
 	}
@@ -210,6 +247,10 @@ func TestEventFilterRuleConvert(t *testing.T) {
 				IgnoreUpdateNewValueExpr: "age <= 55",
 				IgnoreUpdateOldValueExpr: "age >= 84",
 				IgnoreDeleteValueExpr:    "age > 20",
+				RowFilterExpr:            "region = 'eu'",
+				AllowDDL:                 []string{"add column"},
+				BlockDDL:                 []string{"drop table"},
+				BlockedDDLAction:         "pause",
 			},
 			apiRule: EventFilterRule{
 				Matcher:                  []string{"test.t1", "test.t2"},
@@ -219,6 +260,10 @@ func TestEventFilterRuleConvert(t *testing.T) {
 				IgnoreUpdateNewValueExpr: "age <= 55",
 				IgnoreUpdateOldValueExpr: "age >= 84",
 				IgnoreDeleteValueExpr:    "age > 20",
+				RowFilterExpr:            "region = 'eu'",
+				AllowDDL:                 []string{"add column"},
+				BlockDDL:                 []string{"drop table"},
+				BlockedDDLAction:         "pause",
 			},
 		},
 	}
@@ -16,6 +16,7 @@ package v2
 import (
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -34,7 +35,7 @@ import (
 // @Router	/api/v2/processors/{changefeed_id}/{capture_id} [get]
 func (h *OpenAPIV2) getProcessor(c *gin.Context) {
 	ctx := c.Request.Context()
-	changefeedID := model.DefaultChangeFeedID(c.Param(apiOpVarChangefeedID))
+	changefeedID := getChangefeedID(c)
 	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
 		_ = c.Error(
 			cerror.ErrAPIInvalidParam.GenWithStack(
@@ -123,11 +124,18 @@ func (h *OpenAPIV2) getProcessor(c *gin.Context) {
 // @Description list all processors in the TiCDC cluster
 // @Tags processor,v2
 // @Produce json
+// @Param limit query integer false "max number of processors, unlimited by default"
+// @Param offset query integer false "number of processors to skip"
 // @Success 200 {array} ProcessorCommonInfo
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/processors [get]
 func (h *OpenAPIV2) listProcessors(c *gin.Context) {
 	ctx := c.Request.Context()
+	page, err := parsePagination(c)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
 	infos, err := h.capture.StatusProvider().GetProcessors(ctx)
 	if err != nil {
 		_ = c.Error(err)
@@ -142,9 +150,18 @@ func (h *OpenAPIV2) listProcessors(c *gin.Context) {
 		}
 		prcInfos[i] = resp
 	}
+	sort.Slice(prcInfos, func(i, j int) bool {
+		if prcInfos[i].Namespace != prcInfos[j].Namespace {
+			return prcInfos[i].Namespace < prcInfos[j].Namespace
+		}
+		if prcInfos[i].ChangeFeedID != prcInfos[j].ChangeFeedID {
+			return prcInfos[i].ChangeFeedID < prcInfos[j].ChangeFeedID
+		}
+		return prcInfos[i].CaptureID < prcInfos[j].CaptureID
+	})
 	resp := &ListResponse[ProcessorCommonInfo]{
 		Total: len(prcInfos),
-		Items: prcInfos,
+		Items: paginate(prcInfos, page),
 	}
 
 	c.JSON(http.StatusOK, resp)
@@ -0,0 +1,206 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/httputil"
+	"go.uber.org/zap"
+)
+
+const (
+	resourceUsagePath = "/api/v2/resource_usage"
+	// collectResourceTimeout is the timeout of collecting the resource usage
+	// of a capture, so that an unreachable capture doesn't block listing.
+	collectResourceTimeout = 5 * time.Second
+	// sortDirUsageTTL is how long the sort dir usage is cached, walking a
+	// large sort dir on every request is expensive.
+	sortDirUsageTTL = 30 * time.Second
+)
+
+// sortDirUsageCache caches the disk usage of the sort dir.
+var sortDirUsageCache struct {
+	sync.Mutex
+	dir       string
+	usage     uint64
+	updatedAt time.Time
+}
+
+// getResourceUsage gets the resource usage of the capture serving the request
+// @Summary Get the resource usage of a capture
+// @Description get the memory, goroutines and sort dir usage of the capture
+// @Description serving the request, it's not forwarded to the owner.
+// @Tags capture,v2
+// @Produce json
+// @Success 200 {object} ResourceUsage
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/resource_usage [get]
+func (h *OpenAPIV2) getResourceUsage(c *gin.Context) {
+	usage, err := collectResourceUsage(config.GetGlobalServerConfig().Sorter.SortDir)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, usage)
+}
+
+func collectResourceUsage(sortDir string) (*ResourceUsage, error) {
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+	usage := &ResourceUsage{
+		MemoryUsage: memStats.Sys,
+		HeapInuse:   memStats.HeapInuse,
+		Goroutines:  runtime.NumGoroutine(),
+		SortDir:     sortDir,
+	}
+	diskUsage, err := getSortDirUsage(sortDir, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	usage.SortDirUsage = diskUsage
+	return usage, nil
+}
+
+// getSortDirUsage returns the disk usage of the sort dir, the result is
+// cached for sortDirUsageTTL.
+func getSortDirUsage(sortDir string, now time.Time) (uint64, error) {
+	cache := &sortDirUsageCache
+	cache.Lock()
+	defer cache.Unlock()
+	if cache.dir == sortDir && now.Sub(cache.updatedAt) < sortDirUsageTTL {
+		return cache.usage, nil
+	}
+
+	var usage uint64
+	err := filepath.WalkDir(sortDir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed by the sorter during walking.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		usage += uint64(info.Size())
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return 0, cerror.WrapError(cerror.ErrInternalServerError, err)
+	}
+	cache.dir = sortDir
+	cache.usage = usage
+	cache.updatedAt = now
+	return usage, nil
+}
+
+// getCaptureStats returns the table statistics and the resource usage of
+// all captures, the resource usage is collected from the captures
+// concurrently.
+func (h *OpenAPIV2) getCaptureStats(
+	ctx context.Context, captures []*model.CaptureInfo, header http.Header,
+) (map[model.CaptureID]*CaptureStats, error) {
+	tableStats, err := h.capture.StatusProvider().GetCaptureTableStats(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	security := config.GetGlobalServerConfig().Security
+	client, err := httputil.NewClient(security)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	client.SetTimeout(collectResourceTimeout)
+	scheme := "http"
+	if tls, _ := security.ToTLSConfigWithVerify(); tls != nil {
+		scheme = "https"
+	}
+
+	res := make(map[model.CaptureID]*CaptureStats, len(captures))
+	var wg sync.WaitGroup
+	for _, capture := range captures {
+		stats := &CaptureStats{}
+		if s, ok := tableStats[capture.ID]; ok {
+			stats.TableCount = s.TableCount
+			stats.RegionCount = s.RegionCount
+		}
+		res[capture.ID] = stats
+
+		wg.Add(1)
+		go func(capture *model.CaptureInfo, stats *CaptureStats) {
+			defer wg.Done()
+			usage, err := fetchResourceUsage(ctx, client,
+				scheme+"://"+capture.AdvertiseAddr+resourceUsagePath, header)
+			if err != nil {
+				log.Warn("failed to collect the resource usage of capture",
+					zap.String("capture", capture.ID),
+					zap.String("address", capture.AdvertiseAddr),
+					zap.Error(err))
+				stats.ResourceError = err.Error()
+				return
+			}
+			stats.Resource = usage
+		}(capture, stats)
+	}
+	wg.Wait()
+	return res, nil
+}
+
+func fetchResourceUsage(
+	ctx context.Context, client *httputil.Client, url string, header http.Header,
+) (*ResourceUsage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Pass the credentials of the caller through.
+	if auth := header.Get("Authorization"); auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	usage := &ResourceUsage{}
+	if err := json.NewDecoder(resp.Body).Decode(usage); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return usage, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// getSorterMetrics gets a snapshot of the sorter metrics of a table
+// @Summary Get the sorter metrics of a table
+// @Description get the iterator latency percentiles, the resolved ts delay and
+// @Description the disk usage of a table in the sorter. It must be sent to the
+// @Description capture replicating the table, which can be found by the
+// @Description processor apis.
+// @Tags processor,v2
+// @Produce json
+// @Success 200 {object} TableSorterMetrics
+// @Failure 500,400 {object} model.HTTPError
+// @Param   changefeed_id   path    string  true  "changefeed ID"
+// @Param   table_id        path    integer true  "table ID"
+// @Router	/api/v2/sorter_metrics/{changefeed_id}/{table_id} [get]
+func (h *OpenAPIV2) getSorterMetrics(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid changefeed_id: %s", changefeedID.ID))
+		return
+	}
+	tableID, err := strconv.ParseInt(c.Param(apiOpVarTableID), 10, 64)
+	if err != nil || tableID <= 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid table_id: %s", c.Param(apiOpVarTableID)))
+		return
+	}
+
+	info, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	metrics, err := h.capture.QuerySorterMetrics(ctx, changefeedID, tableID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, &TableSorterMetrics{
+		CaptureID:              info.ID,
+		IterSeekLatency:        toLatencyStats(metrics.IterSeekLatency),
+		IterNextLatency:        toLatencyStats(metrics.IterNextLatency),
+		ResolvedTsDelay:        JSONDuration{metrics.ResolvedTsDelay},
+		OnDiskBytes:            metrics.OnDiskBytes,
+		PendingCompactionBytes: metrics.PendingCompactionBytes,
+	})
+}
+
+func toLatencyStats(stats engine.LatencyStats) LatencyStats {
+	return LatencyStats{
+		Count: stats.Count,
+		P50:   JSONDuration{stats.P50},
+		P90:   JSONDuration{stats.P90},
+		P99:   JSONDuration{stats.P99},
+		Max:   JSONDuration{stats.Max},
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSorterMetrics(t *testing.T) {
+	t.Parallel()
+
+	metricsURL := "/api/v2/sorter_metrics/%s/%s"
+	get := func(router http.Handler, url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", url, nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid parameters.
+	{
+		cp := mock_capture.NewMockCapture(gomock.NewController(t))
+		cp.EXPECT().IsReady().Return(true).AnyTimes()
+		router := newRouter(NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{}))
+		for _, url := range []string{
+			fmt.Sprintf(metricsURL, "@^Invalid", "1"),
+			fmt.Sprintf(metricsURL, changeFeedID.ID, "abc"),
+			fmt.Sprintf(metricsURL, changeFeedID.ID, "0"),
+		} {
+			w := get(router, url)
+			respErr := model.HTTPError{}
+			require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+			require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	// case 2: the table is not replicated by the capture.
+	{
+		cp := mock_capture.NewMockCapture(gomock.NewController(t))
+		cp.EXPECT().IsReady().Return(true).AnyTimes()
+		cp.EXPECT().Info().Return(model.CaptureInfo{ID: captureID}, nil)
+		cp.EXPECT().QuerySorterMetrics(gomock.Any(), changeFeedID, int64(1)).
+			Return(engine.TableMetrics{}, cerror.ErrProcessorTableNotFound.GenWithStackByArgs())
+		router := newRouter(NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{}))
+		w := get(router, fmt.Sprintf(metricsURL, changeFeedID.ID, "1"))
+		respErr := model.HTTPError{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+		require.Contains(t, respErr.Code, "ErrProcessorTableNotFound")
+	}
+
+	// case 3: query the sorter metrics of the table.
+	{
+		cp := mock_capture.NewMockCapture(gomock.NewController(t))
+		cp.EXPECT().IsReady().Return(true).AnyTimes()
+		cp.EXPECT().Info().Return(model.CaptureInfo{ID: captureID}, nil)
+		cp.EXPECT().QuerySorterMetrics(gomock.Any(), changeFeedID, int64(1)).
+			Return(engine.TableMetrics{
+				IterSeekLatency: engine.LatencyStats{
+					Count: 10,
+					P50:   time.Millisecond,
+					P90:   2 * time.Millisecond,
+					P99:   3 * time.Millisecond,
+					Max:   4 * time.Millisecond,
+				},
+				IterNextLatency: engine.LatencyStats{
+					Count: 100,
+					P50:   time.Microsecond,
+					P90:   2 * time.Microsecond,
+					P99:   3 * time.Microsecond,
+					Max:   4 * time.Microsecond,
+				},
+				ResolvedTsDelay:        20 * time.Millisecond,
+				OnDiskBytes:            1024,
+				PendingCompactionBytes: 2048,
+			}, nil)
+		router := newRouter(NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{}))
+		w := get(router, fmt.Sprintf(metricsURL, changeFeedID.ID, "1"))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := TableSorterMetrics{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Equal(t, TableSorterMetrics{
+			CaptureID: captureID,
+			IterSeekLatency: LatencyStats{
+				Count: 10,
+				P50:   JSONDuration{time.Millisecond},
+				P90:   JSONDuration{2 * time.Millisecond},
+				P99:   JSONDuration{3 * time.Millisecond},
+				Max:   JSONDuration{4 * time.Millisecond},
+			},
+			IterNextLatency: LatencyStats{
+				Count: 100,
+				P50:   JSONDuration{time.Microsecond},
+				P90:   JSONDuration{2 * time.Microsecond},
+				P99:   JSONDuration{3 * time.Microsecond},
+				Max:   JSONDuration{4 * time.Microsecond},
+			},
+			ResolvedTsDelay:        JSONDuration{20 * time.Millisecond},
+			OnDiskBytes:            1024,
+			PendingCompactionBytes: 2048,
+		}, resp)
+	}
+}
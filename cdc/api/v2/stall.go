@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	apiOpVarTableID = "table_id"
+	apiOpVarLimit   = "limit"
+
+	defaultStallRegionLimit = 5
+	maxStallRegionLimit     = 100
+)
+
+// getResolvedTsStall finds out the regions holding back the resolved ts of a table
+// @Summary Get the regions holding back the resolved ts of a table
+// @Description get the regions with the smallest resolved ts of a table, and
+// @Description the locks in them. It must be sent to the capture replicating
+// @Description the table, which can be found by the processor apis.
+// @Tags processor,v2
+// @Produce json
+// @Success 200 {object} TableStall
+// @Failure 500,400 {object} model.HTTPError
+// @Param   changefeed_id   path    string  true  "changefeed ID"
+// @Param   table_id        path    integer true  "table ID"
+// @Param   limit           query   integer false "max number of regions, 5 by default"
+// @Router	/api/v2/resolved_ts_stall/{changefeed_id}/{table_id} [get]
+func (h *OpenAPIV2) getResolvedTsStall(c *gin.Context) {
+	ctx := c.Request.Context()
+	changefeedID := getChangefeedID(c)
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid changefeed_id: %s", changefeedID.ID))
+		return
+	}
+	tableID, err := strconv.ParseInt(c.Param(apiOpVarTableID), 10, 64)
+	if err != nil || tableID <= 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid table_id: %s", c.Param(apiOpVarTableID)))
+		return
+	}
+	limit := defaultStallRegionLimit
+	if v := c.Query(apiOpVarLimit); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit <= 0 || limit > maxStallRegionLimit {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+				"invalid limit: %s, it must be in [1, %d]", v, maxStallRegionLimit))
+			return
+		}
+	}
+
+	info, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	stalls, err := h.capture.QueryTableStall(ctx, changefeedID, tableID, limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	resp := &TableStall{
+		CaptureID: info.ID,
+		Regions:   make([]RegionStall, 0, len(stalls)),
+	}
+	for _, stall := range stalls {
+		region := RegionStall{
+			RegionID:    stall.RegionID,
+			StoreID:     stall.StoreID,
+			StoreAddr:   stall.StoreAddr,
+			StartKey:    stall.Span.StartKey.String(),
+			EndKey:      stall.Span.EndKey.String(),
+			ResolvedTs:  stall.ResolvedTs,
+			Initialized: stall.Initialized,
+			Lag:         JSONDuration{stall.Lag},
+			Locks:       make([]RegionLock, 0, len(stall.Locks)),
+		}
+		for _, lock := range stall.Locks {
+			region.Locks = append(region.Locks, RegionLock{
+				Key:      hex.EncodeToString(lock.Key),
+				Primary:  hex.EncodeToString(lock.Primary),
+				StartTs:  lock.TxnID,
+				TTL:      lock.TTL,
+				TxnSize:  lock.TxnSize,
+				LockType: lock.LockType.String(),
+			})
+		}
+		resp.Regions = append(resp.Regions, region)
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/txnkv"
+)
+
+func TestGetResolvedTsStall(t *testing.T) {
+	t.Parallel()
+
+	stallURL := "/api/v2/resolved_ts_stall/%s/%s"
+	get := func(router http.Handler, url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", url, nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// case 1: invalid parameters.
+	{
+		cp := mock_capture.NewMockCapture(gomock.NewController(t))
+		cp.EXPECT().IsReady().Return(true).AnyTimes()
+		router := newRouter(NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{}))
+		for _, url := range []string{
+			fmt.Sprintf(stallURL, "@^Invalid", "1"),
+			fmt.Sprintf(stallURL, changeFeedID.ID, "abc"),
+			fmt.Sprintf(stallURL, changeFeedID.ID, "1") + "?limit=0",
+			fmt.Sprintf(stallURL, changeFeedID.ID, "1") + "?limit=101",
+		} {
+			w := get(router, url)
+			respErr := model.HTTPError{}
+			require.Nil(t, json.NewDecoder(w.Body).Decode(&respErr))
+			require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+			require.Equal(t, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	// case 2: query the stall of the table.
+	{
+		cp := mock_capture.NewMockCapture(gomock.NewController(t))
+		cp.EXPECT().IsReady().Return(true).AnyTimes()
+		cp.EXPECT().Info().Return(model.CaptureInfo{ID: captureID}, nil)
+		cp.EXPECT().QueryTableStall(gomock.Any(), changeFeedID, int64(1), 2).
+			Return([]processor.RegionStall{{
+				RegionFeedStat: kv.RegionFeedStat{
+					RegionID:  10,
+					StoreID:   2,
+					StoreAddr: "127.0.0.1:20160",
+					Span: tablepb.Span{
+						TableID:  1,
+						StartKey: []byte{0x74},
+						EndKey:   []byte{0x75},
+					},
+					ResolvedTs:  100,
+					Initialized: true,
+				},
+				Lag: 3 * time.Second,
+				Locks: []*txnkv.Lock{{
+					Key:      []byte{0x74, 0x01},
+					Primary:  []byte{0x74, 0x02},
+					TxnID:    101,
+					TTL:      3000,
+					TxnSize:  1,
+					LockType: kvrpcpb.Op_Put,
+				}},
+			}}, nil)
+		router := newRouter(NewOpenAPIV2ForTest(cp, APIV2HelpersImpl{}))
+		w := get(router, fmt.Sprintf(stallURL, changeFeedID.ID, "1")+"?limit=2")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		resp := TableStall{}
+		require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Equal(t, TableStall{
+			CaptureID: captureID,
+			Regions: []RegionStall{{
+				RegionID:    10,
+				StoreID:     2,
+				StoreAddr:   "127.0.0.1:20160",
+				StartKey:    "74",
+				EndKey:      "75",
+				ResolvedTs:  100,
+				Initialized: true,
+				Lag:         JSONDuration{3 * time.Second},
+				Locks: []RegionLock{{
+					Key:      "7401",
+					Primary:  "7402",
+					StartTs:  101,
+					TTL:      3000,
+					TxnSize:  1,
+					LockType: "Put",
+				}},
+			}},
+		}, resp)
+	}
+}
@@ -34,13 +34,22 @@ import (
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/status [get]
 func (h *OpenAPIV2) serverStatus(c *gin.Context) {
-	info, err := h.capture.Info()
+	status, err := h.getServerStatus()
 	if err != nil {
 		_ = c.Error(err)
 		return
 	}
+	c.IndentedJSON(http.StatusOK, status)
+}
+
+// getServerStatus returns the status of the capture serving the request.
+func (h *OpenAPIV2) getServerStatus() (*model.ServerStatus, error) {
+	info, err := h.capture.Info()
+	if err != nil {
+		return nil, err
+	}
 	etcdClient := h.capture.GetEtcdClient()
-	status := model.ServerStatus{
+	return &model.ServerStatus{
 		Version:   version.ReleaseVersion,
 		GitHash:   version.GitHash,
 		Pid:       os.Getpid(),
@@ -48,6 +57,5 @@ func (h *OpenAPIV2) serverStatus(c *gin.Context) {
 		ClusterID: etcdClient.GetClusterID(),
 		IsOwner:   h.capture.IsOwner(),
 		Liveness:  h.capture.Liveness(),
-	}
-	c.IndentedJSON(http.StatusOK, status)
+	}, nil
 }
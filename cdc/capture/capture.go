@@ -28,6 +28,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/owner"
 	"github.com/pingcap/tiflow/cdc/processor"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
@@ -63,6 +66,17 @@ type Capture interface {
 	Info() (model.CaptureInfo, error)
 	StatusProvider() owner.StatusProvider
 	WriteDebugInfo(ctx context.Context, w io.Writer)
+	// QueryTableStall returns the regions holding back the resolved ts of
+	// the table replicated by this capture.
+	QueryTableStall(
+		ctx context.Context, changefeedID model.ChangeFeedID,
+		tableID model.TableID, limit int,
+	) ([]processor.RegionStall, error)
+	// QuerySorterMetrics returns a snapshot of the sorter metrics of the
+	// table replicated by this capture.
+	QuerySorterMetrics(
+		ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID,
+	) (engine.TableMetrics, error)
 
 	GetUpstreamManager() (*upstream.Manager, error)
 	GetEtcdClient() etcd.CDCEtcdClient
@@ -197,6 +211,8 @@ func (c *captureImpl) reset(ctx context.Context) error {
 		ID:            uuid.New().String(),
 		AdvertiseAddr: c.config.AdvertiseAddr,
 		Version:       version.ReleaseVersion,
+		GitHash:       version.GitHash,
+		Labels:        c.config.Labels,
 	}
 
 	if c.upstreamManager != nil {
@@ -307,6 +323,17 @@ func (c *captureImpl) run(stdCtx context.Context) error {
 		cancel()
 	}()
 
+	// The shared tables must be closed after all processors are closed.
+	var sharedTables *sourcemanager.SharedTableCache
+	if config.GetGlobalServerConfig().Sorter.EnableSharedTableCache {
+		sharedTables = sourcemanager.NewSharedTableCache(stdCtx, c.sortEngineFactory)
+		defer sharedTables.Close()
+	}
+	var quotaAllocator *memquota.Allocator
+	if cfg := config.GetGlobalServerConfig().AdaptiveMemoryQuota; cfg != nil && cfg.Enable {
+		quotaAllocator = memquota.NewAllocator(cfg)
+	}
+
 	defer func() {
 		c.Close()
 		c.grpcService.Reset(nil)
@@ -319,6 +346,8 @@ func (c *captureImpl) run(stdCtx context.Context) error {
 		MessageServer:     c.MessageServer,
 		MessageRouter:     c.MessageRouter,
 		SortEngineFactory: c.sortEngineFactory,
+		SharedTableCache:  sharedTables,
+		MemQuotaAllocator: quotaAllocator,
 	})
 
 	g.Go(func() error {
@@ -360,6 +389,12 @@ func (c *captureImpl) run(stdCtx context.Context) error {
 		return c.MessageServer.Run(ctx)
 	})
 
+	if quotaAllocator != nil {
+		g.Go(func() error {
+			return quotaAllocator.Run(ctx)
+		})
+	}
+
 	return errors.Trace(g.Wait())
 }
 
@@ -684,6 +719,36 @@ func (c *captureImpl) WriteDebugInfo(ctx context.Context, w io.Writer) {
 	wait(doneM)
 }
 
+// QueryTableStall returns the regions holding back the resolved ts of the
+// table replicated by this capture.
+func (c *captureImpl) QueryTableStall(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+	tableID model.TableID, limit int,
+) ([]processor.RegionStall, error) {
+	c.captureMu.Lock()
+	processorManager := c.processorManager
+	// The lock must be released before waiting the query, see WriteDebugInfo.
+	c.captureMu.Unlock()
+	if processorManager == nil {
+		return nil, cerror.ErrCaptureNotInitialized.GenWithStackByArgs()
+	}
+	return processorManager.QueryTableStall(ctx, changefeedID, tableID, limit)
+}
+
+// QuerySorterMetrics returns a snapshot of the sorter metrics of the table
+// replicated by this capture.
+func (c *captureImpl) QuerySorterMetrics(
+	ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID,
+) (engine.TableMetrics, error) {
+	c.captureMu.Lock()
+	processorManager := c.processorManager
+	c.captureMu.Unlock()
+	if processorManager == nil {
+		return engine.TableMetrics{}, cerror.ErrCaptureNotInitialized.GenWithStackByArgs()
+	}
+	return processorManager.QuerySorterMetrics(ctx, changefeedID, tableID)
+}
+
 // IsOwner returns whether the capture is an owner
 func (c *captureImpl) IsOwner() bool {
 	c.ownerMu.Lock()
@@ -12,6 +12,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/pingcap/tiflow/cdc/model"
 	owner "github.com/pingcap/tiflow/cdc/owner"
+	processor "github.com/pingcap/tiflow/cdc/processor"
+	engine "github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	etcd "github.com/pingcap/tiflow/pkg/etcd"
 	upstream "github.com/pingcap/tiflow/pkg/upstream"
 )
@@ -181,6 +183,36 @@ func (mr *MockCaptureMockRecorder) Liveness() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liveness", reflect.TypeOf((*MockCapture)(nil).Liveness))
 }
 
+// QuerySorterMetrics mocks base method.
+func (m *MockCapture) QuerySorterMetrics(ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID) (engine.TableMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySorterMetrics", ctx, changefeedID, tableID)
+	ret0, _ := ret[0].(engine.TableMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySorterMetrics indicates an expected call of QuerySorterMetrics.
+func (mr *MockCaptureMockRecorder) QuerySorterMetrics(ctx, changefeedID, tableID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySorterMetrics", reflect.TypeOf((*MockCapture)(nil).QuerySorterMetrics), ctx, changefeedID, tableID)
+}
+
+// QueryTableStall mocks base method.
+func (m *MockCapture) QueryTableStall(ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID, limit int) ([]processor.RegionStall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTableStall", ctx, changefeedID, tableID, limit)
+	ret0, _ := ret[0].([]processor.RegionStall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTableStall indicates an expected call of QueryTableStall.
+func (mr *MockCaptureMockRecorder) QueryTableStall(ctx, changefeedID, tableID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTableStall", reflect.TypeOf((*MockCapture)(nil).QueryTableStall), ctx, changefeedID, tableID, limit)
+}
+
 // Run mocks base method.
 func (m *MockCapture) Run(ctx context.Context) error {
 	m.ctrl.T.Helper()
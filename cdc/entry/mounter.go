@@ -37,6 +37,7 @@ import (
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	pfilter "github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
@@ -77,6 +78,7 @@ type mounter struct {
 	enableOldValue               bool
 	changefeedID                 model.ChangeFeedID
 	filter                       pfilter.Filter
+	transformer                  *transform.Transformer
 	metricTotalRows              prometheus.Gauge
 	metricIgnoredDMLEventCounter prometheus.Counter
 
@@ -98,6 +100,7 @@ func NewMounter(schemaStorage SchemaStorage,
 	changefeedID model.ChangeFeedID,
 	tz *time.Location,
 	filter pfilter.Filter,
+	transformer *transform.Transformer,
 	enableOldValue bool,
 	integrity *integrity.Config,
 ) Mounter {
@@ -106,6 +109,7 @@ func NewMounter(schemaStorage SchemaStorage,
 		changefeedID:   changefeedID,
 		enableOldValue: enableOldValue,
 		filter:         filter,
+		transformer:    transformer,
 		metricTotalRows: totalRowsCountGauge.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 		metricIgnoredDMLEventCounter: ignoredDMLEventCounter.
@@ -204,6 +208,8 @@ func (m *mounter) unmarshalAndMountRowChanged(ctx context.Context, raw *model.Ra
 				m.metricIgnoredDMLEventCounter.Inc()
 				return nil, nil
 			}
+			m.filter.DropIgnoredColumns(row)
+			m.transformer.Transform(row)
 			return row, nil
 		}
 		return nil, nil
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/filter"
 	"github.com/pingcap/tiflow/pkg/integrity"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/util"
 	"golang.org/x/sync/errgroup"
 )
@@ -38,6 +39,7 @@ type mounterGroup struct {
 	inputCh        chan *model.PolymorphicEvent
 	tz             *time.Location
 	filter         filter.Filter
+	transformer    *transform.Transformer
 	enableOldValue bool
 	integrity      *integrity.Config
 
@@ -58,6 +60,7 @@ func NewMounterGroup(
 	workerNum int,
 	enableOldValue bool,
 	filter filter.Filter,
+	transformer *transform.Transformer,
 	tz *time.Location,
 	changefeedID model.ChangeFeedID,
 	integrity *integrity.Config,
@@ -70,6 +73,7 @@ func NewMounterGroup(
 		inputCh:        make(chan *model.PolymorphicEvent, defaultInputChanSize),
 		enableOldValue: enableOldValue,
 		filter:         filter,
+		transformer:    transformer,
 		tz:             tz,
 
 		integrity: integrity,
@@ -112,7 +116,7 @@ func (m *mounterGroup) Close() {}
 
 func (m *mounterGroup) runWorker(ctx context.Context) error {
 	mounter := NewMounter(m.schemaStorage, m.changefeedID, m.tz, m.filter,
-		m.enableOldValue, m.integrity)
+		m.transformer, m.enableOldValue, m.integrity)
 	for {
 		select {
 		case <-ctx.Done():
@@ -315,7 +315,7 @@ func testMounterDisableOldValue(t *testing.T, tc struct {
 	require.Nil(t, err)
 	mounter := NewMounter(scheamStorage,
 		model.DefaultChangeFeedID("c1"),
-		time.UTC, filter, false,
+		time.UTC, filter, nil, false,
 		config.Integrity).(*mounter)
 	mounter.tz = time.Local
 	ctx := context.Background()
@@ -1040,7 +1040,7 @@ func TestDecodeRowEnableChecksum(t *testing.T) {
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
 
 	mounter := NewMounter(schemaStorage, changefeed, time.Local,
-		filter, true, replicaConfig.Integrity).(*mounter)
+		filter, nil, true, replicaConfig.Integrity).(*mounter)
 
 	ctx := context.Background()
 
@@ -1171,7 +1171,7 @@ func TestDecodeRow(t *testing.T) {
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
 
 	mounter := NewMounter(
-		schemaStorage, changefeed, time.Local, filter, true, cfg.Integrity).(*mounter)
+		schemaStorage, changefeed, time.Local, filter, nil, true, cfg.Integrity).(*mounter)
 
 	helper.Tk().MustExec(`insert into student values(1, "dongmen", 20, "male")`)
 	helper.Tk().MustExec(`update student set age = 27 where id = 1`)
@@ -1250,7 +1250,7 @@ func TestDecodeEventIgnoreRow(t *testing.T) {
 
 	ts := schemaStorage.GetLastSnapshot().CurrentTs()
 	schemaStorage.AdvanceResolvedTs(ver.Ver)
-	mounter := NewMounter(schemaStorage, cfID, time.Local, f, true, cfg.Integrity).(*mounter)
+	mounter := NewMounter(schemaStorage, cfID, time.Local, f, nil, true, cfg.Integrity).(*mounter)
 
 	type testCase struct {
 		schema  string
@@ -19,25 +19,41 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/api/owner"
 	"github.com/pingcap/tiflow/cdc/api/status"
 	v1 "github.com/pingcap/tiflow/cdc/api/v1"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/capture"
 	_ "github.com/pingcap/tiflow/docs/swagger" // use for OpenAPI online docs
+	"github.com/pingcap/tiflow/pkg/audit"
+	"github.com/pingcap/tiflow/pkg/auth"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.uber.org/zap"
 )
 
-// RegisterRoutes create a router for OpenAPI
+// RegisterRoutes create a router for OpenAPI, the audit logger is nil if
+// the audit log is disabled.
 func RegisterRoutes(
 	router *gin.Engine,
 	capture capture.Capture,
 	registry prometheus.Gatherer,
+	auditLogger *audit.Logger,
 ) {
+	// Audit log, rate limits and access control of all the APIs registered
+	// below, the forwarded requests are verified first.
+	conf := config.GetGlobalServerConfig()
+	router.Use(middleware.ForwardedMiddleware(clusterCommonNames(conf.Security)))
+	router.Use(middleware.AuditMiddleware(auditLogger))
+	router.Use(middleware.AuthMiddleware(NewAuthenticator(capture)))
+	router.Use(middleware.RateLimitMiddleware(conf.RateLimit))
+
 	// online docs
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -45,6 +61,7 @@ func RegisterRoutes(
 	v1.RegisterOpenAPIRoutes(router, v1.NewOpenAPI(capture))
 	// Open API V2
 	v2.RegisterOpenAPIV2Routes(router, v2.NewOpenAPIV2(capture))
+	v2.RegisterAuditRoutes(router, auditLogger)
 
 	// Owner API
 	owner.RegisterOwnerAPIRoutes(router, capture)
@@ -75,3 +92,36 @@ func RegisterRoutes(
 	prometheus.DefaultGatherer = registry
 	router.Any("/metrics", gin.WrapH(promhttp.Handler()))
 }
+
+// NewAuthenticator returns an authenticator of the HTTP API and the gRPC
+// admin API, or nil if the access control is disabled.
+func NewAuthenticator(capture capture.Capture) *auth.Authenticator {
+	conf := config.GetGlobalServerConfig()
+	if conf.Auth == nil || !conf.Auth.Enable {
+		return nil
+	}
+	etcdClient := capture.GetEtcdClient()
+	tokens := auth.NewEtcdTokenStore(
+		etcdClient.GetEtcdClient().Unwrap(), etcdClient.GetClusterID())
+	return auth.NewAuthenticator(conf.Auth, clusterCommonNames(conf.Security), tokens)
+}
+
+// clusterCommonNames returns the common names of the certificates used by
+// the captures to forward requests. The allowed common names include the
+// one of this capture if any, otherwise the captures are assumed to share
+// the certificate of this capture.
+func clusterCommonNames(security *config.SecurityConfig) []string {
+	if security == nil || !security.IsTLSEnabled() {
+		return nil
+	}
+	if len(security.CertAllowedCN) != 0 {
+		return security.CertAllowedCN
+	}
+	cn, err := security.SelfCommonName()
+	if err != nil || cn == "" {
+		log.Warn("failed to get the common name of the certificate, "+
+			"the forwarded requests are authenticated as the capture", zap.Error(err))
+		return nil
+	}
+	return []string{cn}
+}
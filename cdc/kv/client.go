@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -54,13 +55,6 @@ const (
 	dialTimeout           = 10 * time.Second
 	tikvRequestMaxBackoff = 20000 // Maximum total sleep time(in ms)
 
-	// TiCDC may open numerous gRPC streams,
-	// with 65535 bytes window size, 10K streams takes about 27GB memory.
-	//
-	// 65535 bytes, the initial window size in http2 spec.
-	grpcInitialWindowSize = (1 << 16) - 1
-	// 8 MB The value for initial window size on a connection
-	grpcInitialConnWindowSize = 1 << 23
 	// 256 MB The maximum message size the client can receive
 	grpcMaxCallRecvMsgSize = 1 << 28
 
@@ -129,6 +123,8 @@ func newRegionErrorInfo(info singleRegionInfo, err error) regionErrorInfo {
 type eventFeedStream struct {
 	client cdcpb.ChangeData_EventFeedClient
 	conn   *sharedConn
+	// addr is the address of the store.
+	addr string
 }
 
 // CDCKVClient is an interface to receives kv changed logs from TiKV
@@ -147,6 +143,9 @@ type CDCKVClient interface {
 	ResolvedTs() model.Ts
 	// CommitTs returns the current ingress commit ts.
 	CommitTs() model.Ts
+	// SlowestRegions returns at most n captured regions with the smallest
+	// resolved ts.
+	SlowestRegions(n int) []RegionFeedStat
 }
 
 // NewCDCKVClient is the constructor of CDC KV client
@@ -160,6 +159,9 @@ type CDCClient struct {
 	clusterID uint64
 
 	grpcPool GrpcPool
+	// scanLimiters limit the throughput of incremental scans, it is shared
+	// by all kv clients of the upstream.
+	scanLimiters *ScanLimiters
 
 	regionCache *tikv.RegionCache
 	pdClock     pdutil.Clock
@@ -174,6 +176,14 @@ type CDCClient struct {
 		v map[string]*tableStoreStat
 	}
 
+	// regionFeeds are the region workers of the running streams and the
+	// pending regions of the streams, which are inspected to find out the
+	// regions holding back the resolved ts.
+	regionFeeds struct {
+		sync.Mutex
+		v map[*regionWorker]*syncRegionFeedStateMap
+	}
+
 	// filterLoop is used in BDR mode, when it is true, tikv cdc component
 	// will filter data that are written by another TiCDC.
 	filterLoop bool
@@ -185,11 +195,25 @@ type tableStoreStat struct {
 	commitTs    atomic.Uint64
 }
 
+// RegionFeedStat is the status of a captured region.
+type RegionFeedStat struct {
+	RegionID  uint64
+	StoreID   uint64
+	StoreAddr string
+	Span      tablepb.Span
+	// ResolvedTs is the latest resolved ts received from the region.
+	ResolvedTs uint64
+	// Initialized is false if the incremental scan of the region
+	// is not finished yet.
+	Initialized bool
+}
+
 // NewCDCClient creates a CDCClient instance
 func NewCDCClient(
 	ctx context.Context,
 	pd pd.Client,
 	grpcPool GrpcPool,
+	scanLimiters *ScanLimiters,
 	regionCache *tikv.RegionCache,
 	pdClock pdutil.Clock,
 	cfg *config.KVClientConfig,
@@ -201,12 +225,13 @@ func NewCDCClient(
 	clusterID := pd.GetClusterID(ctx)
 
 	c := &CDCClient{
-		clusterID:   clusterID,
-		config:      cfg,
-		pd:          pd,
-		grpcPool:    grpcPool,
-		regionCache: regionCache,
-		pdClock:     pdClock,
+		clusterID:    clusterID,
+		config:       cfg,
+		pd:           pd,
+		grpcPool:     grpcPool,
+		scanLimiters: scanLimiters,
+		regionCache:  regionCache,
+		pdClock:      pdClock,
 
 		changefeed: changefeed,
 		tableID:    tableID,
@@ -214,6 +239,7 @@ func NewCDCClient(
 		filterLoop: filterLoop,
 	}
 	c.tableStoreStats.v = make(map[string]*tableStoreStat)
+	c.regionFeeds.v = make(map[*regionWorker]*syncRegionFeedStateMap)
 	return c
 }
 
@@ -242,6 +268,7 @@ func (c *CDCClient) newStream(ctx context.Context, addr string, storeID uint64)
 		stream = &eventFeedStream{
 			client: streamClient,
 			conn:   conn,
+			addr:   addr,
 		}
 		log.Debug("created stream to store",
 			zap.String("namespace", c.changefeed.Namespace),
@@ -306,6 +333,47 @@ func (c *CDCClient) CommitTs() model.Ts {
 	return ingressCommitTs
 }
 
+// SlowestRegions returns at most n captured regions with the smallest
+// resolved ts, including the regions whose requests are not responded yet.
+func (c *CDCClient) SlowestRegions(n int) []RegionFeedStat {
+	var stats []RegionFeedStat
+	collect := func(_ uint64, state *regionFeedState) bool {
+		stat := RegionFeedStat{
+			RegionID:    state.getRegionID(),
+			Span:        state.sri.span,
+			ResolvedTs:  state.getLastResolvedTs(),
+			Initialized: state.isInitialized(),
+		}
+		if stat.ResolvedTs == 0 {
+			// The region feed is not started yet.
+			stat.ResolvedTs = state.sri.resolvedTs
+		}
+		if rpcCtx := state.sri.rpcCtx; rpcCtx != nil {
+			stat.StoreID = rpcCtx.Peer.GetStoreId()
+			stat.StoreAddr = rpcCtx.Addr
+		}
+		stats = append(stats, stat)
+		return true
+	}
+
+	c.regionFeeds.Lock()
+	for worker, pendingRegions := range c.regionFeeds.v {
+		for _, states := range worker.statesManager.states {
+			states.iter(collect)
+		}
+		pendingRegions.iter(collect)
+	}
+	c.regionFeeds.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ResolvedTs < stats[j].ResolvedTs
+	})
+	if len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
+
 var currentID uint64 = 0
 
 func allocID() uint64 {
@@ -355,11 +423,16 @@ type eventFeedSession struct {
 	// use sync.Pool to store resolved ts event only, because resolved ts event
 	// has the same size and generate cycle.
 	resolvedTsPool sync.Pool
+
+	// scanLimiters limit the throughput of incremental scans.
+	scanLimiters []*scanLimiter
 }
 
 type rangeRequestTask struct {
 	span tablepb.Span
 	ts   uint64
+	// resubscribed is true if the range is requested again after an error.
+	resubscribed bool
 }
 
 func newEventFeedSession(
@@ -405,9 +478,11 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64) error {
 	s.regionCh = chann.NewAutoDrainChann[singleRegionInfo]()
 	s.regionRouter = chann.NewAutoDrainChann[singleRegionInfo]()
 	s.errCh = chann.NewAutoDrainChann[regionErrorInfo]()
+	s.scanLimiters = s.client.scanLimiters.acquire(s.changefeed)
 
 	eventFeedGauge.Inc()
 	defer func() {
+		s.client.scanLimiters.release(s.changefeed)
 		eventFeedGauge.Dec()
 		s.regionRouter.CloseAndDrain()
 		s.regionCh.CloseAndDrain()
@@ -441,7 +516,8 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64) error {
 				// Besides the count or frequency of range request is limited,
 				// we use ephemeral goroutine instead of permanent goroutine.
 				g.Go(func() error {
-					return s.divideAndSendEventFeedToRegions(ctx, task.span, task.ts)
+					return s.divideAndSendEventFeedToRegions(
+						ctx, task.span, task.ts, task.resubscribed)
 				})
 			}
 		}
@@ -479,9 +555,9 @@ func (s *eventFeedSession) eventFeed(ctx context.Context, ts uint64) error {
 // scheduleDivideRegionAndRequest schedules a range to be divided by regions,
 // and these regions will be then scheduled to send ChangeData requests.
 func (s *eventFeedSession) scheduleDivideRegionAndRequest(
-	ctx context.Context, span tablepb.Span, ts uint64,
+	ctx context.Context, span tablepb.Span, ts uint64, resubscribed bool,
 ) {
-	task := rangeRequestTask{span: span, ts: ts}
+	task := rangeRequestTask{span: span, ts: ts, resubscribed: resubscribed}
 	select {
 	case s.requestRangeCh.In() <- task:
 		s.rangeChSizeGauge.Inc()
@@ -512,7 +588,7 @@ func (s *eventFeedSession) scheduleRegionRequest(ctx context.Context, sri single
 			for _, r := range res.RetryRanges {
 				// This call is always blocking, otherwise if scheduling in a new
 				// goroutine, it won't block the caller of `schedulerRegionRequest`.
-				s.scheduleDivideRegionAndRequest(ctx, r, sri.resolvedTs)
+				s.scheduleDivideRegionAndRequest(ctx, r, sri.resolvedTs, sri.resubscribed)
 			}
 		case regionlock.LockRangeStatusCancel:
 			return
@@ -559,15 +635,50 @@ func (s *eventFeedSession) onRegionFail(ctx context.Context, errorInfo regionErr
 	s.enqueueError(ctx, errorInfo)
 }
 
-// requestRegionToStore gets singleRegionInfo from regionRouter, which is a token
-// based limiter, sends request to TiKV.
+// requestRegionToStore gets singleRegionInfo from regionRouter, and routes it
+// to the storeRequester of the store where the region is located. Each store
+// requester sends requests to its store in a standalone goroutine.
+func (s *eventFeedSession) requestRegionToStore(
+	ctx context.Context,
+	g *errgroup.Group,
+) error {
+	requesters := make(map[string]*storeRequester)
+	defer func() {
+		for _, requester := range requesters {
+			requester.regions.CloseAndDrain()
+		}
+	}()
+
+	var sri singleRegionInfo
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case sri = <-s.regionRouter.Out():
+		}
+		storeAddr := sri.rpcCtx.Addr
+		requester, ok := requesters[storeAddr]
+		if !ok {
+			requester = newStoreRequester(storeAddr, s.client.config.RegionScanLimit)
+			requesters[storeAddr] = requester
+			g.Go(func() error {
+				return s.sendRequestsToStore(ctx, g, requester)
+			})
+		}
+		requester.regions.In() <- sri
+	}
+}
+
+// sendRequestsToStore gets singleRegionInfo from the storeRequester, sends
+// request to TiKV once an in-flight slot is taken.
 // If the send request to TiKV returns error, fail the region with sendRequestToStoreErr
 // and kv client will redispatch the region.
 // If initialize gPRC stream with an error, fail the region with connectToStoreErr
 // and kv client will also redispatch the region.
-func (s *eventFeedSession) requestRegionToStore(
+func (s *eventFeedSession) sendRequestsToStore(
 	ctx context.Context,
 	g *errgroup.Group,
+	requester *storeRequester,
 ) error {
 	// Stores pending regions info for each stream. After sending a new request, the region info wil be put to the map,
 	// and it will be loaded by the receiver thread when it receives the first response from that region. We need this
@@ -586,7 +697,25 @@ func (s *eventFeedSession) requestRegionToStore(
 		select {
 		case <-ctx.Done():
 			return errors.Trace(ctx.Err())
-		case sri = <-s.regionRouter.Out():
+		case info, ok := <-requester.regions.Out():
+			if !ok {
+				return nil
+			}
+			sri = info
+		}
+		// Wait until the number of in-flight regions is below the limit.
+		if err := requester.acquire(ctx); err != nil {
+			return err
+		}
+		// The region starts an incremental scan once it's requested, the
+		// regions requested again after errors are not limited, otherwise
+		// the recovering regions hold back the resolved ts even longer.
+		if !sri.resubscribed {
+			for _, limiter := range s.scanLimiters {
+				if err := limiter.waitRegion(ctx); err != nil {
+					return err
+				}
+			}
 		}
 		requestID := allocID()
 
@@ -607,20 +736,21 @@ func (s *eventFeedSession) requestRegionToStore(
 
 		failpoint.Inject("kvClientPendingRegionDelay", nil)
 
-		// each TiKV store has an independent pendingRegions.
+		// each stream has an independent pendingRegions.
 		storeAddr := rpcCtx.Addr
 		storeID := rpcCtx.Peer.GetStoreId()
+		streamKey := s.getStreamKey(storeAddr, regionID)
 		var (
 			stream *eventFeedStream
 			err    error
 		)
-		stream, ok := s.getStream(storeAddr)
+		stream, ok := s.getStream(streamKey)
 		if !ok {
 			// when a new stream is established, always create a new pending
 			// regions map, the old map will be used in old `receiveFromStream`
 			// and won't be deleted until that goroutine exits.
 			pendingRegions := newSyncRegionFeedStateMap()
-			storePendingRegions[storeAddr] = pendingRegions
+			storePendingRegions[streamKey] = pendingRegions
 			streamCtx, streamCancel := context.WithCancel(ctx)
 			_ = streamCancel // to avoid possible context leak warning from govet
 			stream, err = s.client.newStream(streamCtx, storeAddr, storeID)
@@ -641,28 +771,30 @@ func (s *eventFeedSession) requestRegionToStore(
 					})
 					time.Sleep(delay)
 				}
+				requester.release()
 				bo := tikv.NewBackoffer(ctx, tikvRequestMaxBackoff)
 				s.client.regionCache.OnSendFail(bo, rpcCtx, regionScheduleReload, err)
 				errInfo := newRegionErrorInfo(sri, &connectToStoreErr{})
 				s.onRegionFail(ctx, errInfo)
 				continue
 			}
-			s.addStream(storeAddr, stream, streamCancel)
+			s.addStream(streamKey, stream, streamCancel)
 			log.Info("creating new stream to store to send request",
 				zap.String("namespace", s.changefeed.Namespace),
 				zap.String("changefeed", s.changefeed.ID),
 				zap.Uint64("regionID", regionID),
 				zap.Uint64("requestID", requestID),
 				zap.Uint64("storeID", storeID),
-				zap.String("addr", storeAddr))
+				zap.String("addr", storeAddr),
+				zap.String("streamKey", streamKey))
 
 			g.Go(func() error {
-				defer s.deleteStream(storeAddr)
-				return s.receiveFromStream(ctx, g, storeAddr, storeID, stream.client, pendingRegions)
+				defer s.deleteStream(streamKey)
+				return s.receiveFromStream(ctx, g, streamKey, storeID, stream.client, pendingRegions)
 			})
 		}
 
-		pendingRegions, ok := storePendingRegions[storeAddr]
+		pendingRegions, ok := storePendingRegions[streamKey]
 		if !ok {
 			// Should never happen
 			log.Panic("pending regions is not found for store",
@@ -674,6 +806,7 @@ func (s *eventFeedSession) requestRegionToStore(
 		}
 
 		state := newRegionFeedState(sri, requestID)
+		state.requester = requester
 		pendingRegions.setByRequestID(requestID, state)
 
 		log.Debug("start new request",
@@ -713,12 +846,12 @@ func (s *eventFeedSession) requestRegionToStore(
 			}
 			// Delete the stream from the map so that the next time the store is accessed, the stream will be
 			// re-established.
-			s.deleteStream(storeAddr)
+			s.deleteStream(streamKey)
 			// Delete `pendingRegions` from `storePendingRegions` so that the next time a region of this store is
 			// requested, it will create a new one. So if the `receiveFromStream` goroutine tries to stop all
 			// pending regions, the new pending regions that are requested after reconnecting won't be stopped
 			// incorrectly.
-			delete(storePendingRegions, storeAddr)
+			delete(storePendingRegions, streamKey)
 
 			// Remove the region from pendingRegions. If it's already removed, it should be already retried by
 			// `receiveFromStream`, so no need to retry here.
@@ -726,6 +859,7 @@ func (s *eventFeedSession) requestRegionToStore(
 			if !ok {
 				continue
 			}
+			state.releaseInflight()
 
 			errInfo := newRegionErrorInfo(sri, &sendRequestToStoreErr{})
 			s.onRegionFail(ctx, errInfo)
@@ -805,7 +939,7 @@ func (s *eventFeedSession) dispatchRequest(ctx context.Context) error {
 // to region boundaries. When region merging happens, it's possible that it
 // will produce some overlapping spans.
 func (s *eventFeedSession) divideAndSendEventFeedToRegions(
-	ctx context.Context, span tablepb.Span, ts uint64,
+	ctx context.Context, span tablepb.Span, ts uint64, resubscribed bool,
 ) error {
 	limit := 20
 	nextSpan := span
@@ -858,6 +992,7 @@ func (s *eventFeedSession) divideAndSendEventFeedToRegions(
 			partialSpan = spanz.HackSpan(partialSpan)
 
 			sri := newSingleRegionInfo(tiRegion.VerID(), partialSpan, ts, nil)
+			sri.resubscribed = resubscribed
 			s.scheduleRegionRequest(ctx, sri)
 			// return if no more regions
 			if spanz.EndCompare(nextSpan.StartKey, span.EndKey) >= 0 {
@@ -892,11 +1027,11 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 		} else if innerErr.GetEpochNotMatch() != nil {
 			// TODO: If only confver is updated, we don't need to reload the region from region cache.
 			metricFeedEpochNotMatchCounter.Inc()
-			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
+			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs, true)
 			return nil
 		} else if innerErr.GetRegionNotFound() != nil {
 			metricFeedRegionNotFoundCounter.Inc()
-			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
+			s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs, true)
 			return nil
 		} else if duplicatedRequest := innerErr.GetDuplicateRequest(); duplicatedRequest != nil {
 			metricFeedDuplicateRequestCounter.Inc()
@@ -926,7 +1061,7 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 		}
 	case *rpcCtxUnavailableErr:
 		metricFeedRPCCtxUnavailable.Inc()
-		s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs)
+		s.scheduleDivideRegionAndRequest(ctx, errInfo.span, errInfo.resolvedTs, true)
 		return nil
 	case *connectToStoreErr:
 		metricConnectToStoreErr.Inc()
@@ -942,6 +1077,7 @@ func (s *eventFeedSession) handleError(ctx context.Context, errInfo regionErrorI
 	}
 
 	failpoint.Inject("kvClientRegionReentrantErrorDelay", nil)
+	errInfo.singleRegionInfo.resubscribed = true
 	s.scheduleRegionRequest(ctx, errInfo.singleRegionInfo)
 	return nil
 }
@@ -966,7 +1102,7 @@ func (s *eventFeedSession) getRPCContextForRegion(ctx context.Context, id tikv.R
 func (s *eventFeedSession) receiveFromStream(
 	ctx context.Context,
 	g *errgroup.Group,
-	addr string,
+	streamKey string,
 	storeID uint64,
 	stream cdcpb.ChangeData_EventFeedClient,
 	pendingRegions *syncRegionFeedStateMap,
@@ -987,12 +1123,13 @@ func (s *eventFeedSession) receiveFromStream(
 		log.Info("stream to store closed",
 			zap.String("namespace", s.changefeed.Namespace),
 			zap.String("changefeed", s.changefeed.ID),
-			zap.String("addr", addr), zap.Uint64("storeID", storeID))
+			zap.String("streamKey", streamKey), zap.Uint64("storeID", storeID))
 
 		failpoint.Inject("kvClientStreamCloseDelay", nil)
 
 		remainingRegions := pendingRegions.takeAll()
 		for _, state := range remainingRegions {
+			state.releaseInflight()
 			errInfo := newRegionErrorInfo(state.sri, cerror.ErrPendingRegionCancel.FastGenByArgs())
 			s.onRegionFail(ctx, errInfo)
 		}
@@ -1003,14 +1140,24 @@ func (s *eventFeedSession) receiveFromStream(
 
 	// always create a new region worker, because `receiveFromStream` is ensured
 	// to call exactly once from outer code logic
-	worker := newRegionWorker(s.changefeed, s, addr)
+	worker := newRegionWorker(s.changefeed, s, streamKey)
 
 	defer worker.evictAllRegions()
 
+	s.client.regionFeeds.Lock()
+	s.client.regionFeeds.v[worker] = pendingRegions
+	s.client.regionFeeds.Unlock()
+	defer func() {
+		s.client.regionFeeds.Lock()
+		delete(s.client.regionFeeds.v, worker)
+		s.client.regionFeeds.Unlock()
+	}()
+
 	g.Go(func() error {
 		return worker.run(ctx)
 	})
 
+	storeResolvedTs := s.client.supportStoreResolvedTs(ctx, storeID)
 	maxCommitTs := model.Ts(0)
 	for {
 		cevent, err := stream.Recv()
@@ -1034,7 +1181,7 @@ func (s *eventFeedSession) receiveFromStream(
 					"receive from stream canceled",
 					zap.String("namespace", s.changefeed.Namespace),
 					zap.String("changefeed", s.changefeed.ID),
-					zap.String("addr", addr),
+					zap.String("streamKey", streamKey),
 					zap.Uint64("storeID", storeID),
 				)
 			} else {
@@ -1042,7 +1189,7 @@ func (s *eventFeedSession) receiveFromStream(
 					"failed to receive from stream",
 					zap.String("namespace", s.changefeed.Namespace),
 					zap.String("changefeed", s.changefeed.ID),
-					zap.String("addr", addr),
+					zap.String("streamKey", streamKey),
 					zap.Uint64("storeID", storeID),
 					zap.Error(err),
 				)
@@ -1060,7 +1207,7 @@ func (s *eventFeedSession) receiveFromStream(
 			// TODO: better to closes the send direction of the stream to notify
 			// the other side, but it is not safe to call CloseSend concurrently
 			// with SendMsg, in future refactor we should refine the recv loop
-			s.deleteStream(addr)
+			s.deleteStream(streamKey)
 
 			// send nil regionStatefulEvent to signal worker exit
 			err = worker.sendEvents(ctx, []*regionStatefulEvent{nil})
@@ -1094,13 +1241,13 @@ func (s *eventFeedSession) receiveFromStream(
 				}
 			}
 		}
-		err = s.sendRegionChangeEvents(ctx, cevent.Events, worker, pendingRegions, addr)
+		err = s.sendRegionChangeEvents(ctx, cevent.Events, worker, pendingRegions, streamKey)
 		if err != nil {
 			return err
 		}
 		if cevent.ResolvedTs != nil {
 			metricSendEventBatchResolvedSize.Observe(float64(len(cevent.ResolvedTs.Regions)))
-			err = s.sendResolvedTs(ctx, cevent.ResolvedTs, worker)
+			err = s.sendResolvedTs(ctx, cevent.ResolvedTs, worker, storeResolvedTs)
 			if err != nil {
 				return err
 			}
@@ -1210,12 +1357,20 @@ func (s *eventFeedSession) sendResolvedTs(
 	ctx context.Context,
 	resolvedTs *cdcpb.ResolvedTs,
 	worker *regionWorker,
+	storeResolvedTs bool,
 ) error {
+	// A resolved ts without any region is a store-level resolved ts if the
+	// store sends them, which applies to all regions subscribed on the stream.
+	storeLevel := len(resolvedTs.Regions) == 0 && storeResolvedTs
+	regionCount := len(resolvedTs.Regions)
+	if storeLevel {
+		regionCount = int(worker.statesManager.regionCount())
+	}
 	statefulEvents := make([]*regionStatefulEvent, worker.concurrency)
 	// split resolved ts
 	for i := 0; i < worker.concurrency; i++ {
 		// Allocate a buffer with 1.5x length than average to reduce reallocate.
-		buffLen := len(resolvedTs.Regions) / worker.concurrency * 2
+		buffLen := regionCount / worker.concurrency * 2
 		ev := s.resolvedTsPool.Get().(*regionStatefulEvent)
 		// must reset fields to prevent dirty data
 		ev.resolvedTsEvent.resolvedTs = resolvedTs.Ts
@@ -1224,15 +1379,27 @@ func (s *eventFeedSession) sendResolvedTs(
 		statefulEvents[i] = ev
 	}
 
-	for _, regionID := range resolvedTs.Regions {
-		state, ok := worker.getRegionState(regionID)
-		if ok {
-			slot := worker.inputCalcSlot(regionID)
-			statefulEvents[slot].resolvedTsEvent.regions = append(
-				statefulEvents[slot].resolvedTsEvent.regions, state,
-			)
-			// regionID is just an slot index
-			statefulEvents[slot].regionID = regionID
+	appendState := func(regionID uint64, state *regionFeedState) {
+		slot := worker.inputCalcSlot(regionID)
+		statefulEvents[slot].resolvedTsEvent.regions = append(
+			statefulEvents[slot].resolvedTsEvent.regions, state,
+		)
+		// regionID is just an slot index
+		statefulEvents[slot].regionID = regionID
+	}
+	if storeLevel {
+		for _, states := range worker.statesManager.states {
+			states.iter(func(regionID uint64, state *regionFeedState) bool {
+				appendState(regionID, state)
+				return true
+			})
+		}
+	} else {
+		for _, regionID := range resolvedTs.Regions {
+			state, ok := worker.getRegionState(regionID)
+			if ok {
+				appendState(regionID, state)
+			}
 		}
 	}
 	for _, event := range statefulEvents {
@@ -1246,37 +1413,72 @@ func (s *eventFeedSession) sendResolvedTs(
 	return nil
 }
 
-func (s *eventFeedSession) addStream(storeAddr string, stream *eventFeedStream, cancel context.CancelFunc) {
+// getStreamKey returns the key of the stream which the region is requested on.
+// It's the store address if only one stream is opened to each store.
+// supportStoreResolvedTs returns whether the resolved ts events without
+// regions from the store are store-level resolved ts. It requires both
+// `enable-store-resolved-ts` and a TiKV which sends them, see
+// version.MinTiKVVersionForStoreResolvedTs.
+func (c *CDCClient) supportStoreResolvedTs(ctx context.Context, storeID uint64) bool {
+	if !c.config.EnableStoreResolvedTs {
+		return false
+	}
+	supported, err := version.CheckStoreResolvedTsSupported(ctx, c.pd, storeID)
+	if err != nil {
+		log.Warn("fail to check whether the store sends store-level resolved ts",
+			zap.String("namespace", c.changefeed.Namespace),
+			zap.String("changefeed", c.changefeed.ID),
+			zap.Uint64("storeID", storeID), zap.Error(err))
+		return false
+	}
+	if !supported {
+		log.Info("store doesn't send store-level resolved ts, ignore the resolved ts without regions",
+			zap.String("namespace", c.changefeed.Namespace),
+			zap.String("changefeed", c.changefeed.ID),
+			zap.Uint64("storeID", storeID))
+	}
+	return supported
+}
+
+func (s *eventFeedSession) getStreamKey(storeAddr string, regionID uint64) string {
+	streamsPerStore := uint64(s.client.config.StreamsPerStore)
+	if streamsPerStore <= 1 {
+		return storeAddr
+	}
+	return fmt.Sprintf("%s#%d", storeAddr, regionID%streamsPerStore)
+}
+
+func (s *eventFeedSession) addStream(streamKey string, stream *eventFeedStream, cancel context.CancelFunc) {
 	s.streamsLock.Lock()
 	defer s.streamsLock.Unlock()
-	s.streams[storeAddr] = stream
-	s.streamsCanceller[storeAddr] = cancel
+	s.streams[streamKey] = stream
+	s.streamsCanceller[streamKey] = cancel
 }
 
-func (s *eventFeedSession) deleteStream(storeAddr string) {
+func (s *eventFeedSession) deleteStream(streamKey string) {
 	s.streamsLock.Lock()
 	defer s.streamsLock.Unlock()
-	if stream, ok := s.streams[storeAddr]; ok {
-		s.client.grpcPool.ReleaseConn(stream.conn, storeAddr)
-		delete(s.streams, storeAddr)
+	if stream, ok := s.streams[streamKey]; ok {
+		s.client.grpcPool.ReleaseConn(stream.conn, stream.addr)
+		delete(s.streams, streamKey)
 	}
-	if cancel, ok := s.streamsCanceller[storeAddr]; ok {
+	if cancel, ok := s.streamsCanceller[streamKey]; ok {
 		cancel()
-		delete(s.streamsCanceller, storeAddr)
+		delete(s.streamsCanceller, streamKey)
 	}
 }
 
-func (s *eventFeedSession) getStream(storeAddr string) (stream *eventFeedStream, ok bool) {
+func (s *eventFeedSession) getStream(streamKey string) (stream *eventFeedStream, ok bool) {
 	s.streamsLock.RLock()
 	defer s.streamsLock.RUnlock()
-	stream, ok = s.streams[storeAddr]
+	stream, ok = s.streams[streamKey]
 	return
 }
 
-func (s *eventFeedSession) getStreamCancel(storeAddr string) (cancel context.CancelFunc, ok bool) {
+func (s *eventFeedSession) getStreamCancel(streamKey string) (cancel context.CancelFunc, ok bool) {
 	s.streamsLock.RLock()
 	defer s.streamsLock.RUnlock()
-	cancel, ok = s.streamsCanceller[storeAddr]
+	cancel, ok = s.streamsCanceller[streamKey]
 	return
 }
 
@@ -195,7 +195,7 @@ func prepareBenchMultiStore(b *testing.B, storeNum, regionNum int) (
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 1000000)
 	wg.Add(1)
@@ -289,7 +289,7 @@ func prepareBench(b *testing.B, regionNum int) (
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 1000000)
 	wg.Add(1)
@@ -42,6 +42,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/txnutil"
 	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/pingcap/tiflow/pkg/version"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/testutils"
@@ -71,7 +72,7 @@ func TestNewClient(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cli := NewCDCClient(
-		context.Background(), pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		context.Background(), pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, model.DefaultChangeFeedID(""), 0, "", false)
 	require.NotNil(t, cli)
 }
@@ -319,7 +320,7 @@ func TestConnectOfflineTiKV(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		context.Background(), pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		context.Background(), pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	// Take care of the eventCh, it's used to output resolvedTs event or kv event
 	// It will stuck the normal routine
@@ -421,7 +422,7 @@ func TestRecvLargeMessageSize(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -521,7 +522,7 @@ func TestHandleError(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -680,7 +681,7 @@ func TestCompatibilityWithSameConn(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	var wg2 sync.WaitGroup
@@ -747,7 +748,7 @@ func TestClusterIDMismatch(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 
@@ -816,7 +817,7 @@ func testHandleFeedEvent(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -1277,7 +1278,7 @@ func TestStreamSendWithError(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -1389,7 +1390,7 @@ func testStreamRecvWithError(t *testing.T, failpointStr string) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -1522,7 +1523,7 @@ func TestStreamRecvWithErrorAndResolvedGoBack(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -1731,7 +1732,7 @@ func TestIncompatibleTiKV(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	// NOTICE: eventCh may block the main logic of EventFeed
 	eventCh := make(chan model.RegionFeedEvent, 128)
@@ -1808,7 +1809,7 @@ func TestNoPendingRegionError(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 
@@ -1887,7 +1888,7 @@ func TestDropStaleRequest(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2001,7 +2002,7 @@ func TestResolveLock(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2107,7 +2108,7 @@ func testEventCommitTsFallback(t *testing.T, events []*cdcpb.ChangeDataEvent) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	var clientWg sync.WaitGroup
@@ -2235,7 +2236,7 @@ func testEventAfterFeedStop(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2422,7 +2423,7 @@ func TestOutOfRegionRangeEvent(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2640,7 +2641,7 @@ func TestResolveLockNoCandidate(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2736,7 +2737,7 @@ func TestFailRegionReentrant(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2819,7 +2820,7 @@ func TestClientV1UnlockRangeReentrant(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2887,7 +2888,7 @@ func testClientErrNoPendingRegion(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -2965,7 +2966,7 @@ func testKVClientForceReconnect(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -3116,7 +3117,7 @@ func TestConcurrentProcessRangeRequest(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 100)
 	wg.Add(1)
@@ -3233,7 +3234,7 @@ func TestEvTimeUpdate(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -3359,7 +3360,7 @@ func TestRegionWorkerExitWhenIsIdle(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	wg.Add(1)
@@ -3451,7 +3452,7 @@ func TestPrewriteNotMatchError(t *testing.T) {
 	regionCache := tikv.NewRegionCache(pdClient)
 	defer regionCache.Close()
 	cdcClient := NewCDCClient(
-		ctx, pdClient, grpcPool, regionCache, pdutil.NewClock4Test(),
+		ctx, pdClient, grpcPool, nil, regionCache, pdutil.NewClock4Test(),
 		config.GetDefaultServerConfig().KVClient, changefeed, 0, "", false)
 	eventCh := make(chan model.RegionFeedEvent, 50)
 	baseAllocatedID := currentRequestID()
@@ -3541,3 +3542,125 @@ func createFakeEventFeedSession() *eventFeedSession {
 		nil, /*eventCh*/
 	)
 }
+
+func TestGetStreamKey(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultServerConfig().KVClient
+	s := &eventFeedSession{client: &CDCClient{config: cfg}}
+	require.Equal(t, "127.0.0.1:20160", s.getStreamKey("127.0.0.1:20160", 7))
+
+	cfg.StreamsPerStore = 4
+	require.Equal(t, "127.0.0.1:20160#3", s.getStreamKey("127.0.0.1:20160", 7))
+	require.Equal(t, "127.0.0.1:20160#0", s.getStreamKey("127.0.0.1:20160", 8))
+}
+
+func TestSlowestRegions(t *testing.T) {
+	t.Parallel()
+
+	c := &CDCClient{}
+	c.regionFeeds.v = make(map[*regionWorker]*syncRegionFeedStateMap)
+	require.Empty(t, c.SlowestRegions(3))
+
+	newState := func(regionID, storeID uint64, resolvedTs uint64) *regionFeedState {
+		rpcCtx := &tikv.RPCContext{
+			Addr: fmt.Sprintf("store-%d", storeID),
+			Peer: &metapb.Peer{StoreId: storeID},
+		}
+		return newRegionFeedState(newSingleRegionInfo(
+			tikv.NewRegionVerID(regionID, 1, 1), tablepb.Span{StartKey: []byte("a"), EndKey: []byte("b")},
+			resolvedTs, rpcCtx), regionID)
+	}
+
+	worker := &regionWorker{statesManager: newRegionStateManager(4)}
+	for i, ts := range []uint64{30, 10, 40} {
+		state := newState(uint64(i+1), 1, ts)
+		state.start()
+		state.setInitialized()
+		worker.statesManager.setState(state.getRegionID(), state)
+	}
+	pendingRegions := newSyncRegionFeedStateMap()
+	pending := newState(4, 2, 20)
+	pendingRegions.setByRequestID(pending.requestID, pending)
+	c.regionFeeds.v[worker] = pendingRegions
+
+	stats := c.SlowestRegions(3)
+	require.Len(t, stats, 3)
+	require.Equal(t, RegionFeedStat{
+		RegionID:    2,
+		StoreID:     1,
+		StoreAddr:   "store-1",
+		Span:        tablepb.Span{StartKey: []byte("a"), EndKey: []byte("b")},
+		ResolvedTs:  10,
+		Initialized: true,
+	}, stats[0])
+	require.Equal(t, uint64(4), stats[1].RegionID)
+	require.Equal(t, uint64(2), stats[1].StoreID)
+	require.Equal(t, uint64(20), stats[1].ResolvedTs)
+	require.False(t, stats[1].Initialized)
+	require.Equal(t, uint64(1), stats[2].RegionID)
+}
+
+func TestSendStoreResolvedTs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := createFakeEventFeedSession()
+	worker := newRegionWorker(model.ChangeFeedID{}, s, "")
+	for regionID := uint64(1); regionID <= 3; regionID++ {
+		worker.setRegionState(regionID, newRegionFeedState(singleRegionInfo{
+			verID: tikv.NewRegionVerID(regionID, 1, 1),
+		}, regionID))
+	}
+	collect := func() (regions int) {
+		for {
+			select {
+			case events := <-worker.inputCh:
+				for _, ev := range events {
+					require.Equal(t, uint64(10), ev.resolvedTsEvent.resolvedTs)
+					regions += len(ev.resolvedTsEvent.regions)
+				}
+			default:
+				return
+			}
+		}
+	}
+
+	// A resolved ts without regions is ignored if the store doesn't send
+	// store-level resolved ts.
+	require.Nil(t, s.sendResolvedTs(ctx, &cdcpb.ResolvedTs{Ts: 10}, worker, false))
+	require.Equal(t, 0, collect())
+	require.Nil(t, s.sendResolvedTs(ctx, &cdcpb.ResolvedTs{Ts: 10, Regions: []uint64{1, 4}}, worker, false))
+	require.Equal(t, 1, collect())
+
+	// It applies to all regions of the stream if the store sends store-level
+	// resolved ts.
+	require.Nil(t, s.sendResolvedTs(ctx, &cdcpb.ResolvedTs{Ts: 10}, worker, true))
+	require.Equal(t, 3, collect())
+	require.Nil(t, s.sendResolvedTs(ctx, &cdcpb.ResolvedTs{Ts: 10, Regions: []uint64{2}}, worker, true))
+	require.Equal(t, 1, collect())
+}
+
+func TestSupportStoreResolvedTs(t *testing.T) {
+	t.Parallel()
+
+	_, cluster, pdClient, err := testutils.NewMockTiKV("", nil)
+	require.Nil(t, err)
+	cluster.AddStore(1, "localhost:1")
+	storeVersion := version.MinTiKVVersionForStoreResolvedTs.String()
+	pdClient = &mockPDClient{Client: pdClient, versionGen: func() string { return storeVersion }}
+	cfg := config.GetDefaultServerConfig().KVClient
+	c := &CDCClient{pd: pdClient, config: cfg}
+	ctx := context.Background()
+
+	// It's disabled by default.
+	require.False(t, c.supportStoreResolvedTs(ctx, 1))
+
+	cfg.EnableStoreResolvedTs = true
+	require.True(t, c.supportStoreResolvedTs(ctx, 1))
+	// The store doesn't send store-level resolved ts.
+	storeVersion = "7.1.0"
+	require.False(t, c.supportStoreResolvedTs(ctx, 1))
+	// The store doesn't exist.
+	require.False(t, c.supportStoreResolvedTs(ctx, 2))
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/security"
 	"go.uber.org/zap"
@@ -28,20 +29,35 @@ import (
 )
 
 const (
-	// The default max number of TiKV concurrent streams in each connection is 1024
-	grpcConnCapacity = 1000
-
-	// resizeBucket means how many buckets will be extended when resizing a conn array
-	resizeBucketStep = 2
-
 	updateMetricInterval = 1 * time.Minute
 	recycleConnInterval  = 10 * time.Minute
 )
 
+// grpcConnOptions is the options of gRPC connections created by GrpcPoolImpl.
+type grpcConnOptions struct {
+	// connCount means how many connections will be created when resizing a conn array
+	connCount int
+	// connCapacity is the max number of streams in each connection
+	connCapacity int64
+
+	streamWindowSize int32
+	connWindowSize   int32
+}
+
+func newGrpcConnOptions(cfg *config.KVClientConfig) *grpcConnOptions {
+	return &grpcConnOptions{
+		connCount:        cfg.GrpcConnectionCount,
+		connCapacity:     int64(cfg.GrpcStreamsPerConnection),
+		streamWindowSize: int32(cfg.GrpcStreamWindowSize),
+		connWindowSize:   int32(cfg.GrpcConnectionWindowSize),
+	}
+}
+
 // connArray is an array of sharedConn
 type connArray struct {
 	// target is TiKV storage address
-	target string
+	target  string
+	options *grpcConnOptions
 
 	mu    sync.Mutex
 	conns []*sharedConn
@@ -50,15 +66,15 @@ type connArray struct {
 	next int
 }
 
-func newConnArray(target string) *connArray {
-	return &connArray{target: target}
+func newConnArray(target string, options *grpcConnOptions) *connArray {
+	return &connArray{target: target, options: options}
 }
 
 // resize increases conn array size by `size` parameter
 func (ca *connArray) resize(ctx context.Context, credential *security.Credential, size int) error {
 	conns := make([]*sharedConn, 0, size)
 	for i := 0; i < size; i++ {
-		conn, err := createClientConn(ctx, credential, ca.target)
+		conn, err := createClientConn(ctx, credential, ca.target, ca.options)
 		if err != nil {
 			return err
 		}
@@ -68,7 +84,9 @@ func (ca *connArray) resize(ctx context.Context, credential *security.Credential
 	return nil
 }
 
-func createClientConn(ctx context.Context, credential *security.Credential, target string) (*grpc.ClientConn, error) {
+func createClientConn(
+	ctx context.Context, credential *security.Credential, target string, options *grpcConnOptions,
+) (*grpc.ClientConn, error) {
 	grpcTLSOption, err := credential.ToGRPCDialOption()
 	if err != nil {
 		return nil, err
@@ -80,8 +98,8 @@ func createClientConn(ctx context.Context, credential *security.Credential, targ
 		ctx,
 		target,
 		grpcTLSOption,
-		grpc.WithInitialWindowSize(grpcInitialWindowSize),
-		grpc.WithInitialConnWindowSize(grpcInitialConnWindowSize),
+		grpc.WithInitialWindowSize(options.streamWindowSize),
+		grpc.WithInitialConnWindowSize(options.connWindowSize),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(grpcMaxCallRecvMsgSize)),
 		grpc.WithUnaryInterceptor(grpcMetrics.UnaryClientInterceptor()),
 		grpc.WithStreamInterceptor(grpcMetrics.StreamClientInterceptor()),
@@ -113,14 +131,14 @@ func (ca *connArray) getNext(ctx context.Context, credential *security.Credentia
 	defer ca.mu.Unlock()
 
 	if len(ca.conns) == 0 {
-		err := ca.resize(ctx, credential, resizeBucketStep)
+		err := ca.resize(ctx, credential, ca.options.connCount)
 		if err != nil {
 			return nil, err
 		}
 	}
 	for current := ca.next; current < ca.next+len(ca.conns); current++ {
 		conn := ca.conns[current%len(ca.conns)]
-		if conn.active < grpcConnCapacity {
+		if conn.active < ca.options.connCapacity {
 			conn.active++
 			ca.next = (current + 1) % len(ca.conns)
 			return conn, nil
@@ -128,8 +146,8 @@ func (ca *connArray) getNext(ctx context.Context, credential *security.Credentia
 	}
 
 	current := len(ca.conns)
-	// if there is no available conn, increase connArray size by 2.
-	err := ca.resize(ctx, credential, resizeBucketStep)
+	// if there is no available conn, increase connArray size by connCount.
+	err := ca.resize(ctx, credential, ca.options.connCount)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +207,7 @@ type GrpcPoolImpl struct {
 	bucketConns map[string]*connArray
 
 	credential *security.Credential
+	options    *grpcConnOptions
 
 	// lifecycles of all gPRC connections are bounded to this context
 	ctx context.Context
@@ -196,8 +215,17 @@ type GrpcPoolImpl struct {
 
 // NewGrpcPoolImpl creates a new GrpcPoolImpl instance
 func NewGrpcPoolImpl(ctx context.Context, credential *security.Credential) *GrpcPoolImpl {
+	return NewGrpcPoolImplWithConfig(ctx, credential, config.GetGlobalServerConfig().KVClient)
+}
+
+// NewGrpcPoolImplWithConfig creates a new GrpcPoolImpl instance, whose
+// connections are created with the given config.
+func NewGrpcPoolImplWithConfig(
+	ctx context.Context, credential *security.Credential, cfg *config.KVClientConfig,
+) *GrpcPoolImpl {
 	return &GrpcPoolImpl{
 		credential:  credential,
+		options:     newGrpcConnOptions(cfg),
 		bucketConns: make(map[string]*connArray),
 		ctx:         ctx,
 	}
@@ -208,7 +236,7 @@ func (pool *GrpcPoolImpl) GetConn(addr string) (*sharedConn, error) {
 	pool.poolMu.Lock()
 	defer pool.poolMu.Unlock()
 	if _, ok := pool.bucketConns[addr]; !ok {
-		pool.bucketConns[addr] = newConnArray(addr)
+		pool.bucketConns[addr] = newConnArray(addr, pool.options)
 	}
 	return pool.bucketConns[addr].getNext(pool.ctx, pool.credential)
 }
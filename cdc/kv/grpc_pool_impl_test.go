@@ -33,6 +33,7 @@ func TestConnArray(t *testing.T) {
 
 	pool := NewGrpcPoolImpl(ctx, &security.Credential{})
 	defer pool.Close()
+	grpcConnCapacity := int(pool.options.connCapacity)
 	addr := "127.0.0.1:20161"
 	conn, err := pool.GetConn(addr)
 	require.Nil(t, err)
@@ -67,6 +68,8 @@ func TestConnArrayRecycle(t *testing.T) {
 
 	pool := NewGrpcPoolImpl(ctx, &security.Credential{})
 	defer pool.Close()
+	grpcConnCapacity := int(pool.options.connCapacity)
+	resizeBucketStep := pool.options.connCount
 	addr := "127.0.0.1:20161"
 
 	bucket := 6
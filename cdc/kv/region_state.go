@@ -33,6 +33,9 @@ type singleRegionInfo struct {
 	span       tablepb.Span
 	resolvedTs uint64
 	rpcCtx     *tikv.RPCContext
+	// resubscribed is true if the region is requested again after an error,
+	// its incremental scan is not limited by the scan limiters.
+	resubscribed bool
 }
 
 func newSingleRegionInfo(
@@ -58,6 +61,12 @@ type regionFeedState struct {
 	matcher        *matcher
 	startFeedTime  time.Time
 	lastResolvedTs uint64
+
+	// requester is the store requester which sends the request of the region,
+	// the in-flight slot taken in it is released once the region is
+	// initialized or stopped.
+	requester        *storeRequester
+	inflightReleased atomic.Bool
 }
 
 func newRegionFeedState(sri singleRegionInfo, requestID uint64) *regionFeedState {
@@ -76,6 +85,7 @@ func (s *regionFeedState) start() {
 
 func (s *regionFeedState) markStopped() {
 	atomic.StoreInt32(&s.stopped, 1)
+	s.releaseInflight()
 }
 
 func (s *regionFeedState) isStopped() bool {
@@ -88,6 +98,15 @@ func (s *regionFeedState) isInitialized() bool {
 
 func (s *regionFeedState) setInitialized() {
 	s.initialized.Store(true)
+	s.releaseInflight()
+}
+
+// releaseInflight releases the in-flight slot taken by the region. It's safe
+// to be called multiple times.
+func (s *regionFeedState) releaseInflight() {
+	if s.requester != nil && s.inflightReleased.CompareAndSwap(false, true) {
+		s.requester.release()
+	}
 }
 
 func (s *regionFeedState) getRegionID() uint64 {
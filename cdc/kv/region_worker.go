@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/workerpool"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/oracle"
@@ -53,11 +54,17 @@ var (
 	batchEventsFactor         = 8
 	regionWorkerLowWatermark  = int(float64(batchEventsFactor*regionWorkerInputChanSize) * 0.2)
 	regionWorkerHighWatermark = int(float64(batchEventsFactor*regionWorkerInputChanSize) * 0.7)
+
+	// cpuUsageSampler is shared by all region workers to decide whether to
+	// scale up, see regionWorker.adjustConcurrency.
+	cpuUsageSampler = util.NewCPUUsageSampler(time.Second)
 )
 
 const (
 	maxWorkerPoolSize      = 64
 	maxResolvedLockPerLoop = 64
+	// the min interval between two adjustments of region worker concurrency
+	regionWorkerScaleInterval = 5 * time.Second
 )
 
 type regionWorkerMetrics struct {
@@ -99,15 +106,24 @@ type regionWorker struct {
 
 	// event handlers in region worker
 	handles []workerpool.EventHandle
-	// how many workers in worker pool will be used for this region worker
+	// how many workers in worker pool can be used for this region worker,
+	// events are hashed into slots by region ID with it
 	concurrency   int
 	statesManager *regionStateManager
 
+	// the number of handles used by the event handler, which scales between
+	// minConcurrency and concurrency
+	minConcurrency    int
+	activeConcurrency int
+	lastScaleTime     time.Time
+
 	rtsManager  *regionTsManager
 	rtsUpdateCh chan *rtsUpdateEvent
 
 	metrics *regionWorkerMetrics
 
+	// storeAddr is the key of the stream, which is the store address if only
+	// one stream is opened to each store.
 	storeAddr string
 
 	// how many pending input events
@@ -137,6 +153,10 @@ func newRegionWorker(
 	metrics.metricSendEventCommittedCounter = sendEventCounter.
 		WithLabelValues("committed", changefeedID.Namespace, changefeedID.ID)
 
+	concurrency := s.client.config.WorkerConcurrent
+	if s.client.config.MaxWorkerConcurrent > concurrency {
+		concurrency = s.client.config.MaxWorkerConcurrent
+	}
 	return &regionWorker{
 		session:       s,
 		inputCh:       make(chan []*regionStatefulEvent, regionWorkerInputChanSize),
@@ -146,9 +166,12 @@ func newRegionWorker(
 		rtsManager:    newRegionTsManager(),
 		rtsUpdateCh:   make(chan *rtsUpdateEvent, 1024),
 		storeAddr:     addr,
-		concurrency:   s.client.config.WorkerConcurrent,
+		concurrency:   concurrency,
 		metrics:       metrics,
 		inputPending:  0,
+
+		minConcurrency:    s.client.config.WorkerConcurrent,
+		activeConcurrency: s.client.config.WorkerConcurrent,
 	}
 }
 
@@ -363,6 +386,17 @@ func (w *regionWorker) processEvent(ctx context.Context, event *regionStatefulEv
 		w.metrics.metricReceivedEventSize.Observe(float64(event.changeEvent.Event.Size()))
 		switch x := event.changeEvent.Event.(type) {
 		case *cdcpb.Event_Entries_:
+			// The entries sent before the region is initialized are the data
+			// of the incremental scan, they are limited here rather than in
+			// the receive loop of the stream, so that the resolved ts of the
+			// other regions on the stream are not blocked.
+			if !event.state.isInitialized() && !event.state.sri.resubscribed {
+				for _, limiter := range w.session.scanLimiters {
+					if err = limiter.waitBytes(ctx, x.Entries.Size()); err != nil {
+						return err
+					}
+				}
+			}
 			err = w.handleEventEntry(ctx, x, event.state)
 			if err != nil {
 				err = w.handleSingleRegionError(err, event.state)
@@ -448,13 +482,50 @@ func (w *regionWorker) eventHandler(ctx context.Context) error {
 		return
 	}
 
+	// pollBufferedEvents polls the buffered batches without blocking, so that
+	// batches hashed into different handles can be processed in parallel.
+	// The exit signal is returned separately to be handled in the next round.
+	pollBufferedEvents := func(batches [][]*regionStatefulEvent) (
+		[][]*regionStatefulEvent, []*regionStatefulEvent,
+	) {
+		for len(batches) < w.activeConcurrency {
+			select {
+			case events := <-w.inputCh:
+				regionEventsBatchSize.Observe(float64(len(events)))
+				atomic.AddInt32(&w.inputPending, -int32(len(events)))
+				if events[0] == nil {
+					return batches, events
+				}
+				batches = append(batches, events)
+			default:
+				return batches, nil
+			}
+		}
+		return batches, nil
+	}
+	recycle := func(events []*regionStatefulEvent) {
+		for _, ev := range events {
+			// resolved ts event has been consumed, it is safe to put back.
+			if ev != nil && ev.resolvedTsEvent != nil {
+				w.session.resolvedTsPool.Put(ev)
+			}
+		}
+	}
+
 	highWatermarkMet := false
+	var pendingEvents []*regionStatefulEvent
 	for {
-		events, ok, err := pollEvents()
-		if err != nil {
-			return err
+		events, ok := pendingEvents, true
+		pendingEvents = nil
+		polled := events == nil
+		if polled {
+			var err error
+			events, ok, err = pollEvents()
+			if err != nil {
+				return err
+			}
+			regionEventsBatchSize.Observe(float64(len(events)))
 		}
-		regionEventsBatchSize.Observe(float64(len(events)))
 
 		inputPending := atomic.LoadInt32(&w.inputPending)
 		if highWatermarkMet {
@@ -462,40 +533,18 @@ func (w *regionWorker) eventHandler(ctx context.Context) error {
 		} else {
 			highWatermarkMet = int(inputPending) >= regionWorkerHighWatermark
 		}
-		atomic.AddInt32(&w.inputPending, -int32(len(events)))
+		if polled {
+			atomic.AddInt32(&w.inputPending, -int32(len(events)))
+		}
 
-		if highWatermarkMet {
-			// All events in one batch can be hashed into one handle slot.
-			slot := w.inputCalcSlot(events[0].regionID)
-			eventsX := make([]interface{}, 0, len(events))
-			for _, event := range events {
-				eventsX = append(eventsX, event)
-			}
-			err = w.handles[slot].AddEvents(ctx, eventsX)
-			if err != nil {
-				return err
-			}
-			// Principle: events from the same region must be processed linearly.
-			//
-			// When buffered events exceed high watermark, we start to use worker
-			// pool to improve throughput, and we need a mechanism to quit worker
-			// pool when buffered events are less than low watermark, which means
-			// we should have a way to know whether events sent to the worker pool
-			// are all processed.
-			// Send a dummy event to each worker pool handler, after each of these
-			// events are processed, we can ensure all events sent to worker pool
-			// from this region worker are processed.
-			finishedCallbackCh := make(chan struct{}, 1)
-			err = w.handles[slot].AddEvent(ctx, &regionStatefulEvent{finishedCallbackCh: finishedCallbackCh})
-			if err != nil {
+		if highWatermarkMet && ok && events[0] != nil {
+			var batches [][]*regionStatefulEvent
+			batches, pendingEvents = pollBufferedEvents([][]*regionStatefulEvent{events})
+			if err := w.dispatchEvents(ctx, batches); err != nil {
 				return err
 			}
-			select {
-			case <-ctx.Done():
-				return errors.Trace(ctx.Err())
-			case err = <-w.errorCh:
-				return err
-			case <-finishedCallbackCh:
+			for _, batch := range batches {
+				recycle(batch)
 			}
 		} else {
 			// We measure whether the current worker is busy based on the input
@@ -509,20 +558,115 @@ func (w *regionWorker) eventHandler(ctx context.Context) error {
 					return cerror.ErrRegionWorkerExit.GenWithStackByArgs()
 				}
 				if !skipEvent {
-					err = w.processEvent(ctx, event)
-					if err != nil {
+					if err := w.processEvent(ctx, event); err != nil {
 						return err
 					}
 				}
 			}
+			recycle(events)
 		}
-		for _, ev := range events {
-			// resolved ts event has been consumed, it is safe to put back.
-			if ev.resolvedTsEvent != nil {
-				w.session.resolvedTsPool.Put(ev)
-			}
+		w.maybeAdjustConcurrency()
+	}
+}
+
+// dispatchEvents sends batches of events to the worker pool, and waits until
+// all of them are processed. All events in one batch can be hashed into one
+// slot, and batches in the same slot are sent to the same handle, so events
+// from the same region are still processed linearly.
+func (w *regionWorker) dispatchEvents(
+	ctx context.Context, batches [][]*regionStatefulEvent,
+) error {
+	used := make([]bool, w.activeConcurrency)
+	usedCount := 0
+	for _, events := range batches {
+		idx := w.inputCalcSlot(events[0].regionID) % w.activeConcurrency
+		eventsX := make([]interface{}, 0, len(events))
+		for _, event := range events {
+			eventsX = append(eventsX, event)
+		}
+		if err := w.handles[idx].AddEvents(ctx, eventsX); err != nil {
+			return err
+		}
+		if !used[idx] {
+			used[idx] = true
+			usedCount++
+		}
+	}
+	// Principle: events from the same region must be processed linearly.
+	//
+	// When buffered events exceed high watermark, we start to use worker
+	// pool to improve throughput, and we need a mechanism to quit worker
+	// pool when buffered events are less than low watermark, which means
+	// we should have a way to know whether events sent to the worker pool
+	// are all processed.
+	// Send a dummy event to each worker pool handler, after each of these
+	// events are processed, we can ensure all events sent to worker pool
+	// from this region worker are processed.
+	finishedCallbackCh := make(chan struct{}, usedCount)
+	for idx, ok := range used {
+		if !ok {
+			continue
+		}
+		err := w.handles[idx].AddEvent(ctx, &regionStatefulEvent{finishedCallbackCh: finishedCallbackCh})
+		if err != nil {
+			return err
 		}
 	}
+	for i := 0; i < usedCount; i++ {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case err := <-w.errorCh:
+			return err
+		case <-finishedCallbackCh:
+		}
+	}
+	return nil
+}
+
+func (w *regionWorker) maybeAdjustConcurrency() {
+	if w.minConcurrency >= w.concurrency {
+		return
+	}
+	now := time.Now()
+	if now.Sub(w.lastScaleTime) < regionWorkerScaleInterval {
+		return
+	}
+	w.lastScaleTime = now
+	w.adjustConcurrency(int(atomic.LoadInt32(&w.inputPending)), cpuUsageSampler.Usage())
+}
+
+// adjustConcurrency scales the number of handles used by the event handler.
+// It scales up if events are piling up and the CPU has headroom, and scales
+// down if the backlog is drained or the CPU is overloaded. It must be called
+// when no event is in flight in the worker pool, because the mapping from
+// slots to handles changes.
+func (w *regionWorker) adjustConcurrency(inputPending int, cpuUsage float64) {
+	threshold := w.session.client.config.WorkerScaleCPUThreshold
+	active := w.activeConcurrency
+	if inputPending >= regionWorkerHighWatermark && cpuUsage < threshold {
+		active *= 2
+		if active > w.concurrency {
+			active = w.concurrency
+		}
+	} else if inputPending < regionWorkerLowWatermark || cpuUsage >= threshold {
+		active /= 2
+		if active < w.minConcurrency {
+			active = w.minConcurrency
+		}
+	}
+	if active == w.activeConcurrency {
+		return
+	}
+	log.Info("region worker concurrency changed",
+		zap.String("namespace", w.session.client.changefeed.Namespace),
+		zap.String("changefeed", w.session.client.changefeed.ID),
+		zap.String("addr", w.storeAddr),
+		zap.Int("from", w.activeConcurrency),
+		zap.Int("to", active),
+		zap.Int("inputPending", inputPending),
+		zap.Float64("cpuUsage", cpuUsage))
+	w.activeConcurrency = active
 }
 
 func (w *regionWorker) collectWorkpoolError(ctx context.Context) error {
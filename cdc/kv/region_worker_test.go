@@ -351,3 +351,37 @@ func TestRegionWorkerHandleEventsBeforeStartTs(t *testing.T) {
 	err = w.handleEventEntry(ctx, events, s1)
 	require.Nil(t, err)
 }
+
+func TestRegionWorkerAdjustConcurrency(t *testing.T) {
+	t.Parallel()
+
+	s := createFakeEventFeedSession()
+	s.client.config.WorkerConcurrent = 2
+	s.client.config.MaxWorkerConcurrent = 8
+	s.client.config.WorkerScaleCPUThreshold = 0.8
+	w := newRegionWorker(model.ChangeFeedID{}, s, "")
+	require.Equal(t, 8, w.concurrency)
+	require.Equal(t, 2, w.activeConcurrency)
+
+	// Scale up when events are piling up and the CPU has headroom.
+	w.adjustConcurrency(regionWorkerHighWatermark, 0.5)
+	require.Equal(t, 4, w.activeConcurrency)
+	w.adjustConcurrency(regionWorkerHighWatermark, 0.5)
+	require.Equal(t, 8, w.activeConcurrency)
+	w.adjustConcurrency(regionWorkerHighWatermark, 0.5)
+	require.Equal(t, 8, w.activeConcurrency)
+
+	// Keep the concurrency if the backlog is between the watermarks.
+	w.adjustConcurrency(regionWorkerLowWatermark, 0.5)
+	require.Equal(t, 8, w.activeConcurrency)
+
+	// Scale down when the CPU is overloaded even if events are piling up.
+	w.adjustConcurrency(regionWorkerHighWatermark, 0.9)
+	require.Equal(t, 4, w.activeConcurrency)
+
+	// Scale down when the backlog is drained.
+	w.adjustConcurrency(0, 0.5)
+	require.Equal(t, 2, w.activeConcurrency)
+	w.adjustConcurrency(0, 0.5)
+	require.Equal(t, 2, w.activeConcurrency)
+}
@@ -0,0 +1,160 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"golang.org/x/time/rate"
+)
+
+// scanLimiter limits the throughput of incremental scans. A region starts an
+// incremental scan once it's requested, and the scanned data is sent back
+// before the region is initialized.
+type scanLimiter struct {
+	// regions and bytes never block if they are unlimited.
+	regions *rate.Limiter
+	bytes   *rate.Limiter
+}
+
+func newScanLimiter(regionsPerSecond, mbPerSecond int) *scanLimiter {
+	l := &scanLimiter{
+		regions: rate.NewLimiter(rate.Inf, 0),
+		bytes:   rate.NewLimiter(rate.Inf, 0),
+	}
+	l.setLimits(regionsPerSecond, mbPerSecond)
+	return l
+}
+
+// setLimits updates the limits, 0 means unlimited.
+func (l *scanLimiter) setLimits(regionsPerSecond, mbPerSecond int) {
+	setLimit(l.regions, regionsPerSecond)
+	setLimit(l.bytes, mbPerSecond*1024*1024)
+}
+
+func setLimit(limiter *rate.Limiter, perSecond int) {
+	if perSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		limiter.SetBurst(0)
+		return
+	}
+	limiter.SetLimit(rate.Limit(perSecond))
+	limiter.SetBurst(perSecond)
+}
+
+func (l *scanLimiter) waitRegion(ctx context.Context) error {
+	return errors.Trace(l.regions.Wait(ctx))
+}
+
+func (l *scanLimiter) waitBytes(ctx context.Context, n int) error {
+	// WaitN fails if n exceeds the burst, so wait in chunks.
+	for n > 0 {
+		if l.bytes.Limit() == rate.Inf {
+			return nil
+		}
+		chunk := n
+		if burst := l.bytes.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := l.bytes.WaitN(ctx, chunk); err != nil {
+			return errors.Trace(err)
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// ScanLimiters holds the scan limiter of all changefeeds on the capture, and
+// the scan limiters of changefeeds, which are shared by all kv clients of a
+// changefeed. It is owned by the upstream, and a nil ScanLimiters limits
+// nothing.
+type ScanLimiters struct {
+	mu          sync.Mutex
+	cfg         *config.KVClientConfig
+	capture     *scanLimiter
+	changefeeds map[model.ChangeFeedID]*changefeedScanLimiter
+}
+
+type changefeedScanLimiter struct {
+	*scanLimiter
+	refCount int
+}
+
+// NewScanLimiters creates a ScanLimiters with the limits of the config.
+func NewScanLimiters(cfg *config.KVClientConfig) *ScanLimiters {
+	return &ScanLimiters{
+		cfg: cfg,
+		capture: newScanLimiter(
+			cfg.IncrementalScanRegionsPerSecond, cfg.IncrementalScanMBPerSecond),
+		changefeeds: make(map[model.ChangeFeedID]*changefeedScanLimiter),
+	}
+}
+
+// UpdateConfig updates the limits of the capture and all changefeeds.
+func (r *ScanLimiters) UpdateConfig(cfg *config.KVClientConfig) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cfg = cfg
+	r.capture.setLimits(
+		cfg.IncrementalScanRegionsPerSecond, cfg.IncrementalScanMBPerSecond)
+	for _, l := range r.changefeeds {
+		l.setLimits(
+			cfg.ChangefeedIncrementalScanRegionsPerSecond,
+			cfg.ChangefeedIncrementalScanMBPerSecond)
+	}
+}
+
+// acquire returns the scan limiters which should be waited by a kv client of
+// the changefeed. release must be called after the kv client exits.
+func (r *ScanLimiters) acquire(changefeed model.ChangeFeedID) []*scanLimiter {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.changefeeds[changefeed]
+	if !ok {
+		l = &changefeedScanLimiter{
+			scanLimiter: newScanLimiter(
+				r.cfg.ChangefeedIncrementalScanRegionsPerSecond,
+				r.cfg.ChangefeedIncrementalScanMBPerSecond),
+		}
+		r.changefeeds[changefeed] = l
+	}
+	l.refCount++
+	return []*scanLimiter{r.capture, l.scanLimiter}
+}
+
+func (r *ScanLimiters) release(changefeed model.ChangeFeedID) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.changefeeds[changefeed]
+	if !ok {
+		return
+	}
+	l.refCount--
+	if l.refCount == 0 {
+		delete(r.changefeeds, changefeed)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unlimited := newScanLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, unlimited.waitRegion(ctx))
+	}
+	require.NoError(t, unlimited.waitBytes(ctx, 1<<30))
+
+	l := newScanLimiter(10, 1)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, l.waitRegion(ctx))
+	}
+	// The burst is exhausted, so the next region must wait.
+	require.False(t, l.regions.Allow())
+
+	// Waiting for more bytes than the burst doesn't fail.
+	require.NoError(t, l.waitBytes(ctx, 1024*1024))
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, l.waitBytes(cctx, 2*1024*1024))
+
+	// The limits can be lifted and set again.
+	l.setLimits(0, 0)
+	require.NoError(t, l.waitRegion(ctx))
+	require.NoError(t, l.waitBytes(ctx, 1<<30))
+	l.setLimits(5, 0)
+	require.Equal(t, 5, l.regions.Burst())
+}
+
+func TestScanLimiters(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultServerConfig().KVClient
+	cfg.IncrementalScanRegionsPerSecond = 100
+	cfg.ChangefeedIncrementalScanRegionsPerSecond = 10
+	r := NewScanLimiters(cfg)
+
+	cf1 := model.DefaultChangeFeedID("cf1")
+	cf2 := model.DefaultChangeFeedID("cf2")
+	l1 := r.acquire(cf1)
+	l2 := r.acquire(cf1)
+	l3 := r.acquire(cf2)
+	// All kv clients share the capture limiter.
+	require.Same(t, l1[0], l2[0])
+	require.Same(t, l1[0], l3[0])
+	require.Equal(t, 100, l1[0].regions.Burst())
+	// kv clients of the same changefeed share the changefeed limiter.
+	require.Same(t, l1[1], l2[1])
+	require.NotSame(t, l1[1], l3[1])
+	require.Equal(t, 10, l1[1].regions.Burst())
+
+	// The limits of the capture and changefeeds are updated with the config.
+	cfg = config.GetDefaultServerConfig().KVClient
+	cfg.IncrementalScanRegionsPerSecond = 200
+	cfg.ChangefeedIncrementalScanRegionsPerSecond = 20
+	r.UpdateConfig(cfg)
+	require.Equal(t, 200, l1[0].regions.Burst())
+	require.Equal(t, 20, l3[1].regions.Burst())
+
+	r.release(cf1)
+	require.Len(t, r.changefeeds, 2)
+	r.release(cf1)
+	r.release(cf2)
+	require.Len(t, r.changefeeds, 0)
+
+	// A nil ScanLimiters limits nothing.
+	var unlimited *ScanLimiters
+	require.Nil(t, unlimited.acquire(cf1))
+	unlimited.release(cf1)
+	unlimited.UpdateConfig(cfg)
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/chann"
+)
+
+// storeRequester queues the regions located in the same store and sends their
+// requests to the store. The number of in-flight regions, which are requested
+// but not initialized yet, is limited, so the incremental scans of a table are
+// pipelined in each store instead of being issued all at once, and a store
+// with lots of regions to scan doesn't block the requests to other stores.
+type storeRequester struct {
+	storeAddr string
+	regions   *chann.DrainableChann[singleRegionInfo]
+	inflight  chan struct{}
+}
+
+func newStoreRequester(storeAddr string, maxInflight int) *storeRequester {
+	return &storeRequester{
+		storeAddr: storeAddr,
+		regions:   chann.NewAutoDrainChann[singleRegionInfo](),
+		inflight:  make(chan struct{}, maxInflight),
+	}
+}
+
+// acquire takes an in-flight slot, it blocks until a slot is released if
+// all slots are taken.
+func (r *storeRequester) acquire(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return errors.Trace(ctx.Err())
+	case r.inflight <- struct{}{}:
+		return nil
+	}
+}
+
+// release releases an in-flight slot, it must be called after acquire.
+func (r *storeRequester) release() {
+	<-r.inflight
+}
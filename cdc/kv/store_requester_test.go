@@ -0,0 +1,62 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
+)
+
+func TestStoreRequesterInflight(t *testing.T) {
+	t.Parallel()
+
+	requester := newStoreRequester("127.0.0.1:20160", 2)
+	defer requester.regions.CloseAndDrain()
+
+	ctx := context.Background()
+	states := make([]*regionFeedState, 0, 2)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, requester.acquire(ctx))
+		state := newRegionFeedState(singleRegionInfo{
+			verID: tikv.NewRegionVerID(uint64(i), 1, 1),
+		}, uint64(i))
+		state.requester = requester
+		states = append(states, state)
+	}
+
+	// All slots are taken, so the next region must wait.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.Error(t, requester.acquire(timeoutCtx))
+
+	// A slot is released only once even if the region is initialized and
+	// then stopped.
+	states[0].setInitialized()
+	states[0].markStopped()
+	require.Len(t, requester.inflight, 1)
+	require.NoError(t, requester.acquire(ctx))
+	require.Len(t, requester.inflight, 2)
+
+	states[1].releaseInflight()
+	require.Len(t, requester.inflight, 1)
+
+	// A region without requester doesn't release any slot.
+	state := newRegionFeedState(singleRegionInfo{}, 3)
+	state.setInitialized()
+	require.Len(t, requester.inflight, 1)
+}
@@ -28,6 +28,9 @@ type CaptureInfo struct {
 	ID            CaptureID `json:"id"`
 	AdvertiseAddr string    `json:"address"`
 	Version       string    `json:"version"`
+	GitHash       string    `json:"git-hash,omitempty"`
+	// Labels are the labels in the server config of the capture.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // Marshal using json.Marshal.
@@ -149,6 +149,12 @@ type ChangeFeedInfo struct {
 	CreatorVersion string `json:"creator-version"`
 	// Epoch is the epoch of a changefeed, changes on every restart.
 	Epoch uint64 `json:"epoch"`
+	// ErrorHandles skip or replace the events which can't be applied to
+	// the downstream.
+	ErrorHandles []*ErrorHandle `json:"error-handles,omitempty"`
+	// ErrorHistory is the recent errors and warnings of the changefeed, the
+	// oldest one comes first.
+	ErrorHistory []*ErrorRecord `json:"error-history,omitempty"`
 }
 
 const changeFeedIDMaxLen = 128
@@ -197,6 +203,12 @@ func (info *ChangeFeedInfo) String() (str string) {
 	if err != nil {
 		log.Error("failed to marshal changefeed info", zap.Error(err))
 	}
+	if clone.Config != nil && clone.Config.Consistent != nil {
+		clone.Config.Consistent.Storage, err = util.MaskSinkURI(clone.Config.Consistent.Storage)
+		if err != nil {
+			log.Error("failed to marshal changefeed info", zap.Error(err))
+		}
+	}
 
 	str, err = clone.Marshal()
 	if err != nil {
@@ -258,6 +270,20 @@ func (info *ChangeFeedInfo) Clone() (*ChangeFeedInfo, error) {
 	return cloned, err
 }
 
+// IsRuntimeMutableUpdate returns true if the new info only updates the
+// parameters which can be applied to the changefeed while it's running, see
+// config.IsRuntimeMutableSinkURI and config.ReplicaConfig.IsRuntimeMutable.
+func (info *ChangeFeedInfo) IsRuntimeMutableUpdate(newInfo *ChangeFeedInfo) (bool, error) {
+	if info.TargetTs != newInfo.TargetTs || info.UpstreamID != newInfo.UpstreamID {
+		return false, nil
+	}
+	ok, err := config.IsRuntimeMutableSinkURI(info.SinkURI, newInfo.SinkURI)
+	if err != nil || !ok {
+		return false, errors.Trace(err)
+	}
+	return newInfo.Config.IsRuntimeMutable(info.Config), nil
+}
+
 // VerifyAndComplete verifies changefeed info and may fill in some fields.
 // If a required field is not provided, return an error.
 // If some necessary filed is missing but can use a default value, fill in it.
@@ -0,0 +1,105 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ErrorHandleAction is the action to take on an event which can't be
+// applied to the downstream.
+type ErrorHandleAction string
+
+const (
+	// ErrorHandleSkip skips the event. For DML events, all the row changes of
+	// the table committed at the ts are skipped.
+	ErrorHandleSkip ErrorHandleAction = "skip"
+	// ErrorHandleReplace replaces the DDL event with another statement, it
+	// doesn't apply to DML events.
+	ErrorHandleReplace ErrorHandleAction = "replace"
+)
+
+// ErrorHandle handles the events of a table committed at a ts, which can't
+// be applied to the downstream.
+type ErrorHandle struct {
+	CommitTs Ts     `json:"commit-ts"`
+	Schema   string `json:"schema"`
+	// Table is empty for the DDL events of a schema.
+	Table  string            `json:"table"`
+	Action ErrorHandleAction `json:"action"`
+	// Statement is the statement to replace the DDL event with.
+	Statement string `json:"statement,omitempty"`
+}
+
+// Validate checks the error handle is complete.
+func (h *ErrorHandle) Validate() error {
+	if h.CommitTs == 0 {
+		return cerror.ErrInvalidErrorHandle.GenWithStackByArgs("commit ts is not set")
+	}
+	if h.Schema == "" {
+		return cerror.ErrInvalidErrorHandle.GenWithStackByArgs("schema is not set")
+	}
+	switch h.Action {
+	case ErrorHandleSkip:
+		if h.Statement != "" {
+			return cerror.ErrInvalidErrorHandle.GenWithStackByArgs(
+				"statement is only allowed by the replace action")
+		}
+	case ErrorHandleReplace:
+		if strings.TrimSpace(h.Statement) == "" {
+			return cerror.ErrInvalidErrorHandle.GenWithStackByArgs(
+				"statement is required by the replace action")
+		}
+	default:
+		return cerror.ErrInvalidErrorHandle.GenWithStackByArgs(
+			"unknown action " + string(h.Action))
+	}
+	return nil
+}
+
+// Match returns true if the error handle applies to the events of the
+// table committed at the ts.
+func (h *ErrorHandle) Match(commitTs Ts, schema, table string) bool {
+	return h.CommitTs == commitTs &&
+		strings.EqualFold(h.Schema, schema) &&
+		strings.EqualFold(h.Table, table)
+}
+
+// FindErrorHandle returns the error handle applies to the events of the
+// table committed at the ts, nil is returned if there is no such one.
+func FindErrorHandle(handles []*ErrorHandle, commitTs Ts, schema, table string) *ErrorHandle {
+	for _, h := range handles {
+		if h.Match(commitTs, schema, table) {
+			return h
+		}
+	}
+	return nil
+}
+
+// AddErrorHandle adds an error handle to the changefeed, it replaces the
+// one of the same events, and removes the ones committed before the
+// checkpoint ts, which have been replicated.
+func (info *ChangeFeedInfo) AddErrorHandle(handle *ErrorHandle, checkpointTs Ts) {
+	handles := make([]*ErrorHandle, 0, len(info.ErrorHandles)+1)
+	for _, h := range info.ErrorHandles {
+		if h.CommitTs < checkpointTs ||
+			h.Match(handle.CommitTs, handle.Schema, handle.Table) {
+			continue
+		}
+		handles = append(handles, h)
+	}
+	info.ErrorHandles = append(handles, handle)
+}
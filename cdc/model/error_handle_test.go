@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandleValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := []*ErrorHandle{
+		{CommitTs: 10, Schema: "test", Table: "t", Action: ErrorHandleSkip},
+		{CommitTs: 10, Schema: "test", Action: ErrorHandleSkip},
+		{
+			CommitTs: 10, Schema: "test", Table: "t", Action: ErrorHandleReplace,
+			Statement: "ALTER TABLE t ADD COLUMN c INT",
+		},
+	}
+	for _, h := range valid {
+		require.Nil(t, h.Validate())
+	}
+
+	invalid := []*ErrorHandle{
+		{Schema: "test", Action: ErrorHandleSkip},
+		{CommitTs: 10, Action: ErrorHandleSkip},
+		{CommitTs: 10, Schema: "test", Action: "ignore"},
+		{CommitTs: 10, Schema: "test", Action: ErrorHandleSkip, Statement: "SELECT 1"},
+		{CommitTs: 10, Schema: "test", Action: ErrorHandleReplace, Statement: " "},
+	}
+	for _, h := range invalid {
+		require.True(t, cerror.ErrInvalidErrorHandle.Equal(h.Validate()), h)
+	}
+}
+
+func TestFindErrorHandle(t *testing.T) {
+	t.Parallel()
+
+	handles := []*ErrorHandle{
+		{CommitTs: 10, Schema: "test", Table: "t1", Action: ErrorHandleSkip},
+		{CommitTs: 20, Schema: "test", Action: ErrorHandleSkip},
+	}
+	require.Equal(t, handles[0], FindErrorHandle(handles, 10, "TEST", "T1"))
+	require.Equal(t, handles[1], FindErrorHandle(handles, 20, "test", ""))
+	require.Nil(t, FindErrorHandle(handles, 10, "test", "t2"))
+	require.Nil(t, FindErrorHandle(handles, 11, "test", "t1"))
+	require.Nil(t, FindErrorHandle(nil, 10, "test", "t1"))
+}
+
+func TestAddErrorHandle(t *testing.T) {
+	t.Parallel()
+
+	info := &ChangeFeedInfo{}
+	h1 := &ErrorHandle{CommitTs: 10, Schema: "test", Table: "t1", Action: ErrorHandleSkip}
+	info.AddErrorHandle(h1, 5)
+	require.Equal(t, []*ErrorHandle{h1}, info.ErrorHandles)
+
+	// the handle of the same events is replaced
+	h2 := &ErrorHandle{
+		CommitTs: 10, Schema: "test", Table: "t1", Action: ErrorHandleReplace,
+		Statement: "ALTER TABLE t1 ADD COLUMN c INT",
+	}
+	info.AddErrorHandle(h2, 5)
+	require.Equal(t, []*ErrorHandle{h2}, info.ErrorHandles)
+
+	// the handles before the checkpoint are removed
+	h3 := &ErrorHandle{CommitTs: 30, Schema: "test", Table: "t1", Action: ErrorHandleSkip}
+	info.AddErrorHandle(h3, 20)
+	require.Equal(t, []*ErrorHandle{h3}, info.ErrorHandles)
+}
@@ -28,6 +28,58 @@ type RunningError struct {
 	Message string    `json:"message"`
 }
 
+// ErrorLevel is the level of an error in the error history of a changefeed.
+type ErrorLevel string
+
+const (
+	// ErrorLevelError means the error stops the changefeed.
+	ErrorLevelError ErrorLevel = "error"
+	// ErrorLevelWarning means the error is retried inside the changefeed.
+	ErrorLevelWarning ErrorLevel = "warning"
+)
+
+// maxErrorHistorySize is the max number of the records kept in the error
+// history of a changefeed, the oldest ones are dropped.
+const maxErrorHistorySize = 16
+
+// ErrorRecord is a record in the error history of a changefeed.
+type ErrorRecord struct {
+	RunningError
+	Level ErrorLevel `json:"level"`
+	// FirstTime is the time the error happened the first time, Time is the
+	// time it happened the last time.
+	FirstTime time.Time `json:"first-time"`
+	// Count is how many times the error happened in a row.
+	Count int `json:"count"`
+}
+
+// RecordError records an error in the error history of the changefeed, the
+// error is merged into the last record if they are the same.
+func (info *ChangeFeedInfo) RecordError(level ErrorLevel, err *RunningError) {
+	if err == nil {
+		return
+	}
+	if n := len(info.ErrorHistory); n > 0 {
+		last := info.ErrorHistory[n-1]
+		if last.Level == level && last.Addr == err.Addr &&
+			last.Code == err.Code && last.Message == err.Message {
+			last.Time = err.Time
+			last.Count++
+			return
+		}
+	}
+	info.ErrorHistory = append(info.ErrorHistory, &ErrorRecord{
+		RunningError: *err,
+		Level:        level,
+		FirstTime:    err.Time,
+		Count:        1,
+	})
+	if n := len(info.ErrorHistory); n > maxErrorHistorySize {
+		info.ErrorHistory = append(info.ErrorHistory[:0],
+			info.ErrorHistory[n-maxErrorHistorySize:]...)
+	}
+}
+
 // IsChangefeedUnRetryableError return true if a running error contains a changefeed not retry error.
 func (r RunningError) IsChangefeedUnRetryableError() bool {
 	return cerror.IsChangefeedUnRetryableError(errors.New(r.Message + r.Code))
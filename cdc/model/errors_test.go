@@ -14,7 +14,9 @@
 package model
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -55,3 +57,40 @@ func TestIsChangefeedNotRetryError(t *testing.T) {
 		require.Equal(t, c.result, c.err.IsChangefeedUnRetryableError())
 	}
 }
+
+func TestRecordError(t *testing.T) {
+	t.Parallel()
+
+	info := &ChangeFeedInfo{}
+	info.RecordError(ErrorLevelError, nil)
+	require.Empty(t, info.ErrorHistory)
+
+	now := time.Now()
+	err := &RunningError{Time: now, Addr: "capture-1", Code: "CDC:ErrSink", Message: "sink failed"}
+	info.RecordError(ErrorLevelError, err)
+	// the same error is merged into the last record
+	later := *err
+	later.Time = now.Add(time.Minute)
+	info.RecordError(ErrorLevelError, &later)
+	require.Equal(t, []*ErrorRecord{{
+		RunningError: later,
+		Level:        ErrorLevelError,
+		FirstTime:    now,
+		Count:        2,
+	}}, info.ErrorHistory)
+
+	// the same error of another level is not merged
+	info.RecordError(ErrorLevelWarning, &later)
+	require.Len(t, info.ErrorHistory, 2)
+
+	// the oldest records are dropped
+	for i := 0; i < maxErrorHistorySize; i++ {
+		info.RecordError(ErrorLevelWarning, &RunningError{
+			Time: now, Addr: "capture-2", Message: fmt.Sprintf("warning %d", i),
+		})
+	}
+	require.Len(t, info.ErrorHistory, maxErrorHistorySize)
+	require.Equal(t, "warning 0", info.ErrorHistory[0].Message)
+	require.Equal(t, fmt.Sprintf("warning %d", maxErrorHistorySize-1),
+		info.ErrorHistory[maxErrorHistorySize-1].Message)
+}
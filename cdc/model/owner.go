@@ -16,6 +16,7 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pingcap/errors"
 	timodel "github.com/pingcap/tidb/parser/model"
@@ -277,6 +278,35 @@ type ChangeFeedStatus struct {
 	// initializing the changefeed.
 	MinTableBarrierTs uint64       `json:"min-table-barrier-ts"`
 	AdminJobType      AdminJobType `json:"admin-job-type"`
+	// LagSLABreachedSince is the time when the changefeed started to breach
+	// its lag SLA, it's nil if the SLA is not breached. It's persisted so a
+	// new owner doesn't notify the breach again.
+	LagSLABreachedSince *time.Time `json:"lag-sla-breached-since,omitempty"`
+	// PausedTables are the tables paused by the user, they are excluded from
+	// the checkpoint calculation of the changefeed.
+	PausedTables map[TableID]*PausedTable `json:"paused-tables,omitempty"`
+}
+
+// PausedTable is a table whose replication is paused by the user.
+type PausedTable struct {
+	// CheckpointTs is the checkpoint ts of the changefeed when the table is
+	// paused, the table is replicated from it again once it's resumed.
+	CheckpointTs Ts `json:"checkpoint-ts"`
+	// Resumed is true if the table has been resumed and is catching up with
+	// the checkpoint ts of the changefeed.
+	Resumed bool `json:"resumed"`
+}
+
+// MinCheckpointTs returns the minimum checkpoint ts of the changefeed and
+// its paused tables, the upstream data after it must be retained.
+func (status *ChangeFeedStatus) MinCheckpointTs() Ts {
+	ts := status.CheckpointTs
+	for _, table := range status.PausedTables {
+		if table.CheckpointTs < ts {
+			ts = table.CheckpointTs
+		}
+	}
+	return ts
 }
 
 // Marshal returns json encoded string of ChangeFeedStatus, only contains necessary fields stored in storage
@@ -297,3 +327,56 @@ type ProcInfoSnap struct {
 	CfID      ChangeFeedID `json:"changefeed-id"`
 	CaptureID string       `json:"capture-id"`
 }
+
+// TablePhase is the replication phase of a table.
+type TablePhase string
+
+const (
+	// TablePhaseAbsent means the table is not scheduled to any capture.
+	TablePhaseAbsent TablePhase = "absent"
+	// TablePhaseScanning means the table is being prepared by a capture,
+	// which scans the incremental data of it before replicating.
+	TablePhaseScanning TablePhase = "scanning"
+	// TablePhaseReplicating means the table is being replicated.
+	TablePhaseReplicating TablePhase = "replicating"
+	// TablePhaseRemoving means the table is being removed from its captures.
+	TablePhaseRemoving TablePhase = "removing"
+	// TablePhaseExcluded means the table is paused by the user, and is
+	// excluded from the checkpoint calculation of the changefeed.
+	TablePhaseExcluded TablePhase = "excluded"
+	// TablePhaseCatchingUp means the table is resumed by the user, and is
+	// catching up with the checkpoint ts of the changefeed.
+	TablePhaseCatchingUp TablePhase = "catching-up"
+)
+
+// TableProgress is the replication progress of a table, a table may be split
+// into several spans, in which case the progress of the slowest span is used.
+type TableProgress struct {
+	TableID TableID `json:"table-id"`
+	// Captures are the captures replicating the spans of the table.
+	Captures     []CaptureID `json:"captures"`
+	Phase        TablePhase  `json:"phase"`
+	CheckpointTs Ts          `json:"checkpoint-ts"`
+	ResolvedTs   Ts          `json:"resolved-ts"`
+	SpanCount    int         `json:"span-count"`
+}
+
+// LagSLAStatus is the compliance of a changefeed with its lag SLA.
+type LagSLAStatus struct {
+	MaxLag        time.Duration `json:"max-lag"`
+	CheckpointLag time.Duration `json:"checkpoint-lag"`
+	Breached      bool          `json:"breached"`
+	// BreachedSince is the time when the changefeed started to breach the
+	// SLA, it's zero if the SLA is not breached.
+	BreachedSince time.Time `json:"breached-since"`
+	// BreachCount is the number of times the SLA has been breached since
+	// the changefeed was loaded by the owner.
+	BreachCount int       `json:"breach-count"`
+	CheckTime   time.Time `json:"check-time"`
+}
+
+// CaptureTableStats is the statistics of the tables replicated by a capture.
+type CaptureTableStats struct {
+	TableCount  int    `json:"table-count"`
+	RegionCount uint64 `json:"region-count"`
+}
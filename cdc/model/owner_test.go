@@ -88,6 +88,19 @@ func TestChangeFeedStatusMarshal(t *testing.T) {
 	require.Equal(t, status, newStatus)
 }
 
+func TestChangeFeedStatusMinCheckpointTs(t *testing.T) {
+	t.Parallel()
+
+	status := &ChangeFeedStatus{CheckpointTs: 100}
+	require.Equal(t, Ts(100), status.MinCheckpointTs())
+
+	status.PausedTables = map[TableID]*PausedTable{
+		1: {CheckpointTs: 80},
+		2: {CheckpointTs: 90, Resumed: true},
+	}
+	require.Equal(t, Ts(80), status.MinCheckpointTs())
+}
+
 func TestTableOperationState(t *testing.T) {
 	t.Parallel()
 
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	redoCfg "github.com/pingcap/tiflow/pkg/redo"
 	"github.com/pingcap/tiflow/pkg/sink/observer"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -96,6 +97,9 @@ type changefeed struct {
 
 	// state related fields
 	initialized bool
+	// initInfo is the changefeed info the changefeed was initialized with,
+	// only the runtime-mutable updates of the info take effect after that.
+	initInfo *model.ChangeFeedInfo
 	// isRemoved is true if the changefeed is removed,
 	// which means it will be removed from memory forever
 	isRemoved bool
@@ -123,6 +127,8 @@ type changefeed struct {
 	downstreamObserver observer.Observer
 	observerLastTick   *atomic.Time
 
+	lagSLA *lagSLATracker
+
 	newDDLPuller func(ctx context.Context,
 		replicaConfig *config.ReplicaConfig,
 		up *upstream.Upstream,
@@ -171,6 +177,7 @@ func newChangefeed(
 		newDDLPuller:          puller.NewDDLPuller,
 		newSink:               newDDLSink,
 		newDownstreamObserver: observer.NewObserver,
+		lagSLA:                newLagSLATracker(id),
 	}
 	c.newScheduler = newScheduler
 	c.cfg = cfg
@@ -320,6 +327,11 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 	if !c.feedStateManager.ShouldRunning() {
 		c.isRemoved = c.feedStateManager.ShouldRemoved()
 		c.releaseResources(ctx)
+		if c.isRemoved {
+			c.lagSLA.cleanup()
+		} else {
+			c.updateStoppedLagSLA(preCheckpointTs)
+		}
 		return nil
 	}
 
@@ -341,6 +353,8 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 	default:
 	}
 
+	// The DDL events of the paused tables are held until they catch up.
+	c.ddlManager.updatePausedTables(c.state.Status.PausedTables)
 	// TODO: pass table checkpointTs when we support concurrent process ddl
 	allPhysicalTables, barrier, err := c.ddlManager.tick(ctx, preCheckpointTs, nil)
 	if err != nil {
@@ -385,9 +399,14 @@ func (c *changefeed) tick(ctx cdcContext.Context, captures map[model.CaptureID]*
 		return nil
 	}
 
+	currentTables, catchUpTables := filterPausedTables(
+		allPhysicalTables, c.state.Status.PausedTables)
+	caughtUpTables := c.scheduler.CatchUpTables(catchUpTables)
+	c.cleanupPausedTables(allPhysicalTables, caughtUpTables)
+
 	startTime := time.Now()
 	newCheckpointTs, newResolvedTs, err := c.scheduler.Tick(
-		ctx, preCheckpointTs, allPhysicalTables, captures, barrier.Barrier)
+		ctx, preCheckpointTs, currentTables, captures, barrier.Barrier)
 	costTime := time.Since(startTime)
 	if costTime > schedulerLogsWarnDuration {
 		log.Warn("scheduler tick took too long",
@@ -606,16 +625,18 @@ LOOP2:
 	}
 	c.barriers.Update(finishBarrier, c.state.Info.GetTargetTs())
 
-	filter, err := filter.NewFilter(c.state.Info.Config, "")
+	f, err := filter.NewFilter(c.state.Info.Config, "")
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// skip the DDL events skipped by the error handles of the changefeed.
+	f = filter.NewErrorHandleFilter(f, c.state.Info.ErrorHandles)
 	c.schema, err = newSchemaWrap4Owner(
 		c.upstream.KVStorage,
 		ddlStartTs,
 		c.state.Info.Config,
 		c.id,
-		filter)
+		f)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -647,7 +668,7 @@ LOOP2:
 		c.upstream, ddlStartTs,
 		c.id,
 		c.schema,
-		filter)
+		f)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -701,6 +722,11 @@ LOOP2:
 		return errors.Trace(err)
 	}
 
+	tableRouter, err := router.NewRouter(c.state.Info.Config.Sink.RouteRules)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	c.ddlManager = newDDLManager(
 		c.id,
 		ddlStartTs,
@@ -712,6 +738,8 @@ LOOP2:
 		c.redoMetaMgr,
 		downstreamType,
 		util.GetOrZero(c.state.Info.Config.BDRMode),
+		c.state.Info.ErrorHandles,
+		tableRouter,
 	)
 
 	// create scheduler
@@ -725,6 +753,10 @@ LOOP2:
 
 	c.initMetrics()
 
+	c.initInfo, err = c.state.Info.Clone()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	c.initialized = true
 	log.Info("changefeed initialized",
 		zap.String("namespace", c.state.ID.Namespace),
@@ -737,6 +769,34 @@ LOOP2:
 	return nil
 }
 
+// getEffectiveInfo returns the changefeed info currently in effect. For an
+// initialized changefeed it's the info it was initialized with, with the
+// runtime-mutable updates applied, otherwise it's the latest info.
+func (c *changefeed) getEffectiveInfo() (*model.ChangeFeedInfo, error) {
+	if !c.initialized || c.initInfo == nil {
+		return c.state.Info.Clone()
+	}
+	info, err := c.initInfo.Clone()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	mutable, err := c.initInfo.IsRuntimeMutableUpdate(c.state.Info)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if mutable {
+		info.SinkURI = c.state.Info.SinkURI
+		info.Config = c.state.Info.Config.Clone()
+	}
+	// The runtime states are always the latest.
+	info.AdminJobType = c.state.Info.AdminJobType
+	info.State = c.state.Info.State
+	info.Error = c.state.Info.Error
+	info.Warning = c.state.Info.Warning
+	info.Epoch = c.state.Info.Epoch
+	return info, nil
+}
+
 func (c *changefeed) initMetrics() {
 	c.metricsChangefeedCheckpointTsGauge = changefeedCheckpointTsGauge.
 		WithLabelValues(c.id.Namespace, c.id.ID)
@@ -801,6 +861,7 @@ func (c *changefeed) releaseResources(ctx cdcContext.Context) {
 	c.cleanupMetrics()
 	c.schema = nil
 	c.barriers = nil
+	c.initInfo = nil
 	c.initialized = false
 	c.isReleased = true
 
@@ -999,6 +1060,42 @@ func (c *changefeed) updateMetrics(currentTs int64, checkpointTs, resolvedTs mod
 	c.metricsChangefeedResolvedTsLagDuration.Observe(resolvedLag)
 
 	c.metricsCurrentPDTsGauge.Set(float64(currentTs))
+
+	c.updateLagSLA(time.Duration(currentTs-phyCkpTs) * time.Millisecond)
+}
+
+// updateLagSLA checks the checkpoint lag against the lag SLA, and persists
+// the breach time once it's changed.
+func (c *changefeed) updateLagSLA(lag time.Duration) {
+	var persisted *time.Time
+	if c.state.Status != nil {
+		persisted = c.state.Status.LagSLABreachedSince
+	}
+	if !c.lagSLA.update(c.state.Info.Config.LagSLA, lag, time.Now(), persisted) {
+		return
+	}
+	breachedSince := c.lagSLA.breachedSince()
+	c.state.PatchStatus(
+		func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+			if status == nil {
+				return nil, false, nil
+			}
+			status.LagSLABreachedSince = breachedSince
+			return status, true, nil
+		})
+}
+
+// updateStoppedLagSLA checks the lag SLA of a stopped or failed changefeed,
+// whose lag keeps growing from its last checkpoint.
+func (c *changefeed) updateStoppedLagSLA(checkpointTs model.Ts) {
+	if c.state.Info == nil || c.state.Info.Config == nil {
+		return
+	}
+	pdTime, err := c.upstream.PDClock.CurrentTime()
+	if err != nil {
+		return
+	}
+	c.updateLagSLA(pdTime.Sub(oracle.GetTimeFromTS(checkpointTs)))
 }
 
 func (c *changefeed) updateStatus(checkpointTs, resolvedTs, minTableBarrierTs model.Ts) {
@@ -1032,6 +1129,7 @@ func (c *changefeed) updateStatus(checkpointTs, resolvedTs, minTableBarrierTs mo
 func (c *changefeed) Close(ctx cdcContext.Context) {
 	startTime := time.Now()
 	c.releaseResources(ctx)
+	c.lagSLA.cleanup()
 
 	costTime := time.Since(startTime)
 	if costTime > changefeedLogsWarnDuration {
@@ -156,7 +156,9 @@ func (m *mockDDLSink) Barrier(ctx context.Context) error {
 }
 
 type mockScheduler struct {
-	currentTables []model.TableID
+	currentTables  []model.TableID
+	catchUpTables  map[model.TableID]model.Ts
+	caughtUpTables []model.TableID
 }
 
 func (m *mockScheduler) Tick(
@@ -181,6 +183,12 @@ func (m *mockScheduler) DrainCapture(target model.CaptureID) (int, error) {
 	return 0, nil
 }
 
+// CatchUpTables implement scheduler interface
+func (m *mockScheduler) CatchUpTables(tables map[model.TableID]model.Ts) []model.TableID {
+	m.catchUpTables = tables
+	return m.caughtUpTables
+}
+
 // Close closes the scheduler and releases resources.
 func (m *mockScheduler) Close(ctx context.Context) {}
 
@@ -384,6 +392,74 @@ func TestExecDDL(t *testing.T) {
 	require.Contains(t, cf.scheduler.(*mockScheduler).currentTables, job.TableID)
 }
 
+func TestExecDDLOfPausedTable(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	helper.DDL2Job("create database test0")
+	job := helper.DDL2Job("create table test0.table0(id int primary key)")
+	tableID := job.TableID
+	startTs := job.BinlogInfo.FinishedTS + 1000
+
+	ctx := cdcContext.NewContext4Test(context.Background(), true)
+	ctx.ChangefeedVars().Info.StartTs = startTs
+
+	cf, captures, tester := createChangefeed4Test(ctx, t)
+	cf.upstream.KVStorage = helper.Storage()
+	defer cf.Close(ctx)
+	tickThreeTime := func() {
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+		cf.Tick(ctx, captures)
+		tester.MustApplyPatches()
+	}
+	// pre check and initialize
+	tickThreeTime()
+	mockDDLPuller := cf.ddlManager.ddlPuller.(*mockDDLPuller)
+	mockDDLSink := cf.ddlManager.ddlSink.(*mockDDLSink)
+	mockScheduler := cf.scheduler.(*mockScheduler)
+	mockDDLPuller.resolvedTs = startTs
+	tickThreeTime()
+	require.Equal(t, startTs, cf.state.Status.CheckpointTs)
+
+	require.Nil(t, cf.pauseTables(ctx, []model.TableID{tableID}))
+	tester.MustApplyPatches()
+	tickThreeTime()
+	require.NotContains(t, mockScheduler.currentTables, tableID)
+
+	// The ddl of the paused table is held, the changefeed is blocked at it.
+	job = helper.DDL2Job("alter table test0.table0 add column c int")
+	mockDDLPuller.resolvedTs += 1000
+	ddlTs := mockDDLPuller.resolvedTs
+	job.BinlogInfo.FinishedTS = ddlTs
+	mockDDLPuller.ddlQueue = append(mockDDLPuller.ddlQueue, job)
+	tickThreeTime()
+	mockDDLPuller.resolvedTs += 1000
+	tickThreeTime()
+	require.Equal(t, ddlTs, cf.state.Status.CheckpointTs)
+	require.Nil(t, mockDDLSink.ddlExecuting)
+
+	// The resumed table catches up from the checkpoint it was paused at.
+	require.Nil(t, cf.resumeTables([]model.TableID{tableID}))
+	tester.MustApplyPatches()
+	tickThreeTime()
+	require.Equal(t, map[model.TableID]model.Ts{tableID: startTs},
+		mockScheduler.catchUpTables)
+	require.Contains(t, mockScheduler.currentTables, tableID)
+	require.Nil(t, mockDDLSink.ddlExecuting)
+
+	// The ddl is executed once the table catches up with it.
+	mockScheduler.caughtUpTables = []model.TableID{tableID}
+	tickThreeTime()
+	require.Empty(t, cf.state.Status.PausedTables)
+	require.Equal(t, "alter table test0.table0 add column c int",
+		mockDDLSink.ddlExecuting.Query)
+	mockDDLSink.ddlDone = true
+	tickThreeTime()
+	require.Equal(t, mockDDLPuller.resolvedTs, cf.state.Status.CheckpointTs)
+}
+
 func TestEmitCheckpointTs(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
@@ -638,3 +714,48 @@ func TestBarrierAdvance(t *testing.T) {
 		require.Less(t, cf.state.Info.StartTs+10, barrier)
 	}
 }
+
+func TestGetEffectiveInfo(t *testing.T) {
+	t.Parallel()
+
+	info := &model.ChangeFeedInfo{
+		SinkURI: "mysql://127.0.0.1:3306/?worker-count=4",
+		State:   model.StateNormal,
+		Config:  config.GetDefaultReplicaConfig(),
+	}
+	cf := &changefeed{state: &orchestrator.ChangefeedReactorState{Info: info}}
+
+	// not initialized, the latest info is in effect.
+	effective, err := cf.getEffectiveInfo()
+	require.Nil(t, err)
+	require.Equal(t, info.SinkURI, effective.SinkURI)
+	require.Equal(t, info.State, effective.State)
+
+	cf.initInfo, err = info.Clone()
+	require.Nil(t, err)
+	cf.initialized = true
+
+	// the runtime-mutable updates are in effect.
+	updated, err := info.Clone()
+	require.Nil(t, err)
+	updated.SinkURI = "mysql://127.0.0.1:3306/?worker-count=8"
+	updated.Config.MemoryQuota = 1024
+	updated.Epoch = 1
+	cf.state.Info = updated
+	effective, err = cf.getEffectiveInfo()
+	require.Nil(t, err)
+	require.Equal(t, updated.SinkURI, effective.SinkURI)
+	require.Equal(t, uint64(1024), effective.Config.MemoryQuota)
+	require.Equal(t, uint64(1), effective.Epoch)
+
+	// the other updates take effect after the changefeed is restarted.
+	updated, err = info.Clone()
+	require.Nil(t, err)
+	updated.TargetTs = 100
+	updated.Config.ForceReplicate = !info.Config.ForceReplicate
+	cf.state.Info = updated
+	effective, err = cf.getEffectiveInfo()
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), effective.TargetTs)
+	require.Equal(t, info.Config.ForceReplicate, effective.Config.ForceReplicate)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/puller"
 	"github.com/pingcap/tiflow/cdc/redo"
 	"github.com/pingcap/tiflow/cdc/scheduler/schedulepb"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"go.uber.org/zap"
 )
 
@@ -134,6 +135,16 @@ type ddlManager struct {
 	BDRMode       bool
 	sinkType      model.DownstreamType
 	ddlResolvedTs model.Ts
+
+	// errorHandles are used to replace the DDL events which can't be
+	// executed to the downstream.
+	errorHandles []*model.ErrorHandle
+	// pausedTables are the tables paused or catching up, their DDL events
+	// are held in pendingDDLs until they catch up with the DDL events.
+	pausedTables map[model.TableID]struct{}
+	// tableRouter renames the tables of the DDL events sent to the redo log,
+	// the ones sent to the ddl sink are routed by the sink.
+	tableRouter *router.Router
 }
 
 func newDDLManager(
@@ -147,6 +158,8 @@ func newDDLManager(
 	redoMetaManager redo.MetaManager,
 	sinkType model.DownstreamType,
 	bdrMode bool,
+	errorHandles []*model.ErrorHandle,
+	tableRouter *router.Router,
 ) *ddlManager {
 	log.Info("create ddl manager",
 		zap.String("namaspace", changefeedID.Namespace),
@@ -167,6 +180,8 @@ func newDDLManager(
 		checkpointTs:    checkpointTs,
 		ddlResolvedTs:   startTs,
 		BDRMode:         bdrMode,
+		errorHandles:    errorHandles,
+		tableRouter:     tableRouter,
 		// use the passed sinkType after we support get resolvedTs from sink
 		sinkType:        model.DB,
 		tableCheckpoint: make(map[model.TableName]model.Ts),
@@ -226,6 +241,9 @@ func (m *ddlManager) tick(
 			if err != nil {
 				return nil, nil, err
 			}
+			// The events are replaced before they are sent to both the redo
+			// log and the downstream, so that they are consistent.
+			m.replaceDDLEvents(events)
 
 			for _, event := range events {
 				// If changefeed is in BDRMode, skip ddl.
@@ -251,7 +269,11 @@ func (m *ddlManager) tick(
 			// Send DDL events to redo log.
 			if m.redoDDLManager.Enabled() {
 				for _, event := range events {
-					err := m.redoDDLManager.EmitDDLEvent(ctx, event)
+					routed, err := m.tableRouter.RouteDDL(event)
+					if err != nil {
+						return nil, nil, errors.Trace(err)
+					}
+					err = m.redoDDLManager.EmitDDLEvent(ctx, routed)
 					if err != nil {
 						return nil, nil, err
 					}
@@ -311,6 +333,30 @@ func (m *ddlManager) tick(
 	return tableIDs, m.barrier(), nil
 }
 
+// replaceDDLEvents replaces the DDL events by the replace error handles of
+// the changefeed. The DDL events skipped by the error handles have been
+// filtered out by the schema.
+func (m *ddlManager) replaceDDLEvents(events []*model.DDLEvent) {
+	for _, event := range events {
+		if event.TableInfo == nil {
+			continue
+		}
+		tableName := event.TableInfo.TableName
+		h := model.FindErrorHandle(m.errorHandles, event.CommitTs,
+			tableName.Schema, tableName.Table)
+		if h == nil || h.Action != model.ErrorHandleReplace {
+			continue
+		}
+		log.Info("replace a ddl event by the error handle",
+			zap.String("namespace", m.changfeedID.Namespace),
+			zap.String("ID", m.changfeedID.ID),
+			zap.String("query", event.Query),
+			zap.String("statement", h.Statement),
+			zap.Uint64("commitTs", event.CommitTs))
+		event.Query = h.Statement
+	}
+}
+
 func (m *ddlManager) shouldExecDDL(nextDDL *model.DDLEvent) bool {
 	// TiCDC guarantees all dml(s) that happen before a ddl was sent to
 	// downstream when this ddl is sent. So, we need to wait checkpointTs is
@@ -320,6 +366,17 @@ func (m *ddlManager) shouldExecDDL(nextDDL *model.DDLEvent) bool {
 	// [dml-1(ts=5), dml-2(ts=8), dml-3(ts=11), ddl-1(ts=11), ddl-2(ts=12)].
 	// We need to wait `checkpointTs == ddlCommitTs(ts=11)` before executing ddl-1.
 	checkpointReachBarrier := m.checkpointTs == nextDDL.CommitTs
+	if checkpointReachBarrier && nextDDL != m.executingDDL && m.heldByPausedTables(nextDDL) {
+		// The paused tables are excluded from the checkpoint calculation, so
+		// the checkpointTs doesn't mean they have reached the ddl commitTs.
+		// The ddl blocks the changefeed until they are resumed and caught up.
+		log.Debug("ddl is held by the paused tables",
+			zap.String("namespace", m.changfeedID.Namespace),
+			zap.String("changefeed", m.changfeedID.ID),
+			zap.String("query", nextDDL.Query),
+			zap.Uint64("commitTs", nextDDL.CommitTs))
+		return false
+	}
 
 	redoCheckpointReachBarrier := true
 	redoDDLResolvedTsExceedBarrier := true
@@ -424,6 +481,39 @@ func (m *ddlManager) updateCheckpointTs(checkpointTs model.Ts,
 	}
 }
 
+// updatePausedTables updates the tables paused or catching up.
+func (m *ddlManager) updatePausedTables(paused map[model.TableID]*model.PausedTable) {
+	if len(paused) == 0 {
+		m.pausedTables = nil
+		return
+	}
+	m.pausedTables = make(map[model.TableID]struct{}, len(paused))
+	for tableID := range paused {
+		m.pausedTables[tableID] = struct{}{}
+	}
+}
+
+// heldByPausedTables returns true if the ddl is related to a paused table.
+func (m *ddlManager) heldByPausedTables(ddl *model.DDLEvent) bool {
+	if len(m.pausedTables) == 0 {
+		return false
+	}
+	if ddl.Type == timodel.ActionDropSchema {
+		// The paused tables may be dropped with the schema.
+		return true
+	}
+	if ddl.TableInfo == nil || ddl.TableInfo.TableInfo == nil {
+		// The other schema ddls are not related to any table.
+		return false
+	}
+	for _, tableID := range getRelatedPhysicalTableIDs(ddl) {
+		if _, ok := m.pausedTables[tableID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // getAllTableNextDDL returns the next DDL of all tables.
 func (m *ddlManager) getAllTableNextDDL() []*model.DDLEvent {
 	res := make([]*model.DDLEvent, 0, 1)
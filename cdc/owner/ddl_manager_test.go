@@ -14,6 +14,7 @@
 package owner
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -48,7 +49,7 @@ func createDDLManagerForTest(t *testing.T) *ddlManager {
 		schema,
 		redo.NewDisabledDDLManager(),
 		redo.NewDisabledMetaManager(),
-		model.DB, false)
+		model.DB, false, nil, nil)
 	return res
 }
 
@@ -72,6 +73,32 @@ func newFakeDDLEvent(
 	}
 }
 
+// mockRedoDDLManager is an enabled redo DDL manager, it records the queries
+// of the DDL events written to the redo log.
+type mockRedoDDLManager struct {
+	redo.DDLManager
+	queries    []string
+	resolvedTs model.Ts
+}
+
+func (m *mockRedoDDLManager) Enabled() bool {
+	return true
+}
+
+func (m *mockRedoDDLManager) EmitDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
+	m.queries = append(m.queries, ddl.Query)
+	return nil
+}
+
+func (m *mockRedoDDLManager) UpdateResolvedTs(ctx context.Context, resolvedTs uint64) error {
+	m.resolvedTs = resolvedTs
+	return nil
+}
+
+func (m *mockRedoDDLManager) GetResolvedTs() model.Ts {
+	return m.resolvedTs
+}
+
 func TestGetNextDDL(t *testing.T) {
 	dm := createDDLManagerForTest(t)
 	dm.executingDDL = newFakeDDLEvent(1,
@@ -455,3 +482,72 @@ func TestIsGlobalDDL(t *testing.T) {
 		require.Equal(t, c.ret, isGlobalDDL(c.ddl))
 	}
 }
+
+func TestErrorHandlesWithRedo(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	ctx := context.Background()
+	dm := createDDLManagerForTest(t)
+	redoManager := &mockRedoDDLManager{
+		DDLManager: redo.NewDisabledDDLManager(),
+	}
+	dm.redoDDLManager = redoManager
+	ddlPuller := &mockDDLPuller{schemaStorage: dm.schema}
+	dm.ddlPuller = ddlPuller
+	mockDDLSink := dm.ddlSink.(*mockDDLSink)
+
+	for _, ddl := range []string{
+		"create database test1",
+		"create table test1.t1(id int primary key)",
+	} {
+		job := helper.DDL2Job(ddl)
+		dm.schema.AdvanceResolvedTs(job.BinlogInfo.FinishedTS - 1)
+		require.Nil(t, dm.schema.HandleDDLJob(job))
+	}
+	replaceJob := helper.DDL2Job("alter table test1.t1 add column c1 int")
+	skipJob := helper.DDL2Job("alter table test1.t1 add column c2 int")
+	replaceTs := replaceJob.BinlogInfo.FinishedTS
+	skipTs := skipJob.BinlogInfo.FinishedTS
+	handles := []*model.ErrorHandle{
+		{
+			CommitTs:  replaceTs,
+			Schema:    "test1",
+			Table:     "t1",
+			Action:    model.ErrorHandleReplace,
+			Statement: "alter table test1.t1 add column c1 bigint",
+		},
+		{
+			CommitTs: skipTs,
+			Schema:   "test1",
+			Table:    "t1",
+			Action:   model.ErrorHandleSkip,
+		},
+	}
+	dm.errorHandles = handles
+	dm.schema.filter = filter.NewErrorHandleFilter(dm.schema.filter, handles)
+
+	tick := func(job *timodel.Job, checkpointTs model.Ts) {
+		dm.schema.AdvanceResolvedTs(job.BinlogInfo.FinishedTS - 1)
+		ddlPuller.ddlQueue = append(ddlPuller.ddlQueue, job)
+		ddlPuller.resolvedTs = job.BinlogInfo.FinishedTS
+		_, _, err := dm.tick(ctx, checkpointTs, nil)
+		require.Nil(t, err)
+	}
+
+	// The replaced DDL is written to the redo log.
+	tick(replaceJob, 1)
+	require.Equal(t, []string{handles[0].Statement}, redoManager.queries)
+	require.Equal(t, handles[0].Statement, dm.getNextDDL().Query)
+
+	// The skipped DDL is neither written to the redo log nor executed.
+	tick(skipJob, 1)
+	require.Equal(t, []string{handles[0].Statement}, redoManager.queries)
+	require.Len(t, dm.getAllTableNextDDL(), 1)
+
+	// The replaced DDL is executed to the downstream.
+	mockDDLSink.ddlDone = true
+	_, _, err := dm.tick(ctx, replaceTs, nil)
+	require.Nil(t, err)
+	require.Equal(t, handles[0].Statement, mockDDLSink.ddlExecuting.Query)
+	require.Nil(t, dm.getNextDDL())
+}
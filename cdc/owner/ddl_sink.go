@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/ddlsink/factory"
 	"github.com/pingcap/tiflow/cdc/syncpointstore"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
 )
@@ -74,6 +75,9 @@ type ddlSinkImpl struct {
 	ddlCh chan *model.DDLEvent
 
 	sink ddlsink.Sink
+	// tableRouter renames the tables of the DDLs by the route rules, it's
+	// created along with the sink.
+	tableRouter *router.Router
 	// `sinkInitHandler` can be helpful in unit testing.
 	sinkInitHandler ddlSinkInitHandler
 
@@ -114,11 +118,16 @@ func ddlSinkInitializer(ctx context.Context, a *ddlSinkImpl) error {
 	log.Info("Try to create ddlSink based on sink",
 		zap.String("namespace", a.changefeedID.Namespace),
 		zap.String("changefeed", a.changefeedID.ID))
+	tableRouter, err := router.NewRouter(a.info.Config.Sink.RouteRules)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	s, err := factory.New(ctx, a.info.SinkURI, a.info.Config)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	a.sink = s
+	a.tableRouter = tableRouter
 
 	if !util.GetOrZero(a.info.Config.EnableSyncPoint) {
 		return nil
@@ -192,6 +201,9 @@ func (s *ddlSinkImpl) writeCheckpointTs(ctx context.Context, lastCheckpointTs *m
 		s.mu.Unlock()
 
 		if err = s.makeSinkReady(ctx); err == nil {
+			for i, table := range tables {
+				tables[i] = s.tableRouter.RouteTableInfo(table)
+			}
 			err = s.sink.WriteCheckpointTs(ctx, checkpointTs, tables)
 		}
 		if err == nil {
@@ -211,7 +223,12 @@ func (s *ddlSinkImpl) writeDDLEvent(ctx context.Context, ddl *model.DDLEvent) er
 
 	doWrite := func() (err error) {
 		if err = s.makeSinkReady(ctx); err == nil {
-			err = s.sink.WriteDDLEvent(ctx, ddl)
+			// The routed DDL is a copy, the DDL itself is kept for the owner
+			// which tracks the DDLs by the upstream tables.
+			var routed *model.DDLEvent
+			if routed, err = s.tableRouter.RouteDDL(ddl); err == nil {
+				err = s.sink.WriteDDLEvent(ctx, routed)
+			}
 			failpoint.Inject("InjectChangefeedDDLError", func() {
 				err = cerror.ErrExecDDLFailed.GenWithStackByArgs()
 			})
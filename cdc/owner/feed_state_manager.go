@@ -466,6 +466,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 					return nil, false, nil
 				}
 				info.Error = err
+				info.RecordError(model.ErrorLevelError, err)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -494,6 +495,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 					return nil, false, nil
 				}
 				info.Error = err
+				info.RecordError(model.ErrorLevelError, err)
 				return info, true, nil
 			})
 			m.shouldBeRunning = false
@@ -508,6 +510,7 @@ func (m *feedStateManager) handleError(errs ...*model.RunningError) {
 		}
 		for _, err := range errs {
 			info.Error = err
+			info.RecordError(model.ErrorLevelError, err)
 		}
 		return info, len(errs) > 0, nil
 	})
@@ -572,6 +575,7 @@ func (m *feedStateManager) handleWarning(errs ...*model.RunningError) {
 		}
 		for _, err := range errs {
 			info.Warning = err
+			info.RecordError(model.ErrorLevelWarning, err)
 		}
 		return info, len(errs) > 0, nil
 	})
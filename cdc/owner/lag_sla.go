@@ -0,0 +1,213 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.uber.org/zap"
+)
+
+const (
+	lagSLAWebhookTimeout = 10 * time.Second
+	// lagSLARecoverRatio is the ratio of the max lag the lag must drop to
+	// before a breached SLA is recovered, so a lag hovering around the max
+	// lag doesn't flap the notifications.
+	lagSLARecoverRatio = 0.9
+)
+
+// lagSLANotification is the payload sent to the webhook of a lag SLA.
+type lagSLANotification struct {
+	Namespace     string    `json:"namespace"`
+	Changefeed    string    `json:"changefeed"`
+	Breached      bool      `json:"breached"`
+	MaxLagSeconds float64   `json:"max_lag_seconds"`
+	LagSeconds    float64   `json:"lag_seconds"`
+	Time          time.Time `json:"time"`
+}
+
+// lagSLATracker tracks the compliance of a changefeed with its lag SLA.
+// It is only accessed in the owner goroutine.
+type lagSLATracker struct {
+	id     model.ChangeFeedID
+	status *model.LagSLAStatus
+	// notify sends the notification to the webhook, it's replaced in tests.
+	notify func(url string, n *lagSLANotification)
+}
+
+func newLagSLATracker(id model.ChangeFeedID) *lagSLATracker {
+	return &lagSLATracker{id: id, notify: sendLagSLANotification}
+}
+
+// update checks the checkpoint lag against the SLA, the status is reset if
+// the SLA is removed from the changefeed. persisted is the breach time
+// persisted in the changefeed status, which restores a breach detected by
+// the previous owner without notifying it again.
+//
+// It returns true if the breach time to persist is changed.
+func (t *lagSLATracker) update(
+	cfg *config.LagSLAConfig, lag time.Duration, now time.Time, persisted *time.Time,
+) bool {
+	if cfg == nil || cfg.MaxLagSeconds <= 0 {
+		t.status = nil
+		changefeedLagSLABreachedGauge.DeleteLabelValues(t.id.Namespace, t.id.ID)
+		return persisted != nil
+	}
+	if t.status == nil {
+		t.status = &model.LagSLAStatus{}
+		if persisted != nil {
+			t.status.Breached = true
+			t.status.BreachedSince = *persisted
+			changefeedLagSLABreachedGauge.WithLabelValues(t.id.Namespace, t.id.ID).Set(1)
+		}
+	}
+	s := t.status
+	s.MaxLag = cfg.MaxLag()
+	s.CheckpointLag = lag
+	s.CheckTime = now
+
+	breached := lag > s.MaxLag
+	if s.Breached {
+		breached = float64(lag) > float64(s.MaxLag)*lagSLARecoverRatio
+	}
+	if breached == s.Breached {
+		return false
+	}
+	s.Breached = breached
+	if breached {
+		s.BreachedSince = now
+		s.BreachCount++
+		changefeedLagSLABreachedGauge.WithLabelValues(t.id.Namespace, t.id.ID).Set(1)
+		log.Warn("changefeed breaches its lag SLA",
+			zap.String("namespace", t.id.Namespace),
+			zap.String("changefeed", t.id.ID),
+			zap.Duration("lag", lag),
+			zap.Duration("maxLag", s.MaxLag))
+	} else {
+		s.BreachedSince = time.Time{}
+		changefeedLagSLABreachedGauge.WithLabelValues(t.id.Namespace, t.id.ID).Set(0)
+		log.Info("changefeed recovers from breaching its lag SLA",
+			zap.String("namespace", t.id.Namespace),
+			zap.String("changefeed", t.id.ID),
+			zap.Duration("lag", lag),
+			zap.Duration("maxLag", s.MaxLag))
+	}
+	if cfg.WebhookURL != "" {
+		t.notify(cfg.WebhookURL, &lagSLANotification{
+			Namespace:     t.id.Namespace,
+			Changefeed:    t.id.ID,
+			Breached:      breached,
+			MaxLagSeconds: s.MaxLag.Seconds(),
+			LagSeconds:    lag.Seconds(),
+			Time:          now,
+		})
+	}
+	return true
+}
+
+// breachedSince returns the breach time to persist, nil is returned if the
+// SLA is not breached.
+func (t *lagSLATracker) breachedSince() *time.Time {
+	if t.status == nil || !t.status.Breached {
+		return nil
+	}
+	since := t.status.BreachedSince
+	return &since
+}
+
+// getStatus returns a copy of the status, nil is returned if the changefeed
+// has no lag SLA.
+func (t *lagSLATracker) getStatus() *model.LagSLAStatus {
+	if t.status == nil {
+		return nil
+	}
+	s := *t.status
+	return &s
+}
+
+// cleanup stops tracking the SLA until the changefeed is ticked again.
+func (t *lagSLATracker) cleanup() {
+	t.status = nil
+	changefeedLagSLABreachedGauge.DeleteLabelValues(t.id.Namespace, t.id.ID)
+}
+
+// sendLagSLANotification posts the notification to the webhook in background,
+// so that a slow webhook doesn't block the owner.
+func sendLagSLANotification(url string, n *lagSLANotification) {
+	go func() {
+		body, err := json.Marshal(n)
+		if err != nil {
+			log.Warn("failed to marshal lag SLA notification", zap.Error(err))
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), lagSLAWebhookTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			log.Warn("failed to send lag SLA notification", zap.Error(err))
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := lagSLAWebhookClient.Do(req)
+		if err != nil {
+			log.Warn("failed to send lag SLA notification",
+				zap.String("namespace", n.Namespace),
+				zap.String("changefeed", n.Changefeed),
+				zap.Error(err))
+			return
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			log.Warn("lag SLA webhook returns an error status",
+				zap.String("namespace", n.Namespace),
+				zap.String("changefeed", n.Changefeed),
+				zap.Int("status", resp.StatusCode))
+		}
+	}()
+}
+
+// lagSLAWebhookClient sends the notifications. It refuses to connect to the
+// addresses not allowed by config.IsWebhookIPAllowed after resolving the
+// host, and doesn't follow redirects, so a webhook can't be redirected to
+// the captures or the cloud metadata services.
+var lagSLAWebhookClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: lagSLAWebhookTimeout,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || !config.IsWebhookIPAllowed(ip) {
+					return errors.Errorf("lag SLA webhook address %s is not allowed", address)
+				}
+				return nil
+			},
+		}).DialContext,
+	},
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
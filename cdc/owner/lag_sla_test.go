@@ -0,0 +1,120 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLagSLATracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := newLagSLATracker(model.DefaultChangeFeedID("test-lag-sla"))
+	var notifications []*lagSLANotification
+	tracker.notify = func(url string, n *lagSLANotification) {
+		require.Equal(t, "http://127.0.0.1/hook", url)
+		notifications = append(notifications, n)
+	}
+	defer tracker.cleanup()
+
+	// no SLA
+	now := time.Now()
+	require.False(t, tracker.update(nil, time.Hour, now, nil))
+	require.Nil(t, tracker.getStatus())
+
+	cfg := &config.LagSLAConfig{MaxLagSeconds: 30, WebhookURL: "http://127.0.0.1/hook"}
+	require.False(t, tracker.update(cfg, 10*time.Second, now, nil))
+	status := tracker.getStatus()
+	require.False(t, status.Breached)
+	require.Equal(t, 30*time.Second, status.MaxLag)
+	require.Empty(t, notifications)
+
+	// breached
+	now = now.Add(time.Minute)
+	require.True(t, tracker.update(cfg, 40*time.Second, now, nil))
+	require.Equal(t, now, *tracker.breachedSince())
+	status = tracker.getStatus()
+	require.True(t, status.Breached)
+	require.Equal(t, now, status.BreachedSince)
+	require.Equal(t, 1, status.BreachCount)
+	require.Len(t, notifications, 1)
+	require.True(t, notifications[0].Breached)
+	require.Equal(t, float64(40), notifications[0].LagSeconds)
+
+	// still breached, no more notification
+	require.False(t, tracker.update(cfg, 50*time.Second, now.Add(time.Second), &now))
+	require.Equal(t, now, tracker.getStatus().BreachedSince)
+	require.Len(t, notifications, 1)
+	// not recovered until the lag drops below the recover ratio of the max lag
+	require.False(t, tracker.update(cfg, 29*time.Second, now.Add(time.Second), &now))
+	require.True(t, tracker.getStatus().Breached)
+
+	// recovered
+	require.True(t, tracker.update(cfg, 5*time.Second, now.Add(2*time.Second), &now))
+	require.Nil(t, tracker.breachedSince())
+	status = tracker.getStatus()
+	require.False(t, status.Breached)
+	require.True(t, status.BreachedSince.IsZero())
+	require.Equal(t, 1, status.BreachCount)
+	require.Len(t, notifications, 2)
+	require.False(t, notifications[1].Breached)
+
+	// the SLA is removed
+	require.False(t, tracker.update(nil, time.Hour, now.Add(3*time.Second), nil))
+	require.Nil(t, tracker.getStatus())
+}
+
+func TestLagSLATrackerRestore(t *testing.T) {
+	t.Parallel()
+
+	tracker := newLagSLATracker(model.DefaultChangeFeedID("test-lag-sla-restore"))
+	notified := 0
+	tracker.notify = func(string, *lagSLANotification) { notified++ }
+	defer tracker.cleanup()
+
+	// the breach detected by the previous owner isn't notified again.
+	cfg := &config.LagSLAConfig{MaxLagSeconds: 30, WebhookURL: "http://hooks.example.com"}
+	since := time.Now().Add(-time.Hour)
+	require.False(t, tracker.update(cfg, time.Hour, time.Now(), &since))
+	status := tracker.getStatus()
+	require.True(t, status.Breached)
+	require.Equal(t, since, status.BreachedSince)
+	require.Zero(t, notified)
+
+	// the persisted breach is cleared once the SLA is removed.
+	require.True(t, tracker.update(nil, time.Hour, time.Now(), &since))
+}
+
+func TestLagSLAWebhookClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the webhook must not reach the loopback address.
+	resp, err := lagSLAWebhookClient.Get(server.URL)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	require.Error(t, err)
+}
@@ -83,6 +83,14 @@ var (
 			Buckets:   lagBucket(),
 		}, []string{"namespace", "changefeed"})
 
+	changefeedLagSLABreachedGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "owner",
+			Name:      "lag_sla_breached",
+			Help:      "1 if the changefeed breaches its lag SLA, otherwise 0",
+		}, []string{"namespace", "changefeed"})
+
 	ownershipCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "ticdc",
@@ -148,6 +156,7 @@ func InitMetrics(registry *prometheus.Registry) {
 
 	registry.MustRegister(ownershipCounter)
 	registry.MustRegister(changefeedStatusGauge)
+	registry.MustRegister(changefeedLagSLABreachedGauge)
 	registry.MustRegister(changefeedTickDuration)
 	registry.MustRegister(changefeedCloseDuration)
 	registry.MustRegister(changefeedIgnoredDDLEventCounter)
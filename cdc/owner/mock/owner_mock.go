@@ -75,6 +75,18 @@ func (mr *MockOwnerMockRecorder) EnqueueJob(adminJob, done interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueJob", reflect.TypeOf((*MockOwner)(nil).EnqueueJob), adminJob, done)
 }
 
+// PauseTables mocks base method.
+func (m *MockOwner) PauseTables(cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PauseTables", cfID, tableIDs, done)
+}
+
+// PauseTables indicates an expected call of PauseTables.
+func (mr *MockOwnerMockRecorder) PauseTables(cfID, tableIDs, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTables", reflect.TypeOf((*MockOwner)(nil).PauseTables), cfID, tableIDs, done)
+}
+
 // Query mocks base method.
 func (m *MockOwner) Query(query *owner.Query, done chan<- error) {
 	m.ctrl.T.Helper()
@@ -99,6 +111,18 @@ func (mr *MockOwnerMockRecorder) RebalanceTables(cfID, done interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceTables", reflect.TypeOf((*MockOwner)(nil).RebalanceTables), cfID, done)
 }
 
+// ResumeTables mocks base method.
+func (m *MockOwner) ResumeTables(cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResumeTables", cfID, tableIDs, done)
+}
+
+// ResumeTables indicates an expected call of ResumeTables.
+func (mr *MockOwnerMockRecorder) ResumeTables(cfID, tableIDs, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTables", reflect.TypeOf((*MockOwner)(nil).ResumeTables), cfID, tableIDs, done)
+}
+
 // ScheduleTable mocks base method.
 func (m *MockOwner) ScheduleTable(cfID model.ChangeFeedID, toCapture model.CaptureID, tableID model.TableID, done chan<- error) {
 	m.ctrl.T.Helper()
@@ -95,6 +95,21 @@ func (mr *MockStatusProviderMockRecorder) GetCaptures(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCaptures", reflect.TypeOf((*MockStatusProvider)(nil).GetCaptures), ctx)
 }
 
+// GetCaptureTableStats mocks base method.
+func (m *MockStatusProvider) GetCaptureTableStats(ctx context.Context) (map[model.CaptureID]*model.CaptureTableStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCaptureTableStats", ctx)
+	ret0, _ := ret[0].(map[model.CaptureID]*model.CaptureTableStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCaptureTableStats indicates an expected call of GetCaptureTableStats.
+func (mr *MockStatusProviderMockRecorder) GetCaptureTableStats(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCaptureTableStats", reflect.TypeOf((*MockStatusProvider)(nil).GetCaptureTableStats), ctx)
+}
+
 // GetChangeFeedInfo mocks base method.
 func (m *MockStatusProvider) GetChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +140,36 @@ func (mr *MockStatusProviderMockRecorder) GetChangeFeedStatus(ctx, changefeedID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedStatus", reflect.TypeOf((*MockStatusProvider)(nil).GetChangeFeedStatus), ctx, changefeedID)
 }
 
+// GetEffectiveChangeFeedInfo mocks base method.
+func (m *MockStatusProvider) GetEffectiveChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveChangeFeedInfo", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveChangeFeedInfo indicates an expected call of GetEffectiveChangeFeedInfo.
+func (mr *MockStatusProviderMockRecorder) GetEffectiveChangeFeedInfo(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveChangeFeedInfo", reflect.TypeOf((*MockStatusProvider)(nil).GetEffectiveChangeFeedInfo), ctx, changefeedID)
+}
+
+// GetLagSLAStatus mocks base method.
+func (m *MockStatusProvider) GetLagSLAStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.LagSLAStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLagSLAStatus", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.LagSLAStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLagSLAStatus indicates an expected call of GetLagSLAStatus.
+func (mr *MockStatusProviderMockRecorder) GetLagSLAStatus(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLagSLAStatus", reflect.TypeOf((*MockStatusProvider)(nil).GetLagSLAStatus), ctx, changefeedID)
+}
+
 // GetProcessors mocks base method.
 func (m *MockStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +185,21 @@ func (mr *MockStatusProviderMockRecorder) GetProcessors(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessors", reflect.TypeOf((*MockStatusProvider)(nil).GetProcessors), ctx)
 }
 
+// GetTableProgresses mocks base method.
+func (m *MockStatusProvider) GetTableProgresses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableProgresses", ctx, changefeedID)
+	ret0, _ := ret[0].([]*model.TableProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableProgresses indicates an expected call of GetTableProgresses.
+func (mr *MockStatusProviderMockRecorder) GetTableProgresses(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableProgresses", reflect.TypeOf((*MockStatusProvider)(nil).GetTableProgresses), ctx, changefeedID)
+}
+
 // IsHealthy mocks base method.
 func (m *MockStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	m.ctrl.T.Helper()
@@ -45,6 +45,7 @@ const (
 	ownerJobTypeAdminJob
 	ownerJobTypeDebugInfo
 	ownerJobTypeQuery
+	ownerJobTypePauseTables
 )
 
 // versionInconsistentLogRate represents the rate of log output when there are
@@ -61,6 +62,11 @@ type ownerJob struct {
 	// for ScheduleTable only
 	TableID model.TableID
 
+	// for PauseTables only
+	TableIDs []model.TableID
+	// for PauseTables only, resume the tables instead of pausing them
+	ResumeTables bool
+
 	// for Admin Job only
 	AdminJob *model.AdminJob
 
@@ -88,6 +94,8 @@ type Owner interface {
 		tableID model.TableID, done chan<- error,
 	)
 	DrainCapture(query *scheduler.Query, done chan<- error)
+	PauseTables(cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error)
+	ResumeTables(cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error)
 	WriteDebugInfo(w io.Writer, done chan<- error)
 	Query(query *Query, done chan<- error)
 	AsyncStop()
@@ -274,6 +282,33 @@ func (o *ownerImpl) DrainCapture(query *scheduler.Query, done chan<- error) {
 	})
 }
 
+// PauseTables pauses replicating the tables of the specified changefeed
+// `done` must be buffered to prevent blocking owner.
+func (o *ownerImpl) PauseTables(
+	cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error,
+) {
+	o.pushOwnerJob(&ownerJob{
+		Tp:           ownerJobTypePauseTables,
+		ChangefeedID: cfID,
+		TableIDs:     tableIDs,
+		done:         done,
+	})
+}
+
+// ResumeTables resumes replicating the paused tables of the specified changefeed
+// `done` must be buffered to prevent blocking owner.
+func (o *ownerImpl) ResumeTables(
+	cfID model.ChangeFeedID, tableIDs []model.TableID, done chan<- error,
+) {
+	o.pushOwnerJob(&ownerJob{
+		Tp:           ownerJobTypePauseTables,
+		ChangefeedID: cfID,
+		TableIDs:     tableIDs,
+		ResumeTables: true,
+		done:         done,
+	})
+}
+
 // WriteDebugInfo writes debug info into the specified http writer
 func (o *ownerImpl) WriteDebugInfo(w io.Writer, done chan<- error) {
 	o.pushOwnerJob(&ownerJob{
@@ -487,6 +522,12 @@ func (o *ownerImpl) handleJobs(ctx context.Context) {
 			if cfReactor.scheduler != nil {
 				cfReactor.scheduler.Rebalance()
 			}
+		case ownerJobTypePauseTables:
+			if job.ResumeTables {
+				job.done <- cfReactor.resumeTables(job.TableIDs)
+			} else {
+				job.done <- cfReactor.pauseTables(ctx, job.TableIDs)
+			}
 		case ownerJobTypeQuery:
 			job.done <- o.handleQueries(job.query)
 		case ownerJobTypeDebugInfo:
@@ -552,6 +593,61 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 			return errors.Trace(err)
 		}
 		query.Data = ret
+	case QueryTableProgresses:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		provider := cfReactor.GetInfoProvider()
+		if provider == nil {
+			// The scheduler has not been initialized yet.
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		ret, err := provider.GetTableProgresses()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = withPausedTables(ret, cfReactor.state.Status)
+	case QueryLagSLAStatus:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		query.Data = cfReactor.lagSLA.getStatus()
+	case QueryEffectiveChangeFeedInfo:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok || cfReactor.state == nil || cfReactor.state.Info == nil {
+			return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.ChangeFeedID)
+		}
+		info, err := cfReactor.getEffectiveInfo()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		query.Data = info
+	case QueryCaptureTableStats:
+		ret := make(map[model.CaptureID]*model.CaptureTableStats, len(o.captures))
+		for captureID := range o.captures {
+			ret[captureID] = &model.CaptureTableStats{}
+		}
+		for _, cfReactor := range o.changefeeds {
+			provider := cfReactor.GetInfoProvider()
+			if provider == nil {
+				// The scheduler has not been initialized yet.
+				continue
+			}
+			stats, err := provider.GetCaptureTableStats()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for captureID, s := range stats {
+				if _, ok := ret[captureID]; !ok {
+					ret[captureID] = &model.CaptureTableStats{}
+				}
+				ret[captureID].TableCount += s.TableCount
+				ret[captureID].RegionCount += s.RegionCount
+			}
+		}
+		query.Data = ret
 	case QueryProcessors:
 		var ret []*model.ProcInfoSnap
 		for cfID, cfReactor := range o.changefeeds {
@@ -580,6 +676,8 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 				ID:            captureInfo.ID,
 				AdvertiseAddr: captureInfo.AdvertiseAddr,
 				Version:       captureInfo.Version,
+				GitHash:       captureInfo.GitHash,
+				Labels:        captureInfo.Labels,
 			})
 		}
 		query.Data = ret
@@ -746,6 +844,10 @@ func (o *ownerImpl) calculateGCSafepoint(state *orchestrator.GlobalReactorState)
 		}
 
 		checkpointTs := changefeedState.Info.GetCheckpointTs(changefeedState.Status)
+		if changefeedState.Status != nil {
+			// The paused tables catch up from their own checkpoints.
+			checkpointTs = changefeedState.Status.MinCheckpointTs()
+		}
 		upstreamID := changefeedState.Info.UpstreamID
 
 		if _, exist := minCheckpointTsMap[upstreamID]; !exist {
@@ -0,0 +1,240 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	redoCfg "github.com/pingcap/tiflow/pkg/redo"
+	"go.uber.org/zap"
+)
+
+// filterPausedTables removes the paused tables from the tables to be
+// replicated, and returns the ts the resumed tables catch up from.
+func filterPausedTables(
+	tables []model.TableID, paused map[model.TableID]*model.PausedTable,
+) ([]model.TableID, map[model.TableID]model.Ts) {
+	if len(paused) == 0 {
+		return tables, nil
+	}
+	res := make([]model.TableID, 0, len(tables))
+	catchUp := make(map[model.TableID]model.Ts)
+	for _, tableID := range tables {
+		table, ok := paused[tableID]
+		if !ok {
+			res = append(res, tableID)
+			continue
+		}
+		if table.Resumed {
+			res = append(res, tableID)
+			catchUp[tableID] = table.CheckpointTs
+		}
+	}
+	return res, catchUp
+}
+
+// withPausedTables marks the phases of the paused tables in the table
+// progresses, the paused tables which are not scheduled are added.
+func withPausedTables(
+	progresses []*model.TableProgress, status *model.ChangeFeedStatus,
+) []*model.TableProgress {
+	if status == nil || len(status.PausedTables) == 0 {
+		return progresses
+	}
+	found := make(map[model.TableID]struct{}, len(status.PausedTables))
+	for _, p := range progresses {
+		table, ok := status.PausedTables[p.TableID]
+		if !ok {
+			continue
+		}
+		found[p.TableID] = struct{}{}
+		if table.Resumed {
+			p.Phase = model.TablePhaseCatchingUp
+		} else {
+			// The table is being removed from its captures.
+			p.Phase = model.TablePhaseExcluded
+		}
+	}
+	for tableID, table := range status.PausedTables {
+		if _, ok := found[tableID]; ok {
+			continue
+		}
+		phase := model.TablePhaseExcluded
+		if table.Resumed {
+			phase = model.TablePhaseCatchingUp
+		}
+		progresses = append(progresses, &model.TableProgress{
+			TableID:      tableID,
+			Phase:        phase,
+			CheckpointTs: table.CheckpointTs,
+			ResolvedTs:   table.CheckpointTs,
+		})
+	}
+	return progresses
+}
+
+// pauseTables stops replicating the tables, they are excluded from the
+// checkpoint calculation until they are resumed.
+func (c *changefeed) pauseTables(ctx context.Context, tableIDs []model.TableID) error {
+	if err := c.checkPausableTables(ctx, tableIDs); err != nil {
+		return errors.Trace(err)
+	}
+	c.state.PatchStatus(
+		func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+			if status == nil {
+				return nil, false, nil
+			}
+			if status.PausedTables == nil {
+				status.PausedTables = make(map[model.TableID]*model.PausedTable)
+			}
+			changed := false
+			for _, tableID := range tableIDs {
+				table, ok := status.PausedTables[tableID]
+				if !ok {
+					// The table has been replicated to the checkpoint at least.
+					status.PausedTables[tableID] = &model.PausedTable{
+						CheckpointTs: status.CheckpointTs,
+					}
+					changed = true
+				} else if table.Resumed {
+					// The table is paused again before it catches up, it
+					// hasn't advanced the checkpoint since it was paused.
+					table.Resumed = false
+					changed = true
+				}
+			}
+			return status, changed, nil
+		})
+	log.Info("pause tables of changefeed",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.Int64s("tables", tableIDs))
+	return nil
+}
+
+// resumeTables resumes replicating the paused tables, they catch up from
+// the checkpoint they were paused at.
+func (c *changefeed) resumeTables(tableIDs []model.TableID) error {
+	if c.state.Status == nil {
+		return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(c.id)
+	}
+	for _, tableID := range tableIDs {
+		if _, ok := c.state.Status.PausedTables[tableID]; !ok {
+			return cerror.ErrTableNotPaused.GenWithStackByArgs(tableID, c.id)
+		}
+	}
+	c.state.PatchStatus(
+		func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+			if status == nil {
+				return nil, false, nil
+			}
+			changed := false
+			for _, tableID := range tableIDs {
+				if table, ok := status.PausedTables[tableID]; ok && !table.Resumed {
+					table.Resumed = true
+					changed = true
+				}
+			}
+			return status, changed, nil
+		})
+	log.Info("resume tables of changefeed",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.Int64s("tables", tableIDs))
+	return nil
+}
+
+// checkPausableTables checks that the changefeed is running and replicates
+// the tables.
+func (c *changefeed) checkPausableTables(ctx context.Context, tableIDs []model.TableID) error {
+	if !c.initialized || c.state.Status == nil {
+		return cerror.ErrPauseTableNotSupported.GenWithStackByArgs(
+			c.id, "the changefeed is not running")
+	}
+	if redoCfg.IsConsistentEnabled(c.state.Info.Config.Consistent.Level) {
+		return cerror.ErrPauseTableNotSupported.GenWithStackByArgs(
+			c.id, "the redo log is enabled")
+	}
+	tables, err := c.ddlManager.allPhysicalTables(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	replicated := make(map[model.TableID]struct{}, len(tables))
+	for _, tableID := range tables {
+		replicated[tableID] = struct{}{}
+	}
+	for _, tableID := range tableIDs {
+		if _, ok := replicated[tableID]; !ok {
+			return cerror.ErrTableNotReplicated.GenWithStackByArgs(tableID, c.id)
+		}
+	}
+	return nil
+}
+
+// cleanupPausedTables removes the resumed tables that have caught up and
+// the paused tables that are dropped from the paused tables.
+func (c *changefeed) cleanupPausedTables(
+	allTables []model.TableID, caughtUp []model.TableID,
+) {
+	paused := c.state.Status.PausedTables
+	if len(paused) == 0 {
+		return
+	}
+	existing := make(map[model.TableID]struct{}, len(allTables))
+	for _, tableID := range allTables {
+		existing[tableID] = struct{}{}
+	}
+	var dropped []model.TableID
+	for tableID := range paused {
+		if _, ok := existing[tableID]; !ok {
+			dropped = append(dropped, tableID)
+		}
+	}
+	if len(dropped) == 0 && len(caughtUp) == 0 {
+		return
+	}
+	c.state.PatchStatus(
+		func(status *model.ChangeFeedStatus) (*model.ChangeFeedStatus, bool, error) {
+			if status == nil {
+				return nil, false, nil
+			}
+			changed := false
+			for _, tableID := range dropped {
+				if _, ok := status.PausedTables[tableID]; ok {
+					delete(status.PausedTables, tableID)
+					changed = true
+				}
+			}
+			for _, tableID := range caughtUp {
+				// The table may be paused again before the patch is applied.
+				if table, ok := status.PausedTables[tableID]; ok && table.Resumed {
+					delete(status.PausedTables, tableID)
+					changed = true
+				}
+			}
+			if len(status.PausedTables) == 0 {
+				status.PausedTables = nil
+			}
+			return status, changed, nil
+		})
+	log.Info("tables are no longer paused",
+		zap.String("namespace", c.id.Namespace),
+		zap.String("changefeed", c.id.ID),
+		zap.Int64s("dropped", dropped),
+		zap.Int64s("caughtUp", caughtUp))
+}
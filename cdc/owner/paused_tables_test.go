@@ -0,0 +1,70 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package owner
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterPausedTables(t *testing.T) {
+	t.Parallel()
+
+	tables := []model.TableID{1, 2, 3}
+	current, catchUp := filterPausedTables(tables, nil)
+	require.Equal(t, tables, current)
+	require.Empty(t, catchUp)
+
+	current, catchUp = filterPausedTables(tables, map[model.TableID]*model.PausedTable{
+		1: {CheckpointTs: 10},
+		2: {CheckpointTs: 20, Resumed: true},
+		// dropped table
+		4: {CheckpointTs: 30},
+	})
+	require.Equal(t, []model.TableID{2, 3}, current)
+	require.Equal(t, map[model.TableID]model.Ts{2: 20}, catchUp)
+}
+
+func TestWithPausedTables(t *testing.T) {
+	t.Parallel()
+
+	progresses := []*model.TableProgress{
+		{TableID: 1, Phase: model.TablePhaseRemoving, CheckpointTs: 15},
+		{TableID: 2, Phase: model.TablePhaseReplicating, CheckpointTs: 25},
+		{TableID: 3, Phase: model.TablePhaseReplicating, CheckpointTs: 30},
+	}
+	require.Equal(t, progresses, withPausedTables(progresses, nil))
+
+	status := &model.ChangeFeedStatus{
+		CheckpointTs: 30,
+		PausedTables: map[model.TableID]*model.PausedTable{
+			1: {CheckpointTs: 10},
+			2: {CheckpointTs: 20, Resumed: true},
+			4: {CheckpointTs: 5},
+		},
+	}
+	progresses = withPausedTables(progresses, status)
+	require.Len(t, progresses, 4)
+	require.Equal(t, model.TablePhaseExcluded, progresses[0].Phase)
+	require.Equal(t, model.TablePhaseCatchingUp, progresses[1].Phase)
+	require.Equal(t, model.TablePhaseReplicating, progresses[2].Phase)
+	require.Equal(t, &model.TableProgress{
+		TableID:      4,
+		Phase:        model.TablePhaseExcluded,
+		CheckpointTs: 5,
+		ResolvedTs:   5,
+	}, progresses[3])
+}
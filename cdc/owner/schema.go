@@ -56,7 +56,7 @@ func newSchemaWrap4Owner(
 	}
 
 	schemaStorage, err := entry.NewSchemaStorage(
-		meta, startTs, config.ForceReplicate, id, util.RoleOwner, filter)
+		meta, startTs, config.ReplicateIneligibleTables(), id, util.RoleOwner, filter)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -87,8 +87,12 @@ func (s *schemaWrap4Owner) AllPhysicalTables(
 		return nil, err
 	}
 
+	var ineligibleTables []model.TableName
 	snap.IterTables(true, func(tblInfo *model.TableInfo) {
 		if s.shouldIgnoreTable(tblInfo) {
+			if s.isFailedIneligibleTable(tblInfo) {
+				ineligibleTables = append(ineligibleTables, tblInfo.TableName)
+			}
 			return
 		}
 		if pi := tblInfo.GetPartitionInfo(); pi != nil {
@@ -104,6 +108,9 @@ func (s *schemaWrap4Owner) AllPhysicalTables(
 		zap.Uint64("snapTs", snap.CurrentTs()),
 		zap.Any("tables", res),
 		zap.String("snapshot", snap.DumpToString()))
+	if len(ineligibleTables) != 0 {
+		return nil, cerror.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
+	}
 
 	return res, nil
 }
@@ -276,11 +283,11 @@ func (s *schemaWrap4Owner) shouldIgnoreTable(t *model.TableInfo) bool {
 	if s.filter.ShouldIgnoreTable(schemaName, tableName) {
 		return true
 	}
-	if !t.IsEligible(s.config.ForceReplicate) {
+	if !t.IsEligible(s.config.ReplicateIneligibleTables()) {
 		// Sequence is not supported yet, and always ineligible.
 		// Skip Warn to avoid confusion.
 		// See https://github.com/pingcap/tiflow/issues/4559
-		if !t.IsSequence() {
+		if !t.IsSequence() && !s.isFailedIneligibleTable(t) {
 			log.Warn("skip ineligible table",
 				zap.String("namespace", s.id.Namespace),
 				zap.String("changefeed", s.id.ID),
@@ -292,3 +299,11 @@ func (s *schemaWrap4Owner) shouldIgnoreTable(t *model.TableInfo) bool {
 	}
 	return false
 }
+
+// isFailedIneligibleTable returns whether the table is a table without a valid
+// index, which fails the changefeed by the fail policy.
+func (s *schemaWrap4Owner) isFailedIneligibleTable(t *model.TableInfo) bool {
+	return s.config.IneligibleTablePolicy == config.IneligibleTablePolicyFail &&
+		!t.IsSequence() && !t.IsEligible(false) &&
+		!s.filter.ShouldIgnoreTable(t.TableName.Schema, t.TableName.Table)
+}
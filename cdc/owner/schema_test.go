@@ -91,6 +91,46 @@ func TestAllPhysicalTables(t *testing.T) {
 	require.Equal(t, tableIDs, expectedTableIDs)
 }
 
+func TestAllPhysicalTablesWithIneligibleTablePolicy(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+	ver, err := helper.Storage().CurrentVersion(oracle.GlobalTxnScope)
+	require.Nil(t, err)
+	newSchema := func(policy string) *schemaWrap4Owner {
+		cfg := config.GetDefaultReplicaConfig()
+		cfg.IneligibleTablePolicy = policy
+		f, err := filter.NewFilter(cfg, "")
+		require.Nil(t, err)
+		schema, err := newSchemaWrap4Owner(helper.Storage(), ver.Ver,
+			cfg, dummyChangeFeedID, f)
+		require.Nil(t, err)
+		return schema
+	}
+	appendOnly := newSchema(config.IneligibleTablePolicyAppendOnly)
+	fail := newSchema(config.IneligibleTablePolicyFail)
+
+	job := helper.DDL2Job("create table test.t1(id int primary key)")
+	tableIDT1 := job.BinlogInfo.TableInfo.ID
+	for _, schema := range []*schemaWrap4Owner{appendOnly, fail} {
+		require.Nil(t, schema.HandleDDLJob(job))
+		tableIDs, err := schema.AllPhysicalTables(context.Background(), job.BinlogInfo.FinishedTS)
+		require.Nil(t, err)
+		require.Equal(t, []model.TableID{tableIDT1}, tableIDs)
+	}
+
+	// the ineligible table is replicated by the append-only policy, and fails
+	// the changefeed by the fail policy.
+	job = helper.DDL2Job("create table test.t2(id int)")
+	tableIDT2 := job.BinlogInfo.TableInfo.ID
+	require.Nil(t, appendOnly.HandleDDLJob(job))
+	tableIDs, err := appendOnly.AllPhysicalTables(context.Background(), job.BinlogInfo.FinishedTS)
+	require.Nil(t, err)
+	require.ElementsMatch(t, []model.TableID{tableIDT1, tableIDT2}, tableIDs)
+	require.Nil(t, fail.HandleDDLJob(job))
+	_, err = fail.AllPhysicalTables(context.Background(), job.BinlogInfo.FinishedTS)
+	require.Regexp(t, ".*ErrTableIneligible.*test.t2.*", err)
+}
+
 func TestAllTables(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
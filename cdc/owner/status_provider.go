@@ -39,6 +39,22 @@ type StatusProvider interface {
 	// GetAllTaskStatuses returns the task statuses for the specified changefeed.
 	GetAllTaskStatuses(ctx context.Context, changefeedID model.ChangeFeedID) (map[model.CaptureID]*model.TaskStatus, error)
 
+	// GetTableProgresses returns the replication progresses of all tables
+	// of the specified changefeed.
+	GetTableProgresses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableProgress, error)
+
+	// GetLagSLAStatus returns the compliance of the specified changefeed with
+	// its lag SLA, nil is returned if the changefeed has no lag SLA.
+	GetLagSLAStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.LagSLAStatus, error)
+
+	// GetEffectiveChangeFeedInfo returns the info currently in effect of the
+	// specified changefeed, which may differ from the stored one.
+	GetEffectiveChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error)
+
+	// GetCaptureTableStats returns the statistics of the tables replicated
+	// by each capture in all changefeeds.
+	GetCaptureTableStats(ctx context.Context) (map[model.CaptureID]*model.CaptureTableStats, error)
+
 	// GetProcessors returns the statuses of all processors
 	GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error)
 
@@ -65,6 +81,15 @@ const (
 	QueryCaptures
 	// QueryHealth is the type of query cluster health info.
 	QueryHealth
+	// QueryTableProgresses is the type of query the replication progresses of tables.
+	QueryTableProgresses
+	// QueryLagSLAStatus is the type of query the lag SLA status of a changefeed.
+	QueryLagSLAStatus
+	// QueryCaptureTableStats is the type of query the table statistics of captures.
+	QueryCaptureTableStats
+	// QueryEffectiveChangeFeedInfo is the type of query the info currently in
+	// effect of a changefeed.
+	QueryEffectiveChangeFeedInfo
 )
 
 // Query wraps query command and return results.
@@ -139,6 +164,49 @@ func (p *ownerStatusProvider) GetAllTaskStatuses(ctx context.Context, changefeed
 	return query.Data.(map[model.CaptureID]*model.TaskStatus), nil
 }
 
+func (p *ownerStatusProvider) GetTableProgresses(ctx context.Context, changefeedID model.ChangeFeedID) ([]*model.TableProgress, error) {
+	query := &Query{
+		Tp:           QueryTableProgresses,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.([]*model.TableProgress), nil
+}
+
+func (p *ownerStatusProvider) GetLagSLAStatus(ctx context.Context, changefeedID model.ChangeFeedID) (*model.LagSLAStatus, error) {
+	query := &Query{
+		Tp:           QueryLagSLAStatus,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.(*model.LagSLAStatus), nil
+}
+
+func (p *ownerStatusProvider) GetEffectiveChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error) {
+	query := &Query{
+		Tp:           QueryEffectiveChangeFeedInfo,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.(*model.ChangeFeedInfo), nil
+}
+
+func (p *ownerStatusProvider) GetCaptureTableStats(ctx context.Context) (map[model.CaptureID]*model.CaptureTableStats, error) {
+	query := &Query{
+		Tp: QueryCaptureTableStats,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return query.Data.(map[model.CaptureID]*model.CaptureTableStats), nil
+}
+
 func (p *ownerStatusProvider) GetProcessors(ctx context.Context) ([]*model.ProcInfoSnap, error) {
 	query := &Query{
 		Tp: QueryProcessors,
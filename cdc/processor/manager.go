@@ -22,12 +22,19 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
+	"github.com/pingcap/tiflow/pkg/txnutil"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tikv/client-go/v2/oracle"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/txnkv"
 	"go.uber.org/zap"
 )
 
@@ -36,15 +43,45 @@ type commandTp int
 const (
 	commandTpUnknown commandTp = iota
 	commandTpWriteDebugInfo
+	commandTpQueryTableStall
+	commandTpQuerySorterMetrics
 	processorLogsWarnDuration = 1 * time.Second
 )
 
+// maxStallLocks is the max number of locks reported for a stalled region.
+const maxStallLocks = 32
+
 type command struct {
 	tp      commandTp
 	payload interface{}
 	done    chan<- error
 }
 
+// RegionStall is a region which holds back the resolved ts of a table.
+type RegionStall struct {
+	kv.RegionFeedStat
+	// Lag is the duration between the resolved ts of the region and now.
+	Lag time.Duration
+	// Locks are the locks in the region, which may hold back its resolved ts.
+	Locks []*txnkv.Lock
+}
+
+type tableStallQuery struct {
+	changefeedID model.ChangeFeedID
+	tableID      model.TableID
+	limit        int
+
+	up      *upstream.Upstream
+	regions []kv.RegionFeedStat
+}
+
+type sorterMetricsQuery struct {
+	changefeedID model.ChangeFeedID
+	tableID      model.TableID
+
+	metrics engine.TableMetrics
+}
+
 // Manager is a manager of processor, which maintains the state and behavior of processors
 type Manager interface {
 	orchestrator.Reactor
@@ -54,6 +91,18 @@ type Manager interface {
 	Close()
 
 	WriteDebugInfo(ctx context.Context, w io.Writer, done chan<- error)
+
+	// QueryTableStall returns at most limit regions with the smallest resolved
+	// ts of the table, which hold back the resolved ts of the table.
+	QueryTableStall(
+		ctx context.Context, changefeedID model.ChangeFeedID,
+		tableID model.TableID, limit int,
+	) ([]RegionStall, error)
+
+	// QuerySorterMetrics returns a snapshot of the sorter metrics of the table.
+	QuerySorterMetrics(
+		ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID,
+	) (engine.TableMetrics, error)
 }
 
 // managerImpl is a manager of processor, which maintains the state and behavior of processors
@@ -203,6 +252,81 @@ func (m *managerImpl) WriteDebugInfo(
 	}
 }
 
+// QueryTableStall returns at most limit regions with the smallest resolved ts
+// of the table, which hold back the resolved ts of the table.
+func (m *managerImpl) QueryTableStall(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+	tableID model.TableID, limit int,
+) ([]RegionStall, error) {
+	query := &tableStallQuery{
+		changefeedID: changefeedID,
+		tableID:      tableID,
+		limit:        limit,
+	}
+	done := make(chan error, 1)
+	if err := m.sendCommand(ctx, commandTpQueryTableStall, query, done); err != nil {
+		return nil, errors.Trace(err)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, errors.Trace(ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	// Locks are scanned here instead of in Tick, because it sends requests
+	// to TiKV which may take a long time.
+	now, err := query.up.PDClock.CurrentTime()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	maxVersion := oracle.ComposeTS(oracle.GetPhysical(now), 0)
+	stalls := make([]RegionStall, 0, len(query.regions))
+	for _, region := range query.regions {
+		locks, err := txnutil.ScanLocks(ctx, query.up.KVStorage.(tikv.Storage),
+			region.RegionID, maxVersion, maxStallLocks)
+		if err != nil {
+			log.Warn("scan locks of stalled region failed",
+				zap.String("namespace", changefeedID.Namespace),
+				zap.String("changefeed", changefeedID.ID),
+				zap.Int64("tableID", tableID),
+				zap.Uint64("regionID", region.RegionID),
+				zap.Error(err))
+		}
+		stalls = append(stalls, RegionStall{
+			RegionFeedStat: region,
+			Lag:            now.Sub(oracle.GetTimeFromTS(region.ResolvedTs)),
+			Locks:          locks,
+		})
+	}
+	return stalls, nil
+}
+
+// QuerySorterMetrics returns a snapshot of the sorter metrics of the table.
+func (m *managerImpl) QuerySorterMetrics(
+	ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID,
+) (engine.TableMetrics, error) {
+	query := &sorterMetricsQuery{
+		changefeedID: changefeedID,
+		tableID:      tableID,
+	}
+	done := make(chan error, 1)
+	if err := m.sendCommand(ctx, commandTpQuerySorterMetrics, query, done); err != nil {
+		return engine.TableMetrics{}, errors.Trace(err)
+	}
+	select {
+	case <-ctx.Done():
+		return engine.TableMetrics{}, errors.Trace(ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return engine.TableMetrics{}, errors.Trace(err)
+		}
+	}
+	return query.metrics, nil
+}
+
 // sendCommands sends command to manager.
 // `done` is closed upon command completion or sendCommand returns error.
 func (m *managerImpl) sendCommand(
@@ -234,6 +358,18 @@ func (m *managerImpl) handleCommand() {
 		if err != nil {
 			cmd.done <- err
 		}
+	case commandTpQueryTableStall:
+		query := cmd.payload.(*tableStallQuery)
+		err := m.queryTableStall(query)
+		if err != nil {
+			cmd.done <- err
+		}
+	case commandTpQuerySorterMetrics:
+		query := cmd.payload.(*sorterMetricsQuery)
+		err := m.querySorterMetrics(query)
+		if err != nil {
+			cmd.done <- err
+		}
 	default:
 		log.Warn("Unknown command in processor manager", zap.Any("command", cmd))
 	}
@@ -251,3 +387,30 @@ func (m *managerImpl) writeDebugInfo(w io.Writer) error {
 
 	return nil
 }
+
+func (m *managerImpl) queryTableStall(query *tableStallQuery) error {
+	processor, ok := m.processors[query.changefeedID]
+	if !ok {
+		return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.changefeedID.ID)
+	}
+	regions, ok := processor.getSlowestRegions(query.tableID, query.limit)
+	if !ok {
+		return cerror.ErrProcessorTableNotFound.GenWithStackByArgs()
+	}
+	query.up = processor.upstream
+	query.regions = regions
+	return nil
+}
+
+func (m *managerImpl) querySorterMetrics(query *sorterMetricsQuery) error {
+	processor, ok := m.processors[query.changefeedID]
+	if !ok {
+		return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(query.changefeedID.ID)
+	}
+	metrics, ok := processor.getSorterMetrics(query.tableID)
+	if !ok {
+		return cerror.ErrProcessorTableNotFound.GenWithStackByArgs()
+	}
+	query.metrics = metrics
+	return nil
+}
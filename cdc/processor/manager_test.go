@@ -21,9 +21,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/upstream"
@@ -248,6 +250,38 @@ func TestSendCommandError(t *testing.T) {
 	}
 }
 
+func TestQueryTableStallChangefeedNotFound(t *testing.T) {
+	liveness := model.LivenessCaptureAlive
+	cfg := config.NewDefaultSchedulerConfig()
+	m := NewManager(&model.CaptureInfo{ID: "capture-test"}, nil, &liveness, cfg).(*managerImpl)
+	go func() {
+		// Handle the command like Tick does.
+		for len(m.commandQueue) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		m.handleCommand()
+	}()
+	_, err := m.QueryTableStall(
+		context.Background(), model.DefaultChangeFeedID("test-changefeed"), 1, 5)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(errors.Cause(err)))
+}
+
+func TestQuerySorterMetricsChangefeedNotFound(t *testing.T) {
+	liveness := model.LivenessCaptureAlive
+	cfg := config.NewDefaultSchedulerConfig()
+	m := NewManager(&model.CaptureInfo{ID: "capture-test"}, nil, &liveness, cfg).(*managerImpl)
+	go func() {
+		// Handle the command like Tick does.
+		for len(m.commandQueue) == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		m.handleCommand()
+	}()
+	_, err := m.QuerySorterMetrics(
+		context.Background(), model.DefaultChangeFeedID("test-changefeed"), 1)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(errors.Cause(err)))
+}
+
 func TestManagerLiveness(t *testing.T) {
 	ctx := cdcContext.NewBackendContext4Test(false)
 	s := &managerTester{}
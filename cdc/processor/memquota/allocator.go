@@ -0,0 +1,204 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memquota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.uber.org/zap"
+)
+
+// Allocator redistributes the memory quota between the changefeeds on the same
+// capture. The sum of the configured memory quotas of all registered quotas is
+// kept unchanged, but the quota unused by an idle changefeed is lent to the
+// changefeeds which are starved, weighted by their priorities. The quota of a
+// changefeed is always kept in [min-ratio, max-ratio] times of its configured
+// one, and it's given back as soon as the changefeed is starved.
+type Allocator struct {
+	minRatio float64
+	maxRatio float64
+	interval time.Duration
+
+	mu     sync.Mutex
+	quotas map[*MemQuota]*allocation
+}
+
+type allocation struct {
+	// base is the configured memory quota.
+	base   uint64
+	weight uint64
+
+	used    uint64
+	starved bool
+	// ceil and alloc are only used in allocate.
+	ceil  uint64
+	alloc uint64
+}
+
+// NewAllocator creates an Allocator.
+func NewAllocator(cfg *config.AdaptiveMemoryQuotaConfig) *Allocator {
+	return &Allocator{
+		minRatio: cfg.MinRatio,
+		maxRatio: cfg.MaxRatio,
+		interval: time.Duration(cfg.AdjustInterval),
+		quotas:   make(map[*MemQuota]*allocation),
+	}
+}
+
+// Register registers the quota, whose current total bytes is treated as its
+// configured memory quota. A higher priority gets more spare quota.
+func (a *Allocator) Register(quota *MemQuota, priority int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.quotas[quota] = &allocation{
+		base:   quota.GetTotalBytes(),
+		weight: uint64(priority) + 1,
+	}
+}
+
+// SetBase updates the configured memory quota of a registered quota, it takes
+// effect in the next adjustment.
+func (a *Allocator) SetBase(quota *MemQuota, base uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if alloc, ok := a.quotas[quota]; ok {
+		alloc.base = base
+	}
+}
+
+// Unregister unregisters the quota. The quota lent to other changefeeds is
+// reclaimed in the next adjustment.
+func (a *Allocator) Unregister(quota *MemQuota) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.quotas, quota)
+}
+
+// Run adjusts the quotas periodically until ctx is canceled.
+func (a *Allocator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			a.adjust()
+		}
+	}
+}
+
+func (a *Allocator) adjust() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	allocs := make([]*allocation, 0, len(a.quotas))
+	for quota, alloc := range a.quotas {
+		alloc.used = quota.GetUsedBytes()
+		alloc.starved = quota.takeStarved() > 0
+		allocs = append(allocs, alloc)
+	}
+	allocate(allocs, a.minRatio, a.maxRatio)
+	for quota, alloc := range a.quotas {
+		if total := quota.GetTotalBytes(); total != alloc.alloc {
+			log.Debug("Adjust memory quota",
+				zap.String("namespace", quota.changefeedID.Namespace),
+				zap.String("changefeed", quota.changefeedID.ID),
+				zap.Uint64("base", alloc.base),
+				zap.Uint64("used", alloc.used),
+				zap.Bool("starved", alloc.starved),
+				zap.Uint64("from", total),
+				zap.Uint64("to", alloc.alloc))
+			quota.SetTotalBytes(alloc.alloc)
+		}
+	}
+}
+
+// allocate calculates the quota of each allocation. A starved one is given its
+// base quota, and an idle one is shrunk to 1.25 times of its used bytes, both
+// of which are in [base*minRatio, base]. The quota left is distributed to the
+// starved ones by weight up to base*maxRatio, and then given back to the idle
+// ones up to their base.
+func allocate(allocs []*allocation, minRatio, maxRatio float64) {
+	var spare uint64
+	for _, alloc := range allocs {
+		floor := uint64(float64(alloc.base) * minRatio)
+		alloc.ceil = uint64(float64(alloc.base) * maxRatio)
+		if alloc.starved {
+			alloc.alloc = alloc.base
+		} else {
+			alloc.alloc = alloc.used + alloc.used/4
+			if alloc.alloc < floor {
+				alloc.alloc = floor
+			}
+			if alloc.alloc > alloc.base {
+				alloc.alloc = alloc.base
+			}
+		}
+		spare += alloc.base - alloc.alloc
+	}
+
+	starved := make([]*allocation, 0, len(allocs))
+	idle := make([]*allocation, 0, len(allocs))
+	for _, alloc := range allocs {
+		if alloc.starved {
+			starved = append(starved, alloc)
+		} else {
+			idle = append(idle, alloc)
+		}
+	}
+	spare = fill(spare, starved, func(alloc *allocation) uint64 { return alloc.ceil })
+	fill(spare, idle, func(alloc *allocation) uint64 { return alloc.base })
+}
+
+// fill distributes spare to allocs by weight, without exceeding the limit of
+// each allocation. It returns the quota left.
+func fill(spare uint64, allocs []*allocation, limit func(*allocation) uint64) uint64 {
+	for spare > 0 {
+		var totalWeight uint64
+		for _, alloc := range allocs {
+			if alloc.alloc < limit(alloc) {
+				totalWeight += alloc.weight
+			}
+		}
+		if totalWeight == 0 {
+			return spare
+		}
+		given := uint64(0)
+		for _, alloc := range allocs {
+			room := limit(alloc) - alloc.alloc
+			if room == 0 {
+				continue
+			}
+			share := uint64(float64(spare) * float64(alloc.weight) / float64(totalWeight))
+			if share == 0 {
+				share = 1
+			}
+			if share > room {
+				share = room
+			}
+			if share > spare-given {
+				share = spare - given
+			}
+			alloc.alloc += share
+			given += share
+		}
+		spare -= given
+	}
+	return spare
+}
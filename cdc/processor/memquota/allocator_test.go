@@ -0,0 +1,112 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memquota
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+
+	// All changefeeds are idle, every one keeps its base quota.
+	allocs := []*allocation{
+		{base: 100, weight: 1, used: 10},
+		{base: 100, weight: 1, used: 50},
+	}
+	allocate(allocs, 0.25, 4)
+	require.Equal(t, uint64(100), allocs[0].alloc)
+	require.Equal(t, uint64(100), allocs[1].alloc)
+
+	// The unused quota of idle changefeeds is lent to the starved one.
+	allocs = []*allocation{
+		{base: 100, weight: 1, used: 10},
+		{base: 100, weight: 1, used: 100, starved: true},
+	}
+	allocate(allocs, 0.25, 4)
+	require.Equal(t, uint64(25), allocs[0].alloc)
+	require.Equal(t, uint64(175), allocs[1].alloc)
+
+	// The spare quota is distributed by weight.
+	allocs = []*allocation{
+		{base: 100, weight: 1, used: 0},
+		{base: 100, weight: 1, used: 100, starved: true},
+		{base: 100, weight: 3, used: 100, starved: true},
+	}
+	allocate(allocs, 0.2, 4)
+	require.Equal(t, uint64(20), allocs[0].alloc)
+	require.Equal(t, uint64(120), allocs[1].alloc)
+	require.Equal(t, uint64(160), allocs[2].alloc)
+
+	// The quota is capped by the ceiling, and the quota left is given back to
+	// the idle ones.
+	allocs = []*allocation{
+		{base: 100, weight: 1, used: 0},
+		{base: 10, weight: 1, used: 10, starved: true},
+	}
+	allocate(allocs, 0.1, 2)
+	require.Equal(t, uint64(90), allocs[0].alloc)
+	require.Equal(t, uint64(20), allocs[1].alloc)
+
+	// The quota of a starved changefeed is reclaimed immediately.
+	allocs = []*allocation{
+		{base: 100, weight: 1, used: 100, starved: true},
+		{base: 100, weight: 1, used: 150, starved: true},
+	}
+	allocate(allocs, 0.25, 4)
+	require.Equal(t, uint64(100), allocs[0].alloc)
+	require.Equal(t, uint64(100), allocs[1].alloc)
+}
+
+func TestAllocatorAdjust(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultAdaptiveMemoryQuotaConfig()
+	a := NewAllocator(cfg)
+	idle := NewMemQuota(model.DefaultChangeFeedID("idle"), 100, "sink")
+	defer idle.Close()
+	busy := NewMemQuota(model.DefaultChangeFeedID("busy"), 100, "sink")
+	defer busy.Close()
+	a.Register(idle, 0)
+	a.Register(busy, 0)
+
+	require.True(t, busy.TryAcquire(100))
+	require.False(t, busy.TryAcquire(50))
+	a.adjust()
+	require.Equal(t, uint64(25), idle.GetTotalBytes())
+	require.Equal(t, uint64(175), busy.GetTotalBytes())
+	require.True(t, busy.TryAcquire(50))
+
+	// The idle changefeed gets its quota back once it's starved.
+	require.True(t, idle.TryAcquire(25))
+	require.False(t, idle.TryAcquire(1))
+	a.adjust()
+	require.Equal(t, uint64(100), idle.GetTotalBytes())
+	require.Equal(t, uint64(100), busy.GetTotalBytes())
+	require.False(t, busy.TryAcquire(1))
+
+	// The quota lent by an unregistered changefeed is reclaimed.
+	a.Unregister(idle)
+	a.adjust()
+	require.Equal(t, uint64(100), busy.GetTotalBytes())
+
+	// The updated base quota is applied in the next adjustment.
+	a.SetBase(busy, 200)
+	a.adjust()
+	require.Equal(t, uint64(200), busy.GetTotalBytes())
+}
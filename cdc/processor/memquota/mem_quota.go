@@ -37,8 +37,13 @@ type MemConsumeRecord struct {
 // MemQuota is used to trace memory usage.
 type MemQuota struct {
 	changefeedID model.ChangeFeedID
-	// totalBytes is the total memory quota for one changefeed.
-	totalBytes uint64
+	// totalBytes is the total memory quota for one changefeed. It can be
+	// adjusted by the Allocator if the adaptive memory quota is enabled.
+	totalBytes atomic.Uint64
+
+	// starved counts the acquirements failed because of insufficient quota
+	// since the last time it's taken by the Allocator.
+	starved atomic.Uint64
 
 	// usedBytes is the memory usage of one changefeed.
 	usedBytes atomic.Uint64
@@ -66,7 +71,6 @@ type MemQuota struct {
 func NewMemQuota(changefeedID model.ChangeFeedID, totalBytes uint64, comp string) *MemQuota {
 	m := &MemQuota{
 		changefeedID:     changefeedID,
-		totalBytes:       totalBytes,
 		blockAcquireCond: sync.NewCond(&sync.Mutex{}),
 		metricTotal: MemoryQuota.WithLabelValues(changefeedID.Namespace,
 			changefeedID.ID, "total", comp),
@@ -76,6 +80,7 @@ func NewMemQuota(changefeedID model.ChangeFeedID, totalBytes uint64, comp string
 
 		tableMemory: spanz.NewHashMap[[]*MemConsumeRecord](),
 	}
+	m.totalBytes.Store(totalBytes)
 	m.metricTotal.Set(float64(totalBytes))
 	m.metricUsed.Set(float64(0))
 
@@ -106,7 +111,8 @@ func NewMemQuota(changefeedID model.ChangeFeedID, totalBytes uint64, comp string
 func (m *MemQuota) TryAcquire(nBytes uint64) bool {
 	for {
 		usedBytes := m.usedBytes.Load()
-		if usedBytes+nBytes > m.totalBytes {
+		if usedBytes+nBytes > m.totalBytes.Load() {
+			m.starved.Add(1)
 			return false
 		}
 		if m.usedBytes.CompareAndSwap(usedBytes, usedBytes+nBytes) {
@@ -127,7 +133,8 @@ func (m *MemQuota) BlockAcquire(nBytes uint64) error {
 			return context.Canceled
 		}
 		usedBytes := m.usedBytes.Load()
-		if usedBytes+nBytes > m.totalBytes {
+		if usedBytes+nBytes > m.totalBytes.Load() {
+			m.starved.Add(1)
 			m.blockAcquireCond.L.Lock()
 			m.blockAcquireCond.Wait()
 			m.blockAcquireCond.L.Unlock()
@@ -149,7 +156,7 @@ func (m *MemQuota) Refund(nBytes uint64) {
 		log.Panic("MemQuota.refund fail",
 			zap.Uint64("used", usedBytes), zap.Uint64("refund", nBytes))
 	}
-	if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes {
+	if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes.Load() {
 		m.blockAcquireCond.Broadcast()
 	}
 }
@@ -175,7 +182,7 @@ func (m *MemQuota) Record(span tablepb.Span, resolved model.ResolvedTs, nBytes u
 			log.Panic("MemQuota.refund fail",
 				zap.Uint64("used", usedBytes), zap.Uint64("refund", nBytes))
 		}
-		if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes {
+		if m.usedBytes.Add(^(nBytes - 1)) < m.totalBytes.Load() {
 			m.blockAcquireCond.Broadcast()
 		}
 		return
@@ -216,7 +223,7 @@ func (m *MemQuota) Release(span tablepb.Span, resolved model.ResolvedTs) {
 		log.Panic("MemQuota.release fail",
 			zap.Uint64("used", usedBytes), zap.Uint64("release", toRelease))
 	}
-	if m.usedBytes.Add(^(toRelease - 1)) < m.totalBytes {
+	if m.usedBytes.Add(^(toRelease - 1)) < m.totalBytes.Load() {
 		m.blockAcquireCond.Broadcast()
 	}
 }
@@ -243,7 +250,7 @@ func (m *MemQuota) Clean(span tablepb.Span) uint64 {
 	}
 	m.tableMemory.Delete(span)
 
-	if m.usedBytes.Add(^(cleaned - 1)) < m.totalBytes {
+	if m.usedBytes.Add(^(cleaned - 1)) < m.totalBytes.Load() {
 		m.blockAcquireCond.Broadcast()
 	}
 	return cleaned
@@ -258,6 +265,27 @@ func (m *MemQuota) Close() {
 	}
 }
 
+// GetTotalBytes returns the total memory quota.
+func (m *MemQuota) GetTotalBytes() uint64 {
+	return m.totalBytes.Load()
+}
+
+// SetTotalBytes adjusts the total memory quota. The used memory quota can
+// exceed the new total one, in which case the following acquirements are
+// blocked until enough memory is released.
+func (m *MemQuota) SetTotalBytes(totalBytes uint64) {
+	if m.totalBytes.Swap(totalBytes) < totalBytes {
+		m.blockAcquireCond.Broadcast()
+	}
+	m.metricTotal.Set(float64(totalBytes))
+}
+
+// takeStarved returns the count of acquirements failed because of insufficient
+// quota since the last call.
+func (m *MemQuota) takeStarved() uint64 {
+	return m.starved.Swap(0)
+}
+
 // GetUsedBytes returns the used memory quota.
 func (m *MemQuota) GetUsedBytes() uint64 {
 	return m.usedBytes.Load()
@@ -265,5 +293,5 @@ func (m *MemQuota) GetUsedBytes() uint64 {
 
 // hasAvailable returns true if the memory quota is available, otherwise returns false.
 func (m *MemQuota) hasAvailable(nBytes uint64) bool {
-	return m.usedBytes.Load()+nBytes <= m.totalBytes
+	return m.usedBytes.Load()+nBytes <= m.totalBytes.Load()
 }
@@ -10,6 +10,9 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	model "github.com/pingcap/tiflow/cdc/model"
+	processor "github.com/pingcap/tiflow/cdc/processor"
+	engine "github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	orchestrator "github.com/pingcap/tiflow/pkg/orchestrator"
 )
 
@@ -48,6 +51,36 @@ func (mr *MockManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockManager)(nil).Close))
 }
 
+// QuerySorterMetrics mocks base method.
+func (m *MockManager) QuerySorterMetrics(ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID) (engine.TableMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySorterMetrics", ctx, changefeedID, tableID)
+	ret0, _ := ret[0].(engine.TableMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySorterMetrics indicates an expected call of QuerySorterMetrics.
+func (mr *MockManagerMockRecorder) QuerySorterMetrics(ctx, changefeedID, tableID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySorterMetrics", reflect.TypeOf((*MockManager)(nil).QuerySorterMetrics), ctx, changefeedID, tableID)
+}
+
+// QueryTableStall mocks base method.
+func (m *MockManager) QueryTableStall(ctx context.Context, changefeedID model.ChangeFeedID, tableID model.TableID, limit int) ([]processor.RegionStall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTableStall", ctx, changefeedID, tableID, limit)
+	ret0, _ := ret[0].([]processor.RegionStall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTableStall indicates an expected call of QueryTableStall.
+func (mr *MockManagerMockRecorder) QueryTableStall(ctx, changefeedID, tableID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTableStall", reflect.TypeOf((*MockManager)(nil).QueryTableStall), ctx, changefeedID, tableID, limit)
+}
+
 // Tick mocks base method.
 func (m *MockManager) Tick(ctx context.Context, state orchestrator.ReactorState) (orchestrator.ReactorState, error) {
 	m.ctrl.T.Helper()
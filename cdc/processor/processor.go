@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/sinkmanager"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
 	"github.com/pingcap/tiflow/cdc/redo"
@@ -42,6 +43,8 @@ import (
 	"github.com/pingcap/tiflow/pkg/orchestrator"
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/transform"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
@@ -76,6 +79,9 @@ type processor struct {
 	sourceManager component[*sourcemanager.SourceManager]
 
 	sinkManager component[*sinkmanager.SinkManager]
+	// runtimeInfo is the changefeed info whose runtime-mutable parameters
+	// have been applied to the sink manager.
+	runtimeInfo *model.ChangeFeedInfo
 
 	initialized bool
 
@@ -589,6 +595,7 @@ func (p *processor) tick(ctx cdcContext.Context) error {
 	if err := p.lazyInit(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	p.updateRuntimeParams()
 
 	barrier, err := p.agent.Tick(ctx)
 	if err != nil {
@@ -603,6 +610,21 @@ func (p *processor) tick(ctx cdcContext.Context) error {
 	return nil
 }
 
+// updateRuntimeParams applies the runtime-mutable parameters of the changefeed
+// info to the sink manager if the info is updated since the last tick.
+func (p *processor) updateRuntimeParams() {
+	info := p.changefeed.Info
+	if p.sinkManager.r == nil || p.runtimeInfo == nil || info == p.runtimeInfo {
+		return
+	}
+	// The source ID and the upstream cluster ID are filled by the processor,
+	// instead of being persisted.
+	info.Config.Sink.TiDBSourceID = p.runtimeInfo.Config.Sink.TiDBSourceID
+	info.Config.Sink.UpstreamClusterID = p.runtimeInfo.Config.Sink.UpstreamClusterID
+	p.runtimeInfo = info
+	p.sinkManager.r.UpdateRuntimeParams(info)
+}
+
 // checkChangefeedNormal checks if the changefeed is runnable.
 func (p *processor) checkChangefeedNormal() bool {
 	// check the state in this tick, make sure that the admin job type of the changefeed is not stopped
@@ -657,6 +679,8 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// skip the events skipped by the error handles of the changefeed.
+	p.filter = filter.NewErrorHandleFilter(p.filter, p.changefeed.Info.ErrorHandles)
 
 	if err = p.initDDLHandler(stdCtx); err != nil {
 		return err
@@ -664,10 +688,14 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 	p.ddlHandler.name = "ddlHandler"
 	p.ddlHandler.spawn(stdCtx)
 
+	transformer, err := transform.NewTransformer(p.changefeed.Info.Config.Transform)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	p.mg.r = entry.NewMounterGroup(p.ddlHandler.r.schemaStorage,
 		p.changefeed.Info.Config.Mounter.WorkerNum,
 		p.changefeed.Info.Config.EnableOldValue,
-		p.filter, tz, p.changefeedID, p.changefeed.Info.Config.Integrity)
+		p.filter, transformer, tz, p.changefeedID, p.changefeed.Info.Config.Integrity)
 	p.mg.name = "MounterGroup"
 	p.mg.spawn(stdCtx)
 
@@ -676,6 +704,7 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 		return errors.Trace(err)
 	}
 	p.changefeed.Info.Config.Sink.TiDBSourceID = sourceID
+	p.changefeed.Info.Config.Sink.UpstreamClusterID = p.upstream.ID
 
 	p.redo.r, err = redo.NewDMLManager(stdCtx, p.changefeed.Info.Config.Consistent)
 	if err != nil {
@@ -695,15 +724,18 @@ func (p *processor) lazyInitImpl(etcdCtx cdcContext.Context) (err error) {
 
 	p.sourceManager.r = sourcemanager.New(
 		p.changefeedID, p.upstream, p.mg.r,
-		sortEngine, util.GetOrZero(p.changefeed.Info.Config.BDRMode))
+		sortEngine, util.GetOrZero(p.changefeed.Info.Config.BDRMode),
+		p.globalVars.SharedTableCache)
 	p.sourceManager.name = "SourceManager"
 	p.sourceManager.spawn(stdCtx)
 
 	p.sinkManager.r = sinkmanager.New(
 		p.changefeedID, p.changefeed.Info, p.upstream,
-		p.ddlHandler.r.schemaStorage, p.redo.r, p.sourceManager.r)
+		p.ddlHandler.r.schemaStorage, p.redo.r, p.sourceManager.r,
+		p.globalVars.MemQuotaAllocator)
 	p.sinkManager.name = "SinkManager"
 	p.sinkManager.spawn(stdCtx)
+	p.runtimeInfo = p.changefeed.Info
 
 	// Bind them so that sourceManager can notify sinkManager.r.
 	p.sourceManager.r.OnResolve(p.sinkManager.r.UpdateReceivedSorterResolvedTs)
@@ -769,7 +801,7 @@ func (p *processor) handleErrorCh() (err error) {
 func (p *processor) initDDLHandler(ctx context.Context) error {
 	checkpointTs := p.changefeed.Info.GetCheckpointTs(p.changefeed.Status)
 	resolvedTs := p.changefeed.Status.ResolvedTs
-	forceReplicate := p.changefeed.Info.Config.ForceReplicate
+	forceReplicate := p.changefeed.Info.Config.ReplicateIneligibleTables()
 
 	// if resolvedTs == checkpointTs it means owner can't tell whether the DDL on checkpointTs has
 	// been executed or not. So the DDL puller must start at checkpointTs-1.
@@ -799,7 +831,7 @@ func (p *processor) initDDLHandler(ctx context.Context) error {
 	ddlPuller, err := puller.NewDDLJobPuller(
 		ctx,
 		p.upstream.PDClient,
-		p.upstream.GrpcPool,
+		p.upstream.DDLGrpcPool,
 		p.upstream.RegionCache,
 		p.upstream.KVStorage,
 		p.upstream.PDClock,
@@ -885,8 +917,9 @@ func (p *processor) doGCSchemaStorage() {
 	}
 
 	// Please refer to `unmarshalAndMountRowChanged` in cdc/entry/mounter.go
-	// for why we need -1.
-	lastSchemaTs := p.ddlHandler.r.schemaStorage.DoGC(p.changefeed.Status.CheckpointTs - 1)
+	// for why we need -1. The schemas are kept for the paused tables to
+	// catch up from their own checkpoints.
+	lastSchemaTs := p.ddlHandler.r.schemaStorage.DoGC(p.changefeed.Status.MinCheckpointTs() - 1)
 	if p.lastSchemaTs == lastSchemaTs {
 		return
 	}
@@ -987,6 +1020,28 @@ func (p *processor) WriteDebugInfo(w io.Writer) error {
 	return nil
 }
 
+// getSlowestRegions returns at most n regions of the table with the smallest
+// resolved ts. It returns false if the table is not found.
+func (p *processor) getSlowestRegions(
+	tableID model.TableID, n int,
+) ([]kv.RegionFeedStat, bool) {
+	if !p.initialized {
+		return nil, false
+	}
+	span := spanz.TableIDToComparableSpan(tableID)
+	return p.sourceManager.r.GetTableSlowestRegions(span, n)
+}
+
+func (p *processor) getSorterMetrics(
+	tableID model.TableID,
+) (engine.TableMetrics, bool) {
+	if !p.initialized {
+		return engine.TableMetrics{}, false
+	}
+	span := spanz.TableIDToComparableSpan(tableID)
+	return p.sourceManager.r.GetTableSorterMetrics(span)
+}
+
 func (p *processor) calculateTableBarrierTs(
 	barrier *schedulepb.Barrier,
 ) map[model.TableID]model.Ts {
@@ -1085,4 +1140,6 @@ func (d *ddlHandler) Run(ctx context.Context, _ ...chan<- error) error {
 
 func (d *ddlHandler) WaitForReady(_ context.Context) {}
 
-func (d *ddlHandler) Close() {}
+func (d *ddlHandler) Close() {
+	d.puller.Close()
+}
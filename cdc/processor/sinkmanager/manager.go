@@ -16,6 +16,7 @@ package sinkmanager
 import (
 	"context"
 	"math"
+	"reflect"
 	"sync"
 	"time"
 
@@ -32,7 +33,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
 	tablesinkmetrics "github.com/pingcap/tiflow/cdc/sink/metrics/tablesink"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -49,6 +52,10 @@ const (
 	// engine.CleanByTable can be expensive. So it's necessary to reduce useless calls.
 	cleanTableInterval  = 5 * time.Second
 	cleanTableMinEvents = 128
+	// The pullers are paused once the sink memory quota is exhausted, and are
+	// resumed after the usage drops below the ratio.
+	backpressureCheckInterval = 100 * time.Millisecond
+	backpressureResumeRatio   = 0.8
 )
 
 // TableStats of a table sink.
@@ -88,6 +95,9 @@ type SinkManager struct {
 	// sinkFactory used to create table sink.
 	sinkFactory   *factory.SinkFactory
 	sinkFactoryMu sync.Mutex
+	// sinkUpdated is notified when the sink parameters of the changefeed are
+	// updated, the sink factory is re-created with the new parameters.
+	sinkUpdated chan struct{}
 
 	// tableSinks is a map from tableID to tableSink.
 	tableSinks spanz.SyncMap
@@ -107,6 +117,9 @@ type SinkManager struct {
 	redoWorkerAvailable chan struct{}
 	// redoMemQuota is used to control the total memory usage of the redo.
 	redoMemQuota *memquota.MemQuota
+	// quotaAllocator adjusts sinkMemQuota and redoMemQuota if the adaptive
+	// memory quota is enabled, otherwise it's nil.
+	quotaAllocator *memquota.Allocator
 
 	// To control lifetime of all sub-goroutines.
 	managerCtx    context.Context
@@ -132,6 +145,7 @@ func New(
 	schemaStorage entry.SchemaStorage,
 	redoDMLMgr redo.DMLManager,
 	sourceManager *sourcemanager.SourceManager,
+	quotaAllocator *memquota.Allocator,
 ) *SinkManager {
 	m := &SinkManager{
 		changefeedID:   changefeedID,
@@ -139,11 +153,13 @@ func New(
 		up:             up,
 		schemaStorage:  schemaStorage,
 		sourceManager:  sourceManager,
+		quotaAllocator: quotaAllocator,
 
 		sinkProgressHeap:    newTableProgresses(),
 		sinkWorkers:         make([]*sinkWorker, 0, sinkWorkerNum),
 		sinkTaskChan:        make(chan *sinkTask),
 		sinkWorkerAvailable: make(chan struct{}, 1),
+		sinkUpdated:         make(chan struct{}, 1),
 
 		metricsTableSinkTotalRows: tablesinkmetrics.TotalRowsCountCounter.
 			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
@@ -155,21 +171,73 @@ func New(
 		m.redoWorkers = make([]*redoWorker, 0, redoWorkerNum)
 		m.redoTaskChan = make(chan *redoTask)
 		m.redoWorkerAvailable = make(chan struct{}, 1)
+	}
 
-		// Use 3/4 memory quota as redo quota, and 1/2 again for redo cache.
-		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, changefeedInfo.Config.MemoryQuota/4*1, "sink")
-		redoQuota := changefeedInfo.Config.MemoryQuota / 4 * 3
-		m.redoMemQuota = memquota.NewMemQuota(changefeedID, redoQuota, "redo")
+	sinkQuota, redoQuota := m.splitMemoryQuota(changefeedInfo.Config.MemoryQuota)
+	m.sinkMemQuota = memquota.NewMemQuota(changefeedID, sinkQuota, "sink")
+	m.redoMemQuota = memquota.NewMemQuota(changefeedID, redoQuota, "redo")
+	if m.redoDMLMgr != nil {
+		// Use 1/2 redo quota again for redo cache.
 		m.eventCache = newRedoEventCache(changefeedID, redoQuota/2*1)
-	} else {
-		m.sinkMemQuota = memquota.NewMemQuota(changefeedID, changefeedInfo.Config.MemoryQuota, "sink")
-		m.redoMemQuota = memquota.NewMemQuota(changefeedID, 0, "redo")
+	}
+
+	if m.quotaAllocator != nil {
+		priority := changefeedInfo.Config.MemoryQuotaPriority
+		m.quotaAllocator.Register(m.sinkMemQuota, priority)
+		if m.redoDMLMgr != nil {
+			m.quotaAllocator.Register(m.redoMemQuota, priority)
+		}
 	}
 
 	m.ready = make(chan struct{})
 	return m
 }
 
+// splitMemoryQuota splits the memory quota of the changefeed into the sink
+// quota and the redo quota.
+func (m *SinkManager) splitMemoryQuota(memoryQuota uint64) (sinkQuota, redoQuota uint64) {
+	if m.redoDMLMgr == nil {
+		return memoryQuota, 0
+	}
+	// Use 3/4 memory quota as redo quota.
+	return memoryQuota / 4 * 1, memoryQuota / 4 * 3
+}
+
+// UpdateRuntimeParams applies the runtime-mutable parameters of the updated
+// changefeed info. The memory quotas are resized in place, and the sink
+// factory is re-created if the sink parameters are changed, in which case the
+// table sinks are restarted from their checkpoints.
+func (m *SinkManager) UpdateRuntimeParams(info *model.ChangeFeedInfo) {
+	m.sinkFactoryMu.Lock()
+	old := m.changefeedInfo
+	m.changefeedInfo = info
+	m.sinkFactoryMu.Unlock()
+
+	if info.Config.MemoryQuota != old.Config.MemoryQuota {
+		sinkQuota, redoQuota := m.splitMemoryQuota(info.Config.MemoryQuota)
+		log.Info("Sink manager updates memory quota",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Uint64("from", old.Config.MemoryQuota),
+			zap.Uint64("to", info.Config.MemoryQuota))
+		m.sinkMemQuota.SetTotalBytes(sinkQuota)
+		m.redoMemQuota.SetTotalBytes(redoQuota)
+		if m.quotaAllocator != nil {
+			m.quotaAllocator.SetBase(m.sinkMemQuota, sinkQuota)
+			if m.redoDMLMgr != nil {
+				m.quotaAllocator.SetBase(m.redoMemQuota, redoQuota)
+			}
+		}
+	}
+
+	if info.SinkURI != old.SinkURI || !reflect.DeepEqual(info.Config.Sink, old.Config.Sink) {
+		select {
+		case m.sinkUpdated <- struct{}{}:
+		default:
+		}
+	}
+}
+
 // Run implements util.Runnable.
 // When it returns, all sub-goroutines should be closed.
 func (m *SinkManager) Run(ctx context.Context, warnings ...chan<- error) (err error) {
@@ -184,8 +252,20 @@ func (m *SinkManager) Run(ctx context.Context, warnings ...chan<- error) (err er
 			zap.Error(err))
 	}()
 
-	splitTxn := util.GetOrZero(m.changefeedInfo.Config.Sink.TxnAtomicity).ShouldSplitTxn()
-	enableOldValue := m.changefeedInfo.Config.EnableOldValue
+	m.sinkFactoryMu.Lock()
+	cfg := m.changefeedInfo.Config
+	m.sinkFactoryMu.Unlock()
+	splitTxn := util.GetOrZero(cfg.Sink.TxnAtomicity).ShouldSplitTxn()
+	largeTxnThreshold := util.GetOrZero(cfg.Sink.LargeTxnThresholdInMB) * uint64(1<<20)
+	enableOldValue := cfg.EnableOldValue
+	splitter, err := newUpdateSplitter(cfg.Sink.SplitUpdateRules)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tableRouter, err := router.NewRouter(cfg.Sink.RouteRules)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	gcErrors := make(chan error, 16)
 	sinkFactoryErrors := make(chan error, 16)
@@ -193,10 +273,14 @@ func (m *SinkManager) Run(ctx context.Context, warnings ...chan<- error) (err er
 	redoErrors := make(chan error, 16)
 
 	m.backgroundGC(gcErrors)
+	if config.GetGlobalServerConfig().Sorter.EnableBackpressure {
+		m.backgroundBackpressure()
+	}
 	if m.sinkEg == nil {
 		var sinkCtx context.Context
 		m.sinkEg, sinkCtx = errgroup.WithContext(m.managerCtx)
-		m.startSinkWorkers(sinkCtx, m.sinkEg, splitTxn, enableOldValue)
+		m.startSinkWorkers(sinkCtx, m.sinkEg, splitTxn, largeTxnThreshold,
+			enableOldValue, splitter, tableRouter)
 		m.sinkEg.Go(func() error { return m.generateSinkTasks(sinkCtx) })
 		m.wg.Add(1)
 		go func() {
@@ -216,7 +300,7 @@ func (m *SinkManager) Run(ctx context.Context, warnings ...chan<- error) (err er
 	if m.redoDMLMgr != nil && m.redoEg == nil {
 		var redoCtx context.Context
 		m.redoEg, redoCtx = errgroup.WithContext(m.managerCtx)
-		m.startRedoWorkers(redoCtx, m.redoEg, enableOldValue)
+		m.startRedoWorkers(redoCtx, m.redoEg, enableOldValue, splitter, tableRouter)
 		m.redoEg.Go(func() error { return m.generateRedoTasks(redoCtx) })
 		m.wg.Add(1)
 		go func() {
@@ -258,6 +342,13 @@ func (m *SinkManager) Run(ctx context.Context, warnings ...chan<- error) (err er
 			return errors.Trace(err)
 		case err = <-redoErrors:
 			return errors.Trace(err)
+		case <-m.sinkUpdated:
+			log.Info("Sink manager re-creates sink factory with updated sink parameters",
+				zap.String("namespace", m.changefeedID.Namespace),
+				zap.String("changefeed", m.changefeedID.ID))
+			m.clearSinkFactory()
+			sinkFactoryErrors = make(chan error, 16)
+			continue
 		case err = <-sinkFactoryErrors:
 			log.Warn("Sink manager backend sink fails",
 				zap.String("namespace", m.changefeedID.Namespace),
@@ -324,26 +415,68 @@ func (m *SinkManager) clearSinkFactory() {
 	}
 }
 
-func (m *SinkManager) startSinkWorkers(ctx context.Context, eg *errgroup.Group, splitTxn bool, enableOldValue bool) {
+func (m *SinkManager) startSinkWorkers(
+	ctx context.Context, eg *errgroup.Group,
+	splitTxn bool, largeTxnThreshold uint64, enableOldValue bool,
+	splitter *updateSplitter, tableRouter *router.Router,
+) {
 	for i := 0; i < sinkWorkerNum; i++ {
 		w := newSinkWorker(m.changefeedID, m.sourceManager,
 			m.sinkMemQuota, m.redoMemQuota,
-			m.eventCache, splitTxn, enableOldValue)
+			m.eventCache, splitTxn, largeTxnThreshold, enableOldValue, splitter, tableRouter)
 		m.sinkWorkers = append(m.sinkWorkers, w)
 		eg.Go(func() error { return w.handleTasks(ctx, m.sinkTaskChan) })
 	}
 }
 
-func (m *SinkManager) startRedoWorkers(ctx context.Context, eg *errgroup.Group, enableOldValue bool) {
+func (m *SinkManager) startRedoWorkers(
+	ctx context.Context, eg *errgroup.Group,
+	enableOldValue bool, splitter *updateSplitter, tableRouter *router.Router,
+) {
 	for i := 0; i < redoWorkerNum; i++ {
 		w := newRedoWorker(m.changefeedID, m.sourceManager, m.redoMemQuota,
-			m.redoDMLMgr, m.eventCache, enableOldValue)
+			m.redoDMLMgr, m.eventCache, enableOldValue, splitter, tableRouter)
 		m.redoWorkers = append(m.redoWorkers, w)
 		eg.Go(func() error { return w.handleTasks(ctx, m.redoTaskChan) })
 	}
 }
 
 // backgroundGC is used to clean up the old data in the sorter.
+// backgroundBackpressure propagates the backpressure of the sink to the
+// pullers, so that the pull rate adapts to the capacity of the downstream.
+func (m *SinkManager) backgroundBackpressure() {
+	ticker := time.NewTicker(backpressureCheckInterval)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer ticker.Stop()
+		defer m.sourceManager.SetBackpressure(false)
+		for {
+			select {
+			case <-m.managerCtx.Done():
+				return
+			case <-ticker.C:
+				m.updateBackpressure()
+			}
+		}
+	}()
+}
+
+// updateBackpressure pauses the pullers if the sink memory quota is exhausted,
+// which means the sink flush falls behind. Pausing the pullers never blocks
+// the sink, because it only consumes the events which are already resolved.
+func (m *SinkManager) updateBackpressure() {
+	used, total := m.sinkMemQuota.GetUsedBytes(), m.sinkMemQuota.GetTotalBytes()
+	if total == 0 {
+		return
+	}
+	if used >= total {
+		m.sourceManager.SetBackpressure(true)
+	} else if float64(used) < float64(total)*backpressureResumeRatio {
+		m.sourceManager.SetBackpressure(false)
+	}
+}
+
 func (m *SinkManager) backgroundGC(errors chan<- error) {
 	ticker := time.NewTicker(time.Second)
 	m.wg.Add(1)
@@ -970,6 +1103,10 @@ func (m *SinkManager) waitSubroutines() {
 	// Sink workers and redo workers can be blocked on MemQuota.BlockAcquire,
 	// which doesn't watch m.managerCtx. So we must close these 2 MemQuotas
 	// before wait them.
+	if m.quotaAllocator != nil {
+		m.quotaAllocator.Unregister(m.sinkMemQuota)
+		m.quotaAllocator.Unregister(m.redoMemQuota)
+	}
 	m.sinkMemQuota.Close()
 	m.redoMemQuota.Close()
 	m.wg.Wait()
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
@@ -261,6 +262,36 @@ func TestGetTableStatsToReleaseMemQuota(t *testing.T) {
 	}, 5*time.Second, 10*time.Millisecond)
 }
 
+func TestUpdateBackpressure(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, source, _ := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer func() {
+		cancel()
+		manager.Close()
+	}()
+
+	total := manager.sinkMemQuota.GetTotalBytes()
+	manager.updateBackpressure()
+	require.False(t, source.IsBackpressured())
+
+	// Pause the pullers once the memory quota is exhausted.
+	manager.sinkMemQuota.ForceAcquire(total)
+	manager.updateBackpressure()
+	require.True(t, source.IsBackpressured())
+
+	// Keep pausing until the usage drops below the resume ratio.
+	manager.sinkMemQuota.Refund(total / 10)
+	manager.updateBackpressure()
+	require.True(t, source.IsBackpressured())
+	manager.sinkMemQuota.Refund(total / 2)
+	manager.updateBackpressure()
+	require.False(t, source.IsBackpressured())
+}
+
 func TestDoNotGenerateTableSinkTaskWhenTableIsNotReplicating(t *testing.T) {
 	t.Parallel()
 
@@ -356,3 +387,41 @@ func TestSinkManagerRunWithErrors(t *testing.T) {
 		log.Panic("must get an error instead of a timeout")
 	}
 }
+
+func TestUpdateRuntimeParams(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changefeedInfo := getChangefeedInfo()
+	manager, _, _ := CreateManagerWithMemEngine(t, ctx, model.DefaultChangeFeedID("1"),
+		changefeedInfo, make(chan error, 1))
+	defer func() {
+		cancel()
+		manager.Close()
+	}()
+
+	getSinkFactory := func() *factory.SinkFactory {
+		manager.sinkFactoryMu.Lock()
+		defer manager.sinkFactoryMu.Unlock()
+		return manager.sinkFactory
+	}
+	require.Eventually(t, func() bool { return getSinkFactory() != nil },
+		5*time.Second, 10*time.Millisecond)
+	oldFactory := getSinkFactory()
+
+	// The memory quota is resized in place.
+	newInfo := getChangefeedInfo()
+	newInfo.Config.MemoryQuota = changefeedInfo.Config.MemoryQuota * 2
+	manager.UpdateRuntimeParams(newInfo)
+	require.Equal(t, newInfo.Config.MemoryQuota, manager.sinkMemQuota.GetTotalBytes())
+	require.Equal(t, oldFactory, getSinkFactory())
+
+	// The sink factory is re-created with the new sink parameters.
+	newInfo = getChangefeedInfo()
+	newInfo.SinkURI = "blackhole://?worker-count=2"
+	manager.UpdateRuntimeParams(newInfo)
+	require.Eventually(t, func() bool {
+		f := getSinkFactory()
+		return f != nil && f != oldFactory
+	}, 5*time.Second, 10*time.Millisecond)
+}
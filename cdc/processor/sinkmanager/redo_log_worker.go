@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
 	"github.com/pingcap/tiflow/cdc/redo"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
@@ -34,6 +35,8 @@ type redoWorker struct {
 	redoDMLManager redo.DMLManager
 	eventCache     *redoEventCache
 	enableOldValue bool
+	splitter       *updateSplitter
+	tableRouter    *router.Router
 }
 
 func newRedoWorker(
@@ -43,6 +46,8 @@ func newRedoWorker(
 	redoDMLMgr redo.DMLManager,
 	eventCache *redoEventCache,
 	enableOldValue bool,
+	splitter *updateSplitter,
+	tableRouter *router.Router,
 ) *redoWorker {
 	return &redoWorker{
 		changefeedID:   changefeedID,
@@ -51,6 +56,8 @@ func newRedoWorker(
 		redoDMLManager: redoDMLMgr,
 		eventCache:     eventCache,
 		enableOldValue: enableOldValue,
+		splitter:       splitter,
+		tableRouter:    tableRouter,
 	}
 }
 
@@ -149,7 +156,8 @@ func (w *redoWorker) handleTask(ctx context.Context, task *redoTask) (finalErr e
 		if e.Row != nil {
 			// For all events, we add table replicate ts, so mysql sink can determine safe-mode.
 			e.Row.ReplicatingTs = task.tableSink.replicateTs
-			x, size, err = convertRowChangedEvents(w.changefeedID, task.span, w.enableOldValue, e)
+			x, size, err = convertRowChangedEvents(w.changefeedID, task.span,
+				w.enableOldValue, w.splitter, w.tableRouter, e)
 			if err != nil {
 				return errors.Trace(err)
 			}
@@ -27,6 +27,10 @@ type tableSinkAdvancer struct {
 	task *sinkTask
 	// splitTxn indicates whether to split the transaction into multiple batches.
 	splitTxn bool
+	// largeTxnThreshold is the size above which the current transaction is
+	// split into multiple batches even if splitTxn is false, so that the
+	// memory usage is bounded. 0 means never.
+	largeTxnThreshold uint64
 	// sinkMemQuota is used to acquire memory quota for the table sink.
 	sinkMemQuota *memquota.MemQuota
 	// NOTICE: First time to run the task, we have initialized memory quota for the table.
@@ -51,20 +55,25 @@ type tableSinkAdvancer struct {
 	pendingTxnSize uint64
 	// Used to record the current transaction commit ts.
 	currTxnCommitTs uint64
+	// Used to record the size of the current transaction, including the
+	// events which have been emitted.
+	currTxnSize uint64
 }
 
 func newTableSinkAdvancer(
 	task *sinkTask,
 	splitTxn bool,
+	largeTxnThreshold uint64,
 	sinkMemQuota *memquota.MemQuota,
 	availableMem uint64,
 ) *tableSinkAdvancer {
 	return &tableSinkAdvancer{
-		task:         task,
-		splitTxn:     splitTxn,
-		sinkMemQuota: sinkMemQuota,
-		availableMem: availableMem,
-		events:       make([]*model.RowChangedEvent, 0, bufferSize),
+		task:              task,
+		splitTxn:          splitTxn,
+		largeTxnThreshold: largeTxnThreshold,
+		sinkMemQuota:      sinkMemQuota,
+		availableMem:      availableMem,
+		events:            make([]*model.RowChangedEvent, 0, bufferSize),
 	}
 }
 
@@ -111,7 +120,7 @@ func (a *tableSinkAdvancer) advance(isLastTime bool) (err error) {
 
 		a.committedTxnSize = 0
 		a.pendingTxnSize = 0
-	} else if a.splitTxn && a.currTxnCommitTs > 0 {
+	} else if a.shouldSplitTxn() && a.currTxnCommitTs > 0 {
 		// We just got a new commit ts. Because we split the transaction,
 		// we can advance the table sink with the current commit ts.
 		// This will advance some complete transactions before currTxnCommitTs,
@@ -122,7 +131,7 @@ func (a *tableSinkAdvancer) advance(isLastTime bool) (err error) {
 		batchID.Add(1)
 		a.committedTxnSize = 0
 		a.pendingTxnSize = 0
-	} else if !a.splitTxn && a.lastTxnCommitTs > 0 {
+	} else if !a.shouldSplitTxn() && a.lastTxnCommitTs > 0 {
 		// We just got a new commit ts. Because we don't split the transaction,
 		// we **only** advance the table sink by the last transaction commit ts.
 		err = advanceTableSink(a.task, a.lastTxnCommitTs,
@@ -178,7 +187,7 @@ func (a *tableSinkAdvancer) tryAdvanceAndAcquireMem(
 	// 2. all events are received.
 	// 3. the pending batch size exceeds maxUpdateIntervalSize;
 	if exceedAvailableMem || allFetched ||
-		needEmitAndAdvance(a.splitTxn, a.committedTxnSize, a.pendingTxnSize) {
+		needEmitAndAdvance(a.shouldSplitTxn(), a.committedTxnSize, a.pendingTxnSize) {
 		if err := a.advance(false); err != nil {
 			return errors.Trace(err)
 		}
@@ -207,7 +216,7 @@ func (a *tableSinkAdvancer) tryAdvanceAndAcquireMem(
 			// The transaction is not finished and splitTxn is false, we need to
 			// force acquire memory. Because we can't leave rest data
 			// to the next round.
-			if !a.splitTxn {
+			if !a.shouldSplitTxn() {
 				a.sinkMemQuota.ForceAcquire(requestMemSize)
 				a.availableMem += requestMemSize
 				log.Debug("MemoryQuotaTracing: force acquire memory for table sink task",
@@ -245,9 +254,16 @@ func (a *tableSinkAdvancer) tryMoveToNextTxn(commitTs model.Ts) {
 		// Move to the next transaction.
 		a.currTxnCommitTs = commitTs
 		a.pendingTxnSize = 0
+		a.currTxnSize = 0
 	}
 }
 
+// shouldSplitTxn returns whether the current transaction can be split into
+// multiple batches.
+func (a *tableSinkAdvancer) shouldSplitTxn() bool {
+	return a.splitTxn || (a.largeTxnThreshold > 0 && a.currTxnSize >= a.largeTxnThreshold)
+}
+
 // finish finishes the table sink task.
 // It will move the table sink task to the upperBound position.
 func (a *tableSinkAdvancer) finish(upperBound engine.Position) error {
@@ -268,6 +284,17 @@ func (a *tableSinkAdvancer) appendEvents(events []*model.RowChangedEvent, size u
 	// Record the pending transaction size. It means how many events we do
 	// not flush to the table sink.
 	a.pendingTxnSize += size
+
+	if !a.splitTxn && a.largeTxnThreshold > 0 &&
+		a.currTxnSize < a.largeTxnThreshold && a.currTxnSize+size >= a.largeTxnThreshold {
+		log.Info("Transaction is too large, split it into multiple batches",
+			zap.String("namespace", a.task.tableSink.changefeed.Namespace),
+			zap.String("changefeed", a.task.tableSink.changefeed.ID),
+			zap.Stringer("span", &a.task.span),
+			zap.Uint64("commitTs", a.currTxnCommitTs),
+			zap.Uint64("threshold", a.largeTxnThreshold))
+	}
+	a.currTxnSize += size
 }
 
 // hasEnoughMem returns whether the table sink task has enough memory to continue.
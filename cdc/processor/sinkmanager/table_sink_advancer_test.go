@@ -127,7 +127,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceTableSinkWithBatchID() {
 	task, _ := suite.genSinkTask()
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 
 	err := advanceTableSinkWithBatchID(task, 2, 256, 1, memoryQuota)
@@ -143,7 +143,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceTableSink() {
 	task, _ := suite.genSinkTask()
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 
 	err := advanceTableSink(task, 2, 256, memoryQuota)
@@ -157,7 +157,7 @@ func (suite *tableSinkAdvancerSuite) TestNewTableSinkAdvancer() {
 	task, _ := suite.genSinkTask()
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 	require.Equal(suite.T(), uint64(512), advancer.availableMem)
 }
@@ -166,7 +166,7 @@ func (suite *tableSinkAdvancerSuite) TestHasEnoughMem() {
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
 	task, _ := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 	require.True(suite.T(), advancer.hasEnoughMem())
 	for i := 0; i < 6; i++ {
@@ -181,7 +181,7 @@ func (suite *tableSinkAdvancerSuite) TestCleanup() {
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
 	task, _ := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 	require.Equal(suite.T(), uint64(512), advancer.availableMem)
 	require.Equal(suite.T(), uint64(0), advancer.usedMem)
@@ -195,7 +195,7 @@ func (suite *tableSinkAdvancerSuite) TestAppendEvents() {
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
 	task, _ := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 	require.True(suite.T(), advancer.hasEnoughMem())
 	for i := 0; i < 2; i++ {
@@ -211,7 +211,7 @@ func (suite *tableSinkAdvancerSuite) TestTryMoveMoveToNextTxn() {
 	memoryQuota := suite.genMemQuota(512)
 	defer memoryQuota.Close()
 	task, _ := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 512)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 512)
 	require.NotNil(suite.T(), advancer)
 
 	// Initial state.
@@ -258,7 +258,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceTheSameCommitTsEventsWithCommitF
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 1
@@ -301,7 +301,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceTheSameCommitTsEventsWithoutComm
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 1
@@ -349,7 +349,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceDifferentCommitTsEventsWithSplit
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -400,7 +400,7 @@ func (suite *tableSinkAdvancerSuite) TestAdvanceDifferentCommitTsEventsWithoutSp
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
 	// Do not split txn.
-	advancer := newTableSinkAdvancer(task, false, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, false, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -455,7 +455,7 @@ func (suite *tableSinkAdvancerSuite) TestLastTimeAdvanceDifferentCommitTsEventsW
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
 	// Do not split txn.
-	advancer := newTableSinkAdvancer(task, false, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, false, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -502,6 +502,57 @@ func (suite *tableSinkAdvancerSuite) TestLastTimeAdvanceDifferentCommitTsEventsW
 	require.Equal(suite.T(), uint64(1), batchID.Load())
 }
 
+// Test Scenario:
+// When the current transaction exceeds the large transaction threshold, and we
+// do **not** support split txn, we should still split it and advance the table
+// sink with a batch ID.
+func (suite *tableSinkAdvancerSuite) TestAdvanceLargeTxnWithoutSplitTxn() {
+	memoryQuota := suite.genMemQuota(768)
+	defer memoryQuota.Close()
+	task, sink := suite.genSinkTask()
+	// Do not split txn.
+	advancer := newTableSinkAdvancer(task, false, 512, memoryQuota, 768)
+	require.NotNil(suite.T(), advancer)
+
+	// 1. append 1 event with commit ts 2
+	advancer.tryMoveToNextTxn(2)
+	advancer.appendEvents([]*model.RowChangedEvent{
+		{CommitTs: 2},
+	}, 256)
+	require.False(suite.T(), advancer.shouldSplitTxn())
+	require.False(suite.T(), needEmitAndAdvance(advancer.shouldSplitTxn(),
+		advancer.committedTxnSize, advancer.pendingTxnSize))
+
+	// 2. append 1 event with commit ts 2, the txn becomes large
+	advancer.tryMoveToNextTxn(2)
+	advancer.appendEvents([]*model.RowChangedEvent{
+		{CommitTs: 2},
+	}, 256)
+	require.True(suite.T(), advancer.shouldSplitTxn())
+	require.True(suite.T(), needEmitAndAdvance(advancer.shouldSplitTxn(),
+		advancer.committedTxnSize, advancer.pendingTxnSize))
+
+	// 3. advance with split txn
+	err := advancer.advance(false)
+	require.NoError(suite.T(), err)
+
+	require.Len(suite.T(), sink.GetEvents(), 2)
+	sink.AckAllEvents()
+	require.Eventually(suite.T(), func() bool {
+		expectedResolvedTs := model.NewResolvedTs(2)
+		expectedResolvedTs.Mode = model.BatchResolvedMode
+		expectedResolvedTs.BatchID = 1
+		return task.tableSink.getCheckpointTs() == expectedResolvedTs
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(suite.T(), uint64(0), advancer.committedTxnSize)
+	require.Equal(suite.T(), uint64(0), advancer.pendingTxnSize)
+	require.Equal(suite.T(), uint64(2), batchID.Load(), "batch ID should be increased")
+
+	// 4. move to the next txn, it's not split anymore
+	advancer.tryMoveToNextTxn(3)
+	require.False(suite.T(), advancer.shouldSplitTxn())
+}
+
 // Test Scenario:
 // We receive some events and exceed the available memory quota.
 // We should advance the table sink and also make up the difference
@@ -510,7 +561,7 @@ func (suite *tableSinkAdvancerSuite) TestTryAdvanceWhenExceedAvailableMem() {
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -564,7 +615,7 @@ func (suite *tableSinkAdvancerSuite) TestTryAdvanceWhenReachTheMaxUpdateIntSizeA
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -613,7 +664,7 @@ func (suite *tableSinkAdvancerSuite) TestFinish() {
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -665,7 +716,7 @@ func (suite *tableSinkAdvancerSuite) TestTryAdvanceAndForceAcquireWithoutSplitTx
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, false, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, false, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
@@ -714,7 +765,7 @@ func (suite *tableSinkAdvancerSuite) TestTryAdvanceAndBlockAcquireWithSplitTxn()
 	memoryQuota := suite.genMemQuota(768)
 	defer memoryQuota.Close()
 	task, sink := suite.genSinkTask()
-	advancer := newTableSinkAdvancer(task, true, memoryQuota, 768)
+	advancer := newTableSinkAdvancer(task, true, 0, memoryQuota, 768)
 	require.NotNil(suite.T(), advancer)
 
 	// 1. append 1 event with commit ts 2
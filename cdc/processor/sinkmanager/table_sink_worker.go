@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
@@ -65,9 +66,16 @@ type sinkWorker struct {
 	eventCache    *redoEventCache
 	// splitTxn indicates whether to split the transaction into multiple batches.
 	splitTxn bool
+	// largeTxnThreshold is the size above which a transaction is split even if
+	// splitTxn is false. 0 means never.
+	largeTxnThreshold uint64
 	// enableOldValue indicates whether to enable the old value feature.
 	// If it is enabled, we need to deal with the compatibility of the data format.
 	enableOldValue bool
+	// splitter decides whether to split the updates by the split update rules.
+	splitter *updateSplitter
+	// tableRouter renames the tables of the rows by the route rules.
+	tableRouter *router.Router
 
 	// Metrics.
 	metricRedoEventCacheHit  prometheus.Counter
@@ -83,16 +91,22 @@ func newSinkWorker(
 	redoQuota *memquota.MemQuota,
 	eventCache *redoEventCache,
 	splitTxn bool,
+	largeTxnThreshold uint64,
 	enableOldValue bool,
+	splitter *updateSplitter,
+	tableRouter *router.Router,
 ) *sinkWorker {
 	return &sinkWorker{
-		changefeedID:   changefeedID,
-		sourceManager:  sourceManager,
-		sinkMemQuota:   sinkQuota,
-		redoMemQuota:   redoQuota,
-		eventCache:     eventCache,
-		splitTxn:       splitTxn,
-		enableOldValue: enableOldValue,
+		changefeedID:      changefeedID,
+		sourceManager:     sourceManager,
+		sinkMemQuota:      sinkQuota,
+		redoMemQuota:      redoQuota,
+		eventCache:        eventCache,
+		splitTxn:          splitTxn,
+		largeTxnThreshold: largeTxnThreshold,
+		enableOldValue:    enableOldValue,
+		splitter:          splitter,
+		tableRouter:       tableRouter,
 
 		metricRedoEventCacheHit:  RedoEventCacheAccess.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "hit"),
 		metricRedoEventCacheMiss: RedoEventCacheAccess.WithLabelValues(changefeedID.Namespace, changefeedID.ID, "miss"),
@@ -120,7 +134,8 @@ func (w *sinkWorker) handleTasks(ctx context.Context, taskChan <-chan *sinkTask)
 func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr error) {
 	// We need to use a new batch ID for each task.
 	batchID.Add(1)
-	advancer := newTableSinkAdvancer(task, w.splitTxn, w.sinkMemQuota, requestMemSize)
+	advancer := newTableSinkAdvancer(task, w.splitTxn, w.largeTxnThreshold,
+		w.sinkMemQuota, requestMemSize)
 	// The task is finished and some required memory isn't used.
 	defer advancer.cleanup()
 
@@ -233,7 +248,8 @@ func (w *sinkWorker) handleTask(ctx context.Context, task *sinkTask) (finalErr e
 		if e.Row != nil {
 			// For all rows, we add table replicate ts, so mysql sink can determine safe-mode.
 			e.Row.ReplicatingTs = task.tableSink.replicateTs
-			x, size, err := convertRowChangedEvents(w.changefeedID, task.span, w.enableOldValue, e)
+			x, size, err := convertRowChangedEvents(w.changefeedID, task.span,
+				w.enableOldValue, w.splitter, w.tableRouter, e)
 			if err != nil {
 				return err
 			}
@@ -128,7 +128,7 @@ func (suite *tableSinkWorkerSuite) createWorker(
 ) (*sinkWorker, engine.SortEngine) {
 	sortEngine := memory.New(context.Background())
 	sm := sourcemanager.New(suite.testChangefeedID, upstream.NewUpstream4Test(&MockPD{}),
-		&entry.MockMountGroup{}, sortEngine, false)
+		&entry.MockMountGroup{}, sortEngine, false, nil)
 	go func() { sm.Run(ctx) }()
 
 	// To avoid refund or release panics.
@@ -137,7 +137,7 @@ func (suite *tableSinkWorkerSuite) createWorker(
 	quota.ForceAcquire(testEventSize)
 	quota.AddTable(suite.testSpan)
 
-	return newSinkWorker(suite.testChangefeedID, sm, quota, nil, nil, splitTxn, false), sortEngine
+	return newSinkWorker(suite.testChangefeedID, sm, quota, nil, nil, splitTxn, 0, false, nil, nil), sortEngine
 }
 
 func (suite *tableSinkWorkerSuite) addEventsToSortEngine(
@@ -26,8 +26,10 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerrors "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/zap"
@@ -406,10 +408,10 @@ func (t *tableSinkWrapper) cleanRangeEventCounts(upperBound engine.Position, min
 }
 
 // convertRowChangedEvents uses to convert RowChangedEvents to TableSinkRowChangedEvents.
-// It will deal with the old value compatibility.
+// It will deal with the old value compatibility and the split update rules.
 func convertRowChangedEvents(
 	changefeed model.ChangeFeedID, span tablepb.Span, enableOldValue bool,
-	events ...*model.PolymorphicEvent,
+	splitter *updateSplitter, tableRouter *router.Router, events ...*model.PolymorphicEvent,
 ) ([]*model.RowChangedEvent, uint64, error) {
 	size := 0
 	rowChangedEvents := make([]*model.RowChangedEvent, 0, len(events))
@@ -441,27 +443,46 @@ func convertRowChangedEvents(
 
 		// This indicates that it is an update event,
 		// and after enable old value internally by default(but disable in the configuration).
-		// We need to handle the update event to be compatible with the old format.
-		if !enableOldValue && colLen != 0 && preColLen != 0 && colLen == preColLen {
-			if shouldSplitUpdateEvent(e) {
+		// We need to handle the update event to be compatible with the old format,
+		// unless a split update rule decides how to emit it.
+		mode := splitter.getMode(e.Row.Table)
+		if (!enableOldValue || mode != "") && mode != config.SplitUpdateModeNone &&
+			colLen != 0 && preColLen != 0 && colLen == preColLen {
+			if mode == config.SplitUpdateModeAll || shouldSplitUpdateEvent(e) {
 				deleteEvent, insertEvent, err := splitUpdateEvent(e)
 				if err != nil {
 					return nil, 0, errors.Trace(err)
 				}
 				// NOTICE: Please do not change the order, the delete event always comes before the insert event.
-				rowChangedEvents = append(rowChangedEvents, deleteEvent.Row, insertEvent.Row)
+				rowChangedEvents = append(rowChangedEvents,
+					routeRow(tableRouter, deleteEvent.Row), routeRow(tableRouter, insertEvent.Row))
 			} else {
 				// If the handle key columns are not updated, PreColumns is directly ignored.
-				e.Row.PreColumns = nil
-				rowChangedEvents = append(rowChangedEvents, e.Row)
+				if !enableOldValue {
+					e.Row.PreColumns = nil
+				}
+				rowChangedEvents = append(rowChangedEvents, routeRow(tableRouter, e.Row))
 			}
 		} else {
-			rowChangedEvents = append(rowChangedEvents, e.Row)
+			rowChangedEvents = append(rowChangedEvents, routeRow(tableRouter, e.Row))
 		}
 	}
 	return rowChangedEvents, uint64(size), nil
 }
 
+// routeRow returns a copy of the row with the table name routed by the route
+// rules. The row itself isn't modified since the split update rules and the
+// other rules of the processor match the upstream table.
+func routeRow(tableRouter *router.Router, row *model.RowChangedEvent) *model.RowChangedEvent {
+	table := tableRouter.RouteTableName(row.Table)
+	if table == row.Table {
+		return row
+	}
+	routed := *row
+	routed.Table = table
+	return &routed
+}
+
 // shouldSplitUpdateEvent determines if the split event is needed to align the old format based on
 // whether the handle key column has been modified.
 // If the handle key column is modified,
@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink"
 	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/router"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
@@ -123,7 +125,7 @@ func TestConvertNilRowChangedEvents(t *testing.T) {
 	changefeedID := model.DefaultChangeFeedID("1")
 	span := spanz.TableIDToComparableSpan(1)
 	enableOldVlaue := false
-	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldVlaue, events...)
+	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldVlaue, nil, nil, events...)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(result))
 	require.Equal(t, uint64(0), size)
@@ -145,7 +147,7 @@ func TestConvertEmptyRowChangedEvents(t *testing.T) {
 	changefeedID := model.DefaultChangeFeedID("1")
 	span := spanz.TableIDToComparableSpan(1)
 	enableOldValue := false
-	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, events...)
+	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, nil, nil, events...)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(result))
 	require.Equal(t, uint64(0), size)
@@ -197,7 +199,7 @@ func TestConvertRowChangedEventsWhenEnableOldValue(t *testing.T) {
 	changefeedID := model.DefaultChangeFeedID("1")
 	span := spanz.TableIDToComparableSpan(1)
 	enableOldValue := true
-	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, events...)
+	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, nil, nil, events...)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(result))
 	require.Equal(t, uint64(224), size)
@@ -250,7 +252,7 @@ func TestConvertRowChangedEventsWhenDisableOldValue(t *testing.T) {
 	changefeedID := model.DefaultChangeFeedID("1")
 	span := spanz.TableIDToComparableSpan(1)
 	enableOldValue := false
-	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, events...)
+	result, size, err := convertRowChangedEvents(changefeedID, span, enableOldValue, nil, nil, events...)
 	require.NoError(t, err)
 	require.Equal(t, 2, len(result))
 	require.Equal(t, uint64(224), size)
@@ -296,12 +298,128 @@ func TestConvertRowChangedEventsWhenDisableOldValue(t *testing.T) {
 			},
 		},
 	}
-	result, size, err = convertRowChangedEvents(changefeedID, span, enableOldValue, events...)
+	result, size, err = convertRowChangedEvents(changefeedID, span, enableOldValue, nil, nil, events...)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(result))
 	require.Equal(t, uint64(224), size)
 }
 
+func TestConvertRowChangedEventsWithSplitUpdateRules(t *testing.T) {
+	t.Parallel()
+
+	splitter, err := newUpdateSplitter([]*config.SplitUpdateRule{
+		{Matcher: []string{"test.all"}, Mode: config.SplitUpdateModeAll},
+		{Matcher: []string{"test.none"}, Mode: config.SplitUpdateModeNone},
+		{Matcher: []string{"test.*"}, Mode: config.SplitUpdateModeHandleKey},
+	})
+	require.NoError(t, err)
+
+	newEvent := func(table string, handleKeyUpdated bool) *model.PolymorphicEvent {
+		preHandleKey := "col2-value"
+		if handleKeyUpdated {
+			preHandleKey = "col2-value-old"
+		}
+		return &model.PolymorphicEvent{
+			CRTs:  1,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				CommitTs: 1,
+				Columns: []*model.Column{
+					{Name: "col1", Flag: model.BinaryFlag, Value: "col1-value-updated"},
+					{Name: "col2", Flag: model.HandleKeyFlag, Value: "col2-value"},
+				},
+				PreColumns: []*model.Column{
+					{Name: "col1", Flag: model.BinaryFlag, Value: "col1-value"},
+					{Name: "col2", Flag: model.HandleKeyFlag, Value: preHandleKey},
+				},
+				Table: &model.TableName{Schema: "test", Table: table},
+			},
+		}
+	}
+	changefeedID := model.DefaultChangeFeedID("1")
+	span := spanz.TableIDToComparableSpan(1)
+
+	cases := []struct {
+		table            string
+		handleKeyUpdated bool
+		enableOldValue   bool
+		expectedRows     int
+		expectedPreCols  bool
+	}{
+		{"all", false, true, 2, true},
+		{"all", false, false, 2, true},
+		{"none", true, true, 1, true},
+		{"none", true, false, 1, true},
+		{"t1", true, true, 2, true},
+		{"t1", false, true, 1, true},
+		{"t1", false, false, 1, false},
+	}
+	for _, c := range cases {
+		result, _, err := convertRowChangedEvents(changefeedID, span,
+			c.enableOldValue, splitter, nil, newEvent(c.table, c.handleKeyUpdated))
+		require.NoError(t, err)
+		require.Len(t, result, c.expectedRows, c)
+		if c.expectedRows == 2 {
+			// the delete event always comes before the insert event.
+			require.True(t, result[0].IsDelete())
+			require.True(t, result[1].IsInsert())
+		} else {
+			require.Equal(t, c.expectedPreCols, result[0].PreColumns != nil, c)
+		}
+	}
+
+	// the updates of the tables matching no rule are kept if the old value
+	// is enabled.
+	result, _, err := convertRowChangedEvents(changefeedID, span,
+		true, nil, nil, newEvent("t1", true))
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+}
+
+func TestConvertRowChangedEventsWithRouteRules(t *testing.T) {
+	t.Parallel()
+
+	splitter, err := newUpdateSplitter([]*config.SplitUpdateRule{
+		{Matcher: []string{"db_1.orders"}, Mode: config.SplitUpdateModeAll},
+	})
+	require.NoError(t, err)
+	tableRouter, err := router.NewRouter([]*config.RouteRule{
+		{Pattern: `db_(\d+)\.orders`, TargetSchema: "db_all"},
+	})
+	require.NoError(t, err)
+
+	table := &model.TableName{Schema: "db_1", Table: "orders", TableID: 1}
+	events := []*model.PolymorphicEvent{
+		{
+			CRTs:  1,
+			RawKV: &model.RawKVEntry{OpType: model.OpTypePut},
+			Row: &model.RowChangedEvent{
+				CommitTs: 1,
+				Columns: []*model.Column{
+					{Name: "col1", Flag: model.HandleKeyFlag, Value: "col1-value-updated"},
+				},
+				PreColumns: []*model.Column{
+					{Name: "col1", Flag: model.HandleKeyFlag, Value: "col1-value"},
+				},
+				Table: table,
+			},
+		},
+	}
+	changefeedID := model.DefaultChangeFeedID("1")
+	span := spanz.TableIDToComparableSpan(1)
+	result, _, err := convertRowChangedEvents(changefeedID, span,
+		true, splitter, tableRouter, events...)
+	require.NoError(t, err)
+	// The split update rules match the upstream table.
+	require.Len(t, result, 2)
+	for _, row := range result {
+		require.Equal(t, &model.TableName{Schema: "db_all", Table: "orders", TableID: 1}, row.Table)
+	}
+	// The upstream row is not modified.
+	require.Same(t, table, events[0].Row.Table)
+	require.Equal(t, "db_1", table.Schema)
+}
+
 func TestGetUpperBoundTs(t *testing.T) {
 	t.Parallel()
 	wrapper, _ := createTableSinkWrapper(
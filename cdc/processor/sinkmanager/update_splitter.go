@@ -0,0 +1,74 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinkmanager
+
+import (
+	"sync"
+
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+)
+
+type splitUpdateRule struct {
+	tableMatcher tfilter.Filter
+	mode         string
+}
+
+// updateSplitter decides whether the updates of a table are split into
+// delete and insert pairs by the split update rules. It's safe for concurrent
+// use, and a nil updateSplitter has no rule.
+type updateSplitter struct {
+	rules []*splitUpdateRule
+	// modes caches the split update mode of a table, model.TableName -> string,
+	// the mode is empty if no rule matches the table.
+	modes sync.Map
+}
+
+func newUpdateSplitter(rules []*config.SplitUpdateRule) (*updateSplitter, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	s := &updateSplitter{}
+	for _, rule := range rules {
+		tf, err := tfilter.Parse(rule.Matcher)
+		if err != nil {
+			return nil, cerrors.WrapError(cerrors.ErrSinkInvalidConfig, err)
+		}
+		s.rules = append(s.rules, &splitUpdateRule{tableMatcher: tf, mode: rule.Mode})
+	}
+	return s, nil
+}
+
+// getMode returns the split update mode of the first rule matching the table,
+// or an empty string if no rule matches it.
+func (s *updateSplitter) getMode(table *model.TableName) string {
+	if s == nil || table == nil {
+		return ""
+	}
+	key := model.TableName{Schema: table.Schema, Table: table.Table}
+	if mode, ok := s.modes.Load(key); ok {
+		return mode.(string)
+	}
+	mode := ""
+	for _, rule := range s.rules {
+		if rule.tableMatcher.MatchTable(table.Schema, table.Table) {
+			mode = rule.mode
+			break
+		}
+	}
+	s.modes.Store(key, mode)
+	return mode
+}
@@ -14,6 +14,8 @@
 package engine
 
 import (
+	"time"
+
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 )
@@ -74,6 +76,9 @@ type SortEngine interface {
 	// GetStatsByTable gets the statistics of the given table.
 	GetStatsByTable(span tablepb.Span) TableStats
 
+	// GetMetricsByTable gets a snapshot of the metrics of the given table.
+	GetMetricsByTable(span tablepb.Span) TableMetrics
+
 	// ReceivedEvents returns the number of events received by the sort engine.
 	ReceivedEvents() int64
 
@@ -172,3 +177,29 @@ type TableStats struct {
 	ReceivedMaxCommitTs   model.Ts
 	ReceivedMaxResolvedTs model.Ts
 }
+
+// TableMetrics is a snapshot of the metrics of a table in a sort engine.
+type TableMetrics struct {
+	// IterSeekLatency is the latency of the recent iterator seeks.
+	IterSeekLatency LatencyStats
+	// IterNextLatency is the latency of the recent iterator nexts.
+	IterNextLatency LatencyStats
+	// ResolvedTsDelay is the duration from the latest resolved ts is added
+	// into the engine to it's available for fetching.
+	ResolvedTsDelay time.Duration
+	// OnDiskBytes is the estimated on-disk bytes of the table.
+	OnDiskBytes uint64
+	// PendingCompactionBytes is the estimated bytes need to be compacted of
+	// the storage of the table, which can be shared with other tables.
+	PendingCompactionBytes uint64
+}
+
+// LatencyStats is the percentiles of some recent latency samples.
+type LatencyStats struct {
+	// Count is the total number of samples, including the discarded ones.
+	Count uint64
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
@@ -51,6 +51,10 @@ type SortEngineFactory struct {
 	engineType      sortEngineType
 	dir             string
 	memQuotaInBytes uint64
+	// maxDiskInBytes is the max on-disk bytes of each engine, 0 means unlimited.
+	maxDiskInBytes uint64
+	// encryptionKey is used to encrypt the on-disk data, nil means no encryption.
+	encryptionKey []byte
 
 	mu      sync.Mutex
 	engines map[model.ChangeFeedID]engine.SortEngine
@@ -80,13 +84,13 @@ func (f *SortEngineFactory) Create(ID model.ChangeFeedID) (e engine.SortEngine,
 			return e, nil
 		}
 		if len(f.dbs) == 0 {
-			f.dbs, f.writeStalls, err = createPebbleDBs(f.dir, f.pebbleConfig, f.memQuotaInBytes)
+			f.dbs, f.writeStalls, err = createPebbleDBs(f.dir, f.pebbleConfig, f.memQuotaInBytes, f.encryptionKey)
 			if err != nil {
 				return
 			}
 			f.dbInitialized.Store(true)
 		}
-		e = epebble.New(ID, f.dbs)
+		e = epebble.New(ID, f.dbs, f.maxDiskInBytes)
 		f.engines[ID] = e
 	default:
 		log.Panic("not implemented")
@@ -129,7 +133,12 @@ func (f *SortEngineFactory) Close() (err error) {
 }
 
 // NewForPebble will create a SortEngineFactory for the pebble implementation.
-func NewForPebble(dir string, memQuotaInBytes uint64, cfg *config.DBConfig) *SortEngineFactory {
+// The on-disk bytes of each created engine is limited by maxDiskInBytes if
+// it's not 0, and the on-disk data is encrypted by encryptionKey if it's not nil.
+func NewForPebble(
+	dir string, memQuotaInBytes uint64, cfg *config.DBConfig, maxDiskInBytes uint64,
+	encryptionKey []byte,
+) *SortEngineFactory {
 	factoryMu.Lock()
 	defer factoryMu.Unlock()
 	if factory == nil {
@@ -137,6 +146,8 @@ func NewForPebble(dir string, memQuotaInBytes uint64, cfg *config.DBConfig) *Sor
 			engineType:      pebbleEngine,
 			dir:             dir,
 			memQuotaInBytes: memQuotaInBytes,
+			maxDiskInBytes:  maxDiskInBytes,
+			encryptionKey:   encryptionKey,
 			engines:         make(map[model.ChangeFeedID]engine.SortEngine),
 			closed:          make(chan struct{}),
 			pebbleConfig:    cfg,
@@ -174,6 +185,13 @@ func (f *SortEngineFactory) collectMetrics() {
 			engine.IteratorGauge().WithLabelValues(id).Set(float64(stats.TableIters))
 			engine.WriteDelayCount().WithLabelValues(id).
 				Set(float64(stdatomic.LoadUint64(&f.writeStalls[i].counter)))
+			engine.WriteStallDuration().WithLabelValues(id).
+				Set(float64(stdatomic.LoadInt64(&f.writeStalls[i].durInMs)) / 1000)
+			engine.CompactionCount().WithLabelValues(id).Set(float64(stats.Compact.Count))
+			engine.CompactionInProgress().WithLabelValues(id).Set(float64(stats.Compact.NumInProgress))
+			engine.CompactionDebt().WithLabelValues(id).Set(float64(stats.Compact.EstimatedDebt))
+			engine.L0Sublevels().WithLabelValues(id).Set(float64(stats.Levels[0].Sublevels))
+			engine.MemTableCount().WithLabelValues(id).Set(float64(stats.MemTable.Count))
 
 			metricLevelCount := engine.LevelCount().MustCurryWith(map[string]string{"id": id})
 			for level, metric := range stats.Levels {
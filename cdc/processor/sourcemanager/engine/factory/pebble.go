@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	epebble "github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/pebble"
@@ -30,15 +31,27 @@ import (
 func createPebbleDBs(
 	dir string, cfg *config.DBConfig,
 	memQuotaInBytes uint64,
+	encryptionKey []byte,
 ) ([]*pebble.DB, []writeStall, error) {
 	dbs := make([]*pebble.DB, 0, cfg.Count)
 	writeStalls := make([]writeStall, cfg.Count)
 
+	fs := vfs.Default
+	if encryptionKey != nil {
+		var err error
+		if fs, err = epebble.NewEncryptedFS(fs, encryptionKey); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	cache := pebble.NewCache(int64(memQuotaInBytes))
 	defer cache.Unref()
 	for id := 0; id < cfg.Count; id++ {
-		ws := writeStalls[id]
+		// Take the address, otherwise the metrics collector never sees the
+		// stalls recorded by the event listener.
+		ws := &writeStalls[id]
 		adjust := func(opts *pebble.Options) {
+			opts.FS = fs
 			opts.EventListener = pebble.MakeLoggingEventListener(&pebbleLogger{id: id})
 
 			opts.EventListener.WriteStallBegin = func(_ pebble.WriteStallBeginInfo) {
@@ -145,6 +145,12 @@ func (s *EventSorter) GetStatsByTable(span tablepb.Span) engine.TableStats {
 	return engine.TableStats{}
 }
 
+// GetMetricsByTable implements engine.SortEngine.
+func (s *EventSorter) GetMetricsByTable(span tablepb.Span) engine.TableMetrics {
+	log.Panic("GetMetricsByTable should never be called")
+	return engine.TableMetrics{}
+}
+
 // ReceivedEvents implements engine.SortEngine.
 // Do not use this function, it is only used for testing.
 func (s *EventSorter) ReceivedEvents() int64 {
@@ -58,6 +58,14 @@ var (
 		Buckets:   prometheus.ExponentialBuckets(0.004, 2.0, 20),
 	}, []string{"namespace", "id", "call"})
 
+	sorterResolvedTsDelayHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ticdc",
+		Subsystem: "sorter",
+		Name:      "resolved_ts_delay_seconds",
+		Help:      "Bucketed histogram of the duration from a resolved ts is added into the sorter to it's available for fetching",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2.0, 20),
+	}, []string{"namespace", "changefeed"})
+
 	// inMemoryDataSizeGauge is the metric that records sorter memory usage.
 	inMemoryDataSizeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "ticdc",
@@ -101,6 +109,48 @@ var (
 		Name:      "block_cache_access_total",
 		Help:      "The total number of db block cache access",
 	}, []string{"id", "type"})
+
+	dbWriteStallDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "write_stall_duration_seconds_total",
+		Help:      "The total duration of db write stall",
+	}, []string{"id"})
+
+	dbCompactionCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "compaction_total",
+		Help:      "The total number of db compactions",
+	}, []string{"id"})
+
+	dbCompactionInProgress = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "compaction_in_progress",
+		Help:      "The number of db compactions in progress",
+	}, []string{"id"})
+
+	dbCompactionDebt = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "compaction_debt_bytes",
+		Help:      "The estimated bytes need to be compacted for the db to reach a stable state",
+	}, []string{"id"})
+
+	dbL0Sublevels = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "l0_sublevels",
+		Help:      "The number of sublevels in level-0 by the db",
+	}, []string{"id"})
+
+	dbMemTableCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ticdc",
+		Subsystem: "db",
+		Name:      "memtable_count",
+		Help:      "The number of memory tables by the db",
+	}, []string{"id"})
 )
 
 /* Some metrics are shared in pipeline sorter and pull-based-sink sort engine */
@@ -125,6 +175,11 @@ func SorterIterReadDuration() *prometheus.HistogramVec {
 	return sorterIterReadDurationHistogram
 }
 
+// SorterResolvedTsDelay returns sorterResolvedTsDelayHistogram.
+func SorterResolvedTsDelay() *prometheus.HistogramVec {
+	return sorterResolvedTsDelayHistogram
+}
+
 // InMemoryDataSize returns inMemoryDataSizeGauge.
 func InMemoryDataSize() *prometheus.GaugeVec {
 	return inMemoryDataSizeGauge
@@ -155,6 +210,36 @@ func BlockCacheAccess() *prometheus.GaugeVec {
 	return dbBlockCacheAccess
 }
 
+// WriteStallDuration returns dbWriteStallDuration.
+func WriteStallDuration() *prometheus.GaugeVec {
+	return dbWriteStallDuration
+}
+
+// CompactionCount returns dbCompactionCount.
+func CompactionCount() *prometheus.GaugeVec {
+	return dbCompactionCount
+}
+
+// CompactionInProgress returns dbCompactionInProgress.
+func CompactionInProgress() *prometheus.GaugeVec {
+	return dbCompactionInProgress
+}
+
+// CompactionDebt returns dbCompactionDebt.
+func CompactionDebt() *prometheus.GaugeVec {
+	return dbCompactionDebt
+}
+
+// L0Sublevels returns dbL0Sublevels.
+func L0Sublevels() *prometheus.GaugeVec {
+	return dbL0Sublevels
+}
+
+// MemTableCount returns dbMemTableCount.
+func MemTableCount() *prometheus.GaugeVec {
+	return dbMemTableCount
+}
+
 // InitMetrics registers all metrics in this file
 func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(mountWaitDuration)
@@ -162,6 +247,7 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(sorterCompactDurationHistogram)
 	registry.MustRegister(sorterWriteBytesHistogram)
 	registry.MustRegister(sorterIterReadDurationHistogram)
+	registry.MustRegister(sorterResolvedTsDelayHistogram)
 	registry.MustRegister(inMemoryDataSizeGauge)
 	registry.MustRegister(onDiskDataSizeGauge)
 	registry.MustRegister(dbIteratorGauge)
@@ -170,4 +256,10 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(dbLevelCount)
 	registry.MustRegister(dbWriteDelayCount)
 	registry.MustRegister(dbBlockCacheAccess)
+	registry.MustRegister(dbWriteStallDuration)
+	registry.MustRegister(dbCompactionCount)
+	registry.MustRegister(dbCompactionInProgress)
+	registry.MustRegister(dbCompactionDebt)
+	registry.MustRegister(dbL0Sublevels)
+	registry.MustRegister(dbMemTableCount)
 }
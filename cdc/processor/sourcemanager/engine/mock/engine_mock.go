@@ -135,6 +135,20 @@ func (mr *MockSortEngineMockRecorder) FetchByTable(span, lowerBound, upperBound
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByTable", reflect.TypeOf((*MockSortEngine)(nil).FetchByTable), span, lowerBound, upperBound)
 }
 
+// GetMetricsByTable mocks base method.
+func (m *MockSortEngine) GetMetricsByTable(span tablepb.Span) engine.TableMetrics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsByTable", span)
+	ret0, _ := ret[0].(engine.TableMetrics)
+	return ret0
+}
+
+// GetMetricsByTable indicates an expected call of GetMetricsByTable.
+func (mr *MockSortEngineMockRecorder) GetMetricsByTable(span interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsByTable", reflect.TypeOf((*MockSortEngine)(nil).GetMetricsByTable), span)
+}
+
 // GetResolvedTs mocks base method.
 func (m *MockSortEngine) GetResolvedTs(span tablepb.Span) model.Ts {
 	m.ctrl.T.Helper()
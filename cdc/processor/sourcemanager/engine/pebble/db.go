@@ -113,12 +113,12 @@ func buildPebbleOption(cfg *config.DBConfig) (opts *pebble.Options) {
 	opts.ErrorIfExists = true
 	opts.DisableWAL = false // Delete range requires WAL.
 	opts.MaxOpenFiles = cfg.MaxOpenFiles / cfg.Count
-	opts.MaxConcurrentCompactions = 6
+	opts.MaxConcurrentCompactions = cfg.MaxConcurrentCompactions
 	opts.L0CompactionThreshold = cfg.CompactionL0Trigger
 	opts.L0StopWritesThreshold = cfg.WriteL0PauseTrigger
 	opts.LBaseMaxBytes = 64 << 20 // 64 MB
 	opts.MemTableSize = cfg.WriterBufferSize
-	opts.MemTableStopWritesThreshold = 4
+	opts.MemTableStopWritesThreshold = cfg.MemTableStopWritesThreshold
 	opts.Levels = make([]pebble.LevelOptions, 7)
 	opts.TablePropertyCollectors = append(opts.TablePropertyCollectors,
 		func() pebble.TablePropertyCollector {
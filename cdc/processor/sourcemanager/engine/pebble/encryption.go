@@ -0,0 +1,197 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Each encrypted file starts with a random IV, followed by the data encrypted
+// by AES-CTR. The counter of a block is the IV plus the block offset, so that
+// any part of the file can be decrypted independently. The files are never
+// reused after restart, so there is no need to record the key in the files.
+const ivLen = aes.BlockSize
+
+// LoadEncryptionKey loads the hex encoded AES key from the key file. The key
+// file is expected to be provisioned by a KMS or a secret manager.
+func LoadEncryptionKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrSorterEncryption, err)
+	}
+	key, err := hex.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrSorterEncryption, err)
+	}
+	if _, err := aes.NewCipher(key); err != nil {
+		return nil, errors.WrapError(errors.ErrSorterEncryption, err)
+	}
+	return key, nil
+}
+
+// NewEncryptedFS wraps fs so that all files created or opened through it are
+// encrypted by the AES key.
+func NewEncryptedFS(fs vfs.FS, key []byte) (vfs.FS, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrSorterEncryption, err)
+	}
+	return &encryptedFS{FS: fs, block: block}, nil
+}
+
+type encryptedFS struct {
+	vfs.FS
+	block cipher.Block
+}
+
+func (fs *encryptedFS) Create(name string) (vfs.File, error) {
+	f, err := fs.FS.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, ivLen)
+	if _, err := rand.Read(iv); err != nil {
+		_ = f.Close()
+		return nil, errors.WrapError(errors.ErrSorterEncryption, err)
+	}
+	if _, err := f.Write(iv); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &encryptedFile{File: f, block: fs.block, iv: iv}, nil
+}
+
+func (fs *encryptedFS) Open(name string, opts ...vfs.OpenOption) (vfs.File, error) {
+	f, err := fs.FS.Open(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, ivLen)
+	if _, err := io.ReadFull(f, iv); err != nil {
+		_ = f.Close()
+		return nil, errors.ErrSorterEncryption.GenWithStack(
+			"fail to read the header of encrypted file %s: %s", name, err.Error())
+	}
+	return &encryptedFile{File: f, block: fs.block, iv: iv}, nil
+}
+
+// ReuseForWrite doesn't reuse the old file, because the data written to it
+// must be encrypted with a new IV.
+func (fs *encryptedFS) ReuseForWrite(oldname, newname string) (vfs.File, error) {
+	if err := fs.FS.Rename(oldname, newname); err != nil {
+		return nil, err
+	}
+	return fs.Create(newname)
+}
+
+func (fs *encryptedFS) Stat(name string) (os.FileInfo, error) {
+	info, err := fs.FS.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedFileInfo(info), nil
+}
+
+type encryptedFile struct {
+	vfs.File
+	block cipher.Block
+	iv    []byte
+
+	// Offsets of the plaintext for sequential reads and writes.
+	readOffset  int64
+	writeOffset int64
+	buf         []byte
+}
+
+func (f *encryptedFile) Read(p []byte) (int, error) {
+	n, err := f.File.Read(p)
+	f.xorKeyStream(p[:n], p[:n], f.readOffset)
+	f.readOffset += int64(n)
+	return n, err
+}
+
+func (f *encryptedFile) ReadAt(p []byte, off int64) (int, error) {
+	n, err := f.File.ReadAt(p, off+ivLen)
+	f.xorKeyStream(p[:n], p[:n], off)
+	return n, err
+}
+
+func (f *encryptedFile) Write(p []byte) (int, error) {
+	if cap(f.buf) < len(p) {
+		f.buf = make([]byte, len(p))
+	}
+	buf := f.buf[:len(p)]
+	f.xorKeyStream(buf, p, f.writeOffset)
+	n, err := f.File.Write(buf)
+	f.writeOffset += int64(n)
+	return n, err
+}
+
+func (f *encryptedFile) Stat() (os.FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptedFileInfo(info), nil
+}
+
+// xorKeyStream XORs src with the key stream starting at the plaintext offset.
+func (f *encryptedFile) xorKeyStream(dst, src []byte, offset int64) {
+	if len(src) == 0 {
+		return
+	}
+	counter := make([]byte, ivLen)
+	copy(counter, f.iv)
+	lo := binary.BigEndian.Uint64(counter[8:])
+	hi := binary.BigEndian.Uint64(counter[:8])
+	blocks := uint64(offset / ivLen)
+	if lo+blocks < lo {
+		hi++
+	}
+	binary.BigEndian.PutUint64(counter[8:], lo+blocks)
+	binary.BigEndian.PutUint64(counter[:8], hi)
+
+	stream := cipher.NewCTR(f.block, counter)
+	if skip := int(offset % ivLen); skip > 0 {
+		discard := make([]byte, skip)
+		stream.XORKeyStream(discard, discard)
+	}
+	stream.XORKeyStream(dst, src)
+}
+
+type encryptedFileInfo struct {
+	os.FileInfo
+}
+
+func newEncryptedFileInfo(info os.FileInfo) os.FileInfo {
+	if info.IsDir() || info.Size() < ivLen {
+		return info
+	}
+	return encryptedFileInfo{FileInfo: info}
+}
+
+// Size returns the size of the plaintext.
+func (info encryptedFileInfo) Size() int64 {
+	return info.FileInfo.Size() - ivLen
+}
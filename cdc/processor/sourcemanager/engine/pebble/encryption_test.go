@@ -0,0 +1,131 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadEncryptionKey(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "valid")
+	require.Nil(t, os.WriteFile(path, []byte("000102030405060708090a0b0c0d0e0f\n"), 0o600))
+	key, err := LoadEncryptionKey(path)
+	require.Nil(t, err)
+	require.Len(t, key, 16)
+
+	path = filepath.Join(dir, "invalid-length")
+	require.Nil(t, os.WriteFile(path, []byte("000102"), 0o600))
+	_, err = LoadEncryptionKey(path)
+	require.Error(t, err)
+
+	path = filepath.Join(dir, "invalid-hex")
+	require.Nil(t, os.WriteFile(path, []byte("not a hex key"), 0o600))
+	_, err = LoadEncryptionKey(path)
+	require.Error(t, err)
+
+	_, err = LoadEncryptionKey(filepath.Join(dir, "not-exist"))
+	require.Error(t, err)
+}
+
+func TestEncryptedFile(t *testing.T) {
+	fs, err := NewEncryptedFS(vfs.Default, bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	path := filepath.Join(t.TempDir(), "file")
+	f, err := fs.Create(path)
+	require.Nil(t, err)
+	written := 0
+	for _, n := range []int{1, 15, 17, 100, 867} {
+		_, err = f.Write(data[written : written+n])
+		require.Nil(t, err)
+		written += n
+	}
+	require.Nil(t, f.Close())
+
+	raw, err := os.ReadFile(path)
+	require.Nil(t, err)
+	require.Len(t, raw, len(data)+ivLen)
+	require.NotEqual(t, data, raw[ivLen:])
+
+	info, err := fs.Stat(path)
+	require.Nil(t, err)
+	require.Equal(t, int64(len(data)), info.Size())
+
+	f, err = fs.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+	info, err = f.Stat()
+	require.Nil(t, err)
+	require.Equal(t, int64(len(data)), info.Size())
+
+	read, err := io.ReadAll(f)
+	require.Nil(t, err)
+	require.Equal(t, data, read)
+	for _, off := range []int{0, 1, 15, 16, 17, 500, 999} {
+		buf := make([]byte, len(data)-off)
+		_, err = f.ReadAt(buf, int64(off))
+		require.Nil(t, err)
+		require.Equal(t, data[off:], buf)
+	}
+}
+
+func TestEncryptedPebble(t *testing.T) {
+	fs, err := NewEncryptedFS(vfs.Default, bytes.Repeat([]byte{1}, 16))
+	require.Nil(t, err)
+
+	dbPath := filepath.Join(t.TempDir(), t.Name())
+	db, err := OpenPebble(1, dbPath, &config.DBConfig{Count: 1}, nil,
+		func(opts *pebble.Options) { opts.FS = fs })
+	require.Nil(t, err)
+	defer func() { _ = db.Close() }()
+
+	value := bytes.Repeat([]byte("sensitive-row-data"), 64)
+	for i := 0; i < 100; i++ {
+		require.Nil(t, db.Set([]byte{byte(i)}, value, pebble.NoSync))
+	}
+	require.Nil(t, db.Flush())
+
+	for i := 0; i < 100; i++ {
+		v, closer, err := db.Get([]byte{byte(i)})
+		require.Nil(t, err)
+		require.Equal(t, value, v)
+		require.Nil(t, closer.Close())
+	}
+
+	err = filepath.Walk(dbPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		require.Nil(t, err)
+		require.False(t, bytes.Contains(content, []byte("sensitive-row-data")), path)
+		return nil
+	})
+	require.Nil(t, err)
+}
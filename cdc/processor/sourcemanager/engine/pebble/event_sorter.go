@@ -46,6 +46,10 @@ type EventSorter struct {
 	dbs          []*pebble.DB
 	channs       []*chann.DrainableChann[eventWithTableID]
 	serde        encoding.MsgPackGenSerde
+	// maxDiskInBytes is the max on-disk bytes of the changefeed, 0 means
+	// unlimited. Add is blocked when it's exceeded.
+	maxDiskInBytes uint64
+	diskFull       atomic.Bool
 
 	// To manage background goroutines.
 	wg     sync.WaitGroup
@@ -69,19 +73,21 @@ type EventIter struct {
 	nextDuration prometheus.Observer
 }
 
-// New creates an EventSorter instance.
-func New(ID model.ChangeFeedID, dbs []*pebble.DB) *EventSorter {
+// New creates an EventSorter instance. The on-disk bytes of the changefeed is
+// limited by maxDiskInBytes if it's not 0.
+func New(ID model.ChangeFeedID, dbs []*pebble.DB, maxDiskInBytes uint64) *EventSorter {
 	channs := make([]*chann.DrainableChann[eventWithTableID], 0, len(dbs))
 	for i := 0; i < len(dbs); i++ {
 		channs = append(channs, chann.NewAutoDrainChann[eventWithTableID](chann.Cap(128)))
 	}
 
 	eventSorter := &EventSorter{
-		changefeedID: ID,
-		dbs:          dbs,
-		channs:       channs,
-		closed:       make(chan struct{}),
-		tables:       spanz.NewHashMap[*tableState](),
+		changefeedID:   ID,
+		dbs:            dbs,
+		channs:         channs,
+		maxDiskInBytes: maxDiskInBytes,
+		closed:         make(chan struct{}),
+		tables:         spanz.NewHashMap[*tableState](),
 	}
 
 	for i := range eventSorter.dbs {
@@ -104,6 +110,14 @@ func New(ID model.ChangeFeedID, dbs []*pebble.DB) *EventSorter {
 		}(i, fetchTokens, ioTokens)
 	}
 
+	if maxDiskInBytes > 0 {
+		eventSorter.wg.Add(1)
+		go func() {
+			defer eventSorter.wg.Done()
+			eventSorter.checkDiskUsage()
+		}()
+	}
+
 	return eventSorter
 }
 
@@ -158,10 +172,24 @@ func (s *EventSorter) Add(span tablepb.Span, events ...*model.PolymorphicEvent)
 			zap.Stringer("span", &span))
 	}
 
+	// Pause adding events, which also pauses pulling events from upstream,
+	// until some on-disk events are consumed.
+	for s.diskFull.Load() {
+		select {
+		case <-s.closed:
+			return
+		case <-time.After(diskFullBackoff):
+		}
+	}
+
 	maxCommitTs := model.Ts(0)
 	maxResolvedTs := model.Ts(0)
 	for _, event := range events {
-		state.ch.In() <- eventWithTableID{uniqueID: state.uniqueID, span: span, event: event}
+		item := eventWithTableID{uniqueID: state.uniqueID, span: span, event: event}
+		if event.IsResolved() {
+			item.addedAt = time.Now()
+		}
+		state.ch.In() <- item
 		if event.IsResolved() {
 			if event.CRTs > maxResolvedTs {
 				maxResolvedTs = event.CRTs
@@ -234,8 +262,10 @@ func (s *EventSorter) FetchByTable(span tablepb.Span, lowerBound, upperBound eng
 
 	seekStart := time.Now()
 	iter := iterTable(db, state.uniqueID, span.TableID, lowerBound, upperBound)
+	seekDuration := time.Since(seekStart)
 	iterReadDur.WithLabelValues(s.changefeedID.Namespace, s.changefeedID.ID, "first").
-		Observe(time.Since(seekStart).Seconds())
+		Observe(seekDuration.Seconds())
+	state.seekLatency.observe(seekDuration)
 
 	return &EventIter{
 		tableID: span.TableID,
@@ -305,6 +335,34 @@ func (s *EventSorter) GetStatsByTable(span tablepb.Span) engine.TableStats {
 	}
 }
 
+// GetMetricsByTable implements engine.SortEngine.
+func (s *EventSorter) GetMetricsByTable(span tablepb.Span) engine.TableMetrics {
+	s.mu.RLock()
+	state, exists := s.tables.Get(span)
+	s.mu.RUnlock()
+
+	if !exists {
+		log.Panic("Get metrics from an non-existent table",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Stringer("span", &span))
+	}
+
+	db := s.dbs[getDB(span, len(s.dbs))]
+	metrics := engine.TableMetrics{
+		IterSeekLatency:        state.seekLatency.snapshot(),
+		IterNextLatency:        state.nextLatency.snapshot(),
+		ResolvedTsDelay:        time.Duration(state.resolvedTsDelay.Load()),
+		PendingCompactionBytes: db.Metrics().Compact.EstimatedDebt,
+	}
+	start := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID), 0)
+	end := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID), math.MaxUint64)
+	if size, err := db.EstimateDiskUsage(start, end); err == nil {
+		metrics.OnDiskBytes = size
+	}
+	return metrics
+}
+
 // ReceivedEvents implements engine.SortEngine.
 func (s *EventSorter) ReceivedEvents() int64 {
 	s.mu.Lock()
@@ -356,7 +414,9 @@ func (s *EventIter) Next() (event *model.PolymorphicEvent, pos engine.Position,
 	for valid {
 		nextStart := time.Now()
 		value, valid = s.iter.Value(), s.iter.Next()
-		s.nextDuration.Observe(time.Since(nextStart).Seconds())
+		nextDuration := time.Since(nextStart)
+		s.nextDuration.Observe(nextDuration.Seconds())
+		s.state.nextLatency.observe(nextDuration)
 
 		event = &model.PolymorphicEvent{}
 		if _, err = s.serde.Unmarshal(event, value); err != nil {
@@ -389,6 +449,8 @@ type eventWithTableID struct {
 	uniqueID uint32
 	span     tablepb.Span
 	event    *model.PolymorphicEvent
+	// addedAt is only set for resolved events.
+	addedAt time.Time
 }
 
 type tableState struct {
@@ -399,6 +461,9 @@ type tableState struct {
 	maxReceivedCommitTs   atomic.Uint64
 	maxReceivedResolvedTs atomic.Uint64
 	receivedEvents        atomic.Int64
+	resolvedTsDelay       atomic.Int64
+	seekLatency           latencyWindow
+	nextLatency           latencyWindow
 
 	// Following fields are protected by mu.
 	mu      sync.RWMutex
@@ -412,14 +477,16 @@ func (s *EventSorter) handleEvents(
 	idstr := strconv.Itoa(id + 1)
 	writeDuration := engine.SorterWriteDuration().WithLabelValues(idstr)
 	writeBytes := engine.SorterWriteBytes().WithLabelValues(idstr)
+	resolvedTsDelay := engine.SorterResolvedTsDelay().
+		WithLabelValues(s.changefeedID.Namespace, s.changefeedID.ID)
 
 	batch := db.NewBatch()
 	writeOpts := &pebble.WriteOptions{Sync: false}
-	newResolved := spanz.NewHashMap[model.Ts]()
+	newResolved := spanz.NewHashMap[eventWithTableID]()
 
 	handleItem := func(item eventWithTableID) {
 		if item.event.IsResolved() {
-			newResolved.ReplaceOrInsert(item.span, item.event.CRTs)
+			newResolved.ReplaceOrInsert(item.span, item)
 			return
 		}
 		key := encoding.EncodeKey(item.uniqueID, uint64(item.span.TableID), item.event)
@@ -503,7 +570,8 @@ func (s *EventSorter) handleEvents(
 			batch = db.NewBatch()
 		}
 
-		newResolved.Range(func(span tablepb.Span, resolved uint64) bool {
+		newResolved.Range(func(span tablepb.Span, item eventWithTableID) bool {
+			resolved := item.event.CRTs
 			s.mu.RLock()
 			ts, ok := s.tables.Get(span)
 			if !ok {
@@ -516,17 +584,69 @@ func (s *EventSorter) handleEvents(
 				return false
 			}
 			ts.sortedResolved.Store(resolved)
+			delay := time.Since(item.addedAt)
+			ts.resolvedTsDelay.Store(int64(delay))
+			resolvedTsDelay.Observe(delay.Seconds())
 			for _, onResolve := range s.onResolves {
 				onResolve(span, resolved)
 			}
 			s.mu.RUnlock()
 			return true
 		})
-		newResolved = spanz.NewHashMap[model.Ts]()
+		newResolved = spanz.NewHashMap[eventWithTableID]()
 		ioTokens <- struct{}{}
 	}
 }
 
+// checkDiskUsage checks the on-disk bytes of the changefeed periodically.
+func (s *EventSorter) checkDiskUsage() {
+	ticker := time.NewTicker(diskUsageCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closed:
+			return
+		case <-ticker.C:
+			s.updateDiskFull()
+		}
+	}
+}
+
+// updateDiskFull marks the disk full if the on-disk bytes of the changefeed
+// exceeds the limit. It's not marked if all sorted events have been consumed,
+// in which case pausing adding events can't reduce the disk usage but blocks
+// the progress forever.
+func (s *EventSorter) updateDiskFull() {
+	var usage uint64
+	drainable := false
+	s.mu.RLock()
+	s.tables.Range(func(span tablepb.Span, state *tableState) bool {
+		db := s.dbs[getDB(span, len(s.dbs))]
+		start := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID), 0)
+		end := encoding.EncodeTsKey(state.uniqueID, uint64(span.TableID), math.MaxUint64)
+		if size, err := db.EstimateDiskUsage(start, end); err == nil {
+			usage += size
+		}
+		state.mu.RLock()
+		if state.cleaned.CommitTs < state.sortedResolved.Load() {
+			drainable = true
+		}
+		state.mu.RUnlock()
+		return true
+	})
+	s.mu.RUnlock()
+
+	full := usage > s.maxDiskInBytes && drainable
+	if s.diskFull.Swap(full) != full {
+		log.Info("sorter disk usage limit state changed",
+			zap.String("namespace", s.changefeedID.Namespace),
+			zap.String("changefeed", s.changefeedID.ID),
+			zap.Uint64("usage", usage),
+			zap.Uint64("limit", s.maxDiskInBytes),
+			zap.Bool("full", full))
+	}
+}
+
 // cleanTable uses DeleteRange to clean data of the given table.
 func (s *EventSorter) cleanTable(
 	state *tableState, span tablepb.Span, upperBound ...engine.Position,
@@ -566,6 +686,9 @@ func (s *EventSorter) cleanTable(
 const (
 	batchCommitSize     int = 16 * 1024 * 1024
 	batchCommitInterval     = 20 * time.Millisecond
+
+	diskUsageCheckInterval = time.Second
+	diskFullBackoff        = 100 * time.Millisecond
 )
 
 var uniqueIDGen uint32 = 0
@@ -35,7 +35,7 @@ func TestTableOperations(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
-	s := New(cf, []*pebble.DB{db})
+	s := New(cf, []*pebble.DB{db}, 0)
 	defer s.Close()
 
 	require.True(t, s.IsTableBased())
@@ -58,7 +58,7 @@ func TestNoResolvedTs(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
-	s := New(cf, []*pebble.DB{db})
+	s := New(cf, []*pebble.DB{db}, 0)
 	defer s.Close()
 
 	require.True(t, s.IsTableBased())
@@ -89,7 +89,7 @@ func TestEventFetch(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
-	s := New(cf, []*pebble.DB{db})
+	s := New(cf, []*pebble.DB{db}, 0)
 	defer s.Close()
 
 	require.True(t, s.IsTableBased())
@@ -171,7 +171,7 @@ func TestCleanData(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
-	s := New(cf, []*pebble.DB{db})
+	s := New(cf, []*pebble.DB{db}, 0)
 	defer s.Close()
 
 	require.True(t, s.IsTableBased())
@@ -183,3 +183,96 @@ func TestCleanData(t *testing.T) {
 	})
 	require.Nil(t, s.CleanByTable(span, engine.Position{}))
 }
+
+// TestDiskUsageLimit tests the disk is marked full only if there are sorted
+// events which can be consumed.
+func TestDiskUsageLimit(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), t.Name())
+	db, err := OpenPebble(1, dbPath, &config.DBConfig{Count: 1}, nil)
+	require.Nil(t, err)
+	defer func() { _ = db.Close() }()
+
+	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
+	s := New(cf, []*pebble.DB{db}, 1)
+	defer s.Close()
+
+	span := spanz.TableIDToComparableSpan(1)
+	s.AddTable(span)
+	resolvedTs := make(chan model.Ts)
+	s.OnResolve(func(_ tablepb.Span, ts model.Ts) { resolvedTs <- ts })
+
+	s.Add(span, model.NewPolymorphicEvent(&model.RawKVEntry{
+		OpType:  model.OpTypePut,
+		Key:     []byte{1},
+		Value:   make([]byte, 1024),
+		StartTs: 1,
+		CRTs:    2,
+	}), model.NewResolvedPolymorphicEvent(0, 3))
+	timer := time.NewTimer(time.Second)
+	select {
+	case ts := <-resolvedTs:
+		require.Equal(t, model.Ts(3), ts)
+	case <-timer.C:
+		panic("must get a resolved timestamp instead of timeout")
+	}
+
+	require.Nil(t, db.Flush())
+	s.updateDiskFull()
+	require.True(t, s.diskFull.Load())
+
+	require.Nil(t, s.CleanByTable(span, engine.Position{CommitTs: 3, StartTs: 2}))
+	s.updateDiskFull()
+	require.False(t, s.diskFull.Load())
+}
+
+func TestGetMetricsByTable(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), t.Name())
+	db, err := OpenPebble(1, dbPath, &config.DBConfig{Count: 1}, nil)
+	require.Nil(t, err)
+	defer func() { _ = db.Close() }()
+
+	cf := model.ChangeFeedID{Namespace: "default", ID: "test"}
+	s := New(cf, []*pebble.DB{db}, 0)
+	defer s.Close()
+
+	span := spanz.TableIDToComparableSpan(1)
+	s.AddTable(span)
+	resolvedTs := make(chan model.Ts)
+	s.OnResolve(func(_ tablepb.Span, ts model.Ts) { resolvedTs <- ts })
+
+	s.Add(span, model.NewPolymorphicEvent(&model.RawKVEntry{
+		OpType:  model.OpTypePut,
+		Key:     []byte{1},
+		StartTs: 1,
+		CRTs:    2,
+	}), model.NewPolymorphicEvent(&model.RawKVEntry{
+		OpType:  model.OpTypePut,
+		Key:     []byte{2},
+		StartTs: 1,
+		CRTs:    2,
+	}), model.NewResolvedPolymorphicEvent(0, 3))
+	timer := time.NewTimer(time.Second)
+	select {
+	case ts := <-resolvedTs:
+		require.Equal(t, model.Ts(3), ts)
+	case <-timer.C:
+		panic("must get a resolved timestamp instead of timeout")
+	}
+
+	iter := s.FetchByTable(span, engine.Position{}, engine.Position{CommitTs: 3, StartTs: 2})
+	for {
+		event, _, err := iter.Next()
+		require.Nil(t, err)
+		if event == nil {
+			break
+		}
+	}
+	require.Nil(t, iter.Close())
+	require.Nil(t, db.Flush())
+
+	metrics := s.GetMetricsByTable(span)
+	require.Equal(t, uint64(1), metrics.IterSeekLatency.Count)
+	require.Equal(t, uint64(2), metrics.IterNextLatency.Count)
+	require.Greater(t, metrics.ResolvedTsDelay, time.Duration(0))
+	require.Greater(t, metrics.OnDiskBytes, uint64(0))
+}
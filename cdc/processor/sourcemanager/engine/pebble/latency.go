@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+)
+
+const latencyWindowSize = 1024
+
+// latencyWindow keeps the recent latency samples of a table, which is cheaper
+// than a histogram with a table label, and is good enough to find out the
+// bottleneck of a table.
+type latencyWindow struct {
+	mu      sync.Mutex
+	samples [latencyWindowSize]time.Duration
+	count   uint64
+}
+
+func (w *latencyWindow) observe(d time.Duration) {
+	w.mu.Lock()
+	w.samples[w.count%latencyWindowSize] = d
+	w.count++
+	w.mu.Unlock()
+}
+
+func (w *latencyWindow) snapshot() engine.LatencyStats {
+	w.mu.Lock()
+	count := w.count
+	n := count
+	if n > latencyWindowSize {
+		n = latencyWindowSize
+	}
+	samples := make([]time.Duration, n)
+	copy(samples, w.samples[:n])
+	w.mu.Unlock()
+
+	stats := engine.LatencyStats{Count: count}
+	if n == 0 {
+		return stats
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	percentile := func(p float64) time.Duration {
+		return samples[int(float64(n-1)*p)]
+	}
+	stats.P50 = percentile(0.5)
+	stats.P90 = percentile(0.9)
+	stats.P99 = percentile(0.99)
+	stats.Max = samples[n-1]
+	return stats
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pebble
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyWindow(t *testing.T) {
+	w := &latencyWindow{}
+	require.Equal(t, engine.LatencyStats{}, w.snapshot())
+
+	for i := 1; i <= 100; i++ {
+		w.observe(time.Duration(i) * time.Millisecond)
+	}
+	require.Equal(t, engine.LatencyStats{
+		Count: 100,
+		P50:   50 * time.Millisecond,
+		P90:   90 * time.Millisecond,
+		P99:   99 * time.Millisecond,
+		Max:   100 * time.Millisecond,
+	}, w.snapshot())
+
+	// Old samples are discarded.
+	for i := 0; i < latencyWindowSize; i++ {
+		w.observe(time.Microsecond)
+	}
+	stats := w.snapshot()
+	require.Equal(t, uint64(100+latencyWindowSize), stats.Count)
+	require.Equal(t, time.Microsecond, stats.Max)
+}
@@ -15,10 +15,12 @@ package sourcemanager
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
@@ -52,6 +54,17 @@ type SourceManager struct {
 	errChan chan error
 	// Used to indicate whether the changefeed is in BDR mode.
 	bdrMode bool
+	// backpressure pauses the pullers if the sink can't keep up with them.
+	backpressure *pullerwrapper.Backpressure
+
+	// sharedTables is the capture level shared table cache, nil if disabled.
+	sharedTables *SharedTableCache
+	// sharedSubscriptions is the map from spans to the subscriptions of the
+	// tables subscribed from sharedTables.
+	sharedSubscriptions spanz.SyncMap
+	// onResolves are also notified by sharedTables.
+	onResolvesMu sync.RWMutex
+	onResolves   []func(tablepb.Span, model.Ts)
 
 	// pullerWrapperCreator is used to create a puller wrapper.
 	// Only used for testing.
@@ -63,13 +76,15 @@ type SourceManager struct {
 	) pullerwrapper.Wrapper
 }
 
-// New creates a new source manager.
+// New creates a new source manager. sharedTables can be nil if the shared
+// table cache is disabled.
 func New(
 	changefeedID model.ChangeFeedID,
 	up *upstream.Upstream,
 	mg entry.MounterGroup,
 	engine engine.SortEngine,
 	bdrMode bool,
+	sharedTables *SharedTableCache,
 ) *SourceManager {
 	return &SourceManager{
 		ready:                make(chan struct{}),
@@ -79,6 +94,8 @@ func New(
 		engine:               engine,
 		errChan:              make(chan error, 16),
 		bdrMode:              bdrMode,
+		backpressure:         pullerwrapper.NewBackpressure(),
+		sharedTables:         sharedTables,
 		pullerWrapperCreator: pullerwrapper.NewPullerWrapper,
 	}
 }
@@ -99,21 +116,52 @@ func NewForTest(
 		engine:               engine,
 		errChan:              make(chan error, 16),
 		bdrMode:              bdrMode,
+		backpressure:         pullerwrapper.NewBackpressure(),
 		pullerWrapperCreator: pullerwrapper.NewPullerWrapperForTest,
 	}
 }
 
 // AddTable adds a table to the source manager. Start puller and register table to the engine.
 func (m *SourceManager) AddTable(span tablepb.Span, tableName string, startTs model.Ts) {
+	if m.sharedTables != nil {
+		if sub, ok := m.sharedTables.subscribe(m, span, tableName, startTs); ok {
+			m.sharedSubscriptions.Store(span, sub)
+			return
+		}
+	}
 	// Add table to the engine first, so that the engine can receive the events from the puller.
 	m.engine.AddTable(span)
 	p := m.pullerWrapperCreator(m.changefeedID, span, tableName, startTs, m.bdrMode)
-	p.Start(m.ctx, m.up, m.engine, m.errChan)
+	p.Start(m.ctx, m.up, m.engine, m.errChan, m.backpressure)
 	m.pullers.Store(span, p)
 }
 
+// SetBackpressure pauses or resumes pulling events of the tables pulled by the
+// source manager. A table shared with other changefeeds is paused as long as
+// any of its subscribers is paused.
+func (m *SourceManager) SetBackpressure(paused bool) {
+	if m.backpressure.Set(paused) {
+		log.Info("Source manager backpressure changed",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Bool("paused", paused))
+		if m.sharedTables != nil {
+			m.sharedTables.onBackpressure(m)
+		}
+	}
+}
+
+// IsBackpressured returns whether pulling events is paused by the backpressure.
+func (m *SourceManager) IsBackpressured() bool {
+	return m.backpressure.Paused()
+}
+
 // RemoveTable removes a table from the source manager. Stop puller and unregister table from the engine.
 func (m *SourceManager) RemoveTable(span tablepb.Span) {
+	if _, ok := m.sharedSubscriptions.LoadAndDelete(span); ok {
+		m.sharedTables.unsubscribe(m, span)
+		return
+	}
 	if wrapper, ok := m.pullers.Load(span); ok {
 		wrapper.(pullerwrapper.Wrapper).Close()
 		m.pullers.Delete(span)
@@ -124,6 +172,26 @@ func (m *SourceManager) RemoveTable(span tablepb.Span) {
 // OnResolve just wrap the engine's OnResolve method.
 func (m *SourceManager) OnResolve(action func(tablepb.Span, model.Ts)) {
 	m.engine.OnResolve(action)
+	m.onResolvesMu.Lock()
+	m.onResolves = append(m.onResolves, action)
+	m.onResolvesMu.Unlock()
+}
+
+// notifyResolve is called by sharedTables after events of the table are resolved.
+func (m *SourceManager) notifyResolve(span tablepb.Span, ts model.Ts) {
+	m.onResolvesMu.RLock()
+	defer m.onResolvesMu.RUnlock()
+	for _, action := range m.onResolves {
+		action(span, ts)
+	}
+}
+
+// getEngine returns the engine storing the events of the table.
+func (m *SourceManager) getEngine(span tablepb.Span) engine.SortEngine {
+	if sub, ok := m.sharedSubscriptions.Load(span); ok {
+		return sub.(*sharedSubscription).engine
+	}
+	return m.engine
 }
 
 // FetchByTable just wrap the engine's FetchByTable method.
@@ -131,22 +199,28 @@ func (m *SourceManager) FetchByTable(
 	span tablepb.Span, lowerBound, upperBound engine.Position,
 	quota *memquota.MemQuota,
 ) *engine.MountedEventIter {
-	iter := m.engine.FetchByTable(span, lowerBound, upperBound)
+	iter := m.getEngine(span).FetchByTable(span, lowerBound, upperBound)
 	return engine.NewMountedEventIter(m.changefeedID, iter, m.mg, defaultMaxBatchSize, quota)
 }
 
 // CleanByTable just wrap the engine's CleanByTable method.
 func (m *SourceManager) CleanByTable(span tablepb.Span, upperBound engine.Position) error {
+	if _, ok := m.sharedSubscriptions.Load(span); ok {
+		return m.sharedTables.clean(m, span, upperBound)
+	}
 	return m.engine.CleanByTable(span, upperBound)
 }
 
 // GetTableResolvedTs returns the resolved ts of the table.
 func (m *SourceManager) GetTableResolvedTs(span tablepb.Span) model.Ts {
-	return m.engine.GetResolvedTs(span)
+	return m.getEngine(span).GetResolvedTs(span)
 }
 
 // GetTablePullerStats returns the puller stats of the table.
 func (m *SourceManager) GetTablePullerStats(span tablepb.Span) puller.Stats {
+	if sub, ok := m.sharedSubscriptions.Load(span); ok {
+		return sub.(*sharedSubscription).puller.GetStats()
+	}
 	p, ok := m.pullers.Load(span)
 	if !ok {
 		log.Panic("Table puller not found when getting table puller stats",
@@ -157,12 +231,39 @@ func (m *SourceManager) GetTablePullerStats(span tablepb.Span) puller.Stats {
 	return p.(pullerwrapper.Wrapper).GetStats()
 }
 
+// GetTableSlowestRegions returns at most n regions of the table with the
+// smallest resolved ts. It returns false if the table is not found.
+func (m *SourceManager) GetTableSlowestRegions(
+	span tablepb.Span, n int,
+) ([]kv.RegionFeedStat, bool) {
+	if sub, ok := m.sharedSubscriptions.Load(span); ok {
+		return sub.(*sharedSubscription).puller.GetSlowestRegions(n), true
+	}
+	p, ok := m.pullers.Load(span)
+	if !ok {
+		return nil, false
+	}
+	return p.(pullerwrapper.Wrapper).GetSlowestRegions(n), true
+}
+
 // GetTableSorterStats returns the sorter stats of the table.
 func (m *SourceManager) GetTableSorterStats(span tablepb.Span) engine.TableStats {
-	return m.engine.GetStatsByTable(span)
+	return m.getEngine(span).GetStatsByTable(span)
+}
+
+// GetTableSorterMetrics returns a snapshot of the sorter metrics of the table.
+// It returns false if the table is not found.
+func (m *SourceManager) GetTableSorterMetrics(span tablepb.Span) (engine.TableMetrics, bool) {
+	if _, ok := m.sharedSubscriptions.Load(span); !ok {
+		if _, ok := m.pullers.Load(span); !ok {
+			return engine.TableMetrics{}, false
+		}
+	}
+	return m.getEngine(span).GetMetricsByTable(span), true
 }
 
 // ReceivedEvents returns the number of events in the engine that have not been sent to the sink.
+// The events of the shared tables are not counted.
 func (m *SourceManager) ReceivedEvents() int64 {
 	return m.engine.ReceivedEvents()
 }
@@ -198,6 +299,11 @@ func (m *SourceManager) Close() {
 		value.(pullerwrapper.Wrapper).Close()
 		return true
 	})
+	m.sharedSubscriptions.Range(func(span tablepb.Span, _ interface{}) bool {
+		m.sharedTables.unsubscribe(m, span)
+		m.sharedSubscriptions.Delete(span)
+		return true
+	})
 	log.Info("All pullers have been closed",
 		zap.String("namespace", m.changefeedID.Namespace),
 		zap.String("changefeed", m.changefeedID.ID),
@@ -0,0 +1,80 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puller
+
+import (
+	"context"
+	"sync"
+)
+
+// Backpressure pauses pullers from adding events into the sort engine, so
+// that the pull rate adapts to the capacity of the downstream. A nil
+// Backpressure never pauses pullers.
+type Backpressure struct {
+	mu      sync.Mutex
+	paused  bool
+	resumed chan struct{}
+}
+
+// NewBackpressure creates a Backpressure.
+func NewBackpressure() *Backpressure {
+	return &Backpressure{}
+}
+
+// Set pauses or resumes the pullers. It returns whether the state changes.
+func (b *Backpressure) Set(paused bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.paused == paused {
+		return false
+	}
+	b.paused = paused
+	if paused {
+		b.resumed = make(chan struct{})
+	} else {
+		close(b.resumed)
+	}
+	return true
+}
+
+// Paused returns whether the pullers are paused.
+func (b *Backpressure) Paused() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.paused
+}
+
+// Wait blocks until the pullers are resumed or the context is done.
+func (b *Backpressure) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	if !b.paused {
+		b.mu.Unlock()
+		return nil
+	}
+	resumed := b.resumed
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-resumed:
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpressure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var nilBackpressure *Backpressure
+	require.False(t, nilBackpressure.Paused())
+	require.Nil(t, nilBackpressure.Wait(ctx))
+
+	b := NewBackpressure()
+	require.False(t, b.Paused())
+	require.Nil(t, b.Wait(ctx))
+
+	require.True(t, b.Set(true))
+	require.False(t, b.Set(true))
+	require.True(t, b.Paused())
+
+	done := make(chan error, 1)
+	go func() { done <- b.Wait(ctx) }()
+	select {
+	case <-done:
+		require.FailNow(t, "wait should be blocked by the backpressure")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.True(t, b.Set(false))
+	require.False(t, b.Set(false))
+	require.Nil(t, <-done)
+
+	require.True(t, b.Set(true))
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, b.Wait(cctx), context.Canceled)
+}
@@ -16,6 +16,7 @@ package puller
 import (
 	"context"
 
+	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -39,11 +40,16 @@ func NewPullerWrapperForTest(
 }
 
 func (d *dummyPullerWrapper) Start(ctx context.Context, up *upstream.Upstream,
-	eventSortEngine engine.SortEngine, errCh chan<- error) {
+	eventSortEngine engine.SortEngine, errCh chan<- error, backpressure *Backpressure,
+) {
 }
 
 func (d *dummyPullerWrapper) GetStats() puller.Stats {
 	return puller.Stats{}
 }
 
+func (d *dummyPullerWrapper) GetSlowestRegions(n int) []kv.RegionFeedStat {
+	return nil
+}
+
 func (d *dummyPullerWrapper) Close() {}
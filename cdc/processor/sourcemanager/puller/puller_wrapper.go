@@ -17,6 +17,7 @@ import (
 	"context"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
@@ -29,14 +30,18 @@ import (
 
 // Wrapper is a wrapper of puller used by source manager.
 type Wrapper interface {
-	// Start the puller and send internal errors into `errChan`.
+	// Start the puller and send internal errors into `errChan`. Events are
+	// not added into `eventSortEngine` while `backpressure` is paused.
 	Start(
 		ctx context.Context,
 		up *upstream.Upstream,
 		eventSortEngine engine.SortEngine,
 		errChan chan<- error,
+		backpressure *Backpressure,
 	)
 	GetStats() puller.Stats
+	// GetSlowestRegions returns at most n regions with the smallest resolved ts.
+	GetSlowestRegions(n int) []kv.RegionFeedStat
 	Close()
 }
 
@@ -79,6 +84,7 @@ func (n *WrapperImpl) Start(
 	up *upstream.Upstream,
 	eventSortEngine engine.SortEngine,
 	errChan chan<- error,
+	backpressure *Backpressure,
 ) {
 	ctx, n.cancel = context.WithCancel(ctx)
 	errorHandler := func(err error) {
@@ -98,6 +104,7 @@ func (n *WrapperImpl) Start(
 		ctx,
 		up.PDClient,
 		up.GrpcPool,
+		up.ScanLimiters,
 		up.RegionCache,
 		up.KVStorage,
 		up.PDClock,
@@ -127,6 +134,9 @@ func (n *WrapperImpl) Start(
 				if rawKV == nil {
 					continue
 				}
+				if err := backpressure.Wait(ctx); err != nil {
+					return nil
+				}
 				pEvent := model.NewPolymorphicEvent(rawKV)
 				eventSortEngine.Add(n.span, pEvent)
 			}
@@ -139,6 +149,11 @@ func (n *WrapperImpl) GetStats() puller.Stats {
 	return n.p.Stats()
 }
 
+// GetSlowestRegions returns at most n regions with the smallest resolved ts.
+func (n *WrapperImpl) GetSlowestRegions(limit int) []kv.RegionFeedStat {
+	return n.p.SlowestRegions(limit)
+}
+
 // Close the puller wrapper.
 func (n *WrapperImpl) Close() {
 	if n.cancel == nil {
@@ -0,0 +1,418 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourcemanager
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+	pullerwrapper "github.com/pingcap/tiflow/cdc/processor/sourcemanager/puller"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"go.uber.org/zap"
+)
+
+// sharedTableNamespace is the namespace of the sort engines created by the
+// shared table cache. It's not a valid changefeed namespace, so the engines
+// never conflict with the ones of changefeeds.
+const sharedTableNamespace = "_shared_table_cache"
+
+// EngineFactory creates and drops sort engines, it's implemented by
+// factory.SortEngineFactory.
+type EngineFactory interface {
+	Create(ID model.ChangeFeedID) (engine.SortEngine, error)
+	Drop(ID model.ChangeFeedID) error
+}
+
+// SharedTableCache is a capture level cache of tables, which is shared by all
+// changefeeds replicating the same table from the same upstream. The KV data
+// of a table is pulled and sorted only once, and fanned out to all changefeeds
+// subscribing the table. Every subscriber fetches events from its own position
+// and the sorted events are cleaned after all subscribers have consumed them.
+type SharedTableCache struct {
+	ctx     context.Context
+	factory EngineFactory
+
+	mu        sync.Mutex
+	upstreams map[uint64]*sharedUpstream
+
+	// pullerWrapperCreator is used to create a puller wrapper.
+	// Only used for testing.
+	pullerWrapperCreator func(changefeed model.ChangeFeedID,
+		span tablepb.Span,
+		tableName string,
+		startTs model.Ts,
+		bdrMode bool,
+	) pullerwrapper.Wrapper
+}
+
+type sharedUpstream struct {
+	id     model.ChangeFeedID
+	engine engine.SortEngine
+	tables *spanz.HashMap[*sharedTable]
+}
+
+type sharedTable struct {
+	span    tablepb.Span
+	startTs model.Ts
+	bdrMode bool
+	puller  pullerwrapper.Wrapper
+	errCh   chan error
+	cancel  context.CancelFunc
+	// backpressure pauses the shared puller if any subscriber is paused by
+	// its sink. The events can't be cleaned before the slowest subscriber
+	// consumes them, so pulling ahead of it only grows the sort engine.
+	backpressure *pullerwrapper.Backpressure
+
+	// resolvedTs is the latest resolved ts notified by the engine.
+	resolvedTs model.Ts
+	// cleaned is the position to which the events have been cleaned.
+	cleaned     engine.Position
+	subscribers map[*SourceManager]*engine.Position
+
+	// closeMu guards cleaning the table in the engine against closing it,
+	// which are both done without holding the lock of the cache.
+	closeMu sync.RWMutex
+	closed  bool
+}
+
+// sharedSubscription is a table subscribed by a source manager.
+type sharedSubscription struct {
+	engine engine.SortEngine
+	puller pullerwrapper.Wrapper
+}
+
+// NewSharedTableCache creates a SharedTableCache. The pullers of the shared
+// tables run until ctx is canceled or Close is called.
+func NewSharedTableCache(ctx context.Context, factory EngineFactory) *SharedTableCache {
+	return &SharedTableCache{
+		ctx:                  ctx,
+		factory:              factory,
+		upstreams:            make(map[uint64]*sharedUpstream),
+		pullerWrapperCreator: pullerwrapper.NewPullerWrapper,
+	}
+}
+
+// subscribe subscribes the table for the source manager. It returns false if
+// the table can't be shared, e.g. the events after startTs have been cleaned,
+// in which case the source manager should pull the table by itself.
+func (c *SharedTableCache) subscribe(
+	m *SourceManager, span tablepb.Span, tableName string, startTs model.Ts,
+) (*sharedSubscription, bool) {
+	c.mu.Lock()
+	su, err := c.getOrCreateUpstream(m.up)
+	if err != nil {
+		c.mu.Unlock()
+		log.Warn("Fail to create sort engine for shared tables, pull the table directly",
+			zap.String("namespace", m.changefeedID.Namespace),
+			zap.String("changefeed", m.changefeedID.ID),
+			zap.Stringer("span", &span),
+			zap.Error(err))
+		return nil, false
+	}
+
+	table, exists := su.tables.Get(span)
+	if exists {
+		// Events in (startTs, ...] are required by the new subscriber.
+		if table.bdrMode != m.bdrMode || table.startTs > startTs || table.cleaned.CommitTs > startTs {
+			c.mu.Unlock()
+			log.Info("Table can't be shared, pull the table directly",
+				zap.String("namespace", m.changefeedID.Namespace),
+				zap.String("changefeed", m.changefeedID.ID),
+				zap.Stringer("span", &span),
+				zap.Uint64("startTs", startTs),
+				zap.Uint64("sharedStartTs", table.startTs),
+				zap.Uint64("sharedCleanedTs", table.cleaned.CommitTs))
+			return nil, false
+		}
+	} else {
+		table = &sharedTable{
+			span:         span,
+			startTs:      startTs,
+			bdrMode:      m.bdrMode,
+			errCh:        make(chan error, 16),
+			backpressure: pullerwrapper.NewBackpressure(),
+			subscribers:  make(map[*SourceManager]*engine.Position),
+		}
+		su.engine.AddTable(span)
+		table.puller = c.pullerWrapperCreator(su.id, span, tableName, startTs, m.bdrMode)
+		table.puller.Start(c.ctx, m.up, su.engine, table.errCh, table.backpressure)
+		var ctx context.Context
+		ctx, table.cancel = context.WithCancel(c.ctx)
+		go c.forwardErrors(ctx, table)
+		su.tables.ReplaceOrInsert(span, table)
+	}
+	table.subscribers[m] = &engine.Position{}
+	table.updateBackpressure()
+	resolvedTs := table.resolvedTs
+	sub := &sharedSubscription{engine: su.engine, puller: table.puller}
+	c.mu.Unlock()
+
+	log.Info("Subscribe shared table",
+		zap.String("namespace", m.changefeedID.Namespace),
+		zap.String("changefeed", m.changefeedID.ID),
+		zap.Stringer("span", &span),
+		zap.Uint64("startTs", startTs),
+		zap.Bool("created", !exists))
+	// Notify the events resolved before the subscription.
+	if resolvedTs != 0 {
+		m.notifyResolve(span, resolvedTs)
+	}
+	return sub, true
+}
+
+func (c *SharedTableCache) getOrCreateUpstream(up *upstream.Upstream) (*sharedUpstream, error) {
+	if su, ok := c.upstreams[up.ID]; ok {
+		return su, nil
+	}
+	id := model.ChangeFeedID{
+		Namespace: sharedTableNamespace,
+		ID:        strconv.FormatUint(up.ID, 10),
+	}
+	e, err := c.factory.Create(id)
+	if err != nil {
+		return nil, err
+	}
+	upstreamID := up.ID
+	e.OnResolve(func(span tablepb.Span, ts model.Ts) {
+		c.onResolve(upstreamID, span, ts)
+	})
+	su := &sharedUpstream{id: id, engine: e, tables: spanz.NewHashMap[*sharedTable]()}
+	c.upstreams[up.ID] = su
+	return su, nil
+}
+
+func (c *SharedTableCache) onResolve(upstreamID uint64, span tablepb.Span, ts model.Ts) {
+	c.mu.Lock()
+	su, ok := c.upstreams[upstreamID]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	table, ok := su.tables.Get(span)
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	if ts > table.resolvedTs {
+		table.resolvedTs = ts
+	}
+	subscribers := make([]*SourceManager, 0, len(table.subscribers))
+	for m := range table.subscribers {
+		subscribers = append(subscribers, m)
+	}
+	c.mu.Unlock()
+
+	for _, m := range subscribers {
+		m.notifyResolve(span, ts)
+	}
+}
+
+// forwardErrors forwards the errors of the shared puller to all subscribers,
+// each of which will fail and unsubscribe the table.
+func (c *SharedTableCache) forwardErrors(ctx context.Context, table *sharedTable) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-table.errCh:
+			c.mu.Lock()
+			errChans := make([]chan error, 0, len(table.subscribers))
+			for m := range table.subscribers {
+				errChans = append(errChans, m.errChan)
+			}
+			c.mu.Unlock()
+			for _, errChan := range errChans {
+				select {
+				case errChan <- err:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// clean cleans the events of the table consumed by the source manager, the
+// events are removed from the engine after all subscribers consumed them.
+// It's a no-op if the table has been unsubscribed concurrently.
+func (c *SharedTableCache) clean(
+	m *SourceManager, span tablepb.Span, upperBound engine.Position,
+) error {
+	c.mu.Lock()
+	su, ok := c.upstreams[m.up.ID]
+	if !ok {
+		c.mu.Unlock()
+		return nil
+	}
+	table, ok := su.tables.Get(span)
+	if !ok {
+		c.mu.Unlock()
+		return nil
+	}
+	cleaned, ok := table.subscribers[m]
+	if !ok {
+		c.mu.Unlock()
+		return nil
+	}
+	if cleaned.Compare(upperBound) < 0 {
+		*cleaned = upperBound
+	}
+	toClean, ok := table.advanceCleaned()
+	c.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return table.cleanEngine(su.engine, toClean)
+}
+
+// cleanEngine cleans the table in the engine, unless the table has been
+// closed by the last subscriber concurrently.
+func (t *sharedTable) cleanEngine(e engine.SortEngine, toClean engine.Position) error {
+	t.closeMu.RLock()
+	defer t.closeMu.RUnlock()
+	if t.closed {
+		return nil
+	}
+	return e.CleanByTable(t.span, toClean)
+}
+
+// advanceCleaned returns the new position to clean the table to, which is the
+// minimal cleaned position of all subscribers.
+func (t *sharedTable) advanceCleaned() (engine.Position, bool) {
+	var minCleaned *engine.Position
+	for _, cleaned := range t.subscribers {
+		if minCleaned == nil || cleaned.Compare(*minCleaned) < 0 {
+			minCleaned = cleaned
+		}
+	}
+	if minCleaned == nil || !minCleaned.Valid() || minCleaned.Compare(t.cleaned) <= 0 {
+		return engine.Position{}, false
+	}
+	t.cleaned = *minCleaned
+	return t.cleaned, true
+}
+
+// updateBackpressure pauses the shared puller if any subscriber is paused,
+// and resumes it otherwise.
+func (t *sharedTable) updateBackpressure() {
+	paused := false
+	for m := range t.subscribers {
+		if m.IsBackpressured() {
+			paused = true
+			break
+		}
+	}
+	t.backpressure.Set(paused)
+}
+
+// onBackpressure updates the backpressure of the tables subscribed by the
+// source manager, it's called after the backpressure of it changes.
+func (c *SharedTableCache) onBackpressure(m *SourceManager) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	su, ok := c.upstreams[m.up.ID]
+	if !ok {
+		return
+	}
+	su.tables.Range(func(_ tablepb.Span, table *sharedTable) bool {
+		if _, ok := table.subscribers[m]; ok {
+			table.updateBackpressure()
+		}
+		return true
+	})
+}
+
+// unsubscribe unsubscribes the table for the source manager. The shared puller
+// is closed if there are no subscribers of the table.
+func (c *SharedTableCache) unsubscribe(m *SourceManager, span tablepb.Span) {
+	c.mu.Lock()
+	su, ok := c.upstreams[m.up.ID]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	table, ok := su.tables.Get(span)
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	delete(table.subscribers, m)
+	if len(table.subscribers) != 0 {
+		table.updateBackpressure()
+		toClean, ok := table.advanceCleaned()
+		c.mu.Unlock()
+		if ok {
+			if err := table.cleanEngine(su.engine, toClean); err != nil {
+				log.Warn("Fail to clean shared table",
+					zap.Stringer("span", &span), zap.Error(err))
+			}
+		}
+		return
+	}
+	su.tables.Delete(span)
+	dropEngine := su.tables.Len() == 0
+	if dropEngine {
+		delete(c.upstreams, m.up.ID)
+	}
+	c.mu.Unlock()
+
+	// The puller and engine must be closed without holding the lock, since
+	// the engine may be notifying resolved ts to the cache. The same applies
+	// to cleaning the engine.
+	c.closeTable(su, table, dropEngine)
+	log.Info("Shared table is closed since there are no subscribers",
+		zap.Stringer("span", &span))
+}
+
+func (c *SharedTableCache) closeTable(su *sharedUpstream, table *sharedTable, dropEngine bool) {
+	table.puller.Close()
+	table.cancel()
+	table.closeMu.Lock()
+	table.closed = true
+	su.engine.RemoveTable(table.span)
+	table.closeMu.Unlock()
+	if dropEngine {
+		if err := c.factory.Drop(su.id); err != nil {
+			log.Warn("Fail to drop sort engine of shared tables",
+				zap.String("namespace", su.id.Namespace),
+				zap.String("changefeed", su.id.ID),
+				zap.Error(err))
+		}
+	}
+}
+
+// Close closes all shared tables.
+func (c *SharedTableCache) Close() {
+	c.mu.Lock()
+	upstreams := c.upstreams
+	c.upstreams = make(map[uint64]*sharedUpstream)
+	c.mu.Unlock()
+
+	for _, su := range upstreams {
+		var tables []*sharedTable
+		su.tables.Range(func(_ tablepb.Span, table *sharedTable) bool {
+			tables = append(tables, table)
+			return true
+		})
+		for i, table := range tables {
+			c.closeTable(su, table, i == len(tables)-1)
+		}
+	}
+}
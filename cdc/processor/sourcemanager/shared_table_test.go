@@ -0,0 +1,219 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourcemanager
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/memory"
+	pullerwrapper "github.com/pingcap/tiflow/cdc/processor/sourcemanager/puller"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEngineFactory struct {
+	engines map[model.ChangeFeedID]engine.SortEngine
+	dropped int
+}
+
+func (f *mockEngineFactory) Create(ID model.ChangeFeedID) (engine.SortEngine, error) {
+	e := memory.New(context.Background())
+	f.engines[ID] = e
+	return e, nil
+}
+
+func (f *mockEngineFactory) Drop(ID model.ChangeFeedID) error {
+	delete(f.engines, ID)
+	f.dropped++
+	return nil
+}
+
+func newSourceManagerWithSharedTables(
+	id string, up *upstream.Upstream, bdrMode bool, cache *SharedTableCache,
+) (*SourceManager, *sync.Map) {
+	m := New(model.DefaultChangeFeedID(id), up, nil,
+		memory.New(context.Background()), bdrMode, cache)
+	m.pullerWrapperCreator = pullerwrapper.NewPullerWrapperForTest
+	resolved := &sync.Map{}
+	m.OnResolve(func(span tablepb.Span, ts model.Ts) {
+		resolved.Store(span.TableID, ts)
+	})
+	return m, resolved
+}
+
+func TestSharedTableCache(t *testing.T) {
+	t.Parallel()
+
+	factory := &mockEngineFactory{engines: make(map[model.ChangeFeedID]engine.SortEngine)}
+	cache := NewSharedTableCache(context.Background(), factory)
+	cache.pullerWrapperCreator = pullerwrapper.NewPullerWrapperForTest
+	defer cache.Close()
+
+	up := &upstream.Upstream{ID: 1}
+	span := spanz.TableIDToComparableSpan(1)
+	m1, resolved1 := newSourceManagerWithSharedTables("cf1", up, false, cache)
+	m2, resolved2 := newSourceManagerWithSharedTables("cf2", up, false, cache)
+
+	// Both changefeeds subscribe the same table, which is pulled only once.
+	m1.AddTable(span, "t", 10)
+	m2.AddTable(span, "t", 12)
+	require.Len(t, factory.engines, 1)
+	_, ok := m1.sharedSubscriptions.Load(span)
+	require.True(t, ok)
+	_, ok = m2.sharedSubscriptions.Load(span)
+	require.True(t, ok)
+
+	// Resolved events are fanned out to all subscribers.
+	sharedEngine := cache.upstreams[up.ID].engine
+	sharedEngine.Add(span, model.NewResolvedPolymorphicEvent(0, 20))
+	ts, _ := resolved1.Load(span.TableID)
+	require.Equal(t, model.Ts(20), ts)
+	ts, _ = resolved2.Load(span.TableID)
+	require.Equal(t, model.Ts(20), ts)
+	require.Equal(t, model.Ts(20), m2.GetTableResolvedTs(span))
+
+	// A changefeed in a different mode can't share the table.
+	m3, _ := newSourceManagerWithSharedTables("cf3", up, true, cache)
+	m3.AddTable(span, "t", 12)
+	_, ok = m3.sharedSubscriptions.Load(span)
+	require.False(t, ok)
+	m3.RemoveTable(span)
+
+	// Events are cleaned only after all subscribers consumed them.
+	require.NoError(t, m1.CleanByTable(span, engine.GenCommitFence(18)))
+	table := cache.upstreams[up.ID].tables.GetV(span)
+	require.False(t, table.cleaned.Valid())
+	require.NoError(t, m2.CleanByTable(span, engine.GenCommitFence(15)))
+	require.Equal(t, engine.GenCommitFence(15), table.cleaned)
+
+	// Events after 13 have been cleaned, so a changefeed starting from 13 can't
+	// share the table.
+	m4, _ := newSourceManagerWithSharedTables("cf4", up, false, cache)
+	m4.AddTable(span, "t", 13)
+	_, ok = m4.sharedSubscriptions.Load(span)
+	require.False(t, ok)
+	m4.RemoveTable(span)
+
+	// The new subscriber is notified with the resolved ts immediately.
+	m5, resolved5 := newSourceManagerWithSharedTables("cf5", up, false, cache)
+	m5.AddTable(span, "t", 16)
+	ts, _ = resolved5.Load(span.TableID)
+	require.Equal(t, model.Ts(20), ts)
+
+	// Unsubscribing advances the cleaned position.
+	m2.RemoveTable(span)
+	m5.RemoveTable(span)
+	require.Equal(t, engine.GenCommitFence(18), table.cleaned)
+
+	// The shared table is closed after all subscribers leave.
+	m1.RemoveTable(span)
+	require.Len(t, factory.engines, 0)
+	require.Equal(t, 1, factory.dropped)
+}
+
+func TestSharedTableBackpressure(t *testing.T) {
+	t.Parallel()
+
+	factory := &mockEngineFactory{engines: make(map[model.ChangeFeedID]engine.SortEngine)}
+	cache := NewSharedTableCache(context.Background(), factory)
+	cache.pullerWrapperCreator = pullerwrapper.NewPullerWrapperForTest
+	defer cache.Close()
+
+	up := &upstream.Upstream{ID: 1}
+	span := spanz.TableIDToComparableSpan(1)
+	m1, _ := newSourceManagerWithSharedTables("cf1", up, false, cache)
+	m2, _ := newSourceManagerWithSharedTables("cf2", up, false, cache)
+	m1.AddTable(span, "t", 10)
+	m2.AddTable(span, "t", 10)
+	table := cache.upstreams[up.ID].tables.GetV(span)
+	require.False(t, table.backpressure.Paused())
+
+	// The shared puller is paused if any subscriber is paused.
+	m1.SetBackpressure(true)
+	require.True(t, table.backpressure.Paused())
+	m2.SetBackpressure(true)
+	m1.SetBackpressure(false)
+	require.True(t, table.backpressure.Paused())
+	m2.SetBackpressure(false)
+	require.False(t, table.backpressure.Paused())
+
+	// A paused subscriber pauses the table once it subscribes, and the table
+	// is resumed after it unsubscribes.
+	m3, _ := newSourceManagerWithSharedTables("cf3", up, false, cache)
+	m3.SetBackpressure(true)
+	m3.AddTable(span, "t", 10)
+	require.True(t, table.backpressure.Paused())
+	m3.RemoveTable(span)
+	require.False(t, table.backpressure.Paused())
+
+	m1.RemoveTable(span)
+	m2.RemoveTable(span)
+}
+
+func TestSharedTableCleanRaceWithRemove(t *testing.T) {
+	t.Parallel()
+
+	factory := &mockEngineFactory{engines: make(map[model.ChangeFeedID]engine.SortEngine)}
+	cache := NewSharedTableCache(context.Background(), factory)
+	cache.pullerWrapperCreator = pullerwrapper.NewPullerWrapperForTest
+	defer cache.Close()
+
+	up := &upstream.Upstream{ID: 1}
+	span := spanz.TableIDToComparableSpan(1)
+	m1, _ := newSourceManagerWithSharedTables("cf1", up, false, cache)
+	m2, _ := newSourceManagerWithSharedTables("cf2", up, false, cache)
+
+	// Cleaning a table which has been unsubscribed is a no-op, whether the
+	// subscriber, the table or the whole upstream is gone.
+	m1.AddTable(span, "t", 10)
+	m2.AddTable(span, "t", 10)
+	m1.RemoveTable(span)
+	require.NoError(t, cache.clean(m1, span, engine.GenCommitFence(15)))
+	m2.RemoveTable(span)
+	require.NoError(t, cache.clean(m2, span, engine.GenCommitFence(15)))
+	m3, _ := newSourceManagerWithSharedTables("cf3", up, false, cache)
+	m3.AddTable(spanz.TableIDToComparableSpan(2), "t2", 10)
+	require.NoError(t, cache.clean(m3, span, engine.GenCommitFence(15)))
+	m3.RemoveTable(spanz.TableIDToComparableSpan(2))
+
+	// CleanByTable may find the subscription before RemoveTable deletes it,
+	// and clean the shared table after it's unsubscribed.
+	for i := 0; i < 100; i++ {
+		m1.AddTable(span, "t", 10)
+		m2.AddTable(span, "t", 10)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for ts := uint64(11); ts < 20; ts++ {
+				require.NoError(t, cache.clean(m1, span, engine.GenCommitFence(ts)))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// m1 becomes the only subscriber, so its cleaning reaches the
+			// engine while the table is being closed.
+			m2.RemoveTable(span)
+			m1.RemoveTable(span)
+		}()
+		wg.Wait()
+	}
+	require.Len(t, factory.engines, 0)
+}
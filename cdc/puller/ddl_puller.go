@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/tikv"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/zap"
@@ -47,6 +48,7 @@ import (
 
 const (
 	ddlPullerStuckWarnDuration = 30 * time.Second
+	ddlPullerLagUpdateInterval = time.Second
 	// DDLPullerTableName is the fake table name for ddl puller
 	DDLPullerTableName = "DDL_PULLER"
 	// ddl puller should never filter any DDL jobs even if
@@ -81,6 +83,11 @@ type ddlJobPullerImpl struct {
 	jobMetaColumnID           int64
 	outputCh                  chan *model.DDLJobEntry
 	metricDiscardedDDLCounter prometheus.Counter
+
+	// pdClock and metricResolvedTsLag are used to surface the lag of the DDL
+	// puller, which can be nil in tests.
+	pdClock             pdutil.Clock
+	metricResolvedTsLag prometheus.Gauge
 }
 
 // Run starts the DDLJobPuller.
@@ -143,14 +150,47 @@ func (p *ddlJobPullerImpl) Run(ctx context.Context, _ ...chan<- error) error {
 				}
 			}
 		})
+	eg.Go(func() error {
+		ticker := time.NewTicker(ddlPullerLagUpdateInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+				p.updateResolvedTsLag()
+			}
+		}
+	})
 	return eg.Wait()
 }
 
+// updateResolvedTsLag updates the lag of the DDL puller, which is surfaced
+// separately from the table pullers because DDLs block the barriers.
+func (p *ddlJobPullerImpl) updateResolvedTsLag() {
+	if p.pdClock == nil || p.metricResolvedTsLag == nil {
+		return
+	}
+	resolvedTs := p.getResolvedTs()
+	if resolvedTs == 0 {
+		return
+	}
+	now, err := p.pdClock.CurrentTime()
+	if err != nil {
+		return
+	}
+	lag := now.Sub(oracle.GetTimeFromTS(resolvedTs))
+	p.metricResolvedTsLag.Set(lag.Seconds())
+}
+
 // WaitForReady implements util.Runnable.
 func (p *ddlJobPullerImpl) WaitForReady(_ context.Context) {}
 
 // Close implements util.Runnable.
-func (p *ddlJobPullerImpl) Close() {}
+func (p *ddlJobPullerImpl) Close() {
+	ddlPullerResolvedTsLagGauge.DeleteLabelValues(
+		p.changefeedID.Namespace, p.changefeedID.ID)
+}
 
 // Output the DDL job entry, it contains the DDL job and the error.
 func (p *ddlJobPullerImpl) Output() <-chan *model.DDLJobEntry {
@@ -481,6 +521,9 @@ func NewDDLJobPuller(
 			ctx,
 			pdCli,
 			grpcPool,
+			// the incremental scan of the ddl puller is tiny and it should
+			// never be held back by the scans of tables.
+			nil,
 			regionCache,
 			kvStorage,
 			pdClock,
@@ -496,6 +539,9 @@ func NewDDLJobPuller(
 		outputCh:  make(chan *model.DDLJobEntry, defaultPullerOutputChanSize),
 		metricDiscardedDDLCounter: discardedDDLCounter.
 			WithLabelValues(changefeed.Namespace, changefeed.ID),
+		pdClock: pdClock,
+		metricResolvedTsLag: ddlPullerResolvedTsLagGauge.
+			WithLabelValues(changefeed.Namespace, changefeed.ID),
 	}, nil
 }
 
@@ -520,6 +566,13 @@ type ddlPullerImpl struct {
 	lastDDLJobID   int64
 	cancel         context.CancelFunc
 
+	// memoryQuota bounds pendingBytes, the approximate size of pendingDDLJobs.
+	// The DDL puller stops receiving from ddlJobPuller once it's exhausted,
+	// and quotaReleased is notified when pending DDL jobs are popped.
+	memoryQuota   uint64
+	pendingBytes  uint64
+	quotaReleased chan struct{}
+
 	changefeedID model.ChangeFeedID
 
 	clock                      clock.Clock
@@ -540,18 +593,19 @@ func NewDDLPuller(ctx context.Context,
 
 	var puller DDLJobPuller
 	var err error
+	kvCfg := config.GetGlobalServerConfig().KVClient
 	storage := up.KVStorage
 	// storage can be nil only in the test
 	if storage != nil {
 		puller, err = NewDDLJobPuller(
 			ctx,
 			up.PDClient,
-			up.GrpcPool,
+			up.DDLGrpcPool,
 			up.RegionCache,
 			storage,
 			up.PDClock,
 			startTs,
-			config.GetGlobalServerConfig().KVClient,
+			kvCfg,
 			changefeed,
 			schemaStorage,
 			filter,
@@ -562,11 +616,13 @@ func NewDDLPuller(ctx context.Context,
 	}
 
 	return &ddlPullerImpl{
-		ddlJobPuller: puller,
-		resolvedTS:   startTs,
-		cancel:       func() {},
-		clock:        clock.New(),
-		changefeedID: changefeed,
+		ddlJobPuller:  puller,
+		resolvedTS:    startTs,
+		cancel:        func() {},
+		memoryQuota:   kvCfg.DDLPullerMemoryQuota,
+		quotaReleased: make(chan struct{}, 1),
+		clock:         clock.New(),
+		changefeedID:  changefeed,
 	}, nil
 }
 
@@ -605,10 +661,34 @@ func (h *ddlPullerImpl) handleDDLJobEntry(jobEntry *model.DDLJobEntry) error {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	h.pendingDDLJobs = append(h.pendingDDLJobs, job)
+	h.pendingBytes += approximateDDLJobSize(job)
 	h.lastDDLJobID = job.ID
 	return nil
 }
 
+// approximateDDLJobSize returns the approximate memory used by a DDL job,
+// which is dominated by its query, arguments and table info.
+func approximateDDLJobSize(job *timodel.Job) uint64 {
+	size := uint64(len(job.Query) + len(job.RawArgs))
+	if job.BinlogInfo != nil && job.BinlogInfo.TableInfo != nil {
+		for _, col := range job.BinlogInfo.TableInfo.Columns {
+			size += uint64(len(col.Name.O) + len(col.Comment) + 128)
+		}
+		for _, idx := range job.BinlogInfo.TableInfo.Indices {
+			size += uint64(len(idx.Name.O) + len(idx.Columns)*64)
+		}
+	}
+	return size
+}
+
+// memoryExhausted returns true if the pending DDL jobs use up the memory
+// quota, and a zero quota means no limit.
+func (h *ddlPullerImpl) memoryExhausted() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.memoryQuota > 0 && h.pendingBytes >= h.memoryQuota
+}
+
 // Run the ddl puller to receive DDL events
 func (h *ddlPullerImpl) Run(ctx context.Context) error {
 	g, ctx := errgroup.WithContext(ctx)
@@ -626,9 +706,15 @@ func (h *ddlPullerImpl) Run(ctx context.Context) error {
 	g.Go(func() error {
 		h.lastResolvedTsAdvancedTime = h.clock.Now()
 		for {
+			outputCh := h.ddlJobPuller.Output()
+			if h.memoryExhausted() {
+				// Stop receiving until some pending DDL jobs are popped.
+				outputCh = nil
+			}
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
+			case <-h.quotaReleased:
 			case <-ticker.C:
 				duration := h.clock.Since(h.lastResolvedTsAdvancedTime)
 				if duration > ddlPullerStuckWarnDuration {
@@ -638,7 +724,7 @@ func (h *ddlPullerImpl) Run(ctx context.Context) error {
 						zap.Duration("duration", duration),
 						zap.Uint64("resolvedTs", atomic.LoadUint64(&h.resolvedTS)))
 				}
-			case e := <-h.ddlJobPuller.Output():
+			case e := <-outputCh:
 				if err := h.handleDDLJobEntry(e); err != nil {
 					return errors.Trace(err)
 				}
@@ -663,6 +749,15 @@ func (h *ddlPullerImpl) PopFrontDDL() (uint64, *timodel.Job) {
 	}
 	job := h.pendingDDLJobs[0]
 	h.pendingDDLJobs = h.pendingDDLJobs[1:]
+	size := approximateDDLJobSize(job)
+	if size > h.pendingBytes {
+		size = h.pendingBytes
+	}
+	h.pendingBytes -= size
+	select {
+	case h.quotaReleased <- struct{}{}:
+	default:
+	}
 	return job.BinlogInfo.FinishedTS, job
 }
 
@@ -672,6 +767,9 @@ func (h *ddlPullerImpl) Close() {
 		zap.String("namespace", h.changefeedID.Namespace),
 		zap.String("changefeed", h.changefeedID.ID))
 	h.cancel()
+	if h.ddlJobPuller != nil {
+		h.ddlJobPuller.Close()
+	}
 }
 
 func (h *ddlPullerImpl) ResolvedTs() uint64 {
@@ -37,10 +37,14 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cdcContext "github.com/pingcap/tiflow/pkg/context"
 	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/retry"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 )
@@ -86,6 +90,10 @@ func (m *mockPuller) Stats() Stats {
 	return Stats{}
 }
 
+func (m *mockPuller) SlowestRegions(n int) []kv.RegionFeedStat {
+	return nil
+}
+
 func (m *mockPuller) append(e *model.RawKVEntry) {
 	m.inCh <- e
 }
@@ -647,6 +655,117 @@ func TestDDLPuller(t *testing.T) {
 	require.Nil(t, ddl)
 }
 
+func TestDDLPullerMemoryQuota(t *testing.T) {
+	startTs := uint64(10)
+	mockPuller := newMockPuller(t, startTs)
+	ctx := cdcContext.NewBackendContext4Test(true)
+	up := upstream.NewUpstream4Test(nil)
+	f, err := filter.NewFilter(config.GetDefaultReplicaConfig(), "")
+	require.Nil(t, err)
+	schemaStorage, err := entry.NewSchemaStorage(nil,
+		startTs,
+		ctx.ChangefeedVars().Info.Config.ForceReplicate,
+		ctx.ChangefeedVars().ID,
+		util.RoleTester,
+		f,
+	)
+	require.Nil(t, err)
+	p, err := NewDDLPuller(
+		ctx, ctx.ChangefeedVars().Info.Config,
+		up, startTs,
+		ctx.ChangefeedVars().ID,
+		schemaStorage,
+		f)
+	require.Nil(t, err)
+	impl := p.(*ddlPullerImpl)
+	// Any pending DDL job exhausts the quota.
+	impl.memoryQuota = 1
+	impl.ddlJobPuller, _ = newMockDDLJobPuller(t, mockPuller, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := p.Run(ctx)
+		require.True(t, errors.ErrorEqual(err, context.Canceled))
+	}()
+	defer wg.Wait()
+	defer p.Close()
+
+	pendingJobs := func() int {
+		impl.mu.Lock()
+		defer impl.mu.Unlock()
+		return len(impl.pendingDDLJobs)
+	}
+	mockPuller.appendDDL(&timodel.Job{
+		ID:         1,
+		Type:       timodel.ActionCreateTable,
+		StartTS:    5,
+		State:      timodel.JobStateDone,
+		BinlogInfo: &timodel.HistoryInfo{SchemaVersion: 1, FinishedTS: 16},
+		Query:      "create table test.t1(id int)",
+	})
+	mockPuller.appendDDL(&timodel.Job{
+		ID:         2,
+		Type:       timodel.ActionCreateTable,
+		StartTS:    5,
+		State:      timodel.JobStateDone,
+		BinlogInfo: &timodel.HistoryInfo{SchemaVersion: 2, FinishedTS: 18},
+		Query:      "create table test.t2(id int)",
+	})
+	mockPuller.appendResolvedTs(20)
+
+	require.Eventually(t, func() bool { return pendingJobs() == 1 },
+		5*time.Second, 10*time.Millisecond)
+	// The second job is held back until the first one is popped.
+	require.Never(t, func() bool { return pendingJobs() > 1 },
+		200*time.Millisecond, 10*time.Millisecond)
+	require.True(t, impl.memoryExhausted())
+
+	_, ddl := p.PopFrontDDL()
+	require.Equal(t, int64(1), ddl.ID)
+	require.Eventually(t, func() bool { return pendingJobs() == 1 },
+		5*time.Second, 10*time.Millisecond)
+	_, ddl = p.PopFrontDDL()
+	require.Equal(t, int64(2), ddl.ID)
+	waitResolvedTsGrowing(t, p, 20)
+	require.False(t, impl.memoryExhausted())
+}
+
+func TestDDLJobPullerResolvedTsLag(t *testing.T) {
+	changefeedID := model.DefaultChangeFeedID("test-ddl-puller-lag")
+	p := &ddlJobPullerImpl{
+		changefeedID: changefeedID,
+		pdClock:      pdutil.NewClock4Test(),
+		metricResolvedTsLag: ddlPullerResolvedTsLagGauge.
+			WithLabelValues(changefeedID.Namespace, changefeedID.ID),
+	}
+	lag := func() float64 {
+		var m dto.Metric
+		require.Nil(t, p.metricResolvedTsLag.Write(&m))
+		return m.GetGauge().GetValue()
+	}
+	countMetrics := func() int {
+		ch := make(chan prometheus.Metric, 1024)
+		ddlPullerResolvedTsLagGauge.Collect(ch)
+		close(ch)
+		return len(ch)
+	}
+
+	// The lag is unknown before the first resolved ts.
+	p.updateResolvedTsLag()
+	require.Equal(t, float64(0), lag())
+
+	p.setResolvedTs(oracle.GoTimeToTS(time.Now().Add(-time.Minute)))
+	p.updateResolvedTsLag()
+	require.GreaterOrEqual(t, lag(), float64(60))
+
+	// Closing the puller removes its lag from the metrics.
+	count := countMetrics()
+	p.Close()
+	require.Equal(t, count-1, countMetrics())
+}
+
 func TestResolvedTsStuck(t *testing.T) {
 	// For observing the logs
 	zapcore, logs := observer.New(zap.WarnLevel)
@@ -69,6 +69,13 @@ var (
 			Name:      "discarded_ddl_count",
 			Help:      "The total count of ddl job that are discarded in ddl puller.",
 		}, []string{"namespace", "changefeed"})
+	ddlPullerResolvedTsLagGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "puller",
+			Name:      "ddl_puller_resolved_ts_lag",
+			Help:      "The lag of the resolved ts of ddl puller in seconds.",
+		}, []string{"namespace", "changefeed"})
 )
 
 // InitMetrics registers all metrics in this file
@@ -80,4 +87,5 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(outputChanSizeHistogram)
 	registry.MustRegister(eventChanSizeHistogram)
 	registry.MustRegister(discardedDDLCounter)
+	registry.MustRegister(ddlPullerResolvedTsLagGauge)
 }
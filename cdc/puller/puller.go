@@ -57,6 +57,8 @@ type Puller interface {
 	Run(ctx context.Context) error
 	Output() <-chan *model.RawKVEntry
 	Stats() Stats
+	// SlowestRegions returns at most n regions with the smallest resolved ts.
+	SlowestRegions(n int) []kv.RegionFeedStat
 }
 
 type pullerImpl struct {
@@ -79,6 +81,7 @@ type pullerImpl struct {
 func New(ctx context.Context,
 	pdCli pd.Client,
 	grpcPool kv.GrpcPool,
+	scanLimiters *kv.ScanLimiters,
 	regionCache *tikv.RegionCache,
 	kvStorage tidbkv.Storage,
 	pdClock pdutil.Clock,
@@ -106,7 +109,8 @@ func New(ctx context.Context,
 	// initialized, the ts should advance to a non-zero value.
 	tsTracker := frontier.NewFrontier(0, metricMissedRegionCollectCounter, spans...)
 	kvCli := kv.NewCDCKVClient(
-		ctx, pdCli, grpcPool, regionCache, pdClock, cfg, changefeed, tableID, tableName, filterLoop)
+		ctx, pdCli, grpcPool, scanLimiters, regionCache, pdClock, cfg,
+		changefeed, tableID, tableName, filterLoop)
 	p := &pullerImpl{
 		kvCli:        kvCli,
 		kvStorage:    tikvStorage,
@@ -275,3 +279,7 @@ func (p *pullerImpl) Stats() Stats {
 		CheckpointTsEgress:  atomic.LoadUint64(&p.checkpointTs),
 	}
 }
+
+func (p *pullerImpl) SlowestRegions(n int) []kv.RegionFeedStat {
+	return p.kvCli.SlowestRegions(n)
+}
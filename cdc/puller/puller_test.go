@@ -62,6 +62,7 @@ func newMockCDCKVClient(
 	ctx context.Context,
 	pd pd.Client,
 	grpcPool kv.GrpcPool,
+	scanLimiters *kv.ScanLimiters,
 	regionCache *tikv.RegionCache,
 	pdClock pdutil.Clock,
 	cfg *config.KVClientConfig,
@@ -131,7 +132,7 @@ func newPullerForTest(
 	regionCache := tikv.NewRegionCache(pdCli)
 	defer regionCache.Close()
 	plr := New(
-		ctx, pdCli, grpcPool, regionCache, store, pdutil.NewClock4Test(),
+		ctx, pdCli, grpcPool, nil, regionCache, store, pdutil.NewClock4Test(),
 		checkpointTs, spans, config.GetDefaultServerConfig().KVClient,
 		model.DefaultChangeFeedID("changefeed-id-test"), 0,
 		"table-test", false, false)
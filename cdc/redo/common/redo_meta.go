@@ -23,6 +23,10 @@ import (
 type LogMeta struct {
 	CheckpointTs uint64 `msg:"checkpointTs"`
 	ResolvedTs   uint64 `msg:"resolvedTs"`
+	// EncryptionKeyIDs are the ids of the keys which the redo logs may be
+	// encrypted by, the active one comes first. It is empty if the redo
+	// logs are not encrypted.
+	EncryptionKeyIDs []string `msg:"encryptionKeyIDs"`
 }
 
 // ParseMeta parses meta.
@@ -38,3 +42,30 @@ func ParseMeta(metas []*LogMeta, checkpointTs, resolvedTs *model.Ts) {
 		}
 	}
 }
+
+// ParseEncryptionKeyIDs returns the ids of the keys which the redo logs of
+// the metas may be encrypted by, in the order of their first appearances.
+func ParseEncryptionKeyIDs(metas []*LogMeta) []string {
+	var ids []string
+	for _, meta := range metas {
+		ids = MergeEncryptionKeyIDs(ids, meta.EncryptionKeyIDs)
+	}
+	return ids
+}
+
+// MergeEncryptionKeyIDs appends the key ids which are not in ids yet.
+func MergeEncryptionKeyIDs(ids []string, others []string) []string {
+	for _, id := range others {
+		found := false
+		for _, existing := range ids {
+			if existing == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
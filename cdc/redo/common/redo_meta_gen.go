@@ -36,6 +36,25 @@ func (z *LogMeta) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "ResolvedTs")
 				return
 			}
+		case "encryptionKeyIDs":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "EncryptionKeyIDs")
+				return
+			}
+			if cap(z.EncryptionKeyIDs) >= int(zb0002) {
+				z.EncryptionKeyIDs = (z.EncryptionKeyIDs)[:zb0002]
+			} else {
+				z.EncryptionKeyIDs = make([]string, zb0002)
+			}
+			for za0001 := range z.EncryptionKeyIDs {
+				z.EncryptionKeyIDs[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "EncryptionKeyIDs", za0001)
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -49,9 +68,9 @@ func (z *LogMeta) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z LogMeta) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+	// map header, size 3
 	// write "checkpointTs"
-	err = en.Append(0x82, 0xac, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x73)
+	err = en.Append(0x83, 0xac, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x73)
 	if err != nil {
 		return
 	}
@@ -70,19 +89,42 @@ func (z LogMeta) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "ResolvedTs")
 		return
 	}
+	// write "encryptionKeyIDs"
+	err = en.Append(0xb0, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.EncryptionKeyIDs)))
+	if err != nil {
+		err = msgp.WrapError(err, "EncryptionKeyIDs")
+		return
+	}
+	for za0001 := range z.EncryptionKeyIDs {
+		err = en.WriteString(z.EncryptionKeyIDs[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "EncryptionKeyIDs", za0001)
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z LogMeta) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 3
 	// string "checkpointTs"
-	o = append(o, 0x82, 0xac, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x73)
+	o = append(o, 0x83, 0xac, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x73)
 	o = msgp.AppendUint64(o, z.CheckpointTs)
 	// string "resolvedTs"
 	o = append(o, 0xaa, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x73)
 	o = msgp.AppendUint64(o, z.ResolvedTs)
+	// string "encryptionKeyIDs"
+	o = append(o, 0xb0, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.EncryptionKeyIDs)))
+	for za0001 := range z.EncryptionKeyIDs {
+		o = msgp.AppendString(o, z.EncryptionKeyIDs[za0001])
+	}
 	return
 }
 
@@ -116,6 +158,25 @@ func (z *LogMeta) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ResolvedTs")
 				return
 			}
+		case "encryptionKeyIDs":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EncryptionKeyIDs")
+				return
+			}
+			if cap(z.EncryptionKeyIDs) >= int(zb0002) {
+				z.EncryptionKeyIDs = (z.EncryptionKeyIDs)[:zb0002]
+			} else {
+				z.EncryptionKeyIDs = make([]string, zb0002)
+			}
+			for za0001 := range z.EncryptionKeyIDs {
+				z.EncryptionKeyIDs[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "EncryptionKeyIDs", za0001)
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -130,6 +191,9 @@ func (z *LogMeta) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z LogMeta) Msgsize() (s int) {
-	s = 1 + 13 + msgp.Uint64Size + 11 + msgp.Uint64Size
+	s = 1 + 13 + msgp.Uint64Size + 11 + msgp.Uint64Size + 17 + msgp.ArrayHeaderSize
+	for za0001 := range z.EncryptionKeyIDs {
+		s += msgp.StringPrefixSize + len(z.EncryptionKeyIDs[za0001])
+	}
 	return
 }
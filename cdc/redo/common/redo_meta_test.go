@@ -0,0 +1,40 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogMetaEncryptionKeyIDs(t *testing.T) {
+	t.Parallel()
+
+	meta := LogMeta{CheckpointTs: 1, ResolvedTs: 2, EncryptionKeyIDs: []string{"k2", "k1"}}
+	data, err := meta.MarshalMsg(nil)
+	require.NoError(t, err)
+	var decoded LogMeta
+	_, err = decoded.UnmarshalMsg(data)
+	require.NoError(t, err)
+	require.Equal(t, meta, decoded)
+
+	metas := []*LogMeta{
+		{CheckpointTs: 1, ResolvedTs: 2},
+		{CheckpointTs: 1, ResolvedTs: 2, EncryptionKeyIDs: []string{"k2", "k1"}},
+		{CheckpointTs: 1, ResolvedTs: 2, EncryptionKeyIDs: []string{"k3", "k1"}},
+	}
+	require.Equal(t, []string{"k2", "k1", "k3"}, ParseEncryptionKeyIDs(metas))
+	require.Nil(t, ParseEncryptionKeyIDs(metas[:1]))
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"time"
+)
+
+// flushIntervalLagRatio is the ratio of the lag to the flush interval, which
+// means that a changefeed lagging 10s behind flushes the redo logs every 1s.
+const flushIntervalLagRatio = 10
+
+// flushIntervalAdjuster adjusts the flush interval of the redo logs based on
+// the lag. A changefeed with a small lag flushes the redo logs frequently to
+// keep the latency low, and a changefeed catching up flushes less frequently,
+// so that each flush writes a larger batch and the throughput is higher.
+type flushIntervalAdjuster struct {
+	min time.Duration
+	max time.Duration
+}
+
+func newFlushIntervalAdjuster(minInMs, maxInMs int64) *flushIntervalAdjuster {
+	a := &flushIntervalAdjuster{
+		min: time.Duration(minInMs) * time.Millisecond,
+		max: time.Duration(maxInMs) * time.Millisecond,
+	}
+	if a.max < a.min {
+		a.max = a.min
+	}
+	return a
+}
+
+// adaptive returns whether the flush interval can be adjusted.
+func (a *flushIntervalAdjuster) adaptive() bool {
+	return a.max > a.min
+}
+
+// next returns the flush interval for the given lag.
+func (a *flushIntervalAdjuster) next(lag time.Duration) time.Duration {
+	interval := lag / flushIntervalLagRatio
+	if interval < a.min {
+		return a.min
+	}
+	if interval > a.max {
+		return a.max
+	}
+	return interval
+}
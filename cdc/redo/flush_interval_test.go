@@ -0,0 +1,36 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushIntervalAdjuster(t *testing.T) {
+	t.Parallel()
+
+	a := newFlushIntervalAdjuster(100, 0)
+	require.False(t, a.adaptive())
+	require.Equal(t, 100*time.Millisecond, a.next(time.Hour))
+
+	a = newFlushIntervalAdjuster(100, 2000)
+	require.True(t, a.adaptive())
+	require.Equal(t, 100*time.Millisecond, a.next(0))
+	require.Equal(t, 100*time.Millisecond, a.next(500*time.Millisecond))
+	require.Equal(t, time.Second, a.next(10*time.Second))
+	require.Equal(t, 2*time.Second, a.next(time.Minute))
+}
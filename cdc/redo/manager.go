@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 )
 
@@ -455,15 +456,39 @@ func (m *logManager) onResolvedTsMsg(span tablepb.Span, resolvedTs model.Ts) {
 	}
 }
 
+// getLag returns the lag of the redo log resolved ts received by the manager,
+// the flushed resolved ts is not used since it falls behind by the flush
+// interval itself.
+func (m *logManager) getLag(now time.Time) time.Duration {
+	var minRts model.Ts
+	m.rtsMap.Range(func(span tablepb.Span, value interface{}) bool {
+		rts := value.(*statefulRts)
+		received := rts.getUnflushed()
+		if flushed := rts.getFlushed(); flushed > received {
+			received = flushed
+		}
+		if minRts == 0 || received < minRts {
+			minRts = received
+		}
+		return true
+	})
+	if minRts == 0 {
+		return 0
+	}
+	return now.Sub(oracle.GetTimeFromTS(minRts))
+}
+
 func (m *logManager) bgUpdateLog(ctx context.Context) error {
 	m.releaseMemoryCbs = make([]func(), 0, 1024)
-	flushIntervalInMs := m.cfg.FlushIntervalInMs
-	ticker := time.NewTicker(time.Duration(flushIntervalInMs) * time.Millisecond)
+	adjuster := newFlushIntervalAdjuster(m.cfg.FlushIntervalInMs, m.cfg.MaxFlushIntervalInMs)
+	flushInterval := adjuster.min
+	ticker := time.NewTicker(flushInterval)
 	defer ticker.Stop()
 	log.Info("redo manager bgUpdateLog is running",
 		zap.String("namespace", m.cfg.ChangeFeedID.Namespace),
 		zap.String("changefeed", m.cfg.ChangeFeedID.ID),
-		zap.Int64("flushIntervalInMs", flushIntervalInMs))
+		zap.Int64("flushIntervalInMs", m.cfg.FlushIntervalInMs),
+		zap.Int64("maxFlushIntervalInMs", m.cfg.MaxFlushIntervalInMs))
 
 	var err error
 	// logErrCh is used to retrieve errors from log flushing goroutines.
@@ -479,8 +504,20 @@ func (m *logManager) bgUpdateLog(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-ticker.C:
+		case now := <-ticker.C:
 			m.flushLog(ctx, handleErr, &workTimeSlice)
+			if !adjuster.adaptive() {
+				break
+			}
+			if next := adjuster.next(m.getLag(now)); next != flushInterval {
+				log.Debug("redo manager adjusts flush interval",
+					zap.String("namespace", m.cfg.ChangeFeedID.Namespace),
+					zap.String("changefeed", m.cfg.ChangeFeedID.ID),
+					zap.Duration("from", flushInterval),
+					zap.Duration("to", next))
+				flushInterval = next
+				ticker.Reset(flushInterval)
+			}
 		case event, ok := <-m.logBuffer.Out():
 			if !ok {
 				return nil // channel closed
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/uuid"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -56,6 +57,9 @@ type metaManager struct {
 
 	metaCheckpointTs statefulRts
 	metaResolvedTs   statefulRts
+	// encryptionKeyIDs are recorded in the meta, so that the keys required
+	// to read the redo logs are known before reading them.
+	encryptionKeyIDs []string
 
 	// This fields are used to process meta files and perform
 	// garbage collection of logs.
@@ -65,6 +69,7 @@ type metaManager struct {
 
 	lastFlushTime          time.Time
 	flushIntervalInMs      int64
+	logRetention           time.Duration
 	metricFlushLogDuration prometheus.Observer
 }
 
@@ -121,6 +126,14 @@ func NewMetaManager(ctx context.Context, cfg *config.ConsistentConfig) (*metaMan
 		uuidGenerator:     uuid.NewGenerator(),
 		enabled:           true,
 		flushIntervalInMs: cfg.FlushIntervalInMs,
+		logRetention:      time.Duration(cfg.LogRetentionInMs) * time.Millisecond,
+	}
+	if cfg.EncryptionKeyFile != "" {
+		cipher, err := redo.LoadCipher(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		m.encryptionKeyIDs = cipher.KeyIDs()
 	}
 
 	uri, err := storage.ParseRawURL(cfg.Storage)
@@ -189,7 +202,11 @@ func (m *metaManager) UpdateMeta(checkpointTs, resolvedTs model.Ts) {
 func (m *metaManager) GetFlushedMeta() common.LogMeta {
 	checkpointTs := m.metaCheckpointTs.getFlushed()
 	resolvedTs := m.metaResolvedTs.getFlushed()
-	return common.LogMeta{CheckpointTs: checkpointTs, ResolvedTs: resolvedTs}
+	return common.LogMeta{
+		CheckpointTs:     checkpointTs,
+		ResolvedTs:       resolvedTs,
+		EncryptionKeyIDs: m.encryptionKeyIDs,
+	}
 }
 
 // initMeta will read the meta file from external storage and initialize the meta
@@ -240,6 +257,10 @@ func (m *metaManager) initMeta(ctx context.Context, startTs model.Ts) error {
 	}
 	m.metaResolvedTs.unflushed = resolvedTs
 	m.metaCheckpointTs.unflushed = checkpointTs
+	// the logs written before may be encrypted by the keys which are
+	// rotated out, they are still required to read those logs.
+	m.encryptionKeyIDs = common.MergeEncryptionKeyIDs(
+		m.encryptionKeyIDs, common.ParseEncryptionKeyIDs(metas))
 	if err := m.maybeFlushMeta(ctx); err != nil {
 		return errors.WrapError(errors.ErrRedoMetaInitialize,
 			errors.Annotate(err, "flush meta file fail"))
@@ -358,6 +379,7 @@ func (m *metaManager) prepareForFlushMeta() (bool, common.LogMeta) {
 	unflushed := common.LogMeta{}
 	unflushed.CheckpointTs = m.metaCheckpointTs.getUnflushed()
 	unflushed.ResolvedTs = m.metaResolvedTs.getUnflushed()
+	unflushed.EncryptionKeyIDs = m.encryptionKeyIDs
 
 	hasChange := false
 	if flushed.CheckpointTs < unflushed.CheckpointTs ||
@@ -416,10 +438,28 @@ func (m *metaManager) Cleanup(ctx context.Context) error {
 	return m.deleteAllLogs(ctx)
 }
 
+// compactMeta removes the meta files left by the previous owners or failed
+// flushes, all of which have been merged into the current meta file in
+// initMeta. It must be called in the same goroutine as flush, otherwise the
+// meta file being written may be removed.
+func (m *metaManager) compactMeta(ctx context.Context) error {
+	if m.preMetaFile == "" {
+		return nil
+	}
+	changefeedMatcher := getChangefeedMatcher(m.changeFeedID)
+	return util.RemoveFilesIf(ctx, m.extStorage, func(path string) bool {
+		return filepath.Ext(path) == redo.MetaEXT &&
+			strings.Contains(path, changefeedMatcher) &&
+			path != m.preMetaFile
+	}, nil)
+}
+
 func (m *metaManager) bgFlushMeta(egCtx context.Context, flushIntervalInMs int64) (err error) {
 	ticker := time.NewTicker(time.Duration(flushIntervalInMs) * time.Millisecond)
+	compactTicker := time.NewTicker(time.Duration(redo.DefaultGCIntervalInMs) * time.Millisecond)
 	defer func() {
 		ticker.Stop()
+		compactTicker.Stop()
 		log.Info("redo metaManager bgFlushMeta exits",
 			zap.String("namespace", m.changeFeedID.Namespace),
 			zap.String("changefeed", m.changeFeedID.ID),
@@ -435,16 +475,38 @@ func (m *metaManager) bgFlushMeta(egCtx context.Context, flushIntervalInMs int64
 			if err := m.maybeFlushMeta(egCtx); err != nil {
 				return errors.Trace(err)
 			}
+		case <-compactTicker.C:
+			// The stale meta files are only a burden of the storage, so
+			// failing to remove them is not fatal.
+			if err := m.compactMeta(egCtx); err != nil {
+				log.Warn("redo manager meta compaction fail",
+					zap.String("namespace", m.changeFeedID.Namespace),
+					zap.String("changefeed", m.changeFeedID.ID), zap.Error(err))
+			}
 		}
 	}
 }
 
-// bgGC cleans stale files before the flushed checkpoint in background.
+// getGCTs returns the ts before which the redo logs can be removed, that is
+// the flushed checkpoint, or an earlier ts if the logs should be retained.
+func (m *metaManager) getGCTs(now time.Time) uint64 {
+	gcTs := m.metaCheckpointTs.getFlushed()
+	if m.logRetention > 0 {
+		retainedTs := oracle.GoTimeToTS(now.Add(-m.logRetention))
+		if retainedTs < gcTs {
+			gcTs = retainedTs
+		}
+	}
+	return gcTs
+}
+
+// bgGC cleans stale files before the flushed checkpoint and out of the
+// retention in background.
 func (m *metaManager) bgGC(egCtx context.Context) error {
 	ticker := time.NewTicker(time.Duration(redo.DefaultGCIntervalInMs) * time.Millisecond)
 	defer ticker.Stop()
 
-	preCkpt := uint64(0)
+	preGCTs := uint64(0)
 	for {
 		select {
 		case <-egCtx.Done():
@@ -452,18 +514,18 @@ func (m *metaManager) bgGC(egCtx context.Context) error {
 				zap.String("namespace", m.changeFeedID.Namespace),
 				zap.String("changefeed", m.changeFeedID.ID))
 			return errors.Trace(egCtx.Err())
-		case <-ticker.C:
-			ckpt := m.metaCheckpointTs.getFlushed()
-			if ckpt == preCkpt {
+		case now := <-ticker.C:
+			gcTs := m.getGCTs(now)
+			if gcTs == preGCTs {
 				continue
 			}
-			preCkpt = ckpt
+			preGCTs = gcTs
 			log.Debug("redo manager GC is triggered",
-				zap.Uint64("checkpointTs", ckpt),
+				zap.Uint64("gcTs", gcTs),
 				zap.String("namespace", m.changeFeedID.Namespace),
 				zap.String("changefeed", m.changeFeedID.ID))
 			err := util.RemoveFilesIf(egCtx, m.extStorage, func(path string) bool {
-				return m.shouldRemoved(path, ckpt)
+				return m.shouldRemoved(path, gcTs)
 			}, nil)
 			if err != nil {
 				log.Warn("redo manager log GC fail",
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/uuid"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -296,3 +297,64 @@ func TestGCAndCleanup(t *testing.T) {
 	})
 	require.Equal(t, 1, cnt)
 }
+
+func TestCompactMeta(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	captureID := "test-capture"
+	ctx = contextutil.PutCaptureAddrInCtx(ctx, captureID)
+	changefeedID := model.DefaultChangeFeedID("test-changefeed")
+	ctx = contextutil.PutChangefeedIDInCtx(ctx, changefeedID)
+
+	extStorage, uri, err := util.GetTestExtStorage(ctx, t.TempDir())
+	require.NoError(t, err)
+
+	startTs := uint64(10)
+	cfg := &config.ConsistentConfig{
+		Level:             string(redo.ConsistentLevelEventual),
+		MaxLogSize:        redo.DefaultMaxLogSize,
+		Storage:           uri.String(),
+		FlushIntervalInMs: redo.MinFlushIntervalInMs,
+	}
+	m, err := NewMetaManagerWithInit(ctx, cfg, startTs)
+	require.NoError(t, err)
+
+	// write some stale meta files, and a meta file of another changefeed.
+	for i := 0; i < 3; i++ {
+		metaName := getMetafileName(captureID, changefeedID, uuid.NewGenerator())
+		require.NoError(t, extStorage.WriteFile(ctx, metaName, []byte{}))
+	}
+	otherMeta := getMetafileName(captureID,
+		model.DefaultChangeFeedID("other-changefeed"), uuid.NewGenerator())
+	require.NoError(t, extStorage.WriteFile(ctx, otherMeta, []byte{}))
+
+	require.NoError(t, m.compactMeta(ctx))
+	var metaFiles []string
+	extStorage.WalkDir(ctx, nil, func(path string, size int64) error {
+		if strings.HasSuffix(path, redo.MetaEXT) {
+			metaFiles = append(metaFiles, path)
+		}
+		return nil
+	})
+	require.ElementsMatch(t, []string{m.preMetaFile, otherMeta}, metaFiles)
+}
+
+func TestGetGCTs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	checkpointTs := oracle.GoTimeToTS(now.Add(-time.Minute))
+	m := &metaManager{}
+	m.metaCheckpointTs.setFlushed(checkpointTs)
+	require.Equal(t, checkpointTs, m.getGCTs(now))
+
+	// the checkpoint is still in the retention.
+	m.logRetention = time.Hour
+	require.Equal(t, oracle.GoTimeToTS(now.Add(-time.Hour)), m.getGCTs(now))
+
+	// the checkpoint is out of the retention.
+	m.logRetention = time.Second
+	require.Equal(t, checkpointTs, m.getGCTs(now))
+}
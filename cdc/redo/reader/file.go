@@ -67,10 +67,14 @@ type readerConfig struct {
 	uri                url.URL
 	useExternalStorage bool
 	workerNums         int
+	// cipher decrypts the downloaded redo logs, the sorted local files are
+	// always written in plaintext.
+	cipher *redo.Cipher
 }
 
 type reader struct {
 	cfg      *readerConfig
+	cipher   *redo.Cipher
 	mu       sync.Mutex
 	br       io.Reader
 	fileName string
@@ -203,9 +207,10 @@ func selectDownLoadFile(
 	return files, nil
 }
 
-func readAllFromBuffer(buf []byte) (logHeap, error) {
+func readAllFromBuffer(buf []byte, cipher *redo.Cipher) (logHeap, error) {
 	r := &reader{
-		br: bytes.NewReader(buf),
+		br:     bytes.NewReader(buf),
+		cipher: cipher,
 	}
 	defer r.Close()
 
@@ -253,7 +258,7 @@ func sortAndWriteFile(
 	}
 
 	// sort data
-	h, err := readAllFromBuffer(fileContent)
+	h, err := readAllFromBuffer(fileContent, cfg.cipher)
 	if err != nil {
 		return err
 	}
@@ -330,7 +335,14 @@ func (r *reader) Read() (*model.RedoLog, error) {
 		return nil, cerror.WrapError(cerror.ErrRedoFileOp, err)
 	}
 
-	redoLog, _, err := codec.UnmarshalRedoLog(data[:recBytes])
+	record, err := redo.DecryptRecord(r.cipher, data[:recBytes])
+	if err != nil {
+		if r.isTornEntry(data) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	redoLog, _, err := codec.UnmarshalRedoLog(record)
 	if err != nil {
 		if r.isTornEntry(data) {
 			// just return io.EOF, since if torn write it is the last redoLog entry
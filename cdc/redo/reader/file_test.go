@@ -18,9 +18,18 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/model/codec"
+	"github.com/pingcap/tiflow/cdc/redo/writer"
+	"github.com/pingcap/tiflow/cdc/redo/writer/file"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/redo"
 	"github.com/stretchr/testify/require"
 )
@@ -77,3 +86,62 @@ func TestFileReaderRead(t *testing.T) {
 		require.NoError(t, r.Close())
 	}
 }
+
+func TestFileReaderReadEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keyFile := filepath.Join(t.TempDir(), "redo.key")
+	err := os.WriteFile(keyFile,
+		[]byte("k1:000102030405060708090a0b0c0d0e0f\n"), 0o600)
+	require.NoError(t, err)
+
+	writerCfg := &writer.LogWriterConfig{
+		ConsistentConfig:  config.ConsistentConfig{EncryptionKeyFile: keyFile},
+		MaxLogSizeInBytes: 100000,
+		Dir:               dir,
+	}
+	fileName := fmt.Sprintf(redo.RedoLogFileFormatV2, "capture", "default",
+		"changefeed", redo.RedoRowLogFileType, 12, uuid.NewString(), redo.LogEXT)
+	w, err := file.NewFileWriter(ctx, writerCfg, writer.WithLogFileName(func() string {
+		return fileName
+	}))
+	require.NoError(t, err)
+	for ts := uint64(12); ts >= 11; ts-- {
+		rawData, err := codec.MarshalRedoLog((&model.RowChangedEvent{CommitTs: ts}).ToRedoLog(), nil)
+		require.NoError(t, err)
+		_, err = w.Write(rawData)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	uri, err := url.Parse(fmt.Sprintf("file://%s", dir))
+	require.NoError(t, err)
+	cfg := &readerConfig{
+		dir:                t.TempDir(),
+		startTs:            10,
+		endTs:              12,
+		fileType:           redo.RedoRowLogFileType,
+		uri:                *uri,
+		useExternalStorage: true,
+	}
+	// the redo logs can not be read without the key.
+	_, err = newReaders(ctx, cfg)
+	require.True(t, cerror.ErrRedoEncryption.Equal(err))
+
+	cfg.dir = t.TempDir()
+	cfg.cipher, err = redo.LoadCipher(keyFile)
+	require.NoError(t, err)
+	readers, err := newReaders(ctx, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(readers))
+	for _, ts := range []uint64{11, 12} {
+		log, err := readers[0].Read()
+		require.NoError(t, err)
+		require.EqualValues(t, ts, log.RedoRow.Row.CommitTs)
+	}
+	_, err = readers[0].Read()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, readers[0].Close())
+}
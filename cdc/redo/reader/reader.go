@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/redo/common"
 	"github.com/pingcap/tiflow/pkg/errors"
@@ -81,14 +82,28 @@ type LogReaderConfig struct {
 	// will load the file to memory first then write the sorted file to disk
 	// the memory used is WorkerNums * defaultMaxLogSize (64 * megabyte) total
 	WorkerNums int
+
+	// EncryptionKeyFile is the path of the file containing the keys used to
+	// decrypt the redo logs, it is required if the redo logs are encrypted.
+	EncryptionKeyFile string
+
+	// StartTs and EndTs narrow the events read to (StartTs, EndTs], the range
+	// is limited by the checkpoint ts and resolved ts in meta. Zero means no
+	// limitation.
+	StartTs uint64
+	EndTs   uint64
 }
 
 // LogReader implement RedoLogReader interface
 type LogReader struct {
-	cfg   *LogReaderConfig
-	meta  *common.LogMeta
-	rowCh chan *model.RowChangedEvent
-	ddlCh chan *model.DDLEvent
+	cfg    *LogReaderConfig
+	cipher *redo.Cipher
+	meta   *common.LogMeta
+	// startTs and endTs are the range of the events to read.
+	startTs uint64
+	endTs   uint64
+	rowCh   chan *model.RowChangedEvent
+	ddlCh   chan *model.DDLEvent
 }
 
 // newLogReader creates a LogReader instance.
@@ -109,6 +124,13 @@ func newLogReader(ctx context.Context, cfg *LogReaderConfig) (*LogReader, error)
 		rowCh: make(chan *model.RowChangedEvent, defaultReaderChanSize),
 		ddlCh: make(chan *model.DDLEvent, defaultReaderChanSize),
 	}
+	if cfg.EncryptionKeyFile != "" {
+		cipher, err := redo.LoadCipher(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		logReader.cipher = cipher
+	}
 	// remove logs in local dir first, if have logs left belongs to previous changefeed with the same name may have error when apply logs
 	if err := os.RemoveAll(cfg.Dir); err != nil {
 		return nil, errors.WrapError(errors.ErrRedoFileOp, err)
@@ -141,32 +163,48 @@ func (l *LogReader) Run(ctx context.Context) error {
 	return eg.Wait()
 }
 
+// The channels are only closed if all events are read successfully, so that
+// the consumers never mistake a failure for the end of the logs.
 func (l *LogReader) runRowReader(egCtx context.Context) error {
-	defer close(l.rowCh)
 	rowCfg := &readerConfig{
-		startTs:            l.meta.CheckpointTs,
-		endTs:              l.meta.ResolvedTs,
+		startTs:            l.startTs,
+		endTs:              l.endTs,
 		dir:                l.cfg.Dir,
 		fileType:           redo.RedoRowLogFileType,
 		uri:                l.cfg.URI,
 		useExternalStorage: l.cfg.UseExternalStorage,
 		workerNums:         l.cfg.WorkerNums,
+		cipher:             l.cipher,
+	}
+	if err := l.runReader(egCtx, rowCfg); err != nil {
+		return err
 	}
-	return l.runReader(egCtx, rowCfg)
+	close(l.rowCh)
+	return nil
 }
 
 func (l *LogReader) runDDLReader(egCtx context.Context) error {
-	defer close(l.ddlCh)
+	// The DDL at checkpoint ts is also needed, since it may not be executed
+	// in the downstream.
+	startTs := l.startTs
+	if startTs == l.meta.CheckpointTs {
+		startTs--
+	}
 	ddlCfg := &readerConfig{
-		startTs:            l.meta.CheckpointTs - 1,
-		endTs:              l.meta.ResolvedTs,
+		startTs:            startTs,
+		endTs:              l.endTs,
 		dir:                l.cfg.Dir,
 		fileType:           redo.RedoDDLLogFileType,
 		uri:                l.cfg.URI,
 		useExternalStorage: l.cfg.UseExternalStorage,
 		workerNums:         l.cfg.WorkerNums,
+		cipher:             l.cipher,
 	}
-	return l.runReader(egCtx, ddlCfg)
+	if err := l.runReader(egCtx, ddlCfg); err != nil {
+		return err
+	}
+	close(l.ddlCh)
+	return nil
 }
 
 func (l *LogReader) runReader(egCtx context.Context, cfg *readerConfig) error {
@@ -284,8 +322,50 @@ func (l *LogReader) initMeta(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	metas, err := readMetas(ctx, extStorage)
+	if err != nil {
+		return err
+	}
+	if len(metas) == 0 {
+		return errors.ErrRedoMetaFileNotFound.GenWithStackByArgs(l.cfg.Dir)
+	}
+
+	var checkpointTs, resolvedTs uint64
+	common.ParseMeta(metas, &checkpointTs, &resolvedTs)
+	if resolvedTs < checkpointTs {
+		log.Panic("in all meta files, resolvedTs is less than checkpointTs",
+			zap.Uint64("resolvedTs", resolvedTs),
+			zap.Uint64("checkpointTs", checkpointTs))
+	}
+	keyIDs := common.ParseEncryptionKeyIDs(metas)
+	if err := redo.CheckKeys(l.cipher, keyIDs); err != nil {
+		return err
+	}
+	l.meta = &common.LogMeta{
+		CheckpointTs:     checkpointTs,
+		ResolvedTs:       resolvedTs,
+		EncryptionKeyIDs: keyIDs,
+	}
+
+	l.startTs, l.endTs = checkpointTs, resolvedTs
+	if l.cfg.StartTs > l.startTs {
+		l.startTs = l.cfg.StartTs
+	}
+	if l.cfg.EndTs != 0 && l.cfg.EndTs < l.endTs {
+		l.endTs = l.cfg.EndTs
+	}
+	if l.startTs > l.endTs {
+		return errors.ErrRedoConfigInvalid.GenWithStack(
+			"the range (%d, %d] to read is out of the range (%d, %d] of redo logs",
+			l.cfg.StartTs, l.cfg.EndTs, checkpointTs, resolvedTs)
+	}
+	return nil
+}
+
+// readMetas reads all meta files in the external storage.
+func readMetas(ctx context.Context, extStorage storage.ExternalStorage) ([]*common.LogMeta, error) {
 	metas := make([]*common.LogMeta, 0, 64)
-	err = extStorage.WalkDir(ctx, nil, func(path string, size int64) error {
+	err := extStorage.WalkDir(ctx, nil, func(path string, size int64) error {
 		if !strings.HasSuffix(path, redo.MetaEXT) {
 			return nil
 		}
@@ -305,22 +385,10 @@ func (l *LogReader) initMeta(ctx context.Context) error {
 		return nil
 	})
 	if err != nil {
-		return errors.WrapError(errors.ErrRedoMetaInitialize,
+		return nil, errors.WrapError(errors.ErrRedoMetaInitialize,
 			errors.Annotate(err, "read meta file fail"))
 	}
-	if len(metas) == 0 {
-		return errors.ErrRedoMetaFileNotFound.GenWithStackByArgs(l.cfg.Dir)
-	}
-
-	var checkpointTs, resolvedTs uint64
-	common.ParseMeta(metas, &checkpointTs, &resolvedTs)
-	if resolvedTs < checkpointTs {
-		log.Panic("in all meta files, resolvedTs is less than checkpointTs",
-			zap.Uint64("resolvedTs", resolvedTs),
-			zap.Uint64("checkpointTs", checkpointTs))
-	}
-	l.meta = &common.LogMeta{CheckpointTs: checkpointTs, ResolvedTs: resolvedTs}
-	return nil
+	return metas, nil
 }
 
 // ReadMeta implement ReadMeta interface
@@ -100,9 +100,11 @@ func TestReadLogs(t *testing.T) {
 			URI:                *uri,
 			UseExternalStorage: true,
 		},
-		meta:  meta,
-		rowCh: make(chan *model.RowChangedEvent, defaultReaderChanSize),
-		ddlCh: make(chan *model.DDLEvent, defaultReaderChanSize),
+		meta:    meta,
+		startTs: meta.CheckpointTs,
+		endTs:   meta.ResolvedTs,
+		rowCh:   make(chan *model.RowChangedEvent, defaultReaderChanSize),
+		ddlCh:   make(chan *model.DDLEvent, defaultReaderChanSize),
 	}
 	eg, egCtx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
@@ -124,6 +126,59 @@ func TestReadLogs(t *testing.T) {
 	require.ErrorIs(t, eg.Wait(), nil)
 }
 
+func TestReadLogsInRange(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	meta := &common.LogMeta{
+		CheckpointTs: 11,
+		ResolvedTs:   100,
+	}
+	for _, logType := range []string{redo.RedoRowLogFileType, redo.RedoDDLLogFileType} {
+		genLogFile(ctx, t, dir, logType, meta.CheckpointTs, meta.CheckpointTs)
+		genLogFile(ctx, t, dir, logType, 12, 12)
+		genLogFile(ctx, t, dir, logType, 50, 50)
+		genLogFile(ctx, t, dir, logType, meta.ResolvedTs, meta.ResolvedTs)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("file://%s", dir))
+	require.NoError(t, err)
+	r := &LogReader{
+		cfg: &LogReaderConfig{
+			Dir:                t.TempDir(),
+			URI:                *uri,
+			UseExternalStorage: true,
+		},
+		meta:    meta,
+		startTs: 12,
+		endTs:   50,
+		rowCh:   make(chan *model.RowChangedEvent, defaultReaderChanSize),
+		ddlCh:   make(chan *model.DDLEvent, defaultReaderChanSize),
+	}
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return r.Run(egCtx)
+	})
+
+	row, err := r.ReadNextRow(egCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), row.CommitTs)
+	row, err = r.ReadNextRow(egCtx)
+	require.NoError(t, err)
+	require.Nil(t, row)
+	ddl, err := r.ReadNextDDL(egCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), ddl.CommitTs)
+	ddl, err = r.ReadNextDDL(egCtx)
+	require.NoError(t, err)
+	require.Nil(t, ddl)
+
+	cancel()
+	require.NoError(t, eg.Wait())
+}
+
 func TestLogReaderClose(t *testing.T) {
 	t.Parallel()
 
@@ -149,9 +204,11 @@ func TestLogReaderClose(t *testing.T) {
 			URI:                *uri,
 			UseExternalStorage: true,
 		},
-		meta:  meta,
-		rowCh: make(chan *model.RowChangedEvent, 1),
-		ddlCh: make(chan *model.DDLEvent, 1),
+		meta:    meta,
+		startTs: meta.CheckpointTs,
+		endTs:   meta.ResolvedTs,
+		rowCh:   make(chan *model.RowChangedEvent, 1),
+		ddlCh:   make(chan *model.DDLEvent, 1),
 	}
 	eg, egCtx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tiflow/cdc/redo/common"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/redo"
+)
+
+// VerifiedFile is the result of verifying a redo log file.
+type VerifiedFile struct {
+	Name     string
+	FileType string
+	// MaxCommitTs is the max commit ts in the file name.
+	MaxCommitTs uint64
+	RecordCount int
+	// MinRecordTs and MaxRecordTs are the commit ts range of the records.
+	MinRecordTs uint64
+	MaxRecordTs uint64
+}
+
+// VerifyResult is the result of verifying the redo logs of a changefeed.
+type VerifyResult struct {
+	MetaCount    int
+	CheckpointTs uint64
+	ResolvedTs   uint64
+	Files        []*VerifiedFile
+	// Problems are the problems which make the redo logs unrecoverable.
+	Problems []string
+}
+
+// Recoverable returns whether the changefeed can be recovered to ResolvedTs
+// by the redo logs.
+func (r *VerifyResult) Recoverable() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *VerifyResult) addProblem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// VerifyLogs scans the meta and all log files in the external storage without
+// applying them. It checks that the meta contains a continuous resolved ts
+// range, and that every record of the log files needed to recover to the
+// resolved ts can be decoded, or authenticated if the logs are encrypted,
+// and is consistent with the commit ts recorded in the file name.
+func VerifyLogs(ctx context.Context, cfg *LogReaderConfig) (*VerifyResult, error) {
+	extStorage, err := redo.InitExternalStorage(ctx, cfg.URI)
+	if err != nil {
+		return nil, err
+	}
+	var cipher *redo.Cipher
+	if cfg.EncryptionKeyFile != "" {
+		if cipher, err = redo.LoadCipher(cfg.EncryptionKeyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	metas, err := readMetas(ctx, extStorage)
+	if err != nil {
+		return nil, err
+	}
+	if len(metas) == 0 {
+		return nil, errors.ErrRedoMetaFileNotFound.GenWithStackByArgs(cfg.URI.Redacted())
+	}
+	result := &VerifyResult{MetaCount: len(metas)}
+	common.ParseMeta(metas, &result.CheckpointTs, &result.ResolvedTs)
+	if result.ResolvedTs < result.CheckpointTs {
+		result.addProblem("resolved ts %d is less than checkpoint ts %d in meta",
+			result.ResolvedTs, result.CheckpointTs)
+		return result, nil
+	}
+	if err := redo.CheckKeys(cipher, common.ParseEncryptionKeyIDs(metas)); err != nil {
+		result.addProblem("%s", err.Error())
+		return result, nil
+	}
+
+	for _, fileType := range []string{redo.RedoDDLLogFileType, redo.RedoRowLogFileType} {
+		// The DDL at checkpoint ts is also needed, see runDDLReader.
+		startTs := result.CheckpointTs
+		if fileType == redo.RedoDDLLogFileType {
+			startTs--
+		}
+		files, err := selectDownLoadFile(ctx, extStorage, fileType, startTs)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+		for _, name := range files {
+			file, err := verifyLogFile(ctx, extStorage, name, fileType, cipher, result)
+			if err != nil {
+				return nil, err
+			}
+			if file != nil {
+				result.Files = append(result.Files, file)
+			}
+		}
+	}
+	return result, nil
+}
+
+func verifyLogFile(
+	ctx context.Context, extStorage storage.ExternalStorage,
+	name, fileType string, cipher *redo.Cipher, result *VerifyResult,
+) (*VerifiedFile, error) {
+	maxCommitTs, _, err := redo.ParseLogFileName(filepath.Base(name))
+	if err != nil {
+		result.addProblem("%s: %s", name, err.Error())
+		return nil, nil
+	}
+	data, err := extStorage.ReadFile(ctx, name)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrExternalStorageAPI, err)
+	}
+
+	file := &VerifiedFile{Name: name, FileType: fileType, MaxCommitTs: maxCommitTs}
+	isTmp := filepath.Ext(name) == redo.TmpEXT
+	r := &reader{br: bytes.NewReader(data), fileName: name, cipher: cipher}
+	for {
+		rl, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			result.addProblem("%s: record at offset %d is corrupted: %s",
+				name, r.lastValidOff, err.Error())
+			return file, nil
+		}
+		commitTs := rl.GetCommitTs()
+		if file.RecordCount == 0 || commitTs < file.MinRecordTs {
+			file.MinRecordTs = commitTs
+		}
+		if commitTs > file.MaxRecordTs {
+			file.MaxRecordTs = commitTs
+		}
+		file.RecordCount++
+	}
+	// The files are pre-allocated and padded by zeros, any other trailing
+	// bytes are left by a torn write.
+	if trailing := data[r.lastValidOff:]; len(bytes.Trim(trailing, "\x00")) != 0 && !isTmp {
+		result.addProblem("%s: %d bytes after offset %d can not be decoded",
+			name, len(trailing), r.lastValidOff)
+	}
+	if !isTmp && file.RecordCount > 0 && file.MaxRecordTs > file.MaxCommitTs {
+		result.addProblem("%s: record commit ts %d exceeds the max commit ts %d in file name",
+			name, file.MaxRecordTs, file.MaxCommitTs)
+	}
+	return file, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/tiflow/cdc/redo/common"
+	"github.com/pingcap/tiflow/pkg/redo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLogs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	meta := &common.LogMeta{CheckpointTs: 11, ResolvedTs: 100}
+	data, err := meta.MarshalMsg(nil)
+	require.NoError(t, err)
+	metaName := fmt.Sprintf(redo.RedoMetaFileFormat, "capture", "default",
+		"changefeed", redo.RedoMetaFileType, uuid.NewString(), redo.MetaEXT)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, metaName), data, redo.DefaultFileMode))
+
+	for _, logType := range []string{redo.RedoRowLogFileType, redo.RedoDDLLogFileType} {
+		// filtered since all events are before checkpoint ts
+		genLogFile(ctx, t, dir, logType, 1, 5)
+		genLogFile(ctx, t, dir, logType, 12, 20)
+		genLogFile(ctx, t, dir, logType, 90, meta.ResolvedTs)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("file://%s", dir))
+	require.NoError(t, err)
+	cfg := &LogReaderConfig{URI: *uri, UseExternalStorage: true}
+	result, err := VerifyLogs(ctx, cfg)
+	require.NoError(t, err)
+	require.True(t, result.Recoverable(), result.Problems)
+	require.Equal(t, 1, result.MetaCount)
+	require.Equal(t, meta.CheckpointTs, result.CheckpointTs)
+	require.Equal(t, meta.ResolvedTs, result.ResolvedTs)
+	require.Len(t, result.Files, 4)
+	recordCount := 0
+	for _, f := range result.Files {
+		recordCount += f.RecordCount
+		require.LessOrEqual(t, f.MaxRecordTs, f.MaxCommitTs)
+	}
+	// row logs contain all events in the range, ddl logs contain one event.
+	require.Equal(t, 9+11+2, recordCount)
+
+	// a corrupted log file makes the redo logs unrecoverable.
+	corruptedName := fmt.Sprintf(redo.RedoLogFileFormatV2, "capture", "default",
+		"changefeed", redo.RedoRowLogFileType, 50, uuid.NewString(), redo.LogEXT)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, corruptedName),
+		[]byte{8, 0, 0, 0, 0, 0, 0, 0, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1, 0xc1},
+		redo.DefaultFileMode))
+	result, err = VerifyLogs(ctx, cfg)
+	require.NoError(t, err)
+	require.False(t, result.Recoverable())
+	require.Len(t, result.Problems, 1)
+	require.Contains(t, result.Problems[0], corruptedName)
+}
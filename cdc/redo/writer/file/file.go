@@ -68,6 +68,9 @@ type Writer struct {
 	sync.RWMutex
 	uuidGenerator uuid.Generator
 	allocator     *fsutil.FileAllocator
+	// cipher encrypts the redo log records, it is nil if the encryption
+	// is not enabled.
+	cipher *redo.Cipher
 
 	metricFsyncDuration    prometheus.Observer
 	metricFlushAllDuration prometheus.Observer
@@ -110,6 +113,13 @@ func NewFileWriter(
 		metricWriteBytes: common.RedoWriteBytesGauge.
 			WithLabelValues(cfg.ChangeFeedID.Namespace, cfg.ChangeFeedID.ID),
 	}
+	if cfg.EncryptionKeyFile != "" {
+		var err error
+		w.cipher, err = redo.LoadCipher(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if w.op.GetUUIDGenerator != nil {
 		w.uuidGenerator = w.op.GetUUIDGenerator()
 	} else {
@@ -143,6 +153,14 @@ func (w *Writer) Write(rawData []byte) (int, error) {
 	w.Lock()
 	defer w.Unlock()
 
+	if w.cipher != nil {
+		var err error
+		rawData, err = w.cipher.Encrypt(rawData)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	writeLen := int64(len(rawData))
 	if writeLen > w.cfg.MaxLogSizeInBytes {
 		return 0, errors.ErrFileSizeExceed.GenWithStackByArgs(writeLen, w.cfg.MaxLogSizeInBytes)
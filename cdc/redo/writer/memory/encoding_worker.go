@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model/codec"
 	"github.com/pingcap/tiflow/cdc/redo/writer"
 	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/redo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -66,7 +67,8 @@ func (e *polymorphicRedoEvent) reset() {
 }
 
 // encoding format: lenField(8 bytes) + rawData + padding bytes(force 8 bytes alignment)
-func (e *polymorphicRedoEvent) encode() (err error) {
+// rawData is encrypted by the cipher if it is not nil.
+func (e *polymorphicRedoEvent) encode(cipher *redo.Cipher) (err error) {
 	redoLog := e.event.ToRedoLog()
 	e.commitTs = redoLog.GetCommitTs()
 
@@ -74,6 +76,12 @@ func (e *polymorphicRedoEvent) encode() (err error) {
 	if err != nil {
 		return err
 	}
+	if cipher != nil {
+		rawData, err = cipher.Encrypt(rawData)
+		if err != nil {
+			return err
+		}
+	}
 	uint64buf := make([]byte, 8)
 	lenField, padBytes := writer.EncodeFrameSize(len(rawData))
 	binary.LittleEndian.PutUint64(uint64buf, lenField)
@@ -101,11 +109,12 @@ type encodingWorkerGroup struct {
 	inputChs   []chan *polymorphicRedoEvent
 	workerNum  int
 	nextWorker atomic.Uint64
+	cipher     *redo.Cipher
 
 	closed chan struct{}
 }
 
-func newEncodingWorkerGroup(workerNum int) *encodingWorkerGroup {
+func newEncodingWorkerGroup(workerNum int, cipher *redo.Cipher) *encodingWorkerGroup {
 	if workerNum <= 0 {
 		workerNum = defaultEncodingWorkerNum
 	}
@@ -117,6 +126,7 @@ func newEncodingWorkerGroup(workerNum int) *encodingWorkerGroup {
 		inputChs:  inputChs,
 		outputCh:  make(chan *polymorphicRedoEvent, defaultEncodingOutputChanSize),
 		workerNum: workerNum,
+		cipher:    cipher,
 		closed:    make(chan struct{}),
 	}
 }
@@ -153,7 +163,7 @@ func (e *encodingWorkerGroup) runWorker(egCtx context.Context, idx int) error {
 			return errors.Trace(egCtx.Err())
 		case event := <-e.inputChs[idx]:
 			if event.event != nil {
-				if err := event.encode(); err != nil {
+				if err := event.encode(e.cipher); err != nil {
 					return errors.Trace(err)
 				}
 				if err := e.output(egCtx, event); err != nil {
@@ -63,6 +63,14 @@ func NewLogWriter(
 		return nil, err
 	}
 
+	var cipher *redo.Cipher
+	if cfg.EncryptionKeyFile != "" {
+		cipher, err = redo.LoadCipher(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 	lwCtx, lwCancel := context.WithCancel(ctx)
 	lw := &memoryLogWriter{
@@ -71,7 +79,7 @@ func NewLogWriter(
 		cancel: lwCancel,
 	}
 
-	lw.encodeWorkers = newEncodingWorkerGroup(defaultEncodingWorkerNum)
+	lw.encodeWorkers = newEncodingWorkerGroup(defaultEncodingWorkerNum, cipher)
 	eg.Go(func() error {
 		return lw.encodeWorkers.Run(lwCtx)
 	})
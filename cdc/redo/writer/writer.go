@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/pingcap/tiflow/pkg/uuid"
 )
 
@@ -59,9 +60,11 @@ type LogWriterConfig struct {
 }
 
 func (cfg LogWriterConfig) String() string {
+	// the uri may contain the credentials of the storage.
+	uri, _ := util.MaskSinkURI(cfg.URI.String())
 	return fmt.Sprintf("%s:%s:%s:%s:%d:%s:%t",
 		cfg.ChangeFeedID.Namespace, cfg.ChangeFeedID.ID, cfg.CaptureID,
-		cfg.Dir, cfg.MaxLogSize, cfg.URI.String(), cfg.UseExternalStorage)
+		cfg.Dir, cfg.MaxLogSize, uri, cfg.UseExternalStorage)
 }
 
 // Option define the writerOptions
@@ -26,4 +26,11 @@ type InfoProvider interface {
 
 	// GetTaskStatuses returns the task statuses.
 	GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus, error)
+
+	// GetTableProgresses returns the replication progresses of all tables.
+	GetTableProgresses() ([]*model.TableProgress, error)
+
+	// GetCaptureTableStats returns the statistics of the tables replicated
+	// by each capture.
+	GetCaptureTableStats() (map[model.CaptureID]*model.CaptureTableStats, error)
 }
@@ -61,6 +61,13 @@ type Scheduler interface {
 	// It is thread-safe.
 	DrainCapture(target model.CaptureID) (int, error)
 
+	// CatchUpTables sets the tables that are resumed after being paused,
+	// each of them is started at the given ts and is excluded from the
+	// global watermarks until it catches up with the global checkpoint.
+	// It returns the tables that have caught up.
+	// It is thread-safe.
+	CatchUpTables(tables map[model.TableID]model.Ts) []model.TableID
+
 	// Close scheduler and release resource.
 	// It is not thread-safe.
 	Close(ctx context.Context)
@@ -65,6 +65,13 @@ type coordinator struct {
 	pdClock         pdutil.Clock
 	tableRanges     replication.TableRanges
 
+	// catchUpTables are the resumed tables and the ts they are started at,
+	// they are excluded from the checkpoint calculation by
+	// checkpointRanges until they catch up with checkpointTs.
+	catchUpTables    map[model.TableID]model.Ts
+	checkpointRanges replication.TableRanges
+	checkpointTs     model.Ts
+
 	lastCollectTime time.Time
 	changefeedID    model.ChangeFeedID
 }
@@ -241,6 +248,43 @@ func (c *coordinator) DrainCapture(target model.CaptureID) (int, error) {
 	return count, nil
 }
 
+// CatchUpTables implement the scheduler interface
+func (c *coordinator) CatchUpTables(tables map[model.TableID]model.Ts) []model.TableID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.catchUpTables = tables
+	var caughtUp []model.TableID
+	for tableID := range tables {
+		if c.isTableCaughtUp(tableID) {
+			caughtUp = append(caughtUp, tableID)
+		}
+	}
+	return caughtUp
+}
+
+// isTableCaughtUp returns true if all spans of the table are replicating
+// and have caught up with the global checkpoint.
+func (c *coordinator) isTableCaughtUp(tableID model.TableID) bool {
+	if c.checkpointTs == 0 {
+		// The global checkpoint is unknown before the first tick.
+		return false
+	}
+	found, caughtUp := false, true
+	start, end := spanz.TableIDToComparableRange(tableID)
+	c.replicationM.ReplicationSets().AscendRange(start, end,
+		func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+			found = true
+			if rep.State != replication.ReplicationSetStateReplicating ||
+				rep.Checkpoint.CheckpointTs < c.checkpointTs {
+				caughtUp = false
+				return false
+			}
+			return true
+		})
+	return found && caughtUp
+}
+
 func (c *coordinator) Close(ctx context.Context) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -298,11 +342,13 @@ func (c *coordinator) poll(
 		}
 	}
 
+	c.checkpointTs = checkpointTs
 	c.tableRanges.UpdateTables(currentTables)
+	checkpointRanges := c.updateCheckpointRanges(currentTables)
 	if !c.captureM.CheckAllCaptureInitialized() {
 		// Skip generating schedule tasks for replication manager,
 		// as not all capture are initialized.
-		newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(&c.tableRanges, pdTime)
+		newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(checkpointRanges, pdTime)
 		return newCheckpointTs, newResolvedTs, c.sendMsgs(ctx, msgBuf)
 	}
 
@@ -323,6 +369,7 @@ func (c *coordinator) poll(
 		ctx, &c.tableRanges, replications, c.captureM.Captures, c.compat)
 	allTasks := c.schedulerM.Schedule(
 		checkpointTs, currentSpans, c.captureM.Captures, replications, runningTasks)
+	c.startCatchUpTables(allTasks)
 
 	// Handle generated schedule tasks.
 	msgs, err = c.replicationM.HandleTasks(allTasks)
@@ -338,10 +385,52 @@ func (c *coordinator) poll(
 	}
 
 	// Checkpoint calculation
-	newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(&c.tableRanges, pdTime)
+	newCheckpointTs, newResolvedTs = c.replicationM.AdvanceCheckpoint(checkpointRanges, pdTime)
 	return newCheckpointTs, newResolvedTs, nil
 }
 
+// updateCheckpointRanges returns the tables that the global watermarks are
+// calculated from, the resumed tables that are catching up are excluded.
+func (c *coordinator) updateCheckpointRanges(
+	currentTables []model.TableID,
+) *replication.TableRanges {
+	if len(c.catchUpTables) == 0 {
+		return &c.tableRanges
+	}
+	tables := make([]model.TableID, 0, len(currentTables))
+	for _, tableID := range currentTables {
+		if _, ok := c.catchUpTables[tableID]; !ok {
+			tables = append(tables, tableID)
+		}
+	}
+	c.checkpointRanges.UpdateTables(tables)
+	return &c.checkpointRanges
+}
+
+// startCatchUpTables starts the resumed tables at their own ts instead of
+// the global checkpoint, so that they catch up with the changes made while
+// they were paused.
+func (c *coordinator) startCatchUpTables(tasks []*replication.ScheduleTask) {
+	if len(c.catchUpTables) == 0 {
+		return
+	}
+	adjust := func(task *replication.AddTable) {
+		if ts, ok := c.catchUpTables[task.Span.TableID]; ok && ts < task.CheckpointTs {
+			task.CheckpointTs = ts
+		}
+	}
+	for _, task := range tasks {
+		if task.AddTable != nil {
+			adjust(task.AddTable)
+		}
+		if task.BurstBalance != nil {
+			for i := range task.BurstBalance.AddTables {
+				adjust(&task.BurstBalance.AddTables[i])
+			}
+		}
+	}
+}
+
 func (c *coordinator) recvMsgs(ctx context.Context) ([]*schedulepb.Message, error) {
 	recvMsgs, err := c.trans.Recv(ctx)
 	if err != nil {
@@ -512,6 +512,61 @@ func TestCoordinatorAdvanceCheckpoint(t *testing.T) {
 	require.EqualValues(t, 5, rts)
 }
 
+func TestCoordinatorCatchUpTables(t *testing.T) {
+	t.Parallel()
+
+	coord, _ := newTestCoordinator(&config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		CollectStatsTick:   math.MaxInt,
+		MaxTaskConcurrency: 1,
+		ChangefeedSettings: config.GetDefaultReplicaConfig().Scheduler,
+	})
+	catchUpTables := map[model.TableID]model.Ts{1: 5}
+	// No table is caught up before the first tick.
+	require.Empty(t, coord.CatchUpTables(catchUpTables))
+
+	// The resumed table is started at its own ts.
+	tasks := []*replication.ScheduleTask{
+		{AddTable: &replication.AddTable{
+			Span: spanz.TableIDToComparableSpan(1), CheckpointTs: 10,
+		}},
+		{BurstBalance: &replication.BurstBalance{AddTables: []replication.AddTable{
+			{Span: spanz.TableIDToComparableSpan(1), CheckpointTs: 10},
+			{Span: spanz.TableIDToComparableSpan(2), CheckpointTs: 10},
+		}}},
+	}
+	coord.startCatchUpTables(tasks)
+	require.EqualValues(t, 5, tasks[0].AddTable.CheckpointTs)
+	require.EqualValues(t, 5, tasks[1].BurstBalance.AddTables[0].CheckpointTs)
+	require.EqualValues(t, 10, tasks[1].BurstBalance.AddTables[1].CheckpointTs)
+
+	// The resumed table is excluded from the checkpoint calculation.
+	var tables []model.TableID
+	coord.updateCheckpointRanges([]model.TableID{1, 2}).Iter(
+		func(tableID model.TableID, _, _ tablepb.Span) bool {
+			tables = append(tables, tableID)
+			return true
+		})
+	require.Equal(t, []model.TableID{2}, tables)
+
+	// The resumed table catches up with the global checkpoint.
+	coord.checkpointTs = 10
+	span := spanz.TableIDToComparableSpan(1)
+	rs, err := replication.NewReplicationSet(span, 8,
+		map[model.CaptureID]*tablepb.TableStatus{
+			"a": {
+				Span:       span,
+				State:      tablepb.TableStateReplicating,
+				Checkpoint: tablepb.Checkpoint{CheckpointTs: 8, ResolvedTs: 12},
+			},
+		}, coord.changefeedID)
+	require.Nil(t, err)
+	coord.replicationM.SetReplicationSetForTests(rs)
+	require.Empty(t, coord.CatchUpTables(catchUpTables))
+	rs.Checkpoint.CheckpointTs = 10
+	require.Equal(t, []model.TableID{1}, coord.CatchUpTables(catchUpTables))
+}
+
 func TestCoordinatorDropMsgIfChangefeedEpochMismatch(t *testing.T) {
 	t.Parallel()
 
@@ -15,7 +15,9 @@ package v3
 
 import (
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 )
 
 var _ internal.InfoProvider = (*coordinator)(nil)
@@ -48,3 +50,113 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 	}
 	return tasks, nil
 }
+
+// GetTableProgresses returns the replication progresses of all tables.
+func (c *coordinator) GetTableProgresses() ([]*model.TableProgress, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var progresses []*model.TableProgress
+	tables := make(map[model.TableID]*model.TableProgress)
+	c.replicationM.ReplicationSets().Ascend(
+		func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+			phase := tablePhase(rep)
+			progress, ok := tables[span.TableID]
+			if !ok {
+				progress = &model.TableProgress{
+					TableID:      span.TableID,
+					Phase:        phase,
+					CheckpointTs: rep.Checkpoint.CheckpointTs,
+					ResolvedTs:   rep.Checkpoint.ResolvedTs,
+				}
+				tables[span.TableID] = progress
+				progresses = append(progresses, progress)
+			}
+			progress.SpanCount++
+			if tablePhaseOrder[phase] < tablePhaseOrder[progress.Phase] {
+				progress.Phase = phase
+			}
+			if rep.Checkpoint.CheckpointTs < progress.CheckpointTs {
+				progress.CheckpointTs = rep.Checkpoint.CheckpointTs
+			}
+			if rep.Checkpoint.ResolvedTs < progress.ResolvedTs {
+				progress.ResolvedTs = rep.Checkpoint.ResolvedTs
+			}
+			if rep.Primary != "" {
+				progress.Captures = appendCapture(progress.Captures, rep.Primary)
+			}
+			return true
+		})
+	return progresses, nil
+}
+
+// GetCaptureTableStats returns the statistics of the tables replicated by
+// each capture, a table split into spans is counted once by each capture
+// replicating its spans.
+func (c *coordinator) GetCaptureTableStats() (map[model.CaptureID]*model.CaptureTableStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := make(map[model.CaptureID]*model.CaptureTableStats, len(c.captureM.Captures))
+	for captureID := range c.captureM.Captures {
+		stats[captureID] = &model.CaptureTableStats{}
+	}
+	tables := make(map[model.CaptureID]map[model.TableID]struct{})
+	c.replicationM.ReplicationSets().Ascend(
+		func(span tablepb.Span, rep *replication.ReplicationSet) bool {
+			if rep.Primary == "" {
+				return true
+			}
+			s, ok := stats[rep.Primary]
+			if !ok {
+				s = &model.CaptureTableStats{}
+				stats[rep.Primary] = s
+			}
+			s.RegionCount += rep.Stats.RegionCount
+			if tables[rep.Primary] == nil {
+				tables[rep.Primary] = make(map[model.TableID]struct{})
+			}
+			if _, ok := tables[rep.Primary][span.TableID]; !ok {
+				tables[rep.Primary][span.TableID] = struct{}{}
+				s.TableCount++
+			}
+			return true
+		})
+	return stats, nil
+}
+
+// tablePhaseOrder orders the phases from the least to the most advanced one,
+// the least advanced phase of the spans is the phase of a table.
+var tablePhaseOrder = map[model.TablePhase]int{
+	model.TablePhaseAbsent:      0,
+	model.TablePhaseScanning:    1,
+	model.TablePhaseRemoving:    2,
+	model.TablePhaseReplicating: 3,
+}
+
+func tablePhase(rep *replication.ReplicationSet) model.TablePhase {
+	switch rep.State {
+	case replication.ReplicationSetStateReplicating:
+		return model.TablePhaseReplicating
+	case replication.ReplicationSetStatePrepare, replication.ReplicationSetStateCommit:
+		// The primary keeps replicating the table while it's being moved
+		// to another capture.
+		if rep.Primary != "" {
+			return model.TablePhaseReplicating
+		}
+		return model.TablePhaseScanning
+	case replication.ReplicationSetStateRemoving:
+		return model.TablePhaseRemoving
+	default:
+		return model.TablePhaseAbsent
+	}
+}
+
+func appendCapture(captures []model.CaptureID, captureID model.CaptureID) []model.CaptureID {
+	for _, id := range captures {
+		if id == captureID {
+			return captures
+		}
+	}
+	return append(captures, captureID)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
@@ -89,3 +90,100 @@ func TestInfoProviderIsInitialized(t *testing.T) {
 	coord.captureM.SetInitializedForTests(true)
 	require.True(t, ip.IsInitialized())
 }
+
+func TestInfoProviderTableProgresses(t *testing.T) {
+	t.Parallel()
+
+	coord := newCoordinator("a", model.ChangeFeedID{}, 1, &config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 1,
+		ChangefeedSettings: config.GetDefaultReplicaConfig().Scheduler,
+	})
+	// Table 1 is split into two spans replicated by different captures.
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 1, StartKey: []byte("a"), EndKey: []byte("b")},
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "a",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 5, ResolvedTs: 8},
+	})
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 1, StartKey: []byte("b"), EndKey: []byte("c")},
+		State:      replication.ReplicationSetStateReplicating,
+		Primary:    "b",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 3, ResolvedTs: 9},
+	})
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 2},
+		State:      replication.ReplicationSetStatePrepare,
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 2, ResolvedTs: 2},
+	})
+	// Table 3 is being moved from capture a to b.
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:       tablepb.Span{TableID: 3},
+		State:      replication.ReplicationSetStateCommit,
+		Primary:    "a",
+		Checkpoint: tablepb.Checkpoint{CheckpointTs: 4, ResolvedTs: 4},
+	})
+
+	var ip internal.InfoProvider = coord
+	progresses, err := ip.GetTableProgresses()
+	require.Nil(t, err)
+	require.Equal(t, []*model.TableProgress{{
+		TableID:      1,
+		Captures:     []model.CaptureID{"a", "b"},
+		Phase:        model.TablePhaseReplicating,
+		CheckpointTs: 3,
+		ResolvedTs:   8,
+		SpanCount:    2,
+	}, {
+		TableID:      2,
+		Phase:        model.TablePhaseScanning,
+		CheckpointTs: 2,
+		ResolvedTs:   2,
+		SpanCount:    1,
+	}, {
+		TableID:      3,
+		Captures:     []model.CaptureID{"a"},
+		Phase:        model.TablePhaseReplicating,
+		CheckpointTs: 4,
+		ResolvedTs:   4,
+		SpanCount:    1,
+	}}, progresses)
+}
+
+func TestInfoProviderCaptureTableStats(t *testing.T) {
+	t.Parallel()
+
+	coord := newCoordinator("a", model.ChangeFeedID{}, 1, &config.SchedulerConfig{
+		HeartbeatTick:      math.MaxInt,
+		MaxTaskConcurrency: 1,
+		ChangefeedSettings: config.GetDefaultReplicaConfig().Scheduler,
+	})
+	coord.captureM.Captures = map[model.CaptureID]*member.CaptureStatus{
+		"a": {}, "b": {},
+	}
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:    tablepb.Span{TableID: 1, StartKey: []byte("a"), EndKey: []byte("b")},
+		State:   replication.ReplicationSetStateReplicating,
+		Primary: "a",
+		Stats:   tablepb.Stats{RegionCount: 2},
+	})
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:    tablepb.Span{TableID: 1, StartKey: []byte("b"), EndKey: []byte("c")},
+		State:   replication.ReplicationSetStateReplicating,
+		Primary: "a",
+		Stats:   tablepb.Stats{RegionCount: 3},
+	})
+	coord.replicationM.SetReplicationSetForTests(&replication.ReplicationSet{
+		Span:  tablepb.Span{TableID: 2},
+		State: replication.ReplicationSetStateAbsent,
+	})
+
+	var ip internal.InfoProvider = coord
+	stats, err := ip.GetCaptureTableStats()
+	require.Nil(t, err)
+	require.Equal(t, map[model.CaptureID]*model.CaptureTableStats{
+		"a": {TableCount: 1, RegionCount: 5},
+		"b": {},
+	}, stats)
+}
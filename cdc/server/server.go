@@ -28,10 +28,14 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/util/gctuner"
 	"github.com/pingcap/tiflow/cdc"
+	"github.com/pingcap/tiflow/cdc/api/admin"
+	"github.com/pingcap/tiflow/cdc/api/middleware"
 	"github.com/pingcap/tiflow/cdc/capture"
 	"github.com/pingcap/tiflow/cdc/contextutil"
 	"github.com/pingcap/tiflow/cdc/kv"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
+	epebble "github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/pebble"
+	"github.com/pingcap/tiflow/pkg/audit"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/etcd"
@@ -41,6 +45,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/tcpserver"
 	"github.com/pingcap/tiflow/pkg/util"
+	adminProto "github.com/pingcap/tiflow/proto/admin"
 	p2pProto "github.com/pingcap/tiflow/proto/p2p"
 	pd "github.com/tikv/pd/client"
 	"go.etcd.io/etcd/client/pkg/v3/logutil"
@@ -85,6 +90,9 @@ type server struct {
 	etcdClient        etcd.CDCEtcdClient
 	pdEndpoints       []string
 	sortEngineFactory *factory.SortEngineFactory
+	// auditLogger is shared by the HTTP API and the admin service, it's nil
+	// if the audit log is disabled.
+	auditLogger *audit.Logger
 }
 
 // New creates a server instance.
@@ -109,11 +117,20 @@ func New(pdEndpoints []string) (*server, error) {
 		return nil, errors.Trace(err)
 	}
 
+	// Open the audit log at startup, so a bad config fails the server
+	// instead of leaving the API calls unrecorded.
+	auditLogger, err := audit.NewLogger(conf.Audit)
+	if err != nil {
+		_ = tcpServer.Close()
+		return nil, errors.Trace(err)
+	}
+
 	debugConfig := config.GetGlobalServerConfig().Debug
 	s := &server{
 		pdEndpoints: pdEndpoints,
 		grpcService: p2p.NewServerWrapper(debugConfig.Messages.ToMessageServerConfig()),
 		tcpServer:   tcpServer,
+		auditLogger: auditLogger,
 	}
 
 	log.Info("CDC server created",
@@ -181,7 +198,9 @@ func (s *server) prepare(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
-	s.createSortEngineFactory()
+	if err := s.createSortEngineFactory(); err != nil {
+		return errors.Trace(err)
+	}
 
 	if err := s.setMemoryLimit(); err != nil {
 		return errors.Trace(err)
@@ -211,7 +230,7 @@ func (s *server) setMemoryLimit() error {
 	return nil
 }
 
-func (s *server) createSortEngineFactory() {
+func (s *server) createSortEngineFactory() error {
 	conf := config.GetGlobalServerConfig()
 	if s.sortEngineFactory != nil {
 		if err := s.sortEngineFactory.Close(); err != nil {
@@ -224,11 +243,28 @@ func (s *server) createSortEngineFactory() {
 	// See https://github.com/pingcap/tiflow/blob/9dad09/cdc/server.go#L275
 	sortDir := config.GetGlobalServerConfig().Sorter.SortDir
 	memInBytes := conf.Sorter.CacheSizeInMB * uint64(1<<20)
-	s.sortEngineFactory = factory.NewForPebble(sortDir, memInBytes, conf.Debug.DB)
+	dbConfig, cacheInBytes := conf.Sorter.ApplySpillPolicy(conf.Debug.DB)
+	maxDiskInBytes := conf.Sorter.MaxDiskUsagePerChangefeedInMB * uint64(1<<20)
+	var encryptionKey []byte
+	if conf.Sorter.EncryptionKeyFile != "" {
+		key, err := epebble.LoadEncryptionKey(conf.Sorter.EncryptionKeyFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		encryptionKey = key
+	}
+	s.sortEngineFactory = factory.NewForPebble(
+		sortDir, cacheInBytes, dbConfig, maxDiskInBytes, encryptionKey)
 	log.Info("sorter engine memory limit",
 		zap.Uint64("bytes", memInBytes),
 		zap.String("memory", humanize.IBytes(memInBytes)),
+		zap.String("cache", humanize.IBytes(cacheInBytes)),
+		zap.Int("writerBufferSize", dbConfig.WriterBufferSize),
+		zap.String("compression", dbConfig.Compression),
+		zap.Uint64("maxDiskUsagePerChangefeed", maxDiskInBytes),
+		zap.Bool("encryption", encryptionKey != nil),
 	)
+	return nil
 }
 
 // Run runs the server.
@@ -264,7 +300,7 @@ func (s *server) startStatusHTTP(serverCtx context.Context, lis net.Listener) er
 	router.Use(gin.RecoveryWithWriter(logWritter))
 	// router.
 	// Register APIs.
-	cdc.RegisterRoutes(router, s.capture, registry)
+	cdc.RegisterRoutes(router, s.capture, registry, s.auditLogger)
 
 	// No need to configure TLS because it is already handled by `s.tcpServer`.
 	// Add ReadTimeout and WriteTimeout to avoid some abnormal connections never close.
@@ -350,8 +386,15 @@ func (s *server) run(ctx context.Context) (err error) {
 		return s.tcpServer.Run(cctx)
 	})
 
-	grpcServer := grpc.NewServer(s.grpcService.ServerOptions()...)
+	// The admin service is guarded in the same way as the HTTP API.
+	guard := admin.NewGuard(cdc.NewAuthenticator(s.capture),
+		middleware.NewRateLimiter(config.GetGlobalServerConfig().RateLimit),
+		s.auditLogger)
+	grpcServer := grpc.NewServer(append(s.grpcService.ServerOptions(),
+		grpc.ChainUnaryInterceptor(guard.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(guard.StreamServerInterceptor()))...)
 	p2pProto.RegisterCDCPeerToPeerServer(grpcServer, s.grpcService)
+	adminProto.RegisterCDCAdminServer(grpcServer, admin.NewServer(s.capture))
 
 	wg.Go(func() error {
 		return grpcServer.Serve(s.tcpServer.GrpcListener())
@@ -394,6 +437,9 @@ func (s *server) Close() {
 		}
 		s.tcpServer = nil
 	}
+	if err := s.auditLogger.Close(); err != nil {
+		log.Error("close audit logger", zap.Error(err))
+	}
 }
 
 func (s *server) closeSortEngineFactory() {
@@ -114,6 +114,7 @@ func NewKafkaDDLSink(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	encoderConfig.SchemaRegistryCredential = options.Credential
 
 	s, err := newDDLSink(ctx, p, adminClient, topicManager, eventRouter, encoderConfig)
 	if err != nil {
@@ -51,6 +51,10 @@ type DDLSink struct {
 	topicManager manager.TopicManager
 	// encoderBuilder builds encoder for the sink.
 	encoderBuilder codec.RowEventEncoderBuilder
+	// overriddenBuilders builds encoders for the protocols overridden by the
+	// dispatch rules, the DDL and checkpoint events sent to the topics of these
+	// rules are encoded by the overridden protocols.
+	overriddenBuilders map[config.Protocol]codec.RowEventEncoderBuilder
 	// producer used to send events to the MQ system.
 	// Usually it is a sync producer.
 	producer ddlproducer.DDLProducer
@@ -73,16 +77,26 @@ func newDDLSink(ctx context.Context,
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
 	}
+	protocols, builders, err := builder.NewOverriddenRowEventEncoderBuilders(
+		ctx, encoderConfig, eventRouter.GetOverriddenProtocols())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
+	}
+	overriddenBuilders := make(map[config.Protocol]codec.RowEventEncoderBuilder, len(protocols))
+	for i, protocol := range protocols {
+		overriddenBuilders[protocol] = builders[i]
+	}
 
 	s := &DDLSink{
-		id:             changefeedID,
-		protocol:       encoderConfig.Protocol,
-		eventRouter:    eventRouter,
-		topicManager:   topicManager,
-		encoderBuilder: encoderBuilder,
-		producer:       producer,
-		statistics:     metrics.NewStatistics(ctx, sink.RowSink),
-		admin:          adminClient,
+		id:                 changefeedID,
+		protocol:           encoderConfig.Protocol,
+		eventRouter:        eventRouter,
+		topicManager:       topicManager,
+		encoderBuilder:     encoderBuilder,
+		overriddenBuilders: overriddenBuilders,
+		producer:           producer,
+		statistics:         metrics.NewStatistics(ctx, sink.RowSink),
+		admin:              adminClient,
 	}
 
 	return s, nil
@@ -90,7 +104,11 @@ func newDDLSink(ctx context.Context,
 
 // WriteDDLEvent encodes the DDL event and sends it to the MQ system.
 func (k *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error {
-	encoder := k.encoderBuilder.Build()
+	protocol, ok := k.eventRouter.GetProtocolForDDL(ddl)
+	if !ok {
+		protocol = k.protocol
+	}
+	encoder := k.encoderBuilderFor(protocol).Build()
 	msg, err := encoder.EncodeDDLEvent(ddl)
 	if err != nil {
 		return errors.Trace(err)
@@ -98,14 +116,14 @@ func (k *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error
 	if msg == nil {
 		log.Info("Skip ddl event", zap.Uint64("commitTs", ddl.CommitTs),
 			zap.String("query", ddl.Query),
-			zap.String("protocol", k.protocol.String()),
+			zap.String("protocol", protocol.String()),
 			zap.String("namespace", k.id.Namespace),
 			zap.String("changefeed", k.id.ID))
 		return nil
 	}
 
 	topic := k.eventRouter.GetTopicForDDL(ddl)
-	partitionRule := k.eventRouter.GetDLLDispatchRuleByProtocol(k.protocol)
+	partitionRule := k.eventRouter.GetDLLDispatchRuleByProtocol(protocol)
 	log.Debug("Emit ddl event",
 		zap.Uint64("commitTs", ddl.CommitTs),
 		zap.String("query", ddl.Query),
@@ -139,18 +157,18 @@ func (k *DDLSink) WriteDDLEvent(ctx context.Context, ddl *model.DDLEvent) error
 func (k *DDLSink) WriteCheckpointTs(ctx context.Context,
 	ts uint64, tables []*model.TableInfo,
 ) error {
-	encoder := k.encoderBuilder.Build()
-	msg, err := encoder.EncodeCheckpointEvent(ts)
-	if err != nil {
-		return errors.Trace(err)
-	}
-	if msg == nil {
-		return nil
-	}
 	// NOTICE: When there are no tables to replicate,
 	// we need to send checkpoint ts to the default topic.
 	// This will be compatible with the old behavior.
 	if len(tables) == 0 {
+		encoder := k.encoderBuilder.Build()
+		msg, err := encoder.EncodeCheckpointEvent(ts)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if msg == nil {
+			return nil
+		}
 		topic := k.eventRouter.GetDefaultTopic()
 		partitionNum, err := k.topicManager.GetPartitionNum(ctx, topic)
 		if err != nil {
@@ -165,8 +183,30 @@ func (k *DDLSink) WriteCheckpointTs(ctx context.Context,
 	for _, table := range tables {
 		tableNames = append(tableNames, table.TableName)
 	}
-	topics := k.eventRouter.GetActiveTopics(tableNames)
-	for _, topic := range topics {
+	topics, protocols, err := k.eventRouter.GetActiveTopicsWithProtocol(tableNames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The checkpoint event sent to each topic is encoded by the protocol of
+	// the topic, msgs caches the encoded message of each protocol.
+	msgs := make(map[config.Protocol]*common.Message)
+	for i, topic := range topics {
+		protocol := protocols[i]
+		if protocol == config.ProtocolUnknown {
+			protocol = k.protocol
+		}
+		msg, ok := msgs[protocol]
+		if !ok {
+			encoder := k.encoderBuilderFor(protocol).Build()
+			msg, err = encoder.EncodeCheckpointEvent(ts)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			msgs[protocol] = msg
+		}
+		if msg == nil {
+			continue
+		}
 		partitionNum, err := k.topicManager.GetPartitionNum(ctx, topic)
 		if err != nil {
 			return errors.Trace(err)
@@ -179,6 +219,14 @@ func (k *DDLSink) WriteCheckpointTs(ctx context.Context,
 	return nil
 }
 
+// encoderBuilderFor returns the encoder builder of the protocol.
+func (k *DDLSink) encoderBuilderFor(protocol config.Protocol) codec.RowEventEncoderBuilder {
+	if b, ok := k.overriddenBuilders[protocol]; ok {
+		return b
+	}
+	return k.encoderBuilder
+}
+
 // Close closes the sink.
 func (k *DDLSink) Close() {
 	if k.producer != nil {
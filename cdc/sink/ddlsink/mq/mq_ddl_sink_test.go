@@ -263,6 +263,66 @@ func TestWriteCheckpointTsToTableTopics(t *testing.T) {
 	require.Len(t, s.producer.(*ddlproducer.MockDDLProducer).GetEvents("cdc_person2", 0), 1)
 }
 
+func TestWriteCheckpointTsWithOverriddenProtocol(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader, topic := initBroker(t, kafka.DefaultMockPartitionNum)
+	defer leader.Close()
+	// Notice: auto create topic is true. Auto created topic will have 1 partition.
+	uriTemplate := "kafka://%s/%s?kafka-version=0.9.0.0&max-batch-size=1" +
+		"&max-message-bytes=1048576&partition-num=1" +
+		"&kafka-client-id=unit-test&auto-create-topic=true&compression=gzip" +
+		"&protocol=canal-json&enable-tidb-extension=true"
+	uri := fmt.Sprintf(uriTemplate, leader.Addr(), topic)
+
+	sinkURI, err := url.Parse(uri)
+	require.Nil(t, err)
+	replicaConfig := config.GetDefaultReplicaConfig()
+	require.Nil(t, replicaConfig.ValidateAndAdjust(sinkURI))
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{
+			Matcher:      []string{"cdc.person"},
+			TopicRule:    "{schema}_{table}",
+			ProtocolRule: "open-protocol",
+		},
+		{
+			Matcher:      []string{"cdc.person1"},
+			TopicRule:    "{schema}_{table}",
+			ProtocolRule: "simple-json",
+		},
+	}
+
+	s, err := NewKafkaDDLSink(ctx, sinkURI, replicaConfig,
+		kafka.NewMockFactory,
+		ddlproducer.NewMockDDLProducer)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+
+	checkpointTs := uint64(417318403368288260)
+	tables := []*model.TableInfo{
+		{TableName: model.TableName{Schema: "cdc", Table: "person"}},
+		{TableName: model.TableName{Schema: "cdc", Table: "person1"}},
+	}
+	err = s.WriteCheckpointTs(ctx, checkpointTs, tables)
+	require.Nil(t, err)
+
+	producer := s.producer.(*ddlproducer.MockDDLProducer)
+	// The simple json protocol does not encode checkpoint events.
+	require.Len(t, producer.GetAllEvents(), 4)
+	require.Len(t, producer.GetEvents("cdc_person1", 0), 0)
+	msgs := producer.GetEvents("cdc_person", 0)
+	require.Len(t, msgs, 1)
+	require.Equal(t, config.ProtocolOpen, msgs[0].Protocol)
+	for i := int32(0); i < kafka.DefaultMockPartitionNum; i++ {
+		msgs = producer.GetEvents("mock_topic", i)
+		require.Len(t, msgs, 1)
+		require.Equal(t, config.ProtocolCanalJSON, msgs[0].Protocol)
+	}
+}
+
 func TestWriteCheckpointTsWhenCanalJsonTiDBExtensionIsDisable(t *testing.T) {
 	t.Parallel()
 
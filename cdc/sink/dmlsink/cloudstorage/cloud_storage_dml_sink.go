@@ -35,6 +35,8 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/csv"
+	"github.com/pingcap/tiflow/pkg/sink/metadata"
 	putil "github.com/pingcap/tiflow/pkg/util"
 	"golang.org/x/sync/errgroup"
 )
@@ -84,6 +86,8 @@ type DMLSink struct {
 	}
 
 	statistics *metrics.Statistics
+	// injector appends the metadata columns to the rows and the schemas.
+	injector *metadata.Injector
 
 	cancel func()
 	wg     sync.WaitGroup
@@ -125,17 +129,27 @@ func NewDMLSink(ctx context.Context,
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	encoderConfig.ChangefeedID = contextutil.ChangefeedIDFromCtx(ctx)
 	encoderBuilder, err := builder.NewTxnEventEncoderBuilder(encoderConfig)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrStorageSinkInvalidConfig, err)
 	}
 
+	// the csv data files can carry a header row with the column names.
+	var headerBuilder func(tableInfo *model.TableInfo) []byte
+	if protocol == config.ProtocolCsv && encoderConfig.OutputFieldHeader {
+		headerBuilder = func(tableInfo *model.TableInfo) []byte {
+			return csv.BuildHeader(encoderConfig, tableInfo)
+		}
+	}
+
 	wgCtx, wgCancel := context.WithCancel(ctx)
 	s := &DMLSink{
 		changefeedID:    contextutil.ChangefeedIDFromCtx(wgCtx),
 		encodingWorkers: make([]*encodingWorker, defaultEncodingConcurrency),
 		workers:         make([]*dmlWorker, cfg.WorkerCount),
 		statistics:      metrics.NewStatistics(wgCtx, sink.TxnSink),
+		injector:        metadata.NewInjector(replicaConfig.Sink),
 		cancel:          wgCancel,
 		dead:            make(chan struct{}),
 	}
@@ -155,7 +169,7 @@ func NewDMLSink(ctx context.Context,
 	clock := clock.New()
 	for i := 0; i < cfg.WorkerCount; i++ {
 		inputCh := chann.NewAutoDrainChann[eventFragment]()
-		s.workers[i] = newDMLWorker(i, s.changefeedID, storage, cfg, ext,
+		s.workers[i] = newDMLWorker(i, s.changefeedID, storage, cfg, ext, headerBuilder,
 			inputCh, clock, s.statistics)
 		workerChannels[i] = inputCh
 	}
@@ -225,6 +239,17 @@ func (s *DMLSink) WriteEvents(txns ...*dmlsink.CallbackableEvent[*model.SingleTa
 			continue
 		}
 
+		if s.injector != nil {
+			rows := make([]*model.RowChangedEvent, 0, len(txn.Event.Rows))
+			for _, row := range txn.Event.Rows {
+				rows = append(rows, s.injector.Inject(row))
+			}
+			txn.Event.Rows = rows
+			// The schema files and the csv headers are generated by the table
+			// info, so the metadata columns are appended to it as well.
+			txn.Event.TableInfo = s.injector.InjectTableInfo(txn.Event.TableInfo)
+		}
+
 		tbl := cloudstorage.VersionedTableName{
 			TableNameWithPhysicTableID: *txn.Event.Table,
 			TableInfoVersion:           txn.Event.TableInfoVersion,
@@ -51,9 +51,12 @@ type dmlWorker struct {
 	isClosed          uint64
 	statistics        *metrics.Statistics
 	filePathGenerator *cloudstorage.FilePathGenerator
-	bufferPool        sync.Pool
-	metricWriteBytes  prometheus.Gauge
-	metricFileCount   prometheus.Gauge
+	// headerBuilder builds the header written at the beginning of each data
+	// file, it is nil if no header is needed.
+	headerBuilder    func(tableInfo *model.TableInfo) []byte
+	bufferPool       sync.Pool
+	metricWriteBytes prometheus.Gauge
+	metricFileCount  prometheus.Gauge
 }
 
 type tableEventsMap struct {
@@ -83,6 +86,7 @@ func newDMLWorker(
 	storage storage.ExternalStorage,
 	config *cloudstorage.Config,
 	extension string,
+	headerBuilder func(tableInfo *model.TableInfo) []byte,
 	inputCh *chann.DrainableChann[eventFragment],
 	clock clock.Clock,
 	statistics *metrics.Statistics,
@@ -98,6 +102,7 @@ func newDMLWorker(
 		fileSize:          make(map[cloudstorage.VersionedTableName]uint64),
 		statistics:        statistics,
 		filePathGenerator: cloudstorage.NewFilePathGenerator(config, storage, extension, clock),
+		headerBuilder:     headerBuilder,
 		bufferPool: sync.Pool{
 			New: func() interface{} {
 				return new(bytes.Buffer)
@@ -194,7 +199,7 @@ func (d *dmlWorker) flushMessages(ctx context.Context) error {
 				// (file is not generated at all), then after TiCDC recovers from the crash,
 				// storage sink will generate a new file named CDC000003.csv,
 				// we will optimize this issue later.
-				err = d.writeDataFile(ctx, dataFilePath, events)
+				err = d.writeDataFile(ctx, dataFilePath, tbl.tableInfo, events)
 				if err != nil {
 					log.Error("failed to write data file to external storage",
 						zap.Int("workerID", d.id),
@@ -222,7 +227,10 @@ func (d *dmlWorker) writeIndexFile(ctx context.Context, path, content string) er
 	return err
 }
 
-func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eventFragment) error {
+func (d *dmlWorker) writeDataFile(
+	ctx context.Context, path string,
+	tableInfo *model.TableInfo, events []eventFragment,
+) error {
 	var callbacks []func()
 
 	rowsCnt := 0
@@ -230,6 +238,10 @@ func (d *dmlWorker) writeDataFile(ctx context.Context, path string, events []eve
 	defer d.bufferPool.Put(buf)
 	buf.Reset()
 
+	if d.headerBuilder != nil && tableInfo != nil {
+		buf.Write(d.headerBuilder(tableInfo))
+	}
+
 	for _, frag := range events {
 		msgs := frag.encodedMsgs
 		d.statistics.ObserveRows(frag.event.Event.Rows...)
@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/log"
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/key"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/partition"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -74,6 +75,12 @@ type EventRouter struct {
 	rules        []struct {
 		partitionDispatcher partition.Dispatcher
 		topicDispatcher     topic.Dispatcher
+		// partitionRule is the partition rule of the rule config.
+		partitionRule string
+		// protocol is ProtocolUnknown if the rule does not override it.
+		protocol config.Protocol
+		// keyTemplate is nil if the rule does not override the message key.
+		keyTemplate *key.Template
 		filter.Filter
 	}
 }
@@ -91,6 +98,9 @@ func NewEventRouter(cfg *config.ReplicaConfig, defaultTopic string) (*EventRoute
 	rules := make([]struct {
 		partitionDispatcher partition.Dispatcher
 		topicDispatcher     topic.Dispatcher
+		partitionRule       string
+		protocol            config.Protocol
+		keyTemplate         *key.Template
 		filter.Filter
 	}, 0, len(ruleConfigs))
 
@@ -104,15 +114,42 @@ func NewEventRouter(cfg *config.ReplicaConfig, defaultTopic string) (*EventRoute
 		}
 
 		d := getPartitionDispatcher(ruleConfig, cfg.EnableOldValue)
-		t, err := getTopicDispatcher(ruleConfig, defaultTopic, util.GetOrZero(cfg.Sink.Protocol))
+		protocol := util.GetOrZero(cfg.Sink.Protocol)
+		p := config.ProtocolUnknown
+		if ruleConfig.ProtocolRule != "" {
+			p, err = config.ParseSinkProtocolFromString(ruleConfig.ProtocolRule)
+			if err != nil {
+				return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
+			}
+			// The default topic receives the DDL and checkpoint events
+			// encoded by the protocol of the changefeed, so it cannot be
+			// shared with the tables whose protocol is overridden.
+			if ruleConfig.TopicRule == "" || ruleConfig.TopicRule == defaultTopic {
+				return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+					"the dispatch rule which overrides the protocol must dispatch "+
+						"to topics other than the default topic, rule: %v", ruleConfig.Matcher)
+			}
+			protocol = ruleConfig.ProtocolRule
+		}
+		t, err := getTopicDispatcher(ruleConfig, defaultTopic, protocol)
+		if err != nil {
+			return nil, err
+		}
+		k, err := getKeyTemplate(ruleConfig, protocol)
 		if err != nil {
 			return nil, err
 		}
 		rules = append(rules, struct {
 			partitionDispatcher partition.Dispatcher
 			topicDispatcher     topic.Dispatcher
+			partitionRule       string
+			protocol            config.Protocol
+			keyTemplate         *key.Template
 			filter.Filter
-		}{partitionDispatcher: d, topicDispatcher: t, Filter: f})
+		}{
+			partitionDispatcher: d, topicDispatcher: t, partitionRule: ruleConfig.PartitionRule,
+			protocol: p, keyTemplate: k, Filter: f,
+		})
 	}
 
 	return &EventRouter{
@@ -127,27 +164,46 @@ func (s *EventRouter) GetTopicForRowChange(row *model.RowChangedEvent) string {
 	return topicDispatcher.Substitute(row.Table.Schema, row.Table.Table)
 }
 
-// GetTopicForDDL returns the target topic for DDL.
-func (s *EventRouter) GetTopicForDDL(ddl *model.DDLEvent) string {
-	var schema, table string
-	if ddl.PreTableInfo != nil {
-		if ddl.PreTableInfo.TableName.Table == "" {
-			return s.defaultTopic
+// GetTargetForTable returns the target topic and the partition rule of the
+// row changes of a table, the partition rule is "default" if it's not
+// specified.
+func (s *EventRouter) GetTargetForTable(schema, table string) (string, string) {
+	for _, rule := range s.rules {
+		if !rule.MatchTable(schema, table) {
+			continue
 		}
-		schema = ddl.PreTableInfo.TableName.Schema
-		table = ddl.PreTableInfo.TableName.Table
-	} else {
-		if ddl.TableInfo.TableName.Table == "" {
-			return s.defaultTopic
+		partitionRule := rule.partitionRule
+		if partitionRule == "" {
+			partitionRule = "default"
 		}
-		schema = ddl.TableInfo.TableName.Schema
-		table = ddl.TableInfo.TableName.Table
+		return rule.topicDispatcher.Substitute(schema, table), partitionRule
+	}
+	log.Panic("the dispatch rule must cover all tables")
+	return "", ""
+}
+
+// GetTopicForDDL returns the target topic for DDL.
+func (s *EventRouter) GetTopicForDDL(ddl *model.DDLEvent) string {
+	schema, table, ok := tableNameForDDL(ddl)
+	if !ok {
+		return s.defaultTopic
 	}
 
 	topicDispatcher, _ := s.matchDispatcher(schema, table)
 	return topicDispatcher.Substitute(schema, table)
 }
 
+// GetProtocolForDDL returns the protocol overridden by the dispatch rule which
+// the table of the DDL event matches, false is returned if it is not overridden
+// or the DDL event does not belong to any table.
+func (s *EventRouter) GetProtocolForDDL(ddl *model.DDLEvent) (config.Protocol, bool) {
+	schema, table, ok := tableNameForDDL(ddl)
+	if !ok {
+		return config.ProtocolUnknown, false
+	}
+	return s.matchProtocol(schema, table)
+}
+
 // GetPartitionForRowChange returns the target partition for row changes.
 func (s *EventRouter) GetPartitionForRowChange(
 	row *model.RowChangedEvent,
@@ -162,6 +218,62 @@ func (s *EventRouter) GetPartitionForRowChange(
 	)
 }
 
+// GetKeyForRowChange returns the message key rendered by the key template of
+// the dispatch rule which the row changed event matches, false is returned if
+// the rule does not override the message key.
+func (s *EventRouter) GetKeyForRowChange(row *model.RowChangedEvent) ([]byte, bool) {
+	for _, rule := range s.rules {
+		if !rule.MatchTable(row.Table.Schema, row.Table.Table) {
+			continue
+		}
+		if rule.keyTemplate == nil {
+			return nil, false
+		}
+		return rule.keyTemplate.Render(row), true
+	}
+	log.Panic("the dispatch rule must cover all tables")
+	return nil, false
+}
+
+// HasKeyTemplate returns whether any dispatch rule overrides the message key.
+func (s *EventRouter) HasKeyTemplate() bool {
+	for _, rule := range s.rules {
+		if rule.keyTemplate != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetProtocolForRowChange returns the protocol overridden by the dispatch rule
+// which the row changed event matches, false is returned if it is not overridden.
+func (s *EventRouter) GetProtocolForRowChange(
+	row *model.RowChangedEvent,
+) (config.Protocol, bool) {
+	return s.matchProtocol(row.Table.Schema, row.Table.Table)
+}
+
+// GetOverriddenProtocols returns the protocols overridden by the dispatch rules.
+func (s *EventRouter) GetOverriddenProtocols() []config.Protocol {
+	protocols := make([]config.Protocol, 0)
+	for _, rule := range s.rules {
+		if rule.protocol == config.ProtocolUnknown {
+			continue
+		}
+		exists := false
+		for _, p := range protocols {
+			if p == rule.protocol {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			protocols = append(protocols, rule.protocol)
+		}
+	}
+	return protocols
+}
+
 // GetDLLDispatchRuleByProtocol returns the DDL
 // distribution rule according to the protocol.
 func (s *EventRouter) GetDLLDispatchRuleByProtocol(
@@ -202,6 +314,35 @@ func (s *EventRouter) GetActiveTopics(activeTables []model.TableName) []string {
 	return topics
 }
 
+// GetActiveTopicsWithProtocol returns the same topics as GetActiveTopics, and
+// the protocol of each topic, which is ProtocolUnknown if the protocol of the
+// changefeed is used. An error is returned if a topic is shared by tables
+// encoded by different protocols.
+func (s *EventRouter) GetActiveTopicsWithProtocol(
+	activeTables []model.TableName,
+) ([]string, []config.Protocol, error) {
+	topics := s.GetActiveTopics(activeTables)
+	topicProtocols := make(map[string]config.Protocol, len(topics))
+	topicProtocols[s.defaultTopic] = config.ProtocolUnknown
+	for _, table := range activeTables {
+		topicDispatcher, _ := s.matchDispatcher(table.Schema, table.Table)
+		topicName := topicDispatcher.Substitute(table.Schema, table.Table)
+		protocol, _ := s.matchProtocol(table.Schema, table.Table)
+		if p, ok := topicProtocols[topicName]; ok && p != protocol {
+			return nil, nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+				"topic %s is shared by tables encoded by different protocols, table: %s",
+				topicName, table.String())
+		}
+		topicProtocols[topicName] = protocol
+	}
+
+	protocols := make([]config.Protocol, 0, len(topics))
+	for _, topic := range topics {
+		protocols = append(protocols, topicProtocols[topic])
+	}
+	return topics, protocols, nil
+}
+
 // GetDefaultTopic returns the default topic name.
 func (s *EventRouter) GetDefaultTopic() string {
 	return s.defaultTopic
@@ -222,6 +363,32 @@ func (s *EventRouter) matchDispatcher(
 	return nil, nil
 }
 
+// matchProtocol returns the protocol overridden by the dispatch rule which the
+// table matches, false is returned if it is not overridden.
+func (s *EventRouter) matchProtocol(schema, table string) (config.Protocol, bool) {
+	for _, rule := range s.rules {
+		if !rule.MatchTable(schema, table) {
+			continue
+		}
+		return rule.protocol, rule.protocol != config.ProtocolUnknown
+	}
+	log.Panic("the dispatch rule must cover all tables")
+	return config.ProtocolUnknown, false
+}
+
+// tableNameForDDL returns the table name which the DDL event belongs to,
+// false is returned if the DDL event does not belong to any table.
+func tableNameForDDL(ddl *model.DDLEvent) (string, string, bool) {
+	tableInfo := ddl.TableInfo
+	if ddl.PreTableInfo != nil {
+		tableInfo = ddl.PreTableInfo
+	}
+	if tableInfo.TableName.Table == "" {
+		return "", "", false
+	}
+	return tableInfo.TableName.Schema, tableInfo.TableName.Table, true
+}
+
 // getPartitionDispatcher returns the partition dispatcher for a specific partition rule.
 func getPartitionDispatcher(
 	ruleConfig *config.DispatchRule, enableOldValue bool,
@@ -281,3 +448,26 @@ func getTopicDispatcher(
 	}
 	return topic.NewDynamicTopicDispatcher(topicExpr), nil
 }
+
+// getKeyTemplate returns the message key template of the rule, nil is returned
+// if the rule does not override the message key. The key template can only be
+// used by the protocols which encode each row changed event into a standalone
+// message and do not carry data in the message key.
+func getKeyTemplate(ruleConfig *config.DispatchRule, protocol string) (*key.Template, error) {
+	if ruleConfig.KeyRule == "" {
+		return nil, nil
+	}
+	p, err := config.ParseSinkProtocolFromString(protocol)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
+	}
+	switch p {
+	case config.ProtocolCanalJSON, config.ProtocolDebezium,
+		config.ProtocolSimpleJSON, config.ProtocolPlugin:
+	default:
+		return nil, cerror.ErrKafkaInvalidConfig.GenWithStack(
+			"the message key template is not supported by %s protocol, rule: %v",
+			protocol, ruleConfig.Matcher)
+	}
+	return key.NewTemplate(ruleConfig.KeyRule)
+}
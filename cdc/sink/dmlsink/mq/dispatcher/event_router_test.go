@@ -21,6 +21,8 @@ import (
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/partition"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/mq/dispatcher/topic"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -209,6 +211,38 @@ func TestGetTopicForRowChange(t *testing.T) {
 	require.Equal(t, "a_table", topicName)
 }
 
+func TestGetTargetForTable(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewEventRouter(&config.ReplicaConfig{
+		Sink: &config.SinkConfig{
+			DispatchRules: []*config.DispatchRule{
+				{
+					Matcher:       []string{"test_table.*"},
+					PartitionRule: "table",
+					TopicRule:     "hello_{schema}_world",
+				},
+				{
+					Matcher:   []string{"test.*"},
+					TopicRule: "{schema}_{table}",
+				},
+			},
+		},
+	}, "test")
+	require.Nil(t, err)
+
+	topicName, partitionRule := d.GetTargetForTable("test_table", "t1")
+	require.Equal(t, "hello_test_table_world", topicName)
+	require.Equal(t, "table", partitionRule)
+	topicName, partitionRule = d.GetTargetForTable("test", "t1")
+	require.Equal(t, "test_t1", topicName)
+	require.Equal(t, "default", partitionRule)
+	// The tables not matching any rule are dispatched to the default topic.
+	topicName, partitionRule = d.GetTargetForTable("other", "t1")
+	require.Equal(t, "test", topicName)
+	require.Equal(t, "default", partitionRule)
+}
+
 func TestGetPartitionForRowChange(t *testing.T) {
 	t.Parallel()
 
@@ -487,3 +521,148 @@ func TestGetTopicForDDL(t *testing.T) {
 		require.Equal(t, test.expectedTopic, d.GetTopicForDDL(test.ddl))
 	}
 }
+
+func TestGetProtocolForRowChange(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{
+			Matcher:      []string{"typed.*"},
+			TopicRule:    "typed_{table}",
+			ProtocolRule: "avro",
+		},
+		{
+			Matcher:      []string{"raw.*"},
+			TopicRule:    "raw",
+			ProtocolRule: "canal-json",
+		},
+		{
+			Matcher:      []string{"typed_again.*"},
+			TopicRule:    "typed_again_{table}",
+			ProtocolRule: "avro",
+		},
+	}
+	d, err := NewEventRouter(replicaConfig, "test")
+	require.NoError(t, err)
+	require.Equal(t,
+		[]config.Protocol{config.ProtocolAvro, config.ProtocolCanalJSON},
+		d.GetOverriddenProtocols())
+
+	protocol, ok := d.GetProtocolForRowChange(&model.RowChangedEvent{
+		Table: &model.TableName{Schema: "typed", Table: "t1"},
+	})
+	require.True(t, ok)
+	require.Equal(t, config.ProtocolAvro, protocol)
+
+	protocol, ok = d.GetProtocolForRowChange(&model.RowChangedEvent{
+		Table: &model.TableName{Schema: "raw", Table: "t1"},
+	})
+	require.True(t, ok)
+	require.Equal(t, config.ProtocolCanalJSON, protocol)
+
+	_, ok = d.GetProtocolForRowChange(&model.RowChangedEvent{
+		Table: &model.TableName{Schema: "other", Table: "t1"},
+	})
+	require.False(t, ok)
+
+	protocol, ok = d.GetProtocolForDDL(&model.DDLEvent{
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "typed", Table: "t1"},
+		},
+	})
+	require.True(t, ok)
+	require.Equal(t, config.ProtocolAvro, protocol)
+
+	_, ok = d.GetProtocolForDDL(&model.DDLEvent{
+		TableInfo: &model.TableInfo{TableName: model.TableName{Schema: "typed"}},
+	})
+	require.False(t, ok)
+
+	topics, protocols, err := d.GetActiveTopicsWithProtocol([]model.TableName{
+		{Schema: "typed", Table: "t1"},
+		{Schema: "raw", Table: "t1"},
+		{Schema: "other", Table: "t1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"typed_t1", "raw", "test"}, topics)
+	require.Equal(t, []config.Protocol{
+		config.ProtocolAvro, config.ProtocolCanalJSON, config.ProtocolUnknown,
+	}, protocols)
+
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"*.*"}, TopicRule: "all", ProtocolRule: "unknown"},
+	}
+	_, err = NewEventRouter(replicaConfig, "test")
+	require.ErrorContains(t, err, "unknown")
+
+	// the default topic cannot be shared with the overridden protocol.
+	for _, topicRule := range []string{"", "test"} {
+		replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+			{Matcher: []string{"typed.*"}, TopicRule: topicRule, ProtocolRule: "avro"},
+		}
+		_, err = NewEventRouter(replicaConfig, "test")
+		require.ErrorContains(t, err, "default topic")
+	}
+
+	// a topic cannot be shared by tables encoded by different protocols.
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"typed.*"}, TopicRule: "shared", ProtocolRule: "avro"},
+		{Matcher: []string{"raw.*"}, TopicRule: "shared"},
+	}
+	d, err = NewEventRouter(replicaConfig, "test")
+	require.NoError(t, err)
+	_, _, err = d.GetActiveTopicsWithProtocol([]model.TableName{
+		{Schema: "typed", Table: "t1"},
+		{Schema: "raw", Table: "t1"},
+	})
+	require.ErrorContains(t, err, "different protocols")
+}
+
+func TestGetKeyForRowChange(t *testing.T) {
+	t.Parallel()
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.Protocol = util.AddressOf("canal-json")
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"keyed.*"}, KeyRule: "{schema}.{table}:{column:id}"},
+	}
+	d, err := NewEventRouter(replicaConfig, "test")
+	require.NoError(t, err)
+	require.True(t, d.HasKeyTemplate())
+
+	key, ok := d.GetKeyForRowChange(&model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "keyed", Table: "t1"},
+		Columns: []*model.Column{{Name: "id", Value: int64(1)}},
+	})
+	require.True(t, ok)
+	require.Equal(t, []byte("keyed.t1:1"), key)
+
+	_, ok = d.GetKeyForRowChange(&model.RowChangedEvent{
+		Table: &model.TableName{Schema: "other", Table: "t1"},
+	})
+	require.False(t, ok)
+
+	// the protocols which carry data in the message key are rejected.
+	replicaConfig.Sink.Protocol = util.AddressOf("avro")
+	_, err = NewEventRouter(replicaConfig, "test")
+	require.ErrorContains(t, err, "not supported by avro protocol")
+
+	// the protocol overridden by the rule is checked.
+	replicaConfig.Sink.DispatchRules[0].TopicRule = "keyed"
+	replicaConfig.Sink.DispatchRules[0].ProtocolRule = "simple-json"
+	_, err = NewEventRouter(replicaConfig, "test")
+	require.NoError(t, err)
+
+	replicaConfig.Sink.Protocol = util.AddressOf("canal-json")
+	replicaConfig.Sink.DispatchRules = []*config.DispatchRule{
+		{Matcher: []string{"keyed.*"}, KeyRule: "{unknown}"},
+	}
+	_, err = NewEventRouter(replicaConfig, "test")
+	require.True(t, cerror.ErrKafkaInvalidKeyTemplate.Equal(err))
+
+	replicaConfig.Sink.DispatchRules = nil
+	d, err = NewEventRouter(replicaConfig, "test")
+	require.NoError(t, err)
+	require.False(t, d.HasKeyTemplate())
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package key
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// The placeholders supported by the key template.
+const (
+	schemaPlaceholder   = "{schema}"
+	tablePlaceholder    = "{table}"
+	commitTsPlaceholder = "{commit-ts}"
+	// columnPrefix is the prefix of the column placeholder, e.g. {column:id}.
+	columnPrefix = "{column:"
+)
+
+type segmentKind int
+
+const (
+	segmentLiteral segmentKind = iota
+	segmentSchema
+	segmentTable
+	segmentCommitTs
+	segmentColumn
+)
+
+// segment is a literal text or a placeholder of the template, text is the
+// column name of a column placeholder.
+type segment struct {
+	kind segmentKind
+	text string
+}
+
+// Template renders the message key of a row changed event.
+// The template should be in form of literal texts and the following
+// placeholders: {schema}, {table}, {commit-ts} and {column:<name>}.
+// For example, `{schema}.{table}:{column:id}` renders the key `test.t:1`
+// for the row of which the id is 1 in table `test`.`t`.
+type Template struct {
+	expr     string
+	segments []segment
+}
+
+// NewTemplate parses the template expression.
+func NewTemplate(expr string) (*Template, error) {
+	t := &Template{expr: expr}
+	rest := expr
+	for len(rest) > 0 {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			t.segments = append(t.segments, segment{kind: segmentLiteral, text: rest})
+			break
+		}
+		if start > 0 {
+			t.segments = append(t.segments, segment{kind: segmentLiteral, text: rest[:start]})
+			rest = rest[start:]
+		}
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, errors.ErrKafkaInvalidKeyTemplate.GenWithStackByArgs(expr)
+		}
+		placeholder := rest[:end+1]
+		switch {
+		case placeholder == schemaPlaceholder:
+			t.segments = append(t.segments, segment{kind: segmentSchema})
+		case placeholder == tablePlaceholder:
+			t.segments = append(t.segments, segment{kind: segmentTable})
+		case placeholder == commitTsPlaceholder:
+			t.segments = append(t.segments, segment{kind: segmentCommitTs})
+		case strings.HasPrefix(placeholder, columnPrefix) &&
+			len(placeholder) > len(columnPrefix)+1:
+			name := placeholder[len(columnPrefix) : len(placeholder)-1]
+			t.segments = append(t.segments, segment{kind: segmentColumn, text: name})
+		default:
+			return nil, errors.ErrKafkaInvalidKeyTemplate.GenWithStackByArgs(expr)
+		}
+		rest = rest[end+1:]
+	}
+	if len(t.segments) == 0 {
+		return nil, errors.ErrKafkaInvalidKeyTemplate.GenWithStackByArgs(expr)
+	}
+	return t, nil
+}
+
+// Render renders the key of the row changed event. The columns before the
+// change are used for delete events. Column names are matched case
+// insensitively, and a column which does not exist is rendered as "null".
+func (t *Template) Render(row *model.RowChangedEvent) []byte {
+	columns := row.Columns
+	if row.IsDelete() {
+		columns = row.PreColumns
+	}
+
+	var b strings.Builder
+	for _, s := range t.segments {
+		switch s.kind {
+		case segmentLiteral:
+			b.WriteString(s.text)
+		case segmentSchema:
+			b.WriteString(row.Table.Schema)
+		case segmentTable:
+			b.WriteString(row.Table.Table)
+		case segmentCommitTs:
+			b.WriteString(strconv.FormatUint(row.CommitTs, 10))
+		case segmentColumn:
+			var value interface{}
+			for _, col := range columns {
+				if col != nil && strings.EqualFold(col.Name, s.text) {
+					value = col.Value
+					break
+				}
+			}
+			b.WriteString(model.ColumnValueString(value))
+		}
+	}
+	return []byte(b.String())
+}
+
+func (t *Template) String() string {
+	return t.expr
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package key
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplate(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"{schema}",
+		"{schema}.{table}:{column:id}",
+		"prefix-{commit-ts}-suffix",
+		"{column:a}_{column:b}",
+		"static",
+	} {
+		_, err := NewTemplate(expr)
+		require.NoError(t, err, expr)
+	}
+
+	for _, expr := range []string{
+		"",
+		"{schema",
+		"{unknown}",
+		"{column:}",
+		"{schema}.{table",
+	} {
+		_, err := NewTemplate(expr)
+		require.True(t, errors.ErrKafkaInvalidKeyTemplate.Equal(err), expr)
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := NewTemplate("{schema}.{table}:{column:ID}/{column:name}@{commit-ts}")
+	require.NoError(t, err)
+
+	row := &model.RowChangedEvent{
+		CommitTs: 42,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Value: int64(1)},
+			{Name: "name", Value: []byte("tidb")},
+		},
+	}
+	require.Equal(t, []byte("test.t:1/tidb@42"), tmpl.Render(row))
+
+	// the columns before the change are used by delete events.
+	deleted := &model.RowChangedEvent{
+		CommitTs: 43,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Value: int64(2)},
+		},
+	}
+	require.Equal(t, []byte("test.t:2/null@43"), tmpl.Render(deleted))
+}
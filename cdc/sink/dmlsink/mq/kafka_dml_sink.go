@@ -114,6 +114,8 @@ func NewKafkaDMLSink(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	encoderConfig.ChangefeedID = changefeed
+	encoderConfig.SchemaRegistryCredential = options.Credential
 
 	s, err := newDMLSink(
 		ctx, p, adminClient, topicManager,
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/builder"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/kafka"
@@ -79,7 +80,7 @@ func newDMLSink(
 ) (*dmlSink, error) {
 	changefeedID := contextutil.ChangefeedIDFromCtx(ctx)
 
-	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	encoderBuilder, err := newEncoderBuilder(ctx, eventRouter, encoderConfig)
 	if err != nil {
 		return nil, cerror.WrapError(cerror.ErrKafkaInvalidConfig, err)
 	}
@@ -124,6 +125,37 @@ func newDMLSink(
 	return s, nil
 }
 
+// newEncoderBuilder creates the encoder builder of the changefeed protocol, if
+// some dispatch rules override the protocol, the row changed events of the
+// matched tables are encoded by the encoders of the overridden protocols. If
+// some dispatch rules override the message key, the keys of the messages of
+// the matched tables are replaced by the rendered key templates.
+func newEncoderBuilder(
+	ctx context.Context,
+	eventRouter *dispatcher.EventRouter,
+	encoderConfig *common.Config,
+) (codec.RowEventEncoderBuilder, error) {
+	encoderBuilder, err := builder.NewRowEventEncoderBuilder(ctx, encoderConfig)
+	if err != nil {
+		return nil, err
+	}
+	overridden := eventRouter.GetOverriddenProtocols()
+	if len(overridden) != 0 {
+		protocols, builders, err := builder.NewOverriddenRowEventEncoderBuilders(
+			ctx, encoderConfig, overridden)
+		if err != nil {
+			return nil, err
+		}
+		encoderBuilder = codec.NewRoutedEncoderBuilder(
+			encoderBuilder, protocols, builders, eventRouter.GetProtocolForRowChange)
+	}
+	if eventRouter.HasKeyTemplate() {
+		encoderBuilder = codec.NewKeyedEncoderBuilder(
+			encoderBuilder, eventRouter.GetKeyForRowChange, encoderConfig.MaxMessageBytes)
+	}
+	return encoderBuilder, nil
+}
+
 // WriteEvents writes events to the sink.
 // This is an asynchronously and thread-safe method.
 func (s *dmlSink) WriteEvents(rows ...*dmlsink.RowChangeCallbackableEvent) error {
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/retry"
+	"github.com/pingcap/tiflow/pkg/sink/metadata"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -69,6 +70,9 @@ type mysqlBackend struct {
 	events []*dmlsink.TxnCallbackableEvent
 	rows   int
 
+	// injector appends the metadata columns to the rows.
+	injector *metadata.Injector
+
 	statistics                      *metrics.Statistics
 	metricTxnSinkDMLBatchCommit     prometheus.Observer
 	metricTxnSinkDMLBatchCallback   prometheus.Observer
@@ -169,6 +173,7 @@ func NewMySQLBackends(
 		maxAllowedPacket = int64(variable.DefMaxAllowedPacket)
 	}
 
+	injector := metadata.NewInjector(replicaConfig.Sink)
 	backends := make([]*mysqlBackend, 0, cfg.WorkerCount)
 	for i := 0; i < cfg.WorkerCount; i++ {
 		backends = append(backends, &mysqlBackend{
@@ -178,6 +183,7 @@ func NewMySQLBackends(
 			cfg:         cfg,
 			dmlMaxRetry: defaultDMLMaxRetry,
 			statistics:  statistics,
+			injector:    injector,
 
 			metricTxnSinkDMLBatchCommit:     txn.SinkDMLBatchCommit.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
 			metricTxnSinkDMLBatchCallback:   txn.SinkDMLBatchCallback.WithLabelValues(changefeedID.Namespace, changefeedID.ID),
@@ -199,6 +205,13 @@ func NewMySQLBackends(
 // OnTxnEvent implements interface backend.
 // It adds the event to the buffer, and return true if it needs flush immediately.
 func (s *mysqlBackend) OnTxnEvent(event *dmlsink.TxnCallbackableEvent) (needFlush bool) {
+	if s.injector != nil {
+		rows := make([]*model.RowChangedEvent, 0, len(event.Event.Rows))
+		for _, row := range event.Event.Rows {
+			rows = append(rows, s.injector.Inject(row))
+		}
+		event.Event.Rows = rows
+	}
 	s.events = append(s.events, event)
 	s.rows += len(event.Event.Rows)
 	return event.Event.ToWaitFlush() || s.rows >= s.cfg.MaxTxnRow
@@ -50,7 +50,7 @@ func GetProtocol(protocolStr string) (config.Protocol, error) {
 func GetFileExtension(protocol config.Protocol) string {
 	switch protocol {
 	case config.ProtocolAvro, config.ProtocolCanalJSON, config.ProtocolMaxwell,
-		config.ProtocolOpen:
+		config.ProtocolOpen, config.ProtocolDebezium, config.ProtocolSimpleJSON:
 		return ".json"
 	case config.ProtocolCraft:
 		return ".craft"
@@ -58,6 +58,8 @@ func GetFileExtension(protocol config.Protocol) string {
 		return ".canal"
 	case config.ProtocolCsv:
 		return ".csv"
+	case config.ProtocolProtobuf:
+		return ".pb"
 	default:
 		return ".unknown"
 	}
@@ -49,6 +49,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
 	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
 	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -69,9 +70,10 @@ var (
 
 	downstreamURIStr string
 
-	protocol            config.Protocol
-	enableTiDBExtension bool
-	enableRowChecksum   bool
+	protocol               config.Protocol
+	enableTiDBExtension    bool
+	enableRowChecksum      bool
+	enableEncodingChecksum bool
 
 	// eventRouterReplicaConfig only used to initialize the consumer's eventRouter
 	// which then can be used to check RowChangedEvent dispatched correctness
@@ -213,6 +215,23 @@ func init() {
 		}
 	}
 
+	s = upstreamURI.Query().Get("enable-encoding-checksum")
+	if s != "" {
+		enableEncodingChecksum, err = strconv.ParseBool(s)
+		if err != nil {
+			log.Panic("invalid enable-encoding-checksum of upstream-uri")
+		}
+		if enableEncodingChecksum {
+			if protocol != config.ProtocolOpen && protocol != config.ProtocolDefault &&
+				protocol != config.ProtocolCanalJSON {
+				log.Panic("enable-encoding-checksum only work with open-protocol / canal-json")
+			}
+			if protocol == config.ProtocolCanalJSON && !enableTiDBExtension {
+				log.Panic("enable-encoding-checksum of canal-json requires enable-tidb-extension")
+			}
+		}
+	}
+
 	if configFile != "" {
 		eventRouterReplicaConfig = config.GetDefaultReplicaConfig()
 		eventRouterReplicaConfig.Sink.Protocol = util.AddressOf(protocol.String())
@@ -403,9 +422,10 @@ type Consumer struct {
 	// initialize to 0 by default
 	globalResolvedTs uint64
 
-	protocol            config.Protocol
-	enableTiDBExtension bool
-	enableRowChecksum   bool
+	protocol               config.Protocol
+	enableTiDBExtension    bool
+	enableRowChecksum      bool
+	enableEncodingChecksum bool
 
 	eventRouter *dispatcher.EventRouter
 
@@ -434,6 +454,7 @@ func NewConsumer(ctx context.Context) (*Consumer, error) {
 	c.protocol = protocol
 	c.enableTiDBExtension = enableTiDBExtension
 	c.enableRowChecksum = enableRowChecksum
+	c.enableEncodingChecksum = enableEncodingChecksum
 
 	if c.protocol == config.ProtocolAvro {
 		keySchemaM, valueSchemaM, err := avro.NewKeyAndValueSchemaManagers(
@@ -562,10 +583,10 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 		err     error
 	)
 	switch c.protocol {
-	case config.ProtocolOpen, config.ProtocolDefault:
-		decoder = open.NewBatchDecoder()
-	case config.ProtocolCanalJSON:
-		decoder = canal.NewBatchDecoder(c.enableTiDBExtension, "")
+	case config.ProtocolOpen, config.ProtocolDefault,
+		config.ProtocolCanalJSON:
+		decoder = newRowEventDecoder(
+			c.protocol, c.enableTiDBExtension, c.enableEncodingChecksum)
 	case config.ProtocolAvro:
 		decoder = avro.NewDecoder(&avro.Options{
 			EnableTiDBExtension: c.enableTiDBExtension,
@@ -581,8 +602,19 @@ func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim saram
 	}
 
 	eventGroups := make(map[int64]*eventsGroup)
+	// oversized messages may be split into chunks by the encoder,
+	// they are reassembled before being added to the decoder.
+	assembler := common.NewChunkAssembler()
 	for message := range claim.Messages() {
-		if err := decoder.AddKeyValue(message.Key, message.Value); err != nil {
+		key, value, ok, err := assembler.Add(message.Key, message.Value)
+		if err != nil {
+			log.Error("reassemble the chunked message failed", zap.Error(err))
+			return errors.Trace(err)
+		}
+		if !ok {
+			continue
+		}
+		if err := decoder.AddKeyValue(key, value); err != nil {
 			log.Error("add key value to the decoder failed", zap.Error(err))
 			return errors.Trace(err)
 		}
@@ -882,6 +914,25 @@ func syncFlushRowChangedEvents(ctx context.Context, sink *partitionSinks, resolv
 	}
 }
 
+// newRowEventDecoder creates the decoder of the open-protocol or canal-json
+// messages, the row checksum embedded at encoding time is verified by the
+// decoder, and it is required to be present if the encoding checksum is
+// enabled, so that a message without the checksum fails the consumer.
+func newRowEventDecoder(
+	protocol config.Protocol, enableTiDBExtension, enableEncodingChecksum bool,
+) codec.RowEventDecoder {
+	if protocol == config.ProtocolCanalJSON {
+		if enableEncodingChecksum {
+			return canal.NewBatchDecoderWithChecksum("")
+		}
+		return canal.NewBatchDecoder(enableTiDBExtension, "")
+	}
+	if enableEncodingChecksum {
+		return open.NewBatchDecoderWithChecksum()
+	}
+	return open.NewBatchDecoder()
+}
+
 type fakeTableIDGenerator struct {
 	tableIDs       map[string]int64
 	currentTableID int64
@@ -302,20 +302,9 @@ func (c *consumer) emitDMLEvents(
 		return errors.Trace(err)
 	}
 
-	switch c.codecCfg.Protocol {
-	case config.ProtocolCsv:
-		decoder, err = csv.NewBatchDecoder(ctx, c.codecCfg, tableInfo, content)
-		if err != nil {
-			return errors.Trace(err)
-		}
-	case config.ProtocolCanalJSON:
-		// Always enable tidb extension for canal-json protocol
-		// because we need to get the commit ts from the extension field.
-		decoder = canal.NewBatchDecoder(true, c.codecCfg.Terminator)
-		err := decoder.AddKeyValue(nil, content)
-		if err != nil {
-			return errors.Trace(err)
-		}
+	decoder, err = newDecoder(ctx, c.codecCfg, tableInfo, content)
+	if err != nil {
+		return errors.Trace(err)
 	}
 
 	cnt := 0
@@ -610,6 +599,34 @@ func (c *consumer) run(ctx context.Context) error {
 	}
 }
 
+// newDecoder creates the decoder of the file content. The row checksum
+// embedded at encoding time is verified by the decoder, and it is required
+// to be present if the encoding checksum is enabled.
+func newDecoder(
+	ctx context.Context, codecCfg *common.Config,
+	tableInfo *model.TableInfo, content []byte,
+) (codec.RowEventDecoder, error) {
+	switch codecCfg.Protocol {
+	case config.ProtocolCsv:
+		return csv.NewBatchDecoder(ctx, codecCfg, tableInfo, content)
+	case config.ProtocolCanalJSON:
+		// Always enable tidb extension for canal-json protocol
+		// because we need to get the commit ts from the extension field.
+		var decoder codec.RowEventDecoder
+		if codecCfg.EnableEncodingChecksum {
+			decoder = canal.NewBatchDecoderWithChecksum(codecCfg.Terminator)
+		} else {
+			decoder = canal.NewBatchDecoder(true, codecCfg.Terminator)
+		}
+		if err := decoder.AddKeyValue(nil, content); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return decoder, nil
+	default:
+		return nil, errors.Errorf("unsupported protocol %s", codecCfg.Protocol)
+	}
+}
+
 // copied from kafka-consumer
 type fakeTableIDGenerator struct {
 	tableIDs       map[string]int64
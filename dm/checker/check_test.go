@@ -24,6 +24,7 @@ import (
 	router "github.com/pingcap/tidb/util/table-router"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/ctl/common"
+	"github.com/pingcap/tiflow/dm/pkg/checker"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
 	"github.com/stretchr/testify/require"
@@ -780,3 +781,56 @@ func checkHappyPath(t *testing.T, pre func(), cfgs []*config.SubTaskConfig) {
 	require.True(t, result.Summary.Passed)
 	require.Equal(t, int64(0), result.Summary.Warning)
 }
+
+type fakeCustomChecker struct{}
+
+func (fakeCustomChecker) Name() string {
+	return "fake custom checker"
+}
+
+func (fakeCustomChecker) Check(_ context.Context) *checker.Result {
+	result := &checker.Result{
+		Name:  "fake custom checker",
+		Desc:  "check the invariants of the application",
+		State: checker.StateWarning,
+	}
+	result.Errors = append(result.Errors, checker.NewWarn("table %s has no primary key", tb1))
+	return result
+}
+
+func TestCustomPrecheck(t *testing.T) {
+	item := "fake_custom"
+	builder := func(
+		cfgs []*config.SubTaskConfig,
+		upstreamDBs map[string]*conn.BaseDB,
+		targetDB *conn.BaseDB,
+	) ([]checker.RealChecker, error) {
+		return []checker.RealChecker{fakeCustomChecker{}}, nil
+	}
+	RegisterPrecheck(item, "fake custom checking item", builder)
+	defer func() {
+		customPrechecks = nil
+		delete(config.AllCheckingItems, item)
+	}()
+	require.NoError(t, config.ValidateCheckingItem(item))
+	require.Panics(t, func() {
+		RegisterPrecheck(item, "fake custom checking item", builder)
+	})
+	require.Panics(t, func() {
+		RegisterPrecheck(config.VersionChecking, "", builder)
+	})
+
+	c := NewChecker(nil, config.FilterCheckingItems(nil), common.DefaultErrorCnt, common.DefaultWarnCnt)
+	require.NoError(t, c.addCustomPrechecks(nil))
+	require.Len(t, c.checkList, 1)
+	result, err := checker.Do(context.Background(), c.checkList)
+	require.NoError(t, err)
+	require.True(t, result.Summary.Passed)
+	require.Equal(t, int64(1), result.Summary.Warning)
+	require.Equal(t, checker.StateWarning, result.Results[0].State)
+
+	// the custom precheck can be ignored like the built-in ones.
+	c = NewChecker(nil, config.FilterCheckingItems([]string{item}), common.DefaultErrorCnt, common.DefaultWarnCnt)
+	require.NoError(t, c.addCustomPrechecks(nil))
+	require.Len(t, c.checkList, 0)
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/checker"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+// PrecheckBuilder builds the checkers of a custom precheck for the subtasks of a task. upstreamDBs is keyed by the
+// source ID of the subtasks, and targetDB is the downstream database. The databases are owned by the Checker, the
+// checkers should not close them.
+type PrecheckBuilder func(
+	cfgs []*config.SubTaskConfig,
+	upstreamDBs map[string]*conn.BaseDB,
+	targetDB *conn.BaseDB,
+) ([]checker.RealChecker, error)
+
+type customPrecheck struct {
+	item    string
+	builder PrecheckBuilder
+}
+
+// customPrechecks are the registered custom prechecks in the order of registration.
+var customPrechecks []customPrecheck
+
+// RegisterPrecheck registers a custom precheck as the checking item `item`. Like the built-in checking items, it runs
+// during check-task and start-task unless the item is in `ignore-checking-items` of the task, and the results of its
+// checkers are reported with the built-in ones.
+//
+// The package of the precheck should call it in an init function, and be imported by the dm-master binary, which
+// runs the prechecks. It panics if the item is already registered.
+func RegisterPrecheck(item, desc string, builder PrecheckBuilder) {
+	if builder == nil {
+		panic("checker: register a nil builder for custom precheck " + item)
+	}
+	if _, ok := config.AllCheckingItems[item]; ok {
+		panic(fmt.Sprintf("checker: checking item %s is already registered", item))
+	}
+	config.AllCheckingItems[item] = desc
+	customPrechecks = append(customPrechecks, customPrecheck{item: item, builder: builder})
+}
+
+// addCustomPrechecks adds the checkers of the registered custom prechecks which are not ignored.
+func (c *Checker) addCustomPrechecks(upstreamDBs map[string]*conn.BaseDB) error {
+	if len(customPrechecks) == 0 {
+		return nil
+	}
+	var targetDB *conn.BaseDB
+	if len(c.instances) > 0 {
+		targetDB = c.instances[0].targetDB
+	}
+	for _, p := range customPrechecks {
+		if _, ok := c.checkingItems[p.item]; !ok {
+			continue
+		}
+		checkers, err := p.builder(c.stCfgs, upstreamDBs, targetDB)
+		if err != nil {
+			return terror.Annotatef(err, "fail to build custom precheck %s", p.item)
+		}
+		c.checkList = append(c.checkList, checkers...)
+	}
+	return nil
+}
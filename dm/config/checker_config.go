@@ -15,7 +15,10 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 // Backoff related constants.
@@ -75,3 +78,68 @@ func (cc *CheckerConfig) Adjust() {
 	cc.BackoffJitter = DefaultBackoffJitter
 	cc.BackoffFactor = DefaultBackoffFactor
 }
+
+// AutoResumeConfig is the auto-resume policy of the subtasks of a task, which overrides the `checker` config of
+// DM-worker. The zero values mean the values of the `checker` config.
+type AutoResumeConfig struct {
+	BackoffRollback Duration `yaml:"backoff-rollback" toml:"backoff-rollback" json:"backoff-rollback"`
+	BackoffMin      Duration `yaml:"backoff-min" toml:"backoff-min" json:"backoff-min"`
+	BackoffMax      Duration `yaml:"backoff-max" toml:"backoff-max" json:"backoff-max"`
+	BackoffFactor   float64  `yaml:"backoff-factor" toml:"backoff-factor" json:"backoff-factor"`
+	// MaxAttempts is the max number of auto-resumes in a row, 0 means no limit. The number is reset after the subtask
+	// runs without error for backoff-rollback.
+	MaxAttempts int `yaml:"max-attempts" toml:"max-attempts" json:"max-attempts"`
+	// ErrorClasses are the classes of the errors to auto-resume, e.g. `database`, all the errors are auto-resumed if
+	// it's empty. The errors which can't be resolved by resuming are never auto-resumed.
+	ErrorClasses []string `yaml:"error-classes" toml:"error-classes" json:"error-classes"`
+}
+
+// Adjust verifies the auto-resume config.
+func (c *AutoResumeConfig) Adjust() error {
+	if c.BackoffRollback.Duration < 0 || c.BackoffMin.Duration < 0 || c.BackoffMax.Duration < 0 {
+		return terror.ErrConfigInvalidAutoResume.Generate("backoff-rollback, backoff-min and backoff-max should not be negative")
+	}
+	if c.BackoffMax.Duration > 0 && c.BackoffMin.Duration > c.BackoffMax.Duration {
+		return terror.ErrConfigInvalidAutoResume.Generate("backoff-min should not be larger than backoff-max")
+	}
+	if c.BackoffFactor != 0 && c.BackoffFactor < 1 {
+		return terror.ErrConfigInvalidAutoResume.Generate("backoff-factor should not be less than 1")
+	}
+	if c.MaxAttempts < 0 {
+		return terror.ErrConfigInvalidAutoResume.Generate("max-attempts should not be negative")
+	}
+	for _, class := range c.ErrorClasses {
+		if !terror.IsValidErrClass(class) {
+			return terror.ErrConfigInvalidAutoResume.Generate(fmt.Sprintf("unknown error class %s", class))
+		}
+	}
+	return nil
+}
+
+// Merge returns the config in which the zero values are replaced by the ones of the checker config.
+func (c *AutoResumeConfig) Merge(checker CheckerConfig) AutoResumeConfig {
+	merged := AutoResumeConfig{
+		BackoffRollback: checker.BackoffRollback,
+		BackoffMin:      checker.BackoffMin,
+		BackoffMax:      checker.BackoffMax,
+		BackoffFactor:   checker.BackoffFactor,
+	}
+	if c == nil {
+		return merged
+	}
+	if c.BackoffRollback.Duration > 0 {
+		merged.BackoffRollback = c.BackoffRollback
+	}
+	if c.BackoffMin.Duration > 0 {
+		merged.BackoffMin = c.BackoffMin
+	}
+	if c.BackoffMax.Duration > 0 {
+		merged.BackoffMax = c.BackoffMax
+	}
+	if c.BackoffFactor > 0 {
+		merged.BackoffFactor = c.BackoffFactor
+	}
+	merged.MaxAttempts = c.MaxAttempts
+	merged.ErrorClasses = c.ErrorClasses
+	return merged
+}
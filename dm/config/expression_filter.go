@@ -16,8 +16,11 @@ package config
 // TODO: move related struct to tidb-tools
 
 // ExpressionFilter represents a filter that will be applied on row changes.
-// one ExpressionFilter can only have one of (insert, update, delete) expressions.
+// one ExpressionFilter can only have one of (insert, update, delete, value) expressions.
 // there are two update expressions, which form an AND logic. If user omits one expression, DM will use "TRUE" for it.
+// the value expression excludes the matched rows from the downstream in all phases. In the full phase the matched
+// rows are filtered out when dumping, and in the incremental phase the row changes are skipped or transformed, e.g.
+// an UPDATE whose new value matches is replicated as a DELETE of the old value.
 type ExpressionFilter struct {
 	Schema             string `yaml:"schema" toml:"schema" json:"schema"`
 	Table              string `yaml:"table" toml:"table" json:"table"`
@@ -25,4 +28,5 @@ type ExpressionFilter struct {
 	UpdateOldValueExpr string `yaml:"update-old-value-expr" toml:"update-old-value-expr" json:"update-old-value-expr"`
 	UpdateNewValueExpr string `yaml:"update-new-value-expr" toml:"update-new-value-expr" json:"update-new-value-expr"`
 	DeleteValueExpr    string `yaml:"delete-value-expr" toml:"delete-value-expr" json:"delete-value-expr"`
+	ValueExpr          string `yaml:"value-expr,omitempty" toml:"value-expr,omitempty" json:"value-expr"`
 }
@@ -20,8 +20,10 @@ import (
 	"encoding/json"
 	"math"
 	"math/rand"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -43,6 +45,10 @@ const (
 	// the default base(min) server id generated by random.
 	defaultBaseServerID = math.MaxUint32 / 10
 	defaultRelayDir     = "relay-dir"
+
+	// DefaultFailoverThreshold is the default number of times in a row the status of the upstream fails to update
+	// before DM fails over to a replica. The status is updated every 30 seconds.
+	DefaultFailoverThreshold = 3
 )
 
 var getAllServerIDFunc = conn.GetAllServerID
@@ -63,6 +69,10 @@ type PurgeConfig struct {
 	Interval    int64 `yaml:"interval" toml:"interval" json:"interval"`             // check whether need to purge at this @Interval (seconds)
 	Expires     int64 `yaml:"expires" toml:"expires" json:"expires"`                // if file's modified time is older than @Expires (hours), then it can be purged
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
+	// if used space of the disk of @RelayBaseDir reaches @DiskUsageWatermark (percent), then it can be purged
+	DiskUsageWatermark int64 `yaml:"disk-usage-watermark,omitempty" toml:"disk-usage-watermark,omitempty" json:"disk-usage-watermark"`
+	// if true, relay log files consumed by all tasks are purged at every @Interval, like `purge-relay --inactive`
+	Inactive bool `yaml:"inactive,omitempty" toml:"inactive,omitempty" json:"inactive"`
 }
 
 // SourceConfig is the configuration for source.
@@ -87,6 +97,12 @@ type SourceConfig struct {
 
 	SourceID string            `yaml:"source-id" toml:"source-id" json:"source-id"`
 	From     dbconfig.DBConfig `yaml:"from" toml:"from" json:"from"`
+	// addresses (host:port) of the replicas of @From, which share the user, password and security of @From.
+	// if @From is unavailable, DM switches to a replica which has executed all GTIDs consumed from the source.
+	FromReplicas []string `yaml:"from-replicas,omitempty" toml:"from-replicas,omitempty" json:"from-replicas,omitempty"`
+	// the number of times in a row the status of the upstream fails to update before DM fails over to a replica,
+	// 0 means DefaultFailoverThreshold.
+	FailoverThreshold int `yaml:"failover-threshold,omitempty" toml:"failover-threshold,omitempty" json:"failover-threshold,omitempty"`
 
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
@@ -252,9 +268,74 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	if c.Purge.DiskUsageWatermark < 0 || c.Purge.DiskUsageWatermark > 100 {
+		return terror.ErrConfigInvalidRelayPurgeWatermark.Generate(c.Purge.DiskUsageWatermark)
+	}
+
+	if len(c.FromReplicas) > 0 {
+		if !c.EnableGTID {
+			return terror.ErrConfigInvalidFromReplicas.Generate("from-replicas requires enable-gtid")
+		}
+		for _, addr := range c.FromReplicas {
+			if _, _, ok := parseUpstreamAddr(addr); !ok {
+				return terror.ErrConfigInvalidFromReplicas.Generatef("invalid address %s", addr)
+			}
+		}
+	}
+	if c.FailoverThreshold < 0 {
+		return terror.ErrConfigInvalidFromReplicas.Generatef("failover-threshold %d should not be negative", c.FailoverThreshold)
+	}
+
 	return nil
 }
 
+// GetFailoverThreshold returns the number of times in a row the status of the upstream fails to update before DM
+// fails over to a replica.
+func (c *SourceConfig) GetFailoverThreshold() int {
+	if c.FailoverThreshold == 0 {
+		return DefaultFailoverThreshold
+	}
+	return c.FailoverThreshold
+}
+
+// UpstreamAddrs returns the addresses of @From and @FromReplicas, which is the order to fail over.
+func (c *SourceConfig) UpstreamAddrs() []string {
+	addrs := make([]string, 0, len(c.FromReplicas)+1)
+	addrs = append(addrs, net.JoinHostPort(c.From.Host, strconv.Itoa(c.From.Port)))
+	return append(addrs, c.FromReplicas...)
+}
+
+// WithUpstreamAddr returns a cloned config whose @From connects to the address, and the other addresses of
+// UpstreamAddrs are kept in @FromReplicas in order.
+func (c *SourceConfig) WithUpstreamAddr(addr string) (*SourceConfig, error) {
+	host, port, ok := parseUpstreamAddr(addr)
+	if !ok {
+		return nil, terror.ErrConfigInvalidFromReplicas.Generatef("invalid address %s", addr)
+	}
+	clone := c.Clone()
+	clone.From.Host = host
+	clone.From.Port = port
+	clone.FromReplicas = make([]string, 0, len(c.FromReplicas))
+	for _, other := range c.UpstreamAddrs() {
+		if other != addr {
+			clone.FromReplicas = append(clone.FromReplicas, other)
+		}
+	}
+	return clone, nil
+}
+
+func parseUpstreamAddr(addr string) (string, int, bool) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil || host == "" {
+		return "", 0, false
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > math.MaxUint16 {
+		return "", 0, false
+	}
+	return host, port, true
+}
+
 // DecryptPassword returns a decrypted config replica in config.
 func (c *SourceConfig) DecryptPassword() *SourceConfig {
 	clone := c.Clone()
@@ -433,30 +514,34 @@ type SourceConfigForDowngrade struct {
 	ServerID        uint32                 `yaml:"server-id"`
 	Tracer          map[string]interface{} `yaml:"tracer"`
 	// any new config item, we mark it omitempty
-	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
-	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	CaseSensitive     bool                  `yaml:"case-sensitive,omitempty"`
+	Filters           []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	FromReplicas      []string              `yaml:"from-replicas,omitempty"`
+	FailoverThreshold int                   `yaml:"failover-threshold,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
 func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngrade {
 	return &SourceConfigForDowngrade{
-		Enable:          sourceCfg.Enable,
-		EnableGTID:      sourceCfg.EnableGTID,
-		RelayDir:        sourceCfg.RelayDir,
-		Flavor:          sourceCfg.Flavor,
-		Charset:         sourceCfg.Charset,
-		EnableRelay:     sourceCfg.EnableRelay,
-		RelayBinLogName: sourceCfg.RelayBinLogName,
-		RelayBinlogGTID: sourceCfg.RelayBinlogGTID,
-		UUIDSuffix:      sourceCfg.UUIDSuffix,
-		SourceID:        sourceCfg.SourceID,
-		From:            sourceCfg.From,
-		Purge:           sourceCfg.Purge,
-		Checker:         sourceCfg.Checker,
-		ServerID:        sourceCfg.ServerID,
-		Tracer:          sourceCfg.Tracer,
-		CaseSensitive:   sourceCfg.CaseSensitive,
-		Filters:         sourceCfg.Filters,
+		Enable:            sourceCfg.Enable,
+		EnableGTID:        sourceCfg.EnableGTID,
+		RelayDir:          sourceCfg.RelayDir,
+		Flavor:            sourceCfg.Flavor,
+		Charset:           sourceCfg.Charset,
+		EnableRelay:       sourceCfg.EnableRelay,
+		RelayBinLogName:   sourceCfg.RelayBinLogName,
+		RelayBinlogGTID:   sourceCfg.RelayBinlogGTID,
+		UUIDSuffix:        sourceCfg.UUIDSuffix,
+		SourceID:          sourceCfg.SourceID,
+		From:              sourceCfg.From,
+		Purge:             sourceCfg.Purge,
+		Checker:           sourceCfg.Checker,
+		ServerID:          sourceCfg.ServerID,
+		Tracer:            sourceCfg.Tracer,
+		CaseSensitive:     sourceCfg.CaseSensitive,
+		Filters:           sourceCfg.Filters,
+		FromReplicas:      sourceCfg.FromReplicas,
+		FailoverThreshold: sourceCfg.FailoverThreshold,
 	}
 }
 
@@ -220,6 +220,56 @@ func TestConfigVerify(t *testing.T) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Purge.DiskUsageWatermark = 80
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.Purge.DiskUsageWatermark = 101
+				return cfg
+			},
+			".*invalid relay log purge disk-usage-watermark 101.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.EnableGTID = true
+				cfg.FromReplicas = []string{"127.0.0.1:3307", "[::1]:3308"}
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.FromReplicas = []string{"127.0.0.1:3307"}
+				return cfg
+			},
+			".*from-replicas requires enable-gtid.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.EnableGTID = true
+				cfg.FromReplicas = []string{"127.0.0.1"}
+				return cfg
+			},
+			".*invalid address 127.0.0.1.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.FailoverThreshold = -1
+				return cfg
+			},
+			".*failover-threshold -1 should not be negative.*",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -356,3 +406,24 @@ func TestEmbedSampleFile(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, SampleSourceConfig, string(data))
 }
+
+func TestUpstreamAddrs(t *testing.T) {
+	cfg, err := ParseYaml(SampleSourceConfig)
+	require.NoError(t, err)
+	require.Equal(t, []string{"127.0.0.1:3306"}, cfg.UpstreamAddrs())
+
+	cfg.FromReplicas = []string{"127.0.0.1:3307", "127.0.0.1:3308"}
+	require.Equal(t, []string{"127.0.0.1:3306", "127.0.0.1:3307", "127.0.0.1:3308"}, cfg.UpstreamAddrs())
+
+	cfg2, err := cfg.WithUpstreamAddr("127.0.0.1:3308")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", cfg2.From.Host)
+	require.Equal(t, 3308, cfg2.From.Port)
+	require.Equal(t, []string{"127.0.0.1:3308", "127.0.0.1:3306", "127.0.0.1:3307"}, cfg2.UpstreamAddrs())
+	// the original config is not changed
+	require.Equal(t, 3306, cfg.From.Port)
+	require.Equal(t, []string{"127.0.0.1:3307", "127.0.0.1:3308"}, cfg.FromReplicas)
+
+	_, err = cfg.WithUpstreamAddr("127.0.0.1:abc")
+	require.Regexp(t, ".*invalid address 127.0.0.1:abc.*", err.Error())
+}
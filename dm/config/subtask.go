@@ -33,11 +33,11 @@ import (
 	extstorage "github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
-	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
 	router "github.com/pingcap/tidb/util/table-router"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/engine/pkg/promutil"
@@ -112,11 +112,11 @@ type SubTaskConfig struct {
 	ServerID   uint32 `toml:"server-id" json:"server-id"`
 	Flavor     string `toml:"flavor" json:"flavor"`
 	MetaSchema string `toml:"meta-schema" json:"meta-schema"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	HeartbeatUpdateInterval int `toml:"heartbeat-update-interval" json:"heartbeat-update-interval"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	HeartbeatReportInterval int `toml:"heartbeat-report-interval" json:"heartbeat-report-interval"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	EnableHeartbeat bool   `toml:"enable-heartbeat" json:"enable-heartbeat"`
 	Timezone        string `toml:"timezone" json:"timezone"`
 
@@ -166,6 +166,9 @@ type SubTaskConfig struct {
 
 	// which DM worker is running the subtask, this will be injected when the real worker starts running the subtask(StartSubTask).
 	WorkerName string `toml:"-" json:"-"`
+	// AutoResume overrides the auto-resume policy of DM-worker for this subtask.
+	AutoResume *AutoResumeConfig `toml:"auto-resume,omitempty" json:"auto-resume,omitempty"`
+
 	// task experimental configs
 	Experimental struct {
 		AsyncCheckpointFlush bool `yaml:"async-checkpoint-flush" toml:"async-checkpoint-flush" json:"async-checkpoint-flush"`
@@ -369,6 +372,21 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	} else if c.SyncerConfig.SafeMode && duration == 0 {
 		return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 	}
+	if c.SyncerConfig.Heartbeat != nil {
+		// the heartbeat config may be shared by the subtasks of a task.
+		heartbeat := *c.SyncerConfig.Heartbeat
+		if err := heartbeat.Adjust(); err != nil {
+			return err
+		}
+		c.SyncerConfig.Heartbeat = &heartbeat
+	}
+	if c.AutoResume != nil {
+		autoResume := *c.AutoResume
+		if err := autoResume.Adjust(); err != nil {
+			return err
+		}
+		c.AutoResume = &autoResume
+	}
 
 	c.From.AdjustWithTimeZone(c.Timezone)
 	c.To.AdjustWithTimeZone(c.Timezone)
@@ -388,7 +406,7 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if _, err := filter.New(c.CaseSensitive, c.BAList); err != nil {
 		return terror.ErrConfigGenBAList.Delegate(err)
 	}
-	if _, err := regexprrouter.NewRegExprRouter(c.CaseSensitive, c.RouteRules); err != nil {
+	if _, err := tablerouter.NewRouteTable(c.CaseSensitive, c.RouteRules); err != nil {
 		return terror.ErrConfigGenTableRouter.Delegate(err)
 	}
 	// NewMapping will fill arguments with the default values.
@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/util/filter"
+	tfilter "github.com/pingcap/tidb/util/table-filter"
 	router "github.com/pingcap/tidb/util/table-router"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/log"
@@ -62,6 +63,16 @@ const (
 	StrictCollationCompatible = "strict"
 )
 
+// heartbeat.
+const (
+	HeartbeatModeWrite = "write"
+	HeartbeatModeRead  = "read"
+
+	DefaultHeartbeatTable           = "dm_heartbeat.heartbeat"
+	DefaultHeartbeatTimestampColumn = "ts"
+	DefaultHeartbeatUpdateInterval  = time.Second
+)
+
 const (
 	ValidationNone = "none"
 	ValidationFast = "fast"
@@ -301,6 +312,20 @@ type LoaderConfig struct {
 	RangeConcurrency    int                          `yaml:"range-concurrency" toml:"range-concurrency" json:"range-concurrency"`
 	CompressKVPairs     string                       `yaml:"compress-kv-pairs" toml:"compress-kv-pairs" json:"compress-kv-pairs"`
 	PDAddr              string                       `yaml:"pd-addr" toml:"pd-addr" json:"pd-addr"`
+	// TableConfigs overrides the concurrency of loading some tables, e.g. to load a large table with more threads.
+	TableConfigs []*LoadTableConfig `yaml:"table-configs,omitempty" toml:"table-configs,omitempty" json:"table-configs,omitempty"`
+}
+
+// LoadTableConfig is the config of loading the tables matched by Tables. The matched tables are loaded after the
+// other tables, one LoadTableConfig after another.
+type LoadTableConfig struct {
+	// Tables are the upstream tables in the syntax of the table filter, e.g. `db.large_*`.
+	Tables []string `yaml:"tables" toml:"tables" json:"tables"`
+	// PoolSize is the number of threads to load the tables, the pool-size of the loader is used if it's 0.
+	PoolSize int `yaml:"pool-size" toml:"pool-size" json:"pool-size"`
+	// BatchSize is the size of the data imported in a batch in physical import mode, the default of lightning is used
+	// if it's 0.
+	BatchSize config.ByteSize `yaml:"batch-size" toml:"batch-size" json:"batch-size"`
 }
 
 // DefaultLoaderConfig return default loader config for task.
@@ -388,6 +413,24 @@ func (m *LoaderConfig) adjust() error {
 		return terror.ErrConfigInvalidLoadAnalyze.Generate(m.Analyze)
 	}
 
+	for _, tableCfg := range m.TableConfigs {
+		if tableCfg == nil || len(tableCfg.Tables) == 0 {
+			return terror.ErrConfigInvalidLoadTableConfig.Generate("tables should not be empty")
+		}
+		for _, table := range tableCfg.Tables {
+			// the tables are negated to exclude them from loading other tables, see LightningLoader.
+			if strings.HasPrefix(table, "!") {
+				return terror.ErrConfigInvalidLoadTableConfig.Generate(fmt.Sprintf("negated table %s is not supported", table))
+			}
+		}
+		if _, err := tfilter.Parse(tableCfg.Tables); err != nil {
+			return terror.ErrConfigInvalidLoadTableConfig.Delegate(err, fmt.Sprintf("invalid tables %v", tableCfg.Tables))
+		}
+		if tableCfg.PoolSize < 0 || tableCfg.BatchSize < 0 {
+			return terror.ErrConfigInvalidLoadTableConfig.Generate("pool-size and batch-size should not be negative")
+		}
+	}
+
 	return nil
 }
 
@@ -415,6 +458,58 @@ type SyncerConfig struct {
 	SafeModeDuration string `yaml:"safe-mode-duration" toml:"safe-mode-duration" json:"safe-mode-duration"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
+	// Heartbeat measures the replication lag by the heartbeats in the upstream, the lag is measured by the timestamps
+	// of the binlog events if it's nil, which is meaningless when the upstream is idle.
+	Heartbeat *HeartbeatConfig `yaml:"heartbeat,omitempty" toml:"heartbeat,omitempty" json:"heartbeat,omitempty"`
+}
+
+// HeartbeatConfig is the config of the heartbeats to measure the replication lag. A heartbeat is a row in the
+// heartbeat table of the upstream, whose timestamp column is the UTC time it's written, e.g. `2006-01-02T15:04:05.999999`.
+// The lag is the time elapsed since the latest heartbeat the syncer has replicated.
+type HeartbeatConfig struct {
+	// Mode is `write` if the syncer writes the heartbeats, or `read` if they are written by other tools such as
+	// pt-heartbeat.
+	Mode string `yaml:"mode" toml:"mode" json:"mode"`
+	// Table is the heartbeat table in the form of `schema.table`.
+	Table           string `yaml:"table" toml:"table" json:"table"`
+	TimestampColumn string `yaml:"timestamp-column" toml:"timestamp-column" json:"timestamp-column"`
+	// UpdateInterval is the interval of writing the heartbeats in the `write` mode.
+	UpdateInterval Duration `yaml:"update-interval" toml:"update-interval" json:"update-interval"`
+}
+
+// Adjust adjusts and verifies the heartbeat config.
+func (h *HeartbeatConfig) Adjust() error {
+	if h.Mode == "" {
+		h.Mode = HeartbeatModeWrite
+	}
+	if h.Mode != HeartbeatModeWrite && h.Mode != HeartbeatModeRead {
+		return terror.ErrConfigInvalidHeartbeat.Generate(fmt.Sprintf("mode should be %s or %s", HeartbeatModeWrite, HeartbeatModeRead))
+	}
+	if h.Table == "" {
+		h.Table = DefaultHeartbeatTable
+	}
+	if _, err := h.ParseTable(); err != nil {
+		return err
+	}
+	if h.TimestampColumn == "" {
+		h.TimestampColumn = DefaultHeartbeatTimestampColumn
+	}
+	if h.UpdateInterval.Duration == 0 {
+		h.UpdateInterval.Duration = DefaultHeartbeatUpdateInterval
+	}
+	if h.UpdateInterval.Duration < 0 {
+		return terror.ErrConfigInvalidHeartbeat.Generate("update-interval should be positive")
+	}
+	return nil
+}
+
+// ParseTable returns the schema and table name of Table.
+func (h *HeartbeatConfig) ParseTable() (*filter.Table, error) {
+	schema, table, ok := strings.Cut(h.Table, ".")
+	if !ok || schema == "" || table == "" {
+		return nil, terror.ErrConfigInvalidHeartbeat.Generate(fmt.Sprintf("table %s should be in the form of `schema.table`", h.Table))
+	}
+	return &filter.Table{Schema: schema, Name: table}, nil
 }
 
 // DefaultSyncerConfig return default syncer config for task.
@@ -447,6 +542,7 @@ type ValidatorConfig struct {
 	ValidateInterval   Duration `yaml:"validate-interval" toml:"validate-interval" json:"validate-interval"`
 	CheckInterval      Duration `yaml:"check-interval" toml:"check-interval" json:"check-interval"`
 	RowErrorDelay      Duration `yaml:"row-error-delay" toml:"row-error-delay" json:"row-error-delay"`
+	RowRecheckDelay    Duration `yaml:"row-recheck-delay" toml:"row-recheck-delay" json:"row-recheck-delay"` // 0 means error rows are never rechecked
 	MetaFlushInterval  Duration `yaml:"meta-flush-interval" toml:"meta-flush-interval" json:"meta-flush-interval"`
 	BatchQuerySize     int      `yaml:"batch-query-size" toml:"batch-query-size" json:"batch-query-size"`
 	MaxPendingRowSize  string   `yaml:"max-pending-row-size" toml:"max-pending-row-size" json:"max-pending-row-size"`
@@ -512,11 +608,11 @@ type TaskConfig struct {
 	// we store detail status in meta
 	// don't save configuration into it
 	MetaSchema string `yaml:"meta-schema" toml:"meta-schema" json:"meta-schema"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	EnableHeartbeat bool `yaml:"enable-heartbeat" toml:"enable-heartbeat" json:"enable-heartbeat"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	HeartbeatUpdateInterval int `yaml:"heartbeat-update-interval" toml:"heartbeat-update-interval" json:"heartbeat-update-interval"`
-	// deprecated
+	// deprecated, use `heartbeat` of `syncers` instead
 	HeartbeatReportInterval int    `yaml:"heartbeat-report-interval" toml:"heartbeat-report-interval" json:"heartbeat-report-interval"`
 	Timezone                string `yaml:"timezone" toml:"timezone" json:"timezone"`
 
@@ -562,6 +658,9 @@ type TaskConfig struct {
 	// deprecated, replaced by `start-task --remove-meta`
 	RemoveMeta bool `yaml:"remove-meta"`
 
+	// AutoResume overrides the auto-resume policy of DM-worker for the subtasks of this task.
+	AutoResume *AutoResumeConfig `yaml:"auto-resume,omitempty" toml:"auto-resume,omitempty" json:"auto-resume,omitempty"`
+
 	// task experimental configs
 	Experimental struct {
 		AsyncCheckpointFlush bool `yaml:"async-checkpoint-flush" toml:"async-checkpoint-flush" json:"async-checkpoint-flush"`
@@ -622,7 +721,11 @@ func (c *TaskConfig) DecodeFile(fpath string) error {
 		return terror.ErrConfigReadCfgFromFile.Delegate(err, fpath)
 	}
 
-	err = yaml.UnmarshalStrict(bs, c)
+	data, err := MergeTaskTemplates(string(bs))
+	if err != nil {
+		return err
+	}
+	err = yaml.UnmarshalStrict([]byte(data), c)
 	if err != nil {
 		return terror.ErrConfigYamlTransform.Delegate(err)
 	}
@@ -630,18 +733,21 @@ func (c *TaskConfig) DecodeFile(fpath string) error {
 	return c.adjust()
 }
 
-// Decode loads config from file data.
+// Decode loads config from file data, which may have many YAML documents, see MergeTaskTemplates.
 func (c *TaskConfig) Decode(data string) error {
-	err := yaml.UnmarshalStrict([]byte(data), c)
-	if err != nil {
-		return terror.ErrConfigYamlTransform.Delegate(err, "decode task config failed")
+	if err := c.RawDecode(data); err != nil {
+		return err
 	}
 
 	return c.adjust()
 }
 
-// RawDecode loads config from file data.
+// RawDecode loads config from file data, which may have many YAML documents, see MergeTaskTemplates.
 func (c *TaskConfig) RawDecode(data string) error {
+	data, err := MergeTaskTemplates(data)
+	if err != nil {
+		return err
+	}
 	return terror.ErrConfigYamlTransform.Delegate(yaml.UnmarshalStrict([]byte(data), c), "decode task config failed")
 }
 
@@ -747,6 +853,12 @@ func (c *TaskConfig) adjust() error {
 			}
 			setFields = append(setFields, "delete: ["+exprFilter.DeleteValueExpr+"]")
 		}
+		if exprFilter.ValueExpr != "" {
+			if err := checkValidExpr(exprFilter.ValueExpr); err != nil {
+				return terror.ErrConfigExprFilterWrongGrammar.Generate(name, exprFilter.ValueExpr, err)
+			}
+			setFields = append(setFields, "value: ["+exprFilter.ValueExpr+"]")
+		}
 		if len(setFields) > 1 {
 			return terror.ErrConfigExprFilterManyExpr.Generate(name, setFields)
 		}
@@ -999,8 +1111,15 @@ func (c *TaskConfig) adjust() error {
 
 	if c.EnableHeartbeat || c.HeartbeatUpdateInterval != defaultUpdateInterval ||
 		c.HeartbeatReportInterval != defaultReportInterval {
+		// the deprecated heartbeat can't be mapped to `heartbeat` of `syncers`, so don't let users guess which one works.
+		for _, inst := range c.MySQLInstances {
+			if inst.Syncer != nil && inst.Syncer.Heartbeat != nil {
+				return terror.ErrConfigInvalidHeartbeat.Generate(
+					"`enable-heartbeat`, `heartbeat-update-interval` and `heartbeat-report-interval` are deprecated and can't be set together with `heartbeat` of `syncers`")
+			}
+		}
 		c.EnableHeartbeat = false
-		log.L().Warn("heartbeat is deprecated, needn't set it anymore.")
+		log.L().Warn("heartbeat is deprecated, needn't set it anymore, use `heartbeat` of `syncers` to measure the replication lag instead.")
 	}
 	return nil
 }
@@ -1170,9 +1289,10 @@ type SyncerConfigForDowngrade struct {
 	SafeMode                bool   `yaml:"safe-mode"`
 	EnableANSIQuotes        bool   `yaml:"enable-ansi-quotes"`
 
-	SafeModeDuration string `yaml:"safe-mode-duration,omitempty"`
-	Compact          bool   `yaml:"compact,omitempty"`
-	MultipleRows     bool   `yaml:"multipleRows,omitempty"`
+	SafeModeDuration string           `yaml:"safe-mode-duration,omitempty"`
+	Compact          bool             `yaml:"compact,omitempty"`
+	MultipleRows     bool             `yaml:"multipleRows,omitempty"`
+	Heartbeat        *HeartbeatConfig `yaml:"heartbeat,omitempty"`
 }
 
 // NewSyncerConfigsForDowngrade converts SyncerConfig to SyncerConfigForDowngrade.
@@ -1193,6 +1313,7 @@ func NewSyncerConfigsForDowngrade(syncerConfigs map[string]*SyncerConfig) map[st
 			EnableANSIQuotes:        syncerConfig.EnableANSIQuotes,
 			Compact:                 syncerConfig.Compact,
 			MultipleRows:            syncerConfig.MultipleRows,
+			Heartbeat:               syncerConfig.Heartbeat,
 		}
 		syncerConfigsForDowngrade[configName] = newSyncerConfig
 	}
@@ -1242,6 +1363,7 @@ type TaskConfigForDowngrade struct {
 	OnlineDDL        bool                         `yaml:"online-ddl,omitempty"`
 	ShadowTableRules []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules  []string                     `yaml:"trash-table-rules,omitempty"`
+	AutoResume       *AutoResumeConfig            `yaml:"auto-resume,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
@@ -1276,6 +1398,7 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		OnlineDDL:               taskConfig.OnlineDDL,
 		ShadowTableRules:        taskConfig.ShadowTableRules,
 		TrashTableRules:         taskConfig.TrashTableRules,
+		AutoResume:              taskConfig.AutoResume,
 	}
 }
 
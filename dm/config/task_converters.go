@@ -57,6 +57,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		cfg.Meta = inst.Meta
 		cfg.CollationCompatible = c.CollationCompatible
 		cfg.Experimental = c.Experimental
+		cfg.AutoResume = c.AutoResume
 
 		fromClone := dbCfg.Clone()
 		if fromClone == nil {
@@ -273,6 +274,12 @@ func OpenAPITaskToSubTaskConfigs(task *openapi.Task, toDBCfg *dbconfig.DBConfig,
 		if task.IgnoreCheckingItems != nil && len(*task.IgnoreCheckingItems) != 0 {
 			subTaskCfg.IgnoreCheckingItems = *task.IgnoreCheckingItems
 		}
+		if task.ShadowTableRules != nil {
+			subTaskCfg.ShadowTableRules = *task.ShadowTableRules
+		}
+		if task.TrashTableRules != nil {
+			subTaskCfg.TrashTableRules = *task.TrashTableRules
+		}
 		// adjust sub task config
 		if err := subTaskCfg.Adjust(true); err != nil {
 			return nil, terror.Annotatef(err, "source name %s", sourceCfg.SourceName)
@@ -324,6 +331,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.TargetDB = &stCfg0.To // just ref
 	c.OnlineDDL = stCfg0.OnlineDDL
 	c.OnlineDDLScheme = stCfg0.OnlineDDLScheme
+	c.ShadowTableRules = stCfg0.ShadowTableRules
+	c.TrashTableRules = stCfg0.TrashTableRules
 	c.CleanDumpFile = stCfg0.CleanDumpFile
 	c.CollationCompatible = stCfg0.CollationCompatible
 	c.MySQLInstances = make([]*MySQLInstance, 0, len(stCfgs))
@@ -336,6 +345,7 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Syncers = make(map[string]*SyncerConfig)
 	c.ExprFilter = make(map[string]*ExpressionFilter)
 	c.Experimental = stCfg0.Experimental
+	c.AutoResume = stCfg0.AutoResume
 	c.Validators = make(map[string]*ValidatorConfig)
 
 	baListMap := make(map[string]string, len(stCfgs))
@@ -619,6 +629,13 @@ func SubTaskConfigsToOpenAPITask(subTaskConfigList []*SubTaskConfig) *openapi.Ta
 		ignoreItems := oneSubtaskConfig.IgnoreCheckingItems
 		task.IgnoreCheckingItems = &ignoreItems
 	}
+	// the default online ddl table rules are omitted
+	if rules := oneSubtaskConfig.ShadowTableRules; len(rules) != 0 && !(len(rules) == 1 && rules[0] == DefaultShadowTableRules) {
+		task.ShadowTableRules = &rules
+	}
+	if rules := oneSubtaskConfig.TrashTableRules; len(rules) != 0 && !(len(rules) == 1 && rules[0] == DefaultTrashTableRules) {
+		task.TrashTableRules = &rules
+	}
 	return &task
 }
 
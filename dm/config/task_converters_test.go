@@ -378,6 +378,48 @@ func TestConvertWithIgnoreCheckItems(t *testing.T) {
 	require.Equal(t, *newTask, task)
 }
 
+func TestConvertWithOnlineDDLTableRules(t *testing.T) {
+	task, err := fixtures.GenNoShardOpenAPITaskForTest()
+	require.NoError(t, err)
+	shadowTableRules := []string{"^_(.+)_(?:new|gho|shadow)$"}
+	trashTableRules := []string{"^_(.+)_(?:ghc|del|old)$", "^__(.+)_trash$"}
+	task.ShadowTableRules = &shadowTableRules
+	task.TrashTableRules = &trashTableRules
+	sourceCfg1, err := ParseYamlAndVerify(SampleSourceConfig)
+	require.NoError(t, err)
+	source1Name := task.SourceConfig.SourceConf[0].SourceName
+	sourceCfg1.SourceID = task.SourceConfig.SourceConf[0].SourceName
+	sourceCfgMap := map[string]*SourceConfig{source1Name: sourceCfg1}
+	toDBCfg := GetTargetDBCfgFromOpenAPITask(&task)
+	subTaskConfigList, err := OpenAPITaskToSubTaskConfigs(&task, toDBCfg, sourceCfgMap)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(subTaskConfigList))
+	require.Equal(t, shadowTableRules, subTaskConfigList[0].ShadowTableRules)
+	require.Equal(t, trashTableRules, subTaskConfigList[0].TrashTableRules)
+
+	subTaskConfigMap := map[string]map[string]*SubTaskConfig{task.Name: {source1Name: subTaskConfigList[0]}}
+	taskList := SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
+	require.Equal(t, 1, len(taskList))
+	require.Equal(t, task, *taskList[0])
+
+	// the default rules are omitted
+	task.ShadowTableRules = nil
+	task.TrashTableRules = nil
+	subTaskConfigList, err = OpenAPITaskToSubTaskConfigs(&task, toDBCfg, sourceCfgMap)
+	require.NoError(t, err)
+	require.Equal(t, []string{DefaultShadowTableRules}, subTaskConfigList[0].ShadowTableRules)
+	subTaskConfigMap[task.Name][source1Name] = subTaskConfigList[0]
+	taskList = SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
+	require.Nil(t, taskList[0].ShadowTableRules)
+	require.Nil(t, taskList[0].TrashTableRules)
+
+	// invalid rule
+	invalidRules := []string{"^_.+_gho$"}
+	task.ShadowTableRules = &invalidRules
+	_, err = OpenAPITaskToSubTaskConfigs(&task, toDBCfg, sourceCfgMap)
+	require.True(t, terror.ErrConfigOnlineDDLInvalidRegex.Equal(err))
+}
+
 func TestConvertBetweenOpenAPITaskAndTaskConfig(t *testing.T) {
 	// one source task
 	task, err := fixtures.GenNoShardOpenAPITaskForTest()
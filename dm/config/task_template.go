@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io"
+	"strings"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"gopkg.in/yaml.v2"
+)
+
+// MergeTaskTemplates merges the YAML documents of a task config into one document. The first documents are the task
+// templates, e.g. the routes and filters shared by many tasks, and a later document overrides the fields of the
+// former ones: the mappings are merged recursively, and the other values, including the sequences such as
+// `mysql-instances`, are replaced. The data is returned as it is if it has only one document.
+func MergeTaskTemplates(data string) (string, error) {
+	var (
+		docs   []interface{}
+		merged interface{}
+	)
+	decoder := yaml.NewDecoder(strings.NewReader(data))
+	for {
+		var doc interface{}
+		err := decoder.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", terror.ErrConfigYamlTransform.Delegate(err, "decode task config templates failed")
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+	if len(docs) <= 1 {
+		return data, nil
+	}
+
+	for _, doc := range docs {
+		merged = mergeYAMLValue(merged, doc)
+	}
+	b, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", terror.ErrConfigYamlTransform.Delegate(err, "merge task config templates failed")
+	}
+	return string(b), nil
+}
+
+// mergeYAMLValue merges overlay into base if both of them are mappings, otherwise overlay replaces base.
+func mergeYAMLValue(base, overlay interface{}) interface{} {
+	baseMap, ok := base.(map[interface{}]interface{})
+	if !ok {
+		return overlay
+	}
+	overlayMap, ok := overlay.(map[interface{}]interface{})
+	if !ok {
+		return overlay
+	}
+	for k, v := range overlayMap {
+		baseMap[k] = mergeYAMLValue(baseMap[k], v)
+	}
+	return baseMap
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTaskTemplates(t *testing.T) {
+	t.Parallel()
+
+	// a single document is returned as it is.
+	data := "name: test\n"
+	merged, err := MergeTaskTemplates(data)
+	require.NoError(t, err)
+	require.Equal(t, data, merged)
+
+	base := `
+name: base
+task-mode: all
+routes:
+  route-01:
+    schema-pattern: "db_*"
+    target-schema: "db"
+mysql-instances:
+  - source-id: "mysql-replica-01"
+  - source-id: "mysql-replica-02"
+`
+	overlay := `
+name: prod
+routes:
+  route-01:
+    target-schema: "db_prod"
+mysql-instances:
+  - source-id: "mysql-replica-03"
+`
+	merged, err = MergeTaskTemplates(base + "---" + overlay)
+	require.NoError(t, err)
+	require.Equal(t, `mysql-instances:
+- source-id: mysql-replica-03
+name: prod
+routes:
+  route-01:
+    schema-pattern: db_*
+    target-schema: db_prod
+task-mode: all
+`, merged)
+
+	_, err = MergeTaskTemplates(base + "---\nname: [")
+	require.True(t, terror.ErrConfigYamlTransform.Equal(err))
+}
+
+func TestDecodeTaskTemplates(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewTaskConfig()
+	require.NoError(t, cfg.RawDecode("name: base\ntask-mode: all\n---\nname: prod\n"))
+	require.Equal(t, "prod", cfg.Name)
+	require.Equal(t, ModeAll, cfg.TaskMode)
+
+	// the merged config is still checked strictly.
+	cfg = NewTaskConfig()
+	err := cfg.RawDecode("name: base\n---\nunknown-field: 1\n")
+	require.True(t, terror.ErrConfigYamlTransform.Equal(err))
+}
@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -717,6 +718,8 @@ func TestGenAndFromSubTaskConfigs(t *testing.T) {
 		HeartbeatReportInterval: heartbeatRI,
 		CaseSensitive:           stCfg1.CaseSensitive,
 		TargetDB:                &stCfg1.To,
+		ShadowTableRules:        stCfg1.ShadowTableRules,
+		TrashTableRules:         stCfg1.TrashTableRules,
 		CollationCompatible:     LooseCollationCompatible,
 		MySQLInstances: []*MySQLInstance{
 			{
@@ -993,15 +996,33 @@ func TestExclusiveAndWrongExprFilterFields(t *testing.T) {
 		Table:           "tbl",
 		DeleteValueExpr: "a > 1",
 	}
+	cfg.ExprFilter["test-value"] = &ExpressionFilter{
+		Schema:    "db",
+		Table:     "tbl",
+		ValueExpr: "a > 1",
+	}
 	cfg.MySQLInstances[0].ExpressionFilters = []string{
 		"test-insert",
 		"test-update-only-old",
 		"test-update-only-new",
 		"test-update",
 		"test-delete",
+		"test-value",
 	}
 	require.NoError(t, cfg.adjust())
 
+	cfg.ExprFilter["value-and-insert"] = &ExpressionFilter{
+		Schema:          "db",
+		Table:           "tbl",
+		InsertValueExpr: "a > 1",
+		ValueExpr:       "a > 1",
+	}
+	cfg.MySQLInstances[0].ExpressionFilters = append(cfg.MySQLInstances[0].ExpressionFilters, "value-and-insert")
+	err := cfg.adjust()
+	require.True(t, terror.ErrConfigExprFilterManyExpr.Equal(err))
+	delete(cfg.ExprFilter, "value-and-insert")
+	cfg.MySQLInstances[0].ExpressionFilters = cfg.MySQLInstances[0].ExpressionFilters[:6]
+
 	cfg.ExprFilter["both-field"] = &ExpressionFilter{
 		Schema:          "db",
 		Table:           "tbl",
@@ -1009,7 +1030,7 @@ func TestExclusiveAndWrongExprFilterFields(t *testing.T) {
 		DeleteValueExpr: "a > 1",
 	}
 	cfg.MySQLInstances[0].ExpressionFilters = append(cfg.MySQLInstances[0].ExpressionFilters, "both-field")
-	err := cfg.adjust()
+	err = cfg.adjust()
 	require.True(t, terror.ErrConfigExprFilterManyExpr.Equal(err))
 
 	delete(cfg.ExprFilter, "both-field")
@@ -1144,4 +1165,157 @@ func TestLoadConfigAdjust(t *testing.T) {
 	cfg.OnDuplicatePhysical = "wrong"
 	err := cfg.adjust()
 	require.True(t, terror.ErrConfigInvalidPhysicalDuplicateResolution.Equal(err))
+
+	// test table configs
+	cfg.OnDuplicatePhysical = ""
+	cfg.TableConfigs = []*LoadTableConfig{{Tables: []string{"db.large_*"}, PoolSize: 32}}
+	require.NoError(t, cfg.adjust())
+	cfg.TableConfigs[0].Tables = nil
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoadTableConfig.Equal(err))
+	cfg.TableConfigs[0].Tables = []string{"!db.large_*"}
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoadTableConfig.Equal(err))
+	cfg.TableConfigs[0].Tables = []string{"db.large_*"}
+	cfg.TableConfigs[0].PoolSize = -1
+	err = cfg.adjust()
+	require.True(t, terror.ErrConfigInvalidLoadTableConfig.Equal(err))
+}
+
+func TestHeartbeatConfigAdjust(t *testing.T) {
+	t.Parallel()
+
+	cfg := &HeartbeatConfig{}
+	require.NoError(t, cfg.Adjust())
+	require.Equal(t, &HeartbeatConfig{
+		Mode:            HeartbeatModeWrite,
+		Table:           DefaultHeartbeatTable,
+		TimestampColumn: DefaultHeartbeatTimestampColumn,
+		UpdateInterval:  Duration{Duration: DefaultHeartbeatUpdateInterval},
+	}, cfg)
+	table, err := cfg.ParseTable()
+	require.NoError(t, err)
+	require.Equal(t, &filter.Table{Schema: "dm_heartbeat", Name: "heartbeat"}, table)
+
+	cfg.Mode = "wrong"
+	err = cfg.Adjust()
+	require.True(t, terror.ErrConfigInvalidHeartbeat.Equal(err))
+	cfg.Mode = HeartbeatModeRead
+	cfg.Table = "heartbeat"
+	err = cfg.Adjust()
+	require.True(t, terror.ErrConfigInvalidHeartbeat.Equal(err))
+	cfg.Table = "percona.heartbeat"
+	cfg.UpdateInterval.Duration = -time.Second
+	err = cfg.Adjust()
+	require.True(t, terror.ErrConfigInvalidHeartbeat.Equal(err))
+
+	// the heartbeat config of the syncer is adjusted with the subtask config.
+	taskYAML := `
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    block-allow-list: "instance"
+    syncer-config-name: "global"
+block-allow-list:
+  instance:
+    do-dbs: ["test"]
+syncers:
+  global:
+    heartbeat:
+      mode: read
+      table: percona.heartbeat
+`
+	taskCfg := NewTaskConfig()
+	require.NoError(t, taskCfg.Decode(taskYAML))
+	require.Equal(t, &HeartbeatConfig{Mode: HeartbeatModeRead, Table: "percona.heartbeat"}, taskCfg.Syncers["global"].Heartbeat)
+	subTaskCfgs, err := TaskConfigToSubTaskConfigs(taskCfg, map[string]dbconfig.DBConfig{"mysql-replica-01": {}})
+	require.NoError(t, err)
+	require.Equal(t, &HeartbeatConfig{
+		Mode:            HeartbeatModeRead,
+		Table:           "percona.heartbeat",
+		TimestampColumn: DefaultHeartbeatTimestampColumn,
+		UpdateInterval:  Duration{Duration: DefaultHeartbeatUpdateInterval},
+	}, subTaskCfgs[0].Heartbeat)
+
+	// the deprecated heartbeat can't be set together.
+	taskCfg = NewTaskConfig()
+	err = taskCfg.Decode("enable-heartbeat: true" + taskYAML)
+	require.True(t, terror.ErrConfigInvalidHeartbeat.Equal(err))
+}
+
+func TestAutoResumeConfig(t *testing.T) {
+	t.Parallel()
+
+	checker := CheckerConfig{
+		BackoffRollback: Duration{Duration: DefaultBackoffRollback},
+		BackoffMax:      Duration{Duration: DefaultBackoffMax},
+	}
+	checker.Adjust()
+
+	// nil and empty configs mean the checker config.
+	var cfg *AutoResumeConfig
+	expected := AutoResumeConfig{
+		BackoffRollback: checker.BackoffRollback,
+		BackoffMin:      checker.BackoffMin,
+		BackoffMax:      checker.BackoffMax,
+		BackoffFactor:   checker.BackoffFactor,
+	}
+	require.Equal(t, expected, cfg.Merge(checker))
+	cfg = &AutoResumeConfig{}
+	require.NoError(t, cfg.Adjust())
+	require.Equal(t, expected, cfg.Merge(checker))
+
+	cfg = &AutoResumeConfig{
+		BackoffMax:   Duration{Duration: time.Minute},
+		MaxAttempts:  3,
+		ErrorClasses: []string{"database"},
+	}
+	require.NoError(t, cfg.Adjust())
+	expected.BackoffMax = Duration{Duration: time.Minute}
+	expected.MaxAttempts = 3
+	expected.ErrorClasses = []string{"database"}
+	require.Equal(t, expected, cfg.Merge(checker))
+
+	for _, invalid := range []*AutoResumeConfig{
+		{BackoffRollback: Duration{Duration: -time.Second}},
+		{BackoffMin: Duration{Duration: time.Minute}, BackoffMax: Duration{Duration: time.Second}},
+		{BackoffFactor: 0.5},
+		{MaxAttempts: -1},
+		{ErrorClasses: []string{"network"}},
+	} {
+		err := invalid.Adjust()
+		require.True(t, terror.ErrConfigInvalidAutoResume.Equal(err), "%+v", invalid)
+	}
+
+	// the auto-resume config of the task is passed to the subtasks.
+	taskCfg := NewTaskConfig()
+	require.NoError(t, taskCfg.Decode(`
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    block-allow-list: "instance"
+block-allow-list:
+  instance:
+    do-dbs: ["test"]
+auto-resume:
+  backoff-max: 1m
+  max-attempts: 3
+  error-classes: ["database"]
+`))
+	subTaskCfgs, err := TaskConfigToSubTaskConfigs(taskCfg, map[string]dbconfig.DBConfig{"mysql-replica-01": {}})
+	require.NoError(t, err)
+	require.Equal(t, cfg, subTaskCfgs[0].AutoResume)
+	require.Equal(t, cfg, SubTaskConfigsToTaskConfig(subTaskCfgs...).AutoResume)
 }
@@ -290,6 +290,28 @@ func GetFileContent(fpath string) ([]byte, error) {
 	return content, nil
 }
 
+// GetTaskFileContent reads the task file and the task templates specified by `--template`. The templates are put
+// before the task file as YAML documents, so the task file overrides their fields when DM-master merges them.
+func GetTaskFileContent(cmd *cobra.Command, fpath string) ([]byte, error) {
+	templates, err := cmd.Flags().GetStringSlice("template")
+	if err != nil {
+		PrintLinesf("error in parse `--template`")
+		return nil, err
+	}
+	var content []byte
+	for _, template := range append(templates, fpath) {
+		c, err := GetFileContent(template)
+		if err != nil {
+			return nil, err
+		}
+		if len(content) > 0 {
+			content = append(content, "\n---\n"...)
+		}
+		content = append(content, c...)
+	}
+	return content, nil
+}
+
 // GetSourceArgs extracts sources from cmd.
 func GetSourceArgs(cmd *cobra.Command) ([]string, error) {
 	ret, err := cmd.Flags().GetStringSlice("source")
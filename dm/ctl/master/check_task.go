@@ -29,12 +29,13 @@ import (
 // NewCheckTaskCmd creates a CheckTask command.
 func NewCheckTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "check-task <config-file> [--error count] [--warn count]",
+		Use:   "check-task [--template template-file ...] <config-file> [--error count] [--warn count]",
 		Short: "Checks the configuration file of the task",
 		RunE:  checkTaskFunc,
 	}
 	cmd.Flags().Int64P("error", "e", common.DefaultErrorCnt, "max count of errors to display")
 	cmd.Flags().Int64P("warn", "w", common.DefaultWarnCnt, "max count of warns to display")
+	cmd.Flags().StringSlice("template", nil, "task templates which are overridden by the configuration file, the latter template overrides the former")
 	cmd.Flags().String("start-time", "", "specify the start time of binlog replication, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
 	return cmd
 }
@@ -46,7 +47,7 @@ func checkTaskFunc(cmd *cobra.Command, _ []string) error {
 		common.PrintCmdUsage(cmd)
 		return errors.New("please check output to see error")
 	}
-	content, err := common.GetFileContent(cmd.Flags().Arg(0))
+	content, err := common.GetTaskFileContent(cmd, cmd.Flags().Arg(0))
 	if err != nil {
 		return err
 	}
@@ -15,9 +15,16 @@ package master
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"os/signal"
+	"reflect"
+	"sort"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/pingcap/tiflow/dm/ctl/common"
 	"github.com/pingcap/tiflow/dm/pb"
@@ -41,11 +48,13 @@ type taskInfo struct {
 // NewQueryStatusCmd creates a QueryStatus command.
 func NewQueryStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "query-status [-s source ...] [task-name | task-file] [--more]",
+		Use:   "query-status [-s source ...] [task-name | task-file] [--more] [--watch]",
 		Short: "Queries task status",
 		RunE:  queryStatusFunc,
 	}
 	cmd.Flags().BoolP("more", "", false, "whether to print the detailed task information")
+	cmd.Flags().Bool("watch", false, "whether to keep printing the changes of subtasks' stage, sync lag and errors, one JSON per line")
+	cmd.Flags().Duration("interval", time.Second, "the interval to query the task status with `--watch`")
 	return cmd
 }
 
@@ -63,6 +72,20 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		common.PrintLinesf("error in parse `--watch`")
+		return err
+	}
+	if watch {
+		interval, err2 := cmd.Flags().GetDuration("interval")
+		if err2 != nil || interval <= 0 {
+			common.PrintLinesf("error in parse `--interval`, it should be a positive duration")
+			return errors.New("please check output to see error")
+		}
+		return watchStatus(taskName, sources, interval)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
 	defer cancel()
 
@@ -99,6 +122,154 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// subTaskEvent is printed by `query-status --watch` when the status of a subtask is changed.
+type subTaskEvent struct {
+	Time   string `json:"time"`
+	Task   string `json:"task,omitempty"`
+	Source string `json:"source"`
+	// Stage is the stage of the subtask, or "Removed" if the subtask doesn't exist anymore.
+	Stage               string   `json:"stage,omitempty"`
+	Unit                string   `json:"unit,omitempty"`
+	SecondsBehindMaster int64    `json:"secondsBehindMaster,omitempty"`
+	Errors              []string `json:"errors,omitempty"`
+}
+
+const stageRemoved = "Removed"
+
+// subTaskEvents returns the events of the subtasks in resp, keyed by the task and source. A source which fails to
+// return its status has an event without task, if the response doesn't carry its name, the name is taken from the
+// requested sources which have no status in resp.
+func subTaskEvents(resp *pb.QueryStatusListResponse, sources []string) map[string]subTaskEvent {
+	responded := make(map[string]struct{}, len(resp.Sources))
+	for _, source := range resp.Sources {
+		if name := source.GetSourceStatus().GetSource(); name != "" {
+			responded[name] = struct{}{}
+		}
+	}
+	unresponded := make([]string, 0, len(sources))
+	for _, source := range sources {
+		if _, ok := responded[source]; !ok {
+			unresponded = append(unresponded, source)
+		}
+	}
+
+	events := make(map[string]subTaskEvent)
+	for i, source := range resp.Sources {
+		sourceID := source.GetSourceStatus().GetSource()
+		if sourceID == "" && len(unresponded) > 0 {
+			sourceID, unresponded = unresponded[0], unresponded[1:]
+		}
+		if !source.Result {
+			key := sourceID
+			if key == "" {
+				// still keep the failed sources apart when their names are unknown.
+				key = fmt.Sprintf("#%d", i)
+			}
+			events[key] = subTaskEvent{Source: sourceID, Errors: []string{source.Msg}}
+			continue
+		}
+		for _, subTask := range source.SubTaskStatus {
+			event := subTaskEvent{
+				Task:   subTask.Name,
+				Source: sourceID,
+				Stage:  subTask.Stage.String(),
+				Unit:   subTask.Unit.String(),
+			}
+			if sync := subTask.GetSync(); sync != nil {
+				event.SecondsBehindMaster = sync.SecondsBehindMaster
+			}
+			if subTask.Result != nil {
+				for _, processErr := range subTask.Result.Errors {
+					event.Errors = append(event.Errors, processErr.Message)
+				}
+			}
+			events[subTask.Name+"/"+sourceID] = event
+		}
+	}
+	return events
+}
+
+// changedSubTaskEvents returns the events in curr which are different from prev, and the removed events in prev,
+// sorted by their keys.
+func changedSubTaskEvents(prev, curr map[string]subTaskEvent) []subTaskEvent {
+	keys := make([]string, 0, len(curr))
+	for key, event := range curr {
+		if prevEvent, ok := prev[key]; !ok || !reflect.DeepEqual(prevEvent, event) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range prev {
+		if _, ok := curr[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	changed := make([]subTaskEvent, 0, len(keys))
+	for _, key := range keys {
+		event, ok := curr[key]
+		if !ok {
+			event = subTaskEvent{Task: prev[key].Task, Source: prev[key].Source, Stage: stageRemoved}
+		}
+		changed = append(changed, event)
+	}
+	return changed
+}
+
+// watchStatus queries the task status every interval, and prints the events of the changed subtasks until it's
+// interrupted.
+func watchStatus(taskName string, sources []string, interval time.Duration) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var prev map[string]subTaskEvent
+	for {
+		resp := &pb.QueryStatusListResponse{}
+		reqCtx, reqCancel := context.WithTimeout(ctx, common.GlobalConfig().RPCTimeout)
+		err := common.SendRequest(
+			reqCtx,
+			"QueryStatus",
+			&pb.QueryStatusListRequest{
+				Name:    taskName,
+				Sources: sources,
+			},
+			&resp,
+		)
+		reqCancel()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			common.PrintLinesf("can not query %s task's status(in sources %v)", taskName, sources)
+			return err
+		}
+		if !resp.Result {
+			common.PrettyPrintResponse(resp)
+			return nil
+		}
+
+		curr := subTaskEvents(resp, sources)
+		now := time.Now().Format(time.RFC3339)
+		for _, event := range changedSubTaskEvents(prev, curr) {
+			event.Time = now
+			b, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		}
+		prev = curr
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
 // errorOccurred checks ProcessResult and return true if some error occurred.
 func errorOccurred(result *pb.ProcessResult) bool {
 	return result != nil && len(result.Errors) > 0
@@ -169,3 +169,53 @@ func (t *testCtlMaster) TestWrapTaskResult(c *check.C) {
 	_, hasFalseResult = wrapTaskResult(resp)
 	c.Assert(hasFalseResult, check.IsFalse)
 }
+
+func (t *testCtlMaster) TestChangedSubTaskEvents(c *check.C) {
+	resp := &pb.QueryStatusListResponse{
+		Result: true,
+		Sources: []*pb.QueryStatusResponse{
+			{
+				Result:       true,
+				SourceStatus: &pb.SourceStatus{Source: "mysql-replica-01"},
+				SubTaskStatus: []*pb.SubTaskStatus{{
+					Name:   "test",
+					Stage:  pb.Stage_Running,
+					Unit:   pb.UnitType_Sync,
+					Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{SecondsBehindMaster: 3}},
+				}},
+			},
+			{
+				Result:       false,
+				Msg:          "worker is offline",
+				SourceStatus: &pb.SourceStatus{Source: "mysql-replica-02"},
+			},
+		},
+	}
+	prev := subTaskEvents(resp, nil)
+	c.Assert(changedSubTaskEvents(nil, prev), check.DeepEquals, []subTaskEvent{
+		{Source: "mysql-replica-02", Errors: []string{"worker is offline"}},
+		{Task: "test", Source: "mysql-replica-01", Stage: "Running", Unit: "Sync", SecondsBehindMaster: 3},
+	})
+	c.Assert(changedSubTaskEvents(prev, subTaskEvents(resp, nil)), check.HasLen, 0)
+
+	// the subtask is paused with an error, and the source is removed.
+	resp.Sources[0].SubTaskStatus[0].Stage = pb.Stage_Paused
+	resp.Sources[0].SubTaskStatus[0].Result = &pb.ProcessResult{Errors: []*pb.ProcessError{{Message: "fail to sync"}}}
+	resp.Sources = resp.Sources[:1]
+	c.Assert(changedSubTaskEvents(prev, subTaskEvents(resp, nil)), check.DeepEquals, []subTaskEvent{
+		{Source: "mysql-replica-02", Stage: stageRemoved},
+		{Task: "test", Source: "mysql-replica-01", Stage: "Paused", Unit: "Sync", SecondsBehindMaster: 3, Errors: []string{"fail to sync"}},
+	})
+
+	// the failed sources without status are named by the requested sources.
+	resp.Sources = append(resp.Sources,
+		&pb.QueryStatusResponse{Result: false, Msg: "source not found"},
+		&pb.QueryStatusResponse{Result: false, Msg: "source not found"},
+	)
+	c.Assert(changedSubTaskEvents(nil, subTaskEvents(resp, []string{"mysql-replica-01", "mysql-replica-03", "mysql-replica-04"})), check.DeepEquals, []subTaskEvent{
+		{Source: "mysql-replica-03", Errors: []string{"source not found"}},
+		{Source: "mysql-replica-04", Errors: []string{"source not found"}},
+		{Task: "test", Source: "mysql-replica-01", Stage: "Paused", Unit: "Sync", SecondsBehindMaster: 3, Errors: []string{"fail to sync"}},
+	})
+	c.Assert(subTaskEvents(resp, nil), check.HasLen, 3)
+}
@@ -40,17 +40,19 @@ var mapStr2ErrState = map[string]pb.ValidateErrorState{
 
 func NewQueryValidationErrorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "show-error [--error error-state] <task-name>",
+		Use:   "show-error [--error error-state] [--fix-sql] <task-name>",
 		Short: "show validation error row change",
 		RunE:  queryValidationError,
 	}
 	cmd.Flags().String("error", ValidationUnprocessedErr, "filtering type of error: all, ignored, or unprocessed")
+	cmd.Flags().Bool("fix-sql", false, "print the SQL script fixing the downstream rows of the errors")
 	return cmd
 }
 
 func queryValidationError(cmd *cobra.Command, _ []string) (err error) {
 	var (
 		errState   string
+		fixSQL     bool
 		taskName   string
 		pbErrState pb.ValidateErrorState
 		ok         bool
@@ -63,6 +65,10 @@ func queryValidationError(cmd *cobra.Command, _ []string) (err error) {
 	if err != nil {
 		return err
 	}
+	fixSQL, err = cmd.Flags().GetBool("fix-sql")
+	if err != nil {
+		return err
+	}
 	if pbErrState, ok = mapStr2ErrState[errState]; !ok || errState == ValidationResolvedErr {
 		// todo: support querying resolved error?
 		cmd.SetOut(os.Stdout)
@@ -84,10 +90,28 @@ func queryValidationError(cmd *cobra.Command, _ []string) (err error) {
 	if err != nil {
 		return err
 	}
+	if fixSQL && resp.Result {
+		printValidationFixSQL(resp.Error)
+		return nil
+	}
 	common.PrettyPrintResponse(resp)
 	return nil
 }
 
+// printValidationFixSQL prints the fix SQL of the validation errors as a script,
+// each statement is preceded by a comment describing the error.
+func printValidationFixSQL(validationErrors []*pb.ValidationError) {
+	for _, e := range validationErrors {
+		common.PrintLinesf("-- error %s of source %s, %s -> %s: %s", e.Id, e.Source, e.SrcTable, e.DstTable, e.ErrorType)
+		if e.Message == "" {
+			// the error is saved by a version without fix SQL
+			common.PrintLinesf("-- no fix SQL")
+			continue
+		}
+		common.PrintLinesf("%s;", e.Message)
+	}
+}
+
 func NewQueryValidationStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status [--table-stage stage] <task-name>",
@@ -32,11 +32,12 @@ import (
 // NewStartTaskCmd creates a StartTask command.
 func NewStartTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "start-task [-s source ...] [--remove-meta] <config-file>",
+		Use:   "start-task [-s source ...] [--remove-meta] [--template template-file ...] <config-file>",
 		Short: "Starts a task as defined in the configuration file",
 		RunE:  startTaskFunc,
 	}
 	cmd.Flags().BoolP("remove-meta", "", false, "whether to remove task's meta data")
+	cmd.Flags().StringSlice("template", nil, "task templates which are overridden by the configuration file, the latter template overrides the former")
 	cmd.Flags().String("start-time", "", "specify the start time of binlog replication, e.g. '2021-10-21 00:01:00' or 2021-10-21T00:01:00")
 	return cmd
 }
@@ -48,7 +49,7 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		common.PrintCmdUsage(cmd)
 		return errors.New("please check output to see error")
 	}
-	content, err := common.GetFileContent(cmd.Flags().Arg(0))
+	content, err := common.GetTaskFileContent(cmd, cmd.Flags().Arg(0))
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ package dumpling
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +24,8 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/dumpling/export"
+	"github.com/pingcap/tidb/util/dbutil"
+	tablefilter "github.com/pingcap/tidb/util/filter"
 	tidbpromutil "github.com/pingcap/tidb/util/promutil"
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/dm/config"
@@ -401,6 +404,10 @@ func (m *Dumpling) constructArgs(ctx context.Context) (*export.Config, error) {
 		}
 	}
 
+	if err = m.filterByValueExpr(ctx, dumpConfig); err != nil {
+		return nil, err
+	}
+
 	// record exit position when consistency is none, to support scenarios like Aurora upstream
 	if dumpConfig.Consistency == "none" {
 		dumpConfig.PosAfterConnect = true
@@ -421,6 +428,69 @@ func (m *Dumpling) constructArgs(ctx context.Context) (*export.Config, error) {
 	return dumpConfig, nil
 }
 
+// filterByValueExpr excludes the rows matching the value-expr of expression filters from the dump, as the syncer does
+// for row changes. dumpling only has one WHERE clause for all tables, so all the dumped tables must share the value-expr.
+func (m *Dumpling) filterByValueExpr(ctx context.Context, dumpCfg *export.Config) error {
+	tableID := func(schema, table string) string {
+		if !m.cfg.CaseSensitive {
+			schema, table = strings.ToLower(schema), strings.ToLower(table)
+		}
+		return dbutil.TableName(schema, table)
+	}
+	valueExprs := make(map[string]string)
+	for _, f := range m.cfg.ExprFilter {
+		if f.ValueExpr != "" {
+			valueExprs[tableID(f.Schema, f.Table)] = f.ValueExpr
+		}
+	}
+	if len(valueExprs) == 0 {
+		return nil
+	}
+
+	baList, err := tablefilter.New(m.cfg.CaseSensitive, m.cfg.BAList)
+	if err != nil {
+		return terror.ErrDumpUnitGenBAList.Delegate(err)
+	}
+	baseDB, err := conn.GetUpstreamDB(&m.cfg.From)
+	if err != nil {
+		return err
+	}
+	defer baseDB.Close()
+	schemaToTables, err := conn.FetchAllDoTables(ctx, baseDB, baList)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(schemaToTables))
+	for schema, tables := range schemaToTables {
+		for _, table := range tables {
+			ids = append(ids, tableID(schema, table))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Strings(ids)
+	valueExpr := valueExprs[ids[0]]
+	for _, id := range ids[1:] {
+		if expr := valueExprs[id]; expr != valueExpr {
+			return terror.ErrDumpUnitValueExpr.Generate(ids[0], valueExpr, id, expr)
+		}
+	}
+	if valueExpr == "" {
+		return nil
+	}
+
+	// `IS NOT TRUE` keeps the rows whose value-expr is NULL, which are not filtered by the syncer either.
+	where := fmt.Sprintf("(%s) IS NOT TRUE", valueExpr)
+	if dumpCfg.Where != "" {
+		where = fmt.Sprintf("(%s) AND %s", dumpCfg.Where, where)
+	}
+	m.logger.Info("filter the dumped rows by value-expr of expression filters", zap.String("where", where))
+	dumpCfg.Where = where
+	return nil
+}
+
 // detectSQLMode tries to detect SQL mode from upstream. If success, write it to LoaderConfig.
 // Because loader will use this SQL mode, we need to treat disable `EscapeBackslash` when NO_BACKSLASH_ESCAPES.
 func (m *Dumpling) detectSQLMode(ctx context.Context, dumpCfg *export.Config) {
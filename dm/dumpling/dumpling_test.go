@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/engine/pkg/promutil"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
@@ -215,6 +216,59 @@ func (t *testDumplingSuite) TestConstructArgs(c *C) {
 	c.Assert(exportCfg.SessionParams["time_zone"], Equals, "+01:00")
 }
 
+func (t *testDumplingSuite) TestFilterByValueExpr(c *C) {
+	ctx := context.Background()
+	cfg := &config.SubTaskConfig{
+		BAList: &filter.Rules{DoDBs: []string{"db"}},
+		ExprFilter: []*config.ExpressionFilter{
+			{Schema: "db", Table: "t1", ValueExpr: "a > 1"},
+			{Schema: "db", Table: "t2", ValueExpr: "a > 1"},
+			{Schema: "db", Table: "t3", InsertValueExpr: "a > 2"},
+		},
+	}
+	d := NewDumpling(cfg)
+	expectTables := func(mock sqlmock.Sqlmock, tables ...string) {
+		mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"Database"}).AddRow("db").AddRow("db2"))
+		rows := sqlmock.NewRows([]string{"Tables_in_db", "Table_type"})
+		for _, table := range tables {
+			rows.AddRow(table, "BASE TABLE")
+		}
+		mock.ExpectQuery("SHOW FULL TABLES IN `db`").WillReturnRows(rows)
+	}
+
+	// all the dumped tables share the value-expr
+	mock := conn.InitMockDB(c)
+	expectTables(mock, "t1", "t2")
+	exportCfg := &export.Config{Where: "b > 0"}
+	c.Assert(d.filterByValueExpr(ctx, exportCfg), IsNil)
+	c.Assert(exportCfg.Where, Equals, "(b > 0) AND (a > 1) IS NOT TRUE")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// a dumped table has no value-expr
+	mock = conn.InitMockDB(c)
+	expectTables(mock, "t1", "t2", "t3")
+	exportCfg = &export.Config{}
+	err := d.filterByValueExpr(ctx, exportCfg)
+	c.Assert(terror.ErrDumpUnitValueExpr.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*table `db`.`t3` has value-expr \"\".*")
+	c.Assert(exportCfg.Where, Equals, "")
+
+	// the dumped tables have different value-expr
+	cfg.ExprFilter[1].ValueExpr = "a > 2"
+	mock = conn.InitMockDB(c)
+	expectTables(mock, "t1", "t2")
+	err = d.filterByValueExpr(ctx, exportCfg)
+	c.Assert(terror.ErrDumpUnitValueExpr.Equal(err), IsTrue)
+	c.Assert(exportCfg.Where, Equals, "")
+
+	// no value-expr, upstream is not queried
+	cfg.ExprFilter = cfg.ExprFilter[2:]
+	mock = conn.InitMockDB(c)
+	c.Assert(d.filterByValueExpr(ctx, exportCfg), IsNil)
+	c.Assert(exportCfg.Where, Equals, "")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
 func genDumpCfg(t *testing.T) *config.SubTaskConfig {
 	t.Helper()
 
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/errormanager"
 	"github.com/pingcap/tidb/dumpling/export"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/filter"
 	tidbpromutil "github.com/pingcap/tidb/util/promutil"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pb"
@@ -39,6 +40,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/unit"
@@ -78,7 +80,11 @@ type LightningLoader struct {
 	closed         atomic.Bool
 	metaBinlog     atomic.String
 	metaBinlogGTID atomic.String
+	// resumeProgress is the progress in lightning checkpoint which the import is resumed from.
+	resumeProgress atomic.Pointer[pb.LoadResumeProgress]
 	lastErr        error
+	// lastErrRun is the index of the lightning run which returns lastErr, see lightningConfigs.
+	lastErrRun int
 
 	speedRecorder *export.SpeedRecorder
 }
@@ -207,6 +213,114 @@ func (l *LightningLoader) ignoreCheckpointError(ctx context.Context, cfg *lcfg.C
 	return errors.Trace(cpdb.IgnoreErrorCheckpoint(ctx, "all"))
 }
 
+// dumpedTables returns the tables which have schema files in the dump directory.
+func (l *LightningLoader) dumpedTables(ctx context.Context) ([]filter.Table, error) {
+	files, err := storage.CollectDirFiles(ctx, l.cfg.Dir, l.cfg.ExtStorage)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]filter.Table, 0, len(files))
+	for file := range files {
+		if !strings.HasSuffix(file, "-schema.sql") {
+			continue
+		}
+		schema, table, ok := strings.Cut(strings.TrimSuffix(file, "-schema.sql"), ".")
+		if !ok {
+			continue
+		}
+		tables = append(tables, filter.Table{Schema: schema, Name: table})
+	}
+	return tables, nil
+}
+
+// setConcreteRoutes replaces the route rules which refer to capture groups in the lightning configs by the rules of
+// the dumped tables, because lightning doesn't support capture groups.
+func (l *LightningLoader) setConcreteRoutes(ctx context.Context, cfgs []*lcfg.Config) error {
+	tableRouter, err := tablerouter.NewRouteTable(l.cfg.CaseSensitive, l.cfg.RouteRules)
+	if err != nil {
+		return terror.ErrLoadUnitGenTableRouter.Delegate(err)
+	}
+	if !tableRouter.HasCaptureRules() {
+		return nil
+	}
+	tables, err := l.dumpedTables(ctx)
+	if err != nil {
+		return err
+	}
+	routes := tableRouter.ConcreteRules(tables)
+	for _, cfg := range cfgs {
+		cfg.Routes = routes
+	}
+	return nil
+}
+
+// getResumeProgress returns the progress of the engines and chunks of the dumped tables in the lightning checkpoint,
+// lightning resumes the import from the unfinished chunks and engines. It returns nil if there is no checkpoint.
+func (l *LightningLoader) getResumeProgress(ctx context.Context, cfg *lcfg.Config) (*pb.LoadResumeProgress, error) {
+	dumpedTables, err := l.dumpedTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableRouter, err := tablerouter.NewRouteTable(l.cfg.CaseSensitive, l.cfg.RouteRules)
+	if err != nil {
+		return nil, terror.ErrLoadUnitGenTableRouter.Delegate(err)
+	}
+	// the tables of lightning checkpoint are the target tables.
+	tables := make(map[string]struct{})
+	for _, dumped := range dumpedTables {
+		schema, table := dumped.Schema, dumped.Name
+		targetSchema, targetTable, err2 := tableRouter.Route(schema, table)
+		if err2 != nil {
+			return nil, terror.ErrLoadUnitGenTableRouter.Delegate(err2)
+		}
+		if targetSchema == "" {
+			targetSchema = schema
+		}
+		if targetTable == "" {
+			targetTable = table
+		}
+		tables[common.UniqueTable(targetSchema, targetTable)] = struct{}{}
+	}
+
+	cpdb, err := checkpoints.OpenCheckpointsDB(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = cpdb.Close()
+	}()
+	progress := &pb.LoadResumeProgress{}
+	for table := range tables {
+		cp, err2 := cpdb.Get(ctx, table)
+		if err2 != nil {
+			if errors.IsNotFound(err2) {
+				continue
+			}
+			return nil, err2
+		}
+		for engineID, engine := range cp.Engines {
+			// the index engine has no chunks, it's imported after the data engines.
+			if engineID == common.IndexEngineID {
+				continue
+			}
+			progress.TotalEngines++
+			if engine.Status >= checkpoints.CheckpointStatusImported {
+				progress.ImportedEngines++
+			}
+			for _, chunk := range engine.Chunks {
+				progress.TotalChunks++
+				if chunk.Chunk.Offset >= chunk.Chunk.EndOffset {
+					progress.FinishedChunks++
+				}
+			}
+		}
+	}
+	if progress.TotalEngines == 0 {
+		return nil, nil
+	}
+	return progress, nil
+}
+
 func (l *LightningLoader) runLightning(ctx context.Context, cfg *lcfg.Config) (err error) {
 	taskCtx, cancel := context.WithCancel(ctx)
 	l.Lock()
@@ -414,6 +528,58 @@ func (l *LightningLoader) getLightningConfig() (*lcfg.Config, error) {
 	return cfg, nil
 }
 
+// lightningConfigs returns the configs of the lightning runs to load the dumped data one after another. The first run
+// loads the tables which are not matched by the table configs of the loader, and each of the other runs loads the
+// tables matched by a table config with its concurrency. A table matched by many table configs is loaded by the first
+// one.
+func (l *LightningLoader) lightningConfigs() ([]*lcfg.Config, error) {
+	cfg, err := l.getLightningConfig()
+	if err != nil {
+		return nil, err
+	}
+	tableCfgs := l.cfg.LoaderConfig.TableConfigs
+	if len(tableCfgs) == 0 {
+		return []*lcfg.Config{cfg}, nil
+	}
+
+	cfgs := make([]*lcfg.Config, 0, len(tableCfgs)+1)
+	var excluded []string
+	for _, tableCfg := range tableCfgs {
+		for _, table := range tableCfg.Tables {
+			excluded = append(excluded, "!"+table)
+		}
+	}
+	// the filter is shared with the global config, so copy it before appending.
+	cfg.Mydumper.Filter = append(append([]string{}, cfg.Mydumper.Filter...), excluded...)
+	cfgs = append(cfgs, cfg)
+
+	for i, tableCfg := range tableCfgs {
+		cfg, err = l.getLightningConfig()
+		if err != nil {
+			return nil, err
+		}
+		// excluded[:n] are the tables of the former table configs.
+		n := 0
+		for _, former := range tableCfgs[:i] {
+			n += len(former.Tables)
+		}
+		cfg.Mydumper.Filter = append(append([]string{}, tableCfg.Tables...), excluded[:n]...)
+		if tableCfg.PoolSize > 0 {
+			cfg.App.RegionConcurrency = tableCfg.PoolSize
+		}
+		if tableCfg.BatchSize > 0 {
+			cfg.Mydumper.BatchSize = tableCfg.BatchSize
+		}
+		cfgs = append(cfgs, cfg)
+	}
+
+	// only the last run removes the checkpoint, so the tables loaded by the former runs are skipped when resuming.
+	for _, cfg := range cfgs[:len(cfgs)-1] {
+		cfg.Checkpoint.KeepAfterSuccess = lcfg.CheckpointOrigin
+	}
+	return cfgs, nil
+}
+
 func (l *LightningLoader) restore(ctx context.Context) error {
 	if err := putLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 		return err
@@ -424,11 +590,26 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		return err
 	}
 
+	cfgs, err := l.lightningConfigs()
+	if err != nil {
+		return err
+	}
+	if err = l.setConcreteRoutes(ctx, cfgs); err != nil {
+		return err
+	}
+	startRun := 0
+
 	// we have disabled auto-resume for below errors, so if lightning is resuming
 	// it means user wants to skip this error.
 	switch {
 	case terror.ErrLoadLightningHasDup.Equal(l.lastErr),
 		terror.ErrLoadLightningChecksum.Equal(l.lastErr):
+		if l.lastErrRun+1 < len(cfgs) {
+			l.logger.Info("manually resume from error, DM will skip the error and continue to load the remaining tables",
+				zap.Error(l.lastErr))
+			startRun = l.lastErrRun + 1
+			break
+		}
 		l.logger.Info("manually resume from error, DM will skip the error and continue to next unit",
 			zap.Error(l.lastErr))
 
@@ -445,15 +626,25 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		if err = l.checkPointList.RegisterCheckPoint(ctx); err != nil {
 			return err
 		}
-		var cfg *lcfg.Config
-		cfg, err = l.getLightningConfig()
-		if err != nil {
-			return err
-		}
 		if err2 := readyAndWait(ctx, l.cli, l.cfg); err2 != nil {
 			return err2
 		}
-		err = l.runLightning(ctx, cfg)
+		if status == lightningStatusRunning {
+			// all the lightning runs share the same checkpoint.
+			progress, err2 := l.getResumeProgress(ctx, cfgs[0])
+			if err2 != nil {
+				l.logger.Warn("fail to get the resume progress from lightning checkpoint", log.ShortError(err2))
+			} else if progress != nil {
+				l.logger.Info("resume lightning from checkpoint", zap.Stringer("progress", progress))
+				l.resumeProgress.Store(progress)
+			}
+		}
+		for i := startRun; i < len(cfgs); i++ {
+			l.lastErrRun = i
+			if err = l.runLightning(ctx, cfgs[i]); err != nil {
+				break
+			}
+		}
 		if err == nil {
 			l.finish.Store(true)
 			err = l.checkPointList.UpdateStatus(ctx, lightningStatusFinished)
@@ -623,6 +814,9 @@ func (l *LightningLoader) status() *pb.LoadStatus {
 		MetaBinlogGTID: l.metaBinlogGTID.Load(),
 		Bps:            currentSpeed,
 	}
+	if !l.finish.Load() {
+		s.ResumeProgress = l.resumeProgress.Load()
+	}
 	return s
 }
 
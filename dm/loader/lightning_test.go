@@ -14,13 +14,19 @@
 package loader
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +45,99 @@ func TestSetLightningConfig(t *testing.T) {
 	require.Equal(t, stCfg.LoaderConfig.PoolSize, cfg.App.RegionConcurrency)
 }
 
+func TestLightningConfigs(t *testing.T) {
+	t.Parallel()
+
+	stCfg := &config.SubTaskConfig{
+		LoaderConfig: config.LoaderConfig{
+			PoolSize: 10,
+		},
+	}
+	l := NewLightning(stCfg, nil, "")
+	cfgs, err := l.lightningConfigs()
+	require.NoError(t, err)
+	require.Len(t, cfgs, 1)
+	require.Equal(t, lcfg.GetDefaultFilter(), cfgs[0].Mydumper.Filter)
+	require.Equal(t, lcfg.CheckpointRemove, cfgs[0].Checkpoint.KeepAfterSuccess)
+
+	stCfg.LoaderConfig.TableConfigs = []*config.LoadTableConfig{
+		{Tables: []string{"db.large_*"}, PoolSize: 32},
+		{Tables: []string{"db.large_1", "db.huge"}, BatchSize: 1024},
+	}
+	cfgs, err = l.lightningConfigs()
+	require.NoError(t, err)
+	require.Len(t, cfgs, 3)
+	require.Equal(t, append(lcfg.GetDefaultFilter(), "!db.large_*", "!db.large_1", "!db.huge"), cfgs[0].Mydumper.Filter)
+	require.Equal(t, 10, cfgs[0].App.RegionConcurrency)
+	require.Equal(t, []string{"db.large_*"}, cfgs[1].Mydumper.Filter)
+	require.Equal(t, 32, cfgs[1].App.RegionConcurrency)
+	require.Zero(t, cfgs[1].Mydumper.BatchSize)
+	require.Equal(t, []string{"db.large_1", "db.huge", "!db.large_*"}, cfgs[2].Mydumper.Filter)
+	require.Equal(t, 10, cfgs[2].App.RegionConcurrency)
+	require.Equal(t, lcfg.ByteSize(1024), cfgs[2].Mydumper.BatchSize)
+	require.Equal(t, lcfg.CheckpointOrigin, cfgs[0].Checkpoint.KeepAfterSuccess)
+	require.Equal(t, lcfg.CheckpointOrigin, cfgs[1].Checkpoint.KeepAfterSuccess)
+	require.Equal(t, lcfg.CheckpointRemove, cfgs[2].Checkpoint.KeepAfterSuccess)
+}
+
+func TestGetResumeProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db-schema-create.sql"), []byte("CREATE DATABASE db;"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db.tbl-schema.sql"), []byte("CREATE TABLE tbl (id INT);"), 0o644))
+	stCfg := &config.SubTaskConfig{
+		LoaderConfig: config.LoaderConfig{
+			Dir: dir,
+		},
+	}
+	l := NewLightning(stCfg, nil, "")
+	cfg, err := l.getLightningConfig()
+	require.NoError(t, err)
+
+	// no checkpoint of the table.
+	progress, err := l.getResumeProgress(ctx, cfg)
+	require.NoError(t, err)
+	require.Nil(t, progress)
+
+	cpdb, err := checkpoints.OpenCheckpointsDB(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, cpdb.Initialize(ctx, cfg, map[string]*checkpoints.TidbDBInfo{
+		"db": {Name: "db", Tables: map[string]*checkpoints.TidbTableInfo{"tbl": {DB: "db", Name: "tbl"}}},
+	}))
+	tableName := common.UniqueTable("db", "tbl")
+	require.NoError(t, cpdb.InsertEngineCheckpoints(ctx, tableName, map[int32]*checkpoints.EngineCheckpoint{
+		0: {Chunks: []*checkpoints.ChunkCheckpoint{
+			{Key: checkpoints.ChunkCheckpointKey{Path: "db.tbl.0.sql"}, Chunk: mydump.Chunk{Offset: 10, EndOffset: 10}},
+		}},
+		1: {Chunks: []*checkpoints.ChunkCheckpoint{
+			{Key: checkpoints.ChunkCheckpointKey{Path: "db.tbl.1.sql"}, Chunk: mydump.Chunk{Offset: 5, EndOffset: 10}},
+			{Key: checkpoints.ChunkCheckpointKey{Path: "db.tbl.2.sql"}, Chunk: mydump.Chunk{Offset: 10, EndOffset: 10}},
+		}},
+		common.IndexEngineID: {},
+	}))
+	diff := checkpoints.NewTableCheckpointDiff()
+	(&checkpoints.StatusCheckpointMerger{EngineID: 0, Status: checkpoints.CheckpointStatusImported}).MergeInto(diff)
+	require.NoError(t, cpdb.Update(ctx, map[string]*checkpoints.TableCheckpointDiff{tableName: diff}))
+	require.NoError(t, cpdb.Close())
+
+	progress, err = l.getResumeProgress(ctx, cfg)
+	require.NoError(t, err)
+	expected := &pb.LoadResumeProgress{ImportedEngines: 1, TotalEngines: 2, FinishedChunks: 2, TotalChunks: 3}
+	require.Equal(t, expected, progress)
+
+	// the resume progress is shown separately from the percentage until the import is finished.
+	l.resumeProgress.Store(progress)
+	status := l.status()
+	require.Equal(t, "0.00 %", status.Progress)
+	require.Equal(t, expected, status.ResumeProgress)
+	l.finish.Store(true)
+	status = l.status()
+	require.Equal(t, "100.00 %", status.Progress)
+	require.Nil(t, status.ResumeProgress)
+}
+
 func TestConvertLightningError(t *testing.T) {
 	t.Parallel()
 
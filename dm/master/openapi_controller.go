@@ -22,6 +22,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pingcap/log"
@@ -568,6 +570,14 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 				Progress:       loadS.Progress,
 				TotalBytes:     loadS.TotalBytes,
 			}
+			if resumeS := loadS.GetResumeProgress(); resumeS != nil {
+				openapiSubTaskStatus.LoadStatus.ResumeProgress = &openapi.LoadResumeProgress{
+					ImportedEngines: resumeS.ImportedEngines,
+					TotalEngines:    resumeS.TotalEngines,
+					FinishedChunks:  resumeS.FinishedChunks,
+					TotalChunks:     resumeS.TotalChunks,
+				}
+			}
 		}
 		// add sync status
 		if syncerS := subTaskStatus.GetSync(); syncerS != nil {
@@ -620,6 +630,87 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string, req openapi
 	return subTaskStatusList, nil
 }
 
+// getTaskShardDDLConflicts returns the conflicting tables of the optimistic shard DDL locks of the task.
+// subTaskStatusEventStageRemoved is the stage of the event of a subtask which doesn't exist anymore.
+const subTaskStatusEventStageRemoved = "Removed"
+
+// subTaskStatusEvents returns the events of the subtasks in statusList, keyed by the source name.
+func subTaskStatusEvents(statusList []openapi.SubTaskStatus) map[string]openapi.SubTaskStatusEvent {
+	events := make(map[string]openapi.SubTaskStatusEvent, len(statusList))
+	for _, status := range statusList {
+		event := openapi.SubTaskStatusEvent{
+			Name:       status.Name,
+			SourceName: status.SourceName,
+			Stage:      string(status.Stage),
+			ErrorMsg:   status.ErrorMsg,
+		}
+		if status.Unit != "" {
+			unit := status.Unit
+			event.Unit = &unit
+		}
+		if status.SyncStatus != nil {
+			secondsBehindMaster := status.SyncStatus.SecondsBehindMaster
+			event.SecondsBehindMaster = &secondsBehindMaster
+		}
+		events[status.SourceName] = event
+	}
+	return events
+}
+
+// changedSubTaskStatusEvents returns the events in curr which are different from prev, and the removed events in prev,
+// sorted by the source names.
+func changedSubTaskStatusEvents(prev, curr map[string]openapi.SubTaskStatusEvent) []openapi.SubTaskStatusEvent {
+	sources := make([]string, 0, len(curr))
+	for source, event := range curr {
+		if prevEvent, ok := prev[source]; !ok || !reflect.DeepEqual(prevEvent, event) {
+			sources = append(sources, source)
+		}
+	}
+	for source := range prev {
+		if _, ok := curr[source]; !ok {
+			sources = append(sources, source)
+		}
+	}
+	sort.Strings(sources)
+
+	changed := make([]openapi.SubTaskStatusEvent, 0, len(sources))
+	for _, source := range sources {
+		event, ok := curr[source]
+		if !ok {
+			event = openapi.SubTaskStatusEvent{Name: prev[source].Name, SourceName: source, Stage: subTaskStatusEventStageRemoved}
+		}
+		changed = append(changed, event)
+	}
+	return changed
+}
+
+func (s *Server) getTaskShardDDLConflicts(taskName string, req openapi.DMAPIGetTaskShardDDLConflictsParams) ([]openapi.ShardDDLConflict, error) {
+	if s.scheduler.GetSubTaskCfgsByTask(taskName) == nil {
+		return nil, terror.ErrSchedulerTaskNotExist.Generate(taskName)
+	}
+	var sources []string
+	if req.SourceNameList != nil {
+		sources = *req.SourceNameList
+	}
+	conflicts, err := s.optimist.ShowConflicts(taskName, sources)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]openapi.ShardDDLConflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		ret = append(ret, openapi.ShardDDLConflict{
+			LockId:       c.ID,
+			SourceName:   c.Source,
+			SchemaName:   c.UpSchema,
+			TableName:    c.UpTable,
+			DdlList:      c.DDLs,
+			Message:      c.Msg,
+			ResolveHints: c.ResolveHints,
+		})
+	}
+	return ret, nil
+}
+
 func (s *Server) listTask(ctx context.Context, req openapi.DMAPIGetTaskListParams) ([]openapi.Task, error) {
 	subTaskConfigMap := s.scheduler.GetALlSubTaskCfgs()
 	taskList := config.SubTaskConfigsToOpenAPITaskList(subTaskConfigMap)
@@ -756,6 +847,88 @@ func (s *Server) stopTask(ctx context.Context, taskName string, req openapi.Stop
 	return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Stopped, taskName, *req.SourceNameList...)
 }
 
+// batchOperateTasks operates the tasks of the request one by one, and returns the result of each task.
+func (s *Server) batchOperateTasks(ctx context.Context, req openapi.BatchOperateTaskRequest) ([]openapi.BatchOperateTaskResult, error) {
+	if req.Op == openapi.BatchOperateTaskRequestOpUpdate {
+		if req.TaskList == nil || len(*req.TaskList) == 0 {
+			return nil, terror.ErrOpenAPICommonError.Generate("`task_list` must be entered for the update operation")
+		}
+		results := make([]openapi.BatchOperateTaskResult, 0, len(*req.TaskList))
+		for _, task := range *req.TaskList {
+			_, err := s.updateTask(ctx, openapi.UpdateTaskRequest{Task: task})
+			results = append(results, newBatchOperateTaskResult(task.Name, err))
+		}
+		return results, nil
+	}
+
+	var operate func(taskName string) error
+	switch req.Op {
+	case openapi.BatchOperateTaskRequestOpStart:
+		operate = func(taskName string) error {
+			return s.startTask(ctx, taskName, openapi.StartTaskRequest{})
+		}
+	case openapi.BatchOperateTaskRequestOpStop:
+		operate = func(taskName string) error {
+			return s.stopTask(ctx, taskName, openapi.StopTaskRequest{})
+		}
+	case openapi.BatchOperateTaskRequestOpPause:
+		operate = func(taskName string) error {
+			return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Paused, taskName, s.getTaskSourceNameList(taskName)...)
+		}
+	case openapi.BatchOperateTaskRequestOpResume:
+		operate = func(taskName string) error {
+			return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Running, taskName, s.getTaskSourceNameList(taskName)...)
+		}
+	default:
+		return nil, terror.ErrMasterInvalidOperateOp.Generate(string(req.Op), "task")
+	}
+
+	taskNameList, err := s.selectBatchTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]openapi.BatchOperateTaskResult, 0, len(taskNameList))
+	for _, taskName := range taskNameList {
+		if len(s.scheduler.GetSubTaskCfgsByTask(taskName)) == 0 {
+			err = terror.ErrSchedulerTaskNotExist.Generate(taskName)
+		} else {
+			err = operate(taskName)
+		}
+		results = append(results, newBatchOperateTaskResult(taskName, err))
+	}
+	return results, nil
+}
+
+// selectBatchTasks returns the task names of the request, or the tasks filtered by the stage and the sources of the
+// request like listing tasks if the task names are not specified.
+func (s *Server) selectBatchTasks(ctx context.Context, req openapi.BatchOperateTaskRequest) ([]string, error) {
+	if req.TaskNameList != nil && len(*req.TaskNameList) > 0 {
+		return *req.TaskNameList, nil
+	}
+	if req.Stage == nil && (req.SourceNameList == nil || len(*req.SourceNameList) == 0) {
+		return nil, terror.ErrOpenAPICommonError.Generate("one of `task_name_list`, `stage` or `source_name_list` must be entered.")
+	}
+	taskList, err := s.listTask(ctx, openapi.DMAPIGetTaskListParams{Stage: req.Stage, SourceNameList: req.SourceNameList})
+	if err != nil {
+		return nil, err
+	}
+	taskNameList := make([]string, 0, len(taskList))
+	for _, task := range taskList {
+		taskNameList = append(taskNameList, task.Name)
+	}
+	sort.Strings(taskNameList)
+	return taskNameList, nil
+}
+
+func newBatchOperateTaskResult(taskName string, err error) openapi.BatchOperateTaskResult {
+	result := openapi.BatchOperateTaskResult{TaskName: taskName, Success: err == nil}
+	if err != nil {
+		errMsg := err.Error()
+		result.ErrorMsg = &errMsg
+	}
+	return result
+}
+
 // handleCliArgs handles cli args.
 // it will try to delete args if cli args is nil.
 func handleCliArgs(cli *clientv3.Client, taskName string, sources []string, cliArgs *config.TaskCliArgs) error {
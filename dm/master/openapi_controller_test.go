@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/ha"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -546,6 +547,40 @@ func (s *OpenAPIControllerSuite) TestTaskControllerWithInvalidTask() {
 	}
 }
 
+func TestChangedSubTaskStatusEvents(t *testing.T) {
+	errMsg := "worker is offline"
+	statusList := []openapi.SubTaskStatus{
+		{
+			Name:       "test",
+			SourceName: "mysql-replica-01",
+			Stage:      openapi.TaskStageRunning,
+			Unit:       "Sync",
+			SyncStatus: &openapi.SyncStatus{SecondsBehindMaster: 3, RecentTps: 10},
+		},
+		{Name: "test", SourceName: "mysql-replica-02", ErrorMsg: &errMsg},
+	}
+	unit := "Sync"
+	secondsBehindMaster := int64(3)
+	prev := subTaskStatusEvents(statusList)
+	require.Equal(t, []openapi.SubTaskStatusEvent{
+		{Name: "test", SourceName: "mysql-replica-01", Stage: "Running", Unit: &unit, SecondsBehindMaster: &secondsBehindMaster},
+		{Name: "test", SourceName: "mysql-replica-02", ErrorMsg: &errMsg},
+	}, changedSubTaskStatusEvents(nil, prev))
+
+	// the other fields of the status don't make an event.
+	statusList[0].SyncStatus.RecentTps = 20
+	require.Len(t, changedSubTaskStatusEvents(prev, subTaskStatusEvents(statusList)), 0)
+
+	// the subtask is lagging behind, and the source is removed.
+	statusList[0].SyncStatus.SecondsBehindMaster = 10
+	secondsBehindMaster = 10
+	statusList = statusList[:1]
+	require.Equal(t, []openapi.SubTaskStatusEvent{
+		{Name: "test", SourceName: "mysql-replica-01", Stage: "Running", Unit: &unit, SecondsBehindMaster: &secondsBehindMaster},
+		{Name: "test", SourceName: "mysql-replica-02", Stage: subTaskStatusEventStageRemoved},
+	}, changedSubTaskStatusEvents(prev, subTaskStatusEvents(statusList)))
+}
+
 func TestOpenAPIControllerSuite(t *testing.T) {
 	suite.Run(t, new(OpenAPIControllerSuite))
 }
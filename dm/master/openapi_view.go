@@ -19,10 +19,12 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"strconv"
+	"time"
 
 	ginmiddleware "github.com/deepmap/oapi-codegen/pkg/gin-middleware"
 	"github.com/gin-gonic/gin"
@@ -463,6 +465,22 @@ func (s *Server) DMAPICreateTask(c *gin.Context) {
 	c.IndentedJSON(http.StatusCreated, res)
 }
 
+// DMAPIBatchOperateTasks url is: (POST /api/v1/tasks/batch).
+func (s *Server) DMAPIBatchOperateTasks(c *gin.Context) {
+	var req openapi.BatchOperateTaskRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	results, err := s.batchOperateTasks(c.Request.Context(), req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.BatchOperateTaskResponse{Total: len(results), Data: results}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
 // DMAPIUpdateTask url is: (PUT /api/v1/tasks/{task-name}).
 func (s *Server) DMAPIUpdateTask(c *gin.Context, taskName string) {
 	var req openapi.UpdateTaskRequest
@@ -520,6 +538,62 @@ func (s *Server) DMAPIGetTaskStatus(c *gin.Context, taskName string, params open
 	c.IndentedJSON(http.StatusOK, resp)
 }
 
+// DMAPIWatchTaskStatus url is:(GET /api/v1/tasks/{task-name}/status/events).
+func (s *Server) DMAPIWatchTaskStatus(c *gin.Context, taskName string, params openapi.DMAPIWatchTaskStatusParams) {
+	if s.scheduler.GetSubTaskCfgsByTask(taskName) == nil {
+		_ = c.Error(terror.ErrSchedulerTaskNotExist.Generate(taskName))
+		return
+	}
+	interval := time.Second
+	if params.Interval != nil {
+		if *params.Interval <= 0 {
+			_ = c.Error(terror.ErrOpenAPICommonError.Generate("interval should be a positive number of seconds"))
+			return
+		}
+		interval = time.Duration(*params.Interval) * time.Second
+	}
+
+	ctx := c.Request.Context()
+	statusParams := openapi.DMAPIGetTaskStatusParams{SourceNameList: params.SourceNameList}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var prev map[string]openapi.SubTaskStatusEvent
+	c.Stream(func(io.Writer) bool {
+		statusList, err := s.getTaskStatus(ctx, taskName, statusParams)
+		if err != nil {
+			// the response has been started, so report the error as an event.
+			c.SSEvent("error", newErrorWithMessage(err))
+			return false
+		}
+		curr := subTaskStatusEvents(statusList)
+		now := time.Now().Format(time.RFC3339)
+		for _, event := range changedSubTaskStatusEvents(prev, curr) {
+			event.Time = now
+			c.SSEvent("status", event)
+		}
+		prev = curr
+		c.Writer.Flush()
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			return true
+		}
+	})
+}
+
+// DMAPIGetTaskShardDDLConflicts url is: (GET /api/v1/tasks/{task-name}/shard_ddl_conflicts).
+func (s *Server) DMAPIGetTaskShardDDLConflicts(c *gin.Context, taskName string, params openapi.DMAPIGetTaskShardDDLConflictsParams) {
+	conflicts, err := s.getTaskShardDDLConflicts(taskName, params)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := openapi.GetTaskShardDDLConflictsResponse{Total: len(conflicts), Data: conflicts}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
 // DMAPIGetTaskList url is:(GET /api/v1/tasks).
 func (s *Server) DMAPIGetTaskList(c *gin.Context, params openapi.DMAPIGetTaskListParams) {
 	ctx := c.Request.Context()
@@ -918,14 +992,13 @@ func terrorHTTPErrorHandler() gin.HandlerFunc {
 		if gErr == nil {
 			return
 		}
-		var code int
-		var msg string
-		if tErr, ok := gErr.Err.(*terror.Error); ok {
-			code = int(tErr.Code())
-			msg = tErr.Error()
-		} else {
-			msg = gErr.Error()
-		}
-		c.IndentedJSON(http.StatusBadRequest, openapi.ErrorWithMessage{ErrorMsg: msg, ErrorCode: code})
+		c.IndentedJSON(http.StatusBadRequest, newErrorWithMessage(gErr.Err))
+	}
+}
+
+func newErrorWithMessage(err error) openapi.ErrorWithMessage {
+	if tErr, ok := err.(*terror.Error); ok {
+		return openapi.ErrorWithMessage{ErrorMsg: tErr.Error(), ErrorCode: int(tErr.Code())}
 	}
+	return openapi.ErrorWithMessage{ErrorMsg: err.Error()}
 }
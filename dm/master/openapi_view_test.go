@@ -1065,6 +1065,23 @@ func (s *OpenAPIViewSuite) TestTaskAPI() {
 	s.NoError(result.UnmarshalBodyToObject(&resultTaskStatusWithStatus))
 	s.EqualValues(resultTaskStatus, resultTaskStatusWithStatus)
 
+	// get shard DDL conflicts of the task, no conflict
+	conflictsURL := fmt.Sprintf("%s/%s/shard_ddl_conflicts", taskURL, task.Name)
+	result = testutil.NewRequest().Get(conflictsURL).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	var resultConflicts openapi.GetTaskShardDDLConflictsResponse
+	s.NoError(result.UnmarshalBodyToObject(&resultConflicts))
+	s.Equal(0, resultConflicts.Total)
+	s.Len(resultConflicts.Data, 0)
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/not-exist/shard_ddl_conflicts", taskURL)).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+
+	// watch the status of a not exist task, or with an invalid interval
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/not-exist/status/events", taskURL)).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/%s/status/events?interval=0", taskURL, task.Name)).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+
 	// list task with status
 	result = testutil.NewRequest().Get(taskURL+"?with_status=true").GoWithHTTPHandler(s.T(), s1.openapiHandles)
 	s.Equal(http.StatusOK, result.Code())
@@ -1164,6 +1181,45 @@ func (s *OpenAPIViewSuite) TestTaskAPI() {
 	s.Equal(http.StatusOK, result.Code())
 	s.Equal(pb.Stage_Stopped, s1.scheduler.GetExpectSubTaskStage(task.Name, source1Name).Expect)
 
+	// batch operate tasks
+	batchURL := fmt.Sprintf("%s/%s", taskURL, "batch")
+	batchReq := openapi.BatchOperateTaskRequest{Op: openapi.BatchOperateTaskRequestOpResume}
+	result = testutil.NewRequest().Post(batchURL).WithJsonBody(batchReq).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code()) // no task is selected
+
+	batchReq.TaskNameList = &openapi.TaskNameList{task.Name, "not-exist-task"}
+	result = testutil.NewRequest().Post(batchURL).WithJsonBody(batchReq).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	var batchResp openapi.BatchOperateTaskResponse
+	s.NoError(result.UnmarshalBodyToObject(&batchResp))
+	s.Equal(2, batchResp.Total)
+	s.Equal(task.Name, batchResp.Data[0].TaskName)
+	s.True(batchResp.Data[0].Success)
+	s.Equal("not-exist-task", batchResp.Data[1].TaskName)
+	s.False(batchResp.Data[1].Success)
+	s.Contains(*batchResp.Data[1].ErrorMsg, "not exist")
+	s.Equal(pb.Stage_Running, s1.scheduler.GetExpectSubTaskStage(task.Name, source1Name).Expect)
+
+	batchReq = openapi.BatchOperateTaskRequest{
+		Op:             openapi.BatchOperateTaskRequestOpPause,
+		SourceNameList: &openapi.SourceNameList{source1Name},
+	}
+	result = testutil.NewRequest().Post(batchURL).WithJsonBody(batchReq).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	batchResp = openapi.BatchOperateTaskResponse{} // reset
+	s.NoError(result.UnmarshalBodyToObject(&batchResp))
+	s.Equal(1, batchResp.Total)
+	s.True(batchResp.Data[0].Success)
+	s.Equal(pb.Stage_Paused, s1.scheduler.GetExpectSubTaskStage(task.Name, source1Name).Expect)
+
+	batchReq = openapi.BatchOperateTaskRequest{
+		Op:           openapi.BatchOperateTaskRequestOpStop,
+		TaskNameList: &openapi.TaskNameList{task.Name},
+	}
+	result = testutil.NewRequest().Post(batchURL).WithJsonBody(batchReq).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	s.Equal(pb.Stage_Stopped, s1.scheduler.GetExpectSubTaskStage(task.Name, source1Name).Expect)
+
 	// delete task
 	result = testutil.NewRequest().Delete(task1URL+"?force=true").GoWithHTTPHandler(s.T(), s1.openapiHandles)
 	s.Equal(http.StatusNoContent, result.Code())
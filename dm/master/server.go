@@ -916,6 +916,8 @@ func (s *Server) ShowDDLLocks(ctx context.Context, req *pb.ShowDDLLocksRequest)
 		resp.Msg = "no DDL lock exists"
 	} else if err != nil {
 		resp.Msg = fmt.Sprintf("may lost owner and ddls info for optimistic locks, err: %s", err)
+	} else if resp.Conflicts, err = s.optimist.ShowConflicts(req.Task, req.Sources); err != nil {
+		resp.Msg = fmt.Sprintf("may lost conflicts info for optimistic locks, err: %s", err)
 	}
 	return resp, nil
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 // Optimist is used to coordinate the shard DDL migration in optimism mode.
@@ -216,6 +217,71 @@ func (o *Optimist) ShowLocks(task string, sources []string) ([]*pb.DDLLock, erro
 	return ret, err
 }
 
+// conflictResolveHints returns the dmctl commands which can resolve the conflict of a table, by skipping the pending
+// DDLs of the table or by forcing the table schema in the schema tracker.
+func conflictResolveHints(lockID, task, source, schema, table string) []string {
+	return []string{
+		fmt.Sprintf("shard-ddl-lock unlock '%s' -s %s -d %s -t %s --action skip", lockID, source, schema, table),
+		fmt.Sprintf("binlog-schema update %s %s %s <schema-file> -s %s", task, schema, table, source),
+	}
+}
+
+// ShowConflicts shows the conflicting tables of the optimistic shard DDL locks, sorted by the lock ID, source,
+// schema and table.
+func (o *Optimist) ShowConflicts(task string, sources []string) ([]*pb.DDLLockConflict, error) {
+	opm, _, err := optimism.GetAllOperations(o.cli)
+	if err != nil {
+		return nil, err
+	}
+	ifm, _, err := optimism.GetAllInfo(o.cli)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*pb.DDLLockConflict
+	for taskName, opms := range opm {
+		if task != "" && task != taskName {
+			continue
+		}
+		for source, opmss := range opms {
+			if len(sources) > 0 && !slices.Contains(sources, source) {
+				continue
+			}
+			for schema, opmsst := range opmss {
+				for table, op := range opmsst {
+					if op.ConflictStage != optimism.ConflictDetected {
+						continue
+					}
+					info, ok := ifm[taskName][source][schema][table]
+					if !ok {
+						continue
+					}
+					ret = append(ret, &pb.DDLLockConflict{
+						ID:           op.ID,
+						Task:         taskName,
+						Source:       source,
+						UpSchema:     schema,
+						UpTable:      table,
+						DDLs:         info.DDLs,
+						Msg:          op.ConflictMsg,
+						ResolveHints: conflictResolveHints(op.ID, taskName, source, schema, table),
+					})
+				}
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].ID != ret[j].ID {
+			return ret[i].ID < ret[j].ID
+		}
+		if ret[i].Source != ret[j].Source {
+			return ret[i].Source < ret[j].Source
+		}
+		return dbutil.TableName(ret[i].UpSchema, ret[i].UpTable) < dbutil.TableName(ret[j].UpSchema, ret[j].UpTable)
+	})
+	return ret, nil
+}
+
 // UnlockLock unlocks a shard DDL lock manually only when using `unlock-ddl-lock` command.
 // ID: the shard DDL lock ID.
 // source, upstreamSchema, upstreamTable: reveal the upstream table's info which we need to skip/exec
@@ -747,6 +747,27 @@ func (t *testOptimistSuite) TestOptimistLockConflict() {
 	require.Equal(t.T(), 0, len(opCh))
 	require.Equal(t.T(), 0, len(errCh))
 
+	// the conflicting table and its pending DDLs can be shown.
+	conflicts, err := o.ShowConflicts("", nil)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 1)
+	lockID := fmt.Sprintf("%s-`%s`.`%s`", task, downSchema, downTable)
+	require.Equal(t.T(), lockID, conflicts[0].ID)
+	require.Equal(t.T(), source1, conflicts[0].Source)
+	require.Equal(t.T(), "bar-2", conflicts[0].UpTable)
+	require.Equal(t.T(), DDLs2, conflicts[0].DDLs)
+	require.NotEmpty(t.T(), conflicts[0].Msg)
+	require.Equal(t.T(), []string{
+		fmt.Sprintf("shard-ddl-lock unlock '%s' -s %s -d foo -t bar-2 --action skip", lockID, source1),
+		fmt.Sprintf("binlog-schema update %s foo bar-2 <schema-file> -s %s", task, source1),
+	}, conflicts[0].ResolveHints)
+	conflicts, err = o.ShowConflicts("not-exist", nil)
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 0)
+	conflicts, err = o.ShowConflicts("", []string{"not-exist"})
+	require.NoError(t.T(), err)
+	require.Len(t.T(), conflicts, 0)
+
 	// PUT i3, no conflict now.
 	// case for handle-error replace
 	rev3, err := optimism.PutInfo(t.etcdTestCli, i3)
@@ -178,6 +178,11 @@ type ClientInterface interface {
 
 	DMAPICreateTask(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIBatchOperateTasks request with any body
+	DMAPIBatchOperateTasksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIBatchOperateTasks(ctx context.Context, body DMAPIBatchOperateTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIConvertTask request with any body
 	DMAPIConvertTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -216,6 +221,9 @@ type ClientInterface interface {
 
 	DMAPIUpdateTask(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIGetTaskShardDDLConflicts request
+	DMAPIGetTaskShardDDLConflicts(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -244,6 +252,9 @@ type ClientInterface interface {
 	// DMAPIGetTaskStatus request
 	DMAPIGetTaskStatus(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DMAPIWatchTaskStatus request
+	DMAPIWatchTaskStatus(ctx context.Context, taskName string, params *DMAPIWatchTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DMAPIStopTask request with any body
 	DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -634,6 +645,30 @@ func (c *Client) DMAPICreateTask(ctx context.Context, body DMAPICreateTaskJSONRe
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIBatchOperateTasksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIBatchOperateTasksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIBatchOperateTasks(ctx context.Context, body DMAPIBatchOperateTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIBatchOperateTasksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIConvertTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIConvertTaskRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -802,6 +837,18 @@ func (c *Client) DMAPIUpdateTask(ctx context.Context, taskName string, body DMAP
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIGetTaskShardDDLConflicts(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetTaskShardDDLConflictsRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIGetTaskMigrateTargets(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIGetTaskMigrateTargetsRequest(c.Server, taskName, sourceName, params)
 	if err != nil {
@@ -922,6 +969,18 @@ func (c *Client) DMAPIGetTaskStatus(ctx context.Context, taskName string, params
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIWatchTaskStatus(ctx context.Context, taskName string, params *DMAPIWatchTaskStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIWatchTaskStatusRequest(c.Server, taskName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDMAPIStopTaskRequestWithBody(c.Server, taskName, contentType, body)
 	if err != nil {
@@ -1917,6 +1976,46 @@ func NewDMAPICreateTaskRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
+// NewDMAPIBatchOperateTasksRequest calls the generic DMAPIBatchOperateTasks builder with application/json body
+func NewDMAPIBatchOperateTasksRequest(server string, body DMAPIBatchOperateTasksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIBatchOperateTasksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDMAPIBatchOperateTasksRequestWithBody generates requests for DMAPIBatchOperateTasks with any type of body
+func NewDMAPIBatchOperateTasksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewDMAPIConvertTaskRequest calls the generic DMAPIConvertTask builder with application/json body
 func NewDMAPIConvertTaskRequest(server string, body DMAPIConvertTaskJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -2317,6 +2416,58 @@ func NewDMAPIUpdateTaskRequestWithBody(server string, taskName string, contentTy
 	return req, nil
 }
 
+// NewDMAPIGetTaskShardDDLConflictsRequest generates requests for DMAPIGetTaskShardDDLConflicts
+func NewDMAPIGetTaskShardDDLConflictsRequest(server string, taskName string, params *DMAPIGetTaskShardDDLConflictsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/shard_ddl_conflicts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewDMAPIGetTaskMigrateTargetsRequest generates requests for DMAPIGetTaskMigrateTargets
 func NewDMAPIGetTaskMigrateTargetsRequest(server string, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams) (*http.Request, error) {
 	var err error
@@ -2756,6 +2907,76 @@ func NewDMAPIGetTaskStatusRequest(server string, taskName string, params *DMAPIG
 	return req, nil
 }
 
+// NewDMAPIWatchTaskStatusRequest generates requests for DMAPIWatchTaskStatus
+func NewDMAPIWatchTaskStatusRequest(server string, taskName string, params *DMAPIWatchTaskStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/status/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SourceNameList != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source_name_list", runtime.ParamLocationQuery, *params.SourceNameList); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Interval != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewDMAPIStopTaskRequest calls the generic DMAPIStopTask builder with application/json body
 func NewDMAPIStopTaskRequest(server string, taskName string, body DMAPIStopTaskJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -2934,6 +3155,11 @@ type ClientWithResponsesInterface interface {
 
 	DMAPICreateTaskWithResponse(ctx context.Context, body DMAPICreateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateTaskResponse, error)
 
+	// DMAPIBatchOperateTasks request with any body
+	DMAPIBatchOperateTasksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIBatchOperateTasksResponse, error)
+
+	DMAPIBatchOperateTasksWithResponse(ctx context.Context, body DMAPIBatchOperateTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIBatchOperateTasksResponse, error)
+
 	// DMAPIConvertTask request with any body
 	DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error)
 
@@ -2972,6 +3198,9 @@ type ClientWithResponsesInterface interface {
 
 	DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error)
 
+	// DMAPIGetTaskShardDDLConflicts request
+	DMAPIGetTaskShardDDLConflictsWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskShardDDLConflictsResponse, error)
+
 	// DMAPIGetTaskMigrateTargets request
 	DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error)
 
@@ -3000,6 +3229,9 @@ type ClientWithResponsesInterface interface {
 	// DMAPIGetTaskStatus request
 	DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error)
 
+	// DMAPIWatchTaskStatus request
+	DMAPIWatchTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIWatchTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIWatchTaskStatusResponse, error)
+
 	// DMAPIStopTask request with any body
 	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
 
@@ -3541,6 +3773,29 @@ func (r DMAPICreateTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIBatchOperateTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchOperateTaskResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIBatchOperateTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIBatchOperateTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DMAPIConvertTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3768,6 +4023,29 @@ func (r DMAPIUpdateTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIGetTaskShardDDLConflictsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetTaskShardDDLConflictsResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetTaskShardDDLConflictsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetTaskShardDDLConflictsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DMAPIGetTaskMigrateTargetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3949,6 +4227,28 @@ func (r DMAPIGetTaskStatusResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIWatchTaskStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIWatchTaskStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIWatchTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type DMAPIStopTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -4251,6 +4551,23 @@ func (c *ClientWithResponses) DMAPICreateTaskWithResponse(ctx context.Context, b
 	return ParseDMAPICreateTaskResponse(rsp)
 }
 
+// DMAPIBatchOperateTasksWithBodyWithResponse request with arbitrary body returning *DMAPIBatchOperateTasksResponse
+func (c *ClientWithResponses) DMAPIBatchOperateTasksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIBatchOperateTasksResponse, error) {
+	rsp, err := c.DMAPIBatchOperateTasksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIBatchOperateTasksResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIBatchOperateTasksWithResponse(ctx context.Context, body DMAPIBatchOperateTasksJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIBatchOperateTasksResponse, error) {
+	rsp, err := c.DMAPIBatchOperateTasks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIBatchOperateTasksResponse(rsp)
+}
+
 // DMAPIConvertTaskWithBodyWithResponse request with arbitrary body returning *DMAPIConvertTaskResponse
 func (c *ClientWithResponses) DMAPIConvertTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIConvertTaskResponse, error) {
 	rsp, err := c.DMAPIConvertTaskWithBody(ctx, contentType, body, reqEditors...)
@@ -4373,6 +4690,15 @@ func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, t
 	return ParseDMAPIUpdateTaskResponse(rsp)
 }
 
+// DMAPIGetTaskShardDDLConflictsWithResponse request returning *DMAPIGetTaskShardDDLConflictsResponse
+func (c *ClientWithResponses) DMAPIGetTaskShardDDLConflictsWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskShardDDLConflictsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskShardDDLConflictsResponse, error) {
+	rsp, err := c.DMAPIGetTaskShardDDLConflicts(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskShardDDLConflictsResponse(rsp)
+}
+
 // DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
 func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
@@ -4461,6 +4787,15 @@ func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context
 	return ParseDMAPIGetTaskStatusResponse(rsp)
 }
 
+// DMAPIWatchTaskStatusWithResponse request returning *DMAPIWatchTaskStatusResponse
+func (c *ClientWithResponses) DMAPIWatchTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIWatchTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIWatchTaskStatusResponse, error) {
+	rsp, err := c.DMAPIWatchTaskStatus(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIWatchTaskStatusResponse(rsp)
+}
+
 // DMAPIStopTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskResponse
 func (c *ClientWithResponses) DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
 	rsp, err := c.DMAPIStopTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
@@ -5132,6 +5467,39 @@ func ParseDMAPICreateTaskResponse(rsp *http.Response) (*DMAPICreateTaskResponse,
 	return response, nil
 }
 
+// ParseDMAPIBatchOperateTasksResponse parses an HTTP response from a DMAPIBatchOperateTasksWithResponse call
+func ParseDMAPIBatchOperateTasksResponse(rsp *http.Response) (*DMAPIBatchOperateTasksResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIBatchOperateTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchOperateTaskResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDMAPIConvertTaskResponse parses an HTTP response from a DMAPIConvertTaskWithResponse call
 func ParseDMAPIConvertTaskResponse(rsp *http.Response) (*DMAPIConvertTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5438,6 +5806,39 @@ func ParseDMAPIUpdateTaskResponse(rsp *http.Response) (*DMAPIUpdateTaskResponse,
 	return response, nil
 }
 
+// ParseDMAPIGetTaskShardDDLConflictsResponse parses an HTTP response from a DMAPIGetTaskShardDDLConflictsWithResponse call
+func ParseDMAPIGetTaskShardDDLConflictsResponse(rsp *http.Response) (*DMAPIGetTaskShardDDLConflictsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetTaskShardDDLConflictsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetTaskShardDDLConflictsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseDMAPIGetTaskMigrateTargetsResponse parses an HTTP response from a DMAPIGetTaskMigrateTargetsWithResponse call
 func ParseDMAPIGetTaskMigrateTargetsResponse(rsp *http.Response) (*DMAPIGetTaskMigrateTargetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -5691,6 +6092,32 @@ func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, err
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIWatchTaskStatusResponse parses an HTTP response from a DMAPIWatchTaskStatusWithResponse call
+func ParseDMAPIWatchTaskStatusResponse(rsp *http.Response) (*DMAPIWatchTaskStatusResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIWatchTaskStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorWithMessage
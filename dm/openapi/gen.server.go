@@ -92,6 +92,9 @@ type ServerInterface interface {
 	// create a task
 	// (POST /api/v1/tasks)
 	DMAPICreateTask(c *gin.Context)
+	// start, stop, pause, resume or update many tasks at once
+	// (POST /api/v1/tasks/batch)
+	DMAPIBatchOperateTasks(c *gin.Context)
 	// Turn task into the format of a configuration file or vice versa.
 	// (POST /api/v1/tasks/converters)
 	DMAPIConvertTask(c *gin.Context)
@@ -122,6 +125,9 @@ type ServerInterface interface {
 	// update a task
 	// (PUT /api/v1/tasks/{task-name})
 	DMAPIUpdateTask(c *gin.Context, taskName string)
+	// get the conflicting tables of the optimistic shard DDL locks of the task and how to resolve them
+	// (GET /api/v1/tasks/{task-name}/shard_ddl_conflicts)
+	DMAPIGetTaskShardDDLConflicts(c *gin.Context, taskName string, params DMAPIGetTaskShardDDLConflictsParams)
 	// get task source table and target table route relation
 	// (GET /api/v1/tasks/{task-name}/sources/{source-name}/migrate_targets)
 	DMAPIGetTaskMigrateTargets(c *gin.Context, taskName string, sourceName string, params DMAPIGetTaskMigrateTargetsParams)
@@ -146,6 +152,9 @@ type ServerInterface interface {
 	// get task status
 	// (GET /api/v1/tasks/{task-name}/status)
 	DMAPIGetTaskStatus(c *gin.Context, taskName string, params DMAPIGetTaskStatusParams)
+	// watch the changes of the subtasks' stage, sync lag and errors as server-sent events
+	// (GET /api/v1/tasks/{task-name}/status/events)
+	DMAPIWatchTaskStatus(c *gin.Context, taskName string, params DMAPIWatchTaskStatusParams)
 	// stop a task
 	// (POST /api/v1/tasks/{task-name}/stop)
 	DMAPIStopTask(c *gin.Context, taskName string)
@@ -624,6 +633,15 @@ func (siw *ServerInterfaceWrapper) DMAPICreateTask(c *gin.Context) {
 	siw.Handler.DMAPICreateTask(c)
 }
 
+// DMAPIBatchOperateTasks operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIBatchOperateTasks(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIBatchOperateTasks(c)
+}
+
 // DMAPIConvertTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIConvertTask(c *gin.Context) {
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -806,6 +824,39 @@ func (siw *ServerInterfaceWrapper) DMAPIUpdateTask(c *gin.Context) {
 	siw.Handler.DMAPIUpdateTask(c, taskName)
 }
 
+// DMAPIGetTaskShardDDLConflicts operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetTaskShardDDLConflicts(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIGetTaskShardDDLConflictsParams
+
+	// ------------- Optional query parameter "source_name_list" -------------
+	if paramValue := c.Query("source_name_list"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source_name_list: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetTaskShardDDLConflicts(c, taskName, params)
+}
+
 // DMAPIGetTaskMigrateTargets operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIGetTaskMigrateTargets(c *gin.Context) {
 	var err error
@@ -1119,6 +1170,49 @@ func (siw *ServerInterfaceWrapper) DMAPIGetTaskStatus(c *gin.Context) {
 	siw.Handler.DMAPIGetTaskStatus(c, taskName, params)
 }
 
+// DMAPIWatchTaskStatus operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIWatchTaskStatus(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DMAPIWatchTaskStatusParams
+
+	// ------------- Optional query parameter "source_name_list" -------------
+	if paramValue := c.Query("source_name_list"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source_name_list", c.Request.URL.Query(), &params.SourceNameList)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter source_name_list: %s", err)})
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+	if paramValue := c.Query("interval"); paramValue != "" {
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", c.Request.URL.Query(), &params.Interval)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter interval: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIWatchTaskStatus(c, taskName, params)
+}
+
 // DMAPIStopTask operation middleware
 func (siw *ServerInterfaceWrapper) DMAPIStopTask(c *gin.Context) {
 	var err error
@@ -1205,6 +1299,8 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/api/v1/tasks", wrapper.DMAPICreateTask)
 
+	router.POST(options.BaseURL+"/api/v1/tasks/batch", wrapper.DMAPIBatchOperateTasks)
+
 	router.POST(options.BaseURL+"/api/v1/tasks/converters", wrapper.DMAPIConvertTask)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/templates", wrapper.DMAPIGetTaskTemplateList)
@@ -1225,6 +1321,8 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.PUT(options.BaseURL+"/api/v1/tasks/:task-name", wrapper.DMAPIUpdateTask)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/shard_ddl_conflicts", wrapper.DMAPIGetTaskShardDDLConflicts)
+
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/migrate_targets", wrapper.DMAPIGetTaskMigrateTargets)
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/sources/:source-name/schemas", wrapper.DMAPIGetSchemaListByTaskAndSource)
@@ -1241,6 +1339,8 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/status", wrapper.DMAPIGetTaskStatus)
 
+	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/status/events", wrapper.DMAPIWatchTaskStatus)
+
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/stop", wrapper.DMAPIStopTask)
 
 	return router
@@ -1248,109 +1348,127 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TXVEKCHADmklDtNs/ay6Zp/TSazrgUFXPqXkg97F1FjXSu0xVFP",
-	"MVwcJenrkLoI5ZRPSq4RkzwL+dUn9DVHmovqeysgv+pjKTmBYiTIr4KQkhgvgxgnDqLph0A+BJiANUwT",
-	"EFOWQgFWQmT8eDqNaMj3MkyWIcz2QppO/72aChwtplzARYKmcpGJnidnUM47kdNN4jxJ9pxk68OcZ5Rw",
-	"9KdE3eYYhY4DUidvMAQFulAc5GUNzWB9FNKTWGrLx/OTfqY3K/ohfiBWdlHOtegJ5nJjPqEErq1lG3ow",
-	"lH8AQQEXNAMQMDkcMDN+3IDSolKp2Pv1+XuYonM52snwJ3maXSg/pA1e5Z9EeZqBnOA2THLZBAkUBYoR",
-	"1W+ad0fHo4jmiwRVe0fydCF9ufEIcYFTKFAgqIBJwOjN0DdjTDBfoShYrAXa+KUNFtKQObDCRBwdjno9",
-	"1Nr74zahWqg0wXRTycVsp2QzXoNM9DKbehosMEnoMlgKHDn5gwlMluDt5dlJYczzjAuGYAr0qzVjh17C",
-	"eRzu709QOHsxmc/Ry8liH4aT2f7hPgzn89lsdnA8nzx/cfhyNB6RPEkkXg2XtTKRNRDdVr8EUeqzyup3",
-	"g6kN/wKTvZn83/5wWCJsvJ0Y5onklb2pfqCXqMMmwYgwQ6GgbA1uVoghBZrel4QuAeZSMUh+GgDBNrTD",
-	"KWOU/R2L1TvEudPXkSyj7A1AcmyLjdSvQSjdnta76hkItUvUlKaxeTXlS9+bqQGqzzZUE41teFyS9BYJ",
-	"49GekZj6HYBQDwpcYmGeASy3rdQauU9tSE0zzOVvhk1NPC2gunHTAYncdj+GERRwcORQj7YdAY5SYMrS",
-	"DlCaUlLk6t1IaP59eCRMKLNlJLTv84DQV87U9sHWDsODAm58kC2DL324B6R56eJvGeR3eMmUC8uWSPAH",
-	"BL428WNg8rCcky+qOR8D+ktpgC8Ey0ORM+THQgMYhCrwCPjXpB7UvPl0+uryFFy+en1+Cr6I+Rfwly84",
-	"+gIwEX+Zz38B7z9cgve/n5+DV79ffgjO3r/5dPru9P3l+OOns3evPv0D/PfpP/Qbv4Dpr5f/759G76Mo",
-	"wCRCf3wGb85/v7g8/XR6An6d/gJO3789e3/61zNC6MlrcHL626vfzy/Bm7+9+nRxevnXXMQv0sUhePPh",
-	"/PzV5Wnxb+lWudISBrV2pBYtnIkS5ew6hqvf5wMi0/L1Yi6Lqs6taiTvHjw9fTCbze6dnj6nMOoPuxIK",
-	"I3fY1REF+f2MFAlo3GVLKCpUreelx9+mB6NLhjh3PtRxynCYGlRrBUT2fNbSdVQcgLtI3sjC3pcvfOny",
-	"QTz0Yv9o3ksNw/V9rPRBeeCoO2EVrlB4FTDEVVjS5LiMoYkaAcwIOxqqHmIOMsg5ivaAW9Tvk0QZ12Hs",
-	"wbSpiXuDXh2nIKB0iDfojZOcr2oRnA626rP+nWGBuIrVNF5yAZXIlhhkFBMBuPwFCnDyDoSQaEnGAsBY",
-	"RgYMlXGpfK1Iv7WOZPjXJAgpEYg4cONfE7CmObiBRFgY1vbOYWnAl3BemZrCGkhzMwZfwn3/owP3o3vY",
-	"l/90Gpg1CdvI/p5FsKA5zQROMRc4BHwFWSTJKDWAtN7gBouVzribraEkWYOco0hG2ARAE6gCGoY54wAT",
-	"75wnJ+cgrQWn5dY0k4/WPrkY13FWs41T0/ubpY85cwX5VUYilPjnGchogsM1qGWc27H/Hxlmxq8r5GnW",
-	"FCY1SGcQBNb5mXI5O34uTIgnD2KZOfknu9aOX7nuwdGstfTlCoFisJSgDDFMIxzCJFkDo/LidkpGoxWN",
-	"gZkcXMMkR8dALSEZiqOQkojfDXqGUohJwDMYohoG82dN+N9hgtM8BTFDCESYXwH1loLh7eu7LH/r44kH",
-	"zWM/Yt6uL09XWzNDIY7XBnieL6zsXEwZaIG9B85iQKgA+k0seUKduUtVJQAlCNzgJAELpBTQHrhQkJqz",
-	"nWOwD9Hzo8ODw0n8/GU8mc/Ri8kiQvtFOlQ6mi80KvP+BGBD0ts0dsm72tY3Sojb9FAWTR9NFULZFnGV",
-	"eQ70w8ojtGzYzzzyTuWRb31c0h+t2Gq7ziWmeqIKPepTNGhYHIRqMdGGpSLqXxpUnY/B/OXzl7+4hL22",
-	"rof5XDx3D2brZi43CJpwRRWEBOjhAQihCFdBngVpWRFVB+JmJT0UJpW4GgvyTDtT5e5Y4ZdPzJ16dTP+",
-	"rPDem/J8oaZ0uYnu0ouCiJora9N9ygmRL/dpzjqzOpnIRte1wz6iF2C7VPGFclfL45i2nGl3Vukedbwz",
-	"rpJk/VmYRmLsAoU5w2LdXkY50aZKhvOk7uFp8xZjlESlZVvhKEJEO9dLJMqgxp6oNgmIGU3VEOV7xdLP",
-	"aaulRviKmAhgktAbFAUhaYP9hqYpJeC90cwXF+dAvoNjHEKdPCiJ1UsczpMghP7Ay5pYq6pipM1tTp6V",
-	"E0tMvFP/Zk0n8fh4+s54C9P/eTZ7WVSFNFDrX/UKrf2LvqnWk7uSMXwtUbtC67IkxVq8Z71mZFSnpYMG",
-	"bQCd0mGCsreM5pkjbRwl7VK33o2OMeMiSGiorYzrFRmNomizaYXOpruG5mTzCVvJEjX7uMK5hUgJtrWg",
-	"k6hllU5D1ejf3b5ezS+JYcJb6ZHSkqgoXGsAGTap12sq3rzetibGrazM5aD1qHSztRMpo7lcKiillbnW",
-	"OS7z7gUhTuA1dVgz/XtZ11fSquH2uSSxCPFd1AamJtJd+OjMAEDObyiLvDOWA+pTHhw+OxriiRYZBvfc",
-	"8qE178HB7MgVzWZFQqGzlFUNqlyVMh7peskOXaSgWhat88yoGCff6aoXtSpFB1eFaq9js6Lb3uNPyK+G",
-	"F3VcQn5VlXSMRzl3+XoGN/mwhR+jVAystgscGWqzZF2Ei391aKEOx8cq2fU7PnrUZJj3Y5Pct17pQbrq",
-	"WfqLUrRDxFXeT7pEN4y6fM+C53kJTC/PV6xyD/5lKEtwCD183CjHbGfNTDWz8baTNdAlzyYN7tCJG9Zx",
-	"FpxlA+LkHRmWd1Z2MpTSaxSkSJ8rD7Yk+j2VV1au7AJy5QlF9IaYeKj42Z26hzEKUhqhQOAUBVGRI21H",
-	"RzhFoHgszYp8s8g7W3p7xp0apyLXIP3QEDats5hQQDpgg/zKlAuqATZA+7PZ0WQ2n8z2wfzZ8ezwePZs",
-	"WIn1haBZ55bdHycJLM3FYKrfQKzjFo0vzeqkf8YHYlarR2g7qXmaDRR0qyp3g0K4wTonoTAaCIl1UG0d",
-	"ejrYpDij7+DQPiXlD/L7TN6FGmj89YGYXaxJWGGmTtndmMlHQMFmc4U6pxq7XHyGOE2uURQoD52GV4Hn",
-	"KL1TzRYXRpykcZ8U+3VnQUqDp1OVVuToyPFJrN0VCSb/oed1ILuQlMBkKaniWsI+dbtZ4XBVJsQwB8XL",
-	"G8XxrazjwPygw0SHiIhAZEMLLcwBULBAK0wiK+U25N0yQHQYFfmsE6PaCD9Guq4CXRd3PAfAZcrZB9PA",
-	"koOlDNq79lwPaGw7ZAjkZFLMYm99p1jXMgW90bRNCBvJ2q6PhyUF69vj3IymHLjoZIXvtlD52MolzKo8",
-	"4r65RF+JVlvSLk3lR1t5+tREjBNJP5brhAKMIizfgsnH2ug+vf8ak3O6/E1N9knO5TLLiKwgCVGgb90G",
-	"RXHeCpIl6q31sFxCHcMAnmcy0lFHgqp0QF/mjaIEZEm+xGTIZVu8JJShQB0yS2Yoyd+40KuGgYwhcxyt",
-	"hjl36xoxrpM//YoRCWjIUD9litKJcpSbRHA4vQp9Ligrqi+8BzbVpN4aKr87YXMjv3KHd5QEUa7CGeGY",
-	"bUVv5OatIIl0bjVOcChQpDBRkWme6gPTLNGp6OIigya+JV+WmpVKRrn37uOOG7hWBymUSl0EBZJmzVos",
-	"Q5ybepPReFQVn7gX02Z9WFpEeUPqBSs3cpe0RG9trQrvU11AXApycyelwJgxQI0ZDy9OVkrMVCg3hLuR",
-	"a92ANrrU+QQK+FqGbkWCxb2VBeRFNGZ2L86TRCJCQoZSRHTtMExUPWrFsDBJhjpuFQg92qrB7E38nbvS",
-	"ZCC3vXDoUtfphEBK4OXEHEBRnNgm6BolLV1vlJyyro7QRf5c+NUe/VcbUyMtiNJkiK4zMJga7HYFXQaF",
-	"QEzVrmib5AfGN7yC639PmIod+zP6zh34LU8Sw+9SeH0Xha1cgeTEUr4kF3HHBU3CMReIhI7TPqWjiGA0",
-	"AYXawsT4YeoAT5c7USYVZqwua5WzAch5ziSv1vcmF9RFAjmdp+5GUCaj1wizttrfmxbrB0Zht2bWAwKx",
-	"YghG9Wqzw6YlUwTTL0j6hZQYd9Ppw+LUO/P8yDm1fqN3ah8HnJGQbcYBlhLyMIA0bMECirBe2Dpv18PZ",
-	"c0kXdMUowf8ul1JzAPQHCnP1k5SHrzkkAqul3MVsWTKQfE1E7kzD+p0Wt3dRiYy6UdOimdGYlY/Ue8Ju",
-	"3hDFEZnluPguRijNvcES5o2hS7gTq2a9BsBNcBqL+UyGP8IofbjO+IJfDQ4vKp+mnVhrRLvVCrODOJzt",
-	"Hx1M9l+EzyfzOXo+gUfPDiZH4Wzx4jB69jI+mB3PJ89nh/PD/YPx7Nnh88PoILSGvzh4tj/Znx1Ei/3D",
-	"oyg6iI7nk/nzmbMlSL1+zGrxoR5UhXy+NzNaJ9ChMz2wnZx/Rxbet/k1L9MDyoShBErb0V0oLFVn6bSE",
-	"Zo/7PLmmtbzVHtnG8zR1bt3j9hK5idFgt9bi5L7shA2HdxuKHGnhnV4ImmUqe1BVPP1mLtY44wunr+0v",
-	"0tNOvaD2UYjt4vOBMX/DeqqHaoKCfx0qQz4edsbHO2sbBvKlHSN78idjcIOTKIQsKhID9eB3Mfn1nlnx",
-	"1hmnL1suqvKMdhA2AFbhhLXzfM4yFz47ITx2uOKeh9yMiCKuS7JNlqbAmDe2ZX5HCg5cwGeRG+QZ3sTG",
-	"Ebt2kLRK03TT9ElVpGynAuUuhSFbqppw1kmUNPHuOkozKR/e81J6jdgNwwJtdMBdvqW9bWFWKf/ov/VU",
-	"rdsPuu9eYgxxolri8Kt2fqqj8sJ5+bBUp/3drgoFVk3q1F1No5KHIeLcA+5mdXztucZtariA0lfhHrQB",
-	"13A1pBd/5F5ajU41XUelHeGGvwSlvdHVit47T+ZyEweF9RLUlMXwrsZdfQe9dyiZ6SuSabR1fPiLz97G",
-	"hFu9+XyrUj9CKuPkhIaOhN3JO/AhQ+TVxzNw8uGNVLksGR2P+nrqTaTxnGiXFlNiWuzp+CKmisWxUIi3",
-	"FigOYY5HR5KA6nQiQwRmeHQ8OlA/SY0vVgraKczw9Ho+Nf0bpsX0xl8qWyudRWqtVx/P6u2J1CGl1qxq",
-	"vv3ZzGT8ikJvmOlUsUTjX1wXwlR+VGcPVHcjJEX1hlnUikxtIs/TFLL16FjiAMpGSCSmgOfhCkAOat2R",
-	"BFxyq3PR6LMqGfVhr5VPkwBKDF/TaP1guLf7LLWQNsuChVz39gnvQ65oVtuKPSfhb8ctftQHzHwoS1Zd",
-	"pR6HMR1drLrIMh4dPiAYrc5ojqW1Oe8QDKvhbWG4NtmY6Tf9h4oIb7X+S5D2Ax079SGOE0yQJtt7fdqU",
-	"QQZTpHf5n63jLwu8IiZX7SKgWI0KQzCyYBjZalwffbvym/6+0p9bjHPo8MOf2I5STddG++JBG1k4DAMl",
-	"rGp59jgS5mixtmMSZrVd3kjCzMZMvxkvbCMJM97jAAmzwfNLmAXDjy1h9SbanRsZpXsFcE7JeovECQ3/",
-	"6+LDe48o1cGSc5X3/NrsFtEQqOUqqCIaNiAyPmoHOH+7fHc+CBw5sAecldAH5D5wdJDXr3qqRoV9zCzl",
-	"q7jvpW4Ol1coFE9/zRFbW0yNxSooRziY2F06dTt2fExhDRgSOdOtXHSZ1sR0cSiuIrhAqDUv2ASGz9vV",
-	"vo7ekA5JsS/YJkUH1wYfNIdU/FDE+CpG4779t5t9b8vZdvQT39zhnj8YPGVO5MnbOd0ID0ASFaWJEBB0",
-	"Y++6a8PbOmD6zTpZ6LdyJ+phyRSdOmGZ0IVqp5MT/DWv3wr3G7z6Qccgg+e9lddWGDHV97toVkACE25a",
-	"1xR9CVRCx5RTuFSHmuOeOmMHDK/mAwD7eGo8xIbsIq88jk3bpj3p0Gdlx+BDJy8aylMBYvWRkrZ96WKI",
-	"vjTOzvDE5+3YPVca/7aeCJXg3n4f1nhieshkseB9bds00p/lUElwv9tjPt6xWyzaFzM8OduiifwAm1o1",
-	"pujYU/2NjJ9bus0tLd3Q++6oCsk2E9ZPRX+6H9OcuL43dGvsya5qhqpBWJwT3WKyuHT1MAy2geL4wdnL",
-	"8YWhXeUuo6S2zlxl65sO3qp6q/64rNXuLzvcDX7anKY4oNYWc3Nesr6+OyDE1k0EhyRrt8A6/hY82w1w",
-	"640Td+SAqugypItXfcnZoewx/ab/qDJ4A5hF1Xw/PV4ZdxT4epavcB+4vLP+d6tcWr+Rv1tMquuf786j",
-	"ZVeRIRqsbLv1dKxh58WZRzkLanw2aUfYx/62s/2t8/t7WIJBwmPzwWm/e3Vphv3oucZ2OeufxcUqGKFU",
-	"VRRA/T0EXSvQw136iKdPMxVfjetlIMnzkF895um3uTe1WBfNy3SbJ9eaxbOhBqtsq9W1qkM+mss227mN",
-	"N0pPWzZzy6q29XFABxMqIiemzdzTUbQlVBW762r6Icf7l7pPz/YO9+3rAt/zaN/1pawdOucvvxNV3+Gm",
-	"OpuGxTfseY95NB+73+b+1z+n72UBHGsexhxgkuVC91Y2ulT3mS+w0l1Gqy/V6x7llIFrHCJwjRiHW2Wi",
-	"Bkq7w0aXqkBKUZmYRq2mnTyNAWz26G8RdW8A5xV3x4aZ1OJ22CPUs+64ai8v591Lx19WN/u2IevmTtf3",
-	"U+8+AJ6oPq/t7CbCNdVNZnqU+5ka9Ej73ryjujkb7G8Jnt3Rz6Z10N3Z4ptqkbdJDV+DOzaKju0ufY6w",
-	"uIRlYFDsa++303Vz/pvVTQU+2FjuzjbNfjjF3rbXXVvuLZCr7lj/3PSdKU0buu8t/X03rf1UOaKr2FrB",
-	"gK4RAThWXeoBzxdF2MfKXkU/y619kf4AM7EzfPEIudLvoZ0aQeShrzNeR1G1f/f7SqqfMgNstYr6fgnG",
-	"2Y+eYCyrqwcmGC2T5TmfK3rwFf01h6SDan07+c4oskcvjnCesegu0aY78chX9PDr8Bl1I+nuCdWYXx//",
-	"TLzNLTt3Mq7O6uzqCkgi043W/MBoLsxdNFy7WHx3qRxcS1ZWkb1eS1q/ItHdTtB/EKH8Wd3Wxd/uErd7",
-	"c/GGJW9lsdtPlv5ZhLezsuSsxHtgUZLvLRK0YUpikaALwfJQ5OynTD01mRr7O9r6SF5wwGCau78Vtfvp",
-	"+5rkcYvFN03O/JSQnxIy/z7BUp35dj9Y6hRDf5asTM/8FMWNF/9RBPHhU5RWUrAph3+uWmwtcRuazW6v",
-	"VcDeOpfyE+A/WOa79enzXb2Pq78vfrfk87CbRdaHDHdQ2ZctzXe9tn5HLzGZaxWaezbjTpr1Ki/9Lfwf",
-	"TndptHdfddHMr7nUx0fYdbGj9ebza5rvRTSFmKjW8yNJajOBWxeM+rrdRzQc3OLe9LSffs1xeDVRGnii",
-	"y1InVVewmo4ZuTwzhfZ2obrBYjWJUgsetWwbmqILbDmu+OH28+3/BQAA//8+kDDk07YAAA==",
+
+	"H4sIAAAAAAAC/+w9a3PbOJJ/Bcfbqp2ZoyzJdl6+2rpKYs9s7pxH2Z6a29rJaSASkrAhAQYA7dFm/d+v",
+	"8CAJkgBJ2ZZjTbwfdhwRj0a/0OhuNL4EEU0zShARPDj6EvBohVKo/nyZICbeQgKXiF3QjCZ0uZa/Z4xm",
+	"iAmMVKsV5UL+F/0O0yxBwVEw3X+2N9mb7E2DMBDrTP7EBcNkGVyHQUZZvfmLyYuDsh0mAi0RC66vw4Ch",
+	"zzlmKA6O/q4nMZ0/lq3p/B8oEnLUV1BEq/cZYlCgC8g/naHPOeKiDS3N5P/HiEcMZwJTIodR/TAlAGZZ",
+	"glEMBAVihYCA/BMPwgCRPJVQcAGZBIMLmkloYM5RIAHleSr/yLMYChR8dCyb05xFaEZgimYJ1pD9iaFF",
+	"cBT8+7iiwNigf3yu2r+DKTqVreUIAi5RXze59nPV8DoMJPjlZPUlq5XJZWqQQ0BJsgY5RzGYr9Xa9QdQ",
+	"4iYIAyxQyodAEFyXGICMwXUJzODly1GqxTeYgWYDeYBnlHDUZoIYCij/O2hBjmHzRDiXSAVM5HA9zKzb",
+	"hRqMgSuRU7bWgRijbJbyZZvA6hNIEedwicDVChFF1YrV6aJkcbCAOEGxS1p5HkWIc2tRc0oTBEmNpHXx",
+	"lz+PHLLfxELZu5rGhYzXSc4FYm+h/P82DmAcs/by5a+I82KVUc4YIgKkahBAaCxndWiso+f7T51qCyb4",
+	"ErXnoSTBBAEuoMjNbJibaewZBMtR6EBhgmCMHPBjbo+k1mCaDhi0TRI9TD9NDDn0YkvoQo3kDuL4dweY",
+	"ICZmqd5EZsJq1yVzzp3nOgyWDC4ggYPH+Um3t4fQqChHKLXRIF2gmdAerqkDMkZTJFYo54OB/FB2sQe+",
+	"ouzTjeH8RXX2w3ntJ6Xu+tXkbE5zEs+s3bI9p/4I5EegmuvtGnOgcdaeNl3zz8lo0jVhsbnWp9LDq4+l",
+	"cPsmUW1dM7TFUQ8xXBwl6uuQuhDllE9KLhGTPNtlFElVPHhfl2o7omSBl7MFThxI0x+B/AgwAWuYJmBB",
+	"WQoFWAmR8aPxOKYR38swWUYw24toOv7naixwPB9zAecJGqstRI+T6/1qJIcbLfIk2XOirW/lPlPgD7D0",
+	"5qYaOCB18gZDUCBtaHpZQzPYMGvVUls+nh9gF5gZ/RDfESu7MOea9BhzSZgzlMC1NW1DD0byD6mIuKAZ",
+	"gIDJ5oCZ9mEDSgtLg8xhrZRrBnEbzjzNzpUd0gavsk/iPM1ATnAbJjltggSKZ4oR1W+ad4OjIKb5PEEV",
+	"7UiezhGT0yIucAoFmimrdsbo1dCeC0wwX6F4Nl8LtHGnDSbSkDlWhYl4ehiEg+z1on/YRlRrKU0w3Vhy",
+	"MdsJ2YzXIBO9zKa+zuaYJHQ5WwocO/mDCUyW4KeLN8fFZp5nXDAEU6C71jY79AJOF9H+/ghFk+ej6RS9",
+	"GM33YTSa7B/uw2g6nUwmB0fT0bPnhy+CMCB5ksB5y2SttsgaiJ5dvwBR6jPZZAiYeuOfY7I3kf/bHw5L",
+	"jI21s4Dq6BXsjfUHPUUdNglGjBmKBGVredhiSIGm6ZLQJcBcKgaG4iEQbEM7nDBG2S9YrN7qI2GXF0Sd",
+	"HVtspH6dRTRGvtNmpE2ipjSFg8+pvXtDNVBow+OSpJ+QMBbtG7KgfgMg0o1mLrEw3wCWZCu1Ru5TG2Ew",
+	"1ORvHpua67SA6l6bPpBIst+Rt6M27ladHNUiNP/e/SL0uNtehLZ97hD6ypjaPtjaYLhTwPWQ2wZf2nB3",
+	"iHOv1/KOQX6Ll9qpx5ZI8DsEvjbwfazkfAVZfHx8+pqSRYKjO1tMc9x7WcudSkE+r8a8D+gvpDFxLlge",
+	"iZwh/yo0gLNIHaJm/HNSP6C9Pjt5eXECLl6+Oj0Bv4npb+C733D8G8BEfDedfg/evb8A734+PQUvf754",
+	"P3vz7vXZyduTdxfhh7M3b1+e/Q38z8nfdI/vwfiHi3/7u9nDUDzDJEa/fwSvT38+vzg5OzkGP4y/Byfv",
+	"fnrz7uQvbwihx6/A8cmPL38+vQCv//ry7Pzk4i+5WDxP54fg9fvT05cXJ8W/pYnodFPrpbVPnfHc6fRR",
+	"hrvTdT1P0BDXddm9GMvCqpNUDUfknUfRDiaTya2jaKcUxmcqmPWB0SUzjv+6TZSZL9K/keDlShBplEcr",
+	"FH3KKCYCXK1wtFLmL07lTNJOTyiM1alTGsI6XBaDBaNpy8osD07RKiefHPMXDYBuUJwCpJAARJaYIG4b",
+	"a35bTUOH4lnRqzVV0eIGg+tjnm8NMEluDb6ewQu7nGLTQRvc0kJQc9KwRa3Gwn081u+mKBnGzyBtr4Ef",
+	"WSkS0BwvLcVbiZP1vTwht2XOkonWR83UM7tN1ybhkLSSpsMX1iBYywthj2fBX8eHY/UuujVCH7dVYL4Y",
+	"1SBlJ4MHvdgw6rlP5w0KGCv1NmNlGLapEtFItQCmhe2CqD5iDjLIOYr3gHtPuo3nMqzD2LPSpsnQ62nS",
+	"zgEE1Gbn9TQtkpyvam4T7eGoj/oLwwJxpfb0uop0C2sT4fIXKMDxWxBBotUBFgAuBGISy4UzqAhhB644",
+	"KP+cSCe4QMSxNv45AWuagytIhLXCIOw2icBv0bSyiQqzRdpFIfgt2vd/OnB/uoUh9J9OS2hNovZif1bJ",
+	"HNykAAicYi5wBLi0tSUapQZQu8UVFisd5jKkqfJCVA4BNN4hQKMoZ8oI8I15fHwK0ppHqCRN0+Nv0cnF",
+	"uI4A6TaykG5vP33ImcuzVrkBI7n+PAMZTXC0BrUwT0ua0O8ZZojX5GnSFCbVCGoxxdopWk7n2vM9zkfb",
+	"LiICsUuY1OY9eDppTX0hTTzTWEpQhhimMY5gkqyBUXmLth9ULysOgRkcXMIkR0dATSEZiqOIkpjfDHqG",
+	"UojJjGcwQrUVTJ804X+LCU7zFCwYku5b/gmoXgqGn17dZPprH0/cafDoHp3lfc7x2pwZivBibYDn+dxy",
+	"iS8oAy2w98CbBSBUAN0TS56QMCZQIC4AJQhc4SQBc6QU0B44V5CagOoR2Ifo2dPDg8PR4tmLhYxBPB/N",
+	"Y7RfxCDkiei5Xsq03+vekPQ2jl3yrsj6WglxGx9qR1PfSqFsi7gK98z0R2eO1WPwZqeCN9c+Luk/8thq",
+	"u84lJmWpOr/Uh2jgsMg+0GKiN5YKqd81sDoNwfTFsxffu4S9Nq+H+Vw8dwtm62YuNwgacUXqkQTo7gGI",
+	"ZEbmLM9maZmGWAfiaoXECjGpxFVbkGfamCqpYx2/fGLu1Kub8We17r0xz+dqSMeqPPlOBRI1V9aGO8uJ",
+	"9PT0+sbqzOpkInu5Lgr7kF6A7VLF58pcLWOgbTlT33XSmIqpWunM/e7Chgf3HEU5w2LdnkYZ0SZBjfOk",
+	"buHp7W2BURKXO9sKxzEi2rheIlEeauyBaoMot5lqomyvBYyQQy01jq+IiRlMEnolXTWkDfZrmqaUgHdG",
+	"M5+fnwLZBy9wBLXzoERWL3I4T2YR9B+8rIG1qipa2tzm5Fk5sFyJd+gfreHkOj6cvDXWwvh/n0xemL+b",
+	"S+uf9RNa+yd9Xc0nqZIxfCmX9gmtyzwwa/Ke+ZonozouHThoA+iUjmZQpW2DkkpT6UP+1YpypI9z8izH",
+	"QWQ6F4dEBKhWebK52sIgaZ0EZVeQ0OhTa1eL48RzOSFDpDhB8pahoGbbiCHl7M7wfh1AoPaN9iboy5co",
+	"0eFNXpC05DS5RLMVNrdrGtoijYTUEWkKScyN51z6O0w/UNiQimYbCWE9IOIxGCylGHTfWenOwvXRqCfu",
+	"4oFKs58bqIZ8FKStw1pfflgP15RsV5G2SSevBGGy/InRPHNECC1mHk6lBWZczBIaaTvt6Ivbn4PizYYV",
+	"OgjsapqTzQdsuRvV6DVMNhZSgm1N6ERqmVzq4i3Paalm2S9gwlHos8WUH0vzKeZAd68ZSaZ72x4zB7PK",
+	"4Bw0H5UHVX0MExRkudzilV3D9a7tMpC9ICwSeEkd9qD+vUxHL3HVODi5xK9wkjkl2aTyu/P1XaNlkPMr",
+	"ymLviGWD+pAHh0+eDjnLFT4699jyozXuwcHkqcsflBUuuc4bGKpRZeyXJ/quTvbhXwqqZRN2pgcU7TZQ",
+	"sIMvM2i7fbO7Ir1ZO7e4uBcGOUfMuzb5sbU+RqkYmCQ+c8R4zJR1ES7+1aGFOo4O1k7nPzroVqNh5wcb",
+	"5b75yjPYbe78ceU5l8bUFaOu01vB87wEppfnK1a5Bf8ylCU4gh4+btwiaPuddYPi0J+s7YtAyKUTN7x+",
+	"UHCWDYiTdwRkovNCAkMpvUSzFAm40U6i+6nIjDoMziFXZ4mYXhFjKBU/u4NfcIFmMv4yEzhFs7iIMrTQ",
+	"KT+D4rPcVmTPInJj6e0JD7Z1r5kJBaT7mrLJclcNbID2J5Ono8l0NNkH0ydHk8OjyZNhN4POBc06SXb7",
+	"NUlgaS4GY/0KYn3y1+ulWW2l0yd84MpqqWdtIzVPs4GCbl0muQ7vXufIeO5ASKx8ESttwMEmtz3FdLrJ",
+	"Bt+5l9buwJWdr0lUrUwlu7hXJj8BBZvNFXImF8w5MaeZeKYs9Or0u5maLe45DjmHmUZ+3Vmg0qzzYx/z",
+	"nlw6fS06SwCSJYqtO9dQRptMFsCWN8ub8J8OZ87maIVJ7HUgS3KCBC6Lw7T6t8mAGpDhdCdc3gpNLMuz",
+	"vcFwCCgDvwZnanOKfw0Arn0GMUWc/FkA9DvmAkCyTilzzunW92WdAk1keWpbyI1dxWTPfnx9cHBgOdIc",
+	"rD9Ihrp52Ww0Tpbu8D9XwtwR47GJWudU4//W4zpEdS7lGJOllGnucWUVWRfGj2R8+ZiDovNGLqRW1Glg",
+	"fEi0DcwIETET2dBsPa/EDOlbujccJpH81rmiWgv/ikwa5GVRM2dwuuZwHFhafCldTl001w0aZIcMgZyM",
+	"ilGGlm+p+7l6fUE2IuxF1qgeDgsK1cnjJEZTDlx4spxPtlD52MolzCo97raxJF8ueVvSLkzmX1tt+dTE",
+	"AicSfyzX7jAYx1j2gsmHWus+q+UVJqd0+aMa7EyO5TIqEVlBEqGZLnUyK24RKAXdm+tnHWj0CRzwPFNZ",
+	"4TIlRMUQ1LAgjhOQJfkSkyEVTvCSUIZmKslIMkOJ/vrsuhnIGDLpSKqZk1qXiHHtuuxXjEhAg4ba+oM4",
+	"HclvrQwDx5FNLZ8LyorsO2/Avhr0BsZIf1GeMKBkFufqMC4co63olSTeCpI4qWIRJifeKo3FUJboUGRx",
+	"e1Qj310MawVjeqWvTysmdtCOoWWeQCbTxRjikjZlLEh3t+IE5ZcWM4XFz2utJWVDBKOVyi7CJiGS4SUm",
+	"9bCDg0P+b/bd3n98P/vuv44IuvrXckW//9MQblFaWh3E3ebsFVxLBEeUSr0LBZJbuIXYTC5eR9SCMKjC",
+	"a11VxoY5MJW1rTpYXsybOBB7LzwpOqf6hlqptJpcK7Fv2gDVZoOCY0phmytwDUXWiIpsgBt9l+4YCvgK",
+	"8qqgmpuUBeSF38RQT1btkAshEUMpIvpCF0zUJaGKtWDidNcLBvnqxlKien89IVmuon/FKPkXTeIhguI+",
+	"UFYI79mHGmqsSW0nDzbFxW0JOHZJV96BQEqVKzIBKIpcrARdoqS1i5vtC7kPuern4qzi2dlqbWqMBOI0",
+	"GaKXDAzmGmA7Nz6DQiCmslK1teEHxtfcYotjpnxa/UzgpMCPeZIY6Zaqyld3x/JhSrkrtUlRxrFZ74Rw",
+	"zAUikSOPR2lkIhhNQKGkMTEWtkrN0YnM+nrYQtU+KEcDkPOcSV6t0yYX1IUCOZwno1ZQJo/gMWbtDX1v",
+	"XMw/M1txa2TdYCZWDMG4nkd+2LRRFMJ0B4m/iBJzkOi4O+ccefrUOTROBw3t44A3JGKbcYClcj0MwFCW",
+	"zOYyx6y+gHamuz2WPFysGCX4n+VUagyAfkdRrn6S8vA5h0RgNZU7TT1LBqKvuZAb47B+RdxtN1YiIxu1",
+	"cWY0ZmX99ubOmR6iCN1XHYTvbq7S3BtMYXoMncId8DHzNQBugtOYzLdl+M+OpXXeeXLknwYfHCsLru3w",
+	"b/gxqhkmB4tosv/0YLT/PHomc2KfjeDTJwejp9Fk/vwwfvJicTCRObGTw+nh/kE4eXL47DA+iKzmzw+e",
+	"7I/2JwfxfP/waRwfxEfT0fTZxAV1IzO8gkJ/qFL0fT0zWkfQ4S0doJvEIjuigz7i12xqDygjhhIo947u",
+	"K0BSdZZGS2Ro3Ge3NnfLa21/bjxOU+fWzxdeJDdXNNiItzi5zzy04fCSofBqF7a4jPtlyi9U5TL/aK7M",
+	"Ok9TzpNFX96aoHaI1j7Q8IHenOZ990Tdecw77W75eVjuAe/MuRrIl7b3w+MZC2WKcxzJDEvj8qm7Neaj",
+	"H24ZrWvlXvjiG6JKG2sfOQfAKpywduYNWNuFb58Qnn244p67JEZMEdeXrfQCyxXzBlmmN8TgwAl8O3ID",
+	"PcNrQjpO6h0orRxw3Th9UJly28mMu0nC2payuZz5WyVOvFRHaSblw1/r/xKxK4YF2ijxpuylrW1hZin/",
+	"6L/PXM3bD7qv4oAuyT6rlfAv9X5HkNtZVqBUp8MrsleDOnVXc1PR9ds94G7m+mmPFbax4QJKX3K/03q2",
+	"w9WQnvyeS9M2Cj92pXB0HDf8qXFtQlczem8zm2vLHBS7l6AmXY931cHtS0C5QSpfX/Jeo0r63Zc08db5",
+	"3mpNk2vl+hFSGSfHNHI47I7fgvcZIi8/vAHH719LlcuS4CjoK1E9kpvnSJu0mBJTsVqfLxZUsTgWCXJN",
+	"UITXjoKnEoGyD80QgRkOjoID9ZPU+GKloB3DDI8vp2NTQmxcDG/spbJS6ZtYzfXyw5t6tU99o0NpVjXe",
+	"/mRiPH7FFS710Iu+rTD+B9cJepUd1fmkgLuuqMJ6Y1vUikwRkedpCtk6OJJrAGVdUbKggOfRCkAOasVG",
+	"BVxyqxBo8FGlsvtWr5VPEwFKDF/ReH1na2+XLW0t2kwL5nLe6wdMB/O+jU2KPSfir8MWP+rUAT6UJasi",
+	"rffDmI6isF1oCYPDOwSjVWjYMbXezjsEw3o/oti4NiHM+Iv+Q50Ir7X+S5BAHkq9XywSTJBG2zsdbcog",
+	"gynSVP57K9hngVecyeXvUoEVqWNHgQVDYKtxndTg8m/6n2n52GKcQ4cd/sAoSjVeG6+BDCJkYTAMlLCq",
+	"gvD9SJijYvGOSZj1islGEmYIM/5irLCNJMxYjwMkzAbPL2EWDN+2hNXfpOkkZJzuFcA5JesnJI5p9N/n",
+	"7995RKkOlhyrvMHfZreYRkBNV0EV06gBkbFRO8D568Xb00HgyIY94KxEmnSBow95/aqnqvvdx8xSvop7",
+	"qOrufHm1S/H05xyxtcXUWKxmZQsHE7uT4q5Dx9tka8CQyJnOqdYJeCNTn6m4IuUCoVaWaBMYPm5X+zpK",
+	"rTskxS6dkWDu5INmk4ofijO+OqNxH/3tt3O2ZWw7nufZ3OCe3hk8pU/kwe9zuhYzgCQukk4hIOjKprqL",
+	"4G0dMP5iRRb6d7lj9bFkik6dsEzoXBXKywn+nNfrvfg3vHqgY9CG570t3FYYC6rvndKsgAQm3BSlKyoO",
+	"KYeOSadwqQ41xi11xg5svJoPAOzjqXDIHrKLvHI/e9o295MOfWa+SF479EchqdCXkxz7SxdD9LlxdoYn",
+	"Pm5n33O58a+vr5vgXn8d1nhgesh4seBt97ZxrF+5k4B2mD3mLbzdYtG+M8OD21s0ku+AqIgMoOkJeSTp",
+	"tklamqG3pag6km0mrGdF5dlvcztxPd95bfaTXdUMVenPRU508ejiOt3dMNgGiuMbZ68T8ofhLqOkts5c",
+	"ZUmuDt6qqqZ/u6zVrhw/3Ax+2JymOKBW8HpzXjJADHTT6vLAQ5y1W2Adf2mw7R5w6yWRdyRAZfCvx/I6",
+	"Z4eyx/iL/qPy4A1gFpXz/fB4JexI8PVMX6194PTx/L65tF5rYbeYVOc/35xHy3oxQzRYWQ7w4eyGnRdn",
+	"7iUW1Hi5c0fYR1U4qpXar70DeAsLSzBI+AKxHvPqwjT71n2N7XTWP4qJVTBCqaoogPqlI50r0MNdOsTT",
+	"p5mKR5h7GQgJnUx/j9Fvc29qvgZFEcmlL9xdfBu6YZXl/rpmdchHc9pmmclwI/e0tWduWdW23tp2MKFC",
+	"cmLKXz4cRVtCVbG7zqYfEt6X695qcN++LvA1Q/uuNzB3KM5fvgBZp3BTnY3Lu/6Z83ZX8ZYkV7XTOEqQ",
+	"KnRkNEglp6oGopRwVR9RZhk0JRlArriuKOnKVZnE2iCqgESaifWeeTpBxXFKVjQ/cFABpaqvmPsvMlPZ",
+	"wbav5AItYvItcW9znpvz8GSLMPkZWT+SWpa5Eebx/wfB1KracaiSLkKQwZyj0LyYLdnO8EkKybrgVQEo",
+	"qVmLPvaPKLlErEhc79J+uuE21V8BSg/3GLGRwoJJlgv95IExJfQDSsWqdPFvKSDm0T31+A5l4BJHCMj7",
+	"J3CrOrSxpN3RohcqP1BhmZj66eadJFVat/H4VAupewM4r7g6OcyiLC5H3kM6945bNgVeb2fiXFQXW7ch",
+	"6+ZK49ezbnwAPFBzpkbZTYRrrGss9Sj3N6rRPdG9eUV7czbY3xI8u6OfNVVvwRZf5A8bpbA2uGMj55Bd",
+	"ftThFSphGegT8tUt3em0UX9hgaYCH7xZ7g6ZJt+cYm/v110k9+aHViUGHom+M5mZQ+ne0t8309oPlSO6",
+	"7hooGGRBU+kl4TStXpPAHLCyVNfjbQOfo2vANrEzfHEPoYKvoZ0ah8hDX2HIjjsFfur33Sh4yAyw1UsE",
+	"D8U3uaP+9fJywUD/urVljXXZe/kAVfFmwDDnT/Olar4zmsv1TOSuR/1a1NjJTAsDvI7GFG+Vyy/ex8rL",
+	"FoqbZIjHvINhPcydbioRzoSNoihrUXB5iIzUCjnvpIDcT7acU/zUKDNTrj7wZcH9MHxE/bJA94CqzQ/3",
+	"nyTV5padE2DJrbV0OymNWlrMD4zmwlxOxrVKEzeXysHJxWVa8au1xPVLEt8speobEcrHdOcu/nbnPN+a",
+	"izfMgS6znx9Z+jEre2dlyZmafceiJPvJijqbOenkZVvB8kjk7FGmHppMhf4S5z6UFxwwGOfuZyF3P6BV",
+	"kzxusfim7spHCXmUkOnXOSzVmW/3D0udYuj3G5cOy0dR3Hjyb0UQ795pb7nJm3L4x7qcoyVuw22z22oV",
+	"sDfz61y2+QZjQeW6d71AgyLyDcMxw66aWu/4PoZdvmLYZTdvtZp7dpp7NufOsXrUtodJf5HXPB7ZtJ9N",
+	"Q9f1JkwEYpcwkZeJOIooiTkQFCggqniXJkcIzJNJAHMw9cBbDFiDM8UEp3kaHLkeNOkXH4F+F5oXRvrR",
+	"rg1Uvf0U+cmlLgLd4mAI9LjqApBsxBVdYvCref/81yA0D2AvzJu/EWQMIw4gaE8hkanqZT8UYbySMqJD",
+	"n+p17OrhfJ1Xxf+s766F6jFbkMClrpUr5+MAcsARu0RsxOXijFBuKtA067VGaPZNGiM0+2PYIjTzmyKy",
+	"qeIhTdH680Jrmu/FNIWYqMeFguuP5QBurRn0vWcU02jwI0bm1aLx5xxHn0bKpBrpmxejqu5rTRsHrqMW",
+	"/7R1qGR+2yhOLXjMRcXmREWd/7Jd8cP1x+v/HwDhYjNC388AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
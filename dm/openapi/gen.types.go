@@ -8,6 +8,19 @@ import (
 	"fmt"
 )
 
+// Defines values for BatchOperateTaskRequestOp.
+const (
+	BatchOperateTaskRequestOpPause BatchOperateTaskRequestOp = "pause"
+
+	BatchOperateTaskRequestOpResume BatchOperateTaskRequestOp = "resume"
+
+	BatchOperateTaskRequestOpStart BatchOperateTaskRequestOp = "start"
+
+	BatchOperateTaskRequestOpStop BatchOperateTaskRequestOp = "stop"
+
+	BatchOperateTaskRequestOpUpdate BatchOperateTaskRequestOp = "update"
+)
+
 // Defines values for TaskOnDuplicate.
 const (
 	TaskOnDuplicateError TaskOnDuplicate = "error"
@@ -48,6 +61,39 @@ type AlertManagerTopology struct {
 	Port int    `json:"port"`
 }
 
+// BatchOperateTaskRequest defines model for BatchOperateTaskRequest.
+type BatchOperateTaskRequest struct {
+	// operation applied to the tasks
+	Op BatchOperateTaskRequestOp `json:"op"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+	Stage          *TaskStage      `json:"stage,omitempty"`
+
+	// tasks to update, only used by the update operation
+	TaskList *[]Task `json:"task_list,omitempty"`
+
+	// task name list
+	TaskNameList *TaskNameList `json:"task_name_list,omitempty"`
+}
+
+// operation applied to the tasks
+type BatchOperateTaskRequestOp string
+
+// BatchOperateTaskResponse defines model for BatchOperateTaskResponse.
+type BatchOperateTaskResponse struct {
+	Data  []BatchOperateTaskResult `json:"data"`
+	Total int                      `json:"total"`
+}
+
+// BatchOperateTaskResult defines model for BatchOperateTaskResult.
+type BatchOperateTaskResult struct {
+	// error message when the operation of the task failed
+	ErrorMsg *string `json:"error_msg,omitempty"`
+	Success  bool    `json:"success"`
+	TaskName string  `json:"task_name"`
+}
+
 // ClusterMaster defines model for ClusterMaster.
 type ClusterMaster struct {
 	// address of the current master node
@@ -196,6 +242,12 @@ type GetTaskMigrateTargetsResponse struct {
 	Total int                 `json:"total"`
 }
 
+// GetTaskShardDDLConflictsResponse defines model for GetTaskShardDDLConflictsResponse.
+type GetTaskShardDDLConflictsResponse struct {
+	Data  []ShardDDLConflict `json:"data"`
+	Total int                `json:"total"`
+}
+
 // GetTaskStatusResponse defines model for GetTaskStatusResponse.
 type GetTaskStatusResponse struct {
 	Data  []SubTaskStatus `json:"data"`
@@ -215,13 +267,31 @@ type GrafanaTopology struct {
 	Port int    `json:"port"`
 }
 
+// progress in lightning checkpoint which the import of load unit is resumed from
+type LoadResumeProgress struct {
+	// finished chunks of the data engines
+	FinishedChunks int64 `json:"finished_chunks"`
+
+	// imported data engines
+	ImportedEngines int64 `json:"imported_engines"`
+
+	// all chunks of the data engines
+	TotalChunks int64 `json:"total_chunks"`
+
+	// all data engines
+	TotalEngines int64 `json:"total_engines"`
+}
+
 // status of load unit
 type LoadStatus struct {
 	FinishedBytes  int64  `json:"finished_bytes"`
 	MetaBinlog     string `json:"meta_binlog"`
 	MetaBinlogGtid string `json:"meta_binlog_gtid"`
 	Progress       string `json:"progress"`
-	TotalBytes     int64  `json:"total_bytes"`
+
+	// progress in lightning checkpoint which the import of load unit is resumed from
+	ResumeProgress *LoadResumeProgress `json:"resume_progress,omitempty"`
+	TotalBytes     int64               `json:"total_bytes"`
 }
 
 // MasterTopology defines model for MasterTopology.
@@ -332,6 +402,30 @@ type Security struct {
 	SslKeyContent string `json:"ssl_key_content"`
 }
 
+// an upstream table whose shard DDLs conflict with the other tables of an optimistic shard DDL lock
+type ShardDDLConflict struct {
+	// pending DDLs of the upstream table
+	DdlList []string `json:"ddl_list"`
+
+	// shard DDL lock ID
+	LockId string `json:"lock_id"`
+
+	// conflict message
+	Message string `json:"message"`
+
+	// dmctl commands which can resolve the conflict
+	ResolveHints []string `json:"resolve_hints"`
+
+	// upstream schema name
+	SchemaName string `json:"schema_name"`
+
+	// source name of the upstream table
+	SourceName string `json:"source_name"`
+
+	// upstream table name
+	TableName string `json:"table_name"`
+}
+
 // ShardingGroup defines model for ShardingGroup.
 type ShardingGroup struct {
 	DdlList       []string `json:"ddl_list"`
@@ -452,6 +546,30 @@ type SubTaskStatus struct {
 	WorkerName string `json:"worker_name"`
 }
 
+// the changed status of a subtask
+type SubTaskStatusEvent struct {
+	// error message when something wrong
+	ErrorMsg *string `json:"error_msg,omitempty"`
+
+	// task name
+	Name string `json:"name"`
+
+	// sync lag of the sync unit
+	SecondsBehindMaster *int64 `json:"seconds_behind_master,omitempty"`
+
+	// source name
+	SourceName string `json:"source_name"`
+
+	// stage of the subtask, or "Removed" if the subtask doesn't exist anymore
+	Stage string `json:"stage"`
+
+	// when the change is found, in RFC3339 format
+	Time string `json:"time"`
+
+	// task unit type
+	Unit *string `json:"unit,omitempty"`
+}
+
 // status of sync unit
 type SyncStatus struct {
 	BinlogType string `json:"binlog_type"`
@@ -494,6 +612,9 @@ type Task struct {
 	// how to handle conflicted data
 	OnDuplicate TaskOnDuplicate `json:"on_duplicate"`
 
+	// regular expressions of the shadow table names of the online ddl plugin, the only group of each one is the origin table name
+	ShadowTableRules *[]string `json:"shadow_table_rules,omitempty"`
+
 	// the way to coordinate DDL
 	ShardMode *TaskShardMode `json:"shard_mode,omitempty"`
 
@@ -509,6 +630,9 @@ type Task struct {
 
 	// migrate mode
 	TaskMode TaskTaskMode `json:"task_mode"`
+
+	// regular expressions of the trash table names of the online ddl plugin, the only group of each one is the origin table name
+	TrashTableRules *[]string `json:"trash_table_rules,omitempty"`
 }
 
 // Task_BinlogFilterRule defines model for Task.BinlogFilterRule.
@@ -739,6 +863,9 @@ type DMAPIGetTaskListParams struct {
 // DMAPICreateTaskJSONBody defines parameters for DMAPICreateTask.
 type DMAPICreateTaskJSONBody CreateTaskRequest
 
+// DMAPIBatchOperateTasksJSONBody defines parameters for DMAPIBatchOperateTasks.
+type DMAPIBatchOperateTasksJSONBody BatchOperateTaskRequest
+
 // DMAPIConvertTaskJSONBody defines parameters for DMAPIConvertTask.
 type DMAPIConvertTaskJSONBody ConverterTaskRequest
 
@@ -763,6 +890,12 @@ type DMAPIGetTaskParams struct {
 // DMAPIUpdateTaskJSONBody defines parameters for DMAPIUpdateTask.
 type DMAPIUpdateTaskJSONBody UpdateTaskRequest
 
+// DMAPIGetTaskShardDDLConflictsParams defines parameters for DMAPIGetTaskShardDDLConflicts.
+type DMAPIGetTaskShardDDLConflictsParams struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
 // DMAPIGetTaskMigrateTargetsParams defines parameters for DMAPIGetTaskMigrateTargets.
 type DMAPIGetTaskMigrateTargetsParams struct {
 	SchemaPattern *string `json:"schema_pattern,omitempty"`
@@ -781,6 +914,15 @@ type DMAPIGetTaskStatusParams struct {
 	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
 }
 
+// DMAPIWatchTaskStatusParams defines parameters for DMAPIWatchTaskStatus.
+type DMAPIWatchTaskStatusParams struct {
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+
+	// the interval in seconds to query the task status, default is 1
+	Interval *int `json:"interval,omitempty"`
+}
+
 // DMAPIStopTaskJSONBody defines parameters for DMAPIStopTask.
 type DMAPIStopTaskJSONBody StopTaskRequest
 
@@ -808,6 +950,9 @@ type DMAPITransferSourceJSONRequestBody DMAPITransferSourceJSONBody
 // DMAPICreateTaskJSONRequestBody defines body for DMAPICreateTask for application/json ContentType.
 type DMAPICreateTaskJSONRequestBody DMAPICreateTaskJSONBody
 
+// DMAPIBatchOperateTasksJSONRequestBody defines body for DMAPIBatchOperateTasks for application/json ContentType.
+type DMAPIBatchOperateTasksJSONRequestBody DMAPIBatchOperateTasksJSONBody
+
 // DMAPIConvertTaskJSONRequestBody defines body for DMAPIConvertTask for application/json ContentType.
 type DMAPIConvertTaskJSONRequestBody DMAPIConvertTaskJSONBody
 
@@ -845,17 +845,126 @@ func (m *DDLLock) GetUnsynced() []string {
 	return nil
 }
 
+// DDLLockConflict represents a table whose shard DDLs conflict with the other tables of an optimistic DDL lock
+// ID: DDL lock generated ID
+// task: lock's corresponding task name
+// source: upstream source ID of the table
+// upSchema, upTable: upstream schema and table name
+// DDLs: pending DDL statements of the table
+// msg: the conflict message
+// resolveHints: dmctl commands which can resolve the conflict
+type DDLLockConflict struct {
+	ID           string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Task         string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Source       string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	UpSchema     string   `protobuf:"bytes,4,opt,name=upSchema,proto3" json:"upSchema,omitempty"`
+	UpTable      string   `protobuf:"bytes,5,opt,name=upTable,proto3" json:"upTable,omitempty"`
+	DDLs         []string `protobuf:"bytes,6,rep,name=DDLs,proto3" json:"DDLs,omitempty"`
+	Msg          string   `protobuf:"bytes,7,opt,name=msg,proto3" json:"msg,omitempty"`
+	ResolveHints []string `protobuf:"bytes,8,rep,name=resolveHints,proto3" json:"resolveHints,omitempty"`
+}
+
+func (m *DDLLockConflict) Reset()         { *m = DDLLockConflict{} }
+func (m *DDLLockConflict) String() string { return proto.CompactTextString(m) }
+func (*DDLLockConflict) ProtoMessage()    {}
+func (*DDLLockConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{10}
+}
+func (m *DDLLockConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DDLLockConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DDLLockConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DDLLockConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDLLockConflict.Merge(m, src)
+}
+func (m *DDLLockConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *DDLLockConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDLLockConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DDLLockConflict proto.InternalMessageInfo
+
+func (m *DDLLockConflict) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetUpSchema() string {
+	if m != nil {
+		return m.UpSchema
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetUpTable() string {
+	if m != nil {
+		return m.UpTable
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetDDLs() []string {
+	if m != nil {
+		return m.DDLs
+	}
+	return nil
+}
+
+func (m *DDLLockConflict) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *DDLLockConflict) GetResolveHints() []string {
+	if m != nil {
+		return m.ResolveHints
+	}
+	return nil
+}
+
 type ShowDDLLocksResponse struct {
-	Result bool       `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg    string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Locks  []*DDLLock `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
+	Result    bool               `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg       string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Locks     []*DDLLock         `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
+	Conflicts []*DDLLockConflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
 func (m *ShowDDLLocksResponse) Reset()         { *m = ShowDDLLocksResponse{} }
 func (m *ShowDDLLocksResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowDDLLocksResponse) ProtoMessage()    {}
 func (*ShowDDLLocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{10}
+	return fileDescriptor_f9bef11f2a341f03, []int{11}
 }
 func (m *ShowDDLLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,6 +1014,13 @@ func (m *ShowDDLLocksResponse) GetLocks() []*DDLLock {
 	return nil
 }
 
+func (m *ShowDDLLocksResponse) GetConflicts() []*DDLLockConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
 // UnlockDDLLockRequest used to unlock (resolve) DDL lock manually
 // ID: DDL lock ID
 // replaceOwner: dm-worker used to replace the original DDL lock's owner
@@ -923,7 +1039,7 @@ func (m *UnlockDDLLockRequest) Reset()         { *m = UnlockDDLLockRequest{} }
 func (m *UnlockDDLLockRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockDDLLockRequest) ProtoMessage()    {}
 func (*UnlockDDLLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{11}
+	return fileDescriptor_f9bef11f2a341f03, []int{12}
 }
 func (m *UnlockDDLLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1126,7 @@ func (m *UnlockDDLLockResponse) Reset()         { *m = UnlockDDLLockResponse{} }
 func (m *UnlockDDLLockResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockDDLLockResponse) ProtoMessage()    {}
 func (*UnlockDDLLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{12}
+	return fileDescriptor_f9bef11f2a341f03, []int{13}
 }
 func (m *UnlockDDLLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1179,7 @@ func (m *OperateWorkerRelayRequest) Reset()         { *m = OperateWorkerRelayReq
 func (m *OperateWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayRequest) ProtoMessage()    {}
 func (*OperateWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{13}
+	return fileDescriptor_f9bef11f2a341f03, []int{14}
 }
 func (m *OperateWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1233,7 @@ func (m *OperateWorkerRelayResponse) Reset()         { *m = OperateWorkerRelayRe
 func (m *OperateWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayResponse) ProtoMessage()    {}
 func (*OperateWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{14}
+	return fileDescriptor_f9bef11f2a341f03, []int{15}
 }
 func (m *OperateWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1308,7 @@ func (m *PurgeWorkerRelayRequest) Reset()         { *m = PurgeWorkerRelayRequest
 func (m *PurgeWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayRequest) ProtoMessage()    {}
 func (*PurgeWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{15}
+	return fileDescriptor_f9bef11f2a341f03, []int{16}
 }
 func (m *PurgeWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,7 +1382,7 @@ func (m *PurgeWorkerRelayResponse) Reset()         { *m = PurgeWorkerRelayRespon
 func (m *PurgeWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayResponse) ProtoMessage()    {}
 func (*PurgeWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{16}
+	return fileDescriptor_f9bef11f2a341f03, []int{17}
 }
 func (m *PurgeWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1443,7 @@ func (m *CheckTaskRequest) Reset()         { *m = CheckTaskRequest{} }
 func (m *CheckTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskRequest) ProtoMessage()    {}
 func (*CheckTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{17}
+	return fileDescriptor_f9bef11f2a341f03, []int{18}
 }
 func (m *CheckTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1393,7 +1509,7 @@ func (m *CheckTaskResponse) Reset()         { *m = CheckTaskResponse{} }
 func (m *CheckTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskResponse) ProtoMessage()    {}
 func (*CheckTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{18}
+	return fileDescriptor_f9bef11f2a341f03, []int{19}
 }
 func (m *CheckTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1447,7 +1563,7 @@ func (m *OperateSourceRequest) Reset()         { *m = OperateSourceRequest{} }
 func (m *OperateSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceRequest) ProtoMessage()    {}
 func (*OperateSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{19}
+	return fileDescriptor_f9bef11f2a341f03, []int{20}
 }
 func (m *OperateSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1514,7 +1630,7 @@ func (m *OperateSourceResponse) Reset()         { *m = OperateSourceResponse{} }
 func (m *OperateSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceResponse) ProtoMessage()    {}
 func (*OperateSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{20}
+	return fileDescriptor_f9bef11f2a341f03, []int{21}
 }
 func (m *OperateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1573,7 +1689,7 @@ func (m *RegisterWorkerRequest) Reset()         { *m = RegisterWorkerRequest{} }
 func (m *RegisterWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerRequest) ProtoMessage()    {}
 func (*RegisterWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{21}
+	return fileDescriptor_f9bef11f2a341f03, []int{22}
 }
 func (m *RegisterWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1625,7 +1741,7 @@ func (m *RegisterWorkerResponse) Reset()         { *m = RegisterWorkerResponse{}
 func (m *RegisterWorkerResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerResponse) ProtoMessage()    {}
 func (*RegisterWorkerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{22}
+	return fileDescriptor_f9bef11f2a341f03, []int{23}
 }
 func (m *RegisterWorkerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +1793,7 @@ func (m *OfflineMemberRequest) Reset()         { *m = OfflineMemberRequest{} }
 func (m *OfflineMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberRequest) ProtoMessage()    {}
 func (*OfflineMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{23}
+	return fileDescriptor_f9bef11f2a341f03, []int{24}
 }
 func (m *OfflineMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1729,7 +1845,7 @@ func (m *OfflineMemberResponse) Reset()         { *m = OfflineMemberResponse{} }
 func (m *OfflineMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberResponse) ProtoMessage()    {}
 func (*OfflineMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{24}
+	return fileDescriptor_f9bef11f2a341f03, []int{25}
 }
 func (m *OfflineMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1896,7 @@ func (m *OperateLeaderRequest) Reset()         { *m = OperateLeaderRequest{} }
 func (m *OperateLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderRequest) ProtoMessage()    {}
 func (*OperateLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{25}
+	return fileDescriptor_f9bef11f2a341f03, []int{26}
 }
 func (m *OperateLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +1941,7 @@ func (m *OperateLeaderResponse) Reset()         { *m = OperateLeaderResponse{} }
 func (m *OperateLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderResponse) ProtoMessage()    {}
 func (*OperateLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{26}
+	return fileDescriptor_f9bef11f2a341f03, []int{27}
 }
 func (m *OperateLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1880,7 +1996,7 @@ func (m *MasterInfo) Reset()         { *m = MasterInfo{} }
 func (m *MasterInfo) String() string { return proto.CompactTextString(m) }
 func (*MasterInfo) ProtoMessage()    {}
 func (*MasterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{27}
+	return fileDescriptor_f9bef11f2a341f03, []int{28}
 }
 func (m *MasterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1955,7 +2071,7 @@ func (m *WorkerInfo) Reset()         { *m = WorkerInfo{} }
 func (m *WorkerInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkerInfo) ProtoMessage()    {}
 func (*WorkerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{28}
+	return fileDescriptor_f9bef11f2a341f03, []int{29}
 }
 func (m *WorkerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2022,7 +2138,7 @@ func (m *ListLeaderMember) Reset()         { *m = ListLeaderMember{} }
 func (m *ListLeaderMember) String() string { return proto.CompactTextString(m) }
 func (*ListLeaderMember) ProtoMessage()    {}
 func (*ListLeaderMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{29}
+	return fileDescriptor_f9bef11f2a341f03, []int{30}
 }
 func (m *ListLeaderMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2081,7 +2197,7 @@ func (m *ListMasterMember) Reset()         { *m = ListMasterMember{} }
 func (m *ListMasterMember) String() string { return proto.CompactTextString(m) }
 func (*ListMasterMember) ProtoMessage()    {}
 func (*ListMasterMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{30}
+	return fileDescriptor_f9bef11f2a341f03, []int{31}
 }
 func (m *ListMasterMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2249,7 @@ func (m *ListWorkerMember) Reset()         { *m = ListWorkerMember{} }
 func (m *ListWorkerMember) String() string { return proto.CompactTextString(m) }
 func (*ListWorkerMember) ProtoMessage()    {}
 func (*ListWorkerMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{31}
+	return fileDescriptor_f9bef11f2a341f03, []int{32}
 }
 func (m *ListWorkerMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2189,7 +2305,7 @@ func (m *Members) Reset()         { *m = Members{} }
 func (m *Members) String() string { return proto.CompactTextString(m) }
 func (*Members) ProtoMessage()    {}
 func (*Members) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{32}
+	return fileDescriptor_f9bef11f2a341f03, []int{33}
 }
 func (m *Members) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2286,7 +2402,7 @@ func (m *ListMemberRequest) Reset()         { *m = ListMemberRequest{} }
 func (m *ListMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMemberRequest) ProtoMessage()    {}
 func (*ListMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{33}
+	return fileDescriptor_f9bef11f2a341f03, []int{34}
 }
 func (m *ListMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2353,7 +2469,7 @@ func (m *ListMemberResponse) Reset()         { *m = ListMemberResponse{} }
 func (m *ListMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMemberResponse) ProtoMessage()    {}
 func (*ListMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{34}
+	return fileDescriptor_f9bef11f2a341f03, []int{35}
 }
 func (m *ListMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2420,7 +2536,7 @@ func (m *OperateSchemaRequest) Reset()         { *m = OperateSchemaRequest{} }
 func (m *OperateSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaRequest) ProtoMessage()    {}
 func (*OperateSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{35}
+	return fileDescriptor_f9bef11f2a341f03, []int{36}
 }
 func (m *OperateSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2529,7 +2645,7 @@ func (m *OperateSchemaResponse) Reset()         { *m = OperateSchemaResponse{} }
 func (m *OperateSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaResponse) ProtoMessage()    {}
 func (*OperateSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{36}
+	return fileDescriptor_f9bef11f2a341f03, []int{37}
 }
 func (m *OperateSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2588,7 +2704,7 @@ func (m *GetSubTaskCfgRequest) Reset()         { *m = GetSubTaskCfgRequest{} }
 func (m *GetSubTaskCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgRequest) ProtoMessage()    {}
 func (*GetSubTaskCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{37}
+	return fileDescriptor_f9bef11f2a341f03, []int{38}
 }
 func (m *GetSubTaskCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2634,7 +2750,7 @@ func (m *GetSubTaskCfgResponse) Reset()         { *m = GetSubTaskCfgResponse{} }
 func (m *GetSubTaskCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgResponse) ProtoMessage()    {}
 func (*GetSubTaskCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{38}
+	return fileDescriptor_f9bef11f2a341f03, []int{39}
 }
 func (m *GetSubTaskCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2693,7 +2809,7 @@ func (m *GetCfgRequest) Reset()         { *m = GetCfgRequest{} }
 func (m *GetCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCfgRequest) ProtoMessage()    {}
 func (*GetCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{39}
+	return fileDescriptor_f9bef11f2a341f03, []int{40}
 }
 func (m *GetCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2746,7 +2862,7 @@ func (m *GetCfgResponse) Reset()         { *m = GetCfgResponse{} }
 func (m *GetCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCfgResponse) ProtoMessage()    {}
 func (*GetCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{40}
+	return fileDescriptor_f9bef11f2a341f03, []int{41}
 }
 func (m *GetCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2803,7 +2919,7 @@ func (m *GetMasterCfgRequest) Reset()         { *m = GetMasterCfgRequest{} }
 func (m *GetMasterCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgRequest) ProtoMessage()    {}
 func (*GetMasterCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{41}
+	return fileDescriptor_f9bef11f2a341f03, []int{42}
 }
 func (m *GetMasterCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2840,7 +2956,7 @@ func (m *GetMasterCfgResponse) Reset()         { *m = GetMasterCfgResponse{} }
 func (m *GetMasterCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgResponse) ProtoMessage()    {}
 func (*GetMasterCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{42}
+	return fileDescriptor_f9bef11f2a341f03, []int{43}
 }
 func (m *GetMasterCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2888,7 +3004,7 @@ func (m *HandleErrorRequest) Reset()         { *m = HandleErrorRequest{} }
 func (m *HandleErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorRequest) ProtoMessage()    {}
 func (*HandleErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{43}
+	return fileDescriptor_f9bef11f2a341f03, []int{44}
 }
 func (m *HandleErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2962,7 +3078,7 @@ func (m *HandleErrorResponse) Reset()         { *m = HandleErrorResponse{} }
 func (m *HandleErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorResponse) ProtoMessage()    {}
 func (*HandleErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{44}
+	return fileDescriptor_f9bef11f2a341f03, []int{45}
 }
 func (m *HandleErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3021,7 +3137,7 @@ func (m *TransferSourceRequest) Reset()         { *m = TransferSourceRequest{} }
 func (m *TransferSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceRequest) ProtoMessage()    {}
 func (*TransferSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{45}
+	return fileDescriptor_f9bef11f2a341f03, []int{46}
 }
 func (m *TransferSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3073,7 +3189,7 @@ func (m *TransferSourceResponse) Reset()         { *m = TransferSourceResponse{}
 func (m *TransferSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceResponse) ProtoMessage()    {}
 func (*TransferSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{46}
+	return fileDescriptor_f9bef11f2a341f03, []int{47}
 }
 func (m *TransferSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3242,7 @@ func (m *OperateRelayRequest) Reset()         { *m = OperateRelayRequest{} }
 func (m *OperateRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayRequest) ProtoMessage()    {}
 func (*OperateRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{47}
+	return fileDescriptor_f9bef11f2a341f03, []int{48}
 }
 func (m *OperateRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3186,7 +3302,7 @@ func (m *OperateRelayResponse) Reset()         { *m = OperateRelayResponse{} }
 func (m *OperateRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayResponse) ProtoMessage()    {}
 func (*OperateRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{48}
+	return fileDescriptor_f9bef11f2a341f03, []int{49}
 }
 func (m *OperateRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3372,7 @@ func (m *StartValidationRequest) Reset()         { *m = StartValidationRequest{}
 func (m *StartValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*StartValidationRequest) ProtoMessage()    {}
 func (*StartValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{49}
+	return fileDescriptor_f9bef11f2a341f03, []int{50}
 }
 func (m *StartValidationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3365,7 +3481,7 @@ func (m *StartValidationResponse) Reset()         { *m = StartValidationResponse
 func (m *StartValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*StartValidationResponse) ProtoMessage()    {}
 func (*StartValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{50}
+	return fileDescriptor_f9bef11f2a341f03, []int{51}
 }
 func (m *StartValidationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3424,7 +3540,7 @@ func (m *StopValidationRequest) Reset()         { *m = StopValidationRequest{} }
 func (m *StopValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*StopValidationRequest) ProtoMessage()    {}
 func (*StopValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{51}
+	return fileDescriptor_f9bef11f2a341f03, []int{52}
 }
 func (m *StopValidationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3477,7 +3593,7 @@ func (m *StopValidationResponse) Reset()         { *m = StopValidationResponse{}
 func (m *StopValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*StopValidationResponse) ProtoMessage()    {}
 func (*StopValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{52}
+	return fileDescriptor_f9bef11f2a341f03, []int{53}
 }
 func (m *StopValidationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3543,6 +3659,7 @@ func init() {
 	proto.RegisterType((*QueryStatusListResponse)(nil), "pb.QueryStatusListResponse")
 	proto.RegisterType((*ShowDDLLocksRequest)(nil), "pb.ShowDDLLocksRequest")
 	proto.RegisterType((*DDLLock)(nil), "pb.DDLLock")
+	proto.RegisterType((*DDLLockConflict)(nil), "pb.DDLLockConflict")
 	proto.RegisterType((*ShowDDLLocksResponse)(nil), "pb.ShowDDLLocksResponse")
 	proto.RegisterType((*UnlockDDLLockRequest)(nil), "pb.UnlockDDLLockRequest")
 	proto.RegisterType((*UnlockDDLLockResponse)(nil), "pb.UnlockDDLLockResponse")
@@ -3591,155 +3708,160 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xd1, 0x1c, 0x92, 0xa2, 0xc8, 0xa2, 0x44, 0x53, 0x2d, 0x89, 0x1a, 0x8f, 0x65, 0x5a, 0xee, 0x7d,
-	0x40, 0x10, 0x3e, 0x58, 0xb0, 0xbe, 0x9c, 0x16, 0xd8, 0x20, 0x6b, 0xd1, 0x6b, 0x0b, 0x91, 0xd7,
-	0x9b, 0x91, 0xed, 0x64, 0x11, 0x20, 0x9b, 0x21, 0xd9, 0xa4, 0x08, 0x0d, 0x67, 0xc6, 0x33, 0x43,
-	0x69, 0x0d, 0x63, 0x73, 0xc8, 0x29, 0xa7, 0x3c, 0xb0, 0x41, 0xf6, 0x98, 0x43, 0xfe, 0x40, 0x7e,
-	0x46, 0x8e, 0x0b, 0xe4, 0x92, 0x4b, 0x80, 0xc0, 0xce, 0x3d, 0x7f, 0x21, 0xe8, 0xea, 0x9e, 0x99,
-	0x9e, 0x07, 0xb9, 0xe1, 0x02, 0x11, 0x72, 0x9b, 0xaa, 0x6a, 0xd6, 0xbb, 0xab, 0xab, 0x0a, 0x84,
-	0xd6, 0x70, 0x3a, 0xb5, 0x82, 0x90, 0xf9, 0xf7, 0x3c, 0xdf, 0x0d, 0x5d, 0x52, 0xf6, 0xfa, 0x46,
-	0x6b, 0x38, 0xbd, 0x72, 0xfd, 0x8b, 0x08, 0x67, 0xec, 0x8e, 0x5d, 0x77, 0x6c, 0xb3, 0x43, 0xcb,
-	0x9b, 0x1c, 0x5a, 0x8e, 0xe3, 0x86, 0x56, 0x38, 0x71, 0x9d, 0x40, 0x50, 0xe9, 0x2f, 0xa0, 0x7d,
-	0x16, 0x5a, 0x7e, 0xf8, 0xcc, 0x0a, 0x2e, 0x4c, 0xf6, 0x72, 0xc6, 0x82, 0x90, 0x10, 0xa8, 0x86,
-	0x56, 0x70, 0xa1, 0x6b, 0x7b, 0xda, 0x7e, 0xc3, 0xc4, 0x6f, 0xa2, 0xc3, 0x6a, 0xe0, 0xce, 0xfc,
-	0x01, 0x0b, 0xf4, 0xf2, 0x5e, 0x65, 0xbf, 0x61, 0x46, 0x20, 0xe9, 0x02, 0xf8, 0x6c, 0xea, 0x5e,
-	0xb2, 0x27, 0x2c, 0xb4, 0xf4, 0xca, 0x9e, 0xb6, 0x5f, 0x37, 0x15, 0x0c, 0xd9, 0x85, 0x46, 0x80,
-	0x12, 0x26, 0x53, 0xa6, 0x57, 0x91, 0x65, 0x82, 0xa0, 0x5f, 0x69, 0xb0, 0xa1, 0x28, 0x10, 0x78,
-	0xae, 0x13, 0x30, 0xd2, 0x81, 0x9a, 0xcf, 0x82, 0x99, 0x1d, 0xa2, 0x0e, 0x75, 0x53, 0x42, 0xa4,
-	0x0d, 0x95, 0x69, 0x30, 0xd6, 0xcb, 0xc8, 0x85, 0x7f, 0x92, 0xa3, 0x44, 0xaf, 0xca, 0x5e, 0x65,
-	0xbf, 0x79, 0xa4, 0xdf, 0xf3, 0xfa, 0xf7, 0x8e, 0xdd, 0xe9, 0xd4, 0x75, 0x7e, 0x8c, 0x6e, 0x88,
-	0x98, 0x26, 0x1a, 0xef, 0x41, 0x73, 0x70, 0xce, 0x06, 0x5c, 0x1c, 0x17, 0x21, 0x74, 0x52, 0x51,
-	0xf4, 0x67, 0x40, 0x9e, 0x7a, 0xcc, 0xb7, 0x42, 0xa6, 0xfa, 0xc5, 0x80, 0xb2, 0xeb, 0xa1, 0x46,
-	0xad, 0x23, 0xe0, 0x62, 0x38, 0xf1, 0xa9, 0x67, 0x96, 0x5d, 0x8f, 0xfb, 0xcc, 0xb1, 0xa6, 0x4c,
-	0xaa, 0x86, 0xdf, 0xaa, 0xcf, 0x2a, 0x29, 0x9f, 0xd1, 0xdf, 0x68, 0xb0, 0x99, 0x12, 0x20, 0xed,
-	0x5e, 0x24, 0x21, 0xf1, 0x49, 0xb9, 0xc8, 0x27, 0x95, 0x42, 0x9f, 0x54, 0xff, 0x43, 0x9f, 0xd0,
-	0x8f, 0x60, 0xe3, 0xb9, 0x37, 0xcc, 0x18, 0xbc, 0x54, 0x22, 0xd0, 0xdf, 0x6b, 0x40, 0x54, 0x1e,
-	0xff, 0x23, 0xb1, 0xfc, 0x18, 0x3a, 0x3f, 0x9a, 0x31, 0xff, 0xd5, 0x59, 0x68, 0x85, 0xb3, 0xe0,
-	0x74, 0x12, 0x84, 0x8a, 0x79, 0x18, 0x33, 0xad, 0x38, 0x66, 0x19, 0xf3, 0x2e, 0x61, 0x27, 0xc7,
-	0x67, 0x69, 0x13, 0xef, 0x67, 0x4d, 0xdc, 0xe1, 0x26, 0x2a, 0x7c, 0xf3, 0x91, 0x39, 0x86, 0xcd,
-	0xb3, 0x73, 0xf7, 0xaa, 0xd7, 0x3b, 0x3d, 0x75, 0x07, 0x17, 0xc1, 0x77, 0x8b, 0xcd, 0x1f, 0x35,
-	0x58, 0x95, 0x1c, 0x48, 0x0b, 0xca, 0x27, 0x3d, 0xf9, 0xbb, 0xf2, 0x49, 0x2f, 0xe6, 0x54, 0x56,
-	0x38, 0x11, 0xa8, 0x4e, 0xdd, 0x21, 0x93, 0x59, 0x85, 0xdf, 0x64, 0x0b, 0x56, 0xdc, 0x2b, 0x87,
-	0xf9, 0xd2, 0xc9, 0x02, 0xe0, 0x27, 0x7b, 0xbd, 0xd3, 0x40, 0x5f, 0x41, 0x81, 0xf8, 0xcd, 0xfd,
-	0x11, 0xbc, 0x72, 0x06, 0x6c, 0xa8, 0xd7, 0x10, 0x2b, 0x21, 0x62, 0x40, 0x7d, 0xe6, 0x48, 0xca,
-	0x2a, 0x52, 0x62, 0x98, 0x0e, 0x60, 0x2b, 0x6d, 0xe6, 0xd2, 0xbe, 0xbd, 0x0b, 0x2b, 0x36, 0xff,
-	0xa9, 0xf4, 0x6c, 0x93, 0x7b, 0x56, 0xb2, 0x33, 0x05, 0x85, 0xfe, 0x5d, 0x83, 0xad, 0xe7, 0x0e,
-	0xff, 0x8e, 0x08, 0xd2, 0x9b, 0x59, 0x9f, 0x50, 0x58, 0xf3, 0x99, 0x67, 0x5b, 0x03, 0xf6, 0x14,
-	0x4d, 0x16, 0x62, 0x52, 0x38, 0x9e, 0x7a, 0x23, 0xd7, 0x1f, 0x30, 0x13, 0x6b, 0x9d, 0xac, 0x7c,
-	0x2a, 0x8a, 0xbc, 0x83, 0xd7, 0xb9, 0x8a, 0xd7, 0x79, 0x93, 0xab, 0x93, 0x92, 0x2d, 0xef, 0xb5,
-	0x12, 0xb4, 0x95, 0x74, 0x65, 0x35, 0xa0, 0x3e, 0xb4, 0x42, 0xab, 0x6f, 0x05, 0x4c, 0xaf, 0xa1,
-	0x02, 0x31, 0xcc, 0x83, 0x11, 0x5a, 0x7d, 0x9b, 0xe9, 0xab, 0x22, 0x18, 0x08, 0xd0, 0x8f, 0x60,
-	0x3b, 0x63, 0xde, 0xb2, 0x5e, 0xa4, 0x26, 0xdc, 0x94, 0x95, 0x29, 0xba, 0x72, 0xb6, 0xf5, 0x2a,
-	0x72, 0xd3, 0x2d, 0xa5, 0x3e, 0xa1, 0x7f, 0x91, 0x9a, 0x37, 0x24, 0x93, 0x7d, 0x5f, 0x6b, 0x60,
-	0x14, 0x31, 0x95, 0xca, 0x2d, 0xe4, 0xfa, 0xdf, 0x2d, 0x7b, 0x5f, 0x6b, 0xb0, 0xf3, 0xe9, 0xcc,
-	0x1f, 0x17, 0x19, 0xab, 0xd8, 0xa3, 0xe5, 0x02, 0x33, 0x71, 0xac, 0x41, 0x38, 0xb9, 0x64, 0x52,
-	0xab, 0x18, 0xc6, 0xdb, 0xc4, 0x5f, 0x3a, 0xae, 0x58, 0xc5, 0xc4, 0x6f, 0x7e, 0x7e, 0x34, 0xb1,
-	0x19, 0x16, 0x1b, 0x71, 0x79, 0x62, 0x18, 0xef, 0xca, 0xac, 0xdf, 0x9b, 0xf8, 0xfa, 0x0a, 0x52,
-	0x24, 0x44, 0xbf, 0x00, 0x3d, 0xaf, 0xd8, 0x75, 0x94, 0x54, 0x7a, 0x09, 0xed, 0x63, 0x5e, 0x3f,
-	0xbf, 0xed, 0x25, 0xe8, 0x40, 0x8d, 0xf9, 0xfe, 0xb1, 0x23, 0x22, 0x53, 0x31, 0x25, 0xc4, 0xfd,
-	0x76, 0x65, 0xf9, 0x0e, 0x27, 0x08, 0x27, 0x44, 0xe0, 0xb7, 0xb4, 0x02, 0x1f, 0xc2, 0x86, 0x22,
-	0x77, 0xe9, 0xc4, 0xfd, 0x95, 0x06, 0x5b, 0x32, 0xc9, 0xce, 0xd0, 0x92, 0x48, 0xf7, 0x5d, 0x25,
-	0xbd, 0xd6, 0xb8, 0xf9, 0x82, 0x9c, 0xe4, 0xd7, 0xc0, 0x75, 0x46, 0x93, 0xb1, 0x4c, 0x5a, 0x09,
-	0xf1, 0x98, 0x09, 0x87, 0x9c, 0xf4, 0xe4, 0xeb, 0x1d, 0xc3, 0xbc, 0xe5, 0x11, 0x2d, 0xd6, 0x27,
-	0x49, 0x44, 0x15, 0x0c, 0x9d, 0xc1, 0x76, 0x46, 0x93, 0x6b, 0x09, 0xdc, 0x43, 0xd8, 0x36, 0xd9,
-	0x78, 0xc2, 0xfb, 0xc1, 0xe8, 0xc8, 0xc2, 0x87, 0xce, 0x1a, 0x0e, 0x7d, 0x16, 0x04, 0x52, 0x6c,
-	0x04, 0xd2, 0x07, 0xd0, 0xc9, 0xb2, 0x59, 0x3a, 0x18, 0xdf, 0x87, 0xad, 0xa7, 0xa3, 0x91, 0x3d,
-	0x71, 0xd8, 0x13, 0x36, 0xed, 0xa7, 0x34, 0x09, 0x5f, 0x79, 0xb1, 0x26, 0xfc, 0xbb, 0xa8, 0x75,
-	0xe2, 0x85, 0x2c, 0xf3, 0xfb, 0xa5, 0x55, 0xf8, 0x5e, 0x9c, 0x0e, 0xa7, 0xcc, 0x1a, 0x26, 0x2a,
-	0xe4, 0xd2, 0x41, 0x90, 0x45, 0x3a, 0xa0, 0xe0, 0xf4, 0xaf, 0x96, 0x16, 0xfc, 0x6b, 0x0d, 0xe0,
-	0x09, 0x76, 0xe5, 0x27, 0xce, 0xc8, 0x2d, 0x74, 0xbe, 0x01, 0xf5, 0x29, 0xda, 0x75, 0xd2, 0xc3,
-	0x5f, 0x56, 0xcd, 0x18, 0xe6, 0x95, 0xdd, 0xb2, 0x27, 0xf1, 0x83, 0x22, 0x00, 0xfe, 0x0b, 0x8f,
-	0x31, 0xff, 0xb9, 0x79, 0x2a, 0xaa, 0x5b, 0xc3, 0x8c, 0x61, 0x9e, 0x8e, 0x03, 0x7b, 0xc2, 0x9c,
-	0x10, 0xa9, 0xe2, 0x11, 0x51, 0x30, 0xb4, 0x0f, 0x20, 0x02, 0x39, 0x57, 0x1f, 0x02, 0x55, 0x1e,
-	0xfd, 0x28, 0x04, 0xfc, 0x9b, 0xeb, 0x11, 0x84, 0xd6, 0x38, 0xea, 0x01, 0x04, 0x80, 0xe5, 0x0a,
-	0xd3, 0x4d, 0xa6, 0xbd, 0x84, 0xe8, 0x29, 0xb4, 0x79, 0x4b, 0x24, 0x9c, 0x26, 0x62, 0x16, 0xb9,
-	0x46, 0x4b, 0xb2, 0xba, 0xa8, 0x4b, 0x8e, 0x64, 0x57, 0x12, 0xd9, 0xf4, 0x13, 0xc1, 0x4d, 0x78,
-	0x71, 0x2e, 0xb7, 0x7d, 0x58, 0x15, 0xd3, 0x8f, 0x78, 0x70, 0x9a, 0x47, 0x2d, 0x1e, 0xce, 0xc4,
-	0xf5, 0x66, 0x44, 0x8e, 0xf8, 0x09, 0x2f, 0x2c, 0xe2, 0x27, 0x2e, 0x71, 0x8a, 0x5f, 0xe2, 0x3a,
-	0x33, 0x22, 0xd3, 0x3f, 0x69, 0xb0, 0x2a, 0xd8, 0x04, 0xe4, 0x1e, 0xd4, 0x6c, 0xb4, 0x1a, 0x59,
-	0x35, 0x8f, 0xb6, 0x30, 0xa7, 0x32, 0xbe, 0x78, 0x5c, 0x32, 0xe5, 0x29, 0x7e, 0x5e, 0xa8, 0x85,
-	0x5e, 0x50, 0xce, 0xab, 0xd6, 0xf2, 0xf3, 0xe2, 0x14, 0x3f, 0x2f, 0xc4, 0xa2, 0x87, 0x94, 0xf3,
-	0xaa, 0x35, 0xfc, 0xbc, 0x38, 0xf5, 0xa0, 0x0e, 0x35, 0x91, 0x4b, 0xf4, 0x25, 0x6c, 0x20, 0xdf,
-	0xd4, 0x0d, 0xec, 0xa4, 0xd4, 0xad, 0xc7, 0x6a, 0x75, 0x52, 0x6a, 0xd5, 0x63, 0xf1, 0x9d, 0x94,
-	0xf8, 0x7a, 0x24, 0x86, 0xa7, 0x07, 0x0f, 0x5f, 0x94, 0x8d, 0x02, 0xa0, 0x0c, 0x88, 0x2a, 0x72,
-	0xe9, 0xb2, 0xf7, 0x1e, 0xac, 0x0a, 0xe5, 0x53, 0x5d, 0x9c, 0x74, 0xb5, 0x19, 0xd1, 0xe8, 0x1f,
-	0xca, 0x49, 0xad, 0x1f, 0x9c, 0xb3, 0xa9, 0x35, 0xbf, 0xd6, 0x23, 0x39, 0x19, 0xd2, 0x72, 0x9d,
-	0xee, 0xdc, 0x21, 0x2d, 0xd5, 0x7e, 0x55, 0xe7, 0xb5, 0x5f, 0x2b, 0x4a, 0xfb, 0x85, 0x97, 0x03,
-	0xe5, 0xc9, 0x76, 0x4d, 0x42, 0xfc, 0xf4, 0xc8, 0x9e, 0x05, 0xe7, 0xd8, 0xac, 0xd5, 0x4d, 0x01,
-	0x70, 0x6d, 0x78, 0xef, 0xab, 0xd7, 0x11, 0x89, 0xdf, 0xfc, 0x2a, 0x8f, 0x7c, 0x77, 0x2a, 0x9e,
-	0x0d, 0xbd, 0x21, 0x86, 0xe9, 0x04, 0x13, 0xd1, 0x9f, 0x59, 0xfe, 0x98, 0x85, 0x3a, 0x24, 0x74,
-	0x81, 0x51, 0x5f, 0x1e, 0xe9, 0x97, 0x6b, 0x79, 0x79, 0x0e, 0x60, 0xeb, 0x11, 0x0b, 0xcf, 0x66,
-	0x7d, 0xfe, 0x76, 0x1f, 0x8f, 0xc6, 0x0b, 0x1e, 0x1e, 0xfa, 0x1c, 0xb6, 0x33, 0x67, 0x97, 0x56,
-	0x91, 0x40, 0x75, 0x30, 0x1a, 0x47, 0x01, 0xc3, 0x6f, 0xda, 0x83, 0xf5, 0x47, 0x2c, 0x54, 0x64,
-	0xdf, 0x51, 0x9e, 0x1a, 0xd9, 0x57, 0x1e, 0x8f, 0xc6, 0xcf, 0x5e, 0x79, 0x6c, 0xc1, 0xbb, 0x73,
-	0x0a, 0xad, 0x88, 0xcb, 0xd2, 0x5a, 0xb5, 0xa1, 0x32, 0x18, 0xc5, 0x1d, 0xe9, 0x60, 0x34, 0xa6,
-	0xdb, 0xb0, 0xf9, 0x88, 0xc9, 0x7b, 0x9d, 0x68, 0x46, 0xf7, 0xd1, 0x5b, 0x0a, 0x5a, 0x8a, 0x92,
-	0x0c, 0xb4, 0x84, 0xc1, 0xef, 0x34, 0x20, 0x8f, 0x2d, 0x67, 0x68, 0xb3, 0x87, 0xbe, 0xef, 0xfa,
-	0x73, 0xdb, 0x70, 0xa4, 0x7e, 0xa7, 0x24, 0xdf, 0x85, 0x46, 0x7f, 0xe2, 0xd8, 0xee, 0xf8, 0x53,
-	0x37, 0x88, 0x5a, 0xb2, 0x18, 0x81, 0x29, 0xfa, 0xd2, 0x8e, 0x87, 0x3b, 0xfe, 0x4d, 0x03, 0xd8,
-	0x4c, 0xa9, 0x74, 0x2d, 0x09, 0xf6, 0x08, 0xb6, 0x9f, 0xf9, 0x96, 0x13, 0x8c, 0x98, 0x9f, 0x6e,
-	0xee, 0x92, 0xf7, 0x48, 0x53, 0xdf, 0x23, 0xa5, 0x6c, 0x09, 0xc9, 0x12, 0xe2, 0xcd, 0x4d, 0x96,
-	0xd1, 0xd2, 0x0f, 0xfc, 0x30, 0x5e, 0xde, 0xa4, 0xe6, 0x85, 0xdb, 0x4a, 0x54, 0xd6, 0x95, 0x31,
-	0xe6, 0xc5, 0x51, 0xd4, 0x68, 0x4a, 0x4d, 0xcb, 0x73, 0x34, 0x15, 0xa1, 0x89, 0x34, 0x0d, 0xe3,
-	0x12, 0x77, 0x9d, 0xcd, 0xff, 0x9f, 0x35, 0xe8, 0xe0, 0x3e, 0xee, 0x85, 0x65, 0x4f, 0x86, 0xb8,
-	0x2a, 0x4c, 0x2e, 0x14, 0x4c, 0xdd, 0x21, 0xfb, 0xfc, 0xd2, 0xb2, 0x67, 0xd2, 0xdd, 0x8f, 0x4b,
-	0x66, 0x83, 0xe3, 0x5e, 0x70, 0x14, 0x39, 0x80, 0x36, 0x76, 0xf3, 0x9f, 0xf3, 0xa1, 0x47, 0x1e,
-	0x43, 0x75, 0x1e, 0x6b, 0x66, 0x2b, 0xee, 0xf3, 0xc5, 0xd9, 0x85, 0x65, 0x97, 0xe7, 0xac, 0xd2,
-	0x5a, 0xc7, 0xf0, 0x83, 0x9a, 0x58, 0x4b, 0x3c, 0x68, 0x2a, 0x83, 0x04, 0xbd, 0x82, 0x9d, 0x9c,
-	0xc6, 0xd7, 0xe2, 0xab, 0x27, 0xb0, 0x7d, 0x16, 0xba, 0x5e, 0xde, 0x53, 0x0b, 0x27, 0xc7, 0xd8,
-	0xb8, 0x72, 0xda, 0x38, 0x7a, 0xc9, 0x3d, 0x9f, 0x66, 0x77, 0x1d, 0x66, 0x1c, 0xfc, 0x00, 0x6e,
-	0x64, 0xf6, 0x12, 0x64, 0x03, 0xd6, 0x4f, 0x9c, 0x4b, 0xae, 0x88, 0x40, 0xb4, 0x4b, 0x64, 0x0d,
-	0xea, 0x67, 0x17, 0x13, 0x8f, 0xc3, 0x6d, 0x8d, 0x43, 0x0f, 0xbf, 0x60, 0x03, 0x84, 0xca, 0x07,
-	0x7d, 0xa8, 0x47, 0x33, 0x15, 0xd9, 0x84, 0x1b, 0xf2, 0xa7, 0x11, 0xaa, 0x5d, 0x22, 0x37, 0xa0,
-	0x89, 0x21, 0x12, 0xa8, 0xb6, 0x46, 0xda, 0xb0, 0x26, 0x56, 0x85, 0x12, 0x53, 0x26, 0x2d, 0x00,
-	0x6e, 0xbd, 0x84, 0x2b, 0x08, 0x9f, 0xbb, 0x57, 0x12, 0xae, 0x1e, 0xfc, 0x10, 0xea, 0x51, 0xa3,
-	0xae, 0xc8, 0x88, 0x50, 0xed, 0x12, 0xd7, 0xf9, 0xe1, 0xe5, 0x64, 0x10, 0xc6, 0x28, 0x8d, 0xec,
-	0xc0, 0xe6, 0xb1, 0xe5, 0x0c, 0x98, 0x9d, 0x26, 0x94, 0x0f, 0x1c, 0x58, 0x95, 0x6f, 0x01, 0x57,
-	0x4d, 0xf2, 0xe2, 0xa0, 0x30, 0x94, 0xbf, 0x4c, 0x08, 0x69, 0x5c, 0x0d, 0x51, 0xa8, 0x11, 0x46,
-	0x35, 0x85, 0x1f, 0x11, 0x16, 0x6a, 0xa2, 0x8a, 0x08, 0x57, 0xc9, 0x16, 0xb4, 0xf1, 0xd7, 0x6c,
-	0xea, 0xd9, 0x56, 0x28, 0xb0, 0x2b, 0x07, 0x3d, 0x68, 0xc4, 0xc5, 0x80, 0x1f, 0x91, 0x12, 0x63,
-	0x5c, 0xbb, 0xc4, 0x3d, 0x82, 0x2e, 0x42, 0xdc, 0x8b, 0xa3, 0xb6, 0x26, 0x9c, 0xe6, 0x7a, 0x11,
-	0xa2, 0x7c, 0xf4, 0xaf, 0x0d, 0xa8, 0x09, 0x65, 0xc8, 0x67, 0xd0, 0x88, 0xb7, 0xe6, 0x04, 0x3b,
-	0xc2, 0xec, 0x16, 0xdf, 0xd8, 0xce, 0x60, 0x45, 0xd8, 0xe9, 0x9d, 0x5f, 0xfe, 0xf5, 0x9f, 0x5f,
-	0x95, 0x6f, 0xd2, 0xad, 0x43, 0xcb, 0x9b, 0x04, 0x87, 0x97, 0xf7, 0x2d, 0xdb, 0x3b, 0xb7, 0xee,
-	0x1f, 0xf2, 0x34, 0x0c, 0x3e, 0xd0, 0x0e, 0xc8, 0x08, 0x9a, 0xca, 0x6a, 0x9a, 0x74, 0x38, 0x9b,
-	0xfc, 0x32, 0xdc, 0xd8, 0xc9, 0xe1, 0xa5, 0x80, 0xf7, 0x51, 0xc0, 0x9e, 0x71, 0xab, 0x48, 0xc0,
-	0xe1, 0x6b, 0xfe, 0xcc, 0x7e, 0xc9, 0xe5, 0x7c, 0x08, 0x90, 0x6c, 0x8b, 0x09, 0x6a, 0x9b, 0xdb,
-	0x40, 0x1b, 0x9d, 0x2c, 0x5a, 0x0a, 0x29, 0x11, 0x1b, 0x9a, 0xca, 0xda, 0x94, 0x18, 0x99, 0x3d,
-	0xaa, 0xb2, 0xe7, 0x35, 0x6e, 0x15, 0xd2, 0x24, 0xa7, 0x77, 0x51, 0xdd, 0x2e, 0xd9, 0xcd, 0xa8,
-	0x1b, 0xe0, 0x51, 0xa9, 0x2f, 0x39, 0x86, 0x35, 0x75, 0x3b, 0x49, 0xd0, 0xfa, 0x82, 0xb5, 0xac,
-	0xa1, 0xe7, 0x09, 0xb1, 0xca, 0x1f, 0xc3, 0x7a, 0xea, 0xa2, 0x11, 0x3d, 0xb7, 0x13, 0x8c, 0xd8,
-	0xdc, 0x2c, 0xa0, 0xc4, 0x7c, 0x3e, 0x83, 0x4e, 0x7e, 0x9b, 0x86, 0x5e, 0xbc, 0xad, 0x04, 0x25,
-	0xbf, 0xd1, 0x32, 0xba, 0xf3, 0xc8, 0x31, 0xeb, 0xa7, 0xd0, 0xce, 0x6e, 0x9d, 0x08, 0xba, 0x6f,
-	0xce, 0x92, 0xcc, 0xd8, 0x2d, 0x26, 0xc6, 0x0c, 0x3f, 0x80, 0x46, 0xbc, 0xd4, 0x11, 0x89, 0x9a,
-	0xdd, 0x2d, 0x89, 0x44, 0xcd, 0x6d, 0x7e, 0x68, 0x89, 0x8c, 0x61, 0x3d, 0xb5, 0x46, 0x11, 0xfe,
-	0x2a, 0xda, 0xf1, 0x08, 0x7f, 0x15, 0xee, 0x5c, 0xe8, 0x5d, 0x0c, 0xf0, 0x2d, 0xa3, 0x93, 0x0d,
-	0xb0, 0x28, 0x7f, 0x3c, 0x15, 0x4f, 0xa0, 0x95, 0xde, 0x78, 0x90, 0x9b, 0xe2, 0xfd, 0x2e, 0x58,
-	0xa6, 0x18, 0x46, 0x11, 0x29, 0xd6, 0xd9, 0x87, 0xf5, 0xd4, 0xe2, 0x42, 0xea, 0x5c, 0xb0, 0x0b,
-	0x91, 0x3a, 0x17, 0x6d, 0x39, 0xe8, 0xff, 0xa1, 0xce, 0xef, 0x1f, 0xbc, 0x9b, 0xd1, 0x59, 0xce,
-	0x3f, 0x87, 0xaf, 0x79, 0x03, 0xfb, 0x65, 0x94, 0x9c, 0x17, 0xb1, 0x9f, 0x44, 0x89, 0x4b, 0xf9,
-	0x29, 0xb5, 0xfc, 0x48, 0xf9, 0x29, 0xbd, 0xe0, 0xa0, 0xef, 0xa1, 0xcc, 0x3b, 0x86, 0x91, 0x91,
-	0x29, 0xe6, 0xc3, 0xc3, 0xd7, 0xae, 0x87, 0xd7, 0xf6, 0xa7, 0x00, 0xc9, 0x84, 0x27, 0xae, 0x6d,
-	0x6e, 0xc8, 0x14, 0xd7, 0x36, 0x3f, 0x08, 0xd2, 0x2e, 0xca, 0xd0, 0x49, 0xa7, 0xd8, 0x2e, 0x32,
-	0x4a, 0x22, 0x2e, 0x26, 0xa7, 0x54, 0xc4, 0xd5, 0x49, 0x2f, 0x1d, 0xf1, 0xd4, 0xac, 0x43, 0xf7,
-	0x50, 0x8a, 0x61, 0x6c, 0x67, 0x23, 0x8e, 0xc7, 0xb8, 0x11, 0x36, 0x0e, 0x0b, 0xc9, 0x0c, 0x22,
-	0xe4, 0x14, 0x8d, 0x30, 0x42, 0x4e, 0xe1, 0xc0, 0x12, 0x55, 0x3a, 0xd2, 0xcd, 0xca, 0x99, 0xf5,
-	0xd5, 0x62, 0x47, 0x9e, 0x41, 0x4d, 0x0c, 0x15, 0x64, 0x43, 0x32, 0x53, 0xf8, 0x13, 0x15, 0x25,
-	0x19, 0xbf, 0x83, 0x8c, 0x6f, 0x93, 0x45, 0x25, 0x94, 0xfc, 0x1c, 0x9a, 0x4a, 0x1f, 0x2e, 0xea,
-	0x74, 0x7e, 0x56, 0x10, 0x75, 0xba, 0xa0, 0x61, 0x9f, 0xeb, 0x25, 0xc6, 0x4f, 0xe1, 0xb5, 0x38,
-	0x86, 0x35, 0x75, 0x4e, 0x11, 0x45, 0xaf, 0x60, 0xa0, 0x31, 0xf4, 0x3c, 0x21, 0xbe, 0x10, 0x27,
-	0xd0, 0x4a, 0x37, 0xdc, 0xe2, 0x6e, 0x15, 0x76, 0xf3, 0xe2, 0x6e, 0x15, 0xf7, 0xe7, 0xb4, 0xc4,
-	0xf5, 0x51, 0x3b, 0x62, 0xa2, 0x3e, 0x41, 0xa9, 0xa2, 0xa4, 0xe7, 0x09, 0x31, 0x93, 0x53, 0xb8,
-	0x91, 0xe9, 0x16, 0xc5, 0xdb, 0x51, 0xdc, 0xf4, 0x8a, 0xb7, 0x63, 0x4e, 0x7b, 0x29, 0xac, 0x4b,
-	0xf7, 0x6c, 0xc2, 0xba, 0xc2, 0xb6, 0xd0, 0x30, 0x8a, 0x48, 0x31, 0xab, 0x9f, 0xe0, 0xb0, 0x98,
-	0x90, 0xe4, 0xc3, 0xd6, 0x95, 0xbe, 0xcd, 0x12, 0x22, 0xa6, 0x77, 0xe6, 0xd2, 0x63, 0xce, 0xcf,
-	0x81, 0xa4, 0x0e, 0x88, 0x84, 0xb9, 0x9d, 0xfb, 0x61, 0x2a, 0x6f, 0xba, 0xf3, 0xc8, 0x31, 0x5b,
-	0x2b, 0x7e, 0x86, 0xb2, 0xac, 0xef, 0x2a, 0xfe, 0x9f, 0xc3, 0x9e, 0x2e, 0x3a, 0x12, 0x89, 0x78,
-	0xa0, 0xff, 0xe5, 0x4d, 0x57, 0xfb, 0xe6, 0x4d, 0x57, 0xfb, 0xc7, 0x9b, 0xae, 0xf6, 0xdb, 0xb7,
-	0xdd, 0xd2, 0x37, 0x6f, 0xbb, 0xa5, 0xbf, 0xbd, 0xed, 0x96, 0xfa, 0x35, 0xfc, 0xfb, 0xc2, 0xff,
-	0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x85, 0xf5, 0x22, 0xfb, 0x02, 0x21, 0x00, 0x00,
+	// 2437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x59, 0x96, 0x9e, 0x3f, 0x22, 0x8f, 0x6d, 0x99, 0x66, 0x1c, 0xc5, 0xcb, 0xfd,
+	0x80, 0x61, 0x14, 0x31, 0xe2, 0xf6, 0xb4, 0xc0, 0x16, 0xdd, 0x48, 0xd9, 0xc4, 0xa8, 0xb3, 0xd9,
+	0xd2, 0x4e, 0xda, 0x45, 0x81, 0x6e, 0x29, 0x69, 0x24, 0x0b, 0xa6, 0x48, 0x86, 0xa4, 0xec, 0x35,
+	0x82, 0xed, 0xa1, 0xa7, 0x9e, 0xfa, 0x81, 0x2d, 0x76, 0x8f, 0x3d, 0xf4, 0x1f, 0xe8, 0x9f, 0xd1,
+	0x4b, 0x81, 0x05, 0x7a, 0xe9, 0xa5, 0x40, 0x91, 0xf4, 0xde, 0x7f, 0xa1, 0x98, 0x37, 0x43, 0x72,
+	0x86, 0xa4, 0x94, 0xd5, 0x02, 0x35, 0x7a, 0xd3, 0x7b, 0x6f, 0xf8, 0xde, 0xef, 0x7d, 0xcc, 0xcc,
+	0x9b, 0x67, 0xc3, 0x5a, 0x7f, 0x3c, 0xb6, 0xc3, 0x88, 0x06, 0xf7, 0xfc, 0xc0, 0x8b, 0x3c, 0x52,
+	0xf2, 0xbb, 0xc6, 0x5a, 0x7f, 0x7c, 0xe5, 0x05, 0x17, 0x31, 0xcf, 0xd8, 0x1d, 0x7a, 0xde, 0xd0,
+	0xa1, 0x87, 0xb6, 0x3f, 0x3a, 0xb4, 0x5d, 0xd7, 0x8b, 0xec, 0x68, 0xe4, 0xb9, 0x21, 0x97, 0x9a,
+	0xbf, 0x82, 0xc6, 0x69, 0x64, 0x07, 0xd1, 0x99, 0x1d, 0x5e, 0x58, 0xf4, 0xc5, 0x84, 0x86, 0x11,
+	0x21, 0x50, 0x89, 0xec, 0xf0, 0x42, 0xd7, 0xf6, 0xb4, 0xfd, 0xba, 0x85, 0xbf, 0x89, 0x0e, 0x4b,
+	0xa1, 0x37, 0x09, 0x7a, 0x34, 0xd4, 0x4b, 0x7b, 0xe5, 0xfd, 0xba, 0x15, 0x93, 0xa4, 0x05, 0x10,
+	0xd0, 0xb1, 0x77, 0x49, 0x9f, 0xd0, 0xc8, 0xd6, 0xcb, 0x7b, 0xda, 0x7e, 0xcd, 0x92, 0x38, 0x64,
+	0x17, 0xea, 0x21, 0x5a, 0x18, 0x8d, 0xa9, 0x5e, 0x41, 0x95, 0x29, 0xc3, 0xfc, 0x52, 0x83, 0x75,
+	0x09, 0x40, 0xe8, 0x7b, 0x6e, 0x48, 0x49, 0x13, 0xaa, 0x01, 0x0d, 0x27, 0x4e, 0x84, 0x18, 0x6a,
+	0x96, 0xa0, 0x48, 0x03, 0xca, 0xe3, 0x70, 0xa8, 0x97, 0x50, 0x0b, 0xfb, 0x49, 0x8e, 0x52, 0x5c,
+	0xe5, 0xbd, 0xf2, 0xfe, 0xf2, 0x91, 0x7e, 0xcf, 0xef, 0xde, 0x6b, 0x7b, 0xe3, 0xb1, 0xe7, 0xfe,
+	0x14, 0xc3, 0x10, 0x2b, 0x4d, 0x11, 0xef, 0xc1, 0x72, 0xef, 0x9c, 0xf6, 0x2e, 0x2c, 0x6e, 0x82,
+	0x63, 0x92, 0x59, 0xe6, 0x2f, 0x80, 0x3c, 0xf5, 0x69, 0x60, 0x47, 0x54, 0x8e, 0x8b, 0x01, 0x25,
+	0xcf, 0x47, 0x44, 0x6b, 0x47, 0xc0, 0xcc, 0x30, 0xe1, 0x53, 0xdf, 0x2a, 0x79, 0x3e, 0x8b, 0x99,
+	0x6b, 0x8f, 0xa9, 0x80, 0x86, 0xbf, 0x89, 0xae, 0x62, 0x4b, 0x63, 0x66, 0xfe, 0x4e, 0x83, 0x0d,
+	0xc5, 0x80, 0xf0, 0x7b, 0x96, 0x85, 0x34, 0x26, 0xa5, 0xa2, 0x98, 0x94, 0x0b, 0x63, 0x52, 0xf9,
+	0x96, 0x31, 0x31, 0x3f, 0x84, 0xf5, 0x67, 0x7e, 0x3f, 0xe3, 0xf0, 0x5c, 0x85, 0x60, 0xfe, 0x51,
+	0x03, 0x22, 0xeb, 0xf8, 0x3f, 0xc9, 0xe5, 0x47, 0xd0, 0xfc, 0xc9, 0x84, 0x06, 0xd7, 0xa7, 0x91,
+	0x1d, 0x4d, 0xc2, 0x93, 0x51, 0x18, 0x49, 0xee, 0x61, 0xce, 0xb4, 0xe2, 0x9c, 0x65, 0xdc, 0xbb,
+	0x84, 0xed, 0x9c, 0x9e, 0xb9, 0x5d, 0xbc, 0x9f, 0x75, 0x71, 0x9b, 0xb9, 0x28, 0xe9, 0xcd, 0x67,
+	0xa6, 0x0d, 0x1b, 0xa7, 0xe7, 0xde, 0x55, 0xa7, 0x73, 0x72, 0xe2, 0xf5, 0x2e, 0xc2, 0xef, 0x96,
+	0x9b, 0x3f, 0x69, 0xb0, 0x24, 0x34, 0x90, 0x35, 0x28, 0x1d, 0x77, 0xc4, 0x77, 0xa5, 0xe3, 0x4e,
+	0xa2, 0xa9, 0x24, 0x69, 0x22, 0x50, 0x19, 0x7b, 0x7d, 0x2a, 0xaa, 0x0a, 0x7f, 0x93, 0x4d, 0x58,
+	0xf4, 0xae, 0x5c, 0x1a, 0x88, 0x20, 0x73, 0x82, 0xad, 0xec, 0x74, 0x4e, 0x42, 0x7d, 0x11, 0x0d,
+	0xe2, 0x6f, 0x16, 0x8f, 0xf0, 0xda, 0xed, 0xd1, 0xbe, 0x5e, 0x45, 0xae, 0xa0, 0x88, 0x01, 0xb5,
+	0x89, 0x2b, 0x24, 0x4b, 0x28, 0x49, 0x68, 0xf3, 0x6f, 0x1a, 0xdc, 0x12, 0x08, 0xdb, 0x9e, 0x3b,
+	0x70, 0x46, 0xbd, 0xe8, 0x5b, 0x21, 0x65, 0xb6, 0xd0, 0x49, 0x81, 0x55, 0x50, 0x68, 0xcb, 0x3f,
+	0xed, 0x9d, 0xd3, 0xb1, 0x2d, 0x00, 0x27, 0x34, 0x8b, 0xd3, 0xc4, 0x3f, 0xb3, 0xbb, 0x0e, 0xd5,
+	0x17, 0x51, 0x14, 0x93, 0x89, 0x37, 0x55, 0xc9, 0x1b, 0x91, 0xc5, 0xa5, 0x34, 0x8b, 0x26, 0xac,
+	0x04, 0x34, 0xf4, 0x9c, 0x4b, 0xfa, 0x78, 0xe4, 0x46, 0xa1, 0x5e, 0xc3, 0xd5, 0x0a, 0xcf, 0xfc,
+	0x4a, 0x83, 0x4d, 0x35, 0x6f, 0x73, 0x17, 0xcb, 0x5b, 0xb0, 0xe8, 0xb0, 0x4f, 0x45, 0xa9, 0x2c,
+	0xb3, 0x52, 0x11, 0xea, 0x2c, 0x2e, 0x21, 0xf7, 0xa1, 0xde, 0x13, 0xd1, 0x8a, 0x37, 0xfb, 0x86,
+	0xb4, 0x2c, 0x8e, 0xa4, 0x95, 0xae, 0x32, 0xff, 0xa9, 0xc1, 0xe6, 0x33, 0x97, 0x7d, 0x1e, 0xeb,
+	0x12, 0x15, 0x95, 0x8d, 0x36, 0x7a, 0xe9, 0x3b, 0x76, 0x8f, 0x3e, 0xc5, 0xb4, 0x73, 0x64, 0x0a,
+	0x8f, 0x6d, 0xbf, 0x81, 0x17, 0xf4, 0xa8, 0x85, 0xe7, 0xbd, 0x38, 0xfd, 0x65, 0x16, 0x79, 0x1b,
+	0x8f, 0xb4, 0x0a, 0x1e, 0x69, 0x08, 0x4d, 0xb1, 0x2d, 0xce, 0x36, 0xa9, 0x70, 0x17, 0xd5, 0xdb,
+	0xc5, 0x80, 0x5a, 0xdf, 0x8e, 0xec, 0xae, 0x1d, 0x52, 0xbd, 0xca, 0xd3, 0x18, 0xd3, 0xac, 0x20,
+	0x23, 0x4c, 0x22, 0x4f, 0x0d, 0x27, 0xcc, 0x0f, 0x61, 0x2b, 0xe3, 0xde, 0xbc, 0x81, 0x37, 0x2d,
+	0xd8, 0x11, 0xa7, 0x73, 0x7c, 0xec, 0x38, 0xf6, 0x75, 0x1c, 0xa6, 0xdb, 0xd2, 0x19, 0x8d, 0x29,
+	0x41, 0x69, 0xde, 0x91, 0xcc, 0x0e, 0xfc, 0x5a, 0x03, 0xa3, 0x48, 0xa9, 0x00, 0x37, 0x53, 0xeb,
+	0xff, 0xf6, 0xe8, 0xff, 0x5a, 0x83, 0xed, 0x4f, 0x26, 0xc1, 0xb0, 0xc8, 0x59, 0xc9, 0x1f, 0x2d,
+	0x97, 0x98, 0x91, 0x6b, 0xf7, 0xa2, 0xd1, 0x25, 0x15, 0xa8, 0x12, 0x1a, 0xf7, 0x29, 0xbb, 0xed,
+	0x19, 0xb0, 0xb2, 0x85, 0xbf, 0xd9, 0xfa, 0xc1, 0xc8, 0xa1, 0x78, 0xe0, 0x8a, 0xfd, 0x18, 0xd3,
+	0xb8, 0x87, 0x27, 0xdd, 0xce, 0x28, 0x10, 0xdb, 0x51, 0x50, 0xe6, 0xe7, 0xa0, 0xe7, 0x81, 0xdd,
+	0xc4, 0xb5, 0x62, 0x5e, 0x42, 0xa3, 0xcd, 0xee, 0x90, 0x37, 0xdd, 0x86, 0x4d, 0xa8, 0xd2, 0x20,
+	0x68, 0xbb, 0x3c, 0x33, 0x65, 0x4b, 0x50, 0x2c, 0x6e, 0x57, 0x76, 0xe0, 0x32, 0x01, 0x0f, 0x42,
+	0x4c, 0xbe, 0xa1, 0x1d, 0xfa, 0x00, 0xd6, 0x25, 0xbb, 0x73, 0x17, 0xee, 0x6f, 0x34, 0xd8, 0x14,
+	0x45, 0x76, 0x8a, 0x9e, 0xc4, 0xd8, 0x77, 0xa5, 0xf2, 0x5a, 0x61, 0xee, 0x73, 0x71, 0x5a, 0x5f,
+	0xec, 0x7c, 0x18, 0x0d, 0x45, 0xd1, 0x0a, 0x8a, 0xe5, 0x8c, 0x07, 0xe4, 0xb8, 0x23, 0x3a, 0x98,
+	0x84, 0x66, 0x6d, 0x1f, 0x6f, 0x33, 0x3f, 0x4e, 0x33, 0x2a, 0x71, 0xcc, 0x09, 0x6c, 0x65, 0x90,
+	0xdc, 0x48, 0xe2, 0x1e, 0xc2, 0x96, 0x45, 0x87, 0xa3, 0x30, 0xa2, 0x41, 0xbc, 0x64, 0xe6, 0x65,
+	0x6f, 0xf7, 0xfb, 0x01, 0x0d, 0x43, 0x61, 0x36, 0x26, 0xcd, 0x07, 0xd0, 0xcc, 0xaa, 0x99, 0x3b,
+	0x19, 0x3f, 0x84, 0xcd, 0xa7, 0x83, 0x81, 0x33, 0x72, 0xe9, 0x13, 0x3a, 0xee, 0x2a, 0x48, 0xa2,
+	0x6b, 0x3f, 0x41, 0xc2, 0x7e, 0x17, 0xb5, 0x8f, 0xec, 0x20, 0xcb, 0x7c, 0x3f, 0x37, 0x84, 0x1f,
+	0x24, 0xe5, 0x70, 0x42, 0xed, 0x3e, 0x0d, 0xa6, 0x96, 0x03, 0x17, 0xf3, 0x72, 0x40, 0xc3, 0xea,
+	0x57, 0x73, 0x1b, 0xfe, 0xad, 0x06, 0xf0, 0x04, 0x5f, 0x26, 0xc7, 0xee, 0xc0, 0x2b, 0x0c, 0xbe,
+	0x01, 0xb5, 0x31, 0xfa, 0x75, 0xdc, 0xc1, 0x2f, 0x2b, 0x56, 0x42, 0xb3, 0x93, 0xdd, 0x76, 0x46,
+	0xc9, 0x85, 0xc2, 0x09, 0xf6, 0x85, 0x4f, 0x69, 0xf0, 0xcc, 0x3a, 0xe1, 0xa7, 0x5b, 0xdd, 0x4a,
+	0x68, 0x56, 0x8e, 0x3d, 0x67, 0x44, 0xdd, 0x08, 0xa5, 0xfc, 0x12, 0x91, 0x38, 0x66, 0x17, 0x80,
+	0x27, 0x72, 0x2a, 0x1e, 0x02, 0x15, 0x96, 0xfd, 0x38, 0x05, 0xec, 0x37, 0xc3, 0x11, 0x46, 0xf6,
+	0x30, 0xee, 0x2d, 0x38, 0x21, 0xb5, 0x1c, 0x15, 0xb9, 0xe5, 0x30, 0x4f, 0xa0, 0xc1, 0xda, 0x42,
+	0x1e, 0x34, 0x9e, 0xb3, 0x38, 0x34, 0x5a, 0x5a, 0xd5, 0x45, 0x2f, 0x85, 0xd8, 0x76, 0x39, 0xb5,
+	0x6d, 0x7e, 0xcc, 0xb5, 0xf1, 0x28, 0x4e, 0xd5, 0xb6, 0x0f, 0x4b, 0xfc, 0x05, 0xc8, 0x2f, 0x9c,
+	0xe5, 0xa3, 0x35, 0x96, 0xce, 0x34, 0xf4, 0x56, 0x2c, 0x8e, 0xf5, 0xf1, 0x28, 0xcc, 0xd2, 0xc7,
+	0x37, 0xb1, 0xa2, 0x2f, 0x0d, 0x9d, 0x15, 0x8b, 0xcd, 0x3f, 0x6b, 0xb0, 0xc4, 0xd5, 0x84, 0xe4,
+	0x1e, 0x54, 0x1d, 0xf4, 0x1a, 0x55, 0x2d, 0x1f, 0x6d, 0x62, 0x4d, 0x65, 0x62, 0xf1, 0x78, 0xc1,
+	0x12, 0xab, 0xd8, 0x7a, 0x0e, 0x4b, 0x2f, 0xa9, 0xeb, 0x65, 0x6f, 0xd9, 0x7a, 0xbe, 0x8a, 0xad,
+	0xe7, 0x66, 0xf5, 0xb2, 0xba, 0x5e, 0xf6, 0x86, 0xad, 0xe7, 0xab, 0x1e, 0xd4, 0xa0, 0xca, 0x6b,
+	0xc9, 0x7c, 0x01, 0xeb, 0xa8, 0x57, 0xd9, 0x81, 0x4d, 0x05, 0x6e, 0x2d, 0x81, 0xd5, 0x54, 0x60,
+	0xd5, 0x12, 0xf3, 0x4d, 0xc5, 0x7c, 0x2d, 0x36, 0xc3, 0xca, 0x83, 0xa5, 0x2f, 0xae, 0x46, 0x4e,
+	0x98, 0x14, 0x88, 0x6c, 0x72, 0xee, 0x63, 0xef, 0x5d, 0x58, 0xe2, 0xe0, 0x95, 0xc6, 0x4f, 0x84,
+	0xda, 0x8a, 0x65, 0xe6, 0x57, 0xa5, 0xf4, 0xac, 0xc7, 0xb6, 0x76, 0xfa, 0x59, 0x8f, 0xe2, 0xf4,
+	0xa1, 0x9a, 0xeb, 0xa1, 0xa7, 0x3e, 0x54, 0x95, 0xf6, 0xab, 0x32, 0xad, 0xfd, 0x5a, 0x94, 0xda,
+	0x2f, 0xdc, 0x1c, 0x68, 0x4f, 0xb4, 0x6b, 0x82, 0x62, 0xab, 0x07, 0xce, 0x24, 0x3c, 0xc7, 0x66,
+	0xad, 0x66, 0x71, 0x82, 0xa1, 0x61, 0xfd, 0xbf, 0x5e, 0x43, 0x26, 0xfe, 0x66, 0x5b, 0x79, 0x10,
+	0x78, 0x63, 0x7e, 0x6d, 0xe8, 0x75, 0x94, 0x48, 0x9c, 0x58, 0x7e, 0x66, 0x07, 0x43, 0x1a, 0xe9,
+	0x90, 0xca, 0x39, 0x47, 0xbe, 0x79, 0x44, 0x5c, 0x6e, 0xe4, 0xe6, 0x39, 0x80, 0xcd, 0x47, 0x34,
+	0x3a, 0x9d, 0x74, 0xd9, 0xdd, 0xdd, 0x1e, 0x0c, 0x67, 0x5c, 0x3c, 0xe6, 0x33, 0xd8, 0xca, 0xac,
+	0x9d, 0x1b, 0x22, 0x81, 0x4a, 0x6f, 0x30, 0x8c, 0x13, 0x86, 0xbf, 0xcd, 0x0e, 0xac, 0x3e, 0xa2,
+	0x91, 0x64, 0xfb, 0xae, 0x74, 0xd5, 0x88, 0xbe, 0xb2, 0x3d, 0x18, 0x9e, 0x5d, 0xfb, 0x74, 0xc6,
+	0xbd, 0x73, 0x02, 0x6b, 0xb1, 0x96, 0xb9, 0x51, 0x35, 0xa0, 0xdc, 0x1b, 0x24, 0x1d, 0x69, 0x6f,
+	0x30, 0x34, 0xb7, 0x60, 0xe3, 0x11, 0x15, 0xfb, 0x3a, 0x45, 0x66, 0xee, 0xc3, 0xa6, 0xca, 0x16,
+	0xa6, 0x84, 0x02, 0x2d, 0x55, 0xf0, 0x07, 0x0d, 0xc8, 0x63, 0xdb, 0xed, 0x3b, 0xf4, 0x61, 0x10,
+	0x78, 0xc1, 0xd4, 0x36, 0x1c, 0xa5, 0xdf, 0xa9, 0xc8, 0x77, 0xa1, 0xde, 0x1d, 0xb9, 0x8e, 0x37,
+	0xfc, 0xc4, 0x0b, 0xe3, 0x96, 0x2c, 0x61, 0x60, 0x89, 0xbe, 0x70, 0x92, 0x07, 0x2e, 0xfb, 0x6d,
+	0x86, 0xb0, 0xa1, 0x40, 0xba, 0x91, 0x02, 0x7b, 0x04, 0x5b, 0x67, 0x81, 0xed, 0x86, 0x03, 0x1a,
+	0xa8, 0xcd, 0x5d, 0x7a, 0x1f, 0x69, 0xca, 0x13, 0x38, 0x3d, 0xb6, 0xb8, 0x65, 0x41, 0xb1, 0xe6,
+	0x26, 0xab, 0x68, 0xee, 0x0b, 0xbe, 0x9f, 0x0c, 0xb0, 0x94, 0xf7, 0xc2, 0x1d, 0x29, 0x2b, 0xab,
+	0xd2, 0x33, 0xe6, 0xf9, 0x51, 0xdc, 0x68, 0x0a, 0xa4, 0xa5, 0x29, 0x48, 0x79, 0x6a, 0x62, 0xa4,
+	0x51, 0x72, 0xc4, 0xdd, 0x64, 0xf3, 0xff, 0x17, 0x0d, 0x9a, 0x38, 0x93, 0x7c, 0x6e, 0x3b, 0xa3,
+	0x3e, 0x8e, 0x4b, 0xd3, 0x0d, 0x05, 0x6c, 0x16, 0xf2, 0xd9, 0xa5, 0xed, 0x4c, 0x44, 0xb8, 0x1f,
+	0x2f, 0x58, 0x75, 0xc6, 0x7b, 0xce, 0x58, 0xe4, 0x00, 0x1a, 0xd8, 0xcd, 0x7f, 0xc6, 0x1e, 0x3d,
+	0x62, 0x19, 0xc2, 0x79, 0xac, 0x59, 0x6b, 0x49, 0x9f, 0xcf, 0xd7, 0xce, 0x3c, 0x76, 0x59, 0xcd,
+	0x4a, 0xad, 0x75, 0x42, 0x3f, 0xa8, 0xf2, 0xd1, 0xcc, 0x83, 0x65, 0xe9, 0x21, 0x61, 0x5e, 0xc1,
+	0x76, 0x0e, 0xf1, 0x8d, 0xc4, 0xea, 0x09, 0x6c, 0x9d, 0x46, 0x9e, 0x9f, 0x8f, 0xd4, 0xcc, 0x97,
+	0x63, 0xe2, 0x5c, 0x49, 0x75, 0xce, 0xbc, 0x84, 0x66, 0x56, 0xdd, 0x4d, 0xb8, 0x71, 0xf0, 0x23,
+	0xb8, 0x95, 0x99, 0x4b, 0x90, 0x75, 0x58, 0x3d, 0x76, 0x2f, 0x19, 0x10, 0xce, 0x68, 0x2c, 0x90,
+	0x15, 0xa8, 0x9d, 0x5e, 0x8c, 0x7c, 0x46, 0x37, 0x34, 0x46, 0x3d, 0xfc, 0x9c, 0xf6, 0x90, 0x2a,
+	0x1d, 0x74, 0xa1, 0x16, 0xbf, 0xa9, 0xc8, 0x06, 0xdc, 0x12, 0x9f, 0xc6, 0xac, 0xc6, 0x02, 0xb9,
+	0x05, 0xcb, 0x98, 0x22, 0xce, 0x6a, 0x68, 0xa4, 0x01, 0x2b, 0x7c, 0x5c, 0x2a, 0x38, 0x25, 0xb2,
+	0x06, 0xc0, 0xbc, 0x17, 0x74, 0x19, 0xe9, 0x73, 0xef, 0x4a, 0xd0, 0x95, 0x83, 0x1f, 0x43, 0x2d,
+	0x6e, 0xd4, 0x25, 0x1b, 0x31, 0xab, 0xb1, 0xc0, 0x30, 0x3f, 0xbc, 0x1c, 0xf5, 0xa2, 0x84, 0xa5,
+	0x91, 0x6d, 0xd8, 0x68, 0xdb, 0x6e, 0x8f, 0x3a, 0xaa, 0xa0, 0x74, 0xe0, 0xc2, 0x92, 0xb8, 0x0b,
+	0x18, 0x34, 0xa1, 0x8b, 0x91, 0xdc, 0x51, 0x76, 0x33, 0x21, 0xa5, 0x31, 0x18, 0xfc, 0xa0, 0x46,
+	0x1a, 0x61, 0xf2, 0x38, 0x22, 0xcd, 0x61, 0x22, 0x44, 0xa4, 0x2b, 0x64, 0x13, 0x1a, 0xf8, 0x35,
+	0x1d, 0xfb, 0x8e, 0x1d, 0x71, 0xee, 0xe2, 0x41, 0x07, 0xea, 0xc9, 0x61, 0xc0, 0x96, 0x08, 0x8b,
+	0x09, 0xaf, 0xb1, 0xc0, 0x22, 0x82, 0x21, 0x42, 0xde, 0xf3, 0xa3, 0x86, 0xc6, 0x83, 0xe6, 0xf9,
+	0x31, 0xa3, 0x74, 0xf4, 0x9f, 0x75, 0xa8, 0x72, 0x30, 0xe4, 0x53, 0xa8, 0x27, 0x7f, 0x39, 0x20,
+	0xd8, 0x11, 0x66, 0xff, 0x92, 0x61, 0x6c, 0x65, 0xb8, 0x3c, 0xed, 0xe6, 0xdd, 0x5f, 0xff, 0xfd,
+	0xdf, 0x5f, 0x96, 0x76, 0xcc, 0x4d, 0xf6, 0x47, 0x91, 0xf0, 0xf0, 0xf2, 0xbe, 0xed, 0xf8, 0xe7,
+	0xf6, 0xfd, 0x43, 0x56, 0x86, 0xe1, 0xfb, 0xda, 0x01, 0x19, 0xc0, 0xb2, 0x34, 0x9e, 0x27, 0x4d,
+	0xa6, 0x26, 0xff, 0x07, 0x01, 0x63, 0x3b, 0xc7, 0x17, 0x06, 0xde, 0x43, 0x03, 0x7b, 0xc6, 0xed,
+	0x22, 0x03, 0x87, 0x2f, 0xd9, 0x35, 0xfb, 0x05, 0xb3, 0xf3, 0x01, 0x40, 0x3a, 0x31, 0x27, 0x88,
+	0x36, 0x37, 0x85, 0x37, 0x9a, 0x59, 0xb6, 0x30, 0xb2, 0x40, 0x1c, 0x58, 0x96, 0x46, 0xc7, 0xc4,
+	0xc8, 0xcc, 0x92, 0xa5, 0x59, 0xb7, 0x71, 0xbb, 0x50, 0x26, 0x34, 0xbd, 0x83, 0x70, 0x5b, 0x64,
+	0x37, 0x03, 0x37, 0xc4, 0xa5, 0x02, 0x2f, 0x69, 0xc3, 0x8a, 0x3c, 0xd0, 0x24, 0xe8, 0x7d, 0xc1,
+	0x68, 0xda, 0xd0, 0xf3, 0x82, 0x04, 0xf2, 0x47, 0xb0, 0xaa, 0x6c, 0x34, 0xa2, 0xe7, 0x66, 0x82,
+	0xb1, 0x9a, 0x9d, 0x02, 0x49, 0xa2, 0xe7, 0x53, 0x68, 0xe6, 0xa7, 0x69, 0x18, 0xc5, 0x3b, 0x52,
+	0x52, 0xf2, 0x13, 0x2d, 0xa3, 0x35, 0x4d, 0x9c, 0xa8, 0x7e, 0x0a, 0x8d, 0xec, 0xd4, 0x89, 0x60,
+	0xf8, 0xa6, 0x0c, 0xc9, 0x8c, 0xdd, 0x62, 0x61, 0xa2, 0xf0, 0x7d, 0xa8, 0x27, 0x43, 0x1d, 0x5e,
+	0xa8, 0xd9, 0xd9, 0x92, 0xb1, 0x95, 0xe1, 0x26, 0xdf, 0x0e, 0x61, 0x55, 0x19, 0xa3, 0xf0, 0x78,
+	0x15, 0xcd, 0x78, 0x8c, 0x9d, 0x02, 0x89, 0xd0, 0xf3, 0x16, 0x26, 0xf8, 0xb6, 0xd1, 0xcc, 0x26,
+	0x18, 0x97, 0x61, 0xc9, 0x1f, 0xc3, 0x9a, 0x3a, 0xf1, 0x20, 0x3b, 0xfc, 0xfe, 0x2e, 0x18, 0xa6,
+	0x18, 0x46, 0x91, 0x28, 0xc1, 0x1c, 0xc0, 0xaa, 0x32, 0xb8, 0x10, 0x98, 0x0b, 0x66, 0x21, 0xc6,
+	0x4e, 0x81, 0x44, 0xe8, 0xf9, 0x1e, 0x62, 0x7e, 0xef, 0xe0, 0x9d, 0x0c, 0x66, 0xf1, 0xfe, 0x39,
+	0x7c, 0xc9, 0x1a, 0xd8, 0x2f, 0xe2, 0xe2, 0xbc, 0x48, 0xe2, 0xc4, 0x8f, 0x38, 0x25, 0x4e, 0xca,
+	0xf0, 0xc3, 0xd8, 0x29, 0x90, 0x08, 0x9b, 0xef, 0xa2, 0xcd, 0xbb, 0x86, 0x91, 0xb1, 0xc9, 0xdf,
+	0x87, 0x87, 0x2f, 0x3d, 0x1f, 0xb7, 0xed, 0xcf, 0x01, 0xd2, 0x17, 0x1e, 0xdf, 0xb6, 0xb9, 0x47,
+	0xa6, 0xd1, 0xcc, 0xb2, 0x85, 0x8d, 0x16, 0xda, 0xd0, 0x49, 0xb3, 0xd8, 0x2f, 0x32, 0x80, 0x55,
+	0xe5, 0xf9, 0xa2, 0x66, 0x5c, 0x7e, 0xe9, 0x19, 0x3b, 0x05, 0x12, 0x61, 0x65, 0x0f, 0xad, 0x18,
+	0xc6, 0x56, 0x36, 0xe3, 0xb8, 0x8c, 0x39, 0xe1, 0xc0, 0xaa, 0xf2, 0x06, 0xe1, 0x76, 0x8a, 0x9e,
+	0x30, 0xc6, 0x4e, 0x81, 0x44, 0x3d, 0xe9, 0x48, 0x2b, 0x6b, 0x67, 0xd2, 0x95, 0x0f, 0x3b, 0x72,
+	0x06, 0x55, 0xfe, 0xa8, 0x20, 0xeb, 0x42, 0x99, 0xa4, 0x9f, 0xc8, 0x2c, 0xa1, 0xf8, 0x6d, 0x54,
+	0x7c, 0x87, 0xcc, 0x3a, 0x42, 0xc9, 0x2f, 0x61, 0x59, 0xea, 0xc3, 0xf9, 0x39, 0x9d, 0x7f, 0x2b,
+	0x18, 0xdb, 0x39, 0xfe, 0x1b, 0xa2, 0x44, 0xd9, 0x2a, 0xdc, 0x16, 0x6d, 0x58, 0x91, 0xdf, 0x29,
+	0xfc, 0xd0, 0x2b, 0x78, 0xd0, 0x18, 0x7a, 0x5e, 0x90, 0x6c, 0x88, 0x63, 0x58, 0x53, 0x1b, 0x6e,
+	0xbe, 0xb7, 0x0a, 0xbb, 0x79, 0xc3, 0x28, 0x12, 0x25, 0xaa, 0xda, 0xb0, 0x22, 0x77, 0xc4, 0x44,
+	0xbe, 0x82, 0x94, 0x43, 0x49, 0xcf, 0x0b, 0x12, 0x25, 0x27, 0x70, 0x2b, 0xd3, 0x2d, 0xf2, 0xbb,
+	0xa3, 0xb8, 0xe9, 0x35, 0x6e, 0x17, 0xca, 0x64, 0xef, 0xd4, 0x9e, 0x8d, 0x7b, 0x57, 0xd8, 0x16,
+	0x1a, 0x46, 0x91, 0x28, 0x51, 0xf5, 0x33, 0x7c, 0x2c, 0xa6, 0x22, 0x71, 0xb1, 0xb5, 0x44, 0x6c,
+	0xb3, 0x82, 0x58, 0xe9, 0xdd, 0xa9, 0xf2, 0x44, 0xf3, 0x33, 0x20, 0xca, 0x02, 0x5e, 0x30, 0x77,
+	0x72, 0x1f, 0x2a, 0x75, 0xd3, 0x9a, 0x26, 0x4e, 0xd4, 0xda, 0xc9, 0x35, 0x94, 0x55, 0xfd, 0x96,
+	0x14, 0xff, 0x29, 0xea, 0xcd, 0x59, 0x4b, 0x62, 0x13, 0x0f, 0xf4, 0xbf, 0xbe, 0x6a, 0x69, 0xdf,
+	0xbc, 0x6a, 0x69, 0xff, 0x7a, 0xd5, 0xd2, 0x7e, 0xff, 0xba, 0xb5, 0xf0, 0xcd, 0xeb, 0xd6, 0xc2,
+	0x3f, 0x5e, 0xb7, 0x16, 0xba, 0x55, 0xfc, 0x17, 0x8e, 0xef, 0xff, 0x77, 0x00, 0x9c, 0xab, 0x70,
+	0xcb, 0x06, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5278,6 +5400,89 @@ func (m *DDLLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DDLLockConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DDLLockConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DDLLockConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResolveHints) > 0 {
+		for iNdEx := len(m.ResolveHints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ResolveHints[iNdEx])
+			copy(dAtA[i:], m.ResolveHints[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ResolveHints[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DDLs) > 0 {
+		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DDLs[iNdEx])
+			copy(dAtA[i:], m.DDLs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UpTable) > 0 {
+		i -= len(m.UpTable)
+		copy(dAtA[i:], m.UpTable)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.UpTable)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UpSchema) > 0 {
+		i -= len(m.UpSchema)
+		copy(dAtA[i:], m.UpSchema)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.UpSchema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShowDDLLocksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5298,6 +5503,20 @@ func (m *ShowDDLLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7683,31 +7902,82 @@ func (m *DDLLock) Size() (n int) {
 	return n
 }
 
-func (m *ShowDDLLocksResponse) Size() (n int) {
+func (m *DDLLockConflict) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
 	}
-	l = len(m.Msg)
+	l = len(m.Task)
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
-	if len(m.Locks) > 0 {
-		for _, e := range m.Locks {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
 	}
-	return n
-}
-
-func (m *UnlockDDLLockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.UpSchema)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.UpTable)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.DDLs) > 0 {
+		for _, s := range m.DDLs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.ResolveHints) > 0 {
+		for _, s := range m.ResolveHints {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShowDDLLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockDDLLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -10192,6 +10462,312 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DDLLockConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDLLockConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDLLockConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveHints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolveHints = append(m.ResolveHints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10307,6 +10883,40 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &DDLLockConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
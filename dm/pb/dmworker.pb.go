@@ -774,21 +774,93 @@ func (m *DumpStatus) GetProgress() string {
 	return ""
 }
 
+// LoadResumeProgress represents the progress in lightning checkpoint which the import is resumed from
+// importedEngines, totalEngines: imported data engines and all data engines
+// finishedChunks, totalChunks: finished chunks and all chunks of the data engines
+type LoadResumeProgress struct {
+	ImportedEngines int64 `protobuf:"varint,1,opt,name=importedEngines,proto3" json:"importedEngines,omitempty"`
+	TotalEngines    int64 `protobuf:"varint,2,opt,name=totalEngines,proto3" json:"totalEngines,omitempty"`
+	FinishedChunks  int64 `protobuf:"varint,3,opt,name=finishedChunks,proto3" json:"finishedChunks,omitempty"`
+	TotalChunks     int64 `protobuf:"varint,4,opt,name=totalChunks,proto3" json:"totalChunks,omitempty"`
+}
+
+func (m *LoadResumeProgress) Reset()         { *m = LoadResumeProgress{} }
+func (m *LoadResumeProgress) String() string { return proto.CompactTextString(m) }
+func (*LoadResumeProgress) ProtoMessage()    {}
+func (*LoadResumeProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{5}
+}
+func (m *LoadResumeProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadResumeProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadResumeProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LoadResumeProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadResumeProgress.Merge(m, src)
+}
+func (m *LoadResumeProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadResumeProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadResumeProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadResumeProgress proto.InternalMessageInfo
+
+func (m *LoadResumeProgress) GetImportedEngines() int64 {
+	if m != nil {
+		return m.ImportedEngines
+	}
+	return 0
+}
+
+func (m *LoadResumeProgress) GetTotalEngines() int64 {
+	if m != nil {
+		return m.TotalEngines
+	}
+	return 0
+}
+
+func (m *LoadResumeProgress) GetFinishedChunks() int64 {
+	if m != nil {
+		return m.FinishedChunks
+	}
+	return 0
+}
+
+func (m *LoadResumeProgress) GetTotalChunks() int64 {
+	if m != nil {
+		return m.TotalChunks
+	}
+	return 0
+}
+
 // LoadStatus represents status for load unit
 type LoadStatus struct {
-	FinishedBytes  int64  `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
-	TotalBytes     int64  `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	Progress       string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	MetaBinlog     string `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
-	MetaBinlogGTID string `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
-	Bps            int64  `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	FinishedBytes  int64               `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	TotalBytes     int64               `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Progress       string              `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	MetaBinlog     string              `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
+	MetaBinlogGTID string              `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	Bps            int64               `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	ResumeProgress *LoadResumeProgress `protobuf:"bytes,7,opt,name=resumeProgress,proto3" json:"resumeProgress,omitempty"`
 }
 
 func (m *LoadStatus) Reset()         { *m = LoadStatus{} }
 func (m *LoadStatus) String() string { return proto.CompactTextString(m) }
 func (*LoadStatus) ProtoMessage()    {}
 func (*LoadStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{5}
+	return fileDescriptor_51a1b9e17fd67b10, []int{6}
 }
 func (m *LoadStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +931,13 @@ func (m *LoadStatus) GetBps() int64 {
 	return 0
 }
 
+func (m *LoadStatus) GetResumeProgress() *LoadResumeProgress {
+	if m != nil {
+		return m.ResumeProgress
+	}
+	return nil
+}
+
 // ShardingGroup represents a DDL sharding group, this is used by SyncStatus, and is differ from ShardingGroup in syncer pkg
 // target: target table name
 // DDL: in syncing DDL
@@ -877,7 +956,7 @@ func (m *ShardingGroup) Reset()         { *m = ShardingGroup{} }
 func (m *ShardingGroup) String() string { return proto.CompactTextString(m) }
 func (*ShardingGroup) ProtoMessage()    {}
 func (*ShardingGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{6}
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
 }
 func (m *ShardingGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,7 +1046,7 @@ func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
 func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
 func (*SyncStatus) ProtoMessage()    {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+	return fileDescriptor_51a1b9e17fd67b10, []int{8}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1206,7 @@ func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
 func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
 func (*SourceStatus) ProtoMessage()    {}
 func (*SourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+	return fileDescriptor_51a1b9e17fd67b10, []int{9}
 }
 func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,7 +1279,7 @@ func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
 func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
 func (*RelayStatus) ProtoMessage()    {}
 func (*RelayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{9}
+	return fileDescriptor_51a1b9e17fd67b10, []int{10}
 }
 func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,7 +1398,7 @@ func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
 func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatus) ProtoMessage()    {}
 func (*SubTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{10}
+	return fileDescriptor_51a1b9e17fd67b10, []int{11}
 }
 func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1480,7 +1559,7 @@ func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
 func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatusList) ProtoMessage()    {}
 func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+	return fileDescriptor_51a1b9e17fd67b10, []int{12}
 }
 func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1526,7 +1605,7 @@ func (m *CheckError) Reset()         { *m = CheckError{} }
 func (m *CheckError) String() string { return proto.CompactTextString(m) }
 func (*CheckError) ProtoMessage()    {}
 func (*CheckError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{12}
+	return fileDescriptor_51a1b9e17fd67b10, []int{13}
 }
 func (m *CheckError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1572,7 +1651,7 @@ func (m *DumpError) Reset()         { *m = DumpError{} }
 func (m *DumpError) String() string { return proto.CompactTextString(m) }
 func (*DumpError) ProtoMessage()    {}
 func (*DumpError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+	return fileDescriptor_51a1b9e17fd67b10, []int{14}
 }
 func (m *DumpError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1617,7 +1696,7 @@ func (m *LoadError) Reset()         { *m = LoadError{} }
 func (m *LoadError) String() string { return proto.CompactTextString(m) }
 func (*LoadError) ProtoMessage()    {}
 func (*LoadError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{14}
+	return fileDescriptor_51a1b9e17fd67b10, []int{15}
 }
 func (m *LoadError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1743,7 @@ func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
 func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
 func (*SyncSQLError) ProtoMessage()    {}
 func (*SyncSQLError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{15}
+	return fileDescriptor_51a1b9e17fd67b10, []int{16}
 }
 func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1802,7 @@ func (m *SyncError) Reset()         { *m = SyncError{} }
 func (m *SyncError) String() string { return proto.CompactTextString(m) }
 func (*SyncError) ProtoMessage()    {}
 func (*SyncError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{16}
+	return fileDescriptor_51a1b9e17fd67b10, []int{17}
 }
 func (m *SyncError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1771,7 +1850,7 @@ func (m *SourceError) Reset()         { *m = SourceError{} }
 func (m *SourceError) String() string { return proto.CompactTextString(m) }
 func (*SourceError) ProtoMessage()    {}
 func (*SourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{17}
+	return fileDescriptor_51a1b9e17fd67b10, []int{18}
 }
 func (m *SourceError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +1916,7 @@ func (m *RelayError) Reset()         { *m = RelayError{} }
 func (m *RelayError) String() string { return proto.CompactTextString(m) }
 func (*RelayError) ProtoMessage()    {}
 func (*RelayError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{18}
+	return fileDescriptor_51a1b9e17fd67b10, []int{19}
 }
 func (m *RelayError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,7 +1977,7 @@ func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
 func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
 func (*SubTaskError) ProtoMessage()    {}
 func (*SubTaskError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{19}
+	return fileDescriptor_51a1b9e17fd67b10, []int{20}
 }
 func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2038,7 +2117,7 @@ func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
 func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskErrorList) ProtoMessage()    {}
 func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{20}
+	return fileDescriptor_51a1b9e17fd67b10, []int{21}
 }
 func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2169,7 @@ func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
 func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
 func (*ProcessResult) ProtoMessage()    {}
 func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+	return fileDescriptor_51a1b9e17fd67b10, []int{22}
 }
 func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,7 +2236,7 @@ func (m *ProcessError) Reset()         { *m = ProcessError{} }
 func (m *ProcessError) String() string { return proto.CompactTextString(m) }
 func (*ProcessError) ProtoMessage()    {}
 func (*ProcessError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{22}
+	return fileDescriptor_51a1b9e17fd67b10, []int{23}
 }
 func (m *ProcessError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2330,7 @@ func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
 func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeRelayRequest) ProtoMessage()    {}
 func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{23}
+	return fileDescriptor_51a1b9e17fd67b10, []int{24}
 }
 func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2325,7 +2404,7 @@ func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaR
 func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
 func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{24}
+	return fileDescriptor_51a1b9e17fd67b10, []int{25}
 }
 func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2515,7 @@ func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
 func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*V1SubTaskMeta) ProtoMessage()    {}
 func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{25}
+	return fileDescriptor_51a1b9e17fd67b10, []int{26}
 }
 func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2501,7 +2580,7 @@ func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
 func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaRequest) ProtoMessage()    {}
 func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{26}
+	return fileDescriptor_51a1b9e17fd67b10, []int{27}
 }
 func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2547,7 +2626,7 @@ func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
 func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaResponse) ProtoMessage()    {}
 func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{27}
+	return fileDescriptor_51a1b9e17fd67b10, []int{28}
 }
 func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2608,7 +2687,7 @@ func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorReque
 func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleWorkerErrorRequest) ProtoMessage()    {}
 func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{28}
+	return fileDescriptor_51a1b9e17fd67b10, []int{29}
 }
 func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2672,7 +2751,7 @@ func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
 func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgRequest) ProtoMessage()    {}
 func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{29}
+	return fileDescriptor_51a1b9e17fd67b10, []int{30}
 }
 func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2709,7 +2788,7 @@ func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
 func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgResponse) ProtoMessage()    {}
 func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{30}
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
 }
 func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2753,7 +2832,7 @@ func (m *CheckSubtasksCanUpdateRequest) Reset()         { *m = CheckSubtasksCanU
 func (m *CheckSubtasksCanUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateRequest) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{31}
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
 }
 func (m *CheckSubtasksCanUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2798,7 +2877,7 @@ func (m *CheckSubtasksCanUpdateResponse) Reset()         { *m = CheckSubtasksCan
 func (m *CheckSubtasksCanUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateResponse) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{32}
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
 }
 func (m *CheckSubtasksCanUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2850,7 +2929,7 @@ func (m *GetValidationStatusRequest) Reset()         { *m = GetValidationStatusR
 func (m *GetValidationStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusRequest) ProtoMessage()    {}
 func (*GetValidationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{33}
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
 }
 func (m *GetValidationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2910,7 +2989,7 @@ func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
 func (m *ValidationStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationStatus) ProtoMessage()    {}
 func (*ValidationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
 }
 func (m *ValidationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3021,7 +3100,7 @@ func (m *ValidationTableStatus) Reset()         { *m = ValidationTableStatus{} }
 func (m *ValidationTableStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationTableStatus) ProtoMessage()    {}
 func (*ValidationTableStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
 }
 func (m *ValidationTableStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3175,7 @@ func (m *GetValidationStatusResponse) Reset()         { *m = GetValidationStatus
 func (m *GetValidationStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusResponse) ProtoMessage()    {}
 func (*GetValidationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+	return fileDescriptor_51a1b9e17fd67b10, []int{37}
 }
 func (m *GetValidationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3162,7 +3241,7 @@ func (m *GetValidationErrorRequest) Reset()         { *m = GetValidationErrorReq
 func (m *GetValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorRequest) ProtoMessage()    {}
 func (*GetValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{37}
+	return fileDescriptor_51a1b9e17fd67b10, []int{38}
 }
 func (m *GetValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3222,7 +3301,7 @@ func (m *ValidationError) Reset()         { *m = ValidationError{} }
 func (m *ValidationError) String() string { return proto.CompactTextString(m) }
 func (*ValidationError) ProtoMessage()    {}
 func (*ValidationError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{38}
+	return fileDescriptor_51a1b9e17fd67b10, []int{39}
 }
 func (m *ValidationError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3331,7 +3410,7 @@ func (m *GetValidationErrorResponse) Reset()         { *m = GetValidationErrorRe
 func (m *GetValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorResponse) ProtoMessage()    {}
 func (*GetValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{39}
+	return fileDescriptor_51a1b9e17fd67b10, []int{40}
 }
 func (m *GetValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3392,7 +3471,7 @@ func (m *OperateValidationErrorRequest) Reset()         { *m = OperateValidation
 func (m *OperateValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorRequest) ProtoMessage()    {}
 func (*OperateValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{40}
+	return fileDescriptor_51a1b9e17fd67b10, []int{41}
 }
 func (m *OperateValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3458,7 +3537,7 @@ func (m *OperateValidationErrorResponse) Reset()         { *m = OperateValidatio
 func (m *OperateValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorResponse) ProtoMessage()    {}
 func (*OperateValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{41}
+	return fileDescriptor_51a1b9e17fd67b10, []int{42}
 }
 func (m *OperateValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3517,6 +3596,7 @@ func init() {
 	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
 	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
 	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
+	proto.RegisterType((*LoadResumeProgress)(nil), "pb.LoadResumeProgress")
 	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
 	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
 	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
@@ -3560,187 +3640,192 @@ func init() {
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2880 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcd, 0x6f, 0x24, 0x57,
-	0xf1, 0xd3, 0xdd, 0xf3, 0x59, 0x33, 0xb6, 0xdb, 0x6f, 0xbd, 0xfb, 0x9b, 0x38, 0xbb, 0x13, 0xa7,
-	0x37, 0xca, 0xcf, 0xb1, 0x82, 0x95, 0x98, 0xa0, 0xa0, 0x48, 0x90, 0x64, 0xed, 0x8d, 0x77, 0x83,
-	0x37, 0xde, 0x6d, 0x3b, 0xcb, 0x09, 0x89, 0x76, 0xcf, 0xf3, 0xb8, 0x71, 0x4f, 0x77, 0x6f, 0x77,
-	0x8f, 0x2d, 0x1f, 0x10, 0x17, 0xc4, 0x15, 0x2e, 0x20, 0x81, 0xb8, 0x80, 0x84, 0xc4, 0x89, 0x03,
-	0x7f, 0x00, 0x47, 0xc8, 0x31, 0xe2, 0xc4, 0x11, 0x65, 0xff, 0x06, 0xae, 0x08, 0x55, 0xbd, 0xf7,
-	0xba, 0x5f, 0xcf, 0x87, 0x37, 0x8b, 0xc4, 0xad, 0xeb, 0xe3, 0x55, 0xd5, 0xab, 0xaf, 0x57, 0x35,
-	0x36, 0x2c, 0x0f, 0xc7, 0x97, 0x71, 0x7a, 0xce, 0xd3, 0xed, 0x24, 0x8d, 0xf3, 0x98, 0x99, 0xc9,
-	0x89, 0xb3, 0x09, 0xec, 0xc9, 0x84, 0xa7, 0x57, 0x47, 0xb9, 0x97, 0x4f, 0x32, 0x97, 0x3f, 0x9b,
-	0xf0, 0x2c, 0x67, 0x0c, 0xea, 0x91, 0x37, 0xe6, 0x7d, 0x63, 0xc3, 0xd8, 0xec, 0xb8, 0xf4, 0xed,
-	0x24, 0xb0, 0xb6, 0x1b, 0x8f, 0xc7, 0x71, 0xf4, 0x7d, 0x92, 0xe1, 0xf2, 0x2c, 0x89, 0xa3, 0x8c,
-	0xb3, 0x5b, 0xd0, 0x4c, 0x79, 0x36, 0x09, 0x73, 0xe2, 0x6e, 0xbb, 0x12, 0x62, 0x36, 0x58, 0xe3,
-	0x6c, 0xd4, 0x37, 0x49, 0x04, 0x7e, 0x22, 0x67, 0x16, 0x4f, 0x52, 0x9f, 0xf7, 0x2d, 0x42, 0x4a,
-	0x08, 0xf1, 0xc2, 0xae, 0x7e, 0x5d, 0xe0, 0x05, 0xe4, 0xfc, 0xc9, 0x80, 0x1b, 0x15, 0xe3, 0x5e,
-	0x5a, 0xe3, 0x7b, 0xd0, 0x13, 0x3a, 0x84, 0x04, 0xd2, 0xdb, 0xdd, 0xb1, 0xb7, 0x93, 0x93, 0xed,
-	0x23, 0x0d, 0xef, 0x56, 0xb8, 0xd8, 0xfb, 0xb0, 0x94, 0x4d, 0x4e, 0x8e, 0xbd, 0xec, 0x5c, 0x1e,
-	0xab, 0x6f, 0x58, 0x9b, 0xdd, 0x9d, 0x55, 0x3a, 0xa6, 0x13, 0xdc, 0x2a, 0x9f, 0xf3, 0x07, 0x03,
-	0xba, 0xbb, 0x67, 0xdc, 0x97, 0x30, 0x1a, 0x9a, 0x78, 0x59, 0xc6, 0x87, 0xca, 0x50, 0x01, 0xb1,
-	0x35, 0x68, 0xe4, 0x71, 0xee, 0x85, 0x64, 0x6a, 0xc3, 0x15, 0x00, 0x1b, 0x00, 0x64, 0x13, 0xdf,
-	0xe7, 0x59, 0x76, 0x3a, 0x09, 0xc9, 0xd4, 0x86, 0xab, 0x61, 0x50, 0xda, 0xa9, 0x17, 0x84, 0x7c,
-	0x48, 0x6e, 0x6a, 0xb8, 0x12, 0x62, 0x7d, 0x68, 0x5d, 0x7a, 0x69, 0x14, 0x44, 0xa3, 0x7e, 0x83,
-	0x08, 0x0a, 0xc4, 0x13, 0x43, 0x9e, 0x7b, 0x41, 0xd8, 0x6f, 0x6e, 0x18, 0x9b, 0x3d, 0x57, 0x42,
-	0xce, 0xbf, 0x0d, 0x80, 0xbd, 0xc9, 0x38, 0x91, 0x66, 0x6e, 0x40, 0x97, 0x2c, 0x38, 0xf6, 0x4e,
-	0x42, 0x9e, 0x91, 0xad, 0x96, 0xab, 0xa3, 0xd8, 0x26, 0xac, 0xf8, 0xf1, 0x38, 0x09, 0x79, 0xce,
-	0x87, 0x92, 0x0b, 0x4d, 0x37, 0xdc, 0x69, 0x34, 0x7b, 0x03, 0x96, 0x4e, 0x83, 0x28, 0xc8, 0xce,
-	0xf8, 0xf0, 0xde, 0x55, 0xce, 0x85, 0xcb, 0x0d, 0xb7, 0x8a, 0x64, 0x0e, 0xf4, 0x14, 0xc2, 0x8d,
-	0x2f, 0x33, 0xba, 0x90, 0xe1, 0x56, 0x70, 0xec, 0x6d, 0x58, 0xe5, 0x59, 0x1e, 0x8c, 0xbd, 0x9c,
-	0x1f, 0xa3, 0x29, 0xc4, 0xd8, 0x20, 0xc6, 0x59, 0x02, 0xc6, 0xfe, 0x24, 0xc9, 0xe8, 0x9e, 0x96,
-	0x8b, 0x9f, 0x6c, 0x1d, 0xda, 0x49, 0x1a, 0x8f, 0x52, 0x9e, 0x65, 0xfd, 0x16, 0xa5, 0x44, 0x01,
-	0x3b, 0x5f, 0x18, 0x00, 0x07, 0xb1, 0x37, 0x94, 0x0e, 0x98, 0x31, 0x5a, 0xb8, 0x60, 0xca, 0xe8,
-	0x01, 0x00, 0xf9, 0x44, 0xb0, 0x98, 0xc4, 0xa2, 0x61, 0x2a, 0x0a, 0xad, 0xaa, 0x42, 0x3c, 0x3b,
-	0xe6, 0xb9, 0x77, 0x2f, 0x88, 0xc2, 0x78, 0x24, 0xd3, 0x5c, 0xc3, 0xb0, 0x37, 0x61, 0xb9, 0x84,
-	0xf6, 0x8f, 0x1f, 0xee, 0xd1, 0x4d, 0x3b, 0xee, 0x14, 0x76, 0xf6, 0x9a, 0xce, 0x2f, 0x0d, 0x58,
-	0x3a, 0x3a, 0xf3, 0xd2, 0x61, 0x10, 0x8d, 0xf6, 0xd3, 0x78, 0x92, 0x60, 0xd4, 0x73, 0x2f, 0x1d,
-	0xf1, 0x5c, 0x96, 0xaf, 0x84, 0xb0, 0xa8, 0xf7, 0xf6, 0x0e, 0xd0, 0x72, 0x0b, 0x8b, 0x1a, 0xbf,
-	0xc5, 0xcd, 0xd3, 0x2c, 0x3f, 0x88, 0x7d, 0x2f, 0x0f, 0xe2, 0x48, 0x1a, 0x5e, 0x45, 0x52, 0xe1,
-	0x5e, 0x45, 0x3e, 0x65, 0x9e, 0x45, 0x85, 0x4b, 0x10, 0xde, 0x78, 0x12, 0x49, 0x4a, 0x83, 0x28,
-	0x05, 0xec, 0xfc, 0xab, 0x0e, 0x70, 0x74, 0x15, 0xf9, 0x53, 0x39, 0x76, 0xff, 0x82, 0x47, 0x79,
-	0x35, 0xc7, 0x04, 0x0a, 0x85, 0x89, 0x94, 0x4b, 0x94, 0x73, 0x0b, 0x98, 0xdd, 0x86, 0x4e, 0xca,
-	0x7d, 0x1e, 0xe5, 0x48, 0xb4, 0x88, 0x58, 0x22, 0x30, 0x9b, 0xc6, 0x5e, 0x96, 0xf3, 0xb4, 0xe2,
-	0xde, 0x0a, 0x8e, 0x6d, 0x81, 0xad, 0xc3, 0xfb, 0x79, 0x30, 0x94, 0x2e, 0x9e, 0xc1, 0xa3, 0x3c,
-	0xba, 0x84, 0x92, 0xd7, 0x14, 0xf2, 0x74, 0x1c, 0xca, 0xd3, 0x61, 0x92, 0x27, 0xb2, 0x6c, 0x06,
-	0x8f, 0xf2, 0x4e, 0xc2, 0xd8, 0x3f, 0x0f, 0xa2, 0x11, 0x05, 0xa0, 0x4d, 0xae, 0xaa, 0xe0, 0xd8,
-	0x77, 0xc0, 0x9e, 0x44, 0x29, 0xcf, 0xe2, 0xf0, 0x82, 0x0f, 0x29, 0x8e, 0x59, 0xbf, 0xa3, 0xb5,
-	0x1d, 0x3d, 0xc2, 0xee, 0x0c, 0xab, 0x16, 0x21, 0x10, 0x9d, 0x46, 0x46, 0x68, 0x00, 0x70, 0x42,
-	0x86, 0x1c, 0x5f, 0x25, 0xbc, 0xdf, 0x15, 0x79, 0x57, 0x62, 0xd8, 0x3b, 0x70, 0x23, 0xe3, 0x7e,
-	0x1c, 0x0d, 0xb3, 0x7b, 0xfc, 0x2c, 0x88, 0x86, 0x8f, 0xc8, 0x17, 0xfd, 0x1e, 0xb9, 0x78, 0x1e,
-	0x09, 0x33, 0x86, 0x0c, 0xdf, 0xdb, 0x3b, 0x38, 0xbc, 0x8c, 0x78, 0xda, 0x5f, 0x12, 0x19, 0x53,
-	0x41, 0x62, 0xb8, 0xfd, 0x38, 0x3a, 0x0d, 0x03, 0x3f, 0x7f, 0x94, 0x8d, 0xfa, 0xcb, 0xc4, 0xa3,
-	0xa3, 0x30, 0xa4, 0x79, 0x51, 0xd6, 0x2b, 0x22, 0xa4, 0x05, 0xa2, 0x48, 0x06, 0x37, 0xc9, 0xfa,
-	0xb6, 0x96, 0x0c, 0xae, 0x9e, 0x0c, 0x48, 0x5c, 0xd5, 0x93, 0xc1, 0x4d, 0x32, 0xe7, 0xb7, 0x06,
-	0xf4, 0xf4, 0xde, 0xae, 0xbd, 0x3a, 0xc6, 0x82, 0x57, 0xc7, 0xd4, 0x5f, 0x1d, 0xf6, 0x56, 0xf1,
-	0xba, 0x88, 0xd7, 0x82, 0xfc, 0xff, 0x38, 0x8d, 0xb1, 0x0d, 0xbb, 0x44, 0x28, 0x1e, 0x9c, 0x77,
-	0xa1, 0x9b, 0xf2, 0xd0, 0xbb, 0x2a, 0x9e, 0x09, 0xe4, 0x5f, 0x41, 0x7e, 0xb7, 0x44, 0xbb, 0x3a,
-	0x8f, 0xf3, 0x37, 0x13, 0xba, 0x1a, 0x71, 0x26, 0x77, 0x8d, 0xaf, 0x99, 0xbb, 0xe6, 0x82, 0xdc,
-	0xdd, 0x50, 0x26, 0x4d, 0x4e, 0xf6, 0x82, 0x54, 0x96, 0xb3, 0x8e, 0x2a, 0x38, 0x2a, 0xc5, 0xa2,
-	0xa3, 0xb0, 0xdb, 0x6b, 0xa0, 0x56, 0x2a, 0xd3, 0x68, 0xb6, 0x0d, 0x8c, 0x50, 0xbb, 0x5e, 0xee,
-	0x9f, 0x7d, 0x9e, 0xc8, 0xec, 0x69, 0x52, 0x0a, 0xce, 0xa1, 0xb0, 0xd7, 0xa0, 0x91, 0xe5, 0xde,
-	0x88, 0x53, 0xa9, 0x2c, 0xef, 0x74, 0x28, 0xb5, 0x11, 0xe1, 0x0a, 0xbc, 0xe6, 0xfc, 0xf6, 0x0b,
-	0x9c, 0xef, 0xfc, 0xd9, 0x82, 0xa5, 0xca, 0x6b, 0x3c, 0x6f, 0x6a, 0x29, 0x35, 0x9a, 0x0b, 0x34,
-	0x6e, 0x40, 0x7d, 0x12, 0x05, 0x22, 0xd8, 0xcb, 0x3b, 0x3d, 0xa4, 0x7f, 0x1e, 0x05, 0x39, 0x56,
-	0x87, 0x4b, 0x14, 0xcd, 0xa6, 0xfa, 0x8b, 0x12, 0xe2, 0x1d, 0xb8, 0x51, 0x96, 0xe6, 0xde, 0xde,
-	0xc1, 0x41, 0xec, 0x9f, 0x17, 0xbd, 0x7c, 0x1e, 0x89, 0x31, 0x31, 0xb3, 0x50, 0x8b, 0x79, 0x50,
-	0x13, 0x53, 0xcb, 0xff, 0x43, 0xc3, 0xc7, 0x29, 0x82, 0xbc, 0x24, 0x13, 0x4a, 0x1b, 0x2b, 0x1e,
-	0xd4, 0x5c, 0x41, 0x67, 0x6f, 0x40, 0x7d, 0x38, 0x19, 0x27, 0xd2, 0x57, 0xcb, 0xc8, 0x57, 0x3e,
-	0xeb, 0x0f, 0x6a, 0x2e, 0x51, 0x91, 0x2b, 0x8c, 0xbd, 0x61, 0xbf, 0x53, 0x72, 0x95, 0x6f, 0x1f,
-	0x72, 0x21, 0x15, 0xb9, 0xb0, 0x67, 0x50, 0xff, 0x90, 0x5c, 0x65, 0xfb, 0x46, 0x2e, 0xa4, 0xb2,
-	0xf7, 0x00, 0x2e, 0xbc, 0x30, 0x18, 0x8a, 0xc7, 0xa2, 0x4b, 0xbc, 0x6b, 0xc8, 0xfb, 0xb4, 0xc0,
-	0xca, 0xac, 0xd7, 0xf8, 0xee, 0xb5, 0xa1, 0x99, 0x89, 0xf4, 0xff, 0x2e, 0xac, 0x56, 0x62, 0x76,
-	0x10, 0x64, 0xe4, 0x60, 0x41, 0xee, 0x1b, 0x8b, 0x06, 0x2d, 0x75, 0x7e, 0x00, 0x40, 0x9e, 0xb8,
-	0x9f, 0xa6, 0x71, 0xaa, 0x06, 0x3e, 0xa3, 0x18, 0xf8, 0x9c, 0x3b, 0xd0, 0x41, 0x0f, 0x5c, 0x43,
-	0xc6, 0xab, 0x2f, 0x22, 0x27, 0xd0, 0xa3, 0x3b, 0x3f, 0x39, 0x58, 0xc0, 0xc1, 0x76, 0x60, 0x4d,
-	0x4c, 0x5d, 0xa2, 0x08, 0x1e, 0xc7, 0x59, 0x40, 0x9e, 0x10, 0xe5, 0x38, 0x97, 0x86, 0xbd, 0x8c,
-	0xa3, 0xb8, 0xa3, 0x27, 0x07, 0x6a, 0x2e, 0x50, 0xb0, 0xf3, 0x2d, 0xe8, 0xa0, 0x46, 0xa1, 0x6e,
-	0x13, 0x9a, 0x44, 0x50, 0x7e, 0xb0, 0x8b, 0x20, 0x48, 0x83, 0x5c, 0x49, 0x77, 0x7e, 0x6e, 0x40,
-	0x57, 0x34, 0x39, 0x71, 0xf2, 0x65, 0x7b, 0xdc, 0x46, 0xe5, 0xb8, 0xea, 0x12, 0xba, 0xc4, 0x6d,
-	0x00, 0x6a, 0x53, 0x82, 0xa1, 0x5e, 0x26, 0x45, 0x89, 0x75, 0x35, 0x0e, 0x0c, 0x4c, 0x09, 0xcd,
-	0x71, 0xed, 0xaf, 0x4d, 0xe8, 0xc9, 0x90, 0x0a, 0x96, 0xff, 0x51, 0xb1, 0xca, 0x7a, 0xaa, 0xeb,
-	0xf5, 0xf4, 0xa6, 0xaa, 0xa7, 0x46, 0x79, 0x8d, 0x32, 0x8b, 0xca, 0x72, 0xba, 0x2b, 0xcb, 0xa9,
-	0x49, 0x6c, 0x4b, 0xaa, 0x9c, 0x14, 0x97, 0xa8, 0xa6, 0xbb, 0xb2, 0x9a, 0x5a, 0x25, 0x53, 0x91,
-	0x52, 0x45, 0x31, 0xdd, 0x95, 0xc5, 0xd4, 0x2e, 0x99, 0x8a, 0x30, 0xab, 0x5a, 0xba, 0xd7, 0x82,
-	0x06, 0x85, 0xd3, 0xf9, 0x00, 0x6c, 0xdd, 0x35, 0x54, 0x13, 0x6f, 0x4a, 0x62, 0x25, 0x15, 0x34,
-	0x26, 0x57, 0x9e, 0x7d, 0x06, 0x4b, 0x95, 0x56, 0x84, 0x2f, 0x7e, 0x90, 0xed, 0x7a, 0x91, 0xcf,
-	0xc3, 0x62, 0xef, 0xd0, 0x30, 0x5a, 0x92, 0x99, 0xa5, 0x64, 0x29, 0xa2, 0x92, 0x64, 0xda, 0xf6,
-	0x60, 0x55, 0xb6, 0x87, 0xbf, 0x1b, 0xd0, 0xd3, 0x0f, 0xe0, 0x02, 0x72, 0x3f, 0x4d, 0x77, 0xe3,
-	0xa1, 0x88, 0x66, 0xc3, 0x55, 0x20, 0xa6, 0x3e, 0x7e, 0x86, 0x5e, 0x96, 0xc9, 0x0c, 0x2c, 0x60,
-	0x49, 0x3b, 0xf2, 0xe3, 0x44, 0xed, 0x83, 0x05, 0x2c, 0x69, 0x07, 0xfc, 0x82, 0x87, 0xf2, 0x81,
-	0x2a, 0x60, 0xd4, 0xf6, 0x88, 0x67, 0x19, 0xa6, 0x89, 0xe8, 0xab, 0x0a, 0xc4, 0x53, 0xae, 0x77,
-	0xb9, 0xeb, 0x4d, 0x32, 0x2e, 0x67, 0xb6, 0x02, 0x46, 0xb7, 0xe0, 0xde, 0xea, 0xa5, 0xf1, 0x24,
-	0x52, 0x93, 0x9a, 0x86, 0x71, 0x2e, 0x61, 0xf5, 0xf1, 0x24, 0x1d, 0x71, 0x4a, 0x62, 0xb5, 0x06,
-	0xaf, 0x43, 0x3b, 0x88, 0x3c, 0x3f, 0x0f, 0x2e, 0xb8, 0xf4, 0x64, 0x01, 0x63, 0xfe, 0xe6, 0xc1,
-	0x98, 0xcb, 0x51, 0x95, 0xbe, 0x91, 0xff, 0x34, 0x08, 0x39, 0xe5, 0xb5, 0xbc, 0x92, 0x82, 0xa9,
-	0x44, 0xc5, 0x9b, 0x2c, 0x97, 0x5c, 0x01, 0x39, 0xbf, 0x31, 0x61, 0xfd, 0x30, 0xe1, 0xa9, 0x97,
-	0x73, 0xb1, 0x58, 0x1f, 0xf9, 0x67, 0x7c, 0xec, 0x29, 0x13, 0x6e, 0x83, 0x19, 0x27, 0xa4, 0x5c,
-	0xe6, 0xbb, 0x20, 0x1f, 0x26, 0xae, 0x19, 0x27, 0x64, 0x84, 0x97, 0x9d, 0x4b, 0xdf, 0xd2, 0xf7,
-	0xc2, 0x2d, 0x7b, 0x1d, 0xda, 0x43, 0x2f, 0xf7, 0x4e, 0xbc, 0x8c, 0x2b, 0x9f, 0x2a, 0x98, 0x16,
-	0x52, 0xdc, 0xdf, 0xa4, 0x47, 0x05, 0x40, 0x92, 0x48, 0x9b, 0xf4, 0xa6, 0x84, 0x90, 0xfb, 0x34,
-	0x9c, 0x64, 0x67, 0xe4, 0xc6, 0xb6, 0x2b, 0x00, 0xb4, 0xa5, 0xc8, 0xf9, 0xb6, 0x7c, 0x2e, 0x06,
-	0x00, 0xa7, 0x69, 0x3c, 0x16, 0x8d, 0x85, 0x1e, 0xa0, 0xb6, 0xab, 0x61, 0x14, 0xfd, 0x58, 0xac,
-	0x2b, 0x50, 0xd2, 0x05, 0xc6, 0xc9, 0x61, 0xe9, 0xe9, 0xbb, 0x32, 0xed, 0x1f, 0xf1, 0xdc, 0x63,
-	0xeb, 0x9a, 0x3b, 0x00, 0xdd, 0x81, 0x14, 0xe9, 0x8c, 0x17, 0x76, 0x0f, 0xd5, 0x72, 0x2c, 0xad,
-	0xe5, 0x28, 0x0f, 0xd6, 0x29, 0xc5, 0xe9, 0xdb, 0x79, 0x0f, 0xd6, 0x64, 0x44, 0x9e, 0xbe, 0x8b,
-	0x5a, 0x17, 0xc6, 0x42, 0x90, 0x85, 0x7a, 0xe7, 0xaf, 0x06, 0xdc, 0x9c, 0x3a, 0xf6, 0xd2, 0xbf,
-	0x57, 0xbc, 0x0f, 0x75, 0x5c, 0xf8, 0xfa, 0x16, 0x95, 0xe6, 0x5d, 0xd4, 0x31, 0x57, 0xe4, 0x36,
-	0x02, 0xf7, 0xa3, 0x3c, 0xbd, 0x72, 0xe9, 0xc0, 0xfa, 0xa7, 0xd0, 0x29, 0x50, 0x28, 0xf7, 0x9c,
-	0x5f, 0xa9, 0xee, 0x7b, 0xce, 0xaf, 0x70, 0xa2, 0xb8, 0xf0, 0xc2, 0x89, 0x70, 0x8d, 0x7c, 0x60,
-	0x2b, 0x8e, 0x75, 0x05, 0xfd, 0x03, 0xf3, 0xdb, 0x86, 0xf3, 0x63, 0xe8, 0x3f, 0xf0, 0xa2, 0x61,
-	0x28, 0xf3, 0x51, 0x34, 0x05, 0xe9, 0x82, 0x57, 0x35, 0x17, 0x74, 0x51, 0x0a, 0x51, 0xaf, 0xc9,
-	0xc6, 0xdb, 0xd0, 0x39, 0x51, 0xcf, 0xa1, 0x74, 0x7c, 0x89, 0xa0, 0x9c, 0x79, 0x16, 0x66, 0x72,
-	0xad, 0xa4, 0x6f, 0xe7, 0x26, 0xdc, 0xd8, 0xe7, 0xb9, 0xd0, 0xbd, 0x7b, 0x3a, 0x92, 0x9a, 0x9d,
-	0x4d, 0x58, 0xab, 0xa2, 0xa5, 0x73, 0x6d, 0xb0, 0xfc, 0xd3, 0xe2, 0xa9, 0xf1, 0x4f, 0x47, 0xce,
-	0x11, 0xdc, 0x11, 0xd3, 0xd2, 0xe4, 0x04, 0x4d, 0xc0, 0xd6, 0xf7, 0x79, 0x32, 0xf4, 0x72, 0xae,
-	0x2e, 0xb1, 0x03, 0x6b, 0x99, 0xa0, 0xed, 0x9e, 0x8e, 0x8e, 0xe3, 0x71, 0x78, 0x94, 0xa7, 0x41,
-	0xa4, 0x64, 0xcc, 0xa5, 0x39, 0x07, 0x30, 0x58, 0x24, 0x54, 0x1a, 0xd2, 0x87, 0x96, 0xfc, 0xb1,
-	0x46, 0x86, 0x59, 0x81, 0xb3, 0x71, 0x76, 0x46, 0xb0, 0xbe, 0xcf, 0xf3, 0x99, 0x99, 0xa9, 0x6c,
-	0x3b, 0xa8, 0xe3, 0xb3, 0xf2, 0x79, 0x2c, 0x60, 0xf6, 0x0d, 0xe8, 0x9d, 0x06, 0x61, 0xce, 0x53,
-	0xb9, 0x73, 0xcc, 0xe4, 0x7a, 0x85, 0xec, 0xfc, 0xd4, 0x02, 0x7b, 0x5a, 0x4d, 0x11, 0x27, 0x63,
-	0x6e, 0xd7, 0x30, 0x2b, 0x5d, 0x83, 0x41, 0x7d, 0x8c, 0x8d, 0x5d, 0xd6, 0x0c, 0x7e, 0x97, 0x85,
-	0x56, 0x5f, 0x50, 0x68, 0x9b, 0xb0, 0x22, 0xa7, 0xbf, 0x58, 0xed, 0x35, 0x72, 0x81, 0x98, 0x42,
-	0xe3, 0xc0, 0x3c, 0x85, 0xa2, 0x75, 0x43, 0xf4, 0x9b, 0x79, 0x24, 0x6d, 0x1a, 0x6f, 0x7d, 0x8d,
-	0x69, 0x3c, 0x11, 0x04, 0xf1, 0x93, 0x92, 0x74, 0x59, 0x5b, 0x08, 0x9f, 0x43, 0x62, 0x6f, 0xc3,
-	0x6a, 0xc2, 0x23, 0x5c, 0xb4, 0x35, 0xfe, 0x0e, 0xf1, 0xcf, 0x12, 0xf0, 0x9a, 0xf4, 0x54, 0x6a,
-	0xbc, 0x20, 0xae, 0x39, 0x85, 0x76, 0x7e, 0x6f, 0xc0, 0xcd, 0x32, 0x0c, 0xf4, 0x53, 0xd9, 0x0b,
-	0xb6, 0xd3, 0x75, 0x68, 0x67, 0xa9, 0x4f, 0x9c, 0xea, 0xe5, 0x54, 0x30, 0x75, 0xf2, 0x2c, 0x17,
-	0x34, 0xf9, 0xcc, 0x28, 0xf8, 0xc5, 0xb1, 0xe9, 0x43, 0x6b, 0x5c, 0x7d, 0x3e, 0x25, 0xe8, 0xfc,
-	0xc5, 0x80, 0x57, 0xe7, 0x66, 0xe5, 0x7f, 0xf1, 0xb3, 0x2b, 0x14, 0xa1, 0xcb, 0x64, 0x33, 0xbb,
-	0x7e, 0x4b, 0xc0, 0x79, 0xe3, 0x43, 0x58, 0xca, 0x4b, 0xcf, 0x70, 0xf5, 0xb3, 0xeb, 0x2b, 0xd5,
-	0x83, 0x9a, 0xf3, 0xdc, 0x2a, 0xbf, 0x73, 0x0e, 0xaf, 0x54, 0xec, 0xaf, 0x74, 0xae, 0x1d, 0x9a,
-	0xc2, 0x91, 0x97, 0xcb, 0xfe, 0x75, 0x4b, 0x13, 0x2c, 0xa6, 0x5e, 0xa2, 0xba, 0x05, 0x5f, 0xa5,
-	0x10, 0xcd, 0x6a, 0x21, 0x3a, 0xbf, 0x33, 0x61, 0x65, 0x4a, 0x15, 0x5b, 0x06, 0x33, 0x18, 0xca,
-	0x40, 0x9a, 0xc1, 0x70, 0x61, 0x51, 0xe9, 0xc1, 0xb5, 0xa6, 0x82, 0x8b, 0x6d, 0x24, 0xf5, 0xf7,
-	0xbc, 0xdc, 0x93, 0xaf, 0xb4, 0x02, 0x2b, 0x61, 0x6f, 0x4c, 0x85, 0xbd, 0x0f, 0xad, 0x61, 0x96,
-	0xd3, 0x29, 0x51, 0x3b, 0x0a, 0xc4, 0x06, 0x4c, 0xd9, 0x48, 0x3f, 0x00, 0x89, 0xb9, 0xa7, 0x44,
-	0xb0, 0xed, 0x62, 0xf5, 0x6a, 0x5f, 0xeb, 0x13, 0xc9, 0x55, 0x4c, 0x3d, 0x1d, 0xd9, 0x3a, 0x70,
-	0xea, 0xd1, 0x32, 0x0a, 0xaa, 0x19, 0xf5, 0x6c, 0xaa, 0xcd, 0xc9, 0x80, 0xbc, 0x74, 0x3e, 0xbd,
-	0xa5, 0x86, 0x61, 0x91, 0x4a, 0x37, 0xaa, 0x19, 0x51, 0x99, 0x87, 0x7f, 0x65, 0xc0, 0x1d, 0xf5,
-	0x64, 0xce, 0x4f, 0x84, 0xbb, 0xda, 0x13, 0x36, 0x2b, 0x49, 0x3e, 0x65, 0x34, 0x45, 0x7f, 0x1c,
-	0x86, 0x62, 0xfd, 0x31, 0xd5, 0x14, 0xad, 0x30, 0x95, 0xcc, 0xb0, 0xa6, 0x5a, 0xf4, 0x1a, 0x59,
-	0xfb, 0x50, 0xfc, 0x4c, 0x5f, 0x77, 0x05, 0xe0, 0x7c, 0x0a, 0x83, 0x45, 0x76, 0xbd, 0xac, 0x3f,
-	0xb6, 0xce, 0xa1, 0x29, 0xe6, 0x1e, 0xb6, 0x04, 0x9d, 0x87, 0x11, 0xd5, 0xd0, 0x61, 0x62, 0xd7,
-	0x58, 0x1b, 0xea, 0x47, 0x79, 0x9c, 0xd8, 0x06, 0xeb, 0x40, 0xe3, 0x31, 0x0e, 0xbe, 0xb6, 0xc9,
-	0x00, 0x9a, 0xd8, 0x18, 0xc7, 0xdc, 0xb6, 0x10, 0x7d, 0x94, 0x7b, 0x69, 0x6e, 0xd7, 0x11, 0x2d,
-	0x5e, 0x30, 0xbb, 0xc1, 0x96, 0x01, 0x3e, 0x9e, 0xe4, 0xb1, 0x64, 0x6b, 0x22, 0x6d, 0x8f, 0x87,
-	0x3c, 0xe7, 0x76, 0x6b, 0xeb, 0x27, 0x74, 0x64, 0x84, 0x2f, 0x6d, 0x4f, 0xea, 0x22, 0xd8, 0xae,
-	0xb1, 0x16, 0x58, 0x9f, 0xf1, 0x4b, 0xdb, 0x60, 0x5d, 0x68, 0xb9, 0x93, 0x28, 0x0a, 0xa2, 0x91,
-	0xd0, 0x47, 0xaa, 0x87, 0xb6, 0x85, 0x04, 0x34, 0x28, 0xe1, 0x43, 0xbb, 0xce, 0x7a, 0xd0, 0xfe,
-	0x44, 0xfe, 0xa2, 0x6e, 0x37, 0x90, 0x84, 0x6c, 0x78, 0xa6, 0x89, 0x24, 0x52, 0x8e, 0x50, 0x0b,
-	0x21, 0x3a, 0x85, 0x50, 0x7b, 0xeb, 0x10, 0xda, 0x6a, 0xc9, 0x63, 0x2b, 0xd0, 0x95, 0x36, 0x20,
-	0xca, 0xae, 0xe1, 0x85, 0xe8, 0x5d, 0xb6, 0x0d, 0xbc, 0x3c, 0xae, 0x6b, 0xb6, 0x89, 0x5f, 0xb8,
-	0x93, 0xd9, 0x16, 0x39, 0xe4, 0x2a, 0xf2, 0xed, 0x3a, 0x32, 0xd2, 0x6c, 0x6f, 0x0f, 0xb7, 0x1e,
-	0x41, 0x8b, 0x3e, 0x0f, 0x71, 0x64, 0x59, 0x96, 0xf2, 0x24, 0xc6, 0xae, 0xa1, 0x4f, 0x51, 0xbb,
-	0xe0, 0x36, 0xd0, 0x37, 0x74, 0x1d, 0x01, 0x9b, 0x68, 0x82, 0xf0, 0x93, 0x40, 0x58, 0x5b, 0x3f,
-	0x33, 0xa0, 0xad, 0xa6, 0x72, 0x76, 0x03, 0x56, 0x94, 0x93, 0x24, 0x4a, 0x48, 0xdc, 0xe7, 0xb9,
-	0x40, 0xd8, 0x06, 0x29, 0x28, 0x40, 0x13, 0xfd, 0xea, 0xf2, 0x71, 0x7c, 0xc1, 0x25, 0xc6, 0x42,
-	0x95, 0xb8, 0x04, 0x4a, 0xb8, 0x8e, 0x07, 0x10, 0xa6, 0x52, 0xb7, 0x1b, 0xec, 0x16, 0x30, 0x04,
-	0x1f, 0x05, 0x23, 0x4c, 0x27, 0x31, 0x2a, 0x67, 0x76, 0x73, 0xeb, 0x23, 0x68, 0xab, 0x89, 0x54,
-	0xb3, 0x43, 0xa1, 0x0a, 0x3b, 0x04, 0xc2, 0x36, 0x4a, 0xc5, 0x12, 0x63, 0x6e, 0x3d, 0xa5, 0x4d,
-	0x0e, 0x07, 0x3a, 0xcd, 0x33, 0x12, 0x23, 0xd3, 0xeb, 0x3c, 0x48, 0x64, 0xc0, 0x79, 0x12, 0x7a,
-	0x7e, 0x91, 0x60, 0x17, 0x3c, 0xcd, 0x6d, 0x0b, 0xbf, 0x1f, 0x46, 0x3f, 0xe2, 0x3e, 0x66, 0x18,
-	0x86, 0x21, 0xc8, 0x72, 0xbb, 0xb1, 0x75, 0x00, 0xdd, 0xa7, 0xaa, 0xd1, 0x1f, 0x26, 0x78, 0x01,
-	0x65, 0x5c, 0x89, 0xb5, 0x6b, 0xa8, 0x93, 0xb2, 0xb3, 0xc0, 0xda, 0x06, 0x5b, 0x85, 0x25, 0x8c,
-	0x46, 0x89, 0x32, 0xb7, 0x9e, 0x00, 0x9b, 0x6d, 0x51, 0xe8, 0xb4, 0xd2, 0x60, 0xbb, 0x86, 0x96,
-	0x7c, 0xc6, 0x2f, 0xf1, 0x9b, 0x62, 0xf8, 0x70, 0x14, 0xc5, 0x29, 0x27, 0x9a, 0x8a, 0x21, 0xfd,
-	0x14, 0x87, 0x08, 0x6b, 0xeb, 0xe9, 0x54, 0x33, 0x3f, 0x4c, 0xb4, 0x74, 0x27, 0xd8, 0xae, 0x51,
-	0xf2, 0x91, 0x14, 0x81, 0x90, 0x0e, 0x24, 0x31, 0x02, 0x63, 0xa2, 0xa2, 0xdd, 0x90, 0x7b, 0xa9,
-	0x80, 0xad, 0x9d, 0x3f, 0x36, 0xa1, 0x29, 0x66, 0x56, 0xf6, 0x11, 0x74, 0xb5, 0x3f, 0x66, 0x32,
-	0xea, 0xb4, 0xb3, 0x7f, 0x7a, 0x5d, 0xff, 0xbf, 0x19, 0xbc, 0x68, 0x0f, 0x4e, 0x8d, 0x7d, 0x08,
-	0x50, 0xee, 0xa8, 0xec, 0x26, 0x0d, 0x3e, 0xd3, 0x3b, 0xeb, 0x7a, 0x9f, 0x7e, 0xdd, 0x98, 0xf3,
-	0x87, 0x5a, 0xa7, 0xc6, 0xbe, 0x07, 0x4b, 0xb2, 0x07, 0x89, 0xd4, 0x62, 0x03, 0x6d, 0xc3, 0x98,
-	0xb3, 0x7d, 0x5e, 0x2b, 0xec, 0x93, 0x42, 0x98, 0x48, 0x1f, 0xd6, 0x9f, 0xb3, 0xae, 0x08, 0x31,
-	0xaf, 0x2c, 0x5c, 0x64, 0x9c, 0x1a, 0xdb, 0x87, 0xae, 0x58, 0x37, 0x44, 0x67, 0xbd, 0x8d, 0xbc,
-	0x8b, 0xf6, 0x8f, 0x6b, 0x0d, 0xda, 0x85, 0x9e, 0xbe, 0x21, 0x30, 0xf2, 0xe4, 0x9c, 0x55, 0x42,
-	0x08, 0x99, 0xb7, 0x4c, 0x38, 0x35, 0xe6, 0xc1, 0xad, 0xf9, 0x73, 0x3e, 0x7b, 0xbd, 0xfc, 0x19,
-	0x76, 0xc1, 0x62, 0xb1, 0xee, 0x5c, 0xc7, 0x52, 0xa8, 0xf8, 0x01, 0xf4, 0x0b, 0xe5, 0x45, 0x5a,
-	0xcb, 0xac, 0x18, 0x48, 0xd3, 0x16, 0xac, 0x06, 0xeb, 0xaf, 0x2d, 0xa4, 0x17, 0xe2, 0x8f, 0x61,
-	0xb5, 0x64, 0x88, 0x85, 0xfb, 0xd8, 0x9d, 0x99, 0x73, 0x15, 0xb7, 0x0e, 0x16, 0x91, 0x0b, 0xa9,
-	0x3f, 0x2c, 0x97, 0xdb, 0xaa, 0xe4, 0xd7, 0xf5, 0xd8, 0xce, 0x97, 0xee, 0x5c, 0xc7, 0xa2, 0x34,
-	0xdc, 0xeb, 0x7f, 0xf1, 0xd5, 0xc0, 0xf8, 0xf2, 0xab, 0x81, 0xf1, 0xcf, 0xaf, 0x06, 0xc6, 0x2f,
-	0x9e, 0x0f, 0x6a, 0x5f, 0x3e, 0x1f, 0xd4, 0xfe, 0xf1, 0x7c, 0x50, 0x3b, 0x69, 0xd2, 0xbf, 0x2b,
-	0x7c, 0xf3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xfc, 0xb2, 0x14, 0xc0, 0x20, 0x00, 0x00,
+	// 2951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0x9f, 0x9e, 0xef, 0x79, 0x63, 0x7b, 0x7b, 0x6b, 0xbd, 0x4b, 0xc7, 0xd9, 0x9d, 0x38, 0xbd,
+	0x51, 0x70, 0x2c, 0xb0, 0x12, 0x13, 0x14, 0x14, 0x89, 0x7c, 0xac, 0xbd, 0xf1, 0x6e, 0xf0, 0xc6,
+	0xbb, 0x6d, 0x67, 0x39, 0x21, 0xd1, 0x9e, 0x2e, 0x8f, 0x1b, 0xf7, 0x74, 0xf7, 0x76, 0xf5, 0xd8,
+	0xf2, 0x01, 0x71, 0x41, 0x88, 0x1b, 0x5c, 0x40, 0x02, 0x71, 0x01, 0x09, 0x89, 0x03, 0xe2, 0xc0,
+	0x1f, 0xc0, 0x11, 0x38, 0x46, 0x9c, 0x38, 0xa2, 0xe4, 0x6f, 0xe0, 0x8a, 0xd0, 0x7b, 0x55, 0xd5,
+	0x5d, 0x3d, 0x1f, 0xde, 0x2c, 0x12, 0xb7, 0x7e, 0xbf, 0xf7, 0xea, 0x55, 0xf5, 0xfb, 0xaa, 0xf7,
+	0x7a, 0x06, 0x56, 0x82, 0xf1, 0x45, 0x92, 0x9d, 0xf1, 0x6c, 0x2b, 0xcd, 0x92, 0x3c, 0x61, 0xf5,
+	0xf4, 0xd8, 0xdd, 0x00, 0xf6, 0x64, 0xc2, 0xb3, 0xcb, 0xc3, 0xdc, 0xcf, 0x27, 0xc2, 0xe3, 0xcf,
+	0x26, 0x5c, 0xe4, 0x8c, 0x41, 0x33, 0xf6, 0xc7, 0xdc, 0xb1, 0xd6, 0xad, 0x8d, 0x9e, 0x47, 0xcf,
+	0x6e, 0x0a, 0xab, 0x3b, 0xc9, 0x78, 0x9c, 0xc4, 0xdf, 0x25, 0x1d, 0x1e, 0x17, 0x69, 0x12, 0x0b,
+	0xce, 0x6e, 0x41, 0x3b, 0xe3, 0x62, 0x12, 0xe5, 0x24, 0xdd, 0xf5, 0x14, 0xc5, 0x6c, 0x68, 0x8c,
+	0xc5, 0xc8, 0xa9, 0x93, 0x0a, 0x7c, 0x44, 0x49, 0x91, 0x4c, 0xb2, 0x21, 0x77, 0x1a, 0x04, 0x2a,
+	0x0a, 0x71, 0x79, 0x2e, 0xa7, 0x29, 0x71, 0x49, 0xb9, 0x7f, 0xb2, 0xe0, 0x46, 0xe5, 0x70, 0x2f,
+	0xbc, 0xe3, 0xdb, 0xb0, 0x24, 0xf7, 0x90, 0x1a, 0x68, 0xdf, 0xfe, 0xb6, 0xbd, 0x95, 0x1e, 0x6f,
+	0x1d, 0x1a, 0xb8, 0x57, 0x91, 0x62, 0xef, 0xc0, 0xb2, 0x98, 0x1c, 0x1f, 0xf9, 0xe2, 0x4c, 0x2d,
+	0x6b, 0xae, 0x37, 0x36, 0xfa, 0xdb, 0xd7, 0x69, 0x99, 0xc9, 0xf0, 0xaa, 0x72, 0xee, 0xef, 0x2d,
+	0xe8, 0xef, 0x9c, 0xf2, 0xa1, 0xa2, 0xf1, 0xa0, 0xa9, 0x2f, 0x04, 0x0f, 0xf4, 0x41, 0x25, 0xc5,
+	0x56, 0xa1, 0x95, 0x27, 0xb9, 0x1f, 0xd1, 0x51, 0x5b, 0x9e, 0x24, 0xd8, 0x00, 0x40, 0x4c, 0x86,
+	0x43, 0x2e, 0xc4, 0xc9, 0x24, 0xa2, 0xa3, 0xb6, 0x3c, 0x03, 0x41, 0x6d, 0x27, 0x7e, 0x18, 0xf1,
+	0x80, 0xcc, 0xd4, 0xf2, 0x14, 0xc5, 0x1c, 0xe8, 0x5c, 0xf8, 0x59, 0x1c, 0xc6, 0x23, 0xa7, 0x45,
+	0x0c, 0x4d, 0xe2, 0x8a, 0x80, 0xe7, 0x7e, 0x18, 0x39, 0xed, 0x75, 0x6b, 0x63, 0xc9, 0x53, 0x94,
+	0xfb, 0x1f, 0x0b, 0x60, 0x77, 0x32, 0x4e, 0xd5, 0x31, 0xd7, 0xa1, 0x4f, 0x27, 0x38, 0xf2, 0x8f,
+	0x23, 0x2e, 0xe8, 0xac, 0x0d, 0xcf, 0x84, 0xd8, 0x06, 0x5c, 0x1b, 0x26, 0xe3, 0x34, 0xe2, 0x39,
+	0x0f, 0x94, 0x14, 0x1e, 0xdd, 0xf2, 0xa6, 0x61, 0xf6, 0x1a, 0x2c, 0x9f, 0x84, 0x71, 0x28, 0x4e,
+	0x79, 0x70, 0xef, 0x32, 0xe7, 0xd2, 0xe4, 0x96, 0x57, 0x05, 0x99, 0x0b, 0x4b, 0x1a, 0xf0, 0x92,
+	0x0b, 0x41, 0x2f, 0x64, 0x79, 0x15, 0x8c, 0x7d, 0x0d, 0xae, 0x73, 0x91, 0x87, 0x63, 0x3f, 0xe7,
+	0x47, 0x78, 0x14, 0x12, 0x6c, 0x91, 0xe0, 0x2c, 0x03, 0x7d, 0x7f, 0x9c, 0x0a, 0x7a, 0xcf, 0x86,
+	0x87, 0x8f, 0x6c, 0x0d, 0xba, 0x69, 0x96, 0x8c, 0x32, 0x2e, 0x84, 0xd3, 0xa1, 0x90, 0x28, 0x68,
+	0xf7, 0x8f, 0x16, 0xb0, 0xfd, 0xc4, 0x0f, 0x3c, 0x2e, 0x26, 0x63, 0xfe, 0x58, 0xc1, 0xf8, 0x9a,
+	0xe1, 0x38, 0x4d, 0xb2, 0x9c, 0x07, 0xf7, 0xe3, 0x51, 0x18, 0x17, 0xc6, 0x98, 0x86, 0xf1, 0x05,
+	0xc8, 0x3e, 0x5a, 0xac, 0x4e, 0x62, 0x15, 0x8c, 0xbd, 0x0e, 0x2b, 0xfa, 0x85, 0x76, 0x4e, 0x27,
+	0xf1, 0x99, 0xb4, 0x45, 0xc3, 0x9b, 0x42, 0x0b, 0xf3, 0x2b, 0xa1, 0xa6, 0x61, 0x7e, 0x09, 0xb9,
+	0x3f, 0xad, 0x03, 0xe0, 0x71, 0x95, 0xbf, 0x66, 0x6c, 0x2c, 0x0f, 0x39, 0x65, 0xe3, 0x01, 0x00,
+	0xe9, 0x90, 0x22, 0xf2, 0x80, 0x06, 0x52, 0xb1, 0x4f, 0xa3, 0x6a, 0x1f, 0x5c, 0x3b, 0xe6, 0xb9,
+	0x7f, 0x2f, 0x8c, 0xa3, 0x64, 0xa4, 0xb2, 0xd2, 0x40, 0xf0, 0xd5, 0x4a, 0x6a, 0xef, 0xe8, 0xe1,
+	0x2e, 0x39, 0xa6, 0xe7, 0x4d, 0xa1, 0x73, 0xbc, 0xf2, 0x1e, 0xac, 0x64, 0x15, 0xa3, 0x93, 0x6f,
+	0xfa, 0xdb, 0xb7, 0x30, 0xb9, 0x66, 0x5d, 0xe2, 0x4d, 0x49, 0xbb, 0xbf, 0xb0, 0x60, 0xf9, 0xf0,
+	0xd4, 0xcf, 0x82, 0x30, 0x1e, 0xed, 0x65, 0xc9, 0x24, 0xc5, 0x20, 0xcf, 0xfd, 0x6c, 0xc4, 0x73,
+	0x55, 0xad, 0x14, 0x85, 0x35, 0x6c, 0x77, 0x77, 0x1f, 0xdf, 0xbc, 0x81, 0x35, 0x0c, 0x9f, 0xa5,
+	0xe5, 0x32, 0x91, 0xef, 0x27, 0x43, 0x3f, 0x0f, 0x93, 0x58, 0xbd, 0x78, 0x15, 0x44, 0x8d, 0xe2,
+	0x32, 0x1e, 0x52, 0xa2, 0xe1, 0x5a, 0x45, 0xa1, 0xc5, 0x26, 0xb1, 0xe2, 0xb4, 0x88, 0x53, 0xd0,
+	0xee, 0xbf, 0x9b, 0x00, 0x87, 0x97, 0xf1, 0x70, 0x2a, 0xa5, 0xee, 0x9f, 0xf3, 0x38, 0xaf, 0xa6,
+	0x94, 0x84, 0x50, 0x99, 0xcc, 0xb0, 0x54, 0x3b, 0xa7, 0xa0, 0xd9, 0x6d, 0xe8, 0x65, 0x7c, 0xc8,
+	0xe3, 0x1c, 0x99, 0x32, 0x68, 0x4a, 0x00, 0x63, 0x6f, 0xec, 0x8b, 0x9c, 0x67, 0x15, 0xf7, 0x54,
+	0x30, 0xb6, 0x09, 0xb6, 0x49, 0xef, 0xe5, 0x61, 0xa0, 0x5c, 0x34, 0x83, 0xa3, 0x3e, 0x7a, 0x09,
+	0xad, 0xaf, 0x2d, 0xf5, 0x99, 0x18, 0xea, 0x33, 0x69, 0xd2, 0x27, 0x93, 0x6a, 0x06, 0x47, 0x7d,
+	0xc7, 0x51, 0x32, 0x3c, 0x0b, 0xe3, 0x11, 0x39, 0xa0, 0x4b, 0xa6, 0xaa, 0x60, 0xec, 0xdb, 0x60,
+	0x4f, 0xe2, 0x8c, 0x8b, 0x24, 0x3a, 0xe7, 0x01, 0xf9, 0x51, 0x38, 0x3d, 0xa3, 0xca, 0x9a, 0x1e,
+	0xf6, 0x66, 0x44, 0x0d, 0x0f, 0x81, 0x2c, 0xac, 0x92, 0xc2, 0xb8, 0x3d, 0xa6, 0x83, 0x1c, 0x5d,
+	0xa6, 0xdc, 0xe9, 0xcb, 0xb8, 0x2d, 0x11, 0xf6, 0x26, 0xdc, 0x10, 0x7c, 0x98, 0xc4, 0x81, 0xb8,
+	0xc7, 0x4f, 0xc3, 0x38, 0x78, 0x44, 0xb6, 0x70, 0x96, 0xc8, 0xc4, 0xf3, 0x58, 0x18, 0x31, 0x74,
+	0xf0, 0xdd, 0xdd, 0xfd, 0x83, 0x8b, 0x98, 0x67, 0xce, 0xb2, 0x8c, 0x98, 0x0a, 0x88, 0xee, 0x1e,
+	0x26, 0xf1, 0x49, 0x14, 0x0e, 0xf3, 0x47, 0x62, 0xe4, 0xac, 0x90, 0x8c, 0x09, 0xa1, 0x4b, 0xf3,
+	0xa2, 0x8a, 0x5d, 0x93, 0x2e, 0x2d, 0x80, 0x22, 0x18, 0xbc, 0x54, 0x38, 0xb6, 0x11, 0x0c, 0x9e,
+	0x19, 0x0c, 0xc8, 0xbc, 0x6e, 0x06, 0x83, 0x97, 0x0a, 0xf7, 0x37, 0x16, 0x2c, 0x99, 0x57, 0x99,
+	0x71, 0xc9, 0x5a, 0x0b, 0x2e, 0xd9, 0xba, 0x79, 0xc9, 0xb2, 0x37, 0x8a, 0xcb, 0x54, 0x5e, 0x8e,
+	0x64, 0xff, 0xc7, 0x59, 0x82, 0xb7, 0x8e, 0x47, 0x8c, 0xe2, 0x7e, 0x7d, 0x0b, 0xfa, 0x19, 0x8f,
+	0xfc, 0xcb, 0xe2, 0x56, 0x44, 0xf9, 0x6b, 0x28, 0xef, 0x95, 0xb0, 0x67, 0xca, 0xb8, 0x7f, 0xab,
+	0x43, 0xdf, 0x60, 0xce, 0xc4, 0xae, 0xf5, 0x25, 0x63, 0xb7, 0xbe, 0x20, 0x76, 0xd7, 0xf5, 0x91,
+	0x26, 0xc7, 0xbb, 0x61, 0xa6, 0xd2, 0xd9, 0x84, 0x0a, 0x89, 0x4a, 0xb2, 0x98, 0x10, 0x56, 0x7d,
+	0x83, 0x34, 0x52, 0x65, 0x1a, 0x66, 0x5b, 0xc0, 0x08, 0xda, 0xf1, 0xf3, 0xe1, 0xe9, 0xa7, 0xa9,
+	0x8a, 0x9e, 0x36, 0x85, 0xe0, 0x1c, 0x0e, 0x7b, 0x05, 0x5a, 0x22, 0xf7, 0x47, 0x9c, 0x52, 0x65,
+	0x65, 0xbb, 0x47, 0xa1, 0x8d, 0x80, 0x27, 0x71, 0xc3, 0xf8, 0xdd, 0xe7, 0x18, 0xdf, 0xfd, 0x73,
+	0x03, 0x96, 0x2b, 0xcd, 0xc7, 0xbc, 0x26, 0xad, 0xdc, 0xb1, 0xbe, 0x60, 0xc7, 0x75, 0x68, 0x4e,
+	0xe2, 0x50, 0x3a, 0x7b, 0x65, 0x7b, 0x09, 0xf9, 0x9f, 0xc6, 0x61, 0x8e, 0xd9, 0xe1, 0x11, 0xc7,
+	0x38, 0x53, 0xf3, 0x79, 0x01, 0xf1, 0x26, 0xdc, 0x28, 0x53, 0x73, 0x77, 0x77, 0x7f, 0x3f, 0x19,
+	0x9e, 0x15, 0x77, 0xc1, 0x3c, 0x16, 0x63, 0xb2, 0x45, 0xa3, 0x12, 0xf3, 0xa0, 0x26, 0x9b, 0xb4,
+	0xaf, 0x42, 0x6b, 0x88, 0x4d, 0x93, 0xd3, 0x29, 0x03, 0xca, 0xe8, 0xa2, 0x1e, 0xd4, 0x3c, 0xc9,
+	0x67, 0xaf, 0x41, 0x33, 0x98, 0x8c, 0x53, 0x65, 0xab, 0x15, 0x94, 0x2b, 0xbb, 0x98, 0x07, 0x35,
+	0x8f, 0xb8, 0x28, 0x15, 0x25, 0x7e, 0xe0, 0xf4, 0x4a, 0xa9, 0xf2, 0xee, 0x44, 0x29, 0xe4, 0xa2,
+	0x14, 0xd6, 0x0c, 0x07, 0x4a, 0xa9, 0xb2, 0x7c, 0xa3, 0x14, 0x72, 0xd9, 0xdb, 0x00, 0xe7, 0x7e,
+	0x14, 0x06, 0xf2, 0xb2, 0xe8, 0x93, 0xec, 0x2a, 0xca, 0x3e, 0x2d, 0x50, 0x15, 0xf5, 0x86, 0xdc,
+	0xbd, 0x2e, 0xb4, 0x85, 0x0c, 0xff, 0xf7, 0xe0, 0x7a, 0xc5, 0x67, 0xfb, 0xa1, 0x20, 0x03, 0x4b,
+	0xb6, 0x63, 0x2d, 0xea, 0x2b, 0xf5, 0xfa, 0x01, 0x00, 0x59, 0xe2, 0x7e, 0x96, 0x25, 0x99, 0xee,
+	0x6f, 0xad, 0xa2, 0xbf, 0x75, 0xef, 0x40, 0x0f, 0x2d, 0x70, 0x05, 0x1b, 0x5f, 0x7d, 0x11, 0x3b,
+	0x85, 0x25, 0x7a, 0xe7, 0x27, 0xfb, 0x0b, 0x24, 0xd8, 0x36, 0xac, 0xca, 0x26, 0x53, 0x26, 0xc1,
+	0xe3, 0x44, 0x84, 0x64, 0x09, 0x99, 0x8e, 0x73, 0x79, 0x58, 0xcb, 0x38, 0xaa, 0x3b, 0x7c, 0xb2,
+	0xaf, 0xfb, 0x0a, 0x4d, 0xbb, 0xdf, 0x84, 0x1e, 0xee, 0x28, 0xb7, 0xdb, 0x80, 0x36, 0x31, 0xb4,
+	0x1d, 0xec, 0xc2, 0x09, 0xea, 0x40, 0x9e, 0xe2, 0xbb, 0x3f, 0xb3, 0xa0, 0x2f, 0x8b, 0x9c, 0x5c,
+	0xf9, 0xa2, 0x35, 0x6e, 0xbd, 0xb2, 0x5c, 0x57, 0x09, 0x53, 0xe3, 0x16, 0x00, 0x95, 0x29, 0x29,
+	0xd0, 0x2c, 0x83, 0xa2, 0x44, 0x3d, 0x43, 0x02, 0x1d, 0x53, 0x52, 0x73, 0x4c, 0xfb, 0xab, 0x3a,
+	0x2c, 0x29, 0x97, 0x4a, 0x91, 0xff, 0x53, 0xb2, 0xaa, 0x7c, 0x6a, 0x9a, 0xf9, 0xf4, 0xba, 0xce,
+	0xa7, 0x56, 0xf9, 0x1a, 0x65, 0x14, 0x95, 0xe9, 0x74, 0x57, 0xa5, 0x53, 0x9b, 0xc4, 0x96, 0x75,
+	0x3a, 0x69, 0x29, 0x62, 0xa2, 0x10, 0x65, 0x53, 0xa7, 0x14, 0x2a, 0x42, 0xaa, 0x48, 0xa6, 0xbb,
+	0x2a, 0x99, 0xba, 0xa5, 0x50, 0xe1, 0x66, 0x9d, 0x4b, 0xf7, 0x3a, 0xd0, 0x22, 0x77, 0xba, 0xef,
+	0x82, 0x6d, 0x9a, 0x86, 0x72, 0xe2, 0x75, 0xc5, 0xac, 0x84, 0x82, 0x21, 0xe4, 0xa9, 0xb5, 0xcf,
+	0x60, 0xb9, 0x52, 0x8a, 0xf0, 0xc6, 0x0f, 0xc5, 0x8e, 0x1f, 0x0f, 0x79, 0x54, 0x8c, 0x59, 0x06,
+	0x62, 0x04, 0x59, 0xbd, 0xd4, 0xac, 0x54, 0x54, 0x82, 0xcc, 0x18, 0x96, 0x1a, 0x95, 0x61, 0xe9,
+	0x1f, 0x16, 0x2c, 0x99, 0x0b, 0x70, 0xde, 0xba, 0x9f, 0x65, 0x3b, 0x49, 0x20, 0xbd, 0xd9, 0xf2,
+	0x34, 0x89, 0xa1, 0x8f, 0x8f, 0x91, 0x2f, 0x84, 0x8a, 0xc0, 0x82, 0x56, 0xbc, 0xc3, 0x61, 0x92,
+	0xea, 0xf1, 0xb7, 0xa0, 0x15, 0x6f, 0x9f, 0x9f, 0xf3, 0x48, 0x5d, 0x50, 0x05, 0x8d, 0xbb, 0x3d,
+	0xe2, 0x42, 0x60, 0x98, 0xc8, 0xba, 0xaa, 0x49, 0x5c, 0xe5, 0xf9, 0x17, 0x3b, 0xfe, 0x44, 0x70,
+	0xd5, 0xb3, 0x15, 0x34, 0x9a, 0x05, 0xc7, 0x74, 0x3f, 0x4b, 0x26, 0xb1, 0xee, 0xd4, 0x0c, 0xc4,
+	0xbd, 0x80, 0xeb, 0x8f, 0x27, 0xd9, 0x88, 0x53, 0x10, 0xeb, 0xa9, 0x7f, 0x0d, 0xba, 0x61, 0xec,
+	0x0f, 0xf3, 0xf0, 0x9c, 0x2b, 0x4b, 0x16, 0x34, 0xc6, 0x6f, 0x1e, 0x8e, 0xb9, 0x6a, 0x55, 0xe9,
+	0x19, 0xe5, 0x4f, 0xc2, 0x88, 0x53, 0x5c, 0xab, 0x57, 0xd2, 0x34, 0xa5, 0xa8, 0xbc, 0x93, 0xd5,
+	0x4c, 0x2f, 0x29, 0xf7, 0xd7, 0x75, 0x58, 0x3b, 0x48, 0x79, 0xe6, 0xe7, 0x5c, 0x7e, 0x47, 0x38,
+	0x1c, 0x9e, 0xf2, 0xb1, 0xaf, 0x8f, 0x70, 0x1b, 0xea, 0x49, 0xea, 0x58, 0x65, 0xbc, 0x4b, 0xf6,
+	0x41, 0xea, 0xd5, 0x93, 0x94, 0x0e, 0xe1, 0x8b, 0x33, 0x65, 0x5b, 0x7a, 0x5e, 0xf8, 0x51, 0x61,
+	0x0d, 0xba, 0x81, 0x9f, 0xfb, 0xc7, 0xbe, 0xe0, 0xda, 0xa6, 0x9a, 0xa6, 0xf9, 0x1b, 0xc7, 0x55,
+	0x65, 0x51, 0x49, 0x90, 0x26, 0xda, 0x4d, 0x59, 0x53, 0x51, 0x28, 0x7d, 0x12, 0x4d, 0xc4, 0x29,
+	0x99, 0xb1, 0xeb, 0x49, 0x02, 0xcf, 0x52, 0xc4, 0x7c, 0x57, 0x5d, 0x17, 0x03, 0x80, 0x93, 0x2c,
+	0x19, 0xcb, 0xc2, 0x42, 0x17, 0x50, 0xd7, 0x33, 0x10, 0xcd, 0x3f, 0x92, 0xe3, 0x0a, 0x94, 0x7c,
+	0x89, 0xb8, 0x39, 0x2c, 0x3f, 0x7d, 0x4b, 0x85, 0xfd, 0x23, 0x9e, 0xfb, 0x6c, 0xcd, 0x30, 0x07,
+	0xa0, 0x39, 0x90, 0xa3, 0x8c, 0xf1, 0xdc, 0xea, 0xa1, 0x4b, 0x4e, 0xc3, 0x28, 0x39, 0xda, 0x82,
+	0x4d, 0x0a, 0x71, 0x7a, 0x76, 0xdf, 0x86, 0x55, 0xe5, 0x91, 0xa7, 0x6f, 0xe1, 0xae, 0x0b, 0x7d,
+	0x21, 0xd9, 0x72, 0x7b, 0xf7, 0xaf, 0x16, 0xdc, 0x9c, 0x5a, 0xf6, 0xc2, 0x9f, 0x67, 0xde, 0x81,
+	0x26, 0x0e, 0x8c, 0x4e, 0x83, 0x52, 0xf3, 0x2e, 0xee, 0x31, 0x57, 0xe5, 0x16, 0x12, 0xf7, 0xe3,
+	0x3c, 0xbb, 0xf4, 0x68, 0xc1, 0xda, 0xc7, 0xd0, 0x2b, 0x20, 0xd4, 0x7b, 0xc6, 0x2f, 0x75, 0xf5,
+	0x3d, 0xe3, 0x97, 0xd8, 0x51, 0x9c, 0xfb, 0xd1, 0x44, 0x9a, 0x46, 0x5d, 0xb0, 0x15, 0xc3, 0x7a,
+	0x92, 0xff, 0x6e, 0xfd, 0x5b, 0x96, 0xfb, 0x43, 0x70, 0x1e, 0xf8, 0x71, 0x10, 0xa9, 0x78, 0x94,
+	0x45, 0x41, 0x99, 0xe0, 0x65, 0xc3, 0x04, 0x7d, 0xd4, 0x42, 0xdc, 0x2b, 0xa2, 0xf1, 0x36, 0xf4,
+	0x8e, 0xf5, 0x75, 0xa8, 0x0c, 0x5f, 0x02, 0xb8, 0x42, 0x3c, 0x8b, 0x84, 0x1a, 0x2b, 0xe9, 0xd9,
+	0xbd, 0x09, 0x37, 0xf6, 0x78, 0x2e, 0xf7, 0xde, 0x39, 0x19, 0xa9, 0x9d, 0xdd, 0x0d, 0x58, 0xad,
+	0xc2, 0xca, 0xb8, 0x36, 0x34, 0x86, 0x27, 0xc5, 0x55, 0x33, 0x3c, 0x19, 0xb9, 0x87, 0x70, 0x47,
+	0x76, 0x4b, 0x93, 0x63, 0x3c, 0x02, 0x96, 0xbe, 0x4f, 0xd3, 0xc0, 0xcf, 0xb9, 0x7e, 0x89, 0x6d,
+	0x58, 0x15, 0x92, 0xb7, 0x73, 0x32, 0x3a, 0x4a, 0xc6, 0xd1, 0x61, 0x9e, 0x85, 0xb1, 0xd6, 0x31,
+	0x97, 0xe7, 0xee, 0xc3, 0x60, 0x91, 0x52, 0x75, 0x10, 0x07, 0x3a, 0xea, 0xdb, 0x94, 0x72, 0xb3,
+	0x26, 0x67, 0xfd, 0xec, 0x8e, 0x60, 0x6d, 0x8f, 0xe7, 0x33, 0x3d, 0x53, 0x59, 0x76, 0x70, 0x8f,
+	0x4f, 0xca, 0xeb, 0xb1, 0xa0, 0xd9, 0xd7, 0xf1, 0x43, 0x51, 0x94, 0xf3, 0x4c, 0x2e, 0x99, 0x8d,
+	0xf5, 0x0a, 0xdb, 0xfd, 0x71, 0x03, 0xec, 0xe9, 0x6d, 0x0a, 0x3f, 0x59, 0x73, 0xab, 0x46, 0xbd,
+	0x52, 0x35, 0x18, 0x34, 0xc7, 0x58, 0xd8, 0x55, 0xce, 0xe0, 0x73, 0x99, 0x68, 0xcd, 0x05, 0x89,
+	0xb6, 0x01, 0xd7, 0x54, 0xf7, 0x97, 0xe8, 0xb9, 0x46, 0x0d, 0x10, 0x53, 0x30, 0x36, 0xcc, 0x53,
+	0x10, 0x8d, 0x1b, 0xb2, 0xde, 0xcc, 0x63, 0x19, 0xdd, 0x78, 0xe7, 0x4b, 0x74, 0xe3, 0xa9, 0x64,
+	0xc8, 0x2f, 0x68, 0xca, 0x64, 0x5d, 0xa9, 0x7c, 0x0e, 0x0b, 0x3f, 0xb1, 0xa5, 0x3c, 0xc6, 0x41,
+	0xdb, 0x90, 0xef, 0x91, 0xfc, 0x2c, 0x03, 0x5f, 0x93, 0xae, 0x4a, 0x43, 0x16, 0xe4, 0x6b, 0x4e,
+	0xc1, 0xee, 0xef, 0x2c, 0xb8, 0x59, 0xba, 0x81, 0xbe, 0x0c, 0x3e, 0x67, 0x3a, 0x5d, 0x83, 0xae,
+	0xc8, 0x86, 0x24, 0xa9, 0x6f, 0x4e, 0x4d, 0x23, 0x2f, 0x10, 0xb9, 0xe4, 0xa9, 0x6b, 0x46, 0xd3,
+	0xcf, 0xf7, 0x8d, 0x03, 0x9d, 0x71, 0xf5, 0xfa, 0x54, 0xa4, 0xfb, 0x17, 0x0b, 0x5e, 0x9e, 0x1b,
+	0x95, 0xff, 0xc3, 0x57, 0x66, 0x28, 0x5c, 0x27, 0x54, 0x31, 0xbb, 0x7a, 0x4a, 0xc0, 0x7e, 0xe3,
+	0x7d, 0x58, 0xce, 0x4b, 0xcb, 0x70, 0xfd, 0x95, 0xf9, 0xa5, 0xea, 0x42, 0xc3, 0x78, 0x5e, 0x55,
+	0xde, 0x3d, 0x83, 0x97, 0x2a, 0xe7, 0xaf, 0x54, 0xae, 0x6d, 0xea, 0xc2, 0x51, 0x96, 0xab, 0xfa,
+	0x75, 0xcb, 0x50, 0x2c, 0xbb, 0x5e, 0xe2, 0x7a, 0x85, 0x5c, 0x25, 0x11, 0xeb, 0xd5, 0x44, 0x74,
+	0x7f, 0x5b, 0x87, 0x6b, 0x53, 0x5b, 0xb1, 0x15, 0xa8, 0x87, 0x81, 0x72, 0x64, 0x3d, 0x0c, 0x16,
+	0x26, 0x95, 0xe9, 0xdc, 0xc6, 0x94, 0x73, 0xb1, 0x8c, 0x64, 0xc3, 0x5d, 0x3f, 0xf7, 0xd5, 0x2d,
+	0xad, 0xc9, 0x8a, 0xdb, 0x5b, 0x53, 0x6e, 0x77, 0xa0, 0x13, 0x88, 0x9c, 0x56, 0xc9, 0xdc, 0xd1,
+	0x24, 0x16, 0x60, 0x8a, 0x46, 0xfa, 0x00, 0x24, 0xfb, 0x9e, 0x12, 0x60, 0x5b, 0xc5, 0xe8, 0xd5,
+	0xbd, 0xd2, 0x26, 0x4a, 0xaa, 0xe8, 0x7a, 0x7a, 0xaa, 0x74, 0x84, 0xe3, 0x4a, 0x44, 0x41, 0x35,
+	0xa2, 0x9e, 0x4d, 0x95, 0x39, 0xe5, 0x90, 0x17, 0x8e, 0xa7, 0x37, 0x74, 0x33, 0x2c, 0x43, 0xe9,
+	0x46, 0x35, 0x22, 0x2a, 0xfd, 0xf0, 0x2f, 0x2d, 0xb8, 0xa3, 0xaf, 0xcc, 0xf9, 0x81, 0x70, 0xd7,
+	0xb8, 0xc2, 0x66, 0x35, 0xa9, 0xab, 0x8c, 0xba, 0xe8, 0x0f, 0xa3, 0x88, 0x56, 0x3a, 0x75, 0xdd,
+	0x45, 0x6b, 0xa4, 0x12, 0x19, 0x8d, 0xa9, 0x12, 0xbd, 0x4a, 0xa7, 0x7d, 0x28, 0x7f, 0x95, 0x68,
+	0x7a, 0x92, 0x70, 0x3f, 0x86, 0xc1, 0xa2, 0x73, 0xbd, 0xa8, 0x3d, 0x36, 0xcf, 0xa0, 0x2d, 0xfb,
+	0x1e, 0xb6, 0x0c, 0xbd, 0x87, 0x31, 0xe5, 0xd0, 0x41, 0x6a, 0xd7, 0x58, 0x17, 0x9a, 0x87, 0x79,
+	0x92, 0xda, 0x16, 0xeb, 0x41, 0xeb, 0xb1, 0x3f, 0x11, 0xdc, 0xae, 0x33, 0x80, 0xb6, 0xfc, 0x86,
+	0x6c, 0x37, 0x10, 0x3e, 0xcc, 0xfd, 0x2c, 0xb7, 0x9b, 0x08, 0xcb, 0x1b, 0xcc, 0x6e, 0xb1, 0x15,
+	0x80, 0x0f, 0x27, 0x79, 0xa2, 0xc4, 0xda, 0xc8, 0xdb, 0xe5, 0x11, 0xcf, 0xb9, 0xdd, 0xd9, 0xfc,
+	0x11, 0x2d, 0x19, 0xe1, 0x4d, 0xbb, 0xa4, 0xf6, 0x22, 0xda, 0xae, 0xb1, 0x0e, 0x34, 0x3e, 0xe1,
+	0x17, 0xb6, 0xc5, 0xfa, 0xd0, 0xf1, 0x26, 0x31, 0xfe, 0xc4, 0x22, 0xf7, 0xa3, 0xad, 0x03, 0xbb,
+	0x81, 0x0c, 0x3c, 0x50, 0xca, 0x03, 0xbb, 0xc9, 0x96, 0xa0, 0xfb, 0x91, 0xfa, 0x22, 0x6f, 0xb7,
+	0x90, 0x85, 0x62, 0xb8, 0xa6, 0x8d, 0x2c, 0xda, 0x1c, 0xa9, 0x0e, 0x52, 0xb4, 0x0a, 0xa9, 0xee,
+	0xe6, 0x01, 0x74, 0xf5, 0x90, 0xc7, 0xae, 0x41, 0x5f, 0x9d, 0x01, 0x21, 0xbb, 0x86, 0x2f, 0x44,
+	0xf7, 0xb2, 0x6d, 0xe1, 0xcb, 0xe3, 0xb8, 0x66, 0xd7, 0xf1, 0x09, 0x67, 0x32, 0xbb, 0x41, 0x06,
+	0xb9, 0x8c, 0x87, 0x76, 0x13, 0x05, 0xa9, 0xb7, 0xb7, 0x83, 0xcd, 0x47, 0xd0, 0xa1, 0xc7, 0x03,
+	0x6c, 0x59, 0x56, 0x94, 0x3e, 0x85, 0xd8, 0x35, 0xb4, 0x29, 0xee, 0x2e, 0xa5, 0x2d, 0xb4, 0x0d,
+	0xbd, 0x8e, 0xa4, 0xeb, 0x78, 0x04, 0x69, 0x27, 0x09, 0x34, 0x36, 0x7f, 0x62, 0x41, 0x57, 0x77,
+	0xe5, 0xec, 0x06, 0x5c, 0xd3, 0x46, 0x52, 0x90, 0xd4, 0xb8, 0xc7, 0x73, 0x09, 0xd8, 0x16, 0x6d,
+	0x50, 0x90, 0x75, 0xb4, 0xab, 0xc7, 0xc7, 0xc9, 0x39, 0x57, 0x48, 0x03, 0xb7, 0xc4, 0x21, 0x50,
+	0xd1, 0x4d, 0x5c, 0xb0, 0x1f, 0xaa, 0x54, 0xb7, 0x5b, 0xec, 0x16, 0x30, 0x24, 0x1f, 0x85, 0x23,
+	0x0c, 0x27, 0xd9, 0x2a, 0x0b, 0xbb, 0xbd, 0xf9, 0x01, 0x74, 0x75, 0x47, 0x6a, 0x9c, 0x43, 0x43,
+	0xc5, 0x39, 0x24, 0x60, 0x5b, 0xe5, 0xc6, 0x0a, 0xa9, 0x6f, 0x3e, 0x85, 0x8e, 0x6a, 0xe8, 0x0c,
+	0xcb, 0x28, 0x44, 0x85, 0xd7, 0x59, 0x98, 0x2a, 0x87, 0xf3, 0x34, 0xf2, 0x87, 0x45, 0x80, 0x9d,
+	0xf3, 0x2c, 0xb7, 0x1b, 0xf8, 0xfc, 0x30, 0xfe, 0x01, 0x1f, 0x62, 0x84, 0xa1, 0x1b, 0x42, 0x91,
+	0xdb, 0xad, 0xcd, 0x7d, 0xe8, 0x3f, 0xd5, 0x85, 0xfe, 0x00, 0x7f, 0xa1, 0x60, 0xfa, 0x70, 0x25,
+	0x6a, 0xd7, 0x70, 0x4f, 0x8a, 0xce, 0x02, 0xb5, 0x2d, 0x76, 0x1d, 0x96, 0xd1, 0x1b, 0x25, 0x54,
+	0xdf, 0x7c, 0x02, 0x6c, 0xb6, 0x44, 0xa1, 0xd1, 0xca, 0x03, 0xdb, 0x35, 0x3c, 0xc9, 0x27, 0xfc,
+	0x02, 0x9f, 0xc9, 0x87, 0x0f, 0x47, 0x71, 0x92, 0x71, 0xe2, 0x69, 0x1f, 0xd2, 0xa7, 0x38, 0x04,
+	0x1a, 0x9b, 0x4f, 0xa7, 0x8a, 0xf9, 0x41, 0x6a, 0x84, 0x3b, 0xd1, 0x76, 0x8d, 0x82, 0x8f, 0xb4,
+	0x48, 0x40, 0x19, 0x90, 0xd4, 0x48, 0xa4, 0x8e, 0x1b, 0xed, 0x44, 0xdc, 0xcf, 0x24, 0xdd, 0xd8,
+	0xfe, 0x43, 0x1b, 0xda, 0xb2, 0x67, 0x65, 0x1f, 0x40, 0xdf, 0xf8, 0xed, 0x96, 0x51, 0xa5, 0x9d,
+	0xfd, 0xa5, 0x79, 0xed, 0x2b, 0x33, 0xb8, 0x2c, 0x0f, 0x6e, 0x8d, 0xbd, 0x0f, 0x50, 0xce, 0xa8,
+	0xec, 0x26, 0x35, 0x3e, 0xd3, 0x33, 0xeb, 0x9a, 0x83, 0xf0, 0xbc, 0xdf, 0xa5, 0xdd, 0x1a, 0xfb,
+	0x0e, 0x2c, 0xab, 0x1a, 0x24, 0x43, 0x8b, 0x0d, 0x8c, 0x09, 0x63, 0xce, 0xf4, 0x79, 0xa5, 0xb2,
+	0x8f, 0x0a, 0x65, 0x32, 0x7c, 0x98, 0x33, 0x67, 0x5c, 0x91, 0x6a, 0x5e, 0x5a, 0x38, 0xc8, 0xb8,
+	0x35, 0xb6, 0x07, 0x7d, 0x39, 0x6e, 0xc8, 0xca, 0x7a, 0x1b, 0x65, 0x17, 0xcd, 0x1f, 0x57, 0x1e,
+	0x68, 0x07, 0x96, 0xcc, 0x09, 0x81, 0x91, 0x25, 0xe7, 0x8c, 0x12, 0x6b, 0xce, 0x2c, 0xa3, 0x50,
+	0xe2, 0xc3, 0xad, 0xf9, 0x7d, 0x3e, 0x7b, 0xb5, 0xfc, 0x0c, 0xbb, 0x60, 0xb0, 0x58, 0x73, 0xaf,
+	0x12, 0x29, 0xb6, 0xf8, 0x1e, 0x38, 0xc5, 0xe6, 0x45, 0x58, 0xab, 0xa8, 0x18, 0xa8, 0xa3, 0x2d,
+	0x18, 0x0d, 0xd6, 0x5e, 0x59, 0xc8, 0x2f, 0xd4, 0x1f, 0xc1, 0xf5, 0x52, 0x20, 0x91, 0xe6, 0x63,
+	0x77, 0x66, 0xd6, 0x55, 0xcc, 0x3a, 0x58, 0xc4, 0x2e, 0xb4, 0x7e, 0xbf, 0x1c, 0x6e, 0xab, 0x9a,
+	0x5f, 0x35, 0x7d, 0x3b, 0x5f, 0xbb, 0x7b, 0x95, 0x88, 0xde, 0xe1, 0x9e, 0xf3, 0xf7, 0xcf, 0x07,
+	0xd6, 0x67, 0x9f, 0x0f, 0xac, 0x7f, 0x7d, 0x3e, 0xb0, 0x7e, 0xfe, 0xc5, 0xa0, 0xf6, 0xd9, 0x17,
+	0x83, 0xda, 0x3f, 0xbf, 0x18, 0xd4, 0x8e, 0xdb, 0xf4, 0xef, 0x8c, 0x6f, 0xfc, 0x77, 0x00, 0xf9,
+	0xfa, 0x2b, 0x91, 0xaf, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4431,6 +4516,49 @@ func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LoadResumeProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadResumeProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadResumeProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalChunks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FinishedChunks != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedChunks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalEngines != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalEngines))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ImportedEngines != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ImportedEngines))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LoadStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4451,6 +4579,18 @@ func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResumeProgress != nil {
+		{
+			size, err := m.ResumeProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Bps != 0 {
 		i = encodeVarintDmworker(dAtA, i, uint64(m.Bps))
 		i--
@@ -6752,6 +6892,27 @@ func (m *DumpStatus) Size() (n int) {
 	return n
 }
 
+func (m *LoadResumeProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImportedEngines != 0 {
+		n += 1 + sovDmworker(uint64(m.ImportedEngines))
+	}
+	if m.TotalEngines != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalEngines))
+	}
+	if m.FinishedChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedChunks))
+	}
+	if m.TotalChunks != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalChunks))
+	}
+	return n
+}
+
 func (m *LoadStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6779,6 +6940,10 @@ func (m *LoadStatus) Size() (n int) {
 	if m.Bps != 0 {
 		n += 1 + sovDmworker(uint64(m.Bps))
 	}
+	if m.ResumeProgress != nil {
+		l = m.ResumeProgress.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
 	return n
 }
 
@@ -8549,6 +8714,132 @@ func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LoadResumeProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadResumeProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadResumeProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedEngines", wireType)
+			}
+			m.ImportedEngines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImportedEngines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEngines", wireType)
+			}
+			m.TotalEngines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEngines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedChunks", wireType)
+			}
+			m.FinishedChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalChunks", wireType)
+			}
+			m.TotalChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalChunks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8731,6 +9022,42 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeProgress == nil {
+				m.ResumeProgress = &LoadResumeProgress{}
+			}
+			if err := m.ResumeProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -33,9 +33,9 @@ import (
 	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
-	"github.com/pingcap/tidb/util/regexpr-router"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"go.uber.org/zap"
 )
@@ -502,7 +502,7 @@ func FetchTargetDoTables(
 	source string,
 	db *BaseDB,
 	bw *filter.Filter,
-	router *regexprrouter.RouteTable,
+	router *tablerouter.RouteTable,
 ) (map[filter.Table][]filter.Table, map[filter.Table][]string, error) {
 	// fetch tables from source and filter them
 	sourceTables, err := FetchAllDoTables(ctx, db, bw)
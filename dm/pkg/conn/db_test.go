@@ -27,11 +27,11 @@ import (
 	"github.com/pingcap/errors"
 	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/filter"
-	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
 	router "github.com/pingcap/tidb/util/table-router"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -563,7 +563,7 @@ func TestFetchTargetDoTables(t *testing.T) {
 	// empty filter and router, just as upstream.
 	ba, err := filter.New(false, nil)
 	require.NoError(t, err)
-	r, err := regexprrouter.NewRegExprRouter(false, nil)
+	r, err := tablerouter.NewRouteTable(false, nil)
 	require.NoError(t, err)
 
 	schemas := []string{"shard1"}
@@ -590,7 +590,7 @@ func TestFetchTargetDoTables(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 
 	// route to the same downstream.
-	r, err = regexprrouter.NewRegExprRouter(false, []*router.TableRule{
+	r, err = tablerouter.NewRouteTable(false, []*router.TableRule{
 		{SchemaPattern: "shard*", TablePattern: "tbl*", TargetSchema: "shard", TargetTable: "tbl"},
 	})
 	require.NoError(t, err)
@@ -0,0 +1,329 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablerouter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/filter"
+	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
+	router "github.com/pingcap/tidb/util/table-router"
+)
+
+// captureRefRegexp matches the references to the capture groups in the target schema and table of a route rule,
+// which are `$1`, `${1}`, `${name}` and the escaped `$$`.
+var captureRefRegexp = regexp.MustCompile(`\$(\$|\d+|\{\w+\})`)
+
+// namedGroupRegexp matches the beginning of a named capture group.
+var namedGroupRegexp = regexp.MustCompile(`\(\?P<\w+>`)
+
+// captureRule is a route rule whose target refers to the capture groups of its regular expression patterns.
+type captureRule struct {
+	rule *router.TableRule
+	// inner is the rule with the names of the groups removed, the lower-case conversion of the patterns when it's
+	// not case-sensitive breaks the syntax of named groups. The numbers of the groups are not changed by it.
+	inner  *router.TableRule
+	filter *filter.Filter
+	// the compiled patterns, nil if the pattern is not a regular expression.
+	schemaRe *regexp.Regexp
+	tableRe  *regexp.Regexp
+}
+
+// RouteTable routes the tables like regexprrouter.RouteTable, besides, the target schema and table of a rule with
+// regular expression patterns can refer to the capture groups of the patterns, e.g. the tables matched by schema
+// pattern `~^shard_(\d+)$` and table pattern `~^t$` can be routed to target table `t_$1` of target schema `merged`.
+// The groups of the schema pattern are numbered before the ones of the table pattern, named groups can be referred by
+// `${name}`, and `$$` is a literal `$`.
+type RouteTable struct {
+	*regexprrouter.RouteTable
+
+	rules    []*router.TableRule
+	captures []*captureRule
+	// tableRules matches the tables of the table rules without capture groups.
+	tableRules *filter.Filter
+}
+
+// NewRouteTable creates a RouteTable.
+func NewRouteTable(caseSensitive bool, rules []*router.TableRule) (*RouteTable, error) {
+	r := &RouteTable{rules: rules}
+	// the patterns of the rules are converted to lower case by regexprrouter if it's not case-sensitive, so the
+	// capture rules must be built before it.
+	for _, rule := range rules {
+		if !hasCaptureRef(rule.TargetSchema) && !hasCaptureRef(rule.TargetTable) {
+			continue
+		}
+		c, err := newCaptureRule(caseSensitive, rule)
+		if err != nil {
+			return nil, errors.Annotatef(err, "add rule %+v into table router", rule)
+		}
+		r.captures = append(r.captures, c)
+	}
+	var err error
+	if len(r.captures) > 0 {
+		tableRules := &filter.Rules{}
+		for _, rule := range rules {
+			if rule.TablePattern == "" || r.isCaptureRule(rule) {
+				continue
+			}
+			tableRules.DoDBs = append(tableRules.DoDBs, rule.SchemaPattern)
+			tableRules.DoTables = append(tableRules.DoTables, &filter.Table{Schema: rule.SchemaPattern, Name: rule.TablePattern})
+		}
+		if len(tableRules.DoTables) > 0 {
+			if r.tableRules, err = filter.New(caseSensitive, tableRules); err != nil {
+				return nil, err
+			}
+		}
+	}
+	r.RouteTable, err = regexprrouter.NewRegExprRouter(caseSensitive, r.innerRules())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// innerRules returns the rules for the inner regexprrouter.
+func (r *RouteTable) innerRules() []*router.TableRule {
+	if len(r.captures) == 0 {
+		return r.rules
+	}
+	rules := make([]*router.TableRule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		for _, c := range r.captures {
+			if c.rule == rule {
+				rule = c.inner
+				break
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func newCaptureRule(caseSensitive bool, rule *router.TableRule) (*captureRule, error) {
+	c := &captureRule{rule: rule}
+	var (
+		err   error
+		names = make(map[string]struct{})
+		count int
+	)
+	compile := func(pattern string) (*regexp.Regexp, error) {
+		if !strings.HasPrefix(pattern, "~") {
+			return nil, nil
+		}
+		expr := pattern[1:]
+		if !caseSensitive {
+			expr = "(?i)" + expr
+		}
+		re, err2 := regexp.Compile(expr)
+		if err2 != nil {
+			return nil, errors.Trace(err2)
+		}
+		count += re.NumSubexp()
+		for _, name := range re.SubexpNames() {
+			if name != "" {
+				names[name] = struct{}{}
+			}
+		}
+		return re, nil
+	}
+	if c.schemaRe, err = compile(rule.SchemaPattern); err != nil {
+		return nil, err
+	}
+	if c.tableRe, err = compile(rule.TablePattern); err != nil {
+		return nil, err
+	}
+
+	for _, target := range []string{rule.TargetSchema, rule.TargetTable} {
+		for _, ref := range captureRefRegexp.FindAllStringSubmatch(target, -1) {
+			group := strings.Trim(ref[1], "{}")
+			if group == "$" {
+				continue
+			}
+			if n, err2 := strconv.Atoi(group); err2 == nil {
+				if n < 1 || n > count {
+					return nil, errors.Errorf("capture group %s in target %s doesn't exist in the patterns", ref[0], target)
+				}
+			} else if _, ok := names[group]; !ok {
+				return nil, errors.Errorf("capture group %s in target %s doesn't exist in the patterns", ref[0], target)
+			}
+		}
+	}
+
+	inner := *rule
+	inner.SchemaPattern = namedGroupRegexp.ReplaceAllString(rule.SchemaPattern, "(")
+	inner.TablePattern = namedGroupRegexp.ReplaceAllString(rule.TablePattern, "(")
+	c.inner = &inner
+	rules := &filter.Rules{DoDBs: []string{inner.SchemaPattern}}
+	if inner.TablePattern != "" {
+		rules.DoTables = []*filter.Table{{Schema: inner.SchemaPattern, Name: inner.TablePattern}}
+	}
+	if c.filter, err = filter.New(caseSensitive, rules); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Route routes the table to the target schema and table, the references to the capture groups in the target are
+// expanded.
+func (r *RouteTable) Route(schema, table string) (string, string, error) {
+	targetSchema, targetTable, err := r.RouteTable.Route(schema, table)
+	if err != nil || len(r.captures) == 0 {
+		return targetSchema, targetTable, err
+	}
+	if c := r.matchCapture(schema, table); c != nil {
+		targetSchema, targetTable = c.expand(schema, table)
+	}
+	return targetSchema, targetTable, nil
+}
+
+// matchCapture returns the capture rule which routes the table, or nil if the table is routed by other rules. Like
+// regexprrouter.RouteTable, a table rule takes precedence over a schema rule.
+func (r *RouteTable) matchCapture(schema, table string) *captureRule {
+	var schemaRule *captureRule
+	for _, c := range r.captures {
+		if !c.filter.Match(&filter.Table{Schema: schema, Name: table}) {
+			continue
+		}
+		if c.rule.TablePattern == "" {
+			schemaRule = c
+			continue
+		}
+		if table != "" {
+			return c
+		}
+	}
+	// the table may be routed by a table rule without capture groups.
+	if schemaRule != nil && table != "" && r.tableRules != nil &&
+		r.tableRules.Match(&filter.Table{Schema: schema, Name: table}) {
+		return nil
+	}
+	return schemaRule
+}
+
+// expand returns the target schema and table of the rule for the table, with the references expanded.
+func (c *captureRule) expand(schema, table string) (string, string) {
+	var (
+		groups []string
+		names  = make(map[string]string)
+	)
+	collect := func(re *regexp.Regexp, s string) {
+		if re == nil {
+			return
+		}
+		match := re.FindStringSubmatch(s)
+		if match == nil {
+			match = make([]string, re.NumSubexp()+1)
+		}
+		groups = append(groups, match[1:]...)
+		for i, name := range re.SubexpNames() {
+			if name != "" {
+				names[name] = match[i]
+			}
+		}
+	}
+	collect(c.schemaRe, schema)
+	collect(c.tableRe, table)
+
+	expandOne := func(target, origin string) string {
+		if target == "" {
+			return origin
+		}
+		return captureRefRegexp.ReplaceAllStringFunc(target, func(ref string) string {
+			group := strings.Trim(ref[1:], "{}")
+			if group == "$" {
+				return "$"
+			}
+			if n, err := strconv.Atoi(group); err == nil {
+				return groups[n-1]
+			}
+			return names[group]
+		})
+	}
+	return expandOne(c.rule.TargetSchema, schema), expandOne(c.rule.TargetTable, table)
+}
+
+// HasCaptureRules returns whether any rule refers to the capture groups.
+func (r *RouteTable) HasCaptureRules() bool {
+	return len(r.captures) > 0
+}
+
+// ConcreteRules returns the route rules for the tables, in which each rule referring to the capture groups is replaced
+// by the rules of the tables it routes, with the expanded targets. The rules can be used by the routers which don't
+// support capture groups, e.g. the one of lightning.
+func (r *RouteTable) ConcreteRules(tables []filter.Table) []*router.TableRule {
+	if len(r.captures) == 0 {
+		return r.rules
+	}
+	concrete := make(map[*router.TableRule][]*router.TableRule, len(r.captures))
+	seenSchemas := make(map[*router.TableRule]map[string]struct{}, len(r.captures))
+	for _, table := range tables {
+		c := r.matchCapture(table.Schema, table.Name)
+		if c == nil {
+			continue
+		}
+		targetSchema, targetTable := c.expand(table.Schema, table.Name)
+		rule := *c.rule
+		rule.SchemaPattern = exactPattern(table.Schema)
+		rule.TargetSchema = targetSchema
+		if c.rule.TablePattern == "" {
+			// a schema rule routes all tables of the schema to the same target.
+			if _, ok := seenSchemas[c.rule][table.Schema]; ok {
+				continue
+			}
+			if seenSchemas[c.rule] == nil {
+				seenSchemas[c.rule] = make(map[string]struct{})
+			}
+			seenSchemas[c.rule][table.Schema] = struct{}{}
+			if c.rule.TargetTable != "" {
+				rule.TargetTable = targetTable
+			}
+		} else {
+			rule.TablePattern = exactPattern(table.Name)
+			rule.TargetTable = targetTable
+		}
+		concrete[c.rule] = append(concrete[c.rule], &rule)
+	}
+
+	rules := make([]*router.TableRule, 0, len(r.rules))
+	for _, rule := range r.rules {
+		if rules2, ok := concrete[rule]; ok {
+			rules = append(rules, rules2...)
+			continue
+		}
+		if !r.isCaptureRule(rule) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func (r *RouteTable) isCaptureRule(rule *router.TableRule) bool {
+	for _, c := range r.captures {
+		if c.rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func exactPattern(name string) string {
+	return "~^" + regexp.QuoteMeta(name) + "$"
+}
+
+func hasCaptureRef(s string) bool {
+	return captureRefRegexp.MatchString(s)
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablerouter
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/util/filter"
+	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
+	router "github.com/pingcap/tidb/util/table-router"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteCaptureGroups(t *testing.T) {
+	t.Parallel()
+
+	rules := []*router.TableRule{
+		{SchemaPattern: `~^shard_(\d+)$`, TablePattern: "~^t$", TargetSchema: "merged", TargetTable: "t_$1"},
+		{SchemaPattern: `~^(?P<app>\w+)_log$`, TablePattern: `~^log_(\d+)$`, TargetSchema: "${app}", TargetTable: "log_${2}$$"},
+		{SchemaPattern: `~^tenant_(\d+)$`, TargetSchema: "tenant"},
+		{SchemaPattern: `~^tenant_(\d+)$`, TablePattern: "~^u$", TargetSchema: "tenant", TargetTable: "u"},
+		{SchemaPattern: `~^city_(\w+)$`, TargetSchema: "city_all_$1"},
+		{SchemaPattern: "plain", TablePattern: "t*", TargetSchema: "plain_target", TargetTable: "t"},
+	}
+	r, err := NewRouteTable(false, rules)
+	require.NoError(t, err)
+	require.True(t, r.HasCaptureRules())
+
+	cases := []struct {
+		schema, table             string
+		targetSchema, targetTable string
+	}{
+		{"shard_01", "t", "merged", "t_01"},
+		{"Shard_02", "T", "merged", "t_02"},
+		{"shop_log", "log_2023", "shop", "log_2023$"},
+		{"tenant_1", "x", "tenant", "x"},
+		{"tenant_1", "u", "tenant", "u"},
+		{"city_Paris", "a", "city_all_Paris", "a"},
+		{"city_Paris", "", "city_all_Paris", ""},
+		{"plain", "t1", "plain_target", "t"},
+		{"other", "t", "other", "t"},
+	}
+	for _, cs := range cases {
+		targetSchema, targetTable, err := r.Route(cs.schema, cs.table)
+		require.NoError(t, err)
+		require.Equal(t, cs.targetSchema, targetSchema, "%s.%s", cs.schema, cs.table)
+		require.Equal(t, cs.targetTable, targetTable, "%s.%s", cs.schema, cs.table)
+	}
+
+	// rules without capture groups work like regexprrouter.
+	r2, err := NewRouteTable(true, []*router.TableRule{
+		{SchemaPattern: "db*", TablePattern: "t*", TargetSchema: "db", TargetTable: "t"},
+	})
+	require.NoError(t, err)
+	require.False(t, r2.HasCaptureRules())
+	targetSchema, targetTable, err := r2.Route("db1", "t1")
+	require.NoError(t, err)
+	require.Equal(t, "db", targetSchema)
+	require.Equal(t, "t", targetTable)
+
+	_, err = NewRouteTable(false, []*router.TableRule{
+		{SchemaPattern: `~^shard_(\d+)$`, TablePattern: "t", TargetSchema: "merged", TargetTable: "t_$2"},
+	})
+	require.ErrorContains(t, err, "capture group $2 in target t_$2 doesn't exist")
+	_, err = NewRouteTable(false, []*router.TableRule{
+		{SchemaPattern: "shard_*", TargetSchema: "merged_${id}"},
+	})
+	require.ErrorContains(t, err, "capture group ${id} in target merged_${id} doesn't exist")
+}
+
+func TestConcreteRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []*router.TableRule{
+		{SchemaPattern: `~^shard_(\d+)$`, TablePattern: "~^t$", TargetSchema: "merged", TargetTable: "t_$1"},
+		{SchemaPattern: `~^city_(.+)$`, TargetSchema: "city_all_$1"},
+		{SchemaPattern: "plain", TablePattern: "t*", TargetSchema: "plain_target", TargetTable: "t"},
+	}
+	r, err := NewRouteTable(false, rules)
+	require.NoError(t, err)
+	tables := []filter.Table{
+		{Schema: "shard_01", Name: "t"},
+		{Schema: "shard_02", Name: "t"},
+		{Schema: "city_a.b", Name: "x"},
+		{Schema: "city_a.b", Name: "y"},
+		{Schema: "plain", Name: "t1"},
+	}
+	concrete := r.ConcreteRules(tables)
+	require.Len(t, concrete, 4)
+	require.Equal(t, &router.TableRule{
+		SchemaPattern: `~^shard_01$`, TablePattern: "~^t$", TargetSchema: "merged", TargetTable: "t_01",
+	}, concrete[0])
+	require.Equal(t, &router.TableRule{
+		SchemaPattern: `~^shard_02$`, TablePattern: "~^t$", TargetSchema: "merged", TargetTable: "t_02",
+	}, concrete[1])
+	require.Equal(t, &router.TableRule{
+		SchemaPattern: `~^city_a\.b$`, TargetSchema: "city_all_a.b",
+	}, concrete[2])
+	require.Same(t, rules[2], concrete[3])
+
+	// the concrete rules route the tables to the same targets without capture groups.
+	r2, err := regexprrouter.NewRegExprRouter(false, concrete)
+	require.NoError(t, err)
+	for _, table := range tables {
+		expectedSchema, expectedTable, err := r.Route(table.Schema, table.Name)
+		require.NoError(t, err)
+		targetSchema, targetTable, err := r2.Route(table.Schema, table.Name)
+		require.NoError(t, err)
+		require.Equal(t, expectedSchema, targetSchema)
+		require.Equal(t, expectedTable, targetTable)
+	}
+}
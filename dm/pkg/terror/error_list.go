@@ -270,6 +270,11 @@ const (
 	codeConfigInvalidLoadPhysicalChecksum
 	codeConfigColumnMappingDeprecated
 	codeConfigInvalidLoadAnalyze
+	codeConfigInvalidRelayPurgeWatermark
+	codeConfigInvalidFromReplicas
+	codeConfigInvalidLoadTableConfig
+	codeConfigInvalidHeartbeat
+	codeConfigInvalidAutoResume
 )
 
 // Binlog operation error code list.
@@ -363,6 +368,7 @@ const (
 	codeDumpUnitGenTableRouter
 	codeDumpUnitGenBAList
 	codeDumpUnitGlobalLock
+	codeDumpUnitValueExpr
 )
 
 // Load unit error code.
@@ -952,7 +958,7 @@ var (
 	ErrConfigMissingForBound        = New(codeConfigMissingForBound, ClassConfig, ScopeInternal, LevelHigh, "source bound %s doesn't have related source config in etcd", "")
 	ErrConfigBinlogEventFilter      = New(codeConfigBinlogEventFilter, ClassConfig, ScopeInternal, LevelHigh, "generate binlog event filter", "Please check the `filters` config in source and task configuration files.")
 	ErrConfigGlobalConfigsUnused    = New(codeConfigGlobalConfigsUnused, ClassConfig, ScopeInternal, LevelHigh, "The configurations as following %v are set in global configuration but instances don't use them", "Please check the configuration files.")
-	ErrConfigExprFilterManyExpr     = New(codeConfigExprFilterManyExpr, ClassConfig, ScopeInternal, LevelHigh, "expression filter can only specify one of (insert, update, delete) expressions, but %s has specified %v", "If you want to filter by A or B, please write two filters.")
+	ErrConfigExprFilterManyExpr     = New(codeConfigExprFilterManyExpr, ClassConfig, ScopeInternal, LevelHigh, "expression filter can only specify one of (insert, update, delete, value) expressions, but %s has specified %v", "If you want to filter by A or B, please write two filters.")
 	ErrConfigExprFilterNotFound     = New(codeConfigExprFilterNotFound, ClassConfig, ScopeInternal, LevelHigh, "mysql-instance(%d)'s expression-filters %s not exist in expression-filter", "Please check the `expression-filters` config in task configuration file.")
 	ErrConfigExprFilterWrongGrammar = New(codeConfigExprFilterWrongGrammar, ClassConfig, ScopeInternal, LevelHigh, "expression-filter name(%s) SQL(%s) has wrong grammar: %v", "Please check the `expression-filters` config in task configuration file.")
 	ErrConfigExprFilterEmptyName    = New(codeConfigExprFilterEmptyName, ClassConfig, ScopeInternal, LevelHigh, "expression-filter %s has empty %s", "Please check the `expression-filters` config in task configuration file.")
@@ -981,6 +987,11 @@ var (
 	ErrConfigInvalidPhysicalChecksum            = New(codeConfigInvalidLoadPhysicalChecksum, ClassConfig, ScopeInternal, LevelMedium, "invalid load checksum-physical option '%s'", "Please choose a valid value in ['required', 'optional', 'off'] or leave it empty.")
 	ErrConfigColumnMappingDeprecated            = New(codeConfigColumnMappingDeprecated, ClassConfig, ScopeInternal, LevelHigh, "column-mapping is not supported since v6.6.0", "Please use extract-table/extract-schema/extract-source to handle data conflict when merge tables. See https://docs.pingcap.com/tidb/v6.4/task-configuration-file-full#task-configuration-file-template-advanced")
 	ErrConfigInvalidLoadAnalyze                 = New(codeConfigInvalidLoadAnalyze, ClassConfig, ScopeInternal, LevelMedium, "invalid load analyze option '%s'", "Please choose a valid value in ['required', 'optional', 'off'] or leave it empty.")
+	ErrConfigInvalidRelayPurgeWatermark         = New(codeConfigInvalidRelayPurgeWatermark, ClassConfig, ScopeInternal, LevelMedium, "invalid relay log purge disk-usage-watermark %d", "Please set `disk-usage-watermark` of `purge` in source configuration file to a percentage between 1 and 100, or 0 to disable it.")
+	ErrConfigInvalidFromReplicas                = New(codeConfigInvalidFromReplicas, ClassConfig, ScopeInternal, LevelMedium, "invalid from-replicas config: %s", "Please set `enable-gtid: true` and use addresses in the format of `host:port` in `from-replicas` of source configuration file.")
+	ErrConfigInvalidLoadTableConfig             = New(codeConfigInvalidLoadTableConfig, ClassConfig, ScopeInternal, LevelMedium, "invalid load table config: %s", "Please check the `table-configs` config in task configuration file.")
+	ErrConfigInvalidHeartbeat                   = New(codeConfigInvalidHeartbeat, ClassConfig, ScopeInternal, LevelMedium, "invalid heartbeat config: %s", "Please check the `heartbeat` config of `syncers` in task configuration file.")
+	ErrConfigInvalidAutoResume                  = New(codeConfigInvalidAutoResume, ClassConfig, ScopeInternal, LevelMedium, "invalid auto-resume config: %s", "Please check the `auto-resume` config in task configuration file.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -1062,6 +1073,7 @@ var (
 	ErrDumpUnitGenTableRouter = New(codeDumpUnitGenTableRouter, ClassDumpUnit, ScopeInternal, LevelHigh, "generate table router", "Please check `routes` config in task configuration file.")
 	ErrDumpUnitGenBAList      = New(codeDumpUnitGenBAList, ClassDumpUnit, ScopeInternal, LevelHigh, "generate block allow list", "Please check the `block-allow-list` config in task configuration file.")
 	ErrDumpUnitGlobalLock     = New(codeDumpUnitGlobalLock, ClassDumpUnit, ScopeInternal, LevelHigh, "Couldn't acquire global lock", "Please check upstream privilege about FTWRL, or add `--no-locks` or `--consistency none` to extra-args of mydumpers")
+	ErrDumpUnitValueExpr      = New(codeDumpUnitValueExpr, ClassDumpUnit, ScopeInternal, LevelHigh, "dumpling can only filter all the dumped tables by one value-expr of expression filters, but table %s has value-expr %q and table %s has value-expr %q", "Please use the same value-expr for all the tables in `block-allow-list`, or only use value-expr in `incremental` task mode.")
 
 	// Load unit error.
 	ErrLoadUnitCreateSchemaFile    = New(codeLoadUnitCreateSchemaFile, ClassLoadUnit, ScopeInternal, LevelMedium, "generate schema file", "Please check the `loaders` config in task configuration file.")
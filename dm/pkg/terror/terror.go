@@ -96,6 +96,16 @@ func (ec ErrClass) String() string {
 	return fmt.Sprintf("unknown error class: %d", ec)
 }
 
+// IsValidErrClass returns whether s is the string of an error class, e.g. `database`.
+func IsValidErrClass(s string) bool {
+	for _, str := range errClass2Str {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
+
 // ErrScope represents the error occurs environment, such as upstream DB error,
 // downstream DB error, DM internal error etc.
 type ErrScope int
@@ -42,6 +42,9 @@ func TestTError(t *testing.T) {
 
 	require.Equal(t, errClass2Str[ClassDatabase], ClassDatabase.String())
 	require.Equal(t, "unknown error class: 10000", ErrClass(10000).String())
+	require.True(t, IsValidErrClass("database"))
+	require.True(t, IsValidErrClass(ClassSyncUnit.String()))
+	require.False(t, IsValidErrClass("unknown"))
 
 	require.Equal(t, errScope2Str[ScopeUpstream], ScopeUpstream.String())
 	require.Equal(t, "unknown error scope: 10000", ErrScope(10000).String())
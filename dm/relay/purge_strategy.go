@@ -203,8 +203,8 @@ func newInactiveStrategy() PurgeStrategy {
 }
 
 func (s *inactiveStrategy) Check(args interface{}) (bool, error) {
-	// do not support purge in the background
-	return false, nil
+	// for inactive strategy, we always try to do the purging if it's enabled in the background
+	return true, nil
 }
 
 func (s *inactiveStrategy) Do(args interface{}) error {
@@ -231,10 +231,11 @@ func (s *inactiveStrategy) Type() strategyType {
 
 // spaceArgs represents args needed by spaceStrategy.
 type spaceArgs struct {
-	relayBaseDir   string
-	remainSpace    int64 // if remain space (GB) in @RelayBaseDir less than this, then it can be purged
-	uuids          []string
-	activeRelayLog *streamer.RelayLogInfo // earliest active relay log info
+	relayBaseDir       string
+	remainSpace        int64 // if remain space (GB) in @RelayBaseDir less than this, then it can be purged
+	diskUsageWatermark int64 // if used space (percent) of the disk of @RelayBaseDir reaches this, then it can be purged
+	uuids              []string
+	activeRelayLog     *streamer.RelayLogInfo // earliest active relay log info
 }
 
 func (sa *spaceArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
@@ -242,8 +243,8 @@ func (sa *spaceArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
 }
 
 func (sa *spaceArgs) String() string {
-	return fmt.Sprintf("(RelayBaseDir: %s, AllowMinRemainSpace: %dGB, UUIDs: %s, ActiveRelayLog: %s)",
-		sa.relayBaseDir, sa.remainSpace, strings.Join(sa.uuids, ";"), sa.activeRelayLog)
+	return fmt.Sprintf("(RelayBaseDir: %s, AllowMinRemainSpace: %dGB, DiskUsageWatermark: %d%%, UUIDs: %s, ActiveRelayLog: %s)",
+		sa.relayBaseDir, sa.remainSpace, sa.diskUsageWatermark, strings.Join(sa.uuids, ";"), sa.activeRelayLog)
 }
 
 // spaceStrategy represents a relay purge strategy by remain space in dm-worker node.
@@ -270,8 +271,19 @@ func (s *spaceStrategy) Check(args interface{}) (bool, error) {
 		return false, terror.Annotatef(err, "get storage size for directory %s", sa.relayBaseDir)
 	}
 
-	requiredBytes := uint64(sa.remainSpace) * 1024 * 1024 * 1024
-	return storageSize.Available < requiredBytes, nil
+	if sa.remainSpace > 0 {
+		requiredBytes := uint64(sa.remainSpace) * 1024 * 1024 * 1024
+		if storageSize.Available < requiredBytes {
+			return true, nil
+		}
+	}
+	if sa.diskUsageWatermark > 0 && storageSize.Capacity > 0 {
+		usedPercent := (storageSize.Capacity - storageSize.Available) * 100 / storageSize.Capacity
+		if usedPercent >= uint64(sa.diskUsageWatermark) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (s *spaceStrategy) Do(args interface{}) error {
@@ -286,6 +298,7 @@ func (s *spaceStrategy) Do(args interface{}) error {
 	}
 
 	// NOTE: we purge all inactive relay log files when available space less than @remainSpace
+	// or used space reaches @diskUsageWatermark
 	// maybe we can refine this to purge only part of this files every time
 	return purgeRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
 }
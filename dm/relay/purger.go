@@ -107,7 +107,7 @@ func (p *relayPurger) Start() {
 		return
 	}
 
-	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0) {
+	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0 && p.cfg.DiskUsageWatermark <= 0 && !p.cfg.Inactive) {
 		return // no need do purge in the background
 	}
 
@@ -249,15 +249,15 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 	}
 
 	// NOTE: no priority supported yet
-	// 1. strategyInactive only used by dmctl manually
-	// 2. strategyFilename only used by dmctl manually
+	// 1. strategyFilename only used by dmctl manually
 
-	// 3. strategySpace should be started if set RemainSpace
-	if p.cfg.RemainSpace > 0 {
+	// 2. strategySpace should be started if set RemainSpace or DiskUsageWatermark
+	if p.cfg.RemainSpace > 0 || p.cfg.DiskUsageWatermark > 0 {
 		args := &spaceArgs{
-			relayBaseDir: p.baseRelayDir,
-			remainSpace:  p.cfg.RemainSpace,
-			uuids:        uuids,
+			relayBaseDir:       p.baseRelayDir,
+			remainSpace:        p.cfg.RemainSpace,
+			diskUsageWatermark: p.cfg.DiskUsageWatermark,
+			uuids:              uuids,
 		}
 		ps := p.strategies[strategySpace]
 		need, err := ps.Check(args)
@@ -269,7 +269,7 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 		}
 	}
 
-	// 4. strategyTime should be started if set Expires
+	// 3. strategyTime should be started if set Expires
 	if p.cfg.Expires > 0 {
 		safeTime := time.Now().Add(time.Duration(-p.cfg.Expires) * time.Hour)
 		args := &timeArgs{
@@ -287,6 +287,22 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 		}
 	}
 
+	// 4. strategyInactive should be started if set Inactive, it's also used by dmctl manually
+	if p.cfg.Inactive {
+		args := &inactiveArgs{
+			relayBaseDir: p.baseRelayDir,
+			uuids:        uuids,
+		}
+		ps := p.strategies[strategyInactive]
+		need, err := ps.Check(args)
+		if err != nil {
+			return nil, nil, terror.Annotatef(err, "check with %s with args %+v", ps.Type(), args)
+		}
+		if need {
+			return ps, args, nil
+		}
+	}
+
 	return nil, nil, nil
 }
 
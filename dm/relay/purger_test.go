@@ -329,3 +329,70 @@ func (t *testPurgerSuite) TestPurgerInterceptor(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(strings.Contains(err.Error(), interceptor.msg), IsTrue)
 }
+
+func (t *testPurgerSuite) TestPurgeAutomaticallyInactive(c *C) {
+	// create relay log dir
+	baseDir := c.MkDir()
+
+	// prepare files and directories
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(len(relayDirsPath), Equals, 3)
+	c.Assert(len(relayFilesPath), Equals, 3)
+	c.Assert(len(relayFilesPath[2]), Equals, 3)
+
+	err := t.genUUIDIndexFile(baseDir)
+	c.Assert(err, IsNil)
+
+	cfg := config.PurgeConfig{
+		Interval: 1, // enable automatically
+		Inactive: true,
+	}
+
+	purger := NewPurger(cfg, baseDir, []Operator{t}, nil)
+	purger.Start()
+	time.Sleep(2 * time.Second) // sleep enough time to purge all inactive relay log files
+	purger.Close()
+
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
+	c.Assert(utils.IsDirExists(relayDirsPath[1]), IsTrue)
+	c.Assert(utils.IsDirExists(relayDirsPath[2]), IsTrue)
+
+	c.Assert(utils.IsFileExists(relayFilesPath[1][0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][1]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][2]), IsTrue)
+	for _, fp := range relayFilesPath[2] {
+		c.Assert(utils.IsFileExists(fp), IsTrue)
+	}
+
+	// forbidden by the interceptor, nothing is purged
+	baseDir = c.MkDir()
+	relayDirsPath, _, _ = t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+	purger = NewPurger(cfg, baseDir, []Operator{t}, []PurgeInterceptor{newFakeInterceptor()})
+	purger.Start()
+	time.Sleep(2 * time.Second)
+	purger.Close()
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsTrue)
+}
+
+func (t *testPurgerSuite) TestSpaceStrategyCheckDiskUsageWatermark(c *C) {
+	baseDir := c.MkDir()
+	storageSize, err := utils.GetStorageSize(baseDir)
+	c.Assert(err, IsNil)
+	usedPercent := int64((storageSize.Capacity - storageSize.Available) * 100 / storageSize.Capacity)
+
+	ps := newSpaceStrategy()
+	// the watermark can't be reached
+	need, err := ps.Check(&spaceArgs{relayBaseDir: baseDir, diskUsageWatermark: 101})
+	c.Assert(err, IsNil)
+	c.Assert(need, IsFalse)
+	if usedPercent > 0 {
+		need, err = ps.Check(&spaceArgs{relayBaseDir: baseDir, diskUsageWatermark: usedPercent})
+		c.Assert(err, IsNil)
+		c.Assert(need, IsTrue)
+	}
+	// disabled
+	need, err = ps.Check(&spaceArgs{relayBaseDir: baseDir})
+	c.Assert(err, IsNil)
+	c.Assert(need, IsFalse)
+}
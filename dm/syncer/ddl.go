@@ -25,7 +25,6 @@ import (
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	tablefilter "github.com/pingcap/tidb/util/filter"
-	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
@@ -36,6 +35,7 @@ import (
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/metrics"
@@ -68,7 +68,7 @@ type DDLWorker struct {
 	upstreamTZStr              string
 	onlineDDL                  onlineddl.OnlinePlugin
 	checkpoint                 CheckPoint
-	tableRouter                *regexprrouter.RouteTable
+	tableRouter                *tablerouter.RouteTable
 	sourceTableNamesFlavor     conn.LowerCaseTableNamesFlavor
 	collationCompatible        string
 	charsetAndDefaultCollation map[string]string
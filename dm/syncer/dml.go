@@ -177,6 +177,7 @@ func (s *Syncer) genAndFilterUpdateDMLs(
 	param *genDMLParam,
 	oldValueFilters []expression.Expression,
 	newValueFilters []expression.Expression,
+	valueFilters []expression.Expression,
 ) ([]*sqlmodel.RowChange, error) {
 	var (
 		tableID      = utils.GenTableID(param.targetTable)
@@ -232,11 +233,39 @@ RowLoop:
 			}
 		}
 
+		// the rows matching the value filters are not in the downstream, so the UPDATE is transformed when only one
+		// of the old value and the new value matches.
+		oldRow, newRow := oriOldValues, oriChangedValues
+		for _, expr := range valueFilters {
+			if oldRow != nil {
+				skip, err := SkipDMLByExpression(s.sessCtx, oriOldValues, expr, ti.Columns)
+				if err != nil {
+					return nil, err
+				}
+				if skip {
+					oldRow = nil
+				}
+			}
+			if newRow != nil {
+				skip, err := SkipDMLByExpression(s.sessCtx, oriChangedValues, expr, ti.Columns)
+				if err != nil {
+					return nil, err
+				}
+				if skip {
+					newRow = nil
+				}
+			}
+		}
+		if oldRow == nil && newRow == nil {
+			s.filteredUpdate.Add(1)
+			continue RowLoop
+		}
+
 		rowChange := sqlmodel.NewRowChange(
 			&cdcmodel.TableName{Schema: param.sourceTable.Schema, Table: param.sourceTable.Name},
 			&cdcmodel.TableName{Schema: param.targetTable.Schema, Table: param.targetTable.Name},
-			oriOldValues,
-			oriChangedValues,
+			oldRow,
+			newRow,
 			param.sourceTableInfo,
 			downstreamTableInfo.TableInfo,
 			s.sessCtx,
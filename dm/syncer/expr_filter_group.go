@@ -35,10 +35,12 @@ type ExprFilterGroup struct {
 	updateOldExprs map[string][]expression.Expression    // tableName -> expr
 	updateNewExprs map[string][]expression.Expression    // tableName -> expr
 	deleteExprs    map[string][]expression.Expression    // tableName -> expr
+	valueExprs     map[string][]expression.Expression    // tableName -> expr
 
 	hasInsertFilter map[string]struct{} // set(tableName)
 	hasUpdateFilter map[string]struct{} // set(tableName)
 	hasDeleteFilter map[string]struct{} // set(tableName)
+	hasValueFilter  map[string]struct{} // set(tableName)
 
 	tidbCtx sessionctx.Context
 	logCtx  *tcontext.Context
@@ -52,9 +54,11 @@ func NewExprFilterGroup(logCtx *tcontext.Context, tidbCtx sessionctx.Context, ex
 		updateOldExprs:  map[string][]expression.Expression{},
 		updateNewExprs:  map[string][]expression.Expression{},
 		deleteExprs:     map[string][]expression.Expression{},
+		valueExprs:      map[string][]expression.Expression{},
 		hasInsertFilter: map[string]struct{}{},
 		hasUpdateFilter: map[string]struct{}{},
 		hasDeleteFilter: map[string]struct{}{},
+		hasValueFilter:  map[string]struct{}{},
 		tidbCtx:         tidbCtx,
 		logCtx:          logCtx,
 	}
@@ -71,6 +75,12 @@ func NewExprFilterGroup(logCtx *tcontext.Context, tidbCtx sessionctx.Context, ex
 		if c.DeleteValueExpr != "" {
 			ret.hasDeleteFilter[tableName] = struct{}{}
 		}
+		// the value expression skips INSERT and DELETE events like the insert and delete expressions.
+		if c.ValueExpr != "" {
+			ret.hasInsertFilter[tableName] = struct{}{}
+			ret.hasDeleteFilter[tableName] = struct{}{}
+			ret.hasValueFilter[tableName] = struct{}{}
+		}
 	}
 	return ret
 }
@@ -88,8 +98,13 @@ func (g *ExprFilterGroup) GetInsertExprs(table *filter.Table, ti *model.TableInf
 	}
 
 	for _, c := range g.configs[tableID] {
-		if c.InsertValueExpr != "" {
-			expr, err2 := getSimpleExprOfTable(g.tidbCtx, c.InsertValueExpr, ti, g.logCtx.L())
+		// one filter has only one of the expressions.
+		exprStr := c.InsertValueExpr
+		if exprStr == "" {
+			exprStr = c.ValueExpr
+		}
+		if exprStr != "" {
+			expr, err2 := getSimpleExprOfTable(g.tidbCtx, exprStr, ti, g.logCtx.L())
 			if err2 != nil {
 				// TODO: terror
 				return nil, err2
@@ -157,8 +172,13 @@ func (g *ExprFilterGroup) GetDeleteExprs(table *filter.Table, ti *model.TableInf
 	}
 
 	for _, c := range g.configs[tableID] {
-		if c.DeleteValueExpr != "" {
-			expr, err2 := getSimpleExprOfTable(g.tidbCtx, c.DeleteValueExpr, ti, g.logCtx.L())
+		// one filter has only one of the expressions.
+		exprStr := c.DeleteValueExpr
+		if exprStr == "" {
+			exprStr = c.ValueExpr
+		}
+		if exprStr != "" {
+			expr, err2 := getSimpleExprOfTable(g.tidbCtx, exprStr, ti, g.logCtx.L())
 			if err2 != nil {
 				// TODO: terror
 				return nil, err2
@@ -169,6 +189,31 @@ func (g *ExprFilterGroup) GetDeleteExprs(table *filter.Table, ti *model.TableInf
 	return g.deleteExprs[tableID], nil
 }
 
+// GetValueExprs returns the value expression filters for given table to skip or transform UPDATE events.
+// This function will lazy calculate expressions if not initialized.
+func (g *ExprFilterGroup) GetValueExprs(table *filter.Table, ti *model.TableInfo) ([]expression.Expression, error) {
+	tableID := utils.GenTableID(table)
+
+	if ret, ok := g.valueExprs[tableID]; ok {
+		return ret, nil
+	}
+	if _, ok := g.hasValueFilter[tableID]; !ok {
+		return nil, nil
+	}
+
+	for _, c := range g.configs[tableID] {
+		if c.ValueExpr != "" {
+			expr, err2 := getSimpleExprOfTable(g.tidbCtx, c.ValueExpr, ti, g.logCtx.L())
+			if err2 != nil {
+				// TODO: terror
+				return nil, err2
+			}
+			g.valueExprs[tableID] = append(g.valueExprs[tableID], expr)
+		}
+	}
+	return g.valueExprs[tableID], nil
+}
+
 // ResetExprs deletes the expressions generated before. This should be called after table structure changed.
 func (g *ExprFilterGroup) ResetExprs(table *filter.Table) {
 	tableID := utils.GenTableID(table)
@@ -176,6 +221,7 @@ func (g *ExprFilterGroup) ResetExprs(table *filter.Table) {
 	delete(g.updateOldExprs, tableID)
 	delete(g.updateNewExprs, tableID)
 	delete(g.deleteExprs, tableID)
+	delete(g.valueExprs, tableID)
 }
 
 // SkipDMLByExpression returns true when given row matches the expr, which means this row should be skipped.
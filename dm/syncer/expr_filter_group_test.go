@@ -508,3 +508,66 @@ create table t (
 	require.Equal(t, len(oldExprs), len(newExprs))
 	require.Len(t, oldExprs, 3)
 }
+
+func TestGetValueExprs(t *testing.T) {
+	var (
+		dbName  = "test"
+		tblName = "t"
+		table   = &filter.Table{
+			Schema: dbName,
+			Name:   tblName,
+		}
+		tableStr = `
+create table t (
+	c int
+);`
+		sessCtx = utils.NewSessionCtx(map[string]string{"time_zone": "UTC"})
+	)
+
+	stmt, err := parseSQL(tableStr)
+	require.NoError(t, err)
+	tableInfo, err := ddl2.BuildTableInfoFromAST(stmt.(*ast.CreateTableStmt))
+	require.NoError(t, err)
+
+	g := NewExprFilterGroup(tcontext.Background(), sessCtx, []*config.ExpressionFilter{
+		{
+			Schema:    dbName,
+			Table:     tblName,
+			ValueExpr: "c > 1",
+		},
+		{
+			Schema:          dbName,
+			Table:           tblName,
+			InsertValueExpr: "c < 0",
+		},
+	})
+
+	// the value expression is applied to INSERT and DELETE like their own expressions
+	insertExprs, err := g.GetInsertExprs(table, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, insertExprs, 2)
+	deleteExprs, err := g.GetDeleteExprs(table, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, deleteExprs, 1)
+	oldExprs, newExprs, err := g.GetUpdateExprs(table, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, oldExprs, 0)
+	require.Len(t, newExprs, 0)
+	valueExprs, err := g.GetValueExprs(table, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, valueExprs, 1)
+
+	skippedRow := util.Must(adjustValueFromBinlogData([]interface{}{int32(2)}, tableInfo))
+	passedRow := util.Must(adjustValueFromBinlogData([]interface{}{int32(1)}, tableInfo))
+	skip, err := SkipDMLByExpression(sessCtx, skippedRow, valueExprs[0], tableInfo.Columns)
+	require.NoError(t, err)
+	require.True(t, skip)
+	skip, err = SkipDMLByExpression(sessCtx, passedRow, valueExprs[0], tableInfo.Columns)
+	require.NoError(t, err)
+	require.False(t, skip)
+
+	// other tables have no value expressions
+	valueExprs, err = g.GetValueExprs(&filter.Table{Schema: dbName, Name: "t2"}, tableInfo)
+	require.NoError(t, err)
+	require.Len(t, valueExprs, 0)
+}
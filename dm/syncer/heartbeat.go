@@ -0,0 +1,179 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/util/dbutil"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// heartbeatTimeLayout is the layout of the timestamps of the heartbeats, which is the same as pt-heartbeat.
+const heartbeatTimeLayout = "2006-01-02T15:04:05.999999"
+
+// heartbeat measures the replication lag by the heartbeats in the upstream heartbeat table, see config.HeartbeatConfig.
+type heartbeat struct {
+	cfg           *config.HeartbeatConfig
+	caseSensitive bool
+	table         *filter.Table
+	// tsColumnIdx is the index of the timestamp column in the rows of the heartbeat table.
+	tsColumnIdx int
+
+	// lastTS is the unix timestamp in microseconds of the latest heartbeat replicated by the syncer, 0 if there is none.
+	lastTS atomic.Int64
+}
+
+// newHeartbeat creates a heartbeat, the heartbeat table is created in the upstream in the `write` mode.
+func newHeartbeat(tctx *tcontext.Context, cfg *config.HeartbeatConfig, caseSensitive bool, db *conn.BaseDB) (*heartbeat, error) {
+	table, err := cfg.ParseTable()
+	if err != nil {
+		return nil, err
+	}
+	h := &heartbeat{
+		cfg:           cfg,
+		caseSensitive: caseSensitive,
+		table:         table,
+	}
+	if cfg.Mode == config.HeartbeatModeWrite {
+		queries := []string{
+			fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(table.Schema)),
+			// the same as the table of pt-heartbeat.
+			fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s VARCHAR(26) NOT NULL, server_id INT UNSIGNED NOT NULL PRIMARY KEY)",
+				dbutil.TableName(table.Schema, table.Name), dbutil.ColumnName(cfg.TimestampColumn)),
+		}
+		for _, query := range queries {
+			if _, err = db.ExecContext(tctx, query); err != nil {
+				return nil, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBExecuteFailed, query)
+			}
+		}
+	}
+
+	var position int
+	query := "SELECT ORDINAL_POSITION FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND COLUMN_NAME = ?"
+	err = db.DB.QueryRowContext(tctx.Ctx, query, table.Schema, table.Name, cfg.TimestampColumn).Scan(&position)
+	if err == sql.ErrNoRows {
+		return nil, terror.ErrConfigInvalidHeartbeat.Generate(
+			fmt.Sprintf("timestamp column %s of table %s doesn't exist in the upstream", cfg.TimestampColumn, cfg.Table))
+	} else if err != nil {
+		return nil, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBQueryFailed, query)
+	}
+	h.tsColumnIdx = position - 1
+	return h, nil
+}
+
+// isHeartbeatTable returns whether the table is the heartbeat table.
+func (h *heartbeat) isHeartbeatTable(table *filter.Table) bool {
+	if h.caseSensitive {
+		return table.Schema == h.table.Schema && table.Name == h.table.Name
+	}
+	return strings.EqualFold(table.Schema, h.table.Schema) && strings.EqualFold(table.Name, h.table.Name)
+}
+
+// observe records the timestamp of the heartbeat in the rows event of the heartbeat table.
+func (h *heartbeat) observe(tctx *tcontext.Context, ev *replication.RowsEvent, eventType replication.EventType) {
+	switch eventType {
+	case replication.WRITE_ROWS_EVENTv0, replication.WRITE_ROWS_EVENTv1, replication.WRITE_ROWS_EVENTv2,
+		replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+	default:
+		return
+	}
+	// the rows of update events are pairs of the values before and after update, only the latter are heartbeats.
+	start, step := 0, 1
+	if eventType != replication.WRITE_ROWS_EVENTv0 && eventType != replication.WRITE_ROWS_EVENTv1 && eventType != replication.WRITE_ROWS_EVENTv2 {
+		start, step = 1, 2
+	}
+	// a rows event may carry the heartbeats of several servers, e.g. pt-heartbeat writes a row for each server ID.
+	var maxTS int64
+	for i := start; i < len(ev.Rows); i += step {
+		if len(ev.Rows[i]) <= h.tsColumnIdx {
+			continue
+		}
+		ts, err := parseHeartbeatTS(ev.Rows[i][h.tsColumnIdx])
+		if err != nil {
+			tctx.L().Warn("invalid heartbeat timestamp", zap.String("table", h.cfg.Table), zap.Error(err))
+			continue
+		}
+		if ts > maxTS {
+			maxTS = ts
+		}
+	}
+	// the heartbeats may be replicated again in the re-sync of sharding groups.
+	if maxTS > h.lastTS.Load() {
+		h.lastTS.Store(maxTS)
+	}
+}
+
+// parseHeartbeatTS returns the unix timestamp in microseconds of the heartbeat.
+func parseHeartbeatTS(value interface{}) (int64, error) {
+	var s string
+	switch v := value.(type) {
+	case time.Time:
+		return time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), v.Minute(), v.Second(), v.Nanosecond(), time.UTC).UnixMicro(), nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return 0, fmt.Errorf("unsupported type %T of heartbeat timestamp %v", value, value)
+	}
+	t, err := time.Parse(heartbeatTimeLayout, strings.Replace(s, " ", "T", 1))
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixMicro(), nil
+}
+
+// writeHeartbeatCronJob writes the heartbeats to the upstream periodically in the `write` mode.
+func (s *Syncer) writeHeartbeatCronJob(ctx context.Context) {
+	defer s.runWg.Done()
+	query := fmt.Sprintf("REPLACE INTO %s (%s, server_id) VALUES (DATE_FORMAT(UTC_TIMESTAMP(6), '%%Y-%%m-%%dT%%H:%%i:%%s.%%f'), @@server_id)",
+		dbutil.TableName(s.heartbeat.table.Schema, s.heartbeat.table.Name), dbutil.ColumnName(s.cfg.Heartbeat.TimestampColumn))
+	ticker := time.NewTicker(s.cfg.Heartbeat.UpdateInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.fromDB.BaseDB.ExecContext(s.tctx.WithContext(ctx), query); err != nil {
+				s.tctx.L().Warn("fail to write heartbeat", zap.String("table", s.cfg.Heartbeat.Table), zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// heartbeatLag returns the replication lag in seconds measured by the heartbeats, and whether there is any heartbeat.
+func (s *Syncer) heartbeatLag() (float64, bool) {
+	if s.heartbeat == nil {
+		return 0, false
+	}
+	ts := s.heartbeat.lastTS.Load()
+	if ts == 0 {
+		return 0, false
+	}
+	lag := time.Now().UnixMicro() - s.tsOffset.Load()*int64(time.Second/time.Microsecond) - ts
+	return float64(lag) / float64(time.Second/time.Microsecond), true
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeartbeatTS(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2023, 5, 5, 7, 3, 56, 0, time.UTC).UnixMicro()
+	cases := []struct {
+		value    interface{}
+		expected int64
+	}{
+		{"2023-05-05T07:03:56.000950", base + 950},
+		{"2023-05-05 07:03:56.000950", base + 950},
+		{"2023-05-05T07:03:56", base},
+		{[]byte("2023-05-05T07:03:56.5"), base + 500000},
+		{time.Date(2023, 5, 5, 7, 3, 56, 950000, time.Local), base + 950},
+	}
+	for _, cs := range cases {
+		ts, err := parseHeartbeatTS(cs.value)
+		require.NoError(t, err)
+		require.Equal(t, cs.expected, ts, "%v", cs.value)
+	}
+
+	_, err := parseHeartbeatTS(int64(1683270236))
+	require.ErrorContains(t, err, "unsupported type int64")
+	_, err = parseHeartbeatTS("05/05/2023")
+	require.Error(t, err)
+}
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	tctx := tcontext.Background()
+	cfg := &config.HeartbeatConfig{}
+	require.NoError(t, cfg.Adjust())
+
+	mock.ExpectExec("CREATE SCHEMA IF NOT EXISTS `dm_heartbeat`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `dm_heartbeat`.`heartbeat` \\(`ts` VARCHAR\\(26\\) NOT NULL, server_id INT UNSIGNED NOT NULL PRIMARY KEY\\)").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT ORDINAL_POSITION FROM information_schema.COLUMNS").
+		WithArgs("dm_heartbeat", "heartbeat", "ts").
+		WillReturnRows(sqlmock.NewRows([]string{"ORDINAL_POSITION"}).AddRow(1))
+	h, err := newHeartbeat(tctx, cfg, false, conn.NewBaseDBForTest(db))
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 0, h.tsColumnIdx)
+
+	require.True(t, h.isHeartbeatTable(&filter.Table{Schema: "DM_Heartbeat", Name: "heartbeat"}))
+	require.False(t, h.isHeartbeatTable(&filter.Table{Schema: "dm_heartbeat", Name: "heartbeat2"}))
+
+	ts1 := time.Date(2023, 5, 5, 7, 3, 56, 0, time.UTC).UnixMicro()
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{{"2023-05-05T07:03:56.000950", int64(1)}}}, replication.WRITE_ROWS_EVENTv2)
+	require.Equal(t, ts1+950, h.lastTS.Load())
+	// the values after update are used.
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{
+		{"2023-05-05T07:03:56.000950", int64(1)},
+		{"2023-05-05T07:03:56.500950", int64(1)},
+	}}, replication.UPDATE_ROWS_EVENTv2)
+	require.Equal(t, ts1+500950, h.lastTS.Load())
+	// the latest heartbeat of all the rows is used.
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{
+		{"2023-05-05T07:03:58.25", int64(1)},
+		{"2023-05-05T07:03:57", int64(2)},
+	}}, replication.WRITE_ROWS_EVENTv2)
+	require.Equal(t, ts1+2250000, h.lastTS.Load())
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{
+		{"2023-05-05T07:03:56", int64(1)},
+		{"2023-05-05T07:03:58.5", int64(1)},
+		{"2023-05-05T07:03:57", int64(2)},
+		{"2023-05-05T07:03:58", int64(2)},
+	}}, replication.UPDATE_ROWS_EVENTv2)
+	require.Equal(t, ts1+2500000, h.lastTS.Load())
+	// older heartbeats and deletes are ignored.
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{{"2023-05-05T07:03:57", int64(2)}}}, replication.WRITE_ROWS_EVENTv2)
+	h.observe(tctx, &replication.RowsEvent{Rows: [][]interface{}{{"2023-05-05T07:04:57", int64(1)}}}, replication.DELETE_ROWS_EVENTv2)
+	require.Equal(t, ts1+2500000, h.lastTS.Load())
+
+	// read mode with a column which doesn't exist.
+	cfg = &config.HeartbeatConfig{Mode: config.HeartbeatModeRead, Table: "percona.heartbeat", TimestampColumn: "ts2"}
+	require.NoError(t, cfg.Adjust())
+	mock.ExpectQuery("SELECT ORDINAL_POSITION FROM information_schema.COLUMNS").
+		WithArgs("percona", "heartbeat", "ts2").
+		WillReturnRows(sqlmock.NewRows([]string{"ORDINAL_POSITION"}))
+	_, err = newHeartbeat(tctx, cfg, true, conn.NewBaseDBForTest(db))
+	require.True(t, terror.ErrConfigInvalidHeartbeat.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHeartbeatLag(t *testing.T) {
+	t.Parallel()
+
+	s := &Syncer{}
+	_, ok := s.heartbeatLag()
+	require.False(t, ok)
+
+	s.heartbeat = &heartbeat{}
+	_, ok = s.heartbeatLag()
+	require.False(t, ok)
+
+	s.heartbeat.lastTS.Store(time.Now().Add(-100500 * time.Millisecond).UnixMicro())
+	s.tsOffset.Store(10)
+	lag, ok := s.heartbeatLag()
+	require.True(t, ok)
+	require.InDelta(t, 90.5, lag, 0.1)
+}
@@ -38,7 +38,6 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
-	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
 	router "github.com/pingcap/tidb/util/table-router"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
@@ -57,6 +56,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/pessimism"
 	"github.com/pingcap/tiflow/dm/pkg/storage"
 	"github.com/pingcap/tiflow/dm/pkg/streamer"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/relay"
@@ -158,7 +158,7 @@ type Syncer struct {
 	isTransactionEnd    bool
 	waitTransactionLock sync.Mutex
 
-	tableRouter     *regexprrouter.RouteTable
+	tableRouter     *tablerouter.RouteTable
 	binlogFilter    *bf.BinlogEvent
 	baList          *filter.Filter
 	exprFilterGroup *ExprFilterGroup
@@ -223,6 +223,7 @@ type Syncer struct {
 	SourceTableNamesFlavor conn.LowerCaseTableNamesFlavor
 
 	tsOffset                  atomic.Int64    // time offset between upstream and syncer, DM's timestamp - MySQL's timestamp
+	heartbeat                 *heartbeat      // measure the replication lag by the heartbeats if it's not nil
 	secondsBehindMaster       atomic.Int64    // current task delay second behind upstream
 	workerJobTSArray          []*atomic.Int64 // worker's sync job TS array, note that idx=0 is skip idx and idx=1 is ddl idx,sql worker job idx=(queue id + 2)
 	lastCheckpointFlushedTime time.Time
@@ -379,6 +380,13 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-DBs", Fn: s.closeDBs})
 
+	if s.cfg.Heartbeat != nil {
+		s.heartbeat, err = newHeartbeat(tctx, s.cfg.Heartbeat, s.cfg.CaseSensitive, s.fromDB.BaseDB)
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.cfg.CollationCompatible == config.StrictCollationCompatible {
 		s.charsetAndDefaultCollation, s.idAndCollationMap, err = dbconn.GetCharsetAndCollationInfo(tctx, s.fromConn)
 		if err != nil {
@@ -889,7 +897,7 @@ func (s *Syncer) updateReplicationJobTS(job *job, jobIdx int) {
 }
 
 func (s *Syncer) updateReplicationLagMetric() {
-	var lag int64
+	var lag float64
 	var minTS int64
 
 	for idx := range s.workerJobTSArray {
@@ -900,17 +908,21 @@ func (s *Syncer) updateReplicationLagMetric() {
 		}
 	}
 	if minTS != int64(0) {
-		lag = s.calcReplicationLag(minTS)
+		lag = float64(s.calcReplicationLag(minTS))
+	}
+	// the heartbeats measure the lag when the upstream is idle, and the jobs measure the lag of executing them.
+	if heartbeatLag, ok := s.heartbeatLag(); ok && heartbeatLag > lag {
+		lag = heartbeatLag
 	}
 
-	s.metricsProxies.Metrics.ReplicationLagHistogram.Observe(float64(lag))
-	s.metricsProxies.Metrics.ReplicationLagGauge.Set(float64(lag))
-	s.secondsBehindMaster.Store(lag)
+	s.metricsProxies.Metrics.ReplicationLagHistogram.Observe(lag)
+	s.metricsProxies.Metrics.ReplicationLagGauge.Set(lag)
+	s.secondsBehindMaster.Store(int64(lag))
 
 	failpoint.Inject("ShowLagInLog", func(v failpoint.Value) {
 		minLag := v.(int)
 		if int(lag) >= minLag {
-			s.tctx.L().Info("ShowLagInLog", zap.Int64("lag", lag))
+			s.tctx.L().Info("ShowLagInLog", zap.Int64("lag", int64(lag)))
 		}
 	})
 
@@ -1747,6 +1759,10 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	go s.updateLagCronJob(s.runCtx.Ctx)
 	s.runWg.Add(1)
 	go s.updateTSOffsetCronJob(s.runCtx.Ctx)
+	if s.heartbeat != nil && s.cfg.Heartbeat.Mode == config.HeartbeatModeWrite {
+		s.runWg.Add(1)
+		go s.writeHeartbeatCronJob(s.runCtx.Ctx)
+	}
 
 	// some prepare work before the binlog event loop:
 	// 1. first we flush checkpoint as needed, so in next resume we won't go to Load unit.
@@ -2470,6 +2486,10 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 	}
 	targetTable := s.route(sourceTable)
 
+	if s.heartbeat != nil && s.heartbeat.isHeartbeatTable(sourceTable) {
+		s.heartbeat.observe(ec.tctx, ev, ec.header.EventType)
+	}
+
 	if ec.shardingReSync != nil {
 		ec.shardingReSync.currLocation = ec.endLocation
 		// When current progress has passed the latest location in re-sync, we can stop re-sync now.
@@ -2562,9 +2582,13 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		if err2 != nil {
 			return nil, err2
 		}
+		valueExprFilter, err2 := s.exprFilterGroup.GetValueExprs(sourceTable, tableInfo)
+		if err2 != nil {
+			return nil, err2
+		}
 
 		param.safeMode = ec.safeMode
-		dmls, err = s.genAndFilterUpdateDMLs(ec.tctx, param, oldExprFilter, newExprFilter)
+		dmls, err = s.genAndFilterUpdateDMLs(ec.tctx, param, oldExprFilter, newExprFilter, valueExprFilter)
 		if err != nil {
 			return nil, terror.Annotatef(err, "gen update sqls failed, sourceTable: %v, targetTable: %v", sourceTable, targetTable)
 		}
@@ -2665,7 +2689,7 @@ func (qec *queryEventContext) String() string {
 }
 
 // generateExtendColumn generate extended columns by extractor.
-func generateExtendColumn(data [][]interface{}, r *regexprrouter.RouteTable, table *filter.Table, sourceID string) [][]interface{} {
+func generateExtendColumn(data [][]interface{}, r *tablerouter.RouteTable, table *filter.Table, sourceID string) [][]interface{} {
 	extendCol, extendVal := r.FetchExtendColumn(table.Schema, table.Name, sourceID)
 	if len(extendCol) == 0 {
 		return nil
@@ -2869,7 +2893,7 @@ func (s *Syncer) trackOriginDDL(ev *replication.QueryEvent, ec eventContext) (ma
 }
 
 func (s *Syncer) genRouter() error {
-	s.tableRouter, _ = regexprrouter.NewRegExprRouter(s.cfg.CaseSensitive, []*router.TableRule{})
+	s.tableRouter, _ = tablerouter.NewRouteTable(s.cfg.CaseSensitive, []*router.TableRule{})
 	for _, rule := range s.cfg.RouteRules {
 		err := s.tableRouter.AddRule(rule)
 		if err != nil {
@@ -3077,7 +3101,7 @@ func (s *Syncer) route(table *filter.Table) *filter.Table {
 	return route(s.tableRouter, table)
 }
 
-func route(tableRouter *regexprrouter.RouteTable, table *filter.Table) *filter.Table {
+func route(tableRouter *tablerouter.RouteTable, table *filter.Table) *filter.Table {
 	if table.Schema == "" {
 		return table
 	}
@@ -3257,7 +3281,7 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 	var (
 		err             error
 		oldBaList       *filter.Filter
-		oldTableRouter  *regexprrouter.RouteTable
+		oldTableRouter  *tablerouter.RouteTable
 		oldBinlogFilter *bf.BinlogEvent
 	)
 
@@ -3285,7 +3309,7 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) error {
 
 	// update route
 	oldTableRouter = s.tableRouter
-	s.tableRouter, err = regexprrouter.NewRegExprRouter(cfg.CaseSensitive, cfg.RouteRules)
+	s.tableRouter, err = tablerouter.NewRouteTable(cfg.CaseSensitive, cfg.RouteRules)
 	if err != nil {
 		return terror.ErrSyncerUnitGenTableRouter.Delegate(err)
 	}
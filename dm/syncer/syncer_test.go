@@ -53,6 +53,7 @@ import (
 	parserpkg "github.com/pingcap/tiflow/dm/pkg/parser"
 	"github.com/pingcap/tiflow/dm/pkg/retry"
 	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
@@ -1022,7 +1023,7 @@ func (s *testSyncerSuite) TestRun(c *C) {
 	sourceSchemaFromCheckPoint, err := syncer.OperateSchema(ctx, &pb.OperateWorkerSchemaRequest{Op: pb.SchemaOp_GetSchema, Database: "test_1", Table: "t_1"})
 	c.Assert(err, IsNil)
 
-	syncer.tableRouter = &regexprrouter.RouteTable{}
+	syncer.tableRouter = &tablerouter.RouteTable{RouteTable: &regexprrouter.RouteTable{}}
 	c.Assert(syncer.tableRouter.AddRule(&router.TableRule{
 		SchemaPattern: "test_1",
 		TablePattern:  "t_1",
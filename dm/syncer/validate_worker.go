@@ -17,12 +17,14 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/docker/go-units"
 	"github.com/go-sql-driver/mysql"
@@ -32,6 +34,7 @@ import (
 	tidbmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
+	"github.com/pingcap/tidb/util/sqlexec"
 	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
@@ -66,6 +69,27 @@ type validateFailedRow struct {
 	srcJob *rowValidationJob
 }
 
+// genFixSQL generates the statement which makes the downstream row the same as
+// the upstream row, the values are interpolated so that it can be run as is.
+func (r *validateFailedRow) genFixSQL() (string, error) {
+	var (
+		query string
+		args  []interface{}
+	)
+	if r.tp == deletedRowExists {
+		query, args = r.srcJob.row.GenSQL(sqlmodel.DMLDelete)
+	} else {
+		query, args = r.srcJob.row.GenSQL(sqlmodel.DMLReplace)
+	}
+	return interpolateSQL(query, args)
+}
+
+// quarantinedRow is an error row which will be validated again after the recheck delay.
+type quarantinedRow struct {
+	row         *validateFailedRow
+	recheckTime time.Time
+}
+
 type validateWorker struct {
 	sync.Mutex
 	cfg                config.ValidatorConfig
@@ -77,6 +101,7 @@ type validateWorker struct {
 	rowChangeCh        chan *rowValidationJob
 	batchSize          int
 	rowErrorDelayInSec int64
+	rowRecheckDelay    time.Duration
 	maxPendingRowSize  int64
 	maxPendingRowCount int64
 
@@ -85,6 +110,11 @@ type validateWorker struct {
 	pendingRowSize    int64
 	accuRowCount      atomic.Int64 // accumulated row count from channel
 	errorRows         []*validateFailedRow
+	// quarantinedRows are kept in memory only, the error rows quarantined before
+	// the validator restarts are not rechecked.
+	quarantinedRows []*quarantinedRow
+	// resolvedRows are the quarantined rows which are validated successfully on recheck.
+	resolvedRows []*validateFailedRow
 }
 
 func newValidateWorker(v *DataValidator, id int) *validateWorker {
@@ -102,6 +132,7 @@ func newValidateWorker(v *DataValidator, id int) *validateWorker {
 		rowChangeCh:        make(chan *rowValidationJob, workerChannelSize),
 		batchSize:          v.cfg.ValidatorCfg.BatchQuerySize,
 		rowErrorDelayInSec: rowErrorDelayInSec,
+		rowRecheckDelay:    v.cfg.ValidatorCfg.RowRecheckDelay.Duration,
 		maxPendingRowSize:  maxPendingRowSize,
 		maxPendingRowCount: int64(v.cfg.ValidatorCfg.MaxPendingRowCount),
 
@@ -178,6 +209,10 @@ func (vw *validateWorker) validateTableChange() {
 	// clear accumulated row counter
 	vw.accuRowCount.Store(0)
 
+	if err = vw.recheckQuarantinedRows(); err != nil {
+		return
+	}
+
 	if vw.getAllPendingRowCount() == 0 {
 		vw.L.Debug("pending row count = 0, skip validation")
 		return
@@ -278,6 +313,78 @@ func (vw *validateWorker) updatePendingAndErrorRows(failedChanges map[string]map
 	vw.pendingChangesMap = newPendingChanges
 	vw.errorRows = append(vw.errorRows, allErrorRows...)
 	vw.validator.incrErrorRowCount(len(allErrorRows))
+	if vw.rowRecheckDelay > 0 {
+		recheckTime := time.Now().Add(vw.rowRecheckDelay)
+		for _, row := range allErrorRows {
+			vw.quarantinedRows = append(vw.quarantinedRows, &quarantinedRow{row: row, recheckTime: recheckTime})
+		}
+	}
+}
+
+// recheckQuarantinedRows validates the quarantined rows whose recheck delay has passed.
+// the rows validated successfully are marked as resolved, the others stay as error rows
+// and are not rechecked again.
+func (vw *validateWorker) recheckQuarantinedRows() error {
+	dueRows := vw.takeDueQuarantinedRows(time.Now())
+	if len(dueRows) == 0 {
+		return nil
+	}
+
+	resolvedRows := make([]*validateFailedRow, 0)
+	for _, isDelete := range []bool{false, true} {
+		// rows are validated in batch of the same target table
+		tableRows := make(map[string][]*validateFailedRow)
+		for _, q := range dueRows {
+			if (q.row.srcJob.Tp == rowDeleted) != isDelete {
+				continue
+			}
+			tbl := q.row.srcJob.row.GetTargetTable()
+			targetTable := filter.Table{Schema: tbl.Schema, Name: tbl.Table}
+			fullTableName := targetTable.String()
+			tableRows[fullTableName] = append(tableRows[fullTableName], q.row)
+		}
+		for _, rows := range tableRows {
+			jobs := make([]*rowValidationJob, 0, len(rows))
+			for _, row := range rows {
+				jobs = append(jobs, row.srcJob)
+			}
+			failedRows, err := vw.validateRowChanges(jobs, isDelete)
+			if err != nil {
+				// recheck them next time
+				vw.Lock()
+				vw.quarantinedRows = append(vw.quarantinedRows, dueRows...)
+				vw.Unlock()
+				return err
+			}
+			for _, row := range rows {
+				if _, ok := failedRows[row.srcJob.Key]; !ok {
+					resolvedRows = append(resolvedRows, row)
+				}
+			}
+		}
+	}
+
+	vw.L.Info("quarantined rows rechecked", zap.Int("rechecked", len(dueRows)),
+		zap.Int("resolved", len(resolvedRows)))
+	vw.Lock()
+	defer vw.Unlock()
+	vw.resolvedRows = append(vw.resolvedRows, resolvedRows...)
+	return nil
+}
+
+func (vw *validateWorker) takeDueQuarantinedRows(now time.Time) []*quarantinedRow {
+	vw.Lock()
+	defer vw.Unlock()
+	var dueRows, remainRows []*quarantinedRow
+	for _, q := range vw.quarantinedRows {
+		if now.Before(q.recheckTime) {
+			remainRows = append(remainRows, q)
+		} else {
+			dueRows = append(dueRows, q)
+		}
+	}
+	vw.quarantinedRows = remainRows
+	return dueRows
 }
 
 func (vw *validateWorker) validateRowChanges(rows []*rowValidationJob, deleteChange bool) (map[string]*validateFailedRow, error) {
@@ -311,6 +418,12 @@ func (vw *validateWorker) getErrorRows() []*validateFailedRow {
 	return vw.errorRows
 }
 
+func (vw *validateWorker) getResolvedRows() []*validateFailedRow {
+	vw.Lock()
+	defer vw.Unlock()
+	return vw.resolvedRows
+}
+
 func (vw *validateWorker) batchValidateRowChanges(rows []*rowValidationJob, deleteChange bool) (map[string]*validateFailedRow, error) {
 	failpoint.Inject("ValidatorWorkerPanic", func() {})
 
@@ -443,6 +556,7 @@ func (vw *validateWorker) resetErrorRows() {
 	vw.Lock()
 	defer vw.Unlock()
 	vw.errorRows = make([]*validateFailedRow, 0)
+	vw.resolvedRows = make([]*validateFailedRow, 0)
 }
 
 func (vw *validateWorker) newJobAdded(job *rowValidationJob) {
@@ -578,3 +692,44 @@ func getSourceRowsForCompare(jobs []*rowValidationJob) map[string][]*sql.NullStr
 	}
 	return rowMap
 }
+
+// interpolateSQL replaces the placeholders of the query with the literals of the args,
+// the question marks in the quoted identifiers are not placeholders.
+func interpolateSQL(query string, args []interface{}) (string, error) {
+	var buf strings.Builder
+	buf.Grow(len(query) + 16*len(args))
+	argPos := 0
+	quoted := false
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		if ch == '`' {
+			quoted = !quoted
+		} else if ch == '?' && !quoted {
+			if argPos >= len(args) {
+				return "", errors.Errorf("missing arguments of query %s, got %d args", query, len(args))
+			}
+			buf.WriteString(sqlLiteral(args[argPos]))
+			argPos++
+			continue
+		}
+		buf.WriteByte(ch)
+	}
+	if argPos != len(args) {
+		return "", errors.Errorf("too many arguments of query %s, need %d args, got %d args", query, argPos, len(args))
+	}
+	return buf.String(), nil
+}
+
+func sqlLiteral(v interface{}) string {
+	switch dv := v.(type) {
+	case nil:
+		return "NULL"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", dv)
+	case []byte:
+		if !utf8.Valid(dv) {
+			return "x'" + hex.EncodeToString(dv) + "'"
+		}
+	}
+	return "'" + sqlexec.EscapeString(sqlmodel.ColValAsStr(v)) + "'"
+}
@@ -18,6 +18,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	gmysql "github.com/go-sql-driver/mysql"
@@ -563,3 +564,107 @@ func TestValidatorRowCountAndSize(t *testing.T) {
 	require.Equal(t, int64(0), validator.pendingRowCounts[rowDeleted].Load())
 	require.Equal(t, int64(300), validator.pendingRowSize.Load())
 }
+
+func TestValidatorWorkerRecheckQuarantinedRows(t *testing.T) {
+	require.Nil(t, failpoint.Enable("github.com/pingcap/tiflow/dm/syncer/ValidatorMockUpstreamTZ", `return()`))
+	defer func() {
+		require.Nil(t, failpoint.Disable("github.com/pingcap/tiflow/dm/syncer/ValidatorMockUpstreamTZ"))
+	}()
+	tbl1 := filter.Table{Schema: "test", Name: "tbl1"}
+	tableInfo1 := genValidateTableInfo(t, "create table tbl1(a int primary key, b varchar(100))")
+
+	cfg := genSubtaskConfig(t)
+	cfg.ValidatorCfg.Mode = config.ValidationFull
+	cfg.ValidatorCfg.RowRecheckDelay.Duration = time.Hour
+	_, mock, err := conn.InitMockDBFull()
+	require.NoError(t, err)
+	defer func() {
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+	}()
+	syncerObj := NewSyncer(cfg, nil, nil)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.persistHelper.schemaInitialized.Store(true)
+	require.NoError(t, validator.initialize())
+	defer validator.cancel()
+	validator.markErrorStarted.Store(true)
+
+	worker := newValidateWorker(validator, 0)
+	worker.rowErrorDelayInSec = 0
+	require.Equal(t, time.Hour, worker.rowRecheckDelay)
+
+	// both rows become error rows and are quarantined
+	worker.updateRowChange(genRowChangeJob(tbl1, tableInfo1, "1", rowInsert, []interface{}{1, "a"}))
+	worker.updateRowChange(genRowChangeJob(tbl1, tableInfo1, "2", rowDeleted, []interface{}{2, "b"}))
+	mock.ExpectQuery("SELECT .* FROM .*tbl1.* WHERE .*").WillReturnRows(
+		sqlmock.NewRows([]string{"a", "b"}))
+	mock.ExpectQuery("SELECT .* FROM .*tbl1.* WHERE .*").WillReturnRows(
+		sqlmock.NewRows([]string{"a", "b"}).AddRow(2, "b"))
+	worker.validateTableChange()
+	require.Zero(t, validator.result.Errors)
+	require.Len(t, worker.errorRows, 2)
+	require.Len(t, worker.quarantinedRows, 2)
+	require.Empty(t, worker.getResolvedRows())
+
+	// the recheck delay hasn't passed
+	worker.validateTableChange()
+	require.Len(t, worker.quarantinedRows, 2)
+	require.Empty(t, worker.getResolvedRows())
+
+	// the inserted row is synced, while the deleted row still exists
+	for _, q := range worker.quarantinedRows {
+		q.recheckTime = time.Now().Add(-time.Second)
+	}
+	mock.ExpectQuery("SELECT .* FROM .*tbl1.* WHERE .*").WillReturnRows(
+		sqlmock.NewRows([]string{"a", "b"}).AddRow(1, "a"))
+	mock.ExpectQuery("SELECT .* FROM .*tbl1.* WHERE .*").WillReturnRows(
+		sqlmock.NewRows([]string{"a", "b"}).AddRow(2, "b"))
+	worker.validateTableChange()
+	require.Zero(t, validator.result.Errors)
+	require.Empty(t, worker.quarantinedRows)
+	resolvedRows := worker.getResolvedRows()
+	require.Len(t, resolvedRows, 1)
+	require.Equal(t, "1", resolvedRows[0].srcJob.Key)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	worker.resetErrorRows()
+	require.Empty(t, worker.getErrorRows())
+	require.Empty(t, worker.getResolvedRows())
+}
+
+func TestValidatorGenFixSQL(t *testing.T) {
+	tbl1 := filter.Table{Schema: "test", Name: "tbl1"}
+	tableInfo1 := genValidateTableInfo(t, "create table tbl1(a int primary key, b varchar(100), c blob)")
+
+	row := &validateFailedRow{
+		tp:     rowNotExist,
+		srcJob: genRowChangeJob(tbl1, tableInfo1, "1", rowInsert, []interface{}{1, "it's", []byte{0xff, 0x01}}),
+	}
+	fixSQL, err := row.genFixSQL()
+	require.NoError(t, err)
+	require.Equal(t, "REPLACE INTO `test`.`tbl1` (`a`,`b`,`c`) VALUES (1,'it\\'s',x'ff01')", fixSQL)
+
+	row = &validateFailedRow{
+		tp:     rowDifferent,
+		srcJob: genRowChangeJob(tbl1, tableInfo1, "1", rowUpdated, []interface{}{1, nil, []byte("c")}),
+	}
+	fixSQL, err = row.genFixSQL()
+	require.NoError(t, err)
+	require.Equal(t, "REPLACE INTO `test`.`tbl1` (`a`,`b`,`c`) VALUES (1,NULL,'c')", fixSQL)
+
+	row = &validateFailedRow{
+		tp:     deletedRowExists,
+		srcJob: genRowChangeJob(tbl1, tableInfo1, "1", rowDeleted, []interface{}{1, "a", nil}),
+	}
+	fixSQL, err = row.genFixSQL()
+	require.NoError(t, err)
+	require.Equal(t, "DELETE FROM `test`.`tbl1` WHERE `a` = 1 LIMIT 1", fixSQL)
+
+	// question marks in the quoted identifiers are not placeholders
+	fixSQL, err = interpolateSQL("DELETE FROM `t?` WHERE `a?` = ? AND `b` IS ?", []interface{}{1.5, nil})
+	require.NoError(t, err)
+	require.Equal(t, "DELETE FROM `t?` WHERE `a?` = 1.5 AND `b` IS NULL", fixSQL)
+	_, err = interpolateSQL("DELETE FROM `t` WHERE `a` = ?", nil)
+	require.Error(t, err)
+	_, err = interpolateSQL("DELETE FROM `t` WHERE `a` = ?", []interface{}{1, 2})
+	require.Error(t, err)
+}
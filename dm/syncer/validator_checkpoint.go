@@ -24,6 +24,7 @@ import (
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/util/dbutil"
 	"github.com/pingcap/tidb/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
@@ -168,6 +169,7 @@ func (c *validatorPersistHelper) createTable(tctx *tcontext.Context) error {
 			dst_data JSON NOT NULL,
 			error_type int NOT NULL,
 			status int NOT NULL,
+			fix_sql TEXT,
 			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 			UNIQUE KEY uk_source_schema_table_key(source, src_schema_name, src_table_name, row_pk),
@@ -194,6 +196,11 @@ func (c *validatorPersistHelper) createTable(tctx *tcontext.Context) error {
 			return err
 		}
 	}
+	// the error change table created by previous versions has no fix_sql column
+	query := `ALTER TABLE ` + c.errorChangeTableName + ` ADD COLUMN fix_sql TEXT`
+	if _, err := c.db.ExecContext(tctx, query); err != nil && !conn.IsMySQLError(err, tmysql.ErrDupFieldName) {
+		return err
+	}
 	return nil
 }
 
@@ -271,8 +278,8 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 	for _, worker := range c.validator.getWorkers() {
 		for _, r := range worker.getErrorRows() {
 			query := `INSERT INTO ` + c.errorChangeTableName + `
-					(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status)
-					VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
+					(source, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, data, dst_data, error_type, status, fix_sql)
+					VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE
 					source = VALUES(source),
 					src_schema_name = VALUES(src_schema_name),
 					src_table_name = VALUES(src_table_name),
@@ -282,7 +289,8 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 					data = VALUES(data),
 					dst_data = VALUES(dst_data),
 					error_type = VALUES(error_type),
-					status = VALUES(status)
+					status = VALUES(status),
+					fix_sql = VALUES(fix_sql)
 			`
 			queries = append(queries, query)
 
@@ -301,12 +309,30 @@ func (c *validatorPersistHelper) persistTableStatusAndErrors(tctx *tcontext.Cont
 			if err != nil {
 				return err
 			}
+			fixSQL, err := r.genFixSQL()
+			if err != nil {
+				return err
+			}
 			sourceTable := row.GetSourceTable()
 			targetTable := row.GetTargetTable()
 			args = append(args, []interface{}{
 				c.cfg.SourceID, sourceTable.Schema, sourceTable.Table, r.srcJob.Key,
 				targetTable.Schema, targetTable.Table,
-				string(srcDataBytes), string(dstDataBytes), r.tp, pb.ValidateErrorState_NewErr,
+				string(srcDataBytes), string(dstDataBytes), r.tp, pb.ValidateErrorState_NewErr, fixSQL,
+			})
+		}
+	}
+	// mark error rows validated successfully on recheck as resolved, unless they are
+	// operated by user already
+	for _, worker := range c.validator.getWorkers() {
+		for _, r := range worker.getResolvedRows() {
+			query := `UPDATE ` + c.errorChangeTableName + ` SET status = ?
+					WHERE source = ? AND src_schema_name = ? AND src_table_name = ? AND row_pk = ? AND status = ?`
+			queries = append(queries, query)
+			sourceTable := r.srcJob.row.GetSourceTable()
+			args = append(args, []interface{}{
+				pb.ValidateErrorState_ResolvedErr, c.cfg.SourceID, sourceTable.Schema, sourceTable.Table,
+				r.srcJob.Key, pb.ValidateErrorState_NewErr,
 			})
 		}
 	}
@@ -639,7 +665,7 @@ func (c *validatorPersistHelper) loadError(tctx *tcontext.Context, db *conn.Base
 	args := []interface{}{
 		c.cfg.SourceID,
 	}
-	query := "SELECT id, source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, data, dst_data, error_type, status, update_time " +
+	query := "SELECT id, source, src_schema_name, src_table_name, dst_schema_name, dst_table_name, data, dst_data, error_type, status, update_time, fix_sql " +
 		"FROM " + c.errorChangeTableName + " WHERE source = ?"
 	if filterState != pb.ValidateErrorState_InvalidErr {
 		query += " AND status=?"
@@ -655,8 +681,9 @@ func (c *validatorPersistHelper) loadError(tctx *tcontext.Context, db *conn.Base
 		var (
 			id, status, errType                                                                 int
 			source, srcSchemaName, srcTableName, dstSchemaName, dstTableName, data, dstData, ts string
+			fixSQL                                                                              sql.NullString
 		)
-		err = rows.Scan(&id, &source, &srcSchemaName, &srcTableName, &dstSchemaName, &dstTableName, &data, &dstData, &errType, &status, &ts)
+		err = rows.Scan(&id, &source, &srcSchemaName, &srcTableName, &dstSchemaName, &dstTableName, &data, &dstData, &errType, &status, &ts, &fixSQL)
 		if err != nil {
 			return []*pb.ValidationError{}, err
 		}
@@ -670,6 +697,7 @@ func (c *validatorPersistHelper) loadError(tctx *tcontext.Context, db *conn.Base
 			ErrorType: mapErrType2Str[validateFailedType(errType)],
 			Status:    pb.ValidateErrorState(status),
 			Time:      ts,
+			Message:   fixSQL.String,
 		})
 	}
 	if err = rows.Err(); err != nil {
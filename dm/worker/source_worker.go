@@ -58,6 +58,10 @@ type SourceWorker struct {
 	cfg        *config.SourceConfig
 	sourceDB   *conn.BaseDB
 	sourceDBMu sync.Mutex // if the sourceDB can't be connected at start time, we try to re-connect before using it.
+	// the address of the upstream switched to by failover, empty means @From of the source config is used.
+	upstreamAddr string
+	// the number of times in a row the source status fails to update, only accessed in Start.
+	upstreamFailures int
 
 	l log.Logger
 
@@ -187,9 +191,11 @@ func (w *SourceWorker) Start() {
 					w.l.Warn("This source's bin_log is OFF, so it only supports full_mode.", zap.String("sourceID", w.cfg.SourceID), zap.Error(err2))
 				} else {
 					w.l.Error("failed to update source status", zap.Error(err2))
+					w.onUpstreamFailure(w.ctx)
 				}
 				continue
 			}
+			w.upstreamFailures = 0
 
 			sourceStatus := w.sourceStatus.Load().(*binlog.SourceStatus)
 			if w.l.Core().Enabled(zap.DebugLevel) {
@@ -638,6 +644,12 @@ func (w *SourceWorker) OperateSubTask(name string, op pb.TaskOp) error {
 		err = st.Resume(w.getRelayWithoutLock())
 	case pb.TaskOp_AutoResume:
 		// TODO(ehco) change to auto_restart
+		// the upstream may be switched by failover after the subtask is paused.
+		if st.cfg.From.Host != w.cfg.From.Host || st.cfg.From.Port != w.cfg.From.Port {
+			if refreshErr := w.tryRefreshSubTaskAndSourceConfig(st); refreshErr != nil {
+				w.l.Warn("can not update subtask config now", zap.Error(refreshErr))
+			}
+		}
 		w.l.Info("auto_resume subtask", zap.String("task", name))
 		err = st.Resume(w.getRelayWithoutLock())
 	default:
@@ -1283,7 +1295,7 @@ func (w *SourceWorker) refreshSourceCfg() error {
 	if err != nil {
 		return err
 	}
-	w.cfg = sourceCfgM[oldCfg.SourceID]
+	w.cfg = w.withFailoverUpstream(sourceCfgM[oldCfg.SourceID])
 	return nil
 }
 
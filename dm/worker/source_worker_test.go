@@ -853,3 +853,77 @@ func TestMasterBinlogOff(t *testing.T) {
 	require.Len(t, status, 1)
 	require.Equal(t, subtaskCfg.Name, status[0].Name)
 }
+
+func TestTryFailoverUpstream(t *testing.T) {
+	ctx := context.Background()
+	cfg, err := config.ParseYamlAndVerify(config.SampleSourceConfig)
+	require.NoError(t, err)
+	cfg.From.Password = "no need to connect"
+
+	w, err := NewSourceWorker(cfg, nil, "", "")
+	require.NoError(t, err)
+	// no replicas
+	require.False(t, w.tryFailoverUpstream(ctx))
+
+	cfg.EnableGTID = true
+	cfg.FromReplicas = []string{"127.0.0.1:3307", "127.0.0.1:3308"}
+	db, mockDB, err := conn.InitMockDBNotClose()
+	require.NoError(t, err)
+	// close it so that it's not used by other tests
+	defer db.Close()
+	// the first replica can't be connected, fail over to the second one
+	mockDB.ExpectQuery(`SHOW MASTER STATUS`).WillReturnError(errors.New("connection refused"))
+	mockShowMasterStatus(mockDB)
+	require.True(t, w.tryFailoverUpstream(ctx))
+	require.Equal(t, 3308, w.cfg.From.Port)
+	require.Equal(t, "127.0.0.1:3308", w.upstreamAddr)
+	require.Equal(t, []string{"127.0.0.1:3308", "127.0.0.1:3306", "127.0.0.1:3307"}, w.cfg.UpstreamAddrs())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+
+	// the upstream switched to is kept after refreshing the source config
+	refreshed := w.withFailoverUpstream(cfg)
+	require.Equal(t, 3308, refreshed.From.Port)
+	cfg.FromReplicas = []string{"127.0.0.1:3307"}
+	require.Same(t, cfg, w.withFailoverUpstream(cfg))
+	require.Empty(t, w.upstreamAddr)
+}
+
+func TestOnUpstreamFailure(t *testing.T) {
+	ctx := context.Background()
+	cfg, err := config.ParseYamlAndVerify(config.SampleSourceConfig)
+	require.NoError(t, err)
+	cfg.From.Password = "no need to connect"
+	cfg.EnableGTID = true
+	cfg.FromReplicas = []string{"127.0.0.1:3307"}
+
+	w, err := NewSourceWorker(cfg, nil, "", "")
+	require.NoError(t, err)
+	db, mockDB, err := conn.InitMockDBNotClose()
+	require.NoError(t, err)
+	// close it so that it's not used by other tests
+	defer db.Close()
+
+	// a single failure doesn't trigger failover, nor probe any upstream.
+	require.False(t, w.onUpstreamFailure(ctx))
+	require.Equal(t, 1, w.upstreamFailures)
+	require.Equal(t, 3306, w.cfg.From.Port)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+
+	// the current upstream is re-probed after failover-threshold failures, and it's kept if it's reachable.
+	require.False(t, w.onUpstreamFailure(ctx))
+	mockShowMasterStatus(mockDB)
+	require.False(t, w.onUpstreamFailure(ctx))
+	require.Equal(t, 0, w.upstreamFailures)
+	require.Equal(t, 3306, w.cfg.From.Port)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+
+	// fail over if the current upstream is still unreachable.
+	cfg.FailoverThreshold = 2
+	require.False(t, w.onUpstreamFailure(ctx))
+	mockDB.ExpectQuery(`SHOW MASTER STATUS`).WillReturnError(errors.New("connection refused"))
+	mockShowMasterStatus(mockDB)
+	require.True(t, w.onUpstreamFailure(ctx))
+	require.Equal(t, 0, w.upstreamFailures)
+	require.Equal(t, 3307, w.cfg.From.Port)
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tiflow/dm/unit"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 )
 
 // Backoff related constants
@@ -112,6 +113,13 @@ type AutoResumeInfo struct {
 	LatestPausedTime time.Time
 	LatestBlockTime  time.Time
 	LatestResumeTime time.Time
+
+	// MaxAttempts is the max number of auto-resumes in a row, 0 means no limit.
+	MaxAttempts int
+	// ErrorClasses are the classes of the errors to auto-resume, all the errors are auto-resumed if it's empty.
+	ErrorClasses []string
+	// Attempts is the number of auto-resumes in a row, it's reset when the backoff is rolled back.
+	Attempts int
 }
 
 // realTaskStatusChecker is not thread-safe.
@@ -202,7 +210,7 @@ func (tsc *realTaskStatusChecker) run() {
 
 // CheckResumeSubtask updates info and returns ResumeStrategy for a subtask.
 // When ResumeDispatch and the subtask is successfully resumed at caller, caller
-// should update LatestResumeTime and backoff.
+// should update LatestResumeTime, Attempts and backoff.
 // This function is exposed for DM as library.
 func (i *AutoResumeInfo) CheckResumeSubtask(
 	stStatus *pb.SubTaskStatus,
@@ -226,6 +234,13 @@ func (i *AutoResumeInfo) CheckResumeSubtask(
 			})
 			return ResumeNoSense
 		}
+		if len(i.ErrorClasses) > 0 && !slices.Contains(i.ErrorClasses, processErr.ErrClass) {
+			return ResumeNoSense
+		}
+	}
+
+	if i.MaxAttempts > 0 && i.Attempts >= i.MaxAttempts {
+		return ResumeNoSense
 	}
 
 	// auto resume interval does not exceed backoff duration, skip this paused task
@@ -269,6 +284,7 @@ func (i *AutoResumeInfo) update(strategy ResumeStrategy, backoffRollback time.Du
 			i.Backoff.Rollback()
 			// after each rollback, reset this timer
 			i.LatestPausedTime = time.Now()
+			i.Attempts = 0
 		}
 	case ResumeNoSense:
 		// this strategy doesn't forward or rollback backoff
@@ -332,13 +348,23 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 	}()
 
 	for taskName, stStatus := range allSubTaskStatus {
+		autoResumeCfg := tsc.subtaskAutoResumeConfig(taskName)
 		info, ok := tsc.subtaskAutoResume[taskName]
 		if !ok {
-			bf, _ := backoff.NewBackoff(
-				tsc.cfg.BackoffFactor,
+			bf, err := backoff.NewBackoff(
+				autoResumeCfg.BackoffFactor,
 				tsc.cfg.BackoffJitter,
-				tsc.cfg.BackoffMin.Duration,
-				tsc.cfg.BackoffMax.Duration)
+				autoResumeCfg.BackoffMin.Duration,
+				autoResumeCfg.BackoffMax.Duration)
+			if err != nil {
+				tsc.l.Warn("invalid auto-resume config of task, use the checker config instead",
+					zap.String("task", taskName), zap.Error(err))
+				bf, _ = backoff.NewBackoff(
+					tsc.cfg.BackoffFactor,
+					tsc.cfg.BackoffJitter,
+					tsc.cfg.BackoffMin.Duration,
+					tsc.cfg.BackoffMax.Duration)
+			}
 			info = &AutoResumeInfo{
 				Backoff:          bf,
 				LatestPausedTime: time.Now(),
@@ -346,11 +372,14 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 			}
 			tsc.subtaskAutoResume[taskName] = info
 		}
-		strategy := info.CheckResumeSubtask(stStatus, tsc.cfg.BackoffRollback.Duration)
+		info.MaxAttempts = autoResumeCfg.MaxAttempts
+		info.ErrorClasses = autoResumeCfg.ErrorClasses
+		strategy := info.CheckResumeSubtask(stStatus, autoResumeCfg.BackoffRollback.Duration)
 		switch strategy {
 		case ResumeNoSense:
 			tsc.l.Warn("task can't auto resume",
 				zap.String("task", taskName),
+				zap.Int("attempts", info.Attempts),
 				zap.Duration("paused duration", time.Since(info.LatestBlockTime)))
 		case ResumeSkip:
 			tsc.l.Warn("backoff skip auto resume task",
@@ -364,12 +393,25 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 			} else {
 				tsc.l.Info("dispatch auto resume task", zap.String("task", taskName))
 				info.LatestResumeTime = time.Now()
+				info.Attempts++
 				info.Backoff.BoundaryForward()
 			}
 		}
 	}
 }
 
+// subtaskAutoResumeConfig returns the auto-resume policy of the subtask, which is the one in the task config
+// merged with the checker config.
+func (tsc *realTaskStatusChecker) subtaskAutoResumeConfig(taskName string) config.AutoResumeConfig {
+	var autoResume *config.AutoResumeConfig
+	if st := tsc.w.subTaskHolder.findSubTask(taskName); st != nil {
+		if cfg := st.getCfg(); cfg != nil {
+			autoResume = cfg.AutoResume
+		}
+	}
+	return autoResume.Merge(tsc.cfg)
+}
+
 func (tsc *realTaskStatusChecker) check() {
 	if tsc.w.relayEnabled.Load() {
 		tsc.checkRelayStatus()
@@ -81,6 +81,35 @@ func TestResumeStrategy(t *testing.T) {
 	}
 }
 
+func TestResumeStrategyWithPolicy(t *testing.T) {
+	taskName := "test-task"
+	databaseError := unit.NewProcessError(terror.ErrDBBadConn.Delegate(errors.New("connection refused")))
+	pausedStatus := func(err *pb.ProcessError) *pb.SubTaskStatus {
+		return &pb.SubTaskStatus{Name: taskName, Stage: pb.Stage_Paused, Result: &pb.ProcessResult{Errors: []*pb.ProcessError{err}}}
+	}
+	bf, err := backoff.NewBackoff(1, false, time.Millisecond, time.Millisecond)
+	require.NoError(t, err)
+	info := &AutoResumeInfo{
+		Backoff:      bf,
+		MaxAttempts:  2,
+		ErrorClasses: []string{terror.ClassDatabase.String()},
+	}
+
+	// errors of other classes are not auto-resumed.
+	require.Equal(t, ResumeNoSense, info.CheckResumeSubtask(pausedStatus(unknownProcessError), config.DefaultBackoffRollback))
+	require.Equal(t, ResumeDispatch, info.CheckResumeSubtask(pausedStatus(databaseError), config.DefaultBackoffRollback))
+
+	// no more auto-resumes after max attempts.
+	info.Attempts = 2
+	require.Equal(t, ResumeNoSense, info.CheckResumeSubtask(pausedStatus(databaseError), config.DefaultBackoffRollback))
+
+	// the attempts are reset after the subtask runs without error for backoff rollback.
+	info.LatestPausedTime = time.Now().Add(-time.Second)
+	require.Equal(t, ResumeIgnore, info.CheckResumeSubtask(&pb.SubTaskStatus{Name: taskName, Stage: pb.Stage_Running}, 100*time.Millisecond))
+	require.Equal(t, 0, info.Attempts)
+	require.Equal(t, ResumeDispatch, info.CheckResumeSubtask(pausedStatus(databaseError), config.DefaultBackoffRollback))
+}
+
 func TestCheck(t *testing.T) {
 	var (
 		latestResumeTime time.Time
@@ -0,0 +1,196 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"go.uber.org/zap"
+)
+
+// onUpstreamFailure is called when the source status fails to update. To not switch the upstream back and forth on
+// transient errors, it fails over only after the status fails to update for failover-threshold times in a row, and
+// the current upstream can't be reached by a new connection either. It returns whether the upstream is switched.
+func (w *SourceWorker) onUpstreamFailure(ctx context.Context) bool {
+	w.RLock()
+	cfg := w.cfg
+	w.RUnlock()
+	if len(cfg.FromReplicas) == 0 || !cfg.EnableGTID {
+		return false
+	}
+	w.upstreamFailures++
+	if w.upstreamFailures < cfg.GetFailoverThreshold() {
+		w.l.Warn("upstream is unavailable, wait for more failures before failover",
+			zap.Int("failures", w.upstreamFailures), zap.Int("threshold", cfg.GetFailoverThreshold()))
+		return false
+	}
+	// re-probe the current upstream by a new connection before failover.
+	if err := checkUpstreamGTID(ctx, cfg, nil); err == nil {
+		w.l.Info("current upstream is reachable, skip upstream failover", zap.String("address", cfg.UpstreamAddrs()[0]))
+		w.upstreamFailures = 0
+		return false
+	}
+	if !w.tryFailoverUpstream(ctx) {
+		return false
+	}
+	w.upstreamFailures = 0
+	return true
+}
+
+// tryFailoverUpstream is called when the current upstream of the source can't be reached. It switches the source to
+// the first reachable address in `from` and `from-replicas` which has executed all GTIDs consumed by the relay and the
+// subtasks, so they can continue from their GTID locations on the new upstream. It returns whether the upstream is
+// switched.
+func (w *SourceWorker) tryFailoverUpstream(ctx context.Context) bool {
+	w.RLock()
+	cfg := w.cfg
+	if len(cfg.FromReplicas) == 0 || !cfg.EnableGTID {
+		w.RUnlock()
+		return false
+	}
+	consumed, err := w.consumedGTIDSets()
+	w.RUnlock()
+	if err != nil {
+		w.l.Error("fail to get consumed GTID sets, skip upstream failover", zap.Error(err))
+		return false
+	}
+
+	// the first address is the current upstream which can't be reached.
+	for _, addr := range cfg.UpstreamAddrs()[1:] {
+		newCfg, err2 := cfg.WithUpstreamAddr(addr)
+		if err2 != nil {
+			w.l.Warn("skip invalid upstream address", zap.String("address", addr), zap.Error(err2))
+			continue
+		}
+		if err2 = checkUpstreamGTID(ctx, newCfg, consumed); err2 != nil {
+			w.l.Warn("skip unavailable upstream", zap.String("address", addr), zap.Error(err2))
+			continue
+		}
+
+		w.Lock()
+		defer w.Unlock()
+		if w.cfg != cfg {
+			w.l.Info("source config is changed during upstream failover, skip it")
+			return false
+		}
+		w.switchUpstreamWithoutLock(ctx, newCfg, addr)
+		return true
+	}
+	w.l.Warn("no available upstream to fail over", zap.Strings("addresses", cfg.UpstreamAddrs()))
+	return false
+}
+
+// consumedGTIDSets returns the GTID sets consumed from the upstream by the relay and the subtasks which read binlog
+// from the upstream directly.
+func (w *SourceWorker) consumedGTIDSets() ([]mysql.GTIDSet, error) {
+	var gtidStrs []string
+	if w.relayEnabled.Load() && w.relayHolder != nil {
+		gtidStrs = append(gtidStrs, w.relayHolder.Status(nil).RelayBinlogGtid)
+	}
+	for _, st := range w.subTaskHolder.getAllSubTasks() {
+		if st.cfg.UseRelay {
+			continue
+		}
+		cu := st.CurrUnit()
+		if cu == nil || cu.Type() != pb.UnitType_Sync {
+			continue
+		}
+		if status, ok := cu.Status(nil).(*pb.SyncStatus); ok {
+			gtidStrs = append(gtidStrs, status.SyncerBinlogGtid)
+		}
+	}
+
+	sets := make([]mysql.GTIDSet, 0, len(gtidStrs))
+	for _, gtidStr := range gtidStrs {
+		if gtidStr == "" {
+			continue
+		}
+		set, err := gtid.ParserGTID(w.cfg.Flavor, gtidStr)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// switchUpstreamWithoutLock switches the source to the upstream of cfg. The relay reconnects to the new upstream at
+// once, and the subtasks reconnect to it when they are resumed.
+func (w *SourceWorker) switchUpstreamWithoutLock(ctx context.Context, cfg *config.SourceConfig, addr string) {
+	w.l.Info("switch upstream", zap.String("from", w.cfg.UpstreamAddrs()[0]), zap.String("to", addr))
+	w.cfg = cfg
+	w.upstreamAddr = addr
+
+	w.sourceDBMu.Lock()
+	if w.sourceDB != nil {
+		w.sourceDB.Close()
+		w.sourceDB = nil
+	}
+	w.sourceDBMu.Unlock()
+
+	if w.relayEnabled.Load() && w.relayHolder != nil {
+		if err := w.relayHolder.Update(ctx, cfg); err != nil {
+			w.l.Error("fail to update the upstream of relay", zap.Error(err))
+		}
+	}
+}
+
+// withFailoverUpstream returns the config which connects to the upstream switched to by failover, if the address is
+// still in the config.
+func (w *SourceWorker) withFailoverUpstream(cfg *config.SourceConfig) *config.SourceConfig {
+	if w.upstreamAddr == "" || cfg == nil {
+		return cfg
+	}
+	for _, addr := range cfg.UpstreamAddrs() {
+		if addr != w.upstreamAddr {
+			continue
+		}
+		if newCfg, err := cfg.WithUpstreamAddr(addr); err == nil {
+			return newCfg
+		}
+		break
+	}
+	w.upstreamAddr = ""
+	return cfg
+}
+
+// checkUpstreamGTID checks whether the upstream of cfg is reachable and has executed all the GTID sets.
+func checkUpstreamGTID(ctx context.Context, cfg *config.SourceConfig, sets []mysql.GTIDSet) error {
+	db, err := conn.GetUpstreamDB(&cfg.DecryptPassword().From)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	status, err := binlog.GetSourceStatus(tcontext.NewContext(ctx, log.L()), db, cfg.Flavor)
+	if err != nil {
+		return err
+	}
+	executed := status.Location.GetGTID()
+	for _, set := range sets {
+		if executed == nil || !executed.Contain(set) {
+			return errors.Errorf("executed GTID set %s doesn't contain consumed GTID set %s", status.Location.GTIDSetStr(), set)
+		}
+	}
+	return nil
+}
@@ -28,11 +28,11 @@ import (
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util/filter"
 	"github.com/pingcap/tidb/util/mock"
-	regexprrouter "github.com/pingcap/tidb/util/regexpr-router"
 	router "github.com/pingcap/tidb/util/table-router"
 	dmconfig "github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/pingcap/tiflow/dm/pkg/cputil"
+	"github.com/pingcap/tiflow/dm/pkg/tablerouter"
 	"github.com/pingcap/tiflow/engine/framework"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/engine/jobmaster/dm/bootstrap"
@@ -192,7 +192,7 @@ func (c *AgentImpl) FetchAllDoTables(ctx context.Context, cfg *config.JobCfg) (m
 		for _, ruleName := range up.RouteRules {
 			routeRules = append(routeRules, cfg.Routes[ruleName])
 		}
-		router, err := regexprrouter.NewRegExprRouter(up.CaseSensitive, routeRules)
+		router, err := tablerouter.NewRouteTable(up.CaseSensitive, routeRules)
 		if err != nil {
 			return result, err
 		}
@@ -29,6 +29,7 @@ const (
 	HTTPMethodPut
 	HTTPMethodGet
 	HTTPMethodDelete
+	HTTPMethodPatch
 )
 
 // String implements Stringer.String.
@@ -42,6 +43,8 @@ func (h HTTPMethod) String() string {
 		return "GET"
 	case HTTPMethodDelete:
 		return "DELETE"
+	case HTTPMethodPatch:
+		return "PATCH"
 	default:
 		return "unknown"
 	}
@@ -54,6 +57,7 @@ type CDCRESTInterface interface {
 	Put() *Request
 	Get() *Request
 	Delete() *Request
+	Patch() *Request
 }
 
 // CDCRESTClient defines a TiCDC RESTful client
@@ -66,6 +70,9 @@ type CDCRESTClient struct {
 
 	// Client is a wrapped http client.
 	Client *httputil.Client
+
+	// authToken is the API token sent in the Authorization header.
+	authToken string
 }
 
 // NewCDCRESTClient creates a new CDCRESTClient.
@@ -83,7 +90,7 @@ func NewCDCRESTClient(baseURL *url.URL, versionedAPIPath string, client *httputi
 	}, nil
 }
 
-// Method begins a request with a http method (GET, POST, PUT, DELETE).
+// Method begins a request with a http method (GET, POST, PUT, DELETE, PATCH).
 func (c *CDCRESTClient) Method(method HTTPMethod) *Request {
 	return NewRequest(c).WithMethod(method)
 }
@@ -107,3 +114,8 @@ func (c *CDCRESTClient) Delete() *Request {
 func (c *CDCRESTClient) Get() *Request {
 	return c.Method(HTTPMethodGet)
 }
+
+// Patch begins a PATCH request. Short for c.Method(HTTPMethodPatch).
+func (c *CDCRESTClient) Patch() *Request {
+	return c.Method(HTTPMethodPatch)
+}
@@ -100,4 +100,34 @@ func TestHTTPMethods(t *testing.T) {
 
 	req = c.Delete()
 	require.NotNil(t, req)
+
+	req = c.Patch()
+	require.NotNil(t, req)
+	require.Equal(t, "PATCH", req.method.String())
+}
+
+func TestRestRequestAuthToken(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	c, err := restClient(testServer)
+	require.Nil(t, err)
+	err = c.Get().WithPrefix("test").Do(context.Background()).Error()
+	require.NotNil(t, err)
+
+	c, err = CDCRESTClientFromConfig(&Config{
+		Host:      testServer.URL,
+		APIPath:   "/api",
+		Version:   "v1",
+		AuthToken: "test-token",
+	})
+	require.Nil(t, err)
+	err = c.Get().WithPrefix("test").Do(context.Background()).Error()
+	require.NoError(t, err)
 }
@@ -33,6 +33,9 @@ type Config struct {
 	Credential *security.Credential
 	// API verion
 	Version string
+	// AuthToken is the API token or OIDC ID token used for authenticating
+	// to the cdc server.
+	AuthToken string
 }
 
 // defaultServerURLFromConfig is used to build base URL and api path.
@@ -83,6 +86,7 @@ func CDCRESTClientFromConfig(config *Config) (*CDCRESTClient, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	restClient.authToken = config.AuthToken
 
 	return restClient, nil
 }
@@ -88,6 +88,9 @@ func NewRequest(c *CDCRESTClient) *Request {
 	}
 	r.WithHeader("Accept", "application/json")
 	r.WithHeader(middleware.ClientVersionHeader, version.ReleaseVersion)
+	if c.authToken != "" {
+		r.WithHeader("Authorization", "Bearer "+c.authToken)
+	}
 	return r
 }
 
@@ -343,6 +346,35 @@ func (r *Request) Do(ctx context.Context) (res *Result) {
 	return
 }
 
+// Stream executes the request and returns the response body as a stream,
+// which must be closed by the caller. The request is neither retried nor
+// limited by the timeout, since the stream lasts until it's closed.
+func (r *Request) Stream(ctx context.Context) (io.ReadCloser, error) {
+	if r.err != nil {
+		log.Info("error in request", zap.Error(r.err))
+		return nil, r.err
+	}
+
+	client := r.c.Client
+	if client == nil {
+		client = &httputil.Client{}
+	}
+	req, err := r.newHTTPRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Error("failed to send a http request", zap.Error(err))
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusPartialContent {
+		defer resp.Body.Close()
+		return nil, r.checkResponse(resp).Error()
+	}
+	return resp.Body, nil
+}
+
 // check http response and unmarshal error message if necessary.
 func (r *Request) checkResponse(resp *http.Response) *Result {
 	var body []byte
@@ -169,3 +169,35 @@ func TestResultZeroLengthBody(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, strings.Contains(err.Error(), "0-length"), true)
 }
+
+func TestRequestStream(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fail") != "" {
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = rw.Write([]byte(`{"error_msg": "bad request", "error_code": "ErrAPIInvalidParam"}`))
+			return
+		}
+		for i := 0; i < 3; i++ {
+			_, _ = rw.Write([]byte("event\n"))
+			rw.(http.Flusher).Flush()
+		}
+	}))
+	defer testServer.Close()
+
+	c, err := CDCRESTClientFromConfig(&Config{
+		Host:    testServer.URL,
+		APIPath: "/api",
+		Version: "v1",
+	})
+	require.Nil(t, err)
+	body, err := c.Get().WithPrefix("/test").Stream(context.Background())
+	require.Nil(t, err)
+	data, err := io.ReadAll(body)
+	require.Nil(t, err)
+	require.Nil(t, body.Close())
+	require.Equal(t, "event\nevent\nevent\n", string(data))
+
+	_, err = c.Get().WithPrefix("/test").WithParam("fail", "true").
+		Stream(context.Background())
+	require.EqualError(t, err, "bad request")
+}
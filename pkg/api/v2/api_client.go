@@ -91,12 +91,15 @@ func (c *APIV2Client) Processors() ProcessorInterface {
 }
 
 // NewAPIClient creates a new APIV1Client.
-func NewAPIClient(serverAddr string, credential *security.Credential) (*APIV2Client, error) {
+func NewAPIClient(
+	serverAddr string, credential *security.Credential, authToken string,
+) (*APIV2Client, error) {
 	c := &rest.Config{}
 	c.APIPath = "/api"
 	c.Version = "v2"
 	c.Host = serverAddr
 	c.Credential = credential
+	c.AuthToken = authToken
 	client, err := rest.CDCRESTClientFromConfig(c)
 	if err != nil {
 		return nil, errors.Trace(err)
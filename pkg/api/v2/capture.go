@@ -15,6 +15,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/model"
@@ -30,6 +31,10 @@ type CapturesGetter interface {
 // We can also mock the capture operations by implement this interface.
 type CaptureInterface interface {
 	List(ctx context.Context) ([]model.Capture, error)
+	// Drain moves the tables out of a capture, it returns the number of the
+	// tables remaining on the capture, it's called repeatedly until no table
+	// remains.
+	Drain(ctx context.Context, captureID string) (*model.DrainCaptureResp, error)
 }
 
 // captures implements CaptureInterface
@@ -53,3 +58,16 @@ func (c *captures) List(ctx context.Context) ([]model.Capture, error) {
 		Into(result)
 	return result.Items, err
 }
+
+// Drain moves the tables out of a capture
+func (c *captures) Drain(ctx context.Context,
+	captureID string,
+) (*model.DrainCaptureResp, error) {
+	result := new(model.DrainCaptureResp)
+	u := fmt.Sprintf("captures/%s/drain", captureID)
+	err := c.client.Post().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
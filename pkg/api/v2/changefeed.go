@@ -14,14 +14,31 @@
 package v2
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/pingcap/errors"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/api/internal/rest"
 )
 
+// maxStatusStreamEventSize is the max size of an event in the changefeed
+// status stream.
+const maxStatusStreamEventSize = 1024 * 1024
+
+// ChangefeedStatusStreamEvent is an event pushed by the changefeed status
+// stream, only one of the fields is set.
+type ChangefeedStatusStreamEvent struct {
+	Status *v2.ChangefeedStatusEvent
+	Error  *v2.RunningError
+}
+
 // ChangefeedsGetter has a method to return a ChangefeedInterface.
 type ChangefeedsGetter interface {
 	Changefeeds() ChangefeedInterface
@@ -32,6 +49,8 @@ type ChangefeedsGetter interface {
 type ChangefeedInterface interface {
 	// Create creates a changefeed
 	Create(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangeFeedInfo, error)
+	// DryRun verifies a changefeed config without creating the changefeed
+	DryRun(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangefeedDryRunResult, error)
 	// VerifyTable verifies table for a changefeed
 	VerifyTable(ctx context.Context, cfg *v2.VerifyTableConfig) (*v2.Tables, error)
 	// Update updates a changefeed
@@ -43,10 +62,47 @@ type ChangefeedInterface interface {
 	Delete(ctx context.Context, name string) error
 	// Pause pauses a changefeed with given name
 	Pause(ctx context.Context, name string) error
+	// PauseTables pauses replicating tables of a changefeed
+	PauseTables(ctx context.Context, name string, tableIDs []int64) error
+	// ResumeTables resumes replicating the paused tables of a changefeed
+	ResumeTables(ctx context.Context, name string, tableIDs []int64) error
+	// ListTables lists the replication progresses of the tables of a
+	// changefeed, the slowest table comes first
+	ListTables(ctx context.Context, name string) ([]v2.TableProgress, error)
+	// ListIneligibleTables lists the tables without a valid index of a
+	// changefeed
+	ListIneligibleTables(ctx context.Context, name string) (*v2.IneligibleTables, error)
 	// Get gets a changefeed detaail info
 	Get(ctx context.Context, name string) (*v2.ChangeFeedInfo, error)
-	// List lists all changefeeds
-	List(ctx context.Context, state string) ([]v2.ChangefeedCommonInfo, error)
+	// WatchStatus calls onEvent for each event pushed by the status stream of
+	// a changefeed, until the stream is closed, the context is canceled or
+	// onEvent returns an error
+	WatchStatus(ctx context.Context, name string, interval time.Duration,
+		onEvent func(event *ChangefeedStatusStreamEvent) error) error
+	// List lists all changefeeds with the state whose labels match the
+	// label selector
+	List(ctx context.Context, state string,
+		labelSelector string) ([]v2.ChangefeedCommonInfo, error)
+	// HandleError skips or replaces an event of a changefeed
+	HandleError(ctx context.Context, name string,
+		cfg *v2.HandleErrorConfig) ([]v2.HandleErrorConfig, error)
+	// Drift gets the difference between the stored and the effective
+	// config of a changefeed
+	Drift(ctx context.Context, name string) (*v2.ChangefeedDrift, error)
+	// Diagnose checks a changefeed end to end and gets the findings
+	Diagnose(ctx context.Context, name string) (*v2.ChangefeedDiagnosis, error)
+	// Errors lists the recent errors and warnings of a changefeed of the
+	// level, the latest one comes first
+	Errors(ctx context.Context, name string,
+		level string) ([]v2.ChangefeedErrorRecord, error)
+	// UpdateLabels sets and removes labels of a changefeed
+	UpdateLabels(ctx context.Context, name string,
+		patch *v2.ChangefeedLabelsPatch) (map[string]string, error)
+	// Export exports the specs of all changefeeds in a namespace
+	Export(ctx context.Context, namespace string) (*v2.ChangefeedExportDocument, error)
+	// Import creates the changefeeds in an export document
+	Import(ctx context.Context, doc *v2.ChangefeedExportDocument,
+		startTsStrategy string) ([]v2.ChangefeedOperationResult, error)
 }
 
 // changefeeds implements ChangefeedInterface
@@ -65,10 +121,28 @@ func (c *changefeeds) Create(ctx context.Context,
 	cfg *v2.ChangefeedConfig,
 ) (*v2.ChangeFeedInfo, error) {
 	result := &v2.ChangeFeedInfo{}
-	err := c.client.Post().
+	req := c.client.Post().
 		WithURI("changefeeds").
-		WithBody(cfg).
-		Do(ctx).Into(result)
+		WithBody(cfg)
+	// The access control checks the namespace in the query.
+	if cfg.Namespace != "" {
+		req = req.WithParam("namespace", cfg.Namespace)
+	}
+	err := req.Do(ctx).Into(result)
+	return result, err
+}
+
+func (c *changefeeds) DryRun(ctx context.Context,
+	cfg *v2.ChangefeedConfig,
+) (*v2.ChangefeedDryRunResult, error) {
+	result := &v2.ChangefeedDryRunResult{}
+	req := c.client.Post().
+		WithURI("changefeeds/dry_run").
+		WithBody(cfg)
+	if cfg.Namespace != "" {
+		req = req.WithParam("namespace", cfg.Namespace)
+	}
+	err := req.Do(ctx).Into(result)
 	return result, err
 }
 
@@ -128,6 +202,54 @@ func (c *changefeeds) Pause(ctx context.Context,
 		Do(ctx).Error()
 }
 
+// PauseTables pauses replicating tables of a changefeed
+func (c *changefeeds) PauseTables(ctx context.Context,
+	name string, tableIDs []int64,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/tables/pause", name)
+	return c.client.Post().
+		WithURI(u).
+		WithBody(&v2.PauseTablesConfig{TableIDs: tableIDs}).
+		Do(ctx).Error()
+}
+
+// ResumeTables resumes replicating the paused tables of a changefeed
+func (c *changefeeds) ResumeTables(ctx context.Context,
+	name string, tableIDs []int64,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/tables/resume", name)
+	return c.client.Post().
+		WithURI(u).
+		WithBody(&v2.PauseTablesConfig{TableIDs: tableIDs}).
+		Do(ctx).Error()
+}
+
+// ListTables lists the replication progresses of the tables of a changefeed
+func (c *changefeeds) ListTables(ctx context.Context,
+	name string,
+) ([]v2.TableProgress, error) {
+	result := &v2.ListResponse[v2.TableProgress]{}
+	u := fmt.Sprintf("changefeeds/%s/tables", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
+
+// ListIneligibleTables lists the tables without a valid index of a changefeed
+func (c *changefeeds) ListIneligibleTables(ctx context.Context,
+	name string,
+) (*v2.IneligibleTables, error) {
+	result := new(v2.IneligibleTables)
+	u := fmt.Sprintf("changefeeds/%s/ineligible_tables", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
 // Get gets a changefeed detaail info
 func (c *changefeeds) Get(ctx context.Context,
 	name string,
@@ -147,13 +269,187 @@ func (c *changefeeds) Get(ctx context.Context,
 
 // List lists all changefeeds
 func (c *changefeeds) List(ctx context.Context,
-	state string,
+	state string, labelSelector string,
 ) ([]v2.ChangefeedCommonInfo, error) {
 	result := &v2.ListResponse[v2.ChangefeedCommonInfo]{}
 	err := c.client.Get().
 		WithURI("changefeeds").
 		WithParam("state", state).
+		WithParam("label_selector", labelSelector).
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
+
+// HandleError skips or replaces an event of a changefeed
+func (c *changefeeds) HandleError(ctx context.Context,
+	name string, cfg *v2.HandleErrorConfig,
+) ([]v2.HandleErrorConfig, error) {
+	result := &v2.ErrorHandles{}
+	u := fmt.Sprintf("changefeeds/%s/handle_error", name)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result.Handles, err
+}
+
+// Drift gets the difference between the stored and the effective config of
+// a changefeed
+func (c *changefeeds) Drift(ctx context.Context,
+	name string,
+) (*v2.ChangefeedDrift, error) {
+	result := new(v2.ChangefeedDrift)
+	u := fmt.Sprintf("changefeeds/%s/drift", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Diagnose checks a changefeed end to end
+func (c *changefeeds) Diagnose(ctx context.Context,
+	name string,
+) (*v2.ChangefeedDiagnosis, error) {
+	result := new(v2.ChangefeedDiagnosis)
+	u := fmt.Sprintf("changefeeds/%s/diagnosis", name)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Errors lists the recent errors and warnings of a changefeed
+func (c *changefeeds) Errors(ctx context.Context,
+	name string, level string,
+) ([]v2.ChangefeedErrorRecord, error) {
+	result := &v2.ListResponse[v2.ChangefeedErrorRecord]{}
+	u := fmt.Sprintf("changefeeds/%s/errors", name)
+	err := c.client.Get().
+		WithURI(u).
+		WithParam("level", level).
 		Do(ctx).
 		Into(result)
 	return result.Items, err
 }
+
+// UpdateLabels sets and removes labels of a changefeed
+func (c *changefeeds) UpdateLabels(ctx context.Context,
+	name string, patch *v2.ChangefeedLabelsPatch,
+) (map[string]string, error) {
+	result := &v2.ChangefeedLabels{}
+	u := fmt.Sprintf("changefeeds/%s/labels", name)
+	err := c.client.Patch().
+		WithURI(u).
+		WithBody(patch).
+		Do(ctx).
+		Into(result)
+	return result.Labels, err
+}
+
+// Export exports the specs of all changefeeds in a namespace
+func (c *changefeeds) Export(ctx context.Context,
+	namespace string,
+) (*v2.ChangefeedExportDocument, error) {
+	result := &v2.ChangefeedExportDocument{}
+	err := c.client.Get().
+		WithURI("changefeeds/export").
+		WithParam("namespace", namespace).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Import creates the changefeeds in an export document
+func (c *changefeeds) Import(ctx context.Context,
+	doc *v2.ChangefeedExportDocument, startTsStrategy string,
+) ([]v2.ChangefeedOperationResult, error) {
+	result := &v2.ListResponse[v2.ChangefeedOperationResult]{}
+	req := c.client.Post().
+		WithURI("changefeeds/import").
+		WithParam("start_ts_strategy", startTsStrategy).
+		WithBody(doc)
+	if doc.Namespace != "" {
+		req = req.WithParam("namespace", doc.Namespace)
+	}
+	err := req.Do(ctx).Into(result)
+	return result.Items, err
+}
+
+// WatchStatus watches the status stream of a changefeed
+func (c *changefeeds) WatchStatus(ctx context.Context,
+	name string, interval time.Duration,
+	onEvent func(event *ChangefeedStatusStreamEvent) error,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/status/stream", name)
+	req := c.client.Get().
+		WithURI(u).
+		WithHeader("Accept", "text/event-stream")
+	if interval > 0 {
+		req = req.WithParam("interval", strconv.FormatInt(interval.Milliseconds(), 10))
+	}
+	body, err := req.Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// The events are separated by empty lines, each event consists of an
+	// "event:" line and a "data:" line.
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(nil, maxStatusStreamEventSize)
+	var event string
+	var data []byte
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		switch {
+		case bytes.HasPrefix(line, []byte("event:")):
+			event = string(bytes.TrimSpace(line[len("event:"):]))
+		case bytes.HasPrefix(line, []byte("data:")):
+			data = append(data[:0], bytes.TrimSpace(line[len("data:"):])...)
+		case len(line) == 0 && event != "":
+			if err := handleStatusStreamEvent(event, data, onEvent); err != nil {
+				return err
+			}
+			event, data = "", data[:0]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.Trace(err)
+	}
+	return ctx.Err()
+}
+
+func handleStatusStreamEvent(
+	event string, data []byte,
+	onEvent func(event *ChangefeedStatusStreamEvent) error,
+) error {
+	switch event {
+	case v2.StatusStreamEventStatus:
+		status := &v2.ChangefeedStatusEvent{}
+		if err := json.Unmarshal(data, status); err != nil {
+			return errors.Trace(err)
+		}
+		return onEvent(&ChangefeedStatusStreamEvent{Status: status})
+	case v2.StatusStreamEventError:
+		runningErr := &v2.RunningError{}
+		if err := json.Unmarshal(data, runningErr); err != nil {
+			return errors.Trace(err)
+		}
+		return onEvent(&ChangefeedStatusStreamEvent{Error: runningErr})
+	case v2.StatusStreamEventClose:
+		httpErr := &model.HTTPError{}
+		if err := json.Unmarshal(data, httpErr); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.New(httpErr.Error)
+	}
+	// Ignore the unknown events pushed by newer servers.
+	return nil
+}
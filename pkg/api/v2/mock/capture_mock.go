@@ -73,6 +73,21 @@ func (m *MockCaptureInterface) EXPECT() *MockCaptureInterfaceMockRecorder {
 	return m.recorder
 }
 
+// Drain mocks base method.
+func (m *MockCaptureInterface) Drain(ctx context.Context, captureID string) (*model.DrainCaptureResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drain", ctx, captureID)
+	ret0, _ := ret[0].(*model.DrainCaptureResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockCaptureInterfaceMockRecorder) Drain(ctx, captureID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockCaptureInterface)(nil).Drain), ctx, captureID)
+}
+
 // List mocks base method.
 func (m *MockCaptureInterface) List(ctx context.Context) ([]model.Capture, error) {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
@@ -102,6 +103,81 @@ func (mr *MockChangefeedInterfaceMockRecorder) Delete(ctx, name interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChangefeedInterface)(nil).Delete), ctx, name)
 }
 
+// Diagnose mocks base method.
+func (m *MockChangefeedInterface) Diagnose(ctx context.Context, name string) (*v2.ChangefeedDiagnosis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diagnose", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedDiagnosis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diagnose indicates an expected call of Diagnose.
+func (mr *MockChangefeedInterfaceMockRecorder) Diagnose(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diagnose", reflect.TypeOf((*MockChangefeedInterface)(nil).Diagnose), ctx, name)
+}
+
+// Drift mocks base method.
+func (m *MockChangefeedInterface) Drift(ctx context.Context, name string) (*v2.ChangefeedDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drift", ctx, name)
+	ret0, _ := ret[0].(*v2.ChangefeedDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drift indicates an expected call of Drift.
+func (mr *MockChangefeedInterfaceMockRecorder) Drift(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drift", reflect.TypeOf((*MockChangefeedInterface)(nil).Drift), ctx, name)
+}
+
+// DryRun mocks base method.
+func (m *MockChangefeedInterface) DryRun(ctx context.Context, cfg *v2.ChangefeedConfig) (*v2.ChangefeedDryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", ctx, cfg)
+	ret0, _ := ret[0].(*v2.ChangefeedDryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockChangefeedInterfaceMockRecorder) DryRun(ctx, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockChangefeedInterface)(nil).DryRun), ctx, cfg)
+}
+
+// Errors mocks base method.
+func (m *MockChangefeedInterface) Errors(ctx context.Context, name, level string) ([]v2.ChangefeedErrorRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Errors", ctx, name, level)
+	ret0, _ := ret[0].([]v2.ChangefeedErrorRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Errors indicates an expected call of Errors.
+func (mr *MockChangefeedInterfaceMockRecorder) Errors(ctx, name, level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Errors", reflect.TypeOf((*MockChangefeedInterface)(nil).Errors), ctx, name, level)
+}
+
+// Export mocks base method.
+func (m *MockChangefeedInterface) Export(ctx context.Context, namespace string) (*v2.ChangefeedExportDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, namespace)
+	ret0, _ := ret[0].(*v2.ChangefeedExportDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockChangefeedInterfaceMockRecorder) Export(ctx, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockChangefeedInterface)(nil).Export), ctx, namespace)
+}
+
 // Get mocks base method.
 func (m *MockChangefeedInterface) Get(ctx context.Context, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -117,19 +193,79 @@ func (mr *MockChangefeedInterfaceMockRecorder) Get(ctx, name interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChangefeedInterface)(nil).Get), ctx, name)
 }
 
+// HandleError mocks base method.
+func (m *MockChangefeedInterface) HandleError(ctx context.Context, name string, cfg *v2.HandleErrorConfig) ([]v2.HandleErrorConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleError", ctx, name, cfg)
+	ret0, _ := ret[0].([]v2.HandleErrorConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleError indicates an expected call of HandleError.
+func (mr *MockChangefeedInterfaceMockRecorder) HandleError(ctx, name, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleError", reflect.TypeOf((*MockChangefeedInterface)(nil).HandleError), ctx, name, cfg)
+}
+
+// Import mocks base method.
+func (m *MockChangefeedInterface) Import(ctx context.Context, doc *v2.ChangefeedExportDocument, startTsStrategy string) ([]v2.ChangefeedOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, doc, startTsStrategy)
+	ret0, _ := ret[0].([]v2.ChangefeedOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockChangefeedInterfaceMockRecorder) Import(ctx, doc, startTsStrategy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockChangefeedInterface)(nil).Import), ctx, doc, startTsStrategy)
+}
+
 // List mocks base method.
-func (m *MockChangefeedInterface) List(ctx context.Context, state string) ([]v2.ChangefeedCommonInfo, error) {
+func (m *MockChangefeedInterface) List(ctx context.Context, state, labelSelector string) ([]v2.ChangefeedCommonInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, state)
+	ret := m.ctrl.Call(m, "List", ctx, state, labelSelector)
 	ret0, _ := ret[0].([]v2.ChangefeedCommonInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockChangefeedInterfaceMockRecorder) List(ctx, state interface{}) *gomock.Call {
+func (mr *MockChangefeedInterfaceMockRecorder) List(ctx, state, labelSelector interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChangefeedInterface)(nil).List), ctx, state, labelSelector)
+}
+
+// ListIneligibleTables mocks base method.
+func (m *MockChangefeedInterface) ListIneligibleTables(ctx context.Context, name string) (*v2.IneligibleTables, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIneligibleTables", ctx, name)
+	ret0, _ := ret[0].(*v2.IneligibleTables)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIneligibleTables indicates an expected call of ListIneligibleTables.
+func (mr *MockChangefeedInterfaceMockRecorder) ListIneligibleTables(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIneligibleTables", reflect.TypeOf((*MockChangefeedInterface)(nil).ListIneligibleTables), ctx, name)
+}
+
+// ListTables mocks base method.
+func (m *MockChangefeedInterface) ListTables(ctx context.Context, name string) ([]v2.TableProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTables", ctx, name)
+	ret0, _ := ret[0].([]v2.TableProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTables indicates an expected call of ListTables.
+func (mr *MockChangefeedInterfaceMockRecorder) ListTables(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChangefeedInterface)(nil).List), ctx, state)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTables", reflect.TypeOf((*MockChangefeedInterface)(nil).ListTables), ctx, name)
 }
 
 // Pause mocks base method.
@@ -146,6 +282,20 @@ func (mr *MockChangefeedInterfaceMockRecorder) Pause(ctx, name interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockChangefeedInterface)(nil).Pause), ctx, name)
 }
 
+// PauseTables mocks base method.
+func (m *MockChangefeedInterface) PauseTables(ctx context.Context, name string, tableIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseTables", ctx, name, tableIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseTables indicates an expected call of PauseTables.
+func (mr *MockChangefeedInterfaceMockRecorder) PauseTables(ctx, name, tableIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseTables", reflect.TypeOf((*MockChangefeedInterface)(nil).PauseTables), ctx, name, tableIDs)
+}
+
 // Resume mocks base method.
 func (m *MockChangefeedInterface) Resume(ctx context.Context, cfg *v2.ResumeChangefeedConfig, name string) error {
 	m.ctrl.T.Helper()
@@ -160,6 +310,20 @@ func (mr *MockChangefeedInterfaceMockRecorder) Resume(ctx, cfg, name interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockChangefeedInterface)(nil).Resume), ctx, cfg, name)
 }
 
+// ResumeTables mocks base method.
+func (m *MockChangefeedInterface) ResumeTables(ctx context.Context, name string, tableIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeTables", ctx, name, tableIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeTables indicates an expected call of ResumeTables.
+func (mr *MockChangefeedInterfaceMockRecorder) ResumeTables(ctx, name, tableIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeTables", reflect.TypeOf((*MockChangefeedInterface)(nil).ResumeTables), ctx, name, tableIDs)
+}
+
 // Update mocks base method.
 func (m *MockChangefeedInterface) Update(ctx context.Context, cfg *v2.ChangefeedConfig, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +339,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Update(ctx, cfg, name interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChangefeedInterface)(nil).Update), ctx, cfg, name)
 }
 
+// UpdateLabels mocks base method.
+func (m *MockChangefeedInterface) UpdateLabels(ctx context.Context, name string, patch *v2.ChangefeedLabelsPatch) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabels", ctx, name, patch)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLabels indicates an expected call of UpdateLabels.
+func (mr *MockChangefeedInterfaceMockRecorder) UpdateLabels(ctx, name, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabels", reflect.TypeOf((*MockChangefeedInterface)(nil).UpdateLabels), ctx, name, patch)
+}
+
 // VerifyTable mocks base method.
 func (m *MockChangefeedInterface) VerifyTable(ctx context.Context, cfg *v2.VerifyTableConfig) (*v2.Tables, error) {
 	m.ctrl.T.Helper()
@@ -189,3 +368,17 @@ func (mr *MockChangefeedInterfaceMockRecorder) VerifyTable(ctx, cfg interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTable", reflect.TypeOf((*MockChangefeedInterface)(nil).VerifyTable), ctx, cfg)
 }
+
+// WatchStatus mocks base method.
+func (m *MockChangefeedInterface) WatchStatus(ctx context.Context, name string, interval time.Duration, onEvent func(*v20.ChangefeedStatusStreamEvent) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchStatus", ctx, name, interval, onEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchStatus indicates an expected call of WatchStatus.
+func (mr *MockChangefeedInterfaceMockRecorder) WatchStatus(ctx, name, interval, onEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchStatus", reflect.TypeOf((*MockChangefeedInterface)(nil).WatchStatus), ctx, name, interval, onEvent)
+}
@@ -73,6 +73,21 @@ func (m *MockStatusInterface) EXPECT() *MockStatusInterfaceMockRecorder {
 	return m.recorder
 }
 
+// DebugBundle mocks base method.
+func (m *MockStatusInterface) DebugBundle(ctx context.Context) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebugBundle", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DebugBundle indicates an expected call of DebugBundle.
+func (mr *MockStatusInterfaceMockRecorder) DebugBundle(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugBundle", reflect.TypeOf((*MockStatusInterface)(nil).DebugBundle), ctx)
+}
+
 // Get mocks base method.
 func (m *MockStatusInterface) Get(ctx context.Context) (*model.ServerStatus, error) {
 	m.ctrl.T.Helper()
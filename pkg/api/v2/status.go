@@ -28,6 +28,9 @@ type StatusGetter interface {
 // StatusInterface has methods to work with status api
 type StatusInterface interface {
 	Get(ctx context.Context) (*model.ServerStatus, error)
+	// DebugBundle returns a zip archive of the debug information of the
+	// changefeeds and all captures.
+	DebugBundle(ctx context.Context) ([]byte, error)
 }
 
 // status implements StatusGetter
@@ -51,3 +54,13 @@ func (c *status) Get(ctx context.Context) (*model.ServerStatus, error) {
 		Into(result)
 	return result, err
 }
+
+// DebugBundle returns the debug bundle of the cluster
+func (c *status) DebugBundle(ctx context.Context) ([]byte, error) {
+	// Collecting the bundle is expensive, don't retry it.
+	return c.client.Get().
+		WithURI("debug/bundle").
+		WithMaxRetries(1).
+		Do(ctx).
+		Raw()
+}
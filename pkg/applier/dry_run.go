@@ -0,0 +1,170 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/util"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// estimatedRowsPerSecond and estimatedDDLDuration are the rough apply
+	// speed used to estimate the apply time in dry-run mode. The real speed
+	// depends on the downstream, and DDLs such as adding index can take much
+	// longer, so the estimation is only a reference for the operators.
+	estimatedRowsPerSecond = 10000
+	estimatedDDLDuration   = time.Second
+)
+
+// TableDryRunStats is the statistics of the redo logs of a table.
+type TableDryRunStats struct {
+	TableID     model.TableID
+	Schema      string
+	Table       string
+	RowCount    uint64
+	Bytes       uint64
+	MinCommitTs model.Ts
+	MaxCommitTs model.Ts
+}
+
+// DryRunStats is the statistics of the redo logs collected in dry-run mode.
+type DryRunStats struct {
+	CheckpointTs model.Ts
+	ResolvedTs   model.Ts
+	RowCount     uint64
+	DDLCount     uint64
+	// Tables are sorted by the table name.
+	Tables             []*TableDryRunStats
+	EstimatedApplyTime time.Duration
+}
+
+func (s *DryRunStats) estimate() {
+	s.EstimatedApplyTime = time.Duration(s.RowCount)*time.Second/estimatedRowsPerSecond +
+		time.Duration(s.DDLCount)*estimatedDDLDuration
+}
+
+// DryRun reads all redo logs that would be applied and reports the statistics
+// of them, without touching the downstream.
+func (ra *RedoApplier) DryRun(ctx context.Context) (stats *DryRunStats, err error) {
+	eg, egCtx := errgroup.WithContext(ctx)
+	egCtx = contextutil.PutRoleInCtx(egCtx, util.RoleRedoLogApplier)
+
+	if ra.rd, err = createRedoReader(egCtx, ra.cfg); err != nil {
+		return nil, err
+	}
+	eg.Go(func() error {
+		return ra.rd.Run(egCtx)
+	})
+	eg.Go(func() error {
+		s, err := ra.collectStats(egCtx)
+		if err != nil {
+			return err
+		}
+		stats = s
+		return errApplyFinished
+	})
+
+	err = eg.Wait()
+	if errors.Cause(err) != errApplyFinished {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (ra *RedoApplier) collectStats(ctx context.Context) (*DryRunStats, error) {
+	stats := &DryRunStats{}
+	var err error
+	stats.CheckpointTs, stats.ResolvedTs, err = ra.rd.ReadMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[model.TableID]*TableDryRunStats)
+	for {
+		row, err := ra.rd.ReadNextRow(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			break
+		}
+		table, ok := tables[row.Table.TableID]
+		if !ok {
+			table = &TableDryRunStats{
+				TableID:     row.Table.TableID,
+				Schema:      row.Table.Schema,
+				Table:       row.Table.Table,
+				MinCommitTs: row.CommitTs,
+			}
+			tables[row.Table.TableID] = table
+		}
+		table.RowCount++
+		table.Bytes += uint64(row.ApproximateBytes())
+		if row.CommitTs < table.MinCommitTs {
+			table.MinCommitTs = row.CommitTs
+		}
+		if row.CommitTs > table.MaxCommitTs {
+			table.MaxCommitTs = row.CommitTs
+		}
+		stats.RowCount++
+	}
+	for {
+		ddl, err := ra.rd.ReadNextDDL(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ddl == nil {
+			break
+		}
+		// The DDLs skipped by applyDDL are not counted.
+		if _, ok := unsupportedDDL[ddl.Type]; ok && ddl.CommitTs == stats.CheckpointTs {
+			continue
+		}
+		if ddl.TableInfo == nil {
+			continue
+		}
+		stats.DDLCount++
+	}
+
+	for _, table := range tables {
+		stats.Tables = append(stats.Tables, table)
+	}
+	sort.Slice(stats.Tables, func(i, j int) bool {
+		if stats.Tables[i].Schema != stats.Tables[j].Schema {
+			return stats.Tables[i].Schema < stats.Tables[j].Schema
+		}
+		if stats.Tables[i].Table != stats.Tables[j].Table {
+			return stats.Tables[i].Table < stats.Tables[j].Table
+		}
+		return stats.Tables[i].TableID < stats.Tables[j].TableID
+	})
+	stats.estimate()
+	log.Info("dry run redo log finishes",
+		zap.Uint64("checkpointTs", stats.CheckpointTs),
+		zap.Uint64("resolvedTs", stats.ResolvedTs),
+		zap.Uint64("rowCount", stats.RowCount),
+		zap.Uint64("ddlCount", stats.DDLCount),
+		zap.Int("tableCount", len(stats.Tables)),
+		zap.Duration("estimatedApplyTime", stats.EstimatedApplyTime))
+	return stats, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/redo/reader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	checkpointTs := uint64(1000)
+	resolvedTs := uint64(2000)
+	redoLogCh := make(chan *model.RowChangedEvent, 1024)
+	ddlEventCh := make(chan *model.DDLEvent, 1024)
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(checkpointTs, resolvedTs, redoLogCh, ddlEventCh), nil
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	t1 := &model.TableName{Schema: "test", Table: "t1", TableID: 1}
+	t2 := &model.TableName{Schema: "test", Table: "t2", TableID: 2}
+	for _, row := range []*model.RowChangedEvent{
+		{StartTs: 1100, CommitTs: 1200, Table: t2},
+		{StartTs: 1100, CommitTs: 1200, Table: t1},
+		{StartTs: 1300, CommitTs: 1400, Table: t1},
+	} {
+		redoLogCh <- row
+	}
+	ddlEventCh <- &model.DDLEvent{
+		CommitTs:  checkpointTs,
+		TableInfo: &model.TableInfo{TableName: *t1},
+		Query:     "create table t1(id int)",
+		Type:      timodel.ActionCreateTable,
+	}
+	ddlEventCh <- &model.DDLEvent{
+		CommitTs: checkpointTs,
+		Query:    "alter table t1 exchange partition p0 with table t2",
+		Type:     timodel.ActionExchangeTablePartition,
+	}
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	ap := NewRedoApplier(&RedoApplierConfig{})
+	stats, err := ap.DryRun(ctx)
+	require.NoError(t, err)
+	require.Equal(t, checkpointTs, stats.CheckpointTs)
+	require.Equal(t, resolvedTs, stats.ResolvedTs)
+	require.Equal(t, uint64(3), stats.RowCount)
+	require.Equal(t, uint64(1), stats.DDLCount)
+	require.Len(t, stats.Tables, 2)
+	require.Equal(t, "t1", stats.Tables[0].Table)
+	require.Equal(t, uint64(2), stats.Tables[0].RowCount)
+	require.Equal(t, uint64(1200), stats.Tables[0].MinCommitTs)
+	require.Equal(t, uint64(1400), stats.Tables[0].MaxCommitTs)
+	require.Equal(t, "t2", stats.Tables[1].Table)
+	require.Equal(t, uint64(1), stats.Tables[1].RowCount)
+	require.Equal(t, estimatedDDLDuration+3*time.Second/estimatedRowsPerSecond,
+		stats.EstimatedApplyTime)
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// applyProgress is the progress of applying redo logs. It is persisted to a
+// local file, so that an interrupted apply can be resumed by skipping the
+// events which have been applied.
+type applyProgress struct {
+	// CheckpointTs and ResolvedTs are the meta of the redo logs being applied,
+	// they are used to make sure the progress belongs to the same redo logs.
+	CheckpointTs model.Ts `json:"checkpoint-ts"`
+	ResolvedTs   model.Ts `json:"resolved-ts"`
+	// DDLTs is the commit ts of the last applied DDL.
+	DDLTs model.Ts `json:"ddl-ts"`
+	// Tables is the applied ts of each table, all rows of the table whose
+	// commit ts are less than or equal to it have been applied.
+	Tables map[model.TableID]model.Ts `json:"tables"`
+}
+
+// loadApplyProgress loads the progress from the file, a new progress is
+// returned if the file does not exist.
+func loadApplyProgress(path string, checkpointTs, resolvedTs model.Ts) (*applyProgress, error) {
+	progress := &applyProgress{
+		CheckpointTs: checkpointTs,
+		ResolvedTs:   resolvedTs,
+		Tables:       make(map[model.TableID]model.Ts),
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return progress, nil
+		}
+		return nil, errors.WrapError(errors.ErrRedoApplyProgressInvalid, err, path)
+	}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, errors.WrapError(errors.ErrRedoApplyProgressInvalid, err, path)
+	}
+	if progress.CheckpointTs != checkpointTs || progress.ResolvedTs != resolvedTs {
+		return nil, errors.ErrRedoApplyProgressInvalid.GenWithStack(
+			"redo apply progress file %s belongs to the redo logs in range (%d, %d], "+
+				"but the redo logs to apply are in range (%d, %d], please remove it",
+			path, progress.CheckpointTs, progress.ResolvedTs, checkpointTs, resolvedTs)
+	}
+	if progress.Tables == nil {
+		progress.Tables = make(map[model.TableID]model.Ts)
+	}
+	return progress, nil
+}
+
+// isRowApplied returns whether the row has been applied.
+func (p *applyProgress) isRowApplied(row *model.RowChangedEvent) bool {
+	return row.CommitTs <= p.Tables[row.Table.TableID]
+}
+
+// isDDLApplied returns whether the DDL has been applied.
+func (p *applyProgress) isDDLApplied(ddl *model.DDLEvent) bool {
+	return ddl.CommitTs <= p.DDLTs
+}
+
+// updateTable advances the applied ts of the table.
+func (p *applyProgress) updateTable(tableID model.TableID, appliedTs model.Ts) {
+	if appliedTs > p.Tables[tableID] {
+		p.Tables[tableID] = appliedTs
+	}
+}
+
+// save persists the progress to the file atomically.
+func (p *applyProgress) save(path string) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return errors.WrapError(errors.ErrRedoApplyProgressInvalid, err, path)
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.WrapError(errors.ErrRedoFileOp, err)
+	}
+	defer os.Remove(tmpFile.Name()) //nolint:errcheck
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return errors.WrapError(errors.ErrRedoFileOp, err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return errors.WrapError(errors.ErrRedoFileOp, err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.WrapError(errors.ErrRedoFileOp, err)
+	}
+	if err := os.Rename(tmpFile.Name(), path); err != nil {
+		return errors.WrapError(errors.ErrRedoFileOp, err)
+	}
+	return nil
+}
+
+// ApplyProgressSummary is the summary of the progress persisted in a progress
+// file, it's used to monitor an apply running in another process.
+type ApplyProgressSummary struct {
+	CheckpointTs model.Ts
+	ResolvedTs   model.Ts
+	DDLTs        model.Ts
+	// TableCount is the number of the tables which have been applied.
+	TableCount int
+	// AppliedTs is the min applied ts of the applied tables, it's the
+	// checkpoint ts if no table has been applied.
+	AppliedTs model.Ts
+}
+
+// ReadApplyProgress reads the summary of the progress from the file.
+func ReadApplyProgress(path string) (*ApplyProgressSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrRedoApplyProgressInvalid, err, path)
+	}
+	progress := &applyProgress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, errors.WrapError(errors.ErrRedoApplyProgressInvalid, err, path)
+	}
+	summary := &ApplyProgressSummary{
+		CheckpointTs: progress.CheckpointTs,
+		ResolvedTs:   progress.ResolvedTs,
+		DDLTs:        progress.DDLTs,
+		TableCount:   len(progress.Tables),
+		AppliedTs:    progress.CheckpointTs,
+	}
+	first := true
+	for _, appliedTs := range progress.Tables {
+		if first || appliedTs < summary.AppliedTs {
+			summary.AppliedTs = appliedTs
+			first = false
+		}
+	}
+	return summary, nil
+}
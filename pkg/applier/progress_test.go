@@ -0,0 +1,89 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applier
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyProgress(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "progress")
+	progress, err := loadApplyProgress(path, 100, 200)
+	require.NoError(t, err)
+	require.Empty(t, progress.Tables)
+
+	row := &model.RowChangedEvent{CommitTs: 150, Table: &model.TableName{TableID: 1}}
+	ddl := &model.DDLEvent{CommitTs: 120}
+	require.False(t, progress.isRowApplied(row))
+	require.False(t, progress.isDDLApplied(ddl))
+
+	progress.updateTable(1, 150)
+	progress.updateTable(1, 140)
+	progress.DDLTs = 120
+	require.NoError(t, progress.save(path))
+
+	progress, err = loadApplyProgress(path, 100, 200)
+	require.NoError(t, err)
+	require.Equal(t, model.Ts(150), progress.Tables[1])
+	require.True(t, progress.isRowApplied(row))
+	require.True(t, progress.isDDLApplied(ddl))
+	row.CommitTs = 151
+	require.False(t, progress.isRowApplied(row))
+	row.Table.TableID = 2
+	row.CommitTs = 101
+	require.False(t, progress.isRowApplied(row))
+
+	// the progress belongs to other redo logs.
+	_, err = loadApplyProgress(path, 100, 300)
+	require.True(t, errors.ErrRedoApplyProgressInvalid.Equal(err))
+
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	_, err = loadApplyProgress(path, 100, 200)
+	require.True(t, errors.ErrRedoApplyProgressInvalid.Equal(err))
+}
+
+func TestReadApplyProgress(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "progress")
+	_, err := ReadApplyProgress(path)
+	require.True(t, errors.ErrRedoApplyProgressInvalid.Equal(err))
+
+	progress, err := loadApplyProgress(path, 100, 200)
+	require.NoError(t, err)
+	require.NoError(t, progress.save(path))
+	summary, err := ReadApplyProgress(path)
+	require.NoError(t, err)
+	require.Equal(t, &ApplyProgressSummary{
+		CheckpointTs: 100, ResolvedTs: 200, AppliedTs: 100,
+	}, summary)
+
+	progress.updateTable(1, 180)
+	progress.updateTable(2, 150)
+	progress.DDLTs = 120
+	require.NoError(t, progress.save(path))
+	summary, err = ReadApplyProgress(path)
+	require.NoError(t, err)
+	require.Equal(t, &ApplyProgressSummary{
+		CheckpointTs: 100, ResolvedTs: 200, DDLTs: 120, TableCount: 2, AppliedTs: 150,
+	}, summary)
+}
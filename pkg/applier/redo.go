@@ -16,6 +16,7 @@ package applier
 import (
 	"context"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/pingcap/log"
@@ -43,6 +44,8 @@ const (
 	applierChangefeed = "redo-applier"
 	warnDuration      = 3 * time.Minute
 	flushWaitDuration = 200 * time.Millisecond
+	// progressSaveInterval is the interval to persist the apply progress.
+	progressSaveInterval = 5 * time.Second
 )
 
 var (
@@ -56,9 +59,13 @@ var (
 
 // RedoApplierConfig is the configuration used by a redo log applier
 type RedoApplierConfig struct {
-	SinkURI string
-	Storage string
-	Dir     string
+	SinkURI           string
+	Storage           string
+	Dir               string
+	EncryptionKeyFile string
+	// ProgressFile is the local file to persist the apply progress, an
+	// interrupted apply is resumed from it. Empty means no progress is kept.
+	ProgressFile string
 }
 
 // RedoApplier implements a redo log applier
@@ -76,10 +83,17 @@ type RedoApplier struct {
 	sinkFactory *dmlfactory.SinkFactory
 	// tableSinks is a map from tableID to table sink.
 	// We create it when we need it, and close it after we finish applying the redo logs.
+	// The tables are flushed concurrently by the backend sink, tableSinksMu protects
+	// the map from being read by bgReleaseQuota while new table sinks are added.
+	tableSinksMu       sync.RWMutex
 	tableSinks         map[model.TableID]tablesink.TableSink
 	tableResolvedTsMap map[model.TableID]*memquota.MemConsumeRecord
 	appliedLogCount    uint64
 
+	// progress is nil if ProgressFile is not set.
+	progress         *applyProgress
+	lastProgressSave time.Time
+
 	errCh chan error
 }
 
@@ -105,10 +119,27 @@ func (rac *RedoApplierConfig) toLogReaderConfig() (string, *reader.LogReaderConf
 		URI:                *uri,
 		Dir:                rac.Dir,
 		UseExternalStorage: redo.IsExternalStorage(uri.Scheme),
+		EncryptionKeyFile:  rac.EncryptionKeyFile,
 	}
 	return uri.Scheme, cfg, nil
 }
 
+// EnableSafeMode returns the sink URI with safe mode enabled. The redo logs
+// can be applied more than once, so the DMLs must be idempotent.
+func EnableSafeMode(sinkURI string) (string, error) {
+	uri, err := url.Parse(sinkURI)
+	if err != nil {
+		return "", errors.WrapError(errors.ErrSinkURIInvalid, err)
+	}
+	rawQuery := uri.Query()
+	if rawQuery.Get("safe-mode") == "true" {
+		return sinkURI, nil
+	}
+	rawQuery.Set("safe-mode", "true")
+	uri.RawQuery = rawQuery.Encode()
+	return uri.String(), nil
+}
+
 func (ra *RedoApplier) catchError(ctx context.Context) error {
 	for {
 		select {
@@ -137,17 +168,19 @@ func (ra *RedoApplier) initSink(ctx context.Context) (err error) {
 }
 
 func (ra *RedoApplier) bgReleaseQuota(ctx context.Context) error {
-	ticker := time.NewTicker(time.Second)
+	ticker := time.NewTicker(flushWaitDuration)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return errors.Trace(ctx.Err())
 		case <-ticker.C:
+			ra.tableSinksMu.RLock()
 			for tableID, tableSink := range ra.tableSinks {
 				checkpointTs := tableSink.GetCheckpointTs()
 				ra.memQuota.Release(spanz.TableIDToComparableSpan(tableID), checkpointTs)
 			}
+			ra.tableSinksMu.RUnlock()
 		}
 	}
 }
@@ -160,6 +193,17 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	log.Info("apply redo log starts",
 		zap.Uint64("checkpointTs", checkpointTs),
 		zap.Uint64("resolvedTs", resolvedTs))
+	if ra.cfg.ProgressFile != "" {
+		ra.progress, err = loadApplyProgress(ra.cfg.ProgressFile, checkpointTs, resolvedTs)
+		if err != nil {
+			return err
+		}
+		ra.lastProgressSave = time.Now()
+		log.Info("apply redo log resumes from progress",
+			zap.String("progressFile", ra.cfg.ProgressFile),
+			zap.Uint64("ddlTs", ra.progress.DDLTs),
+			zap.Int("tableCount", len(ra.progress.Tables)))
+	}
 	if err := ra.initSink(ctx); err != nil {
 		return err
 	}
@@ -190,15 +234,22 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 			break
 		}
 		if shouldApplyDDL(row, ddl) {
-			if err := ra.applyDDL(ctx, ddl, checkpointTs); err != nil {
-				return err
+			if ra.progress == nil || !ra.progress.isDDLApplied(ddl) {
+				if err := ra.applyDDL(ctx, ddl, checkpointTs); err != nil {
+					return err
+				}
 			}
 			if ddl, err = ra.rd.ReadNextDDL(ctx); err != nil {
 				return err
 			}
 		} else {
-			if err := ra.applyRow(row, checkpointTs); err != nil {
-				return err
+			if ra.progress == nil || !ra.progress.isRowApplied(row) {
+				if err := ra.applyRow(row, checkpointTs); err != nil {
+					return err
+				}
+				if err := ra.maybeSaveProgress(); err != nil {
+					return err
+				}
 			}
 			if row, err = ra.rd.ReadNextRow(ctx); err != nil {
 				return err
@@ -206,11 +257,14 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 		}
 	}
 	// wait all tables to flush data
-	for tableID := range ra.tableResolvedTsMap {
-		if err := ra.waitTableFlush(ctx, tableID, resolvedTs); err != nil {
-			return err
-		}
-		ra.tableSinks[tableID].Close()
+	if err := ra.flushTables(ctx, resolvedTs); err != nil {
+		return err
+	}
+	if err := ra.saveProgress(); err != nil {
+		return err
+	}
+	for _, tableSink := range ra.tableSinks {
+		tableSink.Close()
 	}
 
 	log.Info("apply redo log finishes",
@@ -220,32 +274,19 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	return errApplyFinished
 }
 
-func (ra *RedoApplier) resetQuota(rowSize uint64) error {
+// resetQuota flushes the tables to release the memory quota before acquiring
+// quota for the row. Transaction boundaries are preserved by only flushing the
+// tables whose last appended rows are committed before the row, the ongoing
+// transactions are split by batch resolved ts only if the completed ones can
+// not release enough quota, e.g. a single transaction is larger than the quota.
+func (ra *RedoApplier) resetQuota(row *model.RowChangedEvent) error {
+	rowSize := uint64(row.ApproximateBytes())
 	if rowSize >= config.DefaultChangefeedMemoryQuota || rowSize < ra.pendingQuota {
 		log.Panic("row size exceeds memory quota",
 			zap.Uint64("rowSize", rowSize),
 			zap.Uint64("memoryQuota", config.DefaultChangefeedMemoryQuota))
 	}
 
-	// flush all tables before acquire new quota
-	for tableID, tableRecord := range ra.tableResolvedTsMap {
-		if !tableRecord.ResolvedTs.IsBatchMode() {
-			log.Panic("table resolved ts should always be in batch mode when apply redo log")
-		}
-
-		if err := ra.tableSinks[tableID].UpdateResolvedTs(tableRecord.ResolvedTs); err != nil {
-			return err
-		}
-		ra.memQuota.Record(spanz.TableIDToComparableSpan(tableID),
-			tableRecord.ResolvedTs, tableRecord.Size)
-
-		// reset new record
-		ra.tableResolvedTsMap[tableID] = &memquota.MemConsumeRecord{
-			ResolvedTs: tableRecord.ResolvedTs.AdvanceBatch(),
-			Size:       0,
-		}
-	}
-
 	oldQuota := ra.pendingQuota
 	ra.pendingQuota = rowSize * mysql.DefaultMaxTxnRow
 	if ra.pendingQuota > config.DefaultChangefeedMemoryQuota {
@@ -253,6 +294,36 @@ func (ra *RedoApplier) resetQuota(rowSize uint64) error {
 	} else if ra.pendingQuota < 64*1024 {
 		ra.pendingQuota = 64 * 1024
 	}
+
+	var released uint64
+	var ongoingTables []model.TableID
+	for tableID, tableRecord := range ra.tableResolvedTsMap {
+		if tableRecord.Size == 0 {
+			continue
+		}
+		if tableRecord.ResolvedTs.Ts >= row.CommitTs {
+			ongoingTables = append(ongoingTables, tableID)
+			continue
+		}
+		released += tableRecord.Size
+		if err := ra.flushTable(tableID, model.NewResolvedTs(tableRecord.ResolvedTs.Ts)); err != nil {
+			return err
+		}
+	}
+	if released < ra.pendingQuota-oldQuota {
+		for _, tableID := range ongoingTables {
+			tableRecord := ra.tableResolvedTsMap[tableID]
+			if !tableRecord.ResolvedTs.IsBatchMode() {
+				log.Panic("resolved ts of ongoing transactions should always be in batch mode",
+					zap.Int64("tableID", tableID), zap.Any("resolvedTs", tableRecord.ResolvedTs))
+			}
+			log.Info("split ongoing transaction to release memory quota",
+				zap.Int64("tableID", tableID), zap.Any("resolvedTs", tableRecord.ResolvedTs))
+			if err := ra.flushTable(tableID, tableRecord.ResolvedTs); err != nil {
+				return err
+			}
+		}
+	}
 	return ra.memQuota.BlockAcquire(ra.pendingQuota - oldQuota)
 }
 
@@ -280,24 +351,46 @@ func (ra *RedoApplier) applyDDL(
 	log.Warn("apply DDL", zap.Any("ddl", ddl))
 	// Wait all tables to flush data before applying DDL.
 	// TODO: only block tables that are affected by this DDL.
-	for tableID := range ra.tableSinks {
-		if err := ra.waitTableFlush(ctx, tableID, ddl.CommitTs); err != nil {
-			return err
-		}
+	if err := ra.flushTables(ctx, ddl.CommitTs); err != nil {
+		return err
 	}
 	if err := ra.ddlSink.WriteDDLEvent(ctx, ddl); err != nil {
 		return err
 	}
 	ra.appliedDDLCount++
+	if ra.progress != nil {
+		// DDLs may not be idempotent, so the progress is persisted immediately.
+		ra.progress.DDLTs = ddl.CommitTs
+		return ra.saveProgress()
+	}
 	return nil
 }
 
+// saveProgress persists the applied ts of all tables and the last applied DDL.
+func (ra *RedoApplier) saveProgress() error {
+	if ra.progress == nil {
+		return nil
+	}
+	for tableID, tableSink := range ra.tableSinks {
+		ra.progress.updateTable(tableID, tableSink.GetCheckpointTs().ResolvedMark())
+	}
+	ra.lastProgressSave = time.Now()
+	return ra.progress.save(ra.cfg.ProgressFile)
+}
+
+func (ra *RedoApplier) maybeSaveProgress() error {
+	if ra.progress == nil || time.Since(ra.lastProgressSave) < progressSaveInterval {
+		return nil
+	}
+	return ra.saveProgress()
+}
+
 func (ra *RedoApplier) applyRow(
 	row *model.RowChangedEvent, checkpointTs model.Ts,
 ) error {
 	rowSize := uint64(row.ApproximateBytes())
 	if rowSize > ra.pendingQuota {
-		if err := ra.resetQuota(uint64(row.ApproximateBytes())); err != nil {
+		if err := ra.resetQuota(row); err != nil {
 			return err
 		}
 	}
@@ -311,7 +404,9 @@ func (ra *RedoApplier) applyRow(
 			checkpointTs,
 			prometheus.NewCounter(prometheus.CounterOpts{}),
 		)
+		ra.tableSinksMu.Lock()
 		ra.tableSinks[tableID] = tableSink
+		ra.tableSinksMu.Unlock()
 	}
 	if _, ok := ra.tableResolvedTsMap[tableID]; !ok {
 		// Initialize table record using checkpointTs.
@@ -349,57 +444,65 @@ func (ra *RedoApplier) applyRow(
 	return nil
 }
 
-func (ra *RedoApplier) waitTableFlush(
-	ctx context.Context, tableID model.TableID, rts model.Ts,
-) error {
-	ticker := time.NewTicker(warnDuration)
-	defer ticker.Stop()
-
-	oldTableRecord := ra.tableResolvedTsMap[tableID]
-	if oldTableRecord.ResolvedTs.Ts < rts {
-		// Use new batch resolvedTs to flush data.
-		ra.tableResolvedTsMap[tableID] = &memquota.MemConsumeRecord{
-			ResolvedTs: model.ResolvedTs{
-				Mode:    model.BatchResolvedMode,
-				Ts:      rts,
-				BatchID: 1,
-			},
-			Size: ra.tableResolvedTsMap[tableID].Size,
-		}
-	} else if oldTableRecord.ResolvedTs.Ts > rts {
-		log.Panic("resolved ts of redo log regressed",
-			zap.Any("oldResolvedTs", oldTableRecord),
-			zap.Any("newResolvedTs", rts))
-	}
-
+// flushTable flushes the table up to the resolved ts asynchronously and
+// records the memory quota consumed by the flushed rows.
+func (ra *RedoApplier) flushTable(tableID model.TableID, resolvedTs model.ResolvedTs) error {
 	tableRecord := ra.tableResolvedTsMap[tableID]
-	if err := ra.tableSinks[tableID].UpdateResolvedTs(tableRecord.ResolvedTs); err != nil {
+	if err := ra.tableSinks[tableID].UpdateResolvedTs(resolvedTs); err != nil {
 		return err
 	}
-	ra.memQuota.Record(spanz.TableIDToComparableSpan(tableID),
-		tableRecord.ResolvedTs, tableRecord.Size)
+	ra.memQuota.Record(spanz.TableIDToComparableSpan(tableID), resolvedTs, tableRecord.Size)
 
-	// Make sure all events are flushed to downstream.
-	for !ra.tableSinks[tableID].GetCheckpointTs().EqualOrGreater(tableRecord.ResolvedTs) {
-		select {
-		case <-ctx.Done():
-			return errors.Trace(ctx.Err())
-		case <-ticker.C:
-			log.Warn(
-				"Table sink is not catching up with resolved ts for a long time",
+	// reset new record
+	if resolvedTs.IsBatchMode() {
+		resolvedTs = resolvedTs.AdvanceBatch()
+	}
+	ra.tableResolvedTsMap[tableID] = &memquota.MemConsumeRecord{
+		ResolvedTs: resolvedTs,
+		Size:       0,
+	}
+	return nil
+}
+
+// flushTables is a resolved ts barrier of all tables, it flushes all tables
+// up to rts and waits until all of them are flushed to downstream. All rows
+// whose commit ts are less than or equal to rts must have been appended, so
+// no transaction is split by the barrier. The tables are flushed concurrently
+// rather than one by one.
+func (ra *RedoApplier) flushTables(ctx context.Context, rts model.Ts) error {
+	ticker := time.NewTicker(warnDuration)
+	defer ticker.Stop()
+
+	resolvedTs := model.NewResolvedTs(rts)
+	for tableID, tableRecord := range ra.tableResolvedTsMap {
+		if tableRecord.ResolvedTs.Ts > rts {
+			log.Panic("resolved ts of redo log regressed",
 				zap.Int64("tableID", tableID),
-				zap.Any("resolvedTs", tableRecord.ResolvedTs),
-				zap.Any("checkpointTs", ra.tableSinks[tableID].GetCheckpointTs()),
-			)
-		default:
-			time.Sleep(flushWaitDuration)
+				zap.Any("oldResolvedTs", tableRecord),
+				zap.Any("newResolvedTs", rts))
+		}
+		if err := ra.flushTable(tableID, resolvedTs); err != nil {
+			return err
 		}
 	}
 
-	// reset new record
-	ra.tableResolvedTsMap[tableID] = &memquota.MemConsumeRecord{
-		ResolvedTs: tableRecord.ResolvedTs.AdvanceBatch(),
-		Size:       0,
+	// Make sure all events are flushed to downstream.
+	for tableID, tableSink := range ra.tableSinks {
+		for !tableSink.GetCheckpointTs().EqualOrGreater(resolvedTs) {
+			select {
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			case <-ticker.C:
+				log.Warn(
+					"Table sink is not catching up with resolved ts for a long time",
+					zap.Int64("tableID", tableID),
+					zap.Any("resolvedTs", resolvedTs),
+					zap.Any("checkpointTs", tableSink.GetCheckpointTs()),
+				)
+			default:
+				time.Sleep(flushWaitDuration)
+			}
+		}
 	}
 	return nil
 }
@@ -423,6 +526,15 @@ func (ra *RedoApplier) ReadMeta(ctx context.Context) (checkpointTs uint64, resol
 	return rd.ReadMeta(ctx)
 }
 
+// Verify verifies the redo logs without applying them.
+func (ra *RedoApplier) Verify(ctx context.Context) (*reader.VerifyResult, error) {
+	_, readerCfg, err := ra.cfg.toLogReaderConfig()
+	if err != nil {
+		return nil, err
+	}
+	return reader.VerifyLogs(ctx, readerCfg)
+}
+
 // Apply applies redo log to given target
 func (ra *RedoApplier) Apply(egCtx context.Context) (err error) {
 	eg, egCtx := errgroup.WithContext(egCtx)
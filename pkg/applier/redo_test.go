@@ -24,9 +24,11 @@ import (
 	"github.com/phayes/freeport"
 	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
 	"github.com/pingcap/tiflow/cdc/redo/reader"
 	mysqlDDL "github.com/pingcap/tiflow/cdc/sink/ddlsink/mysql"
 	"github.com/pingcap/tiflow/cdc/sink/dmlsink/txn"
+	"github.com/pingcap/tiflow/pkg/config"
 	pmysql "github.com/pingcap/tiflow/pkg/sink/mysql"
 	"github.com/stretchr/testify/require"
 )
@@ -268,3 +270,45 @@ func getMockDB(t *testing.T) *sql.DB {
 	mock.ExpectClose()
 	return db
 }
+
+func TestResetQuotaPreservesTransactions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ap := NewRedoApplier(&RedoApplierConfig{SinkURI: "blackhole://"})
+	require.NoError(t, ap.initSink(ctx))
+	defer ap.sinkFactory.Close()
+	ap.memQuota = memquota.NewMemQuota(model.DefaultChangeFeedID(applierChangefeed),
+		config.DefaultChangefeedMemoryQuota, "sink")
+	defer ap.memQuota.Close()
+
+	checkpointTs := uint64(5)
+	newRow := func(tableID model.TableID, commitTs uint64) *model.RowChangedEvent {
+		return &model.RowChangedEvent{
+			CommitTs: commitTs,
+			Table:    &model.TableName{Schema: "test", Table: "t", TableID: tableID},
+			Columns:  []*model.Column{{Name: "a", Value: 1}},
+		}
+	}
+	require.NoError(t, ap.applyRow(newRow(1, 10), checkpointTs))
+	require.NoError(t, ap.applyRow(newRow(2, 11), checkpointTs))
+
+	// The transaction of table 1 is complete and flushed in normal mode, the
+	// ongoing transaction of table 2 is split since the quota is not enough.
+	ap.pendingQuota = 0
+	require.NoError(t, ap.resetQuota(newRow(2, 11)))
+	require.Equal(t, model.NewResolvedTs(10), ap.tableSinks[1].GetCheckpointTs())
+	require.Equal(t, model.NewResolvedTs(10), ap.tableResolvedTsMap[1].ResolvedTs)
+	require.Equal(t, model.ResolvedTs{Mode: model.BatchResolvedMode, Ts: 11, BatchID: 1},
+		ap.tableSinks[2].GetCheckpointTs())
+	require.Equal(t, model.ResolvedTs{Mode: model.BatchResolvedMode, Ts: 11, BatchID: 2},
+		ap.tableResolvedTsMap[2].ResolvedTs)
+
+	// The barrier flushes all tables up to the resolved ts.
+	require.NoError(t, ap.applyRow(newRow(1, 12), checkpointTs))
+	require.NoError(t, ap.flushTables(ctx, 12))
+	for _, tableID := range []model.TableID{1, 2} {
+		require.Equal(t, model.NewResolvedTs(12), ap.tableSinks[tableID].GetCheckpointTs())
+		require.Equal(t, uint64(0), ap.tableResolvedTsMap[tableID].Size)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// maxFieldLength is the max length of a recorded string field, the longer
+// ones are truncated.
+const maxFieldLength = 1024
+
+// Entry is a record of a mutating API call.
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Identity is the authenticated caller, it's empty if the access control
+	// is disabled or the caller fails to be authenticated.
+	Identity string `json:"identity"`
+	SourceIP string `json:"source_ip"`
+	// ForwardedBy is the capture forwarding the call to the owner, it's
+	// empty if the call is sent to the owner directly.
+	ForwardedBy string `json:"forwarded_by,omitempty"`
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	// BodyDigest is the hex encoded SHA-256 digest of the request body, the
+	// body itself is not recorded since it may contain credentials.
+	BodyDigest string `json:"body_digest"`
+	// Status is the HTTP status code, or the gRPC status code of a call of
+	// the admin service.
+	Status  int           `json:"status"`
+	Error   string        `json:"error,omitempty"`
+	Latency time.Duration `json:"latency"`
+}
+
+// Logger writes audit entries to a dedicated log file, and keeps the recent
+// entries in memory.
+type Logger struct {
+	logger *zap.Logger
+	// file is the rotated log file, it's nil in tests.
+	file *lumberjack.Logger
+
+	mu sync.Mutex
+	// recent is a ring buffer of the recent entries, next is the position
+	// of the next entry.
+	recent []Entry
+	next   int
+	full   bool
+}
+
+// NewLogger creates a Logger, or returns nil if the audit log is disabled.
+func NewLogger(cfg *config.AuditConfig) (*Logger, error) {
+	if cfg == nil || !cfg.Enable {
+		return nil, nil
+	}
+	// Check the file is writable, so a bad config fails at startup instead
+	// of losing the entries.
+	if err := os.MkdirAll(filepath.Dir(cfg.Filename), 0o755); err != nil {
+		return nil, errors.Trace(err)
+	}
+	f, err := os.OpenFile(cfg.Filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	_ = f.Close()
+
+	file := &lumberjack.Logger{
+		Filename:   cfg.Filename,
+		MaxSize:    cfg.MaxSize,
+		MaxAge:     cfg.MaxDays,
+		MaxBackups: cfg.MaxBackups,
+		LocalTime:  true,
+		Compress:   cfg.Compress,
+	}
+	output := zapcore.AddSync(file)
+	lg, _, err := log.InitLoggerWithWriteSyncer(&log.Config{Level: "info"}, output, output)
+	if err != nil {
+		_ = file.Close()
+		return nil, errors.Trace(err)
+	}
+	l := newLogger(lg, cfg.BufferSize)
+	l.file = file
+	return l, nil
+}
+
+func newLogger(lg *zap.Logger, bufferSize int) *Logger {
+	return &Logger{
+		logger: lg,
+		recent: make([]Entry, bufferSize),
+	}
+}
+
+// Record records an audit entry.
+func (l *Logger) Record(e Entry) {
+	e.Path = truncate(e.Path)
+	e.Error = truncate(e.Error)
+	l.logger.Info("audit",
+		zap.String("identity", e.Identity),
+		zap.String("sourceIP", e.SourceIP),
+		zap.String("forwardedBy", e.ForwardedBy),
+		zap.String("method", e.Method),
+		zap.String("path", e.Path),
+		zap.String("bodyDigest", e.BodyDigest),
+		zap.Int("status", e.Status),
+		zap.String("error", e.Error),
+		zap.Duration("latency", e.Latency))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.recent) == 0 {
+		return
+	}
+	l.recent[l.next] = e
+	l.next++
+	if l.next == len(l.recent) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// Recent returns at most limit recent entries, the newest first. All the
+// entries in memory are returned if limit is not positive.
+func (l *Logger) Recent(limit int) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	size := l.next
+	if l.full {
+		size = len(l.recent)
+	}
+	if limit <= 0 || limit > size {
+		limit = size
+	}
+	entries := make([]Entry, 0, limit)
+	for i := 1; i <= limit; i++ {
+		idx := (l.next - i + len(l.recent)) % len(l.recent)
+		entries = append(entries, l.recent[idx])
+	}
+	return entries
+}
+
+// Close flushes and closes the audit log, it's a no-op on a nil Logger.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	err := l.logger.Sync()
+	if l.file != nil {
+		if closeErr := l.file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return errors.Trace(err)
+}
+
+func truncate(s string) string {
+	if len(s) <= maxFieldLength {
+		return s
+	}
+	return s[:maxFieldLength] + "...(truncated)"
+}
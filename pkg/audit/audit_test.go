@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLoggerRecent(t *testing.T) {
+	t.Parallel()
+
+	l := newLogger(zap.NewNop(), 3)
+	require.Empty(t, l.Recent(0))
+
+	for _, path := range []string{"/a", "/b"} {
+		l.Record(Entry{Path: path})
+	}
+	entries := l.Recent(0)
+	require.Len(t, entries, 2)
+	require.Equal(t, "/b", entries[0].Path)
+	require.Equal(t, "/a", entries[1].Path)
+
+	// the oldest entries are dropped once the buffer is full.
+	for _, path := range []string{"/c", "/d"} {
+		l.Record(Entry{Path: path})
+	}
+	entries = l.Recent(0)
+	require.Len(t, entries, 3)
+	require.Equal(t, []string{"/d", "/c", "/b"},
+		[]string{entries[0].Path, entries[1].Path, entries[2].Path})
+	require.Len(t, l.Recent(1), 1)
+	require.Equal(t, "/d", l.Recent(1)[0].Path)
+
+	// nothing is kept in memory if the buffer size is 0.
+	l = newLogger(zap.NewNop(), 0)
+	l.Record(Entry{Path: "/a"})
+	require.Empty(t, l.Recent(10))
+}
+
+func TestNewLogger(t *testing.T) {
+	t.Parallel()
+
+	l, err := NewLogger(config.NewDefaultAuditConfig())
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	cfg := config.NewDefaultAuditConfig()
+	cfg.Enable = true
+	cfg.Filename = t.TempDir() + "/audit.log"
+	l, err = NewLogger(cfg)
+	require.NoError(t, err)
+	l.Record(Entry{Path: "/api/v2/changefeeds", Status: 200})
+	require.Len(t, l.Recent(0), 1)
+	require.NoError(t, l.Close())
+	require.NoError(t, (*Logger)(nil).Close())
+
+	// the file must be writable.
+	cfg.Filename = t.TempDir()
+	_, err = NewLogger(cfg)
+	require.Error(t, err)
+}
+
+func TestLoggerTruncate(t *testing.T) {
+	t.Parallel()
+
+	l := newLogger(zap.NewNop(), 1)
+	l.Record(Entry{Path: "/" + strings.Repeat("a", maxFieldLength*2)})
+	require.Less(t, len(l.Recent(0)[0].Path), maxFieldLength*2)
+	require.True(t, strings.HasSuffix(l.Recent(0)[0].Path, "...(truncated)"))
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	bearerPrefix = "Bearer "
+
+	// ForwardedIdentityHeader is the header carrying the identity of the
+	// original caller of a request forwarded by a capture. It's only
+	// trusted if the request is sent with a certificate of the cluster.
+	ForwardedIdentityHeader = "TiCDC-Forwarded-Identity"
+)
+
+// Authenticator authenticates the callers of the HTTP API by TLS client
+// certificates, API tokens and OIDC ID tokens.
+type Authenticator struct {
+	tokens TokenStore
+	oidc   *OIDCVerifier
+	// certBindings are the roles granted to the certificates, keyed by the
+	// common names.
+	certBindings map[string][]Binding
+	// clusterCNs are the common names of the certificates used by the
+	// captures to forward requests.
+	clusterCNs map[string]struct{}
+}
+
+// NewAuthenticator creates a new Authenticator. clusterCNs are the common
+// names of the certificates used by the captures.
+func NewAuthenticator(
+	cfg *config.AuthConfig, clusterCNs []string, tokens TokenStore,
+) *Authenticator {
+	a := &Authenticator{
+		tokens:       tokens,
+		certBindings: make(map[string][]Binding, len(cfg.CertRoles)),
+		clusterCNs:   make(map[string]struct{}, len(clusterCNs)),
+	}
+	if cfg.OIDC != nil && cfg.OIDC.Issuer != "" {
+		a.oidc = NewOIDCVerifier(cfg.OIDC)
+	}
+	for cn, roles := range cfg.CertRoles {
+		for _, role := range roles {
+			binding, err := ParseBinding(role)
+			if err != nil {
+				log.Warn("ignore invalid role of certificate",
+					zap.String("commonName", cn), zap.Error(err))
+				continue
+			}
+			a.certBindings[cn] = append(a.certBindings[cn], binding)
+		}
+	}
+	for _, cn := range clusterCNs {
+		a.clusterCNs[cn] = struct{}{}
+	}
+	return a
+}
+
+// Authenticate returns the identity of the caller of the request.
+//
+// A request forwarded by a capture is authenticated as its original
+// caller. A caller with a verified TLS client certificate is granted the
+// roles configured for the certificate. Otherwise, the caller must present
+// an API token or an OIDC ID token in the Authorization header.
+func (a *Authenticator) Authenticate(ctx context.Context, r *http.Request) (*Identity, error) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+		if forwarded := r.Header.Get(ForwardedIdentityHeader); forwarded != "" {
+			if _, ok := a.clusterCNs[cn]; ok {
+				return DecodeIdentity(forwarded)
+			}
+		}
+		if bindings, ok := a.certBindings[cn]; ok {
+			return &Identity{Name: "cert:" + cn, Bindings: bindings}, nil
+		}
+	}
+
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("missing credentials")
+	}
+	rawToken := strings.TrimSpace(header[len(bearerPrefix):])
+	// An ID token is a JWT consisting of three segments, while an API token
+	// consists of two.
+	if strings.Count(rawToken, ".") == 2 {
+		if a.oidc == nil {
+			return nil, cerror.ErrUnauthorized.GenWithStackByArgs("OIDC is not enabled")
+		}
+		return a.oidc.Verify(ctx, rawToken)
+	}
+	token, err := a.tokens.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{
+		Name:     "token:" + token.Name,
+		Bindings: []Binding{token.Binding},
+	}, nil
+}
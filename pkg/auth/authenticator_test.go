@@ -0,0 +1,116 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testTokenStore struct {
+	TokenStore
+	tokens map[string]*Token
+}
+
+func (s *testTokenStore) Verify(_ context.Context, secret string) (*Token, error) {
+	if token, ok := s.tokens[secret]; ok {
+		return token, nil
+	}
+	return nil, cerror.ErrUnauthorized.GenWithStackByArgs("invalid token")
+}
+
+func newCertRequest(cn string) *http.Request {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+	return &http.Request{
+		Header: http.Header{},
+		TLS: &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		},
+	}
+}
+
+func TestAuthenticatorCertRoles(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultAuthConfig()
+	cfg.CertRoles = map[string][]string{"cli": {"admin"}, "ci": {"operator:ns1"}}
+	store := &testTokenStore{tokens: map[string]*Token{
+		"viewer.secret": {
+			Name:    "viewer",
+			Binding: Binding{Role: RoleViewer, Namespace: AllNamespaces},
+		},
+	}}
+	a := NewAuthenticator(cfg, []string{"ticdc"}, store)
+	ctx := context.Background()
+
+	identity, err := a.Authenticate(ctx, newCertRequest("cli"))
+	require.NoError(t, err)
+	require.True(t, identity.Allows(RoleAdmin, AllNamespaces))
+	identity, err = a.Authenticate(ctx, newCertRequest("ci"))
+	require.NoError(t, err)
+	require.True(t, identity.Allows(RoleOperator, "ns1"))
+	require.False(t, identity.Allows(RoleViewer, "ns2"))
+
+	// A certificate without roles is not an admin, the caller must present
+	// a token.
+	_, err = a.Authenticate(ctx, newCertRequest("unknown"))
+	require.Error(t, err)
+	r := newCertRequest("unknown")
+	r.Header.Set("Authorization", "Bearer viewer.secret")
+	identity, err = a.Authenticate(ctx, r)
+	require.NoError(t, err)
+	require.Equal(t, "token:viewer", identity.Name)
+}
+
+func TestAuthenticatorForwardedIdentity(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultAuthConfig()
+	cfg.CertRoles = map[string][]string{"ticdc": {"admin"}, "ci": {"viewer"}}
+	a := NewAuthenticator(cfg, []string{"ticdc"}, &testTokenStore{})
+	ctx := context.Background()
+	forwarded := &Identity{
+		Name:     "token:operator",
+		Bindings: []Binding{{Role: RoleOperator, Namespace: "ns1"}},
+	}
+
+	// The identity forwarded by a capture is the original caller.
+	r := newCertRequest("ticdc")
+	r.Header.Set(ForwardedIdentityHeader, forwarded.Encode())
+	identity, err := a.Authenticate(ctx, r)
+	require.NoError(t, err)
+	require.Equal(t, forwarded, identity)
+	r.Header.Set(ForwardedIdentityHeader, "malformed")
+	_, err = a.Authenticate(ctx, r)
+	require.Error(t, err)
+
+	// The identity forwarded by others is ignored.
+	r = newCertRequest("ci")
+	r.Header.Set(ForwardedIdentityHeader, (&Identity{
+		Name:     "forged",
+		Bindings: []Binding{{Role: RoleAdmin, Namespace: AllNamespaces}},
+	}).Encode())
+	identity, err = a.Authenticate(ctx, r)
+	require.NoError(t, err)
+	require.Equal(t, "cert:ci", identity.Name)
+	require.False(t, identity.Allows(RoleAdmin, AllNamespaces))
+}
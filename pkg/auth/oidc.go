@@ -0,0 +1,252 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// minKeysRefreshInterval limits how often the signing keys are fetched
+	// when a token is signed by an unknown key.
+	minKeysRefreshInterval = time.Minute
+	oidcRequestTimeout     = 10 * time.Second
+)
+
+// OIDCVerifier verifies the ID tokens issued by an OIDC identity provider,
+// only the RS256 signing algorithm is supported.
+type OIDCVerifier struct {
+	cfg        *config.OIDCConfig
+	httpClient *http.Client
+	// fetching makes the concurrent verifications share one fetch of the
+	// signing keys, which is done without holding mu.
+	fetching singleflight.Group
+
+	mu          sync.RWMutex
+	keys        jwk.Set
+	lastRefresh time.Time
+}
+
+// NewOIDCVerifier creates a new OIDCVerifier.
+func NewOIDCVerifier(cfg *config.OIDCConfig) *OIDCVerifier {
+	return &OIDCVerifier{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: oidcRequestTimeout},
+		keys:       jwk.NewSet(),
+	}
+}
+
+// Verify verifies the ID token and returns the identity in it.
+func (v *OIDCVerifier) Verify(ctx context.Context, rawToken string) (*Identity, error) {
+	return v.verify(ctx, rawToken, time.Now())
+}
+
+func (v *OIDCVerifier) verify(
+	ctx context.Context, rawToken string, now time.Time,
+) (*Identity, error) {
+	kid, err := tokenKeyID(rawToken)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := v.getKeys(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.Parse([]byte(rawToken),
+		jwt.WithKeySet(keys),
+		jwt.WithValidate(true),
+		jwt.WithClock(jwt.ClockFunc(func() time.Time { return now })),
+		jwt.WithIssuer(v.cfg.Issuer),
+		jwt.WithAudience(v.cfg.ClientID))
+	if err != nil {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs(
+			"invalid ID token: " + err.Error())
+	}
+	// The expiration is validated only if it's present, but an ID token
+	// must have one.
+	if token.Expiration().IsZero() {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs(
+			"invalid ID token: missing exp claim")
+	}
+	return v.identity(token), nil
+}
+
+// identity returns the identity of a verified token.
+func (v *OIDCVerifier) identity(token jwt.Token) *Identity {
+	identity := &Identity{Name: "oidc:" + token.Subject()}
+	var roles []string
+	claim, _ := token.Get(v.cfg.RolesClaim)
+	switch r := claim.(type) {
+	case string:
+		roles = strings.Fields(r)
+	case []interface{}:
+		for _, item := range r {
+			if s, ok := item.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+	}
+	for _, role := range roles {
+		binding, err := ParseBinding(role)
+		if err != nil {
+			// The identity provider may put roles of other applications in
+			// the same claim, ignore them.
+			continue
+		}
+		identity.Bindings = append(identity.Bindings, binding)
+	}
+	return identity
+}
+
+// tokenKeyID returns the ID of the key signing the token, the signature is
+// verified later by the key.
+func tokenKeyID(rawToken string) (string, error) {
+	msg, err := jws.Parse([]byte(rawToken))
+	if err != nil || len(msg.Signatures()) != 1 {
+		return "", cerror.ErrUnauthorized.GenWithStackByArgs("malformed ID token")
+	}
+	return msg.Signatures()[0].ProtectedHeaders().KeyID(), nil
+}
+
+// getKeys returns the signing keys, they're refreshed if the kid is unknown,
+// since the identity provider may rotate its keys. The keys are fetched
+// without holding the lock, so a slow identity provider doesn't block the
+// verifications of the tokens signed by the known keys.
+func (v *OIDCVerifier) getKeys(ctx context.Context, kid string) (jwk.Set, error) {
+	v.mu.RLock()
+	keys, lastRefresh := v.keys, v.lastRefresh
+	v.mu.RUnlock()
+	if _, ok := keys.LookupKeyID(kid); ok {
+		return keys, nil
+	}
+	if time.Since(lastRefresh) < minKeysRefreshInterval {
+		// The verification fails by the unknown key.
+		return keys, nil
+	}
+
+	result := v.fetching.DoChan("keys", func() (interface{}, error) {
+		v.mu.Lock()
+		if time.Since(v.lastRefresh) < minKeysRefreshInterval {
+			// The keys have just been refreshed by another verification.
+			keys := v.keys
+			v.mu.Unlock()
+			return keys, nil
+		}
+		v.lastRefresh = time.Now()
+		v.mu.Unlock()
+
+		// The fetch is shared by the verifications, so it isn't canceled
+		// by the context of any of them, it's bounded by the timeout of
+		// the http client instead.
+		keys, err := v.fetchKeys(context.Background())
+		if err != nil {
+			log.Warn("failed to fetch OIDC signing keys",
+				zap.String("issuer", v.cfg.Issuer), zap.Error(err))
+			return nil, cerror.ErrUnauthorized.GenWithStackByArgs(
+				"failed to fetch OIDC signing keys")
+		}
+		v.mu.Lock()
+		v.keys = keys
+		v.mu.Unlock()
+		return keys, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs(
+			"failed to fetch OIDC signing keys: " + ctx.Err().Error())
+	case res := <-result:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(jwk.Set), nil
+	}
+}
+
+// fetchKeys fetches the signing keys by the discovery document of the
+// issuer, the issuer in the document must be the configured one.
+func (v *OIDCVerifier) fetchKeys(ctx context.Context) (jwk.Set, error) {
+	discovery := struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	err := v.getJSON(ctx,
+		strings.TrimSuffix(v.cfg.Issuer, "/")+oidcDiscoveryPath, &discovery)
+	if err != nil {
+		return nil, err
+	}
+	if discovery.Issuer != v.cfg.Issuer {
+		return nil, cerror.ErrInternalServerError.GenWithStack(
+			"the issuer %s in the discovery document doesn't match the configured issuer %s",
+			discovery.Issuer, v.cfg.Issuer)
+	}
+	fetched, err := jwk.Fetch(ctx, discovery.JWKSURI, jwk.WithHTTPClient(v.httpClient))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrInternalServerError, err)
+	}
+
+	// Only the RSA keys are used, and they're used for RS256 only even if
+	// the identity provider doesn't specify the algorithm of them.
+	keys := jwk.NewSet()
+	for i := 0; i < fetched.Len(); i++ {
+		key, _ := fetched.Key(i)
+		if key.KeyType() != jwa.RSA {
+			continue
+		}
+		if alg := key.Algorithm(); alg != nil && alg.String() != "" &&
+			alg.String() != jwa.RS256.String() {
+			continue
+		}
+		if err := key.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
+			return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+		}
+		if err := keys.AddKey(key); err != nil {
+			return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+		}
+	}
+	return keys, nil
+}
+
+func (v *OIDCVerifier) getJSON(ctx context.Context, url string, obj interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrInternalServerError, err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrInternalServerError, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return cerror.ErrInternalServerError.GenWithStack(
+			"unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return cerror.WrapError(cerror.ErrUnmarshalFailed,
+		json.NewDecoder(resp.Body).Decode(obj))
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// newOIDCServer starts an identity provider serving the public key of key
+// as k1, and the issuer returned by issuer in its discovery document.
+// It returns the server and the number of the fetches of the keys.
+func newOIDCServer(
+	t *testing.T, key *rsa.PrivateKey, issuer func(url string) string,
+) (*httptest.Server, *int32) {
+	fetches := new(int32)
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer(server.URL),
+			"jwks_uri": server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(fetches, 1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "k1",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e": base64.RawURLEncoding.EncodeToString(
+					big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	return server, fetches
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, fetches := newOIDCServer(t, key, func(url string) string { return url })
+
+	v := NewOIDCVerifier(&config.OIDCConfig{
+		Issuer:     server.URL,
+		ClientID:   "ticdc",
+		RolesClaim: "roles",
+	})
+	claims := map[string]interface{}{
+		"iss":   server.URL,
+		"aud":   []string{"ticdc"},
+		"sub":   "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"viewer", "operator:ns1", "other-app-role"},
+	}
+	identity, err := v.Verify(context.Background(), signToken(t, key, "k1", claims))
+	require.NoError(t, err)
+	require.Equal(t, "oidc:alice", identity.Name)
+	require.Equal(t, []Binding{
+		{Role: RoleViewer, Namespace: AllNamespaces},
+		{Role: RoleOperator, Namespace: "ns1"},
+	}, identity.Bindings)
+
+	// unknown key, the keys are not fetched again within the refresh interval
+	_, err = v.Verify(context.Background(), signToken(t, key, "k2", claims))
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(fetches))
+
+	// tampered token
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = v.Verify(context.Background(), signToken(t, otherKey, "k1", claims))
+	require.Error(t, err)
+
+	// malformed token
+	_, err = v.Verify(context.Background(), "not-a-token")
+	require.Regexp(t, ".*malformed ID token.*", err)
+}
+
+func TestOIDCVerifierIssuerMismatch(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, fetches := newOIDCServer(t, key, func(string) string {
+		return "https://attacker"
+	})
+
+	v := NewOIDCVerifier(&config.OIDCConfig{
+		Issuer:     server.URL,
+		ClientID:   "ticdc",
+		RolesClaim: "roles",
+	})
+	_, err = v.Verify(context.Background(), signToken(t, key, "k1", map[string]interface{}{
+		"iss": server.URL,
+		"aud": "ticdc",
+		"sub": "alice",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}))
+	require.Regexp(t, ".*failed to fetch OIDC signing keys.*", err)
+	require.Equal(t, int32(0), atomic.LoadInt32(fetches))
+}
+
+func TestOIDCVerifyClaims(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, _ := newOIDCServer(t, key, func(url string) string { return url })
+
+	v := NewOIDCVerifier(&config.OIDCConfig{
+		Issuer:     server.URL,
+		ClientID:   "ticdc",
+		RolesClaim: "groups",
+	})
+	now := time.Now()
+	claims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":    server.URL,
+			"aud":    "ticdc",
+			"sub":    "bob",
+			"exp":    now.Add(time.Minute).Unix(),
+			"groups": "admin",
+		}
+	}
+	verify := func(c map[string]interface{}, now time.Time) (*Identity, error) {
+		return v.verify(context.Background(), signToken(t, key, "k1", c), now)
+	}
+
+	identity, err := verify(claims(), now)
+	require.NoError(t, err)
+	require.True(t, identity.Allows(RoleAdmin, AllNamespaces))
+
+	c := claims()
+	c["iss"] = "https://other"
+	_, err = verify(c, now)
+	require.Regexp(t, ".*invalid ID token.*iss.*", err)
+
+	c = claims()
+	c["aud"] = "other"
+	_, err = verify(c, now)
+	require.Regexp(t, ".*invalid ID token.*aud.*", err)
+
+	_, err = verify(claims(), now.Add(time.Hour))
+	require.Regexp(t, ".*invalid ID token.*exp.*", err)
+
+	c = claims()
+	delete(c, "exp")
+	_, err = verify(c, now)
+	require.Regexp(t, ".*missing exp claim.*", err)
+
+	c = claims()
+	c["nbf"] = now.Add(time.Minute * 30).Unix()
+	_, err = verify(c, now)
+	require.Regexp(t, ".*invalid ID token.*nbf.*", err)
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Role is the role of a caller of the TiCDC HTTP API. A role is granted all
+// the permissions of the roles lower than it.
+type Role string
+
+const (
+	// RoleViewer can only read the state of the cluster.
+	RoleViewer Role = "viewer"
+	// RoleOperator can manage changefeeds, such as creating, updating and
+	// pausing changefeeds.
+	RoleOperator Role = "operator"
+	// RoleAdmin can do everything, including the cluster level operations
+	// and issuing tokens.
+	RoleAdmin Role = "admin"
+)
+
+// AllNamespaces is the namespace of a binding which takes effect on all
+// namespaces.
+const AllNamespaces = "*"
+
+// level returns the privilege level of the role, 0 means an invalid role.
+func (r Role) level() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Covers returns true if the role has all the permissions of the required role.
+func (r Role) Covers(required Role) bool {
+	return r.level() > 0 && r.level() >= required.level()
+}
+
+// ParseRole parses a role from a string.
+func ParseRole(s string) (Role, error) {
+	r := Role(strings.ToLower(strings.TrimSpace(s)))
+	if r.level() == 0 {
+		return "", cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid role: %s, it must be one of %s, %s and %s",
+			s, RoleViewer, RoleOperator, RoleAdmin)
+	}
+	return r, nil
+}
+
+// Binding grants a role in a namespace.
+type Binding struct {
+	Role Role `json:"role"`
+	// Namespace is the namespace the role is granted in, AllNamespaces
+	// means all namespaces.
+	Namespace string `json:"namespace"`
+}
+
+// ParseBinding parses a binding from a string like "operator:ns1". The
+// binding takes effect on all namespaces if the namespace is omitted.
+func ParseBinding(s string) (Binding, error) {
+	roleStr, namespace, found := strings.Cut(s, ":")
+	if !found || namespace == "" {
+		namespace = AllNamespaces
+	}
+	role, err := ParseRole(roleStr)
+	if err != nil {
+		return Binding{}, err
+	}
+	return Binding{Role: role, Namespace: namespace}, nil
+}
+
+// String implements fmt.Stringer.
+func (b Binding) String() string {
+	return string(b.Role) + ":" + b.Namespace
+}
+
+// Allows returns true if the binding grants the role in the namespace.
+// Only the bindings on all namespaces are allowed to access the cluster
+// level APIs, whose namespace is AllNamespaces.
+func (b Binding) Allows(role Role, namespace string) bool {
+	if !b.Role.Covers(role) {
+		return false
+	}
+	return b.Namespace == AllNamespaces || b.Namespace == namespace
+}
+
+// Identity is an authenticated caller of the TiCDC HTTP API.
+type Identity struct {
+	// Name is the name of the caller, such as the name of a token or the
+	// subject of an OIDC ID token.
+	Name     string    `json:"name"`
+	Bindings []Binding `json:"bindings"`
+}
+
+// Encode encodes the identity to be forwarded in a header.
+func (i *Identity) Encode() string {
+	data, _ := json.Marshal(i)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeIdentity decodes an identity encoded by Identity.Encode.
+func DecodeIdentity(s string) (*Identity, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("malformed forwarded identity")
+	}
+	identity := &Identity{}
+	if err := json.Unmarshal(data, identity); err != nil || identity.Name == "" {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("malformed forwarded identity")
+	}
+	return identity, nil
+}
+
+// Allows returns true if any binding of the identity grants the role in
+// the namespace.
+func (i *Identity) Allows(role Role, namespace string) bool {
+	for _, b := range i.Bindings {
+		if b.Allows(role, namespace) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBinding(t *testing.T) {
+	t.Parallel()
+
+	b, err := ParseBinding("operator:ns1")
+	require.NoError(t, err)
+	require.Equal(t, Binding{Role: RoleOperator, Namespace: "ns1"}, b)
+
+	b, err = ParseBinding("Admin")
+	require.NoError(t, err)
+	require.Equal(t, Binding{Role: RoleAdmin, Namespace: AllNamespaces}, b)
+	require.Equal(t, "admin:*", b.String())
+
+	_, err = ParseBinding("root:ns1")
+	require.Error(t, err)
+}
+
+func TestIdentityAllows(t *testing.T) {
+	t.Parallel()
+
+	identity := &Identity{
+		Name: "test",
+		Bindings: []Binding{
+			{Role: RoleViewer, Namespace: AllNamespaces},
+			{Role: RoleOperator, Namespace: "ns1"},
+		},
+	}
+	require.True(t, identity.Allows(RoleViewer, "ns2"))
+	require.True(t, identity.Allows(RoleViewer, AllNamespaces))
+	require.True(t, identity.Allows(RoleOperator, "ns1"))
+	require.False(t, identity.Allows(RoleOperator, "ns2"))
+	require.False(t, identity.Allows(RoleOperator, AllNamespaces))
+	require.False(t, identity.Allows(RoleAdmin, "ns1"))
+
+	require.False(t, Role("unknown").Covers(RoleViewer))
+}
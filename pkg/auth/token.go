@@ -0,0 +1,234 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	tokenIDBytes     = 8
+	tokenSecretBytes = 32
+)
+
+// Token is an API token issued to a caller. Only the hash of the secret is
+// stored, the secret itself is returned once when the token is issued or
+// rotated.
+type Token struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Binding    Binding   `json:"binding"`
+	Hash       string    `json:"hash"`
+	CreateTime time.Time `json:"create_time"`
+	// ExpireTime is the time after which the token is rejected, a zero
+	// value means the token never expires.
+	ExpireTime time.Time `json:"expire_time,omitempty"`
+}
+
+// Expired returns true if the token is expired at the given time.
+func (t *Token) Expired(now time.Time) bool {
+	return !t.ExpireTime.IsZero() && !now.Before(t.ExpireTime)
+}
+
+// TokenStore issues, rotates, revokes and verifies API tokens.
+type TokenStore interface {
+	// Issue issues a new token with the binding, and returns its secret.
+	// The token never expires if the ttl is 0.
+	Issue(ctx context.Context, name string, binding Binding,
+		ttl time.Duration) (string, *Token, error)
+	// Rotate replaces the secret of a token, the old secret is rejected
+	// afterwards.
+	Rotate(ctx context.Context, id string, ttl time.Duration) (string, *Token, error)
+	// Revoke deletes a token.
+	Revoke(ctx context.Context, id string) error
+	// List returns all tokens.
+	List(ctx context.Context) ([]*Token, error)
+	// Verify returns the token of the secret if it's valid.
+	Verify(ctx context.Context, secret string) (*Token, error)
+}
+
+type etcdTokenStore struct {
+	client *clientv3.Client
+	prefix string
+}
+
+// NewEtcdTokenStore returns a TokenStore which saves tokens in etcd, so all
+// the captures in a cluster share the same tokens.
+func NewEtcdTokenStore(client *clientv3.Client, clusterID string) TokenStore {
+	return &etcdTokenStore{
+		client: client,
+		prefix: etcd.AuthTokenKeyPrefix(clusterID),
+	}
+}
+
+func (s *etcdTokenStore) key(id string) string {
+	return s.prefix + "/" + id
+}
+
+func (s *etcdTokenStore) Issue(
+	ctx context.Context, name string, binding Binding, ttl time.Duration,
+) (string, *Token, error) {
+	id, err := randomString(tokenIDBytes, hex.EncodeToString)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	token := &Token{
+		ID:         id,
+		Name:       name,
+		Binding:    binding,
+		CreateTime: time.Now(),
+	}
+	secret, err := s.put(ctx, token, ttl, clientv3.Compare(
+		clientv3.CreateRevision(s.key(id)), "=", 0))
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, token, nil
+}
+
+func (s *etcdTokenStore) Rotate(
+	ctx context.Context, id string, ttl time.Duration,
+) (string, *Token, error) {
+	token, revision, err := s.get(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	secret, err := s.put(ctx, token, ttl, clientv3.Compare(
+		clientv3.ModRevision(s.key(id)), "=", revision))
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, token, nil
+}
+
+// put generates a new secret for the token, and saves the token if the
+// condition is met.
+func (s *etcdTokenStore) put(
+	ctx context.Context, token *Token, ttl time.Duration, cmp clientv3.Cmp,
+) (string, error) {
+	random, err := randomString(tokenSecretBytes,
+		base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	secret := token.ID + "." + random
+	token.Hash = hashSecret(secret)
+	token.ExpireTime = time.Time{}
+	if ttl > 0 {
+		token.ExpireTime = time.Now().Add(ttl)
+	}
+	value, err := json.Marshal(token)
+	if err != nil {
+		return "", cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+	resp, err := s.client.Txn(ctx).If(cmp).
+		Then(clientv3.OpPut(s.key(token.ID), string(value))).Commit()
+	if err != nil {
+		return "", cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if !resp.Succeeded {
+		return "", cerror.ErrPDEtcdAPIError.GenWithStack(
+			"token %s is modified concurrently", token.ID)
+	}
+	return secret, nil
+}
+
+func (s *etcdTokenStore) get(ctx context.Context, id string) (*Token, int64, error) {
+	resp, err := s.client.Get(ctx, s.key(id))
+	if err != nil {
+		return nil, 0, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, 0, cerror.ErrAuthTokenNotFound.GenWithStackByArgs(id)
+	}
+	token := &Token{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, token); err != nil {
+		return nil, 0, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+	}
+	return token, resp.Kvs[0].ModRevision, nil
+}
+
+func (s *etcdTokenStore) Revoke(ctx context.Context, id string) error {
+	resp, err := s.client.Delete(ctx, s.key(id))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Deleted == 0 {
+		return cerror.ErrAuthTokenNotFound.GenWithStackByArgs(id)
+	}
+	return nil
+}
+
+func (s *etcdTokenStore) List(ctx context.Context) ([]*Token, error) {
+	resp, err := s.client.Get(ctx, s.prefix+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	tokens := make([]*Token, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		token := &Token{}
+		if err := json.Unmarshal(kv.Value, token); err != nil {
+			return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func (s *etcdTokenStore) Verify(ctx context.Context, secret string) (*Token, error) {
+	id, _, found := strings.Cut(secret, ".")
+	if !found || id == "" {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("malformed token")
+	}
+	token, _, err := s.get(ctx, id)
+	if err != nil {
+		if cerror.ErrAuthTokenNotFound.Equal(err) {
+			return nil, cerror.ErrUnauthorized.GenWithStackByArgs("invalid token")
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(token.Hash), []byte(hashSecret(secret))) != 1 {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("invalid token")
+	}
+	if token.Expired(time.Now()) {
+		return nil, cerror.ErrUnauthorized.GenWithStackByArgs("token expired")
+	}
+	return token, nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(n int, encode func([]byte) string) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return encode(buf), nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func TestEtcdTokenStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientURL, etcdServer, err := etcd.SetupEmbedEtcd(t.TempDir())
+	require.NoError(t, err)
+	defer etcdServer.Close()
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{clientURL.String()},
+		DialTimeout: 3 * time.Second,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	store := NewEtcdTokenStore(client, etcd.DefaultCDCClusterID)
+	binding := Binding{Role: RoleOperator, Namespace: "ns1"}
+	secret, token, err := store.Issue(ctx, "ci", binding, 0)
+	require.NoError(t, err)
+	require.NotContains(t, token.Hash, secret)
+
+	verified, err := store.Verify(ctx, secret)
+	require.NoError(t, err)
+	require.Equal(t, token.ID, verified.ID)
+	require.Equal(t, binding, verified.Binding)
+
+	_, err = store.Verify(ctx, token.ID+".wrong")
+	require.Error(t, err)
+	_, err = store.Verify(ctx, "malformed")
+	require.Error(t, err)
+
+	// the old secret is rejected after rotating.
+	newSecret, _, err := store.Rotate(ctx, token.ID, time.Hour)
+	require.NoError(t, err)
+	_, err = store.Verify(ctx, secret)
+	require.Error(t, err)
+	verified, err = store.Verify(ctx, newSecret)
+	require.NoError(t, err)
+	require.False(t, verified.ExpireTime.IsZero())
+
+	tokens, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+
+	require.NoError(t, store.Revoke(ctx, token.ID))
+	_, err = store.Verify(ctx, newSecret)
+	require.Error(t, err)
+	err = store.Revoke(ctx, token.ID)
+	require.Regexp(t, ".*ErrAuthTokenNotFound.*", err)
+}
+
+func TestTokenExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	token := &Token{}
+	require.False(t, token.Expired(now))
+	token.ExpireTime = now
+	require.True(t, token.Expired(now))
+	require.False(t, token.Expired(now.Add(-time.Second)))
+}
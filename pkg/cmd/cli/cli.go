@@ -22,13 +22,35 @@ import (
 
 // NewCmdCli creates the `cli` command.
 func NewCmdCli() *cobra.Command {
+	return newCmdCli(false)
+}
+
+// newCmdCli creates the `cli` command, inShell is true if the command is run
+// in the interactive shell, which has initialized the command environment.
+func newCmdCli(inShell bool) *cobra.Command {
 	// Bind the certificate and log options.
 	cf := factory.NewClientFlags()
 
 	cmds := &cobra.Command{
 		Use:   "cli",
 		Short: "Manage replication task and TiCDC cluster",
+		Long: `Manage replication task and TiCDC cluster
+
+Exit codes:
+  0  Succeeded
+  1  Failed for the reasons not classified below
+  2  The flags or the arguments are invalid
+  3  The changefeed or the capture doesn't exist
+  4  The server is unreachable or not ready
+  5  Timed out waiting for the condition specified by --wait-for
+  6  The condition specified by --wait-for can't be reached, e.g. the changefeed failed`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := util.ValidateOutputFormat(util.GetOutputFormat(cmd)); err != nil {
+				return err
+			}
+			if inShell {
+				return nil
+			}
 			// Here we will initialize the logging configuration and set the current default context.
 			cancel := util.InitCmd(cmd, &logutil.Config{Level: cf.GetLogLevel()})
 			util.LogHTTPProxies()
@@ -46,6 +68,20 @@ func NewCmdCli() *cobra.Command {
 
 	// Binding the `cli` command flags.
 	cf.AddFlags(cmds)
+	util.AddOutputFlag(cmds)
+
+	if !inShell {
+		interactive := false
+		cmds.Flags().BoolVar(&interactive, "interactive", false,
+			"Run commands in an interactive shell, which keeps the command history "+
+				"and the server address and namespace across commands")
+		cmds.RunE = func(cmd *cobra.Command, args []string) error {
+			if !interactive {
+				return cmd.Help()
+			}
+			return runShell(cmd, cf)
+		}
+	}
 
 	// Construct the client construction factory.
 	f := factory.NewFactory(cf)
@@ -53,9 +89,15 @@ func NewCmdCli() *cobra.Command {
 	// Add subcommands.
 	cmds.AddCommand(newCmdCapture(f))
 	cmds.AddCommand(newCmdChangefeed(f))
+	cmds.AddCommand(newCmdDebug(f))
+	cmds.AddCommand(newCmdDoctor(f))
 	cmds.AddCommand(newCmdProcessor(f))
+	cmds.AddCommand(newCmdRedo(f))
 	cmds.AddCommand(newCmdTso(f))
 	cmds.AddCommand(newCmdUnsafe(f))
 
+	// Complete the changefeed IDs and the capture IDs by querying the server.
+	registerCompletions(cmds, f)
+
 	return cmds
 }
@@ -0,0 +1,187 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// drainProgressBarWidth is the width of the progress bar shown by the
+// `cli capture drain` command.
+const drainProgressBarWidth = 30
+
+// drainCaptureOptions defines flags for the `cli capture drain` command.
+type drainCaptureOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	captureID string
+	timeout   time.Duration
+	interval  time.Duration
+	force     bool
+}
+
+// newDrainCaptureOptions creates new options for the `cli capture drain` command.
+func newDrainCaptureOptions() *drainCaptureOptions {
+	return &drainCaptureOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *drainCaptureOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.captureID, "capture-id", "", "ID of the capture to drain")
+	cmd.PersistentFlags().DurationVar(&o.timeout, "timeout", 10*time.Minute,
+		"Max time to wait for the capture to be drained")
+	cmd.PersistentFlags().DurationVar(&o.interval, "interval", time.Second,
+		"Interval of refreshing the progress")
+	cmd.PersistentFlags().BoolVar(&o.force, "force", false,
+		"Exit successfully even if some tables remain on the capture when the timeout is reached")
+	_ = cmd.MarkPersistentFlagRequired("capture-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *drainCaptureOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// validate checks that the provided drain options are specified as expected.
+func (o *drainCaptureOptions) validate() error {
+	if o.timeout <= 0 {
+		return errors.Errorf("invalid timeout %s", o.timeout)
+	}
+	if o.interval < time.Millisecond {
+		return errors.Errorf("invalid interval %s, it must be at least 1ms", o.interval)
+	}
+	return nil
+}
+
+// run the `cli capture drain` command.
+func (o *drainCaptureOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	live := isTerminal(cmd.OutOrStdout())
+	start := time.Now()
+	total := 0
+	for {
+		// The owner keeps moving the tables out of the capture once it's
+		// asked to, every request returns the number of the remaining tables.
+		resp, err := o.apiClient.Captures().Drain(ctx, o.captureID)
+		if err != nil {
+			return err
+		}
+		remaining := resp.CurrentTableCount
+		if remaining > total {
+			total = remaining
+		}
+		elapsed := time.Since(start)
+		line := renderDrainProgress(total, remaining, elapsed)
+		if live {
+			// Redraw the progress in place.
+			cmd.Print("\r\033[K" + line)
+		} else {
+			cmd.Println(line)
+		}
+		if remaining == 0 {
+			if live {
+				cmd.Println()
+			}
+			cmd.Printf("Capture %s is drained in %s\n",
+				o.captureID, elapsed.Round(time.Millisecond))
+			return nil
+		}
+		if elapsed >= o.timeout {
+			if live {
+				cmd.Println()
+			}
+			if o.force {
+				cmd.Printf("Timed out draining capture %s, %d tables remain, ignored by --force\n",
+					o.captureID, remaining)
+				return nil
+			}
+			return errors.Errorf("timed out draining capture %s after %s, %d tables remain",
+				o.captureID, o.timeout, remaining)
+		}
+
+		select {
+		case <-ctx.Done():
+			if live {
+				cmd.Println()
+			}
+			// Exit normally when it's interrupted.
+			if errors.Cause(ctx.Err()) == context.Canceled {
+				return nil
+			}
+			return errors.Trace(ctx.Err())
+		case <-time.After(o.interval):
+		}
+	}
+}
+
+// renderDrainProgress renders the progress of draining a capture, the
+// estimated time to complete is derived from the speed so far.
+func renderDrainProgress(total, remaining int, elapsed time.Duration) string {
+	moved := total - remaining
+	filled := drainProgressBarWidth
+	if total > 0 {
+		filled = moved * drainProgressBarWidth / total
+	}
+	eta := "unknown"
+	switch {
+	case remaining == 0:
+		eta = "0s"
+	case moved > 0:
+		eta = (elapsed * time.Duration(remaining) / time.Duration(moved)).
+			Round(time.Second).String()
+	}
+	return fmt.Sprintf("[%s%s] %d/%d tables moved, %d remaining, elapsed %s, ETA %s",
+		strings.Repeat("#", filled), strings.Repeat("-", drainProgressBarWidth-filled),
+		moved, total, remaining, elapsed.Round(time.Second), eta)
+}
+
+// newCmdDrainCapture creates the `cli capture drain` command.
+func newCmdDrainCapture(f factory.Factory) *cobra.Command {
+	o := newDrainCaptureOptions()
+
+	command := &cobra.Command{
+		Use:   "drain",
+		Short: "Move all tables out of a capture",
+		Long: `Move all tables out of a capture and wait until no table remains on it, the
+progress is shown until then, so the capture can be restarted safely.`,
+		Example: `  cdc cli capture drain --capture-id=e7dd5d37-df91-4a36-8af0-15d2a4b3b4a1 --timeout=5m`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureDrainCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cps := mock.NewMockCaptureInterface(ctrl)
+	f := &mockFactory{captures: cps}
+
+	cmd := newCmdDrainCapture(f)
+	gomock.InOrder(
+		cps.EXPECT().Drain(gomock.Any(), "c1").
+			Return(&model.DrainCaptureResp{CurrentTableCount: 4}, nil),
+		cps.EXPECT().Drain(gomock.Any(), "c1").
+			Return(&model.DrainCaptureResp{CurrentTableCount: 1}, nil),
+		cps.EXPECT().Drain(gomock.Any(), "c1").
+			Return(&model.DrainCaptureResp{CurrentTableCount: 0}, nil),
+	)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	os.Args = []string{"drain", "--capture-id=c1", "--interval=1ms"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, out.String(), "3/4 tables moved, 1 remaining")
+	require.Contains(t, out.String(), "Capture c1 is drained")
+
+	o := newDrainCaptureOptions()
+	o.captureID = "c1"
+	o.interval = time.Millisecond
+	require.NotNil(t, o.validate())
+	o.timeout = time.Millisecond
+	require.Nil(t, o.validate())
+	require.Nil(t, o.complete(f))
+
+	// timed out
+	cps.EXPECT().Drain(gomock.Any(), "c1").
+		Return(&model.DrainCaptureResp{CurrentTableCount: 2}, nil).AnyTimes()
+	require.Regexp(t, ".*timed out draining capture c1.*2 tables remain.*", o.run(cmd))
+	o.force = true
+	require.Nil(t, o.run(cmd))
+
+	cps2 := mock.NewMockCaptureInterface(ctrl)
+	cps2.EXPECT().Drain(gomock.Any(), "c1").Return(nil, errors.New("test"))
+	require.Nil(t, o.complete(&mockFactory{captures: cps2}))
+	require.NotNil(t, o.run(cmd))
+}
+
+func TestRenderDrainProgress(t *testing.T) {
+	require.Equal(t,
+		"[###############---------------] 2/4 tables moved, 2 remaining, elapsed 10s, ETA 10s",
+		renderDrainProgress(4, 2, 10*time.Second))
+	require.Equal(t,
+		"[------------------------------] 0/4 tables moved, 4 remaining, elapsed 1s, ETA unknown",
+		renderDrainProgress(4, 4, time.Second))
+	require.Equal(t,
+		"[##############################] 0/0 tables moved, 0 remaining, elapsed 0s, ETA 0s",
+		renderDrainProgress(0, 0, 0))
+}
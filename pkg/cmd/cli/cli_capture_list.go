@@ -68,7 +68,7 @@ func (o *listCaptureOptions) run(cmd *cobra.Command) error {
 			})
 	}
 
-	return util.JSONPrint(cmd, captures)
+	return util.PrintOutput(cmd, captures)
 }
 
 // newCmdListCapture creates the `cli capture list` command.
@@ -33,6 +33,7 @@ type mockAPIV2Client struct {
 	unsafes     apiv2client.UnsafeInterface
 	captures    apiv2client.CaptureInterface
 	processors  apiv2client.ProcessorInterface
+	status      apiv2client.StatusInterface
 }
 
 func (f *mockAPIV2Client) Changefeeds() apiv2client.ChangefeedInterface {
@@ -55,6 +56,10 @@ func (f *mockAPIV2Client) Processors() apiv2client.ProcessorInterface {
 	return f.processors
 }
 
+func (f *mockAPIV2Client) Status() apiv2client.StatusInterface {
+	return f.status
+}
+
 type mockFactory struct {
 	factory.Factory
 	captures    *mock.MockCaptureInterface
@@ -89,6 +94,7 @@ func (f *mockFactory) APIV2Client() (apiv2client.APIV2Interface, error) {
 		tso:         f.tso,
 		unsafes:     f.unsafes,
 		processors:  f.processors,
+		status:      f.status,
 	}, nil
 }
 
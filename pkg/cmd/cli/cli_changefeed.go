@@ -34,6 +34,16 @@ func newCmdChangefeed(f factory.Factory) *cobra.Command {
 	cmds.AddCommand(newCmdQueryChangefeed(f))
 	cmds.AddCommand(newCmdRemoveChangefeed(f))
 	cmds.AddCommand(newCmdResumeChangefeed(f))
+	cmds.AddCommand(newCmdExportChangefeed(f))
+	cmds.AddCommand(newCmdImportChangefeed(f))
+	cmds.AddCommand(newCmdLabelChangefeed(f))
+	cmds.AddCommand(newCmdPauseTables(f))
+	cmds.AddCommand(newCmdResumeTables(f))
+	cmds.AddCommand(newCmdHandleError(f))
+	cmds.AddCommand(newCmdErrorsChangefeed(f))
+	cmds.AddCommand(newCmdDriftChangefeed(f))
+	cmds.AddCommand(newCmdWatchChangefeed(f))
+	cmds.AddCommand(newCmdTemplateChangefeed(f))
 
 	return cmds
 }
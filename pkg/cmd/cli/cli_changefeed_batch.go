@@ -0,0 +1,160 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// changefeedBatchOptions defines the flags to select multiple changefeeds by
+// the labels or the patterns of the IDs, which are used by the commands
+// operating on multiple changefeeds.
+type changefeedBatchOptions struct {
+	selector string
+	match    string
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *changefeedBatchOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.selector, "selector", "l", "",
+		"Label selector to select multiple replication tasks, such as env=prod,team!=foo")
+	cmd.PersistentFlags().StringVar(&o.match, "match", "",
+		"Glob pattern of the IDs to select multiple replication tasks, such as 'order-*'")
+}
+
+// enabled returns true if the changefeeds are selected by the flags.
+func (o *changefeedBatchOptions) enabled() bool {
+	return o.selector != "" || o.match != ""
+}
+
+// validate checks that either a changefeed ID or the batch flags are specified.
+func (o *changefeedBatchOptions) validate(changefeedID string) error {
+	if o.enabled() && changefeedID != "" {
+		return errors.New("--changefeed-id can't be specified with --selector or --match")
+	}
+	if !o.enabled() && changefeedID == "" {
+		return errors.New("either --changefeed-id or --selector/--match must be specified")
+	}
+	if _, err := path.Match(o.match, ""); err != nil {
+		return errors.Annotatef(err, "invalid pattern %s", o.match)
+	}
+	return nil
+}
+
+// list lists the changefeeds matching both the selector and the pattern.
+func (o *changefeedBatchOptions) list(
+	ctx context.Context, apiClient apiv2client.APIV2Interface,
+) ([]v2.ChangefeedCommonInfo, error) {
+	changefeeds, err := apiClient.Changefeeds().List(ctx, "all", o.selector)
+	if err != nil {
+		return nil, err
+	}
+	if o.match == "" {
+		return changefeeds, nil
+	}
+	matched := make([]v2.ChangefeedCommonInfo, 0, len(changefeeds))
+	for _, cf := range changefeeds {
+		// The pattern has been validated.
+		if ok, _ := path.Match(o.match, cf.ID); ok {
+			matched = append(matched, cf)
+		}
+	}
+	return matched, nil
+}
+
+// confirmBatch prints the summary of the changefeeds to operate and prompts
+// the user to confirm if noConfirm is false.
+func confirmBatch(
+	cmd *cobra.Command, command string, changefeeds []v2.ChangefeedCommonInfo, noConfirm bool,
+) error {
+	cmd.Printf("The following %d changefeeds will be %s:\n", len(changefeeds), pastTense(command))
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  ID\tSTATE\tCHECKPOINT")
+	for _, cf := range changefeeds {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", cf.ID, cf.FeedState,
+			time.Time(cf.CheckpointTime).Format(timeFormat))
+	}
+	_ = w.Flush()
+	if noConfirm {
+		return nil
+	}
+	cmd.Printf("Confirm to %s these changefeeds [Y/N]\n", command)
+	if !readInput(cmd) {
+		cmd.Printf("Abort changefeed %s.\n", command)
+		return cerror.ErrCliAborted.FastGenByArgs(fmt.Sprintf("cli changefeed %s", command))
+	}
+	return nil
+}
+
+// runBatch runs fn on each changefeed and prints the result of each one, an
+// error is returned if fn fails on any changefeed.
+func runBatch(
+	cmd *cobra.Command, command string, changefeeds []v2.ChangefeedCommonInfo,
+	fn func(changefeedID string) error,
+) error {
+	failed := 0
+	for _, cf := range changefeeds {
+		if err := fn(cf.ID); err != nil {
+			failed++
+			cmd.Printf("  %s: failed, %s\n", cf.ID, err.Error())
+			continue
+		}
+		cmd.Printf("  %s: %s\n", cf.ID, pastTense(command))
+	}
+	cmd.Printf("%d changefeeds %s, %d failed.\n",
+		len(changefeeds)-failed, pastTense(command), failed)
+	if failed > 0 {
+		return errors.Errorf("failed to %s %d of %d changefeeds",
+			command, failed, len(changefeeds))
+	}
+	return nil
+}
+
+// runChangefeedBatch selects the changefeeds by o, confirms with the user
+// and runs fn on each of them.
+func runChangefeedBatch(
+	ctx context.Context, cmd *cobra.Command, apiClient apiv2client.APIV2Interface,
+	o *changefeedBatchOptions, command string, noConfirm bool,
+	fn func(changefeedID string) error,
+) error {
+	changefeeds, err := o.list(ctx, apiClient)
+	if err != nil {
+		return err
+	}
+	if len(changefeeds) == 0 {
+		cmd.Printf("No changefeed matches the selector or the pattern.\n")
+		return nil
+	}
+	if err := confirmBatch(cmd, command, changefeeds, noConfirm); err != nil {
+		return err
+	}
+	return runBatch(cmd, command, changefeeds, fn)
+}
+
+// pastTense returns the past tense of the commands operating on changefeeds,
+// which are pause, resume and remove.
+func pastTense(command string) string {
+	return command + "d"
+}
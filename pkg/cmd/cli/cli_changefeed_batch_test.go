@@ -0,0 +1,100 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedBatchCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	changefeeds := []v2.ChangefeedCommonInfo{
+		{ID: "order-1", FeedState: model.StateNormal},
+		{ID: "order-2", FeedState: model.StateNormal},
+		{ID: "user-1", FeedState: model.StateNormal},
+	}
+
+	// pause the changefeeds selected by both the selector and the pattern.
+	cmd := newCmdPauseChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cf.EXPECT().List(gomock.Any(), "all", "team=a").Return(changefeeds, nil)
+	cf.EXPECT().Pause(gomock.Any(), "order-1").Return(nil)
+	cf.EXPECT().Pause(gomock.Any(), "order-2").Return(errors.New("fake error"))
+	o := newPauseChangefeedOptions()
+	o.batch.selector = "team=a"
+	o.batch.match = "order-*"
+	o.noConfirm = true
+	require.Nil(t, o.validate())
+	require.Nil(t, o.complete(f))
+	require.ErrorContains(t, o.run(cmd), "failed to pause 1 of 2 changefeeds")
+	out := b.String()
+	require.Contains(t, out, "The following 2 changefeeds will be paused:\n")
+	require.NotContains(t, out, "user-1")
+	require.Contains(t, out, "  order-1: paused\n")
+	require.Contains(t, out, "  order-2: failed, fake error\n")
+	require.Contains(t, out, "1 changefeeds paused, 1 failed.\n")
+
+	// resume the changefeeds selected by the pattern.
+	cmd = newCmdResumeChangefeed(f)
+	b = bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cf.EXPECT().List(gomock.Any(), "all", "").Return(changefeeds, nil)
+	cf.EXPECT().Resume(gomock.Any(), gomock.Any(), "user-1").Return(nil)
+	os.Args = []string{"resume", "--match=user-*", "--no-confirm"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "1 changefeeds resumed, 0 failed.\n")
+
+	// no changefeed is selected.
+	cmd = newCmdRemoveChangefeed(f)
+	b = bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cf.EXPECT().List(gomock.Any(), "all", "").Return(changefeeds, nil)
+	os.Args = []string{"remove", "--match=abc*"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "No changefeed matches")
+}
+
+func TestChangefeedBatchValidate(t *testing.T) {
+	t.Parallel()
+
+	o := &changefeedBatchOptions{}
+	require.ErrorContains(t, o.validate(""), "must be specified")
+	require.Nil(t, o.validate("abc"))
+
+	o = &changefeedBatchOptions{selector: "team=a"}
+	require.ErrorContains(t, o.validate("abc"), "can't be specified")
+	require.Nil(t, o.validate(""))
+
+	o = &changefeedBatchOptions{match: "order-["}
+	require.ErrorContains(t, o.validate(""), "invalid pattern")
+
+	resume := newResumeChangefeedOptions()
+	resume.batch.match = "order-*"
+	resume.overwriteCheckpointTs = "now"
+	require.ErrorContains(t, resume.validate(), "--overwrite-checkpoint-ts")
+}
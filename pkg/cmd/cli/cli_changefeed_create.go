@@ -16,7 +16,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
 	"strings"
 
 	"github.com/fatih/color"
@@ -51,11 +53,14 @@ type changefeedCommonOptions struct {
 	upstreamCaPath   string
 	upstreamCertPath string
 	upstreamKeyPath  string
+
+	// stdin is read for the config if the config file is "-".
+	stdin io.Reader
 }
 
 // newChangefeedCommonOptions creates new changefeed common options.
 func newChangefeedCommonOptions() *changefeedCommonOptions {
-	return &changefeedCommonOptions{}
+	return &changefeedCommonOptions{stdin: os.Stdin}
 }
 
 // addFlags receives a *cobra.Command reference and binds
@@ -63,8 +68,11 @@ func newChangefeedCommonOptions() *changefeedCommonOptions {
 func (o *changefeedCommonOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVar(&o.noConfirm, "no-confirm", false, "Don't ask user whether to ignore ineligible table")
 	cmd.PersistentFlags().Uint64Var(&o.targetTs, "target-ts", 0, "Target ts of changefeed")
-	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "", "sink uri")
-	cmd.PersistentFlags().StringVar(&o.configFile, "config", "", "Path of the configuration file")
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "",
+		"sink uri, the ${NAME} references to the environment variables are expanded")
+	cmd.PersistentFlags().StringVar(&o.configFile, "config", "",
+		"Path of the configuration file, or - to read it from the stdin, "+
+			"the ${NAME} references to the environment variables are expanded")
 	cmd.PersistentFlags().StringVar(&o.sortEngine, "sort-engine", model.SortUnified, "sort engine used for data sort")
 	cmd.PersistentFlags().StringVar(&o.sortDir, "sort-dir", "", "directory used for data sort")
 	cmd.PersistentFlags().StringVar(&o.schemaRegistry, "schema-registry", "",
@@ -89,7 +97,7 @@ func (o *changefeedCommonOptions) addFlags(cmd *cobra.Command) {
 
 // strictDecodeConfig do strictDecodeFile check and only verify the rules for now.
 func (o *changefeedCommonOptions) strictDecodeConfig(component string, cfg *config.ReplicaConfig) error {
-	err := util.StrictDecodeFile(o.configFile, component, cfg)
+	err := util.StrictDecodeFileWithEnv(o.configFile, o.stdin, component, cfg)
 	if err != nil {
 		return err
 	}
@@ -99,6 +107,21 @@ func (o *changefeedCommonOptions) strictDecodeConfig(component string, cfg *conf
 	return err
 }
 
+// expandEnv expands the references to the environment variables in the URIs,
+// so that the credentials in them can be passed by the environment variables.
+func (o *changefeedCommonOptions) expandEnv() error {
+	sinkURI, err := util.ExpandEnv(o.sinkURI)
+	if err != nil {
+		return errors.Annotate(err, "sink uri")
+	}
+	schemaRegistry, err := util.ExpandEnv(o.schemaRegistry)
+	if err != nil {
+		return errors.Annotate(err, "schema registry")
+	}
+	o.sinkURI, o.schemaRegistry = sinkURI, schemaRegistry
+	return nil
+}
+
 // createChangefeedOptions defines common flags for the `cli changefeed crate` command.
 type createChangefeedOptions struct {
 	commonChangefeedOptions *changefeedCommonOptions
@@ -106,8 +129,10 @@ type createChangefeedOptions struct {
 
 	changefeedID            string
 	disableGCSafePointCheck bool
+	dryRun                  bool
 	startTs                 uint64
 	timezone                string
+	wait                    changefeedWaitOptions
 
 	cfg *config.ReplicaConfig
 }
@@ -126,9 +151,13 @@ func (o *createChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
 	cmd.PersistentFlags().BoolVarP(&o.disableGCSafePointCheck, "disable-gc-check", "", false, "Disable GC safe point check")
 	cmd.PersistentFlags().Uint64Var(&o.startTs, "start-ts", 0, "Start ts of changefeed")
+	cmd.PersistentFlags().BoolVar(&o.dryRun, "dry-run", false,
+		"Verify the changefeed on the server and print the effective config, "+
+			"the tables to replicate and the warnings without creating it")
 	cmd.PersistentFlags().StringVar(&o.timezone, "tz", "SYSTEM", "timezone used when checking sink uri (changefeed timezone is determined by cdc server)")
 	// we don't support specify these flags below when cdc version >= 6.2.0
 	_ = cmd.PersistentFlags().MarkHidden("tz")
+	o.wait.addFlags(cmd)
 }
 
 // complete adapts from the command line args to the data and client required.
@@ -138,6 +167,9 @@ func (o *createChangefeedOptions) complete(f factory.Factory, cmd *cobra.Command
 		return err
 	}
 	o.apiClient = client
+	if err := o.commonChangefeedOptions.expandEnv(); err != nil {
+		return err
+	}
 	return o.completeReplicaCfg(cmd)
 }
 
@@ -234,7 +266,12 @@ func (o *createChangefeedOptions) validate(cmd *cobra.Command) error {
 		o.commonChangefeedOptions.sortEngine = model.SortUnified
 	}
 
-	return nil
+	if o.dryRun && o.wait.enabled() {
+		return cerror.ErrCliInvalidArgument.GenWithStackByArgs(
+			"--dry-run can't be used with --wait-for")
+	}
+
+	return o.wait.validate()
 }
 
 func (o *createChangefeedOptions) getChangefeedConfig() *v2.ChangefeedConfig {
@@ -285,6 +322,10 @@ func (o *createChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) e
 		o.startTs = oracle.ComposeTS(tso.Timestamp, tso.LogicTime)
 	}
 
+	if o.dryRun {
+		return o.runDryRun(ctx, cmd)
+	}
+
 	if !o.commonChangefeedOptions.noConfirm {
 		if err = confirmLargeDataGap(cmd, tso.Timestamp, o.startTs, "create"); err != nil {
 			return err
@@ -359,11 +400,55 @@ func (o *createChangefeedOptions) run(ctx context.Context, cmd *cobra.Command) e
 		}
 		return err
 	}
-	infoStr, err := info.Marshal()
+	if util.GetOutputFormat(cmd) != "" {
+		if err := util.PrintOutput(cmd, info); err != nil {
+			return err
+		}
+	} else {
+		infoStr, err := info.Marshal()
+		if err != nil {
+			return err
+		}
+		cmd.Printf("Create changefeed successfully!\nID: %s\nInfo: %s\n", info.ID, infoStr)
+	}
+	if o.wait.enabled() {
+		return o.wait.wait(ctx, cmd, o.apiClient, info.ID)
+	}
+	return nil
+}
+
+// runDryRun verifies the changefeed on the server without creating it, and
+// prints the effective config, the tables to replicate and the warnings.
+func (o *createChangefeedOptions) runDryRun(ctx context.Context, cmd *cobra.Command) error {
+	cfg := o.getChangefeedConfig()
+	// Report the ineligible tables as warnings instead of failing.
+	cfg.ReplicaConfig.IgnoreIneligibleTable = true
+	result, err := o.apiClient.Changefeeds().DryRun(ctx, cfg)
 	if err != nil {
 		return err
 	}
-	cmd.Printf("Create changefeed successfully!\nID: %s\nInfo: %s\n", info.ID, infoStr)
+	if util.GetOutputFormat(cmd) != "" {
+		return util.PrintOutput(cmd, result)
+	}
+
+	infoStr, err := result.Info.Marshal()
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Dry run passed, the changefeed is not created.\nID: %s\nInfo: %s\n",
+		result.Info.ID, infoStr)
+	cmd.Printf("Tables (%d):\n", len(result.Tables))
+	for _, table := range result.Tables {
+		if table.Topic == "" {
+			cmd.Printf("  %s.%s\n", table.Schema, table.Table)
+			continue
+		}
+		cmd.Printf("  %s.%s -> %s (partition: %s)\n",
+			table.Schema, table.Table, table.Topic, table.PartitionRule)
+	}
+	for _, warning := range result.Warnings {
+		cmd.Print(color.HiYellowString("[WARN] %s\n", warning))
+	}
 	return nil
 }
 
@@ -14,6 +14,7 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -64,6 +65,28 @@ func TestStrictDecodeConfig(t *testing.T) {
 	require.Regexp(t, ".*CDC:ErrFilterRuleInvalid.*", err)
 }
 
+func TestStrictDecodeConfigFromStdin(t *testing.T) {
+	t.Setenv("TEST_FILTER_SCHEMA", "test")
+
+	cmd := new(cobra.Command)
+	o := newChangefeedCommonOptions()
+	o.addFlags(cmd)
+	o.stdin = bytes.NewBufferString(`
+	[filter]
+	rules = ['*.*', '!${TEST_FILTER_SCHEMA}.*']`)
+	require.Nil(t, cmd.ParseFlags([]string{"--config=-",
+		"--sink-uri=kafka://${TEST_FILTER_SCHEMA}:9092/topic"}))
+
+	cfg := config.GetDefaultReplicaConfig()
+	require.Nil(t, o.strictDecodeConfig("cdc", cfg))
+	require.Equal(t, []string{"*.*", "!test.*"}, cfg.Filter.Rules)
+	require.Nil(t, o.expandEnv())
+	require.Equal(t, "kafka://test:9092/topic", o.sinkURI)
+
+	o.sinkURI = "kafka://${TEST_NOT_SET_ENV}:9092/topic"
+	require.ErrorContains(t, o.expandEnv(), "TEST_NOT_SET_ENV")
+}
+
 func TestTomlFileToApiModel(t *testing.T) {
 	cmd := new(cobra.Command)
 	o := newChangefeedCommonOptions()
@@ -174,3 +197,46 @@ func TestChangefeedCreateCli(t *testing.T) {
 	require.NoError(t, o.complete(f, cmd))
 	require.Contains(t, o.validate(cmd).Error(), "creating changefeed with `--sort-dir`")
 }
+
+func TestChangefeedCreateDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+
+	cmd := newCmdCreateChangefeed(f)
+	os.Args = []string{
+		"create",
+		"--sink-uri=kafka://127.0.0.1:9092/topic",
+		"--changefeed-id=abc",
+		"--dry-run",
+	}
+	f.tso.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&v2.Tso{
+		Timestamp: time.Now().Unix() * 1000,
+	}, nil)
+	f.changefeeds.EXPECT().DryRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, cfg *v2.ChangefeedConfig) (*v2.ChangefeedDryRunResult, error) {
+			require.True(t, cfg.ReplicaConfig.IgnoreIneligibleTable)
+			return &v2.ChangefeedDryRunResult{
+				Info: &v2.ChangeFeedInfo{ID: "abc"},
+				Tables: []v2.DryRunTable{
+					{Schema: "test", Table: "t1", Topic: "topic", PartitionRule: "default"},
+				},
+				Warnings: []string{"1 ineligible tables"},
+			}, nil
+		})
+	f.changefeeds.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	require.Nil(t, cmd.Execute())
+	out := b.String()
+	require.Contains(t, out, "the changefeed is not created")
+	require.Contains(t, out, "test.t1 -> topic (partition: default)")
+	require.Contains(t, out, "[WARN] 1 ineligible tables")
+
+	// --dry-run can't be used with --wait-for
+	cmd = new(cobra.Command)
+	o := newCreateChangefeedOptions(newChangefeedCommonOptions())
+	o.addFlags(cmd)
+	require.Nil(t, cmd.ParseFlags([]string{"--dry-run", "--wait-for=state=normal"}))
+	require.Contains(t, o.validate(cmd).Error(), "--dry-run can't be used with --wait-for")
+}
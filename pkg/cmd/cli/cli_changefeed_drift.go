@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// driftChangefeedOptions defines flags for the `cli changefeed drift` command.
+type driftChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+}
+
+// newDriftChangefeedOptions creates new options for the `cli changefeed drift` command.
+func newDriftChangefeedOptions() *driftChangefeedOptions {
+	return &driftChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *driftChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *driftChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed drift` command.
+func (o *driftChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	drift, err := o.apiClient.Changefeeds().Drift(ctx, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	return util.PrintOutput(cmd, drift)
+}
+
+// newCmdDriftChangefeed creates the `cli changefeed drift` command.
+func newCmdDriftChangefeed(f factory.Factory) *cobra.Command {
+	o := newDriftChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "drift",
+		Short: "Show the difference between the stored and the effective config of a replication task (changefeed)",
+		Long: `Compare the changefeed info stored in etcd against the info currently in
+effect, after the defaults, migrations and the adjustments of the owner are
+applied. "old" is the stored value and "new" is the effective value.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedDriftCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdDriftChangefeed(f)
+	cf.EXPECT().Drift(gomock.Any(), "abc").Return(&v2.ChangefeedDrift{
+		State: "normal",
+		Diff:  []v2.ChangefeedConfigDiff{{Field: "target-ts", Old: 0, New: 10}},
+	}, nil)
+	os.Args = []string{"drift", "--changefeed-id=abc"}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().Drift(gomock.Any(), "abc").Return(nil, errors.New("test"))
+	o := newDriftChangefeedOptions()
+	o.changefeedID = "abc"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
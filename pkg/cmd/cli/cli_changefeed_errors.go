@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// errorsChangefeedOptions defines flags for the `cli changefeed errors` command.
+type errorsChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	level        string
+	limit        int
+}
+
+// newErrorsChangefeedOptions creates new options for the `cli changefeed errors` command.
+func newErrorsChangefeedOptions() *errorsChangefeedOptions {
+	return &errorsChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *errorsChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().StringVar(&o.level, "level", "", "List the errors of the level only, error or warning")
+	cmd.PersistentFlags().IntVar(&o.limit, "limit", 0, "List the latest errors up to the limit, 0 means no limit")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *errorsChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// validate checks that the provided errors options are specified as expected.
+func (o *errorsChangefeedOptions) validate() error {
+	switch model.ErrorLevel(o.level) {
+	case "", model.ErrorLevelError, model.ErrorLevelWarning:
+	default:
+		return errors.Errorf("invalid level %s, it should be error or warning", o.level)
+	}
+	if o.limit < 0 {
+		return errors.Errorf("invalid limit %d", o.limit)
+	}
+	return nil
+}
+
+// run the `cli changefeed errors` command.
+func (o *errorsChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	records, err := o.apiClient.Changefeeds().Errors(ctx, o.changefeedID, o.level)
+	if err != nil {
+		return err
+	}
+	if o.limit > 0 && len(records) > o.limit {
+		records = records[:o.limit]
+	}
+	return util.PrintOutput(cmd, records)
+}
+
+// newCmdErrorsChangefeed creates the `cli changefeed errors` command.
+func newCmdErrorsChangefeed(f factory.Factory) *cobra.Command {
+	o := newErrorsChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "errors",
+		Short: "List the recent errors and warnings of a replication task (changefeed)",
+		Long: `List the recent errors and warnings reported by the captures of a replication
+task (changefeed), the latest one comes first. The same error happening in a row
+is listed once with its count and the first and last time it happened.`,
+		Example: `  cdc cli changefeed errors -c test-cf --level=error --limit=5`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedErrorsCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdErrorsChangefeed(f)
+	cf.EXPECT().Errors(gomock.Any(), "abc", "error").Return([]v2.ChangefeedErrorRecord{
+		{Level: "error", Capture: "127.0.0.1:8300", Code: "CDC:ErrSink", Count: 2},
+	}, nil)
+	os.Args = []string{"errors", "--changefeed-id=abc", "--level=error"}
+	require.Nil(t, cmd.Execute())
+
+	o := newErrorsChangefeedOptions()
+	o.changefeedID = "abc"
+	o.level = "fatal"
+	require.NotNil(t, o.validate())
+	o.level = ""
+	o.limit = -1
+	require.NotNil(t, o.validate())
+	o.limit = 1
+	require.Nil(t, o.validate())
+
+	cf.EXPECT().Errors(gomock.Any(), "abc", "").Return(nil, errors.New("test"))
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pingcap/errors"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// exportChangefeedOptions defines flags for the `cli changefeed export` command.
+type exportChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	namespace string
+	output    string
+}
+
+// newExportChangefeedOptions creates new options for the `cli changefeed export` command.
+func newExportChangefeedOptions() *exportChangefeedOptions {
+	return &exportChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *exportChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "default",
+		"Namespace of the replication tasks (changefeeds) to export")
+	cmd.PersistentFlags().StringVarP(&o.output, "output", "o", "",
+		"Path of the file to write the exported document to, stdout by default")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *exportChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed export` command.
+func (o *exportChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	doc, err := o.apiClient.Changefeeds().Export(ctx, o.namespace)
+	if err != nil {
+		return err
+	}
+	if o.output == "" {
+		return util.JSONPrint(cmd, doc)
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The document contains the credentials in the sink URIs.
+	if err := os.WriteFile(o.output, data, 0o600); err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Printf("Exported %d changefeeds to %s\n", len(doc.Changefeeds), o.output)
+	return nil
+}
+
+// newCmdExportChangefeed creates the `cli changefeed export` command.
+func newCmdExportChangefeed(f factory.Factory) *cobra.Command {
+	o := newExportChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export the specs of all replication tasks (changefeeds) in a namespace",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
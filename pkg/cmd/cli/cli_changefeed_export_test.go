@@ -0,0 +1,96 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedExportCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdExportChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	doc := &v2.ChangefeedExportDocument{
+		Version:     v2.ChangefeedExportVersion,
+		Namespace:   "test",
+		Changefeeds: []v2.ChangefeedSpec{{ID: "abc", SinkURI: "blackhole://"}},
+	}
+	cf.EXPECT().Export(gomock.Any(), "test").Return(doc, nil)
+	output := filepath.Join(t.TempDir(), "export.json")
+	os.Args = []string{"export", "--namespace=test", "--output=" + output}
+	require.Nil(t, cmd.Execute())
+	data, err := os.ReadFile(output)
+	require.Nil(t, err)
+	exported := &v2.ChangefeedExportDocument{}
+	require.Nil(t, json.Unmarshal(data, exported))
+	require.Equal(t, doc, exported)
+
+	cf.EXPECT().Export(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("changefeed export test error"))
+	o := newExportChangefeedOptions()
+	require.NoError(t, o.complete(f))
+	require.Contains(t, o.run(cmd).Error(), "changefeed export test error")
+}
+
+func TestChangefeedImportCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdImportChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	doc := &v2.ChangefeedExportDocument{
+		Version:     v2.ChangefeedExportVersion,
+		Changefeeds: []v2.ChangefeedSpec{{ID: "abc", SinkURI: "blackhole://"}},
+	}
+	data, err := json.Marshal(doc)
+	require.Nil(t, err)
+	file := filepath.Join(t.TempDir(), "export.json")
+	require.Nil(t, os.WriteFile(file, data, 0o600))
+
+	cf.EXPECT().Import(gomock.Any(), doc, v2.StartTsStrategyNow).
+		Return([]v2.ChangefeedOperationResult{{Namespace: "default", ID: "abc"}}, nil)
+	os.Args = []string{"import", "--file=" + file, "--start-ts-strategy=now"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "abc")
+
+	o := newImportChangefeedOptions()
+	require.NoError(t, o.complete(f))
+	o.file = file
+	o.startTsStrategy = v2.StartTsStrategyCheckpoint
+	cf.EXPECT().Import(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]v2.ChangefeedOperationResult{{
+			Namespace: "default", ID: "abc",
+			Error: &model.HTTPError{Error: "already exists"},
+		}}, nil)
+	require.Contains(t, o.run(cmd).Error(), "failed to import 1 of 1 changefeeds")
+}
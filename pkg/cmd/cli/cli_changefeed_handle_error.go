@@ -0,0 +1,115 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// handleErrorOptions defines flags for the `cli changefeed handle-error` command.
+type handleErrorOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	commitTs     uint64
+	schema       string
+	table        string
+	statement    string
+}
+
+// newHandleErrorOptions creates new options for the `cli changefeed handle-error` command.
+func newHandleErrorOptions() *handleErrorOptions {
+	return &handleErrorOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *handleErrorOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Uint64Var(&o.commitTs, "commit-ts", 0, "Commit ts of the event to handle")
+	cmd.PersistentFlags().StringVar(&o.schema, "schema", "", "Schema of the event to handle")
+	cmd.PersistentFlags().StringVar(&o.table, "table", "", "Table of the event to handle, empty for the DDL of a schema")
+	cmd.PersistentFlags().StringVar(&o.statement, "statement", "",
+		"Statement to replace the DDL event with, the event is skipped if it's not specified")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("commit-ts")
+	_ = cmd.MarkPersistentFlagRequired("schema")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *handleErrorOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed handle-error` command.
+func (o *handleErrorOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	cfg := &v2.HandleErrorConfig{
+		CommitTs: o.commitTs,
+		Schema:   o.schema,
+		Table:    o.table,
+		Action:   string(model.ErrorHandleSkip),
+	}
+	if o.statement != "" {
+		cfg.Action = string(model.ErrorHandleReplace)
+		cfg.Statement = o.statement
+	}
+	handles, err := o.apiClient.Changefeeds().HandleError(ctx, o.changefeedID, cfg)
+	if err != nil {
+		return err
+	}
+	return util.PrintOutput(cmd, handles)
+}
+
+// newCmdHandleError creates the `cli changefeed handle-error` command.
+func newCmdHandleError(f factory.Factory) *cobra.Command {
+	o := newHandleErrorOptions()
+
+	command := &cobra.Command{
+		Use:   "handle-error",
+		Short: "Skip or replace an event of a replication task (changefeed)",
+		Long: `Skip an event which can't be applied to the downstream, or replace a DDL
+event with another statement. The changefeed must be stopped, failed or in
+error state, the handle takes effect when the changefeed is resumed or retried.
+Skipping DML events drops every row change of the table committed at the commit
+ts, that is the whole part of the transaction on the table.`,
+		Example: `  # Skip the events of test.t committed at 434012938292428801
+  cdc cli changefeed handle-error -c test-cf --commit-ts=434012938292428801 --schema=test --table=t
+
+  # Replace the DDL of test.t committed at 434012938292428801
+  cdc cli changefeed handle-error -c test-cf --commit-ts=434012938292428801 --schema=test --table=t \
+    --statement="ALTER TABLE t ADD COLUMN c INT"`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedHandleErrorCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdHandleError(f)
+	skip := v2.HandleErrorConfig{CommitTs: 10, Schema: "test", Table: "t", Action: "skip"}
+	cf.EXPECT().HandleError(gomock.Any(), "abc", &skip).
+		Return([]v2.HandleErrorConfig{skip}, nil)
+	os.Args = []string{
+		"handle-error", "--changefeed-id=abc", "--commit-ts=10",
+		"--schema=test", "--table=t",
+	}
+	require.Nil(t, cmd.Execute())
+
+	cmd = newCmdHandleError(f)
+	replace := v2.HandleErrorConfig{
+		CommitTs: 10, Schema: "test", Action: "replace",
+		Statement: "DROP DATABASE test",
+	}
+	cf.EXPECT().HandleError(gomock.Any(), "abc", &replace).
+		Return([]v2.HandleErrorConfig{replace}, nil)
+	os.Args = []string{
+		"handle-error", "--changefeed-id=abc", "--commit-ts=10",
+		"--schema=test", "--statement=DROP DATABASE test",
+	}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().HandleError(gomock.Any(), "abc", gomock.Any()).
+		Return(nil, errors.New("test"))
+	o := newHandleErrorOptions()
+	o.changefeedID = "abc"
+	o.commitTs = 10
+	o.schema = "test"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// importChangefeedOptions defines flags for the `cli changefeed import` command.
+type importChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	file            string
+	startTsStrategy string
+}
+
+// newImportChangefeedOptions creates new options for the `cli changefeed import` command.
+func newImportChangefeedOptions() *importChangefeedOptions {
+	return &importChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *importChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.file, "file", "f", "",
+		"Path of the exported document")
+	cmd.PersistentFlags().StringVar(&o.startTsStrategy, "start-ts-strategy",
+		v2.StartTsStrategyCheckpoint,
+		"Start ts of the imported changefeeds, one of checkpoint, original and now")
+	_ = cmd.MarkPersistentFlagRequired("file")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *importChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed import` command.
+func (o *importChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	data, err := os.ReadFile(o.file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	doc := &v2.ChangefeedExportDocument{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return errors.Annotatef(err, "invalid export document %s", o.file)
+	}
+	results, err := o.apiClient.Changefeeds().Import(ctx, doc, o.startTsStrategy)
+	if err != nil {
+		return err
+	}
+	if err := util.PrintOutput(cmd, results); err != nil {
+		return err
+	}
+	failed := 0
+	for _, r := range results {
+		if r.Error != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to import %d of %d changefeeds", failed, len(results))
+	}
+	return nil
+}
+
+// newCmdImportChangefeed creates the `cli changefeed import` command.
+func newCmdImportChangefeed(f factory.Factory) *cobra.Command {
+	o := newImportChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Create the replication tasks (changefeeds) in an exported document",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// labelChangefeedOptions defines flags for the `cli changefeed label` command.
+type labelChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+}
+
+// newLabelChangefeedOptions creates new options for the `cli changefeed label` command.
+func newLabelChangefeedOptions() *labelChangefeedOptions {
+	return &labelChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *labelChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *labelChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// parseLabelArgs parses the arguments like "team=foo" to set a label and
+// "team-" to remove a label.
+func parseLabelArgs(args []string) (*v2.ChangefeedLabelsPatch, error) {
+	patch := &v2.ChangefeedLabelsPatch{}
+	for _, arg := range args {
+		if kv := strings.SplitN(arg, "=", 2); len(kv) == 2 {
+			if patch.Set == nil {
+				patch.Set = make(map[string]string)
+			}
+			patch.Set[kv[0]] = kv[1]
+			continue
+		}
+		if strings.HasSuffix(arg, "-") && len(arg) > 1 {
+			patch.Remove = append(patch.Remove, strings.TrimSuffix(arg, "-"))
+			continue
+		}
+		return nil, errors.Errorf("invalid label argument %s, "+
+			"it must be key=value to set a label or key- to remove a label", arg)
+	}
+	return patch, nil
+}
+
+// run the `cli changefeed label` command.
+func (o *labelChangefeedOptions) run(cmd *cobra.Command, args []string) error {
+	ctx := context.GetDefaultContext()
+
+	patch, err := parseLabelArgs(args)
+	if err != nil {
+		return err
+	}
+	labels, err := o.apiClient.Changefeeds().UpdateLabels(ctx, o.changefeedID, patch)
+	if err != nil {
+		return err
+	}
+	return util.PrintOutput(cmd, labels)
+}
+
+// newCmdLabelChangefeed creates the `cli changefeed label` command.
+func newCmdLabelChangefeed(f factory.Factory) *cobra.Command {
+	o := newLabelChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "label key=value... key-...",
+		Short: "Set or remove labels of a replication task (changefeed)",
+		Example: `  # Set the team label and remove the env label
+  cdc cli changefeed label -c test-cf team=foo env-`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd, args))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelArgs(t *testing.T) {
+	t.Parallel()
+
+	patch, err := parseLabelArgs([]string{"team=foo", "env-", "app="})
+	require.Nil(t, err)
+	require.Equal(t, &v2.ChangefeedLabelsPatch{
+		Set:    map[string]string{"team": "foo", "app": ""},
+		Remove: []string{"env"},
+	}, patch)
+
+	for _, arg := range []string{"team", "-"} {
+		_, err = parseLabelArgs([]string{arg})
+		require.NotNil(t, err, arg)
+	}
+}
+
+func TestChangefeedLabelCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdLabelChangefeed(f)
+	cf.EXPECT().UpdateLabels(gomock.Any(), "abc", &v2.ChangefeedLabelsPatch{
+		Set:    map[string]string{"team": "foo"},
+		Remove: []string{"env"},
+	}).Return(map[string]string{"team": "foo"}, nil)
+	os.Args = []string{"label", "--changefeed-id=abc", "team=foo", "env-"}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().UpdateLabels(gomock.Any(), "abc", gomock.Any()).
+		Return(nil, errors.New("test"))
+	o := newLabelChangefeedOptions()
+	o.changefeedID = "abc"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd, []string{"team=foo"}))
+}
@@ -32,13 +32,15 @@ type changefeedCommonInfo struct {
 	ID        string                `json:"id"`
 	Namespace string                `json:"namespace"`
 	Summary   *owner.ChangefeedResp `json:"summary"`
+	Labels    map[string]string     `json:"labels,omitempty"`
 }
 
 // listChangefeedOptions defines flags for the `cli changefeed list` command.
 type listChangefeedOptions struct {
 	apiClient v2.APIV2Interface
 
-	listAll bool
+	listAll       bool
+	labelSelector string
 }
 
 // newListChangefeedOptions creates new options for the `cli changefeed list` command.
@@ -50,6 +52,7 @@ func newListChangefeedOptions() *listChangefeedOptions {
 // flags related to template printing to it.
 func (o *listChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&o.listAll, "all", "a", false, "List all replication tasks(including removed and finished)")
+	cmd.PersistentFlags().StringVarP(&o.labelSelector, "selector", "l", "", "Label selector to filter replication tasks, such as env=prod,team!=foo")
 }
 
 // complete adapts from the command line args to the data and client required.
@@ -66,7 +69,7 @@ func (o *listChangefeedOptions) complete(f factory.Factory) error {
 func (o *listChangefeedOptions) run(cmd *cobra.Command) error {
 	ctx := context.GetDefaultContext()
 
-	raw, err := o.apiClient.Changefeeds().List(ctx, "all")
+	raw, err := o.apiClient.Changefeeds().List(ctx, "all", o.labelSelector)
 	if err != nil {
 		return err
 	}
@@ -88,11 +91,12 @@ func (o *listChangefeedOptions) run(cmd *cobra.Command) error {
 				Checkpoint:   time.Time(cf.CheckpointTime).Format(timeFormat),
 				RunningError: cf.RunningError,
 			},
+			Labels: cf.Labels,
 		}
 		cfs = append(cfs, cfci)
 	}
 
-	return util.JSONPrint(cmd, cfs)
+	return util.PrintOutput(cmd, cfs)
 }
 
 // newCmdListChangefeed creates the `cli changefeed list` command.
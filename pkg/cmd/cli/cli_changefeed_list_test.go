@@ -24,6 +24,8 @@ import (
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,7 +38,7 @@ func TestChangefeedListCli(t *testing.T) {
 	b := bytes.NewBufferString("")
 	cmd.SetOut(b)
 
-	cf.EXPECT().List(gomock.Any(), gomock.Any()).Return([]v2.ChangefeedCommonInfo{
+	cf.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]v2.ChangefeedCommonInfo{
 		{
 			UpstreamID:     1,
 			Namespace:      "default",
@@ -108,7 +110,27 @@ func TestChangefeedListCli(t *testing.T) {
 	require.Contains(t, string(out), "finished-5")
 	require.Contains(t, string(out), "stopped-6")
 
-	cf.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("changefeed list test error"))
+	// print in the output format of the root command
+	cf.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return([]v2.ChangefeedCommonInfo{
+		{UpstreamID: 1, Namespace: "default", ID: "normal-2", FeedState: model.StateNormal},
+	}, nil).Times(2)
+	root := &cobra.Command{Use: "cli"}
+	util.AddOutputFlag(root)
+	root.AddCommand(cmd)
+	os.Args = []string{"cli", "list", "--output=yaml"}
+	require.Nil(t, cmd.Execute())
+	out, err = io.ReadAll(b)
+	require.Nil(t, err)
+	require.Contains(t, string(out), "- checkpoint_time:")
+	require.Contains(t, string(out), "  id: normal-2\n")
+	os.Args = []string{"cli", "list", "--output=table"}
+	require.Nil(t, cmd.Execute())
+	out, err = io.ReadAll(b)
+	require.Nil(t, err)
+	require.Regexp(t, "^UPSTREAM_ID +NAMESPACE +ID +STATE", string(out))
+	require.Regexp(t, "\n1 +default +normal-2 +normal", string(out))
+
+	cf.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("changefeed list test error"))
 	o := newListChangefeedOptions()
 	require.NoError(t, o.complete(f))
 	require.Contains(t, o.run(cmd).Error(), "changefeed list test error")
@@ -14,6 +14,7 @@
 package cli
 
 import (
+	"github.com/pingcap/errors"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	"github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/cmd/factory"
@@ -26,6 +27,9 @@ type pauseChangefeedOptions struct {
 	apiClient apiv2client.APIV2Interface
 
 	changefeedID string
+	noConfirm    bool
+	batch        changefeedBatchOptions
+	wait         changefeedWaitOptions
 }
 
 // newPauseChangefeedOptions creates new options for the `cli changefeed pause` command.
@@ -37,7 +41,10 @@ func newPauseChangefeedOptions() *pauseChangefeedOptions {
 // flags related to template printing to it.
 func (o *pauseChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
-	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	cmd.PersistentFlags().BoolVar(&o.noConfirm, "no-confirm", false,
+		"Don't ask user whether to pause the selected changefeeds")
+	o.batch.addFlags(cmd)
+	o.wait.addFlags(cmd)
 }
 
 // complete adapts from the command line args to the data and client required.
@@ -51,10 +58,33 @@ func (o *pauseChangefeedOptions) complete(f factory.Factory) error {
 	return nil
 }
 
+// validate checks that the provided pause options are specified.
+func (o *pauseChangefeedOptions) validate() error {
+	if o.batch.enabled() && o.wait.enabled() {
+		return errors.New("--wait-for can't be specified with --selector or --match")
+	}
+	if err := o.batch.validate(o.changefeedID); err != nil {
+		return err
+	}
+	return o.wait.validate()
+}
+
 // run the `cli changefeed pause` command.
-func (o *pauseChangefeedOptions) run() error {
+func (o *pauseChangefeedOptions) run(cmd *cobra.Command) error {
 	ctx := context.GetDefaultContext()
-	return o.apiClient.Changefeeds().Pause(ctx, o.changefeedID)
+	if o.batch.enabled() {
+		return runChangefeedBatch(ctx, cmd, o.apiClient, &o.batch, "pause", o.noConfirm,
+			func(changefeedID string) error {
+				return o.apiClient.Changefeeds().Pause(ctx, changefeedID)
+			})
+	}
+	if err := o.apiClient.Changefeeds().Pause(ctx, o.changefeedID); err != nil {
+		return err
+	}
+	if o.wait.enabled() {
+		return o.wait.wait(ctx, cmd, o.apiClient, o.changefeedID)
+	}
+	return nil
 }
 
 // newCmdPauseChangefeed creates the `cli changefeed pause` command.
@@ -64,10 +94,19 @@ func newCmdPauseChangefeed(f factory.Factory) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "pause",
 		Short: "Pause a replication task (changefeed)",
-		Args:  cobra.NoArgs,
+		Example: `  # Pause the changefeeds labeled team=team-a
+  cdc cli changefeed pause --selector team=team-a
+
+  # Pause the changefeeds whose IDs start with order-
+  cdc cli changefeed pause --match 'order-*'
+
+  # Pause a changefeed and wait until it's stopped
+  cdc cli changefeed pause -c test --wait-for state=stopped --timeout 5m`,
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.validate())
 			util.CheckErr(o.complete(f))
-			util.CheckErr(o.run())
+			util.CheckErr(o.run(cmd))
 		},
 	}
 
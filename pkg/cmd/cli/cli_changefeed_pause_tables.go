@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// pauseTablesOptions defines flags for the `cli changefeed pause-tables`
+// and `cli changefeed resume-tables` commands.
+type pauseTablesOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	tableIDs     []int64
+	resume       bool
+}
+
+// newPauseTablesOptions creates new options for the `cli changefeed pause-tables`
+// and `cli changefeed resume-tables` commands.
+func newPauseTablesOptions(resume bool) *pauseTablesOptions {
+	return &pauseTablesOptions{resume: resume}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *pauseTablesOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Int64SliceVar(&o.tableIDs, "table-ids", nil, "IDs of the tables, separated by comma")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("table-ids")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *pauseTablesOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed pause-tables` or `cli changefeed resume-tables` command.
+func (o *pauseTablesOptions) run() error {
+	ctx := context.GetDefaultContext()
+	if o.resume {
+		return o.apiClient.Changefeeds().ResumeTables(ctx, o.changefeedID, o.tableIDs)
+	}
+	return o.apiClient.Changefeeds().PauseTables(ctx, o.changefeedID, o.tableIDs)
+}
+
+// newCmdPauseTables creates the `cli changefeed pause-tables` command.
+func newCmdPauseTables(f factory.Factory) *cobra.Command {
+	o := newPauseTablesOptions(false)
+
+	command := &cobra.Command{
+		Use:   "pause-tables",
+		Short: "Pause replicating tables of a replication task (changefeed)",
+		Long: `Pause replicating tables of a replication task (changefeed), the paused
+tables are excluded from the checkpoint of the changefeed, so they don't hold
+back the other tables. A DDL of the paused tables blocks the changefeed at its
+commit ts until the tables are resumed and catch up with it.`,
+		Example: `  cdc cli changefeed pause-tables -c test-cf --table-ids=100,101`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run())
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
+
+// newCmdResumeTables creates the `cli changefeed resume-tables` command.
+func newCmdResumeTables(f factory.Factory) *cobra.Command {
+	o := newPauseTablesOptions(true)
+
+	command := &cobra.Command{
+		Use:   "resume-tables",
+		Short: "Resume replicating the paused tables of a replication task (changefeed)",
+		Long: `Resume replicating the paused tables of a replication task (changefeed), the
+tables catch up from the checkpoint they were paused at.`,
+		Example: `  cdc cli changefeed resume-tables -c test-cf --table-ids=100,101`,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run())
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedPauseTablesCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdPauseTables(f)
+	cf.EXPECT().PauseTables(gomock.Any(), "abc", []int64{1, 2}).Return(nil)
+	os.Args = []string{"pause-tables", "--changefeed-id=abc", "--table-ids=1,2"}
+	require.Nil(t, cmd.Execute())
+
+	cmd = newCmdResumeTables(f)
+	cf.EXPECT().ResumeTables(gomock.Any(), "abc", []int64{1}).Return(nil)
+	os.Args = []string{"resume-tables", "--changefeed-id=abc", "--table-ids=1"}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().ResumeTables(gomock.Any(), "abc", []int64{1}).Return(errors.New("test"))
+	o := newPauseTablesOptions(true)
+	o.changefeedID = "abc"
+	o.tableIDs = []int64{1}
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run())
+}
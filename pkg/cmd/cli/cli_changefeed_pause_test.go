@@ -37,5 +37,5 @@ func TestChangefeedPauseCli(t *testing.T) {
 	o := newPauseChangefeedOptions()
 	o.changefeedID = "abc"
 	require.Nil(t, o.complete(f))
-	require.NotNil(t, o.run())
+	require.NotNil(t, o.run(cmd))
 }
@@ -81,13 +81,13 @@ func (o *queryChangefeedOptions) complete(f factory.Factory) error {
 func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 	ctx := context.Background()
 	if o.simplified {
-		infos, err := o.apiClientV2.Changefeeds().List(ctx, "all")
+		infos, err := o.apiClientV2.Changefeeds().List(ctx, "all", "")
 		if err != nil {
 			return errors.Trace(err)
 		}
 		for _, info := range infos {
 			if info.ID == o.changefeedID {
-				return util.JSONPrint(cmd, info)
+				return util.PrintOutput(cmd, info)
 			}
 		}
 		return cerror.ErrChangeFeedNotExists.GenWithStackByArgs(o.changefeedID)
@@ -114,7 +114,7 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		CreatorVersion: detail.CreatorVersion,
 		TaskStatus:     detail.TaskStatus,
 	}
-	return util.JSONPrint(cmd, meta)
+	return util.PrintOutput(cmd, meta)
 }
 
 // newCmdQueryChangefeed creates the `cli changefeed query` command.
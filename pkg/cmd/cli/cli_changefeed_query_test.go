@@ -38,7 +38,7 @@ func TestChangefeedQueryCli(t *testing.T) {
 	o.complete(f)
 	cmd := newCmdQueryChangefeed(f)
 
-	cfV2.EXPECT().List(gomock.Any(), "all").Return([]v2.ChangefeedCommonInfo{
+	cfV2.EXPECT().List(gomock.Any(), "all", "").Return([]v2.ChangefeedCommonInfo{
 		{
 			UpstreamID:     1,
 			Namespace:      "default",
@@ -51,7 +51,7 @@ func TestChangefeedQueryCli(t *testing.T) {
 	o.simplified = true
 	o.changefeedID = "abc"
 	require.Nil(t, o.run(cmd))
-	cfV2.EXPECT().List(gomock.Any(), "all").Return([]v2.ChangefeedCommonInfo{
+	cfV2.EXPECT().List(gomock.Any(), "all", "").Return([]v2.ChangefeedCommonInfo{
 		{
 			UpstreamID:     1,
 			Namespace:      "default",
@@ -65,7 +65,7 @@ func TestChangefeedQueryCli(t *testing.T) {
 	o.changefeedID = "abcd"
 	require.NotNil(t, o.run(cmd))
 
-	cfV2.EXPECT().List(gomock.Any(), "all").Return(nil, errors.New("test"))
+	cfV2.EXPECT().List(gomock.Any(), "all", "").Return(nil, errors.New("test"))
 	o.simplified = true
 	o.changefeedID = "abcd"
 	require.NotNil(t, o.run(cmd))
@@ -28,6 +28,8 @@ import (
 type removeChangefeedOptions struct {
 	apiClient    apiv2client.APIV2Interface
 	changefeedID string
+	noConfirm    bool
+	batch        changefeedBatchOptions
 }
 
 // newRemoveChangefeedOptions creates new options for the `cli changefeed remove` command.
@@ -39,7 +41,9 @@ func newRemoveChangefeedOptions() *removeChangefeedOptions {
 // flags related to template printing to it.
 func (o *removeChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
-	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	cmd.PersistentFlags().BoolVar(&o.noConfirm, "no-confirm", false,
+		"Don't ask user whether to remove the selected changefeeds")
+	o.batch.addFlags(cmd)
 }
 
 // complete adapts from the command line args to the data and client required.
@@ -52,10 +56,22 @@ func (o *removeChangefeedOptions) complete(f factory.Factory) error {
 	return nil
 }
 
+// validate checks that the provided remove options are specified.
+func (o *removeChangefeedOptions) validate() error {
+	return o.batch.validate(o.changefeedID)
+}
+
 // run the `cli changefeed remove` command.
 func (o *removeChangefeedOptions) run(cmd *cobra.Command) error {
 	ctx := context.GetDefaultContext()
 
+	if o.batch.enabled() {
+		return runChangefeedBatch(ctx, cmd, o.apiClient, &o.batch, "remove", o.noConfirm,
+			func(changefeedID string) error {
+				return o.apiClient.Changefeeds().Delete(ctx, changefeedID)
+			})
+	}
+
 	changefeedDetail, err := o.apiClient.Changefeeds().Get(ctx, o.changefeedID)
 	if err != nil {
 		if strings.Contains(err.Error(), "ErrChangeFeedNotExists") {
@@ -108,8 +124,11 @@ func newCmdRemoveChangefeed(f factory.Factory) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "remove",
 		Short: "Remove a replication task (changefeed)",
-		Args:  cobra.NoArgs,
+		Example: `  # Remove the changefeeds labeled env=test
+  cdc cli changefeed remove --selector env=test`,
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.validate())
 			util.CheckErr(o.complete(f))
 			util.CheckErr(o.run(cmd))
 		},
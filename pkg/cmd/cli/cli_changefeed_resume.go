@@ -18,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/pingcap/errors"
 	v2 "github.com/pingcap/tiflow/cdc/api/v2"
 	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
@@ -43,6 +44,9 @@ type resumeChangefeedOptions struct {
 	upstreamCaPath   string
 	upstreamCertPath string
 	upstreamKeyPath  string
+
+	batch changefeedBatchOptions
+	wait  changefeedWaitOptions
 }
 
 // newResumeChangefeedOptions creates new options for the `cli changefeed pause` command.
@@ -70,8 +74,22 @@ func (o *resumeChangefeedOptions) addFlags(cmd *cobra.Command) {
 	_ = cmd.PersistentFlags().MarkHidden("upstream-ca")
 	_ = cmd.PersistentFlags().MarkHidden("upstream-cert")
 	_ = cmd.PersistentFlags().MarkHidden("upstream-key")
+	o.batch.addFlags(cmd)
+	o.wait.addFlags(cmd)
+}
 
-	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+// validate checks that the provided resume options are specified.
+func (o *resumeChangefeedOptions) validate() error {
+	if o.batch.enabled() && o.overwriteCheckpointTs != "" {
+		return errors.New("--overwrite-checkpoint-ts can't be specified with --selector or --match")
+	}
+	if o.batch.enabled() && o.wait.enabled() {
+		return errors.New("--wait-for can't be specified with --selector or --match")
+	}
+	if err := o.batch.validate(o.changefeedID); err != nil {
+		return err
+	}
+	return o.wait.validate()
 }
 
 // complete adapts from the command line args to the data and client required.
@@ -195,6 +213,14 @@ func (o *resumeChangefeedOptions) validateParams(ctx context.Context) error {
 func (o *resumeChangefeedOptions) run(cmd *cobra.Command) error {
 	ctx := cmdcontext.GetDefaultContext()
 
+	if o.batch.enabled() {
+		cfg := o.getResumeChangefeedConfig()
+		return runChangefeedBatch(ctx, cmd, o.apiClient, &o.batch, "resume", o.noConfirm,
+			func(changefeedID string) error {
+				return o.apiClient.Changefeeds().Resume(ctx, cfg, changefeedID)
+			})
+	}
+
 	if err := o.validateParams(ctx); err != nil {
 		return err
 	}
@@ -203,9 +229,13 @@ func (o *resumeChangefeedOptions) run(cmd *cobra.Command) error {
 	if err := o.confirmResumeChangefeedCheck(cmd); err != nil {
 		return err
 	}
-	err := o.apiClient.Changefeeds().Resume(ctx, cfg, o.changefeedID)
-
-	return err
+	if err := o.apiClient.Changefeeds().Resume(ctx, cfg, o.changefeedID); err != nil {
+		return err
+	}
+	if o.wait.enabled() {
+		return o.wait.wait(ctx, cmd, o.apiClient, o.changefeedID)
+	}
+	return nil
 }
 
 // newCmdResumeChangefeed creates the `cli changefeed resume` command.
@@ -215,8 +245,14 @@ func newCmdResumeChangefeed(f factory.Factory) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "resume",
 		Short: "Resume a paused replication task (changefeed)",
-		Args:  cobra.NoArgs,
+		Example: `  # Resume the changefeeds labeled team=team-a whose IDs start with order-
+  cdc cli changefeed resume --selector team=team-a --match 'order-*'
+
+  # Resume a changefeed and wait until it's replicating normally
+  cdc cli changefeed resume -c test --wait-for state=normal --timeout 5m`,
+		Args: cobra.NoArgs,
 		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.validate())
 			util.CheckErr(o.complete(f))
 			util.CheckErr(o.run(cmd))
 		},
@@ -89,7 +89,7 @@ func (o *statisticsChangefeedOptions) runCliWithAPIClient(ctx context.Context, c
 
 	*lastCount = count
 	*lastTime = now
-	return util.JSONPrint(cmd, statistics)
+	return util.PrintOutput(cmd, statistics)
 }
 
 // run the `cli changefeed statistics` command.
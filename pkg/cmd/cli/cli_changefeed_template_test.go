@@ -0,0 +1,119 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeTemplate decodes the rendered template like the create command does.
+func decodeTemplate(t *testing.T, doc string) *config.ReplicaConfig {
+	path := filepath.Join(t.TempDir(), "changefeed.toml")
+	require.Nil(t, os.WriteFile(path, []byte(doc), 0o600))
+	cfg := config.GetDefaultReplicaConfig()
+	require.Nil(t, util.StrictDecodeFile(path, "TiCDC changefeed", cfg))
+	return cfg
+}
+
+func TestChangefeedTemplateCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	for _, scenario := range changefeedTemplateScenarios() {
+		cmd := newCmdTemplateChangefeed(f)
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		os.Args = []string{"template", "--scenario", scenario}
+		require.Nil(t, cmd.Execute(), scenario)
+		require.Contains(t, b.String(), changefeedTemplates[scenario].sinkURI)
+		cfg := decodeTemplate(t, b.String())
+		require.Equal(t, []string{"*.*"}, cfg.Filter.Rules)
+		require.Equal(t, config.GetDefaultReplicaConfig().MemoryQuota, cfg.MemoryQuota)
+	}
+
+	// pre-filled from a changefeed, the scenario is inferred from the sink URI.
+	cmd := newCmdTemplateChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	protocol := "avro"
+	cf.EXPECT().Get(gomock.Any(), "abc").Return(&v2.ChangeFeedInfo{
+		SinkURI: "kafka://127.0.0.1:9092/abc?protocol=avro",
+		Config: &v2.ReplicaConfig{
+			CaseSensitive: false,
+			Filter: &v2.FilterConfig{
+				Rules: []string{"db1.*", "!db1.log"},
+				EventFilters: []v2.EventFilterRule{{
+					Matcher:               []string{"db1.t"},
+					IgnoreEvent:           []string{"delete"},
+					IgnoreDeleteValueExpr: "id > 1",
+				}},
+			},
+			Sink: &v2.SinkConfig{
+				Protocol: &protocol,
+				DispatchRules: []*v2.DispatchRule{
+					{Matcher: []string{"db1.*"}, TopicRule: "abc_{table}", PartitionRule: "ts"},
+				},
+			},
+		},
+	}, nil)
+	os.Args = []string{"template", "-c", "abc"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "# The values are pre-filled from the changefeed abc.\n")
+	require.Contains(t, b.String(), `--sink-uri="kafka://127.0.0.1:9092/abc?protocol=avro"`)
+	cfg := decodeTemplate(t, b.String())
+	require.False(t, cfg.CaseSensitive)
+	require.Equal(t, []string{"db1.*", "!db1.log"}, cfg.Filter.Rules)
+	require.Len(t, cfg.Filter.EventFilters, 1)
+	require.Equal(t, "id > 1", cfg.Filter.EventFilters[0].IgnoreDeleteValueExpr)
+	require.Equal(t, "avro", *cfg.Sink.Protocol)
+	require.Equal(t, "http://127.0.0.1:8081", *cfg.Sink.SchemaRegistry)
+	require.Len(t, cfg.Sink.DispatchRules, 1)
+	require.Equal(t, "abc_{table}", cfg.Sink.DispatchRules[0].TopicRule)
+	require.Equal(t, "ts", cfg.Sink.DispatchRules[0].PartitionRule)
+
+	o := newTemplateChangefeedOptions()
+	require.Error(t, o.validate())
+	o.scenario = "unknown"
+	require.ErrorContains(t, o.validate(), "unknown scenario")
+}
+
+func TestInferTemplateScenario(t *testing.T) {
+	t.Parallel()
+
+	for uri, expected := range map[string]string{
+		"kafka://127.0.0.1:9092/topic":        "kafka-avro",
+		"s3://bucket/prefix?protocol=csv":     "storage-csv",
+		"file:///tmp/data":                    "storage-csv",
+		"mysql://root@127.0.0.1:3306/":        "mysql-filter",
+		"tidb+ssl://root@127.0.0.1:4000/?a=b": "mysql-filter",
+	} {
+		scenario, err := inferTemplateScenario(uri)
+		require.Nil(t, err, uri)
+		require.Equal(t, expected, scenario, uri)
+	}
+	_, err := inferTemplateScenario("pulsar://127.0.0.1:6650/topic")
+	require.ErrorContains(t, err, "--scenario")
+}
@@ -84,7 +84,7 @@ func (o *updateChangefeedOptions) complete(f factory.Factory) error {
 		return err
 	}
 	o.apiV2Client = apiClient
-	return nil
+	return o.commonChangefeedOptions.expandEnv()
 }
 
 // run the `cli changefeed update` command.
@@ -112,9 +112,14 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 		cmd.Printf("changefeed config is the same with the old one, do nothing\n")
 		return nil
 	}
-	cmd.Printf("Diff of changefeed config:\n")
-	for _, change := range changelog {
-		cmd.Printf("%+v\n", change)
+	// Keep the output parseable if an output format is specified, the diff is
+	// only printed for the confirmation.
+	outputFormat := util.GetOutputFormat(cmd)
+	if outputFormat == "" || !o.commonChangefeedOptions.noConfirm {
+		cmd.Printf("Diff of changefeed config:\n")
+		for _, change := range changelog {
+			cmd.Printf("%+v\n", change)
+		}
 	}
 
 	if !o.commonChangefeedOptions.noConfirm {
@@ -131,6 +136,9 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	if outputFormat != "" {
+		return util.PrintOutput(cmd, info)
+	}
 	infoStr, err := json.Marshal(info)
 	if err != nil {
 		return err
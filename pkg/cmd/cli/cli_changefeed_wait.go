@@ -0,0 +1,178 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultWaitInterval is the interval of checking the state of the
+	// changefeed waited for.
+	defaultWaitInterval = time.Second
+	// waitUnreachableGrace is how long a state from which the state waited
+	// for can't be reached must last before giving up, the state may be
+	// stale right after the changefeed is operated.
+	waitUnreachableGrace = 10 * time.Second
+)
+
+// waitableStates are the states which can be waited for.
+var waitableStates = []model.FeedState{
+	model.StateNormal,
+	model.StateStopped,
+	model.StateFinished,
+	model.StateError,
+	model.StateFailed,
+}
+
+// changefeedWaitOptions defines the flags to wait for a changefeed to reach
+// a state after it's operated, so that the scripts can block until the
+// operation takes effect.
+type changefeedWaitOptions struct {
+	waitFor  string
+	timeout  time.Duration
+	interval time.Duration
+	// unreachableGrace overrides waitUnreachableGrace if it's not zero.
+	unreachableGrace time.Duration
+
+	// state is the state waited for, which is parsed from waitFor.
+	state model.FeedState
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *changefeedWaitOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.waitFor, "wait-for", "",
+		"Wait for the replication task to reach the condition after the operation, "+
+			"such as state=normal")
+	cmd.PersistentFlags().DurationVar(&o.timeout, "timeout", 5*time.Minute,
+		"Max time to wait for the condition specified by --wait-for")
+}
+
+// enabled returns true if --wait-for is specified.
+func (o *changefeedWaitOptions) enabled() bool {
+	return o.waitFor != ""
+}
+
+// validate parses the condition waited for, only the state of the
+// changefeed can be waited for so far.
+func (o *changefeedWaitOptions) validate() error {
+	if !o.enabled() {
+		return nil
+	}
+	key, value, ok := strings.Cut(o.waitFor, "=")
+	if !ok || strings.TrimSpace(key) != "state" {
+		return cerror.ErrCliInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("unsupported --wait-for %s, only state=<state> is supported", o.waitFor))
+	}
+	state := model.FeedState(strings.ToLower(strings.TrimSpace(value)))
+	valid := false
+	for _, s := range waitableStates {
+		if s == state {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return cerror.ErrCliInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("unknown state %s in --wait-for, it must be one of %v",
+				value, waitableStates))
+	}
+	if o.timeout <= 0 {
+		return cerror.ErrCliInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("invalid timeout %s", o.timeout))
+	}
+	o.state = state
+	return nil
+}
+
+// wait waits for the changefeed to reach the state, ErrCliWaitTimeout is
+// returned if it's not reached before the timeout, and
+// ErrCliWaitConditionUnreachable is returned if the changefeed stays in a
+// state from which the state can't be reached.
+func (o *changefeedWaitOptions) wait(
+	ctx context.Context, cmd *cobra.Command,
+	apiClient apiv2client.APIV2Interface, changefeedID string,
+) error {
+	interval := o.interval
+	if interval == 0 {
+		interval = defaultWaitInterval
+	}
+	grace := o.unreachableGrace
+	if grace == 0 {
+		grace = waitUnreachableGrace
+	}
+	start := time.Now()
+	var unreachableSince time.Time
+	for {
+		info, err := apiClient.Changefeeds().Get(ctx, changefeedID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if info.State == o.state {
+			// Keep the output parsable.
+			if util.GetOutputFormat(cmd) == "" {
+				cmd.Printf("Changefeed %s reached state %s in %s\n",
+					changefeedID, info.State, now.Sub(start).Round(time.Millisecond))
+			}
+			return nil
+		}
+		if isWaitUnreachableState(info.State) {
+			if unreachableSince.IsZero() {
+				unreachableSince = now
+			}
+			if now.Sub(unreachableSince) >= grace {
+				return cerror.ErrCliWaitConditionUnreachable.GenWithStackByArgs(
+					changefeedID, o.waitFor, describeWaitState(info))
+			}
+		} else {
+			unreachableSince = time.Time{}
+		}
+		if now.Sub(start) >= o.timeout {
+			return cerror.ErrCliWaitTimeout.GenWithStackByArgs(
+				changefeedID, o.waitFor, o.timeout, describeWaitState(info))
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isWaitUnreachableState returns true if the changefeed can't leave the
+// state without being operated again.
+func isWaitUnreachableState(state model.FeedState) bool {
+	return state == model.StateFailed || state == model.StateFinished
+}
+
+func describeWaitState(info *v2.ChangeFeedInfo) string {
+	if info.Error != nil {
+		return fmt.Sprintf("%s, error: %s", info.State, info.Error.Message)
+	}
+	return string(info.State)
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedWaitOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	o := &changefeedWaitOptions{timeout: time.Minute}
+	require.Nil(t, o.validate())
+	require.False(t, o.enabled())
+
+	o.waitFor = "state=Normal"
+	require.Nil(t, o.validate())
+	require.Equal(t, model.StateNormal, o.state)
+
+	for _, waitFor := range []string{"normal", "lag=1s", "state=removed", "state="} {
+		o.waitFor = waitFor
+		err := o.validate()
+		require.True(t, cerror.ErrCliInvalidArgument.Equal(err), "%s: %v", waitFor, err)
+		require.Equal(t, util.ExitCodeInvalidArgument, util.ExitCode(err))
+	}
+
+	o.waitFor = "state=stopped"
+	o.timeout = 0
+	require.Error(t, o.validate())
+}
+
+func TestChangefeedWait(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	apiClient := &mockAPIV2Client{changefeeds: cf}
+	cmd := &cobra.Command{}
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	ctx := context.Background()
+
+	o := &changefeedWaitOptions{
+		waitFor:          "state=normal",
+		timeout:          time.Minute,
+		interval:         time.Millisecond,
+		unreachableGrace: 5 * time.Millisecond,
+	}
+	require.Nil(t, o.validate())
+
+	// The state is reached after a while.
+	gomock.InOrder(
+		cf.EXPECT().Get(gomock.Any(), "test").
+			Return(&v2.ChangeFeedInfo{State: model.StateStopped}, nil),
+		cf.EXPECT().Get(gomock.Any(), "test").
+			Return(&v2.ChangeFeedInfo{State: model.StateNormal}, nil),
+	)
+	require.Nil(t, o.wait(ctx, cmd, apiClient, "test"))
+	require.Contains(t, b.String(), "Changefeed test reached state normal")
+
+	// The changefeed stays failed.
+	cf.EXPECT().Get(gomock.Any(), "test").Return(&v2.ChangeFeedInfo{
+		State: model.StateFailed,
+		Error: &v2.RunningError{Message: "sink is unreachable"},
+	}, nil).MinTimes(2)
+	err := o.wait(ctx, cmd, apiClient, "test")
+	require.True(t, cerror.ErrCliWaitConditionUnreachable.Equal(err), err)
+	require.Contains(t, err.Error(), "sink is unreachable")
+	require.Equal(t, util.ExitCodeWaitUnreachable, util.ExitCode(err))
+
+	// The state isn't reached before the timeout.
+	o.timeout = 5 * time.Millisecond
+	cf.EXPECT().Get(gomock.Any(), "test").
+		Return(&v2.ChangeFeedInfo{State: model.StateError}, nil).MinTimes(1)
+	err = o.wait(ctx, cmd, apiClient, "test")
+	require.True(t, cerror.ErrCliWaitTimeout.Equal(err), err)
+	require.Equal(t, util.ExitCodeWaitTimeout, util.ExitCode(err))
+
+	// The changefeed is removed.
+	cf.EXPECT().Get(gomock.Any(), "test").
+		Return(nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs("test"))
+	err = o.wait(ctx, cmd, apiClient, "test")
+	require.Equal(t, util.ExitCodeNotFound, util.ExitCode(err))
+}
+
+func TestChangefeedPauseWaitCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	cmd := newCmdPauseChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	cf.EXPECT().Pause(gomock.Any(), "abc").Return(nil)
+	cf.EXPECT().Get(gomock.Any(), "abc").
+		Return(&v2.ChangeFeedInfo{State: model.StateStopped}, nil)
+	os.Args = []string{"pause", "--changefeed-id=abc", "--wait-for=state=stopped", "--timeout=1m"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "Changefeed abc reached state stopped")
+
+	o := newPauseChangefeedOptions()
+	o.batch.match = "abc-*"
+	o.wait.waitFor = "state=stopped"
+	require.Error(t, o.validate())
+}
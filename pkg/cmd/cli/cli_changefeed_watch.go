@@ -0,0 +1,302 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+const (
+	// watchMaxRecentErrors is the max number of the recent errors shown by
+	// the `cli changefeed watch` command.
+	watchMaxRecentErrors = 5
+	// clearScreen moves the cursor to the top left and clears the terminal.
+	clearScreen = "\033[H\033[2J"
+)
+
+// watchTablePhases are the table phases in the order they are shown.
+var watchTablePhases = []model.TablePhase{
+	model.TablePhaseReplicating,
+	model.TablePhaseCatchingUp,
+	model.TablePhaseScanning,
+	model.TablePhaseAbsent,
+	model.TablePhaseRemoving,
+	model.TablePhaseExcluded,
+}
+
+// watchChangefeedOptions defines flags for the `cli changefeed watch` command.
+type watchChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	interval     time.Duration
+	tables       int
+}
+
+// newWatchChangefeedOptions creates new options for the `cli changefeed watch` command.
+func newWatchChangefeedOptions() *watchChangefeedOptions {
+	return &watchChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *watchChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "",
+		"Replication task (changefeed) ID, which can also be specified by the argument")
+	cmd.PersistentFlags().DurationVar(&o.interval, "interval", time.Second,
+		"Interval of refreshing the status")
+	cmd.PersistentFlags().IntVar(&o.tables, "tables", 10,
+		"Number of the slowest tables to show")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *watchChangefeedOptions) complete(f factory.Factory, args []string) error {
+	if len(args) > 0 {
+		if o.changefeedID != "" && o.changefeedID != args[0] {
+			return errors.Errorf("the changefeed ID %s conflicts with --changefeed-id %s",
+				args[0], o.changefeedID)
+		}
+		o.changefeedID = args[0]
+	}
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// validate checks that the provided watch options are specified.
+func (o *watchChangefeedOptions) validate() error {
+	if o.changefeedID == "" {
+		return errors.New("the changefeed ID must be specified by the argument or --changefeed-id")
+	}
+	if o.interval < time.Millisecond {
+		return errors.Errorf("invalid interval %s, it must be at least 1ms", o.interval)
+	}
+	if o.tables < 0 {
+		return errors.Errorf("invalid number of tables %d", o.tables)
+	}
+	return nil
+}
+
+// watchState is the state of the changefeed shown by the `cli changefeed watch` command.
+type watchState struct {
+	changefeedID string
+	updateTime   time.Time
+	status       *v2.ChangefeedStatusEvent
+	tables       []v2.TableProgress
+	tablesErr    error
+	// recentErrors are the recent errors of the changefeed, the latest one
+	// comes first.
+	recentErrors []*v2.RunningError
+}
+
+func (s *watchState) addError(err *v2.RunningError) {
+	s.recentErrors = append([]*v2.RunningError{err}, s.recentErrors...)
+	if len(s.recentErrors) > watchMaxRecentErrors {
+		s.recentErrors = s.recentErrors[:watchMaxRecentErrors]
+	}
+}
+
+// run the `cli changefeed watch` command.
+func (o *watchChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	state := &watchState{changefeedID: o.changefeedID}
+	clear := isTerminal(cmd.OutOrStdout())
+	err := o.apiClient.Changefeeds().WatchStatus(ctx, o.changefeedID, o.interval,
+		func(event *apiv2client.ChangefeedStatusStreamEvent) error {
+			if event.Error != nil {
+				state.addError(event.Error)
+			}
+			if event.Status != nil {
+				state.status = event.Status
+				state.updateTime = time.Now()
+				state.tables, state.tablesErr = o.apiClient.Changefeeds().
+					ListTables(ctx, o.changefeedID)
+			}
+			frame := renderWatchFrame(state, o.tables)
+			if clear {
+				frame = clearScreen + frame
+			}
+			cmd.Print(frame)
+			return nil
+		})
+	// Exit normally when it's interrupted.
+	if errors.Cause(err) == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// renderWatchFrame renders the state of the changefeed, at most maxTables of
+// the slowest tables are shown.
+func renderWatchFrame(state *watchState, maxTables int) string {
+	b := &strings.Builder{}
+	status := state.status
+	if status == nil {
+		fmt.Fprintf(b, "Changefeed: %s\nWaiting for the status...\n", state.changefeedID)
+		renderRecentErrors(b, state.recentErrors)
+		return b.String()
+	}
+
+	fmt.Fprintf(b, "Changefeed: %s    State: %s    Updated at: %s\n",
+		state.changefeedID, status.State, state.updateTime.Format(timeFormat))
+	checkpointTime := oracle.GetTimeFromTS(status.CheckpointTs)
+	resolvedTime := oracle.GetTimeFromTS(status.ResolvedTs)
+	checkpointLag := status.CheckpointLag.Duration()
+	// The lag of the resolved ts is derived from the lag of the checkpoint ts
+	// reported by the server, so that it doesn't depend on the local clock.
+	resolvedLag := checkpointLag - resolvedTime.Sub(checkpointTime)
+	fmt.Fprintf(b, "Checkpoint: %d (%s)    Lag: %s\n", status.CheckpointTs,
+		checkpointTime.Format(timeFormat), checkpointLag.Round(time.Millisecond))
+	fmt.Fprintf(b, "Resolved:   %d (%s)    Lag: %s\n", status.ResolvedTs,
+		resolvedTime.Format(timeFormat), resolvedLag.Round(time.Millisecond))
+	if status.LastWarning != nil {
+		fmt.Fprintf(b, "Last warning: %s\n", formatRunningError(status.LastWarning))
+	}
+
+	b.WriteString("\n")
+	if state.tablesErr != nil {
+		fmt.Fprintf(b, "Tables: failed to list the tables: %v\n", state.tablesErr)
+	} else {
+		renderTables(b, state.tables, maxTables)
+	}
+	renderRecentErrors(b, state.recentErrors)
+	return b.String()
+}
+
+// renderTables renders the number of the tables in each phase and the
+// slowest tables, the tables are sorted by the checkpoint ts by the server.
+func renderTables(b *strings.Builder, tables []v2.TableProgress, maxTables int) {
+	counts := make(map[model.TablePhase]int)
+	for _, table := range tables {
+		counts[model.TablePhase(table.Phase)]++
+	}
+	phases := make([]string, 0, len(counts))
+	for _, phase := range watchTablePhases {
+		if count, ok := counts[phase]; ok {
+			phases = append(phases, fmt.Sprintf("%d %s", count, phase))
+			delete(counts, phase)
+		}
+	}
+	// The phases unknown to this client.
+	unknown := make([]string, 0, len(counts))
+	for phase, count := range counts {
+		unknown = append(unknown, fmt.Sprintf("%d %s", count, phase))
+	}
+	sort.Strings(unknown)
+	phases = append(phases, unknown...)
+	fmt.Fprintf(b, "Tables: %d total", len(tables))
+	if len(phases) > 0 {
+		fmt.Fprintf(b, ", %s", strings.Join(phases, ", "))
+	}
+	b.WriteString("\n")
+
+	if len(tables) == 0 || maxTables == 0 {
+		return
+	}
+	if len(tables) > maxTables {
+		tables = tables[:maxTables]
+	}
+	fmt.Fprintf(b, "Slowest tables:\n")
+	w := tabwriter.NewWriter(b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  TABLE ID\tPHASE\tCHECKPOINT LAG\tSPANS\tCAPTURES")
+	for _, table := range tables {
+		fmt.Fprintf(w, "  %d\t%s\t%s\t%d\t%s\n", table.TableID, table.Phase,
+			table.CheckpointLag.Duration().Round(time.Millisecond), table.SpanCount,
+			strings.Join(table.Captures, ","))
+	}
+	_ = w.Flush()
+}
+
+func renderRecentErrors(b *strings.Builder, recentErrors []*v2.RunningError) {
+	if len(recentErrors) == 0 {
+		return
+	}
+	b.WriteString("\nRecent errors:\n")
+	for _, err := range recentErrors {
+		fmt.Fprintf(b, "  %s\n", formatRunningError(err))
+	}
+}
+
+func formatRunningError(err *v2.RunningError) string {
+	var b strings.Builder
+	if err.Time != nil {
+		fmt.Fprintf(&b, "%s ", err.Time.Format(timeFormat))
+	}
+	if err.Code != "" {
+		fmt.Fprintf(&b, "[%s] ", err.Code)
+	}
+	b.WriteString(err.Message)
+	if err.Addr != "" {
+		fmt.Fprintf(&b, " (%s)", err.Addr)
+	}
+	return b.String()
+}
+
+// isTerminal returns true if w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// newCmdWatchChangefeed creates the `cli changefeed watch` command.
+func newCmdWatchChangefeed(f factory.Factory) *cobra.Command {
+	o := newWatchChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "watch [changefeed-id]",
+		Short: "Watch the live status of a replication task (changefeed)",
+		Long: `Show the checkpoint ts, resolved ts and their lags, the number of the tables
+in each phase, the slowest tables and the recent errors of a changefeed, which
+are refreshed by the status stream of the server until it's interrupted.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeChangefeedIDArg(f),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f, args))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
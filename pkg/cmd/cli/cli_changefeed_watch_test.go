@@ -0,0 +1,135 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func newWatchStatusEvent(t *testing.T, checkpoint, resolved time.Time,
+	lag time.Duration,
+) *v2.ChangefeedStatusEvent {
+	event := &v2.ChangefeedStatusEvent{}
+	data := fmt.Sprintf(`{"state":"normal","checkpoint_ts":%d,"resolved_ts":%d,"checkpoint_lag":%d}`,
+		oracle.GoTimeToTS(checkpoint), oracle.GoTimeToTS(resolved), lag.Nanoseconds())
+	require.Nil(t, json.Unmarshal([]byte(data), event))
+	return event
+}
+
+func TestChangefeedWatchCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdWatchChangefeed(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	now := time.Now()
+	errTime := now.Add(-time.Second)
+	cf.EXPECT().WatchStatus(gomock.Any(), "abc", 500*time.Millisecond, gomock.Any()).
+		DoAndReturn(func(
+			ctx context.Context, name string, interval time.Duration,
+			onEvent func(event *apiv2client.ChangefeedStatusStreamEvent) error,
+		) error {
+			require.Nil(t, onEvent(&apiv2client.ChangefeedStatusStreamEvent{
+				Status: newWatchStatusEvent(t, now, now, time.Second),
+			}))
+			require.Nil(t, onEvent(&apiv2client.ChangefeedStatusStreamEvent{
+				Error: &v2.RunningError{Time: &errTime, Code: "CDC:ErrSinkFailed", Message: "fake error"},
+			}))
+			return context.Canceled
+		})
+	cf.EXPECT().ListTables(gomock.Any(), "abc").Return([]v2.TableProgress{
+		{TableID: 3, Phase: "absent"},
+		{TableID: 1, Phase: "replicating", Captures: []string{"capture-1"}, SpanCount: 1},
+		{TableID: 2, Phase: "replicating", Captures: []string{"capture-2"}, SpanCount: 1},
+	}, nil)
+	os.Args = []string{"watch", "abc", "--interval=500ms"}
+	require.Nil(t, cmd.Execute())
+	out := b.String()
+	require.Contains(t, out, "Changefeed: abc    State: normal")
+	require.Contains(t, out, "Tables: 3 total, 2 replicating, 1 absent\n")
+	require.Contains(t, out, "Recent errors:\n")
+	require.Contains(t, out, "[CDC:ErrSinkFailed] fake error\n")
+
+	// the changefeed is removed
+	cf.EXPECT().WatchStatus(gomock.Any(), "abc", time.Second, gomock.Any()).
+		Return(errors.New("changefeed not exists"))
+	o := newWatchChangefeedOptions()
+	o.interval = time.Second
+	require.Nil(t, o.complete(f, []string{"abc"}))
+	require.Nil(t, o.validate())
+	require.ErrorContains(t, o.run(cmd), "changefeed not exists")
+
+	o = newWatchChangefeedOptions()
+	o.interval = time.Second
+	require.Nil(t, o.complete(f, nil))
+	require.Error(t, o.validate())
+	o.changefeedID = "abc"
+	require.Error(t, o.complete(f, []string{"def"}))
+}
+
+func TestRenderWatchFrame(t *testing.T) {
+	t.Parallel()
+
+	state := &watchState{changefeedID: "abc"}
+	require.Equal(t, "Changefeed: abc\nWaiting for the status...\n", renderWatchFrame(state, 10))
+
+	checkpoint := time.Now().Add(-2 * time.Second)
+	state.updateTime = time.Now()
+	state.status = newWatchStatusEvent(t, checkpoint, checkpoint.Add(time.Second),
+		1500*time.Millisecond)
+	state.status.LastWarning = &v2.RunningError{Message: "fake warning", Addr: "127.0.0.1:8300"}
+	state.tables = []v2.TableProgress{
+		{TableID: 1, Phase: "replicating"},
+		{TableID: 2, Phase: "catching-up"},
+		{TableID: 3, Phase: "unknown-phase"},
+	}
+	for i := 0; i < watchMaxRecentErrors+1; i++ {
+		state.addError(&v2.RunningError{Message: fmt.Sprintf("error %d", i)})
+	}
+	frame := renderWatchFrame(state, 2)
+	require.Contains(t, frame, fmt.Sprintf("Checkpoint: %d (%s)    Lag: 1.5s\n",
+		state.status.CheckpointTs, checkpoint.Format(timeFormat)))
+	require.Contains(t, frame, fmt.Sprintf("Resolved:   %d (%s)    Lag: 500ms\n",
+		state.status.ResolvedTs, checkpoint.Add(time.Second).Format(timeFormat)))
+	require.Contains(t, frame, "Last warning: fake warning (127.0.0.1:8300)\n")
+	require.Contains(t, frame,
+		"Tables: 3 total, 1 replicating, 1 catching-up, 1 unknown-phase\n")
+	require.Regexp(t, `\n  1 +replicating +0s +0 +\n  2 +catching-up`, frame)
+	require.NotContains(t, frame, "unknown-phase  ")
+	// the latest errors come first
+	require.Contains(t, frame, "  error 5\n  error 4\n")
+	require.NotContains(t, frame, "error 0")
+
+	state.tablesErr = errors.New("fake list error")
+	require.Contains(t, renderWatchFrame(state, 2),
+		"Tables: failed to list the tables: fake list error\n")
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/logutil"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap/zapcore"
+)
+
+// completionTimeout is the timeout of querying the server for the completions.
+const completionTimeout = 5 * time.Second
+
+// registerCompletions registers the completions of the changefeed IDs and the
+// capture IDs for the `--changefeed-id` and `--capture-id` flags of cmd and
+// all its subcommands.
+func registerCompletions(cmd *cobra.Command, f factory.Factory) {
+	if cmd.LocalFlags().Lookup("changefeed-id") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("changefeed-id",
+			func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				return completeChangefeedIDs(f, toComplete)
+			})
+	}
+	if cmd.LocalFlags().Lookup("capture-id") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("capture-id",
+			func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				return completeCaptureIDs(f, toComplete)
+			})
+	}
+	for _, sub := range cmd.Commands() {
+		registerCompletions(sub, f)
+	}
+}
+
+// completeChangefeedIDArg completes the changefeed ID specified by the first
+// argument of a command.
+func completeChangefeedIDArg(f factory.Factory) func(
+	cmd *cobra.Command, args []string, toComplete string,
+) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeChangefeedIDs(f, toComplete)
+	}
+}
+
+// completeChangefeedIDs returns the IDs of the changefeeds with the prefix
+// toComplete, the state of each changefeed is shown as its description.
+func completeChangefeedIDs(f factory.Factory, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := prepareCompletion()
+	defer cancel()
+
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	changefeeds, err := apiClient.Changefeeds().List(ctx, "all", "")
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, 0, len(changefeeds))
+	for _, cf := range changefeeds {
+		if strings.HasPrefix(cf.ID, toComplete) {
+			completions = append(completions, fmt.Sprintf("%s\t%s", cf.ID, cf.FeedState))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeCaptureIDs returns the IDs of the captures with the prefix
+// toComplete, the address of each capture is shown as its description.
+func completeCaptureIDs(f factory.Factory, toComplete string) ([]string, cobra.ShellCompDirective) {
+	ctx, cancel := prepareCompletion()
+	defer cancel()
+
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	captures, err := apiClient.Captures().List(ctx)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, 0, len(captures))
+	for _, capture := range captures {
+		if strings.HasPrefix(capture.ID, toComplete) {
+			completions = append(completions,
+				fmt.Sprintf("%s\t%s", capture.ID, capture.AdvertiseAddr))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// prepareCompletion initializes the logger and the default context, which
+// are not initialized for the completions because the hidden `__complete`
+// command doesn't run the PersistentPreRunE of the `cli` command.
+func prepareCompletion() (context.Context, context.CancelFunc) {
+	// The completions are written to the stdout, so the logs are discarded.
+	_ = logutil.InitLogger(&logutil.Config{Level: "error"},
+		logutil.WithOutputWriteSyncer(zapcore.AddSync(io.Discard)))
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	cmdcontext.SetDefaultContext(ctx)
+	return ctx, cancel
+}
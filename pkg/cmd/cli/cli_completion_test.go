@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+
+	root := &cobra.Command{Use: "cli"}
+	root.AddCommand(newCmdPauseChangefeed(f))
+	root.AddCommand(newCmdQueryProcessor(f))
+	root.AddCommand(newCmdWatchChangefeed(f))
+	registerCompletions(root, f)
+	complete := func(args ...string) string {
+		b := bytes.NewBufferString("")
+		root.SetOut(b)
+		root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+		require.Nil(t, root.Execute())
+		return b.String()
+	}
+
+	changefeeds := []v2.ChangefeedCommonInfo{
+		{ID: "abc", FeedState: model.StateNormal},
+		{ID: "abd", FeedState: model.StateStopped},
+		{ID: "def", FeedState: model.StateFailed},
+	}
+	f.changefeeds.EXPECT().List(gomock.Any(), "all", "").Return(changefeeds, nil).Times(2)
+	require.Equal(t, "abc\tnormal\nabd\tstopped\n:4\n", complete("pause", "-c", "ab"))
+	require.Equal(t, "abc\tnormal\nabd\tstopped\ndef\tfailed\n:4\n", complete("watch", ""))
+	// only the first argument of watch is the changefeed ID.
+	require.Equal(t, ":4\n", complete("watch", "abc", ""))
+
+	f.captures.EXPECT().List(gomock.Any()).Return([]model.Capture{
+		{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"},
+		{ID: "capture-2", AdvertiseAddr: "127.0.0.1:8301"},
+	}, nil)
+	require.Equal(t, "capture-2\t127.0.0.1:8301\n:4\n",
+		complete("query", "-c", "abc", "--capture-id", "capture-2"))
+
+	f.captures.EXPECT().List(gomock.Any()).Return(nil, errors.New("test"))
+	require.Equal(t, ":1\n", complete("query", "-p", ""))
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pingcap/errors"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// bundleErrorsFile is the file recording the failures of collecting the
+// debug bundle, see the debug bundle API.
+const bundleErrorsFile = "errors.txt"
+
+// debugBundleOptions defines flags for the `cli debug bundle` command.
+type debugBundleOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	output string
+}
+
+// newDebugBundleOptions creates new options for the `cli debug bundle` command.
+func newDebugBundleOptions() *debugBundleOptions {
+	return &debugBundleOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *debugBundleOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.output, "output", "o", "",
+		"Path of the file to write the debug bundle to, "+
+			"cdc-debug-bundle-<time>.zip in the current directory by default")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *debugBundleOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	if o.output == "" {
+		o.output = fmt.Sprintf("cdc-debug-bundle-%s.zip", time.Now().Format("20060102150405"))
+	}
+	return nil
+}
+
+// run the `cli debug bundle` command.
+func (o *debugBundleOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	cmd.Println("Collecting the debug bundle, it may take a while")
+	data, err := o.apiClient.Status().DebugBundle(ctx)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return errors.Annotate(err, "invalid debug bundle")
+	}
+	// The credentials are redacted, but the bundle still contains the
+	// details of the cluster.
+	if err := os.WriteFile(o.output, data, 0o600); err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Printf("Wrote the debug bundle of %d files to %s\n", len(zr.File), o.output)
+	for _, file := range zr.File {
+		if file.Name == bundleErrorsFile {
+			cmd.Printf("Some information failed to be collected, see %s in the bundle\n",
+				bundleErrorsFile)
+			break
+		}
+	}
+	return nil
+}
+
+// newCmdDebugBundle creates the `cli debug bundle` command.
+func newCmdDebugBundle(f factory.Factory) *cobra.Command {
+	o := newDebugBundleOptions()
+
+	command := &cobra.Command{
+		Use:   "bundle",
+		Short: "Collect the debug information of all captures into an archive",
+		Long: "Collect the goroutine and heap profiles, the recent logs, the status " +
+			"and the metrics of all captures, and the specs and the statuses of all " +
+			"changefeeds into a zip archive, which can be attached to a support " +
+			"ticket. The credentials in the sink URIs and the logs are redacted.",
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBundle(t *testing.T, files ...string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, file := range files {
+		w, err := zw.Create(file)
+		require.Nil(t, err)
+		_, err = w.Write([]byte(file))
+		require.Nil(t, err)
+	}
+	require.Nil(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestDebugBundleCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	status := mock.NewMockStatusInterface(ctrl)
+	f := &mockFactory{status: status}
+	cmd := newCmdDebugBundle(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	data := newTestBundle(t, "captures.json", "captures/capture-1/goroutine.txt")
+	status.EXPECT().DebugBundle(gomock.Any()).Return(data, nil)
+	output := filepath.Join(t.TempDir(), "bundle.zip")
+	os.Args = []string{"bundle", "--output=" + output}
+	require.Nil(t, cmd.Execute())
+	written, err := os.ReadFile(output)
+	require.Nil(t, err)
+	require.Equal(t, data, written)
+	require.Contains(t, b.String(), "Wrote the debug bundle of 2 files to "+output)
+	require.NotContains(t, b.String(), bundleErrorsFile)
+
+	// The failures of collecting the bundle are reported.
+	b.Reset()
+	status.EXPECT().DebugBundle(gomock.Any()).
+		Return(newTestBundle(t, "captures.json", bundleErrorsFile), nil)
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, b.String(), "see errors.txt in the bundle")
+
+	o := newDebugBundleOptions()
+	require.NoError(t, o.complete(f))
+	require.Contains(t, o.output, "cdc-debug-bundle-")
+	o.output = filepath.Join(t.TempDir(), "invalid.zip")
+	status.EXPECT().DebugBundle(gomock.Any()).Return([]byte("not a zip"), nil)
+	require.Contains(t, o.run(cmd).Error(), "invalid debug bundle")
+	_, err = os.Stat(o.output)
+	require.True(t, os.IsNotExist(err))
+
+	status.EXPECT().DebugBundle(gomock.Any()).
+		Return(nil, errors.New("debug bundle test error"))
+	require.Contains(t, o.run(cmd).Error(), "debug bundle test error")
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// doctorOptions defines flags for the `cli doctor` command.
+type doctorOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+}
+
+// newDoctorOptions creates new options for the `cli doctor` command.
+func newDoctorOptions() *doctorOptions {
+	return &doctorOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *doctorOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "",
+		"Replication task (changefeed) ID, which can also be specified by the argument")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *doctorOptions) complete(f factory.Factory, args []string) error {
+	if len(args) > 0 {
+		if o.changefeedID != "" && o.changefeedID != args[0] {
+			return errors.Errorf("the changefeed ID %s conflicts with --changefeed-id %s",
+				args[0], o.changefeedID)
+		}
+		o.changefeedID = args[0]
+	}
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// validate checks that the provided doctor options are specified.
+func (o *doctorOptions) validate() error {
+	if o.changefeedID == "" {
+		return errors.New("the changefeed ID must be specified by the argument or --changefeed-id")
+	}
+	return nil
+}
+
+// run the `cli doctor` command.
+func (o *doctorOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	diagnosis, err := o.apiClient.Changefeeds().Diagnose(ctx, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	if util.GetOutputFormat(cmd) != "" {
+		return util.PrintOutput(cmd, diagnosis)
+	}
+	cmd.Print(renderDiagnosis(diagnosis))
+	return nil
+}
+
+// renderDiagnosis renders the findings of a diagnosis, each finding is
+// followed by its suggestion if there is any.
+func renderDiagnosis(diagnosis *v2.ChangefeedDiagnosis) string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Diagnosis of changefeed %s/%s: %s\n\n",
+		diagnosis.Namespace, diagnosis.ID, diagnosis.Status)
+	width := 0
+	for _, finding := range diagnosis.Findings {
+		if len(finding.Check) > width {
+			width = len(finding.Check)
+		}
+	}
+	for _, finding := range diagnosis.Findings {
+		status := "[" + finding.Status + "]"
+		fmt.Fprintf(b, "%-10s %-*s  %s\n", status, width, finding.Check, finding.Message)
+		if finding.Suggestion != "" {
+			fmt.Fprintf(b, "%-10s %-*s  -> %s\n", "", width, "", finding.Suggestion)
+		}
+	}
+	return b.String()
+}
+
+// newCmdDoctor creates the `cli doctor` command.
+func newCmdDoctor(f factory.Factory) *cobra.Command {
+	o := newDoctorOptions()
+
+	command := &cobra.Command{
+		Use:   "doctor [changefeed-id]",
+		Short: "Diagnose a replication task (changefeed)",
+		Long: `Check a changefeed end to end, including its state, the GC safepoint of the
+upstream, the connectivity of the sink, the tables matched by the filter rules,
+the tables without a valid index and the health of the captures, and print the
+findings with the suggestions to fix them.`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completeChangefeedIDArg(f),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f, args))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdDoctor(f)
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+
+	cf.EXPECT().Diagnose(gomock.Any(), "abc").Return(&v2.ChangefeedDiagnosis{
+		Namespace: "default",
+		ID:        "abc",
+		Status:    v2.HealthStatusFail,
+		Findings: []v2.DiagnosisFinding{
+			{Check: "state", Status: v2.HealthStatusOK, Message: "the changefeed is normal"},
+			{
+				Check:      "sink",
+				Status:     v2.HealthStatusFail,
+				Message:    "failed to connect to the sink",
+				Suggestion: "Check the network connectivity",
+			},
+		},
+	}, nil)
+	os.Args = []string{"doctor", "abc"}
+	require.Nil(t, cmd.Execute())
+	require.Equal(t, "Diagnosis of changefeed default/abc: FAIL\n\n"+
+		"[OK]       state  the changefeed is normal\n"+
+		"[FAIL]     sink   failed to connect to the sink\n"+
+		"                  -> Check the network connectivity\n", b.String())
+
+	cf.EXPECT().Diagnose(gomock.Any(), "abc").
+		Return(nil, errors.New("changefeed not exists"))
+	o := newDoctorOptions()
+	require.Nil(t, o.complete(f, []string{"abc"}))
+	require.Nil(t, o.validate())
+	require.ErrorContains(t, o.run(cmd), "changefeed not exists")
+
+	o = newDoctorOptions()
+	require.Nil(t, o.complete(f, nil))
+	require.Error(t, o.validate())
+	o.changefeedID = "abc"
+	require.Error(t, o.complete(f, []string{"def"}))
+}
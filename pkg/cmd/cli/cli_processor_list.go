@@ -48,7 +48,7 @@ func (o *listProcessorOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
-	return util.JSONPrint(cmd, processors)
+	return util.PrintOutput(cmd, processors)
 }
 
 // newCmdListProcessor creates the `cli processor list` command.
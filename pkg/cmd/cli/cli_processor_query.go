@@ -84,7 +84,7 @@ func (o *queryProcessorOptions) runCliWithAPIClient(ctx context.Context, cmd *co
 		},
 	}
 
-	return util.JSONPrint(cmd, meta)
+	return util.PrintOutput(cmd, meta)
 }
 
 // run runs the `cli processor query` command.
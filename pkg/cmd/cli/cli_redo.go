@@ -0,0 +1,111 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/applier"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/redo"
+	"github.com/spf13/cobra"
+)
+
+// redoStorageOptions defines the flags locating the redo logs of the `cli
+// redo` commands.
+type redoStorageOptions struct {
+	changefeedID      string
+	storage           string
+	dir               string
+	encryptionKeyFile string
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *redoStorageOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "",
+		"Replication task (changefeed) ID, the storage of the redo logs is read from its consistent config")
+	cmd.PersistentFlags().StringVar(&o.storage, "storage", "",
+		"Storage of the redo logs, such as \"s3://bucket/path/prefix\", which overrides the storage of the changefeed")
+	cmd.PersistentFlags().StringVar(&o.dir, "tmp-dir", "",
+		"Temporary path used to download the redo logs from the external storage")
+	cmd.PersistentFlags().StringVar(&o.encryptionKeyFile, "encryption-key-file", "",
+		"File containing the keys to decrypt the redo logs, which overrides the one of the changefeed")
+}
+
+// complete resolves the storage of the redo logs, it's read from the
+// consistent config of the changefeed if --storage is not specified.
+func (o *redoStorageOptions) complete(ctx context.Context, f factory.Factory) error {
+	if o.storage != "" {
+		return nil
+	}
+	if o.changefeedID == "" {
+		return errors.New("either --changefeed-id or --storage must be specified")
+	}
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	info, err := apiClient.Changefeeds().Get(ctx, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	if info.Config == nil || info.Config.Consistent == nil ||
+		!redo.IsConsistentEnabled(info.Config.Consistent.Level) {
+		return errors.Errorf("the redo log of changefeed %s is not enabled", o.changefeedID)
+	}
+	consistent := info.Config.Consistent
+	uri, err := url.Parse(consistent.Storage)
+	if err != nil {
+		return errors.Annotatef(err, "invalid redo log storage of changefeed %s", o.changefeedID)
+	}
+	if redo.IsBlackholeStorage(uri.Scheme) {
+		return errors.Errorf("the redo logs of changefeed %s are discarded by the %s storage",
+			o.changefeedID, uri.Scheme)
+	}
+	o.storage = consistent.Storage
+	if o.encryptionKeyFile == "" {
+		o.encryptionKeyFile = consistent.EncryptionKeyFile
+	}
+	return nil
+}
+
+// applierConfig returns the config of the redo applier reading the redo logs.
+func (o *redoStorageOptions) applierConfig() *applier.RedoApplierConfig {
+	return &applier.RedoApplierConfig{
+		Storage:           o.storage,
+		Dir:               o.dir,
+		EncryptionKeyFile: o.encryptionKeyFile,
+	}
+}
+
+// newCmdRedo creates the `cli redo` command.
+func newCmdRedo(f factory.Factory) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "redo",
+		Short: "Inspect and apply the redo logs of a replication task (changefeed)",
+		Long: `Inspect and apply the redo logs of a changefeed for disaster recovery. The
+storage of the redo logs is read from the changefeed by --changefeed-id, or
+specified by --storage if the TiCDC cluster is unavailable.`,
+	}
+
+	command.AddCommand(newCmdWindowRedo(f))
+	command.AddCommand(newCmdVerifyRedo(f))
+	command.AddCommand(newCmdApplyRedo(f))
+	command.AddCommand(newCmdProgressRedo())
+
+	return command
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/applier"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// applyRedoOptions defines flags for the `cli redo apply` command.
+type applyRedoOptions struct {
+	redoStorageOptions
+
+	sinkURI      string
+	progressFile string
+	interval     time.Duration
+	dryRun       bool
+}
+
+// newApplyRedoOptions creates new options for the `cli redo apply` command.
+func newApplyRedoOptions() *applyRedoOptions {
+	return &applyRedoOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
+	o.redoStorageOptions.addFlags(cmd)
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "",
+		"Sink URI of the target database to apply the redo logs to")
+	cmd.PersistentFlags().StringVar(&o.progressFile, "progress-file", "",
+		"File to persist the apply progress, an interrupted apply resumes from it when the command is re-run")
+	cmd.PersistentFlags().DurationVar(&o.interval, "interval", 10*time.Second,
+		"Interval of reporting the apply progress")
+	cmd.PersistentFlags().BoolVar(&o.dryRun, "dry-run", false,
+		"Read the redo logs and report the statistics without touching the target database")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *applyRedoOptions) complete(ctx context.Context, f factory.Factory) error {
+	if err := o.redoStorageOptions.complete(ctx, f); err != nil {
+		return err
+	}
+	if o.dryRun || o.sinkURI == "" {
+		return nil
+	}
+	sinkURI, err := applier.EnableSafeMode(o.sinkURI)
+	if err != nil {
+		return err
+	}
+	o.sinkURI = sinkURI
+	return nil
+}
+
+// validate checks that the provided apply options are specified.
+func (o *applyRedoOptions) validate() error {
+	if !o.dryRun && o.sinkURI == "" {
+		return errors.New("the sink URI must be specified by --sink-uri unless --dry-run is enabled")
+	}
+	if o.interval < time.Millisecond {
+		return errors.Errorf("invalid interval %s, it must be at least 1ms", o.interval)
+	}
+	return nil
+}
+
+// run the `cli redo apply` command.
+func (o *applyRedoOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	cfg := o.applierConfig()
+	if o.dryRun {
+		stats, err := applier.NewRedoApplier(cfg).DryRun(ctx)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("checkpoint-ts:%d, resolved-ts:%d, rows:%d, ddls:%d, tables:%d\n",
+			stats.CheckpointTs, stats.ResolvedTs, stats.RowCount, stats.DDLCount, len(stats.Tables))
+		cmd.Printf("Dry run redo log successfully, the estimated apply time is %s\n",
+			stats.EstimatedApplyTime)
+		return nil
+	}
+
+	cfg.SinkURI = o.sinkURI
+	cfg.ProgressFile = o.progressFile
+	if cfg.ProgressFile == "" {
+		// The progress is always persisted so that it can be reported, but
+		// it's discarded after the apply if no progress file is specified.
+		dir, err := os.MkdirTemp("", "redo-apply")
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer os.RemoveAll(dir) //nolint:errcheck
+		cfg.ProgressFile = filepath.Join(dir, "progress")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- applier.NewRedoApplier(cfg).Apply(ctx)
+	}()
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				return err
+			}
+			cmd.Println("Apply redo log successfully")
+			return nil
+		case <-ticker.C:
+			reportApplyProgress(cmd, cfg.ProgressFile)
+		}
+	}
+}
+
+// reportApplyProgress prints the progress persisted in the progress file,
+// nothing is printed before the progress is persisted for the first time.
+func reportApplyProgress(cmd *cobra.Command, progressFile string) {
+	summary, err := applier.ReadApplyProgress(progressFile)
+	if err != nil {
+		return
+	}
+	progress := newRedoApplyProgress(summary)
+	cmd.Printf("Applied to %d (%s), %d tables, %s of the recoverable window (%d, %d]\n",
+		progress.AppliedTs, progress.AppliedTime, progress.Tables, progress.Percent,
+		progress.CheckpointTs, progress.ResolvedTs)
+}
+
+// newCmdApplyRedo creates the `cli redo apply` command.
+func newCmdApplyRedo(f factory.Factory) *cobra.Command {
+	o := newApplyRedoOptions()
+
+	command := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply the redo logs to the target database",
+		Long: `Apply the redo logs to the target database in safe mode, the progress is
+reported periodically until all the redo logs are applied.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(cmdcontext.GetDefaultContext(), f))
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/applier"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// redoApplyProgress is the output of the `cli redo progress` command.
+type redoApplyProgress struct {
+	CheckpointTs uint64 `json:"checkpoint_ts"`
+	ResolvedTs   uint64 `json:"resolved_ts"`
+	AppliedTs    uint64 `json:"applied_ts"`
+	AppliedTime  string `json:"applied_time"`
+	Tables       int    `json:"tables"`
+	// Percent is the percentage of the recoverable window which has been
+	// applied, by the physical time of the ts.
+	Percent string `json:"percent"`
+}
+
+// newRedoApplyProgress converts the summary of a progress file to the output.
+func newRedoApplyProgress(summary *applier.ApplyProgressSummary) *redoApplyProgress {
+	percent := 100.0
+	checkpoint := oracle.ExtractPhysical(summary.CheckpointTs)
+	resolved := oracle.ExtractPhysical(summary.ResolvedTs)
+	if resolved > checkpoint {
+		applied := oracle.ExtractPhysical(summary.AppliedTs)
+		percent = float64(applied-checkpoint) * 100 / float64(resolved-checkpoint)
+	}
+	return &redoApplyProgress{
+		CheckpointTs: summary.CheckpointTs,
+		ResolvedTs:   summary.ResolvedTs,
+		AppliedTs:    summary.AppliedTs,
+		AppliedTime:  oracle.GetTimeFromTS(summary.AppliedTs).Format(timeFormat),
+		Tables:       summary.TableCount,
+		Percent:      fmt.Sprintf("%.2f%%", percent),
+	}
+}
+
+// progressRedoOptions defines flags for the `cli redo progress` command.
+type progressRedoOptions struct {
+	progressFile string
+}
+
+// newProgressRedoOptions creates new options for the `cli redo progress` command.
+func newProgressRedoOptions() *progressRedoOptions {
+	return &progressRedoOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *progressRedoOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.progressFile, "progress-file", "",
+		"Progress file of the apply, which is specified by `cli redo apply --progress-file`")
+}
+
+// validate checks that the provided progress options are specified.
+func (o *progressRedoOptions) validate() error {
+	if o.progressFile == "" {
+		return errors.New("the progress file must be specified by --progress-file")
+	}
+	return nil
+}
+
+// run the `cli redo progress` command.
+func (o *progressRedoOptions) run(cmd *cobra.Command) error {
+	summary, err := applier.ReadApplyProgress(o.progressFile)
+	if err != nil {
+		return err
+	}
+	return util.PrintOutput(cmd, newRedoApplyProgress(summary))
+}
+
+// newCmdProgressRedo creates the `cli redo progress` command.
+func newCmdProgressRedo() *cobra.Command {
+	o := newProgressRedoOptions()
+
+	command := &cobra.Command{
+		Use:   "progress",
+		Short: "Show the progress of applying the redo logs",
+		Long: `Show the progress of applying the redo logs by the progress file, which is
+persisted periodically by a running or an interrupted apply.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.validate())
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
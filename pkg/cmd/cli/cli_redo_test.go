@@ -0,0 +1,129 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestRedoStorageOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	ctx := context.Background()
+
+	o := &redoStorageOptions{}
+	require.ErrorContains(t, o.complete(ctx, f), "--changefeed-id or --storage")
+
+	// the storage specified by --storage is used directly.
+	o = &redoStorageOptions{changefeedID: "abc", storage: "s3://bucket/redo"}
+	require.Nil(t, o.complete(ctx, f))
+	require.Equal(t, "s3://bucket/redo", o.storage)
+
+	newInfo := func(level, storage string) *v2.ChangeFeedInfo {
+		return &v2.ChangeFeedInfo{Config: &v2.ReplicaConfig{
+			Consistent: &v2.ConsistentConfig{
+				Level:             level,
+				Storage:           storage,
+				EncryptionKeyFile: "/path/to/key",
+			},
+		}}
+	}
+	cf.EXPECT().Get(gomock.Any(), "abc").Return(newInfo("eventual", "s3://bucket/abc"), nil)
+	o = &redoStorageOptions{changefeedID: "abc"}
+	require.Nil(t, o.complete(ctx, f))
+	require.Equal(t, "s3://bucket/abc", o.storage)
+	require.Equal(t, "/path/to/key", o.encryptionKeyFile)
+	cfg := o.applierConfig()
+	require.Equal(t, "s3://bucket/abc", cfg.Storage)
+	require.Equal(t, "/path/to/key", cfg.EncryptionKeyFile)
+
+	cf.EXPECT().Get(gomock.Any(), "abc").Return(newInfo("none", "s3://bucket/abc"), nil)
+	o = &redoStorageOptions{changefeedID: "abc"}
+	require.ErrorContains(t, o.complete(ctx, f), "is not enabled")
+
+	cf.EXPECT().Get(gomock.Any(), "abc").Return(newInfo("eventual", "blackhole://"), nil)
+	o = &redoStorageOptions{changefeedID: "abc"}
+	require.ErrorContains(t, o.complete(ctx, f), "discarded by the blackhole storage")
+}
+
+func TestApplyRedoOptions(t *testing.T) {
+	f := &mockFactory{}
+	ctx := context.Background()
+
+	o := newApplyRedoOptions()
+	o.interval = time.Second
+	o.storage = "s3://bucket/redo"
+	require.Nil(t, o.complete(ctx, f))
+	require.ErrorContains(t, o.validate(), "--sink-uri")
+
+	o.sinkURI = "mysql://root@127.0.0.1:3306?safe-mode=false"
+	require.Nil(t, o.complete(ctx, f))
+	require.Nil(t, o.validate())
+	require.Equal(t, "mysql://root@127.0.0.1:3306?safe-mode=true", o.sinkURI)
+
+	o = newApplyRedoOptions()
+	o.storage = "s3://bucket/redo"
+	o.dryRun = true
+	o.interval = 0
+	require.Nil(t, o.complete(ctx, f))
+	require.ErrorContains(t, o.validate(), "invalid interval")
+}
+
+func TestProgressRedoCli(t *testing.T) {
+	checkpointTs := oracle.ComposeTS(1000, 0)
+	resolvedTs := oracle.ComposeTS(2000, 0)
+	path := filepath.Join(t.TempDir(), "progress")
+	data := fmt.Sprintf(`{"checkpoint-ts":%d,"resolved-ts":%d,"ddl-ts":0,"tables":{"1":%d,"2":%d}}`,
+		checkpointTs, resolvedTs, oracle.ComposeTS(1250, 1), oracle.ComposeTS(1500, 0))
+	require.Nil(t, os.WriteFile(path, []byte(data), 0o600))
+
+	cmd := newCmdProgressRedo()
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	o := newProgressRedoOptions()
+	require.ErrorContains(t, o.validate(), "--progress-file")
+	o.progressFile = path
+	require.Nil(t, o.validate())
+	require.Nil(t, o.run(cmd))
+	require.Contains(t, b.String(), fmt.Sprintf(`"applied_ts": %d`, oracle.ComposeTS(1250, 1)))
+	require.Contains(t, b.String(), `"tables": 2`)
+	require.Contains(t, b.String(), `"percent": "25.00%"`)
+
+	b.Reset()
+	reportApplyProgress(cmd, path)
+	require.Contains(t, b.String(), fmt.Sprintf("25.00%% of the recoverable window (%d, %d]\n",
+		checkpointTs, resolvedTs))
+
+	// nothing is reported before the progress is persisted.
+	b.Reset()
+	reportApplyProgress(cmd, filepath.Join(t.TempDir(), "not-exist"))
+	require.Empty(t, b.String())
+
+	o.progressFile = filepath.Join(t.TempDir(), "not-exist")
+	require.Error(t, o.run(cmd))
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/pingcap/tiflow/pkg/applier"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// verifyRedoOptions defines flags for the `cli redo verify` command.
+type verifyRedoOptions struct {
+	redoStorageOptions
+
+	verbose bool
+}
+
+// newVerifyRedoOptions creates new options for the `cli redo verify` command.
+func newVerifyRedoOptions() *verifyRedoOptions {
+	return &verifyRedoOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *verifyRedoOptions) addFlags(cmd *cobra.Command) {
+	o.redoStorageOptions.addFlags(cmd)
+	cmd.PersistentFlags().BoolVar(&o.verbose, "verbose", false,
+		"Print the details of each redo log file")
+}
+
+// run the `cli redo verify` command.
+func (o *verifyRedoOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	ap := applier.NewRedoApplier(o.applierConfig())
+	result, err := ap.Verify(ctx)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("meta-files:%d, checkpoint-ts:%d, resolved-ts:%d, log-files:%d\n",
+		result.MetaCount, result.CheckpointTs, result.ResolvedTs, len(result.Files))
+	if o.verbose {
+		for _, f := range result.Files {
+			cmd.Printf("%s type:%s, max-commit-ts:%d, records:%d, record-commit-ts:[%d, %d]\n",
+				f.Name, f.FileType, f.MaxCommitTs, f.RecordCount, f.MinRecordTs, f.MaxRecordTs)
+		}
+	}
+	if !result.Recoverable() {
+		for _, problem := range result.Problems {
+			cmd.Println(problem)
+		}
+		return cerror.ErrRedoVerifyFailed.GenWithStackByArgs(len(result.Problems))
+	}
+	window := newRedoWindow(result.CheckpointTs, result.ResolvedTs)
+	cmd.Printf("Verify redo log successfully, the recoverable window is (%d, %d], "+
+		"i.e. (%s, %s]\n", window.CheckpointTs, window.ResolvedTs,
+		window.CheckpointTime, window.ResolvedTime)
+	return nil
+}
+
+// newCmdVerifyRedo creates the `cli redo verify` command.
+func newCmdVerifyRedo(f factory.Factory) *cobra.Command {
+	o := newVerifyRedoOptions()
+
+	command := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the integrity of the redo logs without applying them",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(cmdcontext.GetDefaultContext(), f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/pingcap/tiflow/pkg/applier"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// redoWindow is the output of the `cli redo window` command, the data can be
+// recovered to any ts in (CheckpointTs, ResolvedTs].
+type redoWindow struct {
+	CheckpointTs   uint64 `json:"checkpoint_ts"`
+	CheckpointTime string `json:"checkpoint_time"`
+	ResolvedTs     uint64 `json:"resolved_ts"`
+	ResolvedTime   string `json:"resolved_time"`
+}
+
+// newRedoWindow returns the recoverable window in (checkpointTs, resolvedTs].
+func newRedoWindow(checkpointTs, resolvedTs uint64) *redoWindow {
+	return &redoWindow{
+		CheckpointTs:   checkpointTs,
+		CheckpointTime: oracle.GetTimeFromTS(checkpointTs).Format(timeFormat),
+		ResolvedTs:     resolvedTs,
+		ResolvedTime:   oracle.GetTimeFromTS(resolvedTs).Format(timeFormat),
+	}
+}
+
+// windowRedoOptions defines flags for the `cli redo window` command.
+type windowRedoOptions struct {
+	redoStorageOptions
+}
+
+// newWindowRedoOptions creates new options for the `cli redo window` command.
+func newWindowRedoOptions() *windowRedoOptions {
+	return &windowRedoOptions{}
+}
+
+// run the `cli redo window` command.
+func (o *windowRedoOptions) run(cmd *cobra.Command) error {
+	ctx := cmdcontext.GetDefaultContext()
+
+	ap := applier.NewRedoApplier(o.applierConfig())
+	checkpointTs, resolvedTs, err := ap.ReadMeta(ctx)
+	if err != nil {
+		return err
+	}
+	return util.PrintOutput(cmd, newRedoWindow(checkpointTs, resolvedTs))
+}
+
+// newCmdWindowRedo creates the `cli redo window` command.
+func newCmdWindowRedo(f factory.Factory) *cobra.Command {
+	o := newWindowRedoOptions()
+
+	command := &cobra.Command{
+		Use:   "window",
+		Short: "Show the recoverable window of the redo logs",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(cmdcontext.GetDefaultContext(), f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
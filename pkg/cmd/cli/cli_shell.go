@@ -0,0 +1,394 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+const (
+	shellPrompt = "cdc cli> "
+	// shellDirName is the directory under the home directory to persist the
+	// history and the context of the interactive shell.
+	shellDirName     = ".ticdc"
+	shellHistoryFile = "cli_history"
+	shellContextFile = "cli_context.json"
+)
+
+const shellHelp = `Run the cli commands without the "cdc cli" prefix, e.g. "changefeed list".
+The server address and namespace in the context are applied to the commands
+which don't specify them.
+
+Shell commands:
+  set server <address>     Set the server address in the context
+  set namespace <name>     Set the namespace in the context
+  unset server|namespace   Remove a value from the context
+  context                  Show the context
+  help [command]           Show the help of the shell or a command
+  exit, quit               Exit the shell
+`
+
+// shellContext is the context of the interactive shell, which is persisted
+// across the commands and the sessions.
+type shellContext struct {
+	Server    string `json:"server,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// flags returns the flags applied to the commands by the context.
+func (c *shellContext) flags() map[string]string {
+	flags := make(map[string]string)
+	if c.Server != "" {
+		flags["server"] = c.Server
+	}
+	if c.Namespace != "" {
+		flags["namespace"] = c.Namespace
+	}
+	return flags
+}
+
+// shell runs the cli commands interactively.
+type shell struct {
+	// dir is the directory to persist the history and the context, they are
+	// not persisted if it's empty.
+	dir     string
+	context shellContext
+	// sessionFlags are the flags of the shell applied to the commands, they
+	// are not persisted since they may be credentials.
+	sessionFlags map[string]string
+	out          io.Writer
+	newRoot      func() *cobra.Command
+}
+
+// runShell runs the interactive shell of the `cli` command.
+func runShell(cmd *cobra.Command, cf *factory.ClientFlags) error {
+	s := &shell{
+		dir:          getShellDir(),
+		sessionFlags: make(map[string]string),
+		out:          cmd.OutOrStdout(),
+		newRoot:      func() *cobra.Command { return newCmdCli(true) },
+	}
+	s.loadContext()
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		switch flag.Name {
+		case "interactive", "log-level":
+		case "server":
+			s.context.Server = flag.Value.String()
+			s.saveContext()
+		default:
+			s.sessionFlags[flag.Name] = flag.Value.String()
+		}
+	})
+
+	historyFile := ""
+	if s.dir != "" {
+		historyFile = filepath.Join(s.dir, shellHistoryFile)
+	}
+	l, err := readline.NewEx(&readline.Config{
+		Prompt:          shellPrompt,
+		HistoryFile:     historyFile,
+		AutoComplete:    readline.NewPrefixCompleter(shellCompleterItems(s.newRoot())...),
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer l.Close()
+
+	for {
+		line, err := l.Readline()
+		if err == readline.ErrInterrupt {
+			continue
+		} else if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		if exit := s.handleLine(line); exit {
+			return nil
+		}
+	}
+}
+
+// getShellDir returns the directory to persist the history and the context of
+// the shell, an empty string is returned if it's unavailable.
+func getShellDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	dir := filepath.Join(home, shellDirName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return ""
+	}
+	return dir
+}
+
+func (s *shell) loadContext() {
+	if s.dir == "" {
+		return
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, shellContextFile))
+	if err != nil {
+		return
+	}
+	if err := json.Unmarshal(data, &s.context); err != nil {
+		log.Warn("failed to load the context of the shell", zap.Error(err))
+		s.context = shellContext{}
+	}
+}
+
+func (s *shell) saveContext() {
+	if s.dir == "" {
+		return
+	}
+	data, err := json.Marshal(&s.context)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(s.dir, shellContextFile), data, 0o600)
+	}
+	if err != nil {
+		fmt.Fprintf(s.out, "Failed to save the context: %v\n", err)
+	}
+}
+
+// handleLine handles a line of input, it returns true if the shell exits.
+func (s *shell) handleLine(line string) bool {
+	args, err := splitShellArgs(line)
+	if err != nil {
+		fmt.Fprintf(s.out, "Error: %v\n", err)
+		return false
+	}
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return true
+	case "help":
+		if len(args) == 1 {
+			fmt.Fprint(s.out, shellHelp)
+			return false
+		}
+		args = append(args[1:], "--help")
+	case "context":
+		fmt.Fprintf(s.out, "server: %s\nnamespace: %s\n", s.context.Server, s.context.Namespace)
+		return false
+	case "set", "unset":
+		s.updateContext(args)
+		return false
+	}
+
+	if err := s.execute(args); err != nil {
+		fmt.Fprintf(s.out, "Error: %v\n", err)
+	}
+	return false
+}
+
+func (s *shell) updateContext(args []string) {
+	value := ""
+	if args[0] == "set" {
+		if len(args) != 3 {
+			fmt.Fprintf(s.out, "Usage: set server|namespace <value>\n")
+			return
+		}
+		value = args[2]
+	} else if len(args) != 2 {
+		fmt.Fprintf(s.out, "Usage: unset server|namespace\n")
+		return
+	}
+	switch args[1] {
+	case "server":
+		s.context.Server = value
+	case "namespace":
+		s.context.Namespace = value
+	default:
+		fmt.Fprintf(s.out, "Unknown context key %q, it must be server or namespace\n", args[1])
+		return
+	}
+	s.saveContext()
+}
+
+// execute runs a cli command with the flags in the context, the command is
+// canceled by an interrupt signal.
+func (s *shell) execute(args []string) error {
+	root := s.newRoot()
+	root.SetOut(s.out)
+	root.SetErr(s.out)
+	// The error is printed by the shell.
+	root.SilenceErrors = true
+	root.SetArgs(s.withContextFlags(root, args))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, os.Interrupt)
+	defer signal.Stop(sc)
+	go func() {
+		select {
+		case <-sc:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	cmdcontext.SetDefaultContext(ctx)
+
+	return util.RunWithoutExit(root.Execute)
+}
+
+// withContextFlags appends the flags in the context and the session to the
+// args, unless they are not supported by the command or already specified.
+func (s *shell) withContextFlags(root *cobra.Command, args []string) []string {
+	cmd, _, err := root.Find(args)
+	if err != nil {
+		return args
+	}
+	flags := s.context.flags()
+	for name, value := range s.sessionFlags {
+		flags[name] = value
+	}
+	res := append([]string{}, args...)
+	for _, name := range sortedKeys(flags) {
+		flag := cmd.Flag(name)
+		if flag == nil || isFlagSpecified(args, flag) {
+			continue
+		}
+		res = append(res, fmt.Sprintf("--%s=%s", name, flags[name]))
+	}
+	return res
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isFlagSpecified(args []string, flag *pflag.Flag) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			return false
+		}
+		if arg == "--"+flag.Name || strings.HasPrefix(arg, "--"+flag.Name+"=") {
+			return true
+		}
+		if flag.Shorthand != "" && strings.HasPrefix(arg, "-"+flag.Shorthand) &&
+			!strings.HasPrefix(arg, "--") {
+			return true
+		}
+	}
+	return false
+}
+
+// shellCompleterItems returns the completion items of the subcommands and
+// flags of a command, and the shell commands.
+func shellCompleterItems(root *cobra.Command) []readline.PrefixCompleterInterface {
+	items := commandCompleterItems(root)
+	items = append(items,
+		readline.PcItem("set", readline.PcItem("server"), readline.PcItem("namespace")),
+		readline.PcItem("unset", readline.PcItem("server"), readline.PcItem("namespace")),
+		readline.PcItem("context"),
+		readline.PcItem("help", commandCompleterItems(root)...),
+		readline.PcItem("exit"),
+		readline.PcItem("quit"),
+	)
+	return items
+}
+
+func commandCompleterItems(cmd *cobra.Command) []readline.PrefixCompleterInterface {
+	var items []readline.PrefixCompleterInterface
+	for _, sub := range cmd.Commands() {
+		if sub.Hidden || sub.Name() == "help" || sub.Name() == "completion" {
+			continue
+		}
+		children := commandCompleterItems(sub)
+		if !sub.HasSubCommands() {
+			sub.Flags().VisitAll(func(flag *pflag.Flag) {
+				children = append(children, readline.PcItem("--"+flag.Name))
+			})
+		}
+		items = append(items, readline.PcItem(sub.Name(), children...))
+	}
+	return items
+}
+
+// splitShellArgs splits a line into args like a shell, the args can be quoted
+// by single or double quotes, and the characters can be escaped by backslashes
+// outside single quotes.
+func splitShellArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if escaped || quote != 0 {
+		return nil, errors.New("unterminated quote or escape")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
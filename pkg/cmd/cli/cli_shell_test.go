@@ -0,0 +1,122 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitShellArgs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		line string
+		args []string
+	}{
+		{"", nil},
+		{"  changefeed   list ", []string{"changefeed", "list"}},
+		{`changefeed handle-error --statement="ALTER TABLE t ADD COLUMN c INT"`, []string{
+			"changefeed", "handle-error", "--statement=ALTER TABLE t ADD COLUMN c INT",
+		}},
+		{`a 'b "c"' "d 'e'" f\ g ''`, []string{"a", `b "c"`, "d 'e'", "f g", ""}},
+	}
+	for _, c := range cases {
+		args, err := splitShellArgs(c.line)
+		require.Nil(t, err, c.line)
+		require.Equal(t, c.args, args, c.line)
+	}
+
+	_, err := splitShellArgs(`a "b`)
+	require.Error(t, err)
+	_, err = splitShellArgs(`a \`)
+	require.Error(t, err)
+}
+
+func TestShell(t *testing.T) {
+	var (
+		out      bytes.Buffer
+		executed []string
+		server   string
+		ns       string
+	)
+	newRoot := func() *cobra.Command {
+		root := &cobra.Command{Use: "cli"}
+		root.PersistentFlags().StringVar(&server, "server", "", "")
+		list := &cobra.Command{
+			Use: "list",
+			Run: func(cmd *cobra.Command, args []string) {
+				executed = append(executed, "list "+server)
+			},
+		}
+		export := &cobra.Command{
+			Use: "export",
+			Run: func(cmd *cobra.Command, args []string) {
+				executed = append(executed, "export "+server+" "+ns)
+			},
+		}
+		export.Flags().StringVarP(&ns, "namespace", "n", "default", "")
+		fail := &cobra.Command{
+			Use: "fail",
+			Run: func(cmd *cobra.Command, args []string) {
+				util.CheckErr(errors.New("command failed"))
+			},
+		}
+		root.AddCommand(list, export, fail)
+		return root
+	}
+	dir := t.TempDir()
+	s := &shell{dir: dir, sessionFlags: map[string]string{}, out: &out, newRoot: newRoot}
+
+	require.False(t, s.handleLine("list"))
+	require.False(t, s.handleLine("set server http://127.0.0.1:8300"))
+	require.False(t, s.handleLine("set namespace ns1"))
+	require.False(t, s.handleLine("list"))
+	require.False(t, s.handleLine("export"))
+	// the flags specified override the context
+	require.False(t, s.handleLine("export -n ns2 --server=http://127.0.0.1:8301"))
+	require.Equal(t, []string{
+		"list ",
+		"list http://127.0.0.1:8300",
+		"export http://127.0.0.1:8300 ns1",
+		"export http://127.0.0.1:8301 ns2",
+	}, executed)
+
+	// the errors of the commands don't exit the shell
+	out.Reset()
+	require.False(t, s.handleLine("fail"))
+	require.Contains(t, out.String(), "command failed")
+	out.Reset()
+	require.False(t, s.handleLine("set unknown a"))
+	require.Contains(t, out.String(), "Unknown context key")
+	out.Reset()
+	require.False(t, s.handleLine("help"))
+	require.Contains(t, out.String(), "Shell commands")
+
+	// the context is persisted
+	s = &shell{dir: dir, sessionFlags: map[string]string{}, out: &out, newRoot: newRoot}
+	s.loadContext()
+	require.Equal(t, shellContext{Server: "http://127.0.0.1:8300", Namespace: "ns1"}, s.context)
+	require.False(t, s.handleLine("unset namespace"))
+	out.Reset()
+	require.False(t, s.handleLine("context"))
+	require.Equal(t, "server: http://127.0.0.1:8300\nnamespace: \n", out.String())
+
+	require.True(t, s.handleLine("exit"))
+}
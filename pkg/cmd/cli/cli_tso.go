@@ -26,6 +26,9 @@ func newCmdTso(f factory.Factory) *cobra.Command {
 	}
 
 	command.AddCommand(newCmdQueryTso(f))
+	command.AddCommand(newCmdNowTso(f))
+	command.AddCommand(newCmdToTimeTso())
+	command.AddCommand(newCmdFromTimeTso())
 
 	return command
 }
@@ -0,0 +1,230 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// tsoTimeFormat is the format of the time of a TSO, which is also accepted
+// by the `cli tso from-time` command.
+const tsoTimeFormat = "2006-01-02 15:04:05.000 -0700"
+
+// tsoTimeLayouts are the layouts of the time accepted by the `cli tso
+// from-time` command, the ones without a zone are in the --tz time zone.
+// The fractional seconds are accepted after the seconds by all the layouts.
+var tsoTimeLayouts = []string{
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// tsoInfo is the output of the `cli tso` commands.
+type tsoInfo struct {
+	TSO      uint64 `json:"tso"`
+	Time     string `json:"time"`
+	Physical int64  `json:"physical"`
+	Logical  int64  `json:"logical"`
+}
+
+// newTsoInfo returns the info of ts with the time in loc.
+func newTsoInfo(ts uint64, loc *time.Location) *tsoInfo {
+	return &tsoInfo{
+		TSO:      ts,
+		Time:     oracle.GetTimeFromTS(ts).In(loc).Format(tsoTimeFormat),
+		Physical: oracle.ExtractPhysical(ts),
+		Logical:  oracle.ExtractLogical(ts),
+	}
+}
+
+// tsoTimeOptions defines the time zone flag of the `cli tso` commands.
+type tsoTimeOptions struct {
+	timezone string
+	location *time.Location
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *tsoTimeOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.timezone, "tz", "Local",
+		"Time zone of the time, such as UTC or Asia/Shanghai")
+}
+
+// complete loads the time zone.
+func (o *tsoTimeOptions) complete() error {
+	loc, err := time.LoadLocation(o.timezone)
+	if err != nil {
+		return errors.Annotatef(err, "invalid time zone %s", o.timezone)
+	}
+	o.location = loc
+	return nil
+}
+
+// nowTsoOptions defines flags for the `cli tso now` command.
+type nowTsoOptions struct {
+	tsoTimeOptions
+	apiClient apiv2client.APIV2Interface
+
+	upstreamID uint64
+}
+
+// newNowTsoOptions creates new options for the `cli tso now` command.
+func newNowTsoOptions() *nowTsoOptions {
+	return &nowTsoOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *nowTsoOptions) addFlags(cmd *cobra.Command) {
+	o.tsoTimeOptions.addFlags(cmd)
+	cmd.PersistentFlags().Uint64Var(&o.upstreamID, "upstream-id", 0,
+		"ID of the upstream to get the TSO from, the default upstream is used if it's 0")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *nowTsoOptions) complete(f factory.Factory) error {
+	if err := o.tsoTimeOptions.complete(); err != nil {
+		return err
+	}
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli tso now` command.
+func (o *nowTsoOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+
+	tso, err := o.apiClient.Tso().Query(ctx, &v2.UpstreamConfig{ID: o.upstreamID})
+	if err != nil {
+		return err
+	}
+	ts := oracle.ComposeTS(tso.Timestamp, tso.LogicTime)
+	return util.PrintOutput(cmd, newTsoInfo(ts, o.location))
+}
+
+// newCmdNowTso creates the `cli tso now` command.
+func newCmdNowTso(f factory.Factory) *cobra.Command {
+	o := newNowTsoOptions()
+
+	command := &cobra.Command{
+		Use:   "now",
+		Short: "Get the current TSO of the upstream from PD by the TiCDC server",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
+
+// newCmdToTimeTso creates the `cli tso to-time` command.
+func newCmdToTimeTso() *cobra.Command {
+	o := &tsoTimeOptions{}
+
+	command := &cobra.Command{
+		Use:   "to-time <tso>",
+		Short: "Convert a TSO to the wall-clock time",
+		Example: `  # Show the time of the checkpoint ts of a changefeed
+  cdc cli tso to-time 434782405638406146 --tz UTC`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete())
+			ts, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				util.CheckErr(errors.Errorf("invalid TSO %s", args[0]))
+			}
+			util.CheckErr(util.PrintOutput(cmd, newTsoInfo(ts, o.location)))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
+
+// parseTsoTime parses s by tsoTimeLayouts, the time without a zone is in loc.
+func parseTsoTime(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range tsoTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid time %s, it must be in one of the formats %s",
+		s, strings.Join(tsoTimeLayouts, ", "))
+}
+
+// maxTsoPhysical is the max physical time in milliseconds of a TSO, whose
+// lower 18 bits are the logical time.
+const maxTsoPhysical = 1<<46 - 1
+
+// timeToTso converts t to the TSO, the time must be in the range of the
+// physical time of a TSO, otherwise the TSO wraps around silently.
+func timeToTso(t time.Time) (uint64, error) {
+	physical := t.UnixMilli()
+	if physical < 0 || physical > maxTsoPhysical {
+		return 0, errors.Errorf("invalid time %s, it must be between %s and %s",
+			t.Format(tsoTimeFormat), time.UnixMilli(0).In(t.Location()).Format(tsoTimeFormat),
+			time.UnixMilli(maxTsoPhysical).In(t.Location()).Format(tsoTimeFormat))
+	}
+	return oracle.GoTimeToTS(t), nil
+}
+
+// newCmdFromTimeTso creates the `cli tso from-time` command.
+func newCmdFromTimeTso() *cobra.Command {
+	o := &tsoTimeOptions{}
+
+	command := &cobra.Command{
+		Use:   "from-time <time>",
+		Short: "Convert a wall-clock time to a TSO, which can be used as the start ts",
+		Example: `  # Get the TSO of a time in the local time zone
+  cdc cli tso from-time "2023-06-01 10:00:00"
+
+  # Get the TSO of a time with a zone
+  cdc cli tso from-time 2023-06-01T10:00:00+08:00`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete())
+			t, err := parseTsoTime(args[0], o.location)
+			util.CheckErr(err)
+			ts, err := timeToTso(t)
+			util.CheckErr(err)
+			util.CheckErr(util.PrintOutput(cmd, newTsoInfo(ts, o.location)))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestTsoConvertCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tso := mock.NewMockTsoInterface(ctrl)
+	f := &mockFactory{tso: tso}
+
+	execute := func(cmd *cobra.Command, args ...string) *tsoInfo {
+		b := bytes.NewBufferString("")
+		cmd.SetOut(b)
+		os.Args = args
+		require.Nil(t, cmd.Execute())
+		info := &tsoInfo{}
+		require.Nil(t, json.Unmarshal(b.Bytes(), info))
+		return info
+	}
+
+	physical := time.Date(2023, 6, 1, 10, 0, 0, 123e6, time.UTC)
+	tso.EXPECT().Query(gomock.Any(), &v2.UpstreamConfig{ID: 1}).Return(&v2.Tso{
+		Timestamp: oracle.GetPhysical(physical),
+		LogicTime: 3,
+	}, nil)
+	info := execute(newCmdNowTso(f), "now", "--upstream-id=1", "--tz=UTC")
+	require.Equal(t, oracle.ComposeTS(oracle.GetPhysical(physical), 3), info.TSO)
+	require.Equal(t, "2023-06-01 10:00:00.123 +0000", info.Time)
+	require.Equal(t, int64(3), info.Logical)
+
+	info = execute(newCmdToTimeTso(), "to-time", "434782405638406146", "--tz=UTC")
+	require.Equal(t, uint64(434782405638406146), info.TSO)
+	require.Equal(t, oracle.GetTimeFromTS(434782405638406146).UTC().Format(tsoTimeFormat),
+		info.Time)
+
+	// the time converted back from the output of to-time.
+	info = execute(newCmdFromTimeTso(), "from-time", "2023-06-01 18:00:00.123 +0800")
+	require.Equal(t, oracle.GoTimeToTS(physical), info.TSO)
+	info = execute(newCmdFromTimeTso(), "from-time", "2023-06-01 10:00:00.123", "--tz=UTC")
+	require.Equal(t, oracle.GoTimeToTS(physical), info.TSO)
+}
+
+func TestParseTsoTime(t *testing.T) {
+	t.Parallel()
+
+	expected := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	for _, s := range []string{
+		"2023-06-01 10:00:00 +0000",
+		"2023-06-01 18:00:00.000 +0800",
+		"2023-06-01T18:00:00+08:00",
+		"2023-06-01 10:00:00",
+		" 2023-06-01 10:00:00.0 ",
+	} {
+		tm, err := parseTsoTime(s, time.UTC)
+		require.Nil(t, err, s)
+		require.True(t, expected.Equal(tm), s)
+	}
+	tm, err := parseTsoTime("2023-06-01", time.FixedZone("", 3600))
+	require.Nil(t, err)
+	require.True(t, expected.Add(-10*time.Hour-time.Hour).Equal(tm))
+
+	_, err = parseTsoTime("2023/06/01", time.UTC)
+	require.ErrorContains(t, err, "invalid time")
+}
+
+func TestTimeToTso(t *testing.T) {
+	t.Parallel()
+
+	tm := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	ts, err := timeToTso(tm)
+	require.Nil(t, err)
+	require.Equal(t, oracle.GoTimeToTS(tm), ts)
+	ts, err = timeToTso(time.UnixMilli(0))
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), ts)
+
+	// the time before the epoch must not wrap around.
+	_, err = timeToTso(time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC))
+	require.ErrorContains(t, err, "invalid time")
+	_, err = timeToTso(time.UnixMilli(maxTsoPhysical + 1))
+	require.ErrorContains(t, err, "invalid time")
+}
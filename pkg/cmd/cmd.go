@@ -19,7 +19,9 @@ import (
 	"github.com/pingcap/tiflow/pkg/cmd/cli"
 	"github.com/pingcap/tiflow/pkg/cmd/redo"
 	"github.com/pingcap/tiflow/pkg/cmd/server"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
 	"github.com/pingcap/tiflow/pkg/cmd/version"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -47,8 +49,13 @@ func Run() {
 	cmd.AddCommand(version.NewCmdVersion())
 	cmd.AddCommand(redo.NewCmdRedo())
 
+	// Classify the invalid flags of all commands by the exit code.
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return cerror.ErrCliInvalidArgument.GenWithStackByArgs(err.Error())
+	})
+
 	if err := cmd.Execute(); err != nil {
 		cmd.PrintErrln(err)
-		os.Exit(1)
+		os.Exit(util.ExitCode(err))
 	}
 }
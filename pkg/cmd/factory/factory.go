@@ -41,6 +41,7 @@ type ClientGetter interface {
 	GetServerAddr() string
 	GetLogLevel() string
 	GetCredential() *security.Credential
+	GetAuthToken() string
 }
 
 // ClientFlags specifies the parameters needed to construct the client.
@@ -51,6 +52,7 @@ type ClientFlags struct {
 	caPath     string
 	certPath   string
 	keyPath    string
+	authToken  string
 }
 
 var _ ClientGetter = &ClientFlags{}
@@ -91,6 +93,11 @@ func (c *ClientFlags) GetServerAddr() string {
 	return c.serverAddr
 }
 
+// GetAuthToken returns the token for authenticating to the cdc server.
+func (c *ClientFlags) GetAuthToken() string {
+	return c.authToken
+}
+
 // NewClientFlags creates new client flags.
 func NewClientFlags() *ClientFlags {
 	return &ClientFlags{}
@@ -110,6 +117,8 @@ func (c *ClientFlags) AddFlags(cmd *cobra.Command) {
 		"Certificate path for TLS connection to CDC server")
 	cmd.PersistentFlags().StringVar(&c.keyPath, "key", "",
 		"Private key path for TLS connection to CDC server")
+	cmd.PersistentFlags().StringVar(&c.authToken, "auth-token", "",
+		"API token or OIDC ID token for authenticating to CDC server")
 	cmd.PersistentFlags().StringVar(&c.logLevel, "log-level", "warn",
 		"log level (etc: debug|info|warn|error)")
 }
@@ -69,6 +69,20 @@ func (mr *MockFactoryMockRecorder) EtcdClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EtcdClient", reflect.TypeOf((*MockFactory)(nil).EtcdClient))
 }
 
+// GetAuthToken mocks base method.
+func (m *MockFactory) GetAuthToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetAuthToken indicates an expected call of GetAuthToken.
+func (mr *MockFactoryMockRecorder) GetAuthToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthToken", reflect.TypeOf((*MockFactory)(nil).GetAuthToken))
+}
+
 // GetCredential mocks base method.
 func (m *MockFactory) GetCredential() *security.Credential {
 	m.ctrl.T.Helper()
@@ -193,6 +207,20 @@ func (m *MockClientGetter) EXPECT() *MockClientGetterMockRecorder {
 	return m.recorder
 }
 
+// GetAuthToken mocks base method.
+func (m *MockClientGetter) GetAuthToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetAuthToken indicates an expected call of GetAuthToken.
+func (mr *MockClientGetterMockRecorder) GetAuthToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthToken", reflect.TypeOf((*MockClientGetter)(nil).GetAuthToken))
+}
+
 // GetCredential mocks base method.
 func (m *MockClientGetter) GetCredential() *security.Credential {
 	m.ctrl.T.Helper()
@@ -14,18 +14,20 @@
 package redo
 
 import (
-	"net/url"
+	"github.com/pingcap/errors"
 
 	"github.com/pingcap/tiflow/pkg/applier"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
-	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 // applyRedoOptions defines flags for the `redo apply` command.
 type applyRedoOptions struct {
 	options
-	sinkURI string
+	sinkURI           string
+	encryptionKeyFile string
+	progressFile      string
+	dryRun            bool
 }
 
 // newapplyRedoOptions creates new applyRedoOptions for the `redo apply` command.
@@ -37,24 +39,25 @@ func newapplyRedoOptions() *applyRedoOptions {
 // flags related to template printing to it.
 func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target database sink-uri")
-	// the possible error returned from MarkFlagRequired is `no such flag`
-	cmd.MarkFlagRequired("sink-uri") //nolint:errcheck
+	cmd.Flags().StringVar(&o.encryptionKeyFile, "encryption-key-file", "", "file containing the keys to decrypt the redo logs, required if the redo logs are encrypted")
+	cmd.Flags().StringVar(&o.progressFile, "progress-file", "", "file to persist the apply progress, an interrupted apply resumes from it when the command is re-run")
+	cmd.Flags().BoolVar(&o.dryRun, "dry-run", false, "read the redo logs and report the statistics without touching the downstream")
 }
 
 //nolint:unparam
 func (o *applyRedoOptions) complete(cmd *cobra.Command) error {
-	// parse sinkURI as a URI
-	sinkURI, err := url.Parse(o.sinkURI)
-	if err != nil {
-		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
+	if o.dryRun {
+		return nil
+	}
+	if o.sinkURI == "" {
+		return errors.New("sink-uri is required unless dry-run is enabled")
 	}
-	rawQuery := sinkURI.Query()
 	// set safe-mode to true if not set
-	if rawQuery.Get("safe-mode") != "true" {
-		rawQuery.Set("safe-mode", "true")
-		sinkURI.RawQuery = rawQuery.Encode()
-		o.sinkURI = sinkURI.String()
+	sinkURI, err := applier.EnableSafeMode(o.sinkURI)
+	if err != nil {
+		return err
 	}
+	o.sinkURI = sinkURI
 	return nil
 }
 
@@ -63,11 +66,21 @@ func (o *applyRedoOptions) run(cmd *cobra.Command) error {
 	ctx := cmdcontext.GetDefaultContext()
 
 	cfg := &applier.RedoApplierConfig{
-		Storage: o.storage,
-		SinkURI: o.sinkURI,
-		Dir:     o.dir,
+		Storage:           o.storage,
+		SinkURI:           o.sinkURI,
+		Dir:               o.dir,
+		EncryptionKeyFile: o.encryptionKeyFile,
+		ProgressFile:      o.progressFile,
 	}
 	ap := applier.NewRedoApplier(cfg)
+	if o.dryRun {
+		stats, err := ap.DryRun(ctx)
+		if err != nil {
+			return err
+		}
+		printDryRunStats(cmd, stats)
+		return nil
+	}
 	err := ap.Apply(ctx)
 	if err != nil {
 		return err
@@ -76,6 +89,17 @@ func (o *applyRedoOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+func printDryRunStats(cmd *cobra.Command, stats *applier.DryRunStats) {
+	cmd.Printf("checkpoint-ts:%d, resolved-ts:%d, rows:%d, ddls:%d, tables:%d\n",
+		stats.CheckpointTs, stats.ResolvedTs, stats.RowCount, stats.DDLCount, len(stats.Tables))
+	for _, t := range stats.Tables {
+		cmd.Printf("%s.%s table-id:%d, rows:%d, bytes:%d, commit-ts:[%d, %d]\n",
+			t.Schema, t.Table, t.TableID, t.RowCount, t.Bytes, t.MinCommitTs, t.MaxCommitTs)
+	}
+	cmd.Printf("Dry run redo log successfully, the estimated apply time is %s\n",
+		stats.EstimatedApplyTime)
+}
+
 // newCmdApply creates the `redo apply` command.
 func newCmdApply(opt *options) *cobra.Command {
 	o := newapplyRedoOptions()
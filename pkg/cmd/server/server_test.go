@@ -165,6 +165,19 @@ func TestParseCfg(t *testing.T) {
 			WorkerPoolSize:      0,
 			RegionScanLimit:     40,
 			RegionRetryDuration: config.TomlDuration(time.Minute),
+
+			GrpcConnectionCount:      2,
+			GrpcStreamsPerConnection: 1000,
+			StreamsPerStore:          1,
+			GrpcStreamWindowSize:     65535,
+			GrpcConnectionWindowSize: 8388608,
+
+			MaxWorkerConcurrent:     32,
+			WorkerScaleCPUThreshold: 0.8,
+
+			DDLGrpcConnectionCount: 1,
+			DDLGrpcWindowSize:      1048576,
+			DDLPullerMemoryQuota:   64 * 1024 * 1024,
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -175,9 +188,11 @@ func TestParseCfg(t *testing.T) {
 				WriterBufferSize:            8388608,
 				Compression:                 "snappy",
 				WriteL0PauseTrigger:         math.MaxInt32,
+				MemTableStopWritesThreshold: 4,
 				CompactionL0Trigger:         160,
 				CompactionDeletionThreshold: 10485760,
 				CompactionPeriod:            1800,
+				MaxConcurrentCompactions:    6,
 				IteratorMaxAliveDuration:    10000,
 				IteratorSlowReadDuration:    256,
 			},
@@ -204,6 +219,15 @@ func TestParseCfg(t *testing.T) {
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		AdaptiveMemoryQuota: &config.AdaptiveMemoryQuotaConfig{
+			Enable:         false,
+			MinRatio:       0.25,
+			MaxRatio:       4,
+			AdjustInterval: config.TomlDuration(5 * time.Second),
+		},
+		Auth:      config.NewDefaultAuthConfig(),
+		Audit:     config.NewDefaultAuditConfig(),
+		RateLimit: config.NewDefaultRateLimitConfig(),
 	}, o.serverConfig)
 }
 
@@ -235,9 +259,19 @@ max-backups = 1
 sort-dir = "/tmp/just_a_test"
 cache-size-in-mb = 8
 max-memory-percentage = 3
+spill-threshold = 0.5
+spill-compression = "none"
+max-disk-usage-per-changefeed-in-mb = 1024
+encryption-key-file = "/tmp/sorter.key"
+enable-backpressure = true
 
 [kv-client]
 region-retry-duration = "3s"
+streams-per-store = 4
+max-worker-concurrent = 16
+worker-scale-cpu-threshold = 0.7
+enable-store-resolved-ts = true
+ddl-grpc-connection-count = 2
 
 [debug]
 [debug.db]
@@ -254,6 +288,8 @@ compaction-deletion-threshold = 15
 compaction-period = 16
 write-l0-slowdown-trigger = 12
 write-l0-pause-trigger = 13
+memtable-stop-writes-threshold = 17
+max-concurrent-compactions = 18
 
 [debug.messages]
 client-max-batch-interval = "500ms"
@@ -269,6 +305,37 @@ heartbeat-tick = 3
 collect-stats-tick = 201
 max-task-concurrency = 11
 check-balance-interval = "10s"
+
+[adaptive-memory-quota]
+enable = true
+min-ratio = 0.5
+max-ratio = 2
+adjust-interval = "10s"
+
+[auth]
+token-ttl = "720h"
+
+[auth.oidc]
+issuer = "https://example.com"
+client-id = "ticdc"
+
+[audit]
+enable = true
+filename = "/tmp/cdc-audit.log"
+max-size = 100
+max-days = 90
+compress = true
+buffer-size = 500
+
+[rate-limit]
+enable = true
+global-qps = 50
+client-qps = 5
+client-burst = 10
+
+[labels]
+zone = "z1"
+host = "h1"
 `, dataDir)
 	err := os.WriteFile(configPath, []byte(configContent), 0o644)
 	require.Nil(t, err)
@@ -303,9 +370,14 @@ check-balance-interval = "10s"
 		OwnerFlushInterval:     config.TomlDuration(600 * time.Millisecond),
 		ProcessorFlushInterval: config.TomlDuration(600 * time.Millisecond),
 		Sorter: &config.SorterConfig{
-			SortDir:             config.DefaultSortDir,
-			CacheSizeInMB:       8,
-			MaxMemoryPercentage: 3,
+			SortDir:                       config.DefaultSortDir,
+			CacheSizeInMB:                 8,
+			MaxMemoryPercentage:           3,
+			SpillThreshold:                0.5,
+			SpillCompression:              "none",
+			MaxDiskUsagePerChangefeedInMB: 1024,
+			EncryptionKeyFile:             "/tmp/sorter.key",
+			EnableBackpressure:            true,
 		},
 		Security: &config.SecurityConfig{},
 		KVClient: &config.KVClientConfig{
@@ -313,6 +385,21 @@ check-balance-interval = "10s"
 			WorkerPoolSize:      0,
 			RegionScanLimit:     40,
 			RegionRetryDuration: config.TomlDuration(3 * time.Second),
+
+			GrpcConnectionCount:      2,
+			GrpcStreamsPerConnection: 1000,
+			StreamsPerStore:          4,
+			GrpcStreamWindowSize:     65535,
+			GrpcConnectionWindowSize: 8388608,
+
+			MaxWorkerConcurrent:     16,
+			WorkerScaleCPUThreshold: 0.7,
+
+			EnableStoreResolvedTs: true,
+
+			DDLGrpcConnectionCount: 2,
+			DDLGrpcWindowSize:      1048576,
+			DDLPullerMemoryQuota:   64 * 1024 * 1024,
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -328,6 +415,8 @@ check-balance-interval = "10s"
 				IteratorSlowReadDuration:    256,
 				CompactionDeletionThreshold: 15,
 				CompactionPeriod:            16,
+				MemTableStopWritesThreshold: 17,
+				MaxConcurrentCompactions:    18,
 			},
 			Messages: &config.MessagesConfig{
 				ClientMaxBatchInterval:       config.TomlDuration(500 * time.Millisecond),
@@ -351,6 +440,38 @@ check-balance-interval = "10s"
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		AdaptiveMemoryQuota: &config.AdaptiveMemoryQuotaConfig{
+			Enable:         true,
+			MinRatio:       0.5,
+			MaxRatio:       2,
+			AdjustInterval: config.TomlDuration(10 * time.Second),
+		},
+		Auth: &config.AuthConfig{
+			TokenTTL: config.TomlDuration(720 * time.Hour),
+			OIDC: &config.OIDCConfig{
+				Issuer:     "https://example.com",
+				ClientID:   "ticdc",
+				RolesClaim: "roles",
+			},
+		},
+		Audit: &config.AuditConfig{
+			Enable:     true,
+			Filename:   "/tmp/cdc-audit.log",
+			MaxSize:    100,
+			MaxDays:    90,
+			MaxBackups: 0,
+			Compress:   true,
+			BufferSize: 500,
+		},
+		RateLimit: &config.RateLimitConfig{
+			Enable:      true,
+			GlobalQPS:   50,
+			GlobalBurst: 51,
+			ClientQPS:   5,
+			ClientBurst: 10,
+			MaxClients:  10000,
+		},
+		Labels: map[string]string{"zone": "z1", "host": "h1"},
 	}, o.serverConfig)
 }
 
@@ -447,6 +568,19 @@ cert-allowed-cn = ["dd","ee"]
 			WorkerPoolSize:      0,
 			RegionScanLimit:     40,
 			RegionRetryDuration: config.TomlDuration(time.Minute),
+
+			GrpcConnectionCount:      2,
+			GrpcStreamsPerConnection: 1000,
+			StreamsPerStore:          1,
+			GrpcStreamWindowSize:     65535,
+			GrpcConnectionWindowSize: 8388608,
+
+			MaxWorkerConcurrent:     32,
+			WorkerScaleCPUThreshold: 0.8,
+
+			DDLGrpcConnectionCount: 1,
+			DDLGrpcWindowSize:      1048576,
+			DDLPullerMemoryQuota:   64 * 1024 * 1024,
 		},
 		Debug: &config.DebugConfig{
 			DB: &config.DBConfig{
@@ -457,9 +591,11 @@ cert-allowed-cn = ["dd","ee"]
 				WriterBufferSize:            8388608,
 				Compression:                 "snappy",
 				WriteL0PauseTrigger:         math.MaxInt32,
+				MemTableStopWritesThreshold: 4,
 				CompactionL0Trigger:         160,
 				CompactionDeletionThreshold: 10485760,
 				CompactionPeriod:            1800,
+				MaxConcurrentCompactions:    6,
 				IteratorMaxAliveDuration:    10000,
 				IteratorSlowReadDuration:    256,
 			},
@@ -486,6 +622,15 @@ cert-allowed-cn = ["dd","ee"]
 		},
 		ClusterID:           "default",
 		MaxMemoryPercentage: config.DefaultMaxMemoryPercentage,
+		AdaptiveMemoryQuota: &config.AdaptiveMemoryQuotaConfig{
+			Enable:         false,
+			MinRatio:       0.25,
+			MaxRatio:       4,
+			AdjustInterval: config.TomlDuration(5 * time.Second),
+		},
+		Auth:      config.NewDefaultAuthConfig(),
+		Audit:     config.NewDefaultAuditConfig(),
+		RateLimit: config.NewDefaultRateLimitConfig(),
 	}, o.serverConfig)
 }
 
@@ -520,9 +665,11 @@ unknown3 = 3
 			WriterBufferSize:            8388608,
 			Compression:                 "snappy",
 			WriteL0PauseTrigger:         math.MaxInt32,
+			MemTableStopWritesThreshold: 4,
 			CompactionL0Trigger:         160,
 			CompactionDeletionThreshold: 10485760,
 			CompactionPeriod:            1800,
+			MaxConcurrentCompactions:    6,
 			IteratorMaxAliveDuration:    10000,
 			IteratorSlowReadDuration:    256,
 		},
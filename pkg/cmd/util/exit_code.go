@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// The exit codes of the commands, the scripts can tell the classes of the
+// failures apart by them.
+const (
+	// ExitCodeError is the exit code of the failures not classified below.
+	ExitCodeError = 1
+	// ExitCodeInvalidArgument means the flags or the arguments are invalid.
+	ExitCodeInvalidArgument = 2
+	// ExitCodeNotFound means the changefeed or the capture doesn't exist.
+	ExitCodeNotFound = 3
+	// ExitCodeUnavailable means the server is unreachable or not ready.
+	ExitCodeUnavailable = 4
+	// ExitCodeWaitTimeout means the changefeed doesn't reach the state
+	// waited for before the timeout.
+	ExitCodeWaitTimeout = 5
+	// ExitCodeWaitUnreachable means the changefeed reaches a state from
+	// which the state waited for can't be reached, e.g. failed.
+	ExitCodeWaitUnreachable = 6
+)
+
+// exitCodeErrors are the errors of each failure class, the errors returned
+// by the server are matched by their RFC codes in the messages.
+var exitCodeErrors = []struct {
+	code int
+	errs []*errors.Error
+}{
+	{ExitCodeInvalidArgument, []*errors.Error{
+		cerror.ErrCliInvalidArgument,
+		cerror.ErrCliInvalidCheckpointTs,
+		cerror.ErrCliCheckpointTsIsInFuture,
+		cerror.ErrAPIInvalidParam,
+	}},
+	{ExitCodeNotFound, []*errors.Error{
+		cerror.ErrChangeFeedNotExists,
+		cerror.ErrCaptureNotExist,
+	}},
+	{ExitCodeUnavailable, []*errors.Error{
+		cerror.ErrServerIsNotReady,
+		cerror.ErrClusterIsUnhealthy,
+		cerror.ErrOwnerNotFound,
+	}},
+	{ExitCodeWaitTimeout, []*errors.Error{cerror.ErrCliWaitTimeout}},
+	{ExitCodeWaitUnreachable, []*errors.Error{cerror.ErrCliWaitConditionUnreachable}},
+}
+
+// ExitCode returns the exit code of the class of err.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	msg := err.Error()
+	for _, class := range exitCodeErrors {
+		for _, e := range class.errs {
+			if strings.Contains(msg, string(e.RFCCode())) {
+				return class.code
+			}
+		}
+	}
+	// The server can't be connected.
+	if _, ok := errors.Cause(err).(net.Error); ok {
+		return ExitCodeUnavailable
+	}
+	return ExitCodeError
+}
+
+// exitWithCode prints err and exits the process with the exit code of the
+// class of err, it does nothing if err is nil.
+func exitWithCode(err error) {
+	if err == nil {
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Error:", err)
+	os.Exit(ExitCode(err))
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{nil, 0},
+		{errors.New("unknown error"), ExitCodeError},
+		{cerror.ErrCliInvalidArgument.GenWithStackByArgs("unknown flag: --foo"), ExitCodeInvalidArgument},
+		// The errors returned by the server lose the types but keep the
+		// RFC codes in the messages.
+		{errors.New("[CDC:ErrChangeFeedNotExists]changefeed not exists, key: test"), ExitCodeNotFound},
+		{errors.New("[CDC:ErrServerIsNotReady]cdc server is not ready"), ExitCodeUnavailable},
+		{errors.Trace(&net.OpError{Op: "dial", Err: errors.New("connection refused")}), ExitCodeUnavailable},
+		{cerror.ErrCliWaitTimeout.GenWithStackByArgs("test", "state=normal", "5m0s", "stopped"), ExitCodeWaitTimeout},
+		{cerror.ErrCliWaitConditionUnreachable.GenWithStackByArgs("test", "state=normal", "failed"), ExitCodeWaitUnreachable},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.code, ExitCode(c.err), "%v", c.err)
+	}
+}
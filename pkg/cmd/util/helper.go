@@ -16,9 +16,11 @@ package util
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/url"
 	"os"
 	"os/signal"
+	"regexp"
 	"strings"
 	"syscall"
 
@@ -122,7 +124,44 @@ func StrictDecodeFile(path, component string, cfg interface{}, ignoreCheckItems
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return checkUndecoded(metaData, path, component, ignoreCheckItems)
+}
+
+// StdinPath is the path to read a file from the stdin.
+const StdinPath = "-"
 
+// StrictDecodeFileWithEnv decodes the toml file strictly like StrictDecodeFile,
+// the file is read from stdin if the path is StdinPath, and the references to
+// the environment variables in it are expanded by ExpandEnv before decoding.
+func StrictDecodeFileWithEnv(
+	path string, stdin io.Reader, component string, cfg interface{}, ignoreCheckItems ...string,
+) error {
+	var data []byte
+	var err error
+	if path == StdinPath {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	content, err := ExpandEnv(string(data))
+	if err != nil {
+		return errors.Annotatef(err, "component %s's config file %s", component, path)
+	}
+	metaData, err := toml.Decode(content, cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return checkUndecoded(metaData, path, component, ignoreCheckItems)
+}
+
+// checkUndecoded returns an error if any item in the config file is not
+// mapped into the Config struct, except the ignoreCheckItems.
+func checkUndecoded(
+	metaData toml.MetaData, path, component string, ignoreCheckItems []string,
+) error {
 	// check if item is a ignoreCheckItem
 	hasIgnoreItem := func(item []string) bool {
 		for _, ignoreCheckItem := range ignoreCheckItems {
@@ -133,6 +172,7 @@ func StrictDecodeFile(path, component string, cfg interface{}, ignoreCheckItems
 		return false
 	}
 
+	var err error
 	if undecoded := metaData.Undecoded(); len(undecoded) > 0 {
 		var b strings.Builder
 		hasUnknownConfigSize := 0
@@ -155,6 +195,33 @@ func StrictDecodeFile(path, component string, cfg interface{}, ignoreCheckItems
 	return errors.Trace(err)
 }
 
+// envReferenceRegexp matches the ${NAME} references to the environment
+// variables and the escaped `$${`.
+var envReferenceRegexp = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ExpandEnv replaces the ${NAME} references in s with the values of the
+// environment variables as they are, and `$${` is replaced with a literal
+// `${`. An error is returned if any referenced variable is not set.
+func ExpandEnv(s string) (string, error) {
+	var missing []string
+	expanded := envReferenceRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		if ref == "$${" {
+			return "${"
+		}
+		name := ref[2 : len(ref)-1]
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", errors.Errorf("environment variables %s are not set",
+			strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
 // VerifyPdEndpoint verifies whether the pd endpoint is a valid http or https URL.
 // The certificate is required when using https.
 func VerifyPdEndpoint(pdEndpoint string, useTLS bool) error {
@@ -188,10 +255,41 @@ func JSONPrint(cmd *cobra.Command, v interface{}) error {
 	return nil
 }
 
+// checkErrHandler handles the errors checked by CheckErr, it prints the error
+// and exits the process with the exit code of the error by default.
+var checkErrHandler = exitWithCode
+
 // CheckErr is used to cmd err.
 func CheckErr(err error) {
 	if cerror.IsCliUnprintableError(err) {
 		err = nil
 	}
-	cobra.CheckErr(err)
+	checkErrHandler(err)
+}
+
+// checkedError wraps an error checked by CheckErr in RunWithoutExit.
+type checkedError struct {
+	err error
+}
+
+// RunWithoutExit runs fn and returns the error checked by CheckErr in fn
+// instead of exiting the process, it's used to run multiple commands in a
+// process. It must not be called concurrently.
+func RunWithoutExit(fn func() error) (err error) {
+	checkErrHandler = func(err error) {
+		if err != nil {
+			panic(checkedError{err: err})
+		}
+	}
+	defer func() {
+		checkErrHandler = exitWithCode
+		if r := recover(); r != nil {
+			checked, ok := r.(checkedError)
+			if !ok {
+				panic(r)
+			}
+			err = checked.err
+		}
+	}()
+	return fn()
 }
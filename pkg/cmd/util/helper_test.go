@@ -171,6 +171,48 @@ func TestStrictDecodeInvalidFile(t *testing.T) {
 	require.Contains(t, err.Error(), "contained unknown configuration options")
 }
 
+func TestStrictDecodeFileWithEnv(t *testing.T) {
+	t.Setenv("TEST_SINK_PASSWORD", "p@ss")
+
+	content := `
+[sink.kafka-config]
+sasl-password = "${TEST_SINK_PASSWORD}"
+sasl-user = "$${TEST_SINK_USER}"
+`
+	cfg := config.GetDefaultReplicaConfig()
+	err := StrictDecodeFileWithEnv(StdinPath, bytes.NewBufferString(content), "cdc", cfg)
+	require.Nil(t, err)
+	require.Equal(t, "p@ss", *cfg.Sink.KafkaConfig.SASLPassword)
+	require.Equal(t, "${TEST_SINK_USER}", *cfg.Sink.KafkaConfig.SASLUser)
+
+	path := filepath.Join(t.TempDir(), "changefeed.toml")
+	require.Nil(t, os.WriteFile(path, []byte("unknown = 1\n"+content), 0o644))
+	err = StrictDecodeFileWithEnv(path, nil, "cdc", config.GetDefaultReplicaConfig())
+	require.ErrorContains(t, err, "contained unknown configuration options: unknown")
+
+	err = StrictDecodeFileWithEnv(StdinPath,
+		bytes.NewBufferString(`case-sensitive = ${TEST_NOT_SET_ENV}`), "cdc", cfg)
+	require.ErrorContains(t, err, "environment variables TEST_NOT_SET_ENV are not set")
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("TEST_ENV_A", "a")
+	t.Setenv("TEST_ENV_EMPTY", "")
+
+	for s, expected := range map[string]string{
+		"":                               "",
+		"kafka://${TEST_ENV_A}:9092":     "kafka://a:9092",
+		"${TEST_ENV_A}${TEST_ENV_EMPTY}": "a",
+		"$TEST_ENV_A $${TEST_ENV_A} ${}": "$TEST_ENV_A ${TEST_ENV_A} ${}",
+	} {
+		expanded, err := ExpandEnv(s)
+		require.Nil(t, err, s)
+		require.Equal(t, expected, expanded, s)
+	}
+	_, err := ExpandEnv("${TEST_ENV_A}${TEST_NOT_SET_ENV_1}${TEST_NOT_SET_ENV_2}")
+	require.ErrorContains(t, err, "TEST_NOT_SET_ENV_1, TEST_NOT_SET_ENV_2 are not set")
+}
+
 func TestAndWriteExampleReplicaTOML(t *testing.T) {
 	cfg := config.GetDefaultReplicaConfig()
 	err := StrictDecodeFile("changefeed.toml", "cdc", &cfg)
@@ -277,6 +319,26 @@ func TestJSONPrint(t *testing.T) {
 	require.Equal(t, output, b.String())
 }
 
+func TestRunWithoutExit(t *testing.T) {
+	err := RunWithoutExit(func() error {
+		CheckErr(nil)
+		CheckErr(fmt.Errorf("checked"))
+		return fmt.Errorf("unreachable")
+	})
+	require.EqualError(t, err, "checked")
+
+	err = RunWithoutExit(func() error {
+		return fmt.Errorf("returned")
+	})
+	require.EqualError(t, err, "returned")
+
+	require.Panics(t, func() {
+		_ = RunWithoutExit(func() error {
+			panic("test")
+		})
+	})
+}
+
 func TestIgnoreStrictCheckItem(t *testing.T) {
 	dataDir := t.TempDir()
 	tmpDir := t.TempDir()
@@ -0,0 +1,283 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pingcap/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OutputFormatJSON prints the results in JSON.
+	OutputFormatJSON = "json"
+	// OutputFormatYAML prints the results in YAML.
+	OutputFormatYAML = "yaml"
+	// OutputFormatTable prints the results in a table.
+	OutputFormatTable = "table"
+
+	outputFlag = "output"
+)
+
+// AddOutputFlag binds the flag of the output format to cmd and its subcommands.
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(outputFlag, "",
+		"Output format of the results (json|yaml|table). The fields of json and "+
+			"yaml are stable across releases, the results are printed in json "+
+			"if it's not specified")
+}
+
+// GetOutputFormat returns the output format of cmd, an empty string is returned
+// if it's not specified.
+func GetOutputFormat(cmd *cobra.Command) string {
+	flag := cmd.Root().PersistentFlags().Lookup(outputFlag)
+	if flag == nil {
+		return ""
+	}
+	return flag.Value.String()
+}
+
+// ValidateOutputFormat checks whether the output format is supported.
+func ValidateOutputFormat(format string) error {
+	switch format {
+	case "", OutputFormatJSON, OutputFormatYAML, OutputFormatTable:
+		return nil
+	}
+	return errors.Errorf("invalid output format %q, it must be one of json|yaml|table", format)
+}
+
+// PrintOutput outputs the data in the output format of cmd.
+func PrintOutput(cmd *cobra.Command, v interface{}) error {
+	switch format := GetOutputFormat(cmd); format {
+	case "", OutputFormatJSON:
+		return JSONPrint(cmd, v)
+	case OutputFormatYAML:
+		return yamlPrint(cmd, v)
+	case OutputFormatTable:
+		return tablePrint(cmd, v)
+	default:
+		return ValidateOutputFormat(format)
+	}
+}
+
+func yamlPrint(cmd *cobra.Command, v interface{}) error {
+	// The yaml is converted from the json, so that the fields are the same.
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Printf("%s", data)
+	return nil
+}
+
+// tablePrint outputs a list of objects as rows and an object as field-value
+// pairs, the nested objects of an object are flattened.
+func tablePrint(cmd *cobra.Command, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Keep the precision of the timestamps.
+	dec.UseNumber()
+	value, err := decodeOrdered(dec)
+	if err != nil {
+		return err
+	}
+
+	var header []string
+	var rows [][]string
+	switch value := value.(type) {
+	case []interface{}:
+		header, rows = listToTable(value)
+	case orderedObject:
+		header = []string{"FIELD", "VALUE"}
+		for _, field := range value.flatten("") {
+			rows = append(rows, []string{field.key, formatTableCell(field.value)})
+		}
+	default:
+		cmd.Println(formatTableCell(value))
+		return nil
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Print(buf.String())
+	return nil
+}
+
+// listToTable returns the table of a list, the columns are the fields of the
+// objects in the list, or the values themselves if they are not objects.
+func listToTable(list []interface{}) ([]string, [][]string) {
+	var columns []string
+	index := make(map[string]int)
+	for _, item := range list {
+		obj, ok := item.(orderedObject)
+		if !ok {
+			rows := make([][]string, 0, len(list))
+			for _, item := range list {
+				rows = append(rows, []string{formatTableCell(item)})
+			}
+			return []string{"VALUE"}, rows
+		}
+		for _, field := range obj {
+			if _, ok := index[field.key]; !ok {
+				index[field.key] = len(columns)
+				columns = append(columns, field.key)
+			}
+		}
+	}
+
+	rows := make([][]string, 0, len(list))
+	for _, item := range list {
+		row := make([]string, len(columns))
+		for _, field := range item.(orderedObject) {
+			row[index[field.key]] = formatTableCell(field.value)
+		}
+		rows = append(rows, row)
+	}
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, strings.ToUpper(column))
+	}
+	return header, rows
+}
+
+func formatTableCell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+type orderedField struct {
+	key   string
+	value interface{}
+}
+
+// orderedObject is a json object which keeps the order of its fields.
+type orderedObject []orderedField
+
+// MarshalJSON implements json.Marshaler.
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// flatten returns the fields of the object and its nested objects, the keys
+// of the nested fields are joined by dots.
+func (o orderedObject) flatten(prefix string) []orderedField {
+	var fields []orderedField
+	for _, field := range o {
+		key := field.key
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := field.value.(orderedObject); ok && len(nested) > 0 {
+			fields = append(fields, nested.flatten(key)...)
+			continue
+		}
+		fields = append(fields, orderedField{key: key, value: field.value})
+	}
+	return fields
+}
+
+// decodeOrdered decodes a json value, the objects are decoded as orderedObject.
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch token {
+	case json.Delim('{'):
+		obj := orderedObject{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, orderedField{key: key.(string), value: value})
+		}
+		// Consume the closing delimiter.
+		if _, err := dec.Token(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return obj, nil
+	case json.Delim('['):
+		list := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return list, nil
+	default:
+		return token, nil
+	}
+}
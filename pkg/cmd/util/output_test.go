@@ -0,0 +1,103 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintOutput(t *testing.T) {
+	type nested struct {
+		A int             `json:"a"`
+		B map[string]bool `json:"b"`
+	}
+	type item struct {
+		ID     string   `json:"id"`
+		Ts     uint64   `json:"ts"`
+		Error  *string  `json:"error,omitempty"`
+		Config *nested  `json:"config,omitempty"`
+		Tables []string `json:"tables,omitempty"`
+	}
+	errMsg := "failed"
+	items := []item{
+		{ID: "a", Ts: 434782405638406146},
+		{ID: "bbb", Ts: 1, Error: &errMsg},
+	}
+	obj := item{
+		ID:     "a",
+		Ts:     434782405638406146,
+		Config: &nested{A: 1, B: map[string]bool{"c": true}},
+		Tables: []string{"t1", "t2"},
+	}
+
+	var b bytes.Buffer
+	root := &cobra.Command{Use: "root"}
+	AddOutputFlag(root)
+	cmd := &cobra.Command{Use: "cmd"}
+	cmd.SetOut(&b)
+	root.AddCommand(cmd)
+	lines := func() [][]string {
+		var res [][]string
+		for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+			res = append(res, strings.Fields(line))
+		}
+		b.Reset()
+		return res
+	}
+
+	// json by default
+	require.Nil(t, PrintOutput(cmd, items[0]))
+	require.Equal(t, "{\n  \"id\": \"a\",\n  \"ts\": 434782405638406146\n}\n", b.String())
+	b.Reset()
+
+	require.Nil(t, root.PersistentFlags().Set(outputFlag, OutputFormatYAML))
+	require.Nil(t, PrintOutput(cmd, items))
+	require.Equal(t, "- id: a\n  ts: 434782405638406146\n- error: failed\n  id: bbb\n  ts: 1\n",
+		b.String())
+	b.Reset()
+
+	require.Nil(t, root.PersistentFlags().Set(outputFlag, OutputFormatTable))
+	require.Nil(t, PrintOutput(cmd, items))
+	require.Equal(t, [][]string{
+		{"ID", "TS", "ERROR"},
+		{"a", "434782405638406146"},
+		{"bbb", "1", "failed"},
+	}, lines())
+	require.Nil(t, PrintOutput(cmd, obj))
+	require.Equal(t, [][]string{
+		{"FIELD", "VALUE"},
+		{"id", "a"},
+		{"ts", "434782405638406146"},
+		{"config.a", "1"},
+		{"config.b.c", "true"},
+		{"tables", `["t1","t2"]`},
+	}, lines())
+	require.Nil(t, PrintOutput(cmd, []string{"x", "y"}))
+	require.Equal(t, [][]string{{"VALUE"}, {"x"}, {"y"}}, lines())
+	require.Nil(t, PrintOutput(cmd, []item{}))
+	require.Equal(t, "", b.String())
+
+	require.Nil(t, root.PersistentFlags().Set(outputFlag, "xml"))
+	require.Error(t, PrintOutput(cmd, obj))
+	require.Error(t, ValidateOutputFormat("xml"))
+	require.Nil(t, ValidateOutputFormat(""))
+
+	// the output format is not specified if the flag isn't bound.
+	require.Equal(t, "", GetOutputFormat(&cobra.Command{}))
+}
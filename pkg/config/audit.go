@@ -0,0 +1,70 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// AuditConfig represents config for the audit log of the HTTP API, which
+// records every mutating API call.
+type AuditConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// Filename is the file the audit log is written to, it must be set if
+	// the audit log is enabled.
+	Filename string `toml:"filename" json:"filename"`
+	// MaxSize is the max size in MB of an audit log file before it's rotated.
+	MaxSize int `toml:"max-size" json:"max-size"`
+	// MaxDays is the max days to retain the rotated audit log files, 0 means
+	// they are never removed by age.
+	MaxDays int `toml:"max-days" json:"max-days"`
+	// MaxBackups is the max number of the rotated audit log files to retain,
+	// 0 means all files are retained.
+	MaxBackups int `toml:"max-backups" json:"max-backups"`
+	// Compress compresses the rotated audit log files by gzip.
+	Compress bool `toml:"compress" json:"compress"`
+	// BufferSize is the number of the recent audit entries kept in memory,
+	// which can be queried by the HTTP API.
+	BufferSize int `toml:"buffer-size" json:"buffer-size"`
+}
+
+// NewDefaultAuditConfig returns the default audit configuration.
+func NewDefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Enable:     false,
+		Filename:   "",
+		MaxSize:    300,
+		MaxDays:    0,
+		MaxBackups: 0,
+		Compress:   false,
+		BufferSize: 1000,
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the audit configuration.
+func (c *AuditConfig) ValidateAndAdjust() error {
+	if c.MaxSize < 0 || c.MaxDays < 0 || c.MaxBackups < 0 || c.BufferSize < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"audit.max-size, audit.max-days, audit.max-backups and " +
+				"audit.buffer-size must not be negative")
+	}
+	if c.Enable && c.Filename == "" {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"audit.filename must be set if audit log is enabled")
+	}
+	if c.MaxSize == 0 {
+		c.MaxSize = NewDefaultAuditConfig().MaxSize
+	}
+	return nil
+}
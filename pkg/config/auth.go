@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// AuthConfig represents config for the role-based access control of the
+// HTTP API.
+type AuthConfig struct {
+	// Enable enables the access control. The callers are authenticated by
+	// TLS client certificates, API tokens or OIDC ID tokens once it's
+	// enabled.
+	Enable bool `toml:"enable" json:"enable"`
+	// CertRoles maps the common names of the TLS client certificates to
+	// the roles granted to them, each role is in the form of "role" or
+	// "role:namespace". A certificate not listed is granted no role.
+	CertRoles map[string][]string `toml:"cert-roles" json:"cert-roles"`
+	// TokenTTL is the default ttl of the issued API tokens, 0 means the
+	// tokens never expire.
+	TokenTTL TomlDuration `toml:"token-ttl" json:"token-ttl"`
+	// OIDC is the config of the OIDC identity provider.
+	OIDC *OIDCConfig `toml:"oidc" json:"oidc"`
+}
+
+// OIDCConfig represents config for authenticating the callers of the HTTP
+// API by the ID tokens issued by an OIDC identity provider.
+type OIDCConfig struct {
+	// Issuer is the URL of the identity provider, OIDC is disabled if it's
+	// empty.
+	Issuer string `toml:"issuer" json:"issuer"`
+	// ClientID is the expected audience of the ID tokens.
+	ClientID string `toml:"client-id" json:"client-id"`
+	// RolesClaim is the claim holding the roles of a caller, each role is
+	// in the form of "role" or "role:namespace", such as "operator:ns1".
+	RolesClaim string `toml:"roles-claim" json:"roles-claim"`
+}
+
+// NewDefaultAuthConfig returns the default auth configuration.
+func NewDefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Enable:   false,
+		TokenTTL: 0,
+		OIDC: &OIDCConfig{
+			RolesClaim: "roles",
+		},
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the auth configuration.
+func (c *AuthConfig) ValidateAndAdjust(security *SecurityConfig) error {
+	if c.TokenTTL < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"auth.token-ttl must not be negative")
+	}
+	if c.OIDC == nil {
+		c.OIDC = NewDefaultAuthConfig().OIDC
+	}
+	if c.OIDC.Issuer != "" && c.OIDC.ClientID == "" {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"auth.oidc.client-id must be set if auth.oidc.issuer is set")
+	}
+	if c.OIDC.RolesClaim == "" {
+		c.OIDC.RolesClaim = NewDefaultAuthConfig().OIDC.RolesClaim
+	}
+	hasCertAdmin := false
+	for cn, roles := range c.CertRoles {
+		for _, role := range roles {
+			name, namespace, _ := strings.Cut(role, ":")
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "admin":
+				hasCertAdmin = hasCertAdmin || namespace == "" || namespace == "*"
+			case "viewer", "operator":
+			default:
+				return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+					"invalid role " + role + " of certificate " + cn + " in auth.cert-roles")
+			}
+		}
+	}
+	// Tokens can only be issued by an admin, so there must be a way to
+	// authenticate the first admin.
+	if c.Enable && c.OIDC.Issuer == "" &&
+		(security == nil || !security.IsTLSEnabled() || !hasCertAdmin) {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"auth requires OIDC or an admin in auth.cert-roles with TLS to authenticate admins")
+	}
+	return nil
+}
@@ -99,6 +99,12 @@ const (
   "sorter": {
     "sort-dir": "/tmp/sorter",
     "cache-size-in-mb": 128,
+    "enable-shared-table-cache": false,
+    "spill-threshold": 0,
+    "spill-compression": "",
+    "max-disk-usage-per-changefeed-in-mb": 0,
+    "encryption-key-file": "",
+    "enable-backpressure": false,
     "max-memory-percentage": 10,
     "max-memory-consumption": 0,
     "num-workerpool-goroutine": 0,
@@ -116,7 +122,22 @@ const (
     "worker-concurrent": 8,
     "worker-pool-size": 0,
     "region-scan-limit": 40,
-    "region-retry-duration": 60000000000
+    "region-retry-duration": 60000000000,
+    "grpc-connection-count": 2,
+    "grpc-streams-per-connection": 1000,
+    "streams-per-store": 1,
+    "grpc-stream-window-size": 65535,
+    "grpc-connection-window-size": 8388608,
+    "incremental-scan-regions-per-second": 0,
+    "incremental-scan-mb-per-second": 0,
+    "changefeed-incremental-scan-regions-per-second": 0,
+    "changefeed-incremental-scan-mb-per-second": 0,
+    "max-worker-concurrent": 32,
+    "worker-scale-cpu-threshold": 0.8,
+    "enable-store-resolved-ts": false,
+    "ddl-grpc-connection-count": 1,
+    "ddl-grpc-window-size": 1048576,
+    "ddl-puller-memory-quota": 67108864
   },
   "debug": {
     "db": {
@@ -127,9 +148,11 @@ const (
       "writer-buffer-size": 8388608,
       "compression": "snappy",
       "write-l0-pause-trigger": 2147483647,
+      "memtable-stop-writes-threshold": 4,
       "compaction-l0-trigger": 160,
       "compaction-deletion-threshold": 10485760,
       "compaction-period": 1800,
+      "max-concurrent-compactions": 6,
       "iterator-max-alive-duration": 10000,
       "iterator-slow-read-duration": 256
     },
@@ -154,7 +177,40 @@ const (
     }
   },
   "cluster-id": "default",
-  "max-memory-percentage": 70
+  "max-memory-percentage": 70,
+  "adaptive-memory-quota": {
+    "enable": false,
+    "min-ratio": 0.25,
+    "max-ratio": 4,
+    "adjust-interval": 5000000000
+  },
+  "auth": {
+    "enable": false,
+    "cert-roles": null,
+    "token-ttl": 0,
+    "oidc": {
+      "issuer": "",
+      "client-id": "",
+      "roles-claim": "roles"
+    }
+  },
+  "audit": {
+    "enable": false,
+    "filename": "",
+    "max-size": 300,
+    "max-days": 0,
+    "max-backups": 0,
+    "compress": false,
+    "buffer-size": 1000
+  },
+  "rate-limit": {
+    "enable": false,
+    "global-qps": 100,
+    "global-burst": 200,
+    "client-qps": 10,
+    "client-burst": 20,
+    "max-clients": 10000
+  }
 }`
 
 	testCfgTestReplicaConfigMarshal1 = `{
@@ -28,6 +28,22 @@ type ConsistentConfig struct {
 	FlushIntervalInMs int64  `toml:"flush-interval" json:"flush-interval"`
 	Storage           string `toml:"storage" json:"storage"`
 	UseFileBackend    bool   `toml:"use-file-backend" json:"use-file-backend"`
+	// MaxFlushIntervalInMs enables the adaptive flush interval if it is greater
+	// than FlushIntervalInMs. The flush interval is adjusted between them based
+	// on the lag of the redo log, a short interval is used to reduce the latency
+	// when the lag is small, and a longer one is used to flush larger batches
+	// when the changefeed is catching up.
+	MaxFlushIntervalInMs int64 `toml:"max-flush-interval" json:"max-flush-interval,omitempty"`
+	// LogRetentionInMs is the duration that the redo logs older than the
+	// checkpoint are retained before they are garbage collected, which allows
+	// to recover the downstream to an earlier point. The logs are removed as
+	// soon as the checkpoint passes them if it is zero.
+	LogRetentionInMs int64 `toml:"log-retention" json:"log-retention,omitempty"`
+	// EncryptionKeyFile is the path of the file containing the keys used to
+	// encrypt the redo logs, or an aws-kms uri whose key file contains the
+	// data keys encrypted by the AWS KMS, see redo.NewKeyProvider.
+	// The redo logs are not encrypted if it is empty.
+	EncryptionKeyFile string `toml:"encryption-key-file" json:"encryption-key-file,omitempty"`
 }
 
 // ValidateAndAdjust validates the consistency config and adjusts it if necessary.
@@ -48,6 +64,23 @@ func (c *ConsistentConfig) ValidateAndAdjust() error {
 			fmt.Sprintf("The consistent.flush-interval:%d must be equal or greater than %d",
 				c.FlushIntervalInMs, redo.MinFlushIntervalInMs))
 	}
+	if c.MaxFlushIntervalInMs != 0 && c.MaxFlushIntervalInMs < c.FlushIntervalInMs {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The consistent.max-flush-interval:%d must be equal or greater than "+
+				"consistent.flush-interval:%d", c.MaxFlushIntervalInMs, c.FlushIntervalInMs))
+	}
+	if c.LogRetentionInMs < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The consistent.log-retention:%d must be equal or greater than 0",
+				c.LogRetentionInMs))
+	}
+
+	if c.EncryptionKeyFile != "" {
+		if _, err := redo.LoadCipher(c.EncryptionKeyFile); err != nil {
+			return cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+				fmt.Sprintf("invalid consistent.encryption-key-file: %s", err.Error()))
+		}
+	}
 
 	uri, err := storage.ParseRawURL(c.Storage)
 	if err != nil {
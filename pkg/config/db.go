@@ -47,6 +47,11 @@ type DBConfig struct {
 	//
 	// The default value is 1<<31 - 1.
 	WriteL0PauseTrigger int `toml:"write-l0-pause-trigger" json:"write-l0-pause-trigger"`
+	// MemTableStopWritesThreshold defines number of memory tables waiting
+	// to be flushed that will pause write.
+	//
+	// The default value is 4.
+	MemTableStopWritesThreshold int `toml:"memtable-stop-writes-threshold" json:"memtable-stop-writes-threshold"`
 
 	// CompactionL0Trigger defines number of db sst file at level-0 that will
 	// trigger compaction.
@@ -64,6 +69,12 @@ type DBConfig struct {
 	//
 	// The default value is 30 minutes, 1800.
 	CompactionPeriod int `toml:"compaction-period" json:"compaction-period"`
+	// MaxConcurrentCompactions is the maximum number of concurrent compactions
+	// of each db. Increase it if the compactions can not keep up with the
+	// writes, e.g. changefeeds with very large incremental scans.
+	//
+	// The default value is 6.
+	MaxConcurrentCompactions int `toml:"max-concurrent-compactions" json:"max-concurrent-compactions"`
 
 	// IteratorMaxAliveDuration the maximum iterator alive duration in ms.
 	//
@@ -83,6 +94,14 @@ func (c *DBConfig) ValidateAndAdjust() error {
 		return errors.ErrIllegalSorterParameter.GenWithStackByArgs(
 			"sorter.leveldb.compression must be \"none\" or \"snappy\"")
 	}
+	if c.MemTableStopWritesThreshold < 0 {
+		return errors.ErrIllegalSorterParameter.GenWithStackByArgs(
+			"debug.db.memtable-stop-writes-threshold must not be negative")
+	}
+	if c.MaxConcurrentCompactions < 0 {
+		return errors.ErrIllegalSorterParameter.GenWithStackByArgs(
+			"debug.db.max-concurrent-compactions must not be negative")
+	}
 
 	return nil
 }
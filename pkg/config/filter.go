@@ -22,8 +22,31 @@ import (
 type FilterConfig struct {
 	Rules []string `toml:"rules" json:"rules"`
 	*filter.MySQLReplicationRules
-	IgnoreTxnStartTs []uint64           `toml:"ignore-txn-start-ts" json:"ignore-txn-start-ts"`
-	EventFilters     []*EventFilterRule `toml:"event-filters" json:"event-filters"`
+	IgnoreTxnStartTs []uint64            `toml:"ignore-txn-start-ts" json:"ignore-txn-start-ts"`
+	EventFilters     []*EventFilterRule  `toml:"event-filters" json:"event-filters"`
+	ColumnFilters    []*ColumnFilterRule `toml:"column-filters" json:"column-filters,omitempty"`
+	SampleRules      []*SampleRule       `toml:"sample-rules" json:"sample-rules,omitempty"`
+}
+
+// SampleRule only replicates a percentage of the rows of the matched tables.
+// The rows are sampled by the hash of their handle keys, so all the changes
+// of a row are either replicated or ignored.
+type SampleRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Percent is the percentage of the replicated rows, in (0, 100].
+	Percent float64 `toml:"percent" json:"percent"`
+}
+
+// ColumnFilterRule ignores the columns of the matched tables, the ignored
+// columns are dropped from the row changed events before they are sent to
+// the sink. The handle key columns can't be ignored.
+type ColumnFilterRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// IgnoreColumns are the names of the ignored columns, case insensitive.
+	IgnoreColumns []string `toml:"ignore-columns" json:"ignore-columns,omitempty"`
+	// IgnoreColumnRegexps are the regular expressions matching the names of
+	// the ignored columns.
+	IgnoreColumnRegexps []string `toml:"ignore-column-regexps" json:"ignore-column-regexps,omitempty"`
 }
 
 // EventFilterRule is used by sql event filter and expression filter
@@ -37,4 +60,37 @@ type EventFilterRule struct {
 	IgnoreUpdateNewValueExpr string `toml:"ignore-update-new-value-expr" json:"ignore-update-new-value-expr"`
 	IgnoreUpdateOldValueExpr string `toml:"ignore-update-old-value-expr" json:"ignore-update-old-value-expr"`
 	IgnoreDeleteValueExpr    string `toml:"ignore-delete-value-expr" json:"ignore-delete-value-expr"`
+	// RowFilterExpr only replicates the rows matching the expression. An
+	// UPDATE moving a row into or out of the matched rows is replicated as
+	// an INSERT or a DELETE.
+	RowFilterExpr string `toml:"row-filter-expr" json:"row-filter-expr,omitempty"`
+	// IgnoreValueRegexps ignore the rows whose column values match the
+	// regular expressions, an UPDATE is ignored if either its old values or
+	// its new values match.
+	IgnoreValueRegexps []*ValueRegexp `toml:"ignore-value-regexps" json:"ignore-value-regexps,omitempty"`
+	// AllowDDL are the DDL types replicated for the matched tables, such as
+	// "add column" and "truncate table", the other DDL types are blocked if
+	// it's not empty.
+	AllowDDL []string `toml:"allow-ddl" json:"allow-ddl,omitempty"`
+	// BlockDDL are the DDL types blocked for the matched tables.
+	BlockDDL []string `toml:"block-ddl" json:"block-ddl,omitempty"`
+	// BlockedDDLAction is the action on a blocked DDL, it's skip by default.
+	BlockedDDLAction string `toml:"blocked-ddl-action" json:"blocked-ddl-action,omitempty"`
 }
+
+// ValueRegexp matches the values of a column by a regular expression, the
+// NULL values never match.
+type ValueRegexp struct {
+	// Column is the name of the column, case insensitive.
+	Column string `toml:"column" json:"column"`
+	Regexp string `toml:"regexp" json:"regexp"`
+}
+
+const (
+	// BlockedDDLActionSkip skips the blocked DDLs, they are still applied to
+	// the schema of the changefeed but not replicated.
+	BlockedDDLActionSkip = "skip"
+	// BlockedDDLActionPause fails the changefeed on a blocked DDL until it's
+	// skipped by the error handles or the event filter is updated.
+	BlockedDDLActionPause = "pause"
+)
@@ -13,7 +13,14 @@
 
 package config
 
-import "github.com/pingcap/tiflow/pkg/errors"
+import (
+	"math"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// minGrpcWindowSize is the initial window size in http2 spec.
+const minGrpcWindowSize = (1 << 16) - 1
 
 // KVClientConfig represents config for kv client
 type KVClientConfig struct {
@@ -25,6 +32,52 @@ type KVClientConfig struct {
 	RegionScanLimit int `toml:"region-scan-limit" json:"region-scan-limit"`
 	// the total retry duration of connecting a region
 	RegionRetryDuration TomlDuration `toml:"region-retry-duration" json:"region-retry-duration"`
+
+	// the number of gRPC connections created to a TiKV store at a time, more
+	// connections are created if all existing ones are full of streams
+	GrpcConnectionCount int `toml:"grpc-connection-count" json:"grpc-connection-count"`
+	// the max number of gRPC streams in a connection
+	GrpcStreamsPerConnection int `toml:"grpc-streams-per-connection" json:"grpc-streams-per-connection"`
+	// the number of gRPC streams opened to a TiKV store by a table
+	StreamsPerStore int `toml:"streams-per-store" json:"streams-per-store"`
+	// the initial flow-control window size of a gRPC stream in bytes
+	GrpcStreamWindowSize int `toml:"grpc-stream-window-size" json:"grpc-stream-window-size"`
+	// the initial flow-control window size of a gRPC connection in bytes
+	GrpcConnectionWindowSize int `toml:"grpc-connection-window-size" json:"grpc-connection-window-size"`
+
+	// The following configs limit the throughput of incremental scans, which
+	// is issued when a region is subscribed, of all changefeeds on the capture
+	// and of each changefeed respectively. 0 means unlimited.
+	IncrementalScanRegionsPerSecond           int `toml:"incremental-scan-regions-per-second" json:"incremental-scan-regions-per-second"`
+	IncrementalScanMBPerSecond                int `toml:"incremental-scan-mb-per-second" json:"incremental-scan-mb-per-second"`
+	ChangefeedIncrementalScanRegionsPerSecond int `toml:"changefeed-incremental-scan-regions-per-second" json:"changefeed-incremental-scan-regions-per-second"`
+	ChangefeedIncrementalScanMBPerSecond      int `toml:"changefeed-incremental-scan-mb-per-second" json:"changefeed-incremental-scan-mb-per-second"`
+
+	// the max number of workers used by a single region worker, which scales
+	// between worker-concurrent and it according to the pending events and the
+	// CPU usage. 0 means the number of workers is always worker-concurrent.
+	MaxWorkerConcurrent int `toml:"max-worker-concurrent" json:"max-worker-concurrent"`
+	// region workers don't scale up when the CPU usage of the host exceeds it
+	WorkerScaleCPUThreshold float64 `toml:"worker-scale-cpu-threshold" json:"worker-scale-cpu-threshold"`
+
+	// whether to consume store-level resolved ts pushed by TiKV, which is a
+	// resolved ts event without any region and applies to all regions of the
+	// stream. It reduces the resolved ts traffic when there are lots of regions.
+	// It only takes effect on the TiKV stores which send store-level resolved
+	// ts, see version.MinTiKVVersionForStoreResolvedTs.
+	EnableStoreResolvedTs bool `toml:"enable-store-resolved-ts" json:"enable-store-resolved-ts"`
+
+	// the number of gRPC connections created to a TiKV store for DDL pullers,
+	// which are isolated from table pullers so that heavy DML traffic can't
+	// starve DDL discovery. 0 means sharing the connections of table pullers.
+	DDLGrpcConnectionCount int `toml:"ddl-grpc-connection-count" json:"ddl-grpc-connection-count"`
+	// the initial flow-control window size of the gRPC streams and connections
+	// of DDL pullers in bytes, which bounds the memory used by them
+	DDLGrpcWindowSize int `toml:"ddl-grpc-window-size" json:"ddl-grpc-window-size"`
+	// the memory quota in bytes of the DDL jobs buffered by a DDL puller but
+	// not consumed yet. The DDL puller stops receiving once it's exhausted,
+	// which pushes back to the gRPC stream instead of growing without bound.
+	DDLPullerMemoryQuota uint64 `toml:"ddl-puller-memory-quota" json:"ddl-puller-memory-quota"`
 }
 
 // ValidateAndAdjust validates and adjusts the kv client configuration
@@ -41,5 +94,62 @@ func (c *KVClientConfig) ValidateAndAdjust() error {
 		return errors.ErrInvalidServerOption.GenWithStackByArgs(
 			"region-scan-limit should be positive")
 	}
+	if c.GrpcConnectionCount <= 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"grpc-connection-count should be at least 1")
+	}
+	if c.GrpcStreamsPerConnection <= 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"grpc-streams-per-connection should be at least 1")
+	}
+	if c.StreamsPerStore <= 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"streams-per-store should be at least 1")
+	}
+	// The window size smaller than 64KB is ignored by gRPC.
+	if c.GrpcStreamWindowSize < minGrpcWindowSize || c.GrpcStreamWindowSize > math.MaxInt32 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"grpc-stream-window-size should be in [65535, 2147483647]")
+	}
+	if c.GrpcConnectionWindowSize < minGrpcWindowSize || c.GrpcConnectionWindowSize > math.MaxInt32 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"grpc-connection-window-size should be in [65535, 2147483647]")
+	}
+	if c.IncrementalScanRegionsPerSecond < 0 || c.IncrementalScanMBPerSecond < 0 ||
+		c.ChangefeedIncrementalScanRegionsPerSecond < 0 || c.ChangefeedIncrementalScanMBPerSecond < 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"incremental scan rate limits should not be negative")
+	}
+	if c.MaxWorkerConcurrent != 0 && c.MaxWorkerConcurrent < c.WorkerConcurrent {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"max-worker-concurrent should be 0 or not less than worker-concurrent")
+	}
+	if c.WorkerScaleCPUThreshold <= 0 || c.WorkerScaleCPUThreshold > 1 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"worker-scale-cpu-threshold should be in (0, 1]")
+	}
+	if c.DDLGrpcConnectionCount < 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"ddl-grpc-connection-count should not be negative")
+	}
+	if c.DDLGrpcConnectionCount > 0 &&
+		(c.DDLGrpcWindowSize < minGrpcWindowSize || c.DDLGrpcWindowSize > math.MaxInt32) {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"ddl-grpc-window-size should be in [65535, 2147483647]")
+	}
+	if c.DDLPullerMemoryQuota == 0 {
+		return errors.ErrInvalidServerOption.GenWithStackByArgs(
+			"ddl-puller-memory-quota should be greater than 0")
+	}
 	return nil
 }
+
+// ForDDLPuller returns a copy of the config used to create the gRPC
+// connections of DDL pullers.
+func (c *KVClientConfig) ForDDLPuller() *KVClientConfig {
+	cfg := *c
+	cfg.GrpcConnectionCount = c.DDLGrpcConnectionCount
+	cfg.GrpcStreamWindowSize = c.DDLGrpcWindowSize
+	cfg.GrpcConnectionWindowSize = c.DDLGrpcWindowSize
+	return &cfg
+}
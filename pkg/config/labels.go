@@ -0,0 +1,51 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const maxLabelLength = 63
+
+var (
+	// labelKeyRe allows keys like "team" and "app.kubernetes.io/name".
+	labelKeyRe   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	labelValueRe = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+// ValidateLabels checks the labels of a changefeed. The characters in a
+// label are restricted, so that labels can be used in label selectors like
+// "team=foo,env!=test" without escaping.
+func ValidateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if len(k) > maxLabelLength || !labelKeyRe.MatchString(k) {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("invalid label key %q, it must consist of at most %d "+
+					"alphanumeric characters, '-', '_', '.' or '/', and start and "+
+					"end with an alphanumeric character", k, maxLabelLength))
+		}
+		if len(v) > maxLabelLength || !labelValueRe.MatchString(v) {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("invalid value %q of label %s, it must be empty or "+
+					"consist of at most %d alphanumeric characters, '-', '_' or '.', "+
+					"and start and end with an alphanumeric character",
+					v, k, maxLabelLength))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateLabels(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, ValidateLabels(nil))
+	require.Nil(t, ValidateLabels(map[string]string{
+		"team":                   "foo",
+		"app.kubernetes.io/name": "order-service_v2",
+		"env":                    "",
+	}))
+
+	for _, labels := range []map[string]string{
+		{"": "foo"},
+		{"-team": "foo"},
+		{"team=": "foo"},
+		{"team": "foo,bar"},
+		{"team": "foo/bar"},
+		{"team": "foo-"},
+		{strings.Repeat("a", 64): "foo"},
+		{"team": strings.Repeat("a", 64)},
+	} {
+		err := ValidateLabels(labels)
+		require.True(t, cerror.ErrInvalidReplicaConfig.Equal(err), labels)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// LagSLAConfig is the service level objective of the checkpoint lag of a
+// changefeed. The changefeed is in breach of the SLA when its checkpoint lag
+// is greater than MaxLagSeconds.
+type LagSLAConfig struct {
+	MaxLagSeconds int64 `toml:"max-lag-seconds" json:"max-lag-seconds"`
+	// WebhookURL receives a notification when the changefeed starts or stops
+	// breaching the SLA, no notification is sent if it is empty.
+	WebhookURL string `toml:"webhook-url" json:"webhook-url,omitempty"`
+}
+
+// MaxLag returns the max checkpoint lag allowed by the SLA.
+func (c *LagSLAConfig) MaxLag() time.Duration {
+	return time.Duration(c.MaxLagSeconds) * time.Second
+}
+
+// ValidateAndAdjust validates the lag SLA config.
+func (c *LagSLAConfig) ValidateAndAdjust() error {
+	if c.MaxLagSeconds <= 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The lag-sla.max-lag-seconds:%d must be greater than 0",
+				c.MaxLagSeconds))
+	}
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The lag-sla.webhook-url:%s must be a http or https url",
+					c.WebhookURL))
+		}
+		host := u.Hostname()
+		if ip := net.ParseIP(host); strings.EqualFold(host, "localhost") ||
+			(ip != nil && !IsWebhookIPAllowed(ip)) {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The lag-sla.webhook-url:%s must not be a loopback, "+
+					"link-local or unspecified address", c.WebhookURL))
+		}
+	}
+	return nil
+}
+
+// IsWebhookIPAllowed returns false if the ip is a loopback, link-local,
+// multicast or unspecified address, which a webhook must not be sent to.
+// It's checked both on the config and on the resolved address of the
+// webhook, so the captures and the cloud metadata services can't be reached
+// by a changefeed config.
+func IsWebhookIPAllowed(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !ip.IsUnspecified()
+}
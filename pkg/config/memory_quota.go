@@ -0,0 +1,65 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// AdaptiveMemoryQuotaConfig represents config for the capture level memory
+// quota allocator, which redistributes the unused memory quota between the
+// changefeeds on the same capture.
+type AdaptiveMemoryQuotaConfig struct {
+	// Enable enables the adaptive memory quota. If it's disabled, every
+	// changefeed always uses its own memory-quota.
+	Enable bool `toml:"enable" json:"enable"`
+	// MinRatio is the hard floor of the quota of a changefeed, which is the
+	// ratio to its memory-quota.
+	MinRatio float64 `toml:"min-ratio" json:"min-ratio"`
+	// MaxRatio is the hard ceiling of the quota of a changefeed, which is the
+	// ratio to its memory-quota.
+	MaxRatio float64 `toml:"max-ratio" json:"max-ratio"`
+	// AdjustInterval is the interval to redistribute the memory quota.
+	AdjustInterval TomlDuration `toml:"adjust-interval" json:"adjust-interval"`
+}
+
+// NewDefaultAdaptiveMemoryQuotaConfig returns the default adaptive memory
+// quota configuration.
+func NewDefaultAdaptiveMemoryQuotaConfig() *AdaptiveMemoryQuotaConfig {
+	return &AdaptiveMemoryQuotaConfig{
+		Enable:         false,
+		MinRatio:       0.25,
+		MaxRatio:       4,
+		AdjustInterval: TomlDuration(5 * time.Second),
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the adaptive memory quota configuration.
+func (c *AdaptiveMemoryQuotaConfig) ValidateAndAdjust() error {
+	if c.MinRatio <= 0 || c.MinRatio > 1 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"adaptive-memory-quota.min-ratio must be in (0, 1]")
+	}
+	if c.MaxRatio < 1 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"adaptive-memory-quota.max-ratio must be larger than or equal to 1")
+	}
+	if c.AdjustInterval <= 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"adaptive-memory-quota.adjust-interval must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// RateLimitConfig represents config for the rate limits of the HTTP API.
+type RateLimitConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// GlobalQPS and GlobalBurst limit the requests of all clients, 0 means
+	// no global limit.
+	GlobalQPS   float64 `toml:"global-qps" json:"global-qps"`
+	GlobalBurst int     `toml:"global-burst" json:"global-burst"`
+	// ClientQPS and ClientBurst limit the requests of each client, 0 means
+	// no per-client limit. A client is identified by its API token, or its
+	// IP address if it doesn't use an API token.
+	ClientQPS   float64 `toml:"client-qps" json:"client-qps"`
+	ClientBurst int     `toml:"client-burst" json:"client-burst"`
+	// MaxClients is the max number of clients whose limits are tracked, the
+	// least recently seen clients are forgotten if it's exceeded.
+	MaxClients int `toml:"max-clients" json:"max-clients"`
+}
+
+// NewDefaultRateLimitConfig returns the default rate limit configuration.
+func NewDefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enable:      false,
+		GlobalQPS:   100,
+		GlobalBurst: 200,
+		ClientQPS:   10,
+		ClientBurst: 20,
+		MaxClients:  10000,
+	}
+}
+
+// ValidateAndAdjust validates and adjusts the rate limit configuration.
+func (c *RateLimitConfig) ValidateAndAdjust() error {
+	if c.GlobalQPS < 0 || c.ClientQPS < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+			"rate-limit.global-qps and rate-limit.client-qps must not be negative")
+	}
+	defaultCfg := NewDefaultRateLimitConfig()
+	if c.GlobalQPS > 0 && c.GlobalBurst <= 0 {
+		c.GlobalBurst = int(c.GlobalQPS) + 1
+	}
+	if c.ClientQPS > 0 && c.ClientBurst <= 0 {
+		c.ClientBurst = int(c.ClientQPS) + 1
+	}
+	if c.MaxClients <= 0 {
+		c.MaxClients = defaultCfg.MaxClients
+	}
+	return nil
+}
@@ -38,6 +38,20 @@ const (
 	minSyncPointRetention = time.Hour * 1
 )
 
+// The policies of the tables without a valid index.
+const (
+	// IneligibleTablePolicyFail fails the changefeed if any table without a
+	// valid index is matched by the filter rules.
+	IneligibleTablePolicyFail = "fail"
+	// IneligibleTablePolicySkip skips the tables without a valid index with
+	// a warning.
+	IneligibleTablePolicySkip = "skip"
+	// IneligibleTablePolicyAppendOnly replicates only the inserted rows of the
+	// tables without a valid index, since the updated and deleted rows can't
+	// be identified downstream.
+	IneligibleTablePolicyAppendOnly = "append-only"
+)
+
 var defaultReplicaConfig = &ReplicaConfig{
 	MemoryQuota:        DefaultChangefeedMemoryQuota,
 	CaseSensitive:      true,
@@ -116,6 +130,21 @@ type replicaConfig struct {
 	// IgnoreIneligibleTable is used to store the user's config when creating a changefeed.
 	// not used in the changefeed's lifecycle.
 	IgnoreIneligibleTable bool `toml:"ignore-ineligible-table" json:"ignore-ineligible-table"`
+	// IneligibleTablePolicy decides how the tables without a valid index,
+	// i.e. a primary key or a not null unique key, are handled. It's one of
+	// fail, skip and append-only, the behavior of force-replicate and
+	// ignore-ineligible-table is kept if it's empty.
+	IneligibleTablePolicy string `toml:"ineligible-table-policy" json:"ineligible-table-policy,omitempty"`
+	// MemoryQuotaPriority is the weight of the changefeed when the unused
+	// memory quota is redistributed by the adaptive memory quota, higher
+	// priority changefeeds get more spare quota.
+	MemoryQuotaPriority int `toml:"memory-quota-priority" json:"memory-quota-priority,omitempty"`
+	// Labels group changefeeds, e.g. by team or application, they are used
+	// to select changefeeds in list and batch operations.
+	Labels map[string]string `toml:"labels" json:"labels,omitempty"`
+	// LagSLA is the objective of the checkpoint lag, which is tracked by the
+	// owner if it's set.
+	LagSLA *LagSLAConfig `toml:"lag-sla" json:"lag-sla,omitempty"`
 
 	// BDR(Bidirectional Replication) is a feature that allows users to
 	// replicate data of same tables from TiDB-1 to TiDB-2 and vice versa.
@@ -134,6 +163,8 @@ type replicaConfig struct {
 	Scheduler *ChangefeedSchedulerConfig `toml:"scheduler" json:"scheduler"`
 	// Integrity is only available when the downstream is MQ.
 	Integrity *integrity.Config `toml:"integrity" json:"integrity"`
+	// Transform is applied to the row changes before they are sent to the sink.
+	Transform *TransformConfig `toml:"transform" json:"transform,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ReplicationConfig
@@ -229,6 +260,37 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 	if c.MemoryQuota == uint64(0) {
 		c.FixMemoryQuota()
 	}
+	if c.MemoryQuotaPriority < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The MemoryQuotaPriority:%d must not be negative",
+				c.MemoryQuotaPriority))
+	}
+	if err := ValidateLabels(c.Labels); err != nil {
+		return err
+	}
+	if c.LagSLA != nil {
+		if err := c.LagSLA.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	if c.Transform != nil {
+		if err := c.Transform.ValidateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	switch c.IneligibleTablePolicy {
+	case "", IneligibleTablePolicyFail, IneligibleTablePolicySkip,
+		IneligibleTablePolicyAppendOnly:
+	default:
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The IneligibleTablePolicy:%s must be one of %s, %s and %s",
+				c.IneligibleTablePolicy, IneligibleTablePolicyFail,
+				IneligibleTablePolicySkip, IneligibleTablePolicyAppendOnly))
+	}
+	if c.IneligibleTablePolicy != "" && c.ForceReplicate {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"The IneligibleTablePolicy can't be used with ForceReplicate")
+	}
 	if c.Scheduler == nil {
 		c.FixScheduler(false)
 	}
@@ -255,6 +317,12 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error {
 	return nil
 }
 
+// ReplicateIneligibleTables returns whether the tables without a valid index
+// are replicated, by either force-replicate or the append-only policy.
+func (c *ReplicaConfig) ReplicateIneligibleTables() bool {
+	return c.ForceReplicate || c.IneligibleTablePolicy == IneligibleTablePolicyAppendOnly
+}
+
 // FixScheduler adjusts scheduler to default value
 func (c *ReplicaConfig) FixScheduler(inheritV66 bool) {
 	if c.Scheduler == nil {
@@ -246,6 +246,74 @@ func TestValidateAndAdjust(t *testing.T) {
 	cfg.Integrity.IntegrityCheckLevel = integrity.CheckLevelCorrectness
 	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
 	require.Equal(t, integrity.CheckLevelNone, cfg.Integrity.IntegrityCheckLevel)
+
+	cfg = GetDefaultReplicaConfig()
+	cfg.LagSLA = &LagSLAConfig{}
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	cfg.LagSLA.MaxLagSeconds = 30
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	cfg.LagSLA.WebhookURL = "ftp://127.0.0.1/hook"
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	cfg.LagSLA.WebhookURL = "https://10.0.0.1/hook"
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	// the captures and the cloud metadata services must not be reached.
+	for _, hook := range []string{
+		"https://127.0.0.1/hook", "http://localhost:8300/hook",
+		"http://169.254.169.254/latest", "http://[::1]/hook", "http://0.0.0.0/hook",
+	} {
+		cfg.LagSLA.WebhookURL = hook
+		require.Error(t, cfg.ValidateAndAdjust(sinkURL), hook)
+	}
+
+	cfg = GetDefaultReplicaConfig()
+	rule := &MaskRule{Matcher: []string{"test.*"}, Function: " SHA256 "}
+	cfg.Transform = &TransformConfig{MaskRules: []*MaskRule{rule}}
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	rule.Columns = []string{"phone", "*_card_no"}
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	require.Equal(t, MaskFunctionSHA256, rule.Function)
+	rule.Columns = []string{"[phone"}
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	rule.Columns = []string{"phone"}
+	rule.Function = "md5"
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	rule.Function = MaskFunctionFixed
+	rule.Matcher = []string{"test.!"}
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+
+	cfg = GetDefaultReplicaConfig()
+	column := &ComputedColumn{Matcher: []string{"test.*"}, Name: "d", Function: "Commit-Date"}
+	cfg.Transform = &TransformConfig{ComputedColumns: []*ComputedColumn{column}}
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	require.Equal(t, ComputeFunctionCommitDate, column.Function)
+	require.Equal(t, "2006-01-02", column.Format)
+	column.Function = ComputeFunctionBucket
+	column.Columns = []string{"id"}
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	column.BucketSize = 10
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	column.Function = ComputeFunctionConcat
+	column.Columns = nil
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	column.Function = "upper"
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	column.Function = ComputeFunctionCommitTs
+	column.Name = " "
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+
+	cfg = GetDefaultReplicaConfig()
+	require.False(t, cfg.ReplicateIneligibleTables())
+	cfg.IneligibleTablePolicy = IneligibleTablePolicyAppendOnly
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	require.True(t, cfg.ReplicateIneligibleTables())
+	cfg.IneligibleTablePolicy = IneligibleTablePolicySkip
+	require.NoError(t, cfg.ValidateAndAdjust(sinkURL))
+	require.False(t, cfg.ReplicateIneligibleTables())
+	cfg.ForceReplicate = true
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
+	cfg.ForceReplicate = false
+	cfg.IneligibleTablePolicy = "ignore"
+	require.Error(t, cfg.ValidateAndAdjust(sinkURL))
 }
 
 func TestIsSinkCompatibleWithSpanReplication(t *testing.T) {
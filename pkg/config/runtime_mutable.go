@@ -0,0 +1,108 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"reflect"
+
+	"github.com/pingcap/errors"
+)
+
+// runtimeMutableSinkURIParams are the parameters of a sink URI which can be
+// updated without restarting the changefeed.
+var runtimeMutableSinkURIParams = map[string]struct{}{
+	"worker-count":              {},
+	"max-txn-row":               {},
+	"max-multi-update-row":      {},
+	"max-multi-update-row-size": {},
+	"max-batch-size":            {},
+	"max-message-bytes":         {},
+	"flush-interval":            {},
+	"file-size":                 {},
+}
+
+// IsRuntimeMutableSinkURI returns true if the new sink URI only differs from
+// the old one in the parameters which can be updated without restarting the
+// changefeed.
+func IsRuntimeMutableSinkURI(oldSinkURI, newSinkURI string) (bool, error) {
+	if oldSinkURI == newSinkURI {
+		return true, nil
+	}
+	oldURI, err := url.Parse(oldSinkURI)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	newURI, err := url.Parse(newSinkURI)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	oldQuery, newQuery := oldURI.Query(), newURI.Query()
+	for param := range runtimeMutableSinkURIParams {
+		oldQuery.Del(param)
+		newQuery.Del(param)
+	}
+	oldURI.RawQuery, newURI.RawQuery = oldQuery.Encode(), newQuery.Encode()
+	return oldURI.String() == newURI.String(), nil
+}
+
+// IsRuntimeMutable returns true if the config only differs from the old one in
+// the parameters which can be updated without restarting the changefeed, which
+// are the memory quota, and the concurrency and batching parameters of the sink.
+func (c *ReplicaConfig) IsRuntimeMutable(old *ReplicaConfig) bool {
+	newCfg, oldCfg := c.Clone(), old.Clone()
+	newCfg.clearRuntimeMutable()
+	oldCfg.clearRuntimeMutable()
+	return reflect.DeepEqual(newCfg, oldCfg)
+}
+
+// clearRuntimeMutable clears the runtime-mutable parameters, and the sink
+// configs left empty, so that setting the parameters in a sink config absent
+// before is also treated as runtime-mutable.
+func (c *ReplicaConfig) clearRuntimeMutable() {
+	c.MemoryQuota = 0
+	if c.Sink == nil {
+		return
+	}
+	c.Sink.EncoderConcurrency = nil
+	if c.Sink.MySQLConfig != nil {
+		c.Sink.MySQLConfig.WorkerCount = nil
+		c.Sink.MySQLConfig.MaxTxnRow = nil
+		c.Sink.MySQLConfig.MaxMultiUpdateRowSize = nil
+		c.Sink.MySQLConfig.MaxMultiUpdateRowCount = nil
+		if reflect.ValueOf(*c.Sink.MySQLConfig).IsZero() {
+			c.Sink.MySQLConfig = nil
+		}
+	}
+	if c.Sink.KafkaConfig != nil {
+		c.Sink.KafkaConfig.MaxMessageBytes = nil
+		if c.Sink.KafkaConfig.CodecConfig != nil {
+			c.Sink.KafkaConfig.CodecConfig.MaxBatchSize = nil
+			if reflect.ValueOf(*c.Sink.KafkaConfig.CodecConfig).IsZero() {
+				c.Sink.KafkaConfig.CodecConfig = nil
+			}
+		}
+		if reflect.ValueOf(*c.Sink.KafkaConfig).IsZero() {
+			c.Sink.KafkaConfig = nil
+		}
+	}
+	if c.Sink.CloudStorageConfig != nil {
+		c.Sink.CloudStorageConfig.WorkerCount = nil
+		c.Sink.CloudStorageConfig.FlushInterval = nil
+		c.Sink.CloudStorageConfig.FileSize = nil
+		if reflect.ValueOf(*c.Sink.CloudStorageConfig).IsZero() {
+			c.Sink.CloudStorageConfig = nil
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRuntimeMutableSinkURI(t *testing.T) {
+	t.Parallel()
+
+	oldURI := "mysql://root@127.0.0.1:3306/?worker-count=16&time-zone=UTC"
+	for _, newURI := range []string{
+		oldURI,
+		"mysql://root@127.0.0.1:3306/?worker-count=32&time-zone=UTC",
+		"mysql://root@127.0.0.1:3306/?time-zone=UTC&max-txn-row=512",
+	} {
+		ok, err := IsRuntimeMutableSinkURI(oldURI, newURI)
+		require.Nil(t, err)
+		require.True(t, ok, newURI)
+	}
+	for _, newURI := range []string{
+		"mysql://root@127.0.0.1:3307/?worker-count=16&time-zone=UTC",
+		"mysql://root@127.0.0.1:3306/?worker-count=16",
+		"tidb://root@127.0.0.1:3306/?worker-count=16&time-zone=UTC",
+	} {
+		ok, err := IsRuntimeMutableSinkURI(oldURI, newURI)
+		require.Nil(t, err)
+		require.False(t, ok, newURI)
+	}
+	_, err := IsRuntimeMutableSinkURI(oldURI, "mysql://%%")
+	require.NotNil(t, err)
+}
+
+func TestReplicaConfigIsRuntimeMutable(t *testing.T) {
+	t.Parallel()
+
+	old := GetDefaultReplicaConfig()
+	cfg := old.Clone()
+	require.True(t, cfg.IsRuntimeMutable(old))
+
+	cfg.MemoryQuota = old.MemoryQuota * 2
+	cfg.Sink.EncoderConcurrency = util.AddressOf(32)
+	cfg.Sink.MySQLConfig = &MySQLConfig{
+		WorkerCount: util.AddressOf(32),
+		MaxTxnRow:   util.AddressOf(512),
+	}
+	cfg.Sink.KafkaConfig = &KafkaConfig{
+		MaxMessageBytes: util.AddressOf(1024),
+		CodecConfig:     &CodecConfig{MaxBatchSize: util.AddressOf(64)},
+	}
+	require.True(t, cfg.IsRuntimeMutable(old))
+
+	cfg.Sink.MySQLConfig.TimeZone = util.AddressOf("UTC")
+	require.False(t, cfg.IsRuntimeMutable(old))
+
+	cfg = old.Clone()
+	cfg.EnableOldValue = !old.EnableOldValue
+	require.False(t, cfg.IsRuntimeMutable(old))
+}
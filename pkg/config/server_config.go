@@ -118,6 +118,22 @@ var defaultServerConfig = &ServerConfig{
 		// The default TiKV region election timeout is [10s, 20s],
 		// Use 1 minute to cover region leader missing.
 		RegionRetryDuration: TomlDuration(time.Minute),
+		GrpcConnectionCount: 2,
+		// The default max number of TiKV concurrent streams in each
+		// connection is 1024.
+		GrpcStreamsPerConnection: 1000,
+		StreamsPerStore:          1,
+		// TiCDC may open numerous gRPC streams, with 65535 bytes window
+		// size, 10K streams takes about 27GB memory.
+		GrpcStreamWindowSize:     (1 << 16) - 1,
+		GrpcConnectionWindowSize: 1 << 23,
+
+		MaxWorkerConcurrent:     32,
+		WorkerScaleCPUThreshold: 0.8,
+
+		DDLGrpcConnectionCount: 1,
+		DDLGrpcWindowSize:      1 << 20,
+		DDLPullerMemoryQuota:   64 * 1024 * 1024, // 64MB
 	},
 	Debug: &DebugConfig{
 		DB: &DBConfig{
@@ -130,9 +146,11 @@ var defaultServerConfig = &ServerConfig{
 			WriterBufferSize:            8388608,
 			Compression:                 "snappy",
 			WriteL0PauseTrigger:         math.MaxInt32,
+			MemTableStopWritesThreshold: 4,
 			CompactionL0Trigger:         160,
 			CompactionDeletionThreshold: 10485760,
 			CompactionPeriod:            1800,
+			MaxConcurrentCompactions:    6,
 			IteratorMaxAliveDuration:    10000,
 			IteratorSlowReadDuration:    256,
 		},
@@ -142,6 +160,10 @@ var defaultServerConfig = &ServerConfig{
 	},
 	ClusterID:           "default",
 	MaxMemoryPercentage: DefaultMaxMemoryPercentage,
+	AdaptiveMemoryQuota: NewDefaultAdaptiveMemoryQuotaConfig(),
+	Auth:                NewDefaultAuthConfig(),
+	Audit:               NewDefaultAuditConfig(),
+	RateLimit:           NewDefaultRateLimitConfig(),
 }
 
 // ServerConfig represents a config for server
@@ -172,6 +194,15 @@ type ServerConfig struct {
 	Debug               *DebugConfig    `toml:"debug" json:"debug"`
 	ClusterID           string          `toml:"cluster-id" json:"cluster-id"`
 	MaxMemoryPercentage int             `toml:"max-memory-percentage" json:"max-memory-percentage"`
+
+	AdaptiveMemoryQuota *AdaptiveMemoryQuotaConfig `toml:"adaptive-memory-quota" json:"adaptive-memory-quota"`
+	Auth                *AuthConfig                `toml:"auth" json:"auth"`
+	Audit               *AuditConfig               `toml:"audit" json:"audit"`
+	RateLimit           *RateLimitConfig           `toml:"rate-limit" json:"rate-limit"`
+
+	// Labels describe the capture, such as its zone and host, which are
+	// reported in the capture inventory.
+	Labels map[string]string `toml:"labels" json:"labels,omitempty"`
 }
 
 // Marshal returns the json marshal format of a ServerConfig
@@ -287,6 +318,41 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 		c.MaxMemoryPercentage = DefaultMaxMemoryPercentage
 	}
 
+	if c.AdaptiveMemoryQuota == nil {
+		c.AdaptiveMemoryQuota = defaultCfg.AdaptiveMemoryQuota
+	}
+	if err = c.AdaptiveMemoryQuota.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.Auth == nil {
+		c.Auth = defaultCfg.Auth
+	}
+	if err = c.Auth.ValidateAndAdjust(c.Security); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.Audit == nil {
+		c.Audit = defaultCfg.Audit
+	}
+	if err = c.Audit.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.RateLimit == nil {
+		c.RateLimit = defaultCfg.RateLimit
+	}
+	if err = c.RateLimit.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+
+	for k := range c.Labels {
+		if k == "" {
+			return cerror.ErrInvalidServerOption.GenWithStackByArgs(
+				"the key of labels must not be empty")
+		}
+	}
+
 	return nil
 }
 
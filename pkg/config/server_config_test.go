@@ -88,6 +88,12 @@ func TestDBConfigValidateAndAdjust(t *testing.T) {
 	require.Nil(t, conf.ValidateAndAdjust())
 	conf.Compression = "invalid"
 	require.Error(t, conf.ValidateAndAdjust())
+	conf.Compression = "snappy"
+	conf.MaxConcurrentCompactions = -1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxConcurrentCompactions = 6
+	conf.MemTableStopWritesThreshold = -1
+	require.Error(t, conf.ValidateAndAdjust())
 }
 
 func TestKVClientConfigValidateAndAdjust(t *testing.T) {
@@ -99,6 +105,90 @@ func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	require.Nil(t, conf.ValidateAndAdjust())
 	conf.RegionRetryDuration = -TomlDuration(time.Second)
 	require.Error(t, conf.ValidateAndAdjust())
+	conf.RegionRetryDuration = TomlDuration(time.Second)
+	conf.ChangefeedIncrementalScanMBPerSecond = -1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.ChangefeedIncrementalScanMBPerSecond = 0
+	conf.StreamsPerStore = 0
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.StreamsPerStore = 1
+	conf.GrpcStreamWindowSize = 1024
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.GrpcStreamWindowSize = 1 << 20
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.MaxWorkerConcurrent = conf.WorkerConcurrent - 1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxWorkerConcurrent = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.WorkerScaleCPUThreshold = 0
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.WorkerScaleCPUThreshold = 1
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.DDLGrpcConnectionCount = -1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.DDLGrpcConnectionCount = 1
+	conf.DDLGrpcWindowSize = 1024
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.DDLGrpcConnectionCount = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.DDLPullerMemoryQuota = 0
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.DDLPullerMemoryQuota = 1024
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	conf.DDLGrpcConnectionCount = 2
+	conf.DDLGrpcWindowSize = 1 << 20
+	ddlConf := conf.ForDDLPuller()
+	require.Equal(t, 2, ddlConf.GrpcConnectionCount)
+	require.Equal(t, 1<<20, ddlConf.GrpcStreamWindowSize)
+	require.Equal(t, 1<<20, ddlConf.GrpcConnectionWindowSize)
+	require.Equal(t, conf.GrpcStreamsPerConnection, ddlConf.GrpcStreamsPerConnection)
+}
+
+func TestAdaptiveMemoryQuotaConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().AdaptiveMemoryQuota
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.MinRatio = 0
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MinRatio = 1.5
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MinRatio = 1
+	conf.MaxRatio = 0.5
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxRatio = 1
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.AdjustInterval = 0
+	require.Error(t, conf.ValidateAndAdjust())
+}
+
+func TestSorterConfigSpillPolicy(t *testing.T) {
+	t.Parallel()
+	defaultCfg := GetDefaultServerConfig().Clone()
+	conf := defaultCfg.Sorter
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	db, cacheInBytes := conf.ApplySpillPolicy(defaultCfg.Debug.DB)
+	require.Equal(t, defaultCfg.Debug.DB, db)
+	require.Equal(t, uint64(128<<20), cacheInBytes)
+
+	conf.SpillThreshold = 1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.SpillThreshold = -0.1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.SpillThreshold = 0.25
+	conf.SpillCompression = "lz4"
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.SpillCompression = "none"
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	db, cacheInBytes = conf.ApplySpillPolicy(defaultCfg.Debug.DB)
+	require.Equal(t, "none", db.Compression)
+	require.Equal(t, (32<<20)/defaultCfg.Debug.DB.Count, db.WriterBufferSize)
+	require.Equal(t, uint64(96<<20), cacheInBytes)
+	// The original configuration is not changed.
+	require.Equal(t, "snappy", defaultCfg.Debug.DB.Compression)
 }
 
 func TestSchedulerConfigValidateAndAdjust(t *testing.T) {
@@ -159,3 +249,77 @@ func TestIsValidClusterID(t *testing.T) {
 		require.Equal(t, c.valid, isValidClusterID(c.id))
 	}
 }
+
+func TestAuthConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().Auth
+	security := &SecurityConfig{}
+
+	require.Nil(t, conf.ValidateAndAdjust(security))
+	conf.TokenTTL = -1
+	require.Error(t, conf.ValidateAndAdjust(security))
+	conf.TokenTTL = 0
+
+	// there is no way to authenticate an admin
+	conf.Enable = true
+	require.Error(t, conf.ValidateAndAdjust(security))
+	security.CAPath, security.CertPath, security.KeyPath = "ca", "cert", "key"
+	require.Error(t, conf.ValidateAndAdjust(security))
+	conf.CertRoles = map[string][]string{"ci": {"operator:ns1"}}
+	require.Error(t, conf.ValidateAndAdjust(security))
+	conf.CertRoles["cli"] = []string{"admin"}
+	require.Nil(t, conf.ValidateAndAdjust(security))
+	conf.CertRoles["ci"] = []string{"root"}
+	require.Error(t, conf.ValidateAndAdjust(security))
+	conf.CertRoles = nil
+
+	conf.OIDC.Issuer = "https://example.com"
+	require.Error(t, conf.ValidateAndAdjust(nil))
+	conf.OIDC.ClientID = "ticdc"
+	conf.OIDC.RolesClaim = ""
+	require.Nil(t, conf.ValidateAndAdjust(nil))
+	require.Equal(t, "roles", conf.OIDC.RolesClaim)
+}
+
+func TestAuditConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().Audit
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.Enable = true
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.Filename = "/tmp/audit.log"
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	conf.MaxDays = -1
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.MaxDays = 0
+	conf.MaxSize = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.Equal(t, 300, conf.MaxSize)
+}
+
+func TestRateLimitConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().RateLimit
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.ClientQPS = -1
+	require.Error(t, conf.ValidateAndAdjust())
+
+	conf.ClientQPS = 5
+	conf.ClientBurst = 0
+	conf.MaxClients = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.Equal(t, 6, conf.ClientBurst)
+	require.Equal(t, 10000, conf.MaxClients)
+}
+
+func TestServerConfigLabels(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig()
+	conf.Labels = map[string]string{"zone": "z1"}
+	require.Nil(t, conf.ValidateAndAdjust())
+	conf.Labels[""] = "h1"
+	require.Error(t, conf.ValidateAndAdjust())
+}
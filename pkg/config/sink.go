@@ -16,10 +16,12 @@ package config
 import (
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	filter "github.com/pingcap/tidb/util/table-filter"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/util"
@@ -57,6 +59,11 @@ const (
 	// NULL is a constant for '\N'
 	NULL = "\\N"
 
+	// BinaryEncodingBase64 encodes the binary columns of csv in base64.
+	BinaryEncodingBase64 = "base64"
+	// BinaryEncodingHex encodes the binary columns of csv in hex.
+	BinaryEncodingHex = "hex"
+
 	// MinFileIndexWidth is the minimum width of file index.
 	MinFileIndexWidth = 6 // enough for 2^19 files
 	// MaxFileIndexWidth is the maximum width of file index.
@@ -111,6 +118,8 @@ type SinkConfig struct {
 	DispatchRules []*DispatchRule `toml:"dispatchers" json:"dispatchers,omitempty"`
 	// CSVConfig is only available when the downstream is Storage.
 	CSVConfig *CSVConfig `toml:"csv" json:"csv,omitempty"`
+	// CanalJSONConfig is only available when the protocol is canal-json.
+	CanalJSONConfig *CanalJSONConfig `toml:"canal-json" json:"canal-json,omitempty"`
 	// ColumnSelectors is Deprecated.
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors,omitempty"`
 	// SchemaRegistry is only available when the downstream is MQ using avro protocol.
@@ -133,6 +142,38 @@ type SinkConfig struct {
 	// OnlyOutputUpdatedColumns is only available when the downstream is MQ.
 	OnlyOutputUpdatedColumns *bool `toml:"only-output-updated-columns" json:"only-output-updated-columns,omitempty"`
 
+	// SplitUpdateRules decide per table whether the updates are emitted as
+	// delete and insert pairs, the first matched rule takes effect. The
+	// updates of the tables matching no rule are split only if they change
+	// the handle key and the old value is disabled.
+	SplitUpdateRules []*SplitUpdateRule `toml:"split-update-rules" json:"split-update-rules,omitempty"`
+
+	// RouteRules rename the schemas and tables of the row changed events and
+	// the DDLs sent downstream, the first matched rule takes effect. Several
+	// upstream tables can be routed to the same downstream table, e.g. the
+	// shards `db_1.orders` and `db_2.orders` to `db_all.orders`. The dispatch
+	// rules and the column selectors match the routed tables.
+	RouteRules []*RouteRule `toml:"route-rules" json:"route-rules,omitempty"`
+
+	// LargeTxnThresholdInMB is the size of a transaction above which it's
+	// emitted in chunks like transaction-atomicity is none, so that the memory
+	// usage is bounded regardless of the transaction size. 0 means never.
+	// It's only useful when transactions are not split by transaction-atomicity.
+	LargeTxnThresholdInMB *uint64 `toml:"large-txn-threshold-in-mb" json:"large-txn-threshold-in-mb,omitempty"`
+
+	// MetadataColumns are the metadata appended to every row as the columns
+	// _tidb_commit_ts, _cdc_processed_at, _source_cluster_id and _op, which
+	// are one of commit-ts, processed-at, source-cluster-id and op.
+	// It is only available when the downstream is MySQL or Storage. Note the
+	// rows of the tables without a valid index can't be deleted or updated in
+	// MySQL with the metadata columns, since they are matched by all columns.
+	MetadataColumns []string `toml:"metadata-columns" json:"metadata-columns,omitempty"`
+
+	// UpstreamClusterID is the cluster ID of the upstream PD, which is the
+	// value of the _source_cluster_id metadata column.
+	// Note: This field is only used internally.
+	UpstreamClusterID uint64 `toml:"-" json:"-"`
+
 	// TiDBSourceID is the source ID of the upstream TiDB,
 	// which is used to set the `tidb_cdc_write_source` session variable.
 	// Note: This field is only used internally and only used in the MySQL sink.
@@ -155,6 +196,27 @@ type CSVConfig struct {
 	NullString string `toml:"null" json:"null"`
 	// whether to include commit ts
 	IncludeCommitTs bool `toml:"include-commit-ts" json:"include-commit-ts"`
+	// escape character for the quote character in quoted fields,
+	// the quote character is doubled if it is empty
+	Escape string `toml:"escape" json:"escape,omitempty"`
+	// whether to output the column names as the first row of each data file
+	OutputFieldHeader bool `toml:"output-field-header" json:"output-field-header,omitempty"`
+	// encoding method of binary columns, base64 or hex
+	BinaryEncodingMethod string `toml:"binary-encoding-method" json:"binary-encoding-method,omitempty"`
+}
+
+// CanalJSONConfig defines the extension fields of the canal-json protocol,
+// which are output in the `_tidb` field if `enable-tidb-extension` is true.
+type CanalJSONConfig struct {
+	// whether to include the commit ts, it is true by default. The canal-json
+	// decoder of TiCDC refuses the row changed events without the commit ts.
+	IncludeCommitTs *bool `toml:"include-commit-ts" json:"include-commit-ts,omitempty"`
+	// whether to include the changefeed id
+	IncludeChangefeedID bool `toml:"include-changefeed-id" json:"include-changefeed-id,omitempty"`
+	// whether to include the physical table id
+	IncludePhysicalTableID bool `toml:"include-physical-table-id" json:"include-physical-table-id,omitempty"`
+	// user-defined static labels attached to each message
+	Labels map[string]string `toml:"labels" json:"labels,omitempty"`
 }
 
 func (c *CSVConfig) validateAndAdjust() error {
@@ -190,6 +252,36 @@ func (c *CSVConfig) validateAndAdjust() error {
 			errors.New("csv config quote and delimiter cannot be the same"))
 	}
 
+	// validate escape
+	if len(c.Escape) > 1 {
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+			errors.New("csv config escape contains more than one character"))
+	}
+	if len(c.Escape) == 1 {
+		if len(c.Quote) == 0 {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config escape can only be used with quote"))
+		}
+		escape := c.Escape[0]
+		if escape == CR || escape == LF {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config escape cannot be line break character"))
+		}
+		if c.Escape == c.Quote || strings.Contains(c.Delimiter, c.Escape) {
+			return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+				errors.New("csv config escape cannot be the same as quote or delimiter"))
+		}
+	}
+
+	// validate binary encoding method
+	switch c.BinaryEncodingMethod {
+	case "", BinaryEncodingBase64, BinaryEncodingHex:
+	default:
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig,
+			errors.Errorf("csv config binary-encoding-method can only be %s or %s",
+				BinaryEncodingBase64, BinaryEncodingHex))
+	}
+
 	return nil
 }
 
@@ -246,6 +338,85 @@ type DispatchRule struct {
 	// In the future release, the DispatcherRule is expected to be removed .
 	PartitionRule string `toml:"partition" json:"partition"`
 	TopicRule     string `toml:"topic" json:"topic"`
+	// ProtocolRule overrides the protocol of the changefeed for the matched
+	// tables, the row changed, DDL and checkpoint events sent to the topics of
+	// the rule are all encoded by this protocol. The topic of the rule must not
+	// be the default topic or shared with tables of other protocols.
+	ProtocolRule string `toml:"protocol" json:"protocol,omitempty"`
+	// KeyRule is the template of the message key of the row changed events
+	// of the matched tables, e.g. `{schema}.{table}:{column:id}`, it replaces
+	// the key generated by the protocol.
+	KeyRule string `toml:"key" json:"key,omitempty"`
+}
+
+const (
+	// SplitUpdateModeHandleKey splits the updates changing the handle key.
+	SplitUpdateModeHandleKey = "handle-key"
+	// SplitUpdateModeAll splits all the updates.
+	SplitUpdateModeAll = "all"
+	// SplitUpdateModeNone never splits the updates, the old values are kept
+	// even if the old value is disabled.
+	SplitUpdateModeNone = "none"
+)
+
+const (
+	// MetadataColumnCommitTs is the commit ts of the row.
+	MetadataColumnCommitTs = "commit-ts"
+	// MetadataColumnProcessedAt is the UTC time the row is processed by the sink.
+	MetadataColumnProcessedAt = "processed-at"
+	// MetadataColumnSourceClusterID is the cluster ID of the upstream.
+	MetadataColumnSourceClusterID = "source-cluster-id"
+	// MetadataColumnOp is the type of the row change, which is I, U or D.
+	MetadataColumnOp = "op"
+)
+
+// SplitUpdateRule decides whether the updates of the matched tables are
+// emitted as delete and insert pairs.
+type SplitUpdateRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Mode is one of handle-key, all and none.
+	Mode string `toml:"mode" json:"mode"`
+}
+
+func (r *SplitUpdateRule) validateAndAdjust() error {
+	if _, err := filter.Parse(r.Matcher); err != nil {
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+	r.Mode = strings.ToLower(strings.TrimSpace(r.Mode))
+	switch r.Mode {
+	case SplitUpdateModeHandleKey, SplitUpdateModeAll, SplitUpdateModeNone:
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"split update mode %s must be one of %s, %s and %s for rule:%v",
+			r.Mode, SplitUpdateModeHandleKey, SplitUpdateModeAll, SplitUpdateModeNone, r.Matcher)
+	}
+	return nil
+}
+
+// RouteRule renames the schema and table of the tables matching a pattern.
+type RouteRule struct {
+	// Pattern is a regular expression matching the whole `schema.table` of
+	// the upstream tables, e.g. `db_(\d+)\.orders`.
+	Pattern string `toml:"pattern" json:"pattern"`
+	// TargetSchema and TargetTable are the downstream schema and table names,
+	// which can refer to the capture groups of the pattern like `${1}`. An
+	// empty name keeps the upstream name.
+	TargetSchema string `toml:"target-schema" json:"target-schema"`
+	TargetTable  string `toml:"target-table" json:"target-table"`
+}
+
+func (r *RouteRule) validate() error {
+	if r.Pattern == "" {
+		return cerror.ErrSinkInvalidConfig.GenWithStack("route rule pattern is empty")
+	}
+	if _, err := regexp.Compile(r.Pattern); err != nil {
+		return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+	}
+	if r.TargetSchema == "" && r.TargetTable == "" {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"route rule %s must have a target schema or a target table", r.Pattern)
+	}
+	return nil
 }
 
 // ColumnSelector represents a column selector for a table.
@@ -261,6 +432,19 @@ type CodecConfig struct {
 	AvroEnableWatermark            *bool   `toml:"avro-enable-watermark" json:"avro-enable-watermark"`
 	AvroDecimalHandlingMode        *string `toml:"avro-decimal-handling-mode" json:"avro-decimal-handling-mode,omitempty"`
 	AvroBigintUnsignedHandlingMode *string `toml:"avro-bigint-unsigned-handling-mode" json:"avro-bigint-unsigned-handling-mode,omitempty"`
+	AvroTimePrecisionMode          *string `toml:"avro-time-precision-mode" json:"avro-time-precision-mode,omitempty"`
+	AvroEnumHandlingMode           *string `toml:"avro-enum-handling-mode" json:"avro-enum-handling-mode,omitempty"`
+	AvroSetHandlingMode            *string `toml:"avro-set-handling-mode" json:"avro-set-handling-mode,omitempty"`
+	AvroNullableUnionOrder         *string `toml:"avro-nullable-union-order" json:"avro-nullable-union-order,omitempty"`
+	AvroEnableSchemaChangeEvent    *bool   `toml:"avro-enable-schema-change-event" json:"avro-enable-schema-change-event,omitempty"`
+	OpenProtocolVersion            *uint64 `toml:"open-protocol-version" json:"open-protocol-version,omitempty"`
+	EnableMessageChunking          *bool   `toml:"enable-message-chunking" json:"enable-message-chunking,omitempty"`
+	EnableEncodingChecksum         *bool   `toml:"enable-encoding-checksum" json:"enable-encoding-checksum,omitempty"`
+
+	// EncoderPlugin is the path of the encoder plugin used by the plugin
+	// protocol, and EncoderPluginParams are passed to the plugin.
+	EncoderPlugin       *string           `toml:"encoder-plugin" json:"encoder-plugin,omitempty"`
+	EncoderPluginParams map[string]string `toml:"encoder-plugin-params" json:"encoder-plugin-params,omitempty"`
 }
 
 // KafkaConfig represents a kafka sink configuration
@@ -356,6 +540,40 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 			rule.PartitionRule = rule.DispatcherRule
 			rule.DispatcherRule = ""
 		}
+		if rule.ProtocolRule != "" {
+			protocol, err := ParseSinkProtocolFromString(rule.ProtocolRule)
+			if err != nil {
+				return cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+			}
+			if protocol == ProtocolCsv {
+				return cerror.ErrSinkInvalidConfig.GenWithStack(
+					"protocol %s cannot be used in the dispatch rule:%v", rule.ProtocolRule, rule)
+			}
+			if !enableOldValue {
+				for _, protocolStr := range ForceEnableOldValueProtocols {
+					if protocolStr == rule.ProtocolRule {
+						return cerror.WrapError(cerror.ErrKafkaInvalidConfig,
+							errors.New(fmt.Sprintf("%s protocol requires old value to be enabled", rule.ProtocolRule)))
+					}
+				}
+			}
+		}
+	}
+
+	for _, rule := range s.SplitUpdateRules {
+		if err := rule.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range s.RouteRules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.validateAndAdjustMetadataColumns(sinkURI); err != nil {
+		return err
 	}
 
 	if util.GetOrZero(s.EncoderConcurrency) < 0 {
@@ -395,6 +613,38 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableOldValue bool) er
 	return nil
 }
 
+func (s *SinkConfig) validateAndAdjustMetadataColumns(sinkURI *url.URL) error {
+	if len(s.MetadataColumns) == 0 {
+		return nil
+	}
+	if sinkURI != nil && !sink.IsMySQLCompatibleScheme(sinkURI.Scheme) &&
+		!sink.IsStorageScheme(sinkURI.Scheme) {
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"metadata-columns is only available when the downstream is MySQL or Storage, "+
+				"but got %s", sinkURI.Scheme)
+	}
+	seen := make(map[string]struct{}, len(s.MetadataColumns))
+	for i, column := range s.MetadataColumns {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case MetadataColumnCommitTs, MetadataColumnProcessedAt,
+			MetadataColumnSourceClusterID, MetadataColumnOp:
+		default:
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"metadata column %s must be one of %s, %s, %s and %s", column,
+				MetadataColumnCommitTs, MetadataColumnProcessedAt,
+				MetadataColumnSourceClusterID, MetadataColumnOp)
+		}
+		if _, ok := seen[column]; ok {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"metadata column %s is duplicated", column)
+		}
+		seen[column] = struct{}{}
+		s.MetadataColumns[i] = column
+	}
+	return nil
+}
+
 // validateAndAdjustSinkURI validate and adjust `Protocol` and `TxnAtomicity` by sinkURI.
 func (s *SinkConfig) validateAndAdjustSinkURI(sinkURI *url.URL) error {
 	if sinkURI == nil {
@@ -38,6 +38,10 @@ const (
 	ProtocolCraft
 	ProtocolOpen
 	ProtocolCsv
+	ProtocolDebezium
+	ProtocolProtobuf
+	ProtocolSimpleJSON
+	ProtocolPlugin
 )
 
 // IsBatchEncode returns whether the protocol is a batch encoder.
@@ -66,6 +70,14 @@ func ParseSinkProtocolFromString(protocol string) (Protocol, error) {
 		return ProtocolOpen, nil
 	case "csv":
 		return ProtocolCsv, nil
+	case "debezium":
+		return ProtocolDebezium, nil
+	case "protobuf":
+		return ProtocolProtobuf, nil
+	case "simple-json":
+		return ProtocolSimpleJSON, nil
+	case "plugin":
+		return ProtocolPlugin, nil
 	default:
 		return ProtocolUnknown, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(protocol)
 	}
@@ -90,6 +102,14 @@ func (p Protocol) String() string {
 		return "open-protocol"
 	case ProtocolCsv:
 		return "csv"
+	case ProtocolDebezium:
+		return "debezium"
+	case ProtocolProtobuf:
+		return "protobuf"
+	case ProtocolSimpleJSON:
+		return "simple-json"
+	case ProtocolPlugin:
+		return "plugin"
 	default:
 		panic("unreachable")
 	}
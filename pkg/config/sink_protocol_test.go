@@ -62,6 +62,22 @@ func TestParseSinkProtocolFromString(t *testing.T) {
 			protocol:             "open-protocol",
 			expectedProtocolEnum: ProtocolOpen,
 		},
+		{
+			protocol:             "debezium",
+			expectedProtocolEnum: ProtocolDebezium,
+		},
+		{
+			protocol:             "protobuf",
+			expectedProtocolEnum: ProtocolProtobuf,
+		},
+		{
+			protocol:             "simple-json",
+			expectedProtocolEnum: ProtocolSimpleJSON,
+		},
+		{
+			protocol:             "plugin",
+			expectedProtocolEnum: ProtocolPlugin,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -109,6 +125,22 @@ func TestString(t *testing.T) {
 			protocolEnum:     ProtocolOpen,
 			expectedProtocol: "open-protocol",
 		},
+		{
+			protocolEnum:     ProtocolDebezium,
+			expectedProtocol: "debezium",
+		},
+		{
+			protocolEnum:     ProtocolProtobuf,
+			expectedProtocol: "protobuf",
+		},
+		{
+			protocolEnum:     ProtocolSimpleJSON,
+			expectedProtocol: "simple-json",
+		},
+		{
+			protocolEnum:     ProtocolPlugin,
+			expectedProtocol: "plugin",
+		},
 	}
 
 	for _, tc := range testCases {
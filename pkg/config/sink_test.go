@@ -82,6 +82,182 @@ func TestValidateOldValue(t *testing.T) {
 	}
 }
 
+func TestValidateDispatchRuleProtocol(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		protocol       string
+		enableOldValue bool
+		expectedErr    string
+	}{
+		{
+			protocol:       "avro",
+			enableOldValue: false,
+			expectedErr:    "",
+		},
+		{
+			protocol:       "canal-json",
+			enableOldValue: false,
+			expectedErr:    ".*canal-json protocol requires old value to be enabled.*",
+		},
+		{
+			protocol:       "canal-json",
+			enableOldValue: true,
+			expectedErr:    "",
+		},
+		{
+			protocol:       "csv",
+			enableOldValue: true,
+			expectedErr:    ".*protocol csv cannot be used in the dispatch rule.*",
+		},
+		{
+			protocol:       "whatever",
+			enableOldValue: true,
+			expectedErr:    ".*whatever.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := SinkConfig{
+			Protocol: util.AddressOf("default"),
+			DispatchRules: []*DispatchRule{
+				{Matcher: []string{"test.*"}, ProtocolRule: tc.protocol},
+			},
+		}
+		if tc.expectedErr == "" {
+			require.Nil(t, cfg.validateAndAdjust(nil, tc.enableOldValue))
+		} else {
+			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjust(nil, tc.enableOldValue))
+		}
+	}
+}
+
+func TestValidateSplitUpdateRules(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		matcher      []string
+		mode         string
+		expectedMode string
+		expectedErr  string
+	}{
+		{
+			matcher:      []string{"test.*"},
+			mode:         " ALL ",
+			expectedMode: SplitUpdateModeAll,
+		},
+		{
+			matcher:      []string{"test.*"},
+			mode:         "handle-key",
+			expectedMode: SplitUpdateModeHandleKey,
+		},
+		{
+			matcher:     []string{"test.*"},
+			mode:        "pk",
+			expectedErr: ".*split update mode pk must be one of.*",
+		},
+		{
+			matcher:     []string{"test.!"},
+			mode:        "none",
+			expectedErr: ".*ErrSinkInvalidConfig.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := SinkConfig{
+			SplitUpdateRules: []*SplitUpdateRule{{Matcher: tc.matcher, Mode: tc.mode}},
+		}
+		if tc.expectedErr == "" {
+			require.Nil(t, cfg.validateAndAdjust(nil, true))
+			require.Equal(t, tc.expectedMode, cfg.SplitUpdateRules[0].Mode)
+		} else {
+			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjust(nil, true))
+		}
+	}
+}
+
+func TestValidateRouteRules(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		rule        *RouteRule
+		expectedErr string
+	}{
+		{
+			rule: &RouteRule{Pattern: `db_(\d+)\.orders`, TargetSchema: "db_all"},
+		},
+		{
+			rule: &RouteRule{Pattern: `db_1\.(.*)`, TargetTable: "${1}_1"},
+		},
+		{
+			rule:        &RouteRule{TargetSchema: "db_all"},
+			expectedErr: ".*route rule pattern is empty.*",
+		},
+		{
+			rule:        &RouteRule{Pattern: `db_(\d+`, TargetSchema: "db_all"},
+			expectedErr: ".*ErrSinkInvalidConfig.*",
+		},
+		{
+			rule:        &RouteRule{Pattern: `db_(\d+)\.orders`},
+			expectedErr: ".*must have a target schema or a target table.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := SinkConfig{RouteRules: []*RouteRule{tc.rule}}
+		if tc.expectedErr == "" {
+			require.Nil(t, cfg.validateAndAdjust(nil, true))
+		} else {
+			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjust(nil, true))
+		}
+	}
+}
+
+func TestValidateMetadataColumns(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		uri             string
+		columns         []string
+		expectedColumns []string
+		expectedErr     string
+	}{
+		{
+			uri:             "mysql://127.0.0.1:3306",
+			columns:         []string{" Commit-TS", "op"},
+			expectedColumns: []string{MetadataColumnCommitTs, MetadataColumnOp},
+		},
+		{
+			uri:             "s3://bucket/prefix?protocol=csv",
+			columns:         []string{"processed-at", "source-cluster-id"},
+			expectedColumns: []string{MetadataColumnProcessedAt, MetadataColumnSourceClusterID},
+		},
+		{
+			uri:         "kafka://127.0.0.1:9092?protocol=canal-json",
+			columns:     []string{"op"},
+			expectedErr: ".*only available when the downstream is MySQL or Storage.*",
+		},
+		{
+			uri:         "mysql://127.0.0.1:3306",
+			columns:     []string{"schema"},
+			expectedErr: ".*metadata column schema must be one of.*",
+		},
+		{
+			uri:         "mysql://127.0.0.1:3306",
+			columns:     []string{"op", "OP"},
+			expectedErr: ".*metadata column op is duplicated.*",
+		},
+	}
+
+	for _, tc := range testCases {
+		sinkURI, err := url.Parse(tc.uri)
+		require.Nil(t, err)
+		cfg := SinkConfig{MetadataColumns: tc.columns}
+		if tc.expectedErr == "" {
+			require.Nil(t, cfg.validateAndAdjustMetadataColumns(sinkURI))
+			require.Equal(t, tc.expectedColumns, cfg.MetadataColumns)
+		} else {
+			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjustMetadataColumns(sinkURI))
+		}
+	}
+}
+
 func TestValidateTxnAtomicity(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -393,6 +569,50 @@ func TestValidateAndAdjustCSVConfig(t *testing.T) {
 			},
 			wantErr: "csv config quote and delimiter cannot be the same",
 		},
+		{
+			name: "valid escape",
+			config: &CSVConfig{
+				Quote:     "\"",
+				Delimiter: ",",
+				Escape:    "\\",
+			},
+			wantErr: "",
+		},
+		{
+			name: "valid non-backslash escape",
+			config: &CSVConfig{
+				Quote:     "\"",
+				Delimiter: ",",
+				Escape:    "'",
+			},
+			wantErr: "",
+		},
+		{
+			name: "escape without quote",
+			config: &CSVConfig{
+				Delimiter: ",",
+				Escape:    "\\",
+			},
+			wantErr: "csv config escape can only be used with quote",
+		},
+		{
+			name: "escape and quote are same",
+			config: &CSVConfig{
+				Quote:     "'",
+				Delimiter: ",",
+				Escape:    "'",
+			},
+			wantErr: "csv config escape cannot be the same as quote or delimiter",
+		},
+		{
+			name: "invalid binary encoding method",
+			config: &CSVConfig{
+				Quote:                "\"",
+				Delimiter:            ",",
+				BinaryEncodingMethod: "base32",
+			},
+			wantErr: "csv config binary-encoding-method can only be base64 or hex",
+		},
 	}
 	for _, c := range tests {
 		tc := c
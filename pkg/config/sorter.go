@@ -27,6 +27,30 @@ type SorterConfig struct {
 	// Cache size of sorter in MB.
 	CacheSizeInMB uint64 `toml:"cache-size-in-mb" json:"cache-size-in-mb"`
 
+	// EnableSharedTableCache enables sharing the pulled and sorted events of a
+	// table between all changefeeds replicating it on the capture.
+	EnableSharedTableCache bool `toml:"enable-shared-table-cache" json:"enable-shared-table-cache"`
+
+	// SpillThreshold is the fraction of the sorter cache used to buffer
+	// the incoming events in memory, the events are spilled to disk once
+	// the buffers are full. 0 means using debug.db.writer-buffer-size.
+	SpillThreshold float64 `toml:"spill-threshold" json:"spill-threshold"`
+	// SpillCompression is the compression algorithm of the spilled files,
+	// "none" or "snappy". Empty means using debug.db.compression.
+	SpillCompression string `toml:"spill-compression" json:"spill-compression"`
+	// MaxDiskUsagePerChangefeedInMB is the max size of the spilled events of
+	// a changefeed, pulling events of the changefeed is paused once it's
+	// exceeded until some events are consumed. 0 means unlimited.
+	MaxDiskUsagePerChangefeedInMB uint64 `toml:"max-disk-usage-per-changefeed-in-mb" json:"max-disk-usage-per-changefeed-in-mb"`
+	// EncryptionKeyFile is the path of the file containing the hex encoded
+	// AES key used to encrypt the sorter data on disk, the data is not
+	// encrypted if it is empty.
+	EncryptionKeyFile string `toml:"encryption-key-file" json:"encryption-key-file"`
+	// EnableBackpressure pauses pulling events of a changefeed when its sink
+	// falls behind, i.e. the memory quota of the sink is exhausted, so that
+	// the events are not piled up in the sorter.
+	EnableBackpressure bool `toml:"enable-backpressure" json:"enable-backpressure"`
+
 	// the maximum memory use percentage that allows in-memory sorting
 	// Deprecated: use CacheSizeInMB instead.
 	MaxMemoryPercentage int `toml:"max-memory-percentage" json:"max-memory-percentage"`
@@ -50,5 +74,29 @@ func (c *SorterConfig) ValidateAndAdjust() error {
 	if c.CacheSizeInMB < 8 || c.CacheSizeInMB*uint64(1<<20) > uint64(math.MaxInt64) {
 		return errors.ErrIllegalSorterParameter.GenWithStackByArgs("cache-size-in-mb should be greater than 8(MB)")
 	}
+	if c.SpillThreshold < 0 || c.SpillThreshold >= 1 {
+		return errors.ErrIllegalSorterParameter.GenWithStackByArgs("spill-threshold should be in [0, 1)")
+	}
+	if c.SpillCompression != "" && c.SpillCompression != "none" && c.SpillCompression != "snappy" {
+		return errors.ErrIllegalSorterParameter.GenWithStackByArgs(
+			"spill-compression must be empty, \"none\" or \"snappy\"")
+	}
 	return nil
 }
+
+// ApplySpillPolicy applies the spill policy to the db sorter configuration.
+// It returns the adjusted copy of the db configuration and the size of the
+// block cache, which is the part of the sorter cache not used for buffering.
+func (c *SorterConfig) ApplySpillPolicy(db *DBConfig) (*DBConfig, uint64) {
+	cfg := *db
+	cacheInBytes := c.CacheSizeInMB * uint64(1<<20)
+	if c.SpillCompression != "" {
+		cfg.Compression = c.SpillCompression
+	}
+	if c.SpillThreshold > 0 {
+		bufferInBytes := uint64(float64(cacheInBytes) * c.SpillThreshold)
+		cfg.WriterBufferSize = int(bufferInBytes / uint64(cfg.Count))
+		cacheInBytes -= bufferInBytes
+	}
+	return &cfg, cacheInBytes
+}
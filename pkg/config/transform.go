@@ -0,0 +1,167 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	filter "github.com/pingcap/tidb/util/table-filter"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// MaskFunctionSHA256 replaces the value with its hex encoded SHA-256 hash.
+	MaskFunctionSHA256 = "sha256"
+	// MaskFunctionLast4 replaces all the characters of the value except the
+	// last 4 ones with '*'.
+	MaskFunctionLast4 = "last4"
+	// MaskFunctionNullify replaces the value with NULL.
+	MaskFunctionNullify = "nullify"
+	// MaskFunctionFixed replaces the value with a fixed value.
+	MaskFunctionFixed = "fixed"
+)
+
+const (
+	// ComputeFunctionConcat joins the values of the columns by the separator.
+	ComputeFunctionConcat = "concat"
+	// ComputeFunctionBucket divides the value of the column by the bucket size
+	// and rounds it down.
+	ComputeFunctionBucket = "bucket"
+	// ComputeFunctionCommitTs is the commit ts of the row change.
+	ComputeFunctionCommitTs = "commit-ts"
+	// ComputeFunctionCommitDate is the UTC commit time of the row change
+	// formatted by the Go time layout.
+	ComputeFunctionCommitDate = "commit-date"
+
+	defaultCommitDateFormat = "2006-01-02"
+)
+
+// TransformConfig represents the transformations applied to the row changes
+// of a changefeed by the processors before they are sent to the sink.
+type TransformConfig struct {
+	MaskRules       []*MaskRule       `toml:"mask-rules" json:"mask-rules,omitempty"`
+	ComputedColumns []*ComputedColumn `toml:"computed-columns" json:"computed-columns,omitempty"`
+}
+
+// MaskRule masks the values of the matched columns of the matched tables.
+type MaskRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Columns are the case insensitive patterns of the names of the masked
+	// columns, `*` matches any sequence of characters and `?` matches any
+	// single character.
+	Columns []string `toml:"columns" json:"columns"`
+	// Function is one of sha256, last4, nullify and fixed.
+	Function string `toml:"function" json:"function"`
+	// Value is the value of the fixed function.
+	Value string `toml:"value" json:"value,omitempty"`
+}
+
+// ComputedColumn appends a column computed from the columns or the metadata
+// of the row changes to the row changes of the matched tables. The masked
+// values are used if the source columns are masked.
+type ComputedColumn struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Name is the name of the computed column, it must not be the name of an
+	// existing column.
+	Name string `toml:"name" json:"name"`
+	// Function is one of concat, bucket, commit-ts and commit-date.
+	Function string `toml:"function" json:"function"`
+	// Columns are the source columns of concat and bucket, bucket requires
+	// exactly one numeric column.
+	Columns []string `toml:"columns" json:"columns,omitempty"`
+	// Separator is the separator of concat.
+	Separator string `toml:"separator" json:"separator,omitempty"`
+	// BucketSize is the size of the buckets of bucket.
+	BucketSize int64 `toml:"bucket-size" json:"bucket-size,omitempty"`
+	// Format is the Go time layout of commit-date, it's 2006-01-02 by default.
+	Format string `toml:"format" json:"format,omitempty"`
+}
+
+func (c *ComputedColumn) validateAndAdjust() error {
+	if _, err := filter.Parse(c.Matcher); err != nil {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The transform.computed-columns.matcher:%v is invalid: %s",
+				c.Matcher, err.Error()))
+	}
+	if strings.TrimSpace(c.Name) == "" {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The transform.computed-columns.name of the matcher %v must not be empty",
+				c.Matcher))
+	}
+	c.Function = strings.ToLower(strings.TrimSpace(c.Function))
+	switch c.Function {
+	case ComputeFunctionConcat:
+		if len(c.Columns) == 0 {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The transform.computed-columns.columns of %s must not be empty", c.Name))
+		}
+	case ComputeFunctionBucket:
+		if len(c.Columns) != 1 || c.BucketSize <= 0 {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The bucket function of %s requires one column and a positive bucket-size",
+					c.Name))
+		}
+	case ComputeFunctionCommitTs:
+	case ComputeFunctionCommitDate:
+		if c.Format == "" {
+			c.Format = defaultCommitDateFormat
+		}
+	default:
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The transform.computed-columns.function:%s must be one of %s, %s, %s and %s",
+				c.Function, ComputeFunctionConcat, ComputeFunctionBucket,
+				ComputeFunctionCommitTs, ComputeFunctionCommitDate))
+	}
+	return nil
+}
+
+// ValidateAndAdjust validates the transform config.
+func (c *TransformConfig) ValidateAndAdjust() error {
+	for _, rule := range c.MaskRules {
+		if _, err := filter.Parse(rule.Matcher); err != nil {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The transform.mask-rules.matcher:%v is invalid: %s",
+					rule.Matcher, err.Error()))
+		}
+		if len(rule.Columns) == 0 {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The transform.mask-rules.columns of the matcher %v must not be empty",
+					rule.Matcher))
+		}
+		for _, pattern := range rule.Columns {
+			if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+				return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+					fmt.Sprintf("The transform.mask-rules.columns:%s is an invalid pattern",
+						pattern))
+			}
+		}
+		rule.Function = strings.ToLower(strings.TrimSpace(rule.Function))
+		switch rule.Function {
+		case MaskFunctionSHA256, MaskFunctionLast4, MaskFunctionNullify, MaskFunctionFixed:
+		default:
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				fmt.Sprintf("The transform.mask-rules.function:%s must be one of %s, %s, %s and %s",
+					rule.Function, MaskFunctionSHA256, MaskFunctionLast4,
+					MaskFunctionNullify, MaskFunctionFixed))
+		}
+	}
+	for _, column := range c.ComputedColumns {
+		if err := column.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
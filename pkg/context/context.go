@@ -19,6 +19,8 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
+	"github.com/pingcap/tiflow/cdc/processor/sourcemanager"
 	"github.com/pingcap/tiflow/cdc/processor/sourcemanager/engine/factory"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/etcd"
@@ -36,6 +38,10 @@ type GlobalVars struct {
 
 	// SortEngineManager is introduced for pull-based sinks.
 	SortEngineFactory *factory.SortEngineFactory
+	// SharedTableCache is nil if sorter.enable-shared-table-cache is false.
+	SharedTableCache *sourcemanager.SharedTableCache
+	// MemQuotaAllocator is nil if adaptive-memory-quota.enable is false.
+	MemQuotaAllocator *memquota.Allocator
 
 	// OwnerRevision is the Etcd revision when the owner got elected.
 	OwnerRevision int64
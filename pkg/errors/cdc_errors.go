@@ -199,6 +199,10 @@ var (
 		"invalid topic expression",
 		errors.RFCCodeText("CDC:ErrKafkaTopicExprInvalid"),
 	)
+	ErrKafkaInvalidKeyTemplate = errors.Normalize(
+		"invalid message key template %s",
+		errors.RFCCodeText("CDC:ErrKafkaInvalidKeyTemplate"),
+	)
 	ErrKafkaBrokerConfigNotFound = errors.Normalize(
 		"kafka broker config item not found",
 		errors.RFCCodeText("CDC:ErrKafkaBrokerConfigNotFound"),
@@ -227,6 +231,18 @@ var (
 		"initialize meta for redo log",
 		errors.RFCCodeText("CDC:ErrRedoMetaInitialize"),
 	)
+	ErrRedoEncryption = errors.Normalize(
+		"redo log encryption failed",
+		errors.RFCCodeText("CDC:ErrRedoEncryption"),
+	)
+	ErrRedoApplyProgressInvalid = errors.Normalize(
+		"invalid redo apply progress file %s",
+		errors.RFCCodeText("CDC:ErrRedoApplyProgressInvalid"),
+	)
+	ErrRedoVerifyFailed = errors.Normalize(
+		"redo log verification failed, %d problems found",
+		errors.RFCCodeText("CDC:ErrRedoVerifyFailed"),
+	)
 	ErrFileSizeExceed = errors.Normalize(
 		"rawData size %d exceeds maximum file size %d",
 		errors.RFCCodeText("CDC:ErrFileSizeExceed"),
@@ -308,6 +324,30 @@ var (
 		"maxwell invalid data",
 		errors.RFCCodeText("CDC:ErrMaxwellInvalidData"),
 	)
+	ErrDebeziumEncodeFailed = errors.Normalize(
+		"debezium encode failed",
+		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
+	)
+	ErrProtobufEncodeFailed = errors.Normalize(
+		"protobuf encode failed",
+		errors.RFCCodeText("CDC:ErrProtobufEncodeFailed"),
+	)
+	ErrEncoderPluginInvalid = errors.Normalize(
+		"encoder plugin %s is invalid",
+		errors.RFCCodeText("CDC:ErrEncoderPluginInvalid"),
+	)
+	ErrEncoderPluginEncodeFailed = errors.Normalize(
+		"encoder plugin encode failed",
+		errors.RFCCodeText("CDC:ErrEncoderPluginEncodeFailed"),
+	)
+	ErrSimpleJSONEncodeFailed = errors.Normalize(
+		"simple json encode failed",
+		errors.RFCCodeText("CDC:ErrSimpleJSONEncodeFailed"),
+	)
+	ErrProtobufSchemaAPIError = errors.Normalize(
+		"protobuf schema registry API error",
+		errors.RFCCodeText("CDC:ErrProtobufSchemaAPIError"),
+	)
 	ErrOpenProtocolCodecInvalidData = errors.Normalize(
 		"open-protocol codec invalid data",
 		errors.RFCCodeText("CDC:ErrOpenProtocolCodecInvalidData"),
@@ -415,6 +455,14 @@ var (
 		"codec decode error",
 		errors.RFCCodeText("CDC:ErrCodecDecode"),
 	)
+	ErrCodecRowChecksumMismatch = errors.Normalize(
+		"row checksum mismatch, expected %d, actual %d",
+		errors.RFCCodeText("CDC:ErrCodecRowChecksumMismatch"),
+	)
+	ErrCodecRowChecksumMissing = errors.Normalize(
+		"row checksum not found in the message, enable-encoding-checksum should be enabled",
+		errors.RFCCodeText("CDC:ErrCodecRowChecksumMissing"),
+	)
 	ErrUnknownMetaType = errors.Normalize(
 		"unknown meta type %v",
 		errors.RFCCodeText("CDC:ErrUnknownMetaType"),
@@ -556,6 +604,26 @@ var (
 		"failed to get PDClient to connect PD, please recheck",
 		errors.RFCCodeText("CDC:ErrAPIGetPDClientFailed"),
 	)
+	ErrUnauthorized = errors.Normalize(
+		"unauthorized: %s",
+		errors.RFCCodeText("CDC:ErrUnauthorized"),
+	)
+	ErrPermissionDenied = errors.Normalize(
+		"permission denied: %s",
+		errors.RFCCodeText("CDC:ErrPermissionDenied"),
+	)
+	ErrAuthTokenNotFound = errors.Normalize(
+		"auth token %s not found",
+		errors.RFCCodeText("CDC:ErrAuthTokenNotFound"),
+	)
+	ErrAuditLogDisabled = errors.Normalize(
+		"audit log is not enabled",
+		errors.RFCCodeText("CDC:ErrAuditLogDisabled"),
+	)
+	ErrAPIRateLimited = errors.Normalize(
+		"too many requests, %s",
+		errors.RFCCodeText("CDC:ErrAPIRateLimited"),
+	)
 	ErrRequestForwardErr = errors.Normalize(
 		"request forward error, an request can only forward to owner one time",
 		errors.RFCCodeText("ErrRequestForwardErr"),
@@ -603,6 +671,22 @@ var (
 			"if you want to ignore these tables, please set ignore_ineligible_table to true",
 		errors.RFCCodeText("CDC:ErrTableIneligible"),
 	)
+	ErrTableNotReplicated = errors.Normalize(
+		"table %d is not replicated by changefeed %s",
+		errors.RFCCodeText("CDC:ErrTableNotReplicated"),
+	)
+	ErrTableNotPaused = errors.Normalize(
+		"table %d of changefeed %s is not paused",
+		errors.RFCCodeText("CDC:ErrTableNotPaused"),
+	)
+	ErrPauseTableNotSupported = errors.Normalize(
+		"pausing tables of changefeed %s is not supported: %s",
+		errors.RFCCodeText("CDC:ErrPauseTableNotSupported"),
+	)
+	ErrInvalidErrorHandle = errors.Normalize(
+		"invalid error handle: %s",
+		errors.RFCCodeText("CDC:ErrInvalidErrorHandle"),
+	)
 
 	// EtcdWorker related errors. Internal use only.
 	// ErrEtcdTryAgain is used by a PatchFunc to force a transaction abort.
@@ -676,6 +760,10 @@ var (
 		"illegal parameter for sorter: %s",
 		errors.RFCCodeText("CDC:ErrIllegalSorterParameter"),
 	)
+	ErrSorterEncryption = errors.Normalize(
+		"sorter encryption error",
+		errors.RFCCodeText("CDC:ErrSorterEncryption"),
+	)
 	ErrConflictingFileLocks = errors.Normalize(
 		"file lock conflict: %s",
 		errors.RFCCodeText("ErrConflictingFileLocks"),
@@ -830,6 +918,18 @@ var (
 		"command '%s' is aborted by user",
 		errors.RFCCodeText("CDC:ErrCliAborted"),
 	)
+	ErrCliInvalidArgument = errors.Normalize(
+		"invalid argument: %s",
+		errors.RFCCodeText("CDC:ErrCliInvalidArgument"),
+	)
+	ErrCliWaitTimeout = errors.Normalize(
+		"timed out waiting for changefeed %s to reach %s after %s, the current state is %s",
+		errors.RFCCodeText("CDC:ErrCliWaitTimeout"),
+	)
+	ErrCliWaitConditionUnreachable = errors.Normalize(
+		"changefeed %s can't reach %s, the current state is %s",
+		errors.RFCCodeText("CDC:ErrCliWaitConditionUnreachable"),
+	)
 	// Filter error
 	ErrFailedToFilterDML = errors.Normalize(
 		"failed to filter dml event: %v, please report a bug",
@@ -847,6 +947,27 @@ var (
 		"invalid ignore event type: '%s'",
 		errors.RFCCodeText("CDC:ErrInvalidIgnoreEventType"),
 	)
+	ErrColumnFilterHandleKey = errors.Normalize(
+		"the column filter can't ignore the column '%s' of table '%s', it's a part of the handle key",
+		errors.RFCCodeText("CDC:ErrColumnFilterHandleKey"),
+	)
+	ErrMaskHandleKey = errors.Normalize(
+		"the mask rules can't mask the column '%s' of table '%s', it's a part of the handle key",
+		errors.RFCCodeText("CDC:ErrMaskHandleKey"),
+	)
+	ErrInvalidComputedColumn = errors.Normalize(
+		"the computed column '%s' of table '%s' is invalid: %s",
+		errors.RFCCodeText("CDC:ErrInvalidComputedColumn"),
+	)
+	ErrInvalidDDLType = errors.Normalize(
+		"invalid ddl type: '%s'",
+		errors.RFCCodeText("CDC:ErrInvalidDDLType"),
+	)
+	ErrDDLBlockedByFilter = errors.Normalize(
+		"ddl '%s' of type '%s' is blocked by the event filter, "+
+			"skip it by the error handling of the changefeed or update the event filter",
+		errors.RFCCodeText("CDC:ErrDDLBlockedByFilter"),
+	)
 	ErrConvertDDLToEventTypeFailed = errors.Normalize(
 		"failed to convert ddl '%s' to filter event type",
 		errors.RFCCodeText("CDC:ErrConvertDDLToEventTypeFailed"),
@@ -73,6 +73,7 @@ func IsChangefeedFastFailErrorCode(errCode errors.RFCErrorCode) bool {
 var changefeedUnRetryableErrors = []*errors.Error{
 	ErrExpressionColumnNotFound,
 	ErrExpressionParseFailed,
+	ErrDDLBlockedByFilter,
 	ErrSchemaSnapshotNotFound,
 	ErrSyncRenameTableFailed,
 	ErrChangefeedUnretryable,
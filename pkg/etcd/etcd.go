@@ -39,6 +39,10 @@ import (
 // DefaultCDCClusterID is the default value of cdc cluster id
 const DefaultCDCClusterID = "default"
 
+// updateChangeFeedInfoMaxRetry is the max number of attempts to update a
+// changefeed info which is modified concurrently.
+const updateChangeFeedInfoMaxRetry = 5
+
 // CaptureOwnerKey is the capture owner path that is saved to etcd
 func CaptureOwnerKey(clusterID string) string {
 	return BaseKey(clusterID) + metaPrefix + "/owner"
@@ -97,6 +101,13 @@ func MigrateBackupKey(version int, backupKey string) string {
 	return fmt.Sprintf("%s/%d/%s", migrateBackupPrefix, version, backupKey)
 }
 
+// AuthTokenKeyPrefix is the prefix of the keys of the HTTP API tokens.
+// The tokens are not saved under the BaseKey of the cluster, so the owner
+// does not watch them.
+func AuthTokenKeyPrefix(clusterID string) string {
+	return fmt.Sprintf("%s/%s/token", authPrefix, clusterID)
+}
+
 // CDCEtcdClient extracts CDCEtcdClients's method used for apiv2.
 type CDCEtcdClient interface {
 	GetClusterID() string
@@ -115,6 +126,10 @@ type CDCEtcdClient interface {
 		id model.ChangeFeedID,
 	) (*model.ChangeFeedInfo, error)
 
+	GetChangeFeedInfoRaw(ctx context.Context,
+		id model.ChangeFeedID,
+	) ([]byte, error)
+
 	GetAllChangeFeedInfo(ctx context.Context) (
 		map[model.ChangeFeedID]*model.ChangeFeedInfo, error,
 	)
@@ -149,6 +164,11 @@ type CDCEtcdClient interface {
 		changeFeedID model.ChangeFeedID,
 	) error
 
+	UpdateChangeFeedInfo(ctx context.Context,
+		changeFeedID model.ChangeFeedID,
+		update func(*model.ChangeFeedInfo) error,
+	) (*model.ChangeFeedInfo, error)
+
 	PutCaptureInfo(context.Context, *model.CaptureInfo, clientv3.LeaseID) error
 
 	DeleteCaptureInfo(context.Context, model.CaptureID) error
@@ -231,7 +251,8 @@ func (c *CDCEtcdClientImpl) CheckMultipleCDCClusterExist(ctx context.Context) er
 	for _, kv := range resp.Kvs {
 		key := string(kv.Key)
 		if strings.HasPrefix(key, BaseKey(DefaultCDCClusterID)) ||
-			strings.HasPrefix(key, migrateBackupPrefix) {
+			strings.HasPrefix(key, migrateBackupPrefix) ||
+			strings.HasPrefix(key, authPrefix) {
 			continue
 		}
 		// skip the reserved cluster id
@@ -311,7 +332,22 @@ func (c *CDCEtcdClientImpl) GetChangeFeedInfo(ctx context.Context,
 	return detail, errors.Trace(err)
 }
 
-// DeleteChangeFeedInfo deletes a changefeed config from etcd
+// GetChangeFeedInfoRaw queries the raw value of a changefeed config stored in
+// etcd, before it's decoded and completed with the defaults.
+func (c *CDCEtcdClientImpl) GetChangeFeedInfoRaw(ctx context.Context,
+	id model.ChangeFeedID,
+) ([]byte, error) {
+	key := GetEtcdKeyChangeFeedInfo(c.ClusterID, id)
+	resp, err := c.Client.Get(ctx, key)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Count == 0 {
+		return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(key)
+	}
+	return resp.Kvs[0].Value, nil
+}
+
 func (c *CDCEtcdClientImpl) DeleteChangeFeedInfo(ctx context.Context,
 	id model.ChangeFeedID,
 ) error {
@@ -531,6 +567,52 @@ func (c *CDCEtcdClientImpl) SaveChangeFeedInfo(ctx context.Context,
 	return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
 }
 
+// UpdateChangeFeedInfo applies the update to the info of a changefeed and
+// stores it into etcd. The info is written only if it has not been modified
+// since it was read, otherwise the update is retried on the latest info, so
+// that the changes made by the owner in the meantime are not overwritten.
+func (c *CDCEtcdClientImpl) UpdateChangeFeedInfo(ctx context.Context,
+	changeFeedID model.ChangeFeedID,
+	update func(*model.ChangeFeedInfo) error,
+) (*model.ChangeFeedInfo, error) {
+	key := GetEtcdKeyChangeFeedInfo(c.ClusterID, changeFeedID)
+	for i := 0; i < updateChangeFeedInfoMaxRetry; i++ {
+		resp, err := c.Client.Get(ctx, key)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+		}
+		if resp.Count == 0 {
+			return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(key)
+		}
+		info := &model.ChangeFeedInfo{}
+		if err := info.Unmarshal(resp.Kvs[0].Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := update(info); err != nil {
+			return nil, errors.Trace(err)
+		}
+		value, err := info.Marshal()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		cmps := []clientv3.Cmp{
+			clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision),
+		}
+		opsThen := []clientv3.Op{clientv3.OpPut(key, value)}
+		txnResp, err := c.Client.Txn(ctx, cmps, opsThen, TxnEmptyOpsElse)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+		}
+		if txnResp.Succeeded {
+			return info, nil
+		}
+		log.Info("changefeed info is modified concurrently, retry the update",
+			zap.String("namespace", changeFeedID.Namespace),
+			zap.String("changefeed", changeFeedID.ID))
+	}
+	return nil, cerror.ErrChangefeedUpdateFailedTransaction.GenWithStackByArgs(changeFeedID)
+}
+
 // PutCaptureInfo put capture info into etcd,
 // this happens when the capture starts.
 func (c *CDCEtcdClientImpl) PutCaptureInfo(
@@ -121,11 +121,19 @@ func TestOpChangeFeedDetail(t *testing.T) {
 	require.Equal(t, d.SinkURI, detail.SinkURI)
 	require.Equal(t, d.SortDir, detail.SortDir)
 
+	raw, err := s.client.GetChangeFeedInfoRaw(ctx, cfID)
+	require.NoError(t, err)
+	value, err := detail.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, value, string(raw))
+
 	err = s.client.DeleteChangeFeedInfo(ctx, cfID)
 	require.NoError(t, err)
 
 	_, err = s.client.GetChangeFeedInfo(ctx, cfID)
 	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+	_, err = s.client.GetChangeFeedInfoRaw(ctx, cfID)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
 }
 
 func TestGetAllChangeFeedInfo(t *testing.T) {
@@ -254,6 +262,56 @@ func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	require.Equal(t, changeFeedInfo.SinkURI, changefeedResult.SinkURI)
 }
 
+func TestUpdateChangeFeedInfo(t *testing.T) {
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	cfID := model.DefaultChangeFeedID("test-update-cf-info")
+	update := func(info *model.ChangeFeedInfo) error {
+		info.SinkURI = "blackhole://"
+		return nil
+	}
+	_, err := s.client.UpdateChangeFeedInfo(ctx, cfID, update)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+
+	err = s.client.SaveChangeFeedInfo(ctx, &model.ChangeFeedInfo{
+		SinkURI: "mysql://127.0.0.1:3306",
+		State:   model.StateNormal,
+	}, cfID)
+	require.NoError(t, err)
+
+	// The info is modified concurrently in the first attempt, the update is
+	// retried on the latest info.
+	attempts := 0
+	info, err := s.client.UpdateChangeFeedInfo(ctx, cfID, func(info *model.ChangeFeedInfo) error {
+		attempts++
+		if attempts == 1 {
+			err := s.client.SaveChangeFeedInfo(ctx, &model.ChangeFeedInfo{
+				SinkURI: "mysql://127.0.0.1:3306",
+				State:   model.StateStopped,
+			}, cfID)
+			require.NoError(t, err)
+		}
+		return update(info)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, "blackhole://", info.SinkURI)
+	require.Equal(t, model.StateStopped, info.State)
+
+	info, err = s.client.GetChangeFeedInfo(ctx, cfID)
+	require.NoError(t, err)
+	require.Equal(t, "blackhole://", info.SinkURI)
+	require.Equal(t, model.StateStopped, info.State)
+
+	_, err = s.client.UpdateChangeFeedInfo(ctx, cfID, func(info *model.ChangeFeedInfo) error {
+		return cerror.ErrAPIInvalidParam.GenWithStack("invalid")
+	})
+	require.True(t, cerror.ErrAPIInvalidParam.Equal(err))
+}
+
 func TestGetAllCaptureLeases(t *testing.T) {
 	s := &Tester{}
 	s.SetUpTest(t)
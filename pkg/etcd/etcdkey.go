@@ -48,6 +48,8 @@ const (
 
 	// MigrateBackupPrefix is the prefix of backup keys during a migration
 	migrateBackupPrefix = "/tidb/cdc/__backup__"
+	// authPrefix is the prefix of the keys of the HTTP API authorization
+	authPrefix = "/tidb/cdc/__auth__"
 )
 
 // CDCKeyType is the type of etcd key
@@ -141,6 +141,21 @@ func (mr *MockCDCEtcdClientMockRecorder) GetChangeFeedInfo(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangeFeedInfo), ctx, id)
 }
 
+// GetChangeFeedInfoRaw mocks base method.
+func (m *MockCDCEtcdClient) GetChangeFeedInfoRaw(ctx context.Context, id model.ChangeFeedID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangeFeedInfoRaw", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeFeedInfoRaw indicates an expected call of GetChangeFeedInfoRaw.
+func (mr *MockCDCEtcdClientMockRecorder) GetChangeFeedInfoRaw(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedInfoRaw", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetChangeFeedInfoRaw), ctx, id)
+}
+
 // GetChangeFeedStatus mocks base method.
 func (m *MockCDCEtcdClient) GetChangeFeedStatus(ctx context.Context, id model.ChangeFeedID) (*model.ChangeFeedStatus, int64, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +301,21 @@ func (mr *MockCDCEtcdClientMockRecorder) SaveChangeFeedInfo(ctx, info, changeFee
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveChangeFeedInfo), ctx, info, changeFeedID)
 }
 
+// UpdateChangeFeedInfo mocks base method.
+func (m *MockCDCEtcdClient) UpdateChangeFeedInfo(ctx context.Context, changeFeedID model.ChangeFeedID, update func(*model.ChangeFeedInfo) error) (*model.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChangeFeedInfo", ctx, changeFeedID, update)
+	ret0, _ := ret[0].(*model.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChangeFeedInfo indicates an expected call of UpdateChangeFeedInfo.
+func (mr *MockCDCEtcdClientMockRecorder) UpdateChangeFeedInfo(ctx, changeFeedID, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangeFeedInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).UpdateChangeFeedInfo), ctx, changeFeedID, update)
+}
+
 // UpdateChangefeedAndUpstream mocks base method.
 func (m *MockCDCEtcdClient) UpdateChangefeedAndUpstream(ctx context.Context, upstreamInfo *model.UpstreamInfo, changeFeedInfo *model.ChangeFeedInfo, changeFeedID model.ChangeFeedID) error {
 	m.ctrl.T.Helper()
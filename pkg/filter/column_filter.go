@@ -0,0 +1,151 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// columnFilterRule only be used by columnFilter.
+type columnFilterRule struct {
+	tableMatcher tfilter.Filter
+	// names are the lower case names of the ignored columns.
+	names   map[string]struct{}
+	regexps []*regexp.Regexp
+}
+
+func newColumnFilterRule(cfg *config.ColumnFilterRule) (*columnFilterRule, error) {
+	tf, err := tfilter.Parse(cfg.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.Matcher)
+	}
+	rule := &columnFilterRule{
+		tableMatcher: tf,
+		names:        make(map[string]struct{}, len(cfg.IgnoreColumns)),
+	}
+	for _, name := range cfg.IgnoreColumns {
+		rule.names[strings.ToLower(name)] = struct{}{}
+	}
+	for _, expr := range cfg.IgnoreColumnRegexps {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, expr)
+		}
+		rule.regexps = append(rule.regexps, re)
+	}
+	return rule, nil
+}
+
+func (r *columnFilterRule) matchColumn(name string) bool {
+	if _, ok := r.names[strings.ToLower(name)]; ok {
+		return true
+	}
+	for _, re := range r.regexps {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// columnKey identifies a column of a table.
+type columnKey struct {
+	schema, table, column string
+}
+
+// columnFilter is a filter that drops columns from the row changed events
+// by the names of the columns.
+type columnFilter struct {
+	rules []*columnFilterRule
+	// ignored caches whether a column is ignored, columnKey -> bool.
+	ignored sync.Map
+}
+
+func newColumnFilter(cfg *config.FilterConfig) (*columnFilter, error) {
+	res := &columnFilter{}
+	for _, ruleCfg := range cfg.ColumnFilters {
+		rule, err := newColumnFilterRule(ruleCfg)
+		if err != nil {
+			return nil, err
+		}
+		res.rules = append(res.rules, rule)
+	}
+	return res, nil
+}
+
+// verify checks the rules don't ignore any handle key column, which is
+// required to replicate the row changes.
+func (f *columnFilter) verify(tableInfos []*model.TableInfo) error {
+	for _, ti := range tableInfos {
+		for _, col := range ti.Columns {
+			if !ti.ColumnsFlag[col.ID].IsHandleKey() {
+				continue
+			}
+			if f.isIgnored(ti.TableName.Schema, ti.TableName.Table, col.Name.O) {
+				return cerror.ErrColumnFilterHandleKey.GenWithStackByArgs(
+					col.Name.O, ti.TableName.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (f *columnFilter) isIgnored(schema, table, column string) bool {
+	for _, rule := range f.rules {
+		if rule.tableMatcher.MatchTable(schema, table) && rule.matchColumn(column) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIgnoredCached is like isIgnored but caches the result.
+func (f *columnFilter) isIgnoredCached(schema, table, column string) bool {
+	key := columnKey{schema: schema, table: table, column: column}
+	if ignored, ok := f.ignored.Load(key); ok {
+		return ignored.(bool)
+	}
+	ignored := f.isIgnored(schema, table, column)
+	f.ignored.Store(key, ignored)
+	return ignored
+}
+
+// dropColumns drops the ignored columns from a row changed event, the
+// dropped columns are set to nil to keep the offsets of the other columns.
+// The handle key columns are never dropped.
+func (f *columnFilter) dropColumns(row *model.RowChangedEvent) {
+	if len(f.rules) == 0 || row.Table == nil {
+		return
+	}
+	schema, table := row.Table.Schema, row.Table.Table
+	drop := func(columns []*model.Column) {
+		for i, col := range columns {
+			if col == nil || col.Flag.IsHandleKey() {
+				continue
+			}
+			if f.isIgnoredCached(schema, table, col.Name) {
+				columns[i] = nil
+			}
+		}
+	}
+	drop(row.Columns)
+	drop(row.PreColumns)
+}
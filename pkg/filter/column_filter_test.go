@@ -0,0 +1,116 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDropColumns(t *testing.T) {
+	t.Parallel()
+
+	f, err := newColumnFilter(&config.FilterConfig{
+		ColumnFilters: []*config.ColumnFilterRule{
+			{
+				Matcher:             []string{"test.t1"},
+				IgnoreColumns:       []string{"Avatar"},
+				IgnoreColumnRegexps: []string{"^audit_"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	newColumns := func() []*model.Column {
+		return []*model.Column{
+			{Name: "id", Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+			{Name: "avatar"},
+			{Name: "name"},
+			nil,
+			{Name: "audit_log"},
+		}
+	}
+	row := &model.RowChangedEvent{
+		Table:      &model.TableName{Schema: "test", Table: "t1"},
+		Columns:    newColumns(),
+		PreColumns: newColumns(),
+	}
+	f.dropColumns(row)
+	for _, columns := range [][]*model.Column{row.Columns, row.PreColumns} {
+		require.Len(t, columns, 5)
+		require.Equal(t, "id", columns[0].Name)
+		require.Nil(t, columns[1])
+		require.Equal(t, "name", columns[2].Name)
+		require.Nil(t, columns[3])
+		require.Nil(t, columns[4])
+	}
+
+	// the columns of the other tables are kept
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t2"},
+		Columns: newColumns(),
+	}
+	f.dropColumns(row)
+	require.Equal(t, newColumns(), row.Columns)
+
+	// the handle key columns are never dropped
+	f, err = newColumnFilter(&config.FilterConfig{
+		ColumnFilters: []*config.ColumnFilterRule{
+			{Matcher: []string{"*.*"}, IgnoreColumns: []string{"id"}},
+		},
+	})
+	require.Nil(t, err)
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t1"},
+		Columns: newColumns(),
+	}
+	f.dropColumns(row)
+	require.Equal(t, newColumns(), row.Columns)
+
+	_, err = newColumnFilter(&config.FilterConfig{
+		ColumnFilters: []*config.ColumnFilterRule{
+			{Matcher: []string{"*.*"}, IgnoreColumnRegexps: []string{"("}},
+		},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrFilterRuleInvalid, err), err)
+}
+
+func TestVerifyColumnFilter(t *testing.T) {
+	helper := newTestHelper(t)
+	defer helper.close()
+	helper.getTk().MustExec("use test;")
+	ti := helper.execDDL("create table test.t1(id int primary key, avatar blob)")
+
+	f, err := newColumnFilter(&config.FilterConfig{
+		ColumnFilters: []*config.ColumnFilterRule{
+			{Matcher: []string{"test.t1"}, IgnoreColumns: []string{"avatar"}},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, f.verify([]*model.TableInfo{ti}))
+
+	f, err = newColumnFilter(&config.FilterConfig{
+		ColumnFilters: []*config.ColumnFilterRule{
+			{Matcher: []string{"test.t1"}, IgnoreColumnRegexps: []string{".*"}},
+		},
+	})
+	require.Nil(t, err)
+	require.True(t, errors.ErrorEqual(cerror.ErrColumnFilterHandleKey,
+		f.verify([]*model.TableInfo{ti})))
+}
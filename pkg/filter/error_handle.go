@@ -0,0 +1,65 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"github.com/pingcap/tiflow/cdc/model"
+)
+
+// errorHandleFilter ignores the events skipped by the error handles of a
+// changefeed, in addition to the events ignored by the filter it wraps.
+type errorHandleFilter struct {
+	Filter
+	handles []*model.ErrorHandle
+}
+
+// NewErrorHandleFilter returns a filter which also ignores the events
+// skipped by the error handles.
+func NewErrorHandleFilter(f Filter, handles []*model.ErrorHandle) Filter {
+	skips := make([]*model.ErrorHandle, 0, len(handles))
+	for _, h := range handles {
+		if h.Action == model.ErrorHandleSkip {
+			skips = append(skips, h)
+		}
+	}
+	if len(skips) == 0 {
+		return f
+	}
+	return &errorHandleFilter{Filter: f, handles: skips}
+}
+
+// ShouldIgnoreDMLEvent implements Filter.
+func (f *errorHandleFilter) ShouldIgnoreDMLEvent(
+	dml *model.RowChangedEvent,
+	rawRow model.RowChangedDatums,
+	ti *model.TableInfo,
+) (bool, error) {
+	if dml.Table != nil &&
+		model.FindErrorHandle(f.handles, dml.CommitTs, dml.Table.Schema, dml.Table.Table) != nil {
+		return true, nil
+	}
+	return f.Filter.ShouldIgnoreDMLEvent(dml, rawRow, ti)
+}
+
+// ShouldIgnoreDDLEvent implements Filter. A skipped DDL is still applied to
+// the schema storage.
+func (f *errorHandleFilter) ShouldIgnoreDDLEvent(ddl *model.DDLEvent) (bool, error) {
+	if ddl.TableInfo != nil {
+		name := ddl.TableInfo.TableName
+		if model.FindErrorHandle(f.handles, ddl.CommitTs, name.Schema, name.Table) != nil {
+			return true, nil
+		}
+	}
+	return f.Filter.ShouldIgnoreDDLEvent(ddl)
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandleFilter(t *testing.T) {
+	t.Parallel()
+
+	f, err := NewFilter(config.GetDefaultReplicaConfig(), "")
+	require.Nil(t, err)
+	require.Equal(t, f, NewErrorHandleFilter(f, nil))
+	require.Equal(t, f, NewErrorHandleFilter(f, []*model.ErrorHandle{{
+		CommitTs: 10, Schema: "test", Action: model.ErrorHandleReplace, Statement: "select 1",
+	}}))
+
+	f = NewErrorHandleFilter(f, []*model.ErrorHandle{
+		{CommitTs: 10, Schema: "test", Table: "t1", Action: model.ErrorHandleSkip},
+		{CommitTs: 20, Schema: "test", Action: model.ErrorHandleSkip},
+	})
+	dmlCases := []struct {
+		commitTs uint64
+		schema   string
+		table    string
+		ignore   bool
+	}{
+		{10, "test", "t1", true},
+		{10, "TEST", "T1", true},
+		{10, "test", "t2", false},
+		{11, "test", "t1", false},
+		{10, "information_schema", "t1", true},
+		{11, "information_schema", "t1", true},
+	}
+	for _, tc := range dmlCases {
+		dml := &model.RowChangedEvent{
+			Table:    &model.TableName{Schema: tc.schema, Table: tc.table},
+			CommitTs: tc.commitTs,
+		}
+		ignore, err := f.ShouldIgnoreDMLEvent(dml, model.RowChangedDatums{}, nil)
+		require.Nil(t, err)
+		require.Equal(t, tc.ignore, ignore, "%#v", tc)
+	}
+
+	ddlCases := []struct {
+		commitTs uint64
+		schema   string
+		table    string
+		ignore   bool
+	}{
+		{10, "test", "t1", true},
+		{20, "test", "", true},
+		{20, "test", "t1", false},
+		{21, "test", "", false},
+	}
+	for _, tc := range ddlCases {
+		ddl := &model.DDLEvent{
+			CommitTs:  tc.commitTs,
+			TableInfo: &model.TableInfo{TableName: model.TableName{Schema: tc.schema, Table: tc.table}},
+			Query:     "create database test",
+		}
+		ignore, err := f.ShouldIgnoreDDLEvent(ddl)
+		require.Nil(t, err)
+		require.Equal(t, tc.ignore, ignore, "%#v", tc)
+	}
+}
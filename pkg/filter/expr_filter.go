@@ -14,6 +14,7 @@
 package filter
 
 import (
+	"regexp"
 	"strings"
 	"sync"
 
@@ -45,6 +46,10 @@ type dmlExprFilterRule struct {
 	updateOldExprs map[string]expression.Expression // tableName -> expr
 	updateNewExprs map[string]expression.Expression // tableName -> expr
 	deleteExprs    map[string]expression.Expression // tableName -> expr
+	rowFilterExprs map[string]expression.Expression // tableName -> expr
+
+	// valueRegexps are compiled from config.IgnoreValueRegexps.
+	valueRegexps []*valueRegexp
 
 	tableMatcher tfilter.Filter
 	// All tables in this rule share the same config.
@@ -68,13 +73,31 @@ func newExprFilterRule(
 		updateOldExprs: make(map[string]expression.Expression),
 		updateNewExprs: make(map[string]expression.Expression),
 		deleteExprs:    make(map[string]expression.Expression),
+		rowFilterExprs: make(map[string]expression.Expression),
 		config:         cfg,
 		tableMatcher:   tf,
 		sessCtx:        sessCtx,
 	}
+	for _, vr := range cfg.IgnoreValueRegexps {
+		re, err := regexp.Compile(vr.Regexp)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, vr.Regexp)
+		}
+		ret.valueRegexps = append(ret.valueRegexps, &valueRegexp{
+			column: strings.ToLower(vr.Column),
+			re:     re,
+		})
+	}
 	return ret, nil
 }
 
+// valueRegexp only be used by dmlExprFilterRule.
+type valueRegexp struct {
+	// column is the lower case name of the column.
+	column string
+	re     *regexp.Regexp
+}
+
 // verifyAndInitRule will verify and init the rule.
 // It should only be called in dmlExprFilter's verify method.
 func (r *dmlExprFilterRule) verify(tableInfos []*model.TableInfo) error {
@@ -104,6 +127,12 @@ func (r *dmlExprFilterRule) verify(tableInfos []*model.TableInfo) error {
 		return cerror.ErrExpressionParseFailed.
 			FastGenByArgs(r.config.IgnoreDeleteValueExpr)
 	}
+	_, _, err = p.ParseSQL(completeExpression(r.config.RowFilterExpr))
+	if err != nil {
+		log.Error("failed to parse expression", zap.Error(err))
+		return cerror.ErrExpressionParseFailed.
+			FastGenByArgs(r.config.RowFilterExpr)
+	}
 	// verify expression filter rule.
 	for _, ti := range tableInfos {
 		tableName := ti.TableName.String()
@@ -138,16 +167,39 @@ func (r *dmlExprFilterRule) verify(tableInfos []*model.TableInfo) error {
 			}
 			r.deleteExprs[tableName] = e
 		}
+		if r.config.RowFilterExpr != "" {
+			e, err := r.getSimpleExprOfTable(r.config.RowFilterExpr, ti)
+			if err != nil {
+				return err
+			}
+			r.rowFilterExprs[tableName] = e
+		}
+		for _, vr := range r.valueRegexps {
+			if !hasColumn(ti, vr.column) {
+				return cerror.ErrExpressionColumnNotFound.
+					FastGenByArgs(vr.column, tableName, vr.re.String())
+			}
+		}
 	}
 	return nil
 }
 
+func hasColumn(ti *model.TableInfo, name string) bool {
+	for _, col := range ti.Columns {
+		if col.Name.L == name {
+			return true
+		}
+	}
+	return false
+}
+
 // The caller must hold r.mu.Lock() before calling this function.
 func (r *dmlExprFilterRule) resetExpr(tableName string) {
 	delete(r.insertExprs, tableName)
 	delete(r.updateOldExprs, tableName)
 	delete(r.updateNewExprs, tableName)
 	delete(r.deleteExprs, tableName)
+	delete(r.rowFilterExprs, tableName)
 }
 
 // getInsertExprs returns the expression filter to filter INSERT events.
@@ -223,6 +275,24 @@ func (r *dmlExprFilterRule) getDeleteExpr(ti *model.TableInfo) (
 	return r.deleteExprs[tableName], nil
 }
 
+func (r *dmlExprFilterRule) getRowFilterExpr(ti *model.TableInfo) (
+	expression.Expression, error,
+) {
+	tableName := ti.TableName.String()
+	if r.rowFilterExprs[tableName] != nil {
+		return r.rowFilterExprs[tableName], nil
+	}
+
+	if r.config.RowFilterExpr != "" {
+		expr, err := r.getSimpleExprOfTable(r.config.RowFilterExpr, ti)
+		if err != nil {
+			return nil, err
+		}
+		r.rowFilterExprs[tableName] = expr
+	}
+	return r.rowFilterExprs[tableName], nil
+}
+
 func (r *dmlExprFilterRule) getSimpleExprOfTable(
 	expr string,
 	ti *model.TableInfo,
@@ -265,6 +335,59 @@ func (r *dmlExprFilterRule) shouldSkipDML(
 		r.tables[tableName] = ti.Clone()
 	}
 
+	ignore, err := r.skipDMLByIgnoreExprs(row, rawRow, ti)
+	if err != nil || ignore {
+		return ignore, err
+	}
+	if r.skipDMLByValueRegexps(row) {
+		return true, nil
+	}
+	return r.skipDMLByRowFilter(row, rawRow, ti)
+}
+
+// skipDMLByValueRegexps skips the DML if any of its old values or new values
+// matches the ignore value regular expressions.
+func (r *dmlExprFilterRule) skipDMLByValueRegexps(row *model.RowChangedEvent) bool {
+	if len(r.valueRegexps) == 0 {
+		return false
+	}
+	return r.matchValueRegexps(row.Columns) || r.matchValueRegexps(row.PreColumns)
+}
+
+func (r *dmlExprFilterRule) matchValueRegexps(columns []*model.Column) bool {
+	for _, col := range columns {
+		if col == nil || col.Value == nil {
+			continue
+		}
+		name := strings.ToLower(col.Name)
+		for _, vr := range r.valueRegexps {
+			if vr.column != name {
+				continue
+			}
+			var matched bool
+			switch v := col.Value.(type) {
+			case []byte:
+				matched = vr.re.Match(v)
+			case string:
+				matched = vr.re.MatchString(v)
+			default:
+				matched = vr.re.MatchString(model.ColumnValueString(v))
+			}
+			if matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// skipDMLByIgnoreExprs checks the ignore value expressions of the DML type.
+// The caller must hold r.mu.Lock() before calling this function.
+func (r *dmlExprFilterRule) skipDMLByIgnoreExprs(
+	row *model.RowChangedEvent,
+	rawRow model.RowChangedDatums,
+	ti *model.TableInfo,
+) (bool, error) {
 	switch {
 	case row.IsInsert():
 		exprs, err := r.getInsertExpr(ti)
@@ -314,6 +437,65 @@ func (r *dmlExprFilterRule) shouldSkipDML(
 	}
 }
 
+// skipDMLByRowFilter skips the DML if neither the old value nor the new value
+// matches the row filter expression. An UPDATE whose old value matches but new
+// value doesn't is converted to a DELETE, and vice versa to an INSERT, so the
+// downstream keeps the same rows as the matched rows of the upstream.
+// The caller must hold r.mu.Lock() before calling this function.
+func (r *dmlExprFilterRule) skipDMLByRowFilter(
+	row *model.RowChangedEvent,
+	rawRow model.RowChangedDatums,
+	ti *model.TableInfo,
+) (bool, error) {
+	expr, err := r.getRowFilterExpr(ti)
+	if err != nil || expr == nil {
+		return false, err
+	}
+	// The values can't be evaluated are treated as matched.
+	oldMatched, newMatched := true, true
+	if row.IsUpdate() || row.IsDelete() {
+		oldMatched, err = r.matchByExpression(rawRow.PreRowDatums, expr)
+		if err != nil {
+			return false, err
+		}
+	}
+	if row.IsUpdate() || row.IsInsert() {
+		newMatched, err = r.matchByExpression(rawRow.RowDatums, expr)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	switch {
+	case row.IsInsert():
+		return !newMatched, nil
+	case row.IsDelete():
+		return !oldMatched, nil
+	case row.IsUpdate():
+		if !oldMatched && !newMatched {
+			return true, nil
+		}
+		if !newMatched {
+			row.Columns = nil
+		} else if !oldMatched {
+			row.PreColumns = nil
+		}
+		return false, nil
+	default:
+		return false, nil
+	}
+}
+
+func (r *dmlExprFilterRule) matchByExpression(
+	rowData []types.Datum,
+	expr expression.Expression,
+) (bool, error) {
+	if len(rowData) == 0 {
+		return true, nil
+	}
+	return r.skipDMLByExpression(rowData, expr)
+}
+
 func (r *dmlExprFilterRule) skipDMLByExpression(
 	rowData []types.Datum,
 	expr expression.Expression,
@@ -350,6 +350,195 @@ func TestShouldSkipDMLBasic(t *testing.T) {
 	}
 }
 
+func TestShouldSkipDMLByRowFilter(t *testing.T) {
+	helper := newTestHelper(t)
+	defer helper.close()
+	helper.getTk().MustExec("use test;")
+
+	tableInfo := helper.execDDL(
+		"create table test.orders(id int primary key, status char(20), region char(10))")
+	f, err := newExprFilter("", &config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher:       []string{"test.orders"},
+				RowFilterExpr: "status != 'archived' and region = 'eu'",
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	sessCtx := utils.NewSessionCtx(map[string]string{
+		"time_zone": "System",
+	})
+	cases := []struct {
+		preRow []interface{}
+		row    []interface{}
+		ignore bool
+		// the type of the event after filtering
+		isInsert bool
+		isUpdate bool
+		isDelete bool
+	}{
+		{ // insert matched
+			row:      []interface{}{1, "active", "eu"},
+			isInsert: true,
+		},
+		{ // insert not matched
+			row:    []interface{}{2, "active", "us"},
+			ignore: true,
+		},
+		{ // delete matched
+			preRow:   []interface{}{3, "active", "eu"},
+			isDelete: true,
+		},
+		{ // delete not matched
+			preRow: []interface{}{4, "archived", "eu"},
+			ignore: true,
+		},
+		{ // update inside the matched rows
+			preRow:   []interface{}{5, "active", "eu"},
+			row:      []interface{}{5, "pending", "eu"},
+			isUpdate: true,
+		},
+		{ // update outside the matched rows
+			preRow: []interface{}{6, "active", "us"},
+			row:    []interface{}{6, "pending", "us"},
+			ignore: true,
+		},
+		{ // update moving the row out of the matched rows
+			preRow:   []interface{}{7, "active", "eu"},
+			row:      []interface{}{7, "archived", "eu"},
+			isDelete: true,
+		},
+		{ // update moving the row into the matched rows
+			preRow:   []interface{}{8, "active", "us"},
+			row:      []interface{}{8, "active", "eu"},
+			isInsert: true,
+		},
+	}
+	for _, c := range cases {
+		rowDatums, err := utils.AdjustBinaryProtocolForDatum(sessCtx, c.row, tableInfo.Columns)
+		require.Nil(t, err)
+		preRowDatums, err := utils.AdjustBinaryProtocolForDatum(sessCtx, c.preRow, tableInfo.Columns)
+		require.Nil(t, err)
+		row := &model.RowChangedEvent{
+			Table: &model.TableName{Schema: "test", Table: "orders"},
+		}
+		if c.row != nil {
+			row.Columns = []*model.Column{{Name: "none"}}
+		}
+		if c.preRow != nil {
+			row.PreColumns = []*model.Column{{Name: "none"}}
+		}
+		rawRow := model.RowChangedDatums{
+			RowDatums:    rowDatums,
+			PreRowDatums: preRowDatums,
+		}
+		ignore, err := f.shouldSkipDML(row, rawRow, tableInfo)
+		require.Nil(t, err)
+		require.Equal(t, c.ignore, ignore, "case: %+v", c)
+		if !ignore {
+			require.Equal(t, c.isInsert, row.IsInsert(), "case: %+v", c)
+			require.Equal(t, c.isUpdate, row.IsUpdate(), "case: %+v", c)
+			require.Equal(t, c.isDelete, row.IsDelete(), "case: %+v", c)
+		}
+	}
+}
+
+func TestShouldSkipDMLByValueRegexps(t *testing.T) {
+	helper := newTestHelper(t)
+	defer helper.close()
+	helper.getTk().MustExec("use test;")
+
+	tableInfo := helper.execDDL(
+		"create table test.users(id int primary key, email varchar(50), avatar blob)")
+	f, err := newExprFilter("", &config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher: []string{"test.users"},
+				IgnoreValueRegexps: []*config.ValueRegexp{
+					{Column: "Email", Regexp: "@internal[.]com$"},
+					{Column: "avatar", Regexp: "^default"},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, f.verify([]*model.TableInfo{tableInfo}))
+
+	sessCtx := utils.NewSessionCtx(map[string]string{
+		"time_zone": "System",
+	})
+	newColumns := func(email, avatar interface{}) []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: int64(1)},
+			{Name: "email", Value: email},
+			{Name: "avatar", Value: avatar},
+		}
+	}
+	cases := []struct {
+		preColumns []*model.Column
+		columns    []*model.Column
+		ignore     bool
+	}{
+		{columns: newColumns("a@internal.com", nil), ignore: true},
+		{columns: newColumns("a@example.com", nil)},
+		{columns: newColumns(nil, []byte("default.png")), ignore: true},
+		{columns: newColumns("a@example.com", []byte("a.png"))},
+		{preColumns: newColumns("a@internal.com", nil), ignore: true},
+		{
+			preColumns: newColumns("a@internal.com", nil),
+			columns:    newColumns("a@example.com", nil),
+			ignore:     true,
+		},
+		{
+			preColumns: newColumns("a@example.com", nil),
+			columns:    newColumns("b@example.com", nil),
+		},
+	}
+	rowDatums, err := utils.AdjustBinaryProtocolForDatum(
+		sessCtx, []interface{}{1, "a@example.com", nil}, tableInfo.Columns)
+	require.Nil(t, err)
+	for _, c := range cases {
+		row := &model.RowChangedEvent{
+			Table:      &model.TableName{Schema: "test", Table: "users"},
+			Columns:    c.columns,
+			PreColumns: c.preColumns,
+		}
+		rawRow := model.RowChangedDatums{RowDatums: rowDatums, PreRowDatums: rowDatums}
+		ignore, err := f.shouldSkipDML(row, rawRow, tableInfo)
+		require.Nil(t, err)
+		require.Equal(t, c.ignore, ignore, "case: %+v", c)
+	}
+
+	// the column must exist in the matched tables.
+	f, err = newExprFilter("", &config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher: []string{"test.users"},
+				IgnoreValueRegexps: []*config.ValueRegexp{
+					{Column: "phone", Regexp: "^1"},
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.True(t, cerror.ErrExpressionColumnNotFound.Equal(
+		errors.Cause(f.verify([]*model.TableInfo{tableInfo}))))
+
+	_, err = newExprFilter("", &config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher: []string{"test.users"},
+				IgnoreValueRegexps: []*config.ValueRegexp{
+					{Column: "email", Regexp: "("},
+				},
+			},
+		},
+	})
+	require.True(t, cerror.ErrFilterRuleInvalid.Equal(errors.Cause(err)), err)
+}
+
 // This test case is for testing when there are syntax error
 // or unknown error in the expression the return error type and message
 // are as expected.
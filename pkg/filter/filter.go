@@ -63,6 +63,8 @@ var allowDDLList = []timodel.ActionType{
 // TODO: find a better way to abstract this interface.
 type Filter interface {
 	// ShouldIgnoreDMLEvent returns true and nil if the DML event should be ignored.
+	// An UPDATE event may be converted to an INSERT or a DELETE event by
+	// the row filter expressions or the sample rules.
 	ShouldIgnoreDMLEvent(dml *model.RowChangedEvent, rawRow model.RowChangedDatums, tableInfo *model.TableInfo) (bool, error)
 	// ShouldIgnoreDDLEvent returns true and nil if the DDL event should be ignored.
 	// If a ddl is ignored, it will applied to cdc's schema storage,
@@ -72,6 +74,9 @@ type Filter interface {
 	// If a ddl is discarded, it will neither be applied to cdc's schema storage
 	// nor sent to downstream.
 	ShouldDiscardDDL(ddlType timodel.ActionType, schema, table string) bool
+	// DropIgnoredColumns drops the columns ignored by the column filters from
+	// a DML event, the dropped columns are set to nil.
+	DropIgnoredColumns(dml *model.RowChangedEvent)
 	// ShouldIgnoreTable returns true if the table should be ignored.
 	ShouldIgnoreTable(schema, table string) bool
 	// ShouldIgnoreSchema returns true if the schema should be ignored.
@@ -89,8 +94,15 @@ type filter struct {
 	dmlExprFilter *dmlExprFilter
 	// sqlEventFilter is used to filter out dml/ddl event by its type or query.
 	sqlEventFilter *sqlEventFilter
+	// columnFilter is used to drop columns from dml event by their names.
+	columnFilter *columnFilter
+	// sampleFilter is used to filter out dml event by the hash of its handle key.
+	sampleFilter *sampleFilter
 	// ignoreTxnStartTs is used to filter out dml/ddl event by its starsTs.
 	ignoreTxnStartTs []uint64
+	// appendOnlyIneligibleTables is used to filter out the updated and deleted
+	// rows of the tables without a valid index by the append-only policy.
+	appendOnlyIneligibleTables bool
 }
 
 // NewFilter creates a filter.
@@ -112,11 +124,23 @@ func NewFilter(cfg *config.ReplicaConfig, tz string) (Filter, error) {
 	if err != nil {
 		return nil, err
 	}
+	columnFilter, err := newColumnFilter(cfg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	sampleFilter, err := newSampleFilter(cfg.Filter)
+	if err != nil {
+		return nil, err
+	}
 	return &filter{
 		tableFilter:      f,
 		dmlExprFilter:    dmlExprFilter,
 		sqlEventFilter:   sqlEventFilter,
+		columnFilter:     columnFilter,
+		sampleFilter:     sampleFilter,
 		ignoreTxnStartTs: cfg.Filter.IgnoreTxnStartTs,
+		appendOnlyIneligibleTables: cfg.IneligibleTablePolicy ==
+			config.IneligibleTablePolicyAppendOnly,
 	}, nil
 }
 
@@ -124,7 +148,9 @@ func NewFilter(cfg *config.ReplicaConfig, tz string) (Filter, error) {
 // 0. By startTs.
 // 1. By table name.
 // 2. By type.
-// 3. By columns value.
+// 3. By columns value, including the row filter expressions.
+// 4. By the sample rules.
+// 5. By the append-only policy of the tables without a valid index.
 func (f *filter) ShouldIgnoreDMLEvent(
 	dml *model.RowChangedEvent,
 	rawRow model.RowChangedDatums,
@@ -145,7 +171,20 @@ func (f *filter) ShouldIgnoreDMLEvent(
 	if ignoreByEventType {
 		return true, nil
 	}
-	return f.dmlExprFilter.shouldSkipDML(dml, rawRow, ti)
+	ignoreByExpr, err := f.dmlExprFilter.shouldSkipDML(dml, rawRow, ti)
+	if err != nil || ignoreByExpr {
+		return ignoreByExpr, err
+	}
+	if f.sampleFilter.shouldSkipDML(dml) {
+		return true, nil
+	}
+	// The check is the last one, since the UPDATE event may be converted to
+	// an INSERT event by the checks above.
+	if f.appendOnlyIneligibleTables && !dml.IsInsert() &&
+		ti != nil && !ti.IsEligible(false) {
+		return true, nil
+	}
+	return false, nil
 }
 
 // ShouldIgnoreDDLEvent checks if a DDL Event should be ignore by conditions below:
@@ -216,7 +255,15 @@ func (f *filter) ShouldIgnoreSchema(schema string) bool {
 	return isSysSchema(schema) || !f.tableFilter.MatchSchema(schema)
 }
 
+// DropIgnoredColumns drops the columns ignored by the column filters.
+func (f *filter) DropIgnoredColumns(dml *model.RowChangedEvent) {
+	f.columnFilter.dropColumns(dml)
+}
+
 func (f *filter) Verify(tableInfos []*model.TableInfo) error {
+	if err := f.columnFilter.verify(tableInfos); err != nil {
+		return err
+	}
 	return f.dmlExprFilter.verify(tableInfos)
 }
 
@@ -18,6 +18,8 @@ import (
 
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
@@ -138,6 +140,56 @@ func TestShouldIgnoreDMLEvent(t *testing.T) {
 	}
 }
 
+func TestShouldIgnoreDMLEventByAppendOnlyPolicy(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GetDefaultReplicaConfig()
+	cfg.IneligibleTablePolicy = config.IneligibleTablePolicyAppendOnly
+	filter, err := NewFilter(cfg, "")
+	require.Nil(t, err)
+
+	newTableInfo := func(pk bool) *model.TableInfo {
+		ft := types.NewFieldType(mysql.TypeLong)
+		if pk {
+			ft.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+		}
+		return model.WrapTableInfo(1, "test", 1, &timodel.TableInfo{
+			Name:       timodel.NewCIStr("t"),
+			PKIsHandle: pk,
+			Columns: []*timodel.ColumnInfo{{
+				ID: 1, Name: timodel.NewCIStr("id"), FieldType: *ft, State: timodel.StatePublic,
+			}},
+		})
+	}
+	columns := []*model.Column{{Name: "id", Value: int64(1)}}
+	insert := &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "test", Table: "t"}, Columns: columns,
+	}
+	update := &model.RowChangedEvent{
+		Table: insert.Table, Columns: columns, PreColumns: columns,
+	}
+	remove := &model.RowChangedEvent{Table: insert.Table, PreColumns: columns}
+
+	// only the inserted rows of the ineligible tables are replicated.
+	for _, tc := range []struct {
+		dml          *model.RowChangedEvent
+		pk           bool
+		expectIgnore bool
+	}{
+		{insert, false, false},
+		{update, false, true},
+		{remove, false, true},
+		{insert, true, false},
+		{update, true, false},
+		{remove, true, false},
+	} {
+		ignore, err := filter.ShouldIgnoreDMLEvent(tc.dml, model.RowChangedDatums{},
+			newTableInfo(tc.pk))
+		require.Nil(t, err)
+		require.Equal(t, tc.expectIgnore, ignore)
+	}
+}
+
 func TestShouldDiscardDDL(t *testing.T) {
 	t.Parallel()
 
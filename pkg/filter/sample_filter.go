@@ -0,0 +1,137 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sync"
+
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// sampleBuckets is the number of the buckets the rows are hashed into, it
+// makes the percentage of the sample rules accurate to 0.01.
+const sampleBuckets = 10000
+
+// sampleRule only be used by sampleFilter.
+type sampleRule struct {
+	tableMatcher tfilter.Filter
+	// buckets is the number of the sampled buckets.
+	buckets uint64
+}
+
+// sampleFilter is a filter that only replicates the rows whose handle keys
+// are hashed into the sampled buckets.
+type sampleFilter struct {
+	rules []*sampleRule
+	// buckets caches the sampled buckets of a table, model.TableName -> uint64,
+	// it's sampleBuckets if no rule matches the table.
+	buckets sync.Map
+}
+
+func newSampleFilter(cfg *config.FilterConfig) (*sampleFilter, error) {
+	res := &sampleFilter{}
+	for _, ruleCfg := range cfg.SampleRules {
+		tf, err := tfilter.Parse(ruleCfg.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, ruleCfg.Matcher)
+		}
+		if ruleCfg.Percent <= 0 || ruleCfg.Percent > 100 {
+			return nil, cerror.ErrFilterRuleInvalid.GenWithStackByArgs(
+				fmt.Sprintf("the percent %v of the sample rule %v must be in (0, 100]",
+					ruleCfg.Percent, ruleCfg.Matcher))
+		}
+		res.rules = append(res.rules, &sampleRule{
+			tableMatcher: tf,
+			buckets:      uint64(math.Round(ruleCfg.Percent * sampleBuckets / 100)),
+		})
+	}
+	return res, nil
+}
+
+// getBuckets returns the sampled buckets of the first rule matching the table.
+func (f *sampleFilter) getBuckets(table *model.TableName) uint64 {
+	key := model.TableName{Schema: table.Schema, Table: table.Table}
+	if buckets, ok := f.buckets.Load(key); ok {
+		return buckets.(uint64)
+	}
+	buckets := uint64(sampleBuckets)
+	for _, rule := range f.rules {
+		if rule.tableMatcher.MatchTable(table.Schema, table.Table) {
+			buckets = rule.buckets
+			break
+		}
+	}
+	f.buckets.Store(key, buckets)
+	return buckets
+}
+
+// shouldSkipDML returns true if the row of the DML event isn't sampled. An
+// UPDATE changing the handle key from a sampled row to a not sampled one is
+// converted to a DELETE, and vice versa to an INSERT.
+func (f *sampleFilter) shouldSkipDML(row *model.RowChangedEvent) bool {
+	if len(f.rules) == 0 || row.Table == nil {
+		return false
+	}
+	buckets := f.getBuckets(row.Table)
+	if buckets >= sampleBuckets {
+		return false
+	}
+	switch {
+	case row.IsInsert():
+		return !isSampled(row.Columns, buckets)
+	case row.IsDelete():
+		return !isSampled(row.PreColumns, buckets)
+	}
+	newSampled := isSampled(row.Columns, buckets)
+	oldSampled := isSampled(row.PreColumns, buckets)
+	switch {
+	case newSampled && oldSampled:
+		return false
+	case newSampled:
+		row.PreColumns = nil
+		return false
+	case oldSampled:
+		row.Columns = nil
+		return false
+	default:
+		return true
+	}
+}
+
+// isSampled returns true if the row is hashed into the sampled buckets by
+// its handle key, or by all its columns if it has no handle key.
+func isSampled(columns []*model.Column, buckets uint64) bool {
+	h := fnv.New64a()
+	hasHandleKey := false
+	for _, col := range columns {
+		if col != nil && col.Flag.IsHandleKey() {
+			hasHandleKey = true
+			break
+		}
+	}
+	for _, col := range columns {
+		if col == nil || (hasHandleKey && !col.Flag.IsHandleKey()) {
+			continue
+		}
+		h.Write([]byte(model.ColumnValueString(col.Value)))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()%sampleBuckets < buckets
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldSkipDMLBySample(t *testing.T) {
+	t.Parallel()
+
+	f, err := newSampleFilter(&config.FilterConfig{
+		SampleRules: []*config.SampleRule{
+			{Matcher: []string{"test.t1"}, Percent: 10},
+			{Matcher: []string{"test.*"}, Percent: 100},
+		},
+	})
+	require.Nil(t, err)
+
+	newColumns := func(id int64) []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: id, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+			{Name: "name", Value: "abc"},
+		}
+	}
+	t1 := &model.TableName{Schema: "test", Table: "t1"}
+	var sampled, notSampled []int64
+	for id := int64(0); id < 10000; id++ {
+		skip := f.shouldSkipDML(&model.RowChangedEvent{Table: t1, Columns: newColumns(id)})
+		if skip {
+			notSampled = append(notSampled, id)
+		} else {
+			sampled = append(sampled, id)
+		}
+		// the deletes of a row are sampled like the inserts
+		require.Equal(t, skip,
+			f.shouldSkipDML(&model.RowChangedEvent{Table: t1, PreColumns: newColumns(id)}))
+	}
+	require.InDelta(t, 1000, len(sampled), 200)
+
+	// the non-key columns don't affect the sampling
+	row := &model.RowChangedEvent{Table: t1, Columns: newColumns(sampled[0])}
+	row.Columns[1].Value = "def"
+	require.False(t, f.shouldSkipDML(row))
+
+	// an update moving the row into or out of the sample
+	row = &model.RowChangedEvent{
+		Table: t1, Columns: newColumns(sampled[0]), PreColumns: newColumns(sampled[1]),
+	}
+	require.False(t, f.shouldSkipDML(row))
+	require.True(t, row.IsUpdate())
+	row = &model.RowChangedEvent{
+		Table: t1, Columns: newColumns(sampled[0]), PreColumns: newColumns(notSampled[0]),
+	}
+	require.False(t, f.shouldSkipDML(row))
+	require.True(t, row.IsInsert())
+	row = &model.RowChangedEvent{
+		Table: t1, Columns: newColumns(notSampled[0]), PreColumns: newColumns(sampled[0]),
+	}
+	require.False(t, f.shouldSkipDML(row))
+	require.True(t, row.IsDelete())
+	row = &model.RowChangedEvent{
+		Table: t1, Columns: newColumns(notSampled[0]), PreColumns: newColumns(notSampled[1]),
+	}
+	require.True(t, f.shouldSkipDML(row))
+
+	// the rows of the other tables are all replicated
+	for _, table := range []*model.TableName{
+		{Schema: "test", Table: "t2"}, {Schema: "prod", Table: "t1"},
+	} {
+		row = &model.RowChangedEvent{Table: table, Columns: newColumns(notSampled[0])}
+		require.False(t, f.shouldSkipDML(row))
+	}
+
+	for _, percent := range []float64{0, -1, 100.5} {
+		_, err = newSampleFilter(&config.FilterConfig{
+			SampleRules: []*config.SampleRule{{Matcher: []string{"*.*"}, Percent: percent}},
+		})
+		require.True(t, errors.ErrorEqual(cerror.ErrFilterRuleInvalid, err), err)
+	}
+	_, err = newSampleFilter(&config.FilterConfig{
+		SampleRules: []*config.SampleRule{{Matcher: []string{"test.!"}, Percent: 1}},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrFilterRuleInvalid, err), err)
+}
@@ -14,10 +14,14 @@
 package filter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 	"github.com/pingcap/tidb/parser"
+	timodel "github.com/pingcap/tidb/parser/model"
 	tfilter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
@@ -47,6 +51,10 @@ type sqlEventRule struct {
 	// which means not match `test.t1`.
 	tf tfilter.Filter
 	bf *bf.BinlogEvent
+	// allowDDL is nil if all DDL types are allowed.
+	allowDDL       map[timodel.ActionType]struct{}
+	blockDDL       map[timodel.ActionType]struct{}
+	pauseOnBlocked bool
 }
 
 func newSQLEventFilterRule(cfg *config.EventFilterRule) (*sqlEventRule, error) {
@@ -62,6 +70,24 @@ func newSQLEventFilterRule(cfg *config.EventFilterRule) (*sqlEventRule, error) {
 	if err := verifyIgnoreEvents(cfg.IgnoreEvent); err != nil {
 		return nil, err
 	}
+	if len(cfg.AllowDDL) != 0 {
+		if res.allowDDL, err = parseDDLTypes(cfg.AllowDDL); err != nil {
+			return nil, err
+		}
+	}
+	if res.blockDDL, err = parseDDLTypes(cfg.BlockDDL); err != nil {
+		return nil, err
+	}
+	switch cfg.BlockedDDLAction {
+	case "", config.BlockedDDLActionSkip:
+	case config.BlockedDDLActionPause:
+		res.pauseOnBlocked = true
+	default:
+		return nil, cerror.ErrFilterRuleInvalid.GenWithStackByArgs(
+			fmt.Sprintf("blocked-ddl-action must be %s or %s, but got %s",
+				config.BlockedDDLActionSkip, config.BlockedDDLActionPause,
+				cfg.BlockedDDLAction))
+	}
 
 	bfRule := &bf.BinlogEventRule{
 		SchemaPattern: binlogFilterSchemaPlaceholder,
@@ -92,6 +118,37 @@ func verifyIgnoreEvents(types []bf.EventType) error {
 	return nil
 }
 
+// parseDDLTypes parses the names of the DDL types, such as "add column".
+func parseDDLTypes(names []string) (map[timodel.ActionType]struct{}, error) {
+	types := make(map[timodel.ActionType]struct{}, len(names))
+	for _, name := range names {
+		found := false
+		for _, actionType := range allowDDLList {
+			if strings.EqualFold(actionType.String(), strings.TrimSpace(name)) {
+				types[actionType] = struct{}{}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, cerror.ErrInvalidDDLType.GenWithStackByArgs(name)
+		}
+	}
+	return types, nil
+}
+
+// isDDLBlocked returns true if the DDL type is not allowed by the rule.
+func (r *sqlEventRule) isDDLBlocked(ddlType timodel.ActionType) bool {
+	if _, ok := r.blockDDL[ddlType]; ok {
+		return true
+	}
+	if r.allowDDL != nil {
+		_, ok := r.allowDDL[ddlType]
+		return !ok
+	}
+	return false
+}
+
 // sqlEventFilter is a filter that filters DDL/DML event by its type or query.
 type sqlEventFilter struct {
 	p     *parser.Parser
@@ -137,6 +194,18 @@ func (f *sqlEventFilter) getRules(schema, table string) []*sqlEventRule {
 
 // skipDDLEvent skips ddl event by its type and query.
 func (f *sqlEventFilter) shouldSkipDDL(ddl *model.DDLEvent) (bool, error) {
+	rules := f.getRules(ddl.TableInfo.TableName.Schema, ddl.TableInfo.TableName.Table)
+	for _, rule := range rules {
+		if !rule.isDDLBlocked(ddl.Type) {
+			continue
+		}
+		if rule.pauseOnBlocked {
+			return false, cerror.ErrDDLBlockedByFilter.GenWithStackByArgs(
+				ddl.Query, ddl.Type.String())
+		}
+		return true, nil
+	}
+
 	evenType, err := ddlToEventType(f.p, ddl.Query, ddl.Type)
 	if err != nil {
 		return false, err
@@ -148,7 +217,6 @@ func (f *sqlEventFilter) shouldSkipDDL(ddl *model.DDLEvent) (bool, error) {
 		return false, nil
 	}
 
-	rules := f.getRules(ddl.TableInfo.TableName.Schema, ddl.TableInfo.TableName.Table)
 	for _, rule := range rules {
 		action, err := rule.bf.Filter(binlogFilterSchemaPlaceholder, binlogFilterTablePlaceholder, evenType, ddl.Query)
 		if err != nil {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/errors"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	timodel "github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
@@ -190,6 +191,77 @@ func TestShouldSkipDDL(t *testing.T) {
 	}
 }
 
+func TestShouldSkipDDLByType(t *testing.T) {
+	t.Parallel()
+
+	f, err := newSQLEventFilter(&config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{
+				Matcher:  []string{"test.t_allow"},
+				AllowDDL: []string{"add column", "Create Table"},
+			},
+			{
+				Matcher:  []string{"test.t_block"},
+				BlockDDL: []string{"drop table", "truncate table"},
+			},
+			{
+				Matcher:          []string{"test.t_pause"},
+				BlockDDL:         []string{"drop table"},
+				BlockedDDLAction: config.BlockedDDLActionPause,
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	cases := []struct {
+		table   string
+		ddlType timodel.ActionType
+		query   string
+		skip    bool
+		err     error
+	}{
+		{"t_allow", timodel.ActionAddColumn, "alter table t_allow add column c int", false, nil},
+		{"t_allow", timodel.ActionCreateTable, "create table t_allow (a int)", false, nil},
+		{"t_allow", timodel.ActionDropColumn, "alter table t_allow drop column c", true, nil},
+		{"t_block", timodel.ActionAddColumn, "alter table t_block add column c int", false, nil},
+		{"t_block", timodel.ActionDropTable, "drop table t_block", true, nil},
+		{"t_block", timodel.ActionTruncateTable, "truncate table t_block", true, nil},
+		{"t_pause", timodel.ActionTruncateTable, "truncate table t_pause", false, nil},
+		{"t_pause", timodel.ActionDropTable, "drop table t_pause", false, cerror.ErrDDLBlockedByFilter},
+		{"t_other", timodel.ActionDropTable, "drop table t_other", false, nil},
+	}
+	for _, c := range cases {
+		ddl := &model.DDLEvent{
+			TableInfo: &model.TableInfo{
+				TableName: model.TableName{Schema: "test", Table: c.table},
+			},
+			Query: c.query,
+			Type:  c.ddlType,
+		}
+		skip, err := f.shouldSkipDDL(ddl)
+		if c.err != nil {
+			require.True(t, errors.ErrorEqual(c.err, err), "case: %+v, err: %v", c, err)
+			require.True(t, cerror.IsChangefeedUnRetryableError(err))
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, c.skip, skip, "case: %+v", c)
+	}
+
+	_, err = newSQLEventFilter(&config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{Matcher: []string{"*.*"}, BlockDDL: []string{"drop everything"}},
+		},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrInvalidDDLType, err), err)
+	_, err = newSQLEventFilter(&config.FilterConfig{
+		EventFilters: []*config.EventFilterRule{
+			{Matcher: []string{"*.*"}, BlockDDL: []string{"drop table"}, BlockedDDLAction: "stop"},
+		},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrFilterRuleInvalid, err), err)
+}
+
 func TestShouldSkipDML(t *testing.T) {
 	t.Parallel()
 	type innerCase struct {
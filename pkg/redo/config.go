@@ -39,6 +39,9 @@ const (
 	DefaultTimeout = 15 * time.Minute
 	// CloseTimeout is the default timeout for close redo writer.
 	CloseTimeout = 15 * time.Second
+	// DefaultStorageMaxTries is the max tries of the file operations on
+	// GCS and Azure Blob storage.
+	DefaultStorageMaxTries = 3
 
 	// FlushWarnDuration is the warning duration for flushing external storage.
 	FlushWarnDuration = time.Second * 20
@@ -153,6 +156,17 @@ func IsExternalStorage(scheme string) bool {
 	}
 }
 
+// isGCSOrAzblobStorage returns whether GCS or Azure Blob storage is used.
+func isGCSOrAzblobStorage(scheme string) bool {
+	switch ConsistentStorage(scheme) {
+	case consistentStorageGCS, consistentStorageGS,
+		consistentStorageAzblob, consistentStorageAzure:
+		return true
+	default:
+		return false
+	}
+}
+
 // IsLocalStorage returns whether a local storage is used.
 func IsLocalStorage(scheme string) bool {
 	switch ConsistentStorage(scheme) {
@@ -181,15 +195,22 @@ var InitExternalStorage = func(ctx context.Context, uri url.URL) (storage.Extern
 	if err != nil {
 		return nil, errors.WrapChangefeedUnretryableErr(errors.ErrStorageInitialize, err)
 	}
+	// The redo logs and meta are always written by a single WriteFile call, which
+	// is atomic for GCS and Azure Blob as well, so it is safe to retry them.
+	if isGCSOrAzblobStorage(uri.Scheme) {
+		s = util.NewExtStorageWithRetry(s, DefaultStorageMaxTries)
+	}
 	return s, nil
 }
 
 func initExternalStorageForTest(ctx context.Context, uri url.URL) (storage.ExternalStorage, error) {
-	if ConsistentStorage(uri.Scheme) == consistentStorageS3 && len(uri.Host) == 0 {
+	if (ConsistentStorage(uri.Scheme) == consistentStorageS3 ||
+		isGCSOrAzblobStorage(uri.Scheme)) && len(uri.Host) == 0 {
 		// TODO: this branch is compatible with previous s3 logic and will be removed
 		// in the future.
+		maskedURI, _ := util.MaskSinkURI(uri.String())
 		return nil, errors.WrapChangefeedUnretryableErr(errors.ErrStorageInitialize,
-			errors.Errorf("please specify the bucket for %+v", uri))
+			errors.Errorf("please specify the bucket for %s", maskedURI))
 	}
 	s, err := util.GetExternalStorageFromURI(ctx, uri.String())
 	if err != nil {
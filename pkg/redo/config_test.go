@@ -187,3 +187,19 @@ func TestInitExternalStorage(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestValidateStorageWithoutBucket(t *testing.T) {
+	t.Parallel()
+
+	for _, urlStr := range []string{
+		"s3:///prefix",
+		"gcs:///prefix",
+		"azure:///prefix?account-name=cdc&account-key=verysecure",
+	} {
+		url, err := storage.ParseRawURL(urlStr)
+		require.NoError(t, err)
+		err = ValidateStorage(url)
+		require.ErrorContains(t, err, "please specify the bucket", urlStr)
+		require.NotContains(t, err.Error(), "verysecure", urlStr)
+	}
+}
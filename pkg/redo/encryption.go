@@ -0,0 +1,185 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// An encrypted redo log record is laid out as follows:
+//
+//	| marker (1 byte) | key id length (1 byte) | key id | nonce (12 bytes) | ciphertext |
+//
+// The marker is 0x00, which can never be the first byte of a plaintext record
+// since it always starts with the version prefix 0xff 0xff, or with a msgpack
+// map header if it is in the legacy v1 format, so the plaintext records written
+// before the encryption is enabled can still be read.
+const (
+	encryptedMarker = 0x00
+	maxKeyIDLength  = 255
+
+	loadKeysTimeout = 30 * time.Second
+)
+
+// Cipher encrypts the redo log records by AES-GCM. The records are always
+// encrypted by the active key, and the other keys are only used to decrypt
+// the records encrypted before the key rotation.
+type Cipher struct {
+	activeKeyID string
+	aeads       map[string]cipher.AEAD
+}
+
+// LoadCipher loads the keys from the key source, which is either the path of
+// a key file or an aws-kms uri, see NewKeyProvider for details.
+func LoadCipher(source string) (*Cipher, error) {
+	provider, err := NewKeyProvider(source)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), loadKeysTimeout)
+	defer cancel()
+	return NewCipher(ctx, provider)
+}
+
+// NewCipher creates a Cipher with the keys provided by the key provider.
+func NewCipher(ctx context.Context, provider KeyProvider) (*Cipher, error) {
+	activeKeyID, keys, err := provider.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newCipher(activeKeyID, keys)
+}
+
+func newCipher(activeKeyID string, keys map[string][]byte) (*Cipher, error) {
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, errors.ErrRedoEncryption.GenWithStack("no redo encryption key is found")
+	}
+	c := &Cipher{activeKeyID: activeKeyID, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+		}
+		c.aeads[id] = aead
+	}
+	return c, nil
+}
+
+func parseCipher(r io.Reader) (*Cipher, error) {
+	activeKeyID, values, err := parseKeyFile(r)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := decodeHexKeys(values)
+	if err != nil {
+		return nil, err
+	}
+	return newCipher(activeKeyID, keys)
+}
+
+// ActiveKeyID returns the id of the key used to encrypt the records.
+func (c *Cipher) ActiveKeyID() string {
+	return c.activeKeyID
+}
+
+// HasKey returns whether the key of the id is loaded.
+func (c *Cipher) HasKey(id string) bool {
+	_, ok := c.aeads[id]
+	return ok
+}
+
+// KeyIDs returns the ids of the loaded keys, the active one comes first.
+func (c *Cipher) KeyIDs() []string {
+	ids := make([]string, 0, len(c.aeads))
+	for id := range c.aeads {
+		if id != c.activeKeyID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return append([]string{c.activeKeyID}, ids...)
+}
+
+// Encrypt encrypts the record by the active key.
+func (c *Cipher) Encrypt(data []byte) ([]byte, error) {
+	aead := c.aeads[c.activeKeyID]
+	headerLen := 2 + len(c.activeKeyID) + aead.NonceSize()
+	out := make([]byte, headerLen, headerLen+len(data)+aead.Overhead())
+	out[0] = encryptedMarker
+	out[1] = byte(len(c.activeKeyID))
+	copy(out[2:], c.activeKeyID)
+	nonce := out[2+len(c.activeKeyID):]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+	}
+	return aead.Seal(out, nonce, data, nil), nil
+}
+
+// IsEncrypted returns whether the record is encrypted.
+func IsEncrypted(data []byte) bool {
+	return len(data) > 0 && data[0] == encryptedMarker
+}
+
+// DecryptRecord decrypts the record if it is encrypted, otherwise the record
+// is returned as it is. c can be nil if the encryption is not enabled, in
+// which case an error is returned for encrypted records.
+func DecryptRecord(c *Cipher, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if len(data) < 2 || len(data) < 2+int(data[1]) {
+		return nil, errors.ErrRedoEncryption.GenWithStack("truncated encrypted redo log record")
+	}
+	id := string(data[2 : 2+int(data[1])])
+	if c == nil || !c.HasKey(id) {
+		return nil, errors.ErrRedoEncryption.GenWithStack(
+			"redo log is encrypted by key %s, which is not provided", id)
+	}
+	aead := c.aeads[id]
+	body := data[2+len(id):]
+	if len(body) < aead.NonceSize() {
+		return nil, errors.ErrRedoEncryption.GenWithStack("truncated encrypted redo log record")
+	}
+	nonce, ciphertext := body[:aead.NonceSize()], body[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+	}
+	return plain, nil
+}
+
+// CheckKeys returns an error if any of the keys recorded in the redo meta is
+// not provided, so that the missing key is reported before reading the logs.
+// c can be nil if the encryption is not enabled.
+func CheckKeys(c *Cipher, keyIDs []string) error {
+	for _, id := range keyIDs {
+		if c == nil || !c.HasKey(id) {
+			return errors.ErrRedoEncryption.GenWithStack(
+				"redo log may be encrypted by key %s, which is not provided", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCipher(t *testing.T) {
+	t.Parallel()
+
+	keyFile := filepath.Join(t.TempDir(), "redo.key")
+	err := os.WriteFile(keyFile, []byte(`# rotated at 2023-06-01
+k2:000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f
+
+k1:000102030405060708090a0b0c0d0e0f
+`), 0o600)
+	require.NoError(t, err)
+	c, err := LoadCipher(keyFile)
+	require.NoError(t, err)
+	require.Equal(t, "k2", c.ActiveKeyID())
+	require.True(t, c.HasKey("k1"))
+	require.False(t, c.HasKey("k3"))
+
+	_, err = LoadCipher(filepath.Join(t.TempDir(), "not-exist"))
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+
+	for _, content := range []string{
+		"",
+		"# no keys",
+		"000102030405060708090a0b0c0d0e0f",
+		":000102030405060708090a0b0c0d0e0f",
+		"k1:not-hex",
+		"k1:0001020304",
+		"k1:000102030405060708090a0b0c0d0e0f\nk1:000102030405060708090a0b0c0d0e0f",
+	} {
+		_, err := parseCipher(strings.NewReader(content))
+		require.True(t, errors.ErrRedoEncryption.Equal(err), content)
+	}
+}
+
+func TestEncryptAndDecryptRecord(t *testing.T) {
+	t.Parallel()
+
+	oldCipher, err := parseCipher(strings.NewReader("k1:000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	rotatedCipher, err := parseCipher(strings.NewReader(
+		"k2:0f0e0d0c0b0a09080706050403020100\nk1:000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"k2", "k1"}, rotatedCipher.KeyIDs())
+
+	// a plaintext record starts with the version prefix 0xff 0xff.
+	plain := []byte{0xff, 0xff, 0x01, 0x82, 0x01, 0x02}
+	require.False(t, IsEncrypted(plain))
+	data, err := DecryptRecord(nil, plain)
+	require.NoError(t, err)
+	require.Equal(t, plain, data)
+
+	encrypted, err := oldCipher.Encrypt(plain)
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	// the records encrypted by the rotated key can still be decrypted.
+	data, err = DecryptRecord(rotatedCipher, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, plain, data)
+
+	encrypted, err = rotatedCipher.Encrypt(plain)
+	require.NoError(t, err)
+	_, err = DecryptRecord(oldCipher, encrypted)
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+	_, err = DecryptRecord(nil, encrypted)
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+
+	// the tampered record can not be decrypted.
+	encrypted[len(encrypted)-1] ^= 0xff
+	_, err = DecryptRecord(rotatedCipher, encrypted)
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+	_, err = DecryptRecord(rotatedCipher, encrypted[:4])
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+}
+
+func TestCheckKeys(t *testing.T) {
+	t.Parallel()
+
+	c, err := parseCipher(strings.NewReader(
+		"k2:0f0e0d0c0b0a09080706050403020100\nk1:000102030405060708090a0b0c0d0e0f"))
+	require.NoError(t, err)
+	require.NoError(t, CheckKeys(c, []string{"k1", "k2"}))
+	require.NoError(t, CheckKeys(nil, nil))
+
+	err = CheckKeys(c, []string{"k2", "k0"})
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+	err = CheckKeys(nil, []string{"k1"})
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+}
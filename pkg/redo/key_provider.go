@@ -0,0 +1,179 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// AWSKMSScheme is the scheme of the key source whose keys are encrypted
+// by the AWS KMS.
+const AWSKMSScheme = "aws-kms"
+
+// KeyProvider provides the keys used to encrypt and decrypt the redo logs.
+type KeyProvider interface {
+	// Keys returns the keys by their ids, and the id of the active key
+	// which the records are encrypted by.
+	Keys(ctx context.Context) (activeKeyID string, keys map[string][]byte, err error)
+}
+
+// NewKeyProvider creates a KeyProvider from the key source, which is either
+//   - the path of a key file, each line of which is in form of
+//     "<key-id>:<hex encoded key>", or
+//   - an uri in form of "aws-kms:///path/to/key-file?region=<region>&endpoint=<endpoint>",
+//     each line of the key file is in form of "<key-id>:<hex encoded ciphertext>",
+//     where the ciphertext is the data key encrypted by the AWS KMS, so the
+//     plaintext keys are never persisted on the disk.
+//
+// The key of the first line is the active key, empty lines and lines starting
+// with '#' are ignored. The keys are never persisted with the changefeed config.
+func NewKeyProvider(source string) (KeyProvider, error) {
+	uri, err := url.Parse(source)
+	if err != nil || uri.Scheme != AWSKMSScheme {
+		return &fileKeyProvider{path: source}, nil
+	}
+	if uri.Path == "" {
+		return nil, errors.ErrRedoEncryption.GenWithStack(
+			"the key file path is required in the %s uri", AWSKMSScheme)
+	}
+	cfg := aws.NewConfig()
+	if region := uri.Query().Get("region"); region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	if endpoint := uri.Query().Get("endpoint"); endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+	}
+	return &kmsKeyProvider{path: uri.Path, client: kms.New(sess)}, nil
+}
+
+// fileKeyProvider loads the plaintext keys from the key file, which is
+// expected to be provisioned by a secret manager.
+type fileKeyProvider struct {
+	path string
+}
+
+// Keys implements KeyProvider.
+func (p *fileKeyProvider) Keys(_ context.Context) (string, map[string][]byte, error) {
+	activeKeyID, values, err := readKeyFile(p.path)
+	if err != nil {
+		return "", nil, err
+	}
+	keys, err := decodeHexKeys(values)
+	if err != nil {
+		return "", nil, err
+	}
+	return activeKeyID, keys, nil
+}
+
+// kmsKeyProvider loads the data keys encrypted by the AWS KMS from the key
+// file, and decrypts them by the KMS.
+type kmsKeyProvider struct {
+	path   string
+	client kmsiface.KMSAPI
+}
+
+// Keys implements KeyProvider.
+func (p *kmsKeyProvider) Keys(ctx context.Context) (string, map[string][]byte, error) {
+	activeKeyID, values, err := readKeyFile(p.path)
+	if err != nil {
+		return "", nil, err
+	}
+	blobs, err := decodeHexKeys(values)
+	if err != nil {
+		return "", nil, err
+	}
+	keys := make(map[string][]byte, len(blobs))
+	for id, blob := range blobs {
+		out, err := p.client.DecryptWithContext(ctx, &kms.DecryptInput{CiphertextBlob: blob})
+		if err != nil {
+			return "", nil, errors.WrapError(errors.ErrRedoEncryption, err)
+		}
+		keys[id] = out.Plaintext
+	}
+	return activeKeyID, keys, nil
+}
+
+func readKeyFile(path string) (string, map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, errors.WrapError(errors.ErrRedoEncryption, err)
+	}
+	defer f.Close()
+	return parseKeyFile(f)
+}
+
+// parseKeyFile returns the hex encoded values by their key ids, and the id
+// of the first key, which is the active one.
+func parseKeyFile(r io.Reader) (string, map[string]string, error) {
+	var activeKeyID string
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, value, ok := strings.Cut(line, ":")
+		if !ok || id == "" || len(id) > maxKeyIDLength {
+			return "", nil, errors.ErrRedoEncryption.GenWithStack(
+				"invalid redo encryption key, the key should be in form of <key-id>:<hex encoded key>")
+		}
+		if _, ok := values[id]; ok {
+			return "", nil, errors.ErrRedoEncryption.GenWithStack(
+				"duplicated redo encryption key id %s", id)
+		}
+		if activeKeyID == "" {
+			activeKeyID = id
+		}
+		values[id] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, errors.WrapError(errors.ErrRedoEncryption, err)
+	}
+	if activeKeyID == "" {
+		return "", nil, errors.ErrRedoEncryption.GenWithStack("no redo encryption key is found")
+	}
+	return activeKeyID, values, nil
+}
+
+func decodeHexKeys(values map[string]string) (map[string][]byte, error) {
+	keys := make(map[string][]byte, len(values))
+	for id, value := range values {
+		key, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrRedoEncryption, err)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKMS "decrypts" a ciphertext by dropping its prefix.
+type mockKMS struct {
+	kmsiface.KMSAPI
+	prefix []byte
+}
+
+func (m *mockKMS) DecryptWithContext(
+	_ context.Context, input *kms.DecryptInput, _ ...request.Option,
+) (*kms.DecryptOutput, error) {
+	if !bytes.HasPrefix(input.CiphertextBlob, m.prefix) {
+		return nil, awserr.New(kms.ErrCodeInvalidCiphertextException, "invalid ciphertext", nil)
+	}
+	return &kms.DecryptOutput{Plaintext: input.CiphertextBlob[len(m.prefix):]}, nil
+}
+
+func TestKMSKeyProvider(t *testing.T) {
+	t.Parallel()
+
+	key := "000102030405060708090a0b0c0d0e0f"
+	prefix := []byte("kms")
+	keyFile := filepath.Join(t.TempDir(), "redo.key")
+	err := os.WriteFile(keyFile,
+		[]byte("k1:"+hex.EncodeToString(prefix)+key+"\n"), 0o600)
+	require.NoError(t, err)
+
+	provider := &kmsKeyProvider{path: keyFile, client: &mockKMS{prefix: prefix}}
+	c, err := NewCipher(context.Background(), provider)
+	require.NoError(t, err)
+	require.Equal(t, "k1", c.ActiveKeyID())
+
+	plain := &fileKeyProvider{path: keyFile}
+	_, err = NewCipher(context.Background(), plain)
+	// the encrypted data key is not a valid aes key.
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+
+	err = os.WriteFile(keyFile, []byte("k1:"+key+"\n"), 0o600)
+	require.NoError(t, err)
+	_, err = NewCipher(context.Background(), provider)
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewKeyProvider("/path/to/redo.key")
+	require.NoError(t, err)
+	require.Equal(t, &fileKeyProvider{path: "/path/to/redo.key"}, provider)
+
+	provider, err = NewKeyProvider("aws-kms:///path/to/redo.key?region=us-west-2")
+	require.NoError(t, err)
+	require.Equal(t, "/path/to/redo.key", provider.(*kmsKeyProvider).path)
+
+	_, err = NewKeyProvider("aws-kms://?region=us-west-2")
+	require.True(t, errors.ErrRedoEncryption.Equal(err))
+}
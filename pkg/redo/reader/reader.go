@@ -0,0 +1,212 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reader provides a library to read the redo logs of a changefeed,
+// so that the redo logs can be consumed by the tools other than the cdc
+// binary, such as a custom disaster recovery orchestration or an audit
+// pipeline.
+//
+// A typical usage is:
+//
+//	r, err := reader.Open(ctx, &reader.Config{
+//		Storage: "s3://bucket/changefeed?endpoint=http://127.0.0.1:9000/",
+//		Dir:     "/tmp/redo",
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer r.Close()
+//	for {
+//		event, err := r.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		// handle event.Row or event.DDL
+//	}
+package reader
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	logreader "github.com/pingcap/tiflow/cdc/redo/reader"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/redo"
+	"github.com/pingcap/tiflow/pkg/util"
+)
+
+// Config is the config to open the redo logs.
+type Config struct {
+	// Storage is the URI of the redo logs, which is the consistent.storage
+	// of the changefeed, such as "s3://bucket/changefeed" or "file:///redo".
+	Storage string
+	// Dir is the local directory used to sort the redo logs, it is removed
+	// when the reader is opened.
+	Dir string
+	// EncryptionKeyFile is the path of the file containing the keys used to
+	// decrypt the redo logs, it is required if the redo logs are encrypted.
+	EncryptionKeyFile string
+	// StartTs and EndTs narrow the events read to (StartTs, EndTs], zero
+	// means to read from the checkpoint ts or to the resolved ts in meta.
+	StartTs uint64
+	EndTs   uint64
+}
+
+func (c *Config) toLogReaderConfig() (string, *logreader.LogReaderConfig, error) {
+	uri, err := url.Parse(c.Storage)
+	if err != nil {
+		return "", nil, errors.WrapError(errors.ErrConsistentStorage, err)
+	}
+	if redo.IsLocalStorage(uri.Scheme) {
+		uri.Scheme = "file"
+	}
+	return uri.Scheme, &logreader.LogReaderConfig{
+		URI:                *uri,
+		Dir:                c.Dir,
+		UseExternalStorage: redo.IsExternalStorage(uri.Scheme),
+		EncryptionKeyFile:  c.EncryptionKeyFile,
+		StartTs:            c.StartTs,
+		EndTs:              c.EndTs,
+	}, nil
+}
+
+// Event is either a row changed event or a DDL event in the redo logs.
+type Event struct {
+	CommitTs uint64
+	// Only one of Row and DDL is not nil.
+	Row *model.RowChangedEvent
+	DDL *model.DDLEvent
+}
+
+// Reader reads the events in the redo logs in the order of commit ts. A DDL
+// is returned after all rows committed before or at the same ts of it, which
+// is the order the events should be applied in.
+type Reader struct {
+	rd logreader.RedoLogReader
+
+	checkpointTs uint64
+	resolvedTs   uint64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	// done is closed after runErr is set.
+	done   chan struct{}
+	runErr error
+
+	row     *model.RowChangedEvent
+	ddl     *model.DDLEvent
+	rowDone bool
+	ddlDone bool
+}
+
+// Open opens the redo logs and starts reading them in background. The reader
+// stops if ctx is canceled or Close is called.
+func Open(ctx context.Context, cfg *Config) (*Reader, error) {
+	storageType, readerCfg, err := cfg.toLogReaderConfig()
+	if err != nil {
+		return nil, err
+	}
+	ctx = contextutil.PutRoleInCtx(ctx, util.RoleRedoLogApplier)
+	rd, err := logreader.NewRedoLogReader(ctx, storageType, readerCfg)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(ctx, rd)
+}
+
+func newReader(ctx context.Context, rd logreader.RedoLogReader) (*Reader, error) {
+	checkpointTs, resolvedTs, err := rd.ReadMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{
+		rd:           rd,
+		checkpointTs: checkpointTs,
+		resolvedTs:   resolvedTs,
+		done:         make(chan struct{}),
+	}
+	r.ctx, r.cancel = context.WithCancel(ctx)
+	go func() {
+		r.runErr = rd.Run(r.ctx)
+		close(r.done)
+		// Unblock Next if the logs can not be read.
+		r.cancel()
+	}()
+	return r, nil
+}
+
+// Meta returns the checkpoint ts and resolved ts in the meta of the redo logs,
+// the changefeed can be recovered to the resolved ts by the events between them.
+func (r *Reader) Meta() (checkpointTs, resolvedTs uint64) {
+	return r.checkpointTs, r.resolvedTs
+}
+
+// Next returns the next event, or io.EOF if all events are read.
+func (r *Reader) Next() (*Event, error) {
+	if r.row == nil && !r.rowDone {
+		row, err := r.rd.ReadNextRow(r.ctx)
+		if err != nil {
+			return nil, r.wrapErr(err)
+		}
+		r.row, r.rowDone = row, row == nil
+	}
+	if r.ddl == nil && !r.ddlDone {
+		ddl, err := r.rd.ReadNextDDL(r.ctx)
+		if err != nil {
+			return nil, r.wrapErr(err)
+		}
+		r.ddl, r.ddlDone = ddl, ddl == nil
+	}
+
+	switch {
+	case r.ddl != nil && (r.row == nil || r.row.CommitTs > r.ddl.CommitTs):
+		event := &Event{CommitTs: r.ddl.CommitTs, DDL: r.ddl}
+		r.ddl = nil
+		return event, nil
+	case r.row != nil:
+		event := &Event{CommitTs: r.row.CommitTs, Row: r.row}
+		r.row = nil
+		return event, nil
+	}
+	<-r.done
+	if r.runErr != nil {
+		return nil, r.runErr
+	}
+	return nil, io.EOF
+}
+
+// wrapErr returns the error of reading the logs in background, which is the
+// cause of the context being canceled.
+func (r *Reader) wrapErr(err error) error {
+	select {
+	case <-r.done:
+		if r.runErr != nil {
+			return r.runErr
+		}
+	default:
+	}
+	return err
+}
+
+// Close stops reading the redo logs.
+func (r *Reader) Close() error {
+	r.cancel()
+	<-r.done
+	return nil
+}
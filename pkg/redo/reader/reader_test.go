@@ -0,0 +1,120 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/stretchr/testify/require"
+)
+
+type mockLogReader struct {
+	runErr error
+	rowCh  chan *model.RowChangedEvent
+	ddlCh  chan *model.DDLEvent
+}
+
+func newMockLogReader(rows []*model.RowChangedEvent, ddls []*model.DDLEvent) *mockLogReader {
+	r := &mockLogReader{
+		rowCh: make(chan *model.RowChangedEvent, len(rows)),
+		ddlCh: make(chan *model.DDLEvent, len(ddls)),
+	}
+	for _, row := range rows {
+		r.rowCh <- row
+	}
+	for _, ddl := range ddls {
+		r.ddlCh <- ddl
+	}
+	return r
+}
+
+func (r *mockLogReader) Run(ctx context.Context) error {
+	if r.runErr != nil {
+		return r.runErr
+	}
+	close(r.rowCh)
+	close(r.ddlCh)
+	return nil
+}
+
+func (r *mockLogReader) ReadNextRow(ctx context.Context) (*model.RowChangedEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case row := <-r.rowCh:
+		return row, nil
+	}
+}
+
+func (r *mockLogReader) ReadNextDDL(ctx context.Context) (*model.DDLEvent, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case ddl := <-r.ddlCh:
+		return ddl, nil
+	}
+}
+
+func (r *mockLogReader) ReadMeta(ctx context.Context) (checkpointTs, resolvedTs uint64, err error) {
+	return 10, 100, nil
+}
+
+func TestReaderNext(t *testing.T) {
+	t.Parallel()
+
+	rd := newMockLogReader(
+		[]*model.RowChangedEvent{{CommitTs: 20}, {CommitTs: 30}, {CommitTs: 40}},
+		[]*model.DDLEvent{{CommitTs: 10}, {CommitTs: 30}, {CommitTs: 100}},
+	)
+	r, err := newReader(context.Background(), rd)
+	require.NoError(t, err)
+	defer r.Close()
+
+	checkpointTs, resolvedTs := r.Meta()
+	require.Equal(t, uint64(10), checkpointTs)
+	require.Equal(t, uint64(100), resolvedTs)
+
+	expected := []struct {
+		commitTs uint64
+		isDDL    bool
+	}{
+		{10, true}, {20, false}, {30, false}, {30, true}, {40, false}, {100, true},
+	}
+	for _, e := range expected {
+		event, err := r.Next()
+		require.NoError(t, err)
+		require.Equal(t, e.commitTs, event.CommitTs)
+		require.Equal(t, e.isDDL, event.DDL != nil)
+		require.Equal(t, !e.isDDL, event.Row != nil)
+	}
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReaderRunFail(t *testing.T) {
+	t.Parallel()
+
+	rd := newMockLogReader(nil, nil)
+	rd.runErr = errors.New("run fail")
+	r, err := newReader(context.Background(), rd)
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, err = r.Next()
+	require.ErrorIs(t, err, rd.runErr)
+}
@@ -75,7 +75,9 @@ func (s *Credential) ToTLSConfigWithVerify() (*tls.Config, error) {
 	return cfg, errors.WrapError(errors.ErrToTLSConfigFailed, err)
 }
 
-func (s *Credential) getSelfCommonName() (string, error) {
+// SelfCommonName returns the Common Name in the certificate specified by
+// s.CertPath, or an empty string if the certificate is not specified.
+func (s *Credential) SelfCommonName() (string, error) {
 	if s.CertPath == "" {
 		return "", nil
 	}
@@ -98,7 +100,7 @@ func (s *Credential) getSelfCommonName() (string, error) {
 // AddSelfCommonName add Common Name in certificate that specified by s.CertPath
 // to s.CertAllowedCN
 func (s *Credential) AddSelfCommonName() error {
-	cn, err := s.getSelfCommonName()
+	cn, err := s.SelfCommonName()
 	if err != nil {
 		return err
 	}
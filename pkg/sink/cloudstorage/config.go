@@ -64,6 +64,11 @@ type Config struct {
 	FileIndexWidth           int
 	DateSeparator            string
 	EnablePartitionSeparator bool
+	// EnableTableIDSeparator separates the data files of all the physical
+	// tables by the table ID like the partitions. It's enabled if there are
+	// route rules, so that the tables routed to the same table don't overwrite
+	// the files of each other.
+	EnableTableIDSeparator bool
 }
 
 // NewConfig returns the default cloud storage sink config.
@@ -113,6 +118,7 @@ func (c *Config) Apply(
 
 	c.DateSeparator = util.GetOrZero(replicaConfig.Sink.DateSeparator)
 	c.EnablePartitionSeparator = util.GetOrZero(replicaConfig.Sink.EnablePartitionSeparator)
+	c.EnableTableIDSeparator = len(replicaConfig.Sink.RouteRules) > 0
 	c.FileIndexWidth = util.GetOrZero(replicaConfig.Sink.FileIndexWidth)
 
 	if c.FileIndexWidth < config.MinFileIndexWidth || c.FileIndexWidth > config.MaxFileIndexWidth {
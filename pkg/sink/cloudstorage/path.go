@@ -168,6 +168,9 @@ func (f *FilePathGenerator) CheckOrWriteSchema(
 
 	var def TableDefinition
 	def.FromTableInfo(tableInfo, table.TableInfoVersion)
+	// The table may be routed to another table by the route rules.
+	def.Schema = table.TableNameWithPhysicTableID.Schema
+	def.Table = table.TableNameWithPhysicTableID.Table
 	if !def.IsTableSchema() {
 		// only check schema for table
 		log.Panic("invalid table schema", zap.Any("versionedTableName", table),
@@ -289,7 +292,8 @@ func (f *FilePathGenerator) generateDataDirPath(tbl VersionedTableName, date str
 	elems = append(elems, tbl.TableNameWithPhysicTableID.Table)
 	elems = append(elems, fmt.Sprintf("%d", f.versionMap[tbl]))
 
-	if f.config.EnablePartitionSeparator && tbl.TableNameWithPhysicTableID.IsPartition {
+	if f.config.EnableTableIDSeparator ||
+		(f.config.EnablePartitionSeparator && tbl.TableNameWithPhysicTableID.IsPartition) {
 		elems = append(elems, fmt.Sprintf("%d", tbl.TableNameWithPhysicTableID.TableID))
 	}
 
@@ -145,6 +145,38 @@ func TestGenerateDataFilePath(t *testing.T) {
 	require.Equal(t, "test/table1/5/2023-01-01/CDC000002.json", path)
 }
 
+func TestGenerateDataFilePathWithTableIDSeparator(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The shards are routed to the same table.
+	shard1 := VersionedTableName{
+		TableNameWithPhysicTableID: model.TableName{
+			Schema:  "db_all",
+			Table:   "orders",
+			TableID: 100,
+		},
+		TableInfoVersion: 5,
+	}
+	shard2 := shard1
+	shard2.TableNameWithPhysicTableID.TableID = 101
+
+	dir := t.TempDir()
+	f := testFilePathGenerator(ctx, t, dir)
+	f.config.EnableTableIDSeparator = true
+	f.versionMap[shard1] = shard1.TableInfoVersion
+	f.versionMap[shard2] = shard2.TableInfoVersion
+	date := f.GenerateDateStr()
+	path, err := f.GenerateDataFilePath(ctx, shard1, date)
+	require.NoError(t, err)
+	require.Equal(t, "db_all/orders/5/100/CDC000001.json", path)
+	path, err = f.GenerateDataFilePath(ctx, shard2, date)
+	require.NoError(t, err)
+	require.Equal(t, "db_all/orders/5/101/CDC000001.json", path)
+}
+
 func TestFetchIndexFromFileName(t *testing.T) {
 	t.Parallel()
 
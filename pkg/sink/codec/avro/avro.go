@@ -61,8 +61,16 @@ type Options struct {
 	// exposed to the outside users.
 	EnableWatermarkEvent bool
 
+	// EnableSchemaChangeEvent set to true, avro encode DDL event as a schema change event,
+	// whose schema is registered under a dedicated subject in the schema registry.
+	EnableSchemaChangeEvent bool
+
 	DecimalHandlingMode        string
 	BigintUnsignedHandlingMode string
+	TimePrecisionMode          string
+	EnumHandlingMode           string
+	SetHandlingMode            string
+	NullableUnionOrder         string
 }
 
 type avroEncodeInput struct {
@@ -176,8 +184,8 @@ type ddlEvent struct {
 	CommitTs uint64             `json:"commitTs"`
 }
 
-// EncodeDDLEvent only encode DDL event if the watermark event is enabled
-// it's only used for the testing purpose.
+// EncodeDDLEvent encode DDL event if the watermark event is enabled, which
+// is only used for the testing purpose, or the schema change event is enabled.
 func (a *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
 	if a.EnableTiDBExtension && a.EnableWatermarkEvent {
 		buf := new(bytes.Buffer)
@@ -200,6 +208,14 @@ func (a *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error
 		return common.NewDDLMsg(config.ProtocolAvro, nil, value, e), nil
 	}
 
+	if a.EnableSchemaChangeEvent {
+		value, err := a.encodeSchemaChangeEvent(e)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return common.NewDDLMsg(config.ProtocolAvro, nil, value, e), nil
+	}
+
 	return nil, nil
 }
 
@@ -272,8 +288,7 @@ func (a *BatchEncoder) avroEncode(
 			input,
 			enableTiDBExtension,
 			enableRowLevelChecksum,
-			a.DecimalHandlingMode,
-			a.BigintUnsignedHandlingMode,
+			a.Options,
 		)
 		if err != nil {
 			log.Error("AvroEventBatchEncoder: generating schema failed", zap.Error(err))
@@ -293,12 +308,13 @@ func (a *BatchEncoder) avroEncode(
 	}
 
 	native, err := rowToAvroData(
+		namespace,
+		e.Table.Table,
 		input,
 		e.CommitTs,
 		operation,
 		enableTiDBExtension,
-		a.DecimalHandlingMode,
-		a.BigintUnsignedHandlingMode,
+		a.Options,
 	)
 	if err != nil {
 		log.Error("AvroEventBatchEncoder: converting to native failed", zap.Error(err))
@@ -492,6 +508,11 @@ func getAvroNamespace(namespace string, tableName *model.TableName) string {
 	return sanitizeName(namespace) + "." + sanitizeName(tableName.Schema)
 }
 
+// getAvroFullName returns the full name of the named type in the namespace.
+func getAvroFullName(namespace string, name string) string {
+	return namespace + "." + sanitizeName(name)
+}
+
 type avroSchema struct {
 	Type string `json:"type"`
 	// connect.parameters is designated field extracted by schema registry
@@ -505,18 +526,30 @@ type avroLogicalTypeSchema struct {
 	Scale       interface{} `json:"scale,omitempty"`
 }
 
+type avroEnumSchema struct {
+	avroSchema
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Symbols   []string `json:"symbols"`
+}
+
+type avroArraySchema struct {
+	avroSchema
+	Items string `json:"items"`
+}
+
 func rowToAvroSchema(
 	namespace string,
 	name string,
 	input *avroEncodeInput,
 	enableTiDBExtension bool,
 	enableRowLevelChecksum bool,
-	decimalHandlingMode string,
-	bigintUnsignedHandlingMode string,
+	o *Options,
 ) (string, error) {
 	if enableRowLevelChecksum {
 		sort.Sort(input)
 	}
+	typeNamespace := getAvroFullName(namespace, name)
 
 	top := avroSchemaTop{
 		Tp:        "record",
@@ -529,8 +562,8 @@ func rowToAvroSchema(
 		avroType, err := columnToAvroSchema(
 			col,
 			input.colInfos[i].Ft,
-			decimalHandlingMode,
-			bigintUnsignedHandlingMode,
+			typeNamespace,
+			o,
 		)
 		if err != nil {
 			return "", err
@@ -543,8 +576,8 @@ func rowToAvroSchema(
 		defaultValue, _, err := columnToAvroData(
 			&copy,
 			input.colInfos[i].Ft,
-			decimalHandlingMode,
-			bigintUnsignedHandlingMode,
+			typeNamespace,
+			o,
 		)
 		if err != nil {
 			log.Error("fail to get default value for avro schema")
@@ -553,28 +586,9 @@ func rowToAvroSchema(
 		// goavro doesn't support set default value for logical type
 		// https://github.com/linkedin/goavro/issues/202
 		if _, ok := avroType.(avroLogicalTypeSchema); ok {
-			if col.Flag.IsNullable() {
-				field["type"] = []interface{}{"null", avroType}
-				field["default"] = nil
-			} else {
-				field["type"] = avroType
-			}
-		} else {
-			if col.Flag.IsNullable() {
-				// https://stackoverflow.com/questions/22938124/avro-field-default-values
-				if defaultValue == nil {
-					field["type"] = []interface{}{"null", avroType}
-				} else {
-					field["type"] = []interface{}{avroType, "null"}
-				}
-				field["default"] = defaultValue
-			} else {
-				field["type"] = avroType
-				if defaultValue != nil {
-					field["default"] = defaultValue
-				}
-			}
+			defaultValue = nil
 		}
+		setFieldTypeAndDefault(field, avroType, defaultValue, col.Flag.IsNullable(), o.NullableUnionOrder)
 
 		top.Fields = append(top.Fields, field)
 	}
@@ -631,13 +645,15 @@ func rowToAvroSchema(
 }
 
 func rowToAvroData(
+	namespace string,
+	name string,
 	input *avroEncodeInput,
 	commitTs uint64,
 	operation string,
 	enableTiDBExtension bool,
-	decimalHandlingMode string,
-	bigintUnsignedHandlingMode string,
+	o *Options,
 ) (map[string]interface{}, error) {
+	typeNamespace := getAvroFullName(namespace, name)
 	ret := make(map[string]interface{}, len(input.columns))
 	for i, col := range input.columns {
 		if col == nil {
@@ -646,8 +662,8 @@ func rowToAvroData(
 		data, str, err := columnToAvroData(
 			col,
 			input.colInfos[i].Ft,
-			decimalHandlingMode,
-			bigintUnsignedHandlingMode,
+			typeNamespace,
+			o,
 		)
 		if err != nil {
 			return nil, err
@@ -671,11 +687,13 @@ func rowToAvroData(
 	return ret, nil
 }
 
+// columnToAvroSchema returns the avro schema of the column, named types such
+// as enum are defined in the typeNamespace.
 func columnToAvroSchema(
 	col *model.Column,
 	ft *types.FieldType,
-	decimalHandlingMode string,
-	bigintUnsignedHandlingMode string,
+	typeNamespace string,
+	o *Options,
 ) (interface{}, error) {
 	tt := getTiDBTypeFromColumn(col)
 	switch col.Type {
@@ -698,7 +716,7 @@ func columnToAvroSchema(
 		}, nil
 	case mysql.TypeLonglong: // BIGINT
 		if col.Flag.IsUnsigned() &&
-			bigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeString {
+			o.BigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeString {
 			return avroSchema{
 				Type:       "string",
 				Parameters: map[string]string{tidbType: tt},
@@ -731,7 +749,7 @@ func columnToAvroSchema(
 			},
 		}, nil
 	case mysql.TypeNewDecimal:
-		if decimalHandlingMode == common.DecimalHandlingModePrecise {
+		if o.DecimalHandlingMode == common.DecimalHandlingModePrecise {
 			defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
 			displayFlen, displayDecimal := ft.GetFlen(), ft.GetDecimal()
 			// length not specified, set it to system type default
@@ -781,19 +799,36 @@ func columnToAvroSchema(
 			e = escapeEnumAndSetOptions(e)
 			es = append(es, e)
 		}
-		return avroSchema{
+		schema := avroSchema{
 			Type: "string",
 			Parameters: map[string]string{
 				tidbType:  tt,
 				"allowed": strings.Join(es, ","),
 			},
-		}, nil
+		}
+		if col.Type == mysql.TypeEnum && o.EnumHandlingMode == common.EnumHandlingModeEnum {
+			if symbols, ok := avroEnumSymbols(ft.GetElems()); ok {
+				return newAvroEnumSchema(schema, typeNamespace, col.Name, symbols), nil
+			}
+			log.Warn("enum elements cannot be converted to distinct avro enum symbols, "+
+				"encode the column as string",
+				zap.String("column", col.Name), zap.Strings("elems", ft.GetElems()))
+		}
+		if col.Type == mysql.TypeSet && o.SetHandlingMode == common.SetHandlingModeArray {
+			schema.Type = "array"
+			return avroArraySchema{avroSchema: schema, Items: "string"}, nil
+		}
+		return schema, nil
 	case mysql.TypeJSON:
 		return avroSchema{
 			Type:       "string",
 			Parameters: map[string]string{tidbType: tt},
 		}, nil
 	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		if o.TimePrecisionMode == common.TimePrecisionModeMillis ||
+			o.TimePrecisionMode == common.TimePrecisionModeMicros {
+			return timeToAvroLogicalTypeSchema(col.Type, tt, o.TimePrecisionMode), nil
+		}
 		return avroSchema{
 			Type:       "string",
 			Parameters: map[string]string{tidbType: tt},
@@ -809,11 +844,13 @@ func columnToAvroSchema(
 	}
 }
 
+// columnToAvroData returns the native avro data of the column and the name of
+// its avro type, which is used to build the union of a nullable column.
 func columnToAvroData(
 	col *model.Column,
 	ft *types.FieldType,
-	decimalHandlingMode string,
-	bigintUnsignedHandlingMode string,
+	typeNamespace string,
+	o *Options,
 ) (interface{}, string, error) {
 	if col.Value == nil {
 		return nil, "null", nil
@@ -850,7 +887,7 @@ func columnToAvroData(
 	case mysql.TypeLonglong:
 		if v, ok := col.Value.(string); ok {
 			if col.Flag.IsUnsigned() {
-				if bigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeString {
+				if o.BigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeString {
 					return v, "string", nil
 				}
 				n, err := strconv.ParseUint(v, 10, 64)
@@ -866,7 +903,7 @@ func columnToAvroData(
 			return n, "long", nil
 		}
 		if col.Flag.IsUnsigned() {
-			if bigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeLong {
+			if o.BigintUnsignedHandlingMode == common.BigintUnsignedHandlingModeLong {
 				return int64(col.Value.(uint64)), "long", nil
 			}
 			// bigintUnsignedHandlingMode == "string"
@@ -897,7 +934,7 @@ func columnToAvroData(
 		}
 		return []byte(types.NewBinaryLiteralFromUint(col.Value.(uint64), -1)), "bytes", nil
 	case mysql.TypeNewDecimal:
-		if decimalHandlingMode == common.DecimalHandlingModePrecise {
+		if o.DecimalHandlingMode == common.DecimalHandlingModePrecise {
 			v, succ := new(big.Rat).SetString(col.Value.(string))
 			if !succ {
 				return nil, "", cerror.ErrAvroEncodeFailed.GenWithStack(
@@ -926,26 +963,42 @@ func columnToAvroData(
 		}
 		return string(col.Value.([]byte)), "string", nil
 	case mysql.TypeEnum:
-		if v, ok := col.Value.(string); ok {
-			return v, "string", nil
+		name, ok := col.Value.(string)
+		if !ok {
+			enumVar, err := types.ParseEnumValue(ft.GetElems(), col.Value.(uint64))
+			if err != nil {
+				return nil, "", cerror.WrapError(cerror.ErrAvroEncodeFailed, err)
+			}
+			name = enumVar.Name
 		}
-		enumVar, err := types.ParseEnumValue(ft.GetElems(), col.Value.(uint64))
-		if err != nil {
-			return nil, "", cerror.WrapError(cerror.ErrAvroEncodeFailed, err)
+		if o.EnumHandlingMode == common.EnumHandlingModeEnum {
+			// the column is encoded as string if the symbols are not distinct.
+			if _, ok := avroEnumSymbols(ft.GetElems()); ok {
+				return avroEnumSymbol(name), getAvroFullName(typeNamespace, col.Name), nil
+			}
 		}
-		return enumVar.Name, "string", nil
+		return name, "string", nil
 	case mysql.TypeSet:
-		if v, ok := col.Value.(string); ok {
-			return v, "string", nil
+		name, ok := col.Value.(string)
+		if !ok {
+			setVar, err := types.ParseSetValue(ft.GetElems(), col.Value.(uint64))
+			if err != nil {
+				return nil, "", cerror.WrapError(cerror.ErrAvroEncodeFailed, err)
+			}
+			name = setVar.Name
 		}
-		setVar, err := types.ParseSetValue(ft.GetElems(), col.Value.(uint64))
-		if err != nil {
-			return nil, "", cerror.WrapError(cerror.ErrAvroEncodeFailed, err)
+		if o.SetHandlingMode == common.SetHandlingModeArray {
+			return setToAvroArray(name), "array", nil
 		}
-		return setVar.Name, "string", nil
+		return name, "string", nil
 	case mysql.TypeJSON:
 		return col.Value.(string), "string", nil
 	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		if o.TimePrecisionMode == common.TimePrecisionModeMillis ||
+			o.TimePrecisionMode == common.TimePrecisionModeMicros {
+			return timeToAvroLogicalTypeData(
+				col.Type, col.Value.(string), col.Flag.IsNullable(), o.TimePrecisionMode)
+		}
 		return col.Value.(string), "string", nil
 	case mysql.TypeYear:
 		if v, ok := col.Value.(string); ok {
@@ -1005,7 +1058,7 @@ func NewBatchEncoderBuilder(ctx context.Context,
 	config *common.Config,
 ) (codec.RowEventEncoderBuilder, error) {
 	keySchemaManager, valueSchemaManager, err := NewKeyAndValueSchemaManagers(
-		ctx, config.AvroSchemaRegistry, nil)
+		ctx, config.AvroSchemaRegistry, config.SchemaRegistryCredential)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1029,8 +1082,13 @@ func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
 			EnableTiDBExtension:        b.config.EnableTiDBExtension,
 			EnableRowChecksum:          b.config.EnableRowChecksum,
 			EnableWatermarkEvent:       b.config.AvroEnableWatermark,
+			EnableSchemaChangeEvent:    b.config.AvroEnableSchemaChangeEvent,
 			DecimalHandlingMode:        b.config.AvroDecimalHandlingMode,
 			BigintUnsignedHandlingMode: b.config.AvroBigintUnsignedHandlingMode,
+			TimePrecisionMode:          b.config.AvroTimePrecisionMode,
+			EnumHandlingMode:           b.config.AvroEnumHandlingMode,
+			SetHandlingMode:            b.config.AvroSetHandlingMode,
+			NullableUnionOrder:         b.config.AvroNullableUnionOrder,
 		},
 	}
 	return encoder
@@ -557,12 +557,15 @@ var avroTestColumns = []*avroTestColumnTuple{
 }
 
 func TestColumnToAvroSchema(t *testing.T) {
+	precise := &Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"}
+	decimalString := &Options{DecimalHandlingMode: "string", BigintUnsignedHandlingMode: "long"}
+	bigintString := &Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "string"}
 	for _, v := range avroTestColumns {
-		schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "precise", "long")
+		schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "", precise)
 		require.NoError(t, err)
 		require.Equal(t, v.expectedSchema, schema)
 		if v.col.Name == "decimal" {
-			schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "string", "long")
+			schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "", decimalString)
 			require.NoError(t, err)
 			require.Equal(
 				t,
@@ -571,7 +574,7 @@ func TestColumnToAvroSchema(t *testing.T) {
 			)
 		}
 		if v.col.Name == "longlongunsigned" {
-			schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "precise", "string")
+			schema, err := columnToAvroSchema(&v.col, v.colInfo.Ft, "", bigintString)
 			require.NoError(t, err)
 			require.Equal(
 				t,
@@ -588,19 +591,22 @@ func TestColumnToAvroSchema(t *testing.T) {
 func TestColumnToAvroData(t *testing.T) {
 	t.Parallel()
 
+	precise := &Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"}
+	decimalString := &Options{DecimalHandlingMode: "string", BigintUnsignedHandlingMode: "long"}
+	bigintString := &Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "string"}
 	for _, v := range avroTestColumns {
-		data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "precise", "long")
+		data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "", precise)
 		require.NoError(t, err)
 		require.Equal(t, v.expectedData, data)
 		require.Equal(t, v.expectedType, str)
 		if v.col.Name == "decimal" {
-			data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "string", "long")
+			data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "", decimalString)
 			require.NoError(t, err)
 			require.Equal(t, "129012.1230000", data)
 			require.Equal(t, "string", str)
 		}
 		if v.col.Name == "longlongunsigned" {
-			data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "precise", "string")
+			data, str, err := columnToAvroData(&v.col, v.colInfo.Ft, "", bigintString)
 			require.NoError(t, err)
 			require.Equal(t, "1", data)
 			require.Equal(t, "string", str)
@@ -657,8 +663,7 @@ func TestRowToAvroSchemaEnableChecksum(t *testing.T) {
 		input,
 		true,
 		true,
-		"string",
-		"string",
+		&Options{DecimalHandlingMode: "string", BigintUnsignedHandlingMode: "string"},
 	)
 	require.NoError(t, err)
 	require.Equal(t, expectedSchemaWithExtensionEnableChecksum, indentJSON(schema))
@@ -699,8 +704,7 @@ func TestRowToAvroSchema(t *testing.T) {
 		},
 		false,
 		false,
-		"precise",
-		"long",
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"},
 	)
 	require.NoError(t, err)
 	require.Equal(t, expectedSchemaWithoutExtension, indentJSON(schema))
@@ -716,8 +720,7 @@ func TestRowToAvroSchema(t *testing.T) {
 		},
 		true,
 		false,
-		"precise",
-		"long",
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"},
 	)
 	require.NoError(t, err)
 	require.Equal(t, expectedSchemaWithExtension, indentJSON(schema))
@@ -742,7 +745,9 @@ func TestRowToAvroData(t *testing.T) {
 		colInfos = append(colInfos, v.colInfo)
 	}
 
-	data, err := rowToAvroData(&avroEncodeInput{cols, colInfos}, 417318403368288260, "c", false, "precise", "long")
+	data, err := rowToAvroData(
+		"ns", "t", &avroEncodeInput{cols, colInfos}, 417318403368288260, "c", false,
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"})
 	require.NoError(t, err)
 	_, exists := data["_tidb_commit_ts"]
 	require.False(t, exists)
@@ -751,7 +756,9 @@ func TestRowToAvroData(t *testing.T) {
 	_, exists = data["_tidb_commit_physical_time"]
 	require.False(t, exists)
 
-	data, err = rowToAvroData(&avroEncodeInput{cols, colInfos}, 417318403368288260, "c", true, "precise", "long")
+	data, err = rowToAvroData(
+		"ns", "t", &avroEncodeInput{cols, colInfos}, 417318403368288260, "c", true,
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"})
 	require.NoError(t, err)
 	v, exists := data["_tidb_commit_ts"]
 	require.True(t, exists)
@@ -838,8 +845,7 @@ func TestAvroEncode4EnableChecksum(t *testing.T) {
 		},
 		true,
 		true,
-		"string",
-		"string",
+		&Options{DecimalHandlingMode: "string", BigintUnsignedHandlingMode: "string"},
 	)
 	require.NoError(t, err)
 	avroValueCodec, err := goavro.NewCodec(valueSchema)
@@ -940,8 +946,7 @@ func TestAvroEncode(t *testing.T) {
 		},
 		false,
 		false,
-		"precise",
-		"long",
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"},
 	)
 	require.NoError(t, err)
 	avroKeyCodec, err := goavro.NewCodec(keySchema)
@@ -966,8 +971,7 @@ func TestAvroEncode(t *testing.T) {
 		},
 		true,
 		false,
-		"precise",
-		"long",
+		&Options{DecimalHandlingMode: "precise", BigintUnsignedHandlingMode: "long"},
 	)
 	require.NoError(t, err)
 	avroValueCodec, err := goavro.NewCodec(valueSchema)
@@ -41,6 +41,9 @@ type decoder struct {
 
 	keySchemaM   *SchemaManager
 	valueSchemaM *SchemaManager
+	// schemaChangeIDs caches whether the schema of the id is the schema of
+	// the schema change event.
+	schemaChangeIDs map[int]bool
 
 	key   []byte
 	value []byte
@@ -55,11 +58,12 @@ func NewDecoder(
 	tz *time.Location,
 ) codec.RowEventDecoder {
 	return &decoder{
-		Options:      o,
-		topic:        topic,
-		keySchemaM:   keySchemaM,
-		valueSchemaM: valueSchemaM,
-		sc:           &stmtctx.StatementContext{TimeZone: tz},
+		Options:         o,
+		topic:           topic,
+		keySchemaM:      keySchemaM,
+		valueSchemaM:    valueSchemaM,
+		schemaChangeIDs: make(map[int]bool),
+		sc:              &stmtctx.StatementContext{TimeZone: tz},
 	}
 }
 
@@ -86,6 +90,13 @@ func (d *decoder) HasNext() (model.MessageType, bool, error) {
 	}
 	switch d.value[0] {
 	case magicByte:
+		isSchemaChange, err := d.isSchemaChangeEvent(context.Background())
+		if err != nil {
+			return model.MessageTypeUnknown, false, err
+		}
+		if isSchemaChange {
+			return model.MessageTypeDDL, true, nil
+		}
 		return model.MessageTypeRow, true, nil
 	case ddlByte:
 		return model.MessageTypeDDL, true, nil
@@ -142,21 +153,22 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 			break
 		}
 
-		var holder map[string]interface{}
+		var avroType map[string]interface{}
 		switch ty := field["type"].(type) {
 		case []interface{}:
 			if m, ok := ty[0].(map[string]interface{}); ok {
-				holder = m["connect.parameters"].(map[string]interface{})
+				avroType = m
 			} else if m, ok := ty[1].(map[string]interface{}); ok {
-				holder = m["connect.parameters"].(map[string]interface{})
+				avroType = m
 			} else {
 				log.Panic("type info is anything else", zap.Any("typeInfo", field["type"]))
 			}
 		case map[string]interface{}:
-			holder = ty["connect.parameters"].(map[string]interface{})
+			avroType = ty
 		default:
 			log.Panic("type info is anything else", zap.Any("typeInfo", field["type"]))
 		}
+		holder := avroType["connect.parameters"].(map[string]interface{})
 		tidbType := holder["tidb_type"].(string)
 
 		mysqlType, flag := mysqlAndFlagTypeFromTiDBType(tidbType)
@@ -180,11 +192,18 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 
 		switch mysqlType {
 		case mysql.TypeEnum:
-			// enum type is encoded as string,
+			// enum type is encoded as string or avro enum,
 			// we need to convert it to int by the order of the enum values definition.
 			allowed := strings.Split(holder["allowed"].(string), ",")
 			switch t := value.(type) {
 			case string:
+				if avroType["type"] == "enum" {
+					value, err = enumValueFromAvroSymbol(allowed, t)
+					if err != nil {
+						return nil, errors.Trace(err)
+					}
+					break
+				}
 				enum, err := types.ParseEnum(allowed, t, "")
 				if err != nil {
 					return nil, errors.Trace(err)
@@ -194,7 +213,7 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 				value = nil
 			}
 		case mysql.TypeSet:
-			// set type is encoded as string,
+			// set type is encoded as string or array of strings,
 			// we need to convert it to the binary format.
 			elems := strings.Split(holder["allowed"].(string), ",")
 			switch t := value.(type) {
@@ -204,9 +223,22 @@ func (d *decoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 					return nil, errors.Trace(err)
 				}
 				value = s.Value
+			case []interface{}:
+				names := make([]string, 0, len(t))
+				for _, name := range t {
+					names = append(names, name.(string))
+				}
+				s, err := types.ParseSet(elems, strings.Join(names, ","), "")
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				value = s.Value
 			case nil:
 				value = nil
 			}
+		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+			// date and time types may be encoded as avro logical types.
+			value = avroLogicalTypeToString(mysqlType, value)
 		}
 
 		col := &model.Column{
@@ -292,6 +324,9 @@ func (d *decoder) NextDDLEvent() (*model.DDLEvent, error) {
 	if len(d.value) == 0 {
 		return nil, errors.New("value should not be empty")
 	}
+	if d.value[0] == magicByte {
+		return d.decodeSchemaChangeEvent(context.Background())
+	}
 	if d.value[0] != ddlByte {
 		return nil, fmt.Errorf("first byte is not the ddl byte, but got: %+v", d.value[0])
 	}
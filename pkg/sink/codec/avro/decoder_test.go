@@ -192,6 +192,77 @@ func TestDecodeDDLEvent(t *testing.T) {
 	require.False(t, decodedEvent.TableInfo.TableName.IsPartition)
 }
 
+func TestDecodeSchemaChangeEvent(t *testing.T) {
+	o := &Options{
+		EnableSchemaChangeEvent:    true,
+		DecimalHandlingMode:        "precise",
+		BigintUnsignedHandlingMode: "long",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	encoder, err := setupEncoderAndSchemaRegistry(ctx, o)
+	defer teardownEncoderAndSchemaRegistry()
+	require.NoError(t, err)
+
+	idType := types.NewFieldType(mysql.TypeLong)
+	idType.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+	tableInfo := model.WrapTableInfo(1, "test", 1030, &timodel.TableInfo{
+		ID:         100,
+		Name:       timodel.NewCIStr("t1"),
+		PKIsHandle: true,
+		Columns: []*timodel.ColumnInfo{
+			{ID: 1, Name: timodel.NewCIStr("id"), FieldType: *idType, State: timodel.StatePublic},
+			{ID: 2, Name: timodel.NewCIStr("a"), FieldType: *types.NewFieldType(mysql.TypeLong), State: timodel.StatePublic},
+		},
+	})
+	ddl := &model.DDLEvent{
+		StartTs:   1020,
+		CommitTs:  1030,
+		TableInfo: tableInfo,
+		Type:      timodel.ActionAddColumn,
+		Query:     "ALTER TABLE test.t1 ADD COLUMN a int",
+	}
+	message, err := encoder.EncodeDDLEvent(ddl)
+	require.NoError(t, err)
+	require.NotNil(t, message)
+	require.Equal(t, magicByte, message.Value[0])
+
+	expectedFingerprint, ok, err := encoder.tableSchemaFingerprint(ddl)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotZero(t, expectedFingerprint)
+
+	keySchemaM, valueSchemaM, err := NewKeyAndValueSchemaManagers(
+		ctx, "http://127.0.0.1:8081", nil)
+	require.NoError(t, err)
+	tz, err := util.GetLocalTimezone()
+	require.NoError(t, err)
+	d := NewDecoder(o, keySchemaM, valueSchemaM, "test-topic", tz)
+	err = d.AddKeyValue(message.Key, message.Value)
+	require.NoError(t, err)
+
+	messageType, exist, err := d.HasNext()
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, model.MessageTypeDDL, messageType)
+	// the result of the schema lookup is cached by the schema id.
+	schemaChangeIDs := d.(*decoder).schemaChangeIDs
+	require.Len(t, schemaChangeIDs, 1)
+	for _, isSchemaChange := range schemaChangeIDs {
+		require.True(t, isSchemaChange)
+	}
+
+	decodedEvent, err := d.NextDDLEvent()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1030), decodedEvent.CommitTs)
+	require.Equal(t, timodel.ActionAddColumn, decodedEvent.Type)
+	require.Equal(t, ddl.Query, decodedEvent.Query)
+	require.Equal(t, "test", decodedEvent.TableInfo.TableName.Schema)
+	require.Equal(t, "t1", decodedEvent.TableInfo.TableName.Table)
+}
+
 func TestDecodeResolvedEvent(t *testing.T) {
 	t.Parallel()
 
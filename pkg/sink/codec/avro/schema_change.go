@@ -0,0 +1,212 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/entry"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// schemaChangeTopic is used to build the subject of the schema change
+	// event schema, which is `_ticdc_schema_change-value`.
+	schemaChangeTopic = "_ticdc_schema_change"
+	// schemaChangeEventName is the record name of the schema change event.
+	schemaChangeEventName = "SchemaChangeEvent"
+
+	// schemaRegistryTimeout bounds the schema registration of the schema
+	// change event, since EncodeDDLEvent does not carry a context.
+	schemaRegistryTimeout = 30 * time.Second
+)
+
+// schemaChangeEventSchema is the avro schema of the schema change event.
+// `fingerprint` is the CRC-64-AVRO fingerprint of the value schema generated
+// from the table schema after the DDL is executed, it is null if the DDL is
+// not a table level DDL.
+const schemaChangeEventSchema = `{
+	"type": "record",
+	"name": "SchemaChangeEvent",
+	"namespace": "com.pingcap.ticdc",
+	"fields": [
+		{"name": "query", "type": "string"},
+		{"name": "type", "type": "int"},
+		{"name": "schema", "type": "string"},
+		{"name": "table", "type": "string"},
+		{"name": "commitTs", "type": "long"},
+		{"name": "fingerprint", "type": ["null", "long"], "default": null}
+	]
+}`
+
+// encodeSchemaChangeEvent encodes the DDL event into a schema change event in
+// the confluent avro wire format, its schema is registered under a dedicated subject.
+func (a *BatchEncoder) encodeSchemaChangeEvent(e *model.DDLEvent) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), schemaRegistryTimeout)
+	defer cancel()
+
+	avroCodec, schemaID, err := a.valueSchemaManager.GetCachedOrRegister(
+		ctx,
+		schemaChangeTopic,
+		0,
+		func() (string, error) { return schemaChangeEventSchema, nil },
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	native := map[string]interface{}{
+		"query":       e.Query,
+		"type":        int32(e.Type),
+		"schema":      e.TableInfo.TableName.Schema,
+		"table":       e.TableInfo.TableName.Table,
+		"commitTs":    int64(e.CommitTs),
+		"fingerprint": nil,
+	}
+	fingerprint, ok, err := a.tableSchemaFingerprint(e)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if ok {
+		native["fingerprint"] = goavro.Union("long", int64(fingerprint))
+	}
+
+	bin, err := avroCodec.BinaryFromNative(nil, native)
+	if err != nil {
+		log.Error("AvroEventBatchEncoder: converting schema change event to Avro binary failed",
+			zap.Error(err))
+		return nil, cerror.WrapError(cerror.ErrAvroEncodeToBinary, err)
+	}
+	res := &avroEncodeResult{data: bin, schemaID: schemaID}
+	return res.toEnvelope()
+}
+
+// tableSchemaFingerprint returns the fingerprint of the value schema, which
+// would be generated for the row changed events of the table after the DDL.
+func (a *BatchEncoder) tableSchemaFingerprint(e *model.DDLEvent) (uint64, bool, error) {
+	if e.TableInfo.TableInfo == nil || len(e.TableInfo.Columns) == 0 {
+		return 0, false, nil
+	}
+	input := tableInfoToAvroEncodeInput(e.TableInfo)
+	if len(input.columns) == 0 {
+		return 0, false, nil
+	}
+	schema, err := rowToAvroSchema(
+		getAvroNamespace(a.namespace, &e.TableInfo.TableName),
+		e.TableInfo.TableName.Table,
+		input,
+		a.EnableTiDBExtension,
+		a.EnableRowChecksum,
+		a.Options,
+	)
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	avroCodec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return 0, false, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	return avroCodec.Rabin, true, nil
+}
+
+// tableInfoToAvroEncodeInput builds the columns in the same way as the
+// mounter does, values are left empty since only the schema is needed.
+func tableInfoToAvroEncodeInput(tableInfo *model.TableInfo) *avroEncodeInput {
+	_, _, colInfos := tableInfo.GetRowColInfos()
+	input := &avroEncodeInput{
+		columns:  make([]*model.Column, 0, len(tableInfo.Columns)),
+		colInfos: colInfos[:0:0],
+	}
+	for i, col := range tableInfo.Columns {
+		if !model.IsColCDCVisible(col) {
+			continue
+		}
+		input.columns = append(input.columns, &model.Column{
+			Name:    col.Name.O,
+			Type:    col.GetType(),
+			Charset: col.GetCharset(),
+			Default: entry.GetDDLDefaultDefinition(col),
+			Flag:    tableInfo.ColumnsFlag[col.ID],
+		})
+		input.colInfos = append(input.colInfos, colInfos[i])
+	}
+	return input
+}
+
+// isSchemaChangeEvent checks whether the value is a schema change event, by
+// looking up the record name of the schema it carries. The result is cached
+// by the schema id, so the registry is requested only once for each schema.
+func (d *decoder) isSchemaChangeEvent(ctx context.Context) (bool, error) {
+	schemaID, _, err := extractSchemaIDAndBinaryData(d.value)
+	if err != nil {
+		return false, err
+	}
+	if isSchemaChange, ok := d.schemaChangeIDs[schemaID]; ok {
+		return isSchemaChange, nil
+	}
+
+	avroCodec, err := d.valueSchemaM.Lookup(ctx, schemaChangeTopic, schemaID)
+	if err != nil {
+		return false, err
+	}
+	var schema struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(avroCodec.Schema()), &schema); err != nil {
+		return false, cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	isSchemaChange := schema.Name == schemaChangeEventName
+	d.schemaChangeIDs[schemaID] = isSchemaChange
+	return isSchemaChange, nil
+}
+
+// decodeSchemaChangeEvent decodes the schema change event into a DDL event.
+func (d *decoder) decodeSchemaChangeEvent(ctx context.Context) (*model.DDLEvent, error) {
+	schemaID, data, err := extractSchemaIDAndBinaryData(d.value)
+	if err != nil {
+		return nil, err
+	}
+	avroCodec, err := d.valueSchemaM.Lookup(ctx, schemaChangeTopic, schemaID)
+	if err != nil {
+		return nil, err
+	}
+	native, _, err := avroCodec.NativeFromBinary(data)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrDecodeFailed, err)
+	}
+	event, ok := native.(map[string]interface{})
+	if !ok {
+		return nil, cerror.ErrDecodeFailed.GenWithStack("raw avro message is not a map")
+	}
+	d.value = nil
+
+	result := new(model.DDLEvent)
+	result.TableInfo = new(model.TableInfo)
+	result.CommitTs = uint64(event["commitTs"].(int64))
+	result.TableInfo.TableName = model.TableName{
+		Schema: event["schema"].(string),
+		Table:  event["table"].(string),
+	}
+	result.Query = event["query"].(string)
+	result.Type = timodel.ActionType(event["type"].(int32))
+	return result, nil
+}
@@ -39,11 +39,9 @@ import (
 // look up local cache according to the table's name, and fetch from the Registry
 // in cache the local cache entry is missing.
 type SchemaManager struct {
-	registryURL   string
+	*RegistryClient
 	subjectSuffix string
 
-	credential *security.Credential // placeholder, currently always nil
-
 	cacheRWLock sync.RWMutex
 	cache       map[string]*schemaCacheEntry
 }
@@ -62,8 +60,8 @@ type schemaCacheEntry struct {
 
 type registerRequest struct {
 	Schema string `json:"schema"`
-	// Commented out for compatibility with Confluent 5.4.x
-	// SchemaType string `json:"schemaType"`
+	// SchemaType is omitted for avro schemas for compatibility with Confluent 5.4.x
+	SchemaType string `json:"schemaType,omitempty"`
 }
 
 type registerResponse struct {
@@ -102,6 +100,47 @@ func newAvroSchemaManager(
 	subjectSuffix string,
 	credential *security.Credential,
 ) (*SchemaManager, error) {
+	client, err := NewRegistryClient(ctx, registryURL, credential)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaManager{
+		RegistryClient: client,
+		cache:          make(map[string]*schemaCacheEntry, 1),
+		subjectSuffix:  subjectSuffix,
+	}, nil
+}
+
+// Register a schema in schema registry, no cache
+func (m *SchemaManager) Register(
+	ctx context.Context,
+	topicName string,
+	schema string,
+) (int, error) {
+	// The Schema Registry expects the JSON to be without newline characters
+	buffer := new(bytes.Buffer)
+	err := json.Compact(buffer, []byte(schema))
+	if err != nil {
+		log.Error("Could not compact schema", zap.Error(err))
+		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
+	}
+	return m.RegisterSchema(ctx, m.topicNameToSchemaSubject(topicName), buffer.String(), "")
+}
+
+// RegistryClient sends requests to the Confluent Schema Registry, it is shared
+// by the protocols which register their schemas in the registry.
+type RegistryClient struct {
+	registryURL string
+	credential  *security.Credential
+}
+
+// NewRegistryClient creates a RegistryClient and tests the connectivity
+// to the schema registry.
+func NewRegistryClient(
+	ctx context.Context,
+	registryURL string,
+	credential *security.Credential,
+) (*RegistryClient, error) {
 	registryURL = strings.TrimRight(registryURL, "/")
 	httpCli, err := httputil.NewClient(credential)
 	if err != nil {
@@ -131,37 +170,30 @@ func newAvroSchemaManager(
 		zap.String("registryURL", registryURL),
 	)
 
-	return &SchemaManager{
-		registryURL:   registryURL,
-		cache:         make(map[string]*schemaCacheEntry, 1),
-		subjectSuffix: subjectSuffix,
+	return &RegistryClient{
+		registryURL: registryURL,
+		credential:  credential,
 	}, nil
 }
 
-// Register a schema in schema registry, no cache
-func (m *SchemaManager) Register(
+// RegisterSchema registers the schema under the subject and returns the schema
+// ID, schemaType is empty for avro schemas, e.g. "PROTOBUF" for others.
+func (c *RegistryClient) RegisterSchema(
 	ctx context.Context,
-	topicName string,
+	subject string,
 	schema string,
+	schemaType string,
 ) (int, error) {
-	// The Schema Registry expects the JSON to be without newline characters
-	buffer := new(bytes.Buffer)
-	err := json.Compact(buffer, []byte(schema))
-	if err != nil {
-		log.Error("Could not compact schema", zap.Error(err))
-		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
-	}
 	reqBody := registerRequest{
-		Schema: buffer.String(),
+		Schema:     schema,
+		SchemaType: schemaType,
 	}
 	payload, err := json.Marshal(&reqBody)
 	if err != nil {
 		log.Error("Could not marshal request to the Registry", zap.Error(err))
 		return 0, cerror.WrapError(cerror.ErrAvroSchemaAPIError, err)
 	}
-	uri := m.registryURL + "/subjects/" + url.QueryEscape(
-		m.topicNameToSchemaSubject(topicName),
-	) + "/versions"
+	uri := c.registryURL + "/subjects/" + url.QueryEscape(subject) + "/versions"
 	log.Info("Registering schema", zap.String("uri", uri), zap.ByteString("payload", payload))
 
 	req, err := http.NewRequestWithContext(ctx, "POST", uri, bytes.NewReader(payload))
@@ -175,7 +207,7 @@ func (m *SchemaManager) Register(
 			"application/json",
 	)
 	req.Header.Add("Content-Type", "application/vnd.schemaregistry.v1+json")
-	resp, err := httpRetry(ctx, m.credential, req)
+	resp, err := httpRetry(ctx, c.credential, req)
 	if err != nil {
 		return 0, err
 	}
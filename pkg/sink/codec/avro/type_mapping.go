@@ -0,0 +1,245 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+)
+
+const (
+	// the date and time values are interpreted as UTC wall-clock times when
+	// they are encoded as avro logical types, so that they can be restored
+	// to the same strings by the consumer.
+	avroDateLayout     = "2006-01-02"
+	avroDatetimeLayout = "2006-01-02 15:04:05.999999"
+)
+
+// setFieldTypeAndDefault sets the type and the default value of the field,
+// the type of a nullable column is a union of "null" and the column type,
+// ordered as the nullable union order option requires.
+func setFieldTypeAndDefault(
+	field map[string]interface{},
+	avroType interface{},
+	defaultValue interface{},
+	nullable bool,
+	nullableUnionOrder string,
+) {
+	if !nullable {
+		field["type"] = avroType
+		if defaultValue != nil {
+			field["default"] = defaultValue
+		}
+		return
+	}
+
+	switch nullableUnionOrder {
+	case common.NullableUnionOrderNullFirst:
+		field["type"] = []interface{}{"null", avroType}
+		field["default"] = nil
+	case common.NullableUnionOrderNullLast:
+		field["type"] = []interface{}{avroType, "null"}
+		if defaultValue != nil {
+			field["default"] = defaultValue
+		}
+	default:
+		// the default value must match the first type of the union.
+		// https://stackoverflow.com/questions/22938124/avro-field-default-values
+		if defaultValue == nil {
+			field["type"] = []interface{}{"null", avroType}
+		} else {
+			field["type"] = []interface{}{avroType, "null"}
+		}
+		field["default"] = defaultValue
+	}
+}
+
+// newAvroEnumSchema builds the avro enum schema of an enum column.
+func newAvroEnumSchema(
+	schema avroSchema,
+	namespace string,
+	name string,
+	symbols []string,
+) avroEnumSchema {
+	schema.Type = "enum"
+	return avroEnumSchema{
+		avroSchema: schema,
+		Name:       sanitizeName(name),
+		Namespace:  namespace,
+		Symbols:    symbols,
+	}
+}
+
+// avroEnumSymbols converts the enum elements to avro enum symbols in the
+// definition order, false is returned if the symbols are not distinct, since
+// the conversion is lossy, e.g. both 'a-b' and 'a_b' are converted to a_b.
+func avroEnumSymbols(elems []string) ([]string, bool) {
+	symbols := make([]string, 0, len(elems))
+	seen := make(map[string]struct{}, len(elems))
+	for _, elem := range elems {
+		symbol := avroEnumSymbol(elem)
+		if _, ok := seen[symbol]; ok {
+			return nil, false
+		}
+		seen[symbol] = struct{}{}
+		symbols = append(symbols, symbol)
+	}
+	return symbols, true
+}
+
+// avroEnumSymbol converts the enum element to an avro enum symbol, which must
+// match [A-Za-z_][A-Za-z0-9_]*.
+func avroEnumSymbol(elem string) string {
+	var sb strings.Builder
+	for i, c := range elem {
+		if i == 0 && (c >= '0' && c <= '9') {
+			sb.WriteString(numberPrefix)
+		}
+		if c == '_' ||
+			('a' <= c && c <= 'z') ||
+			('A' <= c && c <= 'Z') ||
+			('0' <= c && c <= '9') {
+			sb.WriteRune(c)
+		} else {
+			sb.WriteString(replacementChar)
+		}
+	}
+	if sb.Len() == 0 {
+		return replacementChar
+	}
+	return sb.String()
+}
+
+// enumValueFromAvroSymbol returns the value of the enum element, whose avro
+// enum symbol is the given one.
+func enumValueFromAvroSymbol(elems []string, symbol string) (uint64, error) {
+	for i, elem := range elems {
+		if avroEnumSymbol(elem) == symbol {
+			return uint64(i + 1), nil
+		}
+	}
+	return 0, cerror.ErrDecodeFailed.GenWithStack(
+		"unknown avro enum symbol %s", symbol)
+}
+
+// setToAvroArray splits the set value into the array of its elements.
+func setToAvroArray(value string) []interface{} {
+	if value == "" {
+		return []interface{}{}
+	}
+	elems := strings.Split(value, ",")
+	result := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		result = append(result, elem)
+	}
+	return result
+}
+
+// timeToAvroLogicalTypeSchema returns the avro logical type schema of the
+// date and time types in the given precision.
+func timeToAvroLogicalTypeSchema(tp byte, tt string, precision string) avroLogicalTypeSchema {
+	schema := avroLogicalTypeSchema{
+		avroSchema: avroSchema{
+			Parameters: map[string]string{tidbType: tt},
+		},
+	}
+	switch tp {
+	case mysql.TypeDate:
+		schema.Type, schema.LogicalType = "int", "date"
+	case mysql.TypeDuration:
+		if precision == common.TimePrecisionModeMillis {
+			schema.Type, schema.LogicalType = "int", "time-millis"
+		} else {
+			schema.Type, schema.LogicalType = "long", "time-micros"
+		}
+	default:
+		if precision == common.TimePrecisionModeMillis {
+			schema.Type, schema.LogicalType = "long", "timestamp-millis"
+		} else {
+			schema.Type, schema.LogicalType = "long", "timestamp-micros"
+		}
+	}
+	return schema
+}
+
+// timeToAvroLogicalTypeData converts the date and time value to the native
+// data of the avro logical type. Zero dates such as '0000-00-00', which are
+// allowed by a permissive sql mode, cannot be represented by the logical
+// types, they are encoded as null if the column is nullable, otherwise as
+// the unix epoch.
+func timeToAvroLogicalTypeData(
+	tp byte, value string, nullable bool, precision string,
+) (interface{}, string, error) {
+	switch tp {
+	case mysql.TypeDate:
+		t, err := time.ParseInLocation(avroDateLayout, value, time.UTC)
+		if err != nil {
+			return zeroDateToAvroData(nullable, "int.date")
+		}
+		return t, "int.date", nil
+	case mysql.TypeDuration:
+		d, isNull, err := types.ParseDuration(new(stmtctx.StatementContext), value, types.MaxFsp)
+		if err != nil {
+			return nil, "", cerror.WrapError(cerror.ErrAvroEncodeFailed, err)
+		}
+		if isNull {
+			return nil, "", cerror.ErrAvroEncodeFailed.GenWithStack(
+				"invalid time value %s", value)
+		}
+		if precision == common.TimePrecisionModeMillis {
+			return d.Duration, "int.time-millis", nil
+		}
+		return d.Duration, "long.time-micros", nil
+	default:
+		typeName := "long.timestamp-micros"
+		if precision == common.TimePrecisionModeMillis {
+			typeName = "long.timestamp-millis"
+		}
+		t, err := time.ParseInLocation(avroDatetimeLayout, value, time.UTC)
+		if err != nil {
+			return zeroDateToAvroData(nullable, typeName)
+		}
+		return t, typeName, nil
+	}
+}
+
+// zeroDateToAvroData returns the data which the zero date is encoded as.
+func zeroDateToAvroData(nullable bool, typeName string) (interface{}, string, error) {
+	if nullable {
+		return nil, "null", nil
+	}
+	return time.Unix(0, 0).UTC(), typeName, nil
+}
+
+// avroLogicalTypeToString converts the decoded date and time value back to
+// the string representation, other values are returned as is.
+func avroLogicalTypeToString(tp byte, value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		if tp == mysql.TypeDate {
+			return v.UTC().Format(avroDateLayout)
+		}
+		return v.UTC().Format(avroDatetimeLayout)
+	case time.Duration:
+		return types.Duration{Duration: v, Fsp: types.MaxFsp}.String()
+	default:
+		return value
+	}
+}
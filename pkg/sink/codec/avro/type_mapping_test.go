@@ -0,0 +1,167 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvroTypeMapping(t *testing.T) {
+	t.Parallel()
+
+	enumFt := types.NewFieldType(mysql.TypeEnum)
+	enumFt.SetElems([]string{"a b", "1c"})
+	setFt := types.NewFieldType(mysql.TypeSet)
+	setFt.SetElems([]string{"x", "y", "z"})
+
+	cols := []*model.Column{
+		{Name: "e", Type: mysql.TypeEnum, Value: uint64(2)},
+		{Name: "s", Type: mysql.TypeSet, Value: uint64(5)},
+		{Name: "d", Type: mysql.TypeDate, Value: "2023-05-06"},
+		{Name: "dt", Type: mysql.TypeDatetime, Value: "2023-05-06 12:34:56.123456"},
+		{Name: "tm", Type: mysql.TypeDuration, Value: "-12:34:56.789000"},
+		{Name: "n", Type: mysql.TypeEnum, Flag: model.NullableFlag, Value: nil},
+	}
+	colInfos := []rowcodec.ColInfo{
+		{ID: 1, Ft: enumFt},
+		{ID: 2, Ft: setFt},
+		{ID: 3, Ft: types.NewFieldType(mysql.TypeDate)},
+		{ID: 4, Ft: types.NewFieldType(mysql.TypeDatetime)},
+		{ID: 5, Ft: types.NewFieldType(mysql.TypeDuration)},
+		{ID: 6, Ft: enumFt},
+	}
+	input := &avroEncodeInput{columns: cols, colInfos: colInfos}
+	o := &Options{
+		DecimalHandlingMode:        common.DecimalHandlingModePrecise,
+		BigintUnsignedHandlingMode: common.BigintUnsignedHandlingModeLong,
+		TimePrecisionMode:          common.TimePrecisionModeMicros,
+		EnumHandlingMode:           common.EnumHandlingModeEnum,
+		SetHandlingMode:            common.SetHandlingModeArray,
+		NullableUnionOrder:         common.NullableUnionOrderNullLast,
+	}
+
+	schema, err := rowToAvroSchema("default.test", "t", input, false, false, o)
+	require.NoError(t, err)
+	var top struct {
+		Fields []struct {
+			Name string          `json:"name"`
+			Type json.RawMessage `json:"type"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(schema), &top))
+	require.JSONEq(t, `{
+		"type": "enum",
+		"name": "e",
+		"namespace": "default.test.t",
+		"symbols": ["a_b", "_1c"],
+		"connect.parameters": {"tidb_type": "ENUM", "allowed": "a b,1c"}
+	}`, string(top.Fields[0].Type))
+	require.JSONEq(t, `{
+		"type": "array",
+		"items": "string",
+		"connect.parameters": {"tidb_type": "SET", "allowed": "x,y,z"}
+	}`, string(top.Fields[1].Type))
+	require.Contains(t, string(top.Fields[3].Type), `"logicalType":"timestamp-micros"`)
+	// "null" is the last type of the nullable union.
+	require.Contains(t, string(top.Fields[5].Type), `"null"]`)
+
+	avroCodec, err := goavro.NewCodec(schema)
+	require.NoError(t, err)
+	native, err := rowToAvroData("default.test", "t", input, 1, insertOperation, false, o)
+	require.NoError(t, err)
+	bin, err := avroCodec.BinaryFromNative(nil, native)
+	require.NoError(t, err)
+	decoded, _, err := avroCodec.NativeFromBinary(bin)
+	require.NoError(t, err)
+
+	row := decoded.(map[string]interface{})
+	require.Equal(t, "_1c", row["e"])
+	value, err := enumValueFromAvroSymbol(enumFt.GetElems(), row["e"].(string))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), value)
+	require.Equal(t, []interface{}{"x", "z"}, row["s"])
+	require.Equal(t, "2023-05-06", avroLogicalTypeToString(mysql.TypeDate, row["d"]))
+	require.Equal(t, "2023-05-06 12:34:56.123456",
+		avroLogicalTypeToString(mysql.TypeDatetime, row["dt"]))
+	require.Equal(t, "-12:34:56.789000",
+		avroLogicalTypeToString(mysql.TypeDuration, row["tm"]))
+	require.Nil(t, row["n"])
+}
+
+func TestSetFieldTypeAndDefault(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		order        string
+		defaultValue interface{}
+		expected     string
+	}{
+		{common.NullableUnionOrderAuto, nil, `{"type":["null","int"],"default":null}`},
+		{common.NullableUnionOrderAuto, 1, `{"type":["int","null"],"default":1}`},
+		{common.NullableUnionOrderNullFirst, 1, `{"type":["null","int"],"default":null}`},
+		{common.NullableUnionOrderNullLast, nil, `{"type":["int","null"]}`},
+		{common.NullableUnionOrderNullLast, 1, `{"type":["int","null"],"default":1}`},
+	}
+	for _, c := range cases {
+		field := make(map[string]interface{})
+		setFieldTypeAndDefault(field, "int", c.defaultValue, true, c.order)
+		data, err := json.Marshal(field)
+		require.NoError(t, err)
+		require.JSONEq(t, c.expected, string(data), c.order)
+	}
+}
+
+func TestAvroEnumSymbolsNotDistinct(t *testing.T) {
+	t.Parallel()
+
+	ft := types.NewFieldType(mysql.TypeEnum)
+	ft.SetElems([]string{"a-b", "a_b"})
+	col := &model.Column{Name: "e", Type: mysql.TypeEnum, Value: uint64(2)}
+	o := &Options{EnumHandlingMode: common.EnumHandlingModeEnum}
+
+	// fallback to the string mapping, since the symbols are not distinct.
+	schema, err := columnToAvroSchema(col, ft, "default.test.t", o)
+	require.NoError(t, err)
+	require.Equal(t, "string", schema.(avroSchema).Type)
+	data, str, err := columnToAvroData(col, ft, "default.test.t", o)
+	require.NoError(t, err)
+	require.Equal(t, "a_b", data)
+	require.Equal(t, "string", str)
+}
+
+func TestZeroDateToAvroLogicalType(t *testing.T) {
+	t.Parallel()
+
+	data, str, err := timeToAvroLogicalTypeData(
+		mysql.TypeDate, "0000-00-00", true, common.TimePrecisionModeMillis)
+	require.NoError(t, err)
+	require.Nil(t, data)
+	require.Equal(t, "null", str)
+
+	data, str, err = timeToAvroLogicalTypeData(
+		mysql.TypeDatetime, "2023-00-00 00:00:00", false, common.TimePrecisionModeMicros)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(0, 0).UTC(), data)
+	require.Equal(t, "long.timestamp-micros", str)
+}
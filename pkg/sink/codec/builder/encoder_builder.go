@@ -24,8 +24,12 @@ import (
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/craft"
 	"github.com/pingcap/tiflow/pkg/sink/codec/csv"
+	"github.com/pingcap/tiflow/pkg/sink/codec/debezium"
 	"github.com/pingcap/tiflow/pkg/sink/codec/maxwell"
 	"github.com/pingcap/tiflow/pkg/sink/codec/open"
+	"github.com/pingcap/tiflow/pkg/sink/codec/plugin"
+	"github.com/pingcap/tiflow/pkg/sink/codec/protobuf"
+	"github.com/pingcap/tiflow/pkg/sink/codec/simple"
 )
 
 // NewRowEventEncoderBuilder returns an RowEventEncoderBuilder
@@ -46,12 +50,49 @@ func NewRowEventEncoderBuilder(
 		return canal.NewJSONRowEventEncoderBuilder(c), nil
 	case config.ProtocolCraft:
 		return craft.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolDebezium:
+		return debezium.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolProtobuf:
+		return protobuf.NewBatchEncoderBuilder(ctx, c)
+	case config.ProtocolSimpleJSON:
+		return simple.NewBatchEncoderBuilder(c), nil
+	case config.ProtocolPlugin:
+		return plugin.NewBatchEncoderBuilder(c)
 
 	default:
 		return nil, cerror.ErrSinkUnknownProtocol.GenWithStackByArgs(c.Protocol)
 	}
 }
 
+// NewOverriddenRowEventEncoderBuilders returns the RowEventEncoderBuilders of the
+// protocols overridden by the dispatch rules, the protocol of the config is
+// skipped. The returned protocols and builders have the same order.
+func NewOverriddenRowEventEncoderBuilders(
+	ctx context.Context,
+	c *common.Config,
+	overridden []config.Protocol,
+) ([]config.Protocol, []codec.RowEventEncoderBuilder, error) {
+	protocols := make([]config.Protocol, 0, len(overridden))
+	builders := make([]codec.RowEventEncoderBuilder, 0, len(overridden))
+	for _, protocol := range overridden {
+		if protocol == c.Protocol {
+			continue
+		}
+		protocolConfig := *c
+		protocolConfig.Protocol = protocol
+		if err := protocolConfig.Validate(); err != nil {
+			return nil, nil, err
+		}
+		b, err := NewRowEventEncoderBuilder(ctx, &protocolConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		protocols = append(protocols, protocol)
+		builders = append(builders, b)
+	}
+	return protocols, builders, nil
+}
+
 // NewTxnEventEncoderBuilder returns an TxnEventEncoderBuilder.
 func NewTxnEventEncoderBuilder(
 	c *common.Config,
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"go.uber.org/zap"
 )
 
@@ -30,6 +31,10 @@ type batchDecoder struct {
 	msg                 canalJSONMessageInterface
 	enableTiDBExtension bool
 	terminator          string
+
+	// requireChecksum fails the row changed event messages which do not
+	// carry the row checksum in the tidb extension.
+	requireChecksum bool
 }
 
 // NewBatchDecoder return a decoder for canal-json
@@ -43,6 +48,18 @@ func NewBatchDecoder(
 	}
 }
 
+// NewBatchDecoderWithChecksum return a decoder for canal-json with the tidb
+// extension, which fails if a row changed event message does not carry the
+// row checksum, so that a message encoded without the checksum is not taken
+// as a verified one.
+func NewBatchDecoderWithChecksum(terminator string) codec.RowEventDecoder {
+	return &batchDecoder{
+		enableTiDBExtension: true,
+		terminator:          terminator,
+		requireChecksum:     true,
+	}
+}
+
 // AddKeyValue implements the RowEventDecoder interface
 func (b *batchDecoder) AddKeyValue(_, value []byte) error {
 	b.data = value
@@ -57,9 +74,10 @@ func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
 	)
 
 	if b.enableTiDBExtension {
+		// Extensions is left nil to find out whether the message carries
+		// the tidb extension after it is unmarshalled.
 		msg = &canalJSONMessageWithTiDBExtension{
 			JSONMessage: &JSONMessage{},
-			Extensions:  &tidbExtension{},
 		}
 	}
 	if len(b.terminator) > 0 {
@@ -85,6 +103,20 @@ func (b *batchDecoder) HasNext() (model.MessageType, bool, error) {
 			zap.Error(err), zap.ByteString("data", encodedData))
 		return model.MessageTypeUnknown, false, err
 	}
+	if withExtensionEvent, ok := msg.(*canalJSONMessageWithTiDBExtension); ok {
+		if withExtensionEvent.Extensions == nil {
+			// the message is encoded without the tidb extension.
+			withExtensionEvent.Extensions = &tidbExtension{}
+		} else if msg.messageType() == model.MessageTypeRow &&
+			withExtensionEvent.Extensions.CommitTs == 0 {
+			// The consumers rely on the commit ts to order the row changed
+			// events, so fail loudly instead of decoding a zero commit ts.
+			log.Error("canal-json row changed event message does not carry the commit ts, "+
+				"include-commit-ts should be enabled", zap.ByteString("data", encodedData))
+			return model.MessageTypeUnknown, false, cerror.ErrCanalDecodeFailed.
+				GenWithStack("commitTs not found in the tidb extension")
+		}
+	}
 	b.msg = msg
 
 	return b.msg.messageType(), true, nil
@@ -97,14 +129,46 @@ func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 		return nil, cerror.ErrCanalDecodeFailed.
 			GenWithStack("not found row changed event message")
 	}
+	if err := b.verifyChecksum(); err != nil {
+		return nil, err
+	}
 	result, err := canalJSONMessage2RowChange(b.msg)
 	if err != nil {
 		return nil, err
 	}
+	if withExtensionEvent, ok := b.msg.(*canalJSONMessageWithTiDBExtension); ok &&
+		withExtensionEvent.Extensions.PhysicalTableID != 0 {
+		result.Table.TableID = withExtensionEvent.Extensions.PhysicalTableID
+	}
 	b.msg = nil
 	return result, nil
 }
 
+// verifyChecksum verifies the row checksum carried by the tidb extension,
+// it is skipped if the message does not carry one, unless the checksum
+// is required.
+func (b *batchDecoder) verifyChecksum() error {
+	withExtensionEvent, ok := b.msg.(*canalJSONMessageWithTiDBExtension)
+	if !ok || withExtensionEvent.Extensions == nil ||
+		withExtensionEvent.Extensions.RowChecksum == nil {
+		if b.requireChecksum {
+			return cerror.ErrCodecRowChecksumMissing.GenWithStackByArgs()
+		}
+		return nil
+	}
+	err := common.VerifyRowChecksum(withExtensionEvent.Extensions.SchemaVersion,
+		b.msg.getData(), b.msg.getOld(), *withExtensionEvent.Extensions.RowChecksum)
+	if err != nil {
+		log.Error("canal-json row checksum mismatch",
+			zap.String("schema", *b.msg.getSchema()),
+			zap.String("table", *b.msg.getTable()),
+			zap.Uint64("commitTs", b.msg.getCommitTs()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 // NextDDLEvent implements the RowEventDecoder interface
 // `HasNext` should be called before this.
 func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
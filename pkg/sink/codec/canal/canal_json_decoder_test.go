@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -83,6 +84,29 @@ func TestNewCanalJSONBatchDecoder4RowMessage(t *testing.T) {
 	}
 }
 
+func TestCanalJSONBatchDecoderWithoutCommitTs(t *testing.T) {
+	t.Parallel()
+
+	encoder := newJSONRowEventEncoder(&common.Config{
+		EnableTiDBExtension: true,
+		Terminator:          config.CRLF,
+		MaxMessageBytes:     config.DefaultMaxMessageBytes,
+		CanalJSONConfig: &config.CanalJSONConfig{
+			IncludeCommitTs: util.AddressOf(false),
+		},
+	})
+	err := encoder.AppendRowChangedEvent(context.Background(), "", testCaseInsert, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	decoder := NewBatchDecoder(true, "")
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, _, err = decoder.HasNext()
+	require.ErrorContains(t, err, "commitTs not found")
+}
+
 func TestNewCanalJSONBatchDecoder4DDLMessage(t *testing.T) {
 	t.Parallel()
 	for _, encodeEnable := range []bool{false, true} {
@@ -133,6 +133,16 @@ func (c *JSONMessage) pkNameSet() map[string]struct{} {
 type tidbExtension struct {
 	CommitTs    uint64 `json:"commitTs,omitempty"`
 	WatermarkTs uint64 `json:"watermarkTs,omitempty"`
+	// the following fields are only set if they are configured to be included.
+	Namespace       string            `json:"namespace,omitempty"`
+	ChangefeedID    string            `json:"changefeedID,omitempty"`
+	PhysicalTableID int64             `json:"physicalTableID,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	// SchemaVersion and RowChecksum are only set if the encoding checksum is enabled,
+	// the checksum is calculated over the schema version and the `data` field.
+	SchemaVersion uint64 `json:"schemaVersion,omitempty"`
+	// RowChecksum is nil if the message does not carry one.
+	RowChecksum *uint32 `json:"rowChecksum,omitempty"`
 }
 
 type canalJSONMessageWithTiDBExtension struct {
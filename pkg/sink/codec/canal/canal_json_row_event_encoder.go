@@ -15,6 +15,7 @@ package canal
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/goccy/go-json"
@@ -34,13 +35,24 @@ func newJSONMessageForDML(
 	enableTiDBExtension bool,
 	e *model.RowChangedEvent,
 	onlyOutputUpdatedColumns bool,
+	extension *extensionConfig,
 ) ([]byte, error) {
 	isDelete := e.IsDelete()
 	mysqlTypeMap := make(map[string]string, len(e.Columns))
 
+	// checksumValues and oldChecksumValues collect the encoded values of
+	// the `data` and `old` fields, which are used to calculate the row
+	// checksum.
+	var checksumValues, oldChecksumValues map[string]interface{}
+	if enableTiDBExtension && extension.enableEncodingChecksum {
+		checksumValues = make(map[string]interface{}, len(e.Columns))
+		oldChecksumValues = make(map[string]interface{}, len(e.PreColumns))
+	}
+
 	filling := func(columns []*model.Column, out *jwriter.Writer,
 		onlyOutputUpdatedColumn bool,
 		newColumnMap map[string]*model.Column,
+		values map[string]interface{},
 	) error {
 		if len(columns) == 0 {
 			out.RawString("null")
@@ -76,6 +88,13 @@ func newJSONMessageForDML(
 				} else {
 					out.String(value)
 				}
+				if values != nil {
+					if col.Value == nil {
+						values[col.Name] = nil
+					} else {
+						values[col.Name] = value
+					}
+				}
 			}
 		}
 		out.RawByte('}')
@@ -200,13 +219,13 @@ func newJSONMessageForDML(
 	if e.IsDelete() {
 		out.RawString(",\"old\":null")
 		out.RawString(",\"data\":")
-		if err := filling(e.PreColumns, out, false, nil); err != nil {
+		if err := filling(e.PreColumns, out, false, nil, checksumValues); err != nil {
 			return nil, err
 		}
 	} else if e.IsInsert() {
 		out.RawString(",\"old\":null")
 		out.RawString(",\"data\":")
-		if err := filling(e.Columns, out, false, nil); err != nil {
+		if err := filling(e.Columns, out, false, nil, checksumValues); err != nil {
 			return nil, err
 		}
 	} else if e.IsUpdate() {
@@ -218,11 +237,12 @@ func newJSONMessageForDML(
 			}
 		}
 		out.RawString(",\"old\":")
-		if err := filling(e.PreColumns, out, onlyOutputUpdatedColumns, newColsMap); err != nil {
+		if err := filling(e.PreColumns, out, onlyOutputUpdatedColumns, newColsMap,
+			oldChecksumValues); err != nil {
 			return nil, err
 		}
 		out.RawString(",\"data\":")
-		if err := filling(e.Columns, out, false, nil); err != nil {
+		if err := filling(e.Columns, out, false, nil, checksumValues); err != nil {
 			return nil, err
 		}
 	} else {
@@ -233,8 +253,50 @@ func newJSONMessageForDML(
 		const prefix string = ",\"_tidb\":"
 		out.RawString(prefix)
 		out.RawByte('{')
-		out.RawString("\"commitTs\":")
-		out.Uint64(e.CommitTs)
+		isFirst := true
+		writeKey := func(key string) {
+			if isFirst {
+				isFirst = false
+			} else {
+				out.RawByte(',')
+			}
+			out.String(key)
+			out.RawByte(':')
+		}
+		if extension.includeCommitTs {
+			writeKey("commitTs")
+			out.Uint64(e.CommitTs)
+		}
+		if extension.changefeedID != nil {
+			writeKey("namespace")
+			out.String(extension.changefeedID.Namespace)
+			writeKey("changefeedID")
+			out.String(extension.changefeedID.ID)
+		}
+		if extension.includePhysicalTableID {
+			writeKey("physicalTableID")
+			out.Int64(e.Table.TableID)
+		}
+		if len(extension.labels) != 0 {
+			writeKey("labels")
+			out.RawByte('{')
+			for i, key := range extension.labelKeys {
+				if i > 0 {
+					out.RawByte(',')
+				}
+				out.String(key)
+				out.RawByte(':')
+				out.String(extension.labels[key])
+			}
+			out.RawByte('}')
+		}
+		if checksumValues != nil {
+			schemaVersion := common.SchemaVersion(e)
+			writeKey("schemaVersion")
+			out.Uint64(schemaVersion)
+			writeKey("rowChecksum")
+			out.Uint32(common.RowChecksum(schemaVersion, checksumValues, oldChecksumValues))
+		}
 		out.RawByte('}')
 	}
 	out.RawByte('}')
@@ -242,6 +304,44 @@ func newJSONMessageForDML(
 	return out.BuildBytes()
 }
 
+// extensionConfig decides which fields are included in the tidb extension
+// of the row changed event messages.
+type extensionConfig struct {
+	enableEncodingChecksum bool
+	includeCommitTs        bool
+	// changefeedID is nil if it is not included.
+	changefeedID           *model.ChangeFeedID
+	includePhysicalTableID bool
+	labels                 map[string]string
+	// labelKeys is sorted to output the labels in a deterministic order.
+	labelKeys []string
+}
+
+func newExtensionConfig(config *common.Config) *extensionConfig {
+	extension := &extensionConfig{
+		enableEncodingChecksum: config.EnableEncodingChecksum,
+		includeCommitTs:        true,
+	}
+	canalJSONConfig := config.CanalJSONConfig
+	if canalJSONConfig == nil {
+		return extension
+	}
+	if canalJSONConfig.IncludeCommitTs != nil {
+		extension.includeCommitTs = *canalJSONConfig.IncludeCommitTs
+	}
+	if canalJSONConfig.IncludeChangefeedID {
+		changefeedID := config.ChangefeedID
+		extension.changefeedID = &changefeedID
+	}
+	extension.includePhysicalTableID = canalJSONConfig.IncludePhysicalTableID
+	extension.labels = canalJSONConfig.Labels
+	for key := range extension.labels {
+		extension.labelKeys = append(extension.labelKeys, key)
+	}
+	sort.Strings(extension.labelKeys)
+	return extension
+}
+
 func eventTypeString(e *model.RowChangedEvent) string {
 	if e.IsDelete() {
 		return "DELETE"
@@ -263,6 +363,8 @@ type JSONRowEventEncoder struct {
 	messages            []*common.Message
 
 	onlyOutputUpdatedColumns bool
+	enableMessageChunking    bool
+	extension                *extensionConfig
 }
 
 // newJSONRowEventEncoder creates a new JSONRowEventEncoder
@@ -273,6 +375,8 @@ func newJSONRowEventEncoder(config *common.Config) codec.RowEventEncoder {
 		onlyOutputUpdatedColumns: config.OnlyOutputUpdatedColumns,
 		messages:                 make([]*common.Message, 0, 1),
 		maxMessageBytes:          config.MaxMessageBytes,
+		enableMessageChunking:    config.EnableMessageChunking,
+		extension:                newExtensionConfig(config),
 	}
 	return encoder
 }
@@ -336,14 +440,14 @@ func (c *JSONRowEventEncoder) AppendRowChangedEvent(
 	callback func(),
 ) error {
 	value, err := newJSONMessageForDML(c.builder,
-		c.enableTiDBExtension, e, c.onlyOutputUpdatedColumns)
+		c.enableTiDBExtension, e, c.onlyOutputUpdatedColumns, c.extension)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	length := len(value) + common.MaxRecordOverhead
 	// for single message that is longer than max-message-bytes, do not send it.
-	if length > c.maxMessageBytes {
+	if length > c.maxMessageBytes && !c.enableMessageChunking {
 		log.Warn("Single message is too large for canal-json",
 			zap.Int("maxMessageBytes", c.maxMessageBytes),
 			zap.Int("length", length),
@@ -363,6 +467,20 @@ func (c *JSONRowEventEncoder) AppendRowChangedEvent(
 	}
 	m.IncRowsCount()
 
+	if length > c.maxMessageBytes {
+		chunks, err := common.SplitIntoChunks(m, c.maxMessageBytes)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		log.Debug("Single message is split into chunks for canal-json",
+			zap.Int("maxMessageBytes", c.maxMessageBytes),
+			zap.Int("length", length),
+			zap.Int("chunks", len(chunks)),
+			zap.Any("table", e.Table))
+		c.messages = append(c.messages, chunks...)
+		return nil
+	}
+
 	c.messages = append(c.messages, m)
 	return nil
 }
@@ -16,12 +16,15 @@ package canal
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/util"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/encoding/charmap"
 )
@@ -54,7 +57,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	require.True(t, ok)
 
 	data, err := newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseInsert, false)
+		encoder.enableTiDBExtension, testCaseInsert, false, encoder.extension)
 	require.Nil(t, err)
 	var msg canalJSONMessageInterface = &JSONMessage{}
 	err = json.Unmarshal(data, msg)
@@ -98,7 +101,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	}
 
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, false)
+		encoder.enableTiDBExtension, testCaseUpdate, false, encoder.extension)
 	require.Nil(t, err)
 	jsonMsg = &JSONMessage{}
 	err = json.Unmarshal(data, jsonMsg)
@@ -108,7 +111,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	require.Equal(t, "UPDATE", jsonMsg.EventType)
 
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseDelete, false)
+		encoder.enableTiDBExtension, testCaseDelete, false, encoder.extension)
 	require.Nil(t, err)
 	jsonMsg = &JSONMessage{}
 	err = json.Unmarshal(data, jsonMsg)
@@ -126,7 +129,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	encoder, ok = e.(*JSONRowEventEncoder)
 	require.True(t, ok)
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, false)
+		encoder.enableTiDBExtension, testCaseUpdate, false, encoder.extension)
 	require.Nil(t, err)
 
 	withExtension := &canalJSONMessageWithTiDBExtension{}
@@ -139,7 +142,7 @@ func TestNewCanalJSONMessage4DML(t *testing.T) {
 	encoder, ok = e.(*JSONRowEventEncoder)
 	require.True(t, ok)
 	data, err = newJSONMessageForDML(encoder.builder,
-		encoder.enableTiDBExtension, testCaseUpdate, true)
+		encoder.enableTiDBExtension, testCaseUpdate, true, encoder.extension)
 	require.Nil(t, err)
 
 	withExtension = &canalJSONMessageWithTiDBExtension{}
@@ -456,3 +459,223 @@ func TestMaxMessageBytes(t *testing.T) {
 	err = encoder.AppendRowChangedEvent(ctx, topic, testEvent, nil)
 	require.NotNil(t, err)
 }
+
+func TestMessageChunking(t *testing.T) {
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{{
+			Name:  "col1",
+			Type:  mysql.TypeVarchar,
+			Value: []byte(strings.Repeat("a", 1024)),
+		}},
+	}
+
+	ctx := context.Background()
+	cfg := common.NewConfig(config.ProtocolCanalJSON).WithMaxMessageBytes(256)
+	cfg.EnableMessageChunking = true
+	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	called := false
+	err := encoder.AppendRowChangedEvent(ctx, "", testEvent, func() { called = true })
+	require.NoError(t, err)
+
+	messages := encoder.Build()
+	require.Greater(t, len(messages), 1)
+	assembler := common.NewChunkAssembler()
+	decoder := NewBatchDecoder(false, "")
+	for i, message := range messages {
+		require.LessOrEqual(t, message.Length(), 256)
+		key, value, ok, err := assembler.Add(message.Key, message.Value)
+		require.NoError(t, err)
+		if i != len(messages)-1 {
+			require.Nil(t, message.Callback)
+			require.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		message.Callback()
+		require.True(t, called)
+
+		err = decoder.AddKeyValue(key, value)
+		require.NoError(t, err)
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		row, err := decoder.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, testEvent.CommitTs, row.CommitTs)
+	}
+}
+
+func TestEncodingChecksum(t *testing.T) {
+	testEvent := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "a", Table: "b"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")},
+			{Name: "col2", Type: mysql.TypeLong, Value: int64(1)},
+			{Name: "col3", Type: mysql.TypeDouble, Value: nil},
+		},
+	}
+
+	ctx := context.Background()
+	cfg := common.NewConfig(config.ProtocolCanalJSON)
+	cfg.EnableTiDBExtension = true
+	cfg.EnableEncodingChecksum = true
+	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	err := encoder.AppendRowChangedEvent(ctx, "", testEvent, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	var msg canalJSONMessageWithTiDBExtension
+	err = json.Unmarshal(messages[0].Value, &msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), msg.Extensions.SchemaVersion)
+	require.NotNil(t, msg.Extensions.RowChecksum)
+
+	decoder := NewBatchDecoder(true, "")
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	_, err = decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+
+	// the corrupted value is detected by the decoder.
+	corrupted := []byte(strings.Replace(string(messages[0].Value), `"col1":"aa"`, `"col1":"ab"`, 1))
+	require.NotEqual(t, messages[0].Value, corrupted)
+	err = decoder.AddKeyValue(messages[0].Key, corrupted)
+	require.NoError(t, err)
+	_, hasNext, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	_, err = decoder.NextRowChangedEvent()
+	require.Error(t, err)
+}
+
+func TestEncodingChecksumCoversOldValues(t *testing.T) {
+	testEvent := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "a", Table: "b"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")},
+			{Name: "col2", Type: mysql.TypeLong, Value: int64(1)},
+		},
+		PreColumns: []*model.Column{
+			{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("bb")},
+			{Name: "col2", Type: mysql.TypeLong, Value: int64(1)},
+		},
+	}
+
+	ctx := context.Background()
+	cfg := common.NewConfig(config.ProtocolCanalJSON)
+	cfg.EnableTiDBExtension = true
+	cfg.EnableEncodingChecksum = true
+	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	err := encoder.AppendRowChangedEvent(ctx, "", testEvent, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	decoder := NewBatchDecoderWithChecksum("")
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	_, err = decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+
+	// the corrupted old value is detected by the decoder.
+	corrupted := []byte(strings.Replace(string(messages[0].Value), `"col1":"bb"`, `"col1":"bc"`, 1))
+	require.NotEqual(t, messages[0].Value, corrupted)
+	err = decoder.AddKeyValue(messages[0].Key, corrupted)
+	require.NoError(t, err)
+	_, hasNext, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	_, err = decoder.NextRowChangedEvent()
+	require.True(t, cerror.ErrCodecRowChecksumMismatch.Equal(err))
+}
+
+func TestDecoderWithChecksumRequiresChecksum(t *testing.T) {
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")},
+		},
+	}
+
+	ctx := context.Background()
+	cfg := common.NewConfig(config.ProtocolCanalJSON)
+	cfg.EnableTiDBExtension = true
+	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	err := encoder.AppendRowChangedEvent(ctx, "", testEvent, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	decoder := NewBatchDecoderWithChecksum("")
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	_, err = decoder.NextRowChangedEvent()
+	require.True(t, cerror.ErrCodecRowChecksumMissing.Equal(err))
+}
+
+func TestCanalJSONExtensionFields(t *testing.T) {
+	testEvent := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b", TableID: 100, IsPartition: true},
+		Columns: []*model.Column{
+			{Name: "col1", Type: mysql.TypeVarchar, Value: []byte("aa")},
+		},
+	}
+
+	ctx := context.Background()
+	cfg := common.NewConfig(config.ProtocolCanalJSON)
+	cfg.EnableTiDBExtension = true
+	cfg.ChangefeedID = model.DefaultChangeFeedID("test")
+	cfg.CanalJSONConfig = &config.CanalJSONConfig{
+		IncludeCommitTs:        util.AddressOf(false),
+		IncludeChangefeedID:    true,
+		IncludePhysicalTableID: true,
+		Labels:                 map[string]string{"region": "us-west", "env": "prod"},
+	}
+	encoder := NewJSONRowEventEncoderBuilder(cfg).Build()
+	err := encoder.AppendRowChangedEvent(ctx, "", testEvent, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	require.Contains(t, string(messages[0].Value),
+		`"_tidb":{"namespace":"default","changefeedID":"test","physicalTableID":100,`+
+			`"labels":{"env":"prod","region":"us-west"}}`)
+
+	decoder := NewBatchDecoder(true, "")
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	row, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Equal(t, int64(100), row.Table.TableID)
+	require.Equal(t, uint64(0), row.CommitTs)
+
+	// only the commit ts is included by default.
+	cfg.CanalJSONConfig = nil
+	encoder = NewJSONRowEventEncoderBuilder(cfg).Build()
+	err = encoder.AppendRowChangedEvent(ctx, "", testEvent, nil)
+	require.NoError(t, err)
+	messages = encoder.Build()
+	require.Len(t, messages, 1)
+	require.Contains(t, string(messages[0].Value), `"_tidb":{"commitTs":1}`)
+}
@@ -35,6 +35,7 @@ type JSONTxnEventEncoder struct {
 	enableTiDBExtension bool
 
 	onlyOutputUpdatedColumns bool
+	extension                *extensionConfig
 	// the symbol separating two lines
 	terminator      []byte
 	maxMessageBytes int
@@ -55,7 +56,7 @@ func (j *JSONTxnEventEncoder) AppendTxnEvent(
 ) error {
 	for _, row := range txn.Rows {
 		value, err := newJSONMessageForDML(j.builder,
-			j.enableTiDBExtension, row, j.onlyOutputUpdatedColumns)
+			j.enableTiDBExtension, row, j.onlyOutputUpdatedColumns, j.extension)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -106,6 +107,7 @@ func newJSONTxnEventEncoder(config *common.Config) codec.TxnEventEncoder {
 		builder:                  newCanalEntryBuilder(),
 		enableTiDBExtension:      config.EnableTiDBExtension,
 		onlyOutputUpdatedColumns: config.OnlyOutputUpdatedColumns,
+		extension:                newExtensionConfig(config),
 		valueBuf:                 &bytes.Buffer{},
 		terminator:               []byte(config.Terminator),
 		maxMessageBytes:          config.MaxMessageBytes,
@@ -0,0 +1,88 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"sort"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// RowChecksum calculates the checksum of a row at encoding time, which covers
+// the schema version and the column values in the format of the protocol,
+// so that the consumer can recalculate it from the decoded message without
+// knowing the upstream column types. The old values of an update are covered
+// too if they are carried by the message.
+// Columns are sorted by names, a nil value stands for the NULL column.
+func RowChecksum(schemaVersion uint64, values, oldValues map[string]interface{}) uint32 {
+	buf := make([]byte, 0, 64)
+	buf = binary.BigEndian.AppendUint64(buf, schemaVersion)
+	buf = appendChecksumValues(buf, values)
+	if len(oldValues) != 0 {
+		// separate the old values, so that a column can not be moved between
+		// the new and old values without changing the checksum.
+		buf = append(buf, 2)
+		buf = appendChecksumValues(buf, oldValues)
+	}
+	return crc32.ChecksumIEEE(buf)
+}
+
+// VerifyRowChecksum recalculates the checksum of the decoded row,
+// and returns an error if it does not match the expected one.
+func VerifyRowChecksum(
+	schemaVersion uint64, values, oldValues map[string]interface{}, expected uint32,
+) error {
+	actual := RowChecksum(schemaVersion, values, oldValues)
+	if actual != expected {
+		return cerror.ErrCodecRowChecksumMismatch.GenWithStackByArgs(expected, actual)
+	}
+	return nil
+}
+
+func appendChecksumValues(buf []byte, values map[string]interface{}) []byte {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		buf = appendLengthPrefixed(buf, name)
+		value := values[name]
+		if value == nil {
+			buf = append(buf, 0)
+			continue
+		}
+		buf = append(buf, 1)
+		buf = appendLengthPrefixed(buf, model.ColumnValueString(value))
+	}
+	return buf
+}
+
+// SchemaVersion returns the version of the table schema which the row
+// changed event is encoded with, it is 0 if the table info is absent.
+func SchemaVersion(e *model.RowChangedEvent) uint64 {
+	if e.TableInfo == nil {
+		return 0
+	}
+	return e.TableInfo.Version
+}
+
+func appendLengthPrefixed(buf []byte, s string) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(s)))
+	return append(buf, s...)
+}
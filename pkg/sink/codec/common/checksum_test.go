@@ -0,0 +1,79 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowChecksum(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]interface{}{
+		"id":   int64(1),
+		"name": "tidb",
+		"blob": []byte{0x1, 0x2},
+		"null": nil,
+	}
+	checksum := RowChecksum(1, values, nil)
+	require.NoError(t, VerifyRowChecksum(1, values, nil, checksum))
+
+	// the checksum covers the schema version.
+	require.Error(t, VerifyRowChecksum(2, values, nil, checksum))
+
+	// the same value in different go types results in the same checksum.
+	decoded := map[string]interface{}{
+		"id":   "1",
+		"name": []byte("tidb"),
+		"blob": string([]byte{0x1, 0x2}),
+		"null": nil,
+	}
+	require.NoError(t, VerifyRowChecksum(1, decoded, nil, checksum))
+
+	// NULL is different from the string "null".
+	decoded["null"] = "null"
+	require.Error(t, VerifyRowChecksum(1, decoded, nil, checksum))
+
+	// the value is corrupted.
+	decoded["null"] = nil
+	decoded["name"] = "tidc"
+	require.Error(t, VerifyRowChecksum(1, decoded, nil, checksum))
+}
+
+func TestRowChecksumWithOldValues(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]interface{}{"id": int64(1), "name": "tidb"}
+	oldValues := map[string]interface{}{"id": int64(1), "name": "mysql"}
+	checksum := RowChecksum(1, values, oldValues)
+	require.NoError(t, VerifyRowChecksum(1, values, oldValues, checksum))
+	require.NotEqual(t, RowChecksum(1, values, nil), checksum)
+
+	// the old values are protected by the checksum.
+	require.Error(t, VerifyRowChecksum(1, values, nil, checksum))
+	corrupted := map[string]interface{}{"id": int64(1), "name": "mysqk"}
+	require.Error(t, VerifyRowChecksum(1, values, corrupted, checksum))
+
+	// a column moved from the new values to the old values is detected.
+	require.Error(t, VerifyRowChecksum(1,
+		map[string]interface{}{"id": int64(1)},
+		map[string]interface{}{"id": int64(1), "name": "mysql", "name2": "tidb"},
+		checksum))
+
+	// empty old values are the same as absent ones.
+	require.Equal(t, RowChecksum(1, values, nil),
+		RowChecksum(1, values, map[string]interface{}{}))
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// A message which is larger than max-message-bytes can be split into chained
+// chunks, the value of each chunk is laid out as follows:
+//
+//	| magic (4 bytes) | chunk id (8 bytes) | seq (4 bytes) | terminal (1 byte) | data |
+//
+// All chunks of a message share the same key and chunk id, seq starts from 0,
+// and the terminal flag is only set in the last chunk. The consumer concatenates
+// the data of the chunks to restore the original value.
+const (
+	chunkMagic      = "\xffTCK"
+	chunkHeaderSize = len(chunkMagic) + 8 + 4 + 1
+)
+
+// chunkIDGenerator generates the chunk id, it is initialized by the current
+// time to avoid conflicts among the restarts of TiCDC.
+var chunkIDGenerator = uint64(time.Now().UnixNano())
+
+// IsChunk checks whether the value is a chunk of a message.
+func IsChunk(value []byte) bool {
+	return len(value) >= chunkHeaderSize && bytes.HasPrefix(value, []byte(chunkMagic))
+}
+
+// SplitIntoChunks splits the message into chunks, each of which is no larger
+// than maxMessageBytes. The callback and the rows count of the message are
+// attached to the last chunk.
+func SplitIntoChunks(m *Message, maxMessageBytes int) ([]*Message, error) {
+	chunkSize := maxMessageBytes - MaxRecordOverhead - len(m.Key) - chunkHeaderSize
+	if chunkSize <= 0 {
+		return nil, cerror.ErrMessageTooLarge.GenWithStackByArgs()
+	}
+
+	id := atomic.AddUint64(&chunkIDGenerator, 1)
+	chunksCount := (len(m.Value) + chunkSize - 1) / chunkSize
+	chunks := make([]*Message, 0, chunksCount)
+	for seq := 0; seq < chunksCount; seq++ {
+		start := seq * chunkSize
+		end := start + chunkSize
+		terminal := byte(0)
+		if end >= len(m.Value) {
+			end = len(m.Value)
+			terminal = 1
+		}
+
+		value := make([]byte, 0, chunkHeaderSize+end-start)
+		value = append(value, chunkMagic...)
+		value = binary.BigEndian.AppendUint64(value, id)
+		value = binary.BigEndian.AppendUint32(value, uint32(seq))
+		value = append(value, terminal)
+		value = append(value, m.Value[start:end]...)
+
+		chunk := NewMsg(m.Protocol, m.Key, nil, m.Ts, m.Type, m.Schema, m.Table)
+		chunk.Value = value
+		chunks = append(chunks, chunk)
+	}
+
+	last := chunks[len(chunks)-1]
+	last.Callback = m.Callback
+	last.SetRowsCount(m.GetRowsCount())
+	return chunks, nil
+}
+
+// ChunkAssembler reassembles the chunks of a message, it expects that the
+// chunks of a message are received in order and are not interleaved with
+// other messages, which is guaranteed since they are sent to the same partition.
+type ChunkAssembler struct {
+	id      uint64
+	nextSeq uint32
+	key     []byte
+	value   []byte
+}
+
+// NewChunkAssembler creates a ChunkAssembler.
+func NewChunkAssembler() *ChunkAssembler {
+	return &ChunkAssembler{}
+}
+
+// Add adds a received message to the assembler. If the message is not a chunk,
+// it is returned directly. Otherwise, the restored message is returned after
+// the terminal chunk is added, and ok is false before that.
+func (a *ChunkAssembler) Add(key, value []byte) ([]byte, []byte, bool, error) {
+	if !IsChunk(value) {
+		if a.value != nil {
+			return nil, nil, false, cerror.ErrDecodeFailed.GenWithStack(
+				"incomplete chunked message, chunk id %d, next seq %d", a.id, a.nextSeq)
+		}
+		return key, value, true, nil
+	}
+
+	header := value[len(chunkMagic):chunkHeaderSize]
+	id := binary.BigEndian.Uint64(header[:8])
+	seq := binary.BigEndian.Uint32(header[8:12])
+	terminal := header[12] == 1
+
+	if seq == 0 {
+		if a.value != nil {
+			return nil, nil, false, cerror.ErrDecodeFailed.GenWithStack(
+				"incomplete chunked message, chunk id %d, next seq %d", a.id, a.nextSeq)
+		}
+		a.id = id
+		a.key = key
+		a.value = make([]byte, 0, len(value)-chunkHeaderSize)
+	} else if a.value == nil || id != a.id || seq != a.nextSeq {
+		return nil, nil, false, cerror.ErrDecodeFailed.GenWithStack(
+			"unexpected chunk, chunk id %d, seq %d, expected chunk id %d, seq %d",
+			id, seq, a.id, a.nextSeq)
+	}
+	a.value = append(a.value, value[chunkHeaderSize:]...)
+	a.nextSeq = seq + 1
+
+	if !terminal {
+		return nil, nil, false, nil
+	}
+	key, value = a.key, a.value
+	a.key, a.value, a.nextSeq = nil, nil, 0
+	return key, value, true, nil
+}
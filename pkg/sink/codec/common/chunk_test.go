@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitIntoChunks(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	value := bytes.Repeat([]byte("0123456789"), 100)
+	called := 0
+	msg := NewMsg(config.ProtocolCanalJSON, key, value, 1, model.MessageTypeRow, nil, nil)
+	msg.Callback = func() { called++ }
+	msg.IncRowsCount()
+
+	maxMessageBytes := 256
+	chunks, err := SplitIntoChunks(msg, maxMessageBytes)
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+	for i, chunk := range chunks {
+		require.True(t, IsChunk(chunk.Value))
+		require.LessOrEqual(t, chunk.Length(), maxMessageBytes)
+		require.Equal(t, key, chunk.Key)
+		if i != len(chunks)-1 {
+			require.Nil(t, chunk.Callback)
+			require.Equal(t, 0, chunk.GetRowsCount())
+		}
+	}
+	last := chunks[len(chunks)-1]
+	require.Equal(t, 1, last.GetRowsCount())
+	last.Callback()
+	require.Equal(t, 1, called)
+
+	assembler := NewChunkAssembler()
+	for i, chunk := range chunks {
+		k, v, ok, err := assembler.Add(chunk.Key, chunk.Value)
+		require.NoError(t, err)
+		if i != len(chunks)-1 {
+			require.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		require.Equal(t, key, k)
+		require.Equal(t, value, v)
+	}
+
+	// a message which is not chunked is returned directly.
+	k, v, ok, err := assembler.Add(key, value)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, key, k)
+	require.Equal(t, value, v)
+
+	// max-message-bytes is too small to carry any data.
+	_, err = SplitIntoChunks(msg, MaxRecordOverhead+len(key)+chunkHeaderSize)
+	require.Error(t, err)
+}
+
+func TestChunkAssemblerUnexpectedChunk(t *testing.T) {
+	t.Parallel()
+
+	value := bytes.Repeat([]byte("a"), 512)
+	msg := NewMsg(config.ProtocolOpen, nil, value, 1, model.MessageTypeRow, nil, nil)
+	chunks, err := SplitIntoChunks(msg, 128)
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 2)
+
+	// a chunk is lost.
+	assembler := NewChunkAssembler()
+	_, _, ok, err := assembler.Add(nil, chunks[0].Value)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, _, _, err = assembler.Add(nil, chunks[2].Value)
+	require.Error(t, err)
+
+	// the first chunk is lost.
+	assembler = NewChunkAssembler()
+	_, _, _, err = assembler.Add(nil, chunks[1].Value)
+	require.Error(t, err)
+
+	// the chunked message is interleaved with another message.
+	assembler = NewChunkAssembler()
+	_, _, _, err = assembler.Add(nil, chunks[0].Value)
+	require.NoError(t, err)
+	_, _, _, err = assembler.Add(nil, []byte("value"))
+	require.Error(t, err)
+}
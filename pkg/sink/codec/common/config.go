@@ -21,8 +21,10 @@ import (
 	"github.com/imdario/mergo"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/util"
 	"go.uber.org/zap"
 )
@@ -38,15 +40,42 @@ type Config struct {
 	MaxMessageBytes int
 	MaxBatchSize    int
 
+	// EnableMessageChunking splits a row changed event message which is larger
+	// than max-message-bytes into chunks, instead of reporting an error.
+	// only for `open-protocol` and `canal-json` at the moment.
+	EnableMessageChunking bool
+
+	// EnableEncodingChecksum embeds a checksum calculated at encoding time in
+	// each row changed event message, which is verified by the decoder.
+	// only for `open-protocol`, `canal-json` and `csv` at the moment, it is
+	// rejected by other protocols.
+	EnableEncodingChecksum bool
+
 	EnableTiDBExtension bool
 	EnableRowChecksum   bool
 
-	// avro only
+	// canal-json only, the fields included in the tidb extension,
+	// only the commit ts is included if it is nil.
+	CanalJSONConfig *config.CanalJSONConfig
+
+	// ChangefeedID is the changefeed which the encoder belongs to.
+	ChangefeedID model.ChangeFeedID
+
+	// SchemaRegistryCredential is the TLS credential of the changefeed sink,
+	// which is used to connect to the schema registry by avro and protobuf.
+	SchemaRegistryCredential *security.Credential
+
+	// avro only, AvroSchemaRegistry is also used by protobuf
 	AvroSchemaRegistry             string
 	AvroDecimalHandlingMode        string
 	AvroBigintUnsignedHandlingMode string
+	AvroTimePrecisionMode          string
+	AvroEnumHandlingMode           string
+	AvroSetHandlingMode            string
+	AvroNullableUnionOrder         string
 
-	AvroEnableWatermark bool
+	AvroEnableWatermark         bool
+	AvroEnableSchemaChangeEvent bool
 
 	// for sinking to cloud storage
 	Delimiter       string
@@ -55,8 +84,17 @@ type Config struct {
 	IncludeCommitTs bool
 	Terminator      string
 
+	Escape               string
+	OutputFieldHeader    bool
+	BinaryEncodingMethod string
+
 	// for open protocol
 	OnlyOutputUpdatedColumns bool
+	OpenProtocolVersion      uint64
+
+	// for plugin protocol
+	EncoderPlugin       string
+	EncoderPluginParams map[string]string
 }
 
 // NewConfig return a Config for codec
@@ -73,9 +111,16 @@ func NewConfig(protocol config.Protocol) *Config {
 		AvroSchemaRegistry:             "",
 		AvroDecimalHandlingMode:        "precise",
 		AvroBigintUnsignedHandlingMode: "long",
+		AvroTimePrecisionMode:          TimePrecisionModeString,
+		AvroEnumHandlingMode:           EnumHandlingModeString,
+		AvroSetHandlingMode:            SetHandlingModeString,
+		AvroNullableUnionOrder:         NullableUnionOrderAuto,
 		AvroEnableWatermark:            false,
 
+		BinaryEncodingMethod: config.BinaryEncodingBase64,
+
 		OnlyOutputUpdatedColumns: false,
+		OpenProtocolVersion:      BatchVersion1,
 	}
 }
 
@@ -83,9 +128,25 @@ const (
 	codecOPTEnableTiDBExtension            = "enable-tidb-extension"
 	codecOPTAvroDecimalHandlingMode        = "avro-decimal-handling-mode"
 	codecOPTAvroBigintUnsignedHandlingMode = "avro-bigint-unsigned-handling-mode"
+	codecOPTAvroTimePrecisionMode          = "avro-time-precision-mode"
+	codecOPTAvroEnumHandlingMode           = "avro-enum-handling-mode"
+	codecOPTAvroSetHandlingMode            = "avro-set-handling-mode"
+	codecOPTAvroNullableUnionOrder         = "avro-nullable-union-order"
 	codecOPTAvroSchemaRegistry             = "schema-registry"
 
 	codecOPTOnlyOutputUpdatedColumns = "only-output-updated-columns"
+	codecOPTOpenProtocolVersion      = "open-protocol-version"
+	codecOPTEnableEncodingChecksum   = "enable-encoding-checksum"
+	codecOPTEncoderPlugin            = "encoder-plugin"
+)
+
+const (
+	// BatchVersion1 represents the version of batch format, it is the
+	// default batch format version of the open protocol.
+	BatchVersion1 uint64 = 1
+	// BatchVersion2 represents the version of batch format, which carries
+	// the full type information of columns, only used by the open protocol.
+	BatchVersion2 uint64 = 2
 )
 
 const (
@@ -97,6 +158,35 @@ const (
 	BigintUnsignedHandlingModeString = "string"
 	// BigintUnsignedHandlingModeLong is the long mode for unsigned bigint handling
 	BigintUnsignedHandlingModeLong = "long"
+
+	// TimePrecisionModeString encodes the date and time types as strings
+	TimePrecisionModeString = "string"
+	// TimePrecisionModeMillis encodes the date and time types as the avro
+	// logical types in millisecond precision
+	TimePrecisionModeMillis = "millis"
+	// TimePrecisionModeMicros encodes the date and time types as the avro
+	// logical types in microsecond precision
+	TimePrecisionModeMicros = "micros"
+
+	// EnumHandlingModeString encodes the enum type as string
+	EnumHandlingModeString = "string"
+	// EnumHandlingModeEnum encodes the enum type as avro enum
+	EnumHandlingModeEnum = "enum"
+
+	// SetHandlingModeString encodes the set type as a comma separated string
+	SetHandlingModeString = "string"
+	// SetHandlingModeArray encodes the set type as an array of strings
+	SetHandlingModeArray = "array"
+
+	// NullableUnionOrderAuto puts "null" first in the union of a nullable
+	// column, unless the column has a non-null default value
+	NullableUnionOrderAuto = "auto"
+	// NullableUnionOrderNullFirst always puts "null" first in the union of a
+	// nullable column, and the default value of the field is always null
+	NullableUnionOrderNullFirst = "null-first"
+	// NullableUnionOrderNullLast always puts "null" last in the union of a
+	// nullable column, the field has no default value if the column default is null
+	NullableUnionOrderNullLast = "null-last"
 )
 
 type urlConfig struct {
@@ -105,14 +195,30 @@ type urlConfig struct {
 	MaxMessageBytes                *int    `form:"max-message-bytes"`
 	AvroDecimalHandlingMode        *string `form:"avro-decimal-handling-mode"`
 	AvroBigintUnsignedHandlingMode *string `form:"avro-bigint-unsigned-handling-mode"`
+	AvroTimePrecisionMode          *string `form:"avro-time-precision-mode"`
+	AvroEnumHandlingMode           *string `form:"avro-enum-handling-mode"`
+	AvroSetHandlingMode            *string `form:"avro-set-handling-mode"`
+	AvroNullableUnionOrder         *string `form:"avro-nullable-union-order"`
 
 	// AvroEnableWatermark is the option for enabling watermark in avro protocol
 	// only used for internal testing, do not set this in the production environment since the
 	// confluent official consumer cannot handle watermark.
 	AvroEnableWatermark *bool `form:"avro-enable-watermark"`
+	// AvroEnableSchemaChangeEvent is the option for sending DDL events as avro
+	// schema change events, which carry the fingerprint of the new table schema.
+	AvroEnableSchemaChangeEvent *bool `form:"avro-enable-schema-change-event"`
 
 	AvroSchemaRegistry       string `form:"schema-registry"`
 	OnlyOutputUpdatedColumns *bool  `form:"only-output-updated-columns"`
+
+	OpenProtocolVersion *uint64 `form:"open-protocol-version"`
+
+	EnableMessageChunking  *bool `form:"enable-message-chunking"`
+	EnableEncodingChecksum *bool `form:"enable-encoding-checksum"`
+
+	EncoderPlugin *string `form:"encoder-plugin"`
+	// EncoderPluginParams can only be set in the changefeed config.
+	EncoderPluginParams map[string]string `form:"-"`
 }
 
 // Apply fill the Config
@@ -147,12 +253,32 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 		*urlParameter.AvroBigintUnsignedHandlingMode != "" {
 		c.AvroBigintUnsignedHandlingMode = *urlParameter.AvroBigintUnsignedHandlingMode
 	}
+	if urlParameter.AvroTimePrecisionMode != nil &&
+		*urlParameter.AvroTimePrecisionMode != "" {
+		c.AvroTimePrecisionMode = *urlParameter.AvroTimePrecisionMode
+	}
+	if urlParameter.AvroEnumHandlingMode != nil &&
+		*urlParameter.AvroEnumHandlingMode != "" {
+		c.AvroEnumHandlingMode = *urlParameter.AvroEnumHandlingMode
+	}
+	if urlParameter.AvroSetHandlingMode != nil &&
+		*urlParameter.AvroSetHandlingMode != "" {
+		c.AvroSetHandlingMode = *urlParameter.AvroSetHandlingMode
+	}
+	if urlParameter.AvroNullableUnionOrder != nil &&
+		*urlParameter.AvroNullableUnionOrder != "" {
+		c.AvroNullableUnionOrder = *urlParameter.AvroNullableUnionOrder
+	}
 	if urlParameter.AvroEnableWatermark != nil {
 		if c.EnableTiDBExtension && c.Protocol == config.ProtocolAvro {
 			c.AvroEnableWatermark = *urlParameter.AvroEnableWatermark
 		}
 	}
 
+	if urlParameter.AvroEnableSchemaChangeEvent != nil {
+		c.AvroEnableSchemaChangeEvent = *urlParameter.AvroEnableSchemaChangeEvent
+	}
+
 	if urlParameter.AvroSchemaRegistry != "" {
 		c.AvroSchemaRegistry = urlParameter.AvroSchemaRegistry
 	}
@@ -164,7 +290,13 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 			c.Quote = replicaConfig.Sink.CSVConfig.Quote
 			c.NullString = replicaConfig.Sink.CSVConfig.NullString
 			c.IncludeCommitTs = replicaConfig.Sink.CSVConfig.IncludeCommitTs
+			c.Escape = replicaConfig.Sink.CSVConfig.Escape
+			c.OutputFieldHeader = replicaConfig.Sink.CSVConfig.OutputFieldHeader
+			if replicaConfig.Sink.CSVConfig.BinaryEncodingMethod != "" {
+				c.BinaryEncodingMethod = replicaConfig.Sink.CSVConfig.BinaryEncodingMethod
+			}
 		}
+		c.CanalJSONConfig = replicaConfig.Sink.CanalJSONConfig
 	}
 	if urlParameter.OnlyOutputUpdatedColumns != nil {
 		c.OnlyOutputUpdatedColumns = *urlParameter.OnlyOutputUpdatedColumns
@@ -176,6 +308,23 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 		)
 	}
 
+	if urlParameter.OpenProtocolVersion != nil {
+		c.OpenProtocolVersion = *urlParameter.OpenProtocolVersion
+	}
+
+	if urlParameter.EnableMessageChunking != nil {
+		c.EnableMessageChunking = *urlParameter.EnableMessageChunking
+	}
+
+	if urlParameter.EnableEncodingChecksum != nil {
+		c.EnableEncodingChecksum = *urlParameter.EnableEncodingChecksum
+	}
+
+	if urlParameter.EncoderPlugin != nil {
+		c.EncoderPlugin = *urlParameter.EncoderPlugin
+	}
+	c.EncoderPluginParams = urlParameter.EncoderPluginParams
+
 	if replicaConfig.Integrity != nil {
 		c.EnableRowChecksum = replicaConfig.Integrity.Enabled()
 	}
@@ -198,8 +347,18 @@ func mergeConfig(
 				dest.EnableTiDBExtension = codecConfig.EnableTiDBExtension
 				dest.MaxBatchSize = codecConfig.MaxBatchSize
 				dest.AvroEnableWatermark = codecConfig.AvroEnableWatermark
+				dest.AvroEnableSchemaChangeEvent = codecConfig.AvroEnableSchemaChangeEvent
 				dest.AvroDecimalHandlingMode = codecConfig.AvroDecimalHandlingMode
 				dest.AvroBigintUnsignedHandlingMode = codecConfig.AvroBigintUnsignedHandlingMode
+				dest.AvroTimePrecisionMode = codecConfig.AvroTimePrecisionMode
+				dest.AvroEnumHandlingMode = codecConfig.AvroEnumHandlingMode
+				dest.AvroSetHandlingMode = codecConfig.AvroSetHandlingMode
+				dest.AvroNullableUnionOrder = codecConfig.AvroNullableUnionOrder
+				dest.OpenProtocolVersion = codecConfig.OpenProtocolVersion
+				dest.EnableMessageChunking = codecConfig.EnableMessageChunking
+				dest.EnableEncodingChecksum = codecConfig.EnableEncodingChecksum
+				dest.EncoderPlugin = codecConfig.EncoderPlugin
+				dest.EncoderPluginParams = codecConfig.EncoderPluginParams
 			}
 		}
 	}
@@ -225,6 +384,57 @@ func (c *Config) Validate() error {
 			zap.String("protocol", c.Protocol.String()))
 	}
 
+	if c.Protocol == config.ProtocolProtobuf && c.AvroSchemaRegistry == "" {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`Protobuf protocol requires parameter "%s"`,
+			codecOPTAvroSchemaRegistry,
+		)
+	}
+
+	if c.Protocol == config.ProtocolOpen &&
+		c.OpenProtocolVersion != BatchVersion1 &&
+		c.OpenProtocolVersion != BatchVersion2 {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`%s value could only be %d or %d`,
+			codecOPTOpenProtocolVersion,
+			BatchVersion1,
+			BatchVersion2,
+		)
+	}
+
+	if c.EnableEncodingChecksum {
+		switch c.Protocol {
+		case config.ProtocolDefault, config.ProtocolOpen,
+			config.ProtocolCanalJSON, config.ProtocolCsv:
+		default:
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s is not supported by %s protocol`,
+				codecOPTEnableEncodingChecksum, c.Protocol.String(),
+			)
+		}
+	}
+
+	if c.Protocol == config.ProtocolPlugin && c.EncoderPlugin == "" {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`Plugin protocol requires parameter "%s"`,
+			codecOPTEncoderPlugin,
+		)
+	}
+	if c.Protocol != config.ProtocolPlugin && c.EncoderPlugin != "" {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`%s is only supported by plugin protocol`,
+			codecOPTEncoderPlugin,
+		)
+	}
+
+	if c.EnableEncodingChecksum &&
+		c.Protocol == config.ProtocolCanalJSON && !c.EnableTiDBExtension {
+		return cerror.ErrCodecInvalidConfig.GenWithStack(
+			`Canal-JSON protocol with encoding checksum requires "%s" to be "true"`,
+			codecOPTEnableTiDBExtension,
+		)
+	}
+
 	if c.Protocol == config.ProtocolAvro {
 		if c.AvroSchemaRegistry == "" {
 			return cerror.ErrCodecInvalidConfig.GenWithStack(
@@ -253,6 +463,50 @@ func (c *Config) Validate() error {
 			)
 		}
 
+		if c.AvroTimePrecisionMode != TimePrecisionModeString &&
+			c.AvroTimePrecisionMode != TimePrecisionModeMillis &&
+			c.AvroTimePrecisionMode != TimePrecisionModeMicros {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be "%s", "%s" or "%s"`,
+				codecOPTAvroTimePrecisionMode,
+				TimePrecisionModeString,
+				TimePrecisionModeMillis,
+				TimePrecisionModeMicros,
+			)
+		}
+
+		if c.AvroEnumHandlingMode != EnumHandlingModeString &&
+			c.AvroEnumHandlingMode != EnumHandlingModeEnum {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be "%s" or "%s"`,
+				codecOPTAvroEnumHandlingMode,
+				EnumHandlingModeString,
+				EnumHandlingModeEnum,
+			)
+		}
+
+		if c.AvroSetHandlingMode != SetHandlingModeString &&
+			c.AvroSetHandlingMode != SetHandlingModeArray {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be "%s" or "%s"`,
+				codecOPTAvroSetHandlingMode,
+				SetHandlingModeString,
+				SetHandlingModeArray,
+			)
+		}
+
+		if c.AvroNullableUnionOrder != NullableUnionOrderAuto &&
+			c.AvroNullableUnionOrder != NullableUnionOrderNullFirst &&
+			c.AvroNullableUnionOrder != NullableUnionOrderNullLast {
+			return cerror.ErrCodecInvalidConfig.GenWithStack(
+				`%s value could only be "%s", "%s" or "%s"`,
+				codecOPTAvroNullableUnionOrder,
+				NullableUnionOrderAuto,
+				NullableUnionOrderNullFirst,
+				NullableUnionOrderNullLast,
+			)
+		}
+
 		if c.EnableRowChecksum {
 			if !(c.EnableTiDBExtension && c.AvroDecimalHandlingMode == DecimalHandlingModeString &&
 				c.AvroBigintUnsignedHandlingMode == BigintUnsignedHandlingModeString) {
@@ -263,6 +517,15 @@ func (c *Config) Validate() error {
 					codecOPTAvroDecimalHandlingMode, DecimalHandlingModeString,
 					codecOPTAvroBigintUnsignedHandlingMode, BigintUnsignedHandlingModeString)
 			}
+			if c.AvroTimePrecisionMode != TimePrecisionModeString ||
+				c.AvroEnumHandlingMode != EnumHandlingModeString ||
+				c.AvroSetHandlingMode != SetHandlingModeString {
+				return cerror.ErrCodecInvalidConfig.GenWithStack(
+					`Avro protocol with row level checksum requires "%s", "%s" and "%s" to be "string"`,
+					codecOPTAvroTimePrecisionMode,
+					codecOPTAvroEnumHandlingMode,
+					codecOPTAvroSetHandlingMode)
+			}
 		}
 	}
 
@@ -112,6 +112,30 @@ func TestConfigApplyValidate4EnableRowChecksum(t *testing.T) {
 	require.True(t, c.AvroEnableWatermark)
 }
 
+func TestConfigValidate4EnableEncodingChecksum(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []config.Protocol{
+		config.ProtocolOpen, config.ProtocolCanalJSON, config.ProtocolCsv,
+	} {
+		c := NewConfig(p)
+		c.EnableEncodingChecksum = true
+		c.EnableTiDBExtension = true
+		require.NoError(t, c.Validate(), p.String())
+	}
+
+	for _, p := range []config.Protocol{
+		config.ProtocolAvro, config.ProtocolMaxwell, config.ProtocolCraft,
+		config.ProtocolDebezium, config.ProtocolProtobuf, config.ProtocolSimpleJSON,
+	} {
+		c := NewConfig(p)
+		c.EnableEncodingChecksum = true
+		c.AvroSchemaRegistry = "some-schema-registry"
+		err := c.Validate()
+		require.ErrorContains(t, err, "enable-encoding-checksum is not supported", p.String())
+	}
+}
+
 func TestConfigApplyValidate(t *testing.T) {
 	t.Parallel()
 
@@ -186,6 +210,66 @@ func TestConfigApplyValidate(t *testing.T) {
 	err = c.Validate()
 	require.NoError(t, err)
 
+	// protobuf
+	c = NewConfig(config.ProtocolProtobuf)
+	err = c.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(t, err, `Protobuf protocol requires parameter "schema-registry"`)
+
+	err = c.Apply(sinkURI, replicaConfig)
+	require.NoError(t, err)
+	err = c.Validate()
+	require.NoError(t, err)
+
+	// encoder plugin
+	c = NewConfig(config.ProtocolPlugin)
+	err = c.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(t, err, `Plugin protocol requires parameter "encoder-plugin"`)
+
+	pluginReplicaConfig := config.GetDefaultReplicaConfig()
+	pluginReplicaConfig.Sink.KafkaConfig = &config.KafkaConfig{
+		CodecConfig: &config.CodecConfig{
+			EncoderPlugin:       util.AddressOf("/path/to/encoder.so"),
+			EncoderPluginParams: map[string]string{"format": "v1"},
+		},
+	}
+	err = c.Apply(sinkURI, pluginReplicaConfig)
+	require.NoError(t, err)
+	require.Equal(t, "/path/to/encoder.so", c.EncoderPlugin)
+	require.Equal(t, map[string]string{"format": "v1"}, c.EncoderPluginParams)
+	err = c.Validate()
+	require.NoError(t, err)
+
+	c = NewConfig(config.ProtocolOpen)
+	err = c.Apply(sinkURI, pluginReplicaConfig)
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(t, err, "encoder-plugin is only supported by plugin protocol")
+
+	// open-protocol-version
+	c = NewConfig(config.ProtocolOpen)
+	require.Equal(t, BatchVersion1, c.OpenProtocolVersion)
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=open-protocol&open-protocol-version=2"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+	err = c.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	require.Equal(t, BatchVersion2, c.OpenProtocolVersion)
+	err = c.Validate()
+	require.NoError(t, err)
+
+	uri = "kafka://127.0.0.1:9092/abc?protocol=open-protocol&open-protocol-version=3"
+	sinkURI, err = url.Parse(uri)
+	require.NoError(t, err)
+	err = c.Apply(sinkURI, config.GetDefaultReplicaConfig())
+	require.NoError(t, err)
+	err = c.Validate()
+	require.ErrorContains(t, err, "open-protocol-version value could only be 1 or 2")
+
 	// avro-decimal-handling-mode
 	c = NewConfig(config.ProtocolAvro)
 	require.Equal(t, "precise", c.AvroDecimalHandlingMode)
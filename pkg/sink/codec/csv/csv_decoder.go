@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
 	"github.com/pingcap/tidb/br/pkg/lightning/worker"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
@@ -48,8 +49,13 @@ func NewBatchDecoder(ctx context.Context,
 
 	// if quote is not set in config, we should unespace backslash
 	// when parsing csv columns.
-	if len(codecConfig.Quote) == 0 {
+	if len(codecConfig.Quote) == 0 || codecConfig.Escape == string(config.Backslash) {
 		backslashEscape = true
+	} else if len(codecConfig.Escape) != 0 {
+		// the parser only understands the backslash escape, so the quoted
+		// fields escaped by other characters are converted to the rfc4180
+		// form, in which a quote is escaped by doubling it.
+		value = unescapeQuotedFields(value, codecConfig.Quote[0], codecConfig.Escape[0])
 	}
 	cfg := &lconfig.CSVConfig{
 		Separator:       codecConfig.Delimiter,
@@ -57,14 +63,21 @@ func NewBatchDecoder(ctx context.Context,
 		Terminator:      codecConfig.Terminator,
 		Null:            []string{codecConfig.NullString},
 		BackslashEscape: backslashEscape,
+		Header:          codecConfig.OutputFieldHeader,
 	}
 	csvParser, err := mydump.NewCSVParser(ctx, cfg,
 		mydump.NewStringReader(string(value)),
 		int64(lconfig.ReadBlockSize),
-		worker.NewPool(ctx, defaultIOConcurrency, "io"), false, nil)
+		worker.NewPool(ctx, defaultIOConcurrency, "io"), codecConfig.OutputFieldHeader, nil)
 	if err != nil {
 		return nil, err
 	}
+	// skip the header row, the columns are decoded by the table info.
+	if codecConfig.OutputFieldHeader {
+		if err := csvParser.ReadColumns(); err != nil {
+			return nil, cerror.WrapError(cerror.ErrCSVDecodeFailed, err)
+		}
+	}
 	return &batchDecoder{
 		codecConfig: codecConfig,
 		tableInfo:   tableInfo,
@@ -120,3 +133,31 @@ func (b *batchDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error) {
 func (b *batchDecoder) NextDDLEvent() (*model.DDLEvent, error) {
 	return nil, nil
 }
+
+// unescapeQuotedFields replaces the escaped quote and escape characters in
+// the quoted fields with a doubled quote and an escape character
+// respectively, the data outside of quoted fields is kept as is.
+func unescapeQuotedFields(data []byte, quote, escape byte) []byte {
+	res := make([]byte, 0, len(data))
+	inQuote := false
+	for i := 0; i < len(data); i++ {
+		ch := data[i]
+		if inQuote && ch == escape && i+1 < len(data) {
+			switch data[i+1] {
+			case quote:
+				res = append(res, quote, quote)
+				i++
+				continue
+			case escape:
+				res = append(res, escape)
+				i++
+				continue
+			}
+		}
+		if ch == quote {
+			inQuote = !inQuote
+		}
+		res = append(res, ch)
+	}
+	return res
+}
@@ -85,3 +85,112 @@ func TestCSVBatchDecoder(t *testing.T) {
 	_, hasNext, _ := decoder.HasNext()
 	require.False(t, hasNext)
 }
+
+func TestCSVBatchDecoderWithHeader(t *testing.T) {
+	ctx := context.Background()
+	tableInfo := model.WrapTableInfo(1, "hr", 1, &timodel.TableInfo{
+		Name: timodel.NewCIStr("employee"),
+		Columns: []*timodel.ColumnInfo{
+			{
+				ID:        1,
+				Name:      timodel.NewCIStr("Id"),
+				FieldType: *types.NewFieldType(mysql.TypeInt24),
+			},
+			{
+				ID:        2,
+				Name:      timodel.NewCIStr("LastName"),
+				FieldType: *types.NewFieldType(mysql.TypeVarchar),
+			},
+		},
+	})
+	codecConfig := &common.Config{
+		Delimiter:         ",",
+		Quote:             "\"",
+		Escape:            "\\",
+		Terminator:        "\n",
+		NullString:        "\\N",
+		IncludeCommitTs:   true,
+		OutputFieldHeader: true,
+	}
+	csvData := string(BuildHeader(codecConfig, tableInfo)) +
+		`"I","employee","hr",433305438660591626,101,"Sm\"ith"
+"D","employee","hr",433305438660591629,101,"Smith"
+`
+	decoder, err := NewBatchDecoder(ctx, codecConfig, tableInfo, []byte(csvData))
+	require.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		tp, hasNext, err := decoder.HasNext()
+		require.Nil(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		event, err := decoder.NextRowChangedEvent()
+		require.Nil(t, err)
+		require.NotNil(t, event)
+		if i == 0 {
+			require.Equal(t, []byte(`Sm"ith`), event.Columns[1].Value)
+		}
+	}
+
+	_, hasNext, _ := decoder.HasNext()
+	require.False(t, hasNext)
+}
+
+func TestCSVBatchDecoderWithEscape(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tableInfo := model.WrapTableInfo(1, "hr", 1, &timodel.TableInfo{
+		Name: timodel.NewCIStr("employee"),
+		Columns: []*timodel.ColumnInfo{
+			{
+				ID:        1,
+				Name:      timodel.NewCIStr("Id"),
+				FieldType: *types.NewFieldType(mysql.TypeInt24),
+			},
+			{
+				ID:        2,
+				Name:      timodel.NewCIStr("LastName"),
+				FieldType: *types.NewFieldType(mysql.TypeVarchar),
+			},
+		},
+	})
+	values := []string{`Sm"ith`, `O'Brien`, `a'"b''c\d`, `plain`}
+	for _, escape := range []string{"'", "\\"} {
+		codecConfig := &common.Config{
+			Delimiter:       ",",
+			Quote:           "\"",
+			Escape:          escape,
+			Terminator:      "\n",
+			NullString:      "\\N",
+			IncludeCommitTs: true,
+		}
+		var csvData []byte
+		for _, v := range values {
+			msg := &csvMessage{
+				config:     codecConfig,
+				opType:     operationInsert,
+				tableName:  "employee",
+				schemaName: "hr",
+				commitTs:   433305438660591626,
+				columns:    []any{int64(101), v},
+				newRecord:  true,
+			}
+			csvData = append(csvData, msg.encode()...)
+		}
+
+		decoder, err := NewBatchDecoder(ctx, codecConfig, tableInfo, csvData)
+		require.Nil(t, err)
+		for _, v := range values {
+			tp, hasNext, err := decoder.HasNext()
+			require.Nil(t, err)
+			require.True(t, hasNext)
+			require.Equal(t, model.MessageTypeRow, tp)
+			event, err := decoder.NextRowChangedEvent()
+			require.Nil(t, err)
+			require.Equal(t, []byte(v), event.Columns[1].Value, escape)
+		}
+		_, hasNext, _ := decoder.HasNext()
+		require.False(t, hasNext)
+	}
+}
@@ -15,6 +15,7 @@ package csv
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -34,6 +35,16 @@ import (
 // a csv row should at least contain operation-type, table-name, schema-name and one table column
 const minimumColsCnt = 4
 
+// names of the leading and trailing csv columns in the header row.
+const (
+	headerOperation     = "_tidb_op"
+	headerTableName     = "_tidb_table"
+	headerSchemaName    = "_tidb_schema"
+	headerCommitTs      = "_tidb_commit_ts"
+	headerSchemaVersion = "_tidb_schema_version"
+	headerRowChecksum   = "_tidb_row_checksum"
+)
+
 // operation specifies the operation type
 type operation int
 
@@ -81,6 +92,9 @@ type csvMessage struct {
 	schemaName string
 	commitTs   uint64
 	columns    []any
+	// schemaVersion and checksum are only encoded if the encoding checksum is enabled.
+	schemaVersion uint64
+	checksum      uint32
 	// newRecord indicates whether we encounter a new record.
 	newRecord bool
 }
@@ -98,6 +112,7 @@ func newCSVMessage(config *common.Config) *csvMessage {
 // Col3: Schema name, the name of the source schema.
 // Col4: Commit TS, the commit-ts of the source txn (optional).
 // Col5-n: one or more columns that represent the data to be changed.
+// Col(n+1)-(n+2): the schema version and the row checksum (optional).
 func (c *csvMessage) encode() []byte {
 	strBuilder := new(strings.Builder)
 	c.formatValue(c.opType.String(), strBuilder)
@@ -109,6 +124,10 @@ func (c *csvMessage) encode() []byte {
 	for _, col := range c.columns {
 		c.formatValue(col, strBuilder)
 	}
+	if c.config.EnableEncodingChecksum {
+		c.formatValue(c.schemaVersion, strBuilder)
+		c.formatValue(c.checksum, strBuilder)
+	}
 	strBuilder.WriteString(c.config.Terminator)
 	return []byte(strBuilder.String())
 }
@@ -142,7 +161,26 @@ func (c *csvMessage) decode(datums []types.Datum) error {
 	}
 	c.columns = c.columns[:0]
 
-	for i := dataColIdx; i < len(datums); i++ {
+	endColIdx := len(datums)
+	if c.config.EnableEncodingChecksum {
+		endColIdx -= 2
+		if endColIdx < dataColIdx {
+			return cerror.WrapError(cerror.ErrCSVDecodeFailed,
+				errors.New("the csv row should contain the schema version and the row checksum"))
+		}
+		schemaVersion, err := strconv.ParseUint(datums[endColIdx].GetString(), 10, 64)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrCSVDecodeFailed, err)
+		}
+		checksum, err := strconv.ParseUint(datums[endColIdx+1].GetString(), 10, 32)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrCSVDecodeFailed, err)
+		}
+		c.schemaVersion = schemaVersion
+		c.checksum = uint32(checksum)
+	}
+
+	for i := dataColIdx; i < endColIdx; i++ {
 		if datums[i].IsNull() {
 			c.columns = append(c.columns, nil)
 		} else {
@@ -150,19 +188,47 @@ func (c *csvMessage) decode(datums []types.Datum) error {
 		}
 	}
 
+	if c.config.EnableEncodingChecksum {
+		return common.VerifyRowChecksum(
+			c.schemaVersion, csvChecksumValues(c.columns), nil, c.checksum)
+	}
 	return nil
 }
 
+// csvChecksumValues returns the values used to calculate the row checksum,
+// which are the csv columns in the text format keyed by their positions,
+// since the csv record does not carry the column names.
+func csvChecksumValues(columns []any) map[string]interface{} {
+	values := make(map[string]interface{}, len(columns))
+	for i, col := range columns {
+		switch v := col.(type) {
+		case nil, string:
+			values[strconv.Itoa(i)] = v
+		default:
+			values[strconv.Itoa(i)] = fmt.Sprintf("%v", v)
+		}
+	}
+	return values
+}
+
 // as stated in https://datatracker.ietf.org/doc/html/rfc4180,
 // if double-quotes are used to enclose fields, then a double-quote
 // appearing inside a field must be escaped by preceding it with
 // another double quote.
+// If the escape character is configured, the quote and the escape character
+// inside a field are preceded by the escape character instead.
 func (c *csvMessage) formatWithQuotes(value string, strBuilder *strings.Builder) {
 	quote := c.config.Quote
+	escape := c.config.Escape
 
 	strBuilder.WriteString(quote)
-	// replace any quote in csv column with two quotes.
-	strBuilder.WriteString(strings.ReplaceAll(value, quote, quote+quote))
+	if len(escape) == 0 {
+		// replace any quote in csv column with two quotes.
+		strBuilder.WriteString(strings.ReplaceAll(value, quote, quote+quote))
+	} else {
+		replacer := strings.NewReplacer(escape, escape+escape, quote, escape+quote)
+		strBuilder.WriteString(replacer.Replace(value))
+	}
 	strBuilder.WriteString(quote)
 }
 
@@ -233,7 +299,7 @@ func (c *csvMessage) formatValue(value any, strBuilder *strings.Builder) {
 	}
 }
 
-func fromCsvValToColValue(csvVal any, ft types.FieldType) (any, error) {
+func fromCsvValToColValue(csvConfig *common.Config, csvVal any, ft types.FieldType) (any, error) {
 	str, ok := csvVal.(string)
 	if !ok {
 		return csvVal, nil
@@ -243,6 +309,10 @@ func fromCsvValToColValue(csvVal any, ft types.FieldType) (any, error) {
 	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
 		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 		if ft.GetCharset() == charset.CharsetBin {
+			if csvConfig.BinaryEncodingMethod == config.BinaryEncodingHex {
+				blob, err := hex.DecodeString(str)
+				return blob, err
+			}
 			blob, err := base64.StdEncoding.DecodeString(str)
 			return blob, err
 		}
@@ -269,7 +339,7 @@ func fromCsvValToColValue(csvVal any, ft types.FieldType) (any, error) {
 }
 
 // fromColValToCsvVal converts column from TiDB type to csv type.
-func fromColValToCsvVal(col *model.Column, ft *types.FieldType) (any, error) {
+func fromColValToCsvVal(csvConfig *common.Config, col *model.Column, ft *types.FieldType) (any, error) {
 	if col.Value == nil {
 		return nil, nil
 	}
@@ -279,6 +349,9 @@ func fromColValToCsvVal(col *model.Column, ft *types.FieldType) (any, error) {
 		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
 		if col.Flag.IsBinary() {
 			if v, ok := col.Value.([]byte); ok {
+				if csvConfig.BinaryEncodingMethod == config.BinaryEncodingHex {
+					return hex.EncodeToString(v), nil
+				}
 				return base64.StdEncoding.EncodeToString(v), nil
 			}
 			return col.Value, nil
@@ -323,7 +396,7 @@ func rowChangedEvent2CSVMsg(csvConfig *common.Config, e *model.RowChangedEvent)
 	}
 	if e.IsDelete() {
 		csvMsg.opType = operationDelete
-		csvMsg.columns, err = rowChangeColumns2CSVColumns(e.PreColumns, e.ColInfos)
+		csvMsg.columns, err = rowChangeColumns2CSVColumns(csvConfig, e.PreColumns, e.ColInfos)
 		if err != nil {
 			return nil, err
 		}
@@ -334,11 +407,16 @@ func rowChangedEvent2CSVMsg(csvConfig *common.Config, e *model.RowChangedEvent)
 			csvMsg.opType = operationUpdate
 		}
 		// for insert and update operation, we only record the after columns.
-		csvMsg.columns, err = rowChangeColumns2CSVColumns(e.Columns, e.ColInfos)
+		csvMsg.columns, err = rowChangeColumns2CSVColumns(csvConfig, e.Columns, e.ColInfos)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if csvConfig.EnableEncodingChecksum {
+		csvMsg.schemaVersion = common.SchemaVersion(e)
+		csvMsg.checksum = common.RowChecksum(
+			csvMsg.schemaVersion, csvChecksumValues(csvMsg.columns), nil)
+	}
 	return csvMsg, nil
 }
 
@@ -357,9 +435,9 @@ func csvMsg2RowChangedEvent(csvMsg *csvMessage, ticols []*timodel.ColumnInfo) (*
 		Table:  csvMsg.tableName,
 	}
 	if csvMsg.opType == operationDelete {
-		e.PreColumns, err = csvColumns2RowChangeColumns(csvMsg.columns, ticols)
+		e.PreColumns, err = csvColumns2RowChangeColumns(csvMsg.config, csvMsg.columns, ticols)
 	} else {
-		e.Columns, err = csvColumns2RowChangeColumns(csvMsg.columns, ticols)
+		e.Columns, err = csvColumns2RowChangeColumns(csvMsg.config, csvMsg.columns, ticols)
 	}
 
 	if err != nil {
@@ -369,7 +447,9 @@ func csvMsg2RowChangedEvent(csvMsg *csvMessage, ticols []*timodel.ColumnInfo) (*
 	return e, nil
 }
 
-func rowChangeColumns2CSVColumns(cols []*model.Column, colInfos []rowcodec.ColInfo) ([]any, error) {
+func rowChangeColumns2CSVColumns(
+	csvConfig *common.Config, cols []*model.Column, colInfos []rowcodec.ColInfo,
+) ([]any, error) {
 	var csvColumns []any
 	for i, column := range cols {
 		// column could be nil in a condition described in
@@ -378,7 +458,7 @@ func rowChangeColumns2CSVColumns(cols []*model.Column, colInfos []rowcodec.ColIn
 			continue
 		}
 
-		converted, err := fromColValToCsvVal(column, colInfos[i].Ft)
+		converted, err := fromColValToCsvVal(csvConfig, column, colInfos[i].Ft)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -388,7 +468,9 @@ func rowChangeColumns2CSVColumns(cols []*model.Column, colInfos []rowcodec.ColIn
 	return csvColumns, nil
 }
 
-func csvColumns2RowChangeColumns(csvCols []any, ticols []*timodel.ColumnInfo) ([]*model.Column, error) {
+func csvColumns2RowChangeColumns(
+	csvConfig *common.Config, csvCols []any, ticols []*timodel.ColumnInfo,
+) ([]*model.Column, error) {
 	cols := make([]*model.Column, 0, len(csvCols))
 	for idx, csvCol := range csvCols {
 		col := new(model.Column)
@@ -402,7 +484,7 @@ func csvColumns2RowChangeColumns(csvCols []any, ticols []*timodel.ColumnInfo) ([
 			col.Flag.SetIsPrimaryKey()
 		}
 
-		val, err := fromCsvValToColValue(csvCol, ticol.FieldType)
+		val, err := fromCsvValToColValue(csvConfig, csvCol, ticol.FieldType)
 		if err != nil {
 			return cols, err
 		}
@@ -412,3 +494,28 @@ func csvColumns2RowChangeColumns(csvCols []any, ticols []*timodel.ColumnInfo) ([
 
 	return cols, nil
 }
+
+// BuildHeader returns the header row of a csv data file, which contains the
+// names of the csv columns of the table in the same order as the records.
+func BuildHeader(csvConfig *common.Config, tableInfo *model.TableInfo) []byte {
+	msg := newCSVMessage(csvConfig)
+	strBuilder := new(strings.Builder)
+	msg.formatValue(headerOperation, strBuilder)
+	msg.formatValue(headerTableName, strBuilder)
+	msg.formatValue(headerSchemaName, strBuilder)
+	if csvConfig.IncludeCommitTs {
+		msg.formatValue(headerCommitTs, strBuilder)
+	}
+	for _, col := range tableInfo.Columns {
+		if !model.IsColCDCVisible(col) {
+			continue
+		}
+		msg.formatValue(col.Name.O, strBuilder)
+	}
+	if csvConfig.EnableEncodingChecksum {
+		msg.formatValue(headerSchemaVersion, strBuilder)
+		msg.formatValue(headerRowChecksum, strBuilder)
+	}
+	strBuilder.WriteString(csvConfig.Terminator)
+	return []byte(strBuilder.String())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 )
@@ -762,7 +763,7 @@ func TestCSVMessageEncode(t *testing.T) {
 func TestConvertToCSVType(t *testing.T) {
 	for _, group := range csvTestColumnsGroup {
 		for _, c := range group {
-			val, _ := fromColValToCsvVal(&c.col, c.colInfo.Ft)
+			val, _ := fromColValToCsvVal(&common.Config{}, &c.col, c.colInfo.Ft)
 			require.Equal(t, c.want, val, c.col.Name)
 		}
 	}
@@ -901,3 +902,115 @@ func TestCSVMessageDecode(t *testing.T) {
 		}
 	}
 }
+
+func TestCSVMessageEscapeAndBinaryEncoding(t *testing.T) {
+	t.Parallel()
+
+	csvConfig := &common.Config{
+		Delimiter:            ",",
+		Quote:                "\"",
+		Escape:               "\\",
+		Terminator:           "\n",
+		NullString:           "\\N",
+		BinaryEncodingMethod: config.BinaryEncodingHex,
+	}
+	col := &model.Column{
+		Name:  "blob",
+		Type:  mysql.TypeBlob,
+		Flag:  model.BinaryFlag,
+		Value: []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+	ft := types.NewFieldType(mysql.TypeBlob)
+	ft.SetCharset(charset.CharsetBin)
+	val, err := fromColValToCsvVal(csvConfig, col, ft)
+	require.NoError(t, err)
+	require.Equal(t, "deadbeef", val)
+	blob, err := fromCsvValToColValue(csvConfig, val, *ft)
+	require.NoError(t, err)
+	require.Equal(t, col.Value, blob)
+
+	msg := &csvMessage{
+		config:     csvConfig,
+		opType:     operationInsert,
+		tableName:  "t",
+		schemaName: "test",
+		columns:    []any{`a"b\c`, nil},
+		newRecord:  true,
+	}
+	require.Equal(t, []byte(`"I","t","test","a\"b\\c",\N`+"\n"), msg.encode())
+}
+
+func TestBuildHeader(t *testing.T) {
+	t.Parallel()
+
+	tableInfo := model.WrapTableInfo(1, "test", 1, &timodel.TableInfo{
+		Name: timodel.NewCIStr("t"),
+		Columns: []*timodel.ColumnInfo{
+			{ID: 1, Name: timodel.NewCIStr("id"), FieldType: *types.NewFieldType(mysql.TypeLong)},
+			{ID: 2, Name: timodel.NewCIStr("name"), FieldType: *types.NewFieldType(mysql.TypeVarchar)},
+		},
+	})
+	csvConfig := &common.Config{
+		Delimiter:       ",",
+		Quote:           "\"",
+		Terminator:      "\n",
+		IncludeCommitTs: true,
+	}
+	require.Equal(t,
+		`"_tidb_op","_tidb_table","_tidb_schema","_tidb_commit_ts","id","name"`+"\n",
+		string(BuildHeader(csvConfig, tableInfo)))
+}
+
+func TestCSVMessageChecksum(t *testing.T) {
+	t.Parallel()
+
+	csvConfig := &common.Config{
+		Delimiter:              ",",
+		Quote:                  "\"",
+		Terminator:             "\n",
+		NullString:             "\\N",
+		EnableEncodingChecksum: true,
+	}
+	event := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "test", Table: "t"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: int64(1)},
+			{Name: "score", Type: mysql.TypeDouble, Value: float64(1e21)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: nil},
+		},
+		ColInfos: []rowcodec.ColInfo{
+			{ID: 1, Ft: types.NewFieldType(mysql.TypeLong)},
+			{ID: 2, Ft: types.NewFieldType(mysql.TypeDouble)},
+			{ID: 3, Ft: types.NewFieldType(mysql.TypeVarchar)},
+		},
+	}
+	msg, err := rowChangedEvent2CSVMsg(csvConfig, event)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), msg.schemaVersion)
+	require.NotZero(t, msg.checksum)
+	checksum := fmt.Sprintf("%d", msg.checksum)
+	require.Equal(t,
+		`"I","t","test",1,1e+21,\N,100,`+checksum+"\n",
+		string(msg.encode()))
+
+	row := []types.Datum{
+		types.NewStringDatum("I"),
+		types.NewStringDatum("t"),
+		types.NewStringDatum("test"),
+		types.NewStringDatum("1"),
+		types.NewStringDatum("1e+21"),
+		types.NewDatum(nil),
+		types.NewStringDatum("100"),
+		types.NewStringDatum(checksum),
+	}
+	decoded := newCSVMessage(csvConfig)
+	require.NoError(t, decoded.decode(row))
+	require.Len(t, decoded.columns, 3)
+
+	// the corrupted value is detected by the decoder.
+	row[3] = types.NewStringDatum("2")
+	decoded = newCSVMessage(csvConfig)
+	require.Error(t, decoded.decode(row))
+}
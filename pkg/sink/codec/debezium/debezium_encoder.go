@@ -0,0 +1,78 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+)
+
+// BatchEncoder encodes events in the Debezium JSON envelope format.
+// Each row changed event is encoded into a standalone message.
+type BatchEncoder struct {
+	*internal.RowMessages
+}
+
+// EncodeCheckpointEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	// Debezium has no event corresponding to the resolved ts,
+	// so the checkpoint event is ignored.
+	return nil, nil
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	key, err := rowChangeToDebeziumKey(e)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	value, err := rowChangeToDebeziumMsg(e).encode()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return d.Append(key, value, e, callback)
+}
+
+// EncodeDDLEvent implements the RowEventEncoder interface
+// The DDL is encoded as a Debezium schema change event.
+func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	value, err := ddlEventToDebeziumMsg(e).encode()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return common.NewDDLMsg(config.ProtocolDebezium, nil, value, e), nil
+}
+
+// newBatchEncoder creates a new Debezium BatchEncoder.
+func newBatchEncoder(c *common.Config) codec.RowEventEncoder {
+	return &BatchEncoder{
+		RowMessages: internal.NewRowMessages(config.ProtocolDebezium, c.MaxMessageBytes),
+	}
+}
+
+// NewBatchEncoderBuilder creates a Debezium batchEncoderBuilder.
+func NewBatchEncoderBuilder(c *common.Config) codec.RowEventEncoderBuilder {
+	return internal.NewRowEventEncoderBuilder(c, newBatchEncoder)
+}
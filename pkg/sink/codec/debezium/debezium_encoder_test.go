@@ -0,0 +1,147 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebeziumRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolDebezium)).Build()
+
+	insert := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+			{Name: "bin", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1, 0x2}},
+		},
+	}
+	update := &model.RowChangedEvent{
+		CommitTs: 2,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("ticdc")},
+		},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+		},
+	}
+	del := &model.RowChangedEvent{
+		CommitTs: 3,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Value: nil},
+		},
+	}
+
+	count := 0
+	for _, e := range []*model.RowChangedEvent{insert, update, del} {
+		err := encoder.AppendRowChangedEvent(context.Background(), "", e, func() { count++ })
+		require.NoError(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 3)
+	require.Nil(t, encoder.Build())
+
+	expected := []struct {
+		op     string
+		before map[string]interface{}
+		after  map[string]interface{}
+	}{
+		{
+			op:    opCreate,
+			after: map[string]interface{}{"id": float64(1), "name": "tidb", "bin": "AQI="},
+		},
+		{
+			op:     opUpdate,
+			before: map[string]interface{}{"id": float64(1), "name": "tidb"},
+			after:  map[string]interface{}{"id": float64(1), "name": "ticdc"},
+		},
+		{
+			op:     opDelete,
+			before: map[string]interface{}{"id": float64(1), "name": nil},
+		},
+	}
+	for i, msg := range messages {
+		require.Equal(t, config.ProtocolDebezium, msg.Protocol)
+		require.Equal(t, model.MessageTypeRow, msg.Type)
+		require.Equal(t, 1, msg.GetRowsCount())
+		require.JSONEq(t, `{"id":1}`, string(msg.Key))
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(msg.Value, &payload))
+		require.Equal(t, expected[i].op, payload["op"])
+		if expected[i].before == nil {
+			require.Nil(t, payload["before"])
+		} else {
+			require.Equal(t, expected[i].before, payload["before"])
+		}
+		if expected[i].after == nil {
+			require.Nil(t, payload["after"])
+		} else {
+			require.Equal(t, expected[i].after, payload["after"])
+		}
+		source := payload["source"].(map[string]interface{})
+		require.Equal(t, "test", source["db"])
+		require.Equal(t, "t", source["table"])
+		require.Equal(t, connectorName, source["connector"])
+		require.Equal(t, float64(i+1), source["commit_ts"])
+
+		msg.Callback()
+	}
+	require.Equal(t, 3, count)
+}
+
+func TestDebeziumDDLEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := newBatchEncoder(common.NewConfig(config.ProtocolDebezium))
+	ddl := &model.DDLEvent{
+		CommitTs: 1,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t"},
+			TableInfo: &timodel.TableInfo{},
+		},
+		Query: "create table t(id int primary key)",
+		Type:  timodel.ActionCreateTable,
+	}
+	msg, err := encoder.EncodeDDLEvent(ddl)
+	require.NoError(t, err)
+	require.Equal(t, model.MessageTypeDDL, msg.Type)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(msg.Value, &payload))
+	require.Equal(t, "test", payload["databaseName"])
+	require.Equal(t, ddl.Query, payload["ddl"])
+
+	msg, err = encoder.EncodeCheckpointEvent(1)
+	require.NoError(t, err)
+	require.Nil(t, msg)
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debezium
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+	"github.com/pingcap/tiflow/pkg/version"
+	"github.com/tikv/pd/pkg/utils/tsoutil"
+)
+
+const (
+	// connectorName is reported in the `source.connector` field.
+	connectorName = "tidb"
+	// sourceName is reported in the `source.name` field, it plays the role
+	// of the logical server name of a Debezium connector.
+	sourceName = "ticdc"
+
+	// Debezium operation codes, see
+	// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-events
+	opCreate = "c"
+	opUpdate = "u"
+	opDelete = "d"
+)
+
+// source describes the origin of a change event, it is the `source` block
+// of the Debezium envelope.
+type source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	DB        string `json:"db"`
+	Table     string `json:"table,omitempty"`
+	// CommitTs is the TiDB commit ts of the change, it is not part of the
+	// official MySQL connector source block.
+	CommitTs uint64 `json:"commit_ts"`
+}
+
+// rowMessage is the payload of a Debezium data change event. Schemas are not
+// embedded, which is equivalent to `value.converter.schemas.enable=false`.
+type rowMessage struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source *source                `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+func (m *rowMessage) encode() ([]byte, error) {
+	data, err := json.Marshal(m)
+	return data, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+}
+
+// ddlMessage is the payload of a Debezium schema change event.
+type ddlMessage struct {
+	Source       *source `json:"source"`
+	DatabaseName string  `json:"databaseName"`
+	SchemaName   *string `json:"schemaName"`
+	DDL          string  `json:"ddl"`
+	TsMs         int64   `json:"ts_ms"`
+}
+
+func (m *ddlMessage) encode() ([]byte, error) {
+	data, err := json.Marshal(m)
+	return data, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+}
+
+func newSource(schema, table string, commitTs uint64) *source {
+	physicalTime, _ := tsoutil.ParseTS(commitTs)
+	return &source{
+		Version:   version.ReleaseVersion,
+		Connector: connectorName,
+		Name:      sourceName,
+		TsMs:      physicalTime.UnixMilli(),
+		Snapshot:  "false",
+		DB:        schema,
+		Table:     table,
+		CommitTs:  commitTs,
+	}
+}
+
+// rowChangeToDebeziumKey builds the message key, which carries the handle key
+// columns of the row just as Debezium uses the primary key as the record key.
+func rowChangeToDebeziumKey(e *model.RowChangedEvent) ([]byte, error) {
+	columns := e.Columns
+	if e.IsDelete() {
+		columns = e.PreColumns
+	}
+	key := make(map[string]interface{})
+	for _, col := range columns {
+		if col == nil || !col.Flag.IsHandleKey() {
+			continue
+		}
+		key[col.Name] = internal.JSONColumnValue(col)
+	}
+	if len(key) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(key)
+	return data, cerror.WrapError(cerror.ErrDebeziumEncodeFailed, err)
+}
+
+func rowChangeToDebeziumMsg(e *model.RowChangedEvent) *rowMessage {
+	msg := &rowMessage{
+		Source: newSource(e.Table.Schema, e.Table.Table, e.CommitTs),
+		TsMs:   time.Now().UnixMilli(),
+	}
+	switch {
+	case e.IsDelete():
+		msg.Op = opDelete
+		msg.Before = columnsToMap(e.PreColumns)
+	case e.IsUpdate():
+		msg.Op = opUpdate
+		msg.Before = columnsToMap(e.PreColumns)
+		msg.After = columnsToMap(e.Columns)
+	default:
+		msg.Op = opCreate
+		msg.After = columnsToMap(e.Columns)
+	}
+	return msg
+}
+
+func ddlEventToDebeziumMsg(e *model.DDLEvent) *ddlMessage {
+	schema := e.TableInfo.TableName.Schema
+	return &ddlMessage{
+		Source:       newSource(schema, e.TableInfo.TableName.Table, e.CommitTs),
+		DatabaseName: schema,
+		DDL:          e.Query,
+		TsMs:         time.Now().UnixMilli(),
+	}
+}
+
+func columnsToMap(columns []*model.Column) map[string]interface{} {
+	result := make(map[string]interface{}, len(columns))
+	for _, col := range columns {
+		if col == nil {
+			continue
+		}
+		result[col.Name] = internal.JSONColumnValue(col)
+	}
+	return result
+}
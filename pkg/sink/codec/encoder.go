@@ -23,7 +23,10 @@ import (
 
 const (
 	// BatchVersion1 represents the version of batch format
-	BatchVersion1 uint64 = 1
+	BatchVersion1 = common.BatchVersion1
+	// BatchVersion2 represents the version of batch format, which carries
+	// the full type information of columns, only used by the open protocol.
+	BatchVersion2 = common.BatchVersion2
 )
 
 // DDLEventBatchEncoder is an abstraction for DDL event encoder.
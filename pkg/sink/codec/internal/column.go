@@ -192,3 +192,30 @@ func FormatColumn(c Column) Column {
 	}
 	return c
 }
+
+// JSONColumnValue converts the row changed column value to the one which is
+// output by the JSON based protocols. Non-binary values of the string and blob
+// types are converted to strings, while binary values are converted to bytes,
+// which are base64 encoded by the JSON marshaller.
+func JSONColumnValue(col *model.Column) interface{} {
+	if col.Value == nil {
+		return nil
+	}
+	switch col.Type {
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		switch v := col.Value.(type) {
+		case []byte:
+			if col.Flag.IsBinary() {
+				return v
+			}
+			return string(v)
+		case string:
+			if col.Flag.IsBinary() {
+				return []byte(v)
+			}
+			return v
+		}
+	}
+	return col.Value
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+// RowMessages collects the messages of the encoders which encode each row
+// changed event into a standalone message. It implements the Build method
+// of the RowEventEncoder interface.
+type RowMessages struct {
+	protocol        config.Protocol
+	messages        []*common.Message
+	maxMessageBytes int
+}
+
+// NewRowMessages creates a RowMessages.
+func NewRowMessages(protocol config.Protocol, maxMessageBytes int) *RowMessages {
+	return &RowMessages{
+		protocol:        protocol,
+		messages:        make([]*common.Message, 0, 1),
+		maxMessageBytes: maxMessageBytes,
+	}
+}
+
+// Append appends the message of the row changed event, ErrMessageTooLarge is
+// returned if the message exceeds the max message bytes.
+func (r *RowMessages) Append(
+	key, value []byte, e *model.RowChangedEvent, callback func(),
+) error {
+	length := len(key) + len(value) + common.MaxRecordOverhead
+	if length > r.maxMessageBytes {
+		log.Warn("Single message is too large",
+			zap.String("protocol", r.protocol.String()),
+			zap.Int("maxMessageBytes", r.maxMessageBytes),
+			zap.Int("length", length),
+			zap.Any("table", e.Table))
+		return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+	}
+
+	m := common.NewMsg(r.protocol, key, value,
+		e.CommitTs, model.MessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	m.Callback = callback
+	m.IncRowsCount()
+	r.messages = append(r.messages, m)
+	return nil
+}
+
+// Build returns the collected messages, nil is returned if there is none.
+func (r *RowMessages) Build() []*common.Message {
+	if len(r.messages) == 0 {
+		return nil
+	}
+	result := r.messages
+	r.messages = nil
+	return result
+}
+
+type rowEventEncoderBuilder struct {
+	config     *common.Config
+	newEncoder func(c *common.Config) codec.RowEventEncoder
+}
+
+// NewRowEventEncoderBuilder creates a RowEventEncoderBuilder which builds the
+// encoders by newEncoder with the given config.
+func NewRowEventEncoderBuilder(
+	c *common.Config, newEncoder func(c *common.Config) codec.RowEventEncoder,
+) codec.RowEventEncoderBuilder {
+	return &rowEventEncoderBuilder{config: c, newEncoder: newEncoder}
+}
+
+// Build a RowEventEncoder
+func (b *rowEventEncoderBuilder) Build() codec.RowEventEncoder {
+	return b.newEncoder(b.config)
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowMessages(t *testing.T) {
+	t.Parallel()
+
+	messages := NewRowMessages(config.ProtocolDebezium, 1024)
+	e := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+	}
+	count := 0
+	require.NoError(t, messages.Append([]byte("k1"), []byte("v1"), e, func() { count++ }))
+	require.NoError(t, messages.Append(nil, []byte("v2"), e, func() { count++ }))
+
+	built := messages.Build()
+	require.Len(t, built, 2)
+	for _, msg := range built {
+		require.Equal(t, config.ProtocolDebezium, msg.Protocol)
+		require.Equal(t, model.MessageTypeRow, msg.Type)
+		require.Equal(t, 1, msg.GetRowsCount())
+		msg.Callback()
+	}
+	require.Equal(t, 2, count)
+	require.Nil(t, messages.Build())
+
+	err := messages.Append(nil, make([]byte, 1024), e, nil)
+	require.True(t, cerror.ErrMessageTooLarge.Equal(err))
+	require.Nil(t, messages.Build())
+}
+
+func TestJSONColumnValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		col      *model.Column
+		expected interface{}
+	}{
+		{&model.Column{Type: mysql.TypeVarchar, Value: []byte("tidb")}, "tidb"},
+		{&model.Column{Type: mysql.TypeBlob, Value: "tidb"}, "tidb"},
+		{
+			&model.Column{Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1}},
+			[]byte{0x1},
+		},
+		{
+			&model.Column{Type: mysql.TypeString, Flag: model.BinaryFlag, Value: "\x01"},
+			[]byte{0x1},
+		},
+		{&model.Column{Type: mysql.TypeLong, Value: int64(1)}, int64(1)},
+		{&model.Column{Type: mysql.TypeVarchar, Value: nil}, nil},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected, JSONColumnValue(c.col))
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+// KeyGenerator returns the message key of the row changed event, false is
+// returned if the key generated by the encoder should be used.
+type KeyGenerator func(row *model.RowChangedEvent) ([]byte, bool)
+
+// keyedEncoder replaces the keys of the messages of the row changed events by
+// the keys returned by the generator. It expects that the wrapped encoder
+// encodes each row changed event into standalone messages.
+type keyedEncoder struct {
+	RowEventEncoder
+	generator       KeyGenerator
+	maxMessageBytes int
+
+	messages []*common.Message
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (e *keyedEncoder) AppendRowChangedEvent(
+	ctx context.Context,
+	topic string,
+	row *model.RowChangedEvent,
+	callback func(),
+) error {
+	key, ok := e.generator(row)
+	if !ok {
+		return e.RowEventEncoder.AppendRowChangedEvent(ctx, topic, row, callback)
+	}
+	// Build the messages of the previous row changed events first, so that
+	// only the messages of this row changed event are returned below.
+	e.messages = append(e.messages, e.RowEventEncoder.Build()...)
+	if err := e.RowEventEncoder.AppendRowChangedEvent(ctx, topic, row, callback); err != nil {
+		return err
+	}
+	messages := e.RowEventEncoder.Build()
+	for _, m := range messages {
+		m.Key = key
+		if m.Length() > e.maxMessageBytes {
+			log.Warn("Single message is too large after the key is replaced",
+				zap.Int("maxMessageBytes", e.maxMessageBytes),
+				zap.Int("length", m.Length()),
+				zap.Any("table", row.Table))
+			return cerror.ErrMessageTooLarge.GenWithStackByArgs()
+		}
+	}
+	e.messages = append(e.messages, messages...)
+	return nil
+}
+
+// Build implements the RowEventEncoder interface
+func (e *keyedEncoder) Build() []*common.Message {
+	messages := append(e.messages, e.RowEventEncoder.Build()...)
+	e.messages = nil
+	if len(messages) == 0 {
+		return nil
+	}
+	return messages
+}
+
+type keyedEncoderBuilder struct {
+	builder         RowEventEncoderBuilder
+	generator       KeyGenerator
+	maxMessageBytes int
+}
+
+// NewKeyedEncoderBuilder creates a RowEventEncoderBuilder which builds encoders
+// replacing the message keys of the row changed events by the keys returned by
+// the generator. The messages whose keys are replaced are still limited by
+// maxMessageBytes.
+func NewKeyedEncoderBuilder(
+	builder RowEventEncoderBuilder,
+	generator KeyGenerator,
+	maxMessageBytes int,
+) RowEventEncoderBuilder {
+	return &keyedEncoderBuilder{
+		builder:         builder,
+		generator:       generator,
+		maxMessageBytes: maxMessageBytes,
+	}
+}
+
+// Build a keyedEncoder
+func (b *keyedEncoderBuilder) Build() RowEventEncoder {
+	return &keyedEncoder{
+		RowEventEncoder: b.builder.Build(),
+		generator:       b.generator,
+		maxMessageBytes: b.maxMessageBytes,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedEncoder(t *testing.T) {
+	t.Parallel()
+
+	generator := func(row *model.RowChangedEvent) ([]byte, bool) {
+		if row.Table.Schema != "keyed" {
+			return nil, false
+		}
+		return []byte(row.Table.Table), true
+	}
+	builder := NewKeyedEncoderBuilder(
+		&mockEncoderBuilder{protocol: config.ProtocolCanalJSON}, generator, config.DefaultMaxMessageBytes)
+	encoder := builder.Build()
+
+	ctx := context.Background()
+	for _, schema := range []string{"keyed", "raw", "keyed"} {
+		err := encoder.AppendRowChangedEvent(ctx, "topic", &model.RowChangedEvent{
+			Table: &model.TableName{Schema: schema, Table: "t"},
+		}, nil)
+		require.NoError(t, err)
+	}
+	messages := encoder.Build()
+	require.Len(t, messages, 3)
+	require.Equal(t, []byte("t"), messages[0].Key)
+	require.Equal(t, "keyed", *messages[0].Schema)
+	require.Nil(t, messages[1].Key)
+	require.Equal(t, "raw", *messages[1].Schema)
+	require.Equal(t, []byte("t"), messages[2].Key)
+	require.Nil(t, encoder.Build())
+
+	// the message is too large after the key is replaced.
+	builder = NewKeyedEncoderBuilder(
+		&mockEncoderBuilder{protocol: config.ProtocolCanalJSON}, generator, 1)
+	err := builder.Build().AppendRowChangedEvent(ctx, "topic", &model.RowChangedEvent{
+		Table: &model.TableName{Schema: "keyed", Table: "t"},
+	}, nil)
+	require.True(t, cerror.ErrMessageTooLarge.Equal(err))
+}
@@ -31,6 +31,9 @@ type BatchEncoder struct {
 	valueBuf    *bytes.Buffer
 	callbackBuf []func()
 	batchSize   int
+	// rowsCount is the number of records in the batch, which is larger than
+	// batchSize if some update events are split into two records.
+	rowsCount int
 }
 
 // EncodeCheckpointEvent implements the RowEventEncoder interface
@@ -47,12 +50,15 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
-	_, valueMsg := rowChangeToMaxwellMsg(e)
-	value, err := valueMsg.encode()
-	if err != nil {
-		return errors.Trace(err)
+	for _, row := range splitHandleKeyUpdate(e) {
+		_, valueMsg := rowChangeToMaxwellMsg(row)
+		value, err := valueMsg.encode()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		d.valueBuf.Write(value)
+		d.rowsCount++
 	}
-	d.valueBuf.Write(value)
 	d.batchSize++
 	if callback != nil {
 		d.callbackBuf = append(d.callbackBuf, callback)
@@ -84,7 +90,7 @@ func (d *BatchEncoder) Build() []*common.Message {
 
 	ret := common.NewMsg(config.ProtocolMaxwell,
 		d.keyBuf.Bytes(), d.valueBuf.Bytes(), 0, model.MessageTypeRow, nil, nil)
-	ret.SetRowsCount(d.batchSize)
+	ret.SetRowsCount(d.rowsCount)
 	if len(d.callbackBuf) != 0 && len(d.callbackBuf) == d.batchSize {
 		callbacks := d.callbackBuf
 		ret.Callback = func() {
@@ -103,6 +109,7 @@ func (d *BatchEncoder) reset() {
 	d.keyBuf.Reset()
 	d.valueBuf.Reset()
 	d.batchSize = 0
+	d.rowsCount = 0
 	var versionByte [8]byte
 	binary.BigEndian.PutUint64(versionByte[:], codec.BatchVersion1)
 	d.keyBuf.Write(versionByte[:])
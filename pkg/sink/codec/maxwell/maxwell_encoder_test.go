@@ -47,6 +47,23 @@ func TestMaxwellBatchCodec(t *testing.T) {
 		require.Equal(t, len(cs), messages[0].GetRowsCount())
 	}
 
+	// the update event which changes the handle key is encoded into two records.
+	encoder := newEncoder()
+	err := encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: []*model.Column{
+			{Name: "col1", Type: 3, Flag: model.HandleKeyFlag, Value: 10},
+		},
+		Columns: []*model.Column{
+			{Name: "col1", Type: 3, Flag: model.HandleKeyFlag, Value: 11},
+		},
+	}, nil)
+	require.Nil(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	require.Equal(t, 2, messages[0].GetRowsCount())
+
 	ddlCases := [][]*model.DDLEvent{{{
 		CommitTs: 1,
 		TableInfo: &model.TableInfo{
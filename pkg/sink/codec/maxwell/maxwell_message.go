@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 	"github.com/tikv/pd/pkg/utils/tsoutil"
 )
@@ -66,68 +67,73 @@ func rowChangeToMaxwellMsg(e *model.RowChangedEvent) (*internal.MessageKey, *max
 	physicalTime, _ := tsoutil.ParseTS(e.CommitTs)
 	value.Ts = physicalTime.Unix()
 	if e.IsDelete() {
+		// the deleted row is carried by `old` to keep the existing layout.
 		value.Type = "delete"
 		for _, v := range e.PreColumns {
-			switch v.Type {
-			case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
-				if v.Value == nil {
-					value.Old[v.Name] = nil
-				} else if v.Flag.IsBinary() {
-					value.Old[v.Name] = v.Value
-				} else {
-					value.Old[v.Name] = string(v.Value.([]byte))
-				}
-			default:
-				value.Old[v.Name] = v.Value
+			if v == nil {
+				continue
 			}
+			value.Old[v.Name] = internal.JSONColumnValue(v)
 		}
-	} else {
-		for _, v := range e.Columns {
-			switch v.Type {
-			case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
-				if v.Value == nil {
-					value.Data[v.Name] = nil
-				} else if v.Flag.IsBinary() {
-					value.Data[v.Name] = v.Value
-				} else {
-					value.Data[v.Name] = string(v.Value.([]byte))
-				}
-			default:
-				value.Data[v.Name] = v.Value
-			}
+		return key, value
+	}
+
+	for _, v := range e.Columns {
+		if v == nil {
+			continue
 		}
-		if e.PreColumns == nil {
-			value.Type = "insert"
-		} else {
-			value.Type = "update"
-			for _, v := range e.PreColumns {
-				switch v.Type {
-				case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
-					if v.Value == nil {
-						if value.Data[v.Name] != nil {
-							value.Old[v.Name] = nil
-						}
-					} else if v.Flag.IsBinary() {
-						if value.Data[v.Name] != v.Value {
-							value.Old[v.Name] = v.Value
-						}
-					} else {
-						if value.Data[v.Name] != string(v.Value.([]byte)) {
-							value.Old[v.Name] = string(v.Value.([]byte))
-						}
-					}
-				default:
-					if value.Data[v.Name] != v.Value {
-						value.Old[v.Name] = v.Value
-					}
-				}
-			}
+		value.Data[v.Name] = internal.JSONColumnValue(v)
+	}
+	if len(e.PreColumns) == 0 {
+		value.Type = "insert"
+		return key, value
+	}
 
+	// `old` only contains the previous values of the updated columns.
+	value.Type = "update"
+	for _, v := range e.PreColumns {
+		if v == nil {
+			continue
+		}
+		oldValue := internal.JSONColumnValue(v)
+		if !codec.IsColumnValueEqual(oldValue, value.Data[v.Name]) {
+			value.Old[v.Name] = oldValue
 		}
 	}
 	return key, value
 }
 
+// splitHandleKeyUpdate splits the update event which changes the handle key
+// into a delete event and an insert event, so that the consumers which cache
+// rows by the primary key can invalidate the old row. Other events are
+// returned as they are.
+func splitHandleKeyUpdate(e *model.RowChangedEvent) []*model.RowChangedEvent {
+	if !e.IsUpdate() || !isHandleKeyUpdated(e) {
+		return []*model.RowChangedEvent{e}
+	}
+	deleteEvent := *e
+	deleteEvent.Columns = nil
+	insertEvent := *e
+	insertEvent.PreColumns = nil
+	return []*model.RowChangedEvent{&deleteEvent, &insertEvent}
+}
+
+func isHandleKeyUpdated(e *model.RowChangedEvent) bool {
+	if len(e.Columns) != len(e.PreColumns) {
+		return false
+	}
+	for i, col := range e.Columns {
+		preCol := e.PreColumns[i]
+		if col == nil || preCol == nil || !col.Flag.IsHandleKey() {
+			continue
+		}
+		if model.ColumnValueString(col.Value) != model.ColumnValueString(preCol.Value) {
+			return true
+		}
+	}
+	return false
+}
+
 // maxwellColumn represents a column in maxwell
 type maxwellColumn struct {
 	Type string `json:"type"`
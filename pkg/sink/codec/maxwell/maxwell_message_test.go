@@ -58,3 +58,48 @@ func TestEncodeBinaryToMaxwell(t *testing.T) {
 	require.NotNil(t, key)
 	require.NotNil(t, msg)
 }
+
+func TestMaxwellOldValue(t *testing.T) {
+	t.Parallel()
+
+	e := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+			{Name: "avatar", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1}},
+		},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tikv")},
+			{Name: "avatar", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1}},
+		},
+	}
+	_, msg := rowChangeToMaxwellMsg(e)
+	require.Equal(t, "update", msg.Type)
+	require.Equal(t, "tikv", msg.Data["name"])
+	require.Equal(t, map[string]interface{}{"name": "tidb"}, msg.Old)
+	require.Len(t, splitHandleKeyUpdate(e), 1)
+
+	// the deleted row is carried by `old`.
+	deleteEvent := *e
+	deleteEvent.Columns = nil
+	_, msg = rowChangeToMaxwellMsg(&deleteEvent)
+	require.Equal(t, "delete", msg.Type)
+	require.Equal(t, "tidb", msg.Old["name"])
+	require.Empty(t, msg.Data)
+
+	// the update event which changes the handle key is split.
+	e.Columns[0] = &model.Column{
+		Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(2),
+	}
+	events := splitHandleKeyUpdate(e)
+	require.Len(t, events, 2)
+	_, msg = rowChangeToMaxwellMsg(events[0])
+	require.Equal(t, "delete", msg.Type)
+	require.Equal(t, int64(1), msg.Old["id"])
+	_, msg = rowChangeToMaxwellMsg(events[1])
+	require.Equal(t, "insert", msg.Type)
+	require.Equal(t, int64(2), msg.Data["id"])
+}
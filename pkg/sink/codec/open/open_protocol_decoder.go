@@ -77,6 +77,9 @@ func (b *BatchMixedDecoder) NextRowChangedEvent() (*model.RowChangedEvent, error
 	if err := rowMsg.decode(value); err != nil {
 		return nil, errors.Trace(err)
 	}
+	if b.requireChecksum && rowMsg.Checksum == nil {
+		return nil, cerror.ErrCodecRowChecksumMissing.GenWithStackByArgs()
+	}
 	rowEvent := msgToRowChange(b.nextKey, rowMsg)
 	b.nextKey = nil
 	return rowEvent, nil
@@ -129,6 +132,10 @@ type BatchDecoder struct {
 	valueBytes []byte
 	nextKey    *internal.MessageKey
 	nextKeyLen uint64
+
+	// requireChecksum fails the row changed event messages which do not
+	// carry the row checksum.
+	requireChecksum bool
 }
 
 // HasNext implements the RowEventDecoder interface
@@ -229,6 +236,13 @@ func NewBatchDecoder() codec.RowEventDecoder {
 
 }
 
+// NewBatchDecoderWithChecksum creates a new BatchDecoder which fails if a row
+// changed event message does not carry the row checksum, so that a message
+// encoded without the checksum is not taken as a verified one.
+func NewBatchDecoderWithChecksum() codec.RowEventDecoder {
+	return &BatchDecoder{requireChecksum: true}
+}
+
 // AddKeyValue implements the RowEventDecoder interface
 func (b *BatchDecoder) AddKeyValue(key, value []byte) error {
 	if len(b.keyBytes) != 0 || len(b.valueBytes) != 0 {
@@ -237,9 +251,9 @@ func (b *BatchDecoder) AddKeyValue(key, value []byte) error {
 	}
 	version := binary.BigEndian.Uint64(key[:8])
 	key = key[8:]
-	if version != codec.BatchVersion1 {
+	if version != codec.BatchVersion1 && version != codec.BatchVersion2 {
 		return cerror.ErrOpenProtocolCodecInvalidData.
-			GenWithStack("unexpected key format version")
+			GenWithStack("unexpected key format version %d", version)
 	}
 
 	b.keyBytes = key
@@ -257,9 +271,9 @@ func (b *BatchMixedDecoder) AddKeyValue(key, value []byte) error {
 	}
 	version := binary.BigEndian.Uint64(key[:8])
 	key = key[8:]
-	if version != codec.BatchVersion1 {
+	if version != codec.BatchVersion1 && version != codec.BatchVersion2 {
 		return cerror.ErrOpenProtocolCodecInvalidData.
-			GenWithStack("unexpected key format version")
+			GenWithStack("unexpected key format version %d", version)
 	}
 
 	b.mixedBytes = key
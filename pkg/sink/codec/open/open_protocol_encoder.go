@@ -38,6 +38,11 @@ type BatchEncoder struct {
 	MaxMessageBytes          int
 	MaxBatchSize             int
 	OnlyOutputUpdatedColumns bool
+	EnableMessageChunking    bool
+	EnableEncodingChecksum   bool
+	// Version is the batch format version written in the message key,
+	// the version 2 carries the full type information of columns.
+	Version uint64
 }
 
 // AppendRowChangedEvent implements the RowEventEncoder interface
@@ -47,7 +52,10 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	e *model.RowChangedEvent,
 	callback func(),
 ) error {
-	keyMsg, valueMsg := rowChangeToMsg(e)
+	keyMsg, valueMsg := rowChangeToMsg(e, d.Version)
+	if d.EnableEncodingChecksum {
+		valueMsg.fillChecksum(common.SchemaVersion(e))
+	}
 	key, err := keyMsg.Encode()
 	if err != nil {
 		return errors.Trace(err)
@@ -65,6 +73,9 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	// for single message that is longer than max-message-bytes, do not send it.
 	// 16 is the length of `keyLenByte` and `valueLenByte`, 8 is the length of `versionHead`
 	length := len(key) + len(value) + common.MaxRecordOverhead + 16 + 8
+	if length > d.MaxMessageBytes && d.EnableMessageChunking {
+		return d.appendChunks(e, key, value, keyLenByte[:], valueLenByte[:], callback)
+	}
 	if length > d.MaxMessageBytes {
 		log.Warn("Single message is too large for open-protocol",
 			zap.Int("maxMessageBytes", d.MaxMessageBytes),
@@ -77,11 +88,12 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 
 	if len(d.messageBuf) == 0 ||
 		d.curBatchSize >= d.MaxBatchSize ||
-		d.messageBuf[len(d.messageBuf)-1].Length()+len(key)+len(value)+16 > d.MaxMessageBytes {
+		d.messageBuf[len(d.messageBuf)-1].Length()+len(key)+len(value)+16 > d.MaxMessageBytes ||
+		common.IsChunk(d.messageBuf[len(d.messageBuf)-1].Value) {
 		// Before we create a new message, we should handle the previous callbacks.
 		d.tryBuildCallback()
 		versionHead := make([]byte, 8)
-		binary.BigEndian.PutUint64(versionHead, codec.BatchVersion1)
+		binary.BigEndian.PutUint64(versionHead, d.Version)
 		msg := common.NewMsg(config.ProtocolOpen, versionHead, nil,
 			0, model.MessageTypeRow, nil, nil)
 		d.messageBuf = append(d.messageBuf, msg)
@@ -106,6 +118,45 @@ func (d *BatchEncoder) AppendRowChangedEvent(
 	return nil
 }
 
+// appendChunks encodes the row changed event into a standalone message, and
+// splits it into chunks since it is larger than max-message-bytes.
+func (d *BatchEncoder) appendChunks(
+	e *model.RowChangedEvent,
+	key, value, keyLenByte, valueLenByte []byte,
+	callback func(),
+) error {
+	// the callbacks of the previous rows belong to the previous message.
+	d.tryBuildCallback()
+
+	keyBuf := new(bytes.Buffer)
+	var versionByte [8]byte
+	binary.BigEndian.PutUint64(versionByte[:], d.Version)
+	keyBuf.Write(versionByte[:])
+	keyBuf.Write(keyLenByte)
+	keyBuf.Write(key)
+
+	valueBuf := new(bytes.Buffer)
+	valueBuf.Write(valueLenByte)
+	valueBuf.Write(value)
+
+	msg := common.NewMsg(config.ProtocolOpen, keyBuf.Bytes(), valueBuf.Bytes(),
+		e.CommitTs, model.MessageTypeRow, &e.Table.Schema, &e.Table.Table)
+	msg.Callback = callback
+	msg.IncRowsCount()
+	chunks, err := common.SplitIntoChunks(msg, d.MaxMessageBytes)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	log.Debug("Single message is split into chunks for open-protocol",
+		zap.Int("maxMessageBytes", d.MaxMessageBytes),
+		zap.Int("length", msg.Length()),
+		zap.Int("chunks", len(chunks)),
+		zap.Any("table", e.Table))
+	d.messageBuf = append(d.messageBuf, chunks...)
+	d.curBatchSize = 0
+	return nil
+}
+
 // EncodeDDLEvent implements the RowEventEncoder interface
 func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
 	keyMsg, valueMsg := ddlEventToMsg(e)
@@ -125,7 +176,7 @@ func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error
 
 	keyBuf := new(bytes.Buffer)
 	var versionByte [8]byte
-	binary.BigEndian.PutUint64(versionByte[:], codec.BatchVersion1)
+	binary.BigEndian.PutUint64(versionByte[:], d.Version)
 	keyBuf.Write(versionByte[:])
 	keyBuf.Write(keyLenByte[:])
 	keyBuf.Write(key)
@@ -153,7 +204,7 @@ func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error)
 
 	keyBuf := new(bytes.Buffer)
 	var versionByte [8]byte
-	binary.BigEndian.PutUint64(versionByte[:], codec.BatchVersion1)
+	binary.BigEndian.PutUint64(versionByte[:], d.Version)
 	keyBuf.Write(versionByte[:])
 	keyBuf.Write(keyLenByte[:])
 	keyBuf.Write(key)
@@ -197,6 +248,9 @@ func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
 	encoder.(*BatchEncoder).MaxMessageBytes = b.config.MaxMessageBytes
 	encoder.(*BatchEncoder).MaxBatchSize = b.config.MaxBatchSize
 	encoder.(*BatchEncoder).OnlyOutputUpdatedColumns = b.config.OnlyOutputUpdatedColumns
+	encoder.(*BatchEncoder).Version = b.config.OpenProtocolVersion
+	encoder.(*BatchEncoder).EnableMessageChunking = b.config.EnableMessageChunking
+	encoder.(*BatchEncoder).EnableEncodingChecksum = b.config.EnableEncodingChecksum
 
 	return encoder
 }
@@ -208,6 +262,6 @@ func NewBatchEncoderBuilder(config *common.Config) codec.RowEventEncoderBuilder
 
 // NewBatchEncoder creates a new BatchEncoder.
 func NewBatchEncoder() codec.RowEventEncoder {
-	batch := &BatchEncoder{}
+	batch := &BatchEncoder{Version: codec.BatchVersion1}
 	return batch
 }
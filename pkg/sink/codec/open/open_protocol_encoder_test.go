@@ -15,11 +15,15 @@ package open
 
 import (
 	"context"
+	"encoding/binary"
 	"testing"
 
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
 	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
@@ -215,3 +219,116 @@ func TestOpenProtocolBatchCodec(t *testing.T) {
 			return decoder, err
 		})
 }
+
+func TestOpenProtocolVersion2(t *testing.T) {
+	t.Parallel()
+
+	ft := types.NewFieldType(mysql.TypeNewDecimal)
+	ft.SetFlen(10)
+	ft.SetDecimal(2)
+	event := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "a", Table: "b"},
+		Columns: []*model.Column{
+			{
+				Name:  "id",
+				Type:  mysql.TypeLonglong,
+				Flag:  model.HandleKeyFlag | model.UnsignedFlag,
+				Value: uint64(1),
+			},
+			{
+				Name:    "price",
+				Type:    mysql.TypeNewDecimal,
+				Charset: "binary",
+				Flag:    model.NullableFlag,
+				Value:   "1.23",
+			},
+		},
+		ColInfos: []rowcodec.ColInfo{
+			{ID: 1, Ft: types.NewFieldType(mysql.TypeLonglong)},
+			{ID: 2, Ft: ft},
+		},
+	}
+
+	codecConfig := common.NewConfig(config.ProtocolOpen)
+	codecConfig.OpenProtocolVersion = codec.BatchVersion2
+	encoder := NewBatchEncoderBuilder(codecConfig).Build()
+	err := encoder.AppendRowChangedEvent(context.Background(), "", event, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	require.Equal(t, codec.BatchVersion2, binary.BigEndian.Uint64(messages[0].Key[:8]))
+
+	_, value := rowChangeToMsg(event, codec.BatchVersion2)
+	require.Equal(t, 10, value.Meta["price"].Length)
+	require.Equal(t, 2, value.Meta["price"].Decimal)
+	require.True(t, value.Meta["id"].Unsigned)
+	require.True(t, value.Meta["price"].Nullable)
+
+	decoder := NewBatchDecoder()
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	tp, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeRow, tp)
+	decoded, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Len(t, decoded.ColInfos, len(decoded.Columns))
+	for i, col := range decoded.Columns {
+		ft := decoded.ColInfos[i].Ft
+		switch col.Name {
+		case "price":
+			require.Equal(t, "binary", col.Charset)
+			require.True(t, col.Flag.IsNullable())
+			require.Equal(t, 10, ft.GetFlen())
+			require.Equal(t, 2, ft.GetDecimal())
+			require.Equal(t, "binary", ft.GetCharset())
+		case "id":
+			require.True(t, col.Flag.IsUnsigned())
+			require.True(t, mysql.HasUnsignedFlag(ft.GetFlag()))
+			require.True(t, mysql.HasNotNullFlag(ft.GetFlag()))
+		}
+	}
+
+	// the version 1 does not carry the type information.
+	_, value = rowChangeToMsg(event, codec.BatchVersion1)
+	require.Nil(t, value.Meta)
+}
+
+func TestDecoderWithChecksumRequiresChecksum(t *testing.T) {
+	t.Parallel()
+
+	event := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "a", Table: "b"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(1)},
+		},
+	}
+
+	for _, enableChecksum := range []bool{true, false} {
+		codecConfig := common.NewConfig(config.ProtocolOpen)
+		codecConfig.EnableEncodingChecksum = enableChecksum
+		encoder := NewBatchEncoderBuilder(codecConfig).Build()
+		err := encoder.AppendRowChangedEvent(context.Background(), "", event, nil)
+		require.NoError(t, err)
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+
+		decoder := NewBatchDecoderWithChecksum()
+		err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		tp, hasNext, err := decoder.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, tp)
+		_, err = decoder.NextRowChangedEvent()
+		if enableChecksum {
+			require.NoError(t, err)
+		} else {
+			require.True(t, cerror.ErrCodecRowChecksumMissing.Equal(err))
+		}
+	}
+}
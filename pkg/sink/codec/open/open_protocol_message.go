@@ -20,9 +20,13 @@ import (
 	"strings"
 
 	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
 	"github.com/pingcap/tiflow/cdc/model"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 )
 
@@ -30,6 +34,26 @@ type messageRow struct {
 	Update     map[string]internal.Column `json:"u,omitempty"`
 	PreColumns map[string]internal.Column `json:"p,omitempty"`
 	Delete     map[string]internal.Column `json:"d,omitempty"`
+	// Meta is only set in the batch format version 2.
+	Meta map[string]*columnMeta `json:"m,omitempty"`
+	// SchemaVersion and Checksum are only set if the encoding checksum is enabled,
+	// the checksum is calculated over the schema version and the columns of
+	// `Update` or `Delete`.
+	SchemaVersion uint64 `json:"sv,omitempty"`
+	// Checksum is nil if the message does not carry one.
+	Checksum *uint32 `json:"ck,omitempty"`
+}
+
+// columnMeta is the type information of a column, so that the consumer
+// does not have to guess the column type from the JSON value.
+type columnMeta struct {
+	Charset   string   `json:"cs,omitempty"`
+	Length    int      `json:"l"`
+	Decimal   int      `json:"d"`
+	Elems     []string `json:"e,omitempty"`
+	Unsigned  bool     `json:"us,omitempty"`
+	Nullable  bool     `json:"n,omitempty"`
+	Generated bool     `json:"g,omitempty"`
 }
 
 func (m *messageRow) encode(outputOnlyUpdatedColumn bool) ([]byte, error) {
@@ -70,9 +94,42 @@ func (m *messageRow) decode(data []byte) error {
 	for colName, column := range m.PreColumns {
 		m.PreColumns[colName] = internal.FormatColumn(column)
 	}
+	if m.Checksum != nil {
+		values, oldValues := m.checksumValues()
+		return common.VerifyRowChecksum(m.SchemaVersion, values, oldValues, *m.Checksum)
+	}
 	return nil
 }
 
+// fillChecksum calculates the row checksum and sets it to the message.
+func (m *messageRow) fillChecksum(schemaVersion uint64) {
+	m.SchemaVersion = schemaVersion
+	values, oldValues := m.checksumValues()
+	checksum := common.RowChecksum(schemaVersion, values, oldValues)
+	m.Checksum = &checksum
+}
+
+// checksumValues returns the values of the row and the old values of an
+// update, which are covered by the row checksum.
+func (m *messageRow) checksumValues() (map[string]interface{}, map[string]interface{}) {
+	columns := m.Update
+	if len(m.Delete) != 0 {
+		columns = m.Delete
+	}
+	return columnValues(columns), columnValues(m.PreColumns)
+}
+
+func columnValues(columns map[string]internal.Column) map[string]interface{} {
+	if len(columns) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(columns))
+	for name, column := range columns {
+		values[name] = column.Value
+	}
+	return values
+}
+
 type messageDDL struct {
 	Query string             `json:"q"`
 	Type  timodel.ActionType `json:"t"`
@@ -94,7 +151,9 @@ func newResolvedMessage(ts uint64) *internal.MessageKey {
 	}
 }
 
-func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow) {
+func rowChangeToMsg(
+	e *model.RowChangedEvent, version uint64,
+) (*internal.MessageKey, *messageRow) {
 	var partition *int64
 	if e.Table.IsPartition {
 		partition = &e.Table.TableID
@@ -114,6 +173,13 @@ func rowChangeToMsg(e *model.RowChangedEvent) (*internal.MessageKey, *messageRow
 		value.Update = rowChangeColumns2CodecColumns(e.Columns)
 		value.PreColumns = rowChangeColumns2CodecColumns(e.PreColumns)
 	}
+	if version == codec.BatchVersion2 {
+		if e.IsDelete() {
+			value.Meta = rowChangeColumns2ColumnsMeta(e.PreColumns, e.ColInfos)
+		} else {
+			value.Meta = rowChangeColumns2ColumnsMeta(e.Columns, e.ColInfos)
+		}
+	}
 	return key, value
 }
 
@@ -138,9 +204,63 @@ func msgToRowChange(key *internal.MessageKey, value *messageRow) *model.RowChang
 		e.Columns = codecColumns2RowChangeColumns(value.Update)
 		e.PreColumns = codecColumns2RowChangeColumns(value.PreColumns)
 	}
+	if len(value.Meta) != 0 {
+		columnsMeta2RowChangeColumns(e.Columns, value.Meta)
+		columnsMeta2RowChangeColumns(e.PreColumns, value.Meta)
+		// the column infos are aligned with the columns which carry the row.
+		if len(e.Columns) != 0 {
+			e.ColInfos = columnsMeta2ColInfos(e.Columns, value.Meta)
+		} else {
+			e.ColInfos = columnsMeta2ColInfos(e.PreColumns, value.Meta)
+		}
+	}
 	return e
 }
 
+// columnsMeta2RowChangeColumns restores the charset and the flags of the
+// columns from the type information.
+func columnsMeta2RowChangeColumns(cols []*model.Column, metas map[string]*columnMeta) {
+	for _, col := range cols {
+		meta, ok := metas[col.Name]
+		if !ok || meta == nil {
+			continue
+		}
+		col.Charset = meta.Charset
+		if meta.Unsigned {
+			col.Flag.SetIsUnsigned()
+		}
+		if meta.Nullable {
+			col.Flag.SetIsNullable()
+		}
+		if meta.Generated {
+			col.Flag.SetIsGeneratedColumn()
+		}
+	}
+}
+
+// columnsMeta2ColInfos builds the column infos aligned with the columns from
+// the type information.
+func columnsMeta2ColInfos(cols []*model.Column, metas map[string]*columnMeta) []rowcodec.ColInfo {
+	colInfos := make([]rowcodec.ColInfo, 0, len(cols))
+	for _, col := range cols {
+		ft := types.NewFieldType(col.Type)
+		if meta, ok := metas[col.Name]; ok && meta != nil {
+			ft.SetFlen(meta.Length)
+			ft.SetDecimal(meta.Decimal)
+			ft.SetElems(meta.Elems)
+			ft.SetCharset(meta.Charset)
+			if meta.Unsigned {
+				ft.AddFlag(mysql.UnsignedFlag)
+			}
+			if !meta.Nullable {
+				ft.AddFlag(mysql.NotNullFlag)
+			}
+		}
+		colInfos = append(colInfos, rowcodec.ColInfo{Ft: ft})
+	}
+	return colInfos
+}
+
 func rowChangeColumns2CodecColumns(cols []*model.Column) map[string]internal.Column {
 	jsonCols := make(map[string]internal.Column, len(cols))
 	for _, col := range cols {
@@ -157,6 +277,42 @@ func rowChangeColumns2CodecColumns(cols []*model.Column) map[string]internal.Col
 	return jsonCols
 }
 
+// rowChangeColumns2ColumnsMeta builds the type information of the columns,
+// colInfos is expected to be aligned with the columns, if not, only the
+// information carried by the column flags is set.
+func rowChangeColumns2ColumnsMeta(
+	cols []*model.Column, colInfos []rowcodec.ColInfo,
+) map[string]*columnMeta {
+	metas := make(map[string]*columnMeta, len(cols))
+	for i, col := range cols {
+		if col == nil {
+			continue
+		}
+		meta := &columnMeta{
+			Charset:   col.Charset,
+			Length:    types.UnspecifiedLength,
+			Decimal:   types.UnspecifiedLength,
+			Unsigned:  col.Flag.IsUnsigned(),
+			Nullable:  col.Flag.IsNullable(),
+			Generated: col.Flag.IsGeneratedColumn(),
+		}
+		if len(colInfos) == len(cols) && colInfos[i].Ft != nil {
+			ft := colInfos[i].Ft
+			meta.Length = ft.GetFlen()
+			meta.Decimal = ft.GetDecimal()
+			meta.Elems = ft.GetElems()
+			if meta.Charset == "" {
+				meta.Charset = ft.GetCharset()
+			}
+		}
+		metas[col.Name] = meta
+	}
+	if len(metas) == 0 {
+		return nil
+	}
+	return metas
+}
+
 func codecColumns2RowChangeColumns(cols map[string]internal.Column) []*model.Column {
 	sinkCols := make([]*model.Column, 0, len(cols))
 	for name, col := range cols {
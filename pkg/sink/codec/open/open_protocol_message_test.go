@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -159,3 +160,68 @@ func TestOnlyOutputUpdatedColumn(t *testing.T) {
 		assert.Equal(t, cs.output, ok)
 	}
 }
+
+func TestMessageRowChecksum(t *testing.T) {
+	t.Parallel()
+
+	event := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "a", Table: "b"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+			{Name: "avatar", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1, 0x2}},
+			{Name: "score", Type: mysql.TypeDouble, Value: float64(1.5)},
+			{Name: "comment", Type: mysql.TypeVarchar, Value: nil},
+		},
+	}
+	_, row := rowChangeToMsg(event, 1)
+	row.fillChecksum(100)
+	require.Equal(t, uint64(100), row.SchemaVersion)
+	require.NotNil(t, row.Checksum)
+
+	data, err := row.encode(false)
+	require.NoError(t, err)
+	decoded := new(messageRow)
+	require.NoError(t, decoded.decode(data))
+
+	// the corrupted value is detected by the decoder.
+	row.Update["name"] = internal.Column{Type: mysql.TypeVarchar, Value: "tidc"}
+	data, err = row.encode(false)
+	require.NoError(t, err)
+	decoded = new(messageRow)
+	require.Error(t, decoded.decode(data))
+}
+
+func TestMessageRowChecksumCoversPreColumns(t *testing.T) {
+	t.Parallel()
+
+	event := &model.RowChangedEvent{
+		CommitTs:  1,
+		Table:     &model.TableName{Schema: "a", Table: "b"},
+		TableInfo: &model.TableInfo{Version: 100},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+		},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tikv")},
+		},
+	}
+	_, row := rowChangeToMsg(event, 1)
+	row.fillChecksum(100)
+
+	data, err := row.encode(false)
+	require.NoError(t, err)
+	decoded := new(messageRow)
+	require.NoError(t, decoded.decode(data))
+
+	// the corrupted old value is detected by the decoder.
+	row.PreColumns["name"] = internal.Column{Type: mysql.TypeVarchar, Value: "tikw"}
+	data, err = row.encode(false)
+	require.NoError(t, err)
+	decoded = new(messageRow)
+	require.True(t, cerror.ErrCodecRowChecksumMismatch.Equal(decoded.decode(data)))
+}
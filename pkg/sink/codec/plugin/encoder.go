@@ -0,0 +1,117 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+	"go.uber.org/zap"
+)
+
+// BatchEncoder encodes the events by the RowEncoder of an encoder plugin.
+// Each row changed event is encoded into a standalone message.
+type BatchEncoder struct {
+	*internal.RowMessages
+	encoder RowEncoder
+}
+
+// EncodeCheckpointEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	key, value, err := d.encoder.EncodeCheckpointEvent(ts)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginEncodeFailed, err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return common.NewResolvedMsg(config.ProtocolPlugin, key, value, ts), nil
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	key, value, err := d.encoder.EncodeRowChangedEvent(e)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrEncoderPluginEncodeFailed, err)
+	}
+	if value == nil {
+		if callback != nil {
+			callback()
+		}
+		return nil
+	}
+	return d.Append(key, value, e, callback)
+}
+
+// EncodeDDLEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	key, value, err := d.encoder.EncodeDDLEvent(e)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginEncodeFailed, err)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	return common.NewDDLMsg(config.ProtocolPlugin, key, value, e), nil
+}
+
+type batchEncoderBuilder struct {
+	config     *common.Config
+	newEncoder NewRowEncoderFunc
+}
+
+// NewBatchEncoderBuilder loads the encoder plugin of the config and creates
+// a batchEncoderBuilder. An encoder is created once to make sure that the
+// plugin accepts the params, so an invalid plugin fails the changefeed
+// creation instead of the replication.
+func NewBatchEncoderBuilder(c *common.Config) (codec.RowEventEncoderBuilder, error) {
+	newEncoder, err := Load(c.EncoderPlugin)
+	if err != nil {
+		return nil, err
+	}
+	encoder, err := newEncoder(c.EncoderPluginParams)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginInvalid, err, c.EncoderPlugin)
+	}
+	if encoder == nil {
+		return nil, cerror.ErrEncoderPluginInvalid.GenWithStack(
+			"encoder plugin %s: %s returns a nil encoder", c.EncoderPlugin, newEncoderSymbol)
+	}
+	return &batchEncoderBuilder{config: c, newEncoder: newEncoder}, nil
+}
+
+// Build a BatchEncoder
+func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
+	encoder, err := b.newEncoder(b.config.EncoderPluginParams)
+	if err != nil || encoder == nil {
+		// the plugin has been checked when the builder is created.
+		log.Panic("create encoder by the plugin failed",
+			zap.String("plugin", b.config.EncoderPlugin), zap.Error(err))
+	}
+	return &BatchEncoder{
+		RowMessages: internal.NewRowMessages(config.ProtocolPlugin, b.config.MaxMessageBytes),
+		encoder:     encoder,
+	}
+}
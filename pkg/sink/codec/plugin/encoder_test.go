@@ -0,0 +1,122 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRowEncoder struct {
+	prefix string
+}
+
+func (m *mockRowEncoder) EncodeRowChangedEvent(e *model.RowChangedEvent) ([]byte, []byte, error) {
+	if e.IsDelete() {
+		// deleted rows are skipped by this encoder.
+		return nil, nil, nil
+	}
+	if e.Table.Table == "bad" {
+		return nil, nil, errors.New("bad table")
+	}
+	return []byte(e.Table.Table), []byte(fmt.Sprintf("%s-%d", m.prefix, e.CommitTs)), nil
+}
+
+func (m *mockRowEncoder) EncodeDDLEvent(e *model.DDLEvent) ([]byte, []byte, error) {
+	return nil, []byte(e.Query), nil
+}
+
+func (m *mockRowEncoder) EncodeCheckpointEvent(ts uint64) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func newMockRowEncoder(params map[string]string) (RowEncoder, error) {
+	return &mockRowEncoder{prefix: params["prefix"]}, nil
+}
+
+func TestPluginBatchEncoder(t *testing.T) {
+	t.Parallel()
+
+	c := common.NewConfig(config.ProtocolPlugin)
+	c.EncoderPluginParams = map[string]string{"prefix": "row"}
+	encoder := (&batchEncoderBuilder{config: c, newEncoder: newMockRowEncoder}).Build()
+
+	row := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: 1},
+		},
+	}
+	err := encoder.AppendRowChangedEvent(context.Background(), "", row, nil)
+	require.NoError(t, err)
+
+	called := false
+	deleted := &model.RowChangedEvent{
+		CommitTs: 2,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: 1},
+		},
+	}
+	err = encoder.AppendRowChangedEvent(context.Background(), "", deleted, func() { called = true })
+	require.NoError(t, err)
+	require.True(t, called)
+
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	require.Equal(t, []byte("t"), messages[0].Key)
+	require.Equal(t, []byte("row-1"), messages[0].Value)
+	require.Equal(t, config.ProtocolPlugin, messages[0].Protocol)
+
+	bad := &model.RowChangedEvent{
+		CommitTs: 3,
+		Table:    &model.TableName{Schema: "test", Table: "bad"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: 1},
+		},
+	}
+	err = encoder.AppendRowChangedEvent(context.Background(), "", bad, nil)
+	require.True(t, cerror.ErrEncoderPluginEncodeFailed.Equal(err))
+
+	ddl, err := encoder.EncodeDDLEvent(&model.DDLEvent{
+		CommitTs:  4,
+		Query:     "create table t(id int)",
+		TableInfo: &model.TableInfo{TableName: model.TableName{Schema: "test", Table: "t"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("create table t(id int)"), ddl.Value)
+
+	checkpoint, err := encoder.EncodeCheckpointEvent(5)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+}
+
+func TestLoadInvalidPlugin(t *testing.T) {
+	t.Parallel()
+
+	c := common.NewConfig(config.ProtocolPlugin)
+	c.EncoderPlugin = "/path/to/not-exist.so"
+	_, err := NewBatchEncoderBuilder(c)
+	require.True(t, cerror.ErrEncoderPluginInvalid.Equal(err))
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	goplugin "plugin"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// APIVersion is the version of the encoder plugin interface. It is increased
+// whenever the RowEncoder interface is changed incompatibly, plugins built
+// against another version are rejected.
+const APIVersion = 1
+
+// An encoder plugin is a Go plugin built by `go build -buildmode=plugin`,
+// which exports the following symbols:
+//
+//	var EncoderPluginAPIVersion = plugin.APIVersion
+//	func NewRowEncoder(params map[string]string) (plugin.RowEncoder, error)
+//
+// params are the `encoder-plugin-params` of the codec config, so the plugin
+// can be configured without changing tiflow.
+const (
+	apiVersionSymbol = "EncoderPluginAPIVersion"
+	newEncoderSymbol = "NewRowEncoder"
+)
+
+// RowEncoder is the interface implemented by the encoder plugins. Each event
+// is encoded into a standalone message, a nil value means the event is not
+// sent to the downstream.
+type RowEncoder interface {
+	// EncodeRowChangedEvent encodes the row changed event into a message.
+	EncodeRowChangedEvent(e *model.RowChangedEvent) (key, value []byte, err error)
+	// EncodeDDLEvent encodes the DDL event into a message.
+	EncodeDDLEvent(e *model.DDLEvent) (key, value []byte, err error)
+	// EncodeCheckpointEvent encodes the checkpoint ts into a message, which is
+	// broadcast to all partitions.
+	EncodeCheckpointEvent(ts uint64) (key, value []byte, err error)
+}
+
+// NewRowEncoderFunc creates the RowEncoder of the plugin.
+type NewRowEncoderFunc func(params map[string]string) (RowEncoder, error)
+
+// Load opens the encoder plugin at path and validates its exported symbols.
+func Load(path string) (NewRowEncoderFunc, error) {
+	p, err := goplugin.Open(path)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginInvalid, err, path)
+	}
+
+	sym, err := p.Lookup(apiVersionSymbol)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginInvalid, err, path)
+	}
+	version, ok := sym.(*int)
+	if !ok {
+		return nil, cerror.ErrEncoderPluginInvalid.GenWithStack(
+			"encoder plugin %s: %s should be an int variable, but got %T",
+			path, apiVersionSymbol, sym)
+	}
+	if *version != APIVersion {
+		return nil, cerror.ErrEncoderPluginInvalid.GenWithStack(
+			"encoder plugin %s: api version %d is not supported, expected %d",
+			path, *version, APIVersion)
+	}
+
+	sym, err = p.Lookup(newEncoderSymbol)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrEncoderPluginInvalid, err, path)
+	}
+	newEncoder, ok := sym.(func(map[string]string) (RowEncoder, error))
+	if !ok {
+		return nil, cerror.ErrEncoderPluginInvalid.GenWithStack(
+			"encoder plugin %s: %s has unexpected type %T",
+			path, newEncoderSymbol, sym)
+	}
+	log.Info("encoder plugin loaded", zap.String("path", path),
+		zap.Int("apiVersion", *version))
+	return newEncoder, nil
+}
@@ -0,0 +1,428 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/contextutil"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// confluent wire format, the first byte is always 0
+	// https://docs.confluent.io/platform/current/schema-registry/fundamentals/serdes-develop/index.html#wire-format
+	magicByte = uint8(0)
+
+	// tidbOp and tidbCommitTs are appended to the value message
+	// when the TiDB extension is enabled.
+	tidbOp       = "_tidb_op"
+	tidbCommitTs = "_tidb_commit_ts"
+
+	insertOperation = "c"
+	updateOperation = "u"
+)
+
+// BatchEncoder converts the events to protobuf binary data,
+// framed in the Confluent Schema Registry wire format.
+type BatchEncoder struct {
+	namespace           string
+	enableTiDBExtension bool
+
+	keySchemaManager   *SchemaManager
+	valueSchemaManager *SchemaManager
+	result             []*common.Message
+}
+
+// protoField describes a field of the generated protobuf message.
+type protoField struct {
+	name   string
+	number protowire.Number
+	tp     string
+}
+
+// AppendRowChangedEvent appends a row change event to the encoder
+// NOTE: the encoder can only store one RowChangedEvent!
+func (p *BatchEncoder) AppendRowChangedEvent(
+	ctx context.Context,
+	topic string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	message := common.NewMsg(
+		config.ProtocolProtobuf,
+		nil,
+		nil,
+		e.CommitTs,
+		model.MessageTypeRow,
+		&e.Table.Schema,
+		&e.Table.Table,
+	)
+	message.Callback = callback
+
+	// the same as avro, a delete event is sent as a tombstone
+	// which only contains the key.
+	if !e.IsDelete() {
+		value, err := p.encode(ctx, e, topic, false)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		message.Value = value
+	}
+
+	key, err := p.encode(ctx, e, topic, true)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	message.Key = key
+	message.IncRowsCount()
+	p.result = append(p.result, message)
+	return nil
+}
+
+// EncodeCheckpointEvent is no-op, protobuf does not send checkpoint event.
+func (p *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return nil, nil
+}
+
+// EncodeDDLEvent is no-op, protobuf does not send DDL event,
+// the schema evolution is tracked by the schema registry.
+func (p *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	return nil, nil
+}
+
+// Build Messages
+func (p *BatchEncoder) Build() (messages []*common.Message) {
+	result := p.result
+	p.result = nil
+	return result
+}
+
+func (p *BatchEncoder) encode(
+	ctx context.Context,
+	e *model.RowChangedEvent,
+	topic string,
+	isKey bool,
+) ([]byte, error) {
+	var (
+		columns             []*model.Column
+		enableTiDBExtension bool
+		schemaManager       *SchemaManager
+		operation           string
+	)
+	if isKey {
+		columns = handleKeyColumns(e)
+		schemaManager = p.keySchemaManager
+	} else {
+		columns = e.Columns
+		enableTiDBExtension = p.enableTiDBExtension
+		schemaManager = p.valueSchemaManager
+		if e.IsInsert() {
+			operation = insertOperation
+		} else {
+			operation = updateOperation
+		}
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	fields, err := columnsToProtoFields(columns, enableTiDBExtension)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	schemaGen := func() (string, error) {
+		return fieldsToProtoSchema(
+			getProtoPackage(p.namespace, e.Table), sanitizeName(e.Table.Table), fields), nil
+	}
+	schemaID, err := schemaManager.GetCachedOrRegister(ctx, topic, e.TableInfo.Version, schemaGen)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	data, err := columnsToProtoData(columns, fields)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if enableTiDBExtension {
+		n := len(columns)
+		data = protowire.AppendTag(data, fields[n].number, protowire.BytesType)
+		data = protowire.AppendString(data, operation)
+		data = protowire.AppendTag(data, fields[n+1].number, protowire.VarintType)
+		data = protowire.AppendVarint(data, e.CommitTs)
+	}
+	return toEnvelope(schemaID, data), nil
+}
+
+// toEnvelope frames the data in the confluent wire format. The message
+// indexes array is encoded as a single 0, which stands for the first
+// message in the schema.
+func toEnvelope(schemaID int, data []byte) []byte {
+	buf := make([]byte, 0, len(data)+6)
+	buf = append(buf, magicByte)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(schemaID))
+	buf = append(buf, 0)
+	return append(buf, data...)
+}
+
+func columnsToProtoFields(columns []*model.Column, enableTiDBExtension bool) ([]*protoField, error) {
+	fields := make([]*protoField, 0, len(columns)+2)
+	for i, col := range columns {
+		tp, err := columnToProtoType(col)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		fields = append(fields, &protoField{
+			name:   sanitizeName(col.Name),
+			number: protowire.Number(i + 1),
+			tp:     tp,
+		})
+	}
+	if enableTiDBExtension {
+		n := protowire.Number(len(columns))
+		fields = append(fields,
+			&protoField{name: tidbOp, number: n + 1, tp: "string"},
+			&protoField{name: tidbCommitTs, number: n + 2, tp: "uint64"},
+		)
+	}
+	return fields, nil
+}
+
+// fieldsToProtoSchema generates the proto3 schema of a table. All fields are
+// declared `optional`, so that a NULL value can be told apart from the zero value.
+func fieldsToProtoSchema(pkg, name string, fields []*protoField) string {
+	var sb strings.Builder
+	sb.WriteString("syntax = \"proto3\";\n")
+	fmt.Fprintf(&sb, "package %s;\n\n", pkg)
+	fmt.Fprintf(&sb, "message %s {\n", name)
+	for _, f := range fields {
+		fmt.Fprintf(&sb, "  optional %s %s = %d;\n", f.tp, f.name, f.number)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func columnToProtoType(col *model.Column) (string, error) {
+	switch col.Type {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong,
+		mysql.TypeLonglong, mysql.TypeYear:
+		if col.Flag.IsUnsigned() {
+			return "uint64", nil
+		}
+		return "int64", nil
+	case mysql.TypeBit, mysql.TypeEnum, mysql.TypeSet:
+		return "uint64", nil
+	case mysql.TypeFloat:
+		return "float", nil
+	case mysql.TypeDouble:
+		return "double", nil
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if col.Flag.IsBinary() {
+			return "bytes", nil
+		}
+		return "string", nil
+	case mysql.TypeNewDecimal, mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp,
+		mysql.TypeDuration, mysql.TypeJSON:
+		return "string", nil
+	default:
+		log.Error("unknown mysql type", zap.Any("mysqlType", col.Type))
+		return "", cerror.ErrProtobufEncodeFailed.GenWithStack("unknown mysql type %d", col.Type)
+	}
+}
+
+func columnsToProtoData(columns []*model.Column, fields []*protoField) ([]byte, error) {
+	var data []byte
+	for i, col := range columns {
+		if col.Value == nil {
+			continue
+		}
+		var err error
+		data, err = appendProtoValue(data, fields[i], col)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return data, nil
+}
+
+func appendProtoValue(data []byte, field *protoField, col *model.Column) ([]byte, error) {
+	switch field.tp {
+	case "int64":
+		v, ok := toInt64(col.Value)
+		if !ok {
+			break
+		}
+		data = protowire.AppendTag(data, field.number, protowire.VarintType)
+		return protowire.AppendVarint(data, uint64(v)), nil
+	case "uint64":
+		v, ok := toUint64(col.Value)
+		if !ok {
+			break
+		}
+		data = protowire.AppendTag(data, field.number, protowire.VarintType)
+		return protowire.AppendVarint(data, v), nil
+	case "float":
+		v, ok := col.Value.(float32)
+		if !ok {
+			break
+		}
+		data = protowire.AppendTag(data, field.number, protowire.Fixed32Type)
+		return protowire.AppendFixed32(data, math.Float32bits(v)), nil
+	case "double":
+		v, ok := col.Value.(float64)
+		if !ok {
+			break
+		}
+		data = protowire.AppendTag(data, field.number, protowire.Fixed64Type)
+		return protowire.AppendFixed64(data, math.Float64bits(v)), nil
+	case "string", "bytes":
+		var b []byte
+		switch v := col.Value.(type) {
+		case []byte:
+			b = v
+		case string:
+			b = []byte(v)
+		default:
+			return nil, cerror.ErrProtobufEncodeFailed.GenWithStack(
+				"unexpected value %v for column %s", col.Value, col.Name)
+		}
+		data = protowire.AppendTag(data, field.number, protowire.BytesType)
+		return protowire.AppendBytes(data, b), nil
+	}
+	return nil, cerror.ErrProtobufEncodeFailed.GenWithStack(
+		"unexpected value %v for column %s", col.Value, col.Name)
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	return 0, false
+}
+
+func toUint64(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case uint:
+		return uint64(n), true
+	case uint8:
+		return uint64(n), true
+	case uint16:
+		return uint64(n), true
+	case uint32:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	}
+	if n, ok := toInt64(v); ok {
+		return uint64(n), true
+	}
+	return 0, false
+}
+
+func handleKeyColumns(e *model.RowChangedEvent) []*model.Column {
+	cols := e.Columns
+	if e.IsDelete() {
+		cols = e.PreColumns
+	}
+	result := make([]*model.Column, 0)
+	for _, col := range cols {
+		if col != nil && col.Flag.IsHandleKey() {
+			result = append(result, col)
+		}
+	}
+	return result
+}
+
+func getProtoPackage(namespace string, tableName *model.TableName) string {
+	return sanitizeName(namespace) + "." + sanitizeName(tableName.Schema)
+}
+
+// sanitizeName escapes chars not permitted in protobuf identifiers.
+// https://protobuf.dev/reference/protobuf/proto3-spec/#identifiers
+func sanitizeName(name string) string {
+	var sb strings.Builder
+	for i, c := range name {
+		if i == 0 && (c >= '0' && c <= '9') {
+			sb.WriteByte('_')
+			sb.WriteRune(c)
+		} else if !(c == '_' ||
+			('a' <= c && c <= 'z') ||
+			('A' <= c && c <= 'Z') ||
+			('0' <= c && c <= '9')) {
+			sb.WriteByte('_')
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+type batchEncoderBuilder struct {
+	namespace          string
+	config             *common.Config
+	keySchemaManager   *SchemaManager
+	valueSchemaManager *SchemaManager
+}
+
+// NewBatchEncoderBuilder creates a protobuf batchEncoderBuilder.
+func NewBatchEncoderBuilder(ctx context.Context,
+	config *common.Config,
+) (codec.RowEventEncoderBuilder, error) {
+	keySchemaManager, valueSchemaManager, err := NewKeyAndValueSchemaManagers(
+		ctx, config.AvroSchemaRegistry, config.SchemaRegistryCredential)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &batchEncoderBuilder{
+		namespace:          contextutil.ChangefeedIDFromCtx(ctx).Namespace,
+		config:             config,
+		keySchemaManager:   keySchemaManager,
+		valueSchemaManager: valueSchemaManager,
+	}, nil
+}
+
+// Build a protobuf BatchEncoder.
+func (b *batchEncoderBuilder) Build() codec.RowEventEncoder {
+	return &BatchEncoder{
+		namespace:           b.namespace,
+		enableTiDBExtension: b.config.EnableTiDBExtension,
+		keySchemaManager:    b.keySchemaManager,
+		valueSchemaManager:  b.valueSchemaManager,
+		result:              make([]*common.Message, 0, 1),
+	}
+}
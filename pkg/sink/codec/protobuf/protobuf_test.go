@@ -0,0 +1,165 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type mockRegistry struct {
+	mu       sync.Mutex
+	nextID   int
+	subjects map[string]string
+}
+
+func newMockRegistry(t *testing.T) *httptest.Server {
+	registry := &mockRegistry{nextID: 1, subjects: make(map[string]string)}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		var req struct {
+			Schema     string `json:"schema"`
+			SchemaType string `json:"schemaType"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, schemaTypeProtobuf, req.SchemaType)
+
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		subject := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/subjects/"), "/versions")
+		registry.subjects[subject] = req.Schema
+		id := registry.nextID
+		registry.nextID++
+		_ = json.NewEncoder(w).Encode(map[string]int{"id": id})
+	}))
+}
+
+func TestProtobufSchema(t *testing.T) {
+	t.Parallel()
+
+	columns := []*model.Column{
+		{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag},
+		{Name: "1st-name", Type: mysql.TypeVarchar},
+		{Name: "avatar", Type: mysql.TypeBlob, Flag: model.BinaryFlag},
+		{Name: "price", Type: mysql.TypeNewDecimal},
+		{Name: "cnt", Type: mysql.TypeLong, Flag: model.UnsignedFlag},
+	}
+	fields, err := columnsToProtoFields(columns, true)
+	require.NoError(t, err)
+	schema := fieldsToProtoSchema("default.test", "t", fields)
+	require.Equal(t, `syntax = "proto3";
+package default.test;
+
+message t {
+  optional int64 id = 1;
+  optional string _1st_name = 2;
+  optional bytes avatar = 3;
+  optional string price = 4;
+  optional uint64 cnt = 5;
+  optional string _tidb_op = 6;
+  optional uint64 _tidb_commit_ts = 7;
+}
+`, schema)
+}
+
+func TestProtobufEncode(t *testing.T) {
+	t.Parallel()
+
+	server := newMockRegistry(t)
+	defer server.Close()
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolProtobuf)
+	codecConfig.AvroSchemaRegistry = server.URL
+	codecConfig.EnableTiDBExtension = true
+	builder, err := NewBatchEncoderBuilder(ctx, codecConfig)
+	require.NoError(t, err)
+	encoder := builder.Build()
+
+	event := &model.RowChangedEvent{
+		CommitTs:  417318403368288260,
+		Table:     &model.TableName{Schema: "test", Table: "t"},
+		TableInfo: &model.TableInfo{Version: 1},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLonglong, Flag: model.HandleKeyFlag, Value: int64(-1)},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+			{Name: "score", Type: mysql.TypeDouble, Value: nil},
+		},
+	}
+	err = encoder.AppendRowChangedEvent(ctx, "topic", event, nil)
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	msg := messages[0]
+
+	// key: magic byte, schema id, message indexes
+	require.Equal(t, magicByte, msg.Key[0])
+	keySchemaID := binary.BigEndian.Uint32(msg.Key[1:5])
+	require.Equal(t, byte(0), msg.Key[5])
+	num, tp, n := protowire.ConsumeTag(msg.Key[6:])
+	require.Equal(t, protowire.Number(1), num)
+	require.Equal(t, protowire.VarintType, tp)
+	v, _ := protowire.ConsumeVarint(msg.Key[6+n:])
+	require.Equal(t, int64(-1), int64(v))
+
+	require.Equal(t, magicByte, msg.Value[0])
+	valueSchemaID := binary.BigEndian.Uint32(msg.Value[1:5])
+	require.NotEqual(t, keySchemaID, valueSchemaID)
+
+	decoded := make(map[protowire.Number][]byte)
+	data := msg.Value[6:]
+	for len(data) > 0 {
+		num, tp, n := protowire.ConsumeTag(data)
+		require.GreaterOrEqual(t, n, 0)
+		data = data[n:]
+		m := protowire.ConsumeFieldValue(num, tp, data)
+		require.GreaterOrEqual(t, m, 0)
+		decoded[num] = data[:m]
+		data = data[m:]
+	}
+	// the NULL column is omitted.
+	require.Len(t, decoded, 4)
+	name, _ := protowire.ConsumeString(decoded[2])
+	require.Equal(t, "tidb", name)
+	op, _ := protowire.ConsumeString(decoded[4])
+	require.Equal(t, insertOperation, op)
+	commitTs, _ := protowire.ConsumeVarint(decoded[5])
+	require.Equal(t, event.CommitTs, commitTs)
+
+	// delete event only contains the key.
+	event.PreColumns = event.Columns
+	event.Columns = nil
+	err = encoder.AppendRowChangedEvent(ctx, "topic", event, nil)
+	require.NoError(t, err)
+	messages = encoder.Build()
+	require.Len(t, messages, 1)
+	require.Nil(t, messages[0].Value)
+	require.Equal(t, keySchemaID, binary.BigEndian.Uint32(messages[0].Key[1:5]))
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protobuf
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/security"
+	"github.com/pingcap/tiflow/pkg/sink/codec/avro"
+)
+
+const (
+	keySchemaSuffix   = "-key"
+	valueSchemaSuffix = "-value"
+
+	schemaTypeProtobuf = "PROTOBUF"
+)
+
+// SchemaManager registers protobuf schemas to the Confluent Schema Registry,
+// and caches the schema ID of each subject by the table version.
+type SchemaManager struct {
+	client        *avro.RegistryClient
+	subjectSuffix string
+
+	cacheRWLock sync.RWMutex
+	cache       map[string]*schemaCacheEntry
+}
+
+type schemaCacheEntry struct {
+	// tableVersion is the table's version which the message associated with.
+	tableVersion uint64
+	// schemaID is the unique identifier of a schema in schema registry.
+	schemaID int
+}
+
+// NewKeyAndValueSchemaManagers create key and value schema managers respectively,
+// and test connectivity to the schema registry
+func NewKeyAndValueSchemaManagers(
+	ctx context.Context,
+	registryURL string,
+	credential *security.Credential,
+) (*SchemaManager, *SchemaManager, error) {
+	client, err := avro.NewRegistryClient(ctx, registryURL, credential)
+	if err != nil {
+		return nil, nil, cerror.WrapError(cerror.ErrProtobufSchemaAPIError, err)
+	}
+	keyManager := newSchemaManager(client, keySchemaSuffix)
+	valueManager := newSchemaManager(client, valueSchemaSuffix)
+	return keyManager, valueManager, nil
+}
+
+func newSchemaManager(client *avro.RegistryClient, subjectSuffix string) *SchemaManager {
+	return &SchemaManager{
+		client:        client,
+		subjectSuffix: subjectSuffix,
+		cache:         make(map[string]*schemaCacheEntry, 1),
+	}
+}
+
+// Register a protobuf schema in schema registry, no cache
+func (m *SchemaManager) Register(
+	ctx context.Context,
+	topicName string,
+	schema string,
+) (int, error) {
+	id, err := m.client.RegisterSchema(
+		ctx, m.topicNameToSchemaSubject(topicName), schema, schemaTypeProtobuf)
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrProtobufSchemaAPIError, err)
+	}
+	return id, nil
+}
+
+// SchemaGenerator represents a function that returns a protobuf schema.
+// Used for lazy evaluation
+type SchemaGenerator func() (string, error)
+
+// GetCachedOrRegister returns the schema ID of the topic for the given table version.
+// If it is not cached, a new schema is generated, registered and cached.
+func (m *SchemaManager) GetCachedOrRegister(
+	ctx context.Context,
+	topicName string,
+	tableVersion uint64,
+	schemaGen SchemaGenerator,
+) (int, error) {
+	key := m.topicNameToSchemaSubject(topicName)
+	m.cacheRWLock.RLock()
+	if entry, exists := m.cache[key]; exists && entry.tableVersion == tableVersion {
+		m.cacheRWLock.RUnlock()
+		return entry.schemaID, nil
+	}
+	m.cacheRWLock.RUnlock()
+
+	schema, err := schemaGen()
+	if err != nil {
+		return 0, err
+	}
+	id, err := m.Register(ctx, topicName, schema)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	m.cacheRWLock.Lock()
+	m.cache[key] = &schemaCacheEntry{tableVersion: tableVersion, schemaID: id}
+	m.cacheRWLock.Unlock()
+	return id, nil
+}
+
+// TopicNameStrategy, same as the avro protocol.
+func (m *SchemaManager) topicNameToSchemaSubject(topicName string) string {
+	return topicName + m.subjectSuffix
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+)
+
+// ProtocolRouter returns the protocol which the row changed event should be
+// encoded with, false is returned if the default protocol should be used.
+type ProtocolRouter func(row *model.RowChangedEvent) (config.Protocol, bool)
+
+// routedEncoder encodes the row changed events by the encoders of different
+// protocols, DDL and checkpoint events are encoded by the default encoder, the
+// DDL sink encodes them by the protocol of each topic instead.
+type routedEncoder struct {
+	defaultEncoder RowEventEncoder
+	// encoders and protocols keep the same order to build messages
+	// in a deterministic order.
+	protocols []config.Protocol
+	encoders  []RowEventEncoder
+	router    ProtocolRouter
+
+	// messages keeps the messages built by the encoders in the order of the
+	// appended row changed events, last is the encoder of the last appended
+	// row changed event, whose messages are not built yet.
+	messages []*common.Message
+	last     RowEventEncoder
+}
+
+// EncodeCheckpointEvent implements the RowEventEncoder interface
+func (e *routedEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return e.defaultEncoder.EncodeCheckpointEvent(ts)
+}
+
+// EncodeDDLEvent implements the RowEventEncoder interface
+func (e *routedEncoder) EncodeDDLEvent(ddl *model.DDLEvent) (*common.Message, error) {
+	return e.defaultEncoder.EncodeDDLEvent(ddl)
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (e *routedEncoder) AppendRowChangedEvent(
+	ctx context.Context,
+	topic string,
+	row *model.RowChangedEvent,
+	callback func(),
+) error {
+	encoder := e.encoderFor(row)
+	// Flush the messages of the previous encoder when the encoder is switched,
+	// so that the messages are built in the order of the row changed events.
+	if e.last != nil && e.last != encoder {
+		e.messages = append(e.messages, e.last.Build()...)
+	}
+	e.last = encoder
+	return encoder.AppendRowChangedEvent(ctx, topic, row, callback)
+}
+
+// Build implements the RowEventEncoder interface
+func (e *routedEncoder) Build() []*common.Message {
+	if e.last != nil {
+		e.messages = append(e.messages, e.last.Build()...)
+		e.last = nil
+	}
+	messages := e.messages
+	e.messages = nil
+	return messages
+}
+
+func (e *routedEncoder) encoderFor(row *model.RowChangedEvent) RowEventEncoder {
+	protocol, ok := e.router(row)
+	if !ok {
+		return e.defaultEncoder
+	}
+	for i, p := range e.protocols {
+		if p == protocol {
+			return e.encoders[i]
+		}
+	}
+	return e.defaultEncoder
+}
+
+type routedEncoderBuilder struct {
+	defaultBuilder RowEventEncoderBuilder
+	protocols      []config.Protocol
+	builders       []RowEventEncoderBuilder
+	router         ProtocolRouter
+}
+
+// NewRoutedEncoderBuilder creates a RowEventEncoderBuilder which builds encoders
+// routing each row changed event to the encoder of the protocol returned by the
+// router. protocols and builders should have the same length and order.
+func NewRoutedEncoderBuilder(
+	defaultBuilder RowEventEncoderBuilder,
+	protocols []config.Protocol,
+	builders []RowEventEncoderBuilder,
+	router ProtocolRouter,
+) RowEventEncoderBuilder {
+	return &routedEncoderBuilder{
+		defaultBuilder: defaultBuilder,
+		protocols:      protocols,
+		builders:       builders,
+		router:         router,
+	}
+}
+
+// Build a routedEncoder
+func (b *routedEncoderBuilder) Build() RowEventEncoder {
+	encoders := make([]RowEventEncoder, 0, len(b.builders))
+	for _, builder := range b.builders {
+		encoders = append(encoders, builder.Build())
+	}
+	return &routedEncoder{
+		defaultEncoder: b.defaultBuilder.Build(),
+		protocols:      b.protocols,
+		encoders:       encoders,
+		router:         b.router,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEncoder struct {
+	protocol config.Protocol
+	messages []*common.Message
+}
+
+func (e *mockEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return common.NewResolvedMsg(e.protocol, nil, nil, ts), nil
+}
+
+func (e *mockEncoder) EncodeDDLEvent(ddl *model.DDLEvent) (*common.Message, error) {
+	return common.NewDDLMsg(e.protocol, nil, nil, ddl), nil
+}
+
+func (e *mockEncoder) AppendRowChangedEvent(
+	_ context.Context, _ string, row *model.RowChangedEvent, _ func(),
+) error {
+	e.messages = append(e.messages, common.NewMsg(e.protocol, nil, nil,
+		row.CommitTs, model.MessageTypeRow, &row.Table.Schema, &row.Table.Table))
+	return nil
+}
+
+func (e *mockEncoder) Build() []*common.Message {
+	messages := e.messages
+	e.messages = nil
+	return messages
+}
+
+type mockEncoderBuilder struct {
+	protocol config.Protocol
+}
+
+func (b *mockEncoderBuilder) Build() RowEventEncoder {
+	return &mockEncoder{protocol: b.protocol}
+}
+
+func TestRoutedEncoder(t *testing.T) {
+	t.Parallel()
+
+	builder := NewRoutedEncoderBuilder(
+		&mockEncoderBuilder{protocol: config.ProtocolOpen},
+		[]config.Protocol{config.ProtocolAvro},
+		[]RowEventEncoderBuilder{&mockEncoderBuilder{protocol: config.ProtocolAvro}},
+		func(row *model.RowChangedEvent) (config.Protocol, bool) {
+			if row.Table.Schema == "typed" {
+				return config.ProtocolAvro, true
+			}
+			return config.ProtocolUnknown, false
+		},
+	)
+	encoder := builder.Build()
+
+	ctx := context.Background()
+	for _, schema := range []string{"typed", "raw", "raw", "typed", "typed"} {
+		err := encoder.AppendRowChangedEvent(ctx, "topic", &model.RowChangedEvent{
+			Table: &model.TableName{Schema: schema, Table: "t"},
+		}, nil)
+		require.NoError(t, err)
+	}
+	messages := encoder.Build()
+	// messages are built in the order of the appended row changed events.
+	require.Len(t, messages, 5)
+	for i, protocol := range []config.Protocol{
+		config.ProtocolAvro, config.ProtocolOpen, config.ProtocolOpen,
+		config.ProtocolAvro, config.ProtocolAvro,
+	} {
+		require.Equal(t, protocol, messages[i].Protocol)
+	}
+	require.Empty(t, encoder.Build())
+
+	msg, err := encoder.EncodeDDLEvent(&model.DDLEvent{TableInfo: &model.TableInfo{}})
+	require.NoError(t, err)
+	require.Equal(t, config.ProtocolOpen, msg.Protocol)
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/internal"
+)
+
+// Each row changed event is encoded into a flat JSON object, in which the
+// columns are placed at the top level together with the following metadata
+// fields. Row changed events which have columns of the same names as the
+// metadata fields cannot be encoded.
+const (
+	opField       = "_op"
+	schemaField   = "_schema"
+	tableField    = "_table"
+	commitTsField = "_commit_ts"
+	queryField    = "_query"
+
+	opInsert = "INSERT"
+	opUpdate = "UPDATE"
+	opDelete = "DELETE"
+	opDDL    = "DDL"
+)
+
+// BatchEncoder encodes events into flat JSON objects.
+// Each row changed event is encoded into a standalone message.
+type BatchEncoder struct {
+	*internal.RowMessages
+}
+
+// EncodeCheckpointEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	// The consumers of the simple json protocol do not care about
+	// the resolved ts, so the checkpoint event is ignored.
+	return nil, nil
+}
+
+// AppendRowChangedEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context,
+	_ string,
+	e *model.RowChangedEvent,
+	callback func(),
+) error {
+	value, err := encodeRowChangedEvent(e)
+	if err != nil {
+		return err
+	}
+	return d.Append(nil, value, e, callback)
+}
+
+// EncodeDDLEvent implements the RowEventEncoder interface
+func (d *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error) {
+	value, err := json.Marshal(map[string]interface{}{
+		opField:       opDDL,
+		schemaField:   e.TableInfo.TableName.Schema,
+		tableField:    e.TableInfo.TableName.Table,
+		commitTsField: e.CommitTs,
+		queryField:    e.Query,
+	})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSimpleJSONEncodeFailed, err)
+	}
+	return common.NewDDLMsg(config.ProtocolSimpleJSON, nil, value, e), nil
+}
+
+// encodeRowChangedEvent encodes the row into a flat JSON object. The columns
+// after the change are encoded for insert and update events, and the columns
+// before the change are encoded for delete events.
+func encodeRowChangedEvent(e *model.RowChangedEvent) ([]byte, error) {
+	op := opInsert
+	columns := e.Columns
+	switch {
+	case e.IsDelete():
+		op = opDelete
+		columns = e.PreColumns
+	case e.IsUpdate():
+		op = opUpdate
+	}
+
+	row := map[string]interface{}{
+		opField:       op,
+		schemaField:   e.Table.Schema,
+		tableField:    e.Table.Table,
+		commitTsField: e.CommitTs,
+	}
+	for _, col := range columns {
+		if col == nil {
+			continue
+		}
+		if _, ok := row[col.Name]; ok {
+			return nil, cerror.ErrSimpleJSONEncodeFailed.GenWithStack(
+				"column %s of table %s conflicts with the metadata field",
+				col.Name, e.Table.String())
+		}
+		row[col.Name] = formatColumnValue(col)
+	}
+
+	value, err := json.Marshal(row)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSimpleJSONEncodeFailed, err)
+	}
+	return value, nil
+}
+
+// formatColumnValue converts the column value to a JSON friendly value,
+// JSON values are embedded as they are instead of strings.
+func formatColumnValue(col *model.Column) interface{} {
+	if col.Type == mysql.TypeJSON {
+		if v, ok := col.Value.(string); ok && json.Valid([]byte(v)) {
+			return json.RawMessage(v)
+		}
+	}
+	return internal.JSONColumnValue(col)
+}
+
+// newBatchEncoder creates a new simple json BatchEncoder.
+func newBatchEncoder(c *common.Config) codec.RowEventEncoder {
+	return &BatchEncoder{
+		RowMessages: internal.NewRowMessages(config.ProtocolSimpleJSON, c.MaxMessageBytes),
+	}
+}
+
+// NewBatchEncoderBuilder creates a simple json batchEncoderBuilder.
+func NewBatchEncoderBuilder(c *common.Config) codec.RowEventEncoderBuilder {
+	return internal.NewRowEventEncoderBuilder(c, newBatchEncoder)
+}
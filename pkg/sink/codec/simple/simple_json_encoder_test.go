@@ -0,0 +1,121 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleJSONRowChangedEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolSimpleJSON)).Build()
+
+	insert := &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: 1},
+			{Name: "name", Type: mysql.TypeVarchar, Value: []byte("tidb")},
+			{Name: "bin", Type: mysql.TypeBlob, Flag: model.BinaryFlag, Value: []byte{0x1, 0x2}},
+			{Name: "doc", Type: mysql.TypeJSON, Value: `{"a":1}`},
+			{Name: "score", Type: mysql.TypeDouble, Value: nil},
+		},
+	}
+	del := &model.RowChangedEvent{
+		CommitTs: 2,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: 1},
+		},
+	}
+
+	ctx := context.Background()
+	require.NoError(t, encoder.AppendRowChangedEvent(ctx, "", insert, nil))
+	require.NoError(t, encoder.AppendRowChangedEvent(ctx, "", del, nil))
+	messages := encoder.Build()
+	require.Len(t, messages, 2)
+
+	require.JSONEq(t, `{
+		"_op": "INSERT",
+		"_schema": "test",
+		"_table": "t",
+		"_commit_ts": 1,
+		"id": 1,
+		"name": "tidb",
+		"bin": "AQI=",
+		"doc": {"a": 1},
+		"score": null
+	}`, string(messages[0].Value))
+	require.Equal(t, 1, messages[0].GetRowsCount())
+	require.JSONEq(t, `{
+		"_op": "DELETE",
+		"_schema": "test",
+		"_table": "t",
+		"_commit_ts": 2,
+		"id": 1
+	}`, string(messages[1].Value))
+	require.Nil(t, encoder.Build())
+
+	checkpoint, err := encoder.EncodeCheckpointEvent(3)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+}
+
+func TestSimpleJSONDDLEvent(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolSimpleJSON)).Build()
+	msg, err := encoder.EncodeDDLEvent(&model.DDLEvent{
+		CommitTs: 1,
+		TableInfo: &model.TableInfo{
+			TableName: model.TableName{Schema: "test", Table: "t"},
+		},
+		Query: "create table t(id int primary key)",
+		Type:  timodel.ActionCreateTable,
+	})
+	require.NoError(t, err)
+	require.Equal(t, model.MessageTypeDDL, msg.Type)
+
+	var value map[string]interface{}
+	require.NoError(t, json.Unmarshal(msg.Value, &value))
+	require.Equal(t, "DDL", value["_op"])
+	require.Equal(t, "create table t(id int primary key)", value["_query"])
+}
+
+func TestSimpleJSONMetadataFieldConflict(t *testing.T) {
+	t.Parallel()
+
+	encoder := NewBatchEncoderBuilder(common.NewConfig(config.ProtocolSimpleJSON)).Build()
+	err := encoder.AppendRowChangedEvent(context.Background(), "", &model.RowChangedEvent{
+		CommitTs: 1,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Value: 1},
+			{Name: "_op", Type: mysql.TypeVarchar, Value: []byte("user")},
+		},
+	}, nil)
+	require.True(t, cerror.ErrSimpleJSONEncodeFailed.Equal(err))
+	require.Nil(t, encoder.Build())
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// The names of the metadata columns.
+const (
+	CommitTsColumn        = "_tidb_commit_ts"
+	ProcessedAtColumn     = "_cdc_processed_at"
+	SourceClusterIDColumn = "_source_cluster_id"
+	OpColumn              = "_op"
+)
+
+// The values of the op column.
+const (
+	OpInsert = "I"
+	OpUpdate = "U"
+	OpDelete = "D"
+)
+
+const processedAtFormat = "2006-01-02 15:04:05.000000"
+
+type column struct {
+	kind string
+	name string
+	tp   byte
+	flag model.ColumnFlagType
+}
+
+func newColumn(kind string) *column {
+	switch kind {
+	case config.MetadataColumnCommitTs:
+		return &column{kind: kind, name: CommitTsColumn, tp: mysql.TypeLonglong, flag: model.UnsignedFlag}
+	case config.MetadataColumnProcessedAt:
+		return &column{kind: kind, name: ProcessedAtColumn, tp: mysql.TypeDatetime}
+	case config.MetadataColumnSourceClusterID:
+		return &column{kind: kind, name: SourceClusterIDColumn, tp: mysql.TypeLonglong, flag: model.UnsignedFlag}
+	case config.MetadataColumnOp:
+		return &column{kind: kind, name: OpColumn, tp: mysql.TypeVarchar}
+	}
+	return nil
+}
+
+// fieldType returns the field type of the metadata column.
+func (c *column) fieldType() *types.FieldType {
+	ft := types.NewFieldType(c.tp)
+	switch c.tp {
+	case mysql.TypeVarchar:
+		ft.SetFlen(1)
+		ft.SetCharset(mysql.DefaultCharset)
+		ft.SetCollate(mysql.DefaultCollationName)
+	case mysql.TypeDatetime:
+		ft.SetDecimal(6)
+	}
+	if c.flag.IsUnsigned() {
+		ft.AddFlag(mysql.UnsignedFlag)
+	}
+	ft.AddFlag(mysql.NotNullFlag)
+	return ft
+}
+
+// Injector appends the metadata columns to the rows sent to the sink.
+// A nil Injector appends nothing.
+type Injector struct {
+	columns   []*column
+	clusterID uint64
+	// now is used to get the processed time, it can be mocked in tests.
+	now func() time.Time
+}
+
+// NewInjector creates an Injector by the metadata columns of the sink config,
+// it returns nil if there is no metadata column.
+func NewInjector(cfg *config.SinkConfig) *Injector {
+	if cfg == nil || len(cfg.MetadataColumns) == 0 {
+		return nil
+	}
+	i := &Injector{clusterID: cfg.UpstreamClusterID, now: time.Now}
+	for _, kind := range cfg.MetadataColumns {
+		if c := newColumn(kind); c != nil {
+			i.columns = append(i.columns, c)
+		}
+	}
+	return i
+}
+
+// InjectTableInfo returns a copy of the table info with the metadata columns
+// appended, which describes the rows returned by Inject.
+func (i *Injector) InjectTableInfo(info *model.TableInfo) *model.TableInfo {
+	if i == nil || info == nil || info.TableInfo == nil {
+		return info
+	}
+	tiInfo := *info.TableInfo
+	tiInfo.Columns = append([]*timodel.ColumnInfo(nil), info.Columns...)
+	for _, c := range i.columns {
+		tiInfo.MaxColumnID++
+		tiInfo.Columns = append(tiInfo.Columns, &timodel.ColumnInfo{
+			ID:        tiInfo.MaxColumnID,
+			Name:      timodel.NewCIStr(c.name),
+			Offset:    len(tiInfo.Columns),
+			FieldType: *c.fieldType(),
+			State:     timodel.StatePublic,
+		})
+	}
+	injected := *info
+	injected.TableInfo = &tiInfo
+	return &injected
+}
+
+// Inject returns a copy of the row with the metadata columns appended to both
+// the columns and the pre columns. The row itself isn't modified, since the
+// sink may write it again after a failure.
+func (i *Injector) Inject(row *model.RowChangedEvent) *model.RowChangedEvent {
+	if i == nil || row == nil {
+		return row
+	}
+	op := OpUpdate
+	if row.IsInsert() {
+		op = OpInsert
+	} else if row.IsDelete() {
+		op = OpDelete
+	}
+	processedAt := i.now().UTC().Format(processedAtFormat)
+
+	injected := *row
+	// Copy the slices so that the appended columns don't share the backing
+	// arrays of the row.
+	if len(row.Columns) != 0 {
+		injected.Columns = append([]*model.Column(nil), row.Columns...)
+	}
+	if len(row.PreColumns) != 0 {
+		injected.PreColumns = append([]*model.Column(nil), row.PreColumns...)
+	}
+	if len(row.ColInfos) != 0 {
+		injected.ColInfos = append([]rowcodec.ColInfo(nil), row.ColInfos...)
+	}
+	// The metadata columns get the IDs after the existing columns, which may
+	// include the computed columns.
+	nextID := int64(0)
+	for _, colInfo := range row.ColInfos {
+		if colInfo.ID > nextID {
+			nextID = colInfo.ID
+		}
+	}
+	for _, c := range i.columns {
+		var value interface{}
+		switch c.kind {
+		case config.MetadataColumnCommitTs:
+			value = row.CommitTs
+		case config.MetadataColumnProcessedAt:
+			value = processedAt
+		case config.MetadataColumnSourceClusterID:
+			value = i.clusterID
+		case config.MetadataColumnOp:
+			value = []byte(op)
+		}
+		newColumn := func() *model.Column {
+			return &model.Column{Name: c.name, Type: c.tp, Flag: c.flag, Value: value}
+		}
+		if len(injected.Columns) != 0 {
+			injected.Columns = append(injected.Columns, newColumn())
+		}
+		if len(injected.PreColumns) != 0 {
+			injected.PreColumns = append(injected.PreColumns, newColumn())
+		}
+		if len(injected.ColInfos) != 0 {
+			nextID++
+			injected.ColInfos = append(injected.ColInfos, rowcodec.ColInfo{ID: nextID, Ft: c.fieldType()})
+		}
+	}
+	return &injected
+}
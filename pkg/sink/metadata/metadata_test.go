@@ -0,0 +1,136 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInject(t *testing.T) {
+	t.Parallel()
+
+	injector := NewInjector(&config.SinkConfig{
+		MetadataColumns: []string{
+			config.MetadataColumnCommitTs, config.MetadataColumnProcessedAt,
+			config.MetadataColumnSourceClusterID, config.MetadataColumnOp,
+		},
+		UpstreamClusterID: 7,
+	})
+	injector.now = func() time.Time {
+		return time.Date(2023, 5, 1, 8, 0, 0, 1000, time.FixedZone("UTC+8", 8*3600))
+	}
+
+	row := &model.RowChangedEvent{
+		CommitTs: 100,
+		Table:    &model.TableName{Schema: "test", Table: "t"},
+		Columns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+		},
+		PreColumns: []*model.Column{
+			{Name: "id", Type: mysql.TypeLong, Flag: model.HandleKeyFlag, Value: int64(1)},
+		},
+		ColInfos: []rowcodec.ColInfo{{ID: 3, Ft: types.NewFieldType(mysql.TypeLong)}},
+	}
+	injected := injector.Inject(row)
+	require.Len(t, injected.Columns, 5)
+	require.Len(t, injected.PreColumns, 5)
+	require.Len(t, injected.ColInfos, 5)
+	expected := []struct {
+		name  string
+		value interface{}
+	}{
+		{CommitTsColumn, uint64(100)},
+		{ProcessedAtColumn, "2023-05-01 00:00:00.000001"},
+		{SourceClusterIDColumn, uint64(7)},
+		{OpColumn, []byte(OpUpdate)},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.name, injected.Columns[i+1].Name)
+		require.Equal(t, e.value, injected.Columns[i+1].Value)
+		require.Equal(t, e.value, injected.PreColumns[i+1].Value)
+		require.Equal(t, int64(i+4), injected.ColInfos[i+1].ID)
+	}
+	// The row itself isn't modified.
+	require.Len(t, row.Columns, 1)
+	require.Len(t, row.PreColumns, 1)
+	require.Len(t, row.ColInfos, 1)
+
+	row.PreColumns = nil
+	injected = injector.Inject(row)
+	require.Equal(t, []byte(OpInsert), injected.Columns[4].Value)
+	require.Nil(t, injected.PreColumns)
+
+	row.Columns, row.PreColumns = nil, row.Columns
+	injected = injector.Inject(row)
+	require.Equal(t, []byte(OpDelete), injected.PreColumns[4].Value)
+	require.Nil(t, injected.Columns)
+}
+
+func TestNilInjector(t *testing.T) {
+	t.Parallel()
+
+	injector := NewInjector(&config.SinkConfig{})
+	require.Nil(t, injector)
+	row := &model.RowChangedEvent{CommitTs: 100}
+	require.Same(t, row, injector.Inject(row))
+	info := &model.TableInfo{
+		TableInfo: &timodel.TableInfo{
+			Columns: []*timodel.ColumnInfo{
+				{ID: 1, Name: timodel.NewCIStr("id"), State: timodel.StatePublic},
+			},
+			MaxColumnID: 2,
+		},
+	}
+	require.Same(t, info, injector.InjectTableInfo(info))
+}
+
+func TestInjectTableInfo(t *testing.T) {
+	t.Parallel()
+
+	injector := NewInjector(&config.SinkConfig{
+		MetadataColumns: []string{config.MetadataColumnOp, config.MetadataColumnCommitTs},
+	})
+	info := &model.TableInfo{
+		TableInfo: &timodel.TableInfo{
+			Columns: []*timodel.ColumnInfo{
+				{ID: 1, Name: timodel.NewCIStr("id"), State: timodel.StatePublic},
+			},
+			MaxColumnID: 2,
+		},
+		TableName: model.TableName{Schema: "test", Table: "t"},
+	}
+	injected := injector.InjectTableInfo(info)
+	require.Equal(t, info.TableName, injected.TableName)
+	require.Len(t, injected.Columns, 3)
+	op, commitTs := injected.Columns[1], injected.Columns[2]
+	require.Equal(t, OpColumn, op.Name.O)
+	require.Equal(t, int64(3), op.ID)
+	require.Equal(t, 1, op.Offset)
+	require.Equal(t, mysql.TypeVarchar, op.GetType())
+	require.Equal(t, CommitTsColumn, commitTs.Name.O)
+	require.Equal(t, int64(4), commitTs.ID)
+	require.True(t, mysql.HasUnsignedFlag(commitTs.GetFlag()))
+	// The table info itself isn't modified.
+	require.Len(t, info.Columns, 1)
+	require.Equal(t, int64(2), info.MaxColumnID)
+}
@@ -0,0 +1,219 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// restoreFlags are the flags to restore the routed DDL queries, which are
+// the same as the ones used to add the special comments to the DDLs.
+const restoreFlags = format.RestoreTiDBSpecialComment |
+	format.RestoreNameBackQuotes |
+	format.RestoreKeyWordUppercase |
+	format.RestoreStringSingleQuotes |
+	format.SkipPlacementRuleForRestore |
+	format.RestoreWithTTLEnableOff
+
+type routeRule struct {
+	pattern      *regexp.Regexp
+	targetSchema string
+	targetTable  string
+}
+
+type sourceTable struct {
+	schema string
+	table  string
+}
+
+type targetTable struct {
+	schema string
+	table  string
+}
+
+// Router renames the schemas and tables sent downstream by the route rules.
+// It's safe for concurrent use, and a nil Router routes nothing.
+type Router struct {
+	rules []*routeRule
+	// targets caches the routed names of a table, sourceTable -> targetTable.
+	targets sync.Map
+	// tableNames caches the routed table names of the row changed events,
+	// model.TableName -> *model.TableName.
+	tableNames sync.Map
+}
+
+// NewRouter creates a Router, it returns nil if there is no route rule.
+func NewRouter(rules []*config.RouteRule) (*Router, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	r := &Router{}
+	for _, rule := range rules {
+		// The pattern must match the whole `schema.table`.
+		pattern, err := regexp.Compile("^(?:" + rule.Pattern + ")$")
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrSinkInvalidConfig, err)
+		}
+		r.rules = append(r.rules, &routeRule{
+			pattern:      pattern,
+			targetSchema: rule.TargetSchema,
+			targetTable:  rule.TargetTable,
+		})
+	}
+	return r, nil
+}
+
+// Route returns the downstream schema and table of an upstream table by the
+// first matched rule, or the upstream names if no rule matches it.
+func (r *Router) Route(schema, table string) (string, string) {
+	if r == nil {
+		return schema, table
+	}
+	source := sourceTable{schema: schema, table: table}
+	if target, ok := r.targets.Load(source); ok {
+		return target.(targetTable).schema, target.(targetTable).table
+	}
+
+	target := targetTable{schema: schema, table: table}
+	name := schema + "." + table
+	for _, rule := range r.rules {
+		match := rule.pattern.FindStringSubmatchIndex(name)
+		if match == nil {
+			continue
+		}
+		if rule.targetSchema != "" {
+			target.schema = string(rule.pattern.ExpandString(nil, rule.targetSchema, name, match))
+		}
+		if rule.targetTable != "" {
+			target.table = string(rule.pattern.ExpandString(nil, rule.targetTable, name, match))
+		}
+		break
+	}
+	r.targets.Store(source, target)
+	return target.schema, target.table
+}
+
+// RouteTableName returns the routed table name of a row changed event, the
+// table ID is kept. The returned name must not be modified since it's shared
+// by the rows of the table.
+func (r *Router) RouteTableName(name *model.TableName) *model.TableName {
+	if r == nil || name == nil {
+		return name
+	}
+	if routed, ok := r.tableNames.Load(*name); ok {
+		return routed.(*model.TableName)
+	}
+
+	routed := name
+	schema, table := r.Route(name.Schema, name.Table)
+	if schema != name.Schema || table != name.Table {
+		routed = &model.TableName{
+			Schema:      schema,
+			Table:       table,
+			TableID:     name.TableID,
+			IsPartition: name.IsPartition,
+		}
+	}
+	r.tableNames.Store(*name, routed)
+	return routed
+}
+
+// RouteTableInfo returns a copy of the table info with the routed table name,
+// or the table info itself if the table isn't renamed.
+func (r *Router) RouteTableInfo(info *model.TableInfo) *model.TableInfo {
+	if r == nil || info == nil || info.TableName.Table == "" {
+		return info
+	}
+	schema, table := r.Route(info.TableName.Schema, info.TableName.Table)
+	if schema == info.TableName.Schema && table == info.TableName.Table {
+		return info
+	}
+	routed := *info
+	routed.TableName.Schema = schema
+	routed.TableName.Table = table
+	return &routed
+}
+
+// RouteDDL returns a copy of the DDL event whose query and table infos refer
+// to the routed tables, or the DDL event itself if no table is renamed. The
+// unqualified tables in the query belong to the schema of the DDL event. The
+// DDLs of the schemas are not routed.
+func (r *Router) RouteDDL(ddl *model.DDLEvent) (*model.DDLEvent, error) {
+	if r == nil || ddl.TableInfo == nil || ddl.TableInfo.TableName.Table == "" {
+		return ddl, nil
+	}
+	stmt, err := parser.New().ParseOneStmt(ddl.Query, ddl.Charset, ddl.Collate)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	renamer := &tableRenamer{router: r, defaultSchema: ddl.TableInfo.TableName.Schema}
+	stmt.Accept(renamer)
+	if !renamer.renamed {
+		return ddl, nil
+	}
+	var sb strings.Builder
+	if err := stmt.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &model.DDLEvent{
+		StartTs:      ddl.StartTs,
+		CommitTs:     ddl.CommitTs,
+		Query:        sb.String(),
+		TableInfo:    r.RouteTableInfo(ddl.TableInfo),
+		PreTableInfo: r.RouteTableInfo(ddl.PreTableInfo),
+		Type:         ddl.Type,
+		Charset:      ddl.Charset,
+		Collate:      ddl.Collate,
+	}, nil
+}
+
+// tableRenamer renames the tables in a DDL statement by the router.
+type tableRenamer struct {
+	router        *Router
+	defaultSchema string
+	renamed       bool
+}
+
+func (v *tableRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	t, ok := in.(*ast.TableName)
+	if !ok {
+		return in, false
+	}
+	schema := t.Schema.O
+	if schema == "" {
+		schema = v.defaultSchema
+	}
+	targetSchema, targetTable := v.router.Route(schema, t.Name.O)
+	if targetSchema != schema || targetTable != t.Name.O {
+		t.Schema = timodel.NewCIStr(targetSchema)
+		t.Name = timodel.NewCIStr(targetTable)
+		v.renamed = true
+	}
+	return in, true
+}
+
+func (v *tableRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"testing"
+
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(t *testing.T) *Router {
+	r, err := NewRouter([]*config.RouteRule{
+		{Pattern: `db_(\d+)\.orders`, TargetSchema: "db_all"},
+		{Pattern: `db_(\d+)\.(.*)`, TargetSchema: "db_all", TargetTable: "${2}_${1}"},
+		{Pattern: `logs\..*`, TargetSchema: "archive"},
+	})
+	require.Nil(t, err)
+	return r
+}
+
+func TestRoute(t *testing.T) {
+	t.Parallel()
+
+	r := newTestRouter(t)
+	testCases := []struct {
+		schema         string
+		table          string
+		expectedSchema string
+		expectedTable  string
+	}{
+		{"db_1", "orders", "db_all", "orders"},
+		{"db_22", "orders", "db_all", "orders"},
+		{"db_1", "users", "db_all", "users_1"},
+		{"logs", "access", "archive", "access"},
+		// The pattern must match the whole name.
+		{"db_1x", "orders", "db_1x", "orders"},
+		{"test", "logs.access", "test", "logs.access"},
+	}
+	for _, tc := range testCases {
+		// Route twice to cover the cache.
+		for i := 0; i < 2; i++ {
+			schema, table := r.Route(tc.schema, tc.table)
+			require.Equal(t, tc.expectedSchema, schema)
+			require.Equal(t, tc.expectedTable, table)
+		}
+	}
+
+	// A nil router routes nothing.
+	var nilRouter *Router
+	schema, table := nilRouter.Route("db_1", "orders")
+	require.Equal(t, "db_1", schema)
+	require.Equal(t, "orders", table)
+	nilRouter, err := NewRouter(nil)
+	require.Nil(t, err)
+	require.Nil(t, nilRouter)
+
+	_, err = NewRouter([]*config.RouteRule{{Pattern: `db_(\d+`, TargetSchema: "db_all"}})
+	require.Regexp(t, ".*ErrSinkInvalidConfig.*", err)
+}
+
+func TestRouteTableName(t *testing.T) {
+	t.Parallel()
+
+	r := newTestRouter(t)
+	name := &model.TableName{Schema: "db_1", Table: "orders", TableID: 100, IsPartition: true}
+	routed := r.RouteTableName(name)
+	require.Equal(t, &model.TableName{
+		Schema: "db_all", Table: "orders", TableID: 100, IsPartition: true,
+	}, routed)
+	// The source name is not modified and the routed name is reused.
+	require.Equal(t, "db_1", name.Schema)
+	require.Same(t, routed, r.RouteTableName(&model.TableName{
+		Schema: "db_1", Table: "orders", TableID: 100, IsPartition: true,
+	}))
+
+	name = &model.TableName{Schema: "test", Table: "t", TableID: 101}
+	require.Same(t, name, r.RouteTableName(name))
+}
+
+func TestRouteDDL(t *testing.T) {
+	t.Parallel()
+
+	r := newTestRouter(t)
+	newTableInfo := func(schema, table string) *model.TableInfo {
+		return &model.TableInfo{
+			TableInfo: &timodel.TableInfo{Name: timodel.NewCIStr(table)},
+			TableName: model.TableName{Schema: schema, Table: table, TableID: 100},
+		}
+	}
+
+	ddl := &model.DDLEvent{
+		StartTs:   1,
+		CommitTs:  2,
+		Query:     "ALTER TABLE `orders` ADD COLUMN `c` INT",
+		TableInfo: newTableInfo("db_1", "orders"),
+		Type:      timodel.ActionAddColumn,
+	}
+	routed, err := r.RouteDDL(ddl)
+	require.Nil(t, err)
+	require.Equal(t, "ALTER TABLE `db_all`.`orders` ADD COLUMN `c` INT", routed.Query)
+	require.Equal(t, model.TableName{Schema: "db_all", Table: "orders", TableID: 100},
+		routed.TableInfo.TableName)
+	require.Equal(t, uint64(2), routed.CommitTs)
+	require.Equal(t, timodel.ActionAddColumn, routed.Type)
+	// The source DDL is not modified.
+	require.Equal(t, "ALTER TABLE `orders` ADD COLUMN `c` INT", ddl.Query)
+	require.Equal(t, "db_1", ddl.TableInfo.TableName.Schema)
+
+	ddl = &model.DDLEvent{
+		Query:        "RENAME TABLE `db_1`.`users` TO `db_1`.`customers`",
+		TableInfo:    newTableInfo("db_1", "customers"),
+		PreTableInfo: newTableInfo("db_1", "users"),
+		Type:         timodel.ActionRenameTable,
+	}
+	routed, err = r.RouteDDL(ddl)
+	require.Nil(t, err)
+	require.Equal(t,
+		"RENAME TABLE `db_all`.`users_1` TO `db_all`.`customers_1`", routed.Query)
+	require.Equal(t, "customers_1", routed.TableInfo.TableName.Table)
+	require.Equal(t, "users_1", routed.PreTableInfo.TableName.Table)
+
+	// The DDLs of the tables not routed and of the schemas are kept.
+	ddl = &model.DDLEvent{
+		Query:     "CREATE TABLE `t` (`id` INT PRIMARY KEY)",
+		TableInfo: newTableInfo("test", "t"),
+		Type:      timodel.ActionCreateTable,
+	}
+	routed, err = r.RouteDDL(ddl)
+	require.Nil(t, err)
+	require.Same(t, ddl, routed)
+	ddl = &model.DDLEvent{
+		Query:     "CREATE DATABASE `db_1`",
+		TableInfo: &model.TableInfo{TableName: model.TableName{Schema: "db_1"}},
+		Type:      timodel.ActionCreateSchema,
+	}
+	routed, err = r.RouteDDL(ddl)
+	require.Nil(t, err)
+	require.Same(t, ddl, routed)
+}
@@ -0,0 +1,222 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/rowcodec"
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// computedColumn only be used by injector.
+type computedColumn struct {
+	tableMatcher tfilter.Filter
+	config       *config.ComputedColumn
+	// columns are the lower case names of the source columns.
+	columns []string
+	tp      byte
+	flag    model.ColumnFlagType
+}
+
+func newComputedColumn(cfg *config.ComputedColumn) (*computedColumn, error) {
+	tf, err := tfilter.Parse(cfg.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.Matcher)
+	}
+	c := &computedColumn{tableMatcher: tf, config: cfg}
+	for _, name := range cfg.Columns {
+		c.columns = append(c.columns, strings.ToLower(name))
+	}
+	switch cfg.Function {
+	case config.ComputeFunctionConcat, config.ComputeFunctionCommitDate:
+		c.tp = mysql.TypeVarchar
+	case config.ComputeFunctionBucket:
+		c.tp = mysql.TypeLonglong
+	case config.ComputeFunctionCommitTs:
+		c.tp = mysql.TypeLonglong
+		c.flag = model.UnsignedFlag
+	default:
+		return nil, cerror.ErrInvalidComputedColumn.GenWithStackByArgs(
+			cfg.Name, cfg.Matcher, "unknown function "+cfg.Function)
+	}
+	return c, nil
+}
+
+// fieldType returns the field type of the computed column.
+func (c *computedColumn) fieldType() *types.FieldType {
+	ft := types.NewFieldType(c.tp)
+	if c.tp == mysql.TypeVarchar {
+		ft.SetCharset(mysql.DefaultCharset)
+		ft.SetCollate(mysql.DefaultCollationName)
+	}
+	if c.flag.IsUnsigned() {
+		ft.AddFlag(mysql.UnsignedFlag)
+	}
+	return ft
+}
+
+// compute returns the value of the computed column from the columns of a
+// row change, the value of a missing or NULL source column is NULL.
+func (c *computedColumn) compute(commitTs uint64, columns []*model.Column) interface{} {
+	switch c.config.Function {
+	case config.ComputeFunctionCommitTs:
+		return commitTs
+	case config.ComputeFunctionCommitDate:
+		return []byte(oracle.GetTimeFromTS(commitTs).UTC().Format(c.config.Format))
+	case config.ComputeFunctionConcat:
+		values := make([]string, 0, len(c.columns))
+		for _, name := range c.columns {
+			col := findColumn(columns, name)
+			if col == nil || col.Value == nil {
+				return nil
+			}
+			values = append(values, model.ColumnValueString(col.Value))
+		}
+		return []byte(strings.Join(values, c.config.Separator))
+	case config.ComputeFunctionBucket:
+		col := findColumn(columns, c.columns[0])
+		if col == nil || col.Value == nil {
+			return nil
+		}
+		return bucketOf(col.Value, c.config.BucketSize)
+	}
+	return nil
+}
+
+// bucketOf divides a numeric value by the bucket size and rounds it down,
+// it returns nil if the value isn't numeric.
+func bucketOf(value interface{}, size int64) interface{} {
+	switch v := value.(type) {
+	case int64:
+		b := v / size
+		if v%size < 0 {
+			b--
+		}
+		return b
+	case uint64:
+		return int64(v / uint64(size))
+	case float32:
+		return int64(math.Floor(float64(v) / float64(size)))
+	case float64:
+		return int64(math.Floor(v / float64(size)))
+	default:
+		f, err := strconv.ParseFloat(model.ColumnValueString(v), 64)
+		if err != nil {
+			return nil
+		}
+		return int64(math.Floor(f / float64(size)))
+	}
+}
+
+func findColumn(columns []*model.Column, name string) *model.Column {
+	for _, col := range columns {
+		if col != nil && strings.ToLower(col.Name) == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// injector appends the computed columns to the row changes.
+type injector struct {
+	columns []*computedColumn
+}
+
+func newInjector(cfg *config.TransformConfig) (*injector, error) {
+	res := &injector{}
+	for _, columnCfg := range cfg.ComputedColumns {
+		column, err := newComputedColumn(columnCfg)
+		if err != nil {
+			return nil, err
+		}
+		res.columns = append(res.columns, column)
+	}
+	return res, nil
+}
+
+// verify checks the computed columns don't conflict with the existing
+// columns, and their source columns exist.
+func (i *injector) verify(tableInfos []*model.TableInfo) error {
+	for _, ti := range tableInfos {
+		names := make(map[string]struct{}, len(ti.Columns))
+		for _, col := range ti.Columns {
+			names[col.Name.L] = struct{}{}
+		}
+		for _, c := range i.columns {
+			if !c.tableMatcher.MatchTable(ti.TableName.Schema, ti.TableName.Table) {
+				continue
+			}
+			name := strings.ToLower(c.config.Name)
+			if _, ok := names[name]; ok {
+				return cerror.ErrInvalidComputedColumn.GenWithStackByArgs(
+					c.config.Name, ti.TableName.String(), "the column already exists")
+			}
+			for _, source := range c.columns {
+				if _, ok := names[source]; !ok {
+					return cerror.ErrInvalidComputedColumn.GenWithStackByArgs(
+						c.config.Name, ti.TableName.String(),
+						"the source column "+source+" doesn't exist")
+				}
+			}
+			names[name] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// inject appends the computed columns of the matched rules to a row change.
+// The computed columns get the IDs after the max column ID of the table, so
+// they never conflict with the existing columns.
+func (i *injector) inject(row *model.RowChangedEvent) {
+	if len(i.columns) == 0 || row.Table == nil {
+		return
+	}
+	nextID := int64(0)
+	if row.TableInfo != nil {
+		nextID = row.TableInfo.MaxColumnID
+	}
+	for _, c := range i.columns {
+		if !c.tableMatcher.MatchTable(row.Table.Schema, row.Table.Table) {
+			continue
+		}
+		nextID++
+		newColumn := func(columns []*model.Column) *model.Column {
+			return &model.Column{
+				Name:  c.config.Name,
+				Type:  c.tp,
+				Flag:  c.flag,
+				Value: c.compute(row.CommitTs, columns),
+			}
+		}
+		if len(row.Columns) != 0 {
+			row.Columns = append(row.Columns, newColumn(row.Columns))
+		}
+		if len(row.PreColumns) != 0 {
+			row.PreColumns = append(row.PreColumns, newColumn(row.PreColumns))
+		}
+		if len(row.ColInfos) != 0 {
+			row.ColInfos = append(row.ColInfos, rowcodec.ColInfo{ID: nextID, Ft: c.fieldType()})
+		}
+	}
+}
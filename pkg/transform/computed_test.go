@@ -0,0 +1,201 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/rowcodec"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestInject(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.TransformConfig{
+		ComputedColumns: []*config.ComputedColumn{
+			{
+				Matcher:   []string{"test.t1"},
+				Name:      "full_name",
+				Function:  config.ComputeFunctionConcat,
+				Columns:   []string{"First_Name", "last_name"},
+				Separator: " ",
+			},
+			{
+				Matcher:    []string{"test.*"},
+				Name:       "id_bucket",
+				Function:   config.ComputeFunctionBucket,
+				Columns:    []string{"id"},
+				BucketSize: 100,
+			},
+			{
+				Matcher:  []string{"test.*"},
+				Name:     "commit_date",
+				Function: config.ComputeFunctionCommitDate,
+			},
+			{
+				Matcher:  []string{"test.*"},
+				Name:     "commit_ts",
+				Function: config.ComputeFunctionCommitTs,
+			},
+		},
+	}
+	require.Nil(t, cfg.ValidateAndAdjust())
+	tr, err := NewTransformer(cfg)
+	require.Nil(t, err)
+
+	commitTs := oracle.ComposeTS(
+		time.Date(2023, 5, 6, 23, 59, 0, 0, time.UTC).UnixMilli(), 0)
+	newColumns := func(id int64, lastName interface{}) []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: id, Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+			{Name: "first_name", Value: []byte("John")},
+			{Name: "last_name", Value: lastName},
+		}
+	}
+	row := &model.RowChangedEvent{
+		CommitTs:   commitTs,
+		Table:      &model.TableName{Schema: "test", Table: "t1"},
+		TableInfo:  &model.TableInfo{TableInfo: &timodel.TableInfo{MaxColumnID: 3}},
+		Columns:    newColumns(250, "Smith"),
+		PreColumns: newColumns(-1, nil),
+		ColInfos:   make([]rowcodec.ColInfo, 3),
+	}
+	tr.Transform(row)
+
+	require.Len(t, row.Columns, 7)
+	require.Equal(t, &model.Column{
+		Name: "full_name", Type: mysql.TypeVarchar, Value: []byte("John Smith"),
+	}, row.Columns[3])
+	require.Equal(t, &model.Column{
+		Name: "id_bucket", Type: mysql.TypeLonglong, Value: int64(2),
+	}, row.Columns[4])
+	require.Equal(t, []byte("2023-05-06"), row.Columns[5].Value)
+	require.Equal(t, commitTs, row.Columns[6].Value)
+	require.True(t, row.Columns[6].Flag.IsUnsigned())
+
+	// the NULL source columns result in NULL, and the buckets are rounded down.
+	require.Len(t, row.PreColumns, 7)
+	require.Nil(t, row.PreColumns[3].Value)
+	require.Equal(t, int64(-1), row.PreColumns[4].Value)
+
+	require.Len(t, row.ColInfos, 7)
+	for i, id := range []int64{4, 5, 6, 7} {
+		require.Equal(t, id, row.ColInfos[3+i].ID)
+		require.Equal(t, row.Columns[3+i].Type, row.ColInfos[3+i].Ft.GetType())
+	}
+
+	// only the matched computed columns are appended.
+	row = &model.RowChangedEvent{
+		CommitTs: commitTs,
+		Table:    &model.TableName{Schema: "test", Table: "t2"},
+		Columns:  newColumns(1, "Smith"),
+	}
+	tr.Transform(row)
+	require.Len(t, row.Columns, 6)
+	require.Equal(t, "id_bucket", row.Columns[3].Name)
+
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "prod", Table: "t1"},
+		Columns: newColumns(1, "Smith"),
+	}
+	tr.Transform(row)
+	require.Equal(t, newColumns(1, "Smith"), row.Columns)
+}
+
+func TestTransformMaskBeforeInject(t *testing.T) {
+	t.Parallel()
+
+	tr, err := NewTransformer(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{Matcher: []string{"*.*"}, Columns: []string{"phone"}, Function: "last4"},
+		},
+		ComputedColumns: []*config.ComputedColumn{
+			{
+				Matcher:  []string{"*.*"},
+				Name:     "contact",
+				Function: config.ComputeFunctionConcat,
+				Columns:  []string{"phone"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	row := &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t1"},
+		Columns: []*model.Column{{Name: "phone", Value: "13800138000"}},
+	}
+	tr.Transform(row)
+	require.Equal(t, "*******8000", row.Columns[0].Value)
+	require.Equal(t, []byte("*******8000"), row.Columns[1].Value)
+
+	tr, err = NewTransformer(&config.TransformConfig{})
+	require.Nil(t, err)
+	require.Nil(t, tr)
+	tr.Transform(row)
+	require.Nil(t, tr.Verify(nil))
+}
+
+func TestVerifyComputedColumns(t *testing.T) {
+	t.Parallel()
+
+	ti := &model.TableInfo{
+		TableName: model.TableName{Schema: "test", Table: "t1"},
+		TableInfo: &timodel.TableInfo{
+			Columns: []*timodel.ColumnInfo{
+				{ID: 1, Name: timodel.NewCIStr("id")},
+				{ID: 2, Name: timodel.NewCIStr("name")},
+			},
+		},
+	}
+	cases := []struct {
+		column *config.ComputedColumn
+		valid  bool
+	}{
+		{
+			column: &config.ComputedColumn{
+				Name: "name_copy", Function: "concat", Columns: []string{"Name"},
+			},
+			valid: true,
+		},
+		{
+			column: &config.ComputedColumn{Name: "NAME", Function: "commit-ts"},
+		},
+		{
+			column: &config.ComputedColumn{
+				Name: "bucket", Function: "bucket", Columns: []string{"age"}, BucketSize: 10,
+			},
+		},
+	}
+	for _, c := range cases {
+		c.column.Matcher = []string{"test.*"}
+		tr, err := NewTransformer(&config.TransformConfig{
+			ComputedColumns: []*config.ComputedColumn{c.column},
+		})
+		require.Nil(t, err)
+		err = tr.Verify([]*model.TableInfo{ti})
+		if c.valid {
+			require.Nil(t, err)
+		} else {
+			require.True(t, errors.ErrorEqual(cerror.ErrInvalidComputedColumn, err), err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	tfilter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+)
+
+// maskFunc masks a not null value.
+type maskFunc func(value []byte) []byte
+
+// maskRule only be used by Masker.
+type maskRule struct {
+	tableMatcher tfilter.Filter
+	// columns are the lower case patterns of the column names.
+	columns []string
+	mask    maskFunc
+}
+
+func (r *maskRule) matchColumn(name string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range r.columns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// columnKey identifies a column of a table.
+type columnKey struct {
+	schema, table, column string
+}
+
+// Masker masks the values of the columns of the row changes by the mask
+// rules. It's safe for concurrent use.
+type Masker struct {
+	rules []*maskRule
+	// funcs caches the mask function of a column, columnKey -> maskFunc,
+	// the mask function is nil if the column is not masked.
+	funcs sync.Map
+}
+
+// NewMasker creates a Masker, it returns nil if there is no mask rule.
+func NewMasker(cfg *config.TransformConfig) (*Masker, error) {
+	if cfg == nil || len(cfg.MaskRules) == 0 {
+		return nil, nil
+	}
+	m := &Masker{}
+	for _, ruleCfg := range cfg.MaskRules {
+		tf, err := tfilter.Parse(ruleCfg.Matcher)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, ruleCfg.Matcher)
+		}
+		mask, err := newMaskFunc(ruleCfg.Function, ruleCfg.Value)
+		if err != nil {
+			return nil, err
+		}
+		rule := &maskRule{tableMatcher: tf, mask: mask}
+		for _, pattern := range ruleCfg.Columns {
+			rule.columns = append(rule.columns, strings.ToLower(pattern))
+		}
+		m.rules = append(m.rules, rule)
+	}
+	return m, nil
+}
+
+func newMaskFunc(function, value string) (maskFunc, error) {
+	switch function {
+	case config.MaskFunctionSHA256:
+		return func(v []byte) []byte {
+			sum := sha256.Sum256(v)
+			return []byte(hex.EncodeToString(sum[:]))
+		}, nil
+	case config.MaskFunctionLast4:
+		return func(v []byte) []byte {
+			runes := []rune(string(v))
+			for i := 0; i < len(runes)-4; i++ {
+				runes[i] = '*'
+			}
+			return []byte(string(runes))
+		}, nil
+	case config.MaskFunctionNullify:
+		return func([]byte) []byte { return nil }, nil
+	case config.MaskFunctionFixed:
+		return func([]byte) []byte { return []byte(value) }, nil
+	default:
+		return nil, cerror.ErrInvalidReplicaConfig.GenWithStackByArgs(
+			fmt.Sprintf("unknown mask function %s", function))
+	}
+}
+
+// getMaskFunc returns the mask function of the first rule matching the
+// column, or nil if the column is not masked.
+func (m *Masker) getMaskFunc(schema, table, column string) maskFunc {
+	key := columnKey{schema: schema, table: table, column: column}
+	if mask, ok := m.funcs.Load(key); ok {
+		return mask.(maskFunc)
+	}
+	var mask maskFunc
+	for _, rule := range m.rules {
+		if rule.tableMatcher.MatchTable(schema, table) && rule.matchColumn(column) {
+			mask = rule.mask
+			break
+		}
+	}
+	m.funcs.Store(key, mask)
+	return mask
+}
+
+// Verify checks the mask rules don't mask any handle key column, which
+// identifies the rows in the downstream.
+func (m *Masker) Verify(tableInfos []*model.TableInfo) error {
+	if m == nil {
+		return nil
+	}
+	for _, ti := range tableInfos {
+		for _, col := range ti.Columns {
+			if !ti.ColumnsFlag[col.ID].IsHandleKey() {
+				continue
+			}
+			if m.getMaskFunc(ti.TableName.Schema, ti.TableName.Table, col.Name.O) != nil {
+				return cerror.ErrMaskHandleKey.GenWithStackByArgs(
+					col.Name.O, ti.TableName.String())
+			}
+		}
+	}
+	return nil
+}
+
+// Mask masks the values of the columns of a row change in place. The values
+// of the masked columns are converted to strings, except for the NULL values
+// and the nullified ones. The handle key columns are never masked.
+func (m *Masker) Mask(row *model.RowChangedEvent) {
+	if m == nil || row.Table == nil {
+		return
+	}
+	schema, table := row.Table.Schema, row.Table.Table
+	mask := func(columns []*model.Column) {
+		for _, col := range columns {
+			if col == nil || col.Value == nil || col.Flag.IsHandleKey() {
+				continue
+			}
+			f := m.getMaskFunc(schema, table, col.Name)
+			if f == nil {
+				continue
+			}
+			masked := f(valueToBytes(col.Value))
+			if masked == nil {
+				col.Value = nil
+				continue
+			}
+			if _, ok := col.Value.([]byte); ok {
+				col.Value = masked
+			} else {
+				col.Value = string(masked)
+			}
+		}
+	}
+	mask(row.Columns)
+	mask(row.PreColumns)
+}
+
+func valueToBytes(value interface{}) []byte {
+	switch v := value.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	timodel "github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMask(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMasker(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{
+				Matcher:  []string{"test.t1"},
+				Columns:  []string{"Phone"},
+				Function: config.MaskFunctionLast4,
+			},
+			{
+				Matcher:  []string{"test.*"},
+				Columns:  []string{"*_card_no"},
+				Function: config.MaskFunctionSHA256,
+			},
+			{
+				Matcher:  []string{"test.*"},
+				Columns:  []string{"id", "ssn"},
+				Function: config.MaskFunctionNullify,
+			},
+			{
+				Matcher:  []string{"test.*"},
+				Columns:  []string{"email", "phone"},
+				Function: config.MaskFunctionFixed,
+				Value:    "hidden",
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	newColumns := func() []*model.Column {
+		return []*model.Column{
+			{Name: "id", Value: int64(1), Flag: model.HandleKeyFlag | model.PrimaryKeyFlag},
+			{Name: "phone", Value: "13800138000"},
+			{Name: "bank_card_no", Value: []byte("abc")},
+			{Name: "ssn", Value: "123-45-6789"},
+			{Name: "email", Value: nil},
+			nil,
+			{Name: "age", Value: int64(20)},
+		}
+	}
+	row := &model.RowChangedEvent{
+		Table:      &model.TableName{Schema: "test", Table: "t1"},
+		Columns:    newColumns(),
+		PreColumns: newColumns(),
+	}
+	m.Mask(row)
+	for _, columns := range [][]*model.Column{row.Columns, row.PreColumns} {
+		// the handle key columns are never masked
+		require.Equal(t, int64(1), columns[0].Value)
+		require.Equal(t, "*******8000", columns[1].Value)
+		require.Equal(t,
+			[]byte("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+			columns[2].Value)
+		require.Nil(t, columns[3].Value)
+		// the NULL values are kept
+		require.Nil(t, columns[4].Value)
+		require.Nil(t, columns[5])
+		require.Equal(t, int64(20), columns[6].Value)
+	}
+
+	// the first matched rule takes effect
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t2"},
+		Columns: newColumns(),
+	}
+	row.Columns[4].Value = "john@example.com"
+	m.Mask(row)
+	require.Equal(t, "hidden", row.Columns[1].Value)
+	require.Equal(t, "hidden", row.Columns[4].Value)
+
+	// the rows of the other schemas are kept
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "prod", Table: "t1"},
+		Columns: newColumns(),
+	}
+	m.Mask(row)
+	require.Equal(t, newColumns(), row.Columns)
+
+	// the short values are kept by last4
+	row = &model.RowChangedEvent{
+		Table:   &model.TableName{Schema: "test", Table: "t1"},
+		Columns: []*model.Column{{Name: "phone", Value: "138"}},
+	}
+	m.Mask(row)
+	require.Equal(t, "138", row.Columns[0].Value)
+}
+
+func TestNewMasker(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMasker(nil)
+	require.Nil(t, err)
+	require.Nil(t, m)
+	// a nil masker is a no-op
+	m.Mask(&model.RowChangedEvent{Table: &model.TableName{Schema: "test", Table: "t1"}})
+	require.Nil(t, m.Verify(nil))
+
+	m, err = NewMasker(&config.TransformConfig{})
+	require.Nil(t, err)
+	require.Nil(t, m)
+
+	_, err = NewMasker(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{Matcher: []string{"test.!"}, Columns: []string{"a"}, Function: "sha256"},
+		},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrFilterRuleInvalid, err), err)
+
+	_, err = NewMasker(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{Matcher: []string{"test.*"}, Columns: []string{"a"}, Function: "md5"},
+		},
+	})
+	require.True(t, errors.ErrorEqual(cerror.ErrInvalidReplicaConfig, err), err)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	ti := &model.TableInfo{
+		TableName: model.TableName{Schema: "test", Table: "t1"},
+		TableInfo: &timodel.TableInfo{
+			Columns: []*timodel.ColumnInfo{
+				{ID: 1, Name: timodel.NewCIStr("id")},
+				{ID: 2, Name: timodel.NewCIStr("phone")},
+			},
+		},
+		ColumnsFlag: map[int64]model.ColumnFlagType{
+			1: model.HandleKeyFlag | model.PrimaryKeyFlag,
+		},
+	}
+
+	m, err := NewMasker(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{Matcher: []string{"test.t1"}, Columns: []string{"phone"}, Function: "nullify"},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, m.Verify([]*model.TableInfo{ti}))
+
+	m, err = NewMasker(&config.TransformConfig{
+		MaskRules: []*config.MaskRule{
+			{Matcher: []string{"test.t1"}, Columns: []string{"*"}, Function: "nullify"},
+		},
+	})
+	require.Nil(t, err)
+	require.True(t, errors.ErrorEqual(cerror.ErrMaskHandleKey,
+		m.Verify([]*model.TableInfo{ti})))
+}
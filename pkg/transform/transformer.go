@@ -0,0 +1,65 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/config"
+)
+
+// Transformer applies the transformations of a changefeed to the row changes,
+// the values are masked before the computed columns are appended, so the
+// computed columns never expose the masked values. It's safe for concurrent
+// use, and a nil Transformer does nothing.
+type Transformer struct {
+	masker   *Masker
+	injector *injector
+}
+
+// NewTransformer creates a Transformer, it returns nil if there is no
+// transformation.
+func NewTransformer(cfg *config.TransformConfig) (*Transformer, error) {
+	if cfg == nil || (len(cfg.MaskRules) == 0 && len(cfg.ComputedColumns) == 0) {
+		return nil, nil
+	}
+	masker, err := NewMasker(cfg)
+	if err != nil {
+		return nil, err
+	}
+	injector, err := newInjector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Transformer{masker: masker, injector: injector}, nil
+}
+
+// Verify checks the transformations are valid for the tables.
+func (t *Transformer) Verify(tableInfos []*model.TableInfo) error {
+	if t == nil {
+		return nil
+	}
+	if err := t.masker.Verify(tableInfos); err != nil {
+		return err
+	}
+	return t.injector.verify(tableInfos)
+}
+
+// Transform transforms a row change in place.
+func (t *Transformer) Transform(row *model.RowChangedEvent) {
+	if t == nil {
+		return
+	}
+	t.masker.Mask(row)
+	t.injector.inject(row)
+}
@@ -33,6 +33,9 @@ const (
 	EnsureGCServiceResuming = "-resuming-"
 	// EnsureGCServiceInitializing is a tag of GC service id for changefeed initialization
 	EnsureGCServiceInitializing = "-initializing-"
+	// EnsureGCServiceDiagnosing is a tag of GC service id for changefeed
+	// diagnosis, no service GC safepoint is kept with it
+	EnsureGCServiceDiagnosing = "-diagnosing-"
 )
 
 // EnsureChangefeedStartTsSafety checks if the startTs less than the minimum of
@@ -59,6 +62,29 @@ func EnsureChangefeedStartTsSafety(
 	return nil
 }
 
+// CheckChangefeedStartTsSafety checks if the startTs less than the minimum of
+// service GC safepoint without keeping a service GC safepoint for the
+// changefeed. It is used to verify a changefeed config without side effects.
+func CheckChangefeedStartTsSafety(
+	ctx context.Context, pdCli pd.Client,
+	gcServiceIDPrefix string,
+	changefeedID model.ChangeFeedID,
+	startTs uint64,
+) error {
+	// Removing a service GC safepoint that does not exist is a no-op in PD,
+	// and it still returns the minimum service GC safepoint.
+	minServiceGCTs, err := removeServiceGCSafepoint(
+		ctx, pdCli,
+		gcServiceIDPrefix+changefeedID.Namespace+"_"+changefeedID.ID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if startTs > 0 && startTs < minServiceGCTs+1 {
+		return cerrors.ErrStartTsBeforeGC.GenWithStackByArgs(startTs, minServiceGCTs)
+	}
+	return nil
+}
+
 // UndoEnsureChangefeedStartTsSafety cleans the service GC safepoint of a changefeed
 // if something goes wrong after successfully calling EnsureChangefeedStartTsSafety().
 func UndoEnsureChangefeedStartTsSafety(
@@ -106,17 +132,27 @@ func SetServiceGCSafepoint(
 
 // RemoveServiceGCSafepoint removes a service safepoint from PD.
 func RemoveServiceGCSafepoint(ctx context.Context, pdCli pd.Client, serviceID string) error {
+	_, err := removeServiceGCSafepoint(ctx, pdCli, serviceID)
+	return err
+}
+
+func removeServiceGCSafepoint(
+	ctx context.Context, pdCli pd.Client, serviceID string,
+) (minServiceGCTs uint64, err error) {
 	// Set TTL to 0 second to delete the service safe point.
 	TTL := 0
-	return retry.Do(ctx,
+	err = retry.Do(ctx,
 		func() error {
-			_, err := pdCli.UpdateServiceGCSafePoint(ctx, serviceID, int64(TTL), math.MaxUint64)
-			if err != nil {
-				log.Warn("Remove GC safepoint failed, retry later", zap.Error(err))
+			var err1 error
+			minServiceGCTs, err1 = pdCli.UpdateServiceGCSafePoint(
+				ctx, serviceID, int64(TTL), math.MaxUint64)
+			if err1 != nil {
+				log.Warn("Remove GC safepoint failed, retry later", zap.Error(err1))
 			}
-			return err
+			return err1
 		},
 		retry.WithBackoffBaseDelay(gcServiceBackoffDelay), // 1s
 		retry.WithMaxTries(gcServiceMaxRetries),
 		retry.WithIsRetryableErr(cerrors.IsRetryableError))
+	return
 }
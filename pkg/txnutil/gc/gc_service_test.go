@@ -91,6 +91,29 @@ func TestCheckSafetyOfStartTs(t *testing.T) {
 			"because start-ts 50 is earlier than or equal to GC safepoint at 60")
 }
 
+func TestCheckChangefeedStartTsSafety(t *testing.T) {
+	t.Parallel()
+
+	pdCli := &mockPdClientForServiceGCSafePoint{serviceSafePoint: make(map[string]uint64)}
+	ctx := context.Background()
+
+	pdCli.UpdateServiceGCSafePoint(ctx, "service1", 10, 60) //nolint:errcheck
+	err := CheckChangefeedStartTsSafety(ctx, pdCli,
+		"ticdc-dry-run-",
+		model.DefaultChangeFeedID("changefeed1"), 50)
+	require.Equal(t,
+		"[CDC:ErrStartTsBeforeGC]fail to create or maintain changefeed "+
+			"because start-ts 50 is earlier than or equal to GC safepoint at 60", err.Error())
+
+	err = CheckChangefeedStartTsSafety(ctx, pdCli,
+		"ticdc-dry-run-",
+		model.DefaultChangeFeedID("changefeed1"), 65)
+	require.Nil(t, err)
+	// No service GC safepoint is kept for the changefeed.
+	require.Equal(t, uint64(math.MaxUint64),
+		pdCli.serviceSafePoint["ticdc-dry-run-default_changefeed1"])
+}
+
 type mockPdClientForServiceGCSafePoint struct {
 	pd.Client
 	serviceSafePoint   map[string]uint64
@@ -140,3 +140,52 @@ func (r *resolver) Resolve(ctx context.Context, regionID uint64, maxVersion uint
 		zap.Any("role", r.role))
 	return nil
 }
+
+// ScanLocks scans at most limit locks in the given region, whose start ts are
+// not greater than maxVersion.
+func ScanLocks(
+	ctx context.Context, kvStorage tikv.Storage,
+	regionID uint64, maxVersion uint64, limit uint32,
+) ([]*txnkv.Lock, error) {
+	req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{
+		MaxVersion: maxVersion,
+		Limit:      limit,
+	})
+
+	bo := tikv.NewGcResolveLockMaxBackoffer(ctx)
+	for {
+		loc, err := kvStorage.GetRegionCache().LocateRegionByID(bo, regionID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		req.ScanLock().StartKey = loc.StartKey
+		resp, err := kvStorage.SendReq(bo, req, loc.Region, tikv.ReadTimeoutMedium)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if regionErr != nil {
+			err = bo.Backoff(tikv.BoRegionMiss(), errors.New(regionErr.String()))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return nil, errors.Trace(tikverr.ErrBodyMissing)
+		}
+		locksResp := resp.Resp.(*kvrpcpb.ScanLockResponse)
+		if locksResp.GetError() != nil {
+			return nil, errors.Errorf("unexpected scanlock error: %s", locksResp)
+		}
+		locksInfo := locksResp.GetLocks()
+		locks := make([]*txnkv.Lock, len(locksInfo))
+		for i := range locksInfo {
+			locks[i] = txnkv.NewLock(locksInfo[i])
+		}
+		return locks, nil
+	}
+}
@@ -63,9 +63,15 @@ type Upstream struct {
 	PDClient    pd.Client
 	KVStorage   tidbkv.Storage
 	GrpcPool    kv.GrpcPool
+	DDLGrpcPool kv.GrpcPool
 	RegionCache *tikv.RegionCache
 	PDClock     pdutil.Clock
 	GCManager   gc.Manager
+
+	// ScanLimiters limit the throughput of incremental scans of all kv
+	// clients of the upstream.
+	ScanLimiters *kv.ScanLimiters
+
 	// Only use in Close().
 	cancel func()
 	mu     sync.Mutex
@@ -110,6 +116,18 @@ func NewUpstream4Test(pdClient pd.Client) *Upstream {
 	return res
 }
 
+// newDDLGrpcPool returns the gRPC pool of DDL pullers, which is isolated from
+// the given pool of table pullers unless DDLGrpcConnectionCount is 0.
+func newDDLGrpcPool(
+	ctx context.Context, securityConfig *config.SecurityConfig,
+	cfg *config.KVClientConfig, shared kv.GrpcPool,
+) kv.GrpcPool {
+	if cfg.DDLGrpcConnectionCount == 0 {
+		return shared
+	}
+	return kv.NewGrpcPoolImplWithConfig(ctx, securityConfig, cfg.ForDDLPuller())
+}
+
 // init initializes the upstream
 func initUpstream(ctx context.Context, up *Upstream, gcServiceID string) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -169,6 +187,9 @@ func initUpstream(ctx context.Context, up *Upstream, gcServiceID string) error {
 	}
 
 	up.GrpcPool = kv.NewGrpcPoolImpl(ctx, up.SecurityConfig)
+	up.ScanLimiters = kv.NewScanLimiters(config.GetGlobalServerConfig().KVClient)
+	up.DDLGrpcPool = newDDLGrpcPool(
+		ctx, up.SecurityConfig, config.GetGlobalServerConfig().KVClient, up.GrpcPool)
 
 	up.RegionCache = tikv.NewRegionCache(up.PDClient)
 
@@ -206,6 +227,13 @@ func initUpstream(ctx context.Context, up *Upstream, gcServiceID string) error {
 		defer up.wg.Done()
 		up.GrpcPool.RecycleConn(ctx)
 	}()
+	if up.DDLGrpcPool != up.GrpcPool {
+		up.wg.Add(1)
+		go func() {
+			defer up.wg.Done()
+			up.DDLGrpcPool.RecycleConn(ctx)
+		}()
+	}
 
 	log.Info("upstream initialize successfully", zap.Uint64("upstreamID", up.ID))
 	atomic.StoreInt32(&up.status, normal)
@@ -237,6 +265,9 @@ func (up *Upstream) Close() {
 	if up.GrpcPool != nil {
 		up.GrpcPool.Close()
 	}
+	if up.DDLGrpcPool != nil && up.DDLGrpcPool != up.GrpcPool {
+		up.DDLGrpcPool.Close()
+	}
 	if up.RegionCache != nil {
 		up.RegionCache.Close()
 	}
@@ -14,10 +14,13 @@
 package upstream
 
 import (
+	"context"
 	"testing"
 
 	"github.com/benbjohnson/clock"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/pkg/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -66,3 +69,32 @@ func TestTrySetIdleTime(t *testing.T) {
 	up.resetIdleTime()
 	require.True(t, up.idleTime.IsZero())
 }
+
+func TestNewDDLGrpcPool(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.GetDefaultServerConfig().Clone().KVClient
+	shared := kv.NewGrpcPoolImpl(ctx, &config.SecurityConfig{})
+	defer shared.Close()
+
+	cfg.DDLGrpcConnectionCount = 0
+	require.Same(t, shared, newDDLGrpcPool(ctx, &config.SecurityConfig{}, cfg, shared))
+
+	cfg.DDLGrpcConnectionCount = 1
+	pool := newDDLGrpcPool(ctx, &config.SecurityConfig{}, cfg, shared)
+	defer pool.Close()
+	require.NotSame(t, shared, pool)
+
+	// Connections of DDL pullers are never shared with table pullers.
+	addr := "127.0.0.1:20161"
+	conn, err := shared.GetConn(addr)
+	require.Nil(t, err)
+	ddlConn, err := pool.GetConn(addr)
+	require.Nil(t, err)
+	require.NotSame(t, conn.ClientConn, ddlConn.ClientConn)
+	shared.ReleaseConn(conn, addr)
+	pool.ReleaseConn(ddlConn, addr)
+}
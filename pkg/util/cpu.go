@@ -0,0 +1,74 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/shirou/gopsutil/v3/cpu"
+	"go.uber.org/zap"
+)
+
+// CPUUsageSampler samples the CPU usage of the host. It's safe to be shared
+// by multiple goroutines, and the host CPU times are read at most once in an
+// interval no matter how many callers there are.
+type CPUUsageSampler struct {
+	interval time.Duration
+
+	mu         sync.Mutex
+	lastSample time.Time
+	lastBusy   float64
+	lastTotal  float64
+	usage      float64
+}
+
+// NewCPUUsageSampler creates a CPUUsageSampler.
+func NewCPUUsageSampler(interval time.Duration) *CPUUsageSampler {
+	return &CPUUsageSampler{interval: interval}
+}
+
+// Usage returns the ratio of busy CPU time of the host in [0, 1] between the
+// last two samples. It returns 0 before there are enough samples, or if the
+// CPU times are not available.
+func (s *CPUUsageSampler) Usage() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if now.Sub(s.lastSample) < s.interval {
+		return s.usage
+	}
+	s.lastSample = now
+
+	times, err := cpu.Times(false)
+	if err != nil || len(times) == 0 {
+		log.Warn("fail to get cpu times", zap.Error(err))
+		return s.usage
+	}
+	t := times[0]
+	idle := t.Idle + t.Iowait
+	total := idle + t.User + t.System + t.Nice + t.Irq + t.Softirq + t.Steal
+	busy := total - idle
+	if s.lastTotal > 0 && total > s.lastTotal {
+		s.usage = (busy - s.lastBusy) / (total - s.lastTotal)
+		if s.usage < 0 {
+			s.usage = 0
+		} else if s.usage > 1 {
+			s.usage = 1
+		}
+	}
+	s.lastBusy, s.lastTotal = busy, total
+	return s.usage
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUUsageSampler(t *testing.T) {
+	t.Parallel()
+
+	s := NewCPUUsageSampler(10 * time.Millisecond)
+	require.Equal(t, float64(0), s.Usage())
+	for i := 0; i < 10; i++ {
+		time.Sleep(20 * time.Millisecond)
+		usage := s.Usage()
+		require.GreaterOrEqual(t, usage, float64(0))
+		require.LessOrEqual(t, usage, float64(1))
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/retry"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -202,6 +203,78 @@ func (s *extStorageWithTimeout) Rename(
 	return s.ExternalStorage.Rename(ctx, oldFileName, newFileName)
 }
 
+const (
+	extStorageRetryBaseDelayInMs = 500
+	extStorageRetryMaxDelayInMs  = 5000
+)
+
+type extStorageWithRetry struct {
+	storage.ExternalStorage
+	maxTries uint64
+}
+
+// NewExtStorageWithRetry wraps the storage to retry the idempotent file
+// operations on transient errors. It is used for the backends such as GCS
+// and Azure Blob, whose retries are not configured by the s3 retryer.
+func NewExtStorageWithRetry(
+	s storage.ExternalStorage, maxTries uint64,
+) storage.ExternalStorage {
+	return &extStorageWithRetry{ExternalStorage: s, maxTries: maxTries}
+}
+
+func (s *extStorageWithRetry) do(ctx context.Context, op string, fn func() error) error {
+	return retry.Do(ctx, func() error {
+		err := fn()
+		if err != nil && isRetryableExtStorageErr(err) {
+			log.Warn("failed to request external storage, retrying",
+				zap.String("op", op), zap.Error(err))
+		}
+		return err
+	}, retry.WithBackoffBaseDelay(extStorageRetryBaseDelayInMs),
+		retry.WithBackoffMaxDelay(extStorageRetryMaxDelayInMs),
+		retry.WithMaxTries(s.maxTries),
+		retry.WithIsRetryableErr(isRetryableExtStorageErr))
+}
+
+func isRetryableExtStorageErr(err error) bool {
+	cause := errors.Cause(err)
+	return cause != context.Canceled && cause != context.DeadlineExceeded &&
+		!IsNotExistInExtStorage(err)
+}
+
+// WriteFile writes a complete file to storage, similar to os.WriteFile,
+// but WriteFile should be atomic
+func (s *extStorageWithRetry) WriteFile(ctx context.Context, name string, data []byte) error {
+	return s.do(ctx, "WriteFile", func() error {
+		return s.ExternalStorage.WriteFile(ctx, name, data)
+	})
+}
+
+// ReadFile reads a complete file from storage, similar to os.ReadFile
+func (s *extStorageWithRetry) ReadFile(ctx context.Context, name string) (data []byte, err error) {
+	err = s.do(ctx, "ReadFile", func() error {
+		data, err = s.ExternalStorage.ReadFile(ctx, name)
+		return err
+	})
+	return data, err
+}
+
+// FileExists return true if file exists
+func (s *extStorageWithRetry) FileExists(ctx context.Context, name string) (exists bool, err error) {
+	err = s.do(ctx, "FileExists", func() error {
+		exists, err = s.ExternalStorage.FileExists(ctx, name)
+		return err
+	})
+	return exists, err
+}
+
+// DeleteFile delete the file in storage
+func (s *extStorageWithRetry) DeleteFile(ctx context.Context, name string) error {
+	return s.do(ctx, "DeleteFile", func() error {
+		return s.ExternalStorage.DeleteFile(ctx, name)
+	})
+}
+
 // IsNotExistInExtStorage checks if the error is caused by the file not exist in external storage.
 func IsNotExistInExtStorage(err error) bool {
 	if err == nil {
@@ -66,6 +66,14 @@ func validOptionalPort(port string) bool {
 	return true
 }
 
+// sensitiveQueryParams are the query parameters of the sink and storage uri
+// which contain credentials.
+var sensitiveQueryParams = []string{
+	"sasl-password",
+	// credentials of the s3 and azure blob storage
+	"access-key", "secret-access-key", "session-token", "account-key", "sas-token",
+}
+
 // MaskSinkURI returns a sink uri that sensitive infos has been masked.
 func MaskSinkURI(uri string) (string, error) {
 	uriParsed, err := url.Parse(uri)
@@ -74,8 +82,14 @@ func MaskSinkURI(uri string) (string, error) {
 		return "", err
 	}
 	queries := uriParsed.Query()
-	if queries.Has("sasl-password") {
-		queries.Set("sasl-password", "xxxxx")
+	masked := false
+	for _, param := range sensitiveQueryParams {
+		if queries.Has(param) {
+			queries.Set(param, "xxxxx")
+			masked = true
+		}
+	}
+	if masked {
 		uriParsed.RawQuery = queries.Encode()
 	}
 	return uriParsed.Redacted(), nil
@@ -80,6 +80,14 @@ func TestMaskSinkURI(t *testing.T) {
 			"kafka://127.0.0.1:9093/cdc?sasl-mechanism=SCRAM-SHA-256&sasl-user=ticdc&sasl-password=verysecure",
 			"kafka://127.0.0.1:9093/cdc?sasl-mechanism=SCRAM-SHA-256&sasl-password=xxxxx&sasl-user=ticdc",
 		},
+		{
+			"azure://redo/prefix?account-name=cdc&account-key=verysecure",
+			"azure://redo/prefix?account-key=xxxxx&account-name=cdc",
+		},
+		{
+			"gcs://redo/prefix?credentials-file=/etc/gcs.json",
+			"gcs://redo/prefix?credentials-file=/etc/gcs.json",
+		},
 	}
 
 	for _, tt := range tests {
@@ -47,6 +47,12 @@ var (
 	// maxTiKVVersion is the version of the maximum compatible TiKV.
 	// Compatible versions are in [MinTiKVVersion, maxTiKVVersion)
 	maxTiKVVersion = semver.New("8.0.0")
+	// MinTiKVVersionForStoreResolvedTs is the version of the minimal TiKV
+	// which sends store-level resolved ts, that is a resolved ts event whose
+	// region list is empty and which applies to all regions of the stream.
+	// kvproto has no capability negotiation for it, so the version of the
+	// store is the only way to know that the empty region list means it.
+	MinTiKVVersionForStoreResolvedTs = semver.New("7.2.0-alpha")
 
 	// CaptureInfo.Version is added since v4.0.11,
 	// we use the minimal release version as default.
@@ -229,6 +235,21 @@ func CheckStoreVersion(ctx context.Context, client pd.Client, storeID uint64) er
 	return nil
 }
 
+// CheckStoreResolvedTsSupported returns whether the given TiKV sends
+// store-level resolved ts, see MinTiKVVersionForStoreResolvedTs.
+func CheckStoreResolvedTsSupported(ctx context.Context, client pd.Client, storeID uint64) (bool, error) {
+	s, err := client.GetStore(ctx, storeID)
+	if err != nil {
+		return false, cerror.WrapError(cerror.ErrGetAllStoresFailed, err)
+	}
+	ver, err := semver.NewVersion(SanitizeVersion(s.Version))
+	if err != nil {
+		err = errors.Annotate(err, "invalid TiKV version")
+		return false, cerror.WrapError(cerror.ErrNewSemVersion, err)
+	}
+	return !ver.LessThan(*MinTiKVVersionForStoreResolvedTs), nil
+}
+
 // TiCDCClusterVersion is the version of TiCDC cluster
 type TiCDCClusterVersion struct {
 	*semver.Version
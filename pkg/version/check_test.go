@@ -39,6 +39,16 @@ type mockPDClient struct {
 	getStatusCode func() int
 }
 
+func (m *mockPDClient) GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error) {
+	stores, _ := m.GetAllStores(ctx)
+	for _, s := range stores {
+		if s.Id == storeID {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("store %d not found", storeID)
+}
+
 func (m *mockPDClient) GetAllStores(ctx context.Context, opts ...pd.GetStoreOption) ([]*metapb.Store, error) {
 	if m.getAllStores != nil {
 		return m.getAllStores(), nil
@@ -482,3 +492,26 @@ func TestCheckTiCDCVersion(t *testing.T) {
 	err = CheckTiCDCVersion(versions)
 	require.NoError(t, err)
 }
+
+func TestCheckStoreResolvedTsSupported(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockPDClient{getAllStores: func() []*metapb.Store {
+		return []*metapb.Store{
+			{Id: 1, Version: "v7.1.0"},
+			{Id: 2, Version: MinTiKVVersionForStoreResolvedTs.String()},
+			{Id: 3, Version: "v7.4.0-20-g0123456789-dev"},
+			{Id: 4, Version: "invalid"},
+		}
+	}}
+	ctx := context.Background()
+	for storeID, expected := range map[uint64]bool{1: false, 2: true, 3: true} {
+		ok, err := CheckStoreResolvedTsSupported(ctx, mock, storeID)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, storeID)
+	}
+	_, err := CheckStoreResolvedTsSupported(ctx, mock, 4)
+	require.Error(t, err)
+	_, err = CheckStoreResolvedTsSupported(ctx, mock, 5)
+	require.Error(t, err)
+}
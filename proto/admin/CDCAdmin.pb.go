@@ -0,0 +1,962 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: CDCAdmin.proto
+
+package admin
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Capture struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsOwner   bool   `protobuf:"varint,2,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	ClusterId string `protobuf:"bytes,4,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (m *Capture) Reset()         { *m = Capture{} }
+func (m *Capture) String() string { return proto.CompactTextString(m) }
+func (*Capture) ProtoMessage()    {}
+func (*Capture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{0}
+}
+func (m *Capture) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capture.Unmarshal(m, b)
+}
+func (m *Capture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capture.Marshal(b, m, deterministic)
+}
+func (m *Capture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capture.Merge(m, src)
+}
+func (m *Capture) XXX_Size() int {
+	return xxx_messageInfo_Capture.Size(m)
+}
+func (m *Capture) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capture proto.InternalMessageInfo
+
+func (m *Capture) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Capture) GetIsOwner() bool {
+	if m != nil {
+		return m.IsOwner
+	}
+	return false
+}
+
+func (m *Capture) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Capture) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+type ListCapturesRequest struct {
+}
+
+func (m *ListCapturesRequest) Reset()         { *m = ListCapturesRequest{} }
+func (m *ListCapturesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCapturesRequest) ProtoMessage()    {}
+func (*ListCapturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{1}
+}
+func (m *ListCapturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCapturesRequest.Unmarshal(m, b)
+}
+func (m *ListCapturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCapturesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCapturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCapturesRequest.Merge(m, src)
+}
+func (m *ListCapturesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCapturesRequest.Size(m)
+}
+func (m *ListCapturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCapturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCapturesRequest proto.InternalMessageInfo
+
+type ListCapturesResponse struct {
+	Captures []*Capture `protobuf:"bytes,1,rep,name=captures,proto3" json:"captures,omitempty"`
+}
+
+func (m *ListCapturesResponse) Reset()         { *m = ListCapturesResponse{} }
+func (m *ListCapturesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCapturesResponse) ProtoMessage()    {}
+func (*ListCapturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{2}
+}
+func (m *ListCapturesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCapturesResponse.Unmarshal(m, b)
+}
+func (m *ListCapturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCapturesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCapturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCapturesResponse.Merge(m, src)
+}
+func (m *ListCapturesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCapturesResponse.Size(m)
+}
+func (m *ListCapturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCapturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCapturesResponse proto.InternalMessageInfo
+
+func (m *ListCapturesResponse) GetCaptures() []*Capture {
+	if m != nil {
+		return m.Captures
+	}
+	return nil
+}
+
+type Changefeed struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id         string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	UpstreamId uint64 `protobuf:"varint,3,opt,name=upstream_id,json=upstreamId,proto3" json:"upstream_id,omitempty"`
+	// One of normal, stopped, error, failed, removed and finished.
+	State        string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	CheckpointTs uint64 `protobuf:"varint,5,opt,name=checkpoint_ts,json=checkpointTs,proto3" json:"checkpoint_ts,omitempty"`
+	ResolvedTs   uint64 `protobuf:"varint,6,opt,name=resolved_ts,json=resolvedTs,proto3" json:"resolved_ts,omitempty"`
+	// The last error of the changefeed, empty if the state is normal.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Changefeed) Reset()         { *m = Changefeed{} }
+func (m *Changefeed) String() string { return proto.CompactTextString(m) }
+func (*Changefeed) ProtoMessage()    {}
+func (*Changefeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{3}
+}
+func (m *Changefeed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Changefeed.Unmarshal(m, b)
+}
+func (m *Changefeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Changefeed.Marshal(b, m, deterministic)
+}
+func (m *Changefeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Changefeed.Merge(m, src)
+}
+func (m *Changefeed) XXX_Size() int {
+	return xxx_messageInfo_Changefeed.Size(m)
+}
+func (m *Changefeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_Changefeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Changefeed proto.InternalMessageInfo
+
+func (m *Changefeed) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Changefeed) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Changefeed) GetUpstreamId() uint64 {
+	if m != nil {
+		return m.UpstreamId
+	}
+	return 0
+}
+
+func (m *Changefeed) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Changefeed) GetCheckpointTs() uint64 {
+	if m != nil {
+		return m.CheckpointTs
+	}
+	return 0
+}
+
+func (m *Changefeed) GetResolvedTs() uint64 {
+	if m != nil {
+		return m.ResolvedTs
+	}
+	return 0
+}
+
+func (m *Changefeed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ChangefeedRequest struct {
+	// The default namespace is used if it's empty.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ChangefeedRequest) Reset()         { *m = ChangefeedRequest{} }
+func (m *ChangefeedRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangefeedRequest) ProtoMessage()    {}
+func (*ChangefeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{4}
+}
+func (m *ChangefeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangefeedRequest.Unmarshal(m, b)
+}
+func (m *ChangefeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangefeedRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangefeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangefeedRequest.Merge(m, src)
+}
+func (m *ChangefeedRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangefeedRequest.Size(m)
+}
+func (m *ChangefeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangefeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangefeedRequest proto.InternalMessageInfo
+
+func (m *ChangefeedRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ChangefeedRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type OperateChangefeedResponse struct {
+}
+
+func (m *OperateChangefeedResponse) Reset()         { *m = OperateChangefeedResponse{} }
+func (m *OperateChangefeedResponse) String() string { return proto.CompactTextString(m) }
+func (*OperateChangefeedResponse) ProtoMessage()    {}
+func (*OperateChangefeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{5}
+}
+func (m *OperateChangefeedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateChangefeedResponse.Unmarshal(m, b)
+}
+func (m *OperateChangefeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateChangefeedResponse.Marshal(b, m, deterministic)
+}
+func (m *OperateChangefeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateChangefeedResponse.Merge(m, src)
+}
+func (m *OperateChangefeedResponse) XXX_Size() int {
+	return xxx_messageInfo_OperateChangefeedResponse.Size(m)
+}
+func (m *OperateChangefeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateChangefeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateChangefeedResponse proto.InternalMessageInfo
+
+type ListChangefeedsRequest struct {
+	// Only changefeeds in the namespace are returned if it's not empty.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *ListChangefeedsRequest) Reset()         { *m = ListChangefeedsRequest{} }
+func (m *ListChangefeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChangefeedsRequest) ProtoMessage()    {}
+func (*ListChangefeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{6}
+}
+func (m *ListChangefeedsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChangefeedsRequest.Unmarshal(m, b)
+}
+func (m *ListChangefeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChangefeedsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListChangefeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChangefeedsRequest.Merge(m, src)
+}
+func (m *ListChangefeedsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChangefeedsRequest.Size(m)
+}
+func (m *ListChangefeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChangefeedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChangefeedsRequest proto.InternalMessageInfo
+
+func (m *ListChangefeedsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListChangefeedsResponse struct {
+	Changefeeds []*Changefeed `protobuf:"bytes,1,rep,name=changefeeds,proto3" json:"changefeeds,omitempty"`
+}
+
+func (m *ListChangefeedsResponse) Reset()         { *m = ListChangefeedsResponse{} }
+func (m *ListChangefeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListChangefeedsResponse) ProtoMessage()    {}
+func (*ListChangefeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{7}
+}
+func (m *ListChangefeedsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChangefeedsResponse.Unmarshal(m, b)
+}
+func (m *ListChangefeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChangefeedsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListChangefeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChangefeedsResponse.Merge(m, src)
+}
+func (m *ListChangefeedsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListChangefeedsResponse.Size(m)
+}
+func (m *ListChangefeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChangefeedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChangefeedsResponse proto.InternalMessageInfo
+
+func (m *ListChangefeedsResponse) GetChangefeeds() []*Changefeed {
+	if m != nil {
+		return m.Changefeeds
+	}
+	return nil
+}
+
+type Processor struct {
+	Namespace    string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ChangefeedId string `protobuf:"bytes,2,opt,name=changefeed_id,json=changefeedId,proto3" json:"changefeed_id,omitempty"`
+	CaptureId    string `protobuf:"bytes,3,opt,name=capture_id,json=captureId,proto3" json:"capture_id,omitempty"`
+}
+
+func (m *Processor) Reset()         { *m = Processor{} }
+func (m *Processor) String() string { return proto.CompactTextString(m) }
+func (*Processor) ProtoMessage()    {}
+func (*Processor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{8}
+}
+func (m *Processor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Processor.Unmarshal(m, b)
+}
+func (m *Processor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Processor.Marshal(b, m, deterministic)
+}
+func (m *Processor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Processor.Merge(m, src)
+}
+func (m *Processor) XXX_Size() int {
+	return xxx_messageInfo_Processor.Size(m)
+}
+func (m *Processor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Processor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Processor proto.InternalMessageInfo
+
+func (m *Processor) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Processor) GetChangefeedId() string {
+	if m != nil {
+		return m.ChangefeedId
+	}
+	return ""
+}
+
+func (m *Processor) GetCaptureId() string {
+	if m != nil {
+		return m.CaptureId
+	}
+	return ""
+}
+
+type ListProcessorsRequest struct {
+}
+
+func (m *ListProcessorsRequest) Reset()         { *m = ListProcessorsRequest{} }
+func (m *ListProcessorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProcessorsRequest) ProtoMessage()    {}
+func (*ListProcessorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{9}
+}
+func (m *ListProcessorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProcessorsRequest.Unmarshal(m, b)
+}
+func (m *ListProcessorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProcessorsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProcessorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProcessorsRequest.Merge(m, src)
+}
+func (m *ListProcessorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProcessorsRequest.Size(m)
+}
+func (m *ListProcessorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProcessorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProcessorsRequest proto.InternalMessageInfo
+
+type ListProcessorsResponse struct {
+	Processors []*Processor `protobuf:"bytes,1,rep,name=processors,proto3" json:"processors,omitempty"`
+}
+
+func (m *ListProcessorsResponse) Reset()         { *m = ListProcessorsResponse{} }
+func (m *ListProcessorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProcessorsResponse) ProtoMessage()    {}
+func (*ListProcessorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{10}
+}
+func (m *ListProcessorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProcessorsResponse.Unmarshal(m, b)
+}
+func (m *ListProcessorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProcessorsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProcessorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProcessorsResponse.Merge(m, src)
+}
+func (m *ListProcessorsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProcessorsResponse.Size(m)
+}
+func (m *ListProcessorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProcessorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProcessorsResponse proto.InternalMessageInfo
+
+func (m *ListProcessorsResponse) GetProcessors() []*Processor {
+	if m != nil {
+		return m.Processors
+	}
+	return nil
+}
+
+type WatchChangefeedRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The interval to send the status in milliseconds, 1000 if it's zero.
+	IntervalMs uint64 `protobuf:"varint,3,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+}
+
+func (m *WatchChangefeedRequest) Reset()         { *m = WatchChangefeedRequest{} }
+func (m *WatchChangefeedRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchChangefeedRequest) ProtoMessage()    {}
+func (*WatchChangefeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7b87837d050964d, []int{11}
+}
+func (m *WatchChangefeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchChangefeedRequest.Unmarshal(m, b)
+}
+func (m *WatchChangefeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchChangefeedRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchChangefeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchChangefeedRequest.Merge(m, src)
+}
+func (m *WatchChangefeedRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchChangefeedRequest.Size(m)
+}
+func (m *WatchChangefeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchChangefeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchChangefeedRequest proto.InternalMessageInfo
+
+func (m *WatchChangefeedRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *WatchChangefeedRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WatchChangefeedRequest) GetIntervalMs() uint64 {
+	if m != nil {
+		return m.IntervalMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Capture)(nil), "admin.Capture")
+	proto.RegisterType((*ListCapturesRequest)(nil), "admin.ListCapturesRequest")
+	proto.RegisterType((*ListCapturesResponse)(nil), "admin.ListCapturesResponse")
+	proto.RegisterType((*Changefeed)(nil), "admin.Changefeed")
+	proto.RegisterType((*ChangefeedRequest)(nil), "admin.ChangefeedRequest")
+	proto.RegisterType((*OperateChangefeedResponse)(nil), "admin.OperateChangefeedResponse")
+	proto.RegisterType((*ListChangefeedsRequest)(nil), "admin.ListChangefeedsRequest")
+	proto.RegisterType((*ListChangefeedsResponse)(nil), "admin.ListChangefeedsResponse")
+	proto.RegisterType((*Processor)(nil), "admin.Processor")
+	proto.RegisterType((*ListProcessorsRequest)(nil), "admin.ListProcessorsRequest")
+	proto.RegisterType((*ListProcessorsResponse)(nil), "admin.ListProcessorsResponse")
+	proto.RegisterType((*WatchChangefeedRequest)(nil), "admin.WatchChangefeedRequest")
+}
+
+func init() { proto.RegisterFile("CDCAdmin.proto", fileDescriptor_e7b87837d050964d) }
+
+var fileDescriptor_e7b87837d050964d = []byte{
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xad, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xa6, 0x5d, 0xbb, 0xb6, 0xaf, 0x5d, 0xbb, 0x99, 0x6e, 0xcb, 0xba, 0x75, 0x4c, 0xe1, 0x32,
+	0xed, 0x50, 0xa6, 0x4d, 0xe2, 0xc4, 0x65, 0x2b, 0x68, 0x1a, 0xa2, 0x5b, 0x15, 0x21, 0x71, 0xac,
+	0x4c, 0x62, 0xda, 0x88, 0x26, 0xce, 0x6c, 0xa7, 0xfc, 0x1b, 0xfc, 0x59, 0x5c, 0x90, 0x38, 0x72,
+	0x44, 0x70, 0xe1, 0xcf, 0xc0, 0x4e, 0x9c, 0x1f, 0x5b, 0x5b, 0x31, 0xc4, 0x0e, 0x96, 0xec, 0xef,
+	0x3d, 0x7f, 0x7e, 0xdf, 0x7b, 0x5f, 0x14, 0x68, 0xf6, 0x5f, 0xf6, 0xcf, 0x1c, 0xcf, 0xf5, 0x7b,
+	0x01, 0xa3, 0x82, 0xa2, 0x32, 0x56, 0x87, 0x4e, 0x7b, 0x4c, 0xc7, 0x34, 0x42, 0x9e, 0xa9, 0x5d,
+	0x1c, 0x34, 0x3d, 0xa8, 0xf4, 0x71, 0x20, 0x42, 0x46, 0x50, 0x13, 0x8a, 0xae, 0x63, 0x14, 0x0e,
+	0x0a, 0x87, 0x35, 0x4b, 0xee, 0xd0, 0x0e, 0x54, 0x5d, 0x3e, 0xa2, 0x9f, 0x7c, 0xc2, 0x8c, 0xa2,
+	0x44, 0xab, 0x56, 0xc5, 0xe5, 0xd7, 0xea, 0x88, 0x0c, 0xa8, 0x60, 0xc7, 0x61, 0x84, 0x73, 0x63,
+	0x25, 0xca, 0x4f, 0x8e, 0xa8, 0x0b, 0x60, 0x4f, 0x43, 0x2e, 0x08, 0x1b, 0x49, 0xb2, 0x52, 0x14,
+	0xac, 0x69, 0xe4, 0xd2, 0x31, 0x37, 0xe1, 0xf1, 0x1b, 0x97, 0x0b, 0xfd, 0x24, 0xb7, 0xc8, 0x4d,
+	0x48, 0xb8, 0x30, 0xcf, 0xa1, 0x7d, 0x1b, 0xe6, 0x01, 0xf5, 0x39, 0x41, 0x47, 0x50, 0xb5, 0x35,
+	0x26, 0x0b, 0x5b, 0x39, 0xac, 0x9f, 0x34, 0x7b, 0x91, 0x9a, 0x9e, 0x4e, 0xb5, 0xd2, 0xb8, 0xf9,
+	0xb5, 0x00, 0xd0, 0x9f, 0x60, 0x7f, 0x4c, 0x3e, 0x10, 0xe2, 0xa0, 0x3d, 0xa8, 0xf9, 0xd8, 0x93,
+	0x44, 0xd8, 0x26, 0x5a, 0x54, 0x06, 0x68, 0xad, 0xc5, 0x54, 0xeb, 0x13, 0xa8, 0x87, 0x01, 0x17,
+	0x8c, 0x60, 0x4f, 0xd5, 0xad, 0x44, 0x95, 0x2c, 0x48, 0xa0, 0x4b, 0x07, 0xb5, 0xa1, 0xcc, 0x05,
+	0x16, 0x44, 0x4b, 0x8a, 0x0f, 0xe8, 0x29, 0xac, 0xd9, 0x13, 0x62, 0x7f, 0x0c, 0xa8, 0xeb, 0x8b,
+	0x91, 0xe0, 0x46, 0x39, 0xba, 0xd8, 0xc8, 0xc0, 0xb7, 0x5c, 0x71, 0xcb, 0xfa, 0xe8, 0x74, 0x46,
+	0x1c, 0x95, 0xb2, 0x1a, 0x73, 0x27, 0x90, 0x4c, 0x90, 0xdc, 0x84, 0x31, 0xca, 0x8c, 0x4a, 0xcc,
+	0x1d, 0x1d, 0xcc, 0x33, 0xd8, 0xc8, 0xe4, 0xe8, 0x46, 0xfd, 0x9b, 0x2a, 0x73, 0x17, 0x76, 0xae,
+	0x03, 0xc2, 0x64, 0xa5, 0x79, 0xa6, 0xb8, 0xb7, 0xe6, 0x73, 0xd8, 0x8a, 0x7a, 0x9e, 0x46, 0xf8,
+	0xbd, 0x1e, 0x31, 0xaf, 0x60, 0x7b, 0xee, 0x9e, 0x1e, 0xd7, 0x29, 0xd4, 0xed, 0x0c, 0xd6, 0x13,
+	0xdb, 0x48, 0x26, 0x96, 0x95, 0x90, 0xcf, 0x92, 0x0e, 0xac, 0x0d, 0x19, 0xb5, 0xa5, 0x79, 0x28,
+	0xfb, 0x8b, 0xbe, 0xa8, 0xdd, 0xc9, 0xcd, 0x51, 0x2a, 0xb5, 0x91, 0x81, 0x72, 0x52, 0xca, 0x81,
+	0xb1, 0x27, 0x92, 0x49, 0x2a, 0x07, 0xc6, 0x88, 0x74, 0xe0, 0x36, 0x6c, 0xaa, 0xf2, 0xd3, 0x27,
+	0x53, 0x0f, 0xbe, 0x8e, 0xfb, 0x91, 0x0f, 0x68, 0x59, 0xc7, 0x00, 0x41, 0x8a, 0x6a, 0x55, 0xeb,
+	0x5a, 0x55, 0x9a, 0x6e, 0xe5, 0x72, 0xcc, 0x31, 0x6c, 0xbd, 0xc3, 0xc2, 0x9e, 0xfc, 0xe7, 0x00,
+	0x95, 0x75, 0xa4, 0x87, 0x08, 0x9b, 0xe1, 0xe9, 0xc8, 0xe3, 0x89, 0x2d, 0x13, 0x68, 0xc0, 0x4f,
+	0x7e, 0x97, 0xa0, 0x9a, 0x7c, 0xee, 0xe8, 0x02, 0x1a, 0xf9, 0xaf, 0x08, 0x75, 0x74, 0x8d, 0x0b,
+	0xbe, 0xb8, 0xce, 0xee, 0xc2, 0x98, 0x16, 0x3c, 0x84, 0xd6, 0x9d, 0x11, 0xa3, 0x6e, 0x3e, 0x7f,
+	0xce, 0x32, 0x9d, 0xfd, 0x65, 0x61, 0xcd, 0xf8, 0x02, 0xd6, 0x2e, 0x48, 0x2e, 0x82, 0x8c, 0x79,
+	0x57, 0x68, 0xaa, 0x79, 0xbf, 0xa0, 0x01, 0xb4, 0x86, 0x38, 0xe4, 0xe4, 0x5e, 0xf7, 0x0f, 0x74,
+	0x64, 0xa9, 0xf3, 0xd1, 0x15, 0xac, 0xcb, 0x7d, 0xe8, 0x3d, 0x28, 0x9f, 0x47, 0x67, 0x0f, 0xc5,
+	0x37, 0x80, 0xe6, 0x6d, 0x27, 0xa2, 0xbd, 0x5c, 0x7b, 0xe7, 0x9c, 0xdb, 0xe9, 0x2e, 0x89, 0x6a,
+	0xba, 0x57, 0xd0, 0xba, 0x63, 0xc6, 0x74, 0x9a, 0x8b, 0x4d, 0xba, 0x60, 0x04, 0xc7, 0x85, 0x73,
+	0xe3, 0xcb, 0xcf, 0xfd, 0x47, 0xdf, 0xe4, 0xfa, 0x21, 0xd7, 0xe7, 0x5f, 0x72, 0x2f, 0xd7, 0x77,
+	0xb9, 0xde, 0xaf, 0x46, 0xbf, 0x92, 0xd3, 0x3f, 0x41, 0x0d, 0x2d, 0x3a, 0x79, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CDCAdminClient is the client API for CDCAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CDCAdminClient interface {
+	ListCaptures(ctx context.Context, in *ListCapturesRequest, opts ...grpc.CallOption) (*ListCapturesResponse, error)
+	ListChangefeeds(ctx context.Context, in *ListChangefeedsRequest, opts ...grpc.CallOption) (*ListChangefeedsResponse, error)
+	GetChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*Changefeed, error)
+	PauseChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error)
+	ResumeChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error)
+	RemoveChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error)
+	ListProcessors(ctx context.Context, in *ListProcessorsRequest, opts ...grpc.CallOption) (*ListProcessorsResponse, error)
+	// Streams the status of a changefeed periodically until the client
+	// cancels the call or the changefeed is removed.
+	WatchChangefeed(ctx context.Context, in *WatchChangefeedRequest, opts ...grpc.CallOption) (CDCAdmin_WatchChangefeedClient, error)
+}
+
+type cDCAdminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCDCAdminClient(cc *grpc.ClientConn) CDCAdminClient {
+	return &cDCAdminClient{cc}
+}
+
+func (c *cDCAdminClient) ListCaptures(ctx context.Context, in *ListCapturesRequest, opts ...grpc.CallOption) (*ListCapturesResponse, error) {
+	out := new(ListCapturesResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/ListCaptures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) ListChangefeeds(ctx context.Context, in *ListChangefeedsRequest, opts ...grpc.CallOption) (*ListChangefeedsResponse, error) {
+	out := new(ListChangefeedsResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/ListChangefeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) GetChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*Changefeed, error) {
+	out := new(Changefeed)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/GetChangefeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) PauseChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error) {
+	out := new(OperateChangefeedResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/PauseChangefeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) ResumeChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error) {
+	out := new(OperateChangefeedResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/ResumeChangefeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) RemoveChangefeed(ctx context.Context, in *ChangefeedRequest, opts ...grpc.CallOption) (*OperateChangefeedResponse, error) {
+	out := new(OperateChangefeedResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/RemoveChangefeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) ListProcessors(ctx context.Context, in *ListProcessorsRequest, opts ...grpc.CallOption) (*ListProcessorsResponse, error) {
+	out := new(ListProcessorsResponse)
+	err := c.cc.Invoke(ctx, "/admin.CDCAdmin/ListProcessors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cDCAdminClient) WatchChangefeed(ctx context.Context, in *WatchChangefeedRequest, opts ...grpc.CallOption) (CDCAdmin_WatchChangefeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CDCAdmin_serviceDesc.Streams[0], "/admin.CDCAdmin/WatchChangefeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cDCAdminWatchChangefeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CDCAdmin_WatchChangefeedClient interface {
+	Recv() (*Changefeed, error)
+	grpc.ClientStream
+}
+
+type cDCAdminWatchChangefeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *cDCAdminWatchChangefeedClient) Recv() (*Changefeed, error) {
+	m := new(Changefeed)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CDCAdminServer is the server API for CDCAdmin service.
+type CDCAdminServer interface {
+	ListCaptures(context.Context, *ListCapturesRequest) (*ListCapturesResponse, error)
+	ListChangefeeds(context.Context, *ListChangefeedsRequest) (*ListChangefeedsResponse, error)
+	GetChangefeed(context.Context, *ChangefeedRequest) (*Changefeed, error)
+	PauseChangefeed(context.Context, *ChangefeedRequest) (*OperateChangefeedResponse, error)
+	ResumeChangefeed(context.Context, *ChangefeedRequest) (*OperateChangefeedResponse, error)
+	RemoveChangefeed(context.Context, *ChangefeedRequest) (*OperateChangefeedResponse, error)
+	ListProcessors(context.Context, *ListProcessorsRequest) (*ListProcessorsResponse, error)
+	// Streams the status of a changefeed periodically until the client
+	// cancels the call or the changefeed is removed.
+	WatchChangefeed(*WatchChangefeedRequest, CDCAdmin_WatchChangefeedServer) error
+}
+
+// UnimplementedCDCAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedCDCAdminServer struct {
+}
+
+func (*UnimplementedCDCAdminServer) ListCaptures(ctx context.Context, req *ListCapturesRequest) (*ListCapturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaptures not implemented")
+}
+func (*UnimplementedCDCAdminServer) ListChangefeeds(ctx context.Context, req *ListChangefeedsRequest) (*ListChangefeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChangefeeds not implemented")
+}
+func (*UnimplementedCDCAdminServer) GetChangefeed(ctx context.Context, req *ChangefeedRequest) (*Changefeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangefeed not implemented")
+}
+func (*UnimplementedCDCAdminServer) PauseChangefeed(ctx context.Context, req *ChangefeedRequest) (*OperateChangefeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseChangefeed not implemented")
+}
+func (*UnimplementedCDCAdminServer) ResumeChangefeed(ctx context.Context, req *ChangefeedRequest) (*OperateChangefeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeChangefeed not implemented")
+}
+func (*UnimplementedCDCAdminServer) RemoveChangefeed(ctx context.Context, req *ChangefeedRequest) (*OperateChangefeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveChangefeed not implemented")
+}
+func (*UnimplementedCDCAdminServer) ListProcessors(ctx context.Context, req *ListProcessorsRequest) (*ListProcessorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessors not implemented")
+}
+func (*UnimplementedCDCAdminServer) WatchChangefeed(req *WatchChangefeedRequest, srv CDCAdmin_WatchChangefeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChangefeed not implemented")
+}
+
+func RegisterCDCAdminServer(s *grpc.Server, srv CDCAdminServer) {
+	s.RegisterService(&_CDCAdmin_serviceDesc, srv)
+}
+
+func _CDCAdmin_ListCaptures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCapturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).ListCaptures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/ListCaptures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).ListCaptures(ctx, req.(*ListCapturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_ListChangefeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangefeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).ListChangefeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/ListChangefeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).ListChangefeeds(ctx, req.(*ListChangefeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_GetChangefeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangefeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).GetChangefeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/GetChangefeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).GetChangefeed(ctx, req.(*ChangefeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_PauseChangefeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangefeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).PauseChangefeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/PauseChangefeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).PauseChangefeed(ctx, req.(*ChangefeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_ResumeChangefeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangefeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).ResumeChangefeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/ResumeChangefeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).ResumeChangefeed(ctx, req.(*ChangefeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_RemoveChangefeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangefeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).RemoveChangefeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/RemoveChangefeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).RemoveChangefeed(ctx, req.(*ChangefeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_ListProcessors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProcessorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CDCAdminServer).ListProcessors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.CDCAdmin/ListProcessors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CDCAdminServer).ListProcessors(ctx, req.(*ListProcessorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CDCAdmin_WatchChangefeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangefeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CDCAdminServer).WatchChangefeed(m, &cDCAdminWatchChangefeedServer{stream})
+}
+
+type CDCAdmin_WatchChangefeedServer interface {
+	Send(*Changefeed) error
+	grpc.ServerStream
+}
+
+type cDCAdminWatchChangefeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *cDCAdminWatchChangefeedServer) Send(m *Changefeed) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CDCAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.CDCAdmin",
+	HandlerType: (*CDCAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCaptures",
+			Handler:    _CDCAdmin_ListCaptures_Handler,
+		},
+		{
+			MethodName: "ListChangefeeds",
+			Handler:    _CDCAdmin_ListChangefeeds_Handler,
+		},
+		{
+			MethodName: "GetChangefeed",
+			Handler:    _CDCAdmin_GetChangefeed_Handler,
+		},
+		{
+			MethodName: "PauseChangefeed",
+			Handler:    _CDCAdmin_PauseChangefeed_Handler,
+		},
+		{
+			MethodName: "ResumeChangefeed",
+			Handler:    _CDCAdmin_ResumeChangefeed_Handler,
+		},
+		{
+			MethodName: "RemoveChangefeed",
+			Handler:    _CDCAdmin_RemoveChangefeed_Handler,
+		},
+		{
+			MethodName: "ListProcessors",
+			Handler:    _CDCAdmin_ListProcessors_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChangefeed",
+			Handler:       _CDCAdmin_WatchChangefeed_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "CDCAdmin.proto",
+}